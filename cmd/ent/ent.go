@@ -18,7 +18,9 @@ func main() {
 	cmd.AddCommand(
 		base.InitCmd(),
 		base.DescribeCmd(),
+		base.VizCmd(),
 		base.GenerateCmd(),
+		base.ImportCmd(),
 	)
 	_ = cmd.Execute()
 }
@@ -22,7 +22,9 @@ func main() {
 	cmd.AddCommand(
 		base.InitCmd(),
 		base.DescribeCmd(),
+		base.VizCmd(),
 		base.GenerateCmd(migrate),
+		base.ImportCmd(),
 	)
 	_ = cmd.Execute()
 }
@@ -18,6 +18,7 @@ import (
 	"unicode"
 
 	"entgo.io/ent/cmd/internal/printer"
+	"entgo.io/ent/cmd/internal/viz"
 	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
 	"entgo.io/ent/schema/field"
@@ -111,6 +112,45 @@ func DescribeCmd() *cobra.Command {
 	}
 }
 
+// VizCmd returns the viz command for ent/c packages.
+func VizCmd() *cobra.Command {
+	var (
+		format string
+		output string
+		types  []string
+		cmd    = &cobra.Command{
+			Use:   "viz [flags] path",
+			Short: "render an entity-relationship diagram of the graph schema",
+			Example: examples(
+				"ent viz ./ent/schema",
+				"ent viz --format mermaid --types User,Pet ./ent/schema",
+				"ent viz --format html --output erd.html github.com/a8m/x",
+			),
+			Args: cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, path []string) {
+				graph, err := entc.LoadGraph(path[0], &gen.Config{})
+				if err != nil {
+					log.Fatalln(err)
+				}
+				w := os.Stdout
+				if output != "" {
+					if w, err = os.Create(output); err != nil {
+						log.Fatalln(fmt.Errorf("ent/viz: %w", err))
+					}
+					defer w.Close()
+				}
+				if err := (viz.Config{Writer: w, Format: viz.Format(format), Types: types}).Print(graph); err != nil {
+					log.Fatalln(err)
+				}
+			},
+		}
+	)
+	cmd.Flags().StringVar(&format, "format", string(viz.DOT), fmt.Sprintf("output format %v", viz.Formats()))
+	cmd.Flags().StringVar(&output, "output", "", "output file (defaults to stdout)")
+	cmd.Flags().StringSliceVarP(&types, "types", "", nil, "types to include in the diagram (all types by default)")
+	return cmd
+}
+
 // GenerateCmd returns the generate command for ent/c packages.
 func GenerateCmd(postRun ...func(*gen.Config)) *cobra.Command {
 	var (
@@ -0,0 +1,317 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package viz renders the schema graph as an entity-relationship diagram.
+package viz
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+)
+
+// Format of the diagram.
+type Format string
+
+// Formats of the diagram.
+const (
+	DOT     Format = "dot"
+	Mermaid Format = "mermaid"
+	HTML    Format = "html"
+)
+
+// Formats returns all supported formats.
+func Formats() []Format {
+	return []Format{DOT, Mermaid, HTML}
+}
+
+// A Config controls the output of Fprint.
+type Config struct {
+	io.Writer
+	// Format of the diagram. Defaults to DOT.
+	Format Format
+	// Types limits the diagram to the given type names. Edges
+	// to types that are not part of the diagram are omitted.
+	Types []string
+}
+
+// Print renders the diagram of the graph to the writer.
+func (c Config) Print(g *gen.Graph) error {
+	nodes, err := c.nodes(g)
+	if err != nil {
+		return err
+	}
+	var b strings.Builder
+	switch c.Format {
+	case DOT, "":
+		dot(&b, nodes)
+	case Mermaid:
+		mermaid(&b, nodes)
+	case HTML:
+		page(&b, nodes)
+	default:
+		return fmt.Errorf("viz: unsupported format %q", c.Format)
+	}
+	_, err = io.WriteString(c, b.String())
+	return err
+}
+
+// Fprint renders the diagram of the graph in the given format to the writer.
+func Fprint(w io.Writer, g *gen.Graph, format Format) error {
+	return Config{Writer: w, Format: format}.Print(g)
+}
+
+// nodes returns the types of the diagram.
+func (c Config) nodes(g *gen.Graph) ([]*gen.Type, error) {
+	if len(c.Types) == 0 {
+		return g.Nodes, nil
+	}
+	byName := make(map[string]*gen.Type, len(g.Nodes))
+	for _, n := range g.Nodes {
+		byName[n.Name] = n
+	}
+	nodes := make([]*gen.Type, 0, len(c.Types))
+	for _, name := range c.Types {
+		n, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("viz: type %q was not found in the graph", name)
+		}
+		nodes = append(nodes, n)
+	}
+	return nodes, nil
+}
+
+// relation describes a relation between two types in the diagram. Inverse
+// edges are described by the relation of their assoc edge (edge.To).
+type relation struct {
+	from, to *gen.Type
+	edge     *gen.Edge
+}
+
+// Label returns the label of the relation.
+func (r relation) Label() string {
+	if r.edge.Ref != nil {
+		return r.edge.Name + "/" + r.edge.Ref.Name
+	}
+	return r.edge.Name
+}
+
+// relations returns the relations between the given types.
+func relations(nodes []*gen.Type) []relation {
+	in := make(map[*gen.Type]bool, len(nodes))
+	for _, n := range nodes {
+		in[n] = true
+	}
+	var rels []relation
+	for _, n := range nodes {
+		for _, e := range n.Edges {
+			if !e.IsInverse() && in[e.Type] {
+				rels = append(rels, relation{from: n, to: e.Type, edge: e})
+			}
+		}
+	}
+	return rels
+}
+
+// fields returns the fields of the type, including its identifier.
+func fields(t *gen.Type) []*gen.Field {
+	if t.ID == nil {
+		return t.Fields
+	}
+	return append([]*gen.Field{t.ID}, t.Fields...)
+}
+
+// isPK reports if the field is part of the type identifier.
+func isPK(t *gen.Type, f *gen.Field) bool {
+	if f == t.ID {
+		return true
+	}
+	for _, id := range t.CompositeID {
+		if id == f {
+			return true
+		}
+	}
+	return false
+}
+
+// constraints returns the constraints of the given field.
+func constraints(f *gen.Field) []string {
+	var cs []string
+	for _, c := range []struct {
+		ok   bool
+		name string
+	}{
+		{f.Unique, "unique"},
+		{f.Optional, "optional"},
+		{f.Nillable, "nillable"},
+		{f.Immutable, "immutable"},
+		{f.Default, "default"},
+		{f.IsEdgeField(), "edge-field"},
+	} {
+		if c.ok {
+			cs = append(cs, c.name)
+		}
+	}
+	return cs
+}
+
+// index returns the description of the given index.
+func index(idx *gen.Index) string {
+	desc := idx.Name + " (" + strings.Join(idx.Columns, ", ") + ")"
+	if idx.Unique {
+		desc += " unique"
+	}
+	return desc
+}
+
+// dot renders the diagram in Graphviz DOT format.
+func dot(b *strings.Builder, nodes []*gen.Type) {
+	b.WriteString("digraph ent {\n")
+	b.WriteString("\tgraph [rankdir=LR];\n")
+	b.WriteString("\tnode [shape=plaintext fontname=\"Helvetica\"];\n")
+	b.WriteString("\tedge [fontname=\"Helvetica\" fontsize=10];\n")
+	for _, n := range nodes {
+		title := n.Name
+		if n.IsView() {
+			title += " (view)"
+		}
+		fmt.Fprintf(b, "\t%q [label=<<table border=\"0\" cellborder=\"1\" cellspacing=\"0\" cellpadding=\"4\">", n.Name)
+		fmt.Fprintf(b, "<tr><td colspan=\"3\" bgcolor=\"lightgrey\"><b>%s</b></td></tr>", html.EscapeString(title))
+		for _, f := range fields(n) {
+			name := html.EscapeString(f.Name)
+			if isPK(n, f) {
+				name = "<u>" + name + "</u>"
+			}
+			fmt.Fprintf(b, "<tr><td align=\"left\">%s</td><td align=\"left\">%s</td><td align=\"left\">%s</td></tr>",
+				name, html.EscapeString(f.Type.String()), strings.Join(constraints(f), ", "))
+		}
+		for _, idx := range n.Indexes {
+			fmt.Fprintf(b, "<tr><td colspan=\"3\" align=\"left\"><i>index %s</i></td></tr>", html.EscapeString(index(idx)))
+		}
+		b.WriteString("</table>>];\n")
+	}
+	for _, r := range relations(nodes) {
+		head, tail := "tee", "tee"
+		switch r.edge.Rel.Type {
+		case gen.O2M:
+			head = "crow"
+		case gen.M2O:
+			tail = "crow"
+		case gen.M2M:
+			head, tail = "crow", "crow"
+		}
+		fmt.Fprintf(b, "\t%q -> %q [label=%q dir=both arrowhead=%s arrowtail=%s];\n",
+			r.from.Name, r.to.Name, r.Label()+" ("+r.edge.Rel.Type.String()+")", head, tail)
+	}
+	b.WriteString("}\n")
+}
+
+// mermaid renders the diagram in Mermaid (erDiagram) format.
+func mermaid(b *strings.Builder, nodes []*gen.Type) {
+	b.WriteString("erDiagram\n")
+	for _, n := range nodes {
+		fmt.Fprintf(b, "\t%s {\n", n.Name)
+		for _, f := range fields(n) {
+			fmt.Fprintf(b, "\t\t%s %s", mermaidType(f.Type.String()), f.Name)
+			switch {
+			case isPK(n, f):
+				b.WriteString(" PK")
+			case f.IsEdgeField():
+				b.WriteString(" FK")
+			case f.Unique:
+				b.WriteString(" UK")
+			}
+			if cs := constraints(f); len(cs) > 0 {
+				fmt.Fprintf(b, " %q", strings.Join(cs, ", "))
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("\t}\n")
+		// Mermaid does not support indexes, and they are added as comments.
+		for _, idx := range n.Indexes {
+			fmt.Fprintf(b, "\t%%%% %s index %s\n", n.Name, index(idx))
+		}
+	}
+	for _, r := range relations(nodes) {
+		// The left side is the cardinality of the edge owner, and
+		// the right side is the cardinality of the edge type.
+		left, right := "|o", "o|"
+		if r.edge.Ref != nil && !r.edge.Ref.Optional {
+			left = "||"
+		}
+		switch r.edge.Rel.Type {
+		case gen.O2M:
+			right = "o{"
+		case gen.M2O:
+			left = "}o"
+		case gen.M2M:
+			left, right = "}o", "o{"
+		}
+		fmt.Fprintf(b, "\t%s %s--%s %s : %q\n", r.from.Name, left, right, r.to.Name, r.Label())
+	}
+}
+
+// mermaidType returns a type name that is accepted by the Mermaid attribute syntax.
+func mermaidType(s string) string {
+	return reMermaid.ReplaceAllString(s, "_")
+}
+
+var reMermaid = regexp.MustCompile(`[^\w\[\]()-]`)
+
+// page renders the diagram as a self-contained HTML page.
+func page(b *strings.Builder, nodes []*gen.Type) {
+	b.WriteString(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>ent schema</title>
+<style>
+body { font-family: Helvetica, Arial, sans-serif; margin: 2em; }
+section { display: inline-block; vertical-align: top; margin: 0 1em 1em 0; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; font-size: 14px; }
+th { background: #eee; }
+.pk { text-decoration: underline; }
+</style>
+</head>
+<body>
+`)
+	rels := relations(nodes)
+	for _, n := range nodes {
+		title := html.EscapeString(n.Name)
+		if n.IsView() {
+			title += " <small>(view)</small>"
+		}
+		fmt.Fprintf(b, "<section id=%q>\n<table>\n<tr><th colspan=\"3\">%s</th></tr>\n", n.Name, title)
+		for _, f := range fields(n) {
+			name := html.EscapeString(f.Name)
+			if isPK(n, f) {
+				name = "<span class=\"pk\">" + name + "</span>"
+			}
+			fmt.Fprintf(b, "<tr><td>%s</td><td>%s</td><td>%s</td></tr>\n", name, html.EscapeString(f.Type.String()), strings.Join(constraints(f), ", "))
+		}
+		for _, r := range rels {
+			var other *gen.Type
+			switch {
+			case r.from == n:
+				other = r.to
+			case r.to == n:
+				other = r.from
+			default:
+				continue
+			}
+			fmt.Fprintf(b, "<tr><td>%s</td><td><a href=\"#%s\">%s</a></td><td>%s</td></tr>\n",
+				html.EscapeString(r.Label()), other.Name, other.Name, r.edge.Rel.Type)
+		}
+		for _, idx := range n.Indexes {
+			fmt.Fprintf(b, "<tr><td colspan=\"3\"><i>index %s</i></td></tr>\n", html.EscapeString(index(idx)))
+		}
+		b.WriteString("</table>\n</section>\n")
+	}
+	b.WriteString("</body>\n</html>\n")
+}
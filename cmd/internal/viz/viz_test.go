@@ -0,0 +1,161 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package viz
+
+import (
+	"strings"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/entc/load"
+	"entgo.io/ent/schema/field"
+
+	"github.com/stretchr/testify/require"
+)
+
+func graph(t *testing.T) *gen.Graph {
+	storage, err := gen.NewStorage("sql")
+	require.NoError(t, err)
+	g, err := gen.NewGraph(&gen.Config{Package: "entc/gen", Storage: storage},
+		&load.Schema{
+			Name: "User",
+			Fields: []*load.Field{
+				{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}, Unique: true},
+				{Name: "age", Info: &field.TypeInfo{Type: field.TypeInt}, Optional: true},
+			},
+			Edges: []*load.Edge{
+				{Name: "pets", Type: "Pet"},
+				{Name: "groups", Type: "Group"},
+			},
+			Indexes: []*load.Index{
+				{Fields: []string{"name", "age"}},
+			},
+		},
+		&load.Schema{
+			Name: "Pet",
+			Fields: []*load.Field{
+				{Name: "owner_id", Info: &field.TypeInfo{Type: field.TypeInt}},
+			},
+			Edges: []*load.Edge{
+				{Name: "owner", Type: "User", RefName: "pets", Inverse: true, Unique: true, Required: true, Field: "owner_id"},
+			},
+		},
+		&load.Schema{
+			Name: "Group",
+			Fields: []*load.Field{
+				{Name: "created_at", Info: &field.TypeInfo{Type: field.TypeTime}, Immutable: true},
+			},
+			Edges: []*load.Edge{
+				{Name: "users", Type: "User", RefName: "groups", Inverse: true},
+				{Name: "admin", Type: "User", Unique: true},
+			},
+		},
+	)
+	require.NoError(t, err)
+	return g
+}
+
+func TestConfig_Print(t *testing.T) {
+	g := graph(t)
+	tests := []struct {
+		format Format
+		types  []string
+		out    string
+	}{
+		{
+			format: Mermaid,
+			out: `erDiagram
+	User {
+		int id PK
+		string name UK "unique"
+		int age "optional"
+	}
+	%% User index user_name_age (name, age)
+	Pet {
+		int id PK
+		int owner_id FK "edge-field"
+	}
+	Group {
+		int id PK
+		time_Time created_at "immutable"
+	}
+	User ||--o{ Pet : "pets/owner"
+	User }o--o{ Group : "groups/users"
+	Group }o--o| User : "admin"
+`,
+		},
+		{
+			format: Mermaid,
+			types:  []string{"User", "Pet"},
+			out: `erDiagram
+	User {
+		int id PK
+		string name UK "unique"
+		int age "optional"
+	}
+	%% User index user_name_age (name, age)
+	Pet {
+		int id PK
+		int owner_id FK "edge-field"
+	}
+	User ||--o{ Pet : "pets/owner"
+`,
+		},
+		{
+			format: DOT,
+			types:  []string{"Pet", "User"},
+			out: `digraph ent {
+	graph [rankdir=LR];
+	node [shape=plaintext fontname="Helvetica"];
+	edge [fontname="Helvetica" fontsize=10];
+	"Pet" [label=<<table border="0" cellborder="1" cellspacing="0" cellpadding="4"><tr><td colspan="3" bgcolor="lightgrey"><b>Pet</b></td></tr><tr><td align="left"><u>id</u></td><td align="left">int</td><td align="left"></td></tr><tr><td align="left">owner_id</td><td align="left">int</td><td align="left">edge-field</td></tr></table>>];
+	"User" [label=<<table border="0" cellborder="1" cellspacing="0" cellpadding="4"><tr><td colspan="3" bgcolor="lightgrey"><b>User</b></td></tr><tr><td align="left"><u>id</u></td><td align="left">int</td><td align="left"></td></tr><tr><td align="left">name</td><td align="left">string</td><td align="left">unique</td></tr><tr><td align="left">age</td><td align="left">int</td><td align="left">optional</td></tr><tr><td colspan="3" align="left"><i>index user_name_age (name, age)</i></td></tr></table>>];
+	"User" -> "Pet" [label="pets/owner (O2M)" dir=both arrowhead=crow arrowtail=tee];
+}
+`,
+		},
+		{
+			format: DOT,
+			types:  []string{"Group", "User"},
+			out: `digraph ent {
+	graph [rankdir=LR];
+	node [shape=plaintext fontname="Helvetica"];
+	edge [fontname="Helvetica" fontsize=10];
+	"Group" [label=<<table border="0" cellborder="1" cellspacing="0" cellpadding="4"><tr><td colspan="3" bgcolor="lightgrey"><b>Group</b></td></tr><tr><td align="left"><u>id</u></td><td align="left">int</td><td align="left"></td></tr><tr><td align="left">created_at</td><td align="left">time.Time</td><td align="left">immutable</td></tr></table>>];
+	"User" [label=<<table border="0" cellborder="1" cellspacing="0" cellpadding="4"><tr><td colspan="3" bgcolor="lightgrey"><b>User</b></td></tr><tr><td align="left"><u>id</u></td><td align="left">int</td><td align="left"></td></tr><tr><td align="left">name</td><td align="left">string</td><td align="left">unique</td></tr><tr><td align="left">age</td><td align="left">int</td><td align="left">optional</td></tr><tr><td colspan="3" align="left"><i>index user_name_age (name, age)</i></td></tr></table>>];
+	"Group" -> "User" [label="admin (M2O)" dir=both arrowhead=tee arrowtail=crow];
+	"User" -> "Group" [label="groups/users (M2M)" dir=both arrowhead=crow arrowtail=crow];
+}
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			var b strings.Builder
+			err := Config{Writer: &b, Format: tt.format, Types: tt.types}.Print(g)
+			require.NoError(t, err)
+			require.Equal(t, tt.out, b.String())
+		})
+	}
+}
+
+func TestConfig_PrintHTML(t *testing.T) {
+	var b strings.Builder
+	require.NoError(t, Fprint(&b, graph(t), HTML))
+	out := b.String()
+	require.True(t, strings.HasPrefix(out, "<!DOCTYPE html>"))
+	require.Contains(t, out, `<section id="User">`)
+	require.Contains(t, out, `<tr><td>pets/owner</td><td><a href="#Pet">Pet</a></td><td>O2M</td></tr>`)
+	require.Contains(t, out, `<tr><td>created_at</td><td>time.Time</td><td>immutable</td></tr>`)
+	require.NotContains(t, out, "<script", "page must be self-contained")
+}
+
+func TestConfig_PrintError(t *testing.T) {
+	g := graph(t)
+	err := Config{Writer: &strings.Builder{}, Types: []string{"Unknown"}}.Print(g)
+	require.EqualError(t, err, `viz: type "Unknown" was not found in the graph`)
+	err = Config{Writer: &strings.Builder{}, Format: "svg"}.Print(g)
+	require.EqualError(t, err, `viz: unsupported format "svg"`)
+}
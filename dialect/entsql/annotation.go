@@ -4,7 +4,12 @@
 
 package entsql
 
-import "entgo.io/ent/schema"
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema"
+)
 
 // Annotation is a builtin schema annotation for attaching
 // SQL metadata to schema objects for both codegen and runtime.
@@ -61,6 +66,119 @@ type Annotation struct {
 	// By default, this value is nil defaulting to whatever best fits each scenario.
 	//
 	Incremental *bool `json:"incremental,omitempty"`
+
+	// RenamedFrom defines the previous name (storage-key) of the column, and
+	// is used by the migration for renaming the column instead of dropping it
+	// and adding a new one. For example:
+	//
+	//	entsql.Annotation{
+	//		RenamedFrom: "name",
+	//	}
+	//
+	// Note that the annotation can be removed after the migration was applied
+	// on all databases.
+	//
+	RenamedFrom string `json:"renamed_from,omitempty"`
+
+	// Checks defines the CHECK constraints of the table. Checks are matched by their
+	// names in migrations, and therefore, table checks must be named. Checks whose
+	// expression was changed are dropped and added back by the migration. When
+	// used on a field, the checks are added to the table of the field, and unnamed
+	// checks are named as "<table>_<column>_check". For example:
+	//
+	//	entsql.Annotation{
+	//		Checks: []*entsql.Check{
+	//			{Name: "age_check", Expr: "age > 0"},
+	//		},
+	//	}
+	//
+	Checks []*Check `json:"checks,omitempty"`
+
+	// View defines the schema as a read-only SQL view. The table of the
+	// schema is created as a view by the migration (after all tables
+	// were created), and no mutation builders are generated for it.
+	// For example:
+	//
+	//	entsql.Annotation{
+	//		View: &entsql.View{
+	//			Query: "SELECT `user_id` AS `id`, COUNT(*) AS `pets` FROM `pets` GROUP BY `user_id`",
+	//		},
+	//	}
+	//
+	View *View `json:"view,omitempty"`
+}
+
+// View defines the SELECT statement of a view. Queries allows
+// overriding the statement for specific dialects. For example:
+//
+//	&entsql.View{
+//		Query: "SELECT id, name FROM users WHERE active",
+//		Queries: map[string]string{
+//			dialect.MySQL: "SELECT `id`, `name` FROM `users` WHERE `active`",
+//		},
+//	}
+//
+type View struct {
+	Query   string            `json:"query,omitempty"`
+	Queries map[string]string `json:"queries,omitempty"`
+}
+
+// ViewFor returns a view definition for the given dialect, that
+// is built using the sql.Selector of the dialect. For example:
+//
+//	entsql.ViewFor(dialect.Postgres, func(s *sql.Selector) {
+//		t := sql.Table("pets")
+//		s.From(t).
+//			Select(sql.As(t.C("owner_id"), "id"), sql.As(sql.Count("*"), "pets")).
+//			GroupBy(t.C("owner_id"))
+//	})
+//
+// Note that the selector cannot contain query arguments, as view
+// definitions are not parameterized. Use sql.Expr for literal values.
+func ViewFor(dialect string, build func(*sql.Selector)) *View {
+	return (&View{}).For(dialect, build)
+}
+
+// For adds a view definition for the given dialect, that
+// is built using the sql.Selector of the dialect.
+func (v *View) For(dialect string, build func(*sql.Selector)) *View {
+	s := sql.Dialect(dialect).Select()
+	build(s)
+	query, args := s.Query()
+	if len(args) > 0 {
+		panic(fmt.Sprintf("entsql: view definition for %q cannot contain arguments: %v", dialect, args))
+	}
+	if v.Queries == nil {
+		v.Queries = make(map[string]string)
+	}
+	v.Queries[dialect] = query
+	return v
+}
+
+// QueryFor returns the definition of the view for the given dialect.
+func (v *View) QueryFor(dialect string) string {
+	if q, ok := v.Queries[dialect]; ok {
+		return q
+	}
+	return v.Query
+}
+
+// Check defines a CHECK constraint with its raw SQL expression.
+// Exprs allows overriding the expression for specific dialects.
+// For example:
+//
+//	&entsql.Check{
+//		Name: "name_check",
+//		Expr: "length(name) > 0",
+//		Exprs: map[string]string{
+//			dialect.MySQL: "char_length(`name`) > 0",
+//		},
+//	}
+//
+type Check struct {
+	Name  string            `json:"name,omitempty"`
+	Expr  string            `json:"expr,omitempty"`
+	Exprs map[string]string `json:"exprs,omitempty"`
 }
 
 // Name describes the annotation name.
@@ -99,6 +217,15 @@ func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 	if s := ant.Incremental; s != nil {
 		a.Incremental = s
 	}
+	if r := ant.RenamedFrom; r != "" {
+		a.RenamedFrom = r
+	}
+	if len(ant.Checks) > 0 {
+		a.Checks = append(a.Checks[:len(a.Checks):len(a.Checks)], ant.Checks...)
+	}
+	if v := ant.View; v != nil {
+		a.View = v
+	}
 	return a
 }
 
@@ -7,7 +7,10 @@ package gremlin
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+
+	"github.com/gorilla/websocket"
 )
 
 // RoundTripper is an interface representing the ability to execute a
@@ -51,6 +54,43 @@ func NewHTTPClient(url string, client *http.Client) (*Client, error) {
 	return &Client{transport}, nil
 }
 
+// NewWebSocketClient creates a websocket based gremlin client that multiplexes
+// requests over a pool of size connections. A nil dialer defaults to DefaultDialer,
+// and a non-positive size defaults to DefaultPoolSize.
+func NewWebSocketClient(url string, dialer *websocket.Dialer, size int) (*Client, error) {
+	transport, err := newWSTransport(url, dialer, size)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{transport}, nil
+}
+
+// Close closes the underlying connections of the client transport.
+// It is a nop for transports that are not connection based.
+func (c Client) Close() error {
+	if closer, ok := c.Transport.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// intercept wraps the client transport with the given interceptor,
+// and keeps its underlying connections closable by the Close method.
+func (c *Client) intercept(interceptor Interceptor) {
+	closer, ok := c.Transport.(io.Closer)
+	c.Transport = interceptor(c.Transport)
+	if _, wrapped := c.Transport.(io.Closer); ok && !wrapped {
+		c.Transport = &closableTransport{c.Transport, closer}
+	}
+}
+
+// closableTransport is a RoundTripper that was wrapped by an interceptor,
+// and holds the Closer of its underlying transport.
+type closableTransport struct {
+	RoundTripper
+	io.Closer
+}
+
 // Do sends a gremlin request and returns a gremlin response.
 func (c Client) Do(ctx context.Context, req *Request) (*Response, error) {
 	rsp, err := c.Transport.RoundTrip(ctx, req)
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+
+	"github.com/gorilla/websocket"
 )
 
 type (
@@ -23,6 +25,8 @@ type (
 	options struct {
 		interceptors []Interceptor
 		httpClient   *http.Client
+		dialer       *websocket.Dialer
+		poolSize     int
 	}
 
 	// Endpoint wraps a url to add flag unmarshaling.
@@ -45,12 +49,28 @@ func WithHTTPClient(client *http.Client) Option {
 	}
 }
 
+// WithDialer assigns underlying websocket dialer to be used by websocket transport.
+func WithDialer(dialer *websocket.Dialer) Option {
+	return func(opts *options) {
+		opts.dialer = dialer
+	}
+}
+
+// WithPoolSize sets the number of connections opened by websocket transport.
+func WithPoolSize(size int) Option {
+	return func(opts *options) {
+		opts.poolSize = size
+	}
+}
+
 // Build constructs a client from Config.
 func (cfg Config) Build(opt ...Option) (c *Client, err error) {
 	opts := cfg.buildOptions(opt)
 	switch cfg.Endpoint.Scheme {
 	case "http", "https":
 		c, err = NewHTTPClient(cfg.Endpoint.String(), opts.httpClient)
+	case "ws", "wss":
+		c, err = NewWebSocketClient(cfg.Endpoint.String(), opts.dialer, opts.poolSize)
 	default:
 		err = fmt.Errorf("unsupported endpoint scheme: %s", cfg.Endpoint.Scheme)
 	}
@@ -59,10 +79,10 @@ func (cfg Config) Build(opt ...Option) (c *Client, err error) {
 	}
 
 	for i := len(opts.interceptors) - 1; i >= 0; i-- {
-		c.Transport = opts.interceptors[i](c.Transport)
+		c.intercept(opts.interceptors[i])
 	}
 	if !cfg.DisableExpansion {
-		c.Transport = ExpandBindings(c.Transport)
+		c.intercept(ExpandBindings)
 	}
 	return c, nil
 }
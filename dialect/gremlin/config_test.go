@@ -52,6 +52,18 @@ func TestConfigBuild(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "WebSocket",
+			cfg: Config{
+				Endpoint: Endpoint{
+					URL: func() *url.URL {
+						u, _ := url.Parse("ws://gremlin-server/gremlin")
+						return u
+					}(),
+				},
+			},
+			opts: []Option{WithDialer(DefaultDialer), WithPoolSize(2)},
+		},
 		{
 			name: "NoScheme",
 			cfg: Config{
@@ -10,6 +10,8 @@ import (
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
+
+	"github.com/google/uuid"
 )
 
 // Driver is a dialect.Driver implementation for TinkerPop gremlin.
@@ -19,7 +21,7 @@ type Driver struct {
 
 // NewDriver returns a new dialect.Driver implementation for gremlin.
 func NewDriver(c *Client) *Driver {
-	c.Transport = ExpandBindings(c.Transport)
+	c.intercept(ExpandBindings)
 	return &Driver{c}
 }
 
@@ -28,6 +30,17 @@ func (Driver) Dialect() string { return dialect.Gremlin }
 
 // Exec implements the dialect.Exec method.
 func (c *Driver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	return exec(ctx, c.Client, query, args, v)
+}
+
+// Query implements the dialect.Query method.
+func (c *Driver) Query(ctx context.Context, query string, args, v interface{}) error {
+	return c.Exec(ctx, query, args, v)
+}
+
+// exec executes the given query with its bindings, and
+// stores the response in v. Options are applied on the request.
+func exec(ctx context.Context, c *Client, query string, args, v interface{}, opts ...RequestOption) error {
 	vr, ok := v.(*Response)
 	if !ok {
 		return fmt.Errorf("dialect/gremlin: invalid type %T. expect *gremlin.Response", v)
@@ -36,7 +49,7 @@ func (c *Driver) Exec(ctx context.Context, query string, args, v interface{}) er
 	if !ok {
 		return fmt.Errorf("dialect/gremlin: invalid type %T. expect map[string]interface{} for bindings", args)
 	}
-	res, err := c.Do(ctx, NewEvalRequest(query, WithBindings(bindings)))
+	res, err := c.Do(ctx, NewEvalRequest(query, append([]RequestOption{WithBindings(bindings)}, opts...)...))
 	if err != nil {
 		return err
 	}
@@ -44,15 +57,66 @@ func (c *Driver) Exec(ctx context.Context, query string, args, v interface{}) er
 	return nil
 }
 
+// Close closes the underlying connections in case of WS client.
+func (c *Driver) Close() error { return c.Client.Close() }
+
+// Tx starts a transaction in a new server session. All queries of the transaction are
+// executed in the session, and the transaction is committed or rolled back using the
+// "g.tx()" API. An error is returned if the transport (e.g. HTTP) or the graph do not
+// support sessions or transactions.
+func (c *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx := &Tx{client: c.Client, session: uuid.New().String()}
+	// Fail fast if the server does not support transactions, instead of
+	// executing the queries of the transaction without atomicity.
+	if err := tx.eval(ctx, "g.tx().isOpen()"); err != nil {
+		tx.close()
+		return nil, fmt.Errorf("dialect/gremlin: transactions are not supported by the server: %w", err)
+	}
+	return tx, nil
+}
+
+// Tx is a dialect.Tx implementation for gremlin sessions.
+type Tx struct {
+	client  *Client
+	session string
+}
+
+// Exec implements the dialect.Exec method.
+func (t *Tx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	return exec(ctx, t.client, query, args, v, WithSession(t.session))
+}
+
 // Query implements the dialect.Query method.
-func (c *Driver) Query(ctx context.Context, query string, args, v interface{}) error {
-	return c.Exec(ctx, query, args, v)
+func (t *Tx) Query(ctx context.Context, query string, args, v interface{}) error {
+	return t.Exec(ctx, query, args, v)
 }
 
-// Close is a nop close call. It should close the connection in case of WS client.
-func (Driver) Close() error { return nil }
+// Commit commits the transaction and closes its session.
+func (t *Tx) Commit() error {
+	defer t.close()
+	return t.eval(context.Background(), "g.tx().commit()")
+}
 
-// Tx returns a nop transaction.
-func (c *Driver) Tx(context.Context) (dialect.Tx, error) { return dialect.NopTx(c), nil }
+// Rollback rolls back the transaction and closes its session.
+func (t *Tx) Rollback() error {
+	defer t.close()
+	return t.eval(context.Background(), "g.tx().rollback()")
+}
+
+// eval evaluates the given script in the transaction session.
+func (t *Tx) eval(ctx context.Context, query string) error {
+	_, err := t.client.Do(ctx, NewEvalRequest(query, WithSession(t.session)))
+	return err
+}
 
-var _ dialect.Driver = (*Driver)(nil)
+// close closes the transaction session. Errors are ignored, as servers also
+// close sessions when their connection is closed, and some of them do not
+// support closing sessions explicitly.
+func (t *Tx) close() {
+	_, _ = t.client.Do(context.Background(), NewCloseSessionRequest(t.session))
+}
+
+var (
+	_ dialect.Driver = (*Driver)(nil)
+	_ dialect.Tx     = (*Tx)(nil)
+)
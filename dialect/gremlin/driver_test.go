@@ -0,0 +1,73 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gremlin
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"entgo.io/ent/dialect/gremlin/graph/dsl"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriverTx(t *testing.T) {
+	var reqs []*Request
+	drv := NewDriver(&Client{RoundTripperFunc(func(_ context.Context, req *Request) (*Response, error) {
+		reqs = append(reqs, req)
+		rsp := &Response{RequestID: req.RequestID}
+		rsp.Status.Code = StatusSuccess
+		return rsp, nil
+	})})
+	ctx := context.Background()
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.Len(t, reqs, 1)
+	session := reqs[0].Arguments[ArgsSession]
+	require.NotEmpty(t, session)
+	assert.Equal(t, ProcessorSession, reqs[0].Processor)
+	assert.Equal(t, "g.tx().isOpen()", reqs[0].Arguments[ArgsGremlin])
+
+	var rsp Response
+	require.NoError(t, tx.Exec(ctx, "g.V().hasLabel($0)", dsl.Bindings{"$0": "user"}, &rsp))
+	require.Len(t, reqs, 2)
+	assert.Equal(t, session, reqs[1].Arguments[ArgsSession])
+	assert.Equal(t, `g.V().hasLabel("user")`, reqs[1].Arguments[ArgsGremlin], "bindings are expanded")
+
+	require.NoError(t, tx.Commit())
+	require.Len(t, reqs, 4)
+	assert.Equal(t, "g.tx().commit()", reqs[2].Arguments[ArgsGremlin])
+	assert.Equal(t, session, reqs[2].Arguments[ArgsSession])
+	assert.Equal(t, OpsClose, reqs[3].Operation)
+	assert.Equal(t, session, reqs[3].Arguments[ArgsSession])
+
+	tx, err = drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, session, reqs[4].Arguments[ArgsSession], "new session for each transaction")
+	require.NoError(t, tx.Rollback())
+	assert.Equal(t, "g.tx().rollback()", reqs[5].Arguments[ArgsGremlin])
+}
+
+func TestDriverTxNotSupported(t *testing.T) {
+	drv := NewDriver(&Client{RoundTripperFunc(func(_ context.Context, req *Request) (*Response, error) {
+		rsp := &Response{RequestID: req.RequestID}
+		rsp.Status.Code = StatusScriptEvaluationError
+		rsp.Status.Message = "Graph does not support transactions"
+		return rsp, nil
+	})})
+	_, err := drv.Tx(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "transactions are not supported by the server")
+
+	u, err := url.Parse("http://gremlin-server/gremlin")
+	require.NoError(t, err)
+	c, err := NewClient(Config{Endpoint: Endpoint{u}})
+	require.NoError(t, err)
+	_, err = NewDriver(c).Tx(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "sessions are not supported")
+}
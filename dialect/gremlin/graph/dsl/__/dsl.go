@@ -63,6 +63,9 @@ func Properties(args ...interface{}) *dsl.Traversal { return New().Properties(ar
 // OtherV is the api for calling __.OtherV().
 func OtherV() *dsl.Traversal { return New().OtherV() }
 
+// ID is the api for calling __.ID().
+func ID() *dsl.Traversal { return New().ID() }
+
 // Count is the api for calling __.Count().
 func Count() *dsl.Traversal { return New().Count() }
 
@@ -188,6 +188,11 @@ func TestTraverse(t *testing.T) {
 			wantQuery: "g.V().order().by($0, incr)",
 			wantBinds: dsl.Bindings{"$0": "name"},
 		},
+		{
+			input:     g.V().Order().By("name", dsl.Decr).By(__.ID(), dsl.Decr),
+			wantQuery: "g.V().order().by($0, decr).by(__.id(), decr)",
+			wantBinds: dsl.Bindings{"$0": "name"},
+		},
 		{
 			input:     g.V().Order().By("name", dsl.Incr).Undo(),
 			wantQuery: "g.V().order()",
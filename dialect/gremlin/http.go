@@ -39,6 +39,9 @@ func (t *httpTransport) RoundTrip(ctx context.Context, req *Request) (*Response,
 	if req.Operation != OpsEval {
 		return nil, errors.Errorf("gremlin/http: unsupported operation: %q", req.Operation)
 	}
+	if req.Processor == ProcessorSession {
+		return nil, errors.New("gremlin/http: sessions are not supported")
+	}
 	if _, ok := req.Arguments[ArgsGremlin]; !ok {
 		return nil, errors.New("gremlin/http: missing query expression")
 	}
@@ -60,6 +60,18 @@ func NewAuthRequest(requestID, username, password string) *Request {
 	}
 }
 
+// NewCloseSessionRequest returns a new request for closing the given session.
+func NewCloseSessionRequest(session string) *Request {
+	return &Request{
+		RequestID: uuid.New().String(),
+		Operation: OpsClose,
+		Processor: ProcessorSession,
+		Arguments: map[string]interface{}{
+			ArgsSession: session,
+		},
+	}
+}
+
 // WithBindings sets request bindings.
 func WithBindings(bindings map[string]interface{}) RequestOption {
 	return func(r *Request) {
@@ -67,6 +79,15 @@ func WithBindings(bindings map[string]interface{}) RequestOption {
 	}
 }
 
+// WithSession sets the session in which the request is executed. Requests of a session
+// are executed by the server in the same context (e.g. variables and transaction).
+func WithSession(session string) RequestOption {
+	return func(r *Request) {
+		r.Processor = ProcessorSession
+		r.Arguments[ArgsSession] = session
+	}
+}
+
 // WithEvalTimeout sets script evaluation timeout.
 func WithEvalTimeout(timeout time.Duration) RequestOption {
 	return func(r *Request) {
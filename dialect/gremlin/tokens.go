@@ -22,6 +22,7 @@ const (
 	OpsKeys = "keys"
 
 	// OpsClose used to get all the keys of all side-effects as produced by a previously executed Traversal.
+	// When used with the session processor, it closes the session and releases its resources.
 	OpsClose = "close"
 )
 
@@ -29,6 +30,9 @@ const (
 const (
 	// ProcessorTraversal is the default operation processor.
 	ProcessorTraversal = "traversal"
+
+	// ProcessorSession is the operation processor of requests that are executed in a session.
+	ProcessorSession = "session"
 )
 
 const (
@@ -66,4 +70,7 @@ const (
 
 	// ArgsSaslMechanism defines the SASL mechanism (e.g. PLAIN).
 	ArgsSaslMechanism = "saslMechanism"
+
+	// ArgsSession defines the unique identifier of the session in which the request is executed.
+	ArgsSession = "session"
 )
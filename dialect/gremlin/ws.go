@@ -2,17 +2,19 @@
 // This source code is licensed under the Apache 2.0 license found
 // in the LICENSE file in the root directory of this source tree.
 
-package ws
+package gremlin
 
 import (
 	"bytes"
 	"context"
+	"hash/fnv"
 	"io"
 	"net/http"
+	"net/url"
 	"sync"
+	"sync/atomic"
 	"time"
 
-	"entgo.io/ent/dialect/gremlin"
 	"entgo.io/ent/dialect/gremlin/encoding"
 	"entgo.io/ent/dialect/gremlin/encoding/graphson"
 
@@ -30,20 +32,36 @@ const (
 
 	// Send pings to peer with this period. Must be less than pongWait.
 	pingPeriod = (pongWait * 9) / 10
+
+	// DefaultPoolSize is the default number of connections
+	// that are opened by the websocket transport.
+	DefaultPoolSize = 4
 )
 
 type (
-	// A Dialer contains options for connecting to Gremlin server.
-	Dialer struct {
-		// Underlying websocket dialer.
-		websocket.Dialer
+	// wsTransport executes requests over a pool of multiplexed
+	// websocket connections to a Gremlin server.
+	wsTransport struct {
+		dialer *websocket.Dialer
+		url    string
 
-		// Gremlin server basic auth credentials.
+		// Credentials for basic authentication.
 		user, pass string
+
+		// Connection slots, picked in a round-robin order.
+		next  uint32
+		slots []wsSlot
+	}
+
+	// wsSlot holds a lazily dialed pool connection.
+	wsSlot struct {
+		mu     sync.Mutex
+		conn   *wsConn
+		closed bool
 	}
 
-	// Conn performs operations on a gremlin server.
-	Conn struct {
+	// wsConn performs operations on a gremlin server.
+	wsConn struct {
 		// Underlying websocket connection.
 		conn *websocket.Conn
 
@@ -72,48 +90,129 @@ type (
 
 	// represents an execution result.
 	result struct {
-		rsp *gremlin.Response
+		rsp *Response
 		err error
 	}
 )
 
 var (
-	// DefaultDialer is a dialer with all fields set to the default values.
-	DefaultDialer = &Dialer{
-		Dialer: websocket.Dialer{
-			Proxy:            http.ProxyFromEnvironment,
-			HandshakeTimeout: 5 * time.Second,
-			WriteBufferSize:  8192,
-			ReadBufferSize:   8192,
-		},
+	// DefaultDialer is a websocket dialer with all fields set to the default values.
+	DefaultDialer = &websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: 5 * time.Second,
+		WriteBufferSize:  8192,
+		ReadBufferSize:   8192,
 	}
 
-	// ErrConnClosed is returned by the Conn's Execute method when
+	// ErrConnClosed is returned by the websocket transport when
 	// the underlying gremlin server connection is closed.
 	ErrConnClosed = errors.New("gremlin: server connection closed")
 
-	// ErrDuplicateRequest is returned by the Conns Execute method on
-	// request identifier key collision.
+	// ErrDuplicateRequest is returned by the websocket transport
+	// on request identifier key collision.
 	ErrDuplicateRequest = errors.New("gremlin: duplicate request")
 )
 
-// Dial creates a new connection by calling DialContext with a background context.
-func (d *Dialer) Dial(uri string) (*Conn, error) {
-	return d.DialContext(context.Background(), uri)
+// NewWebSocketTransport returns a new websocket transport that multiplexes requests over a pool of
+// size connections. Connections are dialed on first use, and redialed after they were closed (e.g.
+// by the server). The user info of the url is used to answer the server authentication challenges.
+// The returned transport implements the io.Closer interface for closing its connections.
+func NewWebSocketTransport(urlStr string, dialer *websocket.Dialer, size int) (RoundTripper, error) {
+	return newWSTransport(urlStr, dialer, size)
 }
 
-// DialContext creates a new Gremlin connection.
-func (d *Dialer) DialContext(ctx context.Context, uri string) (*Conn, error) {
-	c, rsp, err := d.Dialer.DialContext(ctx, uri, nil)
+func newWSTransport(urlStr string, dialer *websocket.Dialer, size int) (*wsTransport, error) {
+	u, err := url.Parse(urlStr)
+	if err != nil {
+		return nil, errors.Wrap(err, "gremlin/ws: parsing url")
+	}
+	if u.Scheme != "ws" && u.Scheme != "wss" {
+		return nil, errors.Errorf("gremlin/ws: unsupported url scheme: %q", u.Scheme)
+	}
+	if dialer == nil {
+		dialer = DefaultDialer
+	}
+	if size <= 0 {
+		size = DefaultPoolSize
+	}
+	t := &wsTransport{dialer: dialer, slots: make([]wsSlot, size)}
+	if u.User != nil {
+		t.user = u.User.Username()
+		t.pass, _ = u.User.Password()
+		u.User = nil
+	}
+	t.url = u.String()
+	return t, nil
+}
+
+// RoundTrip implements RouterTripper interface.
+func (t *wsTransport) RoundTrip(ctx context.Context, req *Request) (*Response, error) {
+	idx := atomic.AddUint32(&t.next, 1)
+	// Requests of a session are pinned to the same connection.
+	if session, ok := req.Arguments[ArgsSession].(string); ok && req.Processor == ProcessorSession {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(session))
+		idx = h.Sum32()
+	}
+	c, err := t.conn(ctx, idx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Execute(ctx, req)
+}
+
+// conn returns the connection of the given pool index, and
+// dials it in case it was not opened yet, or it was closed.
+func (t *wsTransport) conn(ctx context.Context, idx uint32) (*wsConn, error) {
+	s := &t.slots[idx%uint32(len(t.slots))]
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil, ErrConnClosed
+	}
+	if s.conn != nil {
+		if s.conn.ctx.Err() == nil {
+			return s.conn, nil
+		}
+		// Release the resources of the broken connection before redialing.
+		_ = s.conn.Close()
+		s.conn = nil
+	}
+	c, err := dialWS(ctx, t.dialer, t.url, t.user, t.pass)
+	if err != nil {
+		return nil, err
+	}
+	s.conn = c
+	return c, nil
+}
+
+// Close closes all connections of the transport.
+func (t *wsTransport) Close() error {
+	for i := range t.slots {
+		s := &t.slots[i]
+		s.mu.Lock()
+		if s.conn != nil {
+			_ = s.conn.Close()
+			s.conn = nil
+		}
+		s.closed = true
+		s.mu.Unlock()
+	}
+	return nil
+}
+
+// dialWS creates a new Gremlin connection.
+func dialWS(ctx context.Context, dialer *websocket.Dialer, uri, user, pass string) (*wsConn, error) {
+	c, rsp, err := dialer.DialContext(ctx, uri, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "gremlin: dialing uri %s", uri)
 	}
 	defer rsp.Body.Close()
 
-	conn := &Conn{
+	conn := &wsConn{
 		conn: c,
-		user: d.user,
-		pass: d.pass,
+		user: user,
+		pass: pass,
 		send: make(chan io.Reader),
 	}
 	conn.grp, conn.ctx = errgroup.WithContext(context.Background())
@@ -125,7 +224,13 @@ func (d *Dialer) DialContext(ctx context.Context, uri string) (*Conn, error) {
 }
 
 // Execute executes a request against a Gremlin server.
-func (c *Conn) Execute(ctx context.Context, req *gremlin.Request) (*gremlin.Response, error) {
+func (c *wsConn) Execute(ctx context.Context, req *Request) (*Response, error) {
+	// encoding errors should not terminate the connection
+	var buf bytes.Buffer
+	if err := graphson.NewEncoder(&buf).Encode(req); err != nil {
+		return nil, errors.Wrap(err, "encoding request")
+	}
+
 	// buffered result channel prevents receiver block on context cancellation
 	result := make(chan result, 1)
 
@@ -134,19 +239,6 @@ func (c *Conn) Execute(ctx context.Context, req *gremlin.Request) (*gremlin.Resp
 		return nil, ErrDuplicateRequest
 	}
 
-	pr, pw := io.Pipe()
-	defer pr.Close()
-
-	// stream graphson encoding into request
-	c.grp.Go(func() error {
-		err := graphson.NewEncoder(pw).Encode(req)
-		if err != nil {
-			err = errors.Wrap(err, "encoding request")
-		}
-		pw.CloseWithError(err)
-		return err
-	})
-
 	// local copy for single write
 	send := c.send
 
@@ -158,7 +250,7 @@ func (c *Conn) Execute(ctx context.Context, req *gremlin.Request) (*gremlin.Resp
 		case <-ctx.Done():
 			c.inflight.Delete(req.RequestID)
 			return nil, ctx.Err()
-		case send <- pr:
+		case send <- &buf:
 			send = nil
 		case result := <-result:
 			return result.rsp, result.err
@@ -167,13 +259,13 @@ func (c *Conn) Execute(ctx context.Context, req *gremlin.Request) (*gremlin.Resp
 }
 
 // Close connection with a Gremlin server.
-func (c *Conn) Close() error {
+func (c *wsConn) Close() error {
 	c.grp.Go(func() error { return ErrConnClosed })
 	_ = c.grp.Wait()
 	return nil
 }
 
-func (c *Conn) sender() error {
+func (c *wsConn) sender() error {
 	pinger := time.NewTicker(pingPeriod)
 	defer pinger.Stop()
 
@@ -184,7 +276,7 @@ func (c *Conn) sender() error {
 		select {
 		case r := <-c.send:
 			// ensure write completes within a window
-			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
 
 			// fetch next message writer
 			w, err := c.conn.NextWriter(websocket.BinaryMessage)
@@ -222,9 +314,9 @@ func (c *Conn) sender() error {
 	}
 }
 
-func (c *Conn) receiver() error {
+func (c *wsConn) receiver() error {
 	// handle keepalive responses
-	c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	_ = c.conn.SetReadDeadline(time.Now().Add(pongWait))
 	c.conn.SetPongHandler(func(string) error {
 		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
 	})
@@ -244,7 +336,7 @@ func (c *Conn) receiver() error {
 		}
 
 		// decode received response
-		var rsp gremlin.Response
+		var rsp Response
 		if err := graphson.NewDecoder(r).Decode(&rsp); err != nil {
 			return errors.Wrap(err, "reading response")
 		}
@@ -263,17 +355,17 @@ func (c *Conn) receiver() error {
 	}
 }
 
-func (c *Conn) receive(ifr *inflight, rsp *gremlin.Response) bool {
+func (c *wsConn) receive(ifr *inflight, rsp *Response) bool {
 	result := result{rsp: rsp}
 	switch rsp.Status.Code {
-	case gremlin.StatusSuccess:
+	case StatusSuccess:
 		// quickly handle non fragmented responses
 		if ifr.frags == nil {
 			break
 		}
 		// handle fragment
 		fallthrough
-	case gremlin.StatusPartialContent:
+	case StatusPartialContent:
 		// append received fragment
 		var frag []graphson.RawMessage
 		if err := graphson.Unmarshal(rsp.Result.Data, &frag); err != nil {
@@ -283,7 +375,7 @@ func (c *Conn) receive(ifr *inflight, rsp *gremlin.Response) bool {
 		ifr.frags = append(ifr.frags, frag...)
 
 		// partial response requires additional fragments
-		if rsp.Status.Code == gremlin.StatusPartialContent {
+		if rsp.Status.Code == StatusPartialContent {
 			return false
 		}
 
@@ -291,12 +383,12 @@ func (c *Conn) receive(ifr *inflight, rsp *gremlin.Response) bool {
 		if rsp.Result.Data, result.err = graphson.Marshal(ifr.frags); result.err != nil {
 			result.err = errors.Wrap(result.err, "assembling fragmented response")
 		}
-	case gremlin.StatusAuthenticate:
+	case StatusAuthenticate:
 		// receiver should never block
 		c.grp.Go(func() error {
 			var buf bytes.Buffer
 			if err := graphson.NewEncoder(&buf).Encode(
-				gremlin.NewAuthRequest(rsp.RequestID, c.user, c.pass),
+				NewAuthRequest(rsp.RequestID, c.user, c.pass),
 			); err != nil {
 				return errors.Wrap(err, "encoding auth request")
 			}
@@ -0,0 +1,505 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gremlin
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"entgo.io/ent/dialect/gremlin/encoding/graphson"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type wsPeer struct{ *websocket.Conn }
+
+func (c wsPeer) ReadRequest() (*Request, error) {
+	_, data, err := c.ReadMessage()
+	if err != nil {
+		return nil, err
+	}
+	var req Request
+	if err := graphson.Unmarshal(data[data[0]+1:], &req); err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
+func (c wsPeer) WriteResponse(rsp *Response) error {
+	data, err := graphson.Marshal(rsp)
+	if err != nil {
+		return err
+	}
+	return c.WriteMessage(websocket.BinaryMessage, data)
+}
+
+func serveWS(handler func(wsPeer)) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{ReadBufferSize: 1024, WriteBufferSize: 1024}
+		c, _ := upgrader.Upgrade(w, r, nil)
+		defer c.Close()
+		handler(wsPeer{c})
+		for {
+			_, _, err := c.ReadMessage()
+			if err != nil {
+				break
+			}
+		}
+	}))
+}
+
+func TestWebSocketConnectClosure(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	defer wg.Wait()
+
+	srv := serveWS(func(conn wsPeer) {
+		defer wg.Done()
+		_, _, err := conn.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure))
+	})
+	defer srv.Close()
+
+	conn, err := dialWS(context.Background(), DefaultDialer, "ws://"+srv.Listener.Addr().String(), "", "")
+	require.NoError(t, err)
+
+	err = conn.Close()
+	assert.NoError(t, err)
+
+	_, err = conn.Execute(context.Background(), NewEvalRequest("g.V()"))
+	assert.EqualError(t, err, ErrConnClosed.Error())
+}
+
+func TestWebSocketSimpleQuery(t *testing.T) {
+	srv := serveWS(func(conn wsPeer) {
+		typ, data, err := conn.ReadMessage()
+		require.NoError(t, err)
+		assert.Equal(t, websocket.BinaryMessage, typ)
+
+		var req Request
+		err = graphson.Unmarshal(data[data[0]+1:], &req)
+		require.NoError(t, err)
+		assert.Equal(t, "g.V()", req.Arguments["gremlin"])
+
+		rsp := Response{RequestID: req.RequestID}
+		rsp.Status.Code = StatusNoContent
+		err = conn.WriteResponse(&rsp)
+		require.NoError(t, err)
+	})
+	defer srv.Close()
+
+	conn, err := dialWS(context.Background(), DefaultDialer, "ws://"+srv.Listener.Addr().String(), "", "")
+	require.NoError(t, err)
+	defer assert.Condition(t, func() bool { return assert.NoError(t, conn.Close()) })
+
+	rsp, err := conn.Execute(context.Background(), NewEvalRequest("g.V()"))
+	assert.NoError(t, err)
+	require.NotNil(t, rsp)
+	assert.Equal(t, StatusNoContent, rsp.Status.Code)
+}
+
+func TestWebSocketDuplicateRequest(t *testing.T) {
+	// skip until flakiness will be fixed.
+	t.SkipNow()
+	srv := serveWS(func(conn wsPeer) {
+		req, err := conn.ReadRequest()
+		require.NoError(t, err)
+
+		rsp := Response{RequestID: req.RequestID}
+		rsp.Status.Code = StatusNoContent
+		err = conn.WriteResponse(&rsp)
+		require.NoError(t, err)
+	})
+	defer srv.Close()
+
+	conn, err := dialWS(context.Background(), DefaultDialer, "ws://"+srv.Listener.Addr().String(), "", "")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	var errors [2]error
+	req := NewEvalRequest("g.V()")
+
+	var wg sync.WaitGroup
+	wg.Add(len(errors))
+
+	for i := range errors {
+		go func(i int) {
+			_, errors[i] = conn.Execute(context.Background(), req)
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+
+	err = errors[0]
+	if err == nil {
+		err = errors[1]
+	}
+	assert.EqualError(t, err, ErrDuplicateRequest.Error())
+}
+
+func TestWebSocketConnectCancellation(t *testing.T) {
+	srv := serveWS(func(wsPeer) {})
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	conn, err := dialWS(ctx, DefaultDialer, "ws://"+srv.Listener.Addr().String(), "", "")
+	assert.Error(t, err)
+	assert.Nil(t, conn)
+}
+
+func TestWebSocketQueryCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	srv := serveWS(func(conn wsPeer) {
+		if _, _, err := conn.ReadMessage(); err == nil {
+			cancel()
+		}
+	})
+	defer srv.Close()
+
+	conn, err := dialWS(context.Background(), DefaultDialer, "ws://"+srv.Listener.Addr().String(), "", "")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Execute(ctx, NewEvalRequest("g.E()"))
+	assert.EqualError(t, err, context.Canceled.Error())
+}
+
+func TestWebSocketBadResponse(t *testing.T) {
+	tests := []struct {
+		name   string
+		mangle func(*Response) *Response
+	}{
+		{
+			name: "NoStatus",
+			mangle: func(rsp *Response) *Response {
+				return rsp
+			},
+		},
+		{
+			name: "Malformed",
+			mangle: func(rsp *Response) *Response {
+				rsp.Status.Code = StatusMalformedRequest
+				rsp.Status.Message = "bad request"
+				return rsp
+			},
+		},
+		{
+			name: "Unknown",
+			mangle: func(rsp *Response) *Response {
+				rsp.Status.Code = 424242
+				return rsp
+			},
+		},
+	}
+
+	srv := serveWS(func(conn wsPeer) {
+		for {
+			req, err := conn.ReadRequest()
+			if err != nil {
+				break
+			}
+
+			idx, err := strconv.ParseInt(req.Arguments["gremlin"].(string), 10, 0)
+			require.NoError(t, err)
+
+			err = conn.WriteResponse(tests[idx].mangle(&Response{RequestID: req.RequestID}))
+			require.NoError(t, err)
+		}
+	})
+	defer srv.Close()
+
+	conn, err := dialWS(context.Background(), DefaultDialer, "ws://"+srv.Listener.Addr().String(), "", "")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	var wg sync.WaitGroup
+	wg.Add(len(tests))
+
+	ctx := context.Background()
+	for i, tc := range tests {
+		i, tc := i, tc
+		t.Run(tc.name, func(t *testing.T) {
+			defer wg.Done()
+			rsp, err := conn.Execute(ctx, NewEvalRequest(strconv.FormatInt(int64(i), 10)))
+			assert.NoError(t, err)
+			assert.True(t, rsp.IsErr())
+		})
+	}
+	wg.Wait()
+}
+
+func TestWebSocketServerHangup(t *testing.T) {
+	// skip until flakiness will be fixed.
+	t.SkipNow()
+	srv := serveWS(func(conn wsPeer) { _ = conn.Close() })
+	defer srv.Close()
+
+	conn, err := dialWS(context.Background(), DefaultDialer, "ws://"+srv.Listener.Addr().String(), "", "")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Execute(context.Background(), NewEvalRequest("g.V()"))
+	assert.EqualError(t, err, ErrConnClosed.Error())
+	assert.Error(t, conn.ctx.Err())
+}
+
+func TestWebSocketCanceledLongRequest(t *testing.T) {
+	// skip until flakiness will be fixed.
+	t.SkipNow()
+	ctx, cancel := context.WithCancel(context.Background())
+	srv := serveWS(func(conn wsPeer) {
+		var responses [3]*Response
+		for i := 0; i < len(responses); i++ {
+			req, err := conn.ReadRequest()
+			require.NoError(t, err)
+
+			rsp := Response{RequestID: req.RequestID}
+			rsp.Status.Code = StatusSuccess
+			rsp.Result.Data = graphson.RawMessage(`"ok"`)
+			responses[i] = &rsp
+		}
+
+		cancel()
+
+		responses[0], responses[2] = responses[2], responses[0]
+		for i := 0; i < len(responses); i++ {
+			err := conn.WriteResponse(responses[i])
+			require.NoError(t, err)
+		}
+	})
+	defer srv.Close()
+
+	conn, err := dialWS(context.Background(), DefaultDialer, "ws://"+srv.Listener.Addr().String(), "", "")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	var wg sync.WaitGroup
+	wg.Add(3)
+	defer wg.Wait()
+
+	for i := 0; i < 3; i++ {
+		go func(ctx context.Context, idx int) {
+			defer wg.Done()
+			rsp, err := conn.Execute(ctx, NewEvalRequest("g.V()"))
+			if idx > 0 {
+				assert.NoError(t, err)
+				assert.EqualValues(t, []byte(`"ok"`), rsp.Result.Data)
+			} else {
+				assert.EqualError(t, err, context.Canceled.Error())
+			}
+		}(ctx, i)
+		ctx = context.Background()
+	}
+}
+
+func TestWebSocketPartialResponse(t *testing.T) {
+	type kv struct {
+		Key   string
+		Value int
+	}
+	kvs := []kv{
+		{"one", 1},
+		{"two", 2},
+		{"three", 3},
+	}
+	srv := serveWS(func(conn wsPeer) {
+		req, err := conn.ReadRequest()
+		require.NoError(t, err)
+
+		for i := range kvs {
+			data, err := graphson.Marshal([]kv{kvs[i]})
+			require.NoError(t, err)
+
+			rsp := Response{RequestID: req.RequestID}
+			rsp.Result.Data = graphson.RawMessage(data)
+
+			if i != len(kvs)-1 {
+				rsp.Status.Code = StatusPartialContent
+			} else {
+				rsp.Status.Code = StatusSuccess
+			}
+
+			err = conn.WriteResponse(&rsp)
+			require.NoError(t, err)
+		}
+	})
+	defer srv.Close()
+
+	conn, err := dialWS(context.Background(), DefaultDialer, "ws://"+srv.Listener.Addr().String(), "", "")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	rsp, err := conn.Execute(context.Background(), NewEvalRequest("g.E()"))
+	assert.NoError(t, err)
+
+	var result []kv
+	err = graphson.Unmarshal(rsp.Result.Data, &result)
+	require.NoError(t, err)
+	assert.Equal(t, kvs, result)
+}
+
+func TestWebSocketAuthentication(t *testing.T) {
+	user, pass := "username", "password"
+	srv := serveWS(func(conn wsPeer) {
+		req, err := conn.ReadRequest()
+		require.NoError(t, err)
+
+		rsp := Response{RequestID: req.RequestID}
+		rsp.Status.Code = StatusAuthenticate
+		err = conn.WriteResponse(&rsp)
+		require.NoError(t, err)
+
+		areq, err := conn.ReadRequest()
+		require.NoError(t, err)
+
+		var acreds Credentials
+		err = acreds.UnmarshalText([]byte(areq.Arguments["sasl"].(string)))
+		assert.NoError(t, err)
+		areq.Arguments["sasl"] = acreds
+		assert.Equal(t, NewAuthRequest(req.RequestID, user, pass), areq)
+
+		rsp = Response{RequestID: req.RequestID}
+		rsp.Status.Code = StatusNoContent
+		err = conn.WriteResponse(&rsp)
+		require.NoError(t, err)
+	})
+	defer srv.Close()
+
+	client, err := dialWS(context.Background(), DefaultDialer, "ws://"+srv.Listener.Addr().String(), user, pass)
+	require.NoError(t, err)
+	defer client.Close()
+
+	_, err = client.Execute(context.Background(), NewEvalRequest("g.E().drop()"))
+	assert.NoError(t, err)
+}
+
+func TestWebSocketTransport(t *testing.T) {
+	var dials int32
+	srv := serveWS(func(conn wsPeer) {
+		atomic.AddInt32(&dials, 1)
+		for {
+			req, err := conn.ReadRequest()
+			if err != nil {
+				return
+			}
+			rsp := Response{RequestID: req.RequestID}
+			rsp.Status.Code = StatusSuccess
+			rsp.Result.Data = graphson.RawMessage(strconv.Quote(req.Arguments[ArgsGremlin].(string)))
+			if err := conn.WriteResponse(&rsp); err != nil {
+				return
+			}
+		}
+	})
+	defer srv.Close()
+
+	u, err := url.Parse("ws://" + srv.Listener.Addr().String())
+	require.NoError(t, err)
+	client, err := Config{Endpoint: Endpoint{u}}.Build(WithPoolSize(2))
+	require.NoError(t, err)
+
+	// Bindings expansion is applied on top of the websocket transport.
+	ctx := context.Background()
+	req := NewEvalRequest("g.V().hasLabel($1)", WithBindings(map[string]interface{}{"$1": "user"}))
+	rsp, err := client.Do(ctx, req)
+	require.NoError(t, err)
+	var query string
+	require.NoError(t, graphson.Unmarshal(rsp.Result.Data, &query))
+	assert.Equal(t, `g.V().hasLabel("user")`, query)
+
+	// Connections are dialed on first use, and reused afterwards.
+	for i := 0; i < 4; i++ {
+		_, err := client.Query(ctx, "g.V()")
+		require.NoError(t, err)
+	}
+	assert.EqualValues(t, 2, atomic.LoadInt32(&dials))
+
+	// Closed connections are redialed.
+	transport := client.Transport.(*closableTransport).Closer.(*wsTransport)
+	for i := range transport.slots {
+		require.NoError(t, transport.slots[i].conn.Close())
+	}
+	for i := 0; i < 2; i++ {
+		_, err := client.Query(ctx, "g.V()")
+		require.NoError(t, err)
+	}
+	assert.EqualValues(t, 4, atomic.LoadInt32(&dials))
+
+	require.NoError(t, NewDriver(client).Close())
+	_, err = client.Query(ctx, "g.V()")
+	assert.True(t, errors.Is(err, ErrConnClosed))
+}
+
+func TestWebSocketTransportAuthentication(t *testing.T) {
+	srv := serveWS(func(conn wsPeer) {
+		req, err := conn.ReadRequest()
+		require.NoError(t, err)
+		rsp := Response{RequestID: req.RequestID}
+		rsp.Status.Code = StatusAuthenticate
+		require.NoError(t, conn.WriteResponse(&rsp))
+
+		areq, err := conn.ReadRequest()
+		require.NoError(t, err)
+		var creds Credentials
+		require.NoError(t, creds.UnmarshalText([]byte(areq.Arguments[ArgsSasl].(string))))
+		assert.Equal(t, Credentials{Username: "user", Password: "pass"}, creds)
+
+		rsp = Response{RequestID: req.RequestID}
+		rsp.Status.Code = StatusNoContent
+		require.NoError(t, conn.WriteResponse(&rsp))
+	})
+	defer srv.Close()
+
+	transport, err := NewWebSocketTransport("ws://user:pass@"+srv.Listener.Addr().String(), nil, 1)
+	require.NoError(t, err)
+	defer transport.(io.Closer).Close()
+	rsp, err := transport.RoundTrip(context.Background(), NewEvalRequest("g.V()"))
+	require.NoError(t, err)
+	assert.Equal(t, StatusNoContent, rsp.Status.Code)
+
+	_, err = NewWebSocketTransport("http://localhost:8182", nil, 1)
+	assert.Error(t, err)
+}
+
+func TestWebSocketTransportSession(t *testing.T) {
+	var dials int32
+	srv := serveWS(func(conn wsPeer) {
+		atomic.AddInt32(&dials, 1)
+		for {
+			req, err := conn.ReadRequest()
+			if err != nil {
+				return
+			}
+			rsp := Response{RequestID: req.RequestID}
+			rsp.Status.Code = StatusNoContent
+			if err := conn.WriteResponse(&rsp); err != nil {
+				return
+			}
+		}
+	})
+	defer srv.Close()
+
+	transport, err := NewWebSocketTransport("ws://"+srv.Listener.Addr().String(), nil, 4)
+	require.NoError(t, err)
+	defer transport.(io.Closer).Close()
+	// Requests of a session are executed on the same connection.
+	for i := 0; i < 3; i++ {
+		_, err := transport.RoundTrip(context.Background(), NewEvalRequest("g.V()", WithSession("session")))
+		require.NoError(t, err)
+	}
+	assert.EqualValues(t, 1, atomic.LoadInt32(&dials))
+}
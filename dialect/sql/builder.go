@@ -173,6 +173,29 @@ func (t *TableBuilder) Constraints(fks ...*ForeignKeyBuilder) *TableBuilder {
 	return t
 }
 
+// Check adds a named CHECK constraint to the statement. The name is optional.
+//
+//	CreateTable("users").
+//		Columns(Column("age").Type("int")).
+//		Check("age_check", func(b *Builder) {
+//			b.WriteString("age > 0")
+//		})
+//
+func (t *TableBuilder) Check(name string, check func(*Builder)) *TableBuilder {
+	t.constraints = append(t.constraints, checkConstraint(t.dialect, name, check))
+	return t
+}
+
+// checkConstraint returns a querier for the given CHECK constraint.
+func checkConstraint(dialect, name string, check func(*Builder)) Querier {
+	b := &Builder{dialect: dialect}
+	if name != "" {
+		b.WriteString("CONSTRAINT ").Ident(name).Pad()
+	}
+	b.WriteString("CHECK ").Nested(check)
+	return b
+}
+
 // Charset appends the `CHARACTER SET` clause to the statement. MySQL only.
 func (t *TableBuilder) Charset(s string) *TableBuilder {
 	t.charset = s
@@ -348,6 +371,22 @@ func (t *TableAlter) DropConstraint(ident string) *TableAlter {
 	return t
 }
 
+// AddCheck appends the `ADD CONSTRAINT ... CHECK` clause to the given `ALTER TABLE` statement.
+func (t *TableAlter) AddCheck(name string, check func(*Builder)) *TableAlter {
+	t.Queries = append(t.Queries, &Wrapper{"ADD %s", checkConstraint(t.dialect, name, check)})
+	return t
+}
+
+// DropCheck appends the `DROP CHECK` clause (`DROP CONSTRAINT` in PostgreSQL)
+// to the given `ALTER TABLE` statement.
+func (t *TableAlter) DropCheck(name string) *TableAlter {
+	if t.postgres() {
+		return t.DropConstraint(name)
+	}
+	t.Queries = append(t.Queries, Raw(fmt.Sprintf("DROP CHECK %s", t.Quote(name))))
+	return t
+}
+
 // DropForeignKey appends the `DROP FOREIGN KEY` clause to the given `ALTER TABLE` statement.
 func (t *TableAlter) DropForeignKey(ident string) *TableAlter {
 	t.Queries = append(t.Queries, Raw(fmt.Sprintf("DROP FOREIGN KEY %s", t.Quote(ident))))
@@ -613,6 +652,64 @@ func (d *DropIndexBuilder) Query() (string, []interface{}) {
 	return d.String(), nil
 }
 
+// ViewBuilder is a builder for `CREATE VIEW` statement.
+type ViewBuilder struct {
+	Builder
+	name string
+	as   string
+}
+
+// CreateView creates a builder for the `CREATE VIEW` statement.
+//
+//	CreateView("user_stats").
+//		As("SELECT `owner_id` AS `id`, COUNT(*) AS `pets` FROM `pets` GROUP BY `owner_id`")
+//
+func CreateView(name string) *ViewBuilder {
+	return &ViewBuilder{name: name}
+}
+
+// As sets the SELECT statement that defines the view.
+func (v *ViewBuilder) As(query string) *ViewBuilder {
+	v.as = query
+	return v
+}
+
+// Query returns query representation of a `CREATE VIEW` statement.
+//
+//	CREATE VIEW name AS query
+//
+func (v *ViewBuilder) Query() (string, []interface{}) {
+	v.WriteString("CREATE VIEW ")
+	v.Ident(v.name)
+	v.WriteString(" AS ")
+	v.WriteString(v.as)
+	return v.String(), nil
+}
+
+// DropViewBuilder is a builder for `DROP VIEW` statement.
+type DropViewBuilder struct {
+	Builder
+	name string
+}
+
+// DropView creates a builder for the `DROP VIEW IF EXISTS` statement.
+//
+//	DropView("user_stats")
+//
+func DropView(name string) *DropViewBuilder {
+	return &DropViewBuilder{name: name}
+}
+
+// Query returns query representation of a `DROP VIEW` statement.
+//
+//	DROP VIEW IF EXISTS name
+//
+func (d *DropViewBuilder) Query() (string, []interface{}) {
+	d.WriteString("DROP VIEW IF EXISTS ")
+	d.Ident(d.name)
+	return d.String(), nil
+}
+
 // InsertBuilder is a builder for `INSERT INTO` statement.
 type InsertBuilder struct {
 	Builder
@@ -622,6 +719,7 @@ type InsertBuilder struct {
 	defaults  string
 	returning []string
 	values    [][]interface{}
+	conflict  *conflict
 }
 
 // Insert creates a builder for the `INSERT INTO` statement.
@@ -701,6 +799,9 @@ func (i *InsertBuilder) Query() (string, []interface{}) {
 			})
 		}
 	}
+	if i.conflict != nil {
+		i.writeConflict()
+	}
 	if len(i.returning) > 0 && i.postgres() {
 		i.WriteString(" RETURNING ")
 		i.IdentComma(i.returning...)
@@ -708,6 +809,346 @@ func (i *InsertBuilder) Query() (string, []interface{}) {
 	return i.String(), i.args
 }
 
+// OnConflict allows setting the `conflict_target` and the `conflict_action`
+// of the insert statement. For example:
+//
+//	PostgreSQL/SQLite:
+//
+//		Insert("users").
+//			Columns("id", "name").
+//			Values(1, "a8m").
+//			OnConflict(
+//				ConflictColumns("id"),
+//				ResolveWithNewValues(),
+//			)
+//
+//	MySQL:
+//
+//		Insert("users").
+//			Columns("id", "name").
+//			Values(1, "a8m").
+//			OnConflict(
+//				ResolveWithNewValues(),
+//			)
+//
+// Calling OnConflict more than once appends the given options to the
+// existing ones.
+func (i *InsertBuilder) OnConflict(opts ...ConflictOption) *InsertBuilder {
+	if i.conflict == nil {
+		i.conflict = &conflict{}
+	}
+	for _, opt := range opts {
+		opt(i.conflict)
+	}
+	return i
+}
+
+// conflict holds the configuration of the upsert clause
+// (i.e. `ON CONFLICT` or `ON DUPLICATE KEY UPDATE`).
+type conflict struct {
+	target struct {
+		constraint string
+		columns    []string
+		where      *Predicate
+	}
+	action struct {
+		nothing bool
+		where   *Predicate
+		update  []func(*UpdateSet)
+	}
+}
+
+// ConflictOption allows configuring the conflict
+// clause of the insert statement using functional options.
+type ConflictOption func(*conflict)
+
+// ConflictColumns sets the unique constraints that trigger the conflict
+// resolution on insert to perform an upsert operation. The columns must
+// have a unique constraint applied to trigger this behaviour. Ignored by MySQL.
+//
+//	Insert("users").
+//		Columns("id", "name").
+//		Values(1, "a8m").
+//		OnConflict(
+//			ConflictColumns("id"),
+//			ResolveWithNewValues(),
+//		)
+//
+func ConflictColumns(names ...string) ConflictOption {
+	return func(c *conflict) {
+		c.target.columns = names
+	}
+}
+
+// ConflictConstraint allows setting the constraint
+// name (i.e. `ON CONSTRAINT <name>`) for PostgreSQL.
+//
+//	Insert("users").
+//		Columns("id", "name").
+//		Values(1, "a8m").
+//		OnConflict(
+//			ConflictConstraint("users_pkey"),
+//			ResolveWithNewValues(),
+//		)
+//
+func ConflictConstraint(name string) ConflictOption {
+	return func(c *conflict) {
+		c.target.constraint = name
+	}
+}
+
+// ConflictWhere allows inference of partial unique indexes. See, PostgreSQL
+// doc: https://www.postgresql.org/docs/current/sql-insert.html#SQL-ON-CONFLICT
+func ConflictWhere(p *Predicate) ConflictOption {
+	return func(c *conflict) {
+		c.target.where = p
+	}
+}
+
+// UpdateWhere allows setting the update condition. Only rows
+// for which this expression returns true will be updated.
+// Supported only by SQLite and PostgreSQL.
+func UpdateWhere(p *Predicate) ConflictOption {
+	return func(c *conflict) {
+		c.action.where = p
+	}
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+//
+//	Insert("users").
+//		Columns("id", "name").
+//		Values(1, "a8m").
+//		OnConflict(
+//			ConflictColumns("id"),
+//			DoNothing(),
+//		)
+//
+func DoNothing() ConflictOption {
+	return func(c *conflict) {
+		c.action.nothing = true
+	}
+}
+
+// ResolveWithIgnore sets each column to itself to force an update and return the ID,
+// otherwise does not change any data. This may still trigger update hooks in the database.
+//
+//	Insert("users").
+//		Columns("id").
+//		Values(1).
+//		OnConflict(
+//			ConflictColumns("id"),
+//			ResolveWithIgnore(),
+//		)
+//
+//	// Output:
+//	// MySQL: INSERT INTO `users` (`id`) VALUES (?) ON DUPLICATE KEY UPDATE `id` = `users`.`id`
+//	// PostgreSQL: INSERT INTO "users" ("id") VALUES ($1) ON CONFLICT ("id") DO UPDATE SET "id" = "users"."id"
+//
+func ResolveWithIgnore() ConflictOption {
+	return func(c *conflict) {
+		c.action.update = append(c.action.update, func(u *UpdateSet) {
+			for _, c := range u.columns {
+				u.SetIgnore(c)
+			}
+		})
+	}
+}
+
+// ResolveWithNewValues updates columns using the new values proposed
+// for insertion using the special EXCLUDED/VALUES table.
+//
+//	Insert("users").
+//		Columns("id", "name").
+//		Values(1, "a8m").
+//		OnConflict(
+//			ConflictColumns("id"),
+//			ResolveWithNewValues(),
+//		)
+//
+//	// Output:
+//	// MySQL: INSERT INTO `users` (`id`, `name`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `id` = VALUES(`id`), `name` = VALUES(`name`)
+//	// PostgreSQL: INSERT INTO "users" ("id", "name") VALUES ($1, $2) ON CONFLICT ("id") DO UPDATE SET "id" = "excluded"."id", "name" = "excluded"."name"
+//
+func ResolveWithNewValues() ConflictOption {
+	return func(c *conflict) {
+		c.action.update = append(c.action.update, func(u *UpdateSet) {
+			for _, c := range u.columns {
+				u.SetExcluded(c)
+			}
+		})
+	}
+}
+
+// ResolveWith allows setting a custom function to set the `UPDATE` clause.
+//
+//	Insert("users").
+//		Columns("id", "name").
+//		Values(1, "a8m").
+//		OnConflict(
+//			ConflictColumns("id"),
+//			ResolveWith(func(u *UpdateSet) {
+//				u.SetIgnore("id")
+//				u.SetNull("created_at")
+//				u.Set("name", Expr(u.Excluded().C("name")))
+//			}),
+//		)
+//
+func ResolveWith(fn func(*UpdateSet)) ConflictOption {
+	return func(c *conflict) {
+		c.action.update = append(c.action.update, fn)
+	}
+}
+
+// UpdateSet describes a set of changes of the `DO UPDATE` clause.
+type UpdateSet struct {
+	table   string
+	columns []string
+	update  *UpdateBuilder
+}
+
+// Table returns the table the `UPSERT` statement is executed on.
+func (u *UpdateSet) Table() *SelectTable {
+	return Dialect(u.update.dialect).Table(u.table)
+}
+
+// Excluded returns the special EXCLUDED table of the `DO UPDATE` clause.
+// Note that MySQL uses the VALUES function instead, see SetExcluded.
+func (u *UpdateSet) Excluded() *SelectTable {
+	return Dialect(u.update.dialect).Table("excluded")
+}
+
+// Columns returns all columns in the `INSERT` statement.
+func (u *UpdateSet) Columns() []string {
+	return u.columns
+}
+
+// UpdateColumns returns all columns in the `UPDATE` statement.
+func (u *UpdateSet) UpdateColumns() []string {
+	return append(u.update.nulls, u.update.columns...)
+}
+
+// Set sets a column to a given value. Setting a column more than once
+// overrides its previous value.
+func (u *UpdateSet) Set(column string, v interface{}) *UpdateSet {
+	for i := range u.update.columns {
+		if u.update.columns[i] == column {
+			u.update.values[i] = v
+			return u
+		}
+	}
+	u.unsetNull(column)
+	u.update.Set(column, v)
+	return u
+}
+
+// Add adds a numeric value to the given column.
+func (u *UpdateSet) Add(column string, v interface{}) *UpdateSet {
+	return u.Set(column, P(func(b *Builder) {
+		b.WriteString("COALESCE")
+		b.Nested(func(b *Builder) {
+			b.Ident(u.Table().C(column)).Comma().Arg(0)
+		})
+		b.WriteString(" + ")
+		b.Arg(v)
+	}))
+}
+
+// SetNull sets a column as null value.
+func (u *UpdateSet) SetNull(column string) *UpdateSet {
+	for i := range u.update.columns {
+		if u.update.columns[i] == column {
+			u.update.columns = append(u.update.columns[:i], u.update.columns[i+1:]...)
+			u.update.values = append(u.update.values[:i], u.update.values[i+1:]...)
+			break
+		}
+	}
+	u.unsetNull(column)
+	u.update.SetNull(column)
+	return u
+}
+
+// unsetNull removes the column from the list of NULL columns (if exists).
+func (u *UpdateSet) unsetNull(column string) {
+	for i := range u.update.nulls {
+		if u.update.nulls[i] == column {
+			u.update.nulls = append(u.update.nulls[:i], u.update.nulls[i+1:]...)
+			return
+		}
+	}
+}
+
+// SetIgnore sets the column to itself. For example, "id" = "users"."id".
+func (u *UpdateSet) SetIgnore(name string) *UpdateSet {
+	return u.Set(name, Expr(u.Table().C(name)))
+}
+
+// SetExcluded sets the column name to its EXCLUDED/VALUES value.
+// For example, "c" = "excluded"."c", or `c` = VALUES(`c`).
+func (u *UpdateSet) SetExcluded(name string) *UpdateSet {
+	switch u.update.Dialect() {
+	case dialect.MySQL:
+		return u.Set(name, P(func(b *Builder) {
+			b.WriteString("VALUES(").Ident(name).WriteByte(')')
+		}))
+	default:
+		return u.Set(name, Expr(u.Excluded().C(name)))
+	}
+}
+
+// writeConflict writes the `ON CONFLICT` or the `ON DUPLICATE KEY UPDATE`
+// clause of the insert statement, based on the configured dialect.
+func (i *InsertBuilder) writeConflict() {
+	switch i.Dialect() {
+	case dialect.MySQL:
+		if i.conflict.action.nothing {
+			i.AddError(fmt.Errorf("invalid CONFLICT action ('DO NOTHING') for dialect %q", i.Dialect()))
+			return
+		}
+		if i.conflict.action.where != nil {
+			i.AddError(fmt.Errorf("invalid CONFLICT action (UPDATE WHERE) for dialect %q", i.Dialect()))
+			return
+		}
+		i.WriteString(" ON DUPLICATE KEY UPDATE ")
+	case dialect.SQLite, dialect.Postgres:
+		i.WriteString(" ON CONFLICT")
+		switch t := i.conflict.target; {
+		case t.constraint != "" && len(t.columns) != 0:
+			i.AddError(fmt.Errorf("duplicate CONFLICT clauses: %q, %q", t.constraint, t.columns))
+		case t.constraint != "":
+			i.WriteString(" ON CONSTRAINT ").Ident(t.constraint)
+		case len(t.columns) != 0:
+			i.Pad().Nested(func(b *Builder) {
+				b.IdentComma(t.columns...)
+			})
+		}
+		if p := i.conflict.target.where; p != nil {
+			i.WriteString(" WHERE ").Join(p)
+		}
+		if i.conflict.action.nothing {
+			i.WriteString(" DO NOTHING")
+			return
+		}
+		i.WriteString(" DO UPDATE SET ")
+	default:
+		i.AddError(fmt.Errorf("unsupported CONFLICT clause for dialect %q", i.Dialect()))
+		return
+	}
+	if len(i.conflict.action.update) == 0 {
+		i.AddError(fmt.Errorf("missing action for 'DO UPDATE SET' clause"))
+		return
+	}
+	u := &UpdateSet{table: i.table, columns: i.columns, update: Dialect(i.Dialect()).Update(i.table)}
+	for _, f := range i.conflict.action.update {
+		f(u)
+	}
+	u.update.writeSetter(&i.Builder)
+	if p := i.conflict.action.where; p != nil {
+		i.WriteString(" WHERE ").Join(p)
+	}
+}
+
 // UpdateBuilder is a builder for `UPDATE` statement.
 type UpdateBuilder struct {
 	Builder
@@ -782,32 +1223,38 @@ func (u *UpdateBuilder) Empty() bool {
 	return len(u.columns) == 0 && len(u.nulls) == 0
 }
 
-// Query returns query representation of an `UPDATE` statement.
-func (u *UpdateBuilder) Query() (string, []interface{}) {
-	u.WriteString("UPDATE ")
-	u.writeSchema(u.schema)
-	u.Ident(u.table).WriteString(" SET ")
+// writeSetter writes the "SET" clause (without the keyword) of the
+// update statement to the given builder.
+func (u *UpdateBuilder) writeSetter(b *Builder) {
 	for i, c := range u.nulls {
 		if i > 0 {
-			u.Comma()
+			b.Comma()
 		}
-		u.Ident(c).WriteString(" = NULL")
+		b.Ident(c).WriteString(" = NULL")
 	}
 	if len(u.nulls) > 0 && len(u.columns) > 0 {
-		u.Comma()
+		b.Comma()
 	}
 	for i, c := range u.columns {
 		if i > 0 {
-			u.Comma()
+			b.Comma()
 		}
-		u.Ident(c).WriteString(" = ")
+		b.Ident(c).WriteString(" = ")
 		switch v := u.values[i].(type) {
 		case Querier:
-			u.Join(v)
+			b.Join(v)
 		default:
-			u.Arg(v)
+			b.Arg(v)
 		}
 	}
+}
+
+// Query returns query representation of an `UPDATE` statement.
+func (u *UpdateBuilder) Query() (string, []interface{}) {
+	u.WriteString("UPDATE ")
+	u.writeSchema(u.schema)
+	u.Ident(u.table).WriteString(" SET ")
+	u.writeSetter(&u.Builder)
 	if u.where != nil {
 		u.WriteString(" WHERE ")
 		u.Join(u.where)
@@ -1245,6 +1692,37 @@ func (p *Predicate) CompositeLT(columns []string, args ...interface{}) *Predicat
 	return p.compositeP(operator, columns, args...)
 }
 
+// CompositeEQ returns a composite "=" predicate for matching multiple
+// columns. Unlike CompositeGT and CompositeLT, it is expanded to a list
+// of equality predicates joined by AND, to be supported by all dialects.
+//
+//	CompositeEQ([]string{"a", "b"}, 1, 2)
+//	// (a = 1 AND b = 2)
+//
+func CompositeEQ(columns []string, args ...interface{}) *Predicate {
+	return P().CompositeEQ(columns, args...)
+}
+
+// CompositeEQ appends a composite "=" predicate for matching multiple columns.
+func (p *Predicate) CompositeEQ(columns []string, args ...interface{}) *Predicate {
+	if len(columns) != len(args) {
+		p.AddError(fmt.Errorf("sql: mismatch number of columns and arguments: %d != %d", len(columns), len(args)))
+		return p
+	}
+	return p.Append(func(b *Builder) {
+		b.Nested(func(b *Builder) {
+			for i := range columns {
+				if i > 0 {
+					b.WriteString(" AND ")
+				}
+				b.Ident(columns[i])
+				b.WriteOp(OpEQ)
+				b.Arg(args[i])
+			}
+		})
+	})
+}
+
 // Append appends a new function to the predicate callbacks.
 // The callback list are executed on call to Query.
 func (p *Predicate) Append(f func(*Builder)) *Predicate {
@@ -1543,6 +2021,7 @@ type Selector struct {
 	limit    *int
 	offset   *int
 	distinct bool
+	lock     *LockOptions
 }
 
 // WithContext sets the context into the *Selector.
@@ -1616,6 +2095,85 @@ func (s *Selector) Offset(offset int) *Selector {
 	return s
 }
 
+type (
+	// LockStrength defines the strength of the lock (see below).
+	LockStrength string
+	// LockAction defines the action to take when a requested row is locked by
+	// another transaction (either NOWAIT or SKIP LOCKED). The default behavior
+	// is to wait until the lock is released.
+	LockAction string
+	// LockOptions defines the options of the row-level lock
+	// clause that is added to the `SELECT` statement.
+	LockOptions struct {
+		// Strength of the lock.
+		Strength LockStrength
+		// Action of the lock.
+		Action LockAction
+		// Tables are the optional tables to lock (i.e. `OF table`).
+		Tables []string
+	}
+	// LockOption allows configuring the LockOptions using functional options.
+	LockOption func(*LockOptions)
+)
+
+// A list of all lock strengths and actions.
+const (
+	LockShare  LockStrength = "SHARE"
+	LockUpdate LockStrength = "UPDATE"
+
+	NoWait     LockAction = "NOWAIT"
+	SkipLocked LockAction = "SKIP LOCKED"
+)
+
+// WithLockAction sets the Action of the lock.
+func WithLockAction(action LockAction) LockOption {
+	return func(c *LockOptions) {
+		c.Action = action
+	}
+}
+
+// WithLockTables sets the Tables of the lock (i.e. `OF table`).
+// Only rows coming from these tables are locked.
+func WithLockTables(tables ...string) LockOption {
+	return func(c *LockOptions) {
+		c.Tables = tables
+	}
+}
+
+// For sets the lock configuration for suffixing the `SELECT`
+// statement with the `FOR [SHARE | UPDATE] ...` clause.
+// Note that the clause is ignored by SQLite, because it does
+// not support row-level locking.
+func (s *Selector) For(l LockStrength, opts ...LockOption) *Selector {
+	s.lock = &LockOptions{Strength: l}
+	for _, opt := range opts {
+		opt(s.lock)
+	}
+	return s
+}
+
+// ForShare sets the lock configuration for suffixing the
+// `SELECT` statement with the `FOR SHARE` clause. On MySQL,
+// the `LOCK IN SHARE MODE` clause is used if no options
+// were provided, as `FOR SHARE` requires MySQL 8.0.
+func (s *Selector) ForShare(opts ...LockOption) *Selector {
+	return s.For(LockShare, opts...)
+}
+
+// ForUpdate sets the lock configuration for suffixing the
+// `SELECT` statement with the `FOR UPDATE` clause.
+func (s *Selector) ForUpdate(opts ...LockOption) *Selector {
+	return s.For(LockUpdate, opts...)
+}
+
+// ClearLock removes the lock configuration of the `SELECT` statement. It is used,
+// for example, by queries that use aggregate functions (e.g. COUNT), because
+// databases like PostgreSQL do not support row-level locks with them.
+func (s *Selector) ClearLock() *Selector {
+	s.lock = nil
+	return s
+}
+
 // Where sets or appends the given predicate to the statement.
 func (s *Selector) Where(p *Predicate) *Selector {
 	if s.not {
@@ -1788,6 +2346,7 @@ func (s *Selector) Clone() *Selector {
 		limit:    s.limit,
 		offset:   s.offset,
 		distinct: s.distinct,
+		lock:     s.lock.clone(),
 		where:    s.where.clone(),
 		having:   s.having.clone(),
 		joins:    append([]join{}, joins...),
@@ -1907,10 +2466,44 @@ func (s *Selector) Query() (string, []interface{}) {
 		b.WriteString(" OFFSET ")
 		b.WriteString(strconv.Itoa(*s.offset))
 	}
+	s.joinLock(&b)
 	s.total = b.total
 	return b.String(), b.args
 }
 
+// joinLock writes the lock clause of the statement (if any).
+func (s *Selector) joinLock(b *Builder) {
+	switch {
+	case s.lock == nil || s.sqlite():
+		return
+	// MySQL 5.7 and MariaDB do not support the `FOR SHARE` clause. Hence, `LOCK IN SHARE MODE`
+	// is used instead, as it is also supported by MySQL 8. Note that the lock options (i.e. the
+	// tables and the action of the lock) are supported only by the `FOR SHARE` clause of MySQL 8.
+	case s.mysql() && s.lock.Strength == LockShare && len(s.lock.Tables) == 0 && s.lock.Action == "":
+		b.WriteString(" LOCK IN SHARE MODE")
+		return
+	}
+	b.WriteString(" FOR ").WriteString(string(s.lock.Strength))
+	if len(s.lock.Tables) > 0 {
+		b.WriteString(" OF ").IdentComma(s.lock.Tables...)
+	}
+	if s.lock.Action != "" {
+		b.Pad().WriteString(string(s.lock.Action))
+	}
+}
+
+// clone returns a copy of the lock options.
+func (l *LockOptions) clone() *LockOptions {
+	if l == nil {
+		return nil
+	}
+	return &LockOptions{
+		Strength: l.Strength,
+		Action:   l.Action,
+		Tables:   append([]string{}, l.Tables...),
+	}
+}
+
 func (s *Selector) joinOrder(b *Builder) {
 	b.WriteString(" ORDER BY ")
 	for i := range s.order {
@@ -2334,6 +2927,16 @@ func (b Builder) postgres() bool {
 	return b.Dialect() == dialect.Postgres
 }
 
+// mysql reports if the builder dialect is MySQL.
+func (b Builder) mysql() bool {
+	return b.Dialect() == dialect.MySQL
+}
+
+// sqlite reports if the builder dialect is SQLite.
+func (b Builder) sqlite() bool {
+	return b.Dialect() == dialect.SQLite
+}
+
 // fromIdent sets the builder dialect from the identifier format.
 func (b *Builder) fromIdent(ident string) {
 	if strings.Contains(ident, `"`) {
@@ -2529,6 +3132,29 @@ func (d *DialectBuilder) DropIndex(name string) *DropIndexBuilder {
 	return b
 }
 
+// CreateView creates a ViewBuilder for the configured dialect.
+//
+//	Dialect(dialect.Postgres).
+//		CreateView("name").
+//		As("SELECT ...")
+//
+func (d *DialectBuilder) CreateView(name string) *ViewBuilder {
+	b := CreateView(name)
+	b.SetDialect(d.dialect)
+	return b
+}
+
+// DropView creates a DropViewBuilder for the configured dialect.
+//
+//	Dialect(dialect.Postgres).
+//		DropView("name")
+//
+func (d *DialectBuilder) DropView(name string) *DropViewBuilder {
+	b := DropView(name)
+	b.SetDialect(d.dialect)
+	return b
+}
+
 func isFunc(s string) bool {
 	return strings.Contains(s, "(") && strings.Contains(s, ")")
 }
@@ -203,6 +203,85 @@ func TestBuilder(t *testing.T) {
 				DropColumn(Column("name")),
 			wantQuery: `ALTER TABLE "users" ADD COLUMN "boring" varchar, ALTER COLUMN "age" TYPE int, DROP COLUMN "name"`,
 		},
+		{
+			input: CreateTable("users").
+				Columns(
+					Column("id").Type("int").Attr("auto_increment"),
+					Column("age").Type("int"),
+				).
+				PrimaryKey("id").
+				Check("age_check", func(b *Builder) { b.WriteString("age > 0") }).
+				Check("", func(b *Builder) { b.WriteString("id > 0") }),
+			wantQuery: "CREATE TABLE `users`(`id` int auto_increment, `age` int, PRIMARY KEY(`id`), CONSTRAINT `age_check` CHECK (age > 0), CHECK (id > 0))",
+		},
+		{
+			input: Dialect(dialect.Postgres).CreateTable("users").
+				Columns(Column("age").Type("int")).
+				Check("age_check", func(b *Builder) { b.WriteString("age > 0") }),
+			wantQuery: `CREATE TABLE "users"("age" int, CONSTRAINT "age_check" CHECK (age > 0))`,
+		},
+		{
+			input: AlterTable("users").
+				DropCheck("old_check").
+				AddCheck("age_check", func(b *Builder) { b.WriteString("age > 0") }),
+			wantQuery: "ALTER TABLE `users` DROP CHECK `old_check`, ADD CONSTRAINT `age_check` CHECK (age > 0)",
+		},
+		{
+			input: Dialect(dialect.Postgres).AlterTable("users").
+				DropCheck("old_check").
+				AddCheck("age_check", func(b *Builder) { b.WriteString("age > 0") }),
+			wantQuery: `ALTER TABLE "users" DROP CONSTRAINT "old_check", ADD CONSTRAINT "age_check" CHECK (age > 0)`,
+		},
+		{
+			input:     Select("id").From(Table("users")).Where(EQ("id", 1)).ForUpdate(),
+			wantQuery: "SELECT `id` FROM `users` WHERE `id` = ? FOR UPDATE",
+			wantArgs:  []interface{}{1},
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Select("id").
+				From(Table("users")).
+				Limit(1).
+				ForShare(WithLockAction(NoWait)),
+			wantQuery: "SELECT `id` FROM `users` LIMIT 1 FOR SHARE NOWAIT",
+		},
+		{
+			input:     Dialect(dialect.MySQL).Select("id").From(Table("users")).Where(EQ("id", 1)).ForShare(),
+			wantQuery: "SELECT `id` FROM `users` WHERE `id` = ? LOCK IN SHARE MODE",
+			wantArgs:  []interface{}{1},
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Select("id").
+				From(Table("users")).
+				ForUpdate(WithLockAction(SkipLocked)),
+			wantQuery: "SELECT `id` FROM `users` FOR UPDATE SKIP LOCKED",
+		},
+		{
+			input:     Dialect(dialect.Postgres).Select("id").From(Table("users")).ForShare(),
+			wantQuery: `SELECT "id" FROM "users" FOR SHARE`,
+		},
+		{
+			input:     Dialect(dialect.Postgres).Select("id").From(Table("users")).ForUpdate().Clone(),
+			wantQuery: `SELECT "id" FROM "users" FOR UPDATE`,
+		},
+		{
+			input:     Dialect(dialect.Postgres).Select("id").From(Table("users")).ForUpdate().ClearLock(),
+			wantQuery: `SELECT "id" FROM "users"`,
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select("id").
+				From(Table("users")).
+				Where(EQ("id", 1)).
+				ForUpdate(WithLockTables("users"), WithLockAction(SkipLocked)),
+			wantQuery: `SELECT "id" FROM "users" WHERE "id" = $1 FOR UPDATE OF "users" SKIP LOCKED`,
+			wantArgs:  []interface{}{1},
+		},
+		{
+			input:     Dialect(dialect.SQLite).Select("id").From(Table("users")).ForUpdate(),
+			wantQuery: "SELECT `id` FROM `users`",
+		},
 		{
 			input:     AlterTable("users").RenameIndex("old", "new"),
 			wantQuery: "ALTER TABLE `users` RENAME INDEX `old` TO `new`",
@@ -1285,6 +1364,36 @@ func TestBuilder(t *testing.T) {
 			input:     DropIndex("name_index").Table("users"),
 			wantQuery: "DROP INDEX `name_index` ON `users`",
 		},
+		{
+			input:     CreateView("user_stats").As("SELECT `owner_id` AS `id`, COUNT(*) AS `pets` FROM `pets` GROUP BY `owner_id`"),
+			wantQuery: "CREATE VIEW `user_stats` AS SELECT `owner_id` AS `id`, COUNT(*) AS `pets` FROM `pets` GROUP BY `owner_id`",
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				CreateView("user_stats").
+				As(`SELECT "owner_id" AS "id" FROM "pets"`),
+			wantQuery: `CREATE VIEW "user_stats" AS SELECT "owner_id" AS "id" FROM "pets"`,
+		},
+		{
+			input:     DropView("user_stats"),
+			wantQuery: "DROP VIEW IF EXISTS `user_stats`",
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				DropView("user_stats"),
+			wantQuery: `DROP VIEW IF EXISTS "user_stats"`,
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select("*").
+				From(Table("users")).
+				Where(Or(
+					CompositeLT([]string{"name", "id"}, "a8m", 1),
+					CompositeEQ([]string{"name", "id"}, "nati", 2),
+				)),
+			wantQuery: `SELECT * FROM "users" WHERE ("name", "id") < ($1, $2) OR ("name" = $3 AND "id" = $4)`,
+			wantArgs:  []interface{}{"a8m", 1, "nati", 2},
+		},
 		{
 			input: Select().
 				From(Table("pragma_table_info('t1')").Unquote()).
@@ -1458,3 +1567,107 @@ func TestParamFormatter(t *testing.T) {
 	require.Equal(t, "SELECT * FROM `users` WHERE `point` = ST_GeomFromWKB(?)", query)
 	require.Equal(t, p, args[0])
 }
+
+func TestInsert_OnConflict(t *testing.T) {
+	t.Run("Postgres", func(t *testing.T) {
+		query, args := Dialect(dialect.Postgres).
+			Insert("users").
+			Columns("id", "email").
+			Values("1", "user@example.com").
+			OnConflict(
+				ConflictColumns("email"),
+				ConflictWhere(EQ("name", "Ariel")),
+				ResolveWithNewValues(),
+				ResolveWith(func(s *UpdateSet) {
+					s.SetIgnore("id")
+				}),
+				UpdateWhere(NEQ("updated_at", 0)),
+			).
+			Returning("id").
+			Query()
+		require.Equal(t, `INSERT INTO "users" ("id", "email") VALUES ($1, $2) ON CONFLICT ("email") WHERE "name" = $3 DO UPDATE SET "id" = "users"."id", "email" = "excluded"."email" WHERE "updated_at" <> $4 RETURNING "id"`, query)
+		require.Equal(t, []interface{}{"1", "user@example.com", "Ariel", 0}, args)
+
+		query, args = Dialect(dialect.Postgres).
+			Insert("users").
+			Columns("id", "name").
+			Values("1", "Mashraki").
+			OnConflict(
+				ConflictConstraint("users_pkey"),
+				DoNothing(),
+			).
+			Query()
+		require.Equal(t, `INSERT INTO "users" ("id", "name") VALUES ($1, $2) ON CONFLICT ON CONSTRAINT "users_pkey" DO NOTHING`, query)
+		require.Equal(t, []interface{}{"1", "Mashraki"}, args)
+
+		query, args = Dialect(dialect.Postgres).
+			Insert("users").
+			Columns("id", "count").
+			Values("1", 1).
+			OnConflict(
+				ConflictColumns("id"),
+				ResolveWith(func(s *UpdateSet) {
+					s.Add("count", 1)
+					s.SetNull("name")
+				}),
+			).
+			Query()
+		require.Equal(t, `INSERT INTO "users" ("id", "count") VALUES ($1, $2) ON CONFLICT ("id") DO UPDATE SET "name" = NULL, "count" = COALESCE("users"."count", $3) + $4`, query)
+		require.Equal(t, []interface{}{"1", 1, 0, 1}, args)
+	})
+
+	t.Run("SQLite", func(t *testing.T) {
+		query, args := Dialect(dialect.SQLite).
+			Insert("users").
+			Columns("id", "email").
+			Values("1", "user@example.com").
+			OnConflict(
+				ConflictColumns("email"),
+				ResolveWithIgnore(),
+			).
+			Query()
+		require.Equal(t, "INSERT INTO `users` (`id`, `email`) VALUES (?, ?) ON CONFLICT (`email`) DO UPDATE SET `id` = `users`.`id`, `email` = `users`.`email`", query)
+		require.Equal(t, []interface{}{"1", "user@example.com"}, args)
+	})
+
+	t.Run("MySQL", func(t *testing.T) {
+		query, args := Dialect(dialect.MySQL).
+			Insert("users").
+			Columns("id", "email").
+			Values("1", "user@example.com").
+			OnConflict(
+				ResolveWithNewValues(),
+			).
+			Query()
+		require.Equal(t, "INSERT INTO `users` (`id`, `email`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `id` = VALUES(`id`), `email` = VALUES(`email`)", query)
+		require.Equal(t, []interface{}{"1", "user@example.com"}, args)
+
+		query, args = Dialect(dialect.MySQL).
+			Insert("users").
+			Columns("name", "rank").
+			Values("Mashraki", nil).
+			OnConflict(
+				ResolveWith(func(s *UpdateSet) {
+					s.Set("name", "Ariel")
+					s.SetIgnore("rank")
+				}),
+			).
+			Query()
+		require.Equal(t, "INSERT INTO `users` (`name`, `rank`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `name` = ?, `rank` = `users`.`rank`", query)
+		require.Equal(t, []interface{}{"Mashraki", nil, "Ariel"}, args)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		b := Dialect(dialect.MySQL).Insert("users").Columns("id").Values(1).OnConflict(DoNothing())
+		b.Query()
+		require.Error(t, b.Err())
+
+		b = Dialect(dialect.Postgres).Insert("users").Columns("id").Values(1).OnConflict(ConflictColumns("id"))
+		b.Query()
+		require.EqualError(t, b.Err(), "missing action for 'DO UPDATE SET' clause")
+
+		b = Dialect(dialect.Postgres).Insert("users").Columns("id").Values(1).OnConflict(ConflictColumns("id"), ConflictConstraint("users_pkey"), DoNothing())
+		b.Query()
+		require.Error(t, b.Err())
+	})
+}
@@ -76,6 +76,39 @@ type Tx struct {
 	driver.Tx
 }
 
+// Savepoint creates a savepoint with the given name in the transaction, and returns
+// a nested transaction that is backed by it. Committing the nested transaction releases
+// the savepoint, and rolling it back discards only the changes that were made after the
+// savepoint was created. Savepoints are supported by MySQL, PostgreSQL and SQLite, and
+// the given name must be a valid (unquoted) identifier in these dialects.
+func Savepoint(ctx context.Context, tx dialect.Tx, name string) (dialect.Tx, error) {
+	if err := tx.Exec(ctx, "SAVEPOINT "+name, []interface{}{}, nil); err != nil {
+		return nil, err
+	}
+	return &savepoint{Tx: tx, ctx: ctx, name: name}, nil
+}
+
+// savepoint implements the dialect.Tx interface for savepoints.
+// Statements are executed in the underlying transaction.
+type savepoint struct {
+	dialect.Tx
+	ctx  context.Context
+	name string
+}
+
+// Commit releases the savepoint.
+func (s *savepoint) Commit() error {
+	return s.Tx.Exec(s.ctx, "RELEASE SAVEPOINT "+s.name, []interface{}{}, nil)
+}
+
+// Rollback rolls back the transaction to the savepoint, and releases it.
+func (s *savepoint) Rollback() error {
+	if err := s.Tx.Exec(s.ctx, "ROLLBACK TO SAVEPOINT "+s.name, []interface{}{}, nil); err != nil {
+		return err
+	}
+	return s.Tx.Exec(s.ctx, "RELEASE SAVEPOINT "+s.name, []interface{}{}, nil)
+}
+
 // ExecQuerier wraps the standard Exec and Query methods.
 type ExecQuerier interface {
 	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
@@ -147,4 +180,15 @@ type (
 	NullTime = sql.NullTime
 	// TxOptions holds the transaction options to be used in DB.BeginTx.
 	TxOptions = sql.TxOptions
+	// IsolationLevel is an alias to sql.IsolationLevel.
+	IsolationLevel = sql.IsolationLevel
+)
+
+// Isolation levels of transactions. See sql.IsolationLevel for more info.
+const (
+	LevelDefault         = sql.LevelDefault
+	LevelReadUncommitted = sql.LevelReadUncommitted
+	LevelReadCommitted   = sql.LevelReadCommitted
+	LevelRepeatableRead  = sql.LevelRepeatableRead
+	LevelSerializable    = sql.LevelSerializable
 )
@@ -0,0 +1,48 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSavepoint(t *testing.T) {
+	drv, mock := newMockDriver(t)
+	ctx := context.Background()
+	mock.ExpectBegin()
+	mock.ExpectExec("SAVEPOINT sp_1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO users").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("RELEASE SAVEPOINT sp_1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("SAVEPOINT sp_2").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("ROLLBACK TO SAVEPOINT sp_2").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("RELEASE SAVEPOINT sp_2").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	sp, err := Savepoint(ctx, tx, "sp_1")
+	require.NoError(t, err)
+	require.NoError(t, sp.Exec(ctx, "INSERT INTO users", []interface{}{}, nil))
+	require.NoError(t, sp.Commit())
+	sp, err = Savepoint(ctx, tx, "sp_2")
+	require.NoError(t, err)
+	require.NoError(t, sp.Rollback())
+	require.NoError(t, tx.Commit())
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SAVEPOINT sp_1").WillReturnError(errors.New("savepoint error"))
+	mock.ExpectRollback()
+	tx, err = drv.Tx(ctx)
+	require.NoError(t, err)
+	_, err = Savepoint(ctx, tx, "sp_1")
+	require.EqualError(t, err, "savepoint error")
+	require.NoError(t, tx.Rollback())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
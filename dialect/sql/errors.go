@@ -0,0 +1,70 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"errors"
+	"strings"
+)
+
+// IsDeadlockError reports if the error resulted from a deadlock that was detected by
+// the database. The transaction that received this error was rolled back (or aborted),
+// and it is safe to retry it.
+func IsDeadlockError(err error) bool {
+	return isError(err, []string{"40P01"}, []string{
+		"Error 1213",        // MySQL
+		"deadlock detected", // Postgres
+	})
+}
+
+// IsLockTimeoutError reports if the error resulted from a timeout while waiting for
+// a lock, or from a lock that could not be acquired (e.g. the database is busy).
+func IsLockTimeoutError(err error) bool {
+	return isError(err, []string{"55P03"}, []string{
+		"Error 1205",               // MySQL
+		"due to lock timeout",      // Postgres
+		"could not obtain lock",    // Postgres
+		"database is locked",       // SQLite
+		"database table is locked", // SQLite
+	})
+}
+
+// IsSerializationError reports if the error resulted from a serialization failure
+// of a transaction that is executed in the REPEATABLE READ or SERIALIZABLE isolation
+// levels. The transaction is aborted, and it is safe to retry it.
+func IsSerializationError(err error) bool {
+	return isError(err, []string{"40001"}, []string{
+		"could not serialize access", // Postgres
+	})
+}
+
+// IsRetryableError reports if a transaction that failed with the given error can be
+// retried, because it was aborted due to a deadlock, a lock timeout or a serialization
+// failure. It is used by the generated Client.WithTx helper.
+func IsRetryableError(err error) bool {
+	return IsDeadlockError(err) || IsLockTimeoutError(err) || IsSerializationError(err)
+}
+
+// isError reports if the error has one of the given SQLSTATE codes, or contains one
+// of the given messages. Note that not all drivers expose the SQLSTATE of their errors.
+func isError(err error, states, msgs []string) bool {
+	if err == nil {
+		return false
+	}
+	var e interface{ SQLState() string }
+	if errors.As(err, &e) {
+		for _, s := range states {
+			if e.SQLState() == s {
+				return true
+			}
+		}
+	}
+	for _, s := range msgs {
+		if strings.Contains(err.Error(), s) {
+			return true
+		}
+	}
+	return false
+}
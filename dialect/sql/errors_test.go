@@ -0,0 +1,47 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type stateError string
+
+func (e stateError) Error() string    { return "error with state " + string(e) }
+func (e stateError) SQLState() string { return string(e) }
+
+func TestRetryableErrors(t *testing.T) {
+	tests := []struct {
+		err                              error
+		deadlock, timeout, serialization bool
+	}{
+		{err: errors.New("Error 1213: Deadlock found when trying to get lock; try restarting transaction"), deadlock: true},
+		{err: errors.New("pq: deadlock detected"), deadlock: true},
+		{err: stateError("40P01"), deadlock: true},
+		{err: errors.New("Error 1205: Lock wait timeout exceeded; try restarting transaction"), timeout: true},
+		{err: errors.New("pq: canceling statement due to lock timeout"), timeout: true},
+		{err: errors.New("pq: could not obtain lock on row in relation \"users\""), timeout: true},
+		{err: stateError("55P03"), timeout: true},
+		{err: errors.New("database is locked"), timeout: true},
+		{err: errors.New("database table is locked: users"), timeout: true},
+		{err: errors.New("pq: could not serialize access due to concurrent update"), serialization: true},
+		{err: stateError("40001"), serialization: true},
+		{err: fmt.Errorf("ent: committing transaction: %w", stateError("40001")), serialization: true},
+		{err: errors.New("Error 1062: Duplicate entry 'a8m' for key 'name'")},
+		{err: stateError("23505")},
+		{err: nil},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.deadlock, IsDeadlockError(tt.err), tt.err)
+		require.Equal(t, tt.timeout, IsLockTimeoutError(tt.err), tt.err)
+		require.Equal(t, tt.serialization, IsSerializationError(tt.err), tt.err)
+		require.Equal(t, tt.deadlock || tt.timeout || tt.serialization, IsRetryableError(tt.err), tt.err)
+	}
+}
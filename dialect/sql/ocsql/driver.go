@@ -0,0 +1,190 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package ocsql provides an OpenCensus instrumented dialect.Driver for SQL based databases.
+package ocsql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"go.opencensus.io/trace"
+)
+
+// Driver is a dialect.Driver that instruments all driver
+// operations with OpenCensus stats and tracing.
+type Driver struct {
+	dialect.Driver
+	startOptions trace.StartOptions
+	redact       func(string, []interface{}) []interface{}
+}
+
+// Option allows configuring the instrumented driver using functional options.
+type Option func(*Driver)
+
+// WithStartOptions sets the options that are applied to the spans
+// started by the driver. The span kind is always set to trace.SpanKindClient.
+func WithStartOptions(opts trace.StartOptions) Option {
+	return func(d *Driver) {
+		d.startOptions = opts
+	}
+}
+
+// WithArgs enables the recording of statement arguments in spans.
+// Only allow this if it is safe to have arguments recorded with
+// respect to security. See WithRedactedArgs for masking arguments.
+func WithArgs() Option {
+	return WithRedactedArgs(func(_ string, args []interface{}) []interface{} {
+		return args
+	})
+}
+
+// WithRedactedArgs enables the recording of statement arguments in spans,
+// after they were passed through the given redaction function.
+//
+//	ocsql.WithRedactedArgs(func(query string, args []interface{}) []interface{} {
+//		redacted := make([]interface{}, len(args))
+//		for i := range args {
+//			redacted[i] = "?"
+//		}
+//		return redacted
+//	})
+//
+func WithRedactedArgs(redact func(query string, args []interface{}) []interface{}) Option {
+	return func(d *Driver) {
+		d.redact = redact
+	}
+}
+
+// Wrap returns a new driver that instruments the operations of the given driver.
+// Statements are recorded in spans, but their arguments are not, unless one of the
+// WithArgs or WithRedactedArgs options is provided.
+func Wrap(drv dialect.Driver, opts ...Option) *Driver {
+	d := &Driver{Driver: drv}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// Exec implements the dialect.Exec method.
+func (d *Driver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	return d.exec(ctx, d.Driver, query, args, v)
+}
+
+// Query implements the dialect.Query method.
+func (d *Driver) Query(ctx context.Context, query string, args, v interface{}) error {
+	return d.query(ctx, d.Driver, query, args, v)
+}
+
+// Tx starts and returns an instrumented transaction.
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.beginTx(ctx, func(ctx context.Context) (dialect.Tx, error) {
+		return d.Driver.Tx(ctx)
+	})
+}
+
+// BeginTx starts an instrumented transaction with options,
+// if it is supported by the underlying driver.
+func (d *Driver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("dialect/sql/ocsql: Driver.BeginTx is not supported")
+	}
+	return d.beginTx(ctx, func(ctx context.Context) (dialect.Tx, error) {
+		return drv.BeginTx(ctx, opts)
+	})
+}
+
+func (d *Driver) beginTx(ctx context.Context, begin func(context.Context) (dialect.Tx, error)) (dialect.Tx, error) {
+	start := time.Now()
+	_, span := d.startSpan(ctx, "tx")
+	tx, err := begin(ctx)
+	record(ctx, "tx", d.Dialect(), start, err)
+	endSpan(span, err)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx, drv: d, ctx: ctx}, nil
+}
+
+func (d *Driver) exec(ctx context.Context, conn dialect.ExecQuerier, query string, args, v interface{}) (err error) {
+	start := time.Now()
+	ctx, span := d.startSpan(ctx, "exec")
+	span.AddAttributes(d.statementAttrs(query, args)...)
+	defer func() {
+		record(ctx, "exec", d.Dialect(), start, err)
+		endSpan(span, err)
+	}()
+	if err = conn.Exec(ctx, query, args, v); err != nil {
+		return err
+	}
+	if res, ok := v.(*sql.Result); ok && *res != nil {
+		if n, err := (*res).RowsAffected(); err == nil {
+			span.AddAttributes(trace.Int64Attribute(RowsAffectedAttribute, n))
+		}
+	}
+	return nil
+}
+
+func (d *Driver) query(ctx context.Context, conn dialect.ExecQuerier, query string, args, v interface{}) (err error) {
+	start := time.Now()
+	ctx, span := d.startSpan(ctx, "query")
+	span.AddAttributes(d.statementAttrs(query, args)...)
+	defer func() {
+		record(ctx, "query", d.Dialect(), start, err)
+		endSpan(span, err)
+	}()
+	return conn.Query(ctx, query, args, v)
+}
+
+// Tx is a dialect.Tx that instruments all transaction
+// operations with OpenCensus stats and tracing.
+type Tx struct {
+	dialect.Tx
+	drv *Driver
+	// ctx is the context the transaction was started with.
+	// It is used for recording the Commit and Rollback operations.
+	ctx context.Context
+}
+
+// Exec implements the dialect.Exec method.
+func (t *Tx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	return t.drv.exec(ctx, t.Tx, query, args, v)
+}
+
+// Query implements the dialect.Query method.
+func (t *Tx) Query(ctx context.Context, query string, args, v interface{}) error {
+	return t.drv.query(ctx, t.Tx, query, args, v)
+}
+
+// Commit commits the transaction and records the operation.
+func (t *Tx) Commit() error {
+	return t.end("commit", t.Tx.Commit)
+}
+
+// Rollback rollbacks the transaction and records the operation.
+func (t *Tx) Rollback() error {
+	return t.end("rollback", t.Tx.Rollback)
+}
+
+func (t *Tx) end(method string, fn func() error) error {
+	start := time.Now()
+	_, span := t.drv.startSpan(t.ctx, method)
+	err := fn()
+	record(t.ctx, method, t.drv.Dialect(), start, err)
+	endSpan(span, err)
+	return err
+}
+
+var (
+	_ dialect.Driver = (*Driver)(nil)
+	_ dialect.Tx     = (*Tx)(nil)
+)
@@ -0,0 +1,143 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package ocsql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/trace"
+)
+
+type testExporter struct {
+	spans []*trace.SpanData
+}
+
+func (t *testExporter) ExportSpan(s *trace.SpanData) {
+	t.spans = append(t.spans, s)
+}
+
+func TestDriver(t *testing.T) {
+	trace.ApplyConfig(trace.Config{DefaultSampler: trace.AlwaysSample()})
+	var exporter testExporter
+	trace.RegisterExporter(&exporter)
+	defer trace.UnregisterExporter(&exporter)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectExec("UPDATE `users` SET `name` = ?").
+		WithArgs("a8m").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectQuery("SELECT `id` FROM `users`").
+		WillReturnError(errors.New("bad conn"))
+	drv := Wrap(sql.OpenDB(dialect.MySQL, db))
+
+	ctx := context.Background()
+	var res sql.Result
+	err = drv.Exec(ctx, "UPDATE `users` SET `name` = ?", []interface{}{"a8m"}, &res)
+	require.NoError(t, err)
+	var rows sql.Rows
+	err = drv.Query(ctx, "SELECT `id` FROM `users`", []interface{}{}, &rows)
+	require.EqualError(t, err, "bad conn")
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	require.Len(t, exporter.spans, 2)
+	exec := exporter.spans[0]
+	assert.Equal(t, "sql:exec", exec.Name)
+	assert.Equal(t, trace.SpanKindClient, exec.SpanKind)
+	assert.Equal(t, map[string]interface{}{
+		StatementAttribute:    "UPDATE `users` SET `name` = ?",
+		DialectAttribute:      dialect.MySQL,
+		TableAttribute:        "users",
+		RowsAffectedAttribute: int64(2),
+	}, exec.Attributes)
+	query := exporter.spans[1]
+	assert.Equal(t, "sql:query", query.Name)
+	assert.Equal(t, "users", query.Attributes[TableAttribute])
+	assert.Equal(t, trace.Status{Code: trace.StatusCodeUnknown, Message: "bad conn"}, query.Status)
+}
+
+func TestDriverTx(t *testing.T) {
+	trace.ApplyConfig(trace.Config{DefaultSampler: trace.AlwaysSample()})
+	var exporter testExporter
+	trace.RegisterExporter(&exporter)
+	defer trace.UnregisterExporter(&exporter)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `users`").
+		WithArgs("a8m", 30).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+	drv := Wrap(sql.OpenDB(dialect.MySQL, db), WithArgs())
+
+	ctx := context.Background()
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	err = tx.Exec(ctx, "INSERT INTO `users` (`name`, `age`) VALUES (?, ?)", []interface{}{"a8m", 30}, nil)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+	tx, err = drv.BeginTx(ctx, &sql.TxOptions{})
+	require.NoError(t, err)
+	require.NoError(t, tx.Rollback())
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	names := make([]string, len(exporter.spans))
+	for i, s := range exporter.spans {
+		names[i] = s.Name
+	}
+	assert.Equal(t, []string{"sql:tx", "sql:exec", "sql:commit", "sql:tx", "sql:rollback"}, names)
+	attrs := exporter.spans[1].Attributes
+	assert.Equal(t, "a8m", attrs[ArgAttribute+".0"])
+	assert.Equal(t, int64(30), attrs[ArgAttribute+".1"])
+}
+
+func TestDriverRedactedArgs(t *testing.T) {
+	drv := Wrap(nil, WithRedactedArgs(func(_ string, args []interface{}) []interface{} {
+		redacted := make([]interface{}, len(args))
+		for i := range args {
+			redacted[i] = "?"
+		}
+		return redacted
+	}))
+	attrs := drv.statementAttrs("SELECT * FROM `users` WHERE `password` = ?", []interface{}{"secret"})
+	assert.Equal(t, []trace.Attribute{
+		trace.StringAttribute(StatementAttribute, "SELECT * FROM `users` WHERE `password` = ?"),
+		trace.StringAttribute(TableAttribute, "users"),
+		trace.StringAttribute(ArgAttribute+".0", "?"),
+	}, attrs)
+	attrs = Wrap(nil).statementAttrs("SELECT 1", []interface{}{"secret"})
+	assert.Equal(t, []trace.Attribute{
+		trace.StringAttribute(StatementAttribute, "SELECT 1"),
+	}, attrs)
+}
+
+func TestTableName(t *testing.T) {
+	tests := []struct {
+		query, table string
+	}{
+		{"SELECT `id` FROM `users` WHERE `id` = ?", "users"},
+		{`SELECT "id" FROM "users"`, "users"},
+		{"INSERT INTO `users` (`name`) VALUES (?)", "users"},
+		{"UPDATE users SET name = ?", "users"},
+		{"DELETE FROM `pets` WHERE `owner_id` = ?", "pets"},
+		{"CREATE TABLE IF NOT EXISTS `groups`(`id` bigint)", "groups"},
+		{"SELECT * FROM `ent`.`users`", "ent.users"},
+		{"SELECT 1", ""},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.table, tableName(tt.query), tt.query)
+	}
+}
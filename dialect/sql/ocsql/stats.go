@@ -0,0 +1,102 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package ocsql
+
+import (
+	"context"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+// The following measures are supported for use in custom views.
+var (
+	CallCount = stats.Int64(
+		"sql/call_count",
+		"Number of SQL driver operations started",
+		stats.UnitDimensionless,
+	)
+	ErrorCount = stats.Int64(
+		"sql/error_count",
+		"Number of SQL driver operations that failed",
+		stats.UnitDimensionless,
+	)
+	Latency = stats.Float64(
+		"sql/latency",
+		"End-to-end latency",
+		stats.UnitMilliseconds,
+	)
+)
+
+// The following tags are applied to stats recorded by this package.
+var (
+	// Method is the driver operation that was executed. One of:
+	// "exec", "query", "tx", "commit" or "rollback".
+	Method, _ = tag.NewKey("sql_method")
+	// Dialect is the SQL dialect name of the driver.
+	Dialect, _ = tag.NewKey("sql_dialect")
+	// Status is "ok" if the operation succeeded, or "error" otherwise.
+	Status, _ = tag.NewKey("sql_status")
+)
+
+// DefaultLatencyDistribution is the default distribution used by the latency view in this package.
+var DefaultLatencyDistribution = view.Distribution(1, 2, 3, 4, 5, 6, 8, 10, 13, 16, 20, 25, 30, 40, 50, 65, 80, 100, 130, 160, 200, 250, 300, 400, 500, 650, 800, 1000, 2000, 5000, 10000, 20000, 50000, 100000)
+
+// Package ocsql provides some convenience views for measures.
+// You still need to register these views for data to actually be collected.
+var (
+	CallCountView = &view.View{
+		Name:        "sql/call_count",
+		Measure:     CallCount,
+		Aggregation: view.Count(),
+		Description: "Count of SQL driver operations started, by method",
+		TagKeys:     []tag.Key{Method, Dialect},
+	}
+
+	ErrorCountView = &view.View{
+		Name:        "sql/error_count",
+		Measure:     ErrorCount,
+		Aggregation: view.Count(),
+		Description: "Count of SQL driver operations that failed, by method",
+		TagKeys:     []tag.Key{Method, Dialect},
+	}
+
+	LatencyView = &view.View{
+		Name:        "sql/latency",
+		Measure:     Latency,
+		Aggregation: DefaultLatencyDistribution,
+		Description: "End-to-end latency, by method and status",
+		TagKeys:     []tag.Key{Method, Dialect, Status},
+	}
+)
+
+// Views are the default views provided by this package.
+func Views() []*view.View {
+	return []*view.View{
+		CallCountView,
+		ErrorCountView,
+		LatencyView,
+	}
+}
+
+// record records the stats of a driver operation that was started at the given time.
+func record(ctx context.Context, method, dialect string, start time.Time, err error) {
+	latency := float64(time.Since(start)) / float64(time.Millisecond)
+	var (
+		status = "ok"
+		ms     = []stats.Measurement{CallCount.M(1), Latency.M(latency)}
+	)
+	if err != nil {
+		status = "error"
+		ms = append(ms, ErrorCount.M(1))
+	}
+	_ = stats.RecordWithTags(ctx, []tag.Mutator{
+		tag.Upsert(Method, method),
+		tag.Upsert(Dialect, dialect),
+		tag.Upsert(Status, status),
+	}, ms...)
+}
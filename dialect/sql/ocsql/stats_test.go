@@ -0,0 +1,53 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package ocsql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+)
+
+func TestStatsCollection(t *testing.T) {
+	err := view.Register(Views()...)
+	require.NoError(t, err)
+	defer view.Unregister(Views()...)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectExec("DELETE FROM `users`").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM `pets`").
+		WillReturnError(errors.New("bad conn"))
+	drv := Wrap(sql.OpenDB(dialect.SQLite, db))
+	ctx := context.Background()
+	require.NoError(t, drv.Exec(ctx, "DELETE FROM `users`", []interface{}{}, nil))
+	require.Error(t, drv.Exec(ctx, "DELETE FROM `pets`", []interface{}{}, nil))
+
+	rows, err := view.RetrieveData("sql/call_count")
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, int64(2), rows[0].Data.(*view.CountData).Value)
+
+	rows, err = view.RetrieveData("sql/error_count")
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, int64(1), rows[0].Data.(*view.CountData).Value)
+
+	rows, err = view.RetrieveData("sql/latency")
+	require.NoError(t, err)
+	require.Len(t, rows, 2, "ok and error rows")
+	for _, row := range rows {
+		assert.Equal(t, int64(1), row.Data.(*view.DistributionData).Count)
+	}
+}
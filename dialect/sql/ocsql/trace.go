@@ -0,0 +1,94 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package ocsql
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.opencensus.io/trace"
+)
+
+// Attributes recorded on the span for the operations.
+const (
+	StatementAttribute    = "sql.statement"
+	DialectAttribute      = "sql.dialect"
+	TableAttribute        = "sql.table"
+	ArgAttribute          = "sql.arg"
+	RowsAffectedAttribute = "sql.rows_affected"
+)
+
+// startSpan starts a new client span for the given driver method.
+func (d *Driver) startSpan(ctx context.Context, method string) (context.Context, *trace.Span) {
+	ctx, span := trace.StartSpan(ctx,
+		"sql:"+method,
+		trace.WithSampler(d.startOptions.Sampler),
+		trace.WithSpanKind(trace.SpanKindClient),
+	)
+	span.AddAttributes(trace.StringAttribute(DialectAttribute, d.Dialect()))
+	return ctx, span
+}
+
+// statementAttrs returns the attributes of the given statement and its arguments.
+func (d *Driver) statementAttrs(query string, args interface{}) []trace.Attribute {
+	attrs := []trace.Attribute{
+		trace.StringAttribute(StatementAttribute, query),
+	}
+	if table := tableName(query); table != "" {
+		attrs = append(attrs, trace.StringAttribute(TableAttribute, table))
+	}
+	if argv, ok := args.([]interface{}); ok && d.redact != nil {
+		for i, arg := range d.redact(query, argv) {
+			attrs = append(attrs, argToAttr(ArgAttribute+"."+strconv.Itoa(i), arg))
+		}
+	}
+	return attrs
+}
+
+// endSpan sets the span status according to the given error and ends it.
+func endSpan(span *trace.Span, err error) {
+	if err != nil {
+		span.SetStatus(trace.Status{Code: trace.StatusCodeUnknown, Message: err.Error()})
+	}
+	span.End()
+}
+
+// tableRe matches the first table referenced by a statement.
+var tableRe = regexp.MustCompile("(?i)\\b(?:FROM|INTO|UPDATE|TABLE)\\s+(?:IF\\s+(?:NOT\\s+)?EXISTS\\s+)?([`\"\\w.]+)")
+
+// tableName returns the first table name referenced by the given statement, or an empty string.
+func tableName(query string) string {
+	m := tableRe.FindStringSubmatch(query)
+	if m == nil {
+		return ""
+	}
+	return strings.NewReplacer("`", "", `"`, "").Replace(m[1])
+}
+
+func argToAttr(key string, val interface{}) trace.Attribute {
+	switch v := val.(type) {
+	case nil:
+		return trace.StringAttribute(key, "")
+	case int:
+		return trace.Int64Attribute(key, int64(v))
+	case int64:
+		return trace.Int64Attribute(key, v)
+	case float64:
+		return trace.Float64Attribute(key, v)
+	case string:
+		return trace.StringAttribute(key, v)
+	case bool:
+		return trace.BoolAttribute(key, v)
+	default:
+		s := fmt.Sprintf("%v", v)
+		if len(s) > 256 {
+			s = s[:256]
+		}
+		return trace.StringAttribute(key, s)
+	}
+}
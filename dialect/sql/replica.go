@@ -0,0 +1,237 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"entgo.io/ent/dialect"
+)
+
+// ReplicaDriver is a dialect.Driver that routes Query operations to a set of read
+// replicas, and sends all other operations, including everything that is executed
+// inside transactions, to the primary database.
+type ReplicaDriver struct {
+	primary  *Driver
+	replicas []*replica
+	next     uint64
+	// health checking.
+	interval time.Duration
+	done     chan struct{}
+	wg       sync.WaitGroup
+	// closing.
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// replica is a read replica and its routing state.
+type replica struct {
+	*Driver
+	weight  int
+	healthy int32
+}
+
+// ReplicaOption allows configuring the ReplicaDriver using functional options.
+type ReplicaOption func(*ReplicaDriver)
+
+// Weights sets the weights of the replicas for weighted round-robin routing.
+// The i-th weight is applied to the i-th replica, and replicas without
+// an explicit weight (or with a non-positive one) get a weight of 1.
+func Weights(weights ...int) ReplicaOption {
+	return func(d *ReplicaDriver) {
+		for i := 0; i < len(weights) && i < len(d.replicas); i++ {
+			if weights[i] > 0 {
+				d.replicas[i].weight = weights[i]
+			}
+		}
+	}
+}
+
+// HealthCheck enables periodic health checks of the replicas. Replicas that
+// fail to respond to a ping within the given interval are excluded from the
+// routing until they respond again. Queries are sent to the primary in case
+// there is no healthy replica.
+func HealthCheck(interval time.Duration) ReplicaOption {
+	return func(d *ReplicaDriver) {
+		d.interval = interval
+	}
+}
+
+// NewReplicaDriver returns a new driver that executes write operations and transactions
+// on the primary driver, and routes Query operations to the replicas in a round-robin order.
+//
+//	drv := sql.NewReplicaDriver(primary, []*sql.Driver{replica1, replica2},
+//		sql.Weights(2, 1),
+//		sql.HealthCheck(5*time.Second),
+//	)
+//	client := ent.NewClient(ent.Driver(drv))
+//
+func NewReplicaDriver(primary *Driver, replicas []*Driver, opts ...ReplicaOption) *ReplicaDriver {
+	d := &ReplicaDriver{primary: primary, done: make(chan struct{})}
+	for _, r := range replicas {
+		d.replicas = append(d.replicas, &replica{Driver: r, weight: 1, healthy: 1})
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	if d.interval > 0 && len(d.replicas) > 0 {
+		d.wg.Add(1)
+		go d.healthCheck()
+	}
+	return d
+}
+
+// primaryKey is the context key for forcing primary reads.
+type primaryKey struct{}
+
+// WithPrimary returns a new context that forces the Query operations executed by the
+// ReplicaDriver to be sent to the primary database. It is useful for reading records
+// immediately after they were written (read-your-writes consistency), as replicas may
+// lag behind the primary.
+//
+//	ctx = sql.WithPrimary(ctx)
+//	u := client.User.Create().SetName("a8m").SaveX(ctx)
+//	u = client.User.GetX(ctx, u.ID)
+//
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// IsPrimary reports if the given context forces reads from the primary database.
+func IsPrimary(ctx context.Context) bool {
+	force, _ := ctx.Value(primaryKey{}).(bool)
+	return force
+}
+
+// Exec implements the dialect.Exec method. It is always executed on the primary.
+func (d *ReplicaDriver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	return d.primary.Exec(ctx, query, args, v)
+}
+
+// Query implements the dialect.Query method. It is executed on one of the healthy
+// replicas, or on the primary if there are none, or primary reads were forced.
+func (d *ReplicaDriver) Query(ctx context.Context, query string, args, v interface{}) error {
+	return d.pick(ctx).Query(ctx, query, args, v)
+}
+
+// Tx starts and returns a transaction on the primary.
+func (d *ReplicaDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.primary.Tx(ctx)
+}
+
+// BeginTx starts a transaction with options on the primary.
+func (d *ReplicaDriver) BeginTx(ctx context.Context, opts *TxOptions) (dialect.Tx, error) {
+	return d.primary.BeginTx(ctx, opts)
+}
+
+// Dialect implements the dialect.Dialect method.
+func (d *ReplicaDriver) Dialect() string {
+	return d.primary.Dialect()
+}
+
+// Primary returns the primary driver.
+func (d *ReplicaDriver) Primary() *Driver {
+	return d.primary
+}
+
+// Close stops the health checks, and closes the primary and replica connections.
+// Calling Close more than once has no effect, and returns the result of the first call.
+func (d *ReplicaDriver) Close() error {
+	d.closeOnce.Do(func() {
+		d.closeErr = d.close()
+	})
+	return d.closeErr
+}
+
+// close stops the health checks, and closes the primary and replica connections.
+func (d *ReplicaDriver) close() error {
+	close(d.done)
+	d.wg.Wait()
+	var errs []error
+	if err := d.primary.Close(); err != nil {
+		errs = append(errs, err)
+	}
+	for _, r := range d.replicas {
+		if err := r.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("dialect/sql: closing replica driver: %v", errs)
+	}
+	return nil
+}
+
+// pick returns the driver for executing a Query operation, using
+// weighted round-robin selection between the healthy replicas.
+func (d *ReplicaDriver) pick(ctx context.Context) *Driver {
+	if IsPrimary(ctx) {
+		return d.primary
+	}
+	var total int
+	for _, r := range d.replicas {
+		if r.isHealthy() {
+			total += r.weight
+		}
+	}
+	if total == 0 {
+		return d.primary
+	}
+	n := int(atomic.AddUint64(&d.next, 1) % uint64(total))
+	for _, r := range d.replicas {
+		if !r.isHealthy() {
+			continue
+		}
+		if n < r.weight {
+			return r.Driver
+		}
+		n -= r.weight
+	}
+	// Health state was changed while picking.
+	return d.primary
+}
+
+// healthCheck pings the replicas periodically until the driver is closed.
+func (d *ReplicaDriver) healthCheck() {
+	defer d.wg.Done()
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-d.done:
+			return
+		case <-ticker.C:
+			var wg sync.WaitGroup
+			for _, r := range d.replicas {
+				wg.Add(1)
+				go func(r *replica) {
+					defer wg.Done()
+					r.ping(d.interval)
+				}(r)
+			}
+			wg.Wait()
+		}
+	}
+}
+
+func (r *replica) isHealthy() bool {
+	return atomic.LoadInt32(&r.healthy) == 1
+}
+
+func (r *replica) ping(timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var healthy int32
+	if err := r.DB().PingContext(ctx); err == nil {
+		healthy = 1
+	}
+	atomic.StoreInt32(&r.healthy, healthy)
+}
+
+var _ dialect.Driver = (*ReplicaDriver)(nil)
@@ -0,0 +1,96 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func newMockDriver(t *testing.T) (*Driver, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	require.NoError(t, err)
+	return OpenDB(dialect.MySQL, db), mock
+}
+
+func TestReplicaDriver(t *testing.T) {
+	primary, pmock := newMockDriver(t)
+	r1, mock1 := newMockDriver(t)
+	r2, mock2 := newMockDriver(t)
+	drv := NewReplicaDriver(primary, []*Driver{r1, r2}, Weights(1, 2))
+	require.Equal(t, dialect.MySQL, drv.Dialect())
+
+	// Queries are distributed between the replicas by their weights.
+	mock2.ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"1"}))
+	mock2.ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"1"}))
+	mock1.ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"1"}))
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		var rows Rows
+		require.NoError(t, drv.Query(ctx, "SELECT 1", []interface{}{}, &rows))
+		require.NoError(t, rows.Close())
+	}
+
+	// Writes, transactions and forced reads are executed on the primary.
+	pmock.ExpectExec("UPDATE `users`").WillReturnResult(sqlmock.NewResult(0, 1))
+	pmock.ExpectQuery("SELECT `name` FROM `users`").WillReturnRows(sqlmock.NewRows([]string{"name"}))
+	pmock.ExpectBegin()
+	pmock.ExpectQuery("SELECT `age` FROM `users`").WillReturnRows(sqlmock.NewRows([]string{"age"}))
+	pmock.ExpectCommit()
+	require.NoError(t, drv.Exec(ctx, "UPDATE `users` SET `name` = ?", []interface{}{"a8m"}, nil))
+	var rows Rows
+	require.NoError(t, drv.Query(WithPrimary(ctx), "SELECT `name` FROM `users`", []interface{}{}, &rows))
+	require.NoError(t, rows.Close())
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Query(ctx, "SELECT `age` FROM `users`", []interface{}{}, &rows))
+	require.NoError(t, rows.Close())
+	require.NoError(t, tx.Commit())
+
+	pmock.ExpectClose()
+	mock1.ExpectClose()
+	mock2.ExpectClose()
+	require.NoError(t, drv.Close())
+	for _, mock := range []sqlmock.Sqlmock{pmock, mock1, mock2} {
+		require.NoError(t, mock.ExpectationsWereMet())
+	}
+}
+
+func TestReplicaDriver_HealthCheck(t *testing.T) {
+	primary, pmock := newMockDriver(t)
+	r1, mock1 := newMockDriver(t)
+	drv := NewReplicaDriver(primary, []*Driver{r1}, HealthCheck(time.Hour))
+	ctx := context.Background()
+
+	// Unhealthy replicas are skipped.
+	mock1.ExpectPing().WillReturnError(errors.New("connection refused"))
+	drv.replicas[0].ping(time.Second)
+	require.Equal(t, primary, drv.pick(ctx))
+
+	// Replicas are routed again after they recover.
+	mock1.ExpectPing()
+	drv.replicas[0].ping(time.Second)
+	require.Equal(t, r1, drv.pick(ctx))
+
+	pmock.ExpectClose()
+	mock1.ExpectClose()
+	require.NoError(t, drv.Close())
+	require.NoError(t, drv.Close(), "closing twice has no effect")
+	require.NoError(t, pmock.ExpectationsWereMet())
+	require.NoError(t, mock1.ExpectationsWereMet())
+}
+
+func TestWithPrimary(t *testing.T) {
+	ctx := context.Background()
+	require.False(t, IsPrimary(ctx))
+	require.True(t, IsPrimary(WithPrimary(ctx)))
+}
@@ -0,0 +1,287 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	// SumFile defines the name of the file holding the checksums
+	// of the files in the migration directory.
+	SumFile = "ent.sum"
+	// upSuffix and downSuffix define the suffixes of the migration files.
+	upSuffix   = ".up.sql"
+	downSuffix = ".down.sql"
+)
+
+// ErrChecksumMismatch is returned when the migration directory
+// does not match the checksums stored in its SumFile. It usually
+// happens when migration files were edited manually, or when they
+// were added concurrently in two different branches.
+var ErrChecksumMismatch = errors.New("sql/schema: checksum mismatch")
+
+// Dir wraps the functionality used for reading and writing versioned migration files.
+type Dir interface {
+	// Files returns the names of the files in the migration directory.
+	Files() ([]string, error)
+	// ReadFile returns the content of the file with the given name.
+	ReadFile(string) ([]byte, error)
+	// WriteFile writes the given data to the file with the given name.
+	WriteFile(string, []byte) error
+}
+
+// LocalDir implements the Dir interface for a local migration directory.
+type LocalDir struct {
+	path string
+}
+
+// NewLocalDir returns a new Dir for the given local path.
+func NewLocalDir(path string) (*LocalDir, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("sql/schema: open migration directory: %w", err)
+	}
+	if !fi.IsDir() {
+		return nil, fmt.Errorf("sql/schema: %q is not a directory", path)
+	}
+	return &LocalDir{path: path}, nil
+}
+
+// Files implements Dir.Files.
+func (d *LocalDir) Files() ([]string, error) {
+	infos, err := ioutil.ReadDir(d.path)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(infos))
+	for _, fi := range infos {
+		if !fi.IsDir() {
+			names = append(names, fi.Name())
+		}
+	}
+	return names, nil
+}
+
+// ReadFile implements Dir.ReadFile.
+func (d *LocalDir) ReadFile(name string) ([]byte, error) {
+	return ioutil.ReadFile(filepath.Join(d.path, name))
+}
+
+// WriteFile implements Dir.WriteFile.
+func (d *LocalDir) WriteFile(name string, b []byte) error {
+	return ioutil.WriteFile(filepath.Join(d.path, name), b, 0644)
+}
+
+// Migration describes a versioned migration in the migration directory.
+// Each migration is composed of an "up" file and an optional "down" file,
+// named as follows: <version>_<description>.up.sql and <version>_<description>.down.sql.
+type Migration struct {
+	Version     string // Version of the migration, usually a timestamp.
+	Description string // Description of the migration.
+	Up          string // Name of the up file.
+	Down        string // Name of the down file (optional).
+}
+
+// Migrations returns the migrations stored in the given directory, sorted by their version.
+func Migrations(dir Dir) ([]*Migration, error) {
+	names, err := dir.Files()
+	if err != nil {
+		return nil, fmt.Errorf("sql/schema: reading migration directory: %w", err)
+	}
+	byVersion := make(map[string]*Migration)
+	for _, name := range names {
+		var base string
+		switch {
+		case strings.HasSuffix(name, upSuffix):
+			base = strings.TrimSuffix(name, upSuffix)
+		case strings.HasSuffix(name, downSuffix):
+			base = strings.TrimSuffix(name, downSuffix)
+		default:
+			continue
+		}
+		parts := strings.SplitN(base, "_", 2)
+		if parts[0] == "" {
+			return nil, fmt.Errorf("sql/schema: missing version for migration file %q", name)
+		}
+		var desc string
+		if len(parts) == 2 {
+			desc = parts[1]
+		}
+		m, ok := byVersion[parts[0]]
+		if !ok {
+			m = &Migration{Version: parts[0], Description: desc}
+			byVersion[parts[0]] = m
+		}
+		file := &m.Down
+		if strings.HasSuffix(name, upSuffix) {
+			file = &m.Up
+		}
+		// Files of the same version must share the same description, and
+		// each version can have only a single up file and a single down file.
+		switch {
+		case *file != "":
+			return nil, fmt.Errorf("sql/schema: duplicate migration files for version %q: %q and %q", m.Version, *file, name)
+		case desc != m.Description:
+			return nil, fmt.Errorf("sql/schema: mismatched descriptions for migration version %q: %q and %q", m.Version, m.Description, desc)
+		}
+		*file = name
+	}
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("sql/schema: missing up file for migration version %q", m.Version)
+		}
+		migrations = append(migrations, m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Checksum returns the checksum of the migration directory and the checksums of each of its files
+// in the format of the SumFile. The first line holds the checksum of the directory, and the rest of
+// the lines hold the checksum of each migration file. For example:
+//
+//	h1:ry6d1BZ0IM2yUlU9bkMQpgd1ZbwNCpLcYqd4x+kHcWM=
+//	20210701120000_init.down.sql h1:+IJdMJuKiyQdyaiRPUKbYi4GrqwBGMMvP0zfIpsZgxQ=
+//	20210701120000_init.up.sql h1:g3mmHQGs1ZmSFt2VmmjoIlhj2Sn4MJ9PoHU/KsjEx6k=
+//
+func Checksum(dir Dir) ([]byte, error) {
+	migrations, err := Migrations(dir)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		lines []string
+		total = sha256.New()
+	)
+	for _, m := range migrations {
+		names := []string{m.Up}
+		if m.Down != "" {
+			names = append([]string{m.Down}, names...)
+		}
+		for _, name := range names {
+			b, err := dir.ReadFile(name)
+			if err != nil {
+				return nil, fmt.Errorf("sql/schema: reading migration file %q: %w", name, err)
+			}
+			sum := fileSum(b)
+			total.Write([]byte(name))
+			total.Write([]byte(sum))
+			lines = append(lines, name+" "+sum)
+		}
+	}
+	lines = append([]string{"h1:" + base64.StdEncoding.EncodeToString(total.Sum(nil))}, lines...)
+	return []byte(strings.Join(lines, "\n") + "\n"), nil
+}
+
+// fileSum returns the checksum of the given file content.
+func fileSum(b []byte) string {
+	h := sha256.Sum256(b)
+	return "h1:" + base64.StdEncoding.EncodeToString(h[:])
+}
+
+// WriteSum writes the SumFile of the given directory.
+func WriteSum(dir Dir) error {
+	sum, err := Checksum(dir)
+	if err != nil {
+		return err
+	}
+	return dir.WriteFile(SumFile, sum)
+}
+
+// Validate checks that the migration directory matches its SumFile. An empty directory
+// without a SumFile is valid, and ErrChecksumMismatch is returned if the checksums do not match.
+func Validate(dir Dir) error {
+	sum, err := Checksum(dir)
+	if err != nil {
+		return err
+	}
+	names, err := dir.Files()
+	if err != nil {
+		return err
+	}
+	var hasSum bool
+	for _, name := range names {
+		hasSum = hasSum || name == SumFile
+	}
+	if !hasSum {
+		migrations, err := Migrations(dir)
+		if err != nil {
+			return err
+		}
+		if len(migrations) > 0 {
+			return fmt.Errorf("%w: missing %s file", ErrChecksumMismatch, SumFile)
+		}
+		return nil
+	}
+	current, err := dir.ReadFile(SumFile)
+	if err != nil {
+		return fmt.Errorf("sql/schema: reading %s file: %w", SumFile, err)
+	}
+	if !bytes.Equal(bytes.TrimSpace(current), bytes.TrimSpace(sum)) {
+		return ErrChecksumMismatch
+	}
+	return nil
+}
+
+// Stmts returns the SQL statements of the given migration file. Statements
+// are separated by semicolons, and lines starting with "--" are ignored.
+func Stmts(b []byte) ([]string, error) {
+	var (
+		stmts []string
+		stmt  strings.Builder
+		quote rune
+	)
+	// Lines are read without a size limit, because statements
+	// (e.g. bulk inserts) may contain arbitrarily long lines.
+	br := bufio.NewReader(bytes.NewReader(b))
+	for done := false; !done; {
+		line, err := br.ReadString('\n')
+		switch {
+		case err == io.EOF:
+			done = true
+		case err != nil:
+			return nil, fmt.Errorf("sql/schema: reading migration statements: %w", err)
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if quote == 0 && strings.HasPrefix(strings.TrimSpace(line), "--") {
+			continue
+		}
+		for _, r := range line {
+			switch {
+			case quote != 0:
+				if r == quote {
+					quote = 0
+				}
+			case r == '\'' || r == '"' || r == '`':
+				quote = r
+			case r == ';':
+				if s := strings.TrimSpace(stmt.String()); s != "" {
+					stmts = append(stmts, s)
+				}
+				stmt.Reset()
+				continue
+			}
+			stmt.WriteRune(r)
+		}
+		stmt.WriteByte('\n')
+	}
+	if s := strings.TrimSpace(stmt.String()); s != "" {
+		stmts = append(stmts, s)
+	}
+	return stmts, nil
+}
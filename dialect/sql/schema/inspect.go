@@ -60,11 +60,82 @@ func (i *Inspector) Tables(ctx context.Context) ([]*Table, error) {
 		if err != nil {
 			return nil, err
 		}
+		if d, ok := i.sqlDialect.(checkLoader); ok {
+			if err := d.checks(ctx, tx, t); err != nil {
+				return nil, err
+			}
+		}
+		if d, ok := i.sqlDialect.(fkLoader); ok {
+			if err := d.foreignKeys(ctx, tx, t); err != nil {
+				return nil, err
+			}
+		}
 		tables = append(tables, t)
 	}
+	linkForeignKeys(tables)
 	return tables, nil
 }
 
+// linkForeignKeys links the referenced tables and columns of the loaded
+// foreign-keys to the inspected tables. References to tables that were not
+// inspected (e.g. tables in other schemas) are left as is.
+func linkForeignKeys(tables []*Table) {
+	byName := make(map[string]*Table, len(tables))
+	for _, t := range tables {
+		byName[t.Name] = t
+	}
+	for _, t := range tables {
+		for _, fk := range t.ForeignKeys {
+			ref, ok := byName[fk.RefTable.Name]
+			if !ok {
+				continue
+			}
+			fk.RefTable = ref
+			// Referenced columns may be omitted (e.g. in SQLite),
+			// and in this case, the primary key is referenced.
+			if len(fk.RefColumns) == 0 {
+				fk.RefColumns = ref.PrimaryKey
+			}
+			for j, c := range fk.RefColumns {
+				if rc, ok := ref.column(c.Name); ok {
+					fk.RefColumns[j] = rc
+				}
+			}
+		}
+	}
+}
+
+// scanForeignKeys scans the rows of the foreign-key columns of the given table. Each row holds
+// the constraint name, the column name, and the referenced table and column names, ordered by
+// their position in the constraint.
+func scanForeignKeys(rows *sql.Rows, t *Table) error {
+	names := make(map[string]*ForeignKey)
+	for rows.Next() {
+		var (
+			symbol, column, refTable string
+			refColumn                sql.NullString
+		)
+		if err := rows.Scan(&symbol, &column, &refTable, &refColumn); err != nil {
+			return fmt.Errorf("scanning foreign-key description: %w", err)
+		}
+		c, ok := t.column(column)
+		if !ok {
+			return fmt.Errorf("foreign-key %q column %q was not found in table %q", symbol, column, t.Name)
+		}
+		fk, ok := names[symbol]
+		if !ok {
+			fk = &ForeignKey{Symbol: symbol, RefTable: NewTable(refTable)}
+			names[symbol] = fk
+			t.ForeignKeys = append(t.ForeignKeys, fk)
+		}
+		fk.Columns = append(fk.Columns, c)
+		if refColumn.Valid && refColumn.String != "" {
+			fk.RefColumns = append(fk.RefColumns, &Column{Name: refColumn.String})
+		}
+	}
+	return rows.Err()
+}
+
 func (i *Inspector) tables(ctx context.Context) ([]string, error) {
 	t, ok := i.sqlDialect.(interface{ tables() sql.Querier })
 	if !ok {
@@ -31,16 +31,18 @@ func TestInspector_Tables(t *testing.T) {
 			name: "default schema",
 			before: map[string]func(mysqlMock){
 				dialect.MySQL: func(mock mysqlMock) {
-					mock.ExpectQuery(escape("SELECT `TABLE_NAME` FROM `INFORMATION_SCHEMA`.`TABLES` WHERE `TABLE_SCHEMA` = (SELECT DATABASE())")).
+					mock.ExpectQuery(escape("SELECT `TABLE_NAME` FROM `INFORMATION_SCHEMA`.`TABLES` WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_TYPE` = ?")).
+						WithArgs("BASE TABLE").
 						WillReturnRows(sqlmock.NewRows([]string{"TABLE_NAME"}))
 				},
 				dialect.SQLite: func(mock mysqlMock) {
-					mock.ExpectQuery(escape("SELECT `name` FROM `sqlite_schema` WHERE `type` = ?")).
-						WithArgs("table").
+					mock.ExpectQuery(escape("SELECT `name` FROM `sqlite_schema` WHERE `type` = ? AND (NOT (`name` LIKE ?))")).
+						WithArgs("table", "sqlite_%").
 						WillReturnRows(sqlmock.NewRows([]string{"name"}))
 				},
 				dialect.Postgres: func(mock mysqlMock) {
-					mock.ExpectQuery(escape(`SELECT "table_name" FROM "information_schema"."tables" WHERE "table_schema" = CURRENT_SCHEMA()`)).
+					mock.ExpectQuery(escape(`SELECT "table_name" FROM "information_schema"."tables" WHERE "table_schema" = CURRENT_SCHEMA() AND "table_type" = $1`)).
+						WithArgs("BASE TABLE").
 						WillReturnRows(sqlmock.NewRows([]string{"name"}))
 				},
 			},
@@ -50,8 +52,8 @@ func TestInspector_Tables(t *testing.T) {
 			options: []InspectOption{WithSchema("public")},
 			before: map[string]func(mysqlMock){
 				dialect.MySQL: func(mock mysqlMock) {
-					mock.ExpectQuery(escape("SELECT `TABLE_NAME` FROM `INFORMATION_SCHEMA`.`TABLES` WHERE `TABLE_SCHEMA` = ?")).
-						WithArgs("public").
+					mock.ExpectQuery(escape("SELECT `TABLE_NAME` FROM `INFORMATION_SCHEMA`.`TABLES` WHERE `TABLE_SCHEMA` = ? AND `TABLE_TYPE` = ?")).
+						WithArgs("public", "BASE TABLE").
 						WillReturnRows(sqlmock.NewRows([]string{"TABLE_NAME"}).
 							AddRow("users"))
 					mock.ExpectQuery(escape("SELECT `column_name`, `column_type`, `is_nullable`, `column_key`, `column_default`, `extra`, `character_set_name`, `collation_name` FROM `INFORMATION_SCHEMA`.`COLUMNS` WHERE `TABLE_SCHEMA` = ? AND `TABLE_NAME` = ?")).
@@ -65,10 +67,23 @@ func TestInspector_Tables(t *testing.T) {
 						WithArgs("public", "users").
 						WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "non_unique", "seq_in_index"}).
 							AddRow("PRIMARY", "id", "0", "1"))
+					mock.ExpectQuery(escape("SELECT `CONSTRAINT_NAME` FROM `INFORMATION_SCHEMA`.`TABLE_CONSTRAINTS` WHERE `TABLE_SCHEMA` = ? AND `TABLE_NAME` = ? AND `CONSTRAINT_TYPE` = ?")).
+						WithArgs("public", "users", "CHECK").
+						WillReturnRows(sqlmock.NewRows([]string{"CONSTRAINT_NAME"}).
+							AddRow("id_check").
+							AddRow("text"))
+					mock.ExpectQuery(escape("SELECT `CONSTRAINT_NAME`, `CHECK_CLAUSE` FROM `INFORMATION_SCHEMA`.`CHECK_CONSTRAINTS` WHERE `CONSTRAINT_SCHEMA` = ? AND `CONSTRAINT_NAME` IN (?, ?) ORDER BY `CONSTRAINT_NAME`")).
+						WithArgs("public", "id_check", "text").
+						WillReturnRows(sqlmock.NewRows([]string{"CONSTRAINT_NAME", "CHECK_CLAUSE"}).
+							AddRow("id_check", "(`id` > 0)").
+							AddRow("text", "json_valid(`text`)"))
+					mock.ExpectQuery(escape("SELECT `CONSTRAINT_NAME`, `COLUMN_NAME`, `REFERENCED_TABLE_NAME`, `REFERENCED_COLUMN_NAME` FROM `INFORMATION_SCHEMA`.`KEY_COLUMN_USAGE` WHERE `TABLE_SCHEMA` = ? AND `TABLE_NAME` = ? AND `REFERENCED_TABLE_NAME` IS NOT NULL ORDER BY `CONSTRAINT_NAME`, `ORDINAL_POSITION`")).
+						WithArgs("public", "users").
+						WillReturnRows(sqlmock.NewRows([]string{"CONSTRAINT_NAME", "COLUMN_NAME", "REFERENCED_TABLE_NAME", "REFERENCED_COLUMN_NAME"}))
 				},
 				dialect.SQLite: func(mock mysqlMock) {
-					mock.ExpectQuery(escape("SELECT `name` FROM `sqlite_schema` WHERE `type` = ?")).
-						WithArgs("table").
+					mock.ExpectQuery(escape("SELECT `name` FROM `sqlite_schema` WHERE `type` = ? AND (NOT (`name` LIKE ?))")).
+						WithArgs("table", "sqlite_%").
 						WillReturnRows(sqlmock.NewRows([]string{"name"}).
 							AddRow("users"))
 					mock.ExpectQuery(escape("SELECT `name`, `type`, `notnull`, `dflt_value`, `pk` FROM pragma_table_info('users') ORDER BY `pk`")).
@@ -80,10 +95,16 @@ func TestInspector_Tables(t *testing.T) {
 							AddRow("uuid", "uuid", 0, "NULL", 0))
 					mock.ExpectQuery(escape("SELECT `name`, `unique`, `origin` FROM pragma_index_list('users')")).
 						WillReturnRows(sqlmock.NewRows([]string{"name", "unique", "unique"}))
+					mock.ExpectQuery(escape("SELECT `sql` FROM `sqlite_master` WHERE `type` = ? AND `name` = ?")).
+						WithArgs("table", "users").
+						WillReturnRows(sqlmock.NewRows([]string{"sql"}).
+							AddRow("CREATE TABLE `users`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `name` varchar(255) NULL, `text` text NULL, `uuid` uuid NULL, CONSTRAINT `id_check` CHECK ((id > 0) AND name != ')'), CHECK (1 = 1))"))
+					mock.ExpectQuery(escape("SELECT `id`, `from`, `table`, `to` FROM pragma_foreign_key_list('users') ORDER BY `id`, `seq`")).
+						WillReturnRows(sqlmock.NewRows([]string{"id", "from", "table", "to"}))
 				},
 				dialect.Postgres: func(mock mysqlMock) {
-					mock.ExpectQuery(escape(`SELECT "table_name" FROM "information_schema"."tables" WHERE "table_schema" = $1`)).
-						WithArgs("public").
+					mock.ExpectQuery(escape(`SELECT "table_name" FROM "information_schema"."tables" WHERE "table_schema" = $1 AND "table_type" = $2`)).
+						WithArgs("public", "BASE TABLE").
 						WillReturnRows(sqlmock.NewRows([]string{"name"}).
 							AddRow("users"))
 					mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "udt_name" FROM "information_schema"."columns" WHERE "table_schema" = $1 AND "table_name" = $2`)).
@@ -97,6 +118,13 @@ func TestInspector_Tables(t *testing.T) {
 						WithArgs("public").
 						WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
 							AddRow("users_pkey", "id", "t", "t", 0))
+					mock.ExpectQuery(escape(fmt.Sprintf(checksQuery, "$1", "$2"))).
+						WithArgs("public", "users").
+						WillReturnRows(sqlmock.NewRows([]string{"check_name", "check_clause"}).
+							AddRow("id_check", "CHECK ((id > 0))"))
+					mock.ExpectQuery(escape(fmt.Sprintf(fksQuery, "$1", "users"))).
+						WithArgs("public").
+						WillReturnRows(sqlmock.NewRows([]string{"constraint_name", "column_name", "referenced_table_name", "referenced_column_name"}))
 				},
 			},
 			tables: func() []*Table {
@@ -111,11 +139,124 @@ func TestInspector_Tables(t *testing.T) {
 						Name:       "users",
 						Columns:    c1,
 						PrimaryKey: c1[0:1],
+						Checks:     []*Check{{Name: "id_check"}},
 					}
 				)
 				return []*Table{t1}
 			}(),
 		},
+		{
+			name: "foreign keys",
+			before: map[string]func(mysqlMock){
+				dialect.MySQL: func(mock mysqlMock) {
+					mock.ExpectQuery(escape("SELECT `TABLE_NAME` FROM `INFORMATION_SCHEMA`.`TABLES` WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_TYPE` = ?")).
+						WithArgs("BASE TABLE").
+						WillReturnRows(sqlmock.NewRows([]string{"TABLE_NAME"}).
+							AddRow("pets").
+							AddRow("users"))
+					for _, name := range []string{"pets", "users"} {
+						rows := sqlmock.NewRows([]string{"column_name", "column_type", "is_nullable", "column_key", "column_default", "extra", "character_set_name", "collation_name"}).
+							AddRow("id", "bigint(20)", "NO", "PRI", "NULL", "auto_increment", "", "")
+						fks := sqlmock.NewRows([]string{"CONSTRAINT_NAME", "COLUMN_NAME", "REFERENCED_TABLE_NAME", "REFERENCED_COLUMN_NAME"})
+						if name == "pets" {
+							rows.AddRow("owner_id", "bigint(20)", "YES", "MUL", "NULL", "", "", "")
+							fks.AddRow("pets_users_pets", "owner_id", "users", "id")
+						}
+						mock.ExpectQuery(escape("SELECT `column_name`, `column_type`, `is_nullable`, `column_key`, `column_default`, `extra`, `character_set_name`, `collation_name` FROM `INFORMATION_SCHEMA`.`COLUMNS` WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ?")).
+							WithArgs(name).
+							WillReturnRows(rows)
+						mock.ExpectQuery(escape("SELECT `index_name`, `column_name`, `non_unique`, `seq_in_index` FROM `INFORMATION_SCHEMA`.`STATISTICS` WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ? ORDER BY `index_name`, `seq_in_index`")).
+							WithArgs(name).
+							WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "non_unique", "seq_in_index"}).
+								AddRow("PRIMARY", "id", "0", "1"))
+						mock.ExpectQuery(escape("SELECT `CONSTRAINT_NAME` FROM `INFORMATION_SCHEMA`.`TABLE_CONSTRAINTS` WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ? AND `CONSTRAINT_TYPE` = ?")).
+							WithArgs(name, "CHECK").
+							WillReturnRows(sqlmock.NewRows([]string{"CONSTRAINT_NAME"}))
+						mock.ExpectQuery(escape("SELECT `CONSTRAINT_NAME`, `COLUMN_NAME`, `REFERENCED_TABLE_NAME`, `REFERENCED_COLUMN_NAME` FROM `INFORMATION_SCHEMA`.`KEY_COLUMN_USAGE` WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ? AND `REFERENCED_TABLE_NAME` IS NOT NULL ORDER BY `CONSTRAINT_NAME`, `ORDINAL_POSITION`")).
+							WithArgs(name).
+							WillReturnRows(fks)
+					}
+				},
+				dialect.SQLite: func(mock mysqlMock) {
+					mock.ExpectQuery(escape("SELECT `name` FROM `sqlite_schema` WHERE `type` = ? AND (NOT (`name` LIKE ?))")).
+						WithArgs("table", "sqlite_%").
+						WillReturnRows(sqlmock.NewRows([]string{"name"}).
+							AddRow("pets").
+							AddRow("users"))
+					for _, name := range []string{"pets", "users"} {
+						rows := sqlmock.NewRows([]string{"name", "type", "notnull", "dflt_value", "pk"}).
+							AddRow("id", "integer", 1, "NULL", 1)
+						fks := sqlmock.NewRows([]string{"id", "from", "table", "to"})
+						if name == "pets" {
+							rows.AddRow("owner_id", "integer", 0, "NULL", 0)
+							fks.AddRow(0, "owner_id", "users", nil)
+						}
+						mock.ExpectQuery(escape(fmt.Sprintf("SELECT `name`, `type`, `notnull`, `dflt_value`, `pk` FROM pragma_table_info('%s') ORDER BY `pk`", name))).
+							WillReturnRows(rows)
+						mock.ExpectQuery(escape(fmt.Sprintf("SELECT `name`, `unique`, `origin` FROM pragma_index_list('%s')", name))).
+							WillReturnRows(sqlmock.NewRows([]string{"name", "unique", "unique"}))
+						mock.ExpectQuery(escape("SELECT `sql` FROM `sqlite_master` WHERE `type` = ? AND `name` = ?")).
+							WithArgs("table", name).
+							WillReturnRows(sqlmock.NewRows([]string{"sql"}).
+								AddRow(fmt.Sprintf("CREATE TABLE `%s`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL)", name)))
+						mock.ExpectQuery(escape(fmt.Sprintf("SELECT `id`, `from`, `table`, `to` FROM pragma_foreign_key_list('%s') ORDER BY `id`, `seq`", name))).
+							WillReturnRows(fks)
+					}
+				},
+				dialect.Postgres: func(mock mysqlMock) {
+					mock.ExpectQuery(escape(`SELECT "table_name" FROM "information_schema"."tables" WHERE "table_schema" = CURRENT_SCHEMA() AND "table_type" = $1`)).
+						WithArgs("BASE TABLE").
+						WillReturnRows(sqlmock.NewRows([]string{"name"}).
+							AddRow("pets").
+							AddRow("users"))
+					for _, name := range []string{"pets", "users"} {
+						rows := sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "udt_name"}).
+							AddRow("id", "bigint", "NO", "NULL", "int8")
+						fks := sqlmock.NewRows([]string{"constraint_name", "column_name", "referenced_table_name", "referenced_column_name"})
+						if name == "pets" {
+							rows.AddRow("owner_id", "bigint", "YES", "NULL", "int8")
+							fks.AddRow("pets_users_pets", "owner_id", "users", "id")
+						}
+						mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "udt_name" FROM "information_schema"."columns" WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+							WithArgs(name).
+							WillReturnRows(rows)
+						mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "CURRENT_SCHEMA()", name))).
+							WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
+								AddRow(name+"_pkey", "id", "t", "t", 0))
+						mock.ExpectQuery(escape(fmt.Sprintf(checksQuery, "CURRENT_SCHEMA()", "$1"))).
+							WithArgs(name).
+							WillReturnRows(sqlmock.NewRows([]string{"check_name", "check_clause"}))
+						mock.ExpectQuery(escape(fmt.Sprintf(fksQuery, "CURRENT_SCHEMA()", name))).
+							WillReturnRows(fks)
+					}
+				},
+			},
+			tables: func() []*Table {
+				var (
+					c1 = []*Column{
+						{Name: "id", Type: field.TypeInt64, Increment: true},
+					}
+					t1 = &Table{
+						Name:       "users",
+						Columns:    c1,
+						PrimaryKey: c1,
+					}
+					c2 = []*Column{
+						{Name: "id", Type: field.TypeInt64, Increment: true},
+						{Name: "owner_id", Type: field.TypeInt64, Nullable: true},
+					}
+					t2 = &Table{
+						Name:       "pets",
+						Columns:    c2,
+						PrimaryKey: c2[0:1],
+						ForeignKeys: []*ForeignKey{
+							{Columns: c2[1:], RefTable: t1, RefColumns: c1},
+						},
+					}
+				)
+				return []*Table{t2, t1}
+			}(),
+		},
 	}
 	for _, tt := range tests {
 		for drv := range tt.before {
@@ -138,6 +279,17 @@ func tablesMatch(t *testing.T, got, expected []*Table) {
 	for i := range got {
 		columnsMatch(t, got[i].Columns, expected[i].Columns)
 		columnsMatch(t, got[i].PrimaryKey, expected[i].PrimaryKey)
+		require.Equal(t, len(expected[i].ForeignKeys), len(got[i].ForeignKeys))
+		for j, fk := range got[i].ForeignKeys {
+			require.Equal(t, expected[i].ForeignKeys[j].RefTable.Name, fk.RefTable.Name)
+			columnsMatch(t, fk.Columns, expected[i].ForeignKeys[j].Columns)
+			columnsMatch(t, fk.RefColumns, expected[i].ForeignKeys[j].RefColumns)
+		}
+		require.Equal(t, len(expected[i].Checks), len(got[i].Checks))
+		for j := range got[i].Checks {
+			require.Equal(t, expected[i].Checks[j].Name, got[i].Checks[j].Name)
+			require.NotEmpty(t, got[i].Checks[j].Expr)
+		}
 	}
 }
 
@@ -51,6 +51,14 @@ func WithDropIndex(b bool) MigrateOption {
 	}
 }
 
+// WithDropCheck sets the CHECK constraints dropping option to the migration.
+// Defaults to false.
+func WithDropCheck(b bool) MigrateOption {
+	return func(m *Migrate) {
+		m.dropChecks = b
+	}
+}
+
 // WithFixture sets the foreign-key renaming option to the migration when upgrading
 // ent from v0.1.0 (issue-#285). Defaults to false.
 func WithFixture(b bool) MigrateOption {
@@ -66,6 +74,14 @@ func WithForeignKeys(b bool) MigrateOption {
 	}
 }
 
+// WithDir sets the migration directory used by the versioned migration
+// mode. See Migrate.Diff for more details.
+func WithDir(dir Dir) MigrateOption {
+	return func(m *Migrate) {
+		m.dir = dir
+	}
+}
+
 // WithHooks adds a list of hooks to the schema migration.
 func WithHooks(hooks ...Hook) MigrateOption {
 	return func(m *Migrate) {
@@ -108,10 +124,12 @@ type Migrate struct {
 	universalID     bool     // global unique ids.
 	dropColumns     bool     // drop deleted columns.
 	dropIndexes     bool     // drop deleted indexes.
+	dropChecks      bool     // drop deleted checks.
 	withFixture     bool     // with fks rename fixture.
 	withForeignKeys bool     // with foreign keys
 	typeRanges      []string // types order by their range.
 	hooks           []Hook   // hooks to apply before creation
+	dir             Dir      // versioned migration directory.
 }
 
 // NewMigrate create a migration structure for the given SQL driver.
@@ -120,6 +138,14 @@ func NewMigrate(d dialect.Driver, opts ...MigrateOption) (*Migrate, error) {
 	for _, opt := range opts {
 		opt(m)
 	}
+	if err := m.setupDialect(d); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// setupDialect sets the SQL dialect of the migration for the given driver.
+func (m *Migrate) setupDialect(d dialect.Driver) error {
 	switch d.Dialect() {
 	case dialect.MySQL:
 		m.sqlDialect = &MySQL{Driver: d}
@@ -128,9 +154,9 @@ func NewMigrate(d dialect.Driver, opts ...MigrateOption) (*Migrate, error) {
 	case dialect.Postgres:
 		m.sqlDialect = &Postgres{Driver: d}
 	default:
-		return nil, fmt.Errorf("sql/schema: unsupported dialect %q", d.Dialect())
+		return fmt.Errorf("sql/schema: unsupported dialect %q", d.Dialect())
 	}
-	return m, nil
+	return nil
 }
 
 // Create creates all schema resources in the database. It works in an "append-only"
@@ -171,8 +197,24 @@ func (m *Migrate) create(ctx context.Context, tables ...*Table) error {
 }
 
 func (m *Migrate) txCreate(ctx context.Context, tx dialect.Tx, tables ...*Table) error {
+	// Views are dropped before the tables are altered, because some databases
+	// fail to alter or drop columns that are used by views.
+	if err := m.dropViews(ctx, tx, tables...); err != nil {
+		return err
+	}
 	for _, t := range tables {
+		// Views are created after all tables were created.
+		if t.View() != nil {
+			continue
+		}
 		m.setupTable(t)
+		// Checks are compared by their names in the migration, and unnamed
+		// checks are named by the database. Hence, checks must be named.
+		for _, c := range t.Checks {
+			if c.Name == "" {
+				return fmt.Errorf("check %q of table %q must have a name", c.Expr, t.Name)
+			}
+		}
 		switch exist, err := m.tableExist(ctx, tx, t.Name); {
 		case err != nil:
 			return err
@@ -187,11 +229,14 @@ func (m *Migrate) txCreate(ctx context.Context, tx dialect.Tx, tables ...*Table)
 			if err := m.fixture(ctx, tx, curr, t); err != nil {
 				return err
 			}
+			if err := m.loadChecks(ctx, tx, curr, t); err != nil {
+				return err
+			}
 			change, err := m.changeSet(curr, t)
 			if err != nil {
 				return err
 			}
-			if err := m.apply(ctx, tx, t.Name, change); err != nil {
+			if err := m.apply(ctx, tx, t, change); err != nil {
 				return err
 			}
 		default: // !exist
@@ -215,13 +260,19 @@ func (m *Migrate) txCreate(ctx context.Context, tx dialect.Tx, tables ...*Table)
 			}
 		}
 	}
-	if !m.withForeignKeys {
-		return nil
+	if m.withForeignKeys {
+		if err := m.createFKs(ctx, tx, tables...); err != nil {
+			return err
+		}
 	}
-	// Create foreign keys after tables were created/altered,
-	// because circular foreign-key constraints are possible.
+	return m.createViews(ctx, tx, tables...)
+}
+
+// createFKs creates the foreign keys of the given tables. It is called after
+// tables were created/altered, because circular foreign-key constraints are possible.
+func (m *Migrate) createFKs(ctx context.Context, tx dialect.Tx, tables ...*Table) error {
 	for _, t := range tables {
-		if len(t.ForeignKeys) == 0 {
+		if len(t.ForeignKeys) == 0 || t.View() != nil {
 			continue
 		}
 		fks := make([]*ForeignKey, 0, len(t.ForeignKeys))
@@ -249,8 +300,56 @@ func (m *Migrate) txCreate(ctx context.Context, tx dialect.Tx, tables ...*Table)
 	return nil
 }
 
+// dropViews drops the views of the given tables (if they exist), in the reverse
+// order of their creation, as views may be defined on top of other views.
+func (m *Migrate) dropViews(ctx context.Context, tx dialect.Tx, tables ...*Table) error {
+	for i := len(tables) - 1; i >= 0; i-- {
+		v := tables[i].View()
+		if v == nil {
+			continue
+		}
+		// Definitions are checked before executing any statement.
+		if v.QueryFor(m.Dialect()) == "" {
+			return fmt.Errorf("missing definition of view %q for dialect %q", tables[i].Name, m.Dialect())
+		}
+		query, args := sql.Dialect(m.Dialect()).DropView(tables[i].Name).Query()
+		if err := tx.Exec(ctx, query, args, nil); err != nil {
+			return fmt.Errorf("drop view %q: %w", tables[i].Name, err)
+		}
+	}
+	return nil
+}
+
+// createViews creates the views of the given tables from their definitions. Views
+// are dropped and recreated in each migration, because their definitions may depend
+// on the tables that were altered.
+func (m *Migrate) createViews(ctx context.Context, tx dialect.Tx, tables ...*Table) error {
+	for _, t := range tables {
+		v := t.View()
+		if v == nil {
+			continue
+		}
+		query, args := sql.Dialect(m.Dialect()).CreateView(t.Name).As(v.QueryFor(m.Dialect())).Query()
+		if err := tx.Exec(ctx, query, args, nil); err != nil {
+			return fmt.Errorf("create view %q: %w", t.Name, err)
+		}
+	}
+	return nil
+}
+
 // apply applies changes on the given table.
-func (m *Migrate) apply(ctx context.Context, tx dialect.Tx, table string, change *changes) error {
+func (m *Migrate) apply(ctx context.Context, tx dialect.Tx, t *Table, change *changes) error {
+	table := t.Name
+	// Dialects that do not support renaming columns using ALTER TABLE
+	// statements (e.g. old versions of SQLite), rebuild the table instead.
+	if rb, ok := m.sqlDialect.(rebuilder); ok && len(change.column.rename) > 0 {
+		switch need, err := rb.needRebuild(ctx, tx); {
+		case err != nil:
+			return err
+		case need:
+			return rb.rebuild(ctx, tx, t, change, m.dropColumns)
+		}
+	}
 	// Constraints should be dropped before dropping columns, because if a column
 	// is a part of multi-column constraints (like, unique index), ALTER TABLE
 	// might fail if the intermediate state violates the constraints.
@@ -266,6 +365,20 @@ func (m *Migrate) apply(ctx context.Context, tx dialect.Tx, table string, change
 			}
 		}
 	}
+	// Modified checks are dropped and added back with their new expressions.
+	drops := change.check.modify
+	if m.dropChecks {
+		drops = append(drops, change.check.drop...)
+	}
+	if len(drops) > 0 {
+		query, args := m.sqlDialect.(checkAlterer).alterChecks(table, nil, drops).Query()
+		if err := tx.Exec(ctx, query, args, nil); err != nil {
+			return fmt.Errorf("drop checks of table %q: %w", table, err)
+		}
+	}
+	if err := m.rename(ctx, tx, t, change); err != nil {
+		return err
+	}
 	var drop []*Column
 	if m.dropColumns {
 		drop = change.column.drop
@@ -284,6 +397,53 @@ func (m *Migrate) apply(ctx context.Context, tx dialect.Tx, table string, change
 			return fmt.Errorf("create index %q: %w", table, err)
 		}
 	}
+	// Checks are added last, because they may refer to new columns.
+	if adds := append(change.check.modify, change.check.add...); len(adds) > 0 {
+		query, args := m.sqlDialect.(checkAlterer).alterChecks(table, adds, nil).Query()
+		if err := tx.Exec(ctx, query, args, nil); err != nil {
+			return fmt.Errorf("add checks to table %q: %w", table, err)
+		}
+	}
+	return nil
+}
+
+// loadChecks loads the CHECK constraints of the current table, in case the
+// dialect supports altering them and the migration needs to diff them.
+func (m *Migrate) loadChecks(ctx context.Context, tx dialect.Tx, curr, new *Table) error {
+	d, ok := m.sqlDialect.(checkAlterer)
+	if !ok || !d.supportsCheck() || len(new.Checks) == 0 && !m.dropChecks {
+		return nil
+	}
+	return d.checks(ctx, tx, curr)
+}
+
+// rename applies the column and index renames on the given table.
+func (m *Migrate) rename(ctx context.Context, tx dialect.Tx, t *Table, change *changes) error {
+	for _, r := range change.column.rename {
+		query, args := m.sqlDialect.(columnRenamer).renameColumn(t, r.from, r.to).Query()
+		if err := tx.Exec(ctx, query, args, nil); err != nil {
+			return fmt.Errorf("rename column %q: %w", r.from.Name, err)
+		}
+	}
+	for _, r := range change.index.rename {
+		// Dialects that do not support renaming indexes,
+		// drop the index and create it with the new name.
+		d, ok := m.sqlDialect.(indexRenamer)
+		if !ok {
+			if err := m.dropIndex(ctx, tx, r.from, t.Name); err != nil {
+				return fmt.Errorf("drop index of table %q: %w", t.Name, err)
+			}
+			query, args := m.addIndex(r.to, t.Name).Query()
+			if err := tx.Exec(ctx, query, args, nil); err != nil {
+				return fmt.Errorf("create index %q: %w", r.to.Name, err)
+			}
+			continue
+		}
+		query, args := d.renameIndex(t, r.from, r.to).Query()
+		if err := tx.Exec(ctx, query, args, nil); err != nil {
+			return fmt.Errorf("rename index %q: %w", r.from.Name, err)
+		}
+	}
 	return nil
 }
 
@@ -294,14 +454,32 @@ type changes struct {
 		add    []*Column
 		drop   []*Column
 		modify []*Column
+		rename []*renameColumn
 	}
 	// index changes.
 	index struct {
-		add  Indexes
-		drop Indexes
+		add    Indexes
+		drop   Indexes
+		rename []*renameIndex
+	}
+	// check changes.
+	check struct {
+		add    []*Check
+		drop   []*Check
+		modify []*Check
 	}
 }
 
+// renameColumn describes a column rename.
+type renameColumn struct {
+	from, to *Column
+}
+
+// renameIndex describes an index rename.
+type renameIndex struct {
+	from, to *Index
+}
+
 // dropColumn returns the dropped column by name (if any).
 func (c *changes) dropColumn(name string) (*Column, bool) {
 	for _, col := range c.column.drop {
@@ -327,6 +505,20 @@ func (m *Migrate) changeSet(curr, new *Table) (*changes, error) {
 			return nil, fmt.Errorf("cannot change primary key for table: %q", curr.Name)
 		}
 	}
+	// Rename columns.
+	for _, c1 := range new.Columns {
+		if c1.RenamedFrom == "" || c1.PrimaryKey() || curr.HasColumn(c1.Name) {
+			continue
+		}
+		if _, ok := new.column(c1.RenamedFrom); ok {
+			return nil, fmt.Errorf("column %q is renamed from %q, but %q still exists in table %q", c1.Name, c1.RenamedFrom, c1.RenamedFrom, curr.Name)
+		}
+		if c2, ok := curr.column(c1.RenamedFrom); ok {
+			if err := m.renameColumn(change, curr, c2, c1); err != nil {
+				return nil, err
+			}
+		}
+	}
 	// Add or modify columns.
 	for _, c1 := range new.Columns {
 		// Ignore primary keys.
@@ -376,8 +568,35 @@ func (m *Migrate) changeSet(curr, new *Table) (*changes, error) {
 		}
 	}
 
+	// Rename indexes. An index is renamed if it does not exist in the
+	// new schema, and it has an ~identical index with a different name.
+	renamed := make(map[*Index]bool)
+	for _, r := range change.index.rename {
+		renamed[r.from] = true
+	}
+	for _, idx1 := range new.Indexes {
+		if _, ok := curr.index(idx1.Name); ok {
+			continue
+		}
+		for _, idx2 := range curr.Indexes {
+			_, ok1 := new.fk(idx2.Name)
+			_, ok2 := new.index(idx2.Name)
+			if !ok1 && !ok2 && !idx2.primary && !renamed[idx2] && idx2.sameAs(idx1) {
+				change.index.rename = append(change.index.rename, &renameIndex{from: idx2, to: idx1})
+				renamed[idx2] = true
+				break
+			}
+		}
+	}
+	for _, r := range change.index.rename {
+		renamed[r.to] = true
+	}
+
 	// Add or modify indexes.
 	for _, idx1 := range new.Indexes {
+		if renamed[idx1] {
+			continue
+		}
 		switch idx2, ok := curr.index(idx1.Name); {
 		case !ok:
 			change.index.add.append(idx1)
@@ -392,13 +611,59 @@ func (m *Migrate) changeSet(curr, new *Table) (*changes, error) {
 	for _, idx := range curr.Indexes {
 		_, ok1 := new.fk(idx.Name)
 		_, ok2 := new.index(idx.Name)
-		if !ok1 && !ok2 {
+		if !ok1 && !ok2 && !renamed[idx] {
 			change.index.drop.append(idx)
 		}
 	}
+
+	// Add, modify or drop checks. Checks are matched by their names,
+	// and their expressions are compared in their normalized form.
+	if d, ok := m.sqlDialect.(checkAlterer); ok && d.supportsCheck() {
+		for _, c1 := range new.Checks {
+			switch c2, ok := curr.check(c1.Name); {
+			case !ok:
+				change.check.add = append(change.check.add, c1)
+			case normalizeCheck(m.Dialect(), c1.expr(m.Dialect())) != normalizeCheck(m.Dialect(), c2.Expr):
+				change.check.modify = append(change.check.modify, c1)
+			}
+		}
+		for _, c := range curr.Checks {
+			if _, ok := new.check(c.Name); !ok {
+				change.check.drop = append(change.check.drop, c)
+			}
+		}
+	}
 	return change, nil
 }
 
+// renameColumn adds the rename of the column to the change-set, and updates the loaded table
+// to reflect the rename. Implicit indexes that were created for unique columns are renamed too.
+func (m *Migrate) renameColumn(change *changes, curr *Table, c2, c1 *Column) error {
+	if _, ok := m.sqlDialect.(columnRenamer); !ok {
+		return fmt.Errorf("renaming columns is not supported by %q dialect", m.Dialect())
+	}
+	from := *c2
+	change.column.rename = append(change.column.rename, &renameColumn{from: &from, to: c1})
+	d, ok := m.sqlDialect.(indexRenamer)
+	for _, idx := range c2.indexes {
+		switch {
+		case ok && d.isImplicitIndex(idx, &from):
+			to := &Index{Name: c1.Name, Unique: true, Columns: []*Column{c1}}
+			change.index.rename = append(change.index.rename, &renameIndex{from: idx, to: to})
+		// Implicit indexes of dialects that do not support
+		// renaming indexes are renamed with their column.
+		case !ok && idx.Unique && len(idx.Columns) == 1 && idx.Name == from.Name:
+			idx.Name = c1.Name
+		}
+	}
+	// Update the name of the loaded column, so `changeSet`
+	// will compare it with the renamed column in the schema.
+	delete(curr.columns, c2.Name)
+	c2.Name = c1.Name
+	curr.columns[c2.Name] = c2
+	return nil
+}
+
 // fixture is a special migration code for renaming foreign-key columns (issue-#285).
 func (m *Migrate) fixture(ctx context.Context, tx dialect.Tx, curr, new *Table) error {
 	d, ok := m.sqlDialect.(fkRenamer)
@@ -653,6 +918,44 @@ type preparer interface {
 	prepare(context.Context, dialect.Tx, *changes, string) error
 }
 
+// columnRenamer wraps the method for renaming columns.
+type columnRenamer interface {
+	renameColumn(*Table, *Column, *Column) sql.Querier
+}
+
+// indexRenamer wraps the methods for renaming indexes. Dialects that do not implement
+// this interface, drop the index and create it with the new name instead.
+type indexRenamer interface {
+	isImplicitIndex(*Index, *Column) bool
+	renameIndex(*Table, *Index, *Index) sql.Querier
+}
+
+// rebuilder is implemented by dialects that cannot rename columns using
+// ALTER TABLE statements in some versions, and rebuild the table instead.
+type rebuilder interface {
+	needRebuild(context.Context, dialect.Tx) (bool, error)
+	rebuild(context.Context, dialect.Tx, *Table, *changes, bool) error
+}
+
+// checkLoader wraps the method for loading the CHECK constraints of a table.
+type checkLoader interface {
+	checks(context.Context, dialect.Tx, *Table) error
+}
+
+// fkLoader wraps the method for loading the foreign-keys of a table. The referenced
+// tables of the loaded foreign-keys are linked by the Inspector after all tables were loaded.
+type fkLoader interface {
+	foreignKeys(context.Context, dialect.Tx, *Table) error
+}
+
+// checkAlterer is implemented by dialects that support adding
+// and dropping CHECK constraints of existing tables.
+type checkAlterer interface {
+	checkLoader
+	supportsCheck() bool
+	alterChecks(table string, add, drop []*Check) sql.Querier
+}
+
 // fkRenamer is used by the fixture migration (to solve #285),
 // and it's implemented by the different dialects for renaming FKs.
 type fkRenamer interface {
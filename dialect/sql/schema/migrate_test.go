@@ -8,6 +8,8 @@ import (
 	"context"
 	"testing"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/require"
@@ -59,3 +61,45 @@ func TestMigrateHookAddTable(t *testing.T) {
 	err = migrate.Create(context.Background(), tables...)
 	require.NoError(t, err)
 }
+
+func TestMigrateViews(t *testing.T) {
+	db, mk, err := sqlmock.New()
+	require.NoError(t, err)
+
+	view := &Table{
+		Name: "user_names",
+		Annotation: &entsql.Annotation{
+			View: &entsql.View{
+				Query: "SELECT id, name FROM users",
+				Queries: map[string]string{
+					dialect.MySQL: "SELECT `id`, `name` FROM `users`",
+				},
+			},
+		},
+	}
+	tables := []*Table{view, {Name: "users"}}
+	mock := mysqlMock{mk}
+	mock.start("5.7.23")
+	// Views are dropped before the tables are altered, and created after all tables were created.
+	mock.ExpectExec(escape("DROP VIEW IF EXISTS `user_names`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.tableExists("users", false)
+	mock.ExpectExec(escape("CREATE TABLE IF NOT EXISTS `users`() CHARACTER SET utf8mb4 COLLATE utf8mb4_bin")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(escape("CREATE VIEW `user_names` AS SELECT `id`, `name` FROM `users`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	migrate, err := NewMigrate(sql.OpenDB("mysql", db))
+	require.NoError(t, err)
+	err = migrate.Create(context.Background(), tables...)
+	require.NoError(t, err)
+	require.NoError(t, mk.ExpectationsWereMet())
+
+	view.Annotation.View.Queries = nil
+	view.Annotation.View.Query = ""
+	mock.start("5.7.23")
+	mock.ExpectRollback()
+	err = migrate.Create(context.Background(), view)
+	require.EqualError(t, err, `sql/schema: missing definition of view "user_names" for dialect "mysql"`)
+}
@@ -179,6 +179,9 @@ func (d *MySQL) tBuilder(t *Table) *sql.TableBuilder {
 	for _, pk := range t.PrimaryKey {
 		b.PrimaryKey(pk.Name)
 	}
+	for _, c := range t.Checks {
+		b.Check(c.Name, c.DSL(dialect.MySQL))
+	}
 	// Charset and collation config on MySQL table.
 	// These options can be overridden by the entsql annotation.
 	b.Charset("utf8mb4").Collate("utf8mb4_bin")
@@ -532,10 +535,14 @@ func (d *MySQL) matchSchema(columns ...string) *sql.Predicate {
 }
 
 // tables returns the query for getting the in the schema.
+// Views are ignored.
 func (d *MySQL) tables() sql.Querier {
 	return sql.Select("TABLE_NAME").
 		From(sql.Table("TABLES").Schema("INFORMATION_SCHEMA")).
-		Where(d.matchSchema())
+		Where(sql.And(
+			d.matchSchema(),
+			sql.EQ("TABLE_TYPE", "BASE TABLE"),
+		))
 }
 
 // alterColumns returns the queries for applying the columns change-set.
@@ -602,6 +609,120 @@ func (d *MySQL) normalizeJSON(ctx context.Context, tx dialect.Tx, t *Table) erro
 	return rows.Close()
 }
 
+// checks loads the CHECK constraints of the given table. Note that CHECK constraints are
+// enforced only from MySQL 8.0.16 and MariaDB 10.2.1, and in older versions, the table
+// constraints list does not contain them.
+func (d *MySQL) checks(ctx context.Context, tx dialect.Tx, t *Table) error {
+	rows := &sql.Rows{}
+	query, args := sql.Select("CONSTRAINT_NAME").
+		From(sql.Table("TABLE_CONSTRAINTS").Schema("INFORMATION_SCHEMA")).
+		Where(sql.And(
+			d.matchSchema(),
+			sql.EQ("TABLE_NAME", t.Name),
+			sql.EQ("CONSTRAINT_TYPE", "CHECK"),
+		)).
+		Query()
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return fmt.Errorf("mysql: query table checks %w", err)
+	}
+	// Call Close in cases of failures (Close is idempotent).
+	defer rows.Close()
+	var names []driver.Value
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return fmt.Errorf("mysql: scan table checks: %w", err)
+		}
+		names = append(names, name)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if err := rows.Close(); err != nil {
+		return fmt.Errorf("mysql: closing rows %w", err)
+	}
+	t.Checks = nil
+	if len(names) == 0 {
+		return nil
+	}
+	query, args = sql.Select("CONSTRAINT_NAME", "CHECK_CLAUSE").
+		From(sql.Table("CHECK_CONSTRAINTS").Schema("INFORMATION_SCHEMA")).
+		Where(sql.And(
+			d.matchSchema("CONSTRAINT_SCHEMA"),
+			sql.InValues("CONSTRAINT_NAME", names...),
+		)).
+		OrderBy("CONSTRAINT_NAME").
+		Query()
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return fmt.Errorf("mysql: query check constraints %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name, clause string
+		if err := rows.Scan(&name, &clause); err != nil {
+			return fmt.Errorf("mysql: scan check constraints: %w", err)
+		}
+		// Skip the JSON validation constraints that are created by MariaDB for JSON columns.
+		if _, ok := t.column(name); ok && strings.HasPrefix(clause, "json_valid") {
+			continue
+		}
+		t.AddCheck(&Check{Name: name, Expr: clause})
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	return rows.Close()
+}
+
+// foreignKeys loads the foreign-keys of the given table.
+func (d *MySQL) foreignKeys(ctx context.Context, tx dialect.Tx, t *Table) error {
+	rows := &sql.Rows{}
+	query, args := sql.Select("CONSTRAINT_NAME", "COLUMN_NAME", "REFERENCED_TABLE_NAME", "REFERENCED_COLUMN_NAME").
+		From(sql.Table("KEY_COLUMN_USAGE").Schema("INFORMATION_SCHEMA")).
+		Where(sql.And(
+			d.matchSchema(),
+			sql.EQ("TABLE_NAME", t.Name),
+			sql.NotNull("REFERENCED_TABLE_NAME"),
+		)).
+		OrderBy("CONSTRAINT_NAME", "ORDINAL_POSITION").
+		Query()
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return fmt.Errorf("mysql: query table foreign-keys %w", err)
+	}
+	// Call Close in cases of failures (Close is idempotent).
+	defer rows.Close()
+	if err := scanForeignKeys(rows, t); err != nil {
+		return fmt.Errorf("mysql: %w", err)
+	}
+	return rows.Close()
+}
+
+// supportsCheck reports if the database enforces CHECK constraints.
+func (d *MySQL) supportsCheck() bool {
+	if version, ok := d.mariadb(); ok {
+		return compareVersions(version, "10.2.1") >= 0
+	}
+	return compareVersions(d.version, "8.0.16") >= 0
+}
+
+// alterChecks returns the query for adding and dropping the given CHECK constraints.
+func (d *MySQL) alterChecks(table string, add, drop []*Check) sql.Querier {
+	b := sql.Dialect(dialect.MySQL).AlterTable(table)
+	_, maria := d.mariadb()
+	for _, c := range drop {
+		// MariaDB does not support the `DROP CHECK` clause.
+		if maria {
+			b.DropConstraint(c.Name)
+		} else {
+			b.DropCheck(c.Name)
+		}
+	}
+	for _, c := range add {
+		b.AddCheck(c.Name, c.DSL(dialect.MySQL))
+	}
+	return b
+}
+
 // mariadb reports if the migration runs on MariaDB and returns the semver string.
 func (d *MySQL) mariadb() (string, bool) {
 	idx := strings.Index(d.version, "MariaDB")
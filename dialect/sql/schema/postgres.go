@@ -218,6 +218,106 @@ func (d *Postgres) indexes(ctx context.Context, tx dialect.Tx, table string) (In
 	return idxs, nil
 }
 
+// checksQuery holds a query format for retrieving
+// the CHECK constraints of the current schema.
+const checksQuery = `
+SELECT c.conname AS check_name,
+       pg_get_constraintdef(c.oid) AS check_clause
+FROM pg_constraint c,
+     pg_class t,
+     pg_namespace n
+WHERE c.conrelid = t.oid
+  AND t.relnamespace = n.oid
+  AND c.contype = 'c'
+  AND n.nspname = %s
+  AND t.relname = %s
+ORDER BY check_name;
+`
+
+// checksQuery returns the query (and its arguments) for getting the CHECK constraints of a table.
+func (d *Postgres) checksQuery(table string) (string, []interface{}) {
+	if d.schema != "" {
+		return fmt.Sprintf(checksQuery, "$1", "$2"), []interface{}{d.schema, table}
+	}
+	return fmt.Sprintf(checksQuery, "CURRENT_SCHEMA()", "$1"), []interface{}{table}
+}
+
+// checks loads the CHECK constraints of the given table.
+func (d *Postgres) checks(ctx context.Context, tx dialect.Tx, t *Table) error {
+	rows := &sql.Rows{}
+	query, args := d.checksQuery(t.Name)
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return fmt.Errorf("querying checks for table %s: %w", t.Name, err)
+	}
+	defer rows.Close()
+	t.Checks = nil
+	for rows.Next() {
+		var name, clause string
+		if err := rows.Scan(&name, &clause); err != nil {
+			return fmt.Errorf("scanning check description: %w", err)
+		}
+		// Definitions are returned as "CHECK ((expr))".
+		clause = strings.TrimPrefix(clause, "CHECK ")
+		if strings.HasPrefix(clause, "(") && strings.HasSuffix(clause, ")") {
+			clause = clause[1 : len(clause)-1]
+		}
+		t.AddCheck(&Check{Name: name, Expr: clause})
+	}
+	return rows.Err()
+}
+
+// fksQuery holds a query format for retrieving the
+// foreign-keys columns of the current schema.
+const fksQuery = `
+SELECT c.conname AS constraint_name,
+       a.attname AS column_name,
+       r.relname AS referenced_table_name,
+       ra.attname AS referenced_column_name
+FROM pg_constraint c
+         JOIN pg_class t ON t.oid = c.conrelid
+         JOIN pg_namespace n ON n.oid = t.relnamespace
+         JOIN pg_class r ON r.oid = c.confrelid
+         CROSS JOIN LATERAL unnest(c.conkey, c.confkey) WITH ORDINALITY AS k(attnum, refnum, seq)
+         JOIN pg_attribute a ON a.attrelid = c.conrelid AND a.attnum = k.attnum
+         JOIN pg_attribute ra ON ra.attrelid = c.confrelid AND ra.attnum = k.refnum
+WHERE c.contype = 'f'
+  AND n.nspname = %s
+  AND t.relname = '%s'
+ORDER BY constraint_name, k.seq;
+`
+
+// foreignKeys loads the foreign-keys of the given table.
+func (d *Postgres) foreignKeys(ctx context.Context, tx dialect.Tx, t *Table) error {
+	var (
+		args []interface{}
+		rows = &sql.Rows{}
+	)
+	query := fmt.Sprintf(fksQuery, "CURRENT_SCHEMA()", t.Name)
+	if d.schema != "" {
+		query, args = fmt.Sprintf(fksQuery, "$1", t.Name), []interface{}{d.schema}
+	}
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return fmt.Errorf("querying foreign-keys for table %s: %w", t.Name, err)
+	}
+	defer rows.Close()
+	return scanForeignKeys(rows, t)
+}
+
+// supportsCheck reports if the database enforces CHECK constraints.
+func (d *Postgres) supportsCheck() bool { return true }
+
+// alterChecks returns the query for adding and dropping the given CHECK constraints.
+func (d *Postgres) alterChecks(table string, add, drop []*Check) sql.Querier {
+	b := sql.Dialect(dialect.Postgres).AlterTable(table)
+	for _, c := range drop {
+		b.DropCheck(c.Name)
+	}
+	for _, c := range add {
+		b.AddCheck(c.Name, c.DSL(dialect.Postgres))
+	}
+	return b
+}
+
 // maxCharSize defines the maximum size of limited character types in Postgres (10 MB).
 const maxCharSize = 10 << 20
 
@@ -291,6 +391,9 @@ func (d *Postgres) tBuilder(t *Table) *sql.TableBuilder {
 	for _, pk := range t.PrimaryKey {
 		b.PrimaryKey(pk.Name)
 	}
+	for _, c := range t.Checks {
+		b.Check(c.Name, c.DSL(dialect.Postgres))
+	}
 	return b
 }
 
@@ -459,11 +562,15 @@ func (d *Postgres) matchSchema(columns ...string) *sql.Predicate {
 }
 
 // tables returns the query for getting the in the schema.
+// Views are ignored.
 func (d *Postgres) tables() sql.Querier {
 	return sql.Dialect(dialect.Postgres).
 		Select("table_name").
 		From(sql.Table("tables").Schema("information_schema")).
-		Where(d.matchSchema())
+		Where(sql.And(
+			d.matchSchema(),
+			sql.EQ("table_type", "BASE TABLE"),
+		))
 }
 
 // alterColumns returns the queries for applying the columns change-set.
@@ -593,6 +593,147 @@ func TestPostgres_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "rename column",
+			tables: []*Table{
+				{
+					Name: "users",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "nickname", Type: field.TypeInt, Unique: true, RenamedFrom: "age"},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+				},
+			},
+			options: []MigrateOption{WithDropIndex(true), WithDropColumn(true)},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "udt_name" FROM "information_schema"."columns" WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "udt_name"}).
+						AddRow("id", "bigint", "NO", "NULL", "int8").
+						AddRow("age", "bigint", "NO", "NULL", "int8"))
+				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "CURRENT_SCHEMA()", "users"))).
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
+						AddRow("users_pkey", "id", "t", "t", 0).
+						AddRow("users_age_key", "age", "f", "t", 0))
+				mock.ExpectExec(escape(`ALTER TABLE "users" RENAME COLUMN "age" TO "nickname"`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape(`ALTER INDEX "users_age_key" RENAME TO "users_nickname_key"`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "add and drop checks",
+			tables: []*Table{
+				{
+					Name: "users",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "age", Type: field.TypeInt},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Checks: []*Check{
+						{Name: "age_check", Expr: "age > 0"},
+						{Name: "id_check", Expr: "id > 0", Exprs: map[string]string{dialect.Postgres: `"id" > 0`}},
+					},
+				},
+			},
+			options: []MigrateOption{WithDropCheck(true)},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "udt_name" FROM "information_schema"."columns" WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "udt_name"}).
+						AddRow("id", "bigint", "NO", "NULL", "int8").
+						AddRow("age", "bigint", "NO", "NULL", "int8"))
+				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "CURRENT_SCHEMA()", "users"))).
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
+						AddRow("users_pkey", "id", "t", "t", 0))
+				mock.ExpectQuery(escape(fmt.Sprintf(checksQuery, "CURRENT_SCHEMA()", "$1"))).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"check_name", "check_clause"}).
+						AddRow("age_check", "CHECK ((age > 0))").
+						AddRow("users_age_check", "CHECK ((age < 100))"))
+				mock.ExpectExec(escape(`ALTER TABLE "users" DROP CONSTRAINT "users_age_check"`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape(`ALTER TABLE "users" ADD CONSTRAINT "id_check" CHECK ("id" > 0)`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "modify checks",
+			tables: []*Table{
+				{
+					Name: "users",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "name", Type: field.TypeString},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Checks: []*Check{
+						{Name: "name_check", Expr: "name != '' AND name != 'root'"},
+						{Name: "id_check", Expr: "id > 0", Exprs: map[string]string{dialect.Postgres: `"id" > 0`}},
+					},
+				},
+			},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "udt_name" FROM "information_schema"."columns" WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "udt_name"}).
+						AddRow("id", "bigint", "NO", "NULL", "int8").
+						AddRow("name", "character varying", "NO", "NULL", "varchar"))
+				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "CURRENT_SCHEMA()", "users"))).
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
+						AddRow("users_pkey", "id", "t", "t", 0))
+				mock.ExpectQuery(escape(fmt.Sprintf(checksQuery, "CURRENT_SCHEMA()", "$1"))).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"check_name", "check_clause"}).
+						AddRow("name_check", "CHECK ((((name)::text <> ''::text) AND ((name)::text <> 'root'::text)))").
+						AddRow("id_check", "CHECK ((id > 1))"))
+				mock.ExpectExec(escape(`ALTER TABLE "users" DROP CONSTRAINT "id_check"`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape(`ALTER TABLE "users" ADD CONSTRAINT "id_check" CHECK ("id" > 0)`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "unnamed checks",
+			tables: []*Table{
+				{
+					Name: "users",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "age", Type: field.TypeInt},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Checks: []*Check{
+						{Expr: "age > 0"},
+					},
+				},
+			},
+			options: []MigrateOption{WithDropCheck(true)},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
 		{
 			name: "add and remove indexes",
 			tables: func() []*Table {
@@ -7,9 +7,11 @@ package schema
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/schema/field"
@@ -34,6 +36,7 @@ type Table struct {
 	Indexes     []*Index
 	PrimaryKey  []*Column
 	ForeignKeys []*ForeignKey
+	Checks      []*Check
 	Annotation  *entsql.Annotation
 }
 
@@ -72,12 +75,26 @@ func (t *Table) HasColumn(name string) bool {
 	return ok
 }
 
+// AddCheck adds a CHECK constraint to the table.
+func (t *Table) AddCheck(c *Check) *Table {
+	t.Checks = append(t.Checks, c)
+	return t
+}
+
 // SetAnnotation the entsql.Annotation on the table.
 func (t *Table) SetAnnotation(ant *entsql.Annotation) *Table {
 	t.Annotation = ant
 	return t
 }
 
+// View returns the view definition of the table, or nil if it is not a view.
+func (t *Table) View() *entsql.View {
+	if t.Annotation == nil {
+		return nil
+	}
+	return t.Annotation.View
+}
+
 // AddIndex creates and adds a new index to the table from the given options.
 func (t *Table) AddIndex(name string, unique bool, columns []string) *Table {
 	return t.addIndex(&Index{
@@ -150,22 +167,101 @@ func (t *Table) fk(symbol string) (*ForeignKey, bool) {
 	return nil, false
 }
 
+// check returns a table CHECK constraint by its name.
+func (t *Table) check(name string) (*Check, bool) {
+	for _, c := range t.Checks {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// Check schema definition for SQL dialects.
+type Check struct {
+	Name  string            // constraint name.
+	Expr  string            // raw SQL expression.
+	Exprs map[string]string // optional expression per dialect.
+}
+
+// expr returns the CHECK expression of the given dialect.
+func (c *Check) expr(dialect string) string {
+	if expr, ok := c.Exprs[dialect]; ok {
+		return expr
+	}
+	return c.Expr
+}
+
+// DSL returns a function that writes the CHECK expression of the given dialect.
+func (c *Check) DSL(dialect string) func(*sql.Builder) {
+	return func(b *sql.Builder) {
+		b.WriteString(c.expr(dialect))
+	}
+}
+
+var (
+	// reCharset matches the charset introducers that MySQL adds to string literals.
+	reCharset = regexp.MustCompile(`_(utf8mb4|utf8mb3|utf8|latin1|binary)'`)
+	// reCast matches the type casts that Postgres adds to literals.
+	reCast = regexp.MustCompile(`::(character varying|double precision|timestamp with(out)? time zone|[a-z_]+)(\[\])?`)
+)
+
+// normalizeCheck returns the normalized form of a CHECK expression, used for comparing the
+// expressions that are defined in the schema with the ones that are loaded from the database.
+// Databases store the expressions in a canonical form that quotes identifiers, wraps expressions
+// with parentheses and adds explicit casts or charsets to literals. Hence, these differences are
+// ignored, and changes that affect only the precedence of operators are not detected.
+func normalizeCheck(name, expr string) string {
+	switch name {
+	case dialect.MySQL:
+		expr = reCharset.ReplaceAllString(expr, "'")
+	case dialect.Postgres:
+		expr = reCast.ReplaceAllString(expr, "")
+	}
+	var (
+		b     strings.Builder
+		quote byte
+	)
+	for i := 0; i < len(expr); i++ {
+		switch c := expr[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+			b.WriteByte(c)
+		case c == '\'':
+			quote = c
+			b.WriteByte(c)
+		case c == '`' || c == '"' || c == '(' || c == ')' || c == ' ' || c == '\t' || c == '\n':
+		case c == '!' && i+1 < len(expr) && expr[i+1] == '=':
+			b.WriteString("<>")
+			i++
+		case c >= 'A' && c <= 'Z':
+			b.WriteByte(c + 'a' - 'A')
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
 // Column schema definition for SQL dialects.
 type Column struct {
-	Name       string            // column name.
-	Type       field.Type        // column type.
-	SchemaType map[string]string // optional schema type per dialect.
-	Attr       string            // extra attributes.
-	Size       int64             // max size parameter for string, blob, etc.
-	Key        string            // key definition (PRI, UNI or MUL).
-	Unique     bool              // column with unique constraint.
-	Increment  bool              // auto increment attribute.
-	Nullable   bool              // null or not null attribute.
-	Default    interface{}       // default value.
-	Enums      []string          // enum values.
-	typ        string            // row column type (used for Rows.Scan).
-	indexes    Indexes           // linked indexes.
-	foreign    *ForeignKey       // linked foreign-key.
+	Name        string            // column name.
+	Type        field.Type        // column type.
+	SchemaType  map[string]string // optional schema type per dialect.
+	Attr        string            // extra attributes.
+	Size        int64             // max size parameter for string, blob, etc.
+	Key         string            // key definition (PRI, UNI or MUL).
+	Unique      bool              // column with unique constraint.
+	Increment   bool              // auto increment attribute.
+	Nullable    bool              // null or not null attribute.
+	Default     interface{}       // default value.
+	Enums       []string          // enum values.
+	RenamedFrom string            // previous column name (used for renaming columns).
+	typ         string            // row column type (used for Rows.Scan).
+	indexes     Indexes           // linked indexes.
+	foreign     *ForeignKey       // linked foreign-key.
 }
 
 // UniqueKey returns boolean indicates if this column is a unique key.
@@ -7,6 +7,7 @@ package schema
 import (
 	"testing"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/schema/field"
 
 	"github.com/stretchr/testify/require"
@@ -95,3 +96,20 @@ func TestColumn_ScanDefault(t *testing.T) {
 	require.Equal(t, false, c1.Default)
 	require.Error(t, c1.ScanDefault("foo"))
 }
+
+func TestCheck_Normalize(t *testing.T) {
+	for _, tt := range []struct {
+		dialect, expr1, expr2 string
+		equal                 bool
+	}{
+		{dialect.MySQL, "age > 0", "(`age` > 0)", true},
+		{dialect.MySQL, "name != '' AND age > 0", "((`name` <> _utf8mb4'') and (`age` > 0))", true},
+		{dialect.MySQL, "name != 'A'", "(`name` <> _utf8mb4'a')", false},
+		{dialect.MySQL, "age > 0", "(`age` > 1)", false},
+		{dialect.Postgres, `"name" != ''`, "((name)::text <> ''::text)", true},
+		{dialect.Postgres, "price > 0.5", "(price > (0.5)::double precision)", true},
+		{dialect.Postgres, "age < 100", "(age < 150)", false},
+	} {
+		require.Equal(t, tt.equal, normalizeCheck(tt.dialect, tt.expr1) == normalizeCheck(tt.dialect, tt.expr2), tt.expr1)
+	}
+}
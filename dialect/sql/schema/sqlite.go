@@ -7,6 +7,7 @@ package schema
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"entgo.io/ent/dialect"
@@ -82,6 +83,9 @@ func (d *SQLite) tBuilder(t *Table) *sql.TableBuilder {
 			b.ForeignKeys(fk.DSL())
 		}
 	}
+	for _, c := range t.Checks {
+		b.Check(c.Name, c.DSL(dialect.SQLite))
+	}
 	// If it's an ID based primary key with autoincrement, we add
 	// the `PRIMARY KEY` clause to the column declaration. Otherwise,
 	// we append it to the constraint clause.
@@ -322,9 +326,178 @@ func (d *SQLite) alterColumns(table string, add, _, _ []*Column) sql.Queries {
 	return queries
 }
 
+// renameColumn returns the statement for renaming a column.
+// Note that this statement is supported only by SQLite 3.25 and above,
+// and older versions rebuild the table instead. See rebuild below.
+func (d *SQLite) renameColumn(t *Table, old, new *Column) sql.Querier {
+	return sql.Dialect(dialect.SQLite).
+		AlterTable(t.Name).
+		RenameColumn(old.Name, new.Name)
+}
+
+// needRebuild reports if renaming columns requires rebuilding the table
+// (SQLite < 3.25), because the `RENAME COLUMN` statement is not supported.
+func (d *SQLite) needRebuild(ctx context.Context, tx dialect.Tx) (bool, error) {
+	rows := &sql.Rows{}
+	if err := tx.Query(ctx, "SELECT sqlite_version()", []interface{}{}, rows); err != nil {
+		return false, fmt.Errorf("sqlite: querying version: %w", err)
+	}
+	defer rows.Close()
+	version, err := sql.ScanString(rows)
+	if err != nil {
+		return false, fmt.Errorf("sqlite: scanning version: %w", err)
+	}
+	return compareVersions(version, "3.25.0") == -1, nil
+}
+
+// rebuild applies the change-set on the given table by creating a new table with the desired
+// schema, copying the rows from the current table, dropping it, and renaming the new table.
+// Tables that are referenced by foreign-keys are not rebuilt, because dropping them executes
+// the actions of these foreign-keys.
+func (d *SQLite) rebuild(ctx context.Context, tx dialect.Tx, t *Table, change *changes, dropColumns bool) error {
+	referenced, err := exist(ctx, tx, "SELECT COUNT(*) FROM `sqlite_master` AS `m`, pragma_foreign_key_list(`m`.`name`) AS `p` WHERE `m`.`type` = ? AND `p`.`table` = ?", "table", t.Name)
+	if err != nil {
+		return err
+	}
+	if referenced {
+		return fmt.Errorf("sqlite: table %q is referenced by foreign-keys and cannot be rebuilt for renaming columns (upgrade SQLite to 3.25 or above)", t.Name)
+	}
+	nt := &Table{Name: t.Name + "_new", PrimaryKey: t.PrimaryKey, ForeignKeys: t.ForeignKeys, Checks: t.Checks}
+	nt.Columns = append(nt.Columns, t.Columns...)
+	if !dropColumns {
+		nt.Columns = append(nt.Columns, change.column.drop...)
+	}
+	// Columns that were added are not copied, and
+	// renamed columns are copied from their old name.
+	added := make(map[*Column]bool)
+	for _, c := range change.column.add {
+		added[c] = true
+	}
+	var from, to []string
+	for _, c := range nt.Columns {
+		if added[c] {
+			continue
+		}
+		name := c.Name
+		for _, r := range change.column.rename {
+			if r.to == c {
+				name = r.from.Name
+			}
+		}
+		from, to = append(from, name), append(to, c.Name)
+	}
+	insert := &sql.Builder{}
+	insert.WriteString("INSERT INTO ").Ident(nt.Name).Pad().Nested(func(b *sql.Builder) {
+		b.IdentComma(to...)
+	})
+	insert.WriteString(" SELECT ").IdentComma(from...).WriteString(" FROM ").Ident(t.Name)
+	drop, rename := &sql.Builder{}, &sql.Builder{}
+	drop.WriteString("DROP TABLE ").Ident(t.Name)
+	rename.WriteString("ALTER TABLE ").Ident(nt.Name).WriteString(" RENAME TO ").Ident(t.Name)
+	queries := []sql.Querier{d.tBuilder(nt), insert, drop, rename}
+	for _, idx := range t.Indexes {
+		queries = append(queries, d.addIndex(idx, t.Name))
+	}
+	for _, q := range queries {
+		query, args := q.Query()
+		if err := tx.Exec(ctx, query, args, nil); err != nil {
+			return fmt.Errorf("sqlite: rebuild table %q: %w", t.Name, err)
+		}
+	}
+	return nil
+}
+
+// checks loads the named CHECK constraints of the given table from its definition.
+func (d *SQLite) checks(ctx context.Context, tx dialect.Tx, t *Table) error {
+	rows := &sql.Rows{}
+	query, args := sql.Select("sql").
+		From(sql.Table("sqlite_master")).
+		Where(sql.And(
+			sql.EQ("type", "table"),
+			sql.EQ("name", t.Name),
+		)).
+		Query()
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return fmt.Errorf("sqlite: reading table definition %w", err)
+	}
+	// Call Close in cases of failures (Close is idempotent).
+	defer rows.Close()
+	stmt, err := sql.ScanString(rows)
+	if err != nil {
+		return fmt.Errorf("sqlite: scanning table definition: %w", err)
+	}
+	t.Checks = parseChecks(stmt)
+	return rows.Close()
+}
+
+// foreignKeys loads the foreign-keys of the given table. SQLite does not keep the names
+// of foreign-key constraints, and therefore, the symbols of the loaded foreign-keys are empty.
+func (d *SQLite) foreignKeys(ctx context.Context, tx dialect.Tx, t *Table) error {
+	rows := &sql.Rows{}
+	query, args := sql.Select("id", "from", "table", "to").
+		From(sql.Table(fmt.Sprintf("pragma_foreign_key_list('%s')", t.Name)).Unquote()).
+		OrderBy("id", "seq").
+		Query()
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return fmt.Errorf("sqlite: reading table foreign-keys %w", err)
+	}
+	// Call Close in cases of failures (Close is idempotent).
+	defer rows.Close()
+	if err := scanForeignKeys(rows, t); err != nil {
+		return fmt.Errorf("sqlite: %w", err)
+	}
+	for _, fk := range t.ForeignKeys {
+		fk.Symbol = ""
+	}
+	return rows.Close()
+}
+
+// parseChecks returns the named CHECK constraints from the given `CREATE TABLE` statement.
+func parseChecks(stmt string) []*Check {
+	var checks []*Check
+	for _, m := range reCheck.FindAllStringSubmatchIndex(stmt, -1) {
+		if expr, ok := nested(stmt[m[1]-1:]); ok {
+			checks = append(checks, &Check{Name: stmt[m[2]:m[3]], Expr: expr})
+		}
+	}
+	return checks
+}
+
+// nested returns the expression wrapped by the parentheses at the beginning of s.
+func nested(s string) (string, bool) {
+	var (
+		depth int
+		quote byte
+	)
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			if depth--; depth == 0 {
+				return strings.TrimSpace(s[1:i]), true
+			}
+		}
+	}
+	return "", false
+}
+
+// reCheck matches the beginning of named CHECK constraints.
+var reCheck = regexp.MustCompile("(?i)CONSTRAINT\\s+[`\"]?(\\w+)[`\"]?\\s+CHECK\\s*\\(")
+
 // tables returns the query for getting the in the schema.
+// Internal tables, like "sqlite_sequence", are ignored.
 func (d *SQLite) tables() sql.Querier {
 	return sql.Select("name").
 		From(sql.Table("sqlite_schema")).
-		Where(sql.EQ("type", "table"))
+		Where(sql.And(
+			sql.EQ("type", "table"),
+			sql.Not(sql.Like("name", "sqlite_%")),
+		))
 }
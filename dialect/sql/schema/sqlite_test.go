@@ -50,6 +50,31 @@ func TestSQLite_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "create new table with checks",
+			tables: []*Table{
+				{
+					Name: "users",
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "age", Type: field.TypeInt},
+					},
+					Checks: []*Check{
+						{Name: "age_check", Expr: "age > 0"},
+					},
+				},
+			},
+			before: func(mock sqliteMock) {
+				mock.start()
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape("CREATE TABLE `users`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `age` integer NOT NULL, CONSTRAINT `age_check` CHECK (age > 0))")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
 		{
 			name: "create new table",
 			tables: []*Table{
@@ -350,6 +375,87 @@ func TestSQLite_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "rename column",
+			tables: []*Table{
+				{
+					Name: "users",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "nickname", Type: field.TypeString, Unique: true, RenamedFrom: "name"},
+						{Name: "age", Type: field.TypeInt, Default: 0},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+				},
+			},
+			before: func(mock sqliteMock) {
+				mock.start()
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape("SELECT `name`, `type`, `notnull`, `dflt_value`, `pk` FROM pragma_table_info('users') ORDER BY `pk`")).
+					WithArgs().
+					WillReturnRows(sqlmock.NewRows([]string{"name", "type", "notnull", "dflt_value", "pk"}).
+						AddRow("name", "varchar(255)", 1, nil, 0).
+						AddRow("id", "integer", 1, "NULL", 1))
+				mock.ExpectQuery(escape("SELECT `name`, `unique`, `origin` FROM pragma_index_list('users')")).
+					WillReturnRows(sqlmock.NewRows([]string{"name", "unique", "origin"}).
+						AddRow("sqlite_autoindex_users_1", true, "u"))
+				mock.ExpectQuery(escape("SELECT `name` FROM pragma_index_info('sqlite_autoindex_users_1') ORDER BY `seqno`")).
+					WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("name"))
+				mock.ExpectQuery(escape("SELECT sqlite_version()")).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow("3.35.5"))
+				mock.ExpectExec(escape("ALTER TABLE `users` RENAME COLUMN `name` TO `nickname`")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape("ALTER TABLE `users` ADD COLUMN `age` integer NOT NULL DEFAULT 0")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "rename column on old versions",
+			tables: []*Table{
+				{
+					Name: "users",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "nickname", Type: field.TypeString, Unique: true, RenamedFrom: "name"},
+						{Name: "age", Type: field.TypeInt, Default: 0},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+				},
+			},
+			before: func(mock sqliteMock) {
+				mock.start()
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape("SELECT `name`, `type`, `notnull`, `dflt_value`, `pk` FROM pragma_table_info('users') ORDER BY `pk`")).
+					WithArgs().
+					WillReturnRows(sqlmock.NewRows([]string{"name", "type", "notnull", "dflt_value", "pk"}).
+						AddRow("name", "varchar(255)", 1, nil, 0).
+						AddRow("id", "integer", 1, "NULL", 1))
+				mock.ExpectQuery(escape("SELECT `name`, `unique`, `origin` FROM pragma_index_list('users')")).
+					WillReturnRows(sqlmock.NewRows([]string{"name", "unique", "origin"}).
+						AddRow("sqlite_autoindex_users_1", true, "u"))
+				mock.ExpectQuery(escape("SELECT `name` FROM pragma_index_info('sqlite_autoindex_users_1') ORDER BY `seqno`")).
+					WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("name"))
+				mock.ExpectQuery(escape("SELECT sqlite_version()")).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow("3.22.0"))
+				mock.ExpectQuery(escape("SELECT COUNT(*) FROM `sqlite_master` AS `m`, pragma_foreign_key_list(`m`.`name`) AS `p` WHERE `m`.`type` = ? AND `p`.`table` = ?")).
+					WithArgs("table", "users").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectExec(escape("CREATE TABLE `users_new`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `nickname` varchar(255) UNIQUE NOT NULL, `age` integer NOT NULL DEFAULT 0)")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape("INSERT INTO `users_new` (`id`, `nickname`) SELECT `id`, `name` FROM `users`")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape("DROP TABLE `users`")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape("ALTER TABLE `users_new` RENAME TO `users`")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
 		{
 			name: "universal id for all tables",
 			tables: []*Table{
@@ -464,3 +570,10 @@ func (m sqliteMock) tableExists(table string, exists bool) {
 		WithArgs("table", table).
 		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(count))
 }
+
+func TestParseChecks(t *testing.T) {
+	checks := parseChecks("CREATE TABLE `users`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `name` varchar(255) NOT NULL CHECK (name != ''), CONSTRAINT `id_check` CHECK ((id > 0) AND name != ')'), constraint \"name_check\" check(length(name) < 10))")
+	require.Len(t, checks, 2)
+	require.Equal(t, &Check{Name: "id_check", Expr: "(id > 0) AND name != ')'"}, checks[0])
+	require.Equal(t, &Check{Name: "name_check", Expr: "length(name) < 10"}, checks[1])
+}
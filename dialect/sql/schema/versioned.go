@@ -0,0 +1,452 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+)
+
+// RevisionTable defines the table name holding the applied versioned migrations.
+const RevisionTable = "ent_revisions"
+
+// Diff computes the changes between the given tables and the state of the migration
+// directory, and writes them as a new versioned migration. See NamedDiff for more info.
+func (m *Migrate) Diff(ctx context.Context, tables ...*Table) error {
+	return m.NamedDiff(ctx, "changes", tables...)
+}
+
+// NamedDiff computes the changes between the given tables and the state of the migration
+// directory configured by the WithDir option, and writes them as a new versioned migration
+// with the given name.
+//
+// The driver of the migration is used as a "dev database", and must be connected to an empty
+// database that is not used for anything else, as the changes are applied on it. An error is
+// returned if it contains any table. The existing migration files are replayed on it in order
+// to compute the current state, and the changes are planned against it using the options of
+// the migration.
+// The changes are written to 2 files named <timestamp>_<name>.up.sql and <timestamp>_<name>.down.sql,
+// and the SumFile of the directory is updated. No files are written if there are no changes.
+//
+// Note that the down file is computed on a best-effort basis. It reverts the columns and
+// indexes that were added or modified, and drops the tables that were created.
+func (m *Migrate) NamedDiff(ctx context.Context, name string, tables ...*Table) error {
+	if m.dir == nil {
+		return errors.New("sql/schema: missing migration directory. see schema.WithDir")
+	}
+	if err := Validate(m.dir); err != nil {
+		return err
+	}
+	migrations, err := Migrations(m.dir)
+	if err != nil {
+		return err
+	}
+	current, err := m.inspect(ctx)
+	if err != nil {
+		return err
+	}
+	if len(current) > 0 {
+		return fmt.Errorf("sql/schema: dev database is not empty, found table %q", current[0].Name)
+	}
+	// Replay the existing migrations on the dev database.
+	for _, mg := range migrations {
+		b, err := m.dir.ReadFile(mg.Up)
+		if err != nil {
+			return fmt.Errorf("sql/schema: reading migration file %q: %w", mg.Up, err)
+		}
+		stmts, err := Stmts(b)
+		if err != nil {
+			return fmt.Errorf("sql/schema: reading migration file %q: %w", mg.Up, err)
+		}
+		for _, stmt := range stmts {
+			if err := m.Exec(ctx, stmt, []interface{}{}, nil); err != nil {
+				return fmt.Errorf("sql/schema: replaying migration file %q: %w", mg.Up, err)
+			}
+		}
+	}
+	prev, err := m.inspect(ctx)
+	if err != nil {
+		return err
+	}
+	// Plan (and apply) the changes on the dev database.
+	up := &planDriver{Driver: m.sqlDialect}
+	um := *m
+	if err := um.setupDialect(up); err != nil {
+		return err
+	}
+	if err := um.Create(ctx, tables...); err != nil {
+		return fmt.Errorf("sql/schema: planning migration: %w", err)
+	}
+	// Views are recreated in each migration. Hence, they
+	// are not considered as changes by themselves.
+	if !hasChanges(up.stmts) {
+		return nil
+	}
+	next, err := m.inspect(ctx)
+	if err != nil {
+		return err
+	}
+	// Plan the revert of the changes above by migrating the dev
+	// database back to its previous state, and dropping new tables.
+	down := &planDriver{Driver: m.sqlDialect}
+	dm := &Migrate{dropColumns: true, dropIndexes: true, withForeignKeys: m.withForeignKeys}
+	if err := dm.setupDialect(down); err != nil {
+		return err
+	}
+	reverseRenames(prev, tables)
+	if err := dm.Create(ctx, prev...); err != nil {
+		return fmt.Errorf("sql/schema: planning down migration: %w", err)
+	}
+	down.stmts = append(down.stmts, m.dropNew(prev, next, tables)...)
+	// Versions have a second precision. Hence, a migration that is planned in the same second
+	// as the latest one (or by a clock that is behind it) must not overwrite or precede it.
+	version := time.Now().UTC().Format("20060102150405")
+	if n := len(migrations); n > 0 && version <= migrations[n-1].Version {
+		return fmt.Errorf("sql/schema: migration version %q is not after the latest version %q in the directory", version, migrations[n-1].Version)
+	}
+	base := fmt.Sprintf("%s_%s", version, strings.ReplaceAll(strings.TrimSpace(name), " ", "_"))
+	if err := m.dir.WriteFile(base+upSuffix, stmtsFile(up.stmts)); err != nil {
+		return fmt.Errorf("sql/schema: writing migration file: %w", err)
+	}
+	if err := m.dir.WriteFile(base+downSuffix, stmtsFile(down.stmts)); err != nil {
+		return fmt.Errorf("sql/schema: writing migration file: %w", err)
+	}
+	return WriteSum(m.dir)
+}
+
+// inspect returns the tables that exist in the database, ignoring internal tables.
+func (m *Migrate) inspect(ctx context.Context) ([]*Table, error) {
+	i, err := NewInspect(m.sqlDialect)
+	if err != nil {
+		return nil, err
+	}
+	tables, err := i.Tables(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("sql/schema: inspecting tables: %w", err)
+	}
+	for i := 0; i < len(tables); i++ {
+		if tables[i].Name == RevisionTable {
+			tables = append(tables[:i], tables[i+1:]...)
+			i--
+		}
+	}
+	return tables, nil
+}
+
+// reverseRenames marks the columns that were renamed in the given tables
+// as renamed in the previous tables, in order to revert them in the down file.
+func reverseRenames(prev, tables []*Table) {
+	for _, t := range tables {
+		for _, c := range t.Columns {
+			if c.RenamedFrom == "" {
+				continue
+			}
+			for _, pt := range prev {
+				if pc, ok := pt.column(c.RenamedFrom); ok && pt.Name == t.Name {
+					pc.RenamedFrom = c.Name
+				}
+			}
+		}
+	}
+}
+
+// dropNew returns the statements for dropping the tables that exist in next, but not in prev.
+// Tables are dropped in the reverse order of their creation, and ent tables are dropped last.
+func (m *Migrate) dropNew(prev, next, tables []*Table) []string {
+	exist := make(map[string]bool, len(prev))
+	for _, t := range prev {
+		exist[t.Name] = true
+	}
+	var names []string
+	for i := len(tables) - 1; i >= 0; i-- {
+		if !exist[tables[i].Name] {
+			exist[tables[i].Name] = true
+			names = append(names, tables[i].Name)
+		}
+	}
+	for _, t := range next {
+		if !exist[t.Name] {
+			names = append(names, t.Name)
+		}
+	}
+	views := make(map[string]bool)
+	for _, t := range tables {
+		if t.View() != nil {
+			views[t.Name] = true
+		}
+	}
+	stmts := make([]string, 0, len(names))
+	for _, name := range names {
+		if views[name] {
+			query, _ := sql.Dialect(m.Dialect()).DropView(name).Query()
+			stmts = append(stmts, query)
+			continue
+		}
+		b := &sql.Builder{}
+		b.SetDialect(m.Dialect())
+		stmts = append(stmts, b.WriteString("DROP TABLE ").Ident(name).String())
+	}
+	return stmts
+}
+
+// hasChanges reports if the given statements contain changes other than recreating views.
+func hasChanges(stmts []string) bool {
+	for _, stmt := range stmts {
+		if !strings.HasPrefix(stmt, "DROP VIEW ") && !strings.HasPrefix(stmt, "CREATE VIEW ") {
+			return true
+		}
+	}
+	return false
+}
+
+// stmtsFile returns the content of a migration file for the given statements.
+func stmtsFile(stmts []string) []byte {
+	var b strings.Builder
+	for _, stmt := range stmts {
+		b.WriteString(strings.TrimSuffix(stmt, ";"))
+		b.WriteString(";\n")
+	}
+	return []byte(b.String())
+}
+
+// planDriver is a driver that records the executed statements,
+// with their arguments inlined, before passing them to the
+// underlying driver. Transactions are not used.
+type planDriver struct {
+	dialect.Driver
+	stmts []string
+}
+
+// Exec records the query and calls the underlying driver Exec method.
+func (d *planDriver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	stmt, err := inline(d.Dialect(), query, args)
+	if err != nil {
+		return err
+	}
+	if err := d.Driver.Exec(ctx, query, args, v); err != nil {
+		return err
+	}
+	d.stmts = append(d.stmts, stmt)
+	return nil
+}
+
+// Tx returns a nop-transaction that wraps the plan driver.
+func (d *planDriver) Tx(context.Context) (dialect.Tx, error) {
+	return dialect.NopTx(d), nil
+}
+
+// inline returns the query with its arguments inlined.
+func inline(name, query string, args interface{}) (string, error) {
+	vs, ok := args.([]interface{})
+	if !ok || len(vs) == 0 {
+		return query, nil
+	}
+	var (
+		b     strings.Builder
+		quote rune
+		next  int
+	)
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case quote != 0:
+			if rune(c) == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = rune(c)
+		case c == '?' && name != dialect.Postgres:
+			if next >= len(vs) {
+				return "", fmt.Errorf("sql/schema: missing argument for query %q", query)
+			}
+			b.WriteString(literal(vs[next]))
+			next++
+			continue
+		case c == '$' && name == dialect.Postgres:
+			j := i + 1
+			for j < len(query) && query[j] >= '0' && query[j] <= '9' {
+				j++
+			}
+			if j == i+1 {
+				break
+			}
+			n, err := strconv.Atoi(query[i+1 : j])
+			if err != nil || n < 1 || n > len(vs) {
+				return "", fmt.Errorf("sql/schema: missing argument for query %q", query)
+			}
+			b.WriteString(literal(vs[n-1]))
+			i = j - 1
+			continue
+		}
+		b.WriteByte(c)
+	}
+	return b.String(), nil
+}
+
+// literal returns the SQL literal of the given value.
+func literal(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return "'" + strings.ReplaceAll(v, "'", "''") + "'"
+	case []byte:
+		return "'" + strings.ReplaceAll(string(v), "'", "''") + "'"
+	case bool:
+		if v {
+			return "1"
+		}
+		return "0"
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Executor applies the pending migration files of a migration directory
+// on the database, and records them in the RevisionTable.
+type Executor struct {
+	drv dialect.Driver
+	dir Dir
+}
+
+// NewExecutor returns a new Executor for the given driver and migration directory.
+func NewExecutor(drv dialect.Driver, dir Dir) *Executor {
+	return &Executor{drv: drv, dir: dir}
+}
+
+// Pending returns the migrations that were not applied on the database yet. An error
+// is returned if the directory does not match its SumFile, or if the content of an
+// applied migration file was changed.
+func (e *Executor) Pending(ctx context.Context) ([]*Migration, error) {
+	if err := Validate(e.dir); err != nil {
+		return nil, err
+	}
+	migrations, err := Migrations(e.dir)
+	if err != nil {
+		return nil, err
+	}
+	if err := e.init(ctx); err != nil {
+		return nil, err
+	}
+	applied, err := e.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pending := make([]*Migration, 0, len(migrations))
+	for _, m := range migrations {
+		sum, ok := applied[m.Version]
+		if !ok {
+			pending = append(pending, m)
+			continue
+		}
+		b, err := e.dir.ReadFile(m.Up)
+		if err != nil {
+			return nil, fmt.Errorf("sql/schema: reading migration file %q: %w", m.Up, err)
+		}
+		if fileSum(b) != sum {
+			return nil, fmt.Errorf("%w: applied migration file %q was changed", ErrChecksumMismatch, m.Up)
+		}
+	}
+	return pending, nil
+}
+
+// Execute applies all pending migrations on the database.
+func (e *Executor) Execute(ctx context.Context) error {
+	return e.ExecuteN(ctx, 0)
+}
+
+// ExecuteN applies the next n pending migrations on the database. If n
+// is zero, all pending migrations are applied. Each migration runs in its
+// own transaction, and is recorded in the RevisionTable on success.
+func (e *Executor) ExecuteN(ctx context.Context, n int) error {
+	pending, err := e.Pending(ctx)
+	if err != nil {
+		return err
+	}
+	if n > 0 && n < len(pending) {
+		pending = pending[:n]
+	}
+	for _, m := range pending {
+		if err := e.execute(ctx, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// execute applies the given migration on the database.
+func (e *Executor) execute(ctx context.Context, m *Migration) error {
+	b, err := e.dir.ReadFile(m.Up)
+	if err != nil {
+		return fmt.Errorf("sql/schema: reading migration file %q: %w", m.Up, err)
+	}
+	stmts, err := Stmts(b)
+	if err != nil {
+		return fmt.Errorf("sql/schema: reading migration file %q: %w", m.Up, err)
+	}
+	tx, err := e.drv.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	for _, stmt := range stmts {
+		if err := tx.Exec(ctx, stmt, []interface{}{}, nil); err != nil {
+			return rollback(tx, fmt.Errorf("sql/schema: executing migration file %q: %w", m.Up, err))
+		}
+	}
+	query, args := sql.Dialect(e.drv.Dialect()).
+		Insert(RevisionTable).
+		Columns("version", "description", "checksum", "applied_at").
+		Values(m.Version, m.Description, fileSum(b), time.Now().UTC()).
+		Query()
+	if err := tx.Exec(ctx, query, args, nil); err != nil {
+		return rollback(tx, fmt.Errorf("sql/schema: insert revision %q: %w", m.Version, err))
+	}
+	return tx.Commit()
+}
+
+// init creates the RevisionTable if it does not exist.
+func (e *Executor) init(ctx context.Context) error {
+	m, err := NewMigrate(e.drv)
+	if err != nil {
+		return err
+	}
+	t := NewTable(RevisionTable).
+		AddPrimary(&Column{Name: "version", Type: field.TypeString, Size: 128}).
+		AddColumn(&Column{Name: "description", Type: field.TypeString}).
+		AddColumn(&Column{Name: "checksum", Type: field.TypeString}).
+		AddColumn(&Column{Name: "applied_at", Type: field.TypeTime})
+	if err := m.Create(ctx, t); err != nil {
+		return fmt.Errorf("sql/schema: create revisions table: %w", err)
+	}
+	return nil
+}
+
+// applied returns the checksums of the applied migrations by their version.
+func (e *Executor) applied(ctx context.Context) (map[string]string, error) {
+	rows := &sql.Rows{}
+	query, args := sql.Dialect(e.drv.Dialect()).
+		Select("version", "checksum").From(sql.Table(RevisionTable)).Query()
+	if err := e.drv.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("sql/schema: query revisions table: %w", err)
+	}
+	defer rows.Close()
+	applied := make(map[string]string)
+	for rows.Next() {
+		var version, sum string
+		if err := rows.Scan(&version, &sum); err != nil {
+			return nil, fmt.Errorf("sql/schema: scanning revision: %w", err)
+		}
+		applied[version] = sum
+	}
+	return applied, rows.Err()
+}
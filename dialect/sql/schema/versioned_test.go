@@ -0,0 +1,225 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStmts(t *testing.T) {
+	stmts, err := Stmts([]byte(`-- create "users" table.
+CREATE TABLE users (id int, name varchar(255) DEFAULT 'a;b');
+-- comment; with semicolon.
+INSERT INTO users (id, name) VALUES (1, 'c
+--d');
+DROP TABLE pets`))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"CREATE TABLE users (id int, name varchar(255) DEFAULT 'a;b')",
+		"INSERT INTO users (id, name) VALUES (1, 'c\n--d')",
+		"DROP TABLE pets",
+	}, stmts)
+
+	// Lines are not limited in size.
+	long := "INSERT INTO users (name) VALUES ('" + strings.Repeat("a", 1<<20) + "')"
+	stmts, err = Stmts([]byte("-- long line.\r\n" + long + ";\r\nDROP TABLE pets;\r\n"))
+	require.NoError(t, err)
+	require.Equal(t, []string{long, "DROP TABLE pets"}, stmts)
+}
+
+func TestInline(t *testing.T) {
+	query, err := inline(dialect.MySQL, "INSERT INTO `t` (`a`, `b`, `c`) VALUES (?, ?, ?)", []interface{}{"it's", 1, nil})
+	require.NoError(t, err)
+	require.Equal(t, "INSERT INTO `t` (`a`, `b`, `c`) VALUES ('it''s', 1, NULL)", query)
+	query, err = inline(dialect.Postgres, `UPDATE "t" SET "a" = $2 WHERE "b" = $1`, []interface{}{"x", 10})
+	require.NoError(t, err)
+	require.Equal(t, `UPDATE "t" SET "a" = 10 WHERE "b" = 'x'`, query)
+	_, err = inline(dialect.SQLite, "SELECT ?, ?", []interface{}{1})
+	require.Error(t, err)
+}
+
+func TestMigrations(t *testing.T) {
+	dir := openDir(t)
+	require.NoError(t, Validate(dir), "empty directory is valid")
+	require.NoError(t, dir.WriteFile("2_users.up.sql", []byte("CREATE TABLE users (id int);")))
+	require.NoError(t, dir.WriteFile("1_init.up.sql", []byte("CREATE TABLE pets (id int);")))
+	require.NoError(t, dir.WriteFile("1_init.down.sql", []byte("DROP TABLE pets;")))
+	require.NoError(t, dir.WriteFile("README.md", []byte("ignored")))
+	require.True(t, errors.Is(Validate(dir), ErrChecksumMismatch), "missing sum file")
+
+	migrations, err := Migrations(dir)
+	require.NoError(t, err)
+	require.Len(t, migrations, 2)
+	require.Equal(t, &Migration{Version: "1", Description: "init", Up: "1_init.up.sql", Down: "1_init.down.sql"}, migrations[0])
+	require.Equal(t, &Migration{Version: "2", Description: "users", Up: "2_users.up.sql"}, migrations[1])
+
+	require.NoError(t, WriteSum(dir))
+	require.NoError(t, Validate(dir))
+	sum, err := dir.ReadFile(SumFile)
+	require.NoError(t, err)
+	require.Len(t, strings.Split(strings.TrimSpace(string(sum)), "\n"), 4)
+	require.NoError(t, dir.WriteFile("2_users.up.sql", []byte("CREATE TABLE users (id bigint);")))
+	require.True(t, errors.Is(Validate(dir), ErrChecksumMismatch))
+
+	require.NoError(t, dir.WriteFile("3_pets.down.sql", []byte("DROP TABLE pets;")))
+	_, err = Migrations(dir)
+	require.Error(t, err, "missing up file")
+	require.NoError(t, dir.WriteFile("3_pets.up.sql", []byte("CREATE TABLE pets (id int);")))
+	_, err = Migrations(dir)
+	require.NoError(t, err)
+
+	require.NoError(t, dir.WriteFile("2_groups.up.sql", []byte("CREATE TABLE groups (id int);")))
+	_, err = Migrations(dir)
+	require.EqualError(t, err, `sql/schema: duplicate migration files for version "2": "2_groups.up.sql" and "2_users.up.sql"`)
+	require.NoError(t, os.Remove(filepath.Join(dir.path, "2_groups.up.sql")))
+	require.NoError(t, dir.WriteFile("2_groups.down.sql", []byte("DROP TABLE groups;")))
+	_, err = Migrations(dir)
+	require.EqualError(t, err, `sql/schema: mismatched descriptions for migration version "2": "groups" and "users"`)
+}
+
+func TestVersioned(t *testing.T) {
+	ctx := context.Background()
+	dir := openDir(t)
+	dev, err := sql.Open(dialect.SQLite, "file:dev?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer dev.Close()
+	m, err := NewMigrate(dev, WithDir(dir))
+	require.NoError(t, err)
+
+	usersColumns := []*Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+	}
+	users := &Table{Name: "users", Columns: usersColumns, PrimaryKey: usersColumns[0:1]}
+	require.NoError(t, m.NamedDiff(ctx, "init", users))
+	migrations, err := Migrations(dir)
+	require.NoError(t, err)
+	require.Len(t, migrations, 1)
+	require.Equal(t, "init", migrations[0].Description)
+	require.NoError(t, Validate(dir))
+	down, err := dir.ReadFile(migrations[0].Down)
+	require.NoError(t, err)
+	require.Equal(t, "DROP TABLE `users`;\n", string(down))
+	err = m.Diff(ctx, users)
+	require.EqualError(t, err, `sql/schema: dev database is not empty, found table "users"`)
+
+	// No changes.
+	dev2, err := sql.Open(dialect.SQLite, "file:dev2?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer dev2.Close()
+	m, err = NewMigrate(dev2, WithDir(dir))
+	require.NoError(t, err)
+	require.NoError(t, m.Diff(ctx, users))
+	migrations, err = Migrations(dir)
+	require.NoError(t, err)
+	require.Len(t, migrations, 1)
+
+	drv, err := sql.Open(dialect.SQLite, "file:prod?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer drv.Close()
+	ex := NewExecutor(drv, dir)
+	pending, err := ex.Pending(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.NoError(t, ex.Execute(ctx))
+	pending, err = ex.Pending(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+	exist, err := (&SQLite{Driver: drv}).tableExist(ctx, dialect.NopTx(drv), "users")
+	require.NoError(t, err)
+	require.True(t, exist)
+
+	// Applied files cannot be changed.
+	up, err := dir.ReadFile(migrations[0].Up)
+	require.NoError(t, err)
+	require.NoError(t, dir.WriteFile(migrations[0].Up, append(up, "\n-- changed\n"...)))
+	require.NoError(t, WriteSum(dir))
+	_, err = ex.Pending(ctx)
+	require.True(t, errors.Is(err, ErrChecksumMismatch))
+	require.NoError(t, dir.WriteFile(migrations[0].Up, up))
+	require.NoError(t, WriteSum(dir))
+
+	// Migrations are not written before (or over) the latest version.
+	require.NoError(t, dir.WriteFile("99990101000000_future.up.sql", []byte("CREATE TABLE pets (id integer);\n")))
+	require.NoError(t, WriteSum(dir))
+	dev3, err := sql.Open(dialect.SQLite, "file:dev3?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer dev3.Close()
+	m, err = NewMigrate(dev3, WithDir(dir))
+	require.NoError(t, err)
+	usersColumns = append(usersColumns, &Column{Name: "age", Type: field.TypeInt, Nullable: true})
+	users = &Table{Name: "users", Columns: usersColumns, PrimaryKey: usersColumns[0:1]}
+	err = m.Diff(ctx, users)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `is not after the latest version "99990101000000"`)
+	migrations, err = Migrations(dir)
+	require.NoError(t, err)
+	require.Len(t, migrations, 2)
+}
+
+func TestVersionedViews(t *testing.T) {
+	ctx := context.Background()
+	dir := openDir(t)
+	dev, err := sql.Open(dialect.SQLite, "file:views_dev?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer dev.Close()
+	m, err := NewMigrate(dev, WithDir(dir))
+	require.NoError(t, err)
+
+	usersColumns := []*Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+	}
+	users := &Table{Name: "users", Columns: usersColumns, PrimaryKey: usersColumns[0:1]}
+	names := &Table{
+		Name:       "user_names",
+		Columns:    usersColumns,
+		Annotation: &entsql.Annotation{View: &entsql.View{Query: "SELECT `id`, `name` FROM `users`"}},
+	}
+	require.NoError(t, m.NamedDiff(ctx, "init", users, names))
+	migrations, err := Migrations(dir)
+	require.NoError(t, err)
+	require.Len(t, migrations, 1)
+	up, err := dir.ReadFile(migrations[0].Up)
+	require.NoError(t, err)
+	require.Contains(t, string(up), "CREATE VIEW `user_names` AS SELECT `id`, `name` FROM `users`;\n")
+	down, err := dir.ReadFile(migrations[0].Down)
+	require.NoError(t, err)
+	require.Equal(t, "DROP VIEW IF EXISTS `user_names`;\nDROP TABLE `users`;\n", string(down))
+
+	// Recreating views only is not considered as a change.
+	dev2, err := sql.Open(dialect.SQLite, "file:views_dev2?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer dev2.Close()
+	m, err = NewMigrate(dev2, WithDir(dir))
+	require.NoError(t, err)
+	require.NoError(t, m.Diff(ctx, users, names))
+	migrations, err = Migrations(dir)
+	require.NoError(t, err)
+	require.Len(t, migrations, 1)
+}
+
+func openDir(t *testing.T) *LocalDir {
+	path, err := ioutil.TempDir("", "migrations")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(path) })
+	dir, err := NewLocalDir(path)
+	require.NoError(t, err)
+	return dir
+}
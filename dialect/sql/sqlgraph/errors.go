@@ -6,43 +6,254 @@ package sqlgraph
 
 import (
 	"errors"
+	"regexp"
 	"strings"
 )
 
+// ConstraintKind describes the kind of a database constraint.
+type ConstraintKind uint
+
+// Kinds of database constraints.
+const (
+	UnknownConstraint ConstraintKind = iota
+	UniqueConstraint
+	ForeignKeyConstraint
+	NotNullConstraint
+	CheckConstraint
+)
+
+// String implements the fmt.Stringer interface.
+func (k ConstraintKind) String() string {
+	switch k {
+	case UniqueConstraint:
+		return "unique"
+	case ForeignKeyConstraint:
+		return "foreign key"
+	case NotNullConstraint:
+		return "not null"
+	case CheckConstraint:
+		return "check"
+	default:
+		return "unknown"
+	}
+}
+
+// A ConstraintError represents an error from mutation that violates a specific constraint.
+// The details of the violated constraint are resolved from the database error, and the ones
+// that are not reported by the database (or its driver) are left empty.
+type ConstraintError struct {
+	msg string
+	// Kind is the kind of the violated constraint.
+	Kind ConstraintKind
+	// Constraint is the name of the violated constraint (or index).
+	Constraint string
+	// Table is the table of the violated constraint. For foreign-key
+	// constraints, it is the referencing (child) table.
+	Table string
+	// Columns are the columns of the violated constraint. For foreign-key
+	// constraints, they are the columns of the referencing (child) table.
+	Columns []string
+	// wrap is the database error.
+	wrap error
+}
+
+// Error implements the error interface.
+func (e *ConstraintError) Error() string { return e.msg }
+
+// Unwrap returns the database error that the ConstraintError was parsed from.
+func (e *ConstraintError) Unwrap() error { return e.wrap }
+
+// ParseConstraintError returns a ConstraintError for the given error, if the error is (or wraps)
+// a ConstraintError, or if it resulted from a constraint violation in MySQL, PostgreSQL or SQLite.
+func ParseConstraintError(err error) (*ConstraintError, bool) {
+	if err == nil {
+		return nil, false
+	}
+	var e *ConstraintError
+	if errors.As(err, &e) {
+		return e, true
+	}
+	for _, parse := range []func(error) *ConstraintError{parseMySQLError, parsePostgresError, parseSQLiteError} {
+		if e := parse(err); e != nil {
+			e.msg, e.wrap = err.Error(), err
+			return e, true
+		}
+	}
+	return nil, false
+}
+
 // IsConstraintError returns true if the error resulted from a DB constraint violation
 func IsConstraintError(err error) bool {
-	var e *ConstraintError
-	return errors.As(err, &e) || IsUniqueConstraintError(err) || IsForeignKeyConstraintError(err)
+	_, ok := ParseConstraintError(err)
+	return ok
 }
 
 // IsUniqueConstraintError reports if the error resulted from a DB uniqueness constraint violation.
 // e.g. duplicate value in unique index.
 func IsUniqueConstraintError(err error) bool {
-	uniquenessErrors := []string{
-		"Error 1062",                 // MySQL
-		"violates unique constraint", // Postgres
-		"UNIQUE constraint failed",   // SQLite
-	}
-	for _, s := range uniquenessErrors {
-		if strings.Contains(err.Error(), s) {
-			return true
-		}
-	}
-	return false
+	return isConstraintKind(err, UniqueConstraint)
 }
 
 // IsForeignKeyConstraintError reports if the error resulted from a DB FK constraint violation.
 // e.g. parent row does not exist.
 func IsForeignKeyConstraintError(err error) bool {
-	fkErrors := []string{
-		"Error 1452",                      // MySQL
-		"violates foreign key constraint", // Postgres
-		"FOREIGN KEY constraint failed",   // SQLite
+	return isConstraintKind(err, ForeignKeyConstraint)
+}
+
+// IsNotNullConstraintError reports if the error resulted from a DB NOT NULL constraint violation.
+// e.g. missing value for a required column.
+func IsNotNullConstraintError(err error) bool {
+	return isConstraintKind(err, NotNullConstraint)
+}
+
+// IsCheckConstraintError reports if the error resulted from a DB CHECK constraint violation.
+func IsCheckConstraintError(err error) bool {
+	return isConstraintKind(err, CheckConstraint)
+}
+
+func isConstraintKind(err error, k ConstraintKind) bool {
+	e, ok := ParseConstraintError(err)
+	return ok && e.Kind == k
+}
+
+var (
+	mysqlCode       = regexp.MustCompile(`Error (\d+)(?: \(\w+\))?: `)
+	mysqlUnique     = regexp.MustCompile(`for key '([^']+)'`)
+	mysqlForeignKey = regexp.MustCompile("foreign key constraint fails \\((?:`[^`]+`\\.)?`([^`]+)`, CONSTRAINT `([^`]+)` FOREIGN KEY \\(([^)]+)\\)")
+	mysqlNotNull    = regexp.MustCompile(`(?:Column|Field) '([^']+)' (?:cannot be null|doesn't have a default value)`)
+	mysqlCheck      = regexp.MustCompile(`Check constraint '([^']+)' is violated`)
+)
+
+// parseMySQLError parses the error codes and messages of MySQL (and MariaDB).
+func parseMySQLError(err error) *ConstraintError {
+	msg := err.Error()
+	m := mysqlCode.FindStringSubmatch(msg)
+	if m == nil {
+		return nil
 	}
-	for _, s := range fkErrors {
-		if strings.Contains(err.Error(), s) {
-			return true
+	var e *ConstraintError
+	switch m[1] {
+	case "1062":
+		e = &ConstraintError{Kind: UniqueConstraint}
+		// The key is prefixed with its table name in MySQL 8.
+		if m := mysqlUnique.FindAllStringSubmatch(msg, -1); len(m) > 0 {
+			key := m[len(m)-1][1]
+			if i := strings.LastIndexByte(key, '.'); i != -1 {
+				e.Table, key = key[:i], key[i+1:]
+			}
+			e.Constraint = key
+		}
+	case "1451", "1452":
+		e = &ConstraintError{Kind: ForeignKeyConstraint}
+		if m := mysqlForeignKey.FindStringSubmatch(msg); m != nil {
+			e.Table, e.Constraint, e.Columns = m[1], m[2], splitColumns(m[3], "`")
+		}
+	case "1048", "1364":
+		e = &ConstraintError{Kind: NotNullConstraint}
+		if m := mysqlNotNull.FindStringSubmatch(msg); m != nil {
+			e.Columns = []string{m[1]}
 		}
+	case "3819":
+		e = &ConstraintError{Kind: CheckConstraint}
+		if m := mysqlCheck.FindStringSubmatch(msg); m != nil {
+			e.Constraint = m[1]
+		}
+	}
+	return e
+}
+
+var (
+	postgresCodes = map[string]ConstraintKind{
+		"23505": UniqueConstraint,
+		"23503": ForeignKeyConstraint,
+		"23502": NotNullConstraint,
+		"23514": CheckConstraint,
+	}
+	postgresUnique     = regexp.MustCompile(`violates unique constraint "([^"]+)"`)
+	postgresForeignKey = regexp.MustCompile(`(?:on table "([^"]+)" )?violates foreign key constraint "([^"]+)"(?: on table "([^"]+)")?`)
+	postgresNotNull    = regexp.MustCompile(`null value in column "([^"]+)"(?: of relation "([^"]+)")? violates not-null constraint`)
+	postgresCheck      = regexp.MustCompile(`(?:for relation "([^"]+)" )?violates check constraint "([^"]+)"`)
+	postgresKey        = regexp.MustCompile(`^Key \(([^)]+)\)=`)
+)
+
+// parsePostgresError parses the errors of PostgreSQL. The details of errors
+// that are returned by the lib/pq driver are read from their error fields.
+func parsePostgresError(err error) *ConstraintError {
+	var pqErr interface{ Get(byte) string }
+	if errors.As(err, &pqErr) {
+		kind, ok := postgresCodes[pqErr.Get('C')]
+		if !ok {
+			return nil
+		}
+		e := &ConstraintError{Kind: kind, Constraint: pqErr.Get('n'), Table: pqErr.Get('t')}
+		if c := pqErr.Get('c'); c != "" {
+			e.Columns = []string{c}
+		} else if m := postgresKey.FindStringSubmatch(pqErr.Get('D')); m != nil {
+			e.Columns = splitColumns(m[1], `"`)
+		}
+		return e
+	}
+	msg := err.Error()
+	switch {
+	case postgresUnique.MatchString(msg):
+		m := postgresUnique.FindStringSubmatch(msg)
+		return &ConstraintError{Kind: UniqueConstraint, Constraint: m[1]}
+	case postgresForeignKey.MatchString(msg):
+		m := postgresForeignKey.FindStringSubmatch(msg)
+		e := &ConstraintError{Kind: ForeignKeyConstraint, Table: m[1], Constraint: m[2]}
+		// Deleting (or updating) a referenced row reports the referencing table last.
+		if m[3] != "" {
+			e.Table = m[3]
+		}
+		return e
+	case postgresNotNull.MatchString(msg):
+		m := postgresNotNull.FindStringSubmatch(msg)
+		return &ConstraintError{Kind: NotNullConstraint, Table: m[2], Columns: []string{m[1]}}
+	case postgresCheck.MatchString(msg):
+		m := postgresCheck.FindStringSubmatch(msg)
+		return &ConstraintError{Kind: CheckConstraint, Table: m[1], Constraint: m[2]}
+	}
+	return nil
+}
+
+var (
+	sqliteUnique     = regexp.MustCompile(`UNIQUE constraint failed: (index '([^']+)'|\w+\.\w+(?:, \w+\.\w+)*)`)
+	sqliteForeignKey = regexp.MustCompile(`FOREIGN KEY constraint failed`)
+	sqliteNotNull    = regexp.MustCompile(`NOT NULL constraint failed: (\w+)\.(\w+)`)
+	sqliteCheck      = regexp.MustCompile(`CHECK constraint failed: (\w+)`)
+)
+
+// parseSQLiteError parses the error messages of SQLite.
+func parseSQLiteError(err error) *ConstraintError {
+	msg := err.Error()
+	switch {
+	case sqliteUnique.MatchString(msg):
+		m := sqliteUnique.FindStringSubmatch(msg)
+		e := &ConstraintError{Kind: UniqueConstraint, Constraint: m[2]}
+		if e.Constraint == "" {
+			for _, c := range strings.Split(m[1], ", ") {
+				i := strings.IndexByte(c, '.')
+				e.Table, e.Columns = c[:i], append(e.Columns, c[i+1:])
+			}
+		}
+		return e
+	case sqliteForeignKey.MatchString(msg):
+		return &ConstraintError{Kind: ForeignKeyConstraint}
+	case sqliteNotNull.MatchString(msg):
+		m := sqliteNotNull.FindStringSubmatch(msg)
+		return &ConstraintError{Kind: NotNullConstraint, Table: m[1], Columns: []string{m[2]}}
+	case sqliteCheck.MatchString(msg):
+		m := sqliteCheck.FindStringSubmatch(msg)
+		return &ConstraintError{Kind: CheckConstraint, Constraint: m[1]}
+	}
+	return nil
+}
+
+// splitColumns splits a list of quoted column names.
+func splitColumns(s, quote string) []string {
+	columns := strings.Split(s, ",")
+	for i := range columns {
+		columns[i] = strings.Trim(strings.TrimSpace(columns[i]), quote)
 	}
-	return false
+	return columns
 }
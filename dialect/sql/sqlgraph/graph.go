@@ -48,13 +48,6 @@ func (r Rel) String() (s string) {
 	return s
 }
 
-// A ConstraintError represents an error from mutation that violates a specific constraint.
-type ConstraintError struct {
-	msg string
-}
-
-func (e ConstraintError) Error() string { return e.msg }
-
 // A Step provides a path-step information to the traversal functions.
 type Step struct {
 	// From is the source of the step.
@@ -308,6 +301,9 @@ type (
 	EdgeTarget struct {
 		Nodes  []driver.Value
 		IDSpec *FieldSpec
+		// Fields holds the extra columns of the join-table rows of
+		// M2M edges (e.g. the default values of edge schemas).
+		Fields []*FieldSpec
 	}
 
 	// EdgeSpec holds the information for updating a field
@@ -332,9 +328,48 @@ type (
 		Schema  string
 		Columns []string
 		ID      *FieldSpec
+		// CompositeID holds the fields of composite identifiers
+		// (multi-column primary keys). If it is set, ID is nil.
+		CompositeID []*FieldSpec
 	}
 )
 
+// idColumns returns the identifier columns of the node.
+func (n *NodeSpec) idColumns() []string {
+	if n.ID != nil {
+		return []string{n.ID.Column}
+	}
+	columns := make([]string, len(n.CompositeID))
+	for i, f := range n.CompositeID {
+		columns[i] = f.Column
+	}
+	return columns
+}
+
+// idValue returns the identifier value of the node.
+func (n *NodeSpec) idValue() driver.Value {
+	if n.ID != nil {
+		return n.ID.Value
+	}
+	values := make([]driver.Value, len(n.CompositeID))
+	for i, f := range n.CompositeID {
+		values[i] = f.Value
+	}
+	return values
+}
+
+// matchID returns the predicate for matching the node by its identifier.
+func (n *NodeSpec) matchID() *sql.Predicate {
+	if n.ID != nil {
+		return sql.EQ(n.ID.Column, n.ID.Value)
+	}
+	columns, values := n.idColumns(), make([]interface{}, len(n.CompositeID))
+	for i, f := range n.CompositeID {
+		values[i] = f.Value
+	}
+	return sql.CompositeEQ(columns, values...)
+}
+
 type (
 	// CreateSpec holds the information for creating
 	// a node in the graph.
@@ -344,11 +379,34 @@ type (
 		ID     *FieldSpec
 		Fields []*FieldSpec
 		Edges  []*EdgeSpec
+
+		// The OnConflict option allows providing on-conflict
+		// options to the INSERT statement.
+		//
+		//	sqlgraph.CreateSpec{
+		//		OnConflict: []sql.ConflictOption{
+		//			sql.ResolveWithNewValues(),
+		//		},
+		//	}
+		//
+		OnConflict []sql.ConflictOption
 	}
+
 	// BatchCreateSpec holds the information for creating
 	// multiple nodes in the graph.
 	BatchCreateSpec struct {
 		Nodes []*CreateSpec
+
+		// The OnConflict option allows providing on-conflict
+		// options to the INSERT statement.
+		//
+		//	sqlgraph.CreateSpec{
+		//		OnConflict: []sql.ConflictOption{
+		//			sql.ResolveWithNewValues(),
+		//		},
+		//	}
+		//
+		OnConflict []sql.ConflictOption
 	}
 )
 
@@ -492,6 +550,7 @@ type QuerySpec struct {
 	Unique    bool
 	Order     func(*sql.Selector)
 	Predicate func(*sql.Selector)
+	Modifiers []func(*sql.Selector)
 
 	ScanValues func(columns []string) ([]interface{}, error)
 	Assign     func(columns []string, values []interface{}) error
@@ -594,10 +653,22 @@ func (q *query) count(ctx context.Context, drv dialect.Driver) (int, error) {
 	if err != nil {
 		return 0, err
 	}
-	selector.Count(selector.C(q.Node.ID.Column))
-	if q.Unique {
+	// Row-level locks (e.g. FOR UPDATE) are not
+	// allowed with aggregate functions in PostgreSQL.
+	selector.ClearLock()
+	switch {
+	case q.Node.ID == nil && q.Unique:
+		// Nodes with composite identifiers are counted using
+		// a sub-query that selects their distinct identifiers.
+		selector.Select(selector.Columns(q.Node.idColumns()...)...)
+		selector = q.builder.Select().Count().From(selector.As("t1"))
+	case q.Node.ID == nil:
+		selector.Count()
+	case q.Unique:
 		selector.SetDistinct(false)
 		selector.Count(sql.Distinct(selector.C(q.Node.ID.Column)))
+	default:
+		selector.Count(selector.C(q.Node.ID.Column))
 	}
 	query, args := selector.Query()
 	if err := drv.Query(ctx, query, args, rows); err != nil {
@@ -633,6 +704,9 @@ func (q *query) selector(ctx context.Context) (*sql.Selector, error) {
 	if q.Unique {
 		selector.Distinct()
 	}
+	for _, m := range q.Modifiers {
+		m(selector)
+	}
 	if err := selector.Err(); err != nil {
 		return nil, err
 	}
@@ -646,13 +720,10 @@ type updater struct {
 
 func (u *updater) node(ctx context.Context, tx dialect.ExecQuerier) error {
 	var (
-		// id holds the PK of the node used for linking
-		// it with the other nodes.
-		id         = u.Node.ID.Value
 		addEdges   = EdgeSpecs(u.Edges.Add).GroupRel()
 		clearEdges = EdgeSpecs(u.Edges.Clear).GroupRel()
 	)
-	update := u.builder.Update(u.Node.Table).Schema(u.Node.Schema).Where(sql.EQ(u.Node.ID.Column, id))
+	update := u.builder.Update(u.Node.Table).Schema(u.Node.Schema).Where(u.Node.matchID())
 	if pred := u.Predicate; pred != nil {
 		selector := u.builder.Select().From(u.builder.Table(u.Node.Table).Schema(u.Node.Schema))
 		pred(selector)
@@ -668,12 +739,19 @@ func (u *updater) node(ctx context.Context, tx dialect.ExecQuerier) error {
 			return err
 		}
 	}
-	if err := u.setExternalEdges(ctx, []driver.Value{id}, addEdges, clearEdges); err != nil {
-		return err
+	// Nodes with composite identifiers hold only edges
+	// that reside in their table (i.e. foreign-keys).
+	if u.Node.ID != nil {
+		// id holds the PK of the node used for linking
+		// it with the other nodes.
+		id := u.Node.ID.Value
+		if err := u.setExternalEdges(ctx, []driver.Value{id}, addEdges, clearEdges); err != nil {
+			return err
+		}
 	}
 	selector := u.builder.Select(u.Node.Columns...).
 		From(u.builder.Table(u.Node.Table).Schema(u.Node.Schema)).
-		Where(sql.EQ(u.Node.ID.Column, u.Node.ID.Value))
+		Where(u.Node.matchID())
 	if pred := u.Predicate; pred != nil {
 		pred(selector)
 	}
@@ -692,7 +770,7 @@ func (u *updater) nodes(ctx context.Context, tx dialect.ExecQuerier) (int, error
 		clearEdges = EdgeSpecs(u.Edges.Clear).GroupRel()
 		multiple   = u.hasExternalEdges(addEdges, clearEdges)
 		update     = u.builder.Update(u.Node.Table).Schema(u.Node.Schema)
-		selector   = u.builder.Select(u.Node.ID.Column).
+		selector   = u.builder.Select(u.Node.idColumns()...).
 				From(u.builder.Table(u.Node.Table).Schema(u.Node.Schema)).
 				WithContext(ctx)
 	)
@@ -701,6 +779,9 @@ func (u *updater) nodes(ctx context.Context, tx dialect.ExecQuerier) (int, error
 	}
 	// If this change-set contains multiple table updates.
 	if multiple {
+		if u.Node.ID == nil {
+			return 0, fmt.Errorf("sqlgraph: external edges are not supported for composite identifiers of table %q", u.Node.Table)
+		}
 		query, args := selector.Query()
 		rows := &sql.Rows{}
 		if err := u.tx.Query(ctx, query, args, rows); err != nil {
@@ -826,7 +907,7 @@ func (u *updater) scan(rows *sql.Rows) error {
 		if err := rows.Err(); err != nil {
 			return err
 		}
-		return &NotFoundError{table: u.Node.Table, id: u.Node.ID.Value}
+		return &NotFoundError{table: u.Node.Table, id: u.Node.idValue()}
 	}
 	values, err := u.ScanValues(columns)
 	if err != nil {
@@ -859,6 +940,11 @@ func (c *creator) node(ctx context.Context, tx dialect.ExecQuerier) error {
 	if err := c.insert(ctx, tx, insert); err != nil {
 		return fmt.Errorf("insert node to table %q: %w", c.Table, err)
 	}
+	// Nodes with composite identifiers (no ID field) hold
+	// only edges that reside in their table.
+	if c.ID == nil {
+		return nil
+	}
 	if err := c.graph.addM2MEdges(ctx, []driver.Value{c.ID.Value}, edges[M2M]); err != nil {
 		return err
 	}
@@ -879,7 +965,7 @@ func (c *creator) nodes(ctx context.Context, tx dialect.ExecQuerier) error {
 			return fmt.Errorf("more than 1 table for batch insert: %q != %q", node.Table, c.Nodes[i-1].Table)
 		}
 		values[i] = make(map[string]driver.Value)
-		if node.ID.Value != nil {
+		if node.ID != nil && node.ID.Value != nil {
 			columns[node.ID.Column] = struct{}{}
 			values[i][node.ID.Column] = node.ID.Value
 		}
@@ -895,7 +981,7 @@ func (c *creator) nodes(ctx context.Context, tx dialect.ExecQuerier) error {
 	for column := range columns {
 		for i := range values {
 			switch _, exists := values[i][column]; {
-			case column == c.Nodes[i].ID.Column && !exists:
+			case c.Nodes[i].ID != nil && column == c.Nodes[i].ID.Column && !exists:
 				// If the ID value was provided to one of the nodes, it should be
 				// provided to all others because this affects the way we calculate
 				// their values in MySQL and SQLite dialects.
@@ -918,6 +1004,9 @@ func (c *creator) nodes(ctx context.Context, tx dialect.ExecQuerier) error {
 	if err := c.batchInsert(ctx, tx, insert); err != nil {
 		return fmt.Errorf("insert nodes to table %q: %w", c.Nodes[0].Table, err)
 	}
+	if c.Nodes[0].ID == nil {
+		return nil
+	}
 	if err := c.batchAddM2M(ctx, c.BatchCreateSpec); err != nil {
 		return err
 	}
@@ -943,12 +1032,26 @@ func (c *creator) setTableColumns(insert *sql.InsertBuilder, edges map[Rel][]*Ed
 // insert inserts the node to its table and sets its ID if it wasn't provided by the user.
 func (c *creator) insert(ctx context.Context, tx dialect.ExecQuerier, insert *sql.InsertBuilder) error {
 	var res sql.Result
-	// If the id field was provided by the user.
-	if c.ID.Value != nil {
-		insert.Set(c.ID.Column, c.ID.Value)
+	// If the id field was provided by the user, or the
+	// node is identified by a composite identifier.
+	if c.ID == nil || c.ID.Value != nil {
+		if c.ID != nil {
+			insert.Set(c.ID.Column, c.ID.Value)
+		}
+		if opts := c.CreateSpec.OnConflict; len(opts) > 0 {
+			insert.OnConflict(opts...)
+		}
 		query, args := insert.Query()
+		if err := insert.Err(); err != nil {
+			return err
+		}
 		return tx.Exec(ctx, query, args, &res)
 	}
+	if opts := c.CreateSpec.OnConflict; len(opts) > 0 {
+		insert.OnConflict(opts...)
+		c.ensureLastInsertID(insert)
+		return c.upsertLastID(ctx, tx, insert.Returning(c.ID.Column))
+	}
 	id, err := insertLastID(ctx, tx, insert.Returning(c.ID.Column))
 	if err != nil {
 		return err
@@ -957,12 +1060,90 @@ func (c *creator) insert(ctx context.Context, tx dialect.ExecQuerier, insert *sq
 	return nil
 }
 
+// upsertLastID invokes the upsert query on the transaction and sets the ID of the node. The ID
+// is left empty in case the row was skipped by the conflict action (e.g. DO NOTHING).
+func (c *creator) upsertLastID(ctx context.Context, tx dialect.ExecQuerier, insert *sql.InsertBuilder) error {
+	query, args := insert.Query()
+	if err := insert.Err(); err != nil {
+		return err
+	}
+	// PostgreSQL returns the ID of the inserted or updated row
+	// using the `RETURNING` clause, and no rows if it was skipped.
+	if insert.Dialect() == dialect.Postgres {
+		rows := &sql.Rows{}
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return err
+		}
+		defer rows.Close()
+		var ids []driver.Value
+		if err := sql.ScanSlice(rows, &ids); err != nil {
+			return err
+		}
+		if len(ids) == 1 {
+			c.ID.Value = ids[0]
+		}
+		return nil
+	}
+	// MySQL, SQLite, etc.
+	var res sql.Result
+	if err := tx.Exec(ctx, query, args, &res); err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	// Unlike MySQL that returns the LAST_INSERT_ID also for rows that were not
+	// changed by the upsert statement, SQLite does not change the last_insert_rowid
+	// in case the row was skipped by the conflict action.
+	if affected == 0 && insert.Dialect() == dialect.SQLite {
+		return nil
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	c.ID.Value = id
+	return nil
+}
+
+// ensureLastInsertID ensures the LAST_INSERT_ID is returned also for
+// rows that were updated by the `ON DUPLICATE KEY UPDATE` clause in MySQL.
+// See: https://dev.mysql.com/doc/refman/8.0/en/insert-on-duplicate.html.
+func (c *creator) ensureLastInsertID(insert *sql.InsertBuilder) {
+	if !c.ID.Type.Numeric() || insert.Dialect() != dialect.MySQL {
+		return
+	}
+	insert.OnConflict(sql.ResolveWith(func(s *sql.UpdateSet) {
+		s.Set(c.ID.Column, sql.Expr(fmt.Sprintf("LAST_INSERT_ID(%s)", s.Table().C(c.ID.Column))))
+	}))
+}
+
 // batchInsert inserts a batch of nodes to their table and sets their ID if it wasn't provided by the user.
 func (c *creator) batchInsert(ctx context.Context, tx dialect.ExecQuerier, insert *sql.InsertBuilder) error {
+	opts := c.BatchCreateSpec.OnConflict
+	if len(opts) > 0 {
+		insert.OnConflict(opts...)
+	}
+	// Nodes with composite identifiers are inserted without
+	// returning their keys, as they are provided by the user.
+	if c.Nodes[0].ID == nil {
+		var res sql.Result
+		query, args := insert.Query()
+		if err := insert.Err(); err != nil {
+			return err
+		}
+		return tx.Exec(ctx, query, args, &res)
+	}
 	ids, err := insertLastIDs(ctx, tx, insert.Returning(c.Nodes[0].ID.Column))
 	if err != nil {
 		return err
 	}
+	// In case of an upsert, the IDs of the rows can be resolved only if the database returns
+	// them explicitly (i.e. RETURNING), and each one of the rows was either inserted or updated.
+	if len(opts) > 0 && (insert.Dialect() != dialect.Postgres || len(ids) != len(c.Nodes)) {
+		return nil
+	}
 	for i, node := range c.Nodes {
 		// ID field was provided by the user.
 		if node.ID.Value == nil {
@@ -1066,7 +1247,7 @@ func (g *graph) addM2MEdges(ctx context.Context, ids []driver.Value, edges EdgeS
 	)
 	for _, table := range edgeKeys(tables) {
 		edges := tables[table]
-		insert := g.builder.Insert(table).Columns(edges[0].Columns...)
+		insert := g.builder.Insert(table).Columns(edges[0].joinColumns()...)
 		if edges[0].Schema != "" {
 			// If the Schema field was provided to the EdgeSpec (by the
 			// generated code), it should be the same for all EdgeSpecs.
@@ -1078,9 +1259,9 @@ func (g *graph) addM2MEdges(ctx context.Context, ids []driver.Value, edges EdgeS
 				pk1, pk2 = pk2, pk1
 			}
 			for _, pair := range product(pk1, pk2) {
-				insert.Values(pair[0], pair[1])
+				insert.Values(edge.joinValues(pair[0], pair[1])...)
 				if edge.Bidi {
-					insert.Values(pair[1], pair[0])
+					insert.Values(edge.joinValues(pair[1], pair[0])...)
 				}
 			}
 		}
@@ -1099,7 +1280,7 @@ func (g *graph) batchAddM2M(ctx context.Context, spec *BatchCreateSpec) error {
 		for t, edges := range edges.GroupTable() {
 			insert, ok := tables[t]
 			if !ok {
-				insert = g.builder.Insert(t).Columns(edges[0].Columns...)
+				insert = g.builder.Insert(t).Columns(edges[0].joinColumns()...)
 				if edges[0].Schema != "" {
 					// If the Schema field was provided to the EdgeSpec (by the
 					// generated code), it should be the same for all EdgeSpecs.
@@ -1116,9 +1297,9 @@ func (g *graph) batchAddM2M(ctx context.Context, spec *BatchCreateSpec) error {
 				pk1, pk2 = pk2, pk1
 			}
 			for _, pair := range product(pk1, pk2) {
-				insert.Values(pair[0], pair[1])
+				insert.Values(edge.joinValues(pair[0], pair[1])...)
 				if edge.Bidi {
-					insert.Values(pair[1], pair[0])
+					insert.Values(edge.joinValues(pair[1], pair[0])...)
 				}
 			}
 		}
@@ -1135,6 +1316,30 @@ func (g *graph) batchAddM2M(ctx context.Context, spec *BatchCreateSpec) error {
 	return nil
 }
 
+// joinColumns returns the columns of the join-table rows of M2M edges.
+func (e *EdgeSpec) joinColumns() []string {
+	if e.Target == nil || len(e.Target.Fields) == 0 {
+		return e.Columns
+	}
+	columns := make([]string, 0, len(e.Columns)+len(e.Target.Fields))
+	columns = append(columns, e.Columns...)
+	for _, f := range e.Target.Fields {
+		columns = append(columns, f.Column)
+	}
+	return columns
+}
+
+// joinValues returns the values of a join-table row of M2M edges.
+func (e *EdgeSpec) joinValues(pk1, pk2 driver.Value) []interface{} {
+	values := []interface{}{pk1, pk2}
+	if e.Target != nil {
+		for _, f := range e.Target.Fields {
+			values = append(values, f.Value)
+		}
+	}
+	return values
+}
+
 func (g *graph) clearFKEdges(ctx context.Context, ids []driver.Value, edges []*EdgeSpec) error {
 	for _, edge := range edges {
 		if edge.Rel == O2O && edge.Inverse {
@@ -1191,7 +1396,12 @@ func (g *graph) addFKEdges(ctx context.Context, ids []driver.Value, edges []*Edg
 		// Setting the FK value of the "other" table
 		// without clearing it before, is not allowed.
 		if ids := edge.Target.Nodes; int(affected) < len(ids) {
-			return &ConstraintError{msg: fmt.Sprintf("one of %v is already connected to a different %s", ids, edge.Columns[0])}
+			return &ConstraintError{
+				msg:     fmt.Sprintf("one of %v is already connected to a different %s", ids, edge.Columns[0]),
+				Kind:    UniqueConstraint,
+				Table:   edge.Table,
+				Columns: edge.Columns,
+			}
 		}
 	}
 	return nil
@@ -1226,6 +1436,9 @@ func setTableColumns(fields []*FieldSpec, edges map[Rel][]*EdgeSpec, set func(st
 // insertLastID invokes the insert query on the transaction and returns the LastInsertID.
 func insertLastID(ctx context.Context, tx dialect.ExecQuerier, insert *sql.InsertBuilder) (driver.Value, error) {
 	query, args := insert.Query()
+	if err := insert.Err(); err != nil {
+		return nil, err
+	}
 	// PostgreSQL does not support the LastInsertId() method of sql.Result
 	// on Exec, and should be extracted manually using the `RETURNING` clause.
 	if insert.Dialect() == dialect.Postgres {
@@ -1247,6 +1460,9 @@ func insertLastID(ctx context.Context, tx dialect.ExecQuerier, insert *sql.Inser
 // insertLastIDs invokes the batch insert query on the transaction and returns the LastInsertID of all entities.
 func insertLastIDs(ctx context.Context, tx dialect.ExecQuerier, insert *sql.InsertBuilder) (ids []driver.Value, err error) {
 	query, args := insert.Query()
+	if err := insert.Err(); err != nil {
+		return nil, err
+	}
 	// PostgreSQL does not support the LastInsertId() method of sql.Result
 	// on Exec, and should be extracted manually using the `RETURNING` clause.
 	if insert.Dialect() == dialect.Postgres {
@@ -876,6 +876,23 @@ func TestCreateNode(t *testing.T) {
 				m.ExpectCommit()
 			},
 		},
+		{
+			name: "fields/composite-id",
+			spec: &CreateSpec{
+				Table: "memberships",
+				Fields: []*FieldSpec{
+					{Column: "user_id", Type: field.TypeInt, Value: 1},
+					{Column: "group_id", Type: field.TypeInt, Value: 2},
+				},
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(escape("INSERT INTO `memberships` (`user_id`, `group_id`) VALUES (?, ?)")).
+					WithArgs(1, 2).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				m.ExpectCommit()
+			},
+		},
 		{
 			name: "fields/json",
 			spec: &CreateSpec{
@@ -1071,6 +1088,29 @@ func TestCreateNode(t *testing.T) {
 				m.ExpectCommit()
 			},
 		},
+		{
+			name: "edges/m2m/fields",
+			spec: &CreateSpec{
+				Table: "users",
+				ID:    &FieldSpec{Column: "id"},
+				Fields: []*FieldSpec{
+					{Column: "name", Type: field.TypeString, Value: "mashraki"},
+				},
+				Edges: []*EdgeSpec{
+					{Rel: M2M, Table: "memberships", Columns: []string{"user_id", "group_id"}, Target: &EdgeTarget{Nodes: []driver.Value{2, 3}, IDSpec: &FieldSpec{Column: "id"}, Fields: []*FieldSpec{{Column: "role", Type: field.TypeString, Value: "member"}}}},
+				},
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(escape("INSERT INTO `users` (`name`) VALUES (?)")).
+					WithArgs("mashraki").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				m.ExpectExec(escape("INSERT INTO `memberships` (`user_id`, `group_id`, `role`) VALUES (?, ?, ?), (?, ?, ?)")).
+					WithArgs(1, 2, "member", 1, 3, "member").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				m.ExpectCommit()
+			},
+		},
 		{
 			name: "edges/m2m/bidi",
 			spec: &CreateSpec{
@@ -1230,6 +1270,37 @@ func TestBatchCreate(t *testing.T) {
 				m.ExpectCommit()
 			},
 		},
+		{
+			name: "m2m/fields",
+			nodes: []*CreateSpec{
+				{
+					Table:  "users",
+					ID:     &FieldSpec{Column: "id"},
+					Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "a8m"}},
+					Edges: []*EdgeSpec{
+						{Rel: M2M, Table: "memberships", Columns: []string{"user_id", "group_id"}, Target: &EdgeTarget{Nodes: []driver.Value{2}, IDSpec: &FieldSpec{Column: "id"}, Fields: []*FieldSpec{{Column: "role", Type: field.TypeString, Value: "admin"}}}},
+					},
+				},
+				{
+					Table:  "users",
+					ID:     &FieldSpec{Column: "id"},
+					Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "nati"}},
+					Edges: []*EdgeSpec{
+						{Rel: M2M, Table: "memberships", Columns: []string{"user_id", "group_id"}, Target: &EdgeTarget{Nodes: []driver.Value{2}, IDSpec: &FieldSpec{Column: "id"}, Fields: []*FieldSpec{{Column: "role", Type: field.TypeString, Value: "member"}}}},
+					},
+				},
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(escape("INSERT INTO `users` (`name`) VALUES (?), (?)")).
+					WithArgs("a8m", "nati").
+					WillReturnResult(sqlmock.NewResult(10, 2))
+				m.ExpectExec(escape("INSERT INTO `memberships` (`user_id`, `group_id`, `role`) VALUES (?, ?, ?), (?, ?, ?)")).
+					WithArgs(10, 2, "admin", 11, 2, "member").
+					WillReturnResult(sqlmock.NewResult(2, 2))
+				m.ExpectCommit()
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1242,6 +1313,190 @@ func TestBatchCreate(t *testing.T) {
 	}
 }
 
+func TestUpsert(t *testing.T) {
+	t.Run("MySQL", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectExec(escape("INSERT INTO `users` (`age`, `name`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `age` = VALUES(`age`), `name` = VALUES(`name`), `id` = LAST_INSERT_ID(`users`.`id`)")).
+			WithArgs(30, "a8m").
+			WillReturnResult(sqlmock.NewResult(10, 2))
+		mock.ExpectCommit()
+		spec := &CreateSpec{
+			Table: "users",
+			ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+			Fields: []*FieldSpec{
+				{Column: "age", Type: field.TypeInt, Value: 30},
+				{Column: "name", Type: field.TypeString, Value: "a8m"},
+			},
+			OnConflict: []sql.ConflictOption{
+				sql.ResolveWithNewValues(),
+			},
+		}
+		err = CreateNode(context.Background(), sql.OpenDB(dialect.MySQL, db), spec)
+		require.NoError(t, err)
+		require.Equal(t, int64(10), spec.ID.Value)
+	})
+
+	t.Run("MySQL/DoNothing", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+		spec := &CreateSpec{
+			Table: "users",
+			ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+			Fields: []*FieldSpec{
+				{Column: "name", Type: field.TypeString, Value: "a8m"},
+			},
+			OnConflict: []sql.ConflictOption{
+				sql.DoNothing(),
+			},
+		}
+		err = CreateNode(context.Background(), sql.OpenDB(dialect.MySQL, db), spec)
+		require.Error(t, err)
+	})
+
+	t.Run("Postgres", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectQuery(escape(`INSERT INTO "users" ("age", "name") VALUES ($1, $2) ON CONFLICT ("name") DO UPDATE SET "age" = "excluded"."age", "name" = "users"."name" RETURNING "id"`)).
+			WithArgs(30, "a8m").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10))
+		mock.ExpectCommit()
+		spec := &CreateSpec{
+			Table: "users",
+			ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+			Fields: []*FieldSpec{
+				{Column: "age", Type: field.TypeInt, Value: 30},
+				{Column: "name", Type: field.TypeString, Value: "a8m"},
+			},
+			OnConflict: []sql.ConflictOption{
+				sql.ConflictColumns("name"),
+				sql.ResolveWithNewValues(),
+				sql.ResolveWith(func(s *sql.UpdateSet) {
+					s.SetIgnore("name")
+				}),
+			},
+		}
+		err = CreateNode(context.Background(), sql.OpenDB(dialect.Postgres, db), spec)
+		require.NoError(t, err)
+		require.Equal(t, int64(10), spec.ID.Value)
+	})
+
+	t.Run("Postgres/DoNothing", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectQuery(escape(`INSERT INTO "users" ("name") VALUES ($1) ON CONFLICT ("name") DO NOTHING RETURNING "id"`)).
+			WithArgs("a8m").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectCommit()
+		spec := &CreateSpec{
+			Table: "users",
+			ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+			Fields: []*FieldSpec{
+				{Column: "name", Type: field.TypeString, Value: "a8m"},
+			},
+			OnConflict: []sql.ConflictOption{
+				sql.ConflictColumns("name"),
+				sql.DoNothing(),
+			},
+		}
+		err = CreateNode(context.Background(), sql.OpenDB(dialect.Postgres, db), spec)
+		require.NoError(t, err)
+		require.Nil(t, spec.ID.Value)
+	})
+
+	t.Run("SQLite/DoNothing", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectExec(escape("INSERT INTO `users` (`name`) VALUES (?) ON CONFLICT DO NOTHING")).
+			WithArgs("a8m").
+			WillReturnResult(sqlmock.NewResult(5, 0))
+		mock.ExpectCommit()
+		spec := &CreateSpec{
+			Table: "users",
+			ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+			Fields: []*FieldSpec{
+				{Column: "name", Type: field.TypeString, Value: "a8m"},
+			},
+			OnConflict: []sql.ConflictOption{
+				sql.DoNothing(),
+			},
+		}
+		err = CreateNode(context.Background(), sql.OpenDB(dialect.SQLite, db), spec)
+		require.NoError(t, err)
+		require.Nil(t, spec.ID.Value)
+	})
+
+	t.Run("Postgres/Batch", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectQuery(escape(`INSERT INTO "users" ("name") VALUES ($1), ($2) ON CONFLICT ("name") DO UPDATE SET "name" = "excluded"."name" RETURNING "id"`)).
+			WithArgs("a8m", "nati").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10).AddRow(20))
+		mock.ExpectCommit()
+		spec := &BatchCreateSpec{
+			Nodes: []*CreateSpec{
+				{
+					Table:  "users",
+					ID:     &FieldSpec{Column: "id", Type: field.TypeInt},
+					Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "a8m"}},
+				},
+				{
+					Table:  "users",
+					ID:     &FieldSpec{Column: "id", Type: field.TypeInt},
+					Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "nati"}},
+				},
+			},
+			OnConflict: []sql.ConflictOption{
+				sql.ConflictColumns("name"),
+				sql.ResolveWithNewValues(),
+			},
+		}
+		err = BatchCreate(context.Background(), sql.OpenDB(dialect.Postgres, db), spec)
+		require.NoError(t, err)
+		require.Equal(t, int64(10), spec.Nodes[0].ID.Value)
+		require.Equal(t, int64(20), spec.Nodes[1].ID.Value)
+	})
+
+	t.Run("SQLite/Batch", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectExec(escape("INSERT INTO `users` (`name`) VALUES (?), (?) ON CONFLICT DO NOTHING")).
+			WithArgs("a8m", "nati").
+			WillReturnResult(sqlmock.NewResult(10, 1))
+		mock.ExpectCommit()
+		spec := &BatchCreateSpec{
+			Nodes: []*CreateSpec{
+				{
+					Table:  "users",
+					ID:     &FieldSpec{Column: "id", Type: field.TypeInt},
+					Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "a8m"}},
+				},
+				{
+					Table:  "users",
+					ID:     &FieldSpec{Column: "id", Type: field.TypeInt},
+					Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "nati"}},
+				},
+			},
+			OnConflict: []sql.ConflictOption{
+				sql.DoNothing(),
+			},
+		}
+		err = BatchCreate(context.Background(), sql.OpenDB(dialect.SQLite, db), spec)
+		require.NoError(t, err)
+		// IDs of upserted rows cannot be resolved without the RETURNING clause.
+		require.Nil(t, spec.Nodes[0].ID.Value)
+		require.Nil(t, spec.Nodes[1].ID.Value)
+	})
+}
+
 type user struct {
 	id    int
 	age   int
@@ -1359,6 +1614,36 @@ func TestUpdateNode(t *testing.T) {
 			},
 			wantUser: &user{age: 31, id: 1},
 		},
+		{
+			name: "fields/composite-id",
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table:   "users",
+					Columns: []string{"id", "name", "age"},
+					CompositeID: []*FieldSpec{
+						{Column: "id", Type: field.TypeInt, Value: 1},
+						{Column: "name", Type: field.TypeString, Value: "a8m"},
+					},
+				},
+				Fields: FieldMut{
+					Set: []*FieldSpec{
+						{Column: "age", Type: field.TypeInt, Value: 30},
+					},
+				},
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(escape("UPDATE `users` SET `age` = ? WHERE (`id` = ? AND `name` = ?)")).
+					WithArgs(30, 1, "a8m").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(escape("SELECT `id`, `name`, `age` FROM `users` WHERE (`id` = ? AND `name` = ?)")).
+					WithArgs(1, "a8m").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
+						AddRow(1, 30, "a8m"))
+				mock.ExpectCommit()
+			},
+			wantUser: &user{name: "a8m", age: 30, id: 1},
+		},
 		{
 			name: "edges/o2o_non_inverse and m2o",
 			spec: &UpdateSpec{
@@ -1792,6 +2077,14 @@ func TestQueryNodes(t *testing.T) {
 		WithArgs(40).
 		WillReturnRows(sqlmock.NewRows([]string{"COUNT"}).
 			AddRow(3))
+	mock.ExpectQuery(escape("SELECT `users`.`id`, `users`.`age`, `users`.`name`, `users`.`fk1`, `users`.`fk2` FROM `users` WHERE `age` < ? ORDER BY `id` LIMIT 3 OFFSET 4 FOR UPDATE")).
+		WithArgs(40).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name", "fk1", "fk2"}).
+			AddRow(1, 10, nil, nil, nil))
+	mock.ExpectQuery(escape("SELECT COUNT(`users`.`id`) FROM `users` WHERE `age` < ? ORDER BY `id` LIMIT 3 OFFSET 4")).
+		WithArgs(40).
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT"}).
+			AddRow(1))
 
 	var (
 		users []*user
@@ -1832,6 +2125,56 @@ func TestQueryNodes(t *testing.T) {
 	n, err := CountNodes(context.Background(), sql.OpenDB("", db), spec)
 	require.NoError(t, err)
 	require.Equal(t, 3, n)
+
+	// Query with modifiers.
+	users = nil
+	spec.Unique = false
+	spec.Modifiers = []func(*sql.Selector){
+		func(s *sql.Selector) { s.ForUpdate() },
+	}
+	err = QueryNodes(context.Background(), sql.OpenDB("", db), spec)
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+
+	// Count nodes without the lock clause.
+	n, err = CountNodes(context.Background(), sql.OpenDB("", db), spec)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+}
+
+func TestCountNodesCompositeID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectQuery(escape("SELECT COUNT(*) FROM `memberships` WHERE `user_id` = ?")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT"}).
+			AddRow(2))
+	mock.ExpectQuery(escape("SELECT COUNT(*) FROM (SELECT DISTINCT `memberships`.`user_id`, `memberships`.`group_id` FROM `memberships` WHERE `user_id` = ?) AS `t1`")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT"}).
+			AddRow(2))
+
+	spec := &QuerySpec{
+		Node: &NodeSpec{
+			Table:   "memberships",
+			Columns: []string{"user_id", "group_id", "role"},
+			CompositeID: []*FieldSpec{
+				{Column: "user_id", Type: field.TypeInt},
+				{Column: "group_id", Type: field.TypeInt},
+			},
+		},
+		Predicate: func(s *sql.Selector) {
+			s.Where(sql.EQ("user_id", 1))
+		},
+	}
+	n, err := CountNodes(context.Background(), sql.OpenDB("", db), spec)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	spec.Unique = true
+	n, err = CountNodes(context.Background(), sql.OpenDB("", db), spec)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
 }
 
 func TestQueryNodesSchema(t *testing.T) {
@@ -2019,6 +2362,136 @@ func TestIsConstraintError(t *testing.T) {
 	}
 }
 
+type pqError map[byte]string
+
+func (e pqError) Error() string     { return "pq: " + e['M'] }
+func (e pqError) Get(k byte) string { return e[k] }
+
+func TestParseConstraintError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want *ConstraintError
+	}{
+		{
+			name: "MySQL Unique",
+			err:  errors.New(`insert node to table "users": Error 1062: Duplicate entry 'a8m' for key 'users.name'`),
+			want: &ConstraintError{Kind: UniqueConstraint, Table: "users", Constraint: "name"},
+		},
+		{
+			name: "MySQL 5.7 Unique",
+			err:  errors.New(`Error 1062 (23000): Duplicate entry 'a8m' for key 'name'`),
+			want: &ConstraintError{Kind: UniqueConstraint, Constraint: "name"},
+		},
+		{
+			name: "MySQL FK",
+			err: errors.New("Error 1452: Cannot add or update a child row: a foreign key constraint fails (`test`.`pets`, " +
+				"CONSTRAINT `pets_users_pets` FOREIGN KEY (`user_pets`, `user_id`) REFERENCES `users` (`id`) ON DELETE SET NULL)"),
+			want: &ConstraintError{Kind: ForeignKeyConstraint, Table: "pets", Constraint: "pets_users_pets", Columns: []string{"user_pets", "user_id"}},
+		},
+		{
+			name: "MySQL FK Parent",
+			err: errors.New("Error 1451: Cannot delete or update a parent row: a foreign key constraint fails (`test`.`pets`, " +
+				"CONSTRAINT `pets_users_pets` FOREIGN KEY (`user_pets`) REFERENCES `users` (`id`))"),
+			want: &ConstraintError{Kind: ForeignKeyConstraint, Table: "pets", Constraint: "pets_users_pets", Columns: []string{"user_pets"}},
+		},
+		{
+			name: "MySQL Not Null",
+			err:  errors.New("Error 1048: Column 'name' cannot be null"),
+			want: &ConstraintError{Kind: NotNullConstraint, Columns: []string{"name"}},
+		},
+		{
+			name: "MySQL Check",
+			err:  errors.New("Error 3819: Check constraint 'users_chk_1' is violated."),
+			want: &ConstraintError{Kind: CheckConstraint, Constraint: "users_chk_1"},
+		},
+		{
+			name: "Postgres Unique",
+			err:  errors.New(`pq: duplicate key value violates unique constraint "users_name_key"`),
+			want: &ConstraintError{Kind: UniqueConstraint, Constraint: "users_name_key"},
+		},
+		{
+			name: "Postgres FK",
+			err:  errors.New(`pq: insert or update on table "pets" violates foreign key constraint "pets_users_pets"`),
+			want: &ConstraintError{Kind: ForeignKeyConstraint, Table: "pets", Constraint: "pets_users_pets"},
+		},
+		{
+			name: "Postgres FK Parent",
+			err:  errors.New(`pq: update or delete on table "users" violates foreign key constraint "pets_users_pets" on table "pets"`),
+			want: &ConstraintError{Kind: ForeignKeyConstraint, Table: "pets", Constraint: "pets_users_pets"},
+		},
+		{
+			name: "Postgres Not Null",
+			err:  errors.New(`pq: null value in column "name" of relation "users" violates not-null constraint`),
+			want: &ConstraintError{Kind: NotNullConstraint, Table: "users", Columns: []string{"name"}},
+		},
+		{
+			name: "Postgres Check",
+			err:  errors.New(`pq: new row for relation "users" violates check constraint "users_age_check"`),
+			want: &ConstraintError{Kind: CheckConstraint, Table: "users", Constraint: "users_age_check"},
+		},
+		{
+			name: "Postgres Fields",
+			err:  pqError{'C': "23505", 'M': "duplicate key", 'n': "users_first_last_key", 't': "users", 'D': `Key (first, "last")=(a, m) already exists.`},
+			want: &ConstraintError{Kind: UniqueConstraint, Table: "users", Constraint: "users_first_last_key", Columns: []string{"first", "last"}},
+		},
+		{
+			name: "SQLite Unique",
+			err:  errors.New(`insert node to table "users": UNIQUE constraint failed: users.first, users.last`),
+			want: &ConstraintError{Kind: UniqueConstraint, Table: "users", Columns: []string{"first", "last"}},
+		},
+		{
+			name: "SQLite Unique Index",
+			err:  errors.New(`UNIQUE constraint failed: index 'user_name'`),
+			want: &ConstraintError{Kind: UniqueConstraint, Constraint: "user_name"},
+		},
+		{
+			name: "SQLite FK",
+			err:  errors.New(`FOREIGN KEY constraint failed`),
+			want: &ConstraintError{Kind: ForeignKeyConstraint},
+		},
+		{
+			name: "SQLite Not Null",
+			err:  errors.New(`NOT NULL constraint failed: users.name`),
+			want: &ConstraintError{Kind: NotNullConstraint, Table: "users", Columns: []string{"name"}},
+		},
+		{
+			name: "SQLite Check",
+			err:  errors.New(`CHECK constraint failed: age_positive`),
+			want: &ConstraintError{Kind: CheckConstraint, Constraint: "age_positive"},
+		},
+		{name: "MySQL Other", err: errors.New("Error 1213: Deadlock found when trying to get lock")},
+		{name: "Postgres Other", err: pqError{'C': "40001", 'M': "could not serialize access"}},
+		{name: "Other", err: errors.New("unexpected error")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := fmt.Errorf("wrapped: %w", tt.err)
+			e, ok := ParseConstraintError(err)
+			if tt.want == nil {
+				require.False(t, ok)
+				require.False(t, IsConstraintError(err))
+				return
+			}
+			require.True(t, ok)
+			tt.want.msg, tt.want.wrap = err.Error(), err
+			require.Equal(t, tt.want, e)
+			require.Equal(t, err, e.Unwrap())
+			require.True(t, IsConstraintError(err))
+			require.Equal(t, tt.want.Kind == UniqueConstraint, IsUniqueConstraintError(err))
+			require.Equal(t, tt.want.Kind == ForeignKeyConstraint, IsForeignKeyConstraintError(err))
+			require.Equal(t, tt.want.Kind == NotNullConstraint, IsNotNullConstraintError(err))
+			require.Equal(t, tt.want.Kind == CheckConstraint, IsCheckConstraintError(err))
+		})
+	}
+	// Errors that wrap a ConstraintError are not parsed again.
+	cerr := &ConstraintError{msg: "edge is already connected", Kind: UniqueConstraint, Table: "pets"}
+	e, ok := ParseConstraintError(fmt.Errorf("wrapped: %w", cerr))
+	require.True(t, ok)
+	require.Equal(t, cerr, e)
+	require.Equal(t, "unique", e.Kind.String())
+}
+
 func escape(query string) string {
 	rows := strings.Split(query, "\n")
 	for i := range rows {
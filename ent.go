@@ -63,6 +63,9 @@ type (
 		// Hooks returns an optional list of Hook to apply on
 		// mutations.
 		Hooks() []Hook
+		// Interceptors returns an optional list of Interceptor
+		// to apply on queries.
+		Interceptors() []Interceptor
 		// Policy returns the privacy policy of the schema.
 		Policy() Policy
 		// Annotations returns a list of schema annotations to be used by
@@ -169,6 +172,9 @@ type (
 		// Hooks returns a slice of hooks to add to the schema.
 		// Note that mixin hooks are executed before schema hooks.
 		Hooks() []Hook
+		// Interceptors returns a slice of interceptors to add to the schema.
+		// Note that mixin interceptors are executed before schema interceptors.
+		Interceptors() []Interceptor
 		// Policy returns a privacy policy to add to the schema.
 		// Note that mixin policy are executed before schema policy.
 		Policy() Policy
@@ -223,6 +229,9 @@ func (Schema) Mixin() []Mixin { return nil }
 // Hooks of the schema.
 func (Schema) Hooks() []Hook { return nil }
 
+// Interceptors of the schema.
+func (Schema) Interceptors() []Interceptor { return nil }
+
 // Policy of the schema.
 func (Schema) Policy() Policy { return nil }
 
@@ -232,8 +241,30 @@ func (Schema) Annotations() []schema.Annotation { return nil }
 type (
 	// Value represents a value returned by ent.
 	Value interface{}
-	// Query represents an ent query builder.
-	Query interface{}
+	// Query represents an ent query builder. It is implemented by all
+	// query builders generated by entc (ent codegen), and allows writing
+	// generic interceptors and policies that are not bound to a specific
+	// schema type.
+	Query interface {
+		// Type returns the schema type of the query (e.g. "User").
+		Type() string
+		// SetLimit sets the maximum number of records to be returned
+		// by the query.
+		SetLimit(int)
+		// SetOffset sets the number of records to skip before starting
+		// to return records from the query.
+		SetOffset(int)
+		// AddOrder appends the given ordering functions to the query. The
+		// functions must be of the storage-specific type (e.g. ent.OrderFunc,
+		// or func(*sql.Selector, func(string) bool) in the SQL dialect). An
+		// error is returned if one of the functions does not match this type.
+		AddOrder(...interface{}) error
+		// AddWhere appends the given predicates to the query. The predicates
+		// must be of the storage-specific type (e.g. predicate.User,
+		// func(*sql.Selector) or func(*dsl.Traversal)). An error is returned
+		// if one of the predicates does not match this type.
+		AddWhere(...interface{}) error
+	}
 	// Mutation represents an operation that mutate the graph.
 	// For example, adding a new node, updating many, or dropping
 	// data. The implementation is generated by entc (ent codegen).
@@ -347,6 +378,40 @@ func (f MutateFunc) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	return f(ctx, m)
 }
 
+type (
+	// Querier is the interface that wraps the Query method. It is invoked
+	// by the query builders before the actual execution of the query.
+	Querier interface {
+		// Query runs the given query and returns its result.
+		Query(context.Context, Query) (Value, error)
+	}
+
+	// The QuerierFunc type is an adapter to allow the use of ordinary
+	// function as querier. If f is a function with the appropriate signature,
+	// QuerierFunc(f) is a Querier that calls f.
+	QuerierFunc func(context.Context, Query) (Value, error)
+
+	// Interceptor defines the "query middleware". A function that gets a Querier
+	// and returns a Querier. For example:
+	//
+	//	interceptor := func(next ent.Querier) ent.Querier {
+	//		return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+	//			start := time.Now()
+	//			defer func() {
+	//				log.Printf("Type: %s, Duration: %s\n", q.Type(), time.Since(start))
+	//			}()
+	//			return next.Query(ctx, q)
+	//		})
+	//	}
+	//
+	Interceptor func(Querier) Querier
+)
+
+// Query calls f(ctx, q).
+func (f QuerierFunc) Query(ctx context.Context, q Query) (Value, error) {
+	return f(ctx, q)
+}
+
 // An Op represents a mutation operation.
 type Op uint
 
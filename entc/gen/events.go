@@ -0,0 +1,63 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gen
+
+import (
+	"entgo.io/ent/entc/load"
+	"entgo.io/ent/schema/field"
+)
+
+// OutboxName is the name of the type that holds the outbox table
+// of the change events, when the events/outbox feature is enabled.
+const OutboxName = "EventOutbox"
+
+// EventNodes returns the types that publish change events.
+func (g *Graph) EventNodes() []*Type {
+	var nodes []*Type
+	for _, n := range g.Nodes {
+		if n.HasEvents() {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
+// HasEvents reports if the type publishes change events. Views, types with
+// composite identifiers and types that were derived by other features (e.g.
+// history types) do not publish events.
+func (t Type) HasEvents() bool {
+	return t.featureEnabled(FeatureEvents) && t.HasOneFieldID() && !t.IsView() && !t.derived
+}
+
+// Outbox returns the type of the outbox table, if the events/outbox feature is enabled.
+func (g *Graph) Outbox() *Type {
+	if !g.featureEnabled(FeatureEventsOutbox) {
+		return nil
+	}
+	t, _ := g.typ(OutboxName)
+	return t
+}
+
+// outboxSchemas returns the schema of the outbox table,
+// if the events/outbox feature is enabled.
+func (g *Graph) outboxSchemas() []*load.Schema {
+	if !g.featureEnabled(FeatureEventsOutbox) {
+		return nil
+	}
+	expect(g.featureEnabled(FeatureEvents), "the %s feature requires the %s feature", FeatureEventsOutbox.Name, FeatureEvents.Name)
+	expect(g.Storage == nil || g.Storage.Name == "sql", "the %s feature is supported only by the SQL storage", FeatureEventsOutbox.Name)
+	_, ok := g.typ(OutboxName)
+	expect(!ok, "type %q conflicts with the outbox type of the %s feature", OutboxName, FeatureEventsOutbox.Name)
+	return []*load.Schema{
+		{
+			Name: OutboxName,
+			Fields: []*load.Field{
+				{Name: "entity_type", Info: &field.TypeInfo{Type: field.TypeString}, Immutable: true},
+				{Name: "payload", Info: &field.TypeInfo{Type: field.TypeJSON, Ident: "json.RawMessage", PkgPath: "encoding/json"}, Immutable: true},
+				{Name: "created_at", Info: &field.TypeInfo{Type: field.TypeTime, Ident: "time.Time", PkgPath: "time"}, Immutable: true},
+			},
+		},
+	}
+}
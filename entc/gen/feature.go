@@ -69,12 +69,172 @@ var (
 		},
 	}
 
+	// FeatureUpsert provides a feature-flag for adding upsert (ON CONFLICT)
+	// capabilities to create builders.
+	FeatureUpsert = Feature{
+		Name:        "sql/upsert",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Adds support for upsert (ON CONFLICT / ON DUPLICATE KEY) clauses to the create builders",
+	}
+
+	// FeaturePagination provides a feature-flag for adding cursor-based (keyset)
+	// pagination to the query builders.
+	FeaturePagination = Feature{
+		Name:        "pagination",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Adds a cursor-based (keyset) Paginate method to the query builders",
+		GraphTemplates: []GraphTemplate{
+			{
+				Name:   "pagination",
+				Format: "pagination.go",
+			},
+		},
+		cleanup: func(c *Config) error {
+			return os.RemoveAll(filepath.Join(c.Target, "pagination.go"))
+		},
+	}
+
+	// FeatureGraphQL provides a feature-flag for generating a GraphQL schema and
+	// resolvers glue for the ent types. It requires the pagination feature.
+	FeatureGraphQL = Feature{
+		Name:        "graphql",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Generates a GraphQL schema (SDL) with Relay connections, where and order inputs, and resolvers glue for the ent types",
+		GraphTemplates: []GraphTemplate{
+			{
+				Name:   "graphql/node",
+				Format: "gql_node.go",
+			},
+			{
+				Name:   "graphql/pagination",
+				Format: "gql_pagination.go",
+			},
+			{
+				Name:   "graphql/where_input",
+				Format: "gql_where_input.go",
+			},
+			{
+				Name:   "graphql/edge",
+				Format: "gql_edge.go",
+			},
+			{
+				Name:   "graphql/schema",
+				Format: "ent.graphql",
+			},
+		},
+		cleanup: gqlCleanup,
+	}
+
+	// FeatureGRPC provides a feature-flag for generating protobuf messages and gRPC
+	// CRUD services for the types that are annotated with the entproto annotations.
+	FeatureGRPC = Feature{
+		Name:        "grpc",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Generates protobuf definitions with stable field numbers and gRPC CRUD services for the annotated ent types",
+		GraphTemplates: []GraphTemplate{
+			{
+				Name:   "grpc/proto",
+				Format: "proto/entpb/entpb.proto",
+				Skip:   func(g *Graph) bool { return len(g.ProtoMessages()) == 0 },
+			},
+			{
+				Name:   "grpc/generate",
+				Format: "proto/entpb/generate.go",
+				Skip:   func(g *Graph) bool { return len(g.ProtoMessages()) == 0 },
+			},
+			{
+				Name:   "grpc/service",
+				Format: "proto/entpb/entpb_service.go",
+				Skip:   func(g *Graph) bool { return len(g.ProtoServices()) == 0 },
+			},
+		},
+		cleanup: protoCleanup,
+	}
+
+	// FeatureOpenAPI provides a feature-flag for generating an OpenAPI document
+	// and net/http handlers that serve the REST API that it describes.
+	FeatureOpenAPI = Feature{
+		Name:        "openapi",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Generates an OpenAPI 3 document and net/http handlers serving the CRUD operations of the ent types as a REST API",
+		GraphTemplates: []GraphTemplate{
+			{
+				Name:   "openapi/spec",
+				Format: "openapi.json",
+			},
+			{
+				Name:   "openapi/handler",
+				Format: "http_handler.go",
+			},
+		},
+		cleanup: oasCleanup,
+	}
+
+	// FeatureHistory provides a feature-flag for recording the history of the types
+	// that are annotated with the enthistory annotation in companion history types.
+	FeatureHistory = Feature{
+		Name:        "history",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Generates history types and hooks that record the changes of the annotated ent types, and APIs for querying and restoring them",
+		GraphTemplates: []GraphTemplate{
+			{
+				Name:   "history",
+				Format: "history.go",
+			},
+		},
+		cleanup: func(c *Config) error {
+			return os.RemoveAll(filepath.Join(c.Target, "history.go"))
+		},
+	}
+
+	// FeatureEvents provides a feature-flag for publishing the changes of entities
+	// as typed events to subscribers, after their transactions are committed.
+	FeatureEvents = Feature{
+		Name:        "events",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Publishes typed change events of mutations to subscribers, after their transactions are committed",
+		GraphTemplates: []GraphTemplate{
+			{
+				Name:   "events",
+				Format: "events.go",
+			},
+		},
+		cleanup: func(c *Config) error {
+			return os.RemoveAll(filepath.Join(c.Target, "events.go"))
+		},
+	}
+
+	// FeatureEventsOutbox provides a feature-flag for writing the change events to an
+	// outbox table in the transactions of their mutations, and relaying them from it to
+	// the subscribers. It requires the events feature.
+	FeatureEventsOutbox = Feature{
+		Name:        "events/outbox",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Writes the change events to an outbox table in the transaction of their mutations, for at-least-once delivery",
+	}
+
 	// AllFeatures holds a list of all feature-flags.
 	AllFeatures = []Feature{
 		FeaturePrivacy,
 		FeatureEntQL,
 		FeatureSnapshot,
 		FeatureSchemaConfig,
+		FeatureUpsert,
+		FeaturePagination,
+		FeatureGraphQL,
+		FeatureGRPC,
+		FeatureOpenAPI,
+		FeatureHistory,
+		FeatureEvents,
+		FeatureEventsOutbox,
 	}
 )
 
@@ -104,18 +104,41 @@ func NewGraph(c *Config, schemas ...*load.Schema) (g *Graph, err error) {
 	for i := range schemas {
 		g.addNode(schemas[i])
 	}
+	// Types that are generated by features (e.g. history types) are added
+	// after the loaded types, because their schemas are derived from them.
+	hs := g.historySchemas()
+	if ds := append(hs, g.outboxSchemas()...); len(ds) > 0 {
+		for _, s := range ds {
+			g.addNode(s)
+			g.Nodes[len(g.Nodes)-1].derived = true
+		}
+		g.linkHistory(hs)
+		schemas = append(schemas[:len(schemas):len(schemas)], ds...)
+	}
 	for i := range schemas {
 		g.addEdges(schemas[i])
 	}
+	for _, t := range g.Nodes {
+		g.addThroughEdges(t)
+	}
 	for _, t := range g.Nodes {
 		check(resolve(t), "resolve %q relations", t.Name)
 	}
+	for _, t := range g.Nodes {
+		check(checkViewEdges(t), "resolve %q relations", t.Name)
+	}
 	for _, t := range g.Nodes {
 		check(t.setupFKs(), "set %q foreign-keys", t.Name)
 	}
 	for i := range schemas {
 		g.addIndexes(schemas[i])
 	}
+	for _, t := range g.Nodes {
+		g.setupEdgeSchemas(t)
+	}
+	g.checkGraphQL()
+	g.checkProto()
+	g.checkOpenAPI()
 	g.defaults()
 	return
 }
@@ -135,14 +158,25 @@ func (g *Graph) defaults() {
 		return
 	}
 	// Check that all nodes have the same type for the ID field.
-	for i := 0; i < len(g.Nodes)-1; i++ {
-		cid, nid := g.Nodes[i].ID.Type, g.Nodes[i+1].ID.Type
+	// Types with composite identifiers are skipped.
+	var nodes []*Type
+	for _, n := range g.Nodes {
+		if n.HasOneFieldID() {
+			nodes = append(nodes, n)
+		}
+	}
+	if len(nodes) == 0 {
+		g.IDType = defaultIDType
+		return
+	}
+	for i := 0; i < len(nodes)-1; i++ {
+		cid, nid := nodes[i].ID.Type, nodes[i+1].ID.Type
 		if cid.Type != nid.Type {
 			g.IDType = defaultIDType
 			return
 		}
 	}
-	g.IDType = g.Nodes[0].ID.Type
+	g.IDType = nodes[0].ID.Type
 }
 
 // Gen generates the artifacts for the graph.
@@ -164,6 +198,13 @@ func generate(g *Graph) error {
 	for _, n := range g.Nodes {
 		assets.dirs = append(assets.dirs, filepath.Join(g.Config.Target, n.Package()))
 		for _, tmpl := range Templates {
+			if tmpl.Skip != nil && tmpl.Skip(n) {
+				// Remove files that were generated before the type was skipped.
+				if err := os.Remove(filepath.Join(g.Config.Target, tmpl.Format(n))); err != nil && !os.IsNotExist(err) {
+					return err
+				}
+				continue
+			}
 			b := bytes.NewBuffer(nil)
 			if err := templates.ExecuteTemplate(b, tmpl.Name, n); err != nil {
 				return fmt.Errorf("execute template %q: %w", tmpl.Name, err)
@@ -294,6 +335,94 @@ func (g *Graph) addEdges(schema *load.Schema) {
 	}
 }
 
+// addThroughEdges adds the edges from the types to the edge schemas of their
+// M2M edges. These edges are the inverse edges of the ones defined in the edge
+// schema, and they are used for generating the traversal and eager-loading APIs.
+func (g *Graph) addThroughEdges(t *Type) {
+	for _, e := range t.Edges {
+		through := e.def.Through
+		if through == nil {
+			continue
+		}
+		expect(g.Storage == nil || g.Storage.Name == "sql", "edge schemas are supported only by the SQL storage: %s.%s", t.Name, e.Name)
+		typ, ok := g.typ(through.T)
+		expect(ok, "edge schema %q does not exist for edge %s.%s", through.T, t.Name, e.Name)
+		expect(!e.Unique, "edge schema is allowed only for M2M edges: %s.%s", t.Name, e.Name)
+		owner, target := t, e.Type
+		if e.IsInverse() {
+			owner, target = e.Type, t
+		}
+		from, to, err := typ.edgeSchemaEdges(owner, target)
+		check(err, "resolve edge schema of %s.%s", t.Name, e.Name)
+		// Edge schemas are identified by their edge fields, and therefore,
+		// their tables have a composite primary key on the edge columns.
+		ids := []string{from.def.Field, to.def.Field}
+		switch {
+		case !typ.HasCompositeID():
+			expect(!typ.ID.UserDefined, "edge schema %q can't have a user-defined id field", typ.Name)
+			check(typ.setupCompositeID(ids), "set up the identifier of edge schema %q", typ.Name)
+		default:
+			expect(typ.compositeIDOf(ids), "composite identifier of edge schema %q must be composed of its edge fields %q", typ.Name, ids)
+		}
+		ref := from
+		if e.IsInverse() {
+			ref = to
+		}
+		// Skip adding the edge in case it was defined explicitly in the schema.
+		if ex, ok := t.edge(through.N); ok {
+			expect(ex.Type == typ && ex.Inverse == ref.Name, "edge %s.%s conflicts with the edge schema name of %s.%s", t.Name, ex.Name, t.Name, e.Name)
+			continue
+		}
+		_, ok = t.fields[through.N]
+		expect(!ok, "%s schema can't contain field and edge with the same name %q", t.Name, through.N)
+		t.Edges = append(t.Edges, &Edge{
+			def:         &load.Edge{Name: through.N, Type: typ.Name, RefName: ref.Name, Inverse: true},
+			Type:        typ,
+			Name:        through.N,
+			Owner:       typ,
+			Inverse:     ref.Name,
+			Optional:    true,
+			StructTag:   structTag(through.N, ""),
+			Annotations: make(map[string]interface{}),
+		})
+	}
+}
+
+// setupEdgeSchemas sets the relation table and columns of M2M edges that were
+// defined with an edge schema.
+func (g *Graph) setupEdgeSchemas(t *Type) {
+	for _, e := range t.Edges {
+		through := e.def.Through
+		if through == nil {
+			continue
+		}
+		expect(e.M2M(), "edge schema is allowed only for M2M edges: %s.%s", t.Name, e.Name)
+		expect(e.def.StorageKey == nil, "edge %s.%s with an edge schema can't define a storage-key", t.Name, e.Name)
+		typ, _ := g.typ(through.T)
+		if ref := e.Ref; ref != nil && ref.def.Through != nil {
+			expect(ref.def.Through.T == typ.Name, "mismatch edge schemas for %s.%s and %s.%s", t.Name, e.Name, e.Type.Name, ref.Name)
+		}
+		for _, f := range typ.Fields {
+			expect(f.IsEdgeField() || f.Optional || f.Default, "field %q of edge schema %q must be optional or have a default value", f.Name, typ.Name)
+		}
+		owner, target := t, e.Type
+		if e.IsInverse() {
+			owner, target = e.Type, t
+		}
+		from, to, err := typ.edgeSchemaEdges(owner, target)
+		check(err, "resolve edge schema of %s.%s", t.Name, e.Name)
+		columns := []string{from.Rel.Column(), to.Rel.Column()}
+		for _, e := range []*Edge{e, e.Ref} {
+			if e != nil {
+				e.Through = typ
+				e.Rel.Table = typ.Table()
+				e.Rel.Columns = columns
+			}
+		}
+		typ.EdgeSchema.From, typ.EdgeSchema.To = from, to
+	}
+}
+
 // resolve resolves the type reference and relation of edges.
 // It fails if one of the references is missing or invalid.
 //
@@ -392,18 +521,49 @@ func resolve(t *Type) error {
 	return nil
 }
 
+// checkViewEdges checks that views hold the foreign-keys of their edges, and
+// that regular types do not have edges to views, as views cannot be mutated.
+func checkViewEdges(t *Type) error {
+	for _, e := range t.Edges {
+		switch {
+		case t.IsView() && e.Rel.Type != M2O:
+			return fmt.Errorf("edge %s.%s of view must be a unique edge that its foreign-key is held by the view", t.Name, e.Name)
+		case !t.IsView() && e.Type.IsView():
+			return fmt.Errorf("type %q cannot have edge %q to view %q. Views can have edges to other types only", t.Name, e.Name, e.Type.Name)
+		}
+	}
+	return nil
+}
+
+// MutableNodes returns the nodes of the graph that can be
+// mutated. That is, all nodes that are not views.
+func (g *Graph) MutableNodes() []*Type {
+	nodes := make([]*Type, 0, len(g.Nodes))
+	for _, n := range g.Nodes {
+		if !n.IsView() {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
 // Tables returns the schema definitions of SQL tables for the graph.
 func (g *Graph) Tables() (all []*schema.Table) {
 	tables := make(map[string]*schema.Table)
 	for _, n := range g.Nodes {
 		table := schema.NewTable(n.Table()).
-			AddPrimary(n.ID.PK()).
 			SetAnnotation(n.EntSQL())
+		if n.HasOneFieldID() {
+			table.AddPrimary(n.ID.PK())
+		}
 		for _, f := range n.Fields {
 			if !f.IsEdgeField() {
 				table.AddColumn(f.Column())
 			}
 		}
+		for _, c := range n.checks() {
+			table.AddCheck(c)
+		}
 		tables[table.Name] = table
 		all = append(all, table)
 	}
@@ -431,16 +591,31 @@ func (g *Graph) Tables() (all []*schema.Table) {
 			case M2O:
 				ref, owner := tables[e.Type.Table()], tables[e.Rel.Table]
 				pk := ref.PrimaryKey[0]
-				column := &schema.Column{Name: e.Rel.Column(), Size: pk.Size, Type: pk.Type, Nullable: true}
+				// Edges of edge schemas hold the columns of the join table.
+				// Hence, their rows are deleted along with the edge ends.
+				join := n.EdgeSchema.From == e || n.EdgeSchema.To == e
+				column := &schema.Column{Name: e.Rel.Column(), Size: pk.Size, Type: pk.Type, Nullable: !join}
 				mayAddColumn(owner, column)
+				// Foreign-key constraints cannot be defined on views.
+				if n.IsView() {
+					continue
+				}
+				onDelete := schema.SetNull
+				if join {
+					onDelete = schema.Cascade
+				}
 				owner.AddForeignKey(&schema.ForeignKey{
 					RefTable:   ref,
-					OnDelete:   schema.SetNull,
+					OnDelete:   onDelete,
 					Columns:    []*schema.Column{column},
 					RefColumns: []*schema.Column{ref.PrimaryKey[0]},
 					Symbol:     fmt.Sprintf("%s_%s_%s", owner.Name, ref.Name, e.Name),
 				})
 			case M2M:
+				// The join table is defined by the edge schema.
+				if e.Through != nil {
+					continue
+				}
 				t1, t2 := tables[n.Table()], tables[e.Type.Table()]
 				c1 := &schema.Column{Name: e.Rel.Columns[0], Type: field.TypeInt}
 				if ref := n.ID; ref.UserDefined {
@@ -479,6 +654,16 @@ func (g *Graph) Tables() (all []*schema.Table) {
 	// Append indexes to tables after all columns were added (including relation columns).
 	for _, n := range g.Nodes {
 		table := tables[n.Table()]
+		// Composite identifiers may contain edge-fields. Hence,
+		// their primary keys are set after all columns were added.
+		for _, f := range n.CompositeID {
+			for _, c := range table.Columns {
+				if c.Name == f.StorageKey() {
+					c.Key, c.Nullable = schema.PrimaryKey, false
+					table.PrimaryKey = append(table.PrimaryKey, c)
+				}
+			}
+		}
 		for _, idx := range n.Indexes {
 			table.AddIndex(idx.Name, idx.Unique, idx.Columns)
 		}
@@ -508,9 +693,13 @@ type Snapshot struct {
 
 // SchemaSnapshot returns a JSON string represents the graph schema in loadable format.
 func (g *Graph) SchemaSnapshot() (string, error) {
-	schemas := make([]*load.Schema, len(g.Nodes))
-	for i := range g.Nodes {
-		schemas[i] = g.Nodes[i].schema
+	schemas := make([]*load.Schema, 0, len(g.Nodes))
+	for _, n := range g.Nodes {
+		// Derived types are generated
+		// again when the graph is loaded.
+		if !n.derived {
+			schemas = append(schemas, n.schema)
+		}
 	}
 	snap := Snapshot{
 		Schema:  g.Schema,
@@ -658,6 +847,10 @@ func (a assets) write() error {
 func (a assets) format() error {
 	for _, file := range a.files {
 		path := file.path
+		// Non-Go assets (e.g. GraphQL schemas) are written as is.
+		if filepath.Ext(path) != ".go" {
+			continue
+		}
 		src, err := imports.Process(path, file.content, nil)
 		if err != nil {
 			return fmt.Errorf("format file %s: %w", path, err)
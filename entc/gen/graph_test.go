@@ -6,11 +6,14 @@ package gen
 
 import (
 	"fmt"
+	"go/parser"
+	"go/token"
 	"os"
 	"path/filepath"
 	"reflect"
 	"testing"
 
+	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/entc/load"
 	"entgo.io/ent/schema/field"
 
@@ -211,6 +214,325 @@ func TestNewGraphDuplicateEdgeField(t *testing.T) {
 	require.EqualError(t, err, `entc/gen: User schema can't contain field and edge with the same name "parent"`)
 }
 
+func TestNewGraphViews(t *testing.T) {
+	view := func() *load.Schema {
+		return &load.Schema{
+			Name: "Stats",
+			Fields: []*load.Field{
+				{Name: "user_id", Info: &field.TypeInfo{Type: field.TypeInt}},
+			},
+			Edges: []*load.Edge{
+				{Name: "user", Type: "User", Unique: true, Required: true, Field: "user_id"},
+			},
+			Annotations: map[string]interface{}{
+				"EntSQL": map[string]interface{}{
+					"view": map[string]interface{}{"query": "SELECT id, id AS user_id FROM users"},
+				},
+			},
+		}
+	}
+	user := &load.Schema{Name: "User"}
+	graph, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, view())
+	require.NoError(t, err)
+	require.False(t, graph.Nodes[0].IsView())
+	require.True(t, graph.Nodes[1].IsView())
+	require.Equal(t, []*Type{graph.Nodes[0]}, graph.MutableNodes())
+	tables := graph.Tables()
+	require.Len(t, tables, 2)
+	require.NotNil(t, tables[1].View())
+	require.Empty(t, tables[1].ForeignKeys, "views do not have foreign-keys")
+
+	s := view()
+	s.Edges[0] = &load.Edge{Name: "users", Type: "User"}
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, s)
+	require.EqualError(t, err, "entc/gen: resolve \"Stats\" relations: edge Stats.users of view must be a unique edge that its foreign-key is held by the view")
+
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, &load.Schema{
+		Name:  "User",
+		Edges: []*load.Edge{{Name: "stats", Type: "Stats"}},
+	}, view())
+	require.Error(t, err)
+
+	s = view()
+	s.Hooks = []*load.Position{{Index: 0}}
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, s)
+	require.EqualError(t, err, `entc/gen: create type Stats: view "Stats" cannot have hooks or privacy policies, as it cannot be mutated`)
+}
+
+func TestNewGraphGraphQL(t *testing.T) {
+	cfg := &Config{Package: "entc/gen", Storage: drivers[0], Features: []Feature{FeaturePagination, FeatureGraphQL}}
+	user := func() *load.Schema {
+		return &load.Schema{
+			Name: "User",
+			Fields: []*load.Field{
+				{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}, Annotations: map[string]interface{}{"EntGQL": map[string]interface{}{"order_field": "NAME"}}},
+				{Name: "password", Info: &field.TypeInfo{Type: field.TypeString}, Sensitive: true},
+				{Name: "status", Info: &field.TypeInfo{Type: field.TypeEnum}, Enums: []struct{ N, V string }{{V: "ACTIVE"}}},
+			},
+			Edges: []*load.Edge{
+				{Name: "cards", Type: "Card"},
+			},
+		}
+	}
+	card := &load.Schema{Name: "Card", Annotations: map[string]interface{}{"EntGQL": map[string]interface{}{"skip": true}}}
+	graph, err := NewGraph(cfg, user(), card)
+	require.NoError(t, err)
+	require.Equal(t, []*Type{graph.Nodes[0]}, graph.GQLNodes())
+	u := graph.Nodes[0]
+	require.Len(t, u.GQLFields(), 2, "sensitive fields are omitted")
+	require.Empty(t, u.GQLEdges(), "edges to skipped types are omitted")
+	require.Equal(t, "UserStatus", u.GQLFieldType(u.Fields[2]))
+	require.Equal(t, "ID", u.GQLFieldType(u.ID))
+	require.Equal(t, []*Field{u.Fields[0]}, u.GQLOrderFields())
+
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0], Features: []Feature{FeatureGraphQL}}, user(), card)
+	require.EqualError(t, err, "entc/gen: the graphql feature requires the pagination feature")
+
+	s := user()
+	s.Fields[0].Optional = true
+	_, err = NewGraph(cfg, s, card)
+	require.EqualError(t, err, "entc/gen: field User.name cannot be used as an order field. Order fields must be required, non-sensitive fields of a basic type")
+
+	s = user()
+	s.Fields[2].Enums[0].V = "in-progress"
+	_, err = NewGraph(cfg, s, card)
+	require.EqualError(t, err, `entc/gen: invalid GraphQL enum value "in-progress" for field User.status (use entgql.Skip or entgql.Type)`)
+}
+
+func TestNewGraphProto(t *testing.T) {
+	ant := func(kv ...interface{}) map[string]interface{} {
+		m := make(map[string]interface{})
+		for i := 0; i < len(kv); i += 2 {
+			m[kv[i].(string)] = kv[i+1]
+		}
+		return map[string]interface{}{"EntProto": m}
+	}
+	user := func() *load.Schema {
+		return &load.Schema{
+			Name:        "User",
+			Annotations: ant("service", true),
+			Fields: []*load.Field{
+				{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}, Annotations: ant("field", 2)},
+				{Name: "age", Info: &field.TypeInfo{Type: field.TypeInt}, Optional: true, Annotations: ant("field", 3)},
+				{Name: "nickname", Info: &field.TypeInfo{Type: field.TypeString}, Optional: true, Nillable: true, Annotations: ant("field", 4)},
+				{Name: "status", Info: &field.TypeInfo{Type: field.TypeEnum}, Enums: []struct{ N, V string }{{N: "active", V: "active"}, {N: "in-review", V: "in-review"}}, Annotations: ant("field", 5, "enum", map[string]int32{"active": 1, "in-review": 2})},
+				{Name: "created_at", Info: &field.TypeInfo{Type: field.TypeTime}, Immutable: true, Annotations: ant("field", 6)},
+				{Name: "data", Info: &field.TypeInfo{Type: field.TypeJSON}, Annotations: ant("skip", true)},
+			},
+			Edges: []*load.Edge{
+				{Name: "pets", Type: "Pet", Annotations: ant("field", 7)},
+			},
+		}
+	}
+	pet := func() *load.Schema {
+		return &load.Schema{
+			Name:        "Pet",
+			Annotations: ant("message", true),
+			Fields: []*load.Field{
+				{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}, Annotations: ant("field", 2)},
+			},
+			Edges: []*load.Edge{
+				{Name: "owner", Type: "User", RefName: "pets", Unique: true, Inverse: true, Annotations: ant("field", 3)},
+			},
+		}
+	}
+	target := filepath.Join(os.TempDir(), "ent")
+	require.NoError(t, os.MkdirAll(target, os.ModePerm), "creating tmpdir")
+	defer os.RemoveAll(target)
+	cfg := &Config{Package: "entc/gen", Target: target, Storage: drivers[0], IDType: &field.TypeInfo{Type: field.TypeInt}, Features: []Feature{FeatureGRPC}}
+	graph, err := NewGraph(cfg, user(), pet())
+	require.NoError(t, err)
+	require.Len(t, graph.ProtoMessages(), 2)
+	require.Equal(t, []*Type{graph.Nodes[0]}, graph.ProtoServices())
+	u := graph.Nodes[0]
+	require.Len(t, u.ProtoFields(), 5, "skipped fields are omitted")
+	require.Equal(t, "Status", u.ProtoType(u.Fields[3]))
+	require.Equal(t, "STATUS_IN_REVIEW", u.ProtoEnumValues(u.Fields[3])[1].Proto)
+	require.Equal(t, "CreatedAt", protoGoName("created_at"))
+	require.NoError(t, graph.Gen())
+
+	b, err := os.ReadFile(filepath.Join(target, "proto", "entpb", "entpb.proto"))
+	require.NoError(t, err)
+	for _, s := range []string{
+		`option go_package = "entc/gen/proto/entpb";`,
+		`import "google/protobuf/timestamp.proto";`,
+		"message User {\n  int64 id = 1;\n  string name = 2;\n  int64 age = 3;\n  optional string nickname = 4;\n  Status status = 5;\n  google.protobuf.Timestamp created_at = 6;\n  repeated Pet pets = 7;",
+		"  enum Status {\n    STATUS_UNSPECIFIED = 0;\n    STATUS_ACTIVE = 1;\n    STATUS_IN_REVIEW = 2;\n  }",
+		"message Pet {\n  int64 id = 1;\n  string name = 2;\n  User owner = 3;\n}",
+		"  rpc Delete(DeleteUserRequest) returns (google.protobuf.Empty);",
+	} {
+		require.Contains(t, string(b), s)
+	}
+	require.NotContains(t, string(b), "PetService")
+	f, err := parser.ParseFile(token.NewFileSet(), filepath.Join(target, "proto", "entpb", "entpb_service.go"), nil, 0)
+	require.NoError(t, err)
+	require.Equal(t, "entpb", f.Name.Name)
+	require.NotNil(t, f.Scope.Lookup("UserService"))
+	require.NotNil(t, f.Scope.Lookup("toProtoPet"))
+
+	// Field numbers are enforced at generation time.
+	s := user()
+	s.Fields[1].Annotations = nil
+	_, err = NewGraph(cfg, s, pet())
+	require.EqualError(t, err, "entc/gen: User.age is missing a protobuf field number. Set it using entproto.Field, or skip it using entproto.Skip")
+	s = user()
+	s.Fields[1].Annotations = ant("field", 2)
+	_, err = NewGraph(cfg, s, pet())
+	require.EqualError(t, err, "entc/gen: User.name and User.age have the same protobuf field number 2")
+	s = user()
+	s.Fields[1].Annotations = ant("field", 19001)
+	_, err = NewGraph(cfg, s, pet())
+	require.EqualError(t, err, "entc/gen: invalid protobuf field number 19001 for User.age (1 is reserved for the ID field)")
+	s = user()
+	s.Fields[3].Annotations = ant("field", 5, "enum", map[string]int32{"active": 1})
+	_, err = NewGraph(cfg, s, pet())
+	require.EqualError(t, err, "entc/gen: User.status: the protobuf numbers (entproto.Enum) of the enum values must be set for all values")
+	s = user()
+	s.Fields[5].Annotations = ant("field", 8)
+	_, err = NewGraph(cfg, s, pet())
+	require.EqualError(t, err, "entc/gen: User.data: unsupported field type json.RawMessage for protobuf messages. Skip it using entproto.Skip")
+	p := pet()
+	p.Annotations = nil
+	_, err = NewGraph(cfg, user(), p)
+	require.EqualError(t, err, `entc/gen: User.pets: edge type "Pet" is not a protobuf message. Skip the edge using entproto.Skip`)
+
+	// Generated files are removed when the feature is disabled.
+	graph.Features = nil
+	require.NoError(t, graph.Gen())
+	_, err = os.Stat(filepath.Join(target, "proto"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestNewGraphOpenAPI(t *testing.T) {
+	maxLen := 30
+	user := &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}, Constraints: &field.Constraints{MaxLen: &maxLen, Patterns: []string{"^[a-z]+$"}}},
+			{Name: "email", Info: &field.TypeInfo{Type: field.TypeString}, Unique: true},
+			{Name: "age", Info: &field.TypeInfo{Type: field.TypeInt}, Optional: true, Nillable: true},
+			{Name: "password", Info: &field.TypeInfo{Type: field.TypeString}, Sensitive: true},
+			{Name: "created_at", Info: &field.TypeInfo{Type: field.TypeTime}, Immutable: true},
+		},
+	}
+	target := filepath.Join(os.TempDir(), "ent")
+	require.NoError(t, os.MkdirAll(target, os.ModePerm), "creating tmpdir")
+	defer os.RemoveAll(target)
+	cfg := &Config{Package: "entc/gen", Target: target, Storage: drivers[0], IDType: &field.TypeInfo{Type: field.TypeInt}, Features: []Feature{FeatureOpenAPI}}
+	graph, err := NewGraph(cfg, user)
+	require.NoError(t, err)
+	u := graph.Nodes[0]
+	require.Equal(t, "users", u.OASPath())
+	require.Equal(t, []*Field{u.Fields[1]}, u.OASFilterFields())
+	require.Len(t, u.OASCreateFields(), 5)
+	require.Len(t, u.OASUpdateFields(), 4, "immutable fields are not updatable")
+	doc, err := graph.OpenAPI()
+	require.NoError(t, err)
+	for _, s := range []string{`"openapi": "3.0.3"`, `"/users/{id}"`, `"maxLength": 30`, `"pattern": "^[a-z]+$"`, `"nullable": true`} {
+		require.Contains(t, doc, s)
+	}
+	require.NoError(t, graph.Gen())
+	_, err = os.Stat(filepath.Join(target, "openapi.json"))
+	require.NoError(t, err)
+
+	// REST paths must be unique.
+	_, err = NewGraph(cfg, user, &load.Schema{Name: "Users"})
+	require.EqualError(t, err, `entc/gen: types "User" and "Users" have the same REST path "/users"`)
+
+	// Generated files are removed when the feature is disabled.
+	graph.Features = nil
+	require.NoError(t, graph.Gen())
+	_, err = os.Stat(filepath.Join(target, "openapi.json"))
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(target, "http_handler.go"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestNewGraphHistory(t *testing.T) {
+	user := &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "password", Info: &field.TypeInfo{Type: field.TypeString}, Sensitive: true},
+			{Name: "created_at", Info: &field.TypeInfo{Type: field.TypeTime}, Immutable: true},
+		},
+		Annotations: map[string]interface{}{
+			"EntHistory": map[string]interface{}{"enabled": true},
+		},
+	}
+	pet := &load.Schema{Name: "Pet"}
+	cfg := &Config{Package: "entc/gen", Storage: drivers[0], IDType: &field.TypeInfo{Type: field.TypeInt}, Features: []Feature{FeatureHistory}}
+	graph, err := NewGraph(cfg, user, pet)
+	require.NoError(t, err)
+	require.Len(t, graph.Nodes, 3)
+	u, h := graph.Nodes[0], graph.Nodes[2]
+	require.Equal(t, []*Type{u}, graph.HistoryNodes())
+	require.Equal(t, h, u.History)
+	require.Equal(t, "UserHistory", h.Name)
+	require.Nil(t, graph.Nodes[1].History)
+	require.Equal(t, []*Field{u.Fields[0]}, u.HistoryFields(), "sensitive and immutable fields are not restored")
+	ref, ok := h.FieldBy(func(f *Field) bool { return f.Name == "ref" })
+	require.True(t, ok)
+	require.Equal(t, u.ID.Type, ref.Type)
+	require.Len(t, h.Indexes, 1)
+	snapshot, err := graph.SchemaSnapshot()
+	require.NoError(t, err)
+	require.NotContains(t, snapshot, "UserHistory", "history types are not stored in the snapshot")
+
+	// Types are not recorded when the feature is disabled.
+	graph, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user)
+	require.NoError(t, err)
+	require.Len(t, graph.Nodes, 1)
+	require.Nil(t, graph.Nodes[0].History)
+
+	// History types must not conflict with existing types.
+	_, err = NewGraph(cfg, user, &load.Schema{Name: "UserHistory"})
+	require.EqualError(t, err, `entc/gen: type "UserHistory" conflicts with the history type of "User"`)
+
+	// History records are written in transactions, which are supported only by the SQL storage.
+	cfg.Storage = drivers[1]
+	_, err = NewGraph(cfg, user)
+	require.EqualError(t, err, `entc/gen: type "User" is annotated with history, but the history feature is supported only by the SQL storage`)
+}
+
+func TestNewGraphEvents(t *testing.T) {
+	user := &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+		Annotations: map[string]interface{}{
+			"EntHistory": map[string]interface{}{"enabled": true},
+		},
+	}
+	cfg := &Config{Package: "entc/gen", Storage: drivers[0], IDType: &field.TypeInfo{Type: field.TypeInt}, Features: []Feature{FeatureHistory, FeatureEvents}}
+	graph, err := NewGraph(cfg, user)
+	require.NoError(t, err)
+	require.Len(t, graph.Nodes, 2)
+	require.Equal(t, []*Type{graph.Nodes[0]}, graph.EventNodes(), "derived types do not publish events")
+	require.Nil(t, graph.Outbox())
+
+	cfg.Features = append(cfg.Features, FeatureEventsOutbox)
+	graph, err = NewGraph(cfg, user)
+	require.NoError(t, err)
+	require.Len(t, graph.Nodes, 3)
+	outbox := graph.Outbox()
+	require.NotNil(t, outbox)
+	require.Equal(t, OutboxName, outbox.Name)
+	require.Equal(t, []*Type{graph.Nodes[0]}, graph.EventNodes())
+	snapshot, err := graph.SchemaSnapshot()
+	require.NoError(t, err)
+	require.NotContains(t, snapshot, OutboxName, "the outbox type is not stored in the snapshot")
+
+	// The outbox requires the events feature and the SQL storage, and must not conflict with existing types.
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0], Features: []Feature{FeatureEventsOutbox}}, user)
+	require.EqualError(t, err, "entc/gen: the events/outbox feature requires the events feature")
+	_, err = NewGraph(cfg, user, &load.Schema{Name: OutboxName})
+	require.EqualError(t, err, `entc/gen: type "EventOutbox" conflicts with the outbox type of the events/outbox feature`)
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[1], Features: []Feature{FeatureEvents, FeatureEventsOutbox}}, user)
+	require.EqualError(t, err, "entc/gen: the events/outbox feature is supported only by the SQL storage")
+}
+
 func TestRelation(t *testing.T) {
 	require := require.New(t)
 	_, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, T1)
@@ -297,6 +619,149 @@ func TestFKColumns(t *testing.T) {
 	}
 }
 
+func TestEdgeSchema(t *testing.T) {
+	require := require.New(t)
+	user := &load.Schema{
+		Name: "User",
+		Edges: []*load.Edge{
+			{Name: "groups", Type: "Group", Through: &struct{ N, T string }{N: "memberships", T: "Membership"}},
+		},
+	}
+	group := &load.Schema{
+		Name: "Group",
+		Edges: []*load.Edge{
+			{Name: "users", Type: "User", RefName: "groups", Inverse: true, Through: &struct{ N, T string }{N: "memberships", T: "Membership"}},
+		},
+	}
+	membership := &load.Schema{
+		Name: "Membership",
+		Fields: []*load.Field{
+			{Name: "role", Info: &field.TypeInfo{Type: field.TypeString}, Default: true},
+			{Name: "user_id", Info: &field.TypeInfo{Type: field.TypeInt}},
+			{Name: "group_id", Info: &field.TypeInfo{Type: field.TypeInt}},
+		},
+		Edges: []*load.Edge{
+			{Name: "user", Type: "User", Unique: true, Required: true, Field: "user_id"},
+			{Name: "group", Type: "Group", Unique: true, Required: true, Field: "group_id"},
+		},
+	}
+	graph, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, group, membership)
+	require.NoError(err)
+	u, g, m := graph.Nodes[0], graph.Nodes[1], graph.Nodes[2]
+	require.Len(u.Edges, 2)
+	require.Len(g.Edges, 2)
+	require.True(m.IsEdgeSchema())
+	require.Equal(m.Edges[0], m.EdgeSchema.From)
+	require.Equal(m.Edges[1], m.EdgeSchema.To)
+	for _, e := range []*Edge{u.Edges[0], g.Edges[0]} {
+		require.Equal(M2M, e.Rel.Type)
+		require.Equal(m, e.Through)
+		require.Equal("memberships", e.Rel.Table)
+		require.Equal([]string{"user_id", "group_id"}, e.Rel.Columns)
+	}
+	for _, e := range []*Edge{u.Edges[1], g.Edges[1]} {
+		require.Equal("memberships", e.Name)
+		require.Equal(O2M, e.Rel.Type)
+		require.Equal(m, e.Type)
+	}
+	require.Equal([]string{"user_id"}, u.Edges[1].Rel.Columns)
+	require.Equal([]string{"group_id"}, g.Edges[1].Rel.Columns)
+	require.True(m.HasCompositeID())
+	require.Equal([]*Field{m.Fields[1], m.Fields[2]}, m.CompositeID)
+	require.Empty(m.Indexes)
+
+	tables := graph.Tables()
+	require.Len(tables, 3, "join table should not be created")
+	require.Equal("memberships", tables[2].Name)
+	require.Len(tables[2].PrimaryKey, 2)
+	require.Equal("user_id", tables[2].PrimaryKey[0].Name)
+	require.Equal("group_id", tables[2].PrimaryKey[1].Name)
+	for _, fk := range tables[2].ForeignKeys {
+		require.Equal(schema.Cascade, fk.OnDelete)
+		require.False(fk.Columns[0].Nullable)
+	}
+
+	membership.Fields[0].Default = false
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, group, membership)
+	require.EqualError(err, `entc/gen: field "role" of edge schema "Membership" must be optional or have a default value`)
+	membership.Fields[0].Default = true
+
+	membership.Edges[1].Required = false
+	membership.Fields[2].Optional = true
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, group, membership)
+	require.EqualError(err, `entc/gen: resolve edge schema of User.groups: edge "group" of edge schema "Membership" must be required`)
+	membership.Edges[1].Required = true
+	membership.Fields[2].Optional = false
+
+	membership.Annotations = map[string]interface{}{
+		(&field.Annotation{}).Name(): map[string]interface{}{"ID": []string{"group_id", "user_id"}},
+	}
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, group, membership)
+	require.EqualError(err, `entc/gen: composite identifier of edge schema "Membership" must be composed of its edge fields ["user_id" "group_id"]`)
+	membership.Annotations = nil
+
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0], Features: []Feature{FeaturePrivacy}}, user, group, membership)
+	require.EqualError(err, `entc/gen: set up the identifier of edge schema "Membership": type "Membership" with a composite identifier is not supported by the privacy feature`)
+}
+
+func TestCompositeID(t *testing.T) {
+	require := require.New(t)
+	user := &load.Schema{
+		Name: "User",
+		Edges: []*load.Edge{
+			{Name: "tweets", Type: "UserTweet"},
+		},
+	}
+	tweet := &load.Schema{
+		Name: "UserTweet",
+		Fields: []*load.Field{
+			{Name: "user_id", Info: &field.TypeInfo{Type: field.TypeInt}},
+			{Name: "tweet_id", Info: &field.TypeInfo{Type: field.TypeInt}},
+			{Name: "text", Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+		Edges: []*load.Edge{
+			{Name: "user", Type: "User", RefName: "tweets", Inverse: true, Unique: true, Required: true, Field: "user_id"},
+		},
+		Annotations: map[string]interface{}{
+			(&field.Annotation{}).Name(): map[string]interface{}{"ID": []string{"user_id", "tweet_id"}},
+		},
+	}
+	graph, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, tweet)
+	require.NoError(err)
+	u, ut := graph.Nodes[0], graph.Nodes[1]
+	require.True(ut.HasCompositeID())
+	require.False(ut.HasOneFieldID())
+	require.Nil(ut.ID)
+	require.Len(ut.CompositeID, 2)
+	require.True(ut.CompositeID[0].Immutable)
+	require.Empty(u.EdgesWithID())
+	require.Len(ut.EdgesWithID(), 1)
+	require.Empty(ut.MutableEdges(), "edges of the identifier can't be modified")
+
+	tables := graph.Tables()
+	require.Len(tables, 2)
+	require.Equal("user_tweets", tables[1].Name)
+	require.Len(tables[1].PrimaryKey, 2)
+	require.Equal("user_id", tables[1].PrimaryKey[0].Name)
+	require.Equal("tweet_id", tables[1].PrimaryKey[1].Name)
+	require.False(tables[1].PrimaryKey[1].Increment)
+
+	tweet.Fields[1].Optional = true
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, tweet)
+	require.EqualError(err, `entc/gen: create type UserTweet: field "tweet_id" of the composite identifier of "UserTweet" can't be optional or nillable`)
+	tweet.Fields[1].Optional = false
+
+	tweet.Annotations[(&field.Annotation{}).Name()] = map[string]interface{}{"ID": []string{"user_id", "id"}}
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, tweet)
+	require.EqualError(err, `entc/gen: create type UserTweet: field "id" of the composite identifier was not found in "UserTweet"`)
+	tweet.Annotations[(&field.Annotation{}).Name()] = map[string]interface{}{"ID": []string{"user_id", "tweet_id"}}
+
+	tweet.Edges[0].Field = ""
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, tweet)
+	require.Error(err)
+	require.Contains(err.Error(), `must hold a foreign-key field`)
+}
+
 func TestGraph_Gen(t *testing.T) {
 	require := require.New(t)
 	target := filepath.Join(os.TempDir(), "ent")
@@ -0,0 +1,250 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"entgo.io/ent/entgql"
+	"entgo.io/ent/schema/field"
+)
+
+// EntGQL returns the EntGQL annotation of the type.
+// An empty annotation is returned if it does not exist.
+func (t Type) EntGQL() *entgql.Annotation {
+	return entgqlAnnotate(t.Annotations)
+}
+
+// EntGQL returns the EntGQL annotation of the field.
+// An empty annotation is returned if it does not exist.
+func (f Field) EntGQL() *entgql.Annotation {
+	return entgqlAnnotate(f.Annotations)
+}
+
+// EntGQL returns the EntGQL annotation of the edge.
+// An empty annotation is returned if it does not exist.
+func (e Edge) EntGQL() *entgql.Annotation {
+	return entgqlAnnotate(e.Annotations)
+}
+
+// GQLNodes returns the types that are exposed in the GraphQL schema.
+func (g *Graph) GQLNodes() []*Type {
+	var nodes []*Type
+	for _, n := range g.Nodes {
+		if n.IsGQL() {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
+// GQLIDType returns the type of the identifiers of the nodes in the GraphQL schema.
+func (g *Graph) GQLIDType() *field.TypeInfo {
+	if nodes := g.GQLNodes(); len(nodes) > 0 {
+		return nodes[0].ID.Type
+	}
+	return g.IDType
+}
+
+// GQLScalars returns the custom GraphQL scalars that are
+// used by the fields of the GraphQL schema, sorted by name.
+func (g *Graph) GQLScalars() []string {
+	seen := map[string]bool{"Cursor": true, "Time": true}
+	for name := range gqlBuiltinTypes {
+		seen[name] = true
+	}
+	var scalars []string
+	for _, n := range g.GQLNodes() {
+		for _, f := range n.GQLFields() {
+			if t := f.EntGQL().Type; t != "" && !seen[t] {
+				seen[t] = true
+				scalars = append(scalars, t)
+			}
+		}
+	}
+	sort.Strings(scalars)
+	return scalars
+}
+
+// IsGQL reports if the type is exposed in the GraphQL schema.
+func (t Type) IsGQL() bool {
+	return t.HasOneFieldID() && !t.EntGQL().Skip
+}
+
+// GQLName returns the name of the type in the GraphQL schema.
+func (t Type) GQLName() string {
+	if name := t.EntGQL().Type; name != "" {
+		return name
+	}
+	return t.Name
+}
+
+// GQLFields returns the fields of the type that are exposed in the GraphQL schema.
+// Sensitive fields, and fields without a GraphQL representation are omitted.
+func (t Type) GQLFields() []*Field {
+	var fields []*Field
+	for _, f := range t.Fields {
+		if !f.EntGQL().Skip && !f.Sensitive() && t.GQLFieldType(f) != "" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// GQLWhereFields returns the fields of the type that can be used for filtering in the
+// generated WhereInput, starting with the ID field. Fields with custom Go types are omitted.
+func (t Type) GQLWhereFields() []*Field {
+	fields := []*Field{t.ID}
+	for _, f := range t.GQLFields() {
+		if !f.HasGoType() && len(ops(f)) > 0 {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// GQLWhereOps returns the predicate operations of the field in the
+// generated WhereInput. They are the same as the generated predicates.
+func (t Type) GQLWhereOps(f *Field) []Op {
+	op := ops(f)
+	if f != t.ID && t.Storage.Ops != nil {
+		op = append(op, t.Storage.Ops(f)...)
+	}
+	return op
+}
+
+// GQLEdges returns the edges of the type that are exposed in the GraphQL schema.
+func (t Type) GQLEdges() []*Edge {
+	var edges []*Edge
+	for _, e := range t.Edges {
+		if !e.EntGQL().Skip && e.Type.IsGQL() {
+			edges = append(edges, e)
+		}
+	}
+	return edges
+}
+
+// GQLOrderFields returns the fields of the type that were annotated as order fields.
+func (t Type) GQLOrderFields() []*Field {
+	var fields []*Field
+	for _, f := range t.GQLFields() {
+		if f.EntGQL().OrderField != "" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// GQLFieldType returns the GraphQL type of the given field, or an
+// empty string if the field does not have a GraphQL representation.
+func (t Type) GQLFieldType(f *Field) string {
+	if name := f.EntGQL().Type; name != "" && f != t.ID {
+		return name
+	}
+	switch ft := f.Type.Type; {
+	case f == t.ID || f.IsEdgeField() || ft == field.TypeUUID:
+		return "ID"
+	case ft == field.TypeBool:
+		return "Boolean"
+	case ft.Integer():
+		return "Int"
+	case ft.Float():
+		return "Float"
+	case ft == field.TypeString:
+		return "String"
+	case ft == field.TypeTime:
+		return "Time"
+	case ft == field.TypeEnum:
+		return t.GQLName() + f.StructField()
+	default:
+		return ""
+	}
+}
+
+// GQLConnection reports if the edge is exposed as a Relay connection.
+func (e Edge) GQLConnection() bool {
+	return !e.Unique && e.EntGQL().RelayConnection
+}
+
+// gqlBuiltinTypes holds the builtin scalar types of GraphQL.
+var gqlBuiltinTypes = map[string]bool{
+	"ID":      true,
+	"Int":     true,
+	"Float":   true,
+	"String":  true,
+	"Boolean": true,
+}
+
+// gqlName matches a valid GraphQL name.
+var gqlName = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+
+// checkGraphQL checks that the graph can be exposed in a GraphQL schema.
+func (g *Graph) checkGraphQL() {
+	if !g.featureEnabled(FeatureGraphQL) {
+		return
+	}
+	expect(g.featureEnabled(FeaturePagination), "the %s feature requires the %s feature", FeatureGraphQL.Name, FeaturePagination.Name)
+	var idType *field.TypeInfo
+	for _, n := range g.Nodes {
+		if n.EntGQL().Skip {
+			continue
+		}
+		expect(n.HasOneFieldID(), "type %q with a composite identifier is not supported by the %s feature (use entgql.Skip)", n.Name, FeatureGraphQL.Name)
+		expect(gqlName.MatchString(n.GQLName()), "invalid GraphQL type name %q for type %q", n.GQLName(), n.Name)
+		// The Node interface resolves nodes by their identifiers,
+		// and therefore, all types must share the same ID type.
+		if idType == nil {
+			idType = n.ID.Type
+		}
+		expect(idType.Type == n.ID.Type.Type, "type %q: all types must share the same ID type to implement the Node interface of the %s feature", n.Name, FeatureGraphQL.Name)
+		orders := make(map[string]bool)
+		for _, f := range n.Fields {
+			ant := f.EntGQL()
+			if ant.OrderField != "" {
+				expect(gqlName.MatchString(ant.OrderField), "invalid order field name %q for field %s.%s", ant.OrderField, n.Name, f.Name)
+				expect(!orders[ant.OrderField], "type %q contains multiple %q order fields", n.Name, ant.OrderField)
+				orders[ant.OrderField] = true
+				// Order fields are backed by the <T>OrderField values of the pagination feature.
+				orderable := !f.Optional && !f.Nillable && !f.Sensitive() && !f.HasGoType() && (f.Type.Numeric() || f.IsString() || f.IsTime() || f.IsEnum())
+				expect(orderable, "field %s.%s cannot be used as an order field. Order fields must be required, non-sensitive fields of a basic type", n.Name, f.Name)
+			}
+			if f.IsEnum() && !ant.Skip && ant.Type == "" {
+				for _, e := range f.Enums {
+					expect(gqlName.MatchString(e.Value), "invalid GraphQL enum value %q for field %s.%s (use entgql.Skip or entgql.Type)", e.Value, n.Name, f.Name)
+				}
+			}
+		}
+	}
+}
+
+// entgqlAnnotate extracts the entgql annotation from a loaded annotation format.
+func entgqlAnnotate(annotation map[string]interface{}) *entgql.Annotation {
+	annotate := &entgql.Annotation{}
+	if annotation == nil || annotation[annotate.Name()] == nil {
+		return annotate
+	}
+	if buf, err := json.Marshal(annotation[annotate.Name()]); err == nil {
+		_ = json.Unmarshal(buf, &annotate)
+	}
+	return annotate
+}
+
+// gqlFiles holds the files that are generated by the graphql feature.
+var gqlFiles = []string{"gql_node.go", "gql_pagination.go", "gql_where_input.go", "gql_edge.go", "ent.graphql"}
+
+// gqlCleanup removes the files that were generated by the graphql feature.
+func gqlCleanup(c *Config) error {
+	for _, name := range gqlFiles {
+		if err := os.Remove(filepath.Join(c.Target, name)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("remove %s: %w", name, err)
+		}
+	}
+	return nil
+}
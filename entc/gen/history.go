@@ -0,0 +1,111 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gen
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent/entc/load"
+	"entgo.io/ent/enthistory"
+	"entgo.io/ent/schema/field"
+)
+
+// EntHistory returns the EntHistory annotation of the type.
+// An empty annotation is returned if it does not exist.
+func (t Type) EntHistory() *enthistory.Annotation {
+	annotate := &enthistory.Annotation{}
+	if t.Annotations == nil || t.Annotations[annotate.Name()] == nil {
+		return annotate
+	}
+	if buf, err := json.Marshal(t.Annotations[annotate.Name()]); err == nil {
+		_ = json.Unmarshal(buf, &annotate)
+	}
+	return annotate
+}
+
+// HistoryNodes returns the types that record their history.
+func (g *Graph) HistoryNodes() []*Type {
+	var nodes []*Type
+	for _, n := range g.Nodes {
+		if n.History != nil {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
+// HasTxHook reports if the mutations of the type may be executed in transactions that are
+// started by its generated hooks (e.g. the history and the outbox hooks). In this case, the
+// builders execute their operations using the driver of the mutation, that is replaced by the hook.
+func (t Type) HasTxHook() bool {
+	return t.History != nil || t.HasEvents() && t.featureEnabled(FeatureEventsOutbox)
+}
+
+// HistoryFields returns the fields that are restored from the history
+// records of the type. Immutable fields are skipped, and so are fields
+// that are not encoded to JSON (e.g. sensitive fields), because they
+// are not stored in the history records.
+func (t Type) HistoryFields() []*Field {
+	var fields []*Field
+	for _, f := range t.MutableFields() {
+		if f.JSONName() != "-" && !f.Sensitive() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// historySchemas returns the schemas of the companion types that
+// record the history of the annotated types. Note that the schemas
+// are synthesized, and therefore, they do not have runtime objects
+// (like defaults, validators or hooks) that are loaded from Go code.
+func (g *Graph) historySchemas() []*load.Schema {
+	if !g.featureEnabled(FeatureHistory) {
+		return nil
+	}
+	var schemas []*load.Schema
+	for _, n := range g.Nodes {
+		if !n.EntHistory().Enabled {
+			continue
+		}
+		expect(g.Storage == nil || g.Storage.Name == "sql", "type %q is annotated with history, but the history feature is supported only by the SQL storage", n.Name)
+		expect(n.HasOneFieldID(), "type %q with a composite identifier is not supported by the history feature", n.Name)
+		expect(!n.IsView(), "view %q is not supported by the history feature", n.Name)
+		_, ok := g.typ(n.Name + "History")
+		expect(!ok, "type %q conflicts with the history type of %q", n.Name+"History", n.Name)
+		for _, e := range n.Edges {
+			expect(pascal(e.Name) != "History", "edge %q of type %q conflicts with the history methods of the type", e.Name, n.Name)
+		}
+		schemas = append(schemas, &load.Schema{
+			Name: n.Name + "History",
+			Fields: []*load.Field{
+				{Name: "history_time", Info: &field.TypeInfo{Type: field.TypeTime, Ident: "time.Time", PkgPath: "time"}, Immutable: true},
+				{Name: "operation", Info: &field.TypeInfo{Type: field.TypeEnum}, Immutable: true, Enums: []struct{ N, V string }{
+					{N: "create", V: "create"},
+					{N: "update", V: "update"},
+					{N: "delete", V: "delete"},
+				}},
+				{Name: "ref", Info: n.ID.Type, Immutable: true},
+				{Name: "actor", Info: &field.TypeInfo{Type: field.TypeString}, Optional: true, Immutable: true},
+				{Name: "old_values", Info: &field.TypeInfo{Type: field.TypeJSON, Ident: "json.RawMessage", PkgPath: "encoding/json"}, Optional: true, Immutable: true},
+				{Name: "new_values", Info: &field.TypeInfo{Type: field.TypeJSON, Ident: "json.RawMessage", PkgPath: "encoding/json"}, Optional: true, Immutable: true},
+				{Name: "edge_changes", Info: &field.TypeInfo{Type: field.TypeJSON, Ident: "map[string]enthistory.EdgeChange", PkgPath: "entgo.io/ent/enthistory"}, Optional: true, Immutable: true},
+			},
+			Indexes: []*load.Index{
+				{Fields: []string{"ref", "history_time"}},
+			},
+		})
+	}
+	return schemas
+}
+
+// linkHistory links the annotated types to their history types.
+func (g *Graph) linkHistory(schemas []*load.Schema) {
+	for _, s := range schemas {
+		h, _ := g.typ(s.Name)
+		n, _ := g.typ(s.Name[:len(s.Name)-len("History")])
+		n.History = h
+	}
+}
@@ -20,6 +20,7 @@
 // template/dialect/gremlin/group.tmpl
 // template/dialect/gremlin/meta.tmpl
 // template/dialect/gremlin/open.tmpl
+// template/dialect/gremlin/pagination.tmpl
 // template/dialect/gremlin/predicate.tmpl
 // template/dialect/gremlin/query.tmpl
 // template/dialect/gremlin/select.tmpl
@@ -32,10 +33,12 @@
 // template/dialect/sql/entql.tmpl
 // template/dialect/sql/errors.tmpl
 // template/dialect/sql/feature/schemaconfig.tmpl
+// template/dialect/sql/feature/upsert.tmpl
 // template/dialect/sql/globals.tmpl
 // template/dialect/sql/group.tmpl
 // template/dialect/sql/meta.tmpl
 // template/dialect/sql/open.tmpl
+// template/dialect/sql/pagination.tmpl
 // template/dialect/sql/predicate.tmpl
 // template/dialect/sql/query.tmpl
 // template/dialect/sql/select.tmpl
@@ -43,13 +46,25 @@
 // template/dialect/sql/update.tmpl
 // template/ent.tmpl
 // template/enttest.tmpl
+// template/events.tmpl
+// template/graphql/edge.tmpl
+// template/graphql/node.tmpl
+// template/graphql/pagination.tmpl
+// template/graphql/schema.tmpl
+// template/graphql/where_input.tmpl
+// template/grpc/generate.tmpl
+// template/grpc/proto.tmpl
+// template/grpc/service.tmpl
 // template/header.tmpl
+// template/history.tmpl
 // template/hook.tmpl
 // template/import.tmpl
 // template/internal.tmpl
 // template/meta.tmpl
 // template/migrate/migrate.tmpl
 // template/migrate/schema.tmpl
+// template/openapi/handler.tmpl
+// template/pagination.tmpl
 // template/predicate.tmpl
 // template/privacy/filter.tmpl
 // template/privacy/privacy.tmpl
@@ -132,7 +147,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templateBaseTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\xcd\x6e\xdb\x38\x10\x3e\x4b\x4f\x31\x10\xbc\x5d\x3b\x75\xa4\x36\xb7\x2d\x90\x43\x36\x68\x76\x0b\xb4\x69\x17\xe9\xb6\xc7\x05\x23\x8d\x64\x22\x12\xa9\x92\x94\x13\x43\xf0\xbb\x2f\x86\x14\xf5\xe3\x38\x89\x9b\x34\x87\x40\x9e\x21\xe7\xe7\x9b\x6f\x86\x94\xda\x36\x39\x0a\xcf\x65\xbd\x51\xbc\x58\x19\x38\x79\xf3\xf6\x8f\xe3\x5a\xa1\x46\x61\xe0\x82\xa5\x78\x2d\xe5\x0d\x7c\x10\x69\x0c\x67\x65\x09\x76\x91\x06\xd2\xab\x35\x66\x71\xf8\x75\xc5\x35\x68\xd9\xa8\x14\x21\x95\x19\x02\xd7\x50\xf2\x14\x85\xc6\x0c\x1a\x91\xa1\x02\xb3\x42\x38\xab\x59\xba\x42\x38\x89\xdf\x78\x2d\xe4\xb2\x11\x59\xc8\x85\xd5\x7f\xfc\x70\xfe\xfe\xf2\xea\x3d\xe4\xbc\x44\xe8\x64\x4a\x4a\x03\x19\x57\x98\x1a\xa9\x36\x20\x73\x30\x23\x67\x46\x21\xc6\xe1\x51\xb2\xdd\x86\x61\xdb\x42\x86\x39\x17\x08\xd1\x35\xd3\x18\x41\x27\x9c\xd5\x37\x05\xbc\x3b\x05\x12\xc2\x2c\x3e\x97\x22\xe7\x45\xfc\x85\xa5\x37\xac\x40\x5a\xd4\xb6\x60\xb0\xaa\x4b\x66\x10\xa2\x15\xb2\x0c\x55\x04\x33\xbf\x7d\x50\xf1\xaa\x96\xca\x78\x55\x92\x00\xa1\xc3\x4a\xce\x34\x6a\x30\x12\xd8\x5a\xf2\x0c\xdc\x2a\x48\xa5\xc8\x4b\x9e\x1a\x4d\x89\x34\x1a\xd5\xef\xda\x42\x13\x87\x66\x53\x23\xcc\xc3\xe0\x73\x0d\xfe\xef\x94\x4c\xc5\x9f\xeb\x30\xf8\x9b\x80\x1e\x0b\x49\x10\x06\xdf\x58\xd9\xe0\x58\x6c\x05\x61\xf0\x4f\x83\x6a\x33\x96\x5b\x41\x18\x7c\x91\x25\x4f\x37\x23\xb9\x13\x84\xc1\xa7\xc6\x30\x23\xd5\xa0\xe8\x04\x9d\x86\x4b\x31\xd5\x70\x29\x3a\x15\x5e\x34\x22\x1d\xab\xac\x20\x5c\x38\x8c\x4d\x55\x97\x04\x72\xad\xb8\x30\x39\x44\x19\x67\x25\xa6\x26\xf9\x4d\x27\x52\x65\xa8\x12\xcd\x0b\xc1\x4c\xa3\x30\x82\x59\x7c\x65\xa4\x1a\xc0\xbf\xeb\x21\x76\x66\x62\x0f\xbd\x62\xa2\x40\x98\xe5\x4b\x98\x59\x23\xe4\xc0\x3d\x6c\xb7\x61\x40\x6e\x73\x38\x85\x9a\xe9\x94\x95\xf4\x4c\xd2\x24\x01\xa7\xd8\x6e\x81\xd5\x75\xc9\xa9\x36\x2b\x84\x82\xaf\x51\x40\xce\xb1\xcc\x6c\x49\xda\x16\x9a\xba\x46\xd5\x2d\xb5\x66\xe3\x30\xc8\x29\x49\x6f\x60\xde\x2d\x8f\xe3\x58\x1b\xc5\x45\xb1\x80\xcf\xb4\xce\x22\xd1\x86\x41\xd0\xb6\xc7\x70\xcb\xcd\x0a\xf0\xce\xa0\xc8\x60\xce\x45\x86\x77\x30\x8b\x2f\x65\x86\x1a\xde\x2c\x20\xa2\xb5\x11\x99\x8b\xec\xd6\xc8\xa7\x72\x4c\xc1\x06\xc1\x41\xd8\xe5\xce\xc6\x18\xb6\x20\x08\x14\x9a\x46\xd9\x44\xf6\x02\x68\x6d\x53\x50\xd6\x93\x05\x9a\x7e\xf9\xa6\xb0\x0b\xf7\xfa\x2c\x94\x6c\xea\x07\xeb\x95\x24\x70\x56\x14\x0a\x0b\xcf\x08\x0f\x32\x13\xc0\x3a\x05\xb1\x48\x1b\xac\x41\xba\x1e\xb6\x16\x8f\xaf\x37\x60\x14\x5b\xa3\xd2\xac\x4c\x34\x96\xb6\xa3\xe3\x87\x09\x60\x5d\x69\x1a\x25\x0c\x6a\x8d\x4d\x26\x27\x0e\x08\x14\xf7\x20\x15\x28\x14\xac\xe2\xa2\x00\x26\xa4\x59\xa1\x02\xf7\xdf\xaf\xd1\xae\x4a\x69\xa3\x8d\xac\x40\xb0\x0a\x75\x0c\x17\x52\x01\xde\xb1\xaa\x2e\xf1\x5d\x98\x24\x61\x92\x04\x7f\x51\xa0\x7f\x6e\x5c\xdd\xdf\x2e\x1d\x5d\x4e\x16\x31\xe9\xfa\xac\xe7\x7e\xa6\x6c\xb7\xf1\x99\x1e\xff\xba\x6a\xaa\x6e\xeb\x62\x09\x91\x6e\xaa\xff\xdc\xaf\x68\xb1\x84\x03\x76\x9d\x4c\x76\x9d\x44\x0b\xe7\xf8\x2a\x65\x62\x9e\x9a\xbb\x25\xbc\x5a\x2f\x28\x50\xcb\xd1\x33\x3d\xcf\xc5\xb4\x14\x4b\x5b\x60\xcf\xd4\x69\x95\xda\xd0\x92\xf5\xc9\xb2\x33\xbd\x4b\xb4\x27\x68\x36\xe9\x54\x42\x76\x09\x33\x02\xfb\x82\x72\x20\x56\xfb\x9a\xe1\xd0\xb4\xc2\xb2\xbd\x6b\x5b\xda\xd3\xab\x1e\x69\x05\x17\x5f\x2a\x85\x36\xbb\x21\xb6\x2d\xf0\x1c\x56\x4c\x7f\x9d\x06\xe8\xbb\xe0\x89\x16\xbd\x64\x15\xb1\xdc\x06\xd2\xf7\xab\x18\x75\xe8\xe3\xfd\xd5\x45\xe0\x9b\xab\x9f\x40\x62\x77\x04\xb5\x2d\xfc\x68\xa4\xc1\x3e\xe7\xfd\x7c\x96\x16\x6c\x9e\x8f\x71\xdc\x6e\x77\x66\x18\x1d\x84\xbd\x53\x64\xe9\xca\x35\xd9\x64\x82\x51\x00\xf3\x3d\xa6\x9c\x01\xc7\x93\xde\xc6\x1e\xc2\xfc\xcc\x78\x13\x10\x7d\xf7\x2e\xa2\xb1\xbb\xc3\xe6\x9c\x2b\xee\xaf\x9e\x73\x49\x02\xdf\x58\xc9\x33\x0b\xf0\x7b\xa5\xec\xa0\x20\x63\x1a\x6e\x57\x28\x60\xdd\x29\x69\x6e\x74\xb0\xe6\x8c\x97\xba\x3b\xa3\x77\xf7\x6a\xa3\x9a\xd4\x10\x32\xc4\x98\x0e\x40\x48\x12\x70\x99\xd2\x38\xc9\x0a\xb4\xe3\x25\x0e\x03\x54\x0a\x90\xf6\x85\x2e\x12\x67\x83\xd3\xb8\xa9\x50\x18\x47\x09\x74\x42\x61\x50\xe5\x2c\xc5\xd8\xf5\xf6\x1c\xe1\x68\xc7\xf9\xc2\xed\x9f\x2f\xbc\xdb\xb6\xef\x4c\x8c\x51\xa9\xb8\x53\x77\xce\xfe\x15\xb7\x8a\xd5\x7b\xbd\xe9\xf8\xbb\x62\xf6\xe4\x3b\xc8\xad\xb3\x34\x5f\x74\xa1\xee\xb8\xed\xdc\x7d\xd0\x0f\xe1\xcc\xe0\x5a\xca\x12\x99\x00\x2e\x32\x9e\x3a\xb0\x6f\x57\x68\xc7\xf3\x08\x01\x5a\xd9\x95\x43\x0a\x27\xec\xa2\xba\x67\x7b\xde\x23\xbb\xb0\xc6\x29\x26\x9e\x93\x04\x4e\x4f\x41\x70\x2b\xf0\x51\xe6\xac\xd4\x48\xb4\x08\xd6\x4c\xc1\xfd\x04\x87\x74\x1c\x36\x67\x9a\xcc\x2f\xe1\x15\x7a\x28\x2f\xa5\xb9\xa0\x4b\xea\x1e\xfe\x18\xb5\xa1\x74\x8c\x84\x1c\x4d\xba\x02\x06\xba\xc6\x94\xe7\x3c\xa5\x5b\x12\x37\x1b\x60\x22\x03\x6e\xe0\x96\x69\x10\xd2\xb8\xdb\xae\xbf\xd9\x66\xcc\x30\xba\x93\x76\x6c\x9b\xfa\x19\xb8\x56\xb2\x6b\x2c\xbb\xaa\x3f\x8f\x4a\x13\xcb\x8f\x10\x29\x1a\x0e\xa5\x77\x10\xc1\x6b\xc0\xd8\x39\x7f\x0d\xd1\x10\x7e\xd4\xd7\xdc\xdb\x7d\x56\xb1\x07\x38\xa6\xc5\xf6\x46\x5f\x56\xe5\x49\xca\x4f\xd7\xf8\x13\xd3\x37\x7d\x36\x15\xd3\x37\xfa\x81\xf8\xc6\x0b\xc7\x11\xf6\xcd\xc1\xf3\x9d\x1c\x16\xe3\x38\x05\x2f\x6d\x94\x43\x3c\x03\xc9\xae\xb8\x28\x9a\x92\xa9\xc3\x78\xd6\x2d\x1e\xf3\xac\x92\x0a\x09\x65\x3a\x41\xd0\x52\xee\x09\xba\x4d\x3d\xfe\x62\xc6\x4d\x8c\xbf\x84\x74\x3e\xd5\x09\xef\xbc\xf5\x67\x53\x6f\x00\x70\x97\x7d\xde\xf4\x8b\x09\x38\x41\xe0\xa0\x39\xf3\x51\xb2\x0c\x1f\x1f\x34\x05\x1a\x9b\x41\x46\xa5\x66\xc3\x64\x29\xed\x56\xa0\x3b\xf6\x0a\xe1\x07\xbd\x05\x0e\x85\x1e\xdb\x1d\xca\x6c\x0f\xab\x17\x56\x79\x64\xf9\xe7\x6a\x6c\x9d\x53\x89\xed\xc3\x34\x8b\x49\xa5\x9d\x87\x67\xd7\xb9\xc3\xe5\x5e\x95\x9d\xd9\x17\xd7\x78\x94\xff\xd3\x15\x3e\xa7\xbb\xab\x62\x5c\x98\x47\x4b\x9c\x2a\x64\x06\x93\xa6\xce\xe8\xa6\x43\xbd\x2c\x95\x6b\x6e\xdb\xec\xee\x5d\x2b\x23\x83\x63\x9d\xfd\x30\x82\x5c\x41\xda\x7b\xd1\xf6\x36\x83\xd9\xe4\x55\x67\x09\x6b\x2e\x4b\x77\xe5\x94\xb9\x83\x5f\x2a\xb2\xe6\x2e\x40\x8d\xe0\x3f\x1a\x14\xa8\xfd\x2d\x68\x37\xea\x81\x40\x95\x2e\x3c\x7f\x02\x7b\xdd\x78\xfe\x75\x67\xc7\xc9\xa1\x5c\x1a\x72\xed\x52\xf5\xf4\xaa\x74\xf1\xd2\x9b\xd0\xbd\x90\x1e\xb9\x09\x91\xa2\x27\xed\x43\x65\xfe\x19\xea\xee\x24\xd6\x28\xec\xc9\xbb\x63\xfe\x65\x14\xde\x31\xf6\x04\x87\xdb\x36\x39\x02\xbc\xab\x99\x3f\x15\x81\x18\x62\xe9\x08\x45\x29\xaf\x59\x09\x2b\x2c\x6b\x54\x3a\x06\xfb\x4d\xee\xf1\x0f\x0d\xce\xc9\xfd\xef\x41\x0f\xbe\xcb\xed\x79\x05\x98\x75\x7b\x0e\xfc\xb4\x61\x83\xfc\xf5\x2e\xbb\xc7\xff\x03\x00\x00\xff\xff\x73\x84\x25\xfd\x46\x15\x00\x00")
+var _templateBaseTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\x4b\x6f\xdc\x38\x12\x3e\x4b\xbf\xa2\x20\xf4\xce\xb6\x32\x6d\x29\xf1\x6d\x33\xf0\x02\xde\x20\xde\x35\x30\xe3\xcc\xc2\xb3\x33\x87\x41\xb0\xa0\xa5\x92\x9a\xb0\x9a\x54\x93\x94\xed\x86\xd0\xff\x7d\x50\x7c\xb4\x1e\x7e\xc6\x4e\x0e\x71\x8b\x45\x7e\xf5\xfa\xaa\x54\x62\xdf\xe7\xef\xe2\x4f\xb2\xdd\x29\x5e\xaf\x0d\x1c\xbf\xff\xf0\x8f\xa3\x56\xa1\x46\x61\xe0\x8c\x15\x78\x25\xe5\x35\x9c\x8b\x22\x83\xd3\xa6\x01\xbb\x49\x03\xc9\xd5\x0d\x96\x59\xfc\xdb\x9a\x6b\xd0\xb2\x53\x05\x42\x21\x4b\x04\xae\xa1\xe1\x05\x0a\x8d\x25\x74\xa2\x44\x05\x66\x8d\x70\xda\xb2\x62\x8d\x70\x9c\xbd\x0f\x52\xa8\x64\x27\xca\x98\x0b\x2b\xff\xf9\xfc\xd3\xe7\x8b\xcb\xcf\x50\xf1\x06\xc1\xaf\x29\x29\x0d\x94\x5c\x61\x61\xa4\xda\x81\xac\xc0\x8c\x94\x19\x85\x98\xc5\xef\xf2\xfd\x3e\x8e\xfb\x1e\x4a\xac\xb8\x40\x48\xae\x98\xc6\x04\xfc\xe2\xa2\xbd\xae\xe1\xe3\x09\xd0\x22\x2c\xb2\x4f\x52\x54\xbc\xce\x7e\x65\xc5\x35\xab\x91\x36\xf5\x3d\x18\xdc\xb4\x0d\x33\x08\xc9\x1a\x59\x89\x2a\x81\x45\x38\x3e\x88\xf8\xa6\x95\xca\x04\x51\x9e\x03\x45\x87\x35\x9c\x69\xd4\x60\x24\xb0\x1b\xc9\x4b\x70\xbb\xa0\x90\xa2\x6a\x78\x61\x34\x39\xd2\x69\x54\x7f\xd7\x36\x34\x59\x6c\x76\x2d\xc2\x32\x8e\xbe\xb4\x70\xf8\x77\x42\x58\xd9\x97\x36\x8e\xfe\x43\x91\x9e\xac\xd2\x4a\x1c\xfd\xce\x9a\x0e\x27\xeb\x76\x25\x8e\xfe\xdb\xa1\xda\x4d\x04\x76\x25\x8e\x7e\x95\x0d\x2f\xbc\xc4\xe1\xbb\x15\x77\x84\xa3\x9a\x1d\xe1\xa8\x0e\xa2\xb3\x4e\x14\x53\x11\xad\xc4\xd1\xb9\x30\xa8\x0a\x6c\x8d\x54\x5e\x3c\x5a\x89\xa3\x5f\x3a\xc3\x8c\x1c\x03\xfb\x15\x2f\xe2\x52\xcc\x44\x5c\x0a\x2f\x43\xd2\x30\x91\xd9\x95\x38\x75\x49\x34\x9b\xb6\xa1\x2c\xb6\x8a\x0b\x53\x41\x52\x72\xd6\x60\x61\xf2\xbf\xe9\x5c\xaa\x12\x55\xae\x79\x2d\x98\xe9\x14\x26\xb0\xc8\x2e\x8d\x54\x43\x76\xef\x0e\x39\x74\x30\x59\xc8\xad\x62\xa2\x46\x58\x54\x2b\x58\x58\x10\x52\xe0\x7e\xec\xf7\x71\x44\x6a\x2b\x38\x81\x96\xe9\x82\x35\xf4\x9b\x56\xf3\x1c\x9c\x60\xbf\x07\xd6\xb6\x0d\xa7\xe4\xaf\x11\x6a\x7e\x83\x02\x2a\x8e\x4d\x69\x73\xde\xf7\xd0\xb5\x2d\x2a\xbf\xd5\xc2\x66\x71\x54\x91\x97\x01\x60\xe9\xb7\x67\x59\xa6\x8d\xe2\xa2\x4e\xe1\x0b\xed\x23\xc7\xa1\x8f\xa3\xa8\xef\x8f\xe0\x96\x9b\x35\xe0\x9d\x41\x51\xc2\x92\x8b\x12\xef\x60\x91\x5d\xc8\x12\x35\xbc\x4f\x21\xa1\xbd\x09\xc1\x25\xf6\x68\x12\x5c\x39\x22\x63\xa3\xe8\x45\xb1\x23\xa3\x66\x61\x8b\xa2\x48\xa1\xe9\x94\x80\xc7\x02\x68\xb1\xc9\x28\xab\xc9\x96\x11\x3d\xf9\xd0\xba\x8d\x0f\xea\xac\x95\xec\xda\x47\xf3\x95\xe7\x70\x5a\xd7\x0a\xeb\x40\x89\x10\x64\x26\x80\x79\x01\xf1\x48\x1b\x6c\x41\xba\x26\x61\x11\x8f\xae\x76\x60\x14\xbb\x41\xa5\x59\x93\x6b\x24\x7a\x48\x95\x3d\x4e\x00\xab\x4a\x53\xaf\x62\xd0\x6a\xec\x4a\x39\x51\x40\x41\x71\x3f\xa4\x02\x85\x82\x6d\xb8\xa8\x81\x09\x69\xd6\xa8\xc0\xfd\x1f\xf6\x68\x97\xa5\xa2\xd3\x46\x6e\x40\xb0\x0d\xea\x0c\xce\xa4\x02\xbc\x63\x9b\xb6\xc1\x8f\x71\x9e\xc7\x79\x1e\xfd\x9b\x0c\xfd\xd7\xce\xe5\xfd\xc3\xca\xd1\xe5\x38\xcd\x48\x76\xf0\x7a\x19\x9a\xd6\x7e\x9f\x9d\xea\xf1\xd3\x65\xb7\xf1\x47\xd3\x15\x24\xba\xdb\xfc\xdf\x3d\x25\xe9\x0a\x5e\x70\xea\x78\x72\xea\x38\x49\x9d\xe2\xcb\x82\x89\x65\x61\xee\x56\xf0\xc3\x4d\x4a\x86\x92\x57\x70\xaa\x97\x95\x98\xa6\x62\x65\x13\x1c\x98\x3a\x11\x11\x5b\x89\xac\xcf\xa6\x9d\xe9\x39\xd1\x9e\xa1\xd9\xa4\x52\x29\xb2\x2b\x58\x50\xb0\xcf\xc8\x73\x62\x75\xc8\x19\x0e\x45\x2b\xe0\xe3\x50\xb6\x74\xe6\x20\x7a\xa2\x14\x2c\x89\xf2\x42\x0a\x6d\xe6\x26\xf6\x3d\xf0\x0a\xd6\x4c\xff\x36\x35\x30\x54\xc1\x33\x25\x7a\xc1\x36\xd4\x95\xac\x21\x87\x7a\x15\xa3\x0a\x7d\xba\xbe\xbc\x05\xa1\xb8\x0e\x1d\x48\xcc\x5b\x50\xdf\xc3\xb6\x93\xc6\xc7\xc9\x4a\x1f\xe2\xb3\xb4\x35\xcd\xab\x71\x1c\xf7\xfb\x59\x0f\xa3\x37\xed\x41\x29\xb2\x62\x0d\x36\x3e\x93\x0e\x46\x06\x2c\x1f\x80\x72\x00\x8e\x27\x07\x8c\x07\x08\xf3\x2d\xed\x4d\x40\xf2\x47\x50\x91\x8c\xd5\xbd\xac\xcf\x59\xe3\xbf\x7b\x9f\xcb\x73\xf8\x9d\x35\xbc\xb4\x01\xfe\xac\x94\x6d\x14\x04\xa6\xe1\x76\x8d\x02\x6e\xbc\x90\xfa\x86\x0f\x6b\xc5\x78\xa3\xfd\x10\x30\x3f\xab\x8d\xea\x0a\x43\xa5\x44\x8c\xf1\x01\x84\x3c\x07\xe7\x29\xb5\x93\xb2\x46\xdb\x5e\xb2\x38\x42\xa5\x00\x49\x67\xec\x2c\x71\x18\x9c\xda\xcd\x06\x85\x71\x94\xb0\x1b\x80\xd3\x4b\xba\x62\x05\x66\x31\x85\x00\x96\x08\xef\x66\xca\x53\xb0\x7f\x96\x69\x50\xdb\x1f\x2a\x13\x33\x54\x2a\xf3\x62\xaf\xec\x7f\xe2\x56\xb1\xf6\x41\x6d\x3a\xfb\x43\x31\xfb\xe6\x7b\x91\x5a\x87\xb4\x4c\xbd\xa9\x33\xb5\x5e\xdd\xb9\x7e\x2c\xce\x0c\xae\xa4\x6c\x90\x09\xe0\xa2\xe4\x85\x0b\xf6\xed\x1a\x6d\x7b\x1e\x45\x80\x76\xfa\x74\x48\xe1\x74\x79\xab\xee\x61\x2f\x0f\x91\x4d\x2d\x38\x25\x84\x57\x74\x06\x4e\x4e\x40\x70\xbb\x10\xac\xac\x58\xa3\x31\x8e\xf6\x71\x74\xc3\x14\xdc\x77\x70\x70\x87\x9e\x34\x35\x74\x54\x6a\x05\x3f\x60\xea\x7d\xbb\x90\xe6\x8c\xa6\xe0\x07\xf8\x63\xd4\x8e\xdc\x31\x12\x2a\x34\xc5\x1a\x18\xe8\x16\x0b\x5e\xf1\x82\x66\x2f\x6e\x76\xc0\x44\x09\xdc\xc0\x2d\xd3\x20\xa4\x71\xe3\x74\x18\x9d\x4b\x66\x18\x0d\xbd\x9e\x6d\x53\x3d\x03\xd7\x1a\x76\x85\x8d\xcf\xfa\xeb\xa8\x34\x41\x7e\x82\x48\xc9\xf0\x52\xfa\x08\x09\xfc\x08\x98\x39\xe5\x3f\x42\x32\x98\x9f\x78\x23\xce\x75\xc0\x7d\x55\xb2\x87\x70\x4c\x93\x1d\x40\xdf\x96\xe5\x80\xf2\xc2\x1c\xff\xc2\xf4\x75\x38\x02\x1b\xa6\xaf\xf5\x23\xf6\x8d\x37\x8e\x2d\x3c\x14\x07\xaf\x66\x3e\xa4\x63\x3b\x05\x6f\xac\x95\x83\x3d\xde\x80\x0b\x69\x2e\xb9\xa8\xbb\x86\xa9\x97\xf1\xcc\x6f\x1e\xf3\x6c\x23\x15\x52\x53\xa1\x37\x08\x5a\xca\x3d\x43\xb7\xa9\xc6\xef\xcc\xb8\x09\xf8\x5b\x48\x17\x5c\x9d\xf0\x2e\xa0\xbf\x9a\x7a\x43\x00\xe7\xec\x0b\xd0\x6f\x26\x60\x00\x7a\x79\x9f\xf9\x59\xb2\x12\x9f\x6e\x34\x35\x1a\xeb\x41\x49\xa9\x66\x43\x67\x69\xec\x51\xa0\x19\x7b\x8d\xb0\xa5\x4f\xcc\x21\xd1\x63\xdc\x21\xcd\xf6\x65\xf5\xc6\x2c\x8f\x90\xbf\x2d\xc7\x56\x39\xa5\xd8\xfe\x98\x7a\x31\xc9\xb4\xd3\xf0\xea\x3c\xfb\xb8\xdc\xcb\xb2\x83\x7d\x73\x8e\x47\xfe\x3f\x9f\xe1\x4f\x34\xbb\x2a\xc6\x85\x79\x32\xc5\x85\x42\x66\x30\xef\xda\x92\x26\x1d\xaa\x65\xa9\x5c\x71\xdb\x62\xa7\x69\x92\x89\x92\x00\xc7\x32\x7b\xf3\x82\x5c\x41\x71\xd0\xa2\xed\x34\x83\xe5\xe4\x53\x67\x05\x37\x5c\x36\xf6\x05\x48\x33\xa4\x0d\xbf\x54\x84\xe6\x06\xa0\x4e\xf0\x6d\x87\x02\x75\x98\x82\xe6\x56\x0f\x04\xda\xe8\x3a\xf0\x27\xa2\x21\xe1\x0d\xe3\xce\x4c\xc9\x4b\xb9\x34\xf8\xea\x5d\x0d\xf4\xda\xe8\xfa\xad\x93\xd0\x3d\x93\x9e\x98\x84\x48\xe0\xf5\x9d\xeb\xc7\xd2\xfc\x2d\xd4\x9d\x39\xd6\xa9\x60\xd9\x3d\xf8\xb7\x51\x78\x06\xf6\x3c\x87\x69\xb0\x1f\xdd\x29\x69\x20\xd7\xc7\x37\x2c\x5b\x7f\x79\x45\x1b\xed\x32\x1f\xed\x26\x84\x62\xcd\xb8\xb0\x83\x11\xde\x61\xd1\x19\xd4\x34\x21\x49\x31\xc3\xd8\x79\x87\xe7\x0a\xe9\x4b\x98\x38\x6e\xf0\xce\xd0\x65\x21\xfd\x5d\xc1\x16\xe8\x66\x6c\xb7\x82\xad\x02\x7f\x23\xb6\x72\x59\xd5\xf0\xe7\xd7\xd1\xf9\x14\x96\xf6\x62\x6e\x15\x62\xd6\xc7\x51\x45\x51\xa7\xaf\xce\x06\xc5\xd2\x1d\x4a\xe1\x08\x3e\xfc\x04\x1c\xfe\x79\x02\xef\x7f\x02\x7e\x74\x44\x71\x8d\xb6\x74\xad\xe6\x76\xfc\xc9\xbf\x2e\xb7\x2a\x1d\xbf\xce\xb7\xca\xde\xc6\xed\xc8\xc4\x15\x6c\x6d\xc8\xe8\xe6\x16\xf0\xae\x65\x61\x90\x00\x6a\xca\xb6\x82\xa1\x6e\xe4\x15\x6b\x60\x8d\x4d\x8b\x4a\x67\x60\xef\x49\x0f\x1f\x4a\x0f\x7e\x27\x59\x88\xf9\x27\xfa\x53\x9f\xbf\x0f\x7c\x35\x2d\x60\x3f\xf9\x4a\x7a\x5a\xa3\x33\xf2\xfb\xab\x44\x51\xc2\x7e\x1f\xff\x35\x00\x52\xd6\x5a\x47\xda\x16\x00\x00")
 
 func templateBaseTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -147,12 +162,12 @@ func templateBaseTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/base.tmpl", size: 5446, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/base.tmpl", size: 5850, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateBuilderCreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x57\x51\x6f\xdb\x36\x10\x7e\x96\x7e\xc5\x55\x70\x06\x29\x48\xe4\xb4\x6f\x4b\xe1\x01\x6d\x92\xae\x01\xb6\x6e\x58\xd2\xa2\x40\x5b\x14\x8c\x74\xb2\x09\xcb\x94\x4a\x52\x6e\x02\x43\xff\x7d\xb8\x23\x25\x4b\x8e\x97\x26\xdd\x93\x69\x91\x77\xe4\x7d\xf7\xdd\x47\xde\x66\x33\x3d\x0c\xcf\xaa\xfa\x4e\xcb\xf9\xc2\xc2\x8b\x93\xe7\xbf\x1e\xd7\x1a\x0d\x2a\x0b\x6f\x44\x86\x37\x55\xb5\x84\x4b\x95\xa5\xf0\xaa\x2c\x81\x17\x19\xa0\x79\xbd\xc6\x3c\x0d\xaf\x17\xd2\x80\xa9\x1a\x9d\x21\x64\x55\x8e\x20\x0d\x94\x32\x43\x65\x30\x87\x46\xe5\xa8\xc1\x2e\x10\x5e\xd5\x22\x5b\x20\xbc\x48\x4f\xba\x59\x28\xaa\x46\xe5\xa1\x54\x3c\xff\xc7\xe5\xd9\xc5\xbb\xab\x0b\x28\x64\x89\xe0\xbf\xe9\xaa\xb2\x90\x4b\x8d\x99\xad\xf4\x1d\x54\x05\xd8\xc1\x66\x56\x23\xa6\xe1\xe1\xb4\x6d\xc3\x70\xb3\x81\x1c\x0b\xa9\x10\xa2\x4c\xa3\xb0\x18\x41\xdb\xd2\xd7\x49\xbd\x9c\xc3\xe9\x0c\x6e\x84\x41\x98\xa4\x67\x95\x2a\xe4\x3c\xfd\x5b\x64\x4b\x31\x47\xf0\xa6\x16\x57\x75\x29\x2c\x42\xb4\x40\x91\xa3\x8e\x60\x72\x7f\x4a\xae\xea\x4a\xdb\x6e\xca\xfd\x83\x38\x0c\x36\x9b\x63\xd0\x42\xcd\x11\x26\xb5\xb0\x0b\xda\x6c\x92\x5e\xc9\x9b\x52\xaa\xf9\x25\xaf\x32\x64\x11\x04\x11\x1f\x87\x96\xb4\x6d\xe4\xec\x50\xe5\x34\x97\xf0\x56\x93\x9b\x46\x96\x04\x17\x7b\x38\xe3\x30\xde\x89\x15\x76\x91\x68\xcc\x50\xae\xdd\x7c\x3f\xee\x8d\xfc\xa2\x55\x63\x85\x95\x95\xa2\x45\xb5\x96\xca\x0e\xec\xa2\xb4\x9b\x65\x74\xc2\xe9\x14\x86\xdb\xb6\x2d\xa5\x8e\x70\xef\xbe\x14\x95\x06\x86\x53\xaa\x39\x08\x5e\x9c\xfa\x13\x01\x2a\x2b\xed\x5d\x1a\xda\xbb\x1a\x77\xdd\x18\xab\x9b\xcc\xc2\x26\x0c\x32\xc6\x3b\x0c\xfa\x63\x1d\x6e\x36\x00\x93\xf4\x4f\xff\xbf\x8b\x2f\x58\x54\xd5\xd2\xc0\xa7\x2f\x6f\xab\x6a\x19\x3a\xe8\xbf\x4b\xbb\x00\xbc\xb5\x04\xd2\x04\xa2\xd7\xce\x7f\x34\x0a\x39\x18\xa5\xc8\xa0\xb5\xb4\x22\xf5\x68\x78\x78\x29\xd0\x2b\xb1\x46\x17\x0b\xba\x18\x47\xc1\x78\xbe\xe5\xc2\x0a\x22\x4a\x1a\x16\x8d\xca\x20\x1e\xa1\xde\xb6\x7c\xf8\xc1\xee\x09\x7b\x8d\x33\x7b\x0b\x59\xa5\x2c\xde\x5a\xe2\x17\xfd\x26\x10\x1f\x0e\x37\x38\x02\xd4\xba\xd2\x09\x41\xb2\x16\x9a\x68\x13\xa0\xd6\xee\x6b\x18\x04\x8a\xea\x66\x64\x11\x06\x89\xa3\x88\x2c\x60\x92\xbe\x15\xe6\x1c\x0b\xd1\x94\xd6\x51\x69\xe7\x60\x69\xee\x26\x4d\x9c\x8c\x78\x15\xc8\x02\x4a\x54\xbb\x71\xa4\x0c\x76\x02\xb3\x19\x9c\xd0\x89\x68\x19\x9d\x66\x06\xbb\x0b\xb3\x05\x66\xcb\x38\x79\xc9\xd3\xcf\x66\xa0\x64\xc9\x06\x81\x46\xdb\x68\x45\xff\x39\xb4\x30\x08\x5a\x1f\xc6\xd1\x7f\xb8\xe2\xe0\xae\x6c\xa5\x5d\xdd\x75\xc8\x25\x61\xd0\x02\x96\x06\xd9\x2f\x61\xb3\x6a\x2c\x30\x3d\x2a\x72\xc3\x23\x7c\xd3\xa8\x2c\xa6\x9c\xec\x03\xfb\x08\x56\xd0\xf1\x29\x81\xf8\x83\x28\x1b\x1c\x02\x1e\xf4\xec\x3b\x82\x6a\x49\x85\xb1\x4a\x7d\x7a\x76\x68\x98\xd0\x62\x59\xc0\xb3\x6a\xe9\x0c\x47\x71\x16\x2b\x9b\x5e\x90\xd7\x22\x8e\x1a\x85\xb7\x35\x66\x16\x73\xe8\xa9\xcd\x95\x70\x70\x1d\x1d\xc1\x8a\x1d\xb5\xde\xdb\x13\xa1\xdd\x83\xad\x73\x35\x2a\xef\xb6\x85\x59\xbf\x35\xcd\xfe\x1c\xf6\x5b\x6c\xd2\xbc\x52\x08\x33\xb0\xba\xc1\x61\x86\x3b\xb7\x94\x62\x32\x20\x4d\x90\x04\xe2\x03\xc4\x3a\x86\xe7\x2f\x41\xc2\x6f\x33\x38\x79\x09\xf2\xf8\xb8\xcf\xc2\x9e\xb3\xb1\xc9\x27\xf9\x25\x5e\x35\x36\xf1\x3c\x92\x05\x7c\x75\xb1\x9c\x72\x90\x2e\x4f\x7c\xe6\x23\xd8\x81\xe1\xb1\xe4\x6c\xc3\xfb\x21\x6d\xa5\xe1\x23\x64\xa2\x2c\x8d\x93\x09\xa1\x72\xa8\x85\x92\x99\xa1\xea\xe3\x4f\xce\xd4\x80\x50\x8e\x58\x4f\x52\x88\x8f\xfb\x25\x62\x54\xef\xac\x0c\x7d\xcc\xbb\x20\x0d\x32\xe6\x09\x35\x88\x97\x8f\x1a\xa3\xd6\xc9\x30\xca\xb5\x53\xd1\x63\x98\x14\x12\xcb\xdc\xb8\x5b\xe5\x8d\x1b\xb7\xed\x66\xe3\x94\xe5\xf2\x3c\x7d\x6f\x50\x9f\xf3\xe5\x99\xbb\x89\xce\x62\x06\xa2\xae\x59\x7d\xfd\x07\x5a\xee\x96\x78\x81\xe9\xbc\xec\xe8\xd3\x74\x0a\x9d\x22\x81\x41\xeb\x04\xd7\x7f\x81\x35\xd5\xa7\x71\x97\xf9\xf6\xaa\xb9\xc1\xa2\xd2\x08\x46\xac\x31\x0d\x83\x47\x82\xbb\x95\x3d\x06\x62\x70\x13\x17\x1c\x6e\xd1\x45\xeb\xca\x87\xc5\xb4\xd2\x30\x29\xd2\x91\x9a\x76\x84\x73\xe2\xb0\xc3\x2f\x2e\x9e\xa2\x57\x8a\xdf\x91\x8c\xa8\x6e\xb7\x22\x11\xac\x3b\xbb\xc1\xc3\xc2\xdb\xf9\x8d\x7c\x96\x3d\x5e\xfd\x67\xd2\x35\x76\xb7\x85\x94\x1d\xee\x9e\xe1\x0a\xad\x73\x77\xc5\x37\x2b\x67\x91\xec\xd6\x5c\xc2\x41\x1f\x5f\xef\x63\xf8\x67\xf7\x2a\x64\xe5\x01\xdd\x10\x9d\xcb\xd2\xfd\x35\x4c\xfa\xc6\xa0\x3e\xce\x3d\x15\xd6\xa2\x94\x39\xa9\xb0\x81\x4a\x0d\x73\xf5\x68\xee\x7b\x89\x73\x15\x43\x58\x3d\x98\x20\x9f\x1f\x3a\x47\xac\x2a\x4b\xd1\xfe\x55\x13\x00\xa2\x4c\x20\x56\x64\xe3\x8a\x85\x49\x4b\xa3\xc4\xe3\xf5\xff\xb2\xe7\xeb\xe5\x97\x0f\x2e\x5e\x59\x29\x16\xf9\x0d\xed\x70\x0a\x91\x73\xe0\xf3\x17\x71\x85\x9e\xba\x88\x4c\xfa\x0e\xbf\xc7\x51\xf7\xc8\x6c\xdb\x53\x58\x49\x63\xe8\xad\xa4\xf1\x5b\x23\x35\xe6\xc0\x01\xc2\xe7\xb1\x97\xcf\x51\x94\xb4\x9d\xac\xdf\x4b\x1b\x3f\x7b\x1c\x4b\x3f\x6c\x53\x30\x29\xd2\x4b\x73\xa1\x9a\xd5\x36\xe6\xf5\x53\x63\xee\x43\x66\x59\xb8\x11\x46\x66\x2e\x0b\xe9\x6b\x1a\x5f\xd3\xfd\x15\xad\xa3\x41\x51\x0c\xe4\xe8\x3e\xb5\xfb\xd3\x91\x7b\xce\x3d\x7b\xdc\x2b\xc9\x3f\x07\xf3\xf0\xc6\x1d\xc2\xdc\x53\x13\x0a\x21\x4b\x82\x99\x86\xfb\xa1\x3e\x85\x83\xef\xce\x9f\xc3\x3c\xd8\x8b\xfc\xee\xd8\xb3\x14\x9d\x6a\x5e\xe4\x73\x1c\xb3\x94\x09\x8a\x3d\x41\xc7\x12\x33\xc1\xf4\xbd\x92\xdf\x1a\x7c\xac\xbc\xe0\x4e\x5d\x5f\x9e\x8f\x28\xca\x87\xa3\x27\xd2\xd6\x5d\x77\xf9\xfe\xd8\x93\x89\x93\xc1\x4b\x6f\x4c\xb7\x47\x65\x05\x7f\x9a\xfc\x98\xcf\xd1\x27\x04\x7f\xc8\xfd\xe1\x78\x7b\x81\x3f\xb1\x15\x98\xd8\x55\x5d\xf6\xad\x4f\x01\x51\x2e\x45\x89\x99\x9d\x1e\x98\x69\xd7\x17\x0e\x5f\x42\x6c\x74\xdb\x37\x10\xce\x7c\xb7\x7b\x70\xb2\x56\x2e\x87\x7e\x0f\x8c\xeb\xcf\x5e\x37\xe5\x32\x82\xb8\x16\x26\x13\xa5\xbf\xcd\x93\x7b\x0d\xdb\xb8\x5f\x2b\x97\xe3\xee\x8b\xff\x3f\xd8\x7a\xad\x84\xba\xdb\xd3\x7d\x49\x34\xd4\xb9\x90\x87\x51\x1f\xe6\x1c\xde\x6f\xc2\xbc\x6f\x6a\xb3\x5c\x1b\x36\x40\x8f\xe3\x9c\x1e\xc2\xa5\xbb\x96\x8d\x87\x28\xd7\x7c\x6a\xd3\xd4\xae\x91\x65\xe7\xee\x5c\xd4\xcc\x4d\x7d\xa4\x3f\x84\xfd\xeb\x0d\x03\x35\xc2\xde\x5d\x86\x0b\x61\xae\xc7\xf8\xfb\xb4\x3c\x90\xf3\x72\x09\xd1\x3f\x1e\xd1\x68\x74\x09\xb9\xde\x68\x6f\x46\x83\xd1\xb3\xe5\xde\xe8\xdf\x00\x00\x00\xff\xff\xe4\x1a\xd4\x62\x06\x11\x00\x00")
+var _templateBuilderCreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x51\x6f\xdc\xb8\x11\x7e\x96\x7e\xc5\x9c\xa0\x14\x92\x61\x73\x73\xf7\x56\x07\x5b\x20\x89\x9d\xde\x02\x6d\x5a\xd4\xbe\xeb\x01\x97\xc3\x81\x96\x46\xbb\xc4\x6a\x49\x1d\x49\x6d\x6c\x08\xfa\xef\xc5\x90\x94\x56\x5a\xaf\x2f\x8e\x8b\xbc\xd8\x92\xc8\x19\xce\x7c\xf3\xcd\x47\x72\xbb\x6e\x71\x16\xbf\x57\xcd\x83\x16\xeb\x8d\x85\x1f\x5e\x7f\xff\xd7\x8b\x46\xa3\x41\x69\xe1\x03\x2f\xf0\x4e\xa9\x2d\xac\x64\xc1\xe0\x6d\x5d\x83\x9b\x64\x80\xc6\xf5\x1e\x4b\x16\xdf\x6e\x84\x01\xa3\x5a\x5d\x20\x14\xaa\x44\x10\x06\x6a\x51\xa0\x34\x58\x42\x2b\x4b\xd4\x60\x37\x08\x6f\x1b\x5e\x6c\x10\x7e\x60\xaf\x87\x51\xa8\x54\x2b\xcb\x58\x48\x37\xfe\x8f\xd5\xfb\xeb\x8f\x37\xd7\x50\x89\x1a\x21\x7c\xd3\x4a\x59\x28\x85\xc6\xc2\x2a\xfd\x00\xaa\x02\x3b\x59\xcc\x6a\x44\x16\x9f\x2d\xfa\x3e\x8e\xbb\x0e\x4a\xac\x84\x44\x48\x0a\x8d\xdc\x62\x02\x7d\x4f\x5f\xd3\x66\xbb\x86\xcb\x25\xdc\x71\x83\x90\xb2\xf7\x4a\x56\x62\xcd\xfe\xcd\x8b\x2d\x5f\x23\x04\x53\x8b\xbb\xa6\xe6\x16\x21\xd9\x20\x2f\x51\x27\x90\x3e\x1e\x12\xbb\x46\x69\x3b\x0c\xf9\x37\xc8\xe2\xa8\xeb\x2e\x40\x73\xb9\x46\x48\x1b\x6e\x37\xb4\x58\xca\x6e\xc4\x5d\x2d\xe4\x7a\xe5\x66\x19\x72\x16\x45\x89\x0b\x87\xa6\xf4\x7d\xe2\xed\x50\x96\x34\x96\xbb\x04\xd2\xbb\x56\xd4\x04\x97\xf3\xf0\xde\xa5\xf1\x91\xef\x70\xc8\x44\x63\x81\x62\xef\xc7\xc7\xe7\xd1\x28\x4c\xda\xb5\x96\x5b\xa1\x24\x4d\x6a\xb4\x90\x76\x62\x97\xb0\x61\xd4\xa1\x13\x2f\x16\x30\x5d\xb6\xef\xa9\x74\x54\x8b\xe1\x4b\xa5\x34\x38\x38\x85\x5c\x03\x77\x93\x59\x88\x08\x50\x5a\x61\x1f\x58\x6c\x1f\x1a\x3c\x76\x63\xac\x6e\x0b\x0b\x5d\x1c\x15\x0e\xef\x38\x1a\xc3\x3a\xeb\x3a\x80\x94\xfd\x33\xbc\x0f\xf9\x45\x1b\xa5\xb6\x06\x7e\xfd\xed\x47\xa5\xb6\x1e\x9b\xc5\x19\xbc\x2d\x4b\x41\x56\xbc\x86\x4a\x60\x5d\x1a\xb0\x0a\x78\x59\xd2\xbf\x49\x9c\x0c\x1c\x09\x9c\x55\x6a\x77\x4d\x3d\x26\x5f\x41\x52\x0a\x5e\x63\x61\x17\xaf\xcc\xc2\xa5\x82\x0b\xef\x2a\x81\x94\xdd\x58\xa5\x03\x0d\x9c\xb1\xa8\x60\xc3\xcd\xed\x50\x72\xef\x8b\x06\xdd\xe8\xfd\xc8\x05\x3f\xc0\x46\xbb\x50\x46\xcf\x98\xcf\xc2\x6e\x00\xef\x2d\x7d\x4c\x21\x79\xe7\x61\x49\xa6\x00\xc5\xd1\x8c\x59\x06\xad\xa5\x19\x2c\x54\x3a\xb8\xa3\xfa\xdc\xf0\x3d\xfa\x12\xa0\x2f\xcd\xac\x06\xa1\x4d\x4a\x6e\x39\xf1\x9b\xc5\x55\x2b\x0b\xc8\x66\x64\xe9\x7b\x38\x9b\x97\x27\x77\x5e\xb3\xc2\xde\x43\xa1\xa4\xc5\x7b\x4b\x6d\x41\xff\x73\xc8\xce\xa6\x0b\x9c\x03\x6a\xad\x74\x4e\x95\xdc\x73\x4d\x6c\x8f\x50\x6b\xff\x35\x8e\x22\x49\xed\x3e\xb3\x88\xa3\x7c\x84\x32\x65\x3f\x72\x73\x85\x15\x6f\x6b\x3b\xa0\x38\x0b\x8c\x95\x7e\xd0\x64\xf9\x0c\xc7\x48\x54\x50\xa3\x3c\xce\x83\x39\x8e\xe4\xb0\x5c\xc2\x6b\x8a\x88\xa6\x51\x34\x4b\x38\x9e\x58\x6c\xb0\xd8\x66\xf9\x1b\x0a\x14\xbe\x5b\x82\x14\xb5\x33\x88\x34\xda\x56\x4b\x7a\x77\xa9\xc5\x51\xd4\x87\x34\xce\x9f\x70\xd5\x75\x33\x9e\x0c\xc8\xe5\x71\xd4\x03\xd6\x06\x9d\x5f\xc2\x66\xd7\x5a\x70\xac\x56\xe4\xc6\x3d\xe1\x87\x56\x16\x19\xd5\xe4\x14\xd8\xe7\xb0\x83\xa1\x0d\x72\xc8\x7e\xe6\x75\x8b\x53\xc0\xa3\xb1\x69\xce\x41\x6d\x89\xd2\x3b\x16\xca\x73\xd4\x3d\x39\x4d\x16\x15\x7c\xa7\xb6\xde\x70\x96\x67\xb5\xb3\xec\x9a\x0a\x56\x65\x49\x2b\xf1\xbe\xc1\xc2\x62\x09\x63\x47\xba\x06\x7e\x75\x9b\x9c\xc3\xce\x39\xea\xe3\xe8\x25\xd0\x9e\xc0\xd6\x81\x1b\xcd\x54\xa9\xef\x61\x39\x2e\x1d\x47\x2f\xc5\xfe\x80\x0d\x2b\x95\x44\x58\x82\xd5\x2d\x4e\x2b\x3c\xb8\xa5\x12\x53\x5a\x24\x65\x82\x40\xfc\x13\x62\x5d\xc0\xf7\x6f\x40\xc0\xdf\x96\xf0\xfa\x0d\x88\x8b\x8b\xb1\x0a\x27\x62\x73\x26\xbf\x8a\xdf\xb2\x5d\x6b\xf3\xc0\x23\x51\xc1\xef\x3e\x17\x2a\x56\x6b\xbd\xca\xb9\x98\xcf\xe1\x08\x86\xe7\x92\xb3\x8f\x1f\xa7\x74\x90\x86\x5f\xa0\xe0\x75\x6d\x5c\x43\x03\x97\x25\x34\x5c\x8a\xc2\x80\xa8\xfc\x27\x6f\x6a\x80\x4b\xf2\xa8\xf4\x57\x29\xc4\x2f\xa7\x25\x62\xd6\xef\x04\xd1\x7e\xcc\xf9\x18\xa4\x49\xc5\x44\x75\x9c\xaf\x0b\x35\x43\xad\xf3\x69\x96\x7b\xaf\xa2\x17\x90\x06\xc5\x77\x9b\xe1\x07\xff\xdc\xf7\x83\xbe\xa6\x6c\x75\xe5\x5f\x45\x05\xec\x27\x83\xfa\xca\x6d\xff\x24\x9c\x5d\x37\x1a\x2f\x81\x37\x0d\xc9\xe9\xf0\x81\x24\x76\x54\xd8\x83\xd4\x76\xdd\x49\xc1\x5a\x2c\x60\x90\x28\x30\x68\xbd\x02\x87\x2f\xb0\xa7\x86\x35\xfe\x50\x72\xd8\x32\xef\xb0\x52\x1a\xc1\xf0\x3d\xb2\x38\x7a\x26\xda\xc3\x22\x99\x53\xda\xe9\x89\xa2\x22\xca\x0e\xc1\xf7\xa1\x9f\x9c\xba\x2a\x0d\x69\xc5\x66\xf2\x3a\x30\xd0\xab\xc5\x11\xe1\x18\xbd\x57\xe3\xc6\xfb\x77\x24\x23\xd2\xc8\x83\x6a\x44\xfb\xc1\x6e\x72\x40\x0a\x76\x61\xa1\x50\xf6\x80\xd7\xf8\x99\x84\xce\xb9\x3b\x40\xea\x1c\x1e\xc7\x70\x83\x96\x3e\x55\xec\xc6\x9d\x10\x5c\x59\xc9\x6e\x4f\x2d\x14\xa4\x67\xba\x11\xcc\x76\x85\xd9\xde\x48\x03\xa9\x28\x5d\x3c\x97\x4b\x48\x92\x93\xe4\x18\x66\x2c\x61\x20\xec\xc1\xc5\x62\x01\x4e\xcc\x40\xb7\xd2\x00\xaf\x6b\xff\x4a\xcd\x52\x42\x6b\x50\x5f\x94\x81\x52\x7b\x5e\x8b\x92\x5b\xa5\x0d\x28\x39\xad\xf6\xb3\xdb\x29\xa8\x26\xb5\x80\xd2\xd0\xcd\x0e\x8d\x8f\x4b\x1c\x2a\x4c\x71\x64\x52\x59\xc2\xeb\x5f\x0d\x41\xc8\xeb\x1c\x32\x49\x36\x3e\x9d\x90\x5e\x1e\xf0\xfe\xff\xaa\x1f\x1a\xf0\x2f\x3f\xfb\x6c\x85\x92\x6e\xd7\xe8\x68\xa5\x4b\x70\x87\xd7\xb0\x6c\xdf\x27\xae\xe5\x2f\xe9\x8f\xd2\x86\x7d\xc4\xcf\x59\x32\x1c\xb6\xfb\xfe\x12\x76\xc2\x18\x3a\x33\x6a\xfc\xa3\x15\x1a\x4b\x7f\x7c\x83\x4f\x73\x2f\x9f\x92\x24\xef\x87\x7d\xe2\x51\xd9\xdd\x39\xca\xb3\x3c\x84\x44\x05\x48\x2b\xb6\x32\xd7\xb2\xdd\x1d\x72\xde\x7f\x6d\xce\x63\xca\xb4\x66\x7a\xc7\x8d\x28\xc8\x3c\xad\xd8\x3b\x7a\xbe\xa5\x0d\x31\xd9\x27\x61\x85\x61\x2f\x7c\xb2\x35\xc6\xe8\x88\xc9\xb4\xa6\xf7\x78\x52\xe3\x5f\x06\xf3\x74\x0b\x9f\xc2\x3c\x12\x13\x2a\x2e\x6a\x82\x59\xe9\xa7\xa0\xbe\x84\x57\x9f\xbd\x3f\x8f\x79\x74\x12\xf9\xe3\xe7\xc0\x51\x74\xf8\xb0\xeb\x72\x8d\xe6\xbf\xc2\x6e\x56\x57\x87\x3a\x89\x0a\x1c\x49\x71\x24\x69\x00\x2e\x0c\xa6\xc8\x7e\x92\xe2\x8f\x16\x9f\x2b\x52\x78\xa4\x0e\xab\xab\x19\x51\xc9\xad\x3b\x79\x1d\xdc\x0d\x7b\xfa\x97\x3d\x99\x2c\x9f\x1c\x20\x67\xe9\x3e\xaf\x05\xf0\xc5\x2d\x80\xe5\x1a\xe1\xd3\xdc\xc9\x93\x1d\x30\x7d\x0e\x51\x49\x51\x7f\xe5\x0d\xe3\xcb\x77\xa1\xc7\x97\xa0\xd3\xb7\x9c\x99\xf0\xd2\x3a\xf5\x76\xea\xf7\x95\xf1\xb7\xd5\x77\x6d\xbd\x4d\x20\x6b\xb8\x29\x78\x1d\x0e\x09\x79\xb0\x3f\x08\xe4\xfc\xf6\x5a\x6f\x87\xbb\xce\xe8\xf9\x0b\x17\xd1\x1d\x97\x0f\x27\xee\xa2\x02\x0d\xfd\x6e\x40\x1e\x66\xb7\xd2\x7a\x7b\xfa\x4a\x1a\x7c\xd3\xa5\x93\xf4\xfa\x18\xbd\x6f\x77\x01\xfd\x9d\x42\xfc\xc6\xb7\xd0\xc5\x19\xac\xfc\xc1\xc4\x04\xef\xa5\x76\x88\x9b\xb6\xf1\x3f\x49\x50\x10\x01\x53\xba\x96\x2f\x42\x95\x9e\x17\xfd\x51\xd8\x5d\xf7\x74\xd0\x7f\xce\xd7\x7a\x0b\xc9\x7f\x02\x1b\x92\xd9\x26\x1a\x47\x4f\xb2\x31\x3a\xd0\xf1\xd4\xd3\xff\x06\x00\x7c\x09\xee\x51\xd0\x12\x00\x00")
 
 func templateBuilderCreateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -167,12 +182,12 @@ func templateBuilderCreateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/builder/create.tmpl", size: 4358, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/builder/create.tmpl", size: 4816, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateBuilderDeleteTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\xe1\x6e\xdb\x36\x17\xfd\x2d\x3e\xc5\xa9\xe0\x7e\x90\x0a\x87\x4e\xfb\xef\x4b\xe0\x01\x5d\x96\x60\x05\xba\x6c\x58\x8b\xad\x40\x51\x0c\x0a\x75\x65\x13\x96\x48\x8d\xa2\x12\x1b\x86\xde\x7d\x20\x29\xcb\xb2\xe3\x26\xc1\xb0\x20\x48\x24\x92\xf7\xdc\x7b\x0e\x0f\x79\xb5\xdd\xce\xde\xb0\x2b\x5d\x6f\x8c\x5c\x2c\x2d\xde\x9d\xbf\xfd\xff\x59\x6d\xa8\x21\x65\x71\x93\x09\xba\xd3\x7a\x85\x0f\x4a\x70\xbc\x2f\x4b\xf8\x45\x0d\xdc\xbc\xb9\xa7\x9c\xb3\xcf\x4b\xd9\xa0\xd1\xad\x11\x04\xa1\x73\x82\x6c\x50\x4a\x41\xaa\xa1\x1c\xad\xca\xc9\xc0\x2e\x09\xef\xeb\x4c\x2c\x09\xef\xf8\xf9\x6e\x16\x85\x6e\x55\xce\xa4\xf2\xf3\x1f\x3f\x5c\x5d\xdf\x7e\xba\x46\x21\x4b\x42\x3f\x66\xb4\xb6\xc8\xa5\x21\x61\xb5\xd9\x40\x17\xb0\xa3\x64\xd6\x10\x71\xf6\x66\xd6\x75\x8c\x6d\xb7\xc8\xa9\x90\x8a\x10\xe7\x54\x92\xa5\x18\x5d\xe7\x46\x27\xf5\x6a\x81\x8b\x39\xee\xb2\x86\x30\xe1\x57\x5a\x15\x72\xc1\x7f\xcb\xc4\x2a\x5b\x10\xfa\x50\x4b\x55\x5d\x66\x96\x10\x2f\x29\xcb\xc9\xc4\x98\x3c\x9e\x92\x55\xad\x8d\xdd\x4d\x85\x37\x24\x2c\x8a\x5d\x96\xc7\xc0\x33\x3f\xbc\x7f\x8f\x59\xea\x01\x27\x77\xad\x2c\x9d\x28\x17\x73\x4c\xf8\x4f\xbe\xd8\xdb\xac\xa2\x5d\xbd\x86\x04\xc9\xfb\x30\x3f\x3c\x0f\x41\xfd\xa2\xaa\xb5\x99\x95\x5a\xb9\x45\xb5\x91\xca\x8e\xe2\x62\xbe\x9b\xf5\x1a\xb0\xd9\x0c\xe3\xb4\x5d\xe7\x36\xc8\xa9\xbb\x1b\x29\xb4\x81\x17\x4d\xaa\x05\x32\xbf\x98\xf7\x15\x81\x94\x95\x76\xc3\x99\xdd\xd4\x74\x0c\xd3\x58\xd3\x0a\x8b\x2d\x8b\x84\x27\xcf\xa2\xa5\xd6\xab\x06\xfe\xe7\xeb\xb7\x9f\xb5\x5e\xb1\x68\xa8\x14\x78\xe3\x91\x7f\xe9\x07\x76\x9c\x43\x85\x7f\x2e\xc9\x10\xb2\x3c\x6f\x90\x41\xd1\x03\x6a\x43\xb9\x14\x4e\x78\xab\x7d\xb5\x47\xc9\xfb\x47\xce\x8a\x56\x09\x24\x07\xca\x75\x5d\xc8\xb5\x5f\x9e\x86\x04\x49\xdd\x80\x73\x3e\x60\xf3\x31\xd7\xf4\x38\xc8\x31\x3b\x10\xbb\xeb\xf6\xa1\x0d\xe6\xc8\xea\x9a\x54\x9e\x7c\x7f\xcd\x14\x75\xc3\x39\x4f\x59\x64\xc8\xb6\x46\xe1\xa8\xcc\x9e\xfc\xf5\x9a\x04\x68\x4d\xa2\x75\xb8\x8e\x6c\xd8\x0e\xad\xf0\x77\x4b\x66\x83\x4c\xe5\x08\x08\x0d\x96\xfa\x01\x55\xa6\x36\xb8\x27\x63\xa5\xa0\x06\x0f\x4e\xba\xe0\xfa\xfc\x94\x1e\xa7\xe4\x70\x29\x13\x61\xd7\x10\x5a\x59\x5a\x5b\xe7\x5f\xf7\x3f\x45\x22\x95\x9d\x82\x8c\xd1\x26\x75\x0a\xdc\x67\xc6\xb9\x3c\x22\x63\xc2\x28\x8b\xa2\xac\x28\x48\x58\xca\x21\x95\x65\x51\xca\x22\x59\xa0\x24\x75\xbc\x0b\xdc\xfb\x21\xc5\x7c\x8e\x73\x07\x35\xc4\x79\x7c\xcc\x8f\xe5\x08\xdb\xf1\xc9\x6a\x13\x8e\xcc\xae\xc8\x94\x45\x1d\xa8\x6c\xc8\x83\xb8\x82\xaa\xd6\xc2\x1b\x49\x3b\x18\xff\x44\x37\xad\x12\x89\x63\x7f\x8a\xd7\x14\x15\x76\xce\x4b\x91\xfc\x91\x95\x2d\x8d\x59\x46\x83\x51\xa7\xd0\x2b\x77\xac\x2a\x9e\x9c\x34\x6c\xea\x16\xcb\x02\xaf\xf4\x2a\x04\xee\xf6\x56\xc9\x72\x8a\xa2\xb2\xfc\xda\xa1\x16\x49\xdc\x2a\x5a\xd7\x41\xa7\xc1\x1e\xfe\x1c\xbd\xfe\x1c\x4f\x51\x79\xa0\xce\xfd\x39\x32\x10\xe6\xc3\x7a\x37\xfb\xef\x45\xdb\x93\xe2\xb9\x56\x84\x39\xac\x69\x89\xed\x4b\x3e\x80\x66\x51\xe4\xc9\xb9\xeb\x40\x3a\x05\x9e\xd8\xd1\x33\xbc\xbd\x84\xc4\x0f\x73\x9c\x5f\x42\x9e\x9d\x0d\x12\x9e\xa8\xcf\x87\x7c\x95\xdf\x92\xaa\xb5\x0e\xdf\x51\x96\x05\xfe\x0a\x7c\x2e\x3c\xd9\x20\x32\xb9\xba\xa7\x38\x92\x23\xbd\xf4\x0b\x5f\xcd\x9d\xc2\x21\x51\x5f\xfe\xf9\x50\x37\x73\xbf\x27\x49\xed\x4f\xd8\x97\xd0\x98\x56\xe4\xdf\xa6\xb8\x6b\x2d\xea\x4c\x49\xd1\x40\x16\xc8\x54\x70\x03\xb4\x10\xad\x69\x5e\x7c\xaf\x78\xe4\xd3\x27\xc9\x5d\xcb\x5b\x16\xa9\x81\xe8\xb1\x32\xa3\xad\x92\xc5\x31\x49\x5f\x5a\x42\xc6\xa4\x63\x72\x8a\x85\xb6\xf4\x20\xed\x12\xb4\xb6\xa4\x72\x4c\x10\xff\x18\x2a\x8a\x0f\x1a\x85\xf7\x95\xad\xea\x72\xe8\x12\x05\xe2\x5c\x66\x25\x09\x3b\x7b\xdd\xcc\x76\x8d\x72\xec\x1e\x1f\xb4\x1e\x9a\x5e\x08\xe7\x7d\xdb\x71\xc9\xfa\xb6\x38\xd1\x8a\x4e\x34\xb2\x5f\xd5\x41\x2f\xd3\x8a\x7e\x3f\xd9\xce\x46\xd1\xa3\x16\x75\x30\xfa\x4c\x97\x6a\xa4\x5a\x94\xf4\x4c\xb3\x3a\x04\xdc\xf7\xab\x67\x76\xf5\x85\xd7\xf2\xd8\x23\x63\xa6\x3b\xc0\x83\xec\x4f\x5d\xb9\xc1\x78\x8f\xac\x72\x88\xc9\x9f\x70\x4f\xf3\x20\xad\x58\xfa\x4e\xec\x3e\x73\xf6\x4e\xba\x60\xc3\x61\xf1\x27\xc5\x4f\x2b\x7f\x21\x8f\xa6\xfe\x77\xab\xed\x8d\xfb\x16\xf3\x37\xd7\x16\x47\x5f\x2e\xfc\x63\x76\x47\x65\xc7\xa2\x9c\x8a\xac\x2d\xed\x28\x52\xc9\xd2\xb9\xf3\x3f\x38\x64\x2f\x14\xf0\x3b\x47\xad\xdf\xd3\x17\x28\xf6\x25\x48\x16\x5c\xdc\x1b\xfa\x9f\x00\x00\x00\xff\xff\x7b\x98\x9f\x29\x01\x0b\x00\x00")
+var _templateBuilderDeleteTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x57\x6d\x6f\xdb\xbe\x11\x7f\x2d\x7e\x8a\xab\xe1\x16\x52\xe0\xd0\x69\xdf\x2d\x85\x07\x74\x59\x82\x04\xeb\xb2\x61\xc9\xd6\x02\x45\x31\x30\xd2\x29\x26\x2c\x91\xfa\x93\x54\x62\x43\xe0\x77\xff\xe3\xa8\x07\xcb\xaa\xd3\x06\xed\x8b\x56\x26\xef\x8e\xf7\xf8\xbb\x5f\x9b\x66\x79\xc2\x2e\x74\xb5\x33\xf2\x71\xed\xe0\xc3\xd9\xfb\xbf\x9c\x56\x06\x2d\x2a\x07\x57\x22\xc5\x07\xad\x37\x70\xa3\x52\x0e\x9f\x8a\x02\x82\x90\x05\xba\x37\x4f\x98\x71\x76\xbf\x96\x16\xac\xae\x4d\x8a\x90\xea\x0c\x41\x5a\x28\x64\x8a\xca\x62\x06\xb5\xca\xd0\x80\x5b\x23\x7c\xaa\x44\xba\x46\xf8\xc0\xcf\xfa\x5b\xc8\x75\xad\x32\x26\x55\xb8\xff\x7c\x73\x71\x79\x7b\x77\x09\xb9\x2c\x10\xba\x33\xa3\xb5\x83\x4c\x1a\x4c\x9d\x36\x3b\xd0\x39\xb8\xd1\x63\xce\x20\x72\x76\xb2\xf4\x9e\xb1\xa6\x81\x0c\x73\xa9\x10\x66\x19\x16\xe8\x70\x06\xde\xd3\xe9\xbc\xda\x3c\xc2\xf9\x0a\x1e\x84\x45\x98\xf3\x0b\xad\x72\xf9\xc8\xff\x2d\xd2\x8d\x78\x44\xe8\x54\x1d\x96\x55\x21\x1c\xc2\x6c\x8d\x22\x43\x33\x83\xf9\x8f\x57\xb2\xac\xb4\x71\xfd\x55\xfb\x0b\x62\x16\xcd\x9a\xe6\x98\xe1\x65\x38\xde\xff\x9e\xb1\x24\xb8\x39\x7f\xa8\x65\x41\x49\x39\x5f\xc1\x9c\xff\x3d\x38\x7b\x2b\x4a\xec\xfd\x35\x98\xa2\x7c\x6a\xef\x87\xef\x41\xa9\x13\x2a\x6b\x27\x9c\xd4\x8a\x84\x2a\x23\x95\x1b\xe9\xcd\x78\x7f\x3b\xe4\x00\xb7\x98\x8e\x44\xf9\x9d\xd3\x86\xbc\x9a\x5d\x6e\x31\x25\xa9\xa6\x01\x99\xc3\x9c\xdf\xe9\xdc\xb5\x2e\xb5\x87\xad\xe6\x0a\x66\xb8\x17\x44\x95\x91\x5d\xb6\x5c\xc2\x38\x1c\xef\xa9\xf0\x54\xc9\xfe\x24\xd7\x06\x42\x31\xa4\x7a\x04\x11\x84\x79\x17\x29\xa0\x72\xd2\xed\x38\x73\xbb\x0a\xa7\x66\xac\x33\x75\xea\xa0\x61\x51\x1a\x92\xca\xa2\xb5\xd6\x1b\x0b\xe1\xcf\xb7\xef\xd7\x5a\x6f\x58\x34\x64\x00\xe0\x84\xf4\xf9\x3f\xbb\x83\x3e\x97\xad\x87\x5f\xd6\x68\x10\x44\x96\x59\x10\xa0\xf0\x19\x2a\x83\x99\x4c\xa9\xd6\x4e\x87\x1e\x9b\x3c\xde\x7d\x72\x96\xd7\x2a\x85\xf8\xa0\x22\xde\xc3\xc9\xa1\x78\xd2\x3e\x10\x57\x16\x38\xe7\x83\x6d\x3e\x8e\x35\x99\x2a\x51\x64\x07\x45\xf4\x7e\xaf\x6a\x61\x05\xa2\xaa\x50\x65\xf1\xcb\x32\x0b\xa8\x2c\xe7\x3c\x61\x91\x41\x57\x1b\x05\x13\x37\xbb\xe0\xa9\xba\x40\x95\xab\xc9\x2e\x05\xdb\x96\x43\x2b\xf8\xa3\x46\xb3\x03\xa1\x32\x68\x2d\x58\x58\xeb\x67\x28\x85\xda\xc1\x13\x1a\x27\x53\xb4\xf0\x4c\xa9\x0b\x1a\x98\x1d\xcb\xc7\xb1\x74\xd0\x93\x71\xea\xb6\x90\x6a\xe5\x70\xeb\x68\x2e\xe8\xdf\x04\x62\xa9\xdc\x02\xd0\x18\x6d\x12\xca\xc0\x93\x30\x34\x3d\x11\x1a\xd3\x9e\xb2\x28\x12\x79\x8e\xa9\xc3\x0c\xa4\x72\x2c\x4a\x58\x24\x73\x28\x50\x4d\xab\xc0\x43\x3f\x24\xb0\x5a\xc1\x19\x34\x23\xbd\x60\x1f\x56\xd3\x74\xf0\xa1\x93\xbd\x27\xe7\x12\x16\x79\xc0\xc2\x62\x50\x26\x47\xca\xda\x41\x68\x20\x6d\x60\xd5\x7e\xe1\x55\xad\xd2\x98\xa2\x3e\x16\xcf\x02\x4a\xe8\x3b\x2e\x81\xf8\x7f\xa2\xa8\x71\x1c\x5d\x34\x34\xe8\x02\xf4\x86\x66\xaf\xe4\xf1\xd1\x46\x4d\x48\x58\xe6\xf0\x46\x6f\x5a\xc5\xbe\xa6\x4a\x16\x0b\xc8\x4b\xc7\x2f\x29\x3b\x79\x3c\xab\x15\x6e\xab\x10\x27\x0c\x6d\x11\xe6\xe7\xed\xfd\x6c\x01\x65\x30\xe4\xe9\xaf\x49\xe3\xc0\x6a\x90\x67\xd1\xef\x24\x6b\x1f\x0c\xcf\xb4\x42\x58\x81\x33\x35\xb2\xbd\xab\x07\x26\x59\x14\x79\xf2\x85\xc6\x5f\x52\xe4\x3f\xa9\xe0\x29\xbc\xff\x08\x12\xfe\xba\x82\xb3\x8f\x20\x4f\x4f\x87\xd4\x1d\xf1\x2b\xa8\x7c\x93\xdf\xe3\xb2\x76\x64\x9f\x42\x95\x39\xfc\x3f\x3c\x4a\xef\x94\xb5\x6b\x51\x00\xa9\x62\x0b\x98\xa4\x21\xf9\x18\x04\xdf\xac\x40\xc9\x02\x9a\x91\xfb\x67\x83\xdf\x2c\xf2\xec\x78\x50\xfb\x89\xfa\x4a\x38\x57\xc8\x0d\x86\x5f\x0b\x78\xa8\x1d\x54\x42\xc9\xd4\x12\x86\x0a\x45\xe2\xda\x80\x4e\xd3\xda\xd8\x57\xe3\x08\xd9\xfa\x7a\x7c\x72\x08\xb3\x1b\x16\xa9\x21\xd0\x69\x66\xfa\xa1\x6b\x07\x66\x12\x64\x70\x2d\x46\x63\x92\x71\x70\x8a\x20\xa2\x69\xe0\x59\xba\x35\xcc\x73\x4a\xdf\x04\xfd\x29\xdc\xd0\x06\x56\xe7\xee\xb4\x85\x81\x16\x44\x4a\xe1\xd2\x35\x66\x47\x00\x5d\xa2\x85\x87\x1d\x58\x74\x01\xf3\xdd\x1a\xa5\x81\xb0\x1f\xf3\x5e\x70\x06\xb9\xc4\x22\x5b\x90\xf9\x7e\x3f\xb4\xe0\x54\x42\x6e\x74\x49\x5f\x90\x09\x27\xc2\xc2\x96\xb4\xf1\xb1\x4f\x09\x3c\x0b\x0b\xa9\x41\x41\x23\x10\x3c\x2f\xe5\x56\x2a\x7e\x2d\x4c\xd6\xfa\xfd\xea\x74\xe3\xab\x71\x4a\xe6\xdd\x2b\x37\x76\xff\x0e\xa9\x86\xdb\x3e\x9f\xd3\x92\x34\xcd\x68\xcd\x7a\x3f\xaa\x90\x27\xf0\x3f\x85\xe5\x09\xdc\x0d\x89\x95\x5a\xd9\xc0\x6e\x76\x15\xe1\x2e\x45\xb6\x96\x36\x70\x1e\x61\x10\x0c\xa6\xda\x64\x98\x51\x6e\x29\x1d\xfd\x1d\x4d\x44\x50\x1b\x21\x3b\x87\x40\x88\xc2\x1b\x61\xa5\x5f\x77\xc2\x74\x18\x51\x7f\xad\x86\x98\xbf\x48\xb7\x0e\xc8\x45\xae\x2d\x7a\xb3\xfb\x2e\xf8\x07\xee\x1a\xff\xc3\x1c\xf1\xfb\x5d\x85\x71\x92\xb4\x71\x74\x6c\xe0\x85\x98\xc8\xfb\x6e\x01\x65\x3d\xa9\x73\x46\x28\x2b\xd2\x60\xad\x73\x3e\x04\x12\x87\xe9\xd9\x25\x7d\x04\x5d\x66\xe3\x77\xe3\x3e\xbb\x28\x24\x2a\xd7\xb4\xc4\xe0\x1c\x7a\xde\x72\x2d\xec\xfd\x96\x88\x41\x47\x5b\x46\xee\x12\x63\x21\xa8\xf7\x7e\x52\xa3\x81\xca\xf0\xd6\x9a\x4f\xf8\x7f\xab\x8c\xd0\x23\xe1\x2c\x8a\xda\xc5\xfe\xf2\x0e\xa6\xfd\x7b\x28\x37\x22\x7f\x61\xe3\xe4\xfc\x2e\x30\x99\x2b\x6a\x78\xf0\xfe\xc6\xde\xca\x22\x4e\x82\xf5\x3b\x74\xc7\x44\x62\x27\x4b\xe4\xb7\xfa\xb9\x17\x13\x4f\x01\xcc\x12\xe6\xd9\x88\x7a\xf5\x83\x8b\x5b\x47\xf9\x9f\xc3\xec\x6f\x6d\x6f\xcf\xc6\x5d\x4e\x05\x82\xb9\x2b\xab\x62\xe0\x7e\x39\xcc\x32\x29\x0a\x4c\xdd\xf2\xad\x5d\xf6\x4c\x79\xdc\xa9\x41\x69\x3b\xb0\xde\x56\x9d\x83\x9f\xbe\x3f\xd7\x0a\x8f\x30\xd9\x7f\x29\xec\x2a\xd5\x0b\xfd\xe7\x28\x9f\x1d\x69\x8f\xb8\xe4\xc1\xe9\x2f\xe8\xa4\x95\xea\xb1\xc0\x5f\xb0\xca\x43\x83\x7b\x62\xf9\x0b\x7c\x78\x25\x7f\x1a\xa3\xcd\x38\xd2\xde\xe0\xc1\xeb\x3f\xe3\x46\x01\x6d\x7e\xc4\xf8\x43\x9b\xfc\x27\xb0\x6f\x9f\xa5\x4b\xd7\x64\x21\x25\xd8\xdc\xaf\x80\xf3\x3d\x44\x85\x15\x17\xae\x55\x60\x4e\xa3\xab\x77\xb7\xda\x5d\xd1\x7f\xc6\x02\xd5\x68\x60\xda\xcd\x9f\xc5\x03\x16\x9e\x45\x19\xe6\xa2\x2e\xdc\x48\x53\xc9\x82\x45\xe3\x7c\xfd\xf6\x76\x7c\x65\x02\x5f\xd8\x91\x5d\x4d\x5f\x91\xb1\xaf\xfd\x3c\xb1\xa6\x01\x54\x19\x78\xcf\xfe\x1c\x00\x9e\xea\x63\x43\x02\x0f\x00\x00")
 
 func templateBuilderDeleteTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -187,12 +202,12 @@ func templateBuilderDeleteTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/builder/delete.tmpl", size: 2817, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/builder/delete.tmpl", size: 3842, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateBuilderEntqlTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8d\xc1\x4a\x03\x31\x14\x45\xd7\xe6\x2b\x2e\x43\xdd\x14\xcc\xd4\xee\x14\x5c\x94\x52\xa1\x20\x6e\xda\x1f\x88\xc9\x4b\x27\x98\x26\x31\x79\x23\x96\x30\xff\x2e\x53\x66\xd0\xee\x2e\xf7\xdc\xf3\x5e\xad\xed\x52\x6c\x63\xba\x64\x77\xea\x18\xeb\xd5\xe3\xd3\x43\xca\x54\x28\x30\x5e\x95\xa6\x8f\x18\x3f\xb1\x0f\x5a\x62\xe3\x3d\xae\xa3\x82\x91\xe7\x6f\x32\x52\x1c\x3b\x57\x50\x62\x9f\x35\x41\x47\x43\x70\x05\xde\x69\x0a\x85\x0c\xfa\x60\x28\x83\x3b\xc2\x26\x29\xdd\x11\xd6\x72\x35\x53\xd8\xd8\x07\x23\x5c\xb8\xf2\xb7\xfd\x76\xf7\x7e\xd8\xc1\x3a\x4f\x98\xba\x1c\x23\xc3\xb8\x4c\x9a\x63\xbe\x20\x5a\xf0\xbf\x67\x9c\x89\xa4\x58\xb6\xc3\x20\x44\xad\x30\x64\x5d\x20\x34\x14\xf8\xcb\x37\x18\x06\x71\x57\x2b\x16\x7c\x4e\x1e\xcf\x2f\x48\xd9\x05\xb6\x68\x8c\x53\x9e\x34\xb7\xf7\xa5\x9d\x96\x0b\x79\xe0\x98\xd5\x89\x66\xc7\x59\x74\xaa\x1c\xe9\x9c\xbc\x62\x9a\x4e\x8c\x6c\x84\x3f\x7c\xdb\xcb\xd9\xa2\x60\xc6\xf8\x97\x7e\x03\x00\x00\xff\xff\x9b\x37\x98\x23\x58\x01\x00\x00")
+var _templateBuilderEntqlTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\xcd\xc1\x4a\x03\x31\x18\x04\xe0\xb3\x79\x8a\xa1\xd4\x4b\xc1\xdd\xda\x9b\x82\x87\x52\x2a\x14\xc4\x4b\xfb\x02\x6b\x32\xdb\x0d\xa6\x49\x4c\xfe\x8a\x25\xe4\xdd\x65\x4b\x17\xf4\x16\xe6\x9b\xc9\x5f\x4a\xbb\x50\x9b\x10\x2f\xc9\x1e\x07\xc1\x6a\xf9\xf8\xf4\x10\x13\x33\xbd\xe0\xb5\xd3\xfc\x08\xe1\x13\x3b\xaf\x1b\xac\x9d\xc3\xb5\x94\x31\x7a\xfa\xa6\x69\xd4\x61\xb0\x19\x39\x9c\x93\x26\x74\x30\x84\xcd\x70\x56\xd3\x67\x1a\x9c\xbd\x61\x82\x0c\xc4\x3a\x76\x7a\x20\x56\xcd\x72\x52\xf4\xe1\xec\x8d\xb2\xfe\xea\x6f\xbb\xcd\xf6\x7d\xbf\x45\x6f\x1d\x71\xcb\x52\x08\x02\x63\x13\xb5\x84\x74\x41\xe8\x21\x7f\x8e\x49\x22\x1b\xb5\x68\x6b\x55\xaa\x14\x18\xf6\xd6\x13\x33\x7a\xf9\x72\x33\xd4\xaa\xee\x4a\xc1\x5c\x4e\xd1\xe1\xf9\x05\x31\x59\x2f\x3d\x66\xc6\x76\x8e\x5a\xda\xfb\xdc\xde\x9a\xf3\x66\x2f\x21\x75\x47\x4e\x1b\xdb\x63\xe8\xf2\x81\xa7\xe8\x3a\xe1\xed\x8b\xd1\x46\xfc\x91\xff\x79\x33\xad\xe8\xcd\xf8\x2c\x05\xf4\x06\xb5\xaa\xdf\x01\x00\x9b\x37\x98\x23\x58\x01\x00\x00")
 
 func templateBuilderEntqlTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -212,7 +227,7 @@ func templateBuilderEntqlTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateBuilderMutationTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5c\x59\x93\x1b\x37\x92\x7e\x66\xfd\x8a\x74\x85\xec\x61\xf5\x52\x45\x7b\xde\x56\x5e\x3e\x68\xd4\xf6\x0c\x23\x76\xa5\x8d\xe9\xf6\xbe\x74\x28\xc6\xa5\x02\xd8\xc4\x76\x1d\x74\x01\x64\x77\x07\xcd\xff\x3e\x91\x09\xa0\x0a\xa8\x8b\x87\x5a\xb6\xfc\xa0\x26\xeb\x48\xe4\xf1\xe5\x85\x04\xbd\xdf\xcf\xaf\x82\x77\xe5\xe6\xb9\x12\xf7\x6b\x05\x7f\xfd\xfe\x87\xff\x7c\xbd\xa9\xb8\xe4\x85\x82\x9f\x93\x94\x7f\x2a\xcb\x07\x58\x16\x69\x0c\x6f\xb3\x0c\xe8\x21\x09\x78\xbf\xda\x71\x16\x07\xb7\x6b\x21\x41\x96\xdb\x2a\xe5\x90\x96\x8c\x83\x90\x90\x89\x94\x17\x92\x33\xd8\x16\x8c\x57\xa0\xd6\x1c\xde\x6e\x92\x74\xcd\xe1\xaf\xf1\xf7\xf6\x2e\xac\xca\x6d\xc1\x02\x51\xd0\xfd\xff\x5e\xbe\xfb\xe9\xfd\xcd\x4f\xb0\x12\x19\x07\x73\xad\x2a\x4b\x05\x4c\x54\x3c\x55\x65\xf5\x0c\xe5\x0a\x94\xb3\x98\xaa\x38\x8f\x83\xab\xf9\xe1\x10\x04\xfb\x3d\x30\xbe\x12\x05\x87\x30\xdf\xaa\x44\x89\xb2\x08\xc1\xdc\x78\xb5\x79\xb8\x87\x37\x0b\xf8\x94\x48\x0e\xaf\xe2\x77\x65\xb1\x12\xf7\xf1\xff\x26\xe9\x43\x72\xcf\xf1\xa1\xfd\x1e\x14\xcf\x37\x59\xa2\x38\x84\x6b\x9e\x30\x5e\x85\xf0\x8a\x5e\x17\xf9\xa6\xac\x14\x4c\x83\x49\x98\x96\x85\xe2\x4f\x2a\x0c\x26\xe1\x2a\xa7\x3f\xf2\xb9\x48\xc3\x20\x98\xec\xf7\xaf\xa1\x4a\x8a\x7b\x0e\xaf\x0a\x5c\xe8\x55\xfc\xbe\x64\x5c\x22\x81\xc9\x24\x44\x0e\xba\x8b\xce\xf1\x72\xe1\x5c\x08\x35\x1d\x5e\x30\x5a\x78\x12\xf2\x42\xdd\x97\xb1\x28\xe7\xbc\x50\x61\x10\x05\x41\x5a\x16\x92\x58\x99\xcf\xe1\xc3\x86\x57\x24\x25\xa8\xe7\x0d\x97\x71\x30\xf9\xb0\x79\x57\x71\x94\x00\x00\x16\xc0\x0b\x15\xdb\x2b\x78\xef\x9a\x67\xdc\xbf\xa7\xaf\x34\xf7\x3e\x14\xbc\x75\xef\x43\x41\xb7\x7f\xd9\xb0\x16\x59\x7d\xa5\xb9\xe7\xbe\x5a\x5f\x09\x88\x4f\x54\x44\xcd\xe2\xa8\x9e\x6e\x9f\x37\x5c\xeb\xe4\x7d\x92\xa3\x42\x60\x01\xa1\x77\xc1\xd7\x50\x44\xb6\x1d\x20\x47\x66\xb7\x40\xa0\x7b\x45\xfc\x3f\xe6\xab\xa1\x16\xcc\xe7\xe0\x3d\x75\x38\x40\xc5\x0d\xee\x25\x24\x05\x94\x8d\x8e\xd7\x89\x02\x7a\x90\x4b\x02\xa6\xcf\x68\x41\xcb\x1a\xcc\xde\x57\xc9\x66\x1d\x07\x28\x73\x87\xbe\x54\xd5\x36\x55\xb0\x0f\x26\x29\xe1\x21\x98\x94\x1b\xf8\xb0\x09\x26\xea\x79\x83\x37\x45\x71\x8f\x32\x22\xed\xe5\x75\xfc\xb7\xad\xc8\x18\xaf\x7e\x16\x3c\x43\x89\xe1\xaa\xbe\x83\xba\x22\xad\x39\x1a\x5d\xb5\xc4\xa4\xd7\x8c\x6e\xf1\xc5\x55\x3f\xbd\x55\x43\x8c\xa8\x89\x15\x24\x05\xb3\xd7\xe3\xf7\xdb\x9c\x57\x22\xc5\xef\xef\xca\x62\xc7\x2b\xc5\xd9\x6d\xf9\xb7\x44\x8a\x54\xbf\x33\x49\x18\x3b\x83\xbc\x31\x9e\xf7\x39\xcd\x78\x52\x71\x66\x18\xce\x93\xcd\x9d\x56\xc5\x47\xad\xae\xbd\x2f\x27\x37\x72\xfe\xc4\xee\xb9\xf4\xf8\x7e\xc5\xe3\x5f\x0a\xf1\xdb\xd6\x2c\x47\x52\xf3\x7e\xb6\xb8\x96\xce\x55\xa5\x66\x29\x93\xe3\x6f\x23\x77\xbd\x04\x1c\x66\x27\x93\x8a\xe7\xe5\x8e\xb3\xcf\x20\xe1\xea\xc7\x2a\xa8\x9f\xdc\xa7\xb2\xcc\x7c\x7d\xb2\xb2\xe0\xe6\x72\x99\xb1\xff\x4b\xb2\x2d\x87\xd5\xb6\x48\xa7\x26\x82\xa1\x25\xf1\x6f\x04\xd3\x2b\x0f\xc8\x33\xe0\x55\x55\x56\x51\x30\xd9\x54\x9c\x89\x94\xe0\x7e\xf7\xb1\xfe\x12\x7b\x4f\x07\x87\x20\xd8\x25\x15\xfc\x8b\x3c\xdf\xc2\x0e\x16\x86\xaa\x83\xfb\x68\x5a\x88\x2c\xf2\x9d\xf2\xc3\xc6\xba\xe6\xa6\x12\x85\x82\x69\x9a\xe4\x3c\xb3\xe4\x23\x08\xf5\x03\x61\x8f\xa7\x9a\x57\x0f\x07\x48\xb2\xac\x7c\x44\xc8\x14\xc9\x3d\xcf\x31\x5f\x51\x8a\xe0\x50\x2f\xae\xfd\x6c\x6b\xfc\x78\x2b\x45\x71\x4f\xba\xc0\xaf\x49\x06\x25\x91\x92\x3d\xee\xda\x2c\x42\xaa\xeb\x88\x14\x20\x57\x05\x7f\x6c\xbb\x78\x4a\x21\x57\xe2\xad\x86\x8b\x55\x59\xf5\x84\x0d\x5e\x28\xa1\x9e\xe3\x00\x17\xe8\x21\x35\x4d\x0d\xf7\x33\xa0\x28\x81\x7f\x94\x84\x38\x8e\x7b\xf9\x8c\xa0\xcd\x23\xc6\x99\x1c\x35\xfc\x5d\xeb\xc6\x1e\x31\x45\xa4\xdf\x80\xf9\x2f\x9d\x05\x93\x49\xb9\xa9\xbf\xe3\x7f\xe5\x06\x2f\xaa\x67\xef\x6a\x27\x4c\xcf\x1a\x80\x6a\x0f\x7e\x03\x79\xf2\xc0\xa7\x3d\x7e\x1c\xcd\x82\xc9\x21\x98\xa0\x36\xfe\x45\xd2\x20\x73\xda\xad\x49\xb4\x3d\xf1\xa0\xa6\x79\x44\xcf\x55\x5c\x6d\xab\x02\xf2\xc0\xc4\x73\xf3\x82\xc6\x4b\xf8\x28\xd4\x3a\xac\xf9\x08\x97\xd7\x2e\x54\xf0\x51\x8c\xb7\x5c\xe9\x78\xbd\xbc\x86\x15\x39\x4c\x0b\x1f\x46\xf9\xcd\x2b\x53\xc1\xa0\x1d\x66\xa3\x01\x60\xec\x6b\x16\x09\x22\x79\xc7\x00\x11\x49\x84\x3e\x32\xc5\xa8\xc0\xab\x4a\x3b\x18\x7e\x29\x8b\x94\x03\x16\x10\xf1\x87\x22\xe5\x78\x65\x47\x8e\xea\x7b\x64\x30\x99\x44\xc1\x64\x92\xc7\xb5\x23\x2f\x8c\x2b\xab\x27\x38\xd5\x9d\x89\x0b\x5a\x30\xbe\x2e\xa7\xf4\xba\xb9\x36\x11\x2b\xc8\x63\x8a\x17\xfa\x3b\xf1\xb8\x80\x55\xae\xe2\x9f\xf0\xdd\xd5\x34\xfc\x6d\xcb\xab\x67\x74\x9b\x32\x63\x40\x3c\x4a\xd8\x94\x52\x35\xe8\x16\x12\x8a\x52\x69\x67\xe4\x2c\x8c\x88\xd2\x41\xc7\x52\x43\x96\xde\x23\x7e\x60\x01\x79\xfc\x2e\x13\xbc\x50\xd3\xc8\x0f\x28\xf1\xdf\xb9\x42\xc1\x66\x20\x98\x21\x82\xff\x1e\x22\x1d\x52\x49\xd3\x0d\xa1\x40\xdf\xce\xe3\xc1\x7c\xb9\x80\xef\x04\x43\x24\x39\xf8\x19\x80\xcf\x30\x72\x50\x6a\xdf\x6d\x8f\x42\x08\xcb\x81\x96\x1d\x3f\x13\x42\x3d\xf6\x3f\xcb\xf6\x66\x0d\x64\x6c\x06\x85\xc8\x4e\xd2\x1d\x3e\x1d\x2f\xaf\x8d\x02\xe7\x73\xd0\x56\x03\x4d\x4c\x42\x42\x31\xee\x57\x0c\xfe\xfa\xce\xaf\xb0\xaa\xca\xdc\x57\x0e\x2c\x5b\x01\xf9\x31\x91\x48\x8b\x3f\xf1\x74\xab\x38\xc3\xaa\x29\x01\x55\x25\x85\x4c\x28\x28\xc3\x14\x09\xde\x3e\x45\x33\xff\x7a\x92\x41\xaa\xd7\x17\xd2\xb0\x80\xcd\x07\xe9\x7e\x9a\xb7\x2b\xad\x08\x2c\xc4\xe0\xca\xb0\x8d\x45\x97\xfe\x84\x11\x51\x5f\xdc\xdb\x28\x98\xc7\xfa\xd3\xc1\x3e\x14\x8b\x42\xa8\x69\x54\x9b\x47\x5f\x35\x8a\xb8\x7d\x6a\x94\x50\x68\x0d\xdc\x3e\xfd\x4a\x51\xde\xf2\x20\x75\xcd\xf8\xc8\x2b\xee\xc9\xea\x48\x24\x7f\x44\x5a\x42\xb9\xb4\xc8\x68\x50\xaa\x35\xaf\x1e\x85\xe4\x23\xf2\xdd\x3e\x4d\xd1\xe8\xb7\x4f\xae\xa5\xc5\x0a\x26\x18\x59\x1f\x50\xc6\x3c\x66\x95\xd8\xf1\x2a\x9e\x5e\xa9\xa7\x6b\xfa\x18\xfd\x08\xdf\x94\x0f\x84\x09\x0b\x09\x91\xcd\x3c\x77\xb7\xfd\xd2\xe1\xf0\xa6\xe3\xe1\xd5\xb6\x28\x30\x12\xb4\x6d\x16\xea\x78\xad\x9e\x48\xb5\xb7\x4f\x7d\x6a\x55\x4f\x6d\x95\xa2\xa3\x23\x16\xc9\x3b\x75\x01\x47\x50\xfc\x45\xf2\xea\x9a\x7a\x39\x5d\xce\xcc\xe7\x70\xc3\xd5\xf2\xba\xf1\x49\x1d\x29\x8d\x1f\x0a\xa6\x43\x7b\x0c\xef\x4b\xc5\xb5\xda\xb1\x4b\xa4\x17\x9b\x12\x5e\x48\x28\x8b\xec\x19\x92\x34\xe5\x1b\x34\x06\x16\x07\x98\xab\xf1\x66\xb9\xea\x4b\xcd\x82\x9a\x16\x6b\x81\xae\x67\x12\x57\x03\x09\xe3\xc4\xc8\xe4\x76\x7c\xf3\x39\xa6\x29\x0b\x06\x93\xb5\xb4\xa8\xa6\xb5\x68\xbc\xca\x15\x15\x9f\x23\x24\x59\x09\x77\x89\xc8\x92\x4f\xd8\x45\xaf\x10\x5e\x8f\x89\x84\x4d\x55\xee\x04\xe3\x0c\x54\x49\x6f\x7c\xd2\x0c\x35\xf8\xea\x4a\xb7\xbc\x46\x80\xf5\x48\x37\x03\xfe\x24\xa4\x92\x54\x62\x5a\xd8\x8d\x09\xbb\x40\x33\x3b\xa0\x73\x93\xfb\xd5\xf0\x8b\x33\x50\xd5\x96\x9b\xe0\xdd\x6a\x72\x9c\xe6\x06\x5f\xd7\x9d\x71\x5d\x1b\x38\x7d\x75\x18\x87\xa6\x77\x91\x2a\x29\x54\xfd\xc6\x86\x4a\x0f\x9e\x72\x74\x8b\xba\xdb\xb9\xa1\x7a\x05\x2b\xa4\x3d\x2a\x8f\xff\x86\x0f\x86\x79\x48\xa1\x9a\xde\x5a\x40\x48\x26\xb1\x97\x9a\x7e\x06\x5e\x91\x2e\x91\xbf\x55\x5d\x0d\xdf\x70\x65\x31\x5c\x3f\xe1\x66\x97\x50\xf7\x48\xb6\xc1\x35\x48\x1e\x03\x9d\x43\x66\xaa\x59\x32\xbc\xad\xfa\xd0\xd7\xed\xca\x4c\x35\xb8\x69\x7a\x9e\xf9\x15\xf2\xa3\x50\xf0\xc2\x74\x7b\x54\x0d\x97\x3b\x5e\x55\x82\x71\xd8\x54\x7c\x27\xca\xad\x84\x34\xc9\x32\x89\x10\x7a\xcb\x58\x0c\xb4\xf1\x72\x76\xd3\x38\xc5\x40\xf2\x6a\x15\x2f\x25\x36\x70\xc4\x56\x64\x3a\xae\x3c\x1e\xec\x25\x17\x26\x6b\xb9\x6d\xce\x21\x68\xf4\x5a\x2b\xfc\xef\xa4\x70\xcf\x8b\xbc\x68\xd1\xab\xf0\x8e\x7f\x1d\x35\x40\x6b\x3d\xf4\x94\xca\xb7\x42\xd7\x4b\x26\x3b\x1d\x95\x7b\x25\x0c\xa8\x16\xdb\xb9\xce\x52\x7b\x0b\xe5\x6a\xeb\x2f\x3b\xe3\x16\x83\xe2\x7f\xd0\x1a\x73\x35\x80\x35\x4c\xaf\xe0\x7f\x91\xbe\x76\xfa\xfb\x13\x5c\x66\xd9\xf7\x40\xf9\xe9\xff\x79\x4a\x01\xa6\xf8\x8b\x1a\x8a\x31\x33\xcd\x82\x7e\x54\x48\x58\x71\x95\xae\x39\x6b\x6a\x05\x96\xa8\xe4\x53\x82\xc9\x0e\x17\x7a\x6b\x93\xa0\x93\xe6\x11\x5f\x5e\x11\xe1\x85\x75\x04\x54\xbd\xeb\x34\x03\xd3\x6a\x59\xaa\x40\xf5\x2b\xac\x12\x91\x8d\x47\xf3\x01\x45\x0e\x54\xda\x3b\xd0\x21\x02\x55\x2a\x32\x1d\x6e\x0f\x87\xab\x3a\x22\xb4\xb1\x60\x4b\x7f\x8d\x04\xb1\x82\x6f\xf2\xb8\xdc\xc4\x4b\x39\x75\xb6\xcc\xfc\x6a\x6d\xd7\x4d\xcc\x7d\x86\xae\xc3\xbe\x2e\xbf\x31\xaf\x35\xbb\x72\xb5\xa2\x24\x95\xe5\x06\x66\xc7\x83\xf5\xef\xbf\x83\x5b\x73\x76\x40\x79\x2a\x73\x15\xff\x6d\x2b\x2a\x4e\xb5\x4d\xdd\x83\xb5\xbc\xad\xe6\xcc\xae\xa7\xd5\x45\xbe\x62\x2f\xa1\x15\x22\xcd\x3c\xde\xfb\xe6\x28\x43\xdd\xae\x85\xca\xb3\x01\x3e\xdf\xc0\xb7\x8f\x21\x2d\x1b\xf9\xee\x66\xd7\x37\x4e\x7b\x43\x8d\x6c\x93\xa0\x28\x28\x1d\x68\x07\xf8\x65\x62\xa0\x9b\x46\x4c\xa3\xf2\x96\xb1\xb0\xbb\x76\x30\x69\xe7\x94\x84\x31\x09\x75\x46\x30\x3e\x38\x94\x5f\x5e\x20\xc1\x68\x20\x0d\x46\x6c\xd7\x44\x93\xab\x91\x07\xff\x63\x01\x4e\x42\xf2\xfb\xc6\xd1\x8c\xf0\x9d\xf7\x1a\xd9\x4d\x6b\xe5\x2d\x63\x9c\xf5\x19\xac\x27\x2d\xe8\x2a\x3d\x91\xa8\xbe\x26\x74\x8d\x64\x09\x21\xdd\x34\x31\xa2\xc7\x41\x2e\x4e\x4b\x16\x36\x5b\x0c\x29\xc0\x58\xc0\xcf\x18\x4d\xca\x98\x1c\x1c\xdf\x68\x92\xc6\x44\x97\x2a\xf5\x90\xa1\x0e\x62\xba\x25\x4d\xb2\x41\x18\xbe\xcb\x78\x52\x9d\x04\x44\xda\x0c\x3a\x35\xf5\x9e\x8e\x45\xa3\x96\xe1\xba\x46\x57\x08\x2f\x58\x8f\x9c\x52\x90\xf8\x1b\xb5\x93\x3c\xf6\xb6\xc2\xee\x9a\xe2\xf4\x70\xf8\x08\x0b\xb0\x3b\x61\xfb\x1a\xaf\xb5\x88\x75\xf1\xea\x6b\x58\x2b\x9e\xb3\xb0\x57\xd7\x16\xd0\x62\xac\xba\x41\x78\x1b\xb6\xce\xc4\xb0\xaf\x7f\x04\xa7\x36\x82\xd3\x63\x8e\xc8\xeb\x20\xb0\x7c\xe8\xc5\x9e\x95\xdc\x89\xc9\xff\xe4\xb2\xbf\x66\xae\x38\x55\xcd\x49\x96\x41\xba\xc6\x6e\x40\x1e\x0b\x72\xe7\xe0\xea\x18\xac\xfe\xb4\x2a\xb7\x1e\x6e\xb4\x7d\x74\xc2\x68\x48\x37\x6d\x99\x60\x06\xae\x0d\xa2\x4e\xcd\xec\x58\xc0\xe9\xab\xba\x43\x15\xbd\x9f\x85\x97\xc3\x84\x11\xfa\xf0\x8a\x60\xf2\x67\xdb\xe5\xf0\xda\x66\x6f\x19\xab\x7b\x26\x77\x08\x63\x68\x2c\x20\x94\x5c\xb5\x49\x78\x14\x74\x9f\xe4\xf5\x53\xf3\x2b\x78\xb7\xe6\xe9\x83\xc6\xb6\x90\xd4\xa4\xf0\x4a\x07\xeb\xac\xe2\x09\x7b\x36\x23\x5f\x06\x9f\x9e\x09\x08\x64\xf8\xd7\xfa\x39\x09\x53\x1e\xdf\xc7\xc0\xd9\x3d\x7f\x6d\xfc\x40\xa8\x35\x3d\x27\x11\x29\x45\x92\xf3\xc8\x76\x31\xc8\x18\xde\xbe\xe1\x0a\x2b\xfa\x37\x0b\xaa\x2a\x5f\xf1\xf8\x1f\x89\x24\xbd\xde\xe8\xc5\xcd\xb3\x28\xa7\xf3\xb8\xeb\x99\x56\x3c\x93\x34\x4b\x4c\x50\x4e\xaf\xc7\x1b\x98\x22\x67\x16\xc2\xce\x3c\xc8\xaf\xbe\x51\x34\xc1\xf4\x92\x86\xa5\x5a\xbd\xb2\xd6\xd7\x71\x2f\x6e\xd8\x9a\x76\xed\x44\x0b\x98\xd1\x97\x60\x76\xc4\xa0\x49\xbb\xac\xb5\x77\x38\x86\x06\x6f\xc6\x9b\xba\xb3\x2a\xb3\xef\xd1\x99\xb5\x35\x45\x69\xcf\x3b\x6e\x7e\x1b\xa1\x5c\x0f\x1d\x7a\x19\x6e\x66\x10\xcd\xc6\x32\x0d\x22\x44\x33\x83\x40\xd1\xc7\x97\xb9\x13\x4c\xde\x89\x8f\x9d\x48\x6e\x08\x7a\x7e\x3b\x14\xed\x7c\xd7\xa1\xe8\xde\x17\xee\x9c\x2c\x7a\x11\x70\xce\x8e\x7f\xa3\x43\xc6\x85\xd3\x74\xba\x71\xbb\x25\x48\xb3\x63\x77\x2c\x49\x9d\xed\x07\x4e\x12\x3b\x43\xb4\x3a\x65\xd9\x59\xd2\xa8\x98\x6e\x2d\xdf\x76\x36\x93\xaa\x29\x22\x62\xa3\x55\xc1\x94\xf2\xf5\x0a\xc2\x6f\xe3\x1f\x64\xe8\xf1\xed\x54\xf3\x4e\xc4\x34\x95\xd4\x3f\x69\x38\x1c\xc2\x54\x8a\xe2\x7e\x9b\x25\x55\xad\x8d\xdf\x61\x93\xc8\x34\xc9\x22\x08\x97\xd7\x32\x1c\x8a\x2b\x7a\xba\xfc\x99\x41\x65\x79\x2d\xcf\x8a\x1a\x4d\x5c\x18\x8c\x06\xe4\xc4\xa3\xa3\x6f\xcf\x97\x8f\x3d\x7b\x96\x47\x1f\xc6\xc3\xd1\xd8\x4a\x77\x82\x75\x1d\xba\x1d\xa0\xc6\x42\xc5\x11\xe2\x67\x44\x8c\xfe\xd2\x5b\x03\x86\x11\xc4\x8e\x15\xdf\x6e\x87\x63\xf8\x42\x53\x7b\x35\xf8\x05\xa1\xe4\x34\x87\x5b\x5e\x4b\xbd\x67\x2c\xe1\xee\xe3\x18\x52\x48\x9b\xac\x51\xe7\x11\x28\x98\x59\x25\x93\xb0\x80\x64\xb3\xe1\x05\xc3\x35\xea\x31\xe1\xa1\xbd\x59\x66\xb3\x99\x2d\xd8\x6a\x3c\x0c\x25\xed\xb6\x5e\x5b\xfb\xf0\xbd\x5a\x5b\x5e\xf7\x6c\x1b\x1e\xd1\x53\x77\x1d\x67\x8f\xbd\xa3\xac\xbe\xae\x70\x24\x51\x7e\xd3\x6d\x04\xed\xf6\x7a\xe7\xe1\xba\x1f\xec\x57\x9e\x4d\x5a\x83\xea\x91\xa7\xe8\x47\x76\x15\x04\x30\xe0\xa4\x74\x54\xcc\xce\x34\xf0\xd5\x24\x7b\x4c\x9e\x9b\x65\x32\x5e\x4c\x97\xd7\x32\x82\xff\x5a\xc0\x0f\xb4\x95\xb3\xd5\x6f\xe3\x5a\x72\x46\x76\x7e\x2e\xb7\x20\xd7\xe5\x36\x63\xb0\x95\x7c\xd4\xba\xa2\x90\x8a\x27\x2c\x86\xa5\xb2\x4a\xa6\x4d\x34\x02\x66\xa1\x78\x85\x65\xf6\x56\x26\xf7\xdc\xd6\x96\x66\x37\xd3\x1e\x63\xb3\x4e\x7b\xae\xbd\x4f\x71\x10\x80\x41\x35\xa1\xfd\xb5\xe3\x0c\x18\xf6\x47\xbc\xed\x21\xa1\xeb\x36\x57\x8e\xdf\xb4\xe2\x5c\xd7\x31\x2f\xf6\x48\xa3\xa5\xc3\xc1\x9b\xfb\x0c\xd6\x0f\x97\xf6\x7d\x2d\xd8\x5d\xd4\xf6\xf5\x25\x1f\xdd\x89\x1d\x2d\x8b\x56\x49\x46\x50\x33\xe6\xea\xa9\x1a\x8e\xe7\xb9\xbe\xd1\x86\xdb\xa6\xd1\x71\x4f\x7f\x43\xbf\xde\xff\xc6\x2e\x66\x6c\x8a\xf7\x61\x33\xc5\x7f\x9c\x83\x06\x79\x5c\x6e\xec\x1c\x1b\x31\xe7\xd2\x2d\xec\x69\xcd\xfa\xa8\x6d\x4d\x6c\xea\x6d\xfd\x47\x63\x6b\x22\xd9\x69\x64\xce\x33\x7a\x2b\xab\x67\xbb\xb4\x19\xe0\xd5\xa3\xef\x2c\xd3\xdd\x9b\x3b\x37\xd7\xe6\x66\xc0\xb6\x44\xc8\xdf\xb7\x70\xc2\x85\x28\x90\x62\x59\xd1\x49\xe3\x12\xee\x39\x9d\x45\xa9\x87\x59\x1d\xba\xa2\x48\x2b\x3a\x2d\xc6\xd9\x9c\xf1\xfa\xf3\x8c\xe6\x5b\x81\xdd\x40\xd4\x1c\x4e\x47\x25\xb5\xcf\xc0\xdd\xc7\x46\x5a\xb3\xde\x1b\x53\xc0\xd8\x5b\x33\xf8\x9e\x7a\xf3\x8c\x17\xde\x04\x33\xea\x3d\xc5\xe9\x9f\xde\x7c\x7d\xee\x88\xb3\xc9\x13\xe3\x3b\xb3\x86\xd7\xda\x89\x57\x03\x7b\x08\xad\x13\x9b\xf6\xcc\x0a\x3d\xed\x5a\xb4\x7f\xf0\x96\x40\xab\x01\xbf\x17\x3b\x6e\xb0\x0b\xb7\xd8\x90\xf3\xb4\x2c\x18\xe5\x39\x9e\x90\x35\xdd\xe3\x3e\x20\x0a\x7b\x18\xb1\x9e\xee\x3b\x5b\x5b\xe8\x73\x92\x2b\x9a\xfc\xd8\xef\x76\x63\x40\x27\x20\x24\x28\xd3\x35\xcf\x93\xa3\xc6\x9c\x22\x53\x06\xba\x91\x3e\x8b\x62\x66\x12\x75\x12\x46\x45\x90\x24\x2d\x33\xc9\x47\xa1\xd2\x35\x49\x45\xca\x3d\x62\xd5\x8b\xcc\x3a\x49\x13\xc9\x3d\xeb\xbc\x71\xd3\x7d\x6d\xf3\xf6\x78\xb2\xbd\xa9\xd4\x6f\x4f\x7d\x18\x84\x22\x9a\x8d\x3b\x19\xeb\xda\xb5\x19\xa9\x98\xaa\x52\xdb\xa2\x3b\xd0\x73\x87\x79\x8d\x51\x2f\x9b\xe7\xe9\x31\x9e\x8e\xfc\x48\xab\x9e\xef\xad\x12\x91\xb9\x27\x81\x7a\x62\xa0\x11\xa2\x6f\x94\x37\x83\x41\x83\x37\xf3\xba\x4b\x2d\x1e\xff\xb1\x96\x6e\x06\x96\x67\xd9\xdb\x99\x9a\x6d\x8b\x87\xa2\x7c\x2c\x5a\x63\x5e\x6d\xde\x6f\x65\xa8\x95\x15\x19\x87\xbf\xe1\xa6\xae\xe9\x9e\xcb\x19\x75\xf8\x65\xcf\x89\x27\xb1\x42\x8a\x0d\x96\x44\x9f\x1b\x1b\x1f\x26\x48\x18\x04\x51\xae\xca\x85\xcc\x13\x3d\x54\xae\x29\x10\x39\x2c\xad\x46\x70\x61\x05\x70\x7d\x7e\x66\x84\xa8\x71\x10\x19\x0e\xf7\x41\xdb\xdc\x5f\x20\x6a\xf7\xdb\x7c\x67\x77\xdb\x89\xb5\x78\xea\x0f\xe3\x4c\x55\x68\xcf\x75\xd5\x08\xf1\xed\xca\x9f\x36\x3c\x55\xa8\x21\x7c\xed\xdb\x5b\xaa\x74\x1d\xc3\x9a\x73\x95\xda\xbe\xb6\x2d\xc8\xe3\x1b\xae\x7a\x47\x58\x3b\xf7\x4c\x26\xd5\x2f\x7e\xf9\xd2\xcf\xc4\x19\xe0\x72\x52\xb0\x57\x24\x9c\x9b\xd4\x4d\xf1\xa0\xd1\xe5\xcd\x3d\x8e\x4c\xee\x7a\x53\x7b\x73\x4e\xed\x1f\x89\xb4\xfb\xff\x64\xb9\x5d\x52\x59\x9e\xec\x0b\x27\xa6\x80\x17\x9a\x55\x5d\x14\x63\xce\x99\xe1\x9e\x5c\x2b\xf4\x6d\x6d\x78\x5f\xfc\xea\xa1\xd5\x82\x0c\x60\xaa\x8d\x0a\xbf\x6c\x35\x3a\x6b\x8d\x74\x87\x20\x51\x16\x36\x40\x21\xcd\x13\x8b\x92\x13\x2b\x12\xa2\x78\x52\x51\x72\x42\x45\xd2\x88\x7b\x62\x59\xd2\x8f\xcd\x4b\x0a\x93\x3f\x15\x95\x03\xa9\xaf\xe9\x23\x46\x66\xeb\xe3\xd0\x3b\xa5\xee\xd1\x38\xd3\x14\xe9\x50\x85\x73\x5a\xa0\x74\x72\xd4\xd7\x9d\xdc\xac\x08\xa7\x26\xb7\x97\xac\x6a\xbf\x4a\xf0\x1c\xcf\xa2\xad\x3c\xfa\x42\x99\xd4\x0e\x9b\x26\x04\xdb\x91\x6c\xea\xc7\xbe\xf3\x51\x7c\x3c\xd7\x7a\xc9\xb3\x9b\x73\xdf\x79\xbf\xc3\xf3\xb3\xae\x39\xe2\xd6\xed\xd1\xcd\xd8\xc7\xe9\xd1\x91\xd4\x29\x69\xd6\x5b\x6e\x2c\xd1\xfa\x73\xef\xcf\xca\xb4\xdd\x29\xfa\xe7\x24\x4e\x5a\xc1\x88\x31\xf5\xd2\xe0\x57\x94\x33\x5d\x26\x9d\xdf\x6e\xd8\xb4\x66\x32\x19\x1d\xeb\x1f\x2d\xdc\xed\x2f\x37\x86\x4f\x92\x1c\x69\xad\xad\xa2\xbc\x54\x66\x07\x74\x83\x27\x4a\xf0\xe9\x8f\x81\x73\x8e\xc4\xc1\xaa\x76\xa0\xa1\xf3\x46\xa7\x46\x69\xfa\x39\x8a\x09\xd4\x27\x46\xe9\xa3\xb0\xee\x49\xda\x5e\xa4\x1d\xc0\xf6\x85\xc1\xf6\xc5\x50\x3d\x14\x3b\xf3\x98\xa4\x1a\xce\xb8\x7f\x44\xec\x72\x43\x50\x2b\x78\xa1\x09\x69\x03\xd9\xd6\x86\x9d\x2d\xe3\xd6\x24\xa2\xfe\x45\xe6\x30\x48\xe8\xf7\x18\xbd\xd9\xfc\x05\x40\xd2\x30\x3b\x02\x92\xaf\x6d\x93\xa9\xbd\xe5\x60\xb7\xec\x0d\x04\x3c\x04\xbc\x88\xcd\xc7\x7a\x43\x7d\x52\xc9\x4d\x52\x34\x75\xc2\xe7\xdc\xfc\x24\xb9\x9a\xeb\xa3\x9d\xe7\xc4\xab\x66\x85\x56\x5e\xa2\x69\xd3\xb1\xad\x5d\x7b\x88\x2a\x3a\xf6\xbb\xf5\x13\x87\x79\xa7\xd8\x90\xb7\x6d\xa8\x39\xad\x13\x8f\x19\x93\x9d\xb6\xaf\x4b\x0f\xbb\xca\x76\x07\x7e\xa8\x6a\xfc\x3e\x55\xa5\xfe\xa1\x9a\xfe\xdf\x1f\x44\x8e\xd2\xb5\xc2\xad\x8b\x69\x9f\x42\x9a\xf4\x93\x69\xb4\xed\xd9\xb6\x58\x5e\x4b\xdf\x51\xee\x3e\xd6\x45\x6c\xdb\x5d\x1c\x05\x8f\x78\x4b\x8f\x39\x2e\x53\xf4\x80\xb3\x0c\x9d\x45\xb8\x60\x82\x57\xbb\x96\x23\xf4\xfe\x4a\xb0\x83\x5b\x5e\xb6\x0f\x2c\xd0\x70\xae\x01\xaa\xd3\x31\x7e\x3f\xa3\x69\xea\xc0\xf2\x91\x89\xe7\xe7\x4d\x02\x47\x66\x81\x75\xfd\x6b\x84\x10\x58\xbf\x5c\xd6\xa4\x19\x48\x9a\xf3\x10\x27\x46\x00\x7b\x0a\xe2\x1c\xcc\xb9\x2b\x7c\xd1\x08\x30\x32\x36\x3c\x7e\x9a\xc6\x03\xc9\x45\xd8\x3d\x31\x4a\x4c\xda\x26\x3a\x12\x33\x8c\xfa\xce\x8c\x1a\xd6\x50\x36\x6e\x90\x35\xd1\xa9\x6d\xf7\xec\xd4\xa2\x17\x18\xf3\x8f\x0b\x21\x03\x06\xbd\xd0\x44\x43\x35\xd9\x71\xff\x1e\x03\xcf\xb0\x9b\x9f\x70\x12\xe7\x7c\x6f\xbf\xdc\xd9\x4d\xd7\x70\xa2\xb3\x5f\xd2\x9c\xb8\x2b\x7c\xf9\x74\x3f\x7a\x9e\xe0\xcf\x4f\xf7\xc8\xee\x70\xb3\xf8\xb8\x16\xe9\xda\xd9\xfc\x34\xb5\x70\xed\xa9\x2d\x37\xb5\x9b\xa1\x97\x58\xc5\x61\xa4\xbf\x63\xfc\xfa\x52\xfe\x89\x67\x4c\x4f\xaa\x8d\x9d\xcd\x48\xd2\x02\xf2\x3c\xd8\xe9\x0e\xe9\xbf\x77\x3b\x92\x7e\x2a\xbe\xd2\x3e\x43\x2f\x7e\x56\xa3\x8b\x7c\x9d\xd0\xc2\x7c\x76\x40\xfd\xc2\xc1\xd4\x56\x37\xed\x63\xd9\x7f\x54\x77\xeb\x1c\x66\xeb\xf6\x3b\xd4\x67\x11\x00\x86\xcf\x42\x0d\x41\xa0\xc7\xe7\xc6\xfb\xda\xcf\x82\x44\xcd\xe9\x17\x87\xc4\x4b\xf9\x6c\xdb\xf0\x5f\xbc\xa7\xed\x9a\xd8\x39\xea\xd5\x7c\xfc\x77\x00\x00\x00\xff\xff\xe1\x80\x04\x13\x94\x51\x00\x00")
+var _templateBuilderMutationTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5c\xdd\x93\xdc\x36\x72\x7f\x26\xff\x8a\x36\x6b\xed\xe3\x6c\x46\x1c\xfb\xde\x22\x67\x1f\x74\x5a\xfb\x32\x55\x89\x94\x3a\xad\x93\x07\x95\xea\x4c\x11\x98\x5d\x64\x39\xe0\x98\xc0\xec\x47\x8d\xe7\x7f\x4f\x75\x03\x20\x01\x7e\xcd\x87\xd6\x1f\x49\xa5\xee\x76\x48\x10\x68\x74\xff\xfa\xd7\xdd\x68\xe8\x76\xbb\xc5\x65\xfc\xb6\xda\x3c\xd7\xe2\xf6\x4e\xc3\x5f\xbf\xfd\xee\x5f\x5f\x6d\x6a\xae\xb8\xd4\xf0\x63\x5e\xf0\xcf\x55\x75\x0f\x4b\x59\x64\xf0\xa6\x2c\x81\x06\x29\xc0\xf7\xf5\x03\x67\x59\x7c\x73\x27\x14\xa8\x6a\x5b\x17\x1c\x8a\x8a\x71\x10\x0a\x4a\x51\x70\xa9\x38\x83\xad\x64\xbc\x06\x7d\xc7\xe1\xcd\x26\x2f\xee\x38\xfc\x35\xfb\xd6\xbd\x85\x55\xb5\x95\x2c\x16\x92\xde\xff\xc7\xf2\xed\x0f\xef\x3e\xfc\x00\x2b\x51\x72\xb0\xcf\xea\xaa\xd2\xc0\x44\xcd\x0b\x5d\xd5\xcf\x50\xad\x40\x7b\x8b\xe9\x9a\xf3\x2c\xbe\x5c\xec\xf7\x71\xbc\xdb\x01\xe3\x2b\x21\x39\x24\xeb\xad\xce\xb5\xa8\x64\x02\xf6\xc5\xc5\xe6\xfe\x16\x5e\x5f\xc1\xe7\x5c\x71\xb8\xc8\xde\x56\x72\x25\x6e\xb3\xff\xca\x8b\xfb\xfc\x96\xe3\xa0\xdd\x0e\x34\x5f\x6f\xca\x5c\x73\x48\xee\x78\xce\x78\x9d\xc0\x05\xbe\x89\xc5\x7a\x53\xd5\x1a\xd2\x38\x4a\x8a\x4a\x6a\xfe\xa4\x93\x38\x4a\x56\x6b\xfa\x2f\xf5\x2c\x8b\x24\x8e\xa3\xdd\xee\x15\xd4\xb9\xbc\xe5\x70\x21\x71\xa1\x8b\xec\x3f\xb7\x3a\xff\x5c\xf2\x77\x15\xe3\x0a\xe7\x89\xa2\x64\xb7\x1b\x5a\x7b\x81\x8f\xa5\xf7\x20\x31\xd3\x71\xc9\xf0\xbb\x38\x4a\xb8\xd4\xb7\x55\x26\xaa\x05\x97\x3a\x89\x67\x71\x5c\x54\x52\x91\x44\x8b\x05\xbc\xdf\xf0\x9a\x36\x0b\xfa\x79\xc3\x55\x16\x47\xef\x37\x6f\x6b\x8e\x1b\x01\x80\x2b\xe0\x52\x67\xee\x09\xbe\xbb\xe6\x25\x0f\xdf\x99\x27\xed\xbb\xf7\x92\x77\xde\xbd\x97\xf4\xfa\xa7\x0d\xeb\x4c\x6b\x9e\xb4\xef\xfc\x4f\x9b\x27\x71\x1c\x2d\x16\x80\x8a\x68\x44\xec\xab\xcb\xd3\xd3\xcd\xf3\x86\x1b\x9d\xbc\xcb\xd7\xa8\x21\xb8\x82\x24\x78\x10\x6a\x68\x46\x26\x9e\xd6\x3e\x8e\xb8\x70\xb0\xa0\x21\x92\x2c\x84\x3f\xed\xa4\xf1\x62\x01\xc1\xa8\xfd\x1e\x6a\x6e\xbd\x40\x41\x2e\xa1\x6a\x55\x7d\x97\x6b\xa0\x81\x5c\x11\x4c\x43\x79\x25\x2d\x6b\x11\x7c\x5b\xe7\x9b\xbb\x2c\xc6\xad\xf7\xe6\x57\xba\xde\x16\x1a\x76\x71\x54\x10\x2c\xe2\xa8\xda\xc0\xfb\x4d\x1c\xe9\xe7\x0d\x28\x5d\x0b\x79\x6b\xb6\xfa\x28\xf4\x1d\xae\xb0\xbc\x46\x49\xa3\x68\xb7\x83\xec\x6f\x5b\x51\x32\x5e\xff\x28\x78\x89\x48\x81\x4b\x7c\x88\xca\xa3\x21\x9e\x82\x7c\x74\xae\x3a\x9b\xa7\xaf\xad\xe2\x51\xba\xd5\xe0\xb4\x17\xab\x76\x62\x9a\x4d\xac\x20\x97\xcc\x3d\xcf\xde\x6d\xd7\xbc\x16\x05\xfe\x7e\x5b\xc9\x07\x5e\x6b\xce\x6e\xaa\xbf\xe5\x4a\x14\x66\xea\x28\x67\xec\x84\xe9\x7d\xc1\xdd\xdf\x45\xc9\xf3\x9a\x33\x2b\xf0\x3a\xdf\x7c\x34\x0a\xfa\x64\x94\xb8\x0b\xf7\xc9\xed\x3e\x7f\x60\xb7\x5c\xfd\x8f\xd0\x77\xad\xe6\x5e\x81\x58\xc1\x05\xcf\x7e\x92\xe2\x97\xad\x5d\x14\x15\x7a\xc1\x87\x85\xe3\x24\x5c\xb6\xbc\xee\x0a\x59\xaa\xe9\xaf\x51\xc6\xc1\x09\x3c\x91\xa3\xa8\xe6\xeb\xea\x81\xb3\x2f\x98\xc2\xd7\x92\x53\xd3\xf0\x74\x9f\xab\xaa\x0c\xb5\xca\x2a\xc9\xed\xe3\xaa\x64\xff\x9d\x97\x5b\x0e\xab\xad\x2c\x52\xcb\x75\xc8\x57\xc8\x79\x33\x48\x2f\x03\x90\xcf\x81\xd7\x75\x55\xcf\xe2\x68\x53\x73\x26\x8a\x5c\x73\x05\x1f\x3f\x35\x3f\xb2\x60\x74\xbc\x8f\xe3\x87\xbc\x86\x7f\x12\x71\x38\xf0\xc1\x95\x9d\xd5\xf3\x89\x59\x2a\x45\x39\x0b\x1d\xf6\xfd\xc6\xb9\xed\xa6\x16\x52\x43\x5a\xe4\x6b\x5e\xba\xe9\x67\x90\x98\x01\xc9\x80\x17\xdb\x4f\xf7\x7b\xc8\xcb\xb2\x7a\x54\xb0\xce\x65\x7e\xcb\xd7\x18\xd9\x28\x98\x70\x70\x43\xc1\xf8\xe0\xd6\xfa\xf8\x56\x09\x79\x4b\xba\xc0\x9f\x79\x09\x15\x4d\xa5\x06\x5c\xb9\x5d\x04\x87\xf7\xb7\x14\xa3\x54\x92\x3f\x76\x9e\x43\x41\x3c\xad\x40\xf2\xc7\x56\x8a\x55\x55\x0f\x50\x0a\x97\x5a\xe8\xe7\x2c\xc6\x05\x06\xa6\x4a\x0b\x2b\xfd\x1c\x88\x41\xf0\xbf\xb4\x82\x2c\xcb\x06\xe5\x9c\x41\x57\x46\xe4\xa0\x35\x6a\xf8\x9b\xce\x8b\x1d\x62\x8a\xa6\x7e\x0d\xf6\xff\x8a\x79\x1c\x45\xd5\xa6\xf9\x8d\xff\x5f\x6d\xf0\xa1\x7e\x0e\x9e\xf6\x98\x7c\xde\x02\x94\x20\xae\x5e\xc3\x3a\xbf\xe7\xe9\x80\x37\xcf\xe6\x71\xb4\x8f\x23\xd4\xc6\x3f\x69\x37\x28\x9c\x21\x79\xda\x1a\xca\x55\x6d\x74\xba\x9e\xd1\xb8\x9a\xeb\x6d\x2d\x61\x1d\x5b\xae\xb7\x1f\x18\xbc\x24\x48\x9f\x49\x23\x47\xb2\xbc\x26\xa8\xa0\x1f\x5c\x6c\xf2\x3a\x5f\x2b\x9c\x3c\xc1\x87\xf8\x6d\x5e\xdf\xb6\x0f\x62\x47\x18\x32\xfb\xf7\x5c\xbd\x97\x9c\x04\xb7\x6c\xe2\xcf\xd0\x2c\x26\x18\xd0\x5a\xad\xb3\x36\x93\x5e\x41\x22\x58\x33\x6b\x43\x20\x1e\x6f\x89\x79\xcb\xd1\x6f\xab\xf5\xa6\x52\x42\xf3\x2e\x77\x09\x3b\x67\x67\x65\x27\x49\x32\x87\x60\x2b\xcd\x7b\xfa\xd5\xbe\x6d\x38\x63\x68\x1b\xee\xb7\xf3\xb5\x95\xf3\x35\x48\x3c\xbe\x1e\x59\xa3\xfb\xd1\x7e\x1f\xb0\x8e\xf7\xa7\x75\x57\x34\x17\xc6\x43\xae\x4d\x3c\x5d\x5e\xc3\x0a\xf5\xdc\xf5\x51\xeb\x00\xed\x27\xa9\xa7\x07\xc4\xf5\x20\xdc\x61\xd7\x00\x04\x3f\x4f\xd7\x3d\xf8\xcf\x70\x48\x84\x0c\x95\x22\x9f\xf3\xba\x36\xf4\x86\x3f\x2a\x59\x70\xc0\x44\x2f\x7b\x2f\x0b\x8e\x4f\x1e\x88\x26\x43\x3e\x8c\xa3\x68\x16\x47\xd1\x3a\x6b\x68\xf4\xca\x12\xa9\x7e\x82\x63\xc9\x94\xa4\xa0\x05\xb3\xeb\x2a\xa5\xcf\x8d\x64\x51\x24\x56\xb0\xce\x88\xad\xcd\x6f\x92\xf1\x0a\x56\x6b\x9d\xfd\x80\xdf\xae\xd2\xe4\x97\x2d\xaf\x9f\x91\xb4\xaa\x92\x01\xc9\xa8\x60\x53\x29\x9b\xa9\xa0\xe7\x0b\x05\xb2\xd2\x86\x0a\x39\x4b\x50\xe0\x28\xda\x9b\x48\x66\xa7\xa5\xef\x88\xdc\xe1\x0a\xd6\xd9\xdb\x52\x70\xa9\xd3\x59\x48\xe7\xd9\xdf\xb9\x4e\x0b\xfd\x34\x87\x06\x00\xfb\xbd\x9d\x0d\xff\xd3\xfc\x6d\x55\xde\xce\x18\x9b\xd7\x43\x09\x4d\xb4\xce\x86\x72\x9a\x2b\xf8\x46\xb0\xa1\x78\xdb\x4b\x68\x7a\xce\x62\xa7\x1c\x48\x39\x0c\xc9\x05\x10\xf5\xe6\xb5\xc0\x0c\x7f\xec\x7d\x5e\x19\xa1\x95\x71\x34\xa3\x3d\x02\xfd\x1d\x86\x35\xa6\x90\x10\x82\xe4\x0b\xc1\x3d\x80\xcc\x93\x50\x69\xd7\x40\xc1\xe6\x20\x45\x79\x96\x31\xf1\xeb\x6c\x79\xfd\x1b\x58\x94\x66\x36\x6f\x3f\x50\x08\x71\x2f\x0f\xdb\x75\xb1\x00\x03\x73\x30\x7b\x54\x90\x63\x88\x85\x9f\x31\x57\x31\x6f\x7e\x86\x55\x5d\xad\x43\x9b\xc1\x32\x34\x22\x3c\xe6\x0a\x11\xc0\x9f\x78\xb1\xd5\x9c\x61\x09\x9b\x83\xae\x73\xa9\xf2\x82\x06\xa4\x38\xe1\xcd\xd3\x6c\x1e\x3e\xcf\x4b\x28\x68\x15\xac\x9b\x8d\x08\x58\x55\xa3\x35\x21\x5d\x07\x56\x27\x6b\x3a\x9f\x84\x4b\x2b\x36\xd6\x0f\xe6\x2f\x0c\xe0\xe6\xe1\xce\x05\xed\x75\x66\xfe\xda\xbb\x41\x99\x90\x42\xa7\xb3\x06\x35\xe6\xa9\x55\xc4\xcd\x53\xab\x04\x69\x34\x70\xf3\xf4\x33\x60\x18\x76\x32\x20\xa6\x73\x0d\x8f\xbc\xe6\xc1\x5e\xbd\x1d\xa9\xef\x51\x11\xc2\x53\xa8\x34\x58\x82\x4a\xdf\xf1\xfa\x51\x28\x3e\xb1\xbf\x9b\xa7\x14\xb1\x78\xf3\xe4\x03\x50\xac\x20\xc2\x44\xe0\x1e\xd1\xb1\xce\x58\x2d\x1e\x78\x9d\xa5\x97\xfa\xe9\x9a\xfe\x9c\x7d\x0f\x5f\x55\xf7\x38\xd2\xed\x4b\x8a\x72\x1e\xf0\xa3\x3b\x08\xd8\xef\x5f\xf7\x28\xb1\xde\x4a\x89\xd4\xd9\xb5\x19\x72\xe4\x3e\x8e\xf4\x13\x2e\xfb\xcd\xcd\xd3\x90\x5a\xf5\x53\x57\xa5\xc8\x8c\xe8\x22\x44\x1a\x5d\xff\xb0\x51\x3c\xfb\x49\xf1\xfa\x9a\x4e\x2c\x0c\x30\x17\x0b\xf8\xc0\xf5\xf2\xba\xe5\x0d\x62\x4e\xc7\x15\x82\x99\x90\x98\xc1\xbb\x4a\x73\x63\x03\x3c\x0b\xa1\x0f\xdb\xd2\x54\x28\xa8\x64\xf9\x0c\x79\x51\xf0\x0d\x5a\xa6\x92\x26\xcf\xc4\x97\xd5\x6a\x28\xad\x14\x54\x93\x3b\x73\xf4\xd9\x83\xa4\x4a\x05\x71\x98\x0b\xfc\x8e\x53\x26\x48\x3b\x88\xf3\x88\x87\xe5\x75\x83\x07\x1b\xe3\xcd\x06\x6d\xa1\xec\xd6\x0c\x37\x88\xe3\xf0\xe3\x66\x5f\x0f\xb9\x28\xf1\x64\x05\xab\x38\xa1\xd1\xe9\x60\x53\x57\x0f\x82\x71\x06\xba\xa2\x99\x3e\x1b\xc2\xcf\xe2\xf1\x3d\x2d\xaf\x11\x63\xe1\x9e\xe6\xc0\x9f\x84\xd2\x8a\xea\x21\x07\xba\x91\x2d\x5e\x21\x05\x7a\x68\xf3\x93\xd0\xcb\xc1\x6f\xe6\xa0\xeb\x2d\x8f\x43\xad\xec\x76\x5d\xd6\xf3\x0a\x72\x34\x84\x39\xea\x69\x32\x59\xef\xa0\x28\xc9\x12\x5b\x6f\x2b\x9d\x4b\xdd\x7c\xb1\x41\xa4\xd6\xbc\xe0\xe8\x15\x2e\x55\xcb\x3e\x50\xad\x6c\x32\x36\xb1\x02\xfe\x0b\x0e\x4c\xd6\x98\x27\xa2\x0a\x2e\x36\x78\xc8\x42\xe6\x70\x8f\xac\x88\x54\x19\x93\x1e\x51\xbe\x55\x73\x70\xf0\x81\x6b\x87\xda\x66\x84\x1f\xf3\xe8\xc4\xa6\x09\xae\x89\xc5\xee\x14\xcc\xbc\x69\x4c\x56\xe7\x64\x5b\x0d\x80\x6e\x34\xac\x5f\x6c\x5a\x96\x5f\x5c\xa2\x3c\x1a\x37\x2e\xed\x09\x05\xd5\x6e\xd5\x03\xaf\x6b\xc1\x38\x6c\x6a\xfe\x20\xaa\xad\x82\x22\x2f\x4b\x85\xf0\x79\xc3\x58\x06\x97\x0b\x3f\xdd\x3e\xf6\xa0\x23\xc5\xd4\xea\x62\x95\x2d\x15\x1e\x3a\x90\x58\x33\x1b\x7f\xd6\xd9\xe8\xf9\xc7\x95\x8d\xa5\x1e\x2a\xa2\x7d\xdc\xea\xb5\x51\xf8\xdf\x49\xe1\x81\x07\x05\xfc\x30\xa8\xf0\x9e\x6f\x1d\x34\x40\x67\x3d\xf4\x92\x3a\xb4\x42\xdf\x4d\xa2\x07\xc4\xdc\x88\x5d\x62\xca\x5d\x1f\x7c\x97\x69\x7c\x06\x9d\xa6\xf1\x9a\x07\xeb\x21\xa3\xdb\x7f\x5f\xb2\xae\x06\x30\xb3\x1a\xdc\xf8\x5f\x54\xc8\x9e\x03\xb4\xf7\x9c\x91\x96\x97\x43\x03\xaa\xcf\xff\xcb\x0b\x22\x17\xf9\x17\x3d\xc6\x2f\x73\xfa\x61\x87\x0a\x05\x2b\xae\x8b\x3b\xce\xda\x54\x81\xe5\x3a\xc7\x23\x67\xb3\xd0\x1b\x17\x03\xbd\x28\x8f\xf8\xf2\xed\xe3\x9d\x31\xda\xc0\xd4\x1c\xa3\xce\xa1\xaa\x83\x59\x81\xf2\x7d\x58\xe5\xa2\x54\xa7\xd9\xd5\x28\x72\xa4\x32\x79\x40\x55\x60\x95\xb9\xca\xde\x89\xd2\x50\xed\x7e\x7f\xd9\x30\x42\x17\x0b\xae\x54\x22\xc2\x44\x53\x7f\xb5\xce\xaa\x4d\xb6\x54\xa9\x77\x06\x1c\xe6\x90\x0f\xfd\xb8\x3c\x64\xe8\x86\xf2\x4d\xb9\x02\x95\x84\x66\xc2\x56\x51\x0a\x43\x34\x01\x49\x50\x74\x0b\x82\xed\x24\x7b\xff\xfa\x6b\xcb\x73\x7e\x76\xdc\x03\xea\xb1\x02\xd7\xfc\x97\xad\xa8\x39\xa5\x3b\xcb\xeb\x61\x0f\x6c\xa4\x75\xeb\x51\x92\x63\xfc\xc7\x3d\x42\xcb\xe0\x30\x24\xea\xba\x86\xaf\x0e\x0a\xd4\xaf\xfc\x28\x63\x1b\x91\xf3\x35\x7c\xfd\x98\xd0\xb2\x4e\x16\x3b\xab\x5b\x7f\x30\x8f\xb6\x49\x3f\x3a\xe7\x6e\xf7\x32\xbc\xd8\x30\x7e\x7b\x52\xf3\x86\xb1\x64\x30\x87\xef\xc4\x99\x9c\x31\x65\x03\xd7\x7e\xef\xfc\xd2\x1a\x26\x64\x82\x2c\x8e\x5e\x20\xe8\x20\xb0\x27\x58\xdc\x37\x51\x74\x39\x31\xf0\x5f\xae\x1a\xa9\xe3\x6e\xed\x3d\xf1\x59\x18\xdb\x08\x3f\x68\x08\xa2\x15\xc6\x38\x1b\x32\x58\x40\x94\x86\x0c\x29\x69\xc4\x8c\x29\x67\x1e\x9d\x0d\xaa\xcd\xe0\x56\xa8\x06\xb8\xd3\x7a\x1c\x95\xe2\xb8\x00\xe2\x22\xc8\x98\x02\xe2\x68\x20\x8a\x58\xd8\x3a\x85\x38\x14\xb7\x81\x04\xf5\xd4\x78\x78\x83\xdb\x8b\x55\x66\x8a\xe7\xbc\x1c\x85\xe1\x5b\x3c\x45\x3f\x0a\x88\x74\x9c\x79\x6c\x38\x3e\x1e\x8b\x56\x2d\xe3\xb9\x0e\x39\xe3\x4b\xe6\x28\xc7\x24\x29\x41\x96\x82\x69\x4d\x70\x98\xfb\xb1\x4d\x58\xf7\xfb\x4f\x70\x05\xee\x2c\x77\xd7\xe0\xb5\xd9\xa2\xd3\x74\x47\xc3\x46\xf1\x9c\x25\x83\xba\x76\x80\x16\x13\x2a\xc6\x98\x0d\x56\xac\x13\x31\xec\x2d\x95\xce\x28\xbb\x31\x46\xf0\xca\xce\x89\xfd\x7a\x08\xac\xee\x07\xb1\xe7\x76\xee\x71\xf2\x3f\xb8\x1a\xce\xa3\xb1\xed\xa8\x15\x9e\xd4\x41\x71\x87\x15\x82\x3a\x44\x72\xa7\xe0\xea\x10\xac\xfe\xb0\xcc\xd7\xad\xdc\xf3\xd1\x88\x51\x6f\x3a\xed\x98\x60\x0e\xbe\x0d\x66\x9d\xd9\xb0\xd6\x72\x3f\xfc\x5a\x6b\xac\x39\x88\x73\x55\x54\x3f\x25\x39\xc6\x21\x93\xec\x08\xa6\x7e\xb4\xec\x70\xc1\x1b\xcb\xbd\x61\x36\x19\xea\x34\x13\xed\x1c\x57\x90\x28\xae\xbb\x53\x04\x33\x98\x0a\xaa\x95\x30\xc2\x5b\x18\xf0\xf6\x8e\x17\xf7\xa8\x02\x02\x2e\x96\x2f\xbc\x26\x4c\xe7\x65\xcd\x73\xf6\x6c\x2f\x39\x30\xf8\xfc\x4c\x70\x20\xb2\x7e\x65\xc6\x29\x48\x79\x76\x9b\x01\x67\xb7\xfc\x95\xf5\x06\xcc\x86\x70\x9c\x42\x76\x97\x78\x84\xef\xea\x1b\x14\x0c\x93\xa5\x0f\x5c\x63\x6d\xf1\xfa\x8a\xf2\xcd\x0b\x8e\x7d\x0e\x32\xd2\x07\x9a\xd4\x69\x46\xac\x82\xe1\xbe\x7f\xba\xed\xd9\xd0\x59\x61\x09\xe6\x55\x81\xbc\x05\x2b\x4a\xe6\x80\xec\xf5\x35\xc3\xbc\x1c\xb7\x26\x10\x34\x62\xe5\x44\x6a\xd4\xab\x1a\x7d\x1d\xf6\xe5\x56\xac\xb4\x6f\x27\x5a\xc0\x1e\x40\x0a\xe6\x5a\x65\x06\x2c\x30\xd8\x72\xb5\x94\xec\x10\xea\xcd\x65\xe9\x73\xb8\xe7\xea\x8e\xb0\xbb\x27\x9e\xcd\xe1\xc2\xd0\x37\x7e\x94\x9b\x98\xb9\x69\x9e\x4d\xf7\x88\xbd\x93\x79\x6a\xa8\x89\xb6\x97\x86\x5b\x9f\x5e\xe6\xa3\x60\xea\xa3\xf8\xd4\xe3\x73\x3b\x61\xe0\xbd\x63\x9c\x17\xba\x0e\x71\xfc\x10\xe9\x79\xb1\xf4\x2c\xe0\x9c\xcc\x82\x96\x4b\xc6\xd4\xdb\x96\xa3\xcd\xc7\xfd\x8d\xb4\xa7\x77\xde\x0a\x83\xa1\xea\xd4\xed\xf8\xa1\x2c\x8b\x4f\x0f\x5c\x36\x1a\x4d\x6f\xd3\xcf\xe8\xbb\xce\x66\x03\x36\x31\x22\x96\x60\x35\xa4\x14\xb5\x57\x90\x7c\x9d\x7d\xa7\x92\x40\x6e\xcb\xf8\xbe\xe3\xb5\xf9\xd4\x3f\xe8\x92\x43\x02\x29\x36\xd5\xb7\x65\x5e\x37\xda\xf8\x15\x36\xb9\x2a\xf2\x72\x06\xc9\xf2\x5a\x85\x71\xbf\x75\x60\x30\xb7\x24\xbe\x90\x54\x96\xd7\xea\x24\xd6\x68\x79\xa1\xef\x5c\x96\x0d\xc8\x89\x27\xaf\x70\x04\xbe\x7c\x68\x6c\xdb\x0e\x3f\xc2\xa3\xf7\xd3\x74\x34\xb5\xd2\x47\xc1\xfa\x0e\xdd\x25\xa8\x29\xaa\x38\x30\xf9\x09\x8c\x11\x47\x01\x4f\x04\x80\xc1\x5a\x90\x1f\x4c\xc1\x9d\xaf\xa1\xfd\xad\x5c\xb0\xbc\x56\x41\x26\x7e\x2a\x5c\x0e\xe3\xc4\xae\xbe\xbc\x56\x58\xe2\x20\x54\x3e\x7e\x9a\x42\x0a\x69\x93\xb5\xea\x9c\xd6\xa1\xd5\x34\x4e\x7b\x05\xf9\x66\xc3\x25\x4b\x05\x53\x73\x10\xac\xb5\xbd\x7f\x8c\xe6\xa2\x99\x4b\xdb\x1a\x3c\x8c\x05\xed\xae\x5e\x3b\xa7\xf3\x83\x5a\x5b\x5e\x0f\x1c\x28\x1e\xd0\x53\x7f\x9d\xf6\xe4\xbd\xaf\xac\xa1\xda\x70\x22\x50\x06\x75\xb7\xe5\xbb\xcb\x91\xc1\x4d\x55\x38\xac\x3c\x17\xb4\x46\xd5\xa3\x8e\xd1\x8f\xea\x2b\x08\x60\xc4\x49\x17\x0b\xaf\xd3\x81\x80\xcd\xcb\xc7\xfc\xb9\x5d\xa6\xe4\x32\x5d\x5e\xab\x19\xfc\xdb\x15\x7c\x47\x07\x3a\x5b\xf3\x35\xae\xa5\xe6\x64\xe7\xe7\x6a\x0b\xea\xae\xda\x96\x0c\xb6\x8a\x4f\x5a\x57\x48\xa5\x79\xce\x32\x58\x6a\xa7\x64\xea\x14\xe1\xc4\x42\x6a\x5e\xcb\xbc\x84\xad\xc2\x5b\xa9\x9f\x9f\xfd\x73\x4e\x77\x63\xd3\x39\xed\xa9\xf6\x3e\xc6\x41\x00\x46\xd5\x84\x89\x92\x60\xed\x09\x73\xcf\xb0\xdf\x83\x60\xc1\x21\xd9\x80\xdb\x5c\x7a\x7e\xd3\xe1\xb9\xbe\x63\x0e\xae\x72\x8c\x47\x5a\x2d\xed\xdb\x33\x35\x0a\xaf\x63\xf9\xc3\xb9\xd5\x5f\x07\x76\x27\xa7\x3d\xc3\xfb\x73\xf5\xd8\xc1\xb4\x68\x95\x97\x04\x35\x6b\xae\x81\xac\xe1\x40\x80\x18\x2c\xfd\xc2\x62\x8d\x6e\x36\x07\x0e\xd7\x1c\xf8\x52\x15\x33\xd5\xdb\x7b\xbf\x49\xf1\x3f\xbc\x8b\x11\x78\x22\xed\x1a\xdc\x88\x39\x7f\x5e\xe9\x2e\x26\x37\x97\xcb\x9b\xc9\xd2\xa0\x29\x30\x9b\x5a\x13\xb9\x3e\x9d\xd9\x3b\xbb\xc1\xca\xfa\xd9\x2d\x6d\x5b\x7b\x6e\x71\xb4\x2e\x15\x69\x7e\x43\xdd\x98\x9b\x01\xdb\x52\xbf\x2e\x3c\xbd\xf0\xe8\x42\x48\x9c\xb1\xaa\xe9\x6e\x7d\x05\xb7\x5c\xd3\x59\x81\x6b\x73\xf5\xe6\x15\xb2\xa8\xe9\xd6\x23\x67\x0b\xc6\x9b\xbf\xe7\xd4\xf9\x8a\xdd\x31\xa2\x91\x30\x9d\xdc\xa9\x1b\x03\x1f\x3f\xb5\xbb\xb5\xeb\xbd\xb6\x09\x8c\x7b\x35\x87\x6f\xa9\x42\x2f\xb9\x0c\x7a\x9b\xb3\x78\xe8\xc2\x47\xf3\xda\x22\xc3\xd6\xf5\xc7\x36\x3f\xdb\x38\xb1\x9a\x8c\x13\x56\xd6\xc6\x89\x57\x23\x27\x09\xe1\x9d\x35\x67\x50\x33\xda\xb7\x68\x80\xa6\xe6\x0c\x30\x87\x4e\x01\x7e\x2b\x1e\xb8\xc5\x2e\xdc\x60\x41\xce\x8b\x4a\x32\x8a\x73\x3c\x27\x6b\xda\x15\x5c\x53\xdc\x5d\xaa\x6d\x3a\xfd\xde\x01\x17\xfa\x9c\xe2\x9a\x7a\x42\xee\xb7\x3b\x18\x30\x01\x08\x27\x54\xc5\x1d\x5f\xe7\x07\x8d\x99\xa2\x50\x16\xba\x33\x73\x49\xc5\x76\x26\x9a\x20\xec\xdf\x62\xf0\xcd\xa4\x1e\x85\x2e\xee\x68\x57\xa4\xdc\x03\x56\x3d\xcb\xac\x51\x81\xcd\x2e\xdf\x3a\xaf\xfd\x70\xdf\xd8\xdc\xb1\xaa\x6b\x5c\xc6\x51\x60\xbf\x11\x7b\x9a\x5b\x22\xc4\x68\x8e\x77\x4a\xd6\xb7\x6b\xdb\x58\xb1\x59\xa5\xb1\x45\xbf\xd5\xe7\xb7\xf9\x5a\xa3\x9e\xd7\xe9\xa3\xb6\x8e\x65\x7e\x9c\xab\xe9\xfc\x61\xcf\x8f\xb3\x29\xc3\xba\x4d\x0c\x35\xf9\xe6\x30\x6a\xf0\xb6\x93\x77\xae\xc5\xb3\xdf\xd7\xd2\x6d\x2b\xf3\x24\x7b\x7b\xbd\xb3\xad\xbc\x97\xd5\xa3\xec\x34\x80\x8d\x79\xbf\x56\x89\x51\xd6\xcc\x3a\xfc\x07\x6e\xf3\x9a\xfe\x1d\x9d\x49\x87\x5f\x0e\x5c\x85\x12\x2b\xb4\x69\x8b\x25\x31\xe4\xc6\xd6\x87\xc9\xd1\x2d\x82\xe8\x0a\xc3\x5a\xa8\x75\x6e\xda\xcd\xcd\x0c\x34\x1d\xa6\x56\x13\xb8\x70\x1b\xf0\x7d\x7e\x6e\x37\xd1\xe0\x60\x66\x25\xdc\xc5\x5d\x73\x1f\xf0\xef\x73\x8c\x3e\x6c\xf3\x07\x77\xe6\x4e\xa2\x65\x69\xd8\x92\xb3\x59\xa1\xbb\xf0\xd5\x20\xc4\xef\x89\x6e\x25\x7f\xda\xf0\x02\xaf\x40\x91\xc6\xbe\xbe\xa1\x14\xda\x33\xac\xbd\x98\x8a\x7b\x6b\x32\xb8\x68\x9d\x7d\xe0\x7a\xb0\x91\xf5\xe0\x5f\x6a\xa5\x54\xc9\x87\xd7\x3e\x1e\x16\xe2\x04\x70\x79\x21\xb8\x45\xcb\x19\x41\xdd\x26\x0f\x04\x87\x20\x7f\x98\xc0\x45\x10\xfe\x83\xd0\xee\x92\x72\xba\xff\xee\xba\x00\xb8\x63\xba\x2e\x6d\x65\x72\x1f\xc4\xd1\x21\x88\xbc\x60\x6f\xe1\x3c\x8e\x39\xa5\x93\x7b\x74\xae\x60\xc1\xe3\x03\x22\x24\x23\x87\x0d\xfa\x3e\xee\x94\x20\x23\x98\xea\xa2\xa2\x01\x05\x7a\xbc\x03\x45\xa7\xb1\x3b\x06\x89\x4a\x3a\x82\xc2\x39\x8f\x4c\x4a\xec\x82\x87\x32\x12\x9a\xf1\xa8\xa4\xe4\x88\x8c\xa4\xdd\xee\x91\x69\xc9\x30\x36\x0f\x85\xa9\x3f\x1d\x2a\x47\x42\x9f\xc3\xc6\x3a\x9b\xe8\xb0\x4f\x43\xef\x98\xbc\xc7\xb0\x8f\x71\x02\xba\x5a\xd1\x86\x36\x5b\x78\xfe\x7f\x08\x6e\x6f\x58\x1f\x39\x53\xc1\xed\x25\xb3\xda\x3f\x25\x78\x0e\x47\xd1\x4e\x1c\x8d\x86\x83\xd8\xa9\x91\xd4\xd2\x21\x9e\x25\xbc\x61\xc3\xa0\x7d\x98\xc5\xfe\x7a\x43\xf7\x0b\x8e\x40\xf1\xe1\x58\x1b\x04\xcf\x7e\xcc\xb5\x2d\x1c\x6b\xce\x06\xc6\x14\x75\xed\xe5\xb7\x5e\xd8\xb5\xc7\x23\x7e\x8d\x8e\x53\x39\x28\x4e\x21\x34\x58\x6e\x2a\xd0\x86\xdd\xef\x2f\x8a\xb4\xfd\x5e\xfa\x99\x28\xa3\xc0\x49\x9a\xb2\xdb\x48\x7d\xc8\xcd\xfe\x44\x31\xd3\x17\xb2\xe5\xa6\x26\xac\xd9\x48\x86\x7a\x17\x93\x89\xbb\xfb\x27\x1d\xe3\xf7\x49\xc6\x4d\x1d\x28\x2a\x08\x65\xae\x41\x37\x7a\xaf\x04\x47\x7f\x6a\x30\x5e\xdd\xfb\x58\xa5\x21\x7e\xa7\x74\xa0\x2a\x3d\xcc\xd2\xb8\x29\x47\xd4\xde\x87\xe2\xcc\xa0\xdd\x4a\x16\x06\xed\x80\x69\x47\xb0\x7d\x26\xd9\xbe\x18\xaa\xc7\xb8\x13\xff\xed\x1b\xcf\xeb\xf1\x88\x1b\x62\xf0\xb7\xe1\x2e\x9f\x82\x3a\xe4\x85\x26\xa4\x03\x64\x97\x1b\xf6\x8e\x8c\xad\xf9\x7a\xff\xb2\x78\x1c\x24\x38\xe7\x70\x34\x7f\x01\x90\xb4\xc2\x4e\x80\xe4\xa5\xc2\xf1\xc9\x38\x18\x81\x41\xf7\xc8\xc1\x1d\xd9\x5b\x08\x04\x08\x78\x11\x9b\x8f\xc4\x29\xca\xfe\xb0\x02\x0a\x83\x14\x76\x82\x68\x9c\x1f\x9f\x14\xd7\x0b\x73\xc1\xf3\x14\xbe\x6a\x57\xe8\xc4\x25\x5c\xe3\xe0\xd1\x6e\x78\x95\x6a\x76\xdc\xff\x16\xc3\x91\x8d\xbd\x63\xec\xc9\xbb\xf6\x34\x52\x37\x41\xc8\xb6\xcc\x8e\x3b\xe3\xa5\xc1\xbe\xe2\xfd\xe6\x1f\xaa\x1d\x7f\xa7\xba\x32\xff\x9a\x8d\x3a\x08\x6a\xe6\x19\xc0\x28\xdf\xb9\x9b\x09\x27\x38\x27\xba\x17\x1a\xeb\x74\xbb\x60\x13\x2d\x70\x9a\x8f\x9f\x9a\x84\xb6\xeb\x3a\x3d\x35\x4f\xf8\xcf\xa8\x69\xce\x53\xfa\x88\x13\x8d\xf4\xf5\xce\xe9\xec\x35\x2e\xe7\x29\x60\x77\x29\x98\x9d\xb0\x0d\x04\x6d\xa6\x60\x9b\x76\x2d\x80\x9b\x0f\x09\xc3\xd8\x65\x1d\x59\x7e\x36\xb3\x19\xcd\x49\x1d\xc2\x89\x1e\xa1\x13\xd0\x6d\x42\x30\xd5\x0a\x6c\x31\x78\x0c\x8d\xd8\x7f\x5b\x48\x11\x80\xee\x10\x1c\xc9\x0c\xb6\x29\x77\x12\xfe\xfc\x15\x7e\x37\x66\x98\x68\x2d\x1e\xbe\x71\x13\x00\xe6\x2c\x1c\x1f\xc9\x1e\x7e\xcf\x17\xf6\xc3\xa6\xf2\xb9\xc4\xaa\xf2\x44\x36\x71\x46\x73\x7c\x82\x33\x52\xb2\xea\x2a\xec\x36\x80\x9f\x63\xd8\x3f\x86\x5a\x46\x8c\x7b\x1e\xed\x8c\xd6\xbf\x87\xfd\x7e\x0a\x48\xe3\xee\x3f\xf5\xd5\xd9\x2c\xe0\x83\xe7\x34\x12\xb0\x55\xc6\x91\x24\x70\x4e\x31\xe3\xaf\xf0\xfb\xa6\x07\x56\xda\x09\x55\xff\xa1\xe9\x01\x0a\x6d\x95\x33\x50\x68\x3e\xde\x89\xe2\xce\x3b\x38\x15\xab\xd0\x83\x3b\xee\xeb\x0e\x52\xcf\xb1\x90\x27\xc8\x70\xb5\xf9\xe7\x4e\x11\xac\x6a\xa7\xed\x7d\x9c\x5f\xf8\x87\x9a\xa4\x11\x94\x7c\xb4\x62\x1e\xb3\xc5\xe0\xb1\x26\x4e\x49\x36\xcc\xb5\x31\xe2\x17\x15\xcc\x28\xd7\x11\xa5\xd0\xcb\x19\x6b\x28\x07\x7b\x59\xc2\x75\x99\x51\xf7\xaa\xb7\x2d\x97\x7e\xf3\x8a\xd9\xbb\x20\xd7\xaf\xa1\xa8\x76\x43\xcd\x4c\xdc\xaf\x1a\x83\xc3\x80\x2f\x4e\xd7\xca\x5f\x04\x8f\x46\xd2\xdf\x15\x1e\x2f\xe5\xcb\x5d\x10\xfc\xe6\x35\x73\xdf\xdc\xde\x55\xb2\xdd\x0e\xb8\x64\xb0\xdf\xc7\xff\x37\x00\x09\x17\x21\xc6\xe6\x54\x00\x00")
 
 func templateBuilderMutationTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -227,12 +242,12 @@ func templateBuilderMutationTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/builder/mutation.tmpl", size: 20884, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/builder/mutation.tmpl", size: 21734, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateBuilderQueryTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5b\xeb\x6f\xdb\x38\x12\xff\x6c\xff\x15\xb3\x46\x36\xb0\x03\x47\x6e\xfb\xed\x72\xc8\x1d\xba\x4d\x7b\x30\x50\x74\x1f\xed\x61\x17\x28\x8a\x5d\x46\x1a\xd9\xdc\xca\x94\x8e\xa4\x9c\xe4\x7c\xfe\xdf\x0f\x1c\x52\x12\xf5\xf2\x23\x71\x77\xbb\x9f\x62\x49\xe4\x90\x9c\xf9\xcd\x8b\x33\xd9\x6c\x66\x17\xc3\x57\x69\xf6\x20\xf9\x62\xa9\xe1\xc5\xb3\xe7\x7f\xbb\xcc\x24\x2a\x14\x1a\xde\xb0\x10\x6f\xd3\xf4\x33\xcc\x45\x18\xc0\xcb\x24\x01\x1a\xa4\xc0\x7c\x97\x6b\x8c\x82\xe1\x87\x25\x57\xa0\xd2\x5c\x86\x08\x61\x1a\x21\x70\x05\x09\x0f\x51\x28\x8c\x20\x17\x11\x4a\xd0\x4b\x84\x97\x19\x0b\x97\x08\x2f\x82\x67\xc5\x57\x88\xd3\x5c\x44\x43\x2e\xe8\xfb\xdb\xf9\xab\xd7\xef\xde\xbf\x86\x98\x27\x08\xee\x9d\x4c\x53\x0d\x11\x97\x18\xea\x54\x3e\x40\x1a\x83\xf6\x16\xd3\x12\x31\x18\x5e\xcc\xb6\xdb\xe1\x70\xb3\x81\x08\x63\x2e\x10\x46\xff\xc9\x51\x3e\x8c\x60\xbb\x35\x2f\xcf\xb2\xcf\x0b\xb8\xba\x86\x5b\xa6\x10\xce\x82\x57\xa9\x88\xf9\x22\xf8\x81\x85\x9f\xd9\x02\xc1\xcd\xd4\xb8\xca\x12\xa6\x11\x46\x4b\x64\x11\xca\x11\x9c\xb5\x3f\xf1\x55\x96\x4a\x5d\x7c\xb2\x4f\x30\x1e\x0e\x36\x9b\x4b\x90\x4c\x2c\x10\xce\x32\xa6\x97\x66\xb1\xb3\xe0\x3d\xbf\x4d\xb8\x58\xcc\x69\x94\x32\x33\x06\x83\x11\x6d\xc7\x0c\xd9\x6e\x47\x76\x1e\x8a\xc8\x7c\x9b\xd0\x52\x67\xb7\x39\x4f\x0c\xb7\x88\xc2\x8f\xe6\x14\xef\xd8\x0a\x8b\x83\x48\x0c\x91\xaf\xed\xe7\xf2\x77\x39\xc7\xec\x69\x36\x03\x9f\xcc\x76\x6b\x24\x61\xd8\x58\xbc\x89\x53\x09\xc4\x1d\x2e\x16\x34\x34\x70\x0b\x00\x0a\xcd\x35\x47\x15\x0c\xf5\x43\x86\x4d\x32\x4a\xcb\x3c\xd4\xb0\x19\x0e\x42\xe2\xdf\x70\x90\xf0\x15\xd7\x83\xc1\x05\x17\x7a\x38\x48\xe3\x58\x61\xf5\x24\x23\x94\x83\xc1\xc7\x4f\xdf\x9b\x1f\x6f\x72\x11\x0e\x07\x31\xc7\x24\x52\xe6\xa5\xd2\x92\x8b\xc5\x70\x90\x49\x8c\x78\xc8\x34\x2a\x18\x7c\xfc\x54\x3e\x05\xfe\xae\x2c\x8f\xee\xb8\x5e\xc2\x59\xf0\x3a\x5a\xa0\x63\xe4\x6c\x06\xc8\x16\x28\x2f\x93\x94\x45\xe6\x28\x68\xbe\x05\xc3\x81\x2f\x0b\x34\x7c\x0a\xec\x84\x81\xa1\x8a\xc1\x6b\x33\xe9\x6d\xca\xa2\x37\x66\x3b\xe6\x60\x17\xf6\xc3\x87\x87\x0c\xeb\x0c\x1f\xf8\xe2\x69\xfd\x9e\x5d\xc0\xcb\x28\xe2\x9a\xa7\x82\x25\x60\x0f\x07\x3a\x05\x16\x45\xe6\x8f\xc7\xf2\x00\x08\x9e\x34\xeb\x4c\xaf\xb2\xc4\xec\x2a\x93\x5c\xe8\x18\x46\x11\x67\x09\x86\x7a\xf6\xad\x9a\x91\x54\x66\x96\xd2\xc8\xe0\x47\xa7\xd2\x01\x94\xe6\xf2\x18\x96\x4c\x7d\x28\xc0\x68\x49\x95\xfb\xbc\xd7\xf5\x0f\x41\x6b\xd7\xb3\x19\x70\xa1\x51\xae\x30\xe2\x66\x1c\xad\x07\x63\x1e\x60\x00\x5a\xb2\x35\x4a\xc5\x12\x30\xe0\x9c\x04\x43\x62\x97\xb7\x05\xf0\x9f\x83\xef\x2a\xc4\x0d\x08\xcd\x71\x2e\xc2\x71\x98\x0a\x8d\xf7\xda\x28\x98\xf9\x3b\x81\x71\xcf\xa4\x29\xa0\x94\xa9\x9c\x0c\x2d\x60\x7f\x5e\xa2\x44\xc3\x38\x05\x0c\x04\xde\x41\x09\x05\x42\xab\x61\x65\x03\x8c\x05\x67\x87\x66\x5d\xbb\x4c\xa9\x0e\x85\x48\xab\xe1\x13\xbb\xc2\x38\x53\x10\x04\x41\x37\xce\x26\xcd\x49\x06\xe9\x3e\xdd\xed\x36\xf0\xf0\x7a\x0d\x2c\xcb\x50\x44\xcd\xa5\xbd\x31\x53\xc8\x54\x10\x04\x93\xe1\x40\xa2\xce\xa5\x80\xc6\x50\x77\xf8\xb7\x46\x8b\x8a\xc3\x93\x4a\x81\xd2\x98\x15\x18\x22\x21\x1d\x7c\x4e\x22\x36\xb6\x54\xb8\xd0\x7b\x0f\x65\x76\x6c\x47\x5f\xc3\x39\xfd\xd8\xb3\xdb\xef\x49\xcd\xdd\x76\x05\x58\xad\x7f\xc2\x86\x2d\xbd\xb1\xa3\x73\xe8\x96\xdd\xf0\x6b\x38\xb7\xbf\xf6\x6d\xda\x18\xa1\x6a\xcf\xf4\xf4\x84\x2d\x9b\xf9\xe3\xd4\x40\xa9\xb4\x6e\x87\xed\x9a\x16\xee\x45\x0e\x7d\x9e\x42\xba\x0f\x33\xc6\x53\x5b\x17\x48\x8e\x76\xc9\x14\x28\xbe\xe2\x09\x93\x5c\x3f\x58\x4b\x69\x6c\x21\x9d\x8a\xa3\x32\x6e\x34\x4c\x38\x0a\x1d\x90\x5d\x20\x5b\xb4\xd9\xd4\x4c\xa4\x6f\x59\xc9\x1a\x46\x0b\xfc\xd5\xf3\x44\x64\xac\x60\x9c\x31\x15\xb2\xa4\x34\x96\x46\x73\x26\x30\xfa\xb1\x74\xb5\xc6\xc2\xd0\xd3\x66\x03\xd5\xd8\xc2\xbd\x84\x4b\xc6\x85\xf5\x45\x61\x2e\xa5\x09\x2c\xac\x05\x4a\xad\x9f\x1f\x59\x3b\xec\x86\x8f\xe8\x10\xc1\x70\x70\xa0\x54\x7a\xd7\x1d\x3b\xd9\xd4\xce\x64\x05\x34\xb0\xeb\x5f\x5d\xc3\x79\xc7\x88\x8d\xf5\x73\x57\x4d\x19\x04\xf6\xfd\xb6\x98\x1f\x90\x01\xbc\x76\x26\x50\xdf\x43\xdb\x0c\xc6\x32\x5d\xfd\xbb\xcf\x82\x92\x31\x74\x06\x91\x76\x35\xe0\x31\xbd\xba\xba\x6e\x2d\x9d\x49\xcc\x98\x44\x3a\xac\x59\x6b\xf2\x77\x1a\xf9\xcd\x35\x08\x9e\xd8\xc9\x05\x72\x04\x4f\x88\xb2\x79\xe7\xfc\x9f\xf3\xa3\x78\xaf\x8d\x4b\x38\x83\xd1\x4f\x8e\xf4\xc8\x5b\x65\x64\xa0\x30\x32\xc0\x18\xcd\x23\x14\x7a\x04\x23\xda\xfe\x08\x2e\xad\x1f\x25\x84\xec\x75\x63\x86\x29\x4d\x27\x36\xd8\xe5\xa9\x2a\x6f\xeb\xd6\x71\xe7\xa0\xc5\xa7\xe6\x38\x43\x7b\x10\xf7\x9e\x96\x19\x0e\x08\xcb\xce\xc3\x19\x5d\x7f\xc3\xa5\xd2\x60\xc7\x58\xb0\xc5\xf4\xa6\x1d\xe9\x3c\x10\x69\xcf\x00\x80\x99\xff\x93\x9b\xc9\xe0\xe2\x5d\xaa\xdf\x98\xe0\xf4\xb5\x11\x0d\xdc\x2d\x51\x80\x48\xeb\x84\xee\x98\xb2\x01\xec\xc1\xd6\x83\xf6\xd7\x03\x93\x0b\x9f\xf6\xd4\x83\x84\x48\x23\xe3\x4f\x7a\x40\x61\x8d\xfe\xf3\x49\xf0\x32\x49\x08\x14\xc3\x02\x41\x1e\x2e\x5a\xa8\xd8\xd2\xa8\x04\xc5\x98\xa8\x4f\xe0\xfa\x1a\x9e\xb5\x86\x9e\xd7\x98\xb0\xb1\xa7\xaf\x22\xe7\xe0\x2d\xbb\xc5\x64\x4b\xd4\x8a\x69\x86\xda\xc7\x67\x9f\xac\xc8\x3c\xa1\xfc\x62\x73\x82\xcf\x68\x1f\xa7\x70\x9b\x6b\xc8\x98\xe0\xa1\x32\xa1\x0d\x13\xf6\xc0\x90\x86\x61\x2e\xd5\x71\x0c\xfd\xa5\x9b\xa3\x35\x86\x16\x8c\xec\xe5\x63\x29\x9a\x16\x03\xcf\xcf\xe1\x9b\xb9\x2a\x58\x31\x46\xe9\x34\x95\x76\x4f\x8f\x4d\x0e\xf8\x07\x9f\xdf\xec\xc3\xe3\xfc\xe6\x04\x58\x9c\xdf\x3c\x16\x8e\xf3\x9b\x1e\x40\xf2\xc8\x2e\x31\xbf\x21\x8b\xdf\x61\xac\xd6\x4c\x02\x8f\x14\x7c\xfc\xd4\x18\x48\x2c\xe4\x91\x43\xed\x0e\xd0\xce\x6f\x54\xb7\x25\xb3\x3c\xf3\x81\xca\x23\x1f\xa6\x96\xee\xa1\x00\xf5\xc9\x39\x39\xf1\xa8\x13\xa7\xf3\x9b\x06\x52\xe7\x37\x27\xc5\xea\xfc\xa6\x07\xad\x0d\x0e\x9a\x43\xf2\x68\x37\x5a\xad\xe4\x9e\x88\x57\x1e\x15\x71\x92\x48\x1e\x4a\xa8\x32\x50\x5c\x2c\x12\xec\xb6\x9c\x86\x2e\xdc\x3e\x54\x88\x9d\x02\x0a\x95\x9b\xbc\x0e\xb8\x86\xd4\xa7\x94\x0a\x0c\xda\x70\x7e\xcf\xc5\x22\x4f\x98\xf4\x10\x8d\xf7\x2c\xd4\x89\x09\x08\xba\x57\xe5\x0a\x44\xaa\x0b\x84\x1f\xad\x20\x45\x7e\x0b\x4c\xe2\x91\x6a\x62\x38\xf3\x25\x8c\xf6\x8b\xe3\x8d\xb6\xba\xe3\x3a\x5c\xfa\x86\xdb\xa4\xe4\x4c\x21\x3c\xbf\xf2\xa6\xd4\xac\xb0\xfd\xfe\xec\xea\x91\xc6\x3d\xc2\x98\xe5\x89\xee\x9a\x5e\x93\xe2\x2e\xf7\x50\x21\xac\xd2\x2e\xf3\x74\x2a\xd5\x22\xca\xa7\xf0\x02\x85\xa8\x3b\x05\xb2\xd7\xe0\x9b\xd9\xf3\x9b\x8e\x13\xfa\x1e\x80\xb4\xa3\x69\xbb\xdd\x6d\x97\xcb\x45\x9e\xa4\x2e\x76\x03\x47\xaa\xca\x13\xb5\xe3\xcf\xf3\x21\x2f\x0e\xf3\x21\x9e\xde\x90\x1f\xa9\x69\x0d\x8f\xe0\xda\xf9\x03\x5f\x59\x8e\x73\x31\x9e\x9a\x54\x13\x0f\x56\x90\x62\xaf\x3e\x8e\xea\xaa\x72\x3a\x3f\xe4\xa8\x9f\xc2\x0d\x55\xb2\x3f\x42\x61\x4a\x8f\xf3\x32\x49\x00\xef\x31\xcc\x35\xaa\x0a\xfe\xc0\x44\xe4\xf9\xa1\x84\x2b\x0d\x69\x6c\x96\xce\x92\x5c\x9a\xec\xae\x80\xfa\xc1\x27\x76\x56\xb6\x03\x9f\x1f\x3f\xf5\x5a\xf0\xa7\xe4\x61\x5d\xa6\xbb\x3b\xa7\x0f\x1a\x37\x6d\xa5\x43\x28\x59\x54\xc1\xe0\x65\x92\x9c\x0a\x03\x86\x6e\x37\x4b\x1a\x1c\xd9\xef\xcb\x76\xb9\xb0\x7e\x8b\xa9\xdc\x01\xe7\x37\xea\x28\x0c\xf8\x76\xee\xf0\xe3\x3a\x13\xd1\x09\x80\x2e\xfb\x74\x90\x6d\xea\xe1\xc7\x7b\x34\xb9\xf0\xb8\xa9\xeb\x74\xe3\x3c\xbf\x99\x04\xef\x43\x26\xcc\x66\xa6\x70\x6e\x4c\xd1\x31\xd8\x21\x6b\x58\x05\xac\xf3\x1b\x55\x81\x63\x7e\xa3\x4e\x05\x0e\x43\xb7\x0f\x1c\x9d\xf6\xa1\x1f\x1c\x85\x6d\x3e\xc6\x3a\x14\xd0\x78\x95\xe6\xa2\xee\x3a\x43\x7a\x43\x35\x20\x84\x05\x5f\xa3\x38\xf2\xf6\x8e\x48\xf6\xb9\x2a\xa1\x4f\xac\xfe\xcf\x8e\x55\xfe\x72\x7b\x13\x9f\x05\x95\x8c\xe9\xf1\x54\x52\xb6\xb4\xbb\x99\xc1\x85\xab\xf5\xe4\x8e\x29\x5d\x7c\xf0\x76\x7b\xb0\x74\x89\xa2\x3b\xdc\xeb\x7b\xee\x5f\xd6\xc8\x1c\xcd\x71\x2a\x1b\xb0\x64\x0a\x30\xc1\x15\x0a\xad\x8a\x00\x69\x21\x59\xb6\x3c\xf8\x88\xb4\x42\x8f\xb8\x6f\xd3\x34\x39\xb1\xbc\x63\x96\x28\x3c\x56\xe6\xe5\x1e\x27\x3e\x5b\x2a\x99\xd3\xe3\xa9\x64\x6e\x69\x77\x73\xc4\x30\xc4\x9c\x06\xed\x82\x3d\xcc\xf0\xb6\x7b\xb0\xd0\x89\x62\x81\xe8\xc4\x04\xac\x95\x69\x8f\xf2\x2c\xb1\xc5\x1e\xa7\xd6\xdd\xb5\x9e\x29\x70\x11\x26\x39\xd5\xfa\x58\x92\x00\x53\x2a\x0d\x39\xd3\x18\xd1\x5d\xbe\x0a\x60\xae\x21\x64\x02\x6e\xd1\xac\x92\x2b\xa4\x3a\x9c\x13\x1d\x84\xe9\x6a\x95\x3a\x63\x51\x90\x54\xe4\x64\x72\x85\x66\xd9\x15\x44\x3c\x8e\x51\xa2\x30\x31\x35\x8b\xb5\x2b\x59\x87\xb4\x5d\xae\x60\xc5\x22\x3c\x5c\xb5\xcc\xac\x71\x67\x59\x80\xc7\x4d\x96\xc2\x75\x97\xed\xf7\xf9\x77\x5e\x27\x63\x06\x16\xb7\xd3\xad\x32\x83\xfd\x30\x1d\x0e\x6c\x81\xf6\x0a\x06\xdd\x25\x1f\x33\xc2\x96\x4f\x3a\x88\xd8\x0f\x34\x44\x46\x28\x0d\x11\x57\xb6\xf0\x6a\xba\x9b\xed\xb4\x85\x0e\x1a\x1e\x04\xc1\xc4\xcc\xad\x8a\x61\x57\x50\x4e\xef\xae\xbf\x75\xd1\xaa\xa6\x17\x04\x9b\xb5\xdd\x5a\x49\xb8\xaf\xc2\xdb\xbe\xc1\xef\x19\x18\x38\xa9\x4d\x1b\xa5\xdf\xc1\x6c\x56\xc0\xa0\x55\x3f\xb5\x15\xe7\x9a\x3e\x77\xaf\xe7\x0d\xf0\xd7\xc9\x98\x5e\xb6\x27\x98\xb7\x53\x97\x36\xef\x3a\x33\x1d\xc4\x6f\x19\xe8\xac\x63\xcf\x66\xf0\x33\xd7\xcb\xce\xaa\x8c\xc6\x24\xf1\xc2\xae\xcb\x82\x98\x4e\xbd\xfa\x3a\x7d\xa7\x98\x0d\xf4\x92\x69\xb0\x0a\x25\x04\x86\x9a\xb4\x8c\x96\xe8\xad\xe1\xc0\x07\x93\xf3\x66\xae\x52\xce\xe4\x22\xb7\x06\xdd\x50\x29\xd4\xd4\x82\x36\x97\xe8\x59\xff\x62\x2b\xce\x2c\x1c\x57\x0f\xea\x3b\xf0\x38\xcd\x34\x95\x81\xa9\x5c\x73\x51\x63\xe0\x76\x3b\xe9\xd4\xd8\x66\x9d\xe8\xa8\x1a\x51\x9c\x4a\xf8\x75\x6a\xce\x4f\x4d\x1b\x24\x48\xda\x03\x55\x6b\xd2\x4c\x8f\x89\xfa\xc4\x55\x37\x0e\x84\x2a\x5c\x17\x05\x90\xbe\x4a\x21\x55\x46\x4a\x0c\x53\xf7\xc8\x42\xa6\x79\xf6\x9d\x57\xd6\x73\xcc\xb1\xbc\xf9\x5f\x59\xcc\xf9\x56\xfd\x8b\x46\xda\xaa\x9e\xb1\xa6\xee\xd9\x98\xc1\x42\x62\x44\x0c\xd6\x28\x35\x0f\x51\xc1\xad\xbd\x87\x48\x25\xac\x52\x89\xae\x1f\x62\x16\xa6\x49\xbe\x12\x8a\x6e\x20\xe6\xda\x4c\x4f\x63\x8d\xc2\x12\xa1\x62\x14\x5b\x2c\x24\x2e\xa8\xd4\x9f\x8b\xd0\x60\x44\x4d\xc9\xed\x11\x4f\x7f\x4f\xb9\x80\xf1\x67\x7c\x50\xd5\xc0\x09\x8c\xa6\x30\xa2\xe4\xaf\x2c\x69\x25\x28\xe0\xcc\x06\xd8\xca\xf6\xca\x5c\xc2\x59\x6c\xce\xc8\x45\x84\xf7\xd5\xb7\x67\xe6\xeb\x6c\x66\xbd\x2c\x5b\x65\x09\x5e\xd9\x47\x8a\xf4\xd7\x40\xcd\x29\xb6\xcf\x65\x36\xb3\xd2\x88\x83\xf7\xf4\xaa\x64\xbd\x7d\x59\x84\xbf\xbf\xf9\x63\x3e\xb0\x05\x6c\xb7\xbf\xd1\x5c\x1b\xbc\x9a\x38\xea\xb7\xdf\x55\x2a\xae\x46\x36\x96\x4a\x57\x5c\xe3\x2a\xd3\x0f\x23\x1a\xe6\x76\x33\x70\x25\x5a\x0f\xb2\x65\x8e\x6b\xc3\x8e\x49\x40\x54\x9d\x24\x5a\xc9\x85\xdd\xc5\xab\x54\x28\xcd\x84\x36\x50\xb6\xe3\x5f\x16\x6c\xa3\x19\xd9\xe7\x45\x15\xb7\x4d\xdc\x10\x2f\x1d\x59\x4f\xcc\x76\x3c\xdc\x1c\xa8\x6d\xc5\xae\x48\xec\x60\xfb\x7b\xa6\x45\x53\x4c\x10\x04\xf6\x8d\x53\xae\x1a\x0c\xad\x86\x59\x30\x15\x0a\xd6\x18\xb0\x5f\xc9\x68\x42\xe0\x96\xbb\xae\x5c\x8d\x5d\x76\x43\x1f\xb6\xc5\x7e\x6c\x7d\xdd\x4e\xd9\x5f\xba\xcd\x24\xae\x0f\xae\xdc\x3e\xa9\x70\xdb\xae\xdb\x6e\x7b\xb5\xbb\xe9\x51\x2a\xba\xd3\x66\xe8\x40\x07\x1d\x3a\x0b\xa0\x28\x33\x3d\xc8\x04\xd8\x24\xb6\xb4\x00\xf6\xd1\x44\x5e\xe9\x9d\xf5\x17\x96\x16\x4f\xc5\x0e\xc5\x2f\xfb\x77\xbc\x54\x6d\x3a\xa4\x46\x24\xa5\x91\x45\xc6\xb6\x3b\x3a\x2e\xac\x73\x42\x74\xc1\xbe\xbd\xf8\xff\xba\x15\xfd\x58\x0d\xee\xb9\x1d\xe8\x53\xe0\x13\x68\xa7\x5b\xf1\x20\xe5\xac\x23\xa4\xbb\x91\xe5\x28\x45\xf3\x23\xd8\x26\xf1\x4d\x23\xd6\x6f\xa9\x38\x50\x04\x74\xe0\x31\x9b\x3a\xd6\xd6\x66\x9b\x31\x6d\xca\x06\xbe\xef\x8b\xa0\xa4\xcc\x12\x96\x18\x7e\x56\x90\xa1\x04\xd7\xd1\xd0\x6e\xde\xdb\xd5\xf4\x60\xc9\x10\x95\xe3\x3b\xf8\x0e\x69\xce\x70\x80\x19\x15\xa6\x7c\xb8\xbb\xa7\xa2\xaf\x81\xb1\x9d\x83\x58\x53\xe8\x27\x72\x12\xd7\xbd\x39\xa0\x19\xec\x52\xc0\x8e\x1c\xb0\xcc\xfa\x4a\x2b\xb6\xc7\x7c\x11\x4f\x71\x4d\x66\xcb\xf1\xe9\x2c\x78\x97\xaf\x7e\x48\x13\x1e\x3e\xd8\xa3\xb8\x2d\xfb\x2a\xe3\x3e\x5f\x77\xae\x9c\x4a\x15\xbc\xc3\xbb\xf1\xa8\xf2\x7b\x57\x90\x0b\x2e\xb8\xe6\x2c\xe1\xff\xc5\xa8\x8f\xde\x38\x4e\xe5\x22\xd5\x26\x4e\x71\x1d\xbe\x15\x89\x99\xcc\x85\xe6\x2b\xfc\xe7\x64\x54\x44\x6c\x75\xa3\xdf\xa6\x17\xbc\x5e\xb3\xa4\x04\x65\x2b\xcb\xe9\xf7\x04\x25\xfb\x7c\xc9\x79\xf9\xa1\xb5\xe9\x0d\xd4\x38\xe5\x1a\x55\xaa\xd1\x81\x99\xcd\x01\x1d\x3c\x6d\xfc\x76\x83\xcc\x6b\xbf\xa1\xce\x34\xf2\x37\xb7\x55\xf4\x5e\xb6\x66\x5b\xb7\xfe\x53\x67\x07\x73\xc3\xe3\x97\x6d\xcc\xcd\x50\xc1\xf5\x32\xd3\xeb\x4b\x6f\x11\xe3\x66\x36\x7b\x7a\x99\x9b\xb4\xda\x0d\xcd\xce\xae\x55\x0d\xca\xb1\x50\x00\x00\x1f\x3f\x95\x51\x94\x6d\x65\xfe\x6a\x3b\x69\xcb\x7d\xda\x6e\xc7\xca\xf3\x16\xd1\xb3\x71\xd7\x65\xa0\x5d\xf4\x3f\x96\xec\x6c\x5d\xa5\xd6\x45\x56\x18\xdd\x06\x27\x27\xd5\xb2\x63\xc3\xb1\x20\x08\x6a\xfc\xea\x0f\xfb\xba\x96\x08\x0c\x89\x5a\x93\x64\xd7\x88\x29\xc4\xa2\xdd\x5d\xdb\x1c\xe9\xb8\x62\x5c\xa8\x21\x98\x70\x54\x1d\x07\xa6\x5b\x20\x15\x32\x77\xc5\x2c\x51\xe5\x09\x05\xee\xa9\xc7\xc0\x35\x4b\x72\x7c\x04\x6b\x0a\xf7\xdd\x74\x46\x53\x58\x5b\x0c\xc5\x2c\xc4\xcd\xd6\xf3\x4d\x45\x3b\x45\x65\x53\x5a\x0c\xf0\xcc\x6f\x6f\xd3\x40\x71\x07\xd9\x49\xa0\x6d\x7f\x5d\x36\xb9\x83\x99\xcd\x49\x55\x60\xb2\x9e\x78\x8c\xae\xee\x2d\xcd\xd3\x11\xd7\x96\x47\x30\xb4\xf3\xfe\xb2\xc5\xd1\xd6\x95\x6e\xeb\x44\xfe\x11\x5a\x66\xb8\x7e\x93\x69\x6d\x98\xbb\x87\xd1\xce\x70\xae\xb8\xe6\x6b\xef\x2a\xc6\x95\x27\xbd\xb8\x5a\x9b\x98\xda\xbe\x75\x37\x31\xde\xb8\xed\xb6\xbc\x06\xed\xa8\x71\x9a\x18\xd0\xb6\x85\x15\x70\x0d\x80\x28\xb8\x44\x5a\x24\x0f\x36\x1c\x37\xa9\xb4\xed\x07\xc0\x30\xb7\x81\x74\x13\xe1\xe4\x24\x4c\x98\x4e\x26\xae\x76\x8b\x72\x20\xdb\x6b\xdb\xde\x59\x49\xd3\x8d\x12\x5a\xd1\xbd\xd5\xad\xca\x64\x72\x27\xf0\x0f\x78\xde\x99\x05\x75\x7a\xf1\x8e\x0d\x06\x75\xb6\xba\xf6\x07\x16\x2e\x39\xae\xd9\x6d\x82\x96\x43\x34\xc9\x30\x88\x52\x15\xbd\x64\x02\x9e\x5b\x96\x94\xde\xbc\xc8\x0e\x8a\x93\xb4\x1c\xfc\x0e\x10\x9d\x77\xa0\x68\x77\x5a\xb7\x2e\xd3\xb5\x36\x36\x2a\x45\xaa\xbd\xde\xab\x51\x4f\x94\xed\xce\xba\x9f\x2e\x6e\xc4\xd6\xbb\x0d\x54\x0b\x2d\x3d\x81\xa2\xaf\x63\x35\xbe\x58\x96\x50\xce\xe0\x1a\x28\xea\x5a\x75\xe9\x69\x53\x39\xc2\xd3\xa7\x5a\xf7\x5a\xa5\x49\x4d\xc5\x08\xfe\x5c\x85\xf2\x76\xde\xa3\x52\xbf\x42\x4d\xa5\x7c\xb5\xea\x46\xea\xda\x6f\x96\x39\x40\x2e\x7d\x80\x75\xf2\xf0\xba\x66\xd6\x76\xd9\xaa\x69\xa6\x14\x56\xd5\x67\xe6\xf5\xce\x1c\xdb\x9f\xe9\x75\xcf\xb8\xa9\xf1\x4a\x07\x34\x2b\x3e\x56\xfd\xed\xc6\x30\x82\x6f\x23\xe7\xce\x95\x95\xa8\x91\xd8\x1d\x53\x80\xf7\x19\xdd\x5d\x8f\xa6\xee\x68\x75\xfc\xd5\x14\xd2\x13\x52\x5d\x25\xbd\x0f\x5f\x48\x29\xfd\xa5\xfb\x9b\x75\x8e\x51\xca\x06\xe2\x1e\xa3\x96\xb5\x80\xbf\x3f\xfd\x68\x86\xf4\xfb\x92\x0e\x1a\xff\xd8\xa4\xc3\x5e\x2c\x74\xe4\x1c\xf6\x43\x77\xd2\xd1\xbc\x8d\x28\xb3\x8e\xd6\x1d\x48\xc7\xbf\x50\x56\xb7\x56\x2e\x6b\x70\xce\xbb\x71\xf5\xd3\x95\x88\xb4\xc8\x57\x99\x48\xe3\x4e\xe3\x4b\x65\x1a\xdb\x61\x77\x5c\x6c\x77\x56\xfc\x83\xe8\xe3\xe2\xe2\x06\xc7\x0b\x7c\x37\x0f\xfd\x98\xc8\xb8\xee\x88\x5b\x0b\x1d\x5e\xa6\xf7\x63\xe3\x36\x99\x76\x70\xdc\x3f\xee\xb6\x6e\x82\x3a\xaf\x64\x6b\x51\xf5\xde\xe5\x4e\x1d\x56\x1f\x25\x8f\x47\x06\xd6\xed\x43\xfd\x45\x22\x6b\x56\x42\x3e\xe8\x8d\x02\x2a\x4d\xef\xf6\xf7\x07\x33\xf8\x34\x21\x74\x9b\xd7\x8f\x8e\xa1\x9b\x5b\x3c\x2c\x88\xae\xf8\xf1\x84\x28\x7a\x17\x62\xbe\xba\x30\xfa\x71\x12\x7e\x4c\x20\xdd\x6d\x1d\xbe\xce\x48\xfa\x0f\xd6\x9c\x2f\x1d\x2b\x1f\xc2\xfa\xbf\x68\xb0\xbc\x47\xcf\xbf\xea\x68\xf9\xb1\x18\x39\x3e\x5e\xee\x06\xc0\x1f\x17\x30\xb7\xc2\xd1\x7d\x11\xb3\x72\x15\xda\x47\x84\xcc\xc5\xcf\xff\x07\x00\x00\xff\xff\x67\x80\x2a\xfd\xf6\x45\x00\x00")
+var _templateBuilderQueryTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x5c\xdd\x6f\xdb\xb8\x96\x7f\x96\xfe\x8a\x33\x46\x26\x90\x0b\x57\x4e\xe7\x6d\x33\xc8\x2e\xba\x4d\xba\x6b\x60\xd0\xce\x9d\x76\xf7\x0e\x10\x04\x1d\x46\xa2\x6c\xde\xca\x94\x2a\x52\x6e\xb2\x5e\xff\xef\x17\x87\x1f\x12\xf5\x65\xcb\xa9\x33\xb7\xc0\x3c\xc5\x12\xc9\xc3\xc3\xc3\xdf\xf9\x22\x8f\xb2\xdd\xce\x5f\xf8\x6f\xb2\xfc\xb1\x60\xcb\x95\x84\x9f\x2e\x5e\xfd\xdb\xcb\xbc\xa0\x82\x72\x09\x6f\x49\x44\xef\xb3\xec\x33\x2c\x78\x14\xc2\xeb\x34\x05\xd5\x49\x00\xb6\x17\x1b\x1a\x87\xfe\xc7\x15\x13\x20\xb2\xb2\x88\x28\x44\x59\x4c\x81\x09\x48\x59\x44\xb9\xa0\x31\x94\x3c\xa6\x05\xc8\x15\x85\xd7\x39\x89\x56\x14\x7e\x0a\x2f\x6c\x2b\x24\x59\xc9\x63\x9f\x71\xd5\xfe\xcb\xe2\xcd\xcd\xbb\x0f\x37\x90\xb0\x94\x82\x79\x57\x64\x99\x84\x98\x15\x34\x92\x59\xf1\x08\x59\x02\xd2\x99\x4c\x16\x94\x86\xfe\x8b\xf9\x6e\xe7\xfb\xdb\x2d\xc4\x34\x61\x9c\xc2\xe4\x4b\x49\x8b\xc7\x09\xec\x76\xf8\xf2\x2c\xff\xbc\x84\xcb\x2b\xb8\x27\x82\xc2\x59\xf8\x26\xe3\x09\x5b\x86\xbf\x92\xe8\x33\x59\x52\x30\x23\x25\x5d\xe7\x29\x91\x14\x26\x2b\x4a\x62\x5a\x4c\xe0\xac\xdb\xc4\xd6\x79\x56\x48\xdb\xa4\x9f\x20\xf0\xbd\xed\xf6\x25\x14\x84\x2f\x29\x9c\xe5\x44\xae\x70\xb2\xb3\xf0\x03\xbb\x4f\x19\x5f\x2e\x54\x2f\x81\xc4\x3c\x6f\xa2\xd8\xc1\x2e\xbb\xdd\x44\x8f\xa3\x3c\xc6\xb6\xa9\xe2\xff\xec\xbe\x64\x29\x4a\x4b\x51\xf8\x1b\xae\xe2\x1d\x59\x53\xbb\x90\x82\x46\x94\x6d\x74\x73\xf5\xbb\x1a\x83\x3c\xcd\xe7\xe0\x92\xd9\xed\x70\x27\x50\xb4\xf6\x4d\x92\x15\xa0\xa4\xc3\xf8\x52\x75\x0d\xcd\x04\x40\xb9\x64\x92\x51\x11\xfa\xf2\x31\xa7\x6d\x32\x42\x16\x65\x24\x61\xeb\x7b\x91\x92\x9f\xef\xa5\x6c\xcd\xa4\xe7\xbd\x60\x5c\xfa\x5e\x96\x24\x82\xd6\x4f\x45\x4c\x0b\xcf\xbb\xbd\x7b\x8f\x3f\xde\x96\x3c\xf2\xbd\x84\xd1\x34\x16\xf8\x52\xc8\x82\xf1\xa5\xef\xe5\x05\x8d\x59\x44\x24\x15\xe0\xdd\xde\x55\x4f\xa1\xcb\x95\xef\x31\x2e\x69\xa1\xc6\x2d\xf0\x57\x44\x73\x99\x15\x5a\x74\x5f\x99\x5c\xc1\x59\x78\x13\x2f\xa9\x91\xef\x7c\x0e\x94\x2c\x69\xf1\x32\xcd\x48\x8c\x2b\xa4\xd8\x16\xfa\x9e\xbb\x45\x14\xc5\x17\xea\x01\x1e\x4e\x46\xc3\x1b\x1c\xf4\x4b\x46\xe2\xb7\xc8\x25\xae\xf7\x85\x6e\xf8\xf8\x98\xd3\xe6\x3e\x78\xee\xae\x75\x7e\xcf\x5f\xc0\xeb\x38\x66\x92\x65\x9c\xa4\xa0\xd7\x0c\x32\x03\x12\xc7\xf8\xc7\xd9\x89\x10\x14\x6a\xd5\xa8\x33\xb9\xce\x53\xe4\x2a\x2f\x18\x97\x09\x4c\x62\x46\x52\x1a\xc9\xf9\x8f\x62\xae\x36\x6b\xae\x29\x4d\xe0\x2c\xfc\x20\xb3\xc2\xe0\x56\x8d\x65\x09\xac\x88\xf8\x68\x31\xaa\x49\x55\x7c\x3e\x54\xe0\xd5\x0d\x61\x87\xeb\xf9\x1c\x94\x88\xd7\x34\x66\x48\x40\xcd\x07\x01\x0b\x69\x08\xb2\x20\x1b\x5a\x08\x92\x02\xc2\x7a\x1a\xe2\xc8\x06\x0b\xe0\x3e\x87\xff\x59\xc1\xc5\xf7\x70\x00\x24\x25\x8f\x82\x28\xe3\x92\x3e\x48\xd4\x3b\xfc\x3b\x85\x60\x60\xd0\x0c\x68\x51\x64\xc5\xd4\xd7\x38\xfe\xfb\x8a\x16\x14\x05\x27\x80\x00\xa7\x5f\xa1\x42\x88\x02\xb1\x5c\x75\x30\x6a\x25\xeb\xe3\xbc\x10\x34\x34\xc6\x6e\x69\xdd\x7d\xaa\x67\x08\x72\x01\x61\x18\xf6\xc3\x6f\xda\x1e\x84\x0a\xe0\xd2\xdd\xed\xea\x91\x02\xae\x80\xe4\x39\xe5\x71\x7b\x6a\xa7\xcf\x0c\x72\x11\x86\xe1\xd4\xf7\x0a\x2a\xcb\x82\x43\xab\xab\x59\xfc\x2f\xa8\x5c\x76\xf1\x4a\xd3\x40\x48\x9a\x5b\x0c\xa9\x4d\x1a\xbd\x4e\x45\x2c\xd0\x54\x18\x97\x07\x17\x05\xbb\x5d\xa8\x7b\x5f\xc1\xb9\xfa\x71\x80\xdb\xf7\x4a\xfb\x0d\xbb\x1c\xb4\x31\xf8\x06\x86\x35\xbd\xc0\xd0\x19\xcb\xb2\xe9\x7e\x05\xe7\xfa\xd7\x21\xa6\xd1\x36\xd5\x3c\xab\xa7\x6f\x60\x19\xc7\x07\x19\x42\xa9\x32\x7a\xe3\xb8\xc6\xde\xc3\xc8\x51\xcd\x33\xc8\x46\x60\x06\x6d\x15\xe8\x1e\xda\xe6\x73\xf4\xc4\xca\x98\x5b\xaf\x69\x94\xbb\x01\xf1\x10\x16\x12\xd8\x3a\x4f\xe9\x9a\x72\xa9\x47\x52\x2e\xb5\xd5\xd3\xb6\x21\x21\x11\x1d\x2d\x09\x64\x23\x98\x82\xb6\xf2\xb0\xad\x98\xc6\xf7\xee\xc4\x86\xeb\x0f\x54\x2a\x7c\x82\xa0\x66\x72\x85\x38\xed\xe8\xed\x2e\x9c\x98\x45\x3b\xa7\xab\x13\x87\x75\xa0\xe2\xd7\xc0\xbd\x62\xd8\x00\xef\x99\x39\xee\x51\x8a\x31\x4a\xa0\xb9\x7e\x1d\xc7\x06\xef\x0a\x66\x9a\xa1\x25\xdb\x50\x83\x7c\xf4\x95\x28\x3c\x74\x5c\xa2\xa9\x01\x27\x5e\x89\xe5\x44\xeb\x4a\x35\x7e\xbb\x9b\x6a\xf3\x8f\x88\x41\xfb\xfe\x69\x06\x09\x47\x9f\xa8\x03\xab\x0c\xdf\x7b\xc9\x0c\xb2\xcf\xf8\x32\xe1\x61\x50\xeb\x99\xef\x79\x2c\x81\x1f\xb2\xcf\xaa\x93\x05\x5c\xb2\x96\xe1\x0d\x92\x4c\x82\x89\x0d\x03\x77\xbb\x4b\x28\x39\x7d\xc8\x69\x24\x69\x6c\xb4\x5e\xa9\xc8\x8f\x1f\x95\x5b\x69\xb2\x3b\x41\x26\xa6\xbe\xe7\x69\x7f\xfa\x14\xcd\x4d\xa6\xbe\xb7\xab\x94\x80\xb3\xb4\xde\x11\xe3\xe1\x3a\x3b\xe2\x78\x94\x67\xde\x09\xd7\x03\xee\xdd\x8a\xbc\xde\x89\x5c\xe0\x7b\x4f\x7c\x65\x32\x5a\xe9\x86\x3c\x0c\x50\x86\x4a\x89\xbc\x08\xc3\xec\x7e\x77\x7a\x69\x23\x2e\x57\x4e\xce\x6a\x07\x65\x59\xf7\x99\x41\x3e\xb5\x93\x20\xe2\x86\x82\x89\xe9\xe9\xe6\x8a\x69\x42\xca\x54\x5e\x1e\x07\xad\x8a\xcc\x7e\x78\xe5\x06\x5d\x1d\x88\x60\x46\xa6\x8d\xb6\x4a\xa8\x56\x44\x80\x60\x6b\x96\x92\x82\xc9\x47\x1d\xfa\xd2\x78\xa9\x95\x94\x51\x81\xe9\x52\x94\x32\xc4\x83\x0a\xf4\x54\x70\xb9\xdd\x9a\x3d\xd3\x31\xaf\x1b\x2a\x23\x23\x38\xfe\x93\xe5\xc6\x46\x9f\x10\xe4\x44\x44\x24\xad\xa2\x5f\xcc\x0f\xa6\x30\xf9\x5b\x95\x52\x79\xf3\x39\xa8\xa7\xed\x16\xea\xbe\x66\x8b\x21\x5a\x11\x66\xfc\x4f\x54\x16\x05\x26\x90\xc8\xe2\x23\x64\x3a\x9f\x53\xaa\x58\x75\x9f\x00\x32\x11\xfa\xde\x48\xcc\x0e\xce\x1b\x18\x67\xdb\x58\x93\x8e\x13\x3c\x3d\xff\xe5\x15\x04\xe7\x4e\x54\x6f\x06\xbe\x51\x42\xdb\xea\xac\xe6\xb2\xed\x5a\x43\xfd\x7e\x37\xd5\xf6\x2e\x98\x5a\x72\xa1\x0a\x70\xaf\x4c\x88\x2b\x1f\xa0\x1b\xe6\x26\x45\xb6\xfe\x9f\xa1\x08\x59\x05\xbb\x26\xe0\x55\x4c\xa2\x05\xc3\x57\x97\x57\x1d\x1e\xf2\x82\xe6\xa4\xa0\x9a\x83\x48\x3e\x4c\x7f\xc6\x81\xf0\xc3\x15\x70\x96\xea\xc1\x0e\x78\x14\x65\x7c\x67\xf2\x1b\x93\x27\xd1\x07\x89\x21\xff\x19\x4c\x7e\x33\xa4\x27\xce\x2c\x13\x44\xc6\x04\x73\xa3\xc9\x22\xa6\x5c\x4e\x60\xa2\xd8\x9f\xc0\x4b\x44\x8b\x51\xa5\x83\x69\x0a\x0a\xa5\x9d\xa4\x78\xfb\x32\x91\x3a\x9b\x32\xf3\x98\x75\xa8\xc9\x67\xb8\x3e\x63\x7c\xcd\x7b\x25\x7b\xd4\x96\xed\xd6\x66\x30\xe8\xde\xde\xb2\x42\xc8\x46\xec\x93\xa8\x37\xae\xf1\x41\xaf\x85\xaa\x83\xa4\x5d\xa3\x8a\xe3\x7f\x33\x23\x09\xbc\x78\x97\xc9\xb7\x78\x26\xa1\xd4\x1b\xbe\xae\x28\x07\x9e\x35\x33\xe5\xaf\x44\xe8\x73\x8b\xd1\xa6\x56\xf1\x37\x00\x93\x17\x2e\x6d\x9b\x03\xe1\xae\x62\xf8\x26\x66\x43\xa0\x50\x41\x53\xf0\x6a\x1a\xbe\x4e\x53\xa4\x3c\xf5\x2d\x82\x1c\x5c\x74\x50\xb1\x53\xbd\x52\xca\x03\x45\x7d\x0a\x57\x57\x70\xd1\xe9\x7a\xde\x10\xc2\x56\xcd\xed\x1c\x98\x84\xbf\x90\x7b\x9a\x36\x8d\x16\x52\xbb\xbd\xb8\x9b\x59\xf3\x65\x37\xe5\x77\x3c\x80\x48\xd9\x67\xaa\x1f\x67\x70\x5f\x4a\xc8\x09\x67\x91\x00\x96\x00\xe1\xc6\xd5\x64\x51\x54\x16\xe2\x38\x81\xfe\xde\x2f\xd1\x86\x40\xad\x20\x07\xe5\x58\x6d\x4d\x47\x80\xe7\xe7\xf0\xc3\x42\x58\x51\x04\xb4\x30\x9a\xaa\xb8\x57\x8f\x6d\x09\xa0\x6b\xdf\x6e\x71\x5d\x67\xe1\x7f\x13\xf1\x9e\x53\x75\x9e\xb0\xb8\x46\x9c\x5a\x89\x2c\xae\x0f\x01\x75\x71\x7d\x02\x90\x2e\xae\x9f\x8a\xd3\xc5\xf5\x00\x52\x59\xac\xf9\x5c\x5c\x2b\x0b\xda\x63\xc5\x36\xa4\x00\x16\x0b\xb8\xbd\x6b\x75\x54\xb2\x65\xb1\x81\xf3\x1e\x34\x2f\xae\x45\xbf\x89\xd3\x32\x73\x11\xcc\x62\x17\xbf\xb8\x71\x57\xa3\x91\xeb\x92\x33\x1b\xc8\xe2\x5e\x00\x2f\xae\x5b\x10\x5e\x5c\x9f\x14\xc4\x8b\xeb\x01\x18\xb7\x24\x88\x8b\x64\xf1\x7e\x18\x2f\xae\x4f\x00\x64\x16\xfb\x6d\xf3\xfa\x9e\xa7\x8f\x15\x68\x09\x08\xc6\x97\x29\xed\x37\xae\x08\x37\xb8\x7f\xac\xb1\x3b\x03\xca\x45\xa9\x72\x41\x26\x21\x73\x29\x65\x9c\x86\x5d\x60\x7f\x60\x7c\x59\xa6\xa4\x70\xb0\x4d\x1f\x48\x24\x53\x0c\x21\xfa\x67\x65\x02\x78\x26\x2d\xd6\x8f\x56\x15\x7b\xf2\x09\xa4\xa0\x47\x2a\x0c\x4a\xe6\x39\xec\xfa\x4f\xc7\xdb\x75\x13\x90\x3b\xb6\x7d\xeb\xeb\x60\xfc\xd5\xa5\xef\xf5\x1b\x6a\xdd\x7e\x71\xf9\x44\xfb\xef\x44\xc6\xed\xe1\x8d\x5d\x1c\xa6\x60\x4f\x01\x50\x8e\xb5\x9e\xe1\xd3\xa9\x94\x0c\x69\x9d\xc4\x51\xd8\xad\xee\xdd\x90\xa7\xfb\x04\x24\xbb\xb8\xee\x59\xba\xd5\x12\xd4\x24\xa5\x36\x0d\x6e\x71\x08\x77\x3c\xc4\xb7\xe9\xd1\xe2\xfa\x09\x3a\xf4\x8d\x6a\xf3\xaf\x73\x33\x3f\x8d\x73\x33\x8e\x42\xb1\xb8\xad\x4e\x2c\x86\x2b\x9c\xe9\xf6\xe2\xce\xbc\xbe\xb8\x3c\xda\x0b\x39\xfa\x53\x0f\x1c\xad\x39\x96\xd7\x5a\x83\x5c\x5f\xa5\x9f\x4f\xa9\x45\x27\xf2\x54\xf5\xde\x1f\xa1\x49\x3d\x4e\x09\x2f\x22\xe9\x03\x8d\x4a\xcc\xe6\x2b\x45\x00\xc2\x63\xc7\x55\xa5\x4c\xa8\xa3\x44\x4c\x55\xd3\xb2\x20\x69\x0d\xfa\xd1\x6b\x37\x86\xb8\x07\xa9\xb7\x77\x83\x46\x9e\x25\x43\xeb\x3f\x9c\xcd\xf5\x59\xf7\x2f\x4a\x96\x98\x02\x32\x7d\xe8\x15\x0c\x65\x9e\x33\xf8\xa2\xd3\xf3\x29\x04\xff\x4b\xd2\x92\xba\x6c\x79\xc6\x27\xeb\x53\xb4\x2f\x61\xd0\x5e\x6d\xff\x51\x9a\xca\x0a\x46\x1c\x7a\x28\xea\xf6\xc0\x63\x32\x83\x2f\xf6\xec\xcc\xd0\x51\xed\xa1\x9b\x12\xc3\x6e\x57\x3b\xba\xdd\xd4\xf7\x36\x15\x70\x30\x6d\x75\xee\xfc\x94\xc2\xce\xda\xe2\x9c\xc1\x97\xa2\xf3\x32\x64\x38\x4c\xf4\xe2\xab\x4f\xb8\xc6\x23\x6b\xa1\x6c\xc2\xf6\xbe\x4e\x7d\x57\x26\x47\x8a\xc4\x9e\xfe\xe8\x61\x34\xd6\xa1\x3d\xab\xd7\x35\x99\xc1\xa6\xe3\x35\x84\x1b\x87\xbe\x4e\xd3\x5a\xaf\x5f\xa7\xe9\xa9\x94\x1a\xe9\xf6\x23\xfb\xf6\xae\xd7\x37\x0e\x47\x2d\xf5\x1e\x8e\xd5\x68\x25\xf3\x43\xce\x71\x71\x2d\x8e\xd2\xf1\x9a\xe3\xc5\xf5\x78\x39\x18\x67\xd0\x15\x43\xd0\x71\x30\xb3\xd1\x5e\x68\x40\x50\x1f\x28\x5e\xec\x06\x6d\xab\x6e\x96\x3d\x0d\x3f\x44\x84\xe3\x9e\xcc\xe0\x1c\x9d\xce\x28\xdb\x60\xde\xb1\xb8\x81\x9a\xc5\xb5\xa8\x51\xb3\xb8\x16\xa7\x42\x0d\xd2\x1d\x42\x4d\x4b\x10\xc8\x31\x8b\x87\x51\x63\xbd\xf0\x78\xd4\xb0\x58\x74\x1c\xc1\x9b\xac\xe4\xcd\x70\x29\x52\x6f\xcc\x7d\x8c\xbe\xe5\x38\xee\x36\x4f\x91\x1c\xc0\x04\xe3\xd2\x45\xc1\x09\x0c\xfd\xc5\x5f\xc2\xcc\x57\x32\xfd\x73\x0d\xbd\x23\x5c\x05\x0b\xc7\xcc\xe3\x1d\x5a\x9f\x69\xbf\x78\x26\xc3\x6e\xe6\xaf\x55\x54\x89\xa4\x56\x52\xf5\x78\x2a\x35\x55\xc4\x06\x14\x95\x71\x53\xc9\x53\x72\x39\xa8\x9c\xee\x7e\x8d\x55\x4f\xb5\x42\xb3\xb8\x9b\x07\xe6\x9e\xc9\x16\x25\xc5\xe5\xd4\x46\x1c\x6f\x34\xa8\xbd\xcb\x32\xb9\xcc\xb2\x20\xf9\x6a\xf4\x12\xd5\x0c\xfd\x2b\x0c\xee\xb3\x2c\x3d\xb1\x9a\x26\x24\x15\xf4\x2f\xa1\xaa\x95\x60\xff\x5c\x55\x6d\x09\x98\x22\x17\x8e\xba\xe2\x96\xf6\xea\xab\x19\xf7\x2c\x3a\x6b\x98\xa8\x75\x56\xc9\xa6\xd6\x59\xf5\x78\x2a\x9d\x55\xc4\x06\x74\x16\x57\x0f\xdb\x4a\x2a\x03\x60\x76\x77\x6e\xac\xd2\x2a\x8a\x66\x75\x6f\x52\x3c\x63\xb3\x4a\x4b\x20\x2e\xf3\x54\xdf\x62\x1a\x6f\xda\x64\xd9\x16\xb3\xcd\x80\xf1\x28\x2d\x55\xc9\x1d\x49\x53\x20\x42\x64\x11\x96\x93\xc5\xaa\x0a\x48\xa8\xab\xeb\x88\x70\xb8\xa7\x28\xc3\x12\xcb\x42\x65\x06\x46\xf5\x20\xca\xd6\xeb\xcc\x60\xd1\x92\xc4\xaa\x9c\x18\x4a\x41\x71\xda\x35\xc4\x2c\x49\x28\xde\x26\xa6\x8f\x40\x12\x69\x0a\x4a\x23\xc5\x2e\x13\xb0\x26\xf1\xf8\x8b\x6f\xb5\xc8\x60\xda\x6e\x30\x66\xa2\x3d\xfc\xaa\x83\x53\x04\x83\x23\xbf\xf3\x26\x19\xec\x68\x6f\x13\x3b\x17\xd0\xba\x61\xe6\x7b\x9e\xaa\x29\xb9\x84\xee\x1d\xb5\x6a\xc0\x1e\xba\xac\xa4\x87\x88\x6e\x50\x5d\xb0\xc6\x00\x89\x98\xbb\x6c\xa7\xe2\x72\xbb\xeb\xaa\xa0\xaa\x58\xc0\x4a\x22\x1c\x5b\xdf\x73\x5f\xda\xab\xf0\xa1\x2a\xcc\x3e\x5a\xf5\x70\x4b\x50\x2b\xf8\x25\xd4\xcc\x38\x96\xa2\x8f\x84\x1e\x60\x87\xb7\x2b\x34\x1b\x85\x9d\x43\x75\x9a\xdd\x0b\xdb\x81\x8e\xa1\xd9\x74\x3b\x93\x89\x1f\x3d\xbc\xcc\x36\x28\xea\x54\x41\x86\xa6\xe8\xc3\xb1\x8c\xfd\xf3\x39\x1d\xdc\x79\xf0\x36\xb4\x3b\x00\xdf\x5a\x2e\x86\xca\x45\x2d\x47\x7d\x05\xa3\x47\x55\x8c\xce\x15\xa5\xce\x95\xec\xfe\xc2\xd1\x7d\xf7\xb5\xae\xec\xd4\xe1\xed\xbe\x6d\xc3\xa5\x53\xab\x19\x97\x57\xd5\xd5\x7b\xb3\xa0\x76\x3e\x87\xbf\x33\xb9\xea\xad\x26\x90\x34\x4d\x9d\xcc\xef\xa5\x25\x26\x33\xa7\xd0\xb7\x2a\x76\xc3\x9e\x44\xaa\xf3\xc8\x28\xe3\xdc\xd8\xfc\x4c\x4d\x31\x58\x7b\x00\x1f\xf1\x80\x35\x37\x7b\x40\x8a\x65\xa9\x43\x12\xa4\x62\x0d\x95\x56\xdb\xb2\xa0\x4e\xfc\x62\x59\x31\x86\xf1\xb8\x3a\x86\xa1\x05\x07\x59\x2e\x55\x3d\x2a\x86\x40\xc1\x8b\x86\x00\x77\xbb\x69\xaf\xcd\x3a\x75\x7d\x83\x29\xf9\xc9\x72\xe9\x94\x5f\x21\x5b\x38\x97\x97\xe5\x32\x50\x13\xda\x40\x62\xa4\x02\xc2\x95\xbd\xbc\xb7\x76\xb3\x35\x10\xf1\xe4\xa0\xcb\xc7\xe6\x65\x91\x95\xb9\x2d\x9a\xb8\xbc\xaa\xe4\xa5\x17\xf7\xff\x15\xfa\x7f\x14\xff\xa5\x7a\xea\x02\x15\x74\x31\xe6\x19\xfd\xb4\xdd\x44\x45\x0c\x36\xb4\x90\x2c\xa2\x02\x2f\xa9\x50\xc9\xb2\x02\xd6\x19\x1e\x60\x1b\x7d\xc9\xd2\x72\xcd\x85\xba\x45\xc2\x72\x2b\x01\x59\x22\x29\x47\x47\x14\xab\xe8\x07\xc8\x72\x59\xd0\x25\x1a\x89\xaa\x60\x6e\xa6\x62\x01\xa5\xea\xff\xc8\x18\x87\xe0\x33\x7d\x14\x75\xc7\x29\x4c\x66\x80\x9c\x85\x7e\x55\x8e\x91\x52\x0e\x67\xa1\x32\x63\x4a\x57\xb0\xe1\x2c\x41\x81\x33\x1e\xd3\x87\xba\xed\x02\x5b\xe7\x73\xe4\xe7\xe6\x81\x60\xf5\xd7\xa5\x7e\x54\xa7\xe0\x1b\x50\xf5\xf4\xba\x34\x7f\x3e\xd7\xbb\x91\x84\x1f\x54\xb5\x7e\x25\x7a\xfd\xd2\x26\xe5\x7f\xb8\x7d\x3e\x12\x0c\x92\xfe\x40\x7a\x9e\x8a\xf8\x55\x72\xf0\xc7\x3f\x44\xc6\x2f\x27\x2a\x9c\x9f\x65\x6b\x86\xb6\x40\x3e\x4e\x54\x37\xc3\x8d\x67\xaa\x8d\x1c\x14\x5b\xc8\x59\x2c\xa1\x10\x3d\xcf\xec\x44\xe7\xc8\x03\x9f\x13\x8c\xd8\x85\x24\x5c\x62\x40\xaf\xfb\xbf\xb6\x62\x0b\xea\x20\xce\x24\x23\x53\xd3\xc5\x39\x24\xd9\x4c\x91\x1d\x07\x37\x23\x15\xd0\x72\xa5\x6c\xae\x29\x56\x9d\x59\x0b\x1c\x86\xa1\x7e\x63\xf4\xad\x01\x43\x94\xa7\xef\xa9\x57\xb8\x5d\xe7\x3d\x1d\x0e\x69\x9b\x19\x1e\x9a\xe9\xaa\x52\x34\xfb\x6d\xc4\x56\x35\xec\x2c\x3f\xe8\x21\xed\x90\xc3\x65\x47\x79\x41\x37\xa3\xab\x8e\x58\x32\x14\x49\x1e\x4e\x8b\xba\x67\x51\x6e\x62\x71\xc0\x4f\xd6\x74\x67\xed\x78\x4a\x2d\xd4\x9c\x0c\x9e\x09\x75\x5e\x36\xca\x04\xe8\xa3\xb5\xca\x02\xe8\x47\x20\x69\x9a\x7d\x45\xc7\x40\x41\xd3\x62\x19\xdf\xa3\xf8\xd5\xb7\x05\xce\xb1\xd1\x0c\x95\x8f\x71\x21\x29\x89\xf1\xa0\xd1\xd0\x31\xb1\xae\xd9\x44\xe3\xac\xd5\xa5\xd8\xe3\xf7\xad\xe8\xc7\x6a\xf0\xc0\x99\xe5\x90\x02\x9f\x40\x3b\xcd\x8c\xa3\x94\xb3\x89\x90\xfe\x22\xfb\xa3\x14\xcd\xc0\xf0\xbc\x8f\xf8\xb6\x55\xc1\xd9\x51\x71\x50\x41\xd1\xc8\x65\xb6\x75\xac\xab\xcd\x55\x1d\xae\x89\x16\xdf\xdb\x38\xc5\x0c\x85\x68\x45\xa3\xcf\x02\x72\x5a\x80\x09\x01\xbb\x1f\x16\xed\x2b\xd8\xd3\x64\x14\x95\xe3\xbf\x2e\x1a\x53\x58\x68\x00\x33\xb1\xa6\xfc\x88\xf8\xd2\xfd\xdd\x4d\xcc\x74\x05\xa6\x9b\xdd\x16\xb4\x3e\xa8\xe8\xeb\x6c\xf2\xe2\x9e\xc4\xd8\x9a\x9e\xda\x8a\x1d\x30\x5f\x4a\xa6\x74\xe3\x7b\xb5\x9c\xce\xc2\x77\xe5\xfa\xd7\x2c\x65\xd1\xa3\xe2\xde\xb2\xec\xaa\x8c\x69\xbe\xea\x9d\x39\x2b\x44\xf8\x8e\x7e\x6d\x1f\x5e\x30\xce\x24\x23\x29\xfb\x3f\x1a\x0f\xd1\x0b\x92\xac\x58\x66\x12\xe3\x14\xf3\x51\x62\x4d\x62\x5e\x94\x5c\xb2\x35\xfd\x8f\xe9\xc4\x46\x6c\x4d\xa3\xdf\xa5\x17\xde\x6c\x48\x5a\x81\xb2\x93\xb7\x4d\x7f\x3e\x28\x3e\x77\xe7\x4c\x9b\x39\x41\xd9\x6e\xdb\xa8\x31\xca\x35\xa9\x55\xa3\x07\x33\x63\xaa\x4f\xbb\xf8\xed\x07\x99\x73\x7b\xa0\x8a\xac\x95\xbf\xb9\xaf\x03\xfa\xea\x6b\xd2\x33\xd5\xf2\x5b\xef\x47\x97\x2d\x8f\x5f\x7d\x79\xd9\x7a\x6f\x3f\xbf\x54\xaf\x5f\x3a\x93\xd8\x62\xf0\x7d\x9f\x5f\xb6\x69\x75\xbf\xc1\x34\x76\xcd\x9a\x33\xdf\x4b\xb8\x00\x00\xb8\xbd\xab\xa2\x28\x3c\x99\xfc\x8e\xbf\xf2\xab\xf8\xd4\x5f\x62\xd5\x9e\xd7\x46\xcf\xe8\xae\x3b\x5f\xa6\x54\xe2\xec\x5c\xeb\x34\xb7\xcc\x1a\xdd\x96\x24\xa7\xf5\xb4\x01\x4a\x2c\x0c\xc3\xea\x85\xf3\xe1\x56\x5b\xfe\xc6\xb1\xb4\xa7\x08\x13\xee\xb8\x96\xa1\x1e\xf8\x25\x49\xd3\xc1\xf4\xf5\x34\x52\x41\x17\x8a\xbe\x2a\x65\x54\xf4\x2c\x58\x1d\x8d\x89\x88\x98\xeb\xae\x82\x8a\x32\x55\x9f\x04\x19\xe9\xa8\xd0\x65\x83\x27\xcd\x4f\x10\x8d\x75\xdf\x6d\x67\x34\x83\x0d\xf4\x7f\x23\x62\x4e\xb0\x1d\x9b\xd2\x9e\xca\x35\xbf\x5d\xeb\x6b\xe4\x61\xcf\x7d\x7b\x09\xb8\x68\x6a\x64\x93\x7b\x84\xd9\x36\xda\x75\x60\xb2\xb1\xf0\xc3\x57\xf5\x61\x2e\x3e\x1d\x71\x96\x7b\x84\x40\x7f\x1f\x25\xd1\xce\x3d\x45\x67\x45\xee\x12\x7e\xde\x7f\xbc\xab\xcc\x9b\x3d\x9a\x91\xc6\x70\xae\x99\x64\x1b\xe7\x74\xc6\x14\xc5\x38\x71\xb5\xc4\x98\x5a\xbf\x35\x87\x33\x4e\xbf\xdd\xae\x3a\x1b\xee\xa9\xac\xc1\x24\x4e\x97\x37\x58\xb8\x86\xe0\x7b\x75\x22\x8d\x45\x6c\x2a\x1c\xa7\xb1\xad\x47\xc3\xf2\x1d\x75\x68\xdc\x46\xb8\x72\x12\x18\xa6\x2b\x13\xd7\x38\x58\x19\x29\xf6\x06\xdb\x7b\xef\xf7\xa5\x63\x96\x6c\x56\x84\x65\x94\x7d\x93\x99\x50\x72\x0a\xff\x0e\xaf\x7a\xb3\xa0\x5e\x2f\xde\xc3\x60\xd8\x14\xab\x29\x61\x25\xd1\x8a\xd1\x0d\xb9\x4f\xa9\x96\x90\x1a\x84\x02\x52\xa9\x8a\x5c\x11\x0e\xaf\x74\xb0\x5a\x79\x73\x9b\x1d\xd8\x95\x74\x1c\xfc\x1e\x10\x9d\xf7\xa0\xa8\xbd\x20\x33\x8d\x79\xbb\xa9\xd2\xb5\x2e\x36\x6a\x45\x6a\xbc\x3e\xa8\x51\xdf\xb8\xb7\x03\x17\x26\xb5\x44\xd4\xb2\x36\xb3\xbd\x32\x69\x50\xdc\x13\x28\xba\x3a\xd6\x90\x0b\x86\x82\xda\x76\x09\x53\xc0\xe7\x66\xab\x12\x5e\x3a\xda\x54\xf5\xd8\xed\xfa\xcb\xaa\x6b\x4d\x6a\x2b\x46\xf8\xaf\x55\x28\x87\xf3\x01\x95\xfa\x54\x2d\xa0\x73\xd8\xd0\x8f\x54\xb3\x31\xa3\xf7\x65\x08\xb0\x66\x3f\x9c\xaa\xcd\x8d\xf3\x41\xe2\x2b\xf7\xf3\xbd\x4d\x5d\x00\xed\xd4\x6e\x1e\x59\xbc\xd9\xf8\x2e\x50\x0f\x1d\xba\x81\x3c\xac\xfe\xd5\x85\xe4\x8f\x58\xd9\x84\xee\x5c\xe8\x1d\x45\x13\x88\xdf\x71\xd8\x2b\xcc\xc9\xcc\x2c\xad\x89\xbf\x86\x42\x3a\x9b\xd4\x54\x49\xa7\xe1\x99\x94\xd2\x9d\xba\x1f\x20\xc7\x2a\xa5\x43\xf1\xa9\x6a\xd9\x08\xf8\x87\xd3\x8f\xd6\x82\x0e\x26\x1d\xaa\xff\x53\x93\x0e\x7d\xb0\xd0\x93\x73\xe8\x86\xfe\xa4\xa3\x7d\x1a\x51\x65\x1d\xed\x86\xbe\xff\xfa\x52\x9f\x5a\x99\xac\xc1\x38\xef\xd6\xd1\x4f\x5f\x22\xd2\x21\x5f\x67\x22\xad\x33\x8d\xe7\xca\x34\x76\x7e\x7f\x5c\xac\x39\xcb\x8a\x6f\x89\x8b\x5b\x12\xb7\xf8\x6e\x2f\xfa\x29\x91\x31\x4b\x5c\x7c\x77\x26\x1a\x5f\x7b\x62\x63\xe3\x53\xd5\x87\xf5\xb3\xd3\xde\x8d\x21\xb6\x9b\x7b\xde\x1e\x56\xaf\xc6\xd1\xc3\x19\x8c\x9a\xb2\x0e\x4d\x36\xa6\xf6\xe4\xd3\x88\xda\x93\x43\x1c\xd6\x05\x29\xdd\x9e\x55\x59\x8a\x23\xe9\x13\xe4\x06\x47\x81\xea\xf7\x51\xa8\x1a\x81\x27\x57\x7c\x1d\x28\x7d\x3f\xe9\x01\xa9\xf4\x36\x1c\x0c\x65\x6a\x73\xd5\x1f\xb4\x8c\x16\x70\x83\xbf\x27\xe7\x01\x5d\x59\x3f\x39\x11\x68\xb3\x38\x2e\x13\xa8\xe5\xf1\x0d\xa9\xc0\x3e\xc4\x7c\x77\xb9\xc0\xd3\x76\x78\x20\xec\xb8\xbd\xdb\x13\x78\x74\xc5\xd2\x20\xf9\x5d\xa5\x03\x7f\xb2\xe6\x38\xbc\x3d\x4b\xc0\x3f\x46\xf4\x43\xb0\xfc\xce\x23\xfe\xb6\x40\xc3\x3e\x4b\xf9\x9d\x86\xfc\x4f\xc5\xc8\x80\xf6\x1d\xad\x7b\x0e\xc9\x67\x8e\xfa\xdb\x4b\x3a\x18\xf6\x0b\x73\xcd\xfc\x84\xb8\x5f\x5d\xe1\xfd\x4a\x96\x8c\x63\x64\x5c\xd0\x2f\x25\x2b\x68\xad\xeb\x2f\x95\xae\x02\xc3\x7f\xc0\xc1\x12\x66\xe2\x76\x15\xc6\xa0\x36\x9b\x7f\xa9\x22\xd0\xb1\x54\xff\xdf\x45\xed\x63\x0c\xc1\x59\xf8\x96\x12\x59\x16\xf4\x86\xa3\xcf\x88\x61\x92\xeb\x79\x58\xc6\x27\xd3\xbe\xcf\x6b\xbc\xc6\xbf\xb6\xac\x7b\x57\x97\x29\x1d\xf6\x81\xf2\x18\x76\x3b\xff\x9f\x03\x00\x15\xd0\x11\xff\x2d\x54\x00\x00")
 
 func templateBuilderQueryTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -247,12 +262,12 @@ func templateBuilderQueryTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/builder/query.tmpl", size: 17910, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/builder/query.tmpl", size: 21549, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateBuilderSetterTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x4d\x8f\xdb\x36\x13\x3e\x5b\xbf\x62\x5e\x41\x2f\x20\x1b\x5d\x3a\xc9\xad\x01\x7c\x70\xd6\x09\x6a\xa0\x4d\x80\x3a\x39\x05\x39\x70\xc5\x91\xcd\x44\x4b\x2a\x24\xe5\xd4\x10\xf4\xdf\x0b\x92\xa2\x24\xcb\x5e\xef\x3a\xdb\xdc\x04\xce\x70\x66\xf8\xcc\x33\x1f\xaa\xeb\xf9\x2c\xba\x95\xe5\x41\xf1\xed\xce\xc0\xab\x17\x2f\x7f\xbf\x29\x15\x6a\x14\x06\xde\xd1\x0c\xef\xa4\xfc\x06\x6b\x91\x11\x58\x16\x05\x38\x25\x0d\x56\xae\xf6\xc8\x48\xf4\x71\xc7\x35\x68\x59\xa9\x0c\x21\x93\x0c\x81\x6b\x28\x78\x86\x42\x23\x83\x4a\x30\x54\x60\x76\x08\xcb\x92\x66\x3b\x84\x57\xe4\x45\x90\x42\x2e\x2b\xc1\x22\x2e\x9c\xfc\xcf\xf5\xed\xdb\xf7\x9b\xb7\x90\xf3\x02\xa1\x3d\x53\x52\x1a\x60\x5c\x61\x66\xa4\x3a\x80\xcc\xc1\x0c\x9c\x19\x85\x48\xa2\xd9\xbc\x69\xa2\xa8\xae\x81\x61\xce\x05\x42\xac\xd1\x18\x54\x31\x34\x8d\x3d\x4d\xee\x2a\x5e\xd8\x18\x5e\x2f\xa0\xa4\x3a\xa3\x05\x24\x64\x93\xc9\x12\xc9\x9b\x56\xd2\x2a\x2a\xcc\x90\xef\xbd\x66\xf7\xdd\x5d\x6f\x95\x72\x8e\x05\xd3\x56\x25\x21\xef\xfc\x77\x2b\xa9\x4a\x46\x8d\xbf\x9d\xd3\x42\xa3\x3f\xbf\x01\x9e\x83\x54\x90\xee\xa8\xde\x54\x79\xce\xff\xe9\x4d\xc6\x9f\xdc\x95\x78\x7a\x49\xfa\x41\x58\x85\xa6\x89\x26\x43\x27\x0b\x30\xaa\xc2\xee\xb8\x8d\xca\x06\xf5\x57\x65\xe8\x5d\x81\xc3\xd8\x6e\x00\x6d\x3c\x3c\x87\x84\xac\x57\xe4\x93\x46\xb5\x72\x58\xb1\x53\x03\xb4\x2c\x51\xb0\xee\xc0\x5e\xe8\x8c\x08\xa7\x6f\x1f\xab\xa8\xd8\x22\x24\xb9\xc3\x21\xef\x5c\x39\x53\xe5\x31\x7e\x39\xf9\x78\x28\x91\x6c\x8c\xe2\x62\x0b\x4d\x53\xd7\x36\x10\xfc\x6e\x15\x7b\xc8\x9b\x06\xfc\xdd\x05\xc4\x7b\x5a\x54\x18\xb7\x47\xad\x53\x1f\x64\x25\x32\x97\x46\xc5\x85\x81\x78\x83\x26\xb6\xf6\x37\x46\x55\x99\x71\x0f\x76\xaa\xf3\x39\x74\xda\x4d\x03\x1a\x8d\x76\x64\x8a\xdd\x29\x79\x4f\xef\x2d\x70\x31\xb8\xb8\x49\x34\x71\x8a\xe9\x11\x03\x9a\x06\x66\x43\xee\x34\xcd\x74\x68\x33\xf5\xb1\xb6\x41\xfb\x17\x3a\x9d\xd1\x25\xa8\xa3\xc9\xc4\x42\x37\x9f\xd9\x30\x8c\x45\x40\x54\xf7\xa8\x78\x06\xc6\xde\x91\x7b\x54\x8a\x33\x84\x52\xe1\x9e\xcb\x4a\x43\x46\x8b\x42\x83\x91\xb0\x64\x8c\x80\xe3\xb6\x37\xc1\x73\xa0\x2e\x31\x1e\xcf\xf7\xad\x99\x8e\x11\x4e\x71\x32\x7a\x05\xb9\xaf\x0c\x35\x5c\x0a\x52\xd7\x01\xb6\xbf\x51\x9f\x05\x2e\x9d\xb6\x9e\x02\xe4\x17\x8d\x9d\x40\x61\x6f\x2b\x34\x95\x12\x30\xba\x17\x4d\x9a\xc8\x26\x70\x3e\x83\xe5\x5e\x72\x06\x5b\x14\xa8\x3c\x18\xbc\x28\x2c\x5b\xc1\xd7\xac\x86\x5c\xaa\xfe\xd0\x42\xa4\x03\x08\x9e\x37\x16\x82\x54\x48\xd3\xe3\xd0\x2a\x4f\x21\x95\x8e\x6d\x1f\x4a\x1b\xa2\xad\xf2\x9c\xac\x30\xa7\x55\x61\xa6\xfe\x4a\xea\xf0\x0b\x78\x25\x39\xf1\x05\x16\x94\xa6\xfd\xa3\x43\x04\xef\x4e\x08\x17\xdc\x9d\x25\x5e\x60\xde\xd1\xf5\x47\x19\x68\x9f\x65\x85\x5b\xbe\x47\x01\x8e\xfc\xb6\x83\xda\x88\x05\x2f\x48\x34\xb9\x86\xa0\x23\xd7\x3d\x51\x67\x4f\x60\xea\x84\xe7\xd0\x5d\xf8\xdf\xc2\xba\xf7\xe7\x27\x4c\x18\x12\x60\x36\x64\xc0\xc4\xb1\xf0\x21\x1e\x4c\x7c\x1e\x43\x23\x19\xe4\xf4\x84\xd6\x39\xb9\x95\x62\x8f\xca\x20\xfb\x28\xdf\x50\x3d\xa4\x7a\x20\xc0\x5a\xbf\x65\x5b\xdf\xe9\x06\xc9\x1b\x75\x89\x25\x63\x17\x93\x15\xda\x04\x65\x4c\xf7\xaf\x37\xf2\x62\xcb\xf8\xd5\x3d\xe3\xfa\xd2\xfb\x39\xcc\xfb\x62\x39\x6a\x23\x67\x60\xbc\x2d\x90\xaa\x27\x01\x99\x59\x4d\xcf\x77\x4f\x66\x99\xff\xe7\x58\x3e\x07\xb5\x2b\xd0\x1a\xe0\xd6\x0f\x3d\xf4\xc3\xdf\x52\xaf\x1f\x7a\xd2\x4d\xbd\x98\x5a\xb2\xb9\x19\x97\x70\xa6\x43\xfb\x48\xd0\x4d\x65\x1b\xc9\x92\xb1\x6e\x06\x26\x48\x3e\x09\xfe\xdd\x8d\xf1\xd6\xc6\xc2\x6d\x2f\x63\x13\x47\x16\x36\x68\xbc\xb8\x9f\x8c\xf3\x19\xdc\xee\x30\xfb\xe6\x3b\x89\xdd\x8f\x5c\x37\x85\x1f\x54\x03\x2d\x14\x52\x76\x68\x97\x23\x06\x77\x07\x97\x0c\x07\xfe\x4d\xe8\xba\x29\x92\x2d\x01\x64\x5b\xbc\xf1\x2d\xe9\x07\x37\x3b\xa7\xa7\x6d\xb6\x04\xbd\xc7\xe9\xa0\x0f\x27\x56\xbc\xf1\x3e\x5e\x2f\x5c\xa7\x4a\x90\xfc\x41\xb5\x23\x45\x2b\xe8\x7b\xf6\x50\x7d\x48\x98\xf0\xbc\xb6\x24\xa4\xa5\xf2\xa0\x4f\x62\x4f\x15\x1b\x59\x28\x49\x2f\xf2\xcd\xc2\xcb\x01\x85\xe1\xe6\x60\x9f\xb6\x5e\x79\x97\x6d\x48\x1d\xbc\xba\xc3\xeb\x4a\xc2\xf5\x31\xa6\xa7\x49\xe3\xcc\x9a\xf5\xab\x1e\x67\x1a\x08\x21\x9d\x9f\x61\x9c\xeb\xd5\x73\xaa\xfd\xa7\x43\xb8\x82\xe8\xc3\xae\xd0\x59\x4f\x70\xd0\x1f\xc6\x39\x1c\x0e\x9b\xf5\x4a\x5f\x9c\x94\x78\xd4\x33\xe2\xf5\x2a\x3e\x3b\x2e\x83\x99\x93\x89\xf9\x73\x4c\xf8\x25\x73\xb5\x8f\x31\xe5\xcc\xab\x3e\x35\xc9\x3c\x07\xce\x1e\x1e\xab\x4d\x03\x8b\x71\x9a\xc6\xf9\x9f\x71\x76\xed\x90\xed\x57\xf2\x42\xfe\xb0\xb3\xd3\x65\x28\x87\xf8\xff\xe4\xa5\x8e\x8f\x00\x9c\x0e\x98\x70\x71\x3f\x7f\x7c\x37\x4f\xc3\x9f\x96\x2c\xa7\xe3\xfc\x9f\x59\xd1\x9f\x50\xfe\x75\x3d\x2e\xeb\x61\x55\x3f\x4a\x89\xe7\xaf\xf9\x67\xba\xca\xb0\xd8\x66\x23\xd7\x0f\xff\x0d\x1c\x97\xf0\x4d\x73\x21\x99\x67\xea\xdf\xc5\x43\xd6\xab\x6e\x59\xb7\xb5\xdf\x1a\xe1\xfe\xc7\xf4\x9e\x7e\xc3\xf4\xf3\x97\xb3\xdc\xfc\x0d\x0a\x14\xfd\xe2\x30\x0d\xdd\x87\xbb\xf1\xc5\xe3\xa3\x1f\x34\xee\xb5\xbc\x7c\x01\xf1\xd7\xb8\x9f\x3c\xad\x4b\xbb\xaf\x7b\x79\xd3\xb8\xff\x3e\x37\x23\x7b\xdc\x1c\xcd\x39\xd3\x9f\x83\xd2\x97\x96\xe5\x56\xdc\x1f\x92\xf5\xea\x11\x5e\x8f\xa1\xe0\x4c\x13\x42\xc6\xbf\x2c\x47\x23\x7b\x3e\x87\x30\x34\xc1\x1b\xd5\x1d\x49\xba\x71\x1a\x68\x22\xef\xbe\x62\x66\xc2\xae\xd2\x26\x8d\x44\x4f\x24\x4d\xb0\x16\x16\x93\x13\xf3\x75\xf4\xd0\xbb\x42\xaf\x8f\xfc\x92\xd1\x06\xff\x6f\x00\x00\x00\xff\xff\x26\x60\xa0\x98\x93\x11\x00\x00")
+var _templateBuilderSetterTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\xc9\x8e\xdb\x38\x13\x3e\x5b\x4f\x51\xbf\xe0\x1f\xb0\x8d\x69\x3a\xc9\x6d\x02\xf8\xd0\x69\xa7\x31\x06\x26\x09\x30\x4e\x30\x87\x20\x07\xb5\x58\xb2\x99\xa8\x49\x85\xa4\x9c\x69\x18\x7a\xf7\x41\x71\xd1\xe6\x5e\xd3\x93\x9b\xcd\x5a\x58\xfc\xea\xab\x45\xc7\xe3\x72\x91\x5c\xa8\xea\x46\x8b\xdd\xde\xc2\xab\x17\x2f\x7f\x3f\xab\x34\x1a\x94\x16\x2e\xb3\x1c\xaf\x94\xfa\x06\x1b\x99\x33\x38\x2f\x4b\x70\x4a\x06\x48\xae\x0f\xc8\x59\xf2\x71\x2f\x0c\x18\x55\xeb\x1c\x21\x57\x1c\x41\x18\x28\x45\x8e\xd2\x20\x87\x5a\x72\xd4\x60\xf7\x08\xe7\x55\x96\xef\x11\x5e\xb1\x17\x51\x0a\x85\xaa\x25\x4f\x84\x74\xf2\x3f\x37\x17\x6f\xdf\x6f\xdf\x42\x21\x4a\x84\x70\xa6\x95\xb2\xc0\x85\xc6\xdc\x2a\x7d\x03\xaa\x00\xdb\xbb\xcc\x6a\x44\x96\x2c\x96\x4d\x93\x24\xc7\x23\x70\x2c\x84\x44\x48\x0d\x5a\x8b\x3a\x85\xa6\xa1\xd3\xe9\x55\x2d\x4a\x8a\xe1\xf5\x0a\xaa\xcc\xe4\x59\x09\x53\xb6\xcd\x55\x85\xec\x4d\x90\x04\x45\x8d\x39\x8a\x83\xd7\x6c\x7f\x4f\xaf\x86\x4a\x85\xc0\x92\x1b\x52\x99\xb2\x4b\xff\x3b\x48\xea\x8a\x67\xd6\x5b\x17\x59\x69\xd0\x5b\x9c\x81\x28\x40\x69\x98\xed\x33\xb3\xad\x8b\x42\xfc\xd3\x45\x94\x7e\x72\x26\xe9\xfc\x3e\xe9\x07\x89\xe9\x9c\x7c\x4d\xfa\x97\xac\xc0\xea\x1a\xdb\xe3\x10\x15\x05\xf5\xae\xb6\xd9\x55\x89\xfd\xd8\xce\x00\x43\x3c\x13\xfa\xf3\x43\xd8\x3d\x4c\xd9\x66\x0d\x4d\x73\x3c\x52\x7c\xec\x93\x41\xbd\x76\xf0\x71\x7f\xd8\x79\xcc\xaa\x0a\x25\x6f\x0f\x98\x97\xd3\x51\xef\x47\x42\x7e\xc3\x4f\x02\x43\x67\x72\x87\x30\x2d\x08\x8c\x68\x19\x63\xad\x86\xf8\x16\xec\xe3\x4d\x85\x6c\x6b\xb5\x90\xbb\x36\x22\xfc\x4e\x8a\xd3\x56\xad\x69\xc0\xdb\xae\x20\x3d\x64\x65\x8d\x94\x5e\xe8\xee\x77\xe0\x14\xb5\xcc\xc9\x79\xa5\x85\xb4\x90\x6e\xd1\xa6\xe4\x7f\x6b\x75\x9d\x5b\x07\x08\x85\x3a\x59\x2e\xa1\xd5\x6e\x1a\x30\x68\x8d\x23\x5b\xea\x4e\xd9\xfb\xec\x9a\xb0\x4a\xc1\xc5\xcd\x92\x89\x73\x3b\x1b\x30\xa4\x69\x60\xd1\xe7\x56\xd3\xcc\xfb\x3e\x9d\x72\x15\x22\x0c\x2f\x74\x3a\x23\x23\x38\x26\x13\x97\x92\xe5\x82\xc2\xb0\x84\x80\xac\xaf\x51\x8b\x1c\x2c\xd9\xa8\x03\x6a\x2d\x38\x42\xa5\xf1\x20\x54\x6d\x20\xcf\xca\xd2\x80\x55\x70\xce\x39\x03\xc7\x7d\xef\x42\x14\x90\xb9\x3c\xb9\xdb\xd8\xfb\xe0\xa6\x65\x8c\x53\x9c\x8c\x5e\xc1\xae\x6b\x9b\x59\xa1\x24\x3b\x1e\x23\x6c\x7f\xa1\xb9\x15\xb8\xd9\x3c\x04\x1b\x21\xbf\xd7\xd9\x09\x14\x64\xad\xd1\xd6\x5a\xc2\xc8\x2e\x99\x34\x09\x51\x63\xb9\x80\xf3\x83\x12\x1c\x76\x28\x51\x7b\x30\x44\x59\x12\x9b\x1d\x3a\xa8\x0d\x14\x4a\x77\x87\x04\x91\x89\x20\x78\x26\x13\x04\x33\xa9\x6c\x87\x43\x50\x9e\xc3\x4c\x69\x3a\xfd\x50\xd1\x7b\xa9\x0b\x14\x6c\x8d\x45\x56\x97\x76\xee\x4d\x66\x64\xdc\xe2\x35\x2d\x98\x2f\xc0\xa8\x34\xef\x1e\x1d\x23\xb8\x3c\x21\x5c\xbc\xee\x56\xe2\x45\xe6\x0d\xcc\x1f\x64\x20\x3d\x8b\x84\x3b\x71\x40\x09\x8e\xfc\xd4\x61\x29\x62\x29\x4a\x96\x4c\x9e\x42\xd0\xd1\xd5\x1d\x51\x17\x8f\x60\xea\x44\x14\xa1\x0a\x9b\x06\xfe\xb7\xa2\x44\x38\x06\x9f\x32\xa1\x4f\x80\x45\x34\x21\x06\x4c\x08\x86\x3b\x79\x40\xd2\xae\xa6\xfb\x39\x3d\xa1\x75\xc1\x2e\x94\x3c\xa0\xb6\xc8\x3f\xaa\x37\x99\xe9\x53\x3d\x12\x60\x63\xde\xf2\x9d\xef\x84\xbd\xe4\x8d\xba\xc4\x39\xe7\xf7\x26\x2b\x3c\x03\x32\xce\x4d\xf7\x7a\xab\xee\x6d\x19\xbf\xba\x67\x3c\xbd\xf4\x7e\x0e\xf3\xae\x58\x22\xb6\x77\xc1\x78\x51\x62\xa6\x1f\x05\x64\x4e\x9a\x9e\xef\x9e\xcc\xaa\xf8\xcf\xb1\x7c\x0e\x6a\x4f\x40\xab\x87\x1b\x69\x22\xdf\x61\x58\x0d\x88\x78\xe6\x6f\x61\xf7\xbd\x29\xdb\xc3\xb0\xd3\xee\x8d\x6c\x67\x33\x9a\xaa\x71\x92\xa2\x73\x8b\x41\xc3\x55\xc6\x54\xb9\x49\x9a\x66\x44\x60\x67\x35\x15\xdc\xc4\x96\x34\x45\xf6\x2e\xbc\xee\x9c\x87\x59\x4d\x31\x20\xfb\x24\xc5\x77\xb7\x3a\x04\x1f\x2b\xb7\x31\x8d\x5d\x0c\x3c\x6c\xd1\x0e\xe2\xf2\xcd\xfa\x62\x8f\xf9\x37\x2a\x50\xbf\x93\xb9\x0e\x0d\x3f\x32\x03\x59\xa9\x31\xe3\x37\x61\x21\xe3\x70\x75\xe3\x12\xec\xba\xd9\x59\xec\xe4\x33\x64\x3b\x06\xf4\xa2\x33\x27\xf0\x6b\x09\xe9\x19\x6a\x7f\x32\xbb\xc6\x79\xaf\xb7\x4f\x49\xbc\x75\xb6\xf4\x3c\x57\xe1\xc8\xfe\xc8\x8c\x2b\xef\x20\x08\xba\xa2\x18\xa8\xf7\x49\x18\x9f\x17\xca\x4c\x51\x79\xf4\x7a\x2f\x76\xf4\xa3\xc8\x62\x99\x93\x25\x86\x06\xe4\xe5\x80\xd2\x0a\x7b\x43\x4f\xdb\xac\xfd\x95\x21\xa4\x16\x5e\xd3\xe2\xf5\x44\x12\x77\x31\xce\x4e\x93\x26\x38\xb9\xf5\xeb\x9c\xa0\x7d\x8c\xb1\xf6\x9e\x7e\x9c\x9b\xf5\x73\x3a\xc8\x4f\x87\xf0\x84\xe2\xe9\x77\x9a\xd6\xfb\x14\x7b\x3d\x67\x9c\xc3\xfe\x00\xdb\xac\xcd\xe5\xb0\x03\x0d\xa7\x2f\x0e\xa6\x6f\xba\x59\xa7\x83\x66\x34\x76\x73\x32\x85\x7f\x8e\x09\xbf\x64\x56\x77\x31\xce\x04\x87\x45\x2f\x84\x07\x93\x2c\x0a\x10\xfc\xee\x51\xdd\x34\xb0\x1a\xa7\x69\x9c\xff\x85\xe0\x4f\x1d\xdc\xdd\x9a\x5f\xaa\x1f\xa8\x61\xe6\x46\x6d\x01\xe9\xff\xd9\x4b\x93\x0e\x00\x6c\xbf\x6c\x1e\xda\xf9\x1f\xde\xf7\x67\xf1\xeb\x4e\x55\xf3\x71\xfe\x6f\x59\xfb\x1f\x51\xfe\xc7\xe3\xb8\xac\xfb\x55\xfd\x20\x25\x9e\xff\xe9\x70\x4b\x57\xe9\x17\x5b\x9f\x0a\x14\xf8\x5d\x34\x18\x97\xf0\x59\x73\x4f\x32\x6f\xa9\x7f\xb7\xf2\xb0\xcd\xba\xfd\x00\x28\x4d\xeb\x84\x7a\xd0\xeb\x15\x5c\x67\xdf\x70\xf6\xf9\xcb\xad\xdc\xfc\x0d\x4a\x94\xad\x9f\xf9\x3c\x76\x1f\x41\xb9\x4b\x45\x3a\xf8\xe8\x13\xfe\xf5\xa4\x2d\x60\x05\xe9\xd7\x20\xa6\x17\x87\x2b\xe9\x1b\xc0\xcb\x9b\x86\x5c\xf8\x11\x19\xfd\x07\x9a\x0b\x6e\x3e\x47\xa5\x2f\x81\xe5\x24\xee\x0e\xd9\x66\xfd\x00\xaf\xc7\x50\x08\x6e\x18\x63\xe3\xcf\xa0\xc1\x1a\xb0\x5c\x42\x1c\x9a\xe0\xf1\x35\x2d\x49\xda\x71\x1a\x69\xa2\xae\xbe\x62\x6e\xe3\xfe\x13\x92\xc6\x92\x47\x92\x26\x7a\x9b\x85\xa4\x9f\xb8\x3f\x26\x77\xbd\x2b\xf6\xfa\xc4\x2f\x2e\x28\x39\x34\x4d\xf2\xef\x00\x08\xf9\x20\xec\x07\x12\x00\x00")
 
 func templateBuilderSetterTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -267,12 +282,12 @@ func templateBuilderSetterTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/builder/setter.tmpl", size: 4499, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/builder/setter.tmpl", size: 4615, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateBuilderUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x6d\x6f\xdc\xc6\x11\xfe\x4c\xfe\x8a\x09\x71\x0e\x48\x41\xe2\x39\xf9\x56\x19\x2a\x10\x5b\x72\x23\xa0\x75\x0a\xcb\x49\x03\xd8\x46\xc0\x23\x87\xba\xad\x78\xbb\xf4\xee\xf2\x24\xe1\xca\xff\x5e\xcc\xbe\xf0\xe5\x8e\x27\x9d\x04\x37\x68\x8a\x02\x06\xc4\xe3\xee\xce\xce\x3c\x33\x3b\xf3\xcc\xd2\x9b\xcd\xfc\x28\x7c\x23\xea\x7b\xc9\xae\x97\x1a\xbe\x7f\xf9\xdd\x9f\x4e\x6a\x89\x0a\xb9\x86\xb7\x59\x8e\x0b\x21\x6e\xe0\x92\xe7\x29\xfc\x50\x55\x60\x26\x29\xa0\x71\xb9\xc6\x22\x0d\x3f\x2c\x99\x02\x25\x1a\x99\x23\xe4\xa2\x40\x60\x0a\x2a\x96\x23\x57\x58\x40\xc3\x0b\x94\xa0\x97\x08\x3f\xd4\x59\xbe\x44\xf8\x3e\x7d\xe9\x47\xa1\x14\x0d\x2f\x42\xc6\xcd\xf8\x5f\x2f\xdf\x5c\xbc\xbb\xba\x80\x92\x55\x08\xee\x9d\x14\x42\x43\xc1\x24\xe6\x5a\xc8\x7b\x10\x25\xe8\xc1\x66\x5a\x22\xa6\xe1\xd1\xbc\x6d\xc3\x70\xb3\x81\x02\x4b\xc6\x11\xa2\xa6\x2e\x32\x8d\x11\xb4\x2d\xbd\x9d\xd5\x37\xd7\x70\x7a\x06\x8b\x4c\x21\xcc\xd2\x37\x82\x97\xec\x3a\xfd\x7b\x96\xdf\x64\xd7\x08\x6e\xa9\xc6\x55\x5d\x65\x1a\x21\x5a\x62\x56\xa0\x8c\x60\xb6\x3b\xc4\x56\xb5\x90\xda\x0f\xd9\x5f\x10\x87\xc1\x66\x73\x02\x32\xe3\xd7\x08\xb3\x3a\xd3\x4b\xda\x6c\x96\x5e\xb1\x45\xc5\xf8\xf5\xa5\x99\xa5\x68\x45\x10\x44\x46\x1d\x9a\xd2\xb6\x91\x5d\x87\xbc\xa0\xb1\xc4\x6c\x35\x5b\x34\xac\x22\xb8\x8c\x84\x9f\x8d\x19\xef\xb2\x15\x7a\x4b\x24\xe6\xc8\xd6\x76\xbc\x7b\xee\x16\xb9\x49\xab\x46\x67\x9a\x09\x4e\x93\x6a\xc9\xb8\x1e\xac\x8b\x52\x3f\x6a\xd0\x09\xe7\x73\x18\x6e\xdb\xb6\xe4\x3a\xc2\xdd\xbf\x29\x85\x04\x03\x27\xe3\xd7\x66\x6a\xea\xf4\x01\xe4\x9a\x69\x86\x2a\x0d\xf5\x7d\x8d\xdb\x62\x94\x96\x4d\xae\x61\x13\x06\xb9\xc1\xdb\x1a\xdb\x43\x69\x5d\x34\x2f\x19\x56\x85\x22\x44\x4f\xda\x36\xb4\x0a\xfd\x63\x89\x12\x21\x2b\x0a\x05\x19\x70\xbc\x85\x5a\x62\xc1\x72\x5a\x46\xda\x90\x76\x5b\x9b\xb9\xc7\x34\x2c\x1b\x9e\x43\x3c\x44\xaa\x6d\xe1\x68\x3c\x3b\xb1\x1b\xc4\xb5\x82\x34\x4d\x3b\xd9\xe9\xd0\xb8\x64\x7b\x11\x19\x32\xc2\xb6\x6d\xfb\xa5\x0a\xce\x20\xab\x6b\xe4\x45\xbc\x7f\xce\x31\xd4\x2a\x4d\xd3\x24\x0c\x24\xea\x46\x72\x18\xf9\xd3\x1a\xbf\xd9\xc0\x2d\xd3\x4b\xc0\x3b\x4d\x61\x31\x83\xe8\xb5\xd5\x20\x1a\x39\x39\x18\x05\xa5\x42\xad\x69\x46\xea\xfc\xef\x02\xea\x79\xc2\x9c\x5b\xb0\xb8\x46\xb5\x2b\x72\x3e\x87\xab\x6c\x8d\x80\x77\x98\x37\x64\x37\xf9\xe2\x4b\x83\xf2\x1e\x32\x5e\x80\x35\xcc\xbe\xe5\xcd\x6a\x81\x92\xce\x2b\x17\x05\x2a\xc8\xca\x12\x73\x8d\x05\x2c\xee\xcd\xb8\xdd\x08\x44\x8d\xd2\x80\x35\xe5\x3b\x98\x72\x1e\x29\x10\xe7\xfa\x0e\x72\xc1\x35\xde\x69\x3a\xcf\xf4\x37\x81\x98\x71\x7d\x0c\x28\xa5\x90\x09\xf9\x6b\x9d\x49\x3a\x9c\x01\x4a\x69\xdf\x86\x41\xd0\xa9\xc1\xb8\x0e\x83\xc4\x46\x25\x2b\x61\x96\xfe\x98\x29\x7b\xde\xce\xb1\xcc\x9a\x4a\xdb\x03\xbb\xa5\x4e\x5a\xd8\x41\x15\x27\xa3\xd3\x1b\xb0\x12\x2a\xe4\xdb\xda\xa7\x4b\x21\x6e\x54\x02\x67\x67\xf0\x92\x34\x9a\xd8\xed\xcd\x12\xf3\x1b\x94\x2e\x3f\x90\x1c\x52\xf7\x6c\x3b\x3a\xd2\x9c\xe6\xc5\xc9\x2b\x33\xfc\xcd\x19\x70\x56\x19\x89\x81\x0f\xa7\x97\xc6\x74\x7a\xd3\xba\x8d\xbc\x6e\x9d\xd1\xc7\x7b\x64\x9b\xc8\xbf\xd2\x42\xda\x7c\xe8\x11\x4e\xc2\xa0\x05\xac\x14\x9a\x8d\x08\xcd\x55\xa3\xe1\x6f\x14\xdd\x82\xc4\x98\x27\x7c\xdb\xf0\x3c\x26\xdf\x4d\x39\xe5\x18\x56\x76\x1a\x13\x3c\x81\xf8\x97\xac\x6a\x70\xe8\xa2\x20\xf0\x87\xe5\x18\xc4\x0d\x25\xac\x55\x1a\x1b\x97\xa7\x7e\x99\x3f\x91\x0e\x9d\x6f\xc4\xcd\xd8\x6e\xce\xaa\x63\x28\x57\x3a\xbd\x20\xa9\x65\x1c\x35\x1c\xef\x6a\xeb\xe4\xee\x24\x9a\x0c\xf5\xe2\x43\x74\x0c\xab\xc4\x43\xf4\xb8\x33\x9e\xe3\x8d\x5d\x77\xf4\x9b\x75\x0e\xd9\x4e\x25\x70\xd6\xa9\x4a\xa3\xcf\xf7\x57\x8f\x67\x5a\x08\x8e\x70\x06\x5a\x36\x18\xf6\x6a\x8d\x44\x87\x41\x60\x70\xa5\xac\xca\x08\xfc\x07\x42\xf8\x04\xbe\x7b\x05\x0c\xfe\x7c\x06\x2f\x5f\x01\x3b\x39\xe9\xbc\x37\xa1\x9f\x59\xf2\x91\x7d\x8e\x57\x8d\x26\xf9\x64\x32\x2b\xe1\x37\x6b\xcf\xa9\x31\xd6\xfa\xd7\xe8\x7d\x0c\x5b\x70\x4c\xe0\xba\x8d\x6a\x1b\xd2\xbf\x49\xa3\xfa\x3c\xf5\xab\xe5\x21\x37\x68\x7e\x1d\xc3\xa2\xd1\x50\x67\x9c\xe5\x8a\xfc\x9e\x71\x1b\x88\x20\xf2\xbc\x91\xea\x49\x09\xe8\xd7\xe9\x0c\x44\xb5\x76\x13\x6e\xf9\xef\x74\x17\xa0\x81\xc7\x5c\x88\x0d\x6c\x35\x1a\xc6\x28\x65\x32\x65\xa3\x33\xef\xe2\x0e\xf3\x89\x34\x7c\xb0\x11\xb4\x7e\xda\x06\x8b\xc9\x26\x0c\x7e\x3b\x44\x7d\xa7\x5d\x8f\x3b\x09\xee\x71\xa7\x5f\x5f\x0b\x77\x23\x79\x5a\xe7\x4d\x87\xe3\x84\xb6\xde\xd4\xdd\xa8\x1a\x23\x3d\x59\x32\xdf\x3b\x41\xd1\x90\x43\x39\xea\x18\x59\x66\xf9\x84\xb2\x6a\xd2\xc6\x44\x5d\x3d\xa4\x54\x6f\xed\xea\xb6\x98\xe9\x55\x5d\x75\x44\xaf\x84\xa8\x60\x59\x85\xb9\x9e\xbf\x50\x73\xcf\x82\x87\xb9\xc2\x2c\xba\xeb\x14\xb3\xcb\x27\xd4\x99\x09\x8e\x13\x5c\xf4\x27\x3e\x4d\x47\x87\x6c\x74\xb0\x72\x9b\x90\x1e\xcc\x47\x47\x32\x1e\xa4\xa4\x19\x28\xc6\xaf\x2b\x9c\xe0\xa6\xf7\x03\x66\x3a\x16\xb8\x4b\x4e\x2d\x0f\x85\x8f\x9f\x95\x96\x8c\x3f\xce\x56\x1f\xe7\x6b\x63\x18\x0e\xa3\x6c\xcf\x16\xf8\x38\x6d\x43\x8a\x0a\xc8\xaa\x4a\xdc\x2a\x50\xe6\x17\xc1\x47\x45\x42\x48\x58\x09\x89\xe0\x30\x88\x73\x51\x35\x2b\xae\x12\xdb\x6c\xa1\xa3\x75\x58\x74\xa8\xce\xe7\xf0\x61\x89\xe0\xb8\x10\xb9\xa7\x17\x98\x55\x95\x17\x64\xbb\xb1\xc2\x77\x72\x76\x35\xa8\x7c\x89\xab\xec\xc1\x53\x3f\xb2\x34\x71\xba\xc7\x46\x2a\x58\xff\x1c\xfb\x3d\xd2\x34\xb5\x6f\x92\x89\xa5\x9e\xb4\x0f\xb3\x81\x5b\xd7\x11\x76\xef\xf1\x8d\x19\x68\xbd\xe0\xc7\x98\xfa\x13\x98\xb0\xf5\x4d\x31\x1d\xa0\x87\xa2\x00\x0f\x12\xdf\xa3\xa1\xec\xc7\x28\x30\xf1\x71\x18\xad\xf8\xdf\x22\xc2\x86\x10\xee\xa3\xc2\x64\xfc\xff\x69\xf0\xef\x47\x83\x87\xce\x78\x3a\x11\x7e\x9e\xb7\x1e\x25\xc1\x9d\xd8\x3f\x1e\x01\x1e\xe0\x39\xa2\xc0\xbd\x49\xff\x09\xfa\xbb\x9d\x90\xf7\x33\xe0\x51\x5e\x21\xcd\x7b\x17\x7e\x1d\x1e\x4c\xf2\x1e\xe6\xc0\x20\x86\xf5\xe6\x29\x76\xfd\x41\x48\xf1\x84\xd6\xff\xe5\xbc\xf8\x00\xea\xf2\x1c\x6a\x3c\x10\xfb\xfb\xb2\xe3\xfe\x71\x7e\x04\x6a\x99\x49\x2c\x3c\xa7\x74\xfc\x62\x81\xfa\x16\xd1\x06\xa2\xbe\x15\x8e\x04\x48\x05\xe6\xae\x7a\xe7\xaa\xda\x33\x4b\x52\xc1\x24\x0f\xf8\xf8\xf9\x47\x21\x6e\xc2\x2e\x95\xc1\x64\x39\xd8\xa7\x8c\x21\x82\x20\x71\x25\xd6\x59\xf5\x64\x65\x1c\x8d\x74\xec\x7d\xd0\x06\xd4\x99\xca\xb3\x0a\xd2\xab\x5c\xd4\x98\xbe\x1e\xb3\xfc\xaf\x7e\x37\xbd\xd9\xf8\x4b\x75\xb4\x3d\xc8\x85\xb1\xca\xfb\xd6\x9c\x10\x7a\x8f\x1d\x2a\x6f\x2a\xcc\x6c\x88\xb9\x3e\xc2\xcc\x69\x5b\xc8\x69\x40\xd1\x2b\xaa\x74\x98\xfe\xcc\xd9\x97\x86\x00\x24\x48\xcc\xa5\x3c\xfa\xa4\x15\x19\xf0\x08\x57\x2a\xf9\x6d\x4b\x84\x76\x6a\x86\xea\xa0\x07\x2d\xba\x4b\x69\x4c\x3f\xdc\xd7\xb8\x43\xf3\x82\x03\x7b\xdc\x81\xce\xf1\xe4\x1d\xf4\xce\x25\xf4\x68\x45\x18\xec\xa3\xac\x81\x05\x8d\x95\xc0\x85\x1e\x21\xe0\x64\xd6\xa6\xfc\x89\x5b\x94\x10\xfb\xd3\xf2\x22\xfd\x8e\x9a\x9d\x81\x4d\x89\x5f\x30\x3f\x22\x59\xe6\xa2\x97\x4c\x75\x9d\x42\x9d\xc9\x6c\x85\x1a\x25\xa5\xa2\xb2\x62\xb9\x56\xf6\xf4\xda\x36\xc2\xa9\x63\x56\x98\xd0\x0b\x9c\x4e\xf8\x85\x14\x18\x41\x63\x75\x3a\x83\x68\x1d\xb9\x9f\x1d\x69\xa0\x21\x56\xa8\xb7\xce\xff\x36\x8c\xa2\xf7\x14\xec\x18\x41\x4c\xfd\x60\x53\x65\xb2\xf3\xd8\xbf\x5c\xdc\x26\x10\x5d\x9e\xdb\xb8\x0e\x7c\x80\x78\x39\x6d\x6b\x4f\x8b\x2b\x24\x53\x0e\x27\x37\xef\x73\x31\x43\x05\x8b\x7b\xb8\x3c\x57\x29\xd1\x89\xc3\x9d\xdd\xef\x1f\x33\xdb\xce\x0c\xb6\xb8\x3c\x37\x7f\xf7\x7d\x8f\x98\x0e\x86\xb1\x44\xdb\xc7\xec\x0f\x8b\xc0\x03\x5a\x4e\xa3\x39\xc3\xf4\xca\x24\xb6\xb7\xa6\xfb\x1a\x42\x57\x6e\xe1\x36\x79\x48\x1e\x01\xed\x89\x68\xf9\x40\xb7\xc1\xd1\xb6\x84\xd7\xd1\xae\xf8\x3d\x68\x11\xc0\xc4\xbd\xb2\x1b\x8c\x3f\x7e\x9e\xc4\xf9\xb8\x63\x80\x24\x3e\x49\x3c\xc8\x86\x1c\x46\x8c\x62\xa7\x8f\x58\x66\x67\xd9\xf1\x33\x88\xfe\xe9\x86\x29\x50\x4f\x2c\xbd\x25\x62\x69\xc7\xdb\xd6\xe4\x45\x93\xcf\x3a\xf5\x2d\x57\x66\x85\xfa\xe8\x27\x7d\x76\x6c\x92\x86\xfb\x97\xe9\xe5\x79\x47\xd7\xa7\x3d\xb9\xdf\xf5\xf6\xe0\xbb\xc3\x33\xf5\x34\x2a\x1c\x5d\xed\xf3\x5f\xda\xbe\xf7\x5f\x6a\x1c\x9a\x7b\x8b\x86\x2f\xe0\xe3\x6f\xae\xae\x54\x0c\x3e\xb6\x6e\x97\x0a\x37\xe3\xfd\x20\x2a\x9f\x52\x75\x9e\x58\x50\xf6\x77\xb8\xf3\xb9\xbf\xd0\x50\xa0\x50\xdb\x3c\xe0\xaf\x38\xd6\xd4\xb1\x29\x9f\xe6\xbc\x6e\x0b\x2c\x85\x44\x50\xd9\x1a\x0f\x4f\xf1\x7d\xe3\xdc\x35\xc2\xae\xca\x95\xb6\xca\xbd\xb5\x0c\xa2\x1d\x35\x67\x65\x3a\xd1\x93\xfb\xa6\xc2\xb6\x8c\x93\x95\xa1\x2b\x8d\x7f\x41\x6d\x4a\xc4\x2b\xdb\x3a\x6e\x9c\xd4\x9f\x6a\x1a\xcc\x2a\x52\xf5\xdb\x6f\xe1\x9b\x69\x21\xe3\x14\x60\xaa\x2c\x16\x71\xd2\x67\x65\xdf\xf5\xad\xbd\x22\x03\x8f\x3b\x19\x23\xfd\xdd\x41\xed\xd4\xb8\x54\x1f\x98\x79\x13\x27\xc3\x4c\x3f\x91\xe2\xae\x50\x4f\xe9\x14\xaf\x93\x3d\x2d\xe6\xf0\xc7\x36\x8f\x7b\xa0\xed\x9d\xcf\xc1\x44\x34\xc8\x86\x2b\x73\xab\x65\x03\xdc\x5c\xef\x34\x0a\xe5\x89\xbf\xde\x5a\x67\x15\x2b\x32\x2d\xa4\xf2\x9d\x47\xf7\x39\xfa\xd0\x98\x70\x7d\x74\xdf\x66\x1c\x12\x16\xa6\xb0\x92\x36\xb1\x90\x04\xc8\x2f\xbd\x1e\x06\xd1\x0b\xde\xac\x12\x88\x4d\xbd\x2f\xd3\xcb\x15\xa1\xb8\xa8\x7c\x09\x37\xb1\xb3\x7e\x6a\xec\x74\xb7\x0e\x46\x83\xd9\x22\x53\xcc\x72\xb0\x32\x7d\x4d\xcf\x26\x8d\xda\x92\x6d\xa7\x8d\xdb\x8f\xdd\xa8\xe8\x94\xf6\x69\xdd\x8a\x9c\x6c\x81\xfb\xe4\xf7\xad\x5b\xc6\x04\x37\xd7\x1d\x1b\x8a\xa7\x53\x5b\x81\xca\xae\x02\x99\x56\xed\x74\x74\x29\xe2\x33\x53\xdb\x9e\xf6\x7e\x83\x32\x63\x15\x16\x26\xe5\xd9\x4b\xc6\x4f\x63\x49\x9f\xa2\x53\x78\x71\x6b\xe5\x25\xce\xb0\xf6\x80\x70\x1b\x7a\x71\x87\xc2\x76\x67\x9b\x5c\xd8\x13\xb2\xd8\xf1\x33\x7f\x30\x93\x43\x8f\xfa\x76\xa1\xbe\x3c\x27\x87\x1d\x32\xb3\x3f\xcf\x94\x01\x7a\x17\xf7\x6d\xac\x90\x2a\x7d\x87\xb7\x63\xfc\x0c\xa7\xb6\x57\xf0\x12\xbf\x34\x4c\x9a\xff\xeb\x63\x8c\x30\x74\xe9\xd3\x98\x10\x7c\x8a\xa2\x43\x8e\xe8\xe0\xa2\x63\xf7\xbc\xba\xc7\x7f\x07\x00\x00\xff\xff\xcf\xbf\xd8\x49\xae\x24\x00\x00")
+var _templateBuilderUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x6b\x6f\xdc\xc6\xd5\xfe\x4c\xfe\x8a\x13\x62\x6d\x90\x82\x34\x6b\xfb\xdb\x2b\x43\x2f\x90\x58\x72\x23\xa0\x75\x8a\xc8\x49\x03\xd8\x46\xc0\x25\x0f\xb5\x53\x71\x87\xf4\xcc\x70\x25\x61\xcb\xff\x5e\x9c\xb9\xf0\xb2\xcb\x95\x56\x6a\x1a\x34\x45\x3f\x69\x39\x97\x33\xe7\x3c\xe7\xf6\xcc\x68\xb3\x99\x1f\x85\xef\xaa\xfa\x5e\xf2\xeb\xa5\x86\x37\xaf\x5e\xff\xdf\x49\x2d\x51\xa1\xd0\xf0\x3e\xcd\x70\x51\x55\x37\x70\x29\x32\x06\xdf\x96\x25\x98\x45\x0a\x68\x5e\xae\x31\x67\xe1\xc7\x25\x57\xa0\xaa\x46\x66\x08\x59\x95\x23\x70\x05\x25\xcf\x50\x28\xcc\xa1\x11\x39\x4a\xd0\x4b\x84\x6f\xeb\x34\x5b\x22\xbc\x61\xaf\xfc\x2c\x14\x55\x23\xf2\x90\x0b\x33\xff\xe7\xcb\x77\x17\x1f\xae\x2e\xa0\xe0\x25\x82\x1b\x93\x55\xa5\x21\xe7\x12\x33\x5d\xc9\x7b\xa8\x0a\xd0\x83\xc3\xb4\x44\x64\xe1\xd1\xbc\x6d\xc3\x70\xb3\x81\x1c\x0b\x2e\x10\xa2\xa6\xce\x53\x8d\x11\xb4\x2d\x8d\xce\xea\x9b\x6b\x38\x3d\x83\x45\xaa\x10\x66\xec\x5d\x25\x0a\x7e\xcd\xfe\x9a\x66\x37\xe9\x35\x82\xdb\xaa\x71\x55\x97\xa9\x46\x88\x96\x98\xe6\x28\x23\x98\xed\x4e\xf1\x55\x5d\x49\xed\xa7\xec\x17\xc4\x61\xb0\xd9\x9c\x80\x4c\xc5\x35\xc2\xac\x4e\xf5\x92\x0e\x9b\xb1\x2b\xbe\x28\xb9\xb8\xbe\x34\xab\x14\x09\x0b\x82\xc8\xa8\x43\x4b\xda\x36\xb2\xfb\x50\xe4\x34\x97\x18\x03\x66\x8b\x86\x97\x04\x97\x91\xf0\x93\x31\xe3\x43\xba\x42\x6f\x89\xc4\x0c\xf9\xda\xce\x77\xbf\xbb\x4d\x6e\xd1\xaa\xd1\xa9\xe6\x95\xa0\x45\xb5\xe4\x42\x0f\xf6\x45\xcc\xcf\x1a\x74\xc2\xf9\x1c\x86\xc7\xb6\x2d\xb9\x8e\x7c\xe1\x47\x8a\x4a\x82\x81\x93\x8b\x6b\xb3\x94\x39\x7d\x00\x85\xe6\x9a\xa3\x62\xa1\xbe\xaf\x71\x5b\x8c\xd2\xb2\xc9\x34\x6c\xc2\x20\x33\x78\x5b\x63\x7b\x28\x8d\x4c\x9c\x17\x1c\xcb\x5c\x11\xa2\x27\x6d\x1b\x5a\x85\xfe\xb6\x44\x89\x90\xe6\xb9\x82\x14\x04\xde\x42\x2d\x31\xe7\x19\x39\x87\xb4\xd1\xcb\x9d\xc3\xdc\x4f\x16\x16\x8d\xc8\x20\x1e\x22\xd5\xb6\x70\x34\x5e\x9d\xd8\x03\xe2\x5a\x01\x63\xac\x93\xcd\x86\xc6\x25\xdb\x9b\xc8\x90\x11\xb6\x6d\xdb\x6f\x55\x70\x06\x69\x5d\xa3\xc8\xe3\xfd\x6b\x8e\xa1\x56\x8c\xb1\x24\x0c\x24\xea\x46\x0a\x18\xf9\xd3\x1a\xbf\xd9\xc0\x2d\xd7\x4b\xc0\x3b\x4d\x61\x31\x83\xe8\x3b\x6b\x59\x34\xd4\x25\x0c\x46\x41\xa9\x50\x6b\x5a\xc1\x5c\x90\xb8\x80\x7a\x9e\x30\xe7\x16\xcc\xaf\x51\xed\x8a\x9c\xcf\xe1\x2a\x5d\x23\xe0\x1d\x66\x0d\xd9\x4d\xbe\xf8\xda\xa0\xbc\x87\x54\xe4\x60\x0d\xb3\xa3\xa2\x59\x2d\x50\x52\xbe\x8a\x2a\x47\x05\x69\x51\x60\xa6\x31\x87\xc5\xbd\x99\xb7\x07\x41\x55\xa3\x34\x60\x4d\xf9\x0e\xa6\x9c\x47\x0a\xc4\x99\xbe\x83\xac\x12\x1a\xef\x34\xe5\x33\xfd\x4d\x20\xe6\x42\x1f\x03\x4a\x59\xc9\x84\xfc\xb5\x4e\x25\x25\x67\x80\x52\xda\xd1\x30\x08\x3a\x35\xb8\xd0\x61\x90\x10\x94\x27\xc0\x0b\x98\xb1\xef\x53\x65\xf3\xed\x1c\x8b\xb4\x29\xb5\xc1\x26\xd8\x52\x87\xe5\x76\x52\xc5\x6e\xab\x43\x26\xe0\x05\x94\x28\xb6\xb5\x67\xcb\xaa\xba\x51\x09\x9c\x9d\xc1\x2b\xd2\x68\xe2\xb4\x77\x4b\xcc\x6e\x50\xba\xfa\x40\x72\x48\xdd\xb3\xed\xe8\x60\x19\xad\x8b\x93\xb7\x64\x09\x7c\x73\x06\x82\x97\x46\x62\xe0\xc3\xe9\x95\x31\x9d\x46\xda\x30\x18\xe9\xd6\x19\x7d\xbc\x47\x36\x7d\xb3\x2b\x5d\x49\x5b\x0f\x3d\xc2\x49\x18\xb4\x80\xa5\x42\x73\x10\xa1\xb9\x6a\x34\xfc\x85\xa2\xbb\x22\x31\xe6\x17\xbe\x6f\x44\x16\x93\xef\xa6\x9c\x72\x0c\x2b\xbb\x81\x57\x22\x81\xf8\xe7\xb4\x6c\x70\xe8\xa2\x20\xf0\xc9\x72\x0c\xd5\x0d\x15\xac\x15\x8b\x8d\xcb\x99\xdf\xe6\x33\xd2\xa1\xf3\x4d\x75\x33\xb6\x5b\xf0\xf2\x18\x8a\x95\x66\x17\xe4\xe2\x22\x8e\x1a\x81\x77\xb5\xb1\x17\xba\x4c\x34\x15\xea\xc5\xc7\xe8\x18\x56\x89\x87\xe8\x71\x67\x3c\xc7\x1b\xbb\xee\xe8\x0f\xeb\x1c\xb2\x5d\x4a\xe0\xac\x53\x35\x0c\xfe\x15\x7f\xf5\x78\xb2\xbc\x12\x08\x67\xa0\x65\x83\x61\xaf\xd6\x48\x74\x18\x04\x06\x57\xaa\xaa\x9c\xc0\x7f\x20\x84\x4f\xe0\xf5\x5b\xe0\xf0\xff\x67\xf0\xea\x2d\xf0\x93\x93\xce\x7b\x13\xfa\x99\x2d\x9f\xf8\x97\x78\xd5\x68\x92\x4f\x00\xf0\x02\x7e\x35\x87\xd2\x39\xab\x46\x5b\xff\x1a\xbd\x8f\x61\x0b\x8e\x09\x5c\xb7\x51\x6d\x43\x82\x75\xd2\xa8\xbe\x4e\xfd\x42\xcd\xac\xe4\x37\x68\xaa\xd6\x31\x2c\x1a\x0d\x75\x2a\x78\xa6\xc8\xef\xa9\xa0\xe5\x95\x84\x2a\xcb\x1a\xa9\x9e\x54\x80\x7e\x99\xae\x40\xd4\x6b\x37\xe1\x96\xff\x4e\x77\x01\x1a\x78\x8c\x17\xdb\xb6\x1a\x0d\x63\x94\x32\x99\xb2\xd1\xf5\xc8\x8b\x3b\xcc\x26\xca\xf0\xc1\x46\xd0\xfe\x69\x1b\x2c\x26\x9b\x30\xf8\xf5\x10\xf5\x9d\x76\x3d\xee\x24\xb8\xc7\x9d\xbe\x7e\x2b\xdc\x49\xd6\x1e\xdc\x37\x1d\x8e\x13\xda\x7a\x53\x93\xb7\x0f\x23\x3d\xd9\x32\x7f\x74\x82\xa2\x81\xcc\xc8\x51\xc7\xc8\x32\xcb\x27\xb4\x55\x53\x36\xd4\xf3\x5a\xf5\xd6\xa9\xee\x88\x99\x5e\xd5\x65\x47\xf4\x0a\x88\x72\x9e\x96\x98\xe9\xf9\x0b\x35\xf7\x2c\x78\x58\x2b\xcc\xa6\xbb\x4e\x31\xbb\x7d\x42\x9d\x59\x25\x70\x82\x8b\xfe\x20\xa6\xe9\xe8\x90\x8d\x0e\x76\x6e\x13\xd2\x83\xf9\xe8\x48\xc6\x83\x94\x34\x05\xc5\xc5\x75\x89\x13\xdc\xf4\x7e\xc0\x4c\xc7\x02\x77\xc9\xa9\xe5\xa1\xf0\xe9\x8b\xd2\x92\x8b\xc7\xd9\xea\xe3\x7c\x6d\x74\xe2\x81\x94\xed\xd9\x02\x1f\xa7\x6d\x48\x51\x01\x69\x59\x56\xb7\x0a\x94\xf9\x22\x46\x4f\x4d\xa2\x92\xb0\xaa\x24\x82\xc3\x20\xce\xaa\xb2\x59\x09\x95\x10\x79\x23\xdc\x6d\x8e\x63\xde\xa1\x3a\x9f\xc3\xc7\x25\x82\xe3\x42\xc0\x87\x02\xd3\xb2\xf4\x82\xec\x6d\x2c\xf7\x37\x39\xbb\x1b\x54\xb6\xc4\x55\xfa\x60\xd6\x8f\x2c\x4d\x9c\xee\xb1\x91\x0a\xd6\x3f\xc7\xfe\x0c\xc6\x98\x1d\x49\x26\xb6\x7a\xd2\x3e\x38\x80\xb9\x7d\x1d\x61\xf7\x1e\xdf\x98\x89\xd6\x0b\x7e\x8c\xa9\x3f\x81\x09\x5b\xdf\xe4\xd3\x01\x7a\x28\x0a\xf0\x20\xf1\x3d\x1a\xca\x7e\x8c\x02\x13\x1f\x87\xd1\x8e\xff\x2e\x22\x6c\x08\xe1\x3e\x2a\x4c\xc6\xff\x8f\x06\xff\x7e\x34\x78\xe8\x8c\xa7\x13\xe1\xe7\x79\xeb\x51\x12\xdc\x89\xfd\xe3\x11\xe0\x01\x9e\x23\x0a\xdc\x9b\xf4\xef\xa0\xbf\xa3\x52\xf4\x20\x03\x1e\xd5\x15\x82\xa8\x53\xec\x11\x22\xc9\x8b\x6d\x93\xa7\x79\x30\xc9\x7b\x98\x03\x43\x35\xec\x37\x4f\xb1\xeb\x0f\x42\x8a\x27\xb4\xfe\x0f\xe7\xc5\x07\x50\x97\xe7\x50\xe3\x81\xd8\xdf\x97\x1d\xf7\x3f\xe7\x47\xa0\x96\xa9\xc4\xdc\x73\x4a\xc7\x2f\x16\xa8\x6f\x11\x6d\x20\xea\xdb\xca\x3e\xa1\x52\x49\x35\x6f\xd5\x3b\x4f\xd5\x9e\x59\x92\x0a\xa6\x78\xc0\xa7\x2f\xdf\x57\xd5\x4d\xd8\x95\x32\x98\x6c\x07\xfb\x94\x31\xef\x77\x20\x71\x55\xad\xd3\xf2\xc9\xca\x38\x1a\xe9\xd8\xbb\x87\x98\x60\x4c\x55\x96\x96\xc0\xae\xb2\xaa\x46\xe6\x1c\xe1\xd4\xf8\xed\xdf\xa6\x37\x1b\xff\xa8\x8e\xb4\xdc\x1a\xbf\x28\xf1\x82\xd4\xeb\x5c\x6c\x12\x85\xa6\xb1\x03\xe7\x5d\x89\x29\xe5\x64\x18\xb8\xeb\x84\x59\xd3\xb6\x90\xd1\x84\xa2\x21\x7a\xf2\x43\xf6\x93\xe0\x5f\x1b\xc2\x91\x90\x31\x6f\xf3\xe8\x6b\x57\x04\x84\x02\xc1\x4b\x0f\x60\x6d\x4b\xbc\x76\x6a\x85\xea\x3c\x00\xba\xea\xde\xa6\x91\x7d\xbc\xaf\x71\x87\xed\x05\x0f\x64\x75\x0f\x54\x32\xd4\x39\x4e\xb6\xa7\x1d\x7f\x1a\x35\x0c\x36\xda\x11\x76\xed\x62\xeb\x24\x4a\x67\xda\xca\xe9\x55\x56\x8f\x10\x70\x32\x6b\x42\xba\xac\x6e\x51\x42\xec\x93\xe6\x05\x7b\xad\xa2\x91\x4d\x89\xdf\x30\x3f\xa2\x56\x42\x56\x0b\x02\xc5\x5d\x18\xea\x54\xa6\x2b\xd4\x28\xa9\x90\x16\x25\xcf\xb4\xb2\x49\x4c\x0b\x3b\x75\xcc\x0e\x93\x0e\x81\xd3\x09\xbf\xc2\xac\x1e\x43\x43\x06\xd4\x70\x06\xd1\x3a\x72\x9f\x2e\xdc\xcd\x9e\x19\xcf\xd5\x7b\xe7\x7f\xa3\x2d\x44\x3f\x52\xcc\x63\x04\x31\x5d\x0b\x9b\x32\x95\x9d\xc7\xfe\xe1\xc2\x37\x81\xe8\xf2\xdc\x86\x77\xe0\x03\xc4\xcb\x69\x5b\x9b\x34\xae\x9f\x4c\x39\x9c\xdc\xbc\xcf\xc5\x1c\x15\x2c\xee\xe1\xf2\x5c\xb1\x30\x78\x8a\xb3\xfb\xf3\x63\x6e\x6f\x35\x83\x23\x2e\xcf\x4d\x34\xed\xfb\xb7\xc4\x74\x30\x8c\x25\xda\xeb\xcc\xfe\xb0\x70\x44\xd9\xc5\xd0\x2e\x9a\x33\x64\x57\xa6\xbe\xbd\xa7\x3a\x35\x82\xae\xd8\xc2\x6d\x32\x49\x1e\x01\xed\x89\x68\xf9\x40\xa7\xc5\x35\xad\x62\x8c\x1d\xed\x8a\xdf\x83\x16\x01\x4c\x14\x2c\xbd\xc1\xf8\xd3\x97\x49\x9c\x8f\x3b\x22\x48\xe2\x93\xc4\x83\x6c\x38\x62\xc4\x29\x76\xfa\x88\xe5\x56\x09\x12\xc4\x29\x52\xff\xee\xa6\x29\x50\x4f\x0c\x52\x86\x5f\xda\xf9\xb6\x25\x11\xb6\xac\x75\xea\x1b\xb5\x02\x9e\xab\x4f\x7e\xd1\x17\x47\x2a\x69\xba\x1f\x64\x97\xe7\x1d\x6b\x9f\xf6\xe4\x7e\xd7\xdb\xc4\x77\xc9\x33\xf5\x6b\xd4\x3f\xba\x16\xe8\xff\xe1\xf6\xc6\x35\x0d\xff\x08\xb3\xb7\x77\xf8\x3e\x3e\xfe\xd7\xab\xeb\x18\xae\x49\xfb\xc9\x4e\xf1\x7e\x85\xe7\x15\x7e\xc9\xa1\xcd\xa7\x0b\xff\x2e\x76\x1f\xec\x2b\xfb\x2f\xba\xf3\xb9\x7f\xd7\xa0\x57\x0d\x6d\xeb\x80\x1b\x81\x35\x5d\xdc\x94\x2f\x73\x5e\xb7\x05\x16\xf4\x7c\xa2\xd2\x35\x1e\x5e\xe2\xfd\x21\x71\xd2\xdd\x87\x5d\xb3\x2b\x6c\xb3\x33\x99\xe6\xaf\x60\xfe\x8e\x56\xb0\x5d\x8d\x03\x7f\xb7\xb0\x37\xc7\xa9\x62\x50\x74\xad\xf1\x4f\x48\x66\xd2\xf5\xd9\xdc\x20\x37\x4e\xea\x0f\x35\x2d\x4f\x4b\x4a\x92\x97\x2f\xe1\x9b\x69\x21\xe3\x12\x60\xba\x2c\xe6\x64\x80\x0f\x22\x7f\xf9\x5b\x7b\x45\x06\xff\x65\x77\x32\x46\xfa\xbb\x44\x75\x0e\x29\xd8\xa5\xfa\xc8\xcd\x48\x9c\xf4\x81\x19\x04\x13\x25\xee\x0a\xf5\x94\x4e\xf1\x3a\xd9\x73\xd3\x1c\x7e\x6c\x85\xfd\x43\xb7\xdf\xf9\x1c\x4c\x44\x83\x6c\x84\xa2\xe7\x33\xfb\xa9\xcc\x2b\x4f\xa3\x50\x9e\xf8\x57\xae\x75\x5a\xf2\x9c\x9e\x04\x94\xbf\x80\x38\x77\x1f\x1e\x13\xee\x3a\xdd\xdf\x36\x0e\x09\x0b\xd3\x58\x49\x9b\xb8\x92\x04\xc8\xcf\xbd\x1e\x06\xd1\x0b\xd1\xac\x12\x88\x4d\xbf\x2f\xd8\xe5\x8a\xdc\xba\x28\x7d\x0b\x37\xb1\xb3\x7e\x6a\xec\x74\x8f\x0f\x46\xc1\xd9\x22\x55\x3c\xa3\xfd\xb3\x82\x7d\x47\xbf\x4d\x19\xb5\x2d\xdb\x2e\x1b\xdf\x42\x76\xa3\xa2\x53\xda\x97\x75\x2b\x72\xf2\x26\xdc\x17\xbf\x97\x6e\x1b\xaf\x84\x79\xf5\xd8\x50\x3c\x9d\xda\x0e\x54\xf8\x2e\x10\x99\x1b\xdb\xe9\xe8\x6d\xc4\x57\xa6\xb6\x3d\xed\xfd\x06\x45\xca\x4b\xcc\x4d\xc9\x33\x74\x1c\x3e\x8f\x25\x7d\x8e\x4e\xe1\xc5\xad\x95\x97\x38\xc3\xda\x03\xc2\x6d\xe8\xc5\x7d\x4c\xb6\x4b\x71\xf2\x64\xcf\xcb\x62\x47\xd3\x7c\x7e\x26\x87\x66\xfc\x76\xbf\xbe\x3c\x27\xbf\x1d\xb2\xb2\x4f\xeb\x97\x2f\x07\xcf\x4c\x1e\x73\x13\x9b\x8a\x7d\xc0\xdb\x31\x8c\x86\x5a\x9b\x07\x60\x90\xf8\xb5\xe1\x74\x0b\x69\xac\x11\xc4\x00\x1c\x96\x5d\x6f\xfe\x1c\x45\x87\x64\xaa\x3f\x55\xf0\x72\x37\x6d\x51\xe4\xd0\xb6\xe1\x3f\x07\x00\xcd\x30\x15\x24\xbc\x24\x00\x00")
 
 func templateBuilderUpdateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -287,12 +302,12 @@ func templateBuilderUpdateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/builder/update.tmpl", size: 9390, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/builder/update.tmpl", size: 9404, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateClientTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x59\xdf\x73\xe3\xb6\xf1\x7f\x96\xfe\x8a\xfd\x72\x7c\xf7\x25\x3d\x3a\x30\xcd\x5b\xd5\xf1\xc3\xe5\x7c\x4d\x35\x93\xd8\x49\xcf\x69\x3b\x93\xc9\xe4\x60\x70\x49\x21\xa6\x00\x1a\x84\x6c\x79\x54\xff\xef\x9d\xc5\x0f\xfe\x90\x68\x9f\x93\xbb\x4e\x5f\x6c\x11\x20\x76\x17\x9f\xfd\xec\x62\x17\xdc\xef\xf3\xd3\xf9\x3b\xdd\x3c\x18\x59\xad\x2d\x7c\xfd\xd5\x9f\xfe\xfc\xa6\x31\xd8\xa2\xb2\xf0\x57\x2e\xf0\x5a\xeb\x1b\x58\x29\xc1\xe0\x6d\x5d\x83\x7b\xa9\x05\x9a\x37\x77\x58\xb0\xf9\xd5\x5a\xb6\xd0\xea\xad\x11\x08\x42\x17\x08\xb2\x85\x5a\x0a\x54\x2d\x16\xb0\x55\x05\x1a\xb0\x6b\x84\xb7\x0d\x17\x6b\x84\xaf\xd9\x57\x71\x16\x4a\xbd\x55\xc5\x5c\x2a\x37\xff\xdd\xea\xdd\xfb\x8b\x0f\xef\xa1\x94\x35\x42\x18\x33\x5a\x5b\x28\xa4\x41\x61\xb5\x79\x00\x5d\x82\x1d\x28\xb3\x06\x91\xcd\x4f\xf3\xc7\xc7\xf9\x7c\xbf\x87\x02\x4b\xa9\x10\x12\x51\x4b\x54\x36\x81\x30\x7c\xd2\xdc\x54\xb0\x3c\x83\x6b\xde\x22\x9c\xb0\x77\x5a\x95\xb2\x62\x3f\x70\x71\xc3\x2b\xa4\x97\xf6\x7b\xb0\xb8\x69\x6a\x6e\x11\x92\x35\xf2\x02\x4d\x02\x27\x6e\xb9\xdc\x34\xda\x58\x48\xe7\xb3\xa4\xd6\x55\x32\x9f\xcf\x12\x92\x78\x2c\x24\xdf\xc8\xca\x70\x8b\xc9\x7c\xb6\xdf\x83\xe1\xaa\x42\x38\xf9\x75\x01\x27\x8a\x54\x9f\xb0\x0b\x5d\x60\x4b\x22\x67\x5e\x82\x9a\x10\xe1\xc7\xfb\x01\x27\xeb\x0d\xa0\x2a\x9c\x2d\xb3\x04\x95\xad\x34\x93\x3a\x47\x65\xf3\x42\xf2\x1a\x85\x3d\x52\x18\x4c\x76\x5a\x3f\x58\x6d\x78\x85\x6c\xe5\xc6\x5a\x78\xd3\x1b\x10\x5e\x0b\x5a\x9c\x12\x9a\xcd\xe6\xf3\x3c\x87\x77\x0e\x41\xf2\x23\x39\xc1\xe3\x09\x76\xcd\x2d\xac\x75\x5d\xb4\xc0\xeb\x1a\x68\xe8\x7a\x2b\xeb\x02\x4d\xcb\xe6\xf6\xa1\xc1\xb8\xac\xb5\x66\x2b\x2c\xec\xe7\x33\xe1\xf6\xe8\xb7\x21\x4b\x32\x68\xdb\x90\xda\xef\x3d\x58\x1e\x8f\x3c\x87\x0f\x62\x8d\x1b\x7e\xa0\xaf\xd4\x06\x84\x41\x6e\xa5\xaa\x16\xe0\xf1\x95\xaa\x02\xae\x0a\x28\x8c\x6e\x1a\x7a\x68\xdd\x4a\x36\x9f\xcd\x82\x8c\xd3\xe0\x08\xe6\x9f\x47\x10\xba\xdf\x01\xaa\x63\xbf\xe4\x39\x78\x0f\x5c\xf0\x0d\x99\x36\x61\x8e\x54\x16\x0d\x17\xce\x8c\x7b\x69\xd7\x6e\x7e\xbc\xa8\x87\x64\x36\x1b\xcf\x9c\x8e\x1e\x3d\x56\xc7\xe6\xf5\x4c\xf4\x7a\xf3\x52\x62\x5d\xb4\x39\x2f\x0a\x69\xa5\x56\xbc\x0e\xdc\x74\x6f\x3b\x23\x4e\xec\xa6\xa9\x5b\xda\xcf\x86\x5b\xb1\xbe\xfa\xa4\x84\xfc\x34\xf1\x5b\x1e\xe0\x41\x32\x1c\x24\x5e\x98\x9b\x76\xf3\xbb\xce\x22\xff\xce\x49\xbf\x74\x68\x77\xf8\xfd\xe8\xf8\x73\x81\xf7\x81\x0b\xce\x81\xd8\x02\x07\x85\xf7\x11\x4a\x4f\x8b\xad\xc1\xa2\x47\xb1\x92\x77\xa8\x40\x37\x64\x61\xcb\xe6\xe5\x56\x89\x5e\x4c\xaa\x1b\xdb\x02\x63\xec\xd2\xcd\x67\x70\x1a\xc4\x13\xc7\x4a\x17\xdd\x5e\xe6\xbe\xd6\xd5\x12\x6a\x5d\xb1\x1f\x8c\x54\xb6\x56\x0b\x58\x6b\x7d\xd3\x2e\xe1\xb5\xfb\xbf\x27\x73\x45\x59\xb1\xa0\xc8\x09\x66\x8c\x65\xf3\x59\xb0\x6d\x79\x06\xaf\xbd\xf0\xbd\x17\xb9\x04\x51\x56\x8f\x71\x9e\x49\x25\x6d\x9a\xcd\x67\x06\xed\xd6\xa8\xb0\x23\xda\xb6\xb3\x38\x15\xd1\xb4\x0c\xfc\x9b\x64\xe2\xb3\xf4\x17\x81\xa9\x70\x06\x91\xba\x17\x78\xef\xc7\x52\xc1\x0a\x23\xef\xd0\x64\x2f\xe6\x31\x00\xc0\x4c\xb0\x31\xf5\xce\x80\xb0\x9c\xe0\x5f\x2a\x98\xdf\x65\x36\xe1\xc5\xcb\xc6\x79\x04\x15\xb9\xaf\xe0\x96\x53\x06\xcd\xdb\xdb\x9a\x9d\x7f\x03\x6d\x83\x42\x96\x12\x0b\xb8\x7e\x70\x0e\xf4\x86\x82\x22\xf1\x5c\x15\x24\xc0\x0d\x73\xcb\x63\xbe\xa6\xb9\x85\x8b\x5f\x8f\xde\x01\x2d\xb8\xb5\x74\x42\x14\x60\x35\x48\xcb\xbc\x09\x9e\xb1\xd0\x70\xc3\x37\x68\xd1\xb4\x20\xb8\x82\x6b\x04\x5e\x14\x58\xf8\x24\x11\xe8\x44\x51\xd9\x07\x6c\xe0\x10\x6d\x22\xf5\xb6\x5d\x38\xf5\x64\xd0\x07\x67\x8f\x43\xa2\xb5\xc6\xe5\x97\x40\x88\x21\xc9\xd2\xe0\xca\x05\xa0\x31\xda\x38\x57\xb6\xf7\xd2\x8a\x35\xf4\x02\x1d\x05\xe9\x64\xd9\xef\xe1\x37\x2d\xd5\x20\xeb\x9e\xfb\x0c\xdd\x42\xb2\x00\x0a\xb8\x65\x08\x9b\x2e\xd6\x1a\xe2\x68\x09\x49\x48\xe5\xf9\xab\x36\x0f\x21\x4b\xa8\x27\xbd\xa8\x90\xb8\xa7\xc2\x91\xf9\xb9\x02\x4b\xbe\xad\x2d\xa9\x08\xcc\x54\xb2\x5e\x40\xb9\xb1\xec\x3d\x19\x5f\xa6\xc9\x56\xb5\x9e\x7e\x58\x04\xfb\x97\xf0\xea\x36\x59\x0c\x36\x93\xcd\x67\xd1\xf9\x57\xbb\x03\x27\x59\xc3\x55\x4b\xb9\xcf\xf9\x23\x60\x0c\x57\x6b\x84\xc6\xe8\x3b\x49\xce\x10\x5a\x59\xdc\x59\x5a\x2e\x5b\xd8\xfa\xe3\xdf\xca\xda\x79\x65\xb0\x9e\x66\x85\xde\x6c\xa4\x25\x5b\xb4\x01\xa3\xeb\x9a\x98\xc4\xc5\x0d\x3b\x0e\xa4\xab\x5d\x2a\xec\x2e\x4a\xa7\x83\x93\xfe\x93\x7f\xae\x76\x43\xdf\xc8\x12\x7e\x5d\x80\xbe\x71\xe9\x20\x04\x0e\x4b\x4f\xed\xee\xdc\xc7\xd0\x5f\x68\x6e\xff\x0c\x42\xb1\x58\x78\x7c\x5c\x12\xcb\x94\xa6\xb3\x8c\x1b\x0b\x7c\x64\x3d\xe5\x2c\xa9\xc6\x83\x89\x83\x6e\x66\xbd\x41\x64\x81\xc2\x7b\x6f\xf8\x02\x06\x51\x2c\x4b\x37\xff\x7f\x67\xa4\xfd\xc5\xc6\x38\x2b\xdc\xd9\x37\xd4\xb9\x84\x57\xf7\x89\xd3\xe7\x95\xc7\x4c\xc8\xe2\xb1\x4b\x89\x2e\x84\xe5\x19\xd8\x5d\x97\xb4\x5e\x5f\xed\x48\xb3\xb0\xbb\x25\x90\x81\xf4\xbb\xcf\x75\x0b\x7f\x72\x3d\x55\xc3\xbc\x89\x47\xc3\x20\x99\x2c\x9f\x4c\x2f\x65\x95\x05\x79\xb1\xc2\x98\x3d\x2e\x68\xb3\x73\x57\x9c\xbd\x81\xfc\x14\x56\xa5\x23\x48\x1b\xb8\x1e\x2c\x0e\x64\x6d\xe1\x6a\x77\x19\x62\x33\xad\xe5\x0d\xc2\x87\x1f\xbf\xcb\xc0\x15\x7d\x7d\x30\x4d\xc6\x92\xdd\x85\xa0\x1e\x46\x52\x58\x26\x4b\x58\xf3\xf6\x6a\x1c\x4b\x21\x7d\x4e\x87\x59\x58\x18\xab\xb1\x3c\x87\x73\xbc\xde\x56\x07\x51\x52\xd0\xd8\x9b\x18\x1d\x2b\xfb\xff\x21\x0e\xac\x86\x0a\x2d\xdc\xa1\xb9\xd6\x2d\xd2\x09\x55\x91\x3f\xb5\x8a\x89\x54\x50\xa6\xa5\x0a\xc7\x1d\x7f\x79\x3e\xcf\xf3\x78\xe4\x38\x3d\x69\x46\xa3\x0e\xc9\x54\xaa\x02\x77\x9d\x43\xbe\xca\x22\xe8\xfe\x8d\x1f\xb7\x68\x1e\xe2\xeb\xef\xf4\x96\xdc\x60\x77\x19\xc9\x3c\x0a\xad\x20\x7a\x78\x9e\xca\x92\xf8\xea\xb6\x36\xa0\xa7\x78\x01\xc3\x02\xf4\xc1\xde\x48\x7a\xa2\x7f\xad\xab\x2f\x70\xc2\xba\xc2\x94\xd0\x13\xf4\xb7\xed\x8e\x17\x57\xd8\x0b\xad\x14\xfa\xf8\xa4\x03\xa6\x31\x78\x87\xca\xb6\xce\x29\xb7\x5b\x34\x12\x5b\x28\x8d\xde\x74\xb1\x34\x91\x68\x9c\xf4\x34\xf3\x29\x85\x76\x1f\x4d\x88\xc9\x24\xbc\x10\x8c\xf9\xa9\x75\xa7\x90\x37\x64\xb3\xb5\xce\x79\xbe\xde\x20\x7f\x53\x91\x4c\x33\xa8\xac\xb4\x0f\x61\x1f\xce\xb7\xb0\x52\xa0\x8d\xeb\x8b\x34\x49\x18\xac\xe9\xe9\x20\xc2\xd9\x23\x78\x5d\x2f\xe1\x63\x00\x87\x5c\xce\x7e\x6a\x31\xa5\xa2\xe5\xe3\xc4\x1e\x68\xce\x8b\x63\x8c\xfd\x4d\xeb\x9b\xae\x02\x79\xb6\x29\x39\xa8\x18\x58\x27\xc6\x17\x47\xe3\xda\x60\xde\xa7\x87\x43\x49\x24\xa5\xf7\xb3\x0b\xca\x4e\x6c\xf2\xae\x6f\xcc\x42\xb1\x1d\x5e\xf5\xc5\x36\x1f\x96\xda\xc7\x95\x75\x2c\xf5\x5d\xab\x31\x5e\x7c\xd4\x71\x84\xce\xcf\xa0\x70\xf6\x29\xf6\x77\x14\xe8\x68\xfa\xf8\xb8\xdf\x53\xf4\xe3\xad\x9f\x4e\x44\xe2\xc7\xdc\x53\x9f\x47\x5e\xb1\xaf\x29\x6f\x04\xf5\xff\x86\x5a\xdf\xc7\xd5\x83\x14\x10\xd2\x5e\x6f\x49\x9f\x0d\x9e\xdd\x8b\x63\x62\x5f\xf6\x7a\xab\xfb\xaa\x77\x24\x33\x15\x61\x3e\xf3\x2d\x44\xaf\xac\x67\xe8\xeb\xd1\x44\x1f\x57\x8f\x87\x54\xe5\x50\xcb\xd6\x52\x23\x7d\x4c\x58\xb2\xc7\x3f\xb4\xd6\x9d\xc4\x79\x0e\x6f\x1d\xff\x68\xf6\x23\x51\xa2\x5c\x40\xb5\x80\x75\xf6\x11\xf0\x76\xcb\x6b\xb7\xec\xe3\x61\xdf\xea\x68\xd7\xa6\x65\x5a\xa5\xeb\x34\xcb\xb2\x11\x4f\x47\x86\x3e\x45\x57\xc1\x3c\xf7\x0e\xab\x58\xde\x34\xa8\x8a\x74\x72\x3a\x54\xfa\x8e\xaf\x21\x59\xb8\xde\x63\xe8\x12\x3f\x10\x5a\x34\xe7\x9a\x31\xeb\x9f\x34\xd3\x8b\x4a\xb3\xd8\xc4\xf9\xe7\x68\xd8\x7e\x3e\xeb\xd0\xf4\x27\xbf\x7f\xeb\xfb\x30\x18\xde\xeb\x8a\xed\x05\x5c\x36\x5e\x42\x36\xf6\xe0\x81\xe0\xde\x8f\xdd\xc2\xd0\xcd\x88\x80\x71\xb6\xe8\xfc\xb8\xec\x7e\x3d\x8e\xf6\xff\xcd\xb6\xbe\x19\x60\x30\xdc\x7c\xec\xae\xdd\x70\x7d\x43\xac\x18\x23\xee\x52\x97\xc4\xf6\x53\xc0\x90\x8e\x34\x76\xbe\xe4\xc9\x29\x98\x0e\xc0\xa3\x35\x03\x00\xa7\x60\x18\xbc\x32\x01\x45\xd4\xb7\xec\x7e\x75\x6c\x6f\x8a\x91\xe3\x15\x6c\xfd\xc8\x1f\xf0\xbc\x97\xd5\x7b\xde\x3f\x7f\x8e\xe7\xbd\x84\x23\xcf\x8f\x04\x7f\xa6\xe7\xbd\xac\x4b\xf5\x29\x0c\xfa\x0c\xe4\x0f\xa9\x4f\xc1\x70\xa9\x30\x8d\xa9\xf2\xe8\x46\xe3\x00\xa2\x4b\xf5\x05\x50\xba\x54\xb8\x20\x4f\xf9\x83\x24\xa1\xca\x3b\x19\xa8\x1c\x18\x93\x3d\x01\x68\x6f\xc6\x97\xc2\x74\x75\xfe\x62\x54\x65\xf1\x02\x44\x57\xe7\xa9\x2c\x02\x1d\x57\xe7\xec\x8a\x8e\xb7\xff\x01\x9a\xc9\xea\x9c\x4e\xc2\x54\x16\xff\x75\x28\xcf\xb1\xc6\x51\x62\x2e\xfc\xc0\x1f\x08\x4f\x2f\xaa\x0f\x4f\xff\xfc\x39\x50\x79\x09\x47\x10\x8c\x04\x7f\x91\xfd\x8f\xc2\x73\x0a\x82\x97\x47\x67\x27\xf0\x05\xd1\xd9\xbd\x7b\x9c\x7c\x45\x3f\xb9\x3a\x1f\x88\x62\xab\xf3\xec\xd0\xf4\x61\x14\x3c\x6f\xfc\x73\x41\x30\xd4\xf7\x5c\x10\x4c\x19\x1d\xb5\xb9\x86\x24\xf2\x80\xfd\x73\x8d\xc6\xc3\x30\x2a\x49\x9c\x7c\x22\x76\x58\xc5\xa2\x4f\x98\x2c\xe0\x0c\x5e\xcb\x62\x62\x4a\x37\x70\xd6\x31\xe2\x52\xe1\x34\x27\x06\x61\x11\x24\x44\x3f\xbb\x4e\x6c\x00\xd3\xad\x7b\xfe\x03\x2c\x0f\x2d\x5d\x44\xc3\x3d\x3e\x79\x76\x0e\x67\x8f\x88\x1a\x4d\xfb\x16\xed\xc0\xb0\x89\x53\xff\x01\xae\x1f\x40\xda\xf6\x59\xf7\x7d\x8b\x76\xea\x6a\x66\x01\x93\xbe\x4c\x4f\x0f\x0a\xb6\xfe\xea\xa6\x23\x60\x6c\x5e\x9f\x77\x23\xbb\x54\xf5\x83\xef\x6a\xbb\xed\xfc\xcb\x7f\x76\xba\x41\x7a\xa0\xea\xc0\x42\xc3\x95\x14\x2d\x55\xfb\x5c\x85\xa6\x4e\x0b\xb1\x35\xcf\xd4\x33\x24\xe8\x77\x6c\x69\xbc\x23\xda\x89\xbe\xfe\xad\xbb\x02\x12\x2c\x00\x44\xab\x27\x2f\x7f\x9c\x85\x69\x77\x83\x13\x60\xd0\xd7\xbf\x85\x0e\x26\x74\x59\x18\xba\x98\xf7\x45\xd5\xb7\x59\x03\xfe\x9f\xa0\x33\x69\xe4\x7a\xf7\x0e\x37\xae\x61\x0f\x71\xdc\x77\x3e\x34\x9e\xc8\x22\xb6\x3e\xf4\x78\x06\x89\xd2\x05\x26\xa3\x1e\x27\x92\x98\x0e\x0d\xde\x0a\x5e\x93\xaa\xb8\xdb\xd8\x56\xc7\xf6\xa6\x9f\xc1\xa2\x42\xaa\x26\xf9\xef\x22\xf8\x94\x92\x34\x9a\xf7\x54\x42\x8b\x28\x78\xf4\x7d\x84\x2d\xcf\x7c\x2c\xf4\x73\x13\x71\xe0\xdf\x65\x0d\xb7\x6b\x38\x03\x32\xec\x89\x5b\x46\xea\xd5\xfe\xe1\x36\xd2\xdd\xec\x7e\xd3\x09\x5e\xc0\xaf\x03\x1a\xf7\xdf\x64\x70\x67\x09\xc2\x13\x05\x49\x6c\x3d\x93\x00\x3b\x39\x31\x21\x9f\x26\xab\xc2\xb5\xc3\x89\xd3\x90\x40\x7f\xb1\xf6\xcc\x05\xb1\xb3\x3a\xa7\x15\x07\xb7\x5a\x4f\x7c\xae\x61\x83\xbb\xe3\xee\x0a\x2e\x12\xcd\x29\xf6\xd7\x71\x03\xfa\x39\x15\x73\x47\xa0\x41\xab\xeb\xce\xb5\x2e\x67\x0c\x3e\x8c\xf9\xb6\xeb\x49\xd7\x86\xf3\x10\x7e\xfe\x85\x7e\x0d\x3e\x87\x68\xe3\xbc\xb9\xdd\x78\xc9\xfe\xf7\x0f\xba\x96\xe2\xc1\xef\xc7\xf7\x85\x2e\x8e\x26\xfa\xbd\x7e\x17\xa1\x2b\x74\xef\xfc\xbc\xac\x51\xf9\x9f\xd9\xe0\xe7\x2f\x0b\x98\xee\x52\x7f\x5e\xfe\x32\xb8\xe1\xa8\xdb\xb1\xe4\x27\x14\x8f\x6f\x43\x7a\x98\x06\x80\xed\xf7\xf9\x29\xbc\xed\xbf\xe6\xb9\x8f\xa7\xe1\xcb\x85\xbe\x43\x63\xdc\x85\xb9\x3c\xb8\x07\xea\x3f\xd1\x81\xff\x68\x17\xdb\xf2\x70\xfb\x13\x6e\x3d\x0f\xbe\x74\x4f\x7d\x22\x1c\x5d\x54\xfc\x27\x00\x00\xff\xff\xd2\x5d\xba\x5c\xe0\x1f\x00\x00")
+var _templateClientTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x5a\x5b\x73\xdb\x36\xf6\x7f\x16\x3f\xc5\xf9\x73\x9c\xfc\x49\x8f\x0c\x75\xfb\xb6\xda\xf1\x43\x6a\xa7\xa9\x66\xdb\xb8\xdd\xb8\xdd\xce\x64\x32\x0d\x4c\x82\x12\x6a\x0a\x60\x08\xc8\x96\x46\xab\xef\xbe\x73\x80\x03\x5e\x24\xca\x56\xdb\x64\xf6\xc5\xa6\x70\x39\x97\xdf\xb9\xe0\xe0\x90\xdb\xed\xe4\x3c\xba\xd2\xd5\xa6\x96\xf3\x85\x85\xaf\xbf\xfa\xdb\xdf\x2f\xaa\x5a\x18\xa1\x2c\x7c\xcb\x33\x71\xa7\xf5\x3d\xcc\x54\xc6\xe0\x55\x59\x82\x5b\x64\x00\xe7\xeb\x07\x91\xb3\xe8\x76\x21\x0d\x18\xbd\xaa\x33\x01\x99\xce\x05\x48\x03\xa5\xcc\x84\x32\x22\x87\x95\xca\x45\x0d\x76\x21\xe0\x55\xc5\xb3\x85\x80\xaf\xd9\x57\x61\x16\x0a\xbd\x52\x79\x24\x95\x9b\xff\x7e\x76\xf5\xfa\xed\xbb\xd7\x50\xc8\x52\x00\x8d\xd5\x5a\x5b\xc8\x65\x2d\x32\xab\xeb\x0d\xe8\x02\x6c\x87\x99\xad\x85\x60\xd1\xf9\x64\xb7\x8b\xa2\xed\x16\x72\x51\x48\x25\x20\xce\x4a\x29\x94\x8d\x81\x86\xcf\xaa\xfb\x39\x4c\x2f\xe1\x8e\x1b\x01\x67\xec\x4a\xab\x42\xce\xd9\x8f\x3c\xbb\xe7\x73\x81\x8b\xb6\x5b\xb0\x62\x59\x95\xdc\x0a\x88\x17\x82\xe7\xa2\x8e\xe1\x0c\x67\x22\xb9\xac\x74\x6d\x21\x89\x46\x71\xa9\xe7\x71\x14\x8d\xe2\xed\x76\x88\xc8\x64\x29\xe7\x35\xb7\x22\x8e\x46\xdb\x2d\xd4\x5c\xcd\x05\x9c\xfd\x36\x86\x33\x85\xac\xcf\xd8\x5b\x9d\x0b\x83\x24\x47\x9e\x82\x1a\x20\xe1\xc7\xdb\x01\x47\xeb\x02\x84\xca\x71\x63\x34\x8a\x85\xb2\x73\xcd\xa4\x9e\x08\x65\x27\xb9\xe4\xa5\xc8\xec\x01\x43\x12\xd9\x71\x7d\x67\x75\xcd\xe7\x82\xcd\xdc\x98\x81\x8b\x56\x00\x5a\x46\x5c\x1c\x13\x9c\x4d\xa3\x68\x32\x81\x2b\x87\x20\xda\x11\x0d\xe3\xf1\x04\xbb\xe0\x16\x16\xba\xcc\x0d\xf0\xb2\x04\x5c\x70\xb7\x92\x65\x2e\x6a\xc3\x22\xbb\xa9\x44\xd8\x66\x6c\xbd\xca\x2c\x6c\xa3\x51\xe6\x74\x44\x09\x2f\x40\x16\x28\xd0\xaa\x42\xb6\x3f\x78\xb0\x50\xad\xd1\x68\x32\x81\x77\xd9\x42\x2c\xf9\x1e\xbf\x42\xd7\x90\xd5\x82\x5b\xa9\xe6\x63\xf0\xf8\x4a\x35\x07\xae\x72\xc8\x6b\x5d\x55\xf8\xc3\xb8\x9d\x2c\x1a\x8d\x88\xc6\x39\x19\x82\xf9\xdf\x3d\x08\xdd\x33\x41\x75\x68\x97\xc9\x04\x10\x18\xc5\xde\xf2\x25\xc2\x3f\x20\x8e\x54\x56\xd4\x3c\x43\x89\xe0\x51\xda\x85\xf3\xd1\xfe\xa6\x16\x92\xd1\xa8\x3f\x73\xde\xfb\xe9\xb1\x3a\x14\xaf\xf5\x44\xcf\x77\x52\x48\x51\xe6\x66\xc2\xf3\x5c\x5a\xa9\x15\x2f\xc9\x37\xdd\x4e\x27\xc4\x99\x5d\x56\xa5\x41\x7d\x96\xdc\x66\x8b\xdb\x67\x29\x4c\xce\x5d\x70\x8c\xba\x78\x20\x0d\x24\x41\xc4\xdc\xb4\x9b\x5f\x37\x12\xb9\x29\x62\x7e\x20\x37\x3d\xef\x9c\xff\xbc\x15\x8f\xe4\x0b\xce\x80\xc2\x00\x07\x25\x1e\x03\x94\xde\x2d\x56\xb5\xc8\x5b\x14\xe7\xf2\x41\x28\xd0\x15\xea\x68\x58\x54\xac\x54\xd6\x92\x49\x74\x65\x0d\x30\xc6\x6e\xdc\x7c\x0a\xe7\x44\x1e\x7d\xac\x70\xd1\xed\x69\x6e\x4b\x3d\x9f\x42\xa9\xe7\xec\xc7\x5a\x2a\x5b\xaa\x31\x2c\xb4\xbe\x37\x53\x78\xe9\xfe\x6f\x77\x63\x6f\x44\x1c\xf1\x0f\x5b\x54\x35\x2b\xe6\x8c\x78\x3b\x5e\x8c\xb1\x34\x1a\x91\xb8\xd3\x4b\x78\xe9\xf9\x6d\x3d\x97\x29\x64\xc5\x7c\x17\xe6\x99\x54\xd2\x26\x69\x34\xaa\x85\x5d\xd5\x8a\x94\x8c\x76\x91\x57\x22\xc9\x82\xb4\x29\xf8\x95\xb0\x7d\x26\x22\x32\x72\x5e\xb8\x24\xb7\x17\xec\xad\x78\xf4\x63\x49\xc6\xf2\x5a\x3e\x88\x3a\x3d\xd9\xb5\x01\x00\x46\x19\xeb\x7b\xe3\x25\x20\xbc\x03\x2e\x99\x64\xcc\x6b\xd9\x67\xe0\x0d\x7b\x53\x39\x23\x09\x85\x16\xcd\xb9\xe5\x98\x54\x27\xe6\x53\xc9\xae\xbf\x01\x53\x89\x4c\x16\x52\xe4\x70\xb7\x71\x91\xe1\x05\x05\x85\x01\xc0\x55\x8e\x04\xdc\x30\xb7\x3c\xa4\x70\x9c\x1b\xbb\x90\xf6\xe8\xed\x79\x0a\xb7\x96\x67\x0b\x91\x83\xd5\x20\x2d\x43\x0a\xde\x05\x78\x09\x15\xaf\xf9\x52\xa0\x09\x21\xe3\x0a\xee\x04\xf0\x3c\x17\xb9\x0b\xd4\xe0\x61\x18\xa8\x6d\x0c\x93\x5b\xa1\x12\x89\x97\x0d\x35\x1f\x3b\x45\xde\x39\x79\xf0\x37\x18\x5b\xbb\x94\x43\x0e\xd1\xf5\xbb\x84\x4c\x39\x06\x51\xd7\xba\x76\xa6\x34\x8f\xd2\x66\x0b\x68\x09\xe2\x60\x86\x87\xcd\x76\x0b\xbf\x6b\xa9\x3a\x89\xf8\xda\x27\x6d\x03\xf1\x18\x30\x06\xa7\x14\x49\x4d\xf8\x55\xe8\xb6\x05\xc4\x94\xdd\x27\x2f\xcc\x84\xa2\x58\x57\x42\xc5\x2d\x29\xca\xe5\x43\x11\xca\xfc\x5c\x2e\x0a\xbe\x2a\x2d\xb2\x20\xcf\x54\xb2\x1c\x43\xb1\xb4\xec\x35\x0a\x5f\x24\xf1\x4a\x19\xef\x7e\x22\x27\xf9\xa7\xf0\xe2\x53\x3c\xee\x28\x93\x46\x23\x67\x7c\x64\x74\x66\xf8\x83\xa8\xb4\xf4\x31\xb1\xe0\xa6\x49\x35\xc9\x80\xdc\x76\xed\xa9\x4c\x9a\x5d\x1d\xf1\x53\xf4\x29\x34\xe7\xed\x7a\xcf\xf2\xb6\xe6\xca\x60\x8e\x75\x46\x26\xc3\xc1\xed\x42\x40\x55\xeb\x07\x89\x16\xce\xb4\xb2\x62\x6d\x71\xbb\x34\xb0\xf2\x65\x86\x95\xa5\x73\xba\xce\x7e\xcc\xe0\x99\x5e\x2e\xa5\xb5\x22\x07\x5d\x43\xad\xcb\x12\xdd\x93\x67\xf7\x2c\x0a\x21\xd8\x2a\xb5\xdb\x45\x93\x09\x52\xbd\xe2\x65\x89\xae\x73\xbb\x06\xad\x80\x0f\xca\x04\x89\x60\x73\x06\x76\xcd\x28\x6e\xd2\x14\x8c\xe5\xb5\xf5\x8a\x18\x64\xd9\xd9\x87\x64\xdd\x09\x2a\x8d\xe3\x8f\x62\x6c\x80\x43\xc3\xdd\x47\x01\x79\xf2\x31\xa9\x41\xaa\x5c\x54\x42\xe5\x42\xd9\x72\xc3\xa2\x4e\x80\x1e\x24\x9b\xdb\x75\x92\xd9\x75\x00\x0b\xeb\x0d\xfc\x8f\x3e\x7c\xbb\xee\xfa\xef\x20\x0e\xa3\x91\x2c\x30\xc2\x9c\xaf\xeb\x7b\xb4\x77\x48\x3c\x2c\x39\xb7\xeb\x6b\x67\xda\xf4\x1f\xa0\xef\x91\xc6\x68\xe4\x35\x76\x74\x71\xb1\xdf\xca\x1a\x9a\x28\x4a\x8a\xeb\x64\xe1\x96\xfc\xdf\x25\x28\x59\xfa\xad\x47\xdd\x33\x14\x6f\xbb\xdd\xd4\x43\x8b\x36\x19\x02\x77\x0a\x2f\x1e\x63\xc7\xdb\xf1\xc0\xf3\xa9\x39\x17\x28\x9b\xd1\x10\xe9\x00\x97\x44\x05\x87\xed\x1a\x17\xbe\xbc\x5d\x6f\x33\xbb\x9e\x42\x66\xd7\x63\xe8\x27\x7a\x5c\xd3\xa4\xf9\x46\x5e\x5c\xa7\x64\x19\x8d\x46\xe1\x0c\x2c\x0d\x65\x70\x59\xc0\x6f\xa7\xe1\x76\x8a\xee\x19\x57\x4a\x63\x5d\xc5\x6b\xdb\xf7\x46\x77\x7e\xca\x3d\x17\x8d\xd3\x8e\x48\x74\x3c\xd8\x75\x63\x1a\x25\x1e\xbd\x6b\x8c\x1b\xd1\xd2\x68\xc0\x2e\x7f\xd0\x2a\xc7\xcc\xb1\x8b\x0e\x4d\xd1\x33\x84\x5d\x37\x47\x27\xda\x00\xcf\xbf\x60\x06\x7c\x6e\x0d\x31\x76\xf9\xee\x68\x71\x7d\x11\x6a\x96\xce\x91\x36\x3d\x7a\xc8\x15\xf3\x94\xe8\x85\xd2\x77\xb4\xf3\xe6\xa4\x64\x37\x39\x87\x19\x5e\x37\x04\x18\xca\xb8\x24\x31\xa5\x4c\x03\xb7\xeb\x1b\x3a\x21\x92\x52\xde\x0b\x78\xf7\xd3\xf7\x29\xb8\xdb\x48\x9b\xd2\x07\x33\xba\x5d\xd3\xd1\xd2\xcd\xe7\xb4\x4d\x16\xbd\xc4\xea\xa9\xd0\x21\x3e\x9c\xec\x77\xbb\x6e\x1a\xc0\x3c\x73\x2d\xee\x56\xf3\xbd\xb4\x9a\xe3\xd8\x45\x48\xa7\x33\xfb\xff\x94\x38\xad\x86\xb9\xb0\xf0\x20\xea\x3b\x6d\x04\x96\x4e\x73\x8c\x32\xad\xc2\x71\x9e\xe1\x79\x5f\x73\xaa\xcb\x5c\x7e\x0c\x85\x8f\xe3\x93\xa4\x78\x2c\x3b\x24\x13\xcc\x4e\xeb\xc6\x20\x5f\xa5\x01\x74\xbf\xe2\xa7\x95\xa8\x37\x61\xf9\x95\x5e\x85\xac\x30\x99\x1c\x56\x4a\x44\x3a\x0c\xa0\x43\xca\x02\xfd\x15\xc7\xbb\xee\x99\x9d\xe0\x61\x04\x3d\xc9\x1b\x9c\x1e\xdd\xbf\xd4\xf3\xcf\x50\xe7\xe1\x89\x51\x22\x7a\x19\xfe\x35\x4d\x91\x83\xc5\x11\x66\x12\x25\x5c\x58\xb8\x04\x5f\xd5\xe2\x41\x28\x6b\x9c\x51\x3e\xad\x44\x2d\x85\x81\xa2\xd6\xcb\x26\x96\xd8\x21\x1a\x57\x48\x37\x49\x31\xc1\xe9\x1a\xb6\xad\x08\xa4\x0a\xa3\x05\x24\xcc\xcf\xc6\xd5\x42\x5e\x90\xe5\xca\x3a\xe3\xf9\x42\x18\x0b\x29\xbc\xbd\xe1\x8c\x50\x56\xda\x0d\xe9\xe1\x6c\x0b\x33\x05\xba\x76\x17\x76\x8d\x14\x3a\x7b\x5a\x77\xc8\xa8\x02\xca\x78\x59\x4e\xe1\x23\x81\x83\xd5\x26\xfb\xd9\x88\x04\x4b\xe7\x8f\x03\x3a\xe0\x9c\x27\xc7\x18\xfb\x4e\xeb\xfb\xe6\xf0\x39\x0c\xe8\x1f\x56\x96\xdf\x95\xa2\x73\x39\xdb\x2b\x5f\x59\x43\x0d\xd9\xf5\x52\x9d\x87\x60\x86\xb5\x7d\x26\x2a\xdb\x02\x81\x60\x6f\x7c\xf5\x8f\x13\xba\xfe\xa3\x60\x1c\x6c\x3d\x09\x93\x46\x92\xa3\xc8\xb4\x2b\x7a\x1c\x18\x63\xcd\x8c\xae\x9f\x40\xeb\x09\x98\x86\x49\x0f\x61\x16\xb5\x99\x75\x9f\x2c\x22\xdf\x86\x88\xcb\x67\x0d\x8f\xf8\xaa\x6d\xb6\xd0\x05\x9a\x96\xfa\x0b\x34\x27\x68\x5c\x55\x7e\x78\x5b\x0e\xd7\x77\xd7\x3e\xe8\x6f\x3e\xe8\x22\x50\x37\xa7\x16\x19\x8a\x71\xa6\xd8\xbf\x44\x26\x30\x90\x61\xb7\xdb\x6e\xb1\xbf\x20\x3e\xf9\xe9\x38\x43\x79\xc2\xe2\x36\x05\xbf\x60\x5f\x9b\xb8\x61\xff\x1f\x28\xf5\x63\xd8\x4d\x40\xd0\xe5\xb5\x2f\x49\x9b\x48\x9f\xd4\xc5\x05\x71\x7b\x95\xf5\x52\x93\xb9\xf7\x69\x26\x19\xcd\xa7\x70\xde\x67\xd6\x06\xf7\xcb\xde\x44\x9b\x92\x9a\x72\x5c\x16\xa0\xb4\x45\x7d\x66\xe6\x17\x29\x1e\xc9\x06\x4d\xf4\x73\x28\xa5\xb1\xd8\x34\x3b\xcc\x01\x28\xa7\x8f\x46\x63\x5d\x35\x3c\x99\xc0\x2b\xe7\xbe\x38\xfb\x11\xc3\xab\x18\xc3\x7c\x0c\x8b\xf4\x23\x88\x4f\x2b\x5e\xba\x68\xf9\xb8\xdf\xa3\x72\x91\x6c\x92\x22\x99\x27\x8b\x24\x4d\xd3\x9e\x83\xf7\x14\x38\x96\x01\x32\xe6\xc6\xfa\x8e\x0b\x97\xc0\x2b\xac\x73\x93\xc1\x69\xba\xd5\x3b\x3f\x3e\x38\xfd\x1a\x9f\xdf\x47\x61\x38\x01\x20\x12\xbd\xb1\x41\x40\xda\x40\x3a\x0d\x96\xce\xfa\x53\xa0\x69\x97\x3f\x97\x02\x32\xe6\x56\x3c\x81\xd7\xd0\xfc\x18\xba\x74\x09\xb7\xa7\x9c\xe8\xca\xf5\x6d\xba\xae\xef\x07\xa8\xbd\xe5\x42\xa0\xc7\xe1\xb8\x6e\x9e\x54\x42\x9e\xae\x98\xff\x4d\xdb\x50\xa5\xc6\x3b\x7d\x71\xea\xc9\xfe\x40\x83\xb4\xae\xe9\x4a\x8c\xe1\xa6\xf2\x14\xda\x93\xf8\xe5\x00\xe1\x36\x5e\x9a\x8d\xd4\x09\xca\xc8\x67\xd3\x71\x13\x17\xd3\xe6\x29\x9c\x1f\x9e\xc5\x37\xab\xf2\xbe\x83\x41\x57\xf9\xd0\x99\x74\xc3\xe5\x3d\xfa\x57\x0f\x0f\x7f\xa0\x48\x61\x9e\x03\x06\x79\x24\x44\xd9\x45\xc6\x10\x4c\x7b\xe0\xe1\x9e\xc0\x67\x2f\x61\x0c\x2c\x19\x80\x22\xf0\x9b\x06\x83\x9a\xa0\xf8\xcf\x55\xde\x33\xbc\x82\x95\x1f\xf9\x13\x96\xf7\xb4\x5a\xcb\xfb\xdf\x7f\xc5\xf2\x9e\xc2\x81\xe5\x7b\x84\xff\xa2\xe5\x3d\xad\x1b\xf5\x1c\x06\x6d\xa6\x77\x96\xde\x3c\x07\xc3\x8d\x12\x49\x38\x92\x0e\xba\xc1\x7b\x10\xdd\xa8\xcf\x80\xd2\x8d\x12\x63\x3c\xa2\xdc\xe9\x07\x31\xde\x14\xdb\xc3\x6f\xb7\xeb\x08\x93\x1e\x01\xf4\x46\x7d\x06\x4c\xbb\xd9\x99\xee\x55\xae\x73\x87\xb8\xe6\xc0\xeb\xb9\x71\x2f\x15\xdc\x81\xda\x69\xe9\xe1\x24\x0e\xf1\x7a\xbe\x5a\x62\x7d\x8a\x11\x86\x03\x32\xbf\xc0\xba\x3a\x87\xa5\xb0\x0b\x9d\x1b\xd6\xb9\x72\x11\xe1\xe9\x25\xc4\xa1\x02\x70\x0c\xc2\x40\xc8\x78\x67\x8a\x7d\xc7\xcd\x8d\x12\xdf\x62\x73\x7d\x76\xdd\x74\x50\x03\x85\x50\xe6\xc4\x32\x07\x07\xda\xec\x9a\xdd\x62\x8d\xd2\x21\x7a\x09\xb1\xcc\x1b\xaa\xcd\xdd\xbf\x53\xa3\xc9\x31\x9c\x15\x54\xac\x5c\xe9\x65\xa5\x8d\xb4\x82\xb8\x35\x6d\x17\x49\x34\xf7\x38\x07\x49\xa8\x51\xd8\xe1\x4a\xf3\xee\x57\x3b\x4b\x10\x53\x3b\xf1\x08\xb1\x24\xe3\x4b\x51\xc2\x59\xe1\x9c\x20\x85\x18\x95\x2b\x06\x34\xeb\xf2\xd8\xdf\x14\x94\x24\x8e\xfb\xf6\x3d\x56\x95\x04\x8f\x9a\x5d\x9f\x1c\x58\xdb\xed\x11\x63\xc9\x1c\xef\xed\x1e\xf1\x2c\x00\x0b\x32\xc7\x48\x2c\xa4\xa8\x1b\x3c\x4e\x08\xca\xd9\x75\xd2\x81\xff\x7f\x10\x8a\xf1\xec\x3a\x0e\xf1\xe8\xe0\xff\xc2\x01\x89\x59\xfe\x5a\x94\xa2\x77\xbc\xe7\x7e\xe0\x4f\x24\x79\x4f\xaa\x4d\xf2\xfe\xf7\x5f\xc1\xcc\x53\x38\x80\xa0\x47\xf8\xb3\xe8\xdf\x4b\xf2\x43\x10\x9c\x9e\xe3\x1b\x82\x27\xe4\xf8\x66\x2d\x4d\x84\x9b\xdd\xb0\xaf\x77\x5a\x1d\x84\x6d\xeb\xb4\xfe\x2c\x61\xb3\xeb\x74\xbf\x01\x79\x6c\xcb\xb3\x99\x89\x62\x0e\xb3\x92\x3b\x3d\x7c\x68\x77\x98\xe1\x63\xc1\xde\xb9\xdb\x99\x93\xb2\x9b\x7f\x0e\xae\x95\x5d\xac\x67\xd7\xa7\xa2\xfd\x25\x03\x7f\x0f\x90\x81\xc0\x1f\xb2\x4f\x90\xd3\xf5\xc1\x83\xcb\xb3\x7f\x2f\x44\xed\x4f\xf5\xde\x9d\x68\x76\xbd\x1f\xcc\x4f\x9a\x97\x68\xb3\xe0\xa4\x4c\xe6\x70\x09\x2f\x65\xbe\x6f\xd4\xce\xd1\x72\xf4\x58\x39\xa4\x86\xe2\x15\xec\x1b\x3f\x1c\x2c\x86\x0c\xb6\x5b\xe8\xa5\xf6\x96\x09\xd9\xaf\xf7\x7c\x40\x57\x57\x70\xd9\x44\xeb\x8d\x12\xc3\xf1\xda\xc2\xb8\x25\x0a\xfb\x45\xc1\x64\x02\xae\x33\xd8\x71\x0e\x7f\x53\xfb\x13\xc9\x88\x5a\x8c\xc1\x92\xee\xe7\xd1\x42\xb9\x3b\x3b\x90\x4f\xc2\x7b\xe2\xac\x7b\x07\x33\x49\x1a\xfc\xfa\x8d\xb0\x1d\x91\x7b\x02\x52\xba\xc0\x37\x3c\xd2\x9a\x2f\xea\xce\x6f\x84\x1d\x7a\xcd\x33\x86\x3d\xdf\x4e\xce\x7b\x12\x76\x5f\x00\x11\x2a\x19\x23\xf4\x4e\x75\x6b\x76\xa3\xca\x0d\x32\x4f\x5b\x44\x7e\xc5\xa6\x8f\xeb\x86\xbf\x11\x76\x0c\x77\x2b\x0b\x15\x57\x32\x33\x18\xd0\x5c\x51\x07\x53\x67\xd9\xaa\x7e\xe2\x66\xf4\x46\xd8\x5f\x4f\xd2\xaa\xaf\x14\x2a\xa3\xef\x7e\x6f\xde\x74\x64\x8c\xd0\x19\x43\x57\xf0\xa1\x97\x1d\x4e\xc8\xa4\x79\x63\x41\x90\xe8\xbb\xdf\xa3\x5d\xb7\x35\x26\x28\xec\x5e\xe7\xf3\xb6\x37\x16\xfc\x14\xa7\x84\x2b\xa6\x7a\x8e\x17\x11\x6f\xb7\xd5\x27\xd1\xb6\x5d\xc5\xeb\x79\x28\x23\xc3\xb2\x4b\x88\x95\xce\x45\xbf\xae\x0b\x41\x82\xf5\x3c\x37\x19\x2f\x91\x55\x50\x3b\xb4\x91\x43\x4f\xaa\x9d\x11\xf9\x5c\x60\xe1\xbc\xe7\x9f\xc7\x91\x3f\xca\x24\x98\xfe\xe8\xb9\x16\x50\xf0\x66\x40\x91\x36\xa8\x71\xe2\x82\x8d\x60\xa1\x1d\xfb\x4d\x76\x0a\xb9\x5d\x1a\x3c\xf0\x99\x84\xe9\x88\xb3\x8a\xdb\x05\x5c\x02\x6a\x32\xe4\x2b\x29\x24\xd8\x91\xfb\xc5\x69\x1e\xde\xb2\x84\x34\xe8\x4e\xb7\xdf\x3a\x31\x30\x6a\x3f\xa7\x11\x6b\x8b\xa9\xe9\x4c\x41\x1c\x3a\x8c\x31\x19\x0a\xcd\x1e\xa3\x17\xc4\x33\x3c\x72\x62\x88\x1d\x0b\xfa\x9a\x06\x69\x3c\xf9\x26\xdf\xc9\x3d\xc1\x2d\x7b\x2f\x7e\x46\xa3\x27\xdf\xe4\xb7\x49\x99\x7e\x92\x7b\x22\xa5\x5f\x3a\xef\x20\x43\x24\x3b\x3e\x43\x87\xc7\xe4\x1c\x50\x05\x83\x2e\x81\x2d\x57\xe3\xde\x1f\xc2\x50\xea\xe9\xdc\xca\x0a\x5d\x0b\x39\x57\x17\xf7\x62\x63\x80\x1b\xf0\xdf\x13\xd1\xbd\xab\x11\xc6\x31\xed\xa4\x0e\x32\xf9\x60\xfe\x08\x75\x84\x60\xdf\x7a\xda\xff\x14\x9b\xbd\x92\x22\x9c\x9a\xe4\xff\xbb\xa8\x8d\x85\xa7\xae\x19\xae\xf6\x6b\xd2\x72\xe7\xf3\x2d\xd7\xfc\x39\xee\xf7\x54\x33\xc2\xfb\x0f\xf8\xd4\xa9\xc8\x74\xed\x9c\x50\xe2\x4b\xc0\x0d\xf9\xe3\x6b\xff\x06\xa7\x0b\x2b\xee\x72\xb0\x16\x82\xdb\x55\x8d\x5f\x3c\xd5\x02\xc4\x5a\x64\x2b\x7c\x8b\x5f\x72\x63\xc7\xc0\x0b\x4b\xdf\x42\xfa\xbe\x37\x75\x60\xf1\xaa\x5b\xe9\x52\x66\x52\xb4\xa8\xfa\x29\x97\xc4\x06\x1a\xa0\xd1\xa8\x2f\xdf\xdb\xd5\xd2\x4b\x80\x61\xb9\x5a\xfe\x88\xd4\x36\xe4\x59\x6e\x7b\xdb\x20\x74\x3f\xdf\x4f\x4b\xa1\xfc\x0b\x94\xb4\xf3\xf8\x61\x0c\x07\x59\xdf\xd1\x7d\x3f\xfd\xe0\x9a\x86\xfd\xf2\x20\x98\xfe\x14\xca\xc1\x8b\x65\xd1\xc5\x93\x8a\x4c\x2a\x43\x1a\xf5\x68\x1a\x59\xb7\x66\xef\x13\xe8\x58\x61\x90\x84\x9f\xed\x51\x08\x1e\x85\xe7\xed\x29\xc8\x3d\x6b\x82\x3f\xa0\xfe\xb3\xc0\xf6\x83\x95\x28\x1f\x61\xbc\x17\x17\xc3\xdd\x6f\x5d\x0f\xc6\x41\xaf\x0f\x7c\x34\x1c\xfa\x15\x0f\xbc\xff\xd0\x19\xe8\x04\x07\xa2\xb1\x5a\x76\x17\xd3\xe7\x0f\x38\x42\xd0\x0d\xb5\xa3\x0f\xb0\xf3\x8b\xbc\xef\xf8\xe7\xb4\xfb\x3c\xe4\x97\x5d\xae\xcf\xa1\x38\x2c\x43\x0f\xc6\x4e\x7e\x69\x9f\x22\xfc\x32\x1a\x5e\xb5\x1f\x65\xba\x2f\x78\xe8\x1b\x1d\xfd\x20\xea\xda\x7d\x8f\x24\xf7\x5e\x14\xb6\x5f\x5a\x52\xae\x0c\xef\x19\xe8\xbd\x20\x35\xac\xf6\x3e\x58\x1e\xfa\xd2\xb3\x5b\x02\x44\xff\x1d\x00\xab\x8b\x6b\x93\xa7\x2d\x00\x00")
 
 func templateClientTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -307,12 +322,12 @@ func templateClientTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/client.tmpl", size: 8160, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/client.tmpl", size: 11687, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateConfigTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x55\x4d\x6f\xe3\x36\x10\x3d\x4b\xbf\xe2\xc1\x70\x01\xdb\x48\xa8\xed\xde\x5a\x20\x87\x45\x76\x8b\x2e\x10\xa4\x05\xb2\xb7\xa2\x28\x68\x72\x24\xb3\x96\x39\x2a\x49\x6d\x13\x08\xf9\xef\x05\x3f\x64\x6b\x81\x6c\xbb\x48\x4f\x26\x35\x33\x6f\xde\x0c\x67\x9e\xa7\xa9\xd9\xd5\xb7\x3c\x3c\x39\xd3\x1d\x02\xde\xbe\xf9\xfe\x87\xeb\xc1\x91\x27\x1b\xf0\x93\x54\xb4\x67\x3e\xe2\xa3\x55\x02\xef\xfa\x1e\xc9\xc9\x23\xda\xdd\x67\xd2\xa2\xfe\x74\x30\x1e\x9e\x47\xa7\x08\x8a\x35\xc1\x78\xf4\x46\x91\xf5\xa4\x31\x5a\x4d\x0e\xe1\x40\x78\x37\x48\x75\x20\xbc\x15\x6f\x66\x2b\x5a\x1e\xad\xae\x8d\x4d\xf6\xbb\x8f\xb7\x1f\xee\x1f\x3e\xa0\x35\x3d\xa1\x7c\x73\xcc\x01\xda\x38\x52\x81\xdd\x13\xb8\x45\x58\x24\x0b\x8e\x48\xd4\xbb\xe6\xf9\xb9\xae\xa7\x09\x9a\x5a\x63\x09\x2b\xc5\xb6\x35\xdd\x0a\xe5\xf3\x7a\x38\x76\xf8\xf1\x06\x7b\xe9\x09\x6b\x71\x9b\xac\xe2\x57\xa9\x8e\xb2\xa3\xe8\x34\x4d\x08\x74\x1a\x7a\x19\x08\xab\x03\x49\x4d\x6e\x85\xf5\x1c\x7e\x31\x99\xd3\xc0\x2e\xcc\xa6\xa6\xc1\x2f\x43\x30\x6c\xd1\x8e\x56\xa5\x43\x60\xe4\xdc\xa3\xa3\x44\x5f\xf5\x86\x6c\x10\x75\x78\x1a\x68\xe9\xbd\xd9\x65\xbf\x6d\x82\xc9\x8c\x62\xd7\x52\x4c\x41\x90\xd9\x9b\xdd\x02\x09\xd2\x6a\x98\xe0\xb1\x1f\x4d\xaf\xc9\x15\xe4\x1c\x02\x1f\xdc\xa8\x02\xa6\xba\x6a\x1a\x68\x67\x3e\x93\xc3\x18\xdf\x20\x82\xd0\x23\xa9\x31\x18\xdb\x41\xcb\x20\x53\x2f\x1c\xfd\x35\x92\x0f\x5e\xd4\x55\xf1\xd6\x46\xf6\xa4\x82\x78\x9f\xae\x19\x87\xf6\x63\x07\xb2\x72\xdf\x13\x64\xb9\xf6\xdc\x75\xc6\x76\x31\x30\xdd\xf7\xcc\x7d\xf2\xee\xb9\xbb\xa4\x2c\x5e\x60\x5b\xc2\x4e\xac\x49\xd4\x55\x74\x4a\x5d\x10\x42\x18\x1b\xc8\xb5\x52\xd1\xf4\xbc\x4d\x08\x07\xe6\xa3\x8f\x9d\xcc\x84\x29\x46\x9f\xc6\x90\xba\x11\x99\x66\xfb\x2e\xfd\xd4\xd5\x34\x5d\xa3\xd9\xe1\x61\x1c\xe2\xd3\x40\x6a\x1d\x13\x96\x7e\xb4\x86\x7a\xed\xd1\x3a\x3e\x61\xcf\xe1\x80\xae\xe7\xbd\xec\xc1\xe7\x42\xaf\xfd\x40\xca\xb4\x46\x9d\x5f\xd9\x0b\xa4\x79\x4a\xc8\x4e\xda\x8e\xb0\x1e\x1c\xb5\xe6\x31\x8e\x50\x6f\x7c\xc0\x6a\x85\xcd\xe0\x8c\x0d\x2d\x56\x05\xa7\xf9\xce\x37\x2b\xac\xc5\x43\x60\x27\x3b\xda\xc6\x01\xa9\x12\xc4\xdf\x26\x1c\xb0\x0e\xa7\xa1\xf7\x11\xe0\x24\x83\x3a\x7c\x9a\x27\x2a\xc3\x9c\x13\x94\xc1\x6d\x32\xef\x66\xb7\x2a\x38\x4b\x2e\x11\x29\x02\x15\xc8\x6c\xaf\xa6\x09\x8f\xe7\x39\xcd\x3e\xeb\x45\x2c\x59\x7d\x61\x34\x5f\x16\xe7\x3c\xcd\xb9\xb3\x03\xb9\x32\x6c\x57\xe9\x11\x5b\xe9\x03\xa4\x52\xe4\x7d\x99\xb6\xec\x77\x19\xb6\x05\x3b\x9b\xa8\x89\x7b\xd6\x94\xb8\x01\x40\x24\xb7\xb6\xe2\x5e\x9e\xe2\xb2\xe1\xb7\xdf\xe3\x46\xfc\xcc\x7c\x7c\x81\x42\x5e\x11\x0f\x39\x0c\xbd\xa1\xbc\x0f\x5c\xbe\xb1\x5d\xac\x07\x78\xff\x67\x1c\xd4\x3a\xce\x11\x36\x0a\xf3\x42\xcd\xee\x1b\x1e\x82\x87\x10\x22\x43\x6e\x23\xd1\x58\xce\x1f\x57\xd1\x23\xd2\xcc\x94\x93\xdb\x54\x57\x15\x0f\x61\xa3\xb6\x75\xf5\x5c\x57\xa6\x85\x12\x79\x62\xa3\x45\x89\xb2\x1d\x37\x97\xfd\x88\xc6\xcd\x6c\xb8\x82\x12\x3d\x77\x29\x38\xd7\xf1\x7e\xb1\x34\xfe\xcb\x9d\x99\xeb\x88\x5d\xc8\x6b\x56\x8a\xc8\x98\xdb\x59\x26\xa6\xba\x72\x14\x46\x57\x04\x63\x51\x61\xe1\x94\x40\x6f\x10\xdc\x48\x97\xc4\x77\xdc\xc1\x53\xc8\x9d\x9b\x33\x9e\xf5\x29\x36\x60\xb9\x89\x29\xef\x1d\x77\x9b\xd6\xbe\xb8\x90\xdf\x4c\x26\x6e\xf4\x0d\x5a\xbb\xe8\x40\x6e\xd9\x59\x0e\xfd\x52\xc5\xf4\x17\x75\xa7\xcb\xe6\x45\x05\xfa\xf6\x6e\x9c\x5f\xa8\x28\x57\xe2\xf1\xaf\xea\x30\xcf\xd5\xab\xe4\xe1\xff\xab\xc3\x6b\xc5\xa1\xd0\xbe\xa8\xc3\x7f\x89\xc3\xd7\xb5\xe1\x2b\x6a\xb0\x38\xc6\xff\xbf\x72\xfc\x27\x00\x00\xff\xff\xd1\x65\x99\xbc\x24\x08\x00\x00")
+var _templateConfigTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\x41\x8f\xdb\x36\x13\x3d\x4b\xbf\xe2\xc1\xf0\x07\xd8\xc6\x2e\x95\x2f\xb7\x06\xd8\x43\xb0\x9b\xa2\x0b\x6c\xb7\x05\x36\xb7\xa2\x28\x68\x72\x24\xb3\x96\x39\x0a\x49\xa5\xbb\x10\xfc\xdf\x0b\x52\x94\xad\x2d\xdc\x24\x48\x4f\x22\x35\x33\x6f\xde\x0c\x67\xde\x30\x54\x9b\xf2\x96\xbb\x17\x67\x9a\x5d\xc0\xdb\x37\xff\xff\xe1\xba\x73\xe4\xc9\x06\xfc\x28\x15\x6d\x99\xf7\xb8\xb7\x4a\xe0\x7d\xdb\x22\x39\x79\x44\xbb\xfb\x4c\x5a\x94\x1f\x77\xc6\xc3\x73\xef\x14\x41\xb1\x26\x18\x8f\xd6\x28\xb2\x9e\x34\x7a\xab\xc9\x21\xec\x08\xef\x3b\xa9\x76\x84\xb7\xe2\xcd\x64\x45\xcd\xbd\xd5\xa5\xb1\xc9\xfe\x70\x7f\xfb\xe1\xf1\xe9\x03\x6a\xd3\x12\xf2\x3f\xc7\x1c\xa0\x8d\x23\x15\xd8\xbd\x80\x6b\x84\x59\xb2\xe0\x88\x44\xb9\xa9\x8e\xc7\xb2\x1c\x06\x68\xaa\x8d\x25\x2c\x14\xdb\xda\x34\x0b\xe4\xdf\xcb\x6e\xdf\xe0\xdd\x0d\xb6\xd2\x13\x96\xe2\x36\x59\xc5\xaf\x52\xed\x65\x43\xd1\x69\x18\x10\xe8\xd0\xb5\x32\x10\x16\x3b\x92\x9a\xdc\x02\xcb\x29\xfc\x6c\x32\x87\x8e\x5d\x98\x4c\x55\x85\x5f\xba\x60\xd8\xa2\xee\xad\x4a\x87\xc0\x18\x73\xf7\x8e\x12\x7d\xd5\x1a\xb2\x41\x94\xe1\xa5\xa3\xb9\xf7\x6a\x33\xfa\xad\x13\xcc\xc8\x28\x76\x2d\xc5\x64\x04\x99\x20\x6b\x76\x33\x24\x48\xab\x61\x82\xc7\xb6\x37\xad\x26\x97\x91\x47\x30\xf8\xe0\x7a\x15\x30\x94\x45\x55\x41\x3b\xf3\x99\x1c\xfa\xf8\x06\x11\x84\x9e\x49\xf5\xc1\xd8\x06\x5a\x06\x99\x7a\xe1\xe8\x53\x4f\x3e\x78\x51\x16\xd9\x5b\x1b\xd9\x92\x0a\xe2\x2e\x5d\x47\x1c\xda\xf6\x0d\xc8\xca\x6d\x4b\x90\xf9\xda\x72\xd3\x18\xdb\xc4\xc0\x74\xdf\x32\xb7\xc9\xbb\xe5\xe6\x9c\x32\x7b\x81\x6d\x0e\x3b\xb0\x26\x51\x16\xd1\x29\x75\x41\x08\x61\x6c\x20\x57\x4b\x45\xc3\x71\x9d\x10\x76\xcc\x7b\x8f\xc0\x99\x30\xc5\xe8\x43\x1f\x52\x37\x22\xd3\xd1\xbe\x49\x9f\x14\x90\x10\x14\x75\x81\xdd\x3f\xe3\x3e\xf5\xe4\x0c\xc5\xa8\xe4\xe4\xb1\x19\xbf\x65\x31\x0c\xd7\xa8\x36\x78\xea\xbb\xf8\xa4\x90\x5a\x47\xa2\xb9\x8f\xb5\xa1\x56\x7b\xd4\x8e\x0f\xd8\x72\xd8\xa1\x69\x79\x2b\x5b\xf0\xa9\x41\xd7\xbe\x23\x65\x6a\xa3\x4e\xd3\xe1\x05\xd2\x1c\x26\x64\x27\x6d\x43\x58\x76\x8e\x6a\xf3\x1c\x47\xaf\x35\x3e\x60\xb1\xc0\xaa\x73\xc6\x86\x1a\x8b\x8c\x53\xfd\xcf\x57\x0b\x2c\xc5\x53\x60\x27\x1b\x5a\xc7\x99\x2b\x12\xc4\x5f\x26\xec\xb0\x0c\x87\xae\xf5\x11\xe0\x20\x83\xda\x7d\x9c\x26\x71\x84\x39\x25\xc8\x03\x5f\x8d\xbc\xab\xcd\x22\xe3\xcc\xb9\x44\xa4\x08\x94\x21\x47\xfb\xa9\x0d\x7b\xd3\x9d\x2b\x89\x5b\xa6\x8d\x8f\x0f\xae\x51\x93\x0c\xbd\x3b\x97\x97\x83\x46\x7a\xb9\x51\xef\x6e\xf0\x3c\x45\xe7\x4c\xcb\xcc\x20\x7a\x9f\xfc\x66\xf1\x64\xf5\x8c\xe3\xe9\x36\xbf\xcc\xce\xc7\xb4\x26\xe9\xc9\xd1\x91\xcb\xcb\x70\x95\x86\xac\x96\x3e\x40\x2a\x45\xde\xe7\x6d\x18\xfd\xce\xcb\x30\xeb\x82\x4d\x2d\x10\x3f\xf7\x21\x56\xf7\xc8\x9a\x7c\xa4\x01\x00\x89\xa8\x15\x8f\xf2\x10\x35\x01\xbf\xfd\x1e\x17\xf7\x27\xe6\xfd\x05\x26\xaf\x46\xee\xeb\x84\xf2\xf0\x7d\x89\xd1\x89\x4a\x71\x91\xc7\xfd\x39\xe1\x05\x3a\xa3\xb0\x78\xc8\xae\x6b\x0d\x8d\x2a\xc2\xf9\x1f\xdb\x99\xa8\x80\xb7\x7f\xc6\xf5\x2e\xe3\xf6\x61\xa5\x30\xc9\xd0\xe4\xbe\xe2\x2e\x78\x08\x21\x46\xc8\x75\x24\x1b\x6b\xfa\xe3\x2a\x7a\x44\xaa\x23\xed\xe4\x36\x94\x45\xc1\x5d\x58\xa9\x75\x59\x1c\xcb\xc2\xd4\x50\x62\xdc\xf3\x68\x51\x22\x6b\xca\xcd\xb4\x34\xe2\x2e\x1a\x57\x93\xe1\x0a\x4a\xb4\xdc\xa4\xe0\xf1\x81\xef\x66\x52\xe3\x5f\x2b\xcd\x54\x47\x6c\xc6\x28\x4e\xb9\x88\x14\xb3\x5a\x4f\xe2\x3a\x94\x85\xa3\xd0\xbb\x2c\xb3\xb3\x0a\x33\xa7\xe8\x8e\x1b\x04\xd7\xd3\x39\xf1\x03\x37\xf0\x14\xc6\xce\x4d\x19\x4f\xaa\x1e\x1b\x30\xd7\xaf\x68\xc0\x03\x37\xab\xda\x5e\x94\xb1\x6f\x26\x13\x75\xf0\x06\xb5\x9d\x75\x20\x95\x96\x5f\x2b\xae\xdd\x5c\xfb\xf5\xab\xba\xd3\x65\x75\x51\xb7\xbf\xbd\x1b\xa7\x17\xca\x7a\x9f\x78\x7c\x51\x1b\xa7\xb9\xfa\x2e\x71\xfc\xef\xda\xf8\xbd\xd2\x98\x69\x9f\xb5\xf1\x6b\xd2\x38\x0c\xff\xa6\x69\xb3\xf5\x9b\x9f\x67\xc7\x72\x18\x40\x56\xe3\x78\x2c\xff\x1e\x00\xa2\x0a\x84\x9c\x5a\x09\x00\x00")
 
 func templateConfigTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -327,12 +342,12 @@ func templateConfigTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/config.tmpl", size: 2084, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/config.tmpl", size: 2394, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateContextTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x93\x4d\x6b\xdb\x4c\x14\x85\xd7\x99\x5f\x71\x30\x81\xd7\x32\x7e\xe5\x34\xbb\x16\xba\x08\x22\x81\xd0\x12\x0a\x15\xed\xb2\x4c\x66\xae\xac\xc1\xf2\x8c\x18\x5d\xc5\x63\x84\xff\x7b\x19\x7d\xc4\x76\x3e\x16\xcd\xce\xdc\x7b\xe6\xdc\x73\xfc\xa0\xae\x5b\x2d\x44\xe6\xea\xbd\x37\xeb\x92\x71\x7d\xf5\xe9\xf3\xff\xb5\xa7\x86\x2c\xe3\x4e\x2a\x7a\x74\x6e\x83\x7b\xab\x52\xdc\x54\x15\x7a\x51\x83\xb8\xf7\x4f\xa4\x53\x91\x97\xa6\x41\xe3\x5a\xaf\x08\xca\x69\x82\x69\x50\x19\x45\xb6\x21\x8d\xd6\x6a\xf2\xe0\x92\x70\x53\x4b\x55\x12\xae\xd3\xab\x69\x8b\xc2\xb5\x56\x0b\x63\xfb\xfd\xf7\xfb\xec\xf6\xe1\xe7\x2d\x0a\x53\x11\xc6\x99\x77\x8e\xa1\x8d\x27\xc5\xce\xef\xe1\x0a\xf0\xc9\x31\xf6\x44\xa9\x58\xac\x0e\x07\x21\xba\x0e\x9a\x0a\x63\x09\x33\xe5\x2c\x53\xe0\x19\xc6\xf9\x65\xbd\x59\xe3\xcb\x57\x3c\xca\x86\x70\x99\x66\xce\x16\x66\x9d\xfe\x90\x6a\x23\xd7\x14\x45\x5d\x07\xa6\x6d\x5d\x49\x26\xcc\x4a\x92\x9a\xfc\x0c\x97\xfd\x73\xb3\xad\x9d\x67\xcc\xc5\xc5\xb3\xad\x48\x84\xe0\x7d\x4d\x50\x95\x21\xcb\x19\x87\x6f\xb4\x47\xc3\xbe\x55\xdc\x1d\x84\x58\xad\x70\xe7\xdd\x36\x1b\xe4\xf0\xc4\xad\xb7\x0d\x24\xb2\x5e\x8f\x86\x9d\x27\x0d\x63\x1b\xa3\x09\x12\xa3\xef\x12\xce\xc3\x9a\x0a\x26\x96\x24\x1f\xff\x46\xfb\x1f\xc3\x59\x4a\x45\xd1\x5a\x75\xea\x3a\x57\x1c\xa6\x87\xe9\x38\x4b\xb0\x18\x2f\x74\xe2\x42\x2d\xf1\x27\x76\x56\x1c\xd2\x5f\xb2\x6a\x69\x7e\x9a\xb6\x3b\x24\xe9\x7c\x54\x27\xe2\x62\x88\x08\x25\x86\xf4\x0f\xb4\x7b\x1d\xde\xd2\x6e\x3a\x88\x9d\xe1\xb2\xc7\xb3\x36\x4f\x64\xa7\x5e\x92\x39\x02\xd6\x63\xda\xa3\xcb\xbc\x96\x3e\x0a\x5e\xe4\x5d\x42\x4d\x89\x93\x97\xbb\x58\x61\x4a\x35\x6e\x7e\x1b\x2e\x87\x26\x83\xdd\x12\xe7\x8d\x96\x50\x49\x2c\xd0\xa3\xe1\x70\x86\x05\x23\x97\x3c\xbc\x4d\x26\x0f\x1f\xa7\x72\xe6\xf9\x0e\x97\x3c\xc4\x42\x1c\x5e\x41\x99\x72\x0e\x40\xf2\x70\x84\xc1\xe1\x48\x23\x0f\xff\xc4\x23\x0f\x6f\xb0\x78\xf6\x78\x97\x06\x87\x18\xf4\x63\x28\x8e\x3d\xe2\xef\x9e\x43\xd7\x81\xac\x8e\x1f\xd1\xdf\x00\x00\x00\xff\xff\x51\x67\x53\x4f\x5f\x04\x00\x00")
+var _templateContextTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x91\x51\x6b\xdb\x30\x14\x85\x9f\xab\x5f\x71\x08\x85\xc5\x25\x73\xba\xbe\x6d\xb0\x87\x62\x5a\x28\x1b\x65\x30\xb3\x3d\x0e\x55\xba\x8e\x45\x5d\xc9\xc8\xd7\xad\x82\xf0\x7f\x1f\x8a\xed\x25\x6d\xca\x0a\x7d\x33\xba\xc7\x57\xdf\xd1\x17\xe3\xfa\x4c\x14\xae\xdd\x7a\xb3\xa9\x19\x17\xe7\x9f\x3e\x7f\x6c\x3d\x75\x64\x19\xd7\x52\xd1\x9d\x73\xf7\xb8\xb1\x2a\xc7\x65\xd3\x60\x17\xea\x90\xe6\xfe\x91\x74\x2e\xca\xda\x74\xe8\x5c\xef\x15\x41\x39\x4d\x30\x1d\x1a\xa3\xc8\x76\xa4\xd1\x5b\x4d\x1e\x5c\x13\x2e\x5b\xa9\x6a\xc2\x45\x7e\x3e\x4f\x51\xb9\xde\x6a\x61\xec\x6e\xfe\xfd\xa6\xb8\xba\xfd\x79\x85\xca\x34\x84\xe9\xcc\x3b\xc7\xd0\xc6\x93\x62\xe7\xb7\x70\x15\xf8\xe0\x32\xf6\x44\xb9\x38\x5b\x0f\x83\x10\x31\x42\x53\x65\x2c\x61\xa1\x9c\x65\x0a\xbc\xc0\x74\x7e\xda\xde\x6f\xf0\xe5\x2b\xee\x64\x47\x38\xcd\x0b\x67\x2b\xb3\xc9\x7f\x48\x75\x2f\x37\x94\x42\x31\x82\xe9\xa1\x6d\x24\x13\x16\x35\x49\x4d\x7e\x81\xd3\x34\x11\xe6\xa1\x75\x9e\xb1\x14\x27\xff\xd6\x8a\x4c\x08\xde\xb6\x04\xd5\x18\xb2\x5c\x70\xf8\x46\x5b\x74\xec\x7b\xc5\x71\x10\x62\xbd\xc6\xb5\x77\x0f\xc5\x18\x87\x27\xee\xbd\xed\x20\x51\xec\xf2\xe8\xd8\x79\xd2\x30\xb6\x33\x9a\x20\x31\xed\x5d\xc1\x79\x58\xd3\xc0\xa4\x92\xe4\xd3\x33\xda\x0f\x0c\x67\x29\x17\x55\x6f\xd5\xe1\xd6\xa5\xe2\x30\xff\x98\x4f\x67\x19\xce\xa6\x1b\xa2\x38\x51\x2b\xfc\x49\x9d\x15\x87\xfc\x97\x6c\x7a\x5a\x1e\xd2\xc6\x21\xcb\x97\x53\x3a\x13\x27\x23\x22\x94\x18\xe9\x6f\xe9\xe9\x18\xde\xd2\xd3\x7c\x21\x9e\x0c\xd7\x89\x11\x1b\xf3\x48\x76\xee\x25\x99\x93\x60\x3d\xd1\xee\xb7\x2c\x5b\xe9\x53\xe0\x05\xef\x0a\x6a\x26\xce\x5e\xce\x10\xf7\x54\xd3\xe4\xb7\xe1\x7a\x6c\x32\xae\x5b\x3d\x7b\xff\x38\xac\xa0\xb2\x54\x60\xa7\x86\xc3\x33\x2d\x98\xbc\x94\xe1\x75\x33\x65\x78\xbf\x95\x32\xbc\xed\xa5\x0c\xa9\x10\x87\x23\x29\x33\xe7\x28\xa4\x0c\x7b\x19\x1c\xf6\x36\xca\x70\x8c\xfc\x1f\x1f\x65\x78\xc5\x45\x19\xde\xb2\xc1\x21\x81\xbe\x4f\xc5\xbe\x47\xfa\xde\x79\x88\x11\x64\x35\x86\x41\xfc\x1d\x00\x51\x67\x53\x4f\x5f\x04\x00\x00")
 
 func templateContextTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -352,7 +367,7 @@ func templateContextTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectGremlinByTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x55\xcd\x6e\xe3\x46\x0c\x3e\x5b\x4f\x41\x18\x39\xd8\x86\x2d\x6d\x73\x6b\x81\x1c\xdc\x74\x03\x04\x08\xb6\x05\x36\x6d\x8f\x0e\x3d\x43\xc9\x83\x8c\x67\x54\x0e\xe5\xad\x21\xe8\xdd\x8b\x19\xd9\xb2\x36\x49\x9b\xa0\x27\x41\xfc\xfd\x48\x7e\xe4\xb4\x6d\xb1\xc8\x6e\x7d\x7d\x64\x53\xed\x04\xae\x3f\xfd\xf0\xe3\xaa\x66\x0a\xe4\x04\xee\x50\xd1\xd6\xfb\x67\xb8\x77\x2a\x87\xb5\xb5\x90\x8c\x02\x44\x3d\x1f\x48\xe7\xd9\xe3\xce\x04\x08\xbe\x61\x45\xa0\xbc\x26\x30\x01\xac\x51\xe4\x02\x69\x68\x9c\x26\x06\xd9\x11\xac\x6b\x54\x3b\x82\xeb\xfc\xd3\x59\x0b\xa5\x6f\x9c\xce\x8c\x4b\xfa\x87\xfb\xdb\xcf\x5f\xbe\x7e\x86\xd2\x58\x82\x93\x8c\xbd\x17\xd0\x86\x49\x89\xe7\x23\xf8\x12\x64\x94\x4c\x98\x28\xcf\x16\x45\xd7\x65\x59\xdb\x82\xa6\xd2\x38\x82\xa9\x36\x68\x49\x49\x51\x31\xed\xad\x71\x85\x67\x4d\x5c\x04\x53\x39\x94\x86\x69\x0a\xab\xae\xcb\x26\x45\x01\xbf\x46\xc5\x5d\xe3\x14\x60\x5d\x5b\x43\x01\xd0\x41\xb2\x36\xae\x02\xdf\x43\xa8\x18\xeb\x1d\x08\xe3\x81\x38\xa0\xcd\xb3\x89\x1c\x6b\x1a\xf9\x96\x8d\x53\xb3\x85\x0e\x36\x7f\x3c\x1b\xcd\xb3\xb6\x5d\x01\x39\x0d\x1f\x82\x16\x23\x9c\x50\x45\xbf\xab\x24\x85\x9f\x6e\xe0\x2a\xff\xaa\x7c\x4d\x79\x4a\xd6\x1b\xa4\x6c\xc2\xf0\x22\x21\xb4\xd9\x64\x52\x7a\x86\xcd\x12\xca\xe8\xca\xe8\x2a\x82\xd2\x90\xd5\x21\x29\x27\xc2\xf9\xcf\xc7\x59\xb9\x84\xe8\xd9\xb6\x50\x63\x50\x68\xcf\xd9\xba\x6e\x9e\x4d\x26\x5d\x36\xe9\x5e\x60\x2f\x16\xa0\x9a\x20\x7e\x0f\x43\x07\x21\x26\xaa\xd8\x37\xf5\x6a\x7b\x4c\xf5\x8b\xf1\x0e\xd2\x20\xfe\xa3\xd8\xe4\xf1\xd6\x1c\xee\x05\x2a\x92\x00\xf2\xcd\x83\xc5\x2d\xd9\x00\x18\xa0\x46\xc6\x3d\x09\x71\xc8\xe1\x71\x17\x6b\xe1\x20\xd0\x44\x52\x9d\xd8\xf1\xb4\x0e\x4f\x10\x84\xea\x04\x28\x4a\x6a\x26\x6d\x14\x0a\x2d\x53\x60\x74\x3a\x89\x03\x29\xef\x74\xe4\x65\x1c\x70\x1d\xd1\xa2\x05\x87\x7b\x1a\x3c\x1d\xfd\x2d\x17\xf7\x00\x33\xcf\x49\x67\x51\x88\xa1\x09\x58\xd1\xfc\x3c\xfb\x75\x55\x31\x55\x28\x74\x99\x7f\x90\xc8\x99\x25\xf4\xdf\x39\x0c\x82\xff\x49\x8c\xbe\x57\x18\xa6\xa3\xa9\x07\x41\x96\x25\x6c\xde\x4d\x92\xe6\xcd\x24\x0d\x3b\x28\xdd\xd9\x8f\x9c\x9e\xbf\x1e\xef\x3b\x08\x5e\x52\xb3\x74\x63\x5e\xa6\xfa\x2f\xca\x6f\x46\x76\x77\x91\x71\x63\x9b\x3f\x07\xe1\xab\x4a\x22\x8a\x0f\xd5\x62\xca\x64\x7b\x73\x03\xd3\x69\x4f\xe6\xf4\x0b\xbf\x50\x89\x8d\x95\xb6\x4d\xc0\xba\xee\x21\x92\xa7\xa7\xf1\xb9\x7e\x72\x7a\x09\x9b\x4d\xbe\x0e\x7d\xd6\x79\xa4\xbe\x29\xc7\x60\xbb\xee\x77\x57\x7a\xab\x67\xf3\xfc\x0f\xb4\x0d\x85\x59\x5a\x9b\x64\xd9\xc7\x9d\xcd\xdb\x16\xc8\x06\x82\xae\xbb\x08\x23\xd0\x07\xaf\xd0\x26\x6d\xea\x68\x4c\xf3\x76\x9f\x8b\xc5\x85\x78\xca\xbb\x20\xe8\x24\x7c\xbf\x48\xba\xaf\x06\x0e\x09\x44\xfe\xc1\x7d\x4a\xc1\xde\x19\xd1\xa0\x4b\x94\x1f\x69\xbf\xc4\xff\x41\x5b\x3f\x57\x51\xb9\xc5\x40\x70\x95\xdf\x7a\x57\x9a\x2a\xff\x0d\xd5\x33\x56\xbd\x55\x51\xbc\xdd\xf2\xb8\x59\x71\x89\xce\x15\xa4\x25\xfe\x7e\xbf\x06\x07\xc0\xd3\xf6\xc4\x93\x71\xbe\x1d\xf9\xf9\x0e\x84\x9d\x6f\xac\x86\x2d\xf5\x8b\x8e\x7d\xdc\x20\xdc\x28\x59\x09\x56\x29\x9e\x26\xe5\x75\x22\x8b\x67\x40\xd8\x63\x0d\xcf\x74\x4c\x2a\xe3\x84\x18\xfb\x7b\x14\x27\xdc\xbf\x22\x89\x0a\xa4\xe3\x9b\x55\x7b\x17\xe8\x94\xee\x74\xec\x41\x7c\x84\xf7\x57\xe3\x85\x4e\x2d\xea\x3a\xb8\x8e\xc1\xf7\x9e\x87\x23\x1a\x8f\x09\x1e\xbc\xd1\x71\x7e\xa5\x35\x4a\x12\x84\x26\x50\x7f\x8c\x62\x85\xb1\x83\x3d\x0b\x46\x7f\x17\xc6\xf4\xbc\x5a\xc2\xb4\xbf\xa8\x9b\x98\x6b\x3a\x7f\x4a\x68\x86\x33\x9a\x60\x9f\x4e\x6e\x02\x63\x46\x38\xfd\x81\x98\x4d\x7c\x63\x25\xcf\x26\x69\xf6\xff\x32\x92\x9b\xd7\x35\x8d\x29\xf9\x4f\x00\x00\x00\xff\xff\x37\x0b\xa2\xdf\xf4\x07\x00\x00")
+var _templateDialectGremlinByTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x55\xc1\x6e\xe3\x36\x10\x3d\x4b\x5f\xf1\x60\xe4\x60\x07\x8e\xb4\xcd\xad\x05\x72\x70\xd3\x0d\x10\x20\xd8\x16\xd8\xb4\x3d\x3a\x34\x39\x92\x89\xd0\xa4\x4a\x8e\xbc\x35\x04\xfd\x7b\x41\x4a\x96\xbd\x49\xda\x04\x3d\x09\xe4\xcc\x70\xde\xcc\x7b\x33\xea\xba\xf2\x32\xbf\x75\xcd\xc1\xeb\x7a\xcb\xb8\xfe\xf4\xc3\x8f\x57\x8d\xa7\x40\x96\x71\x27\x24\x6d\x9c\x7b\xc6\xbd\x95\x05\x56\xc6\x20\x39\x05\x44\xbb\xdf\x93\x2a\xf2\xc7\xad\x0e\x08\xae\xf5\x92\x20\x9d\x22\xe8\x00\xa3\x25\xd9\x40\x0a\xad\x55\xe4\xc1\x5b\xc2\xaa\x11\x72\x4b\xb8\x2e\x3e\x1d\xad\xa8\x5c\x6b\x55\xae\x6d\xb2\x3f\xdc\xdf\x7e\xfe\xf2\xf5\x33\x2a\x6d\x08\xe3\x9d\x77\x8e\xa1\xb4\x27\xc9\xce\x1f\xe0\x2a\xf0\x59\x32\xf6\x44\x45\x7e\x59\xf6\x7d\x9e\x77\x1d\x14\x55\xda\x12\x66\x4a\x0b\x43\x92\xcb\xda\xd3\xce\x68\x5b\x3a\xaf\xc8\x97\x41\xd7\x56\x70\xeb\x69\x86\xab\xbe\xcf\xb3\xb2\xc4\xaf\xd1\x70\xd7\x5a\x09\xd1\x34\x46\x53\x80\xb0\x48\xde\xda\xd6\x70\x03\x84\xda\x8b\x66\x0b\xf6\x62\x4f\x3e\x08\x53\xe4\x19\x1f\x1a\x3a\x8b\xad\x5a\x2b\xe7\x97\x2a\x98\xe2\xf1\xe8\xb4\xc8\xbb\xee\x0a\x64\x15\x3e\x04\x2d\xbe\x30\xa2\x8a\x71\x17\x09\x02\x7e\xba\xc1\x45\xf1\x55\xba\x86\x8a\x94\x6c\x70\x88\xbe\x73\xf6\x78\x91\x10\x5d\x9e\x65\x95\xf3\x58\x2f\x51\xc5\x50\x2f\x6c\x4d\xa8\x34\x19\x15\x92\x31\x63\x5f\xfc\x7c\x98\x57\x4b\xc4\xc8\xae\x43\x23\x82\x14\xe6\x98\xad\xef\x17\x79\x96\xf5\x79\xd6\xbf\xc0\x5e\x5e\x42\xb6\x81\xdd\x0e\x53\x07\x11\x13\xd5\xde\xb5\xcd\xd5\xe6\x80\x88\x88\xb5\xb3\x48\x44\xfc\x47\xb1\x29\xe2\x2d\x1e\xee\x19\x35\x71\x00\x7f\x73\x30\x62\x43\x26\x40\x04\x34\xc2\x8b\x1d\x31\xf9\x50\xe0\x71\x1b\x6b\xf1\x81\xd1\x46\x51\x8d\xea\x78\x5a\x85\x27\x04\xa6\x26\x01\x8a\x64\x35\x9e\x94\x96\x82\x69\x99\x08\x16\x56\x25\xc7\x40\xd2\x59\x15\x75\x19\x09\x6e\x22\x5a\x61\x60\xc5\x8e\xa6\x48\x4b\x7f\xf3\x29\x3c\x60\xee\x7c\xb2\x19\xc1\xe4\xd1\x06\x51\xd3\xe2\xc8\xfd\xaa\xae\x3d\xd5\x82\xe9\xc4\x7f\x60\xaf\x6d\xbd\xc4\xf0\x5d\x60\xba\xf8\x9f\xc2\x18\x7a\x25\xc2\xd8\xa4\x31\x87\xf0\xbc\xc4\xfa\xdd\x24\x89\x6f\x4f\xdc\x7a\x8b\xca\x1e\xe3\xc8\xaa\xc5\x6b\x7a\xdf\x41\xf0\x52\x9a\x95\x3d\xd7\x65\xaa\xff\x64\xfc\xa6\x79\x7b\x17\x15\x77\xee\xf3\xe7\x74\xf9\xaa\x92\x88\xe2\x43\xb5\xe8\x2a\x09\xf2\xe6\x06\xb3\x59\xba\xc8\xd2\x11\xbf\x50\x25\x5a\xc3\x5d\x97\x80\xf5\xfd\x43\x14\xcf\x20\xe3\x63\xfd\x64\xd5\x12\xeb\x75\xb1\x0a\x43\x1f\x16\x45\xd7\x41\x57\xe7\x60\xfb\xfe\x77\x5b\x39\xa3\xe6\x8b\xe2\x0f\x61\x5a\x0a\xf3\x34\x36\xc9\x73\x78\x77\xbe\xe8\x3a\x90\x09\x84\xbe\x3f\x5d\x46\xa0\x0f\x4e\x0a\x93\xac\x69\x60\x62\x9a\xb7\xfb\x5c\x5e\x9e\x84\x27\x9d\x0d\x2c\x2c\x87\xef\x07\x49\x0d\xd5\x60\x9f\x40\x14\x1f\x9c\xa7\xf4\xd8\x3b\x14\x4d\xb6\x24\xf9\x33\xeb\x97\x78\x9e\xac\xcd\x73\x1d\x43\x37\x22\x10\x2e\x8a\x5b\x67\x2b\x5d\x17\xbf\x09\xf9\x2c\xea\xc1\xab\x2c\xdf\x6e\x79\x9c\xac\x38\x67\xc7\x0a\xd2\x10\x7f\x3f\x5f\x53\x00\xc4\x38\x3d\x71\x65\x1c\x77\x47\x71\xdc\x03\x61\xeb\x5a\xa3\xb0\xa1\x61\xd0\xc5\xf0\x6e\x60\xdf\x4a\xbe\x62\x51\xa7\x8e\x29\x92\x4e\x25\xb1\x38\x0f\x81\x9d\x68\xf0\x4c\x87\x64\xd2\x96\xc9\x8b\xf4\x26\x22\xc3\x29\x7c\x90\x02\xa9\xf8\xcf\x6a\x9c\x0d\x34\xa6\x1b\x97\x3d\xd8\xa1\xeb\xf0\x57\xeb\x98\xc6\x16\xf5\x3d\xae\xe1\x3c\x76\xce\x4f\x4b\x34\x2e\x13\xb1\x77\x5a\x41\x3a\x5b\x19\x2d\x39\x41\x68\x03\xa5\x24\x4f\xb1\xc2\xd8\xc1\x41\x05\x67\xa7\xa9\xf4\x51\x57\x4b\xcc\x86\x8d\xba\x8e\xb9\x66\x8b\xa7\x84\x66\x5a\xa3\x09\xf6\xb8\x72\xa3\x03\xf4\x19\x4e\xb7\x27\xef\x75\xfc\xc7\x72\x91\x67\x89\xfb\x7f\xa1\xe4\xe6\x75\x4d\xe7\x92\xfc\x67\x00\x37\x0b\xa2\xdf\xf4\x07\x00\x00")
 
 func templateDialectGremlinByTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -372,7 +387,7 @@ func templateDialectGremlinByTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectGremlinCreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x56\x4d\x6f\xdb\x38\x13\x3e\x4b\xbf\x62\x5e\xc3\x2d\xa4\x40\xa5\xdd\xde\xde\x2c\x7c\xc8\xba\xee\xd6\x40\x9b\x7e\x24\x9b\x4b\x11\x04\x0c\x39\xb2\x89\xc8\xa4\x4a\x52\x6e\x0c\x43\xff\x7d\x31\x94\x6c\xcb\x8a\xb3\xbb\xdd\x9e\x62\x71\xbe\x9e\x79\xe6\x19\x32\xdb\xed\xe8\x2c\x9e\x9a\x72\x63\xd5\x62\xe9\xe1\xcd\xf8\xf5\xff\x5f\x95\x16\x1d\x6a\x0f\xef\xb8\xc0\x7b\x63\x1e\x60\xae\x05\x83\x8b\xa2\x80\xe0\xe4\x80\xec\x76\x8d\x92\xc5\xd7\x4b\xe5\xc0\x99\xca\x0a\x04\x61\x24\x82\x72\x50\x28\x81\xda\xa1\x84\x4a\x4b\xb4\xe0\x97\x08\x17\x25\x17\x4b\x84\x37\x6c\xbc\xb3\x42\x6e\x2a\x2d\x63\xa5\x83\xfd\xc3\x7c\x3a\xbb\xbc\x9a\x41\xae\x0a\x84\xf6\xcc\x1a\xe3\x41\x2a\x8b\xc2\x1b\xbb\x01\x93\x83\xef\x14\xf3\x16\x91\xc5\x67\xa3\xba\x8e\xe3\xed\x16\x24\xe6\x4a\x23\x0c\xa4\xe2\x05\x0a\x3f\x5a\x58\x5c\x15\x4a\x8f\x84\x45\xee\x71\x00\x75\x4d\x5e\xc3\xfb\x4a\x15\x84\xe9\x7c\x02\x25\x77\x82\x17\x30\x64\x57\xc2\x94\xc8\x7e\x6f\x2d\xad\xa3\x45\x81\x6a\xdd\x78\xee\x7f\xef\xc3\x5b\xa7\x55\xe5\xb9\x57\x46\x87\x74\x56\x69\xdf\x89\x1b\xb0\x9d\x75\x00\xe4\x1f\xe7\x95\x16\x90\x1c\xe5\xae\x6b\x38\xeb\xa2\xaa\xeb\x14\x5a\xe0\x57\x7c\x8d\x89\xf0\x8f\x20\x8c\xf6\xf8\xe8\xd9\xb4\xf9\x9b\x42\x12\x42\xd8\x25\x5f\x21\xd4\x75\x06\x68\xad\xb1\x29\x6c\xe3\xc8\xa2\x23\x24\x2f\xdb\x14\xec\x2b\xba\xd2\x68\x87\xdb\x3a\x8e\xbe\x57\x68\x37\x19\xdc\x2b\x2d\x95\x5e\x04\xbf\x1e\x14\xd6\x86\x25\x29\xfb\x42\xce\x49\x1a\x47\x2a\xa7\xf4\xa7\x9c\xa5\xa5\x5f\x6c\xf6\x88\x82\x60\x66\xd0\x2b\x90\x91\x46\xd2\xdf\x42\xf8\xff\x26\xa0\x55\x41\x08\x23\x8b\xbe\xb2\x9a\x3e\x03\xf0\x38\xaa\x77\x45\x32\x30\x0f\x54\x48\xb9\xa9\xd1\xce\x73\xed\x67\xd4\x58\xd2\xa4\x31\x0f\xcf\x86\x07\x36\xbe\x76\x28\x25\x0a\xba\x14\x6d\x85\xd1\xb9\x5a\x9c\x3f\xe9\xa1\x39\xaf\xfb\x6d\x76\x93\xb1\x77\xd6\xac\x76\x3c\x26\xff\xba\xa5\xf6\xac\x9f\x2d\x23\xaf\xf8\xa7\xb5\x90\xa4\x70\x26\x5d\xc1\xae\x2d\x5f\xa3\x75\x3c\xd4\xdd\x6e\x5f\xc1\x0f\xe5\x97\xc0\x2e\xab\x55\xa0\xcc\x72\x52\x60\x5d\xc7\x51\xe4\x37\x25\xad\xe3\xfe\xd0\x79\x5b\x09\x1f\xe0\x46\xa5\x45\xd9\xcf\x37\x1a\x75\xbd\xc9\x43\x09\xee\x91\x91\xbf\x47\xe7\x4f\xf8\x87\xe3\x15\xf7\x62\x89\x0e\xb8\x96\xa0\xbc\x6b\x92\x70\xed\x29\x90\x70\x1c\x92\x06\xc5\xad\xf8\x03\x26\xdf\x6e\xcf\x0e\xc7\x19\x8c\x33\xa2\x89\x51\xbf\x4d\x53\xa8\x65\x68\x62\x4d\x11\x0b\x76\x21\xe5\x4d\x60\x8a\x7d\xe6\xe2\x81\x2f\x68\xa2\xec\x03\xbf\xc7\xa2\xf5\xb7\x5c\x2f\x10\x86\x39\xb9\x0f\xd9\xc7\x76\xe9\xde\x29\x2c\xa4\x6b\xd8\x50\x39\xac\x79\x51\xe1\x4e\x62\x47\xab\x5b\xd7\x8c\xbe\xf3\x7d\xe4\x1f\x48\x24\x26\x07\xd1\x85\x2a\x2a\x27\x9f\x3f\xb5\xfa\x5e\x61\x93\xf5\xb8\xbb\x09\xf0\xb2\x44\x2d\x93\xce\x61\x06\x2f\x0f\x5f\x21\x53\xc3\xfe\x39\x2c\xd8\x4d\x92\xb2\xf7\xdc\x9d\xee\x2c\x83\xfe\x71\x03\x71\xb7\x19\x41\x4a\xa1\xa5\x94\x4d\x4d\xa5\x7d\x92\x66\x4d\x7a\x9a\xca\x39\xdc\xdd\xb1\xb9\x4b\x4a\x76\x39\xfb\x92\x8c\xd3\x74\x1f\x97\x5c\xe2\x8f\x99\xb5\x4d\x17\x81\xa1\x5f\xae\xdf\x16\xa6\xe1\x45\x47\xe3\x8b\xa2\x35\xfb\x6c\x4d\x89\xd6\x6f\x12\x52\xcf\x95\xd2\x8b\x02\x7f\x22\x75\xa3\xa1\x6e\xce\xce\xb8\xb1\x19\xf7\x4c\x2e\xb0\x9d\x32\x19\x87\xcd\x73\xd1\x5e\xc9\x83\xb9\x1e\x74\x6c\x9a\xae\x83\xdd\x4d\x9d\xc3\xe0\x85\x63\x2f\xdc\xa0\x03\x66\x88\x4d\xfb\x1d\x2c\x71\x14\xe5\xc6\xc2\x5d\x06\x4a\x86\xa7\x20\x54\x3f\x25\x20\x64\x57\x61\xc9\x02\xad\x50\xd7\xf3\xb7\x2e\x49\x8f\xf5\x83\x6c\xee\xe6\x9a\x56\x68\x2f\xa1\x1e\xe8\x09\x0c\x3e\x55\x7e\x70\x64\x0d\xb0\x9f\xa2\x46\x76\xbd\x29\xf1\x1f\xb0\xd3\x10\x2e\xa4\x9c\x85\x31\xeb\xe6\x3a\x4c\xc3\x9d\x96\x90\x04\x95\x4c\x53\x36\xd7\x37\xc9\x61\x7a\xc5\x01\xdb\xa9\xd0\x6b\x73\x08\xfc\x54\xf9\x6e\xe4\x7e\xee\x87\x76\xdf\x73\xd7\xbf\x99\x7e\x6d\x6b\x66\xcd\xd6\x84\x4e\x8f\x81\xd1\xc7\x81\xc7\xba\x6e\xf7\x6b\xfe\x96\xb0\xfe\xf7\x25\x21\x7d\xfd\xdd\x8e\xe8\xdd\x2b\x4c\x8c\x3c\xbb\x0a\x27\x64\xfc\xec\xd5\xad\x72\x28\x50\x77\x09\x49\x61\x32\x81\x71\x23\xa5\xf6\x61\x59\xb3\x1b\x5a\x91\x8f\xbc\x4c\xbc\xdd\xaf\x4a\xe4\xc3\x1b\xd6\x09\xfd\x36\xbe\x65\xc4\x1d\x9b\x1a\x5e\xa0\x13\x98\xf4\x8c\x44\x45\xf6\x24\x5d\x7a\x90\xbd\xb0\x07\xd9\x77\x63\x5f\x9f\xdf\x36\x88\xbc\x85\x09\x08\xdb\x2f\x63\xdb\xd4\xde\xee\xc0\xb5\xd0\xbd\x8d\x7b\x4a\x7b\xb6\xa7\x0e\x67\xe1\xff\xad\xf6\xf7\x5f\x01\x00\x00\xff\xff\xab\x26\x26\x39\xb2\x0a\x00\x00")
+var _templateDialectGremlinCreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x56\xdf\x8f\xd3\x38\x10\x7e\x4e\xfe\x8a\xb9\xaa\xa0\x64\x15\xdc\xc2\xdb\xed\xa9\x0f\x7b\xa5\x1c\x95\x60\xf9\xb1\x7b\xfb\x82\x10\xf2\xda\x93\xd6\xda\xd4\x0e\xb6\x53\xa8\x2a\xff\xef\xa7\x71\xd2\x36\x2d\x85\x3b\x8e\xa7\xad\x3d\x33\xdf\x7c\x33\xf3\x8d\xb3\xdb\xed\xe8\x22\x9d\x9a\x7a\x63\xd5\x62\xe9\xe1\xd9\xf8\xe9\xef\x4f\x6a\x8b\x0e\xb5\x87\x17\x5c\xe0\xbd\x31\x0f\x30\xd7\x82\xc1\x55\x55\x41\x74\x72\x40\x76\xbb\x46\xc9\xd2\xdb\xa5\x72\xe0\x4c\x63\x05\x82\x30\x12\x41\x39\xa8\x94\x40\xed\x50\x42\xa3\x25\x5a\xf0\x4b\x84\xab\x9a\x8b\x25\xc2\x33\x36\xde\x59\xa1\x34\x8d\x96\xa9\xd2\xd1\xfe\x6a\x3e\x9d\x5d\xdf\xcc\xa0\x54\x15\x42\x77\x67\x8d\xf1\x20\x95\x45\xe1\x8d\xdd\x80\x29\xc1\xf7\x92\x79\x8b\xc8\xd2\x8b\x51\x08\x69\xba\xdd\x82\xc4\x52\x69\x84\x81\x54\xbc\x42\xe1\x47\x0b\x8b\xab\x4a\xe9\x91\xb0\xc8\x3d\x0e\x20\x04\xf2\x1a\xde\x37\xaa\x22\x4e\x97\x13\xa8\xb9\x13\xbc\x82\x21\xbb\x11\xa6\x46\xf6\x67\x67\xe9\x1c\x2d\x0a\x54\xeb\xd6\x73\xff\x7b\x78\x7f\xec\xb4\x6a\x3c\xf7\xca\x68\x72\xaa\xad\xd2\xbe\x17\x37\x60\x3b\xeb\x00\xc8\x3f\x2d\x1b\x2d\x20\x3b\xc2\x0e\x01\x2e\xfa\xac\x42\xc8\xa1\x23\x7e\xc3\xd7\x98\x09\xff\x15\x84\xd1\x1e\xbf\x7a\x36\x6d\xff\xe6\x90\xc5\x10\x76\xcd\x57\x08\x21\x14\x80\xd6\x1a\x9b\xc3\x36\x4d\x2c\x3a\x62\xf2\xb8\x83\x60\xef\xd1\xd5\x46\x3b\xdc\x86\x34\xf9\xdc\xa0\xdd\x14\x70\xaf\xb4\x54\x7a\x11\xfd\x4e\xa8\xb0\x2e\x2c\xcb\xd9\x3b\x72\xce\xf2\x34\x51\x25\xc1\x9f\x73\x96\x96\x7e\xb1\xd9\x57\x14\x44\xb3\x80\x93\x04\x05\x69\x24\xff\x23\x86\xff\x36\x01\xad\x2a\x62\x98\x58\xf4\x8d\xd5\x74\x8c\xc4\xd3\x24\xec\x92\x14\x60\x1e\x28\x91\x72\x53\xa3\x9d\xe7\xda\xcf\xa8\xb0\xac\x85\x31\x0f\xdf\x0d\x27\x66\xec\xfd\x81\x1a\x81\x3c\xee\xb7\x68\x2b\x8c\x2e\xd5\xe2\xf2\x9b\x1a\xda\xfb\x70\x5a\x66\x1f\x8c\xbd\xb0\x66\xb5\xeb\x63\xf6\x9f\x4b\xea\xee\x4e\xd1\x0a\xf2\x4a\x7f\x5a\x0b\x59\x0e\x17\xd2\x55\xec\xd6\xf2\x35\x5a\xc7\x63\xde\xed\xf6\x09\x7c\x51\x7e\x09\xec\xba\x59\xc5\x96\x59\x4e\x0a\x0c\x21\x4d\x12\xbf\xa9\x69\x1d\xf7\x97\xce\xdb\x46\x78\x0a\x4b\x92\xda\xa2\x3c\xc5\x1b\x8d\xfa\xde\xe4\xa1\x04\xf7\xc8\xc8\xdf\xa3\xf3\x67\xfc\xe3\xf5\x8a\x7b\xb1\x44\x07\x5c\x4b\x50\xde\xb5\x20\x5c\x7b\x0a\x24\x1e\x07\xd0\xa8\xb8\x15\x7f\xc0\xec\xc3\xc7\x8b\xc3\x75\x01\xe3\x82\x9a\xce\x20\x84\xbc\x2d\x0a\xb5\xa4\x7d\x49\xd6\x14\xb1\x60\x57\x52\xde\xc5\x4e\xb1\xb7\x5c\x3c\xf0\x05\x4d\x94\xbd\xe2\xf7\x58\x75\xfe\x96\xeb\x05\xc2\xb0\x24\xf7\x21\x7b\xdd\x2d\xdd\x0b\x85\x95\x74\x11\x88\xc6\xbb\xe6\x55\x83\x3b\x89\x1d\xad\x6e\x08\x8c\xce\xe5\x3e\xf2\x2f\xa4\x26\x66\x07\xd1\xc5\x2c\xaa\x24\x9f\xbf\xb5\xfa\xdc\x10\x03\x6a\xcc\x51\x75\x13\xe0\x75\x8d\x5a\x66\xbd\xcb\x02\x1e\x1f\x4e\x11\xa9\xed\xfe\x25\x2c\xd8\x5d\x96\xb3\x97\xdc\x9d\xaf\xac\x80\xd3\x6b\x3a\x97\x6c\xb7\x19\x71\xef\x63\x49\x39\x9b\x9a\x46\xfb\x2c\x2f\x5a\x78\x9a\xca\x25\x7c\xfa\xc4\xe6\x2e\xab\xd9\xf5\xec\x5d\x36\xce\xf3\x7d\x5c\x76\x8d\x5f\x66\xd6\xb6\x55\xc4\x0e\xfd\x72\xfe\x2e\x31\x0d\x2f\x39\x1a\x5f\x92\xac\xd9\x5b\x6b\x6a\xb4\x7e\x93\x91\x7a\x6e\x94\x5e\x54\xf8\x13\xd0\xad\x86\xfa\x98\xbd\x71\x63\x3b\xee\x99\x5c\x60\x37\x65\x32\x0e\xdb\xcf\x45\xf7\x24\x0f\xe6\x7a\xd0\xb3\x69\x7a\x0e\x76\x2f\x75\x09\x83\x47\x8e\x3d\x72\x83\x1e\x99\x21\xb6\xe5\xf7\xb8\xa4\x49\x52\x1a\x0b\x9f\x0a\x50\x92\x32\xb6\xd9\xcf\x09\x08\xd9\x4d\x5c\xb2\xd8\x56\x08\x61\xfe\xdc\x65\xf9\xb1\x7e\x90\xcd\xdd\x5c\xd3\x0a\xef\x25\x74\x42\x7a\x02\x83\x37\x8d\x1f\x1c\x59\x23\xed\x6f\x59\x23\xbb\xdd\xd4\xf8\x2f\xdc\x69\x08\x57\x52\xce\xe2\x98\x23\x50\x08\x79\x7c\xd3\x32\x92\xa0\x92\x79\xce\xe6\xfa\x2e\x3b\x4c\xaf\x72\xf8\xa3\xd0\x5b\x73\x08\x7c\xd3\xf8\xbb\xec\xcc\xdc\x0f\xe5\xbe\xe4\xee\xf4\x65\xfa\xb5\xad\x99\xb5\x5b\x13\x2b\x3d\x26\xb6\xdd\xf6\xfb\x18\x42\xb7\x5f\xf3\xe7\xc4\xf5\xff\x2f\x09\xe9\xeb\x47\x3b\xd2\xe5\x27\x79\xfc\x60\x15\xce\xc8\xf8\xbb\x4f\xb7\x2a\xa1\x42\xdd\x6f\x48\x0e\x93\x09\x8c\x5b\x29\x75\x1f\x96\x35\xbb\xa3\x15\x79\xcd\xeb\xcc\xdb\xfd\xaa\x24\x3e\x7e\xc3\x7a\xa1\x1f\xc6\x1f\x19\xf5\x8e\x4d\x0d\xaf\xd0\x09\xec\xe3\x92\x91\xde\x8b\xe2\x1b\xb8\xfc\x20\x7b\x61\x0f\xb2\xef\xc7\x3e\xbd\xfc\xd8\x32\xf2\x16\x26\x20\xec\x69\x1a\xdb\x41\x7b\xbb\x23\xd7\x51\xf7\x36\x3d\x51\xda\x77\x6b\xea\xf5\x2c\xfe\xbf\x85\x5a\x42\x08\xe9\x3f\x03\x00\xab\x26\x26\x39\xb2\x0a\x00\x00")
 
 func templateDialectGremlinCreateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -392,7 +407,7 @@ func templateDialectGremlinCreateTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectGremlinDecodeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x41\x6b\x1b\x3b\x10\x3e\xaf\x7e\xc5\x3c\x63\x82\x6d\x6c\x39\x2f\x3c\x1e\xd4\xc5\x87\x10\x27\xed\xd2\x36\x87\x38\xed\xa5\x94\x44\x59\xcd\xda\x6a\xb5\xd2\x22\xc9\xa6\x66\xd1\x7f\x2f\xd2\xee\x86\x75\x9a\xc6\xa1\xa5\x87\x42\x6f\xd6\x7c\xa3\x6f\xbe\xf9\xb4\x33\xae\xaa\xe9\x88\x9c\xe9\x72\x67\xc4\x6a\xed\xe0\xe4\xf8\xdf\x17\x93\xd2\xa0\x45\xe5\xe0\x82\x65\x78\xa7\xf5\x17\x48\x55\x46\xe1\x54\x4a\x88\x49\x16\x02\x6e\xb6\xc8\x29\xb9\x5e\x0b\x0b\x56\x6f\x4c\x86\x90\x69\x8e\x20\x2c\x48\x91\xa1\xb2\xc8\x61\xa3\x38\x1a\x70\x6b\x84\xd3\x92\x65\x6b\x84\x13\x7a\xdc\xa2\x90\xeb\x8d\xe2\x44\xa8\x88\xbf\x4d\xcf\xce\x2f\x97\xe7\x90\x0b\x89\xd0\xc4\x8c\xd6\x0e\xb8\x30\x98\x39\x6d\x76\xa0\x73\x70\x9d\x62\xce\x20\x52\x32\x9a\x7a\x4f\x48\x55\x01\xc7\x5c\x28\x84\x1e\x17\x4c\x62\xe6\xa6\x2b\x83\x85\x14\x6a\xca\x31\xa8\x9a\x6a\x85\x3d\xf0\x3e\x64\xf6\x0d\x66\x28\xb6\x68\x60\x36\x87\x3e\xbd\x6a\x4f\x81\x68\x3a\x85\x0b\xa3\x8b\x2b\xb4\xa5\x0e\x1a\x6d\xc6\x94\x8d\x62\x1a\xbe\xd0\x79\x0d\x71\xe6\x18\x08\xe5\x34\x04\x4e\x7a\xc9\x0a\x04\xef\x29\xc9\x37\x2a\x83\xc1\x5e\x1d\xef\x61\xd4\x4d\x1a\xee\x15\x19\x18\xb4\x30\x6a\xf8\x69\x1b\x1d\x02\x1a\xa3\x0d\x54\x24\xd9\x16\xac\x1c\x87\x63\x10\x6c\xd0\xd2\x2b\x64\xfc\x03\x93\x1b\x7c\xc7\xca\xc1\x90\x24\x22\x8f\xe8\x3f\x73\x50\x42\x86\x1b\x89\x41\xb7\x31\x2a\x44\x49\xe2\x49\x52\x55\x13\xe8\x87\x5e\x02\x43\x69\x84\x72\xd0\x0b\xc7\xde\x9e\x48\x92\x6c\x99\x89\xdd\xc4\x54\xef\xc1\x3a\xb3\xc9\x5c\x64\x4c\x17\x00\x75\xa7\xe9\x82\x5e\xef\xca\xd0\x07\xc0\xed\x67\xab\xd5\xac\x27\xf8\x58\x17\xc2\x61\x51\xba\x5d\xef\x96\x24\x49\x55\x81\x61\x6a\x85\xd0\xbf\x19\x43\x3f\xaf\x9d\xbe\x10\x28\xb9\x8d\x85\x92\x5a\x52\x4e\x97\xb1\x42\x44\x02\x61\x55\x81\xc8\x81\x29\x1e\xb0\xd4\x5e\x8b\x02\x61\xa0\xb4\x0b\xc7\xd7\xcc\xbe\xd2\xa1\xf2\x10\xbc\x17\xca\xfd\xff\x5f\x55\x01\x4a\x1b\x94\xd4\xf7\xfa\x39\xbd\x14\x52\xb2\x3b\x19\x62\xc1\x72\x54\xbc\x46\xfb\x79\x2b\xfa\x3e\xda\x8a\xaf\xd1\xa5\xd3\x86\xad\xf0\x0d\xee\xc0\xfb\xef\xbb\xa9\xaf\x44\x33\x1b\xb7\x67\x73\x08\x0f\x43\x17\xf1\x03\x1b\x1c\x75\x7c\x1b\xbe\x3c\xf8\x1e\x7b\xc6\xd3\x74\x01\xf3\xae\xf1\x34\x5d\x90\xc3\x1e\x46\x0b\xbb\x34\x6d\x27\x7b\x96\xce\x9f\x6f\xaa\x13\x05\xd2\xf7\x4a\x7c\x1d\x1c\x8f\xf7\xe4\x3c\x46\x3c\x84\xae\xfd\x93\xa7\x93\x3b\x0e\x36\x3f\x27\xe1\x77\x63\x8b\x12\x92\xc4\xd1\x6c\x72\x9e\x31\xcf\x05\x53\xbb\x67\x0c\x74\x6c\x22\x2c\x9c\x1a\x5a\x66\xba\x44\xba\x8c\x81\x5f\x1a\x77\xdb\x50\x3c\x39\xee\x6d\xd2\x1f\x33\xee\x1f\x3f\xfd\x1d\xf8\xdf\x33\xf0\xb9\x36\x70\x33\x86\x6d\x7c\xd2\xe8\x53\xd7\xf7\x70\x61\xf4\xf0\x13\x9a\x03\x2b\x4b\x54\x7c\xf0\x10\x19\xc3\x51\xf7\xcf\x24\x5c\x4e\xd2\xc5\x0c\xb6\x34\x5d\x8c\x6b\xa7\x0f\xbd\xc5\xe3\x8f\x31\x83\x10\xfe\x89\x55\xb1\x3d\xb8\x20\x1e\xcf\xb8\xb7\xb9\x95\x7d\xbf\x18\x12\x3f\x8c\xbe\xfd\x60\x41\x7c\x0b\x00\x00\xff\xff\xc5\xf5\xf7\x0a\xb4\x08\x00\x00")
+var _templateDialectGremlinDecodeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x51\x8f\xe3\x34\x10\x7e\x8e\x7f\xc5\x47\x15\x9d\xda\xaa\x75\x97\x13\x42\xa2\xa8\x0f\xa7\xeb\x2d\x44\xc0\x3e\xec\x2e\xbc\x20\x74\xe7\x8b\x27\xbb\x86\xc4\x8e\x6c\xb7\xa2\x8a\xfc\xdf\xd1\xa4\xcd\x92\x1e\xcb\xed\x0a\xc4\x03\xd2\xbd\xd5\xf3\x4d\xbe\xf9\xe6\xb3\x67\xda\x75\xab\xb9\x78\xed\xda\x83\x37\x77\xf7\x11\x2f\x2f\x3e\xff\x6a\xd9\x7a\x0a\x64\x23\x2e\x55\x49\xef\x9d\xfb\x0d\x85\x2d\x25\x5e\xd5\x35\xfa\xa4\x00\xc6\xfd\x9e\xb4\x14\xb7\xf7\x26\x20\xb8\x9d\x2f\x09\xa5\xd3\x04\x13\x50\x9b\x92\x6c\x20\x8d\x9d\xd5\xe4\x11\xef\x09\xaf\x5a\x55\xde\x13\x5e\xca\x8b\x01\x45\xe5\x76\x56\x0b\x63\x7b\xfc\xfb\xe2\xf5\x9b\xab\x9b\x37\xa8\x4c\x4d\x38\xc5\xbc\x73\x11\xda\x78\x2a\xa3\xf3\x07\xb8\x0a\x71\x54\x2c\x7a\x22\x29\xe6\xab\x94\x84\xe8\x3a\x68\xaa\x8c\x25\x4c\xb4\x51\x35\x95\x71\x75\xe7\xa9\xa9\x8d\x5d\x69\x62\x55\x2b\x67\x69\x82\x94\x38\x33\xf7\x54\x92\xd9\x93\xc7\x7a\x83\x5c\x5e\x0f\x27\x26\x5a\xad\x70\xe9\x5d\x73\x4d\xa1\x75\x36\x10\x42\xa9\x6c\xe8\xc5\x9c\xf8\xb8\xf3\x23\xa4\x55\x54\x30\x36\x3a\x30\xa7\xbc\x52\x0d\x21\x25\x29\xaa\x9d\x2d\x31\x3d\xab\x93\x12\xe6\xe3\xa4\xd9\x59\x91\xa9\xa7\x80\xf9\x89\x5f\x0e\xd1\x19\xc8\x7b\xe7\xd1\x89\x6c\xdf\xa8\x76\xc1\x47\x16\xec\x29\xc8\x6b\x52\xfa\x27\x55\xef\xe8\x07\xd5\x4e\x67\x22\x33\x55\x8f\x7e\xb6\x81\x35\x35\x7f\x91\x79\x8a\x3b\x6f\x39\x2a\xb2\x24\xb2\xae\x5b\x22\xe7\x5e\x98\xa1\xf5\xc6\x46\x4c\xf8\x38\x39\x13\x29\xb2\xbd\xf2\x7d\x37\x8c\x21\x25\x84\xe8\x77\x65\xec\x19\x8b\x2d\xd0\x63\xb2\xd8\xca\xdb\x43\xcb\x7d\x00\xef\x7e\x0d\xce\xae\x27\x46\x2f\x5c\x63\x22\x35\x6d\x3c\x4c\xde\x89\x2c\xeb\x3a\x78\x65\xef\x08\xf9\xdb\x05\xf2\x8a\xcb\xe6\xf2\xd2\x50\xad\x43\x5f\x88\x33\x96\xc8\x2b\x79\xd3\x57\xe8\x11\x26\xec\x3a\x98\x0a\xca\x6a\xc6\x8a\x70\x6b\x1a\xc2\xd4\xba\xc8\xc7\x6f\x55\xf8\xc6\x71\xe5\x19\x52\x32\x36\x7e\xf9\x45\xd7\x81\xea\xc0\x4a\x8e\xdf\xe5\x95\xbc\x32\x75\xad\xde\xd7\x1c\x63\xcb\xc9\xea\x23\x9a\x57\x83\xe8\x87\xe8\x20\x9e\x9b\x62\x21\xce\xab\x3b\xfa\x8e\x0e\x48\xe9\xaf\xdd\x1c\x89\x7a\x33\x4f\x6e\xaf\x37\xe0\x8b\x91\xdb\xfe\x81\x4d\x5f\x8c\x7c\x9b\x7d\xfd\xe4\x7d\x9c\x19\x2f\x8b\x2d\x36\x63\xe3\x65\xb1\x15\x4f\x7b\xd8\x5b\x38\xa6\x19\x3a\x39\xb3\x74\xf3\x7c\x53\xa3\x69\x48\xfe\x68\xcd\xef\xd3\x8b\xc5\x99\x9c\xc7\x88\x67\x18\xdb\xbf\xfc\x78\xf2\xc8\xc1\xd3\xcf\x25\xb7\x70\xb2\xc5\x9a\x5a\x24\xf1\x67\xce\x33\xe6\xb9\x51\xf6\xf0\x8c\x81\x66\xe1\x81\x17\xce\xf1\x05\xde\x94\xae\x25\x79\xd3\x07\xfe\xd5\xb8\x87\x13\xc5\x47\xc7\x7d\x48\xfa\xdf\x8c\xfb\xcf\xbf\x7c\x1a\xf8\xff\x66\xe0\x2b\xe7\xf1\x76\x81\x3d\xfb\x72\xf4\x69\xec\x3b\x5f\xe1\xfc\xc3\x27\xb4\x81\x6a\x5b\xb2\x7a\xfa\x21\xb2\xc0\x8b\xf1\x9f\x09\x7f\x9c\x15\xdb\x35\xf6\xb2\xd8\x2e\x44\xf6\x9c\xbb\x78\xfc\x32\xd6\xe0\xf0\x3f\x58\x15\xfb\x27\x17\xc4\xe3\x19\x0f\x36\x0f\xb2\x1f\x16\x43\x96\x66\xbd\xf5\x7f\xb3\x20\xfe\x18\x00\xc5\xf5\xf7\x0a\xb4\x08\x00\x00")
 
 func templateDialectGremlinDecodeTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -412,7 +427,7 @@ func templateDialectGremlinDecodeTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectGremlinDeleteTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x52\x41\x6b\x1b\x3d\x14\x3c\xaf\x7e\xc5\xfb\x4c\xf8\x58\x19\x57\x76\x73\x6b\x4b\x0e\xa9\xeb\x52\x43\x28\x6d\x1c\x7a\x35\xb2\xf4\x76\x2d\xa2\x48\xdb\x27\xad\xb1\x59\xf4\xdf\x8b\x94\x75\x70\x4a\xe8\x69\x97\x37\x33\x6f\x66\x24\x0d\xc3\x7c\xca\x96\xbe\x3b\x91\x69\xf7\x11\xae\x17\xef\x3f\xbc\xeb\x08\x03\xba\x08\x5f\xa5\xc2\x9d\xf7\x8f\xb0\x76\x4a\xc0\xad\xb5\x50\x48\x01\x32\x4e\x07\xd4\x82\x3d\xec\x4d\x80\xe0\x7b\x52\x08\xca\x6b\x04\x13\xc0\x1a\x85\x2e\xa0\x86\xde\x69\x24\x88\x7b\x84\xdb\x4e\xaa\x3d\xc2\xb5\x58\x9c\x51\x68\x7c\xef\x34\x33\xae\xe0\x77\xeb\xe5\xea\xfb\x66\x05\x8d\xb1\x08\xe3\x8c\xbc\x8f\xa0\x0d\xa1\x8a\x9e\x4e\xe0\x1b\x88\x17\x66\x91\x10\x05\x9b\xce\x53\x62\x6c\x18\x40\x63\x63\x1c\xc2\x44\x1b\x69\x51\xc5\x79\x4b\xf8\x64\x8d\x9b\x6b\xb4\x18\x71\x02\x29\x65\xd6\xd5\xae\x37\x36\x67\xfa\x78\x03\x9d\x0c\x4a\x5a\xb8\x12\x1b\xe5\x3b\x14\x9f\x47\x64\x24\x12\x2a\x34\x87\x67\xe6\xcb\xff\x8b\x7c\x24\x3d\xf5\x51\x46\xe3\x5d\x59\x47\xc6\xc5\x0b\xdd\x44\x9c\xd1\x62\xce\x9a\xde\x29\xa8\x2f\x57\xa7\x04\xd3\xcb\x4c\x29\x71\x18\x63\xaf\x8e\xa8\x6a\x15\x8f\xa0\xbc\x8b\x78\x8c\x62\xf9\xfc\xe5\x50\x1b\x17\x67\x80\x44\x9e\x38\x0c\xac\x22\x0c\xd9\xfd\xff\x51\x28\xee\x31\x74\xde\x05\x1c\x12\xab\x7e\xf7\x48\xa7\x19\xec\x8c\xd3\xc6\xb5\x85\xf7\xaa\x5a\x4a\x62\x94\xd5\x5c\xfc\xcc\xe4\x9a\xb3\xca\x34\x79\xfd\x5b\x64\x4d\xf9\x4f\x9c\xc3\xcd\xe0\x2f\x83\x59\x7e\x17\xfc\x53\x91\xff\x77\x03\xce\xd8\x9c\xb0\x22\x8c\x3d\x39\x58\x94\xd8\xac\x4a\xec\x3c\x21\x0c\xe2\x1e\xa5\x5e\xbb\x58\x73\x96\xd8\x5b\x87\x04\xff\x38\xa5\x9a\xc3\x54\x07\x2b\x1e\x48\x1e\x90\x82\x2c\x76\x31\x27\x6f\xc5\xaf\x9a\x8b\x6f\x32\xdc\xc9\x1d\xda\xb2\x50\xfc\x90\xea\x51\xb6\x98\x8b\x94\x29\x67\x55\xe3\x09\xb6\x33\xe8\xca\x25\x4b\xd7\x22\xbc\xba\xd5\x94\x44\x47\xa8\x8d\x92\x11\x43\xa9\xd2\xd5\x91\x5f\x36\x88\x62\x63\x34\xae\x9a\x06\x55\xac\xb7\x5b\xf1\x85\x7c\x57\x73\x2e\x96\xbe\x1f\x3b\x0d\x03\xa0\xd3\xf9\x05\xfc\x09\x00\x00\xff\xff\xbd\x1b\x2d\x65\x69\x03\x00\x00")
+var _templateDialectGremlinDeleteTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x92\xd1\x6b\xdb\x30\x10\xc6\x9f\xad\xbf\xe2\x56\xca\xb0\x42\xa6\x74\x7d\xdb\x46\x1f\xba\x2c\x63\x85\x32\xb6\xb6\xec\xb5\x28\xd2\xd9\x11\x55\x25\xef\x74\x0e\x09\x46\xff\xfb\x90\xeb\x8c\x64\x94\x3d\x59\xdc\xfd\xbe\xfb\xbe\x93\x35\x0c\x8b\x99\x58\xc6\x6e\x4f\xae\xdd\x30\x5c\x5e\xbc\xff\xf0\xae\x23\x4c\x18\x18\xbe\x6a\x83\xeb\x18\x9f\xe0\x26\x18\x05\xd7\xde\xc3\x08\x25\x28\x7d\xda\xa2\x55\xe2\x61\xe3\x12\xa4\xd8\x93\x41\x30\xd1\x22\xb8\x04\xde\x19\x0c\x09\x2d\xf4\xc1\x22\x01\x6f\x10\xae\x3b\x6d\x36\x08\x97\xea\xe2\xd0\x85\x26\xf6\xc1\x0a\x17\xc6\xfe\xed\xcd\x72\xf5\xfd\x7e\x05\x8d\xf3\x08\x53\x8d\x62\x64\xb0\x8e\xd0\x70\xa4\x3d\xc4\x06\xf8\xc8\x8c\x09\x51\x89\xd9\x22\x67\x21\x86\x01\x2c\x36\x2e\x20\x9c\x59\xa7\x3d\x1a\x5e\xb4\x84\xcf\xde\x85\x85\x45\x8f\x8c\x67\x90\x73\xa1\xce\xd7\xbd\xf3\x25\xd3\xc7\x2b\xe8\x74\x32\xda\xc3\xb9\xba\x37\xb1\x43\xf5\x79\xea\x4c\x20\xa1\x41\xb7\x7d\x21\xff\x9e\xcf\xd7\xa7\xd0\x73\xcf\x9a\x5d\x0c\x05\xea\xc8\x05\x3e\xd2\x9d\xa9\x43\x77\x34\x17\x4d\x1f\x0c\xd4\xc7\xa3\x73\x86\xd9\x71\xa6\x9c\x25\x4c\xb1\x57\x3b\x34\xb5\xe1\x1d\x98\x18\x18\x77\xac\x96\x2f\x5f\x09\xb5\x0b\x3c\x07\x24\x8a\x24\x61\x10\x15\x61\x2a\xee\x6f\x27\xa1\xba\xc3\xd4\xc5\x90\x70\xc8\xa2\xfa\xdd\x23\xed\xe7\xb0\x76\xc1\xba\xd0\x8e\xdc\xc9\x6a\x39\xab\x49\x56\x4b\xf5\xb3\xc0\xb5\x14\x95\x6b\xca\xf8\xd7\x60\x4b\xe5\xa4\x0e\xe1\xe6\xf0\x8f\xc1\xbc\xbc\x0b\xf9\x69\x94\xbf\xb9\x82\xe0\x7c\x49\x58\x11\x72\x4f\x01\x2e\xc6\xd8\xa2\xca\xe2\x50\x21\x4c\xea\x0e\xb5\xbd\x09\x5c\x4b\x91\xc5\x6b\x97\x04\xff\xb9\xa5\x5a\xc2\xcc\x26\xaf\x1e\x48\x6f\x91\x92\x1e\xed\xb8\x24\x6f\xd5\xaf\x5a\xaa\x6f\x3a\xdd\xea\x35\xfa\x71\xa0\xfa\xa1\xcd\x93\x6e\xb1\x2c\x32\x56\xa5\xa8\x9a\x48\xf0\x38\x87\xae\x48\x48\x87\x16\xe1\xe4\xaf\xe6\xac\x3a\x42\xeb\x8c\x66\x4c\x65\x76\xd5\xd5\x2c\x8f\x37\x60\x75\xef\x2c\xae\x9a\x06\x0d\xd7\x8f\x8f\xea\x0b\xc5\xae\x96\x52\x2d\x63\x3f\xed\x34\x0c\x80\xc1\x42\xce\xe2\xcf\x00\xbd\x1b\x2d\x65\x69\x03\x00\x00")
 
 func templateDialectGremlinDeleteTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -432,7 +447,7 @@ func templateDialectGremlinDeleteTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectGremlinErrorsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\xc1\x8e\xdb\x36\x10\x3d\x47\x5f\xf1\xe2\x66\x03\xc9\x55\xe5\x4d\x6e\x75\xb1\x87\xc0\x75\xda\x00\xc5\x22\x6d\x92\xd3\x62\x0f\xb4\x38\x92\x89\xa5\x48\x67\x48\x29\x5d\x18\xfa\xf7\x82\xa4\xe4\x6e\xed\x06\x0d\xd0\x9b\x30\x33\x7c\x6f\xde\xcc\xd3\x1c\x8f\xab\x65\xb6\xb1\x87\x47\x56\xed\xde\xe3\xf5\xf5\xab\x1f\x7f\x38\x30\x39\x32\x1e\x6f\x45\x4d\x3b\x6b\x1f\xf0\xce\xd4\x15\xde\x68\x8d\x58\xe4\x10\xf2\x3c\x90\xac\xb2\x8f\x7b\xe5\xe0\x6c\xcf\x35\xa1\xb6\x92\xa0\x1c\xb4\xaa\xc9\x38\x92\xe8\x8d\x24\x86\xdf\x13\xde\x1c\x44\xbd\x27\xbc\xae\xae\xe7\x2c\x1a\xdb\x1b\x99\x29\x13\xf3\xbf\xbd\xdb\x6c\x6f\x3f\x6c\xd1\x28\x4d\x98\x62\x6c\xad\x87\x54\x4c\xb5\xb7\xfc\x08\xdb\xc0\x3f\x21\xf3\x4c\x54\x65\xcb\xd5\x38\x66\x59\xd0\x80\xba\x77\xde\x76\x20\x66\xcb\x0e\xc2\xc8\xf9\x73\x2f\x8c\xd4\xc4\x0e\x0d\xdb\x0e\xee\xb3\x86\x54\x42\x53\xed\x1d\xe2\xf3\xe3\x11\x92\x1a\x65\x08\x8b\x29\xb1\x6a\x99\x3a\xad\xcc\x2a\x21\x2c\x90\xaa\x5e\x1c\x1e\x5a\xac\x6f\xb0\x13\x8e\xf0\xa2\xda\x58\xd3\xa8\xb6\x7a\x2f\xea\x07\xd1\x52\xa8\xc9\x56\x2b\x6c\xe2\x10\xba\x83\xa6\x8e\x8c\x77\x51\x89\x74\xba\xba\x8d\x71\xe3\x89\x1b\x51\x53\x95\x35\xbd\xa9\x91\x13\x36\xd6\x38\xcf\x42\x19\xbf\x0d\x64\x45\x04\xc8\x0b\xe4\xce\xb3\x32\x6d\x89\xbb\xfb\xd3\xab\xe3\x58\xe0\x98\x3d\x63\xf2\x3d\x1b\x38\xcf\xb5\x35\x43\xf5\x7b\x6f\x3d\xe5\x54\x1d\x98\x1a\xf5\x67\x5e\xe0\x7b\x50\xd5\xb9\xb6\x28\x61\x94\xce\xc6\xec\xc4\xb5\xbc\x20\xfb\x64\x3a\xc1\x6e\x2f\xf4\x2f\x2c\x0e\x7b\x67\x4d\xbe\xc3\xdd\xfd\xee\xd1\x53\x91\xc6\x17\xf8\x06\xc1\x18\x70\xf7\xea\x7e\x99\x7a\xca\x9e\xa9\x26\x64\xc3\x2c\xda\xe9\x5d\x75\x42\xca\x77\x25\x5e\x0e\xc5\x4f\xb1\xe2\xf9\x4d\xe8\x21\x80\xcc\x5d\x13\x73\xf6\x6c\x8c\x10\xc3\xdd\xf5\x3d\x6e\x2e\x2a\x9a\xce\x57\xb1\xbd\x26\x5f\xcc\x53\x1f\xc7\x35\x3a\xe5\x9c\x32\x2d\x52\x13\x18\x84\xee\x69\x51\xcc\x60\xcf\x53\xd8\x55\xbf\x0a\xf7\x3e\x4d\x62\x19\x08\x4a\xfc\x3d\x99\xe2\x5b\x68\x94\x19\x84\x56\x72\xa6\x69\x2c\xa7\x49\xac\x71\xe5\x16\x25\x22\x68\x62\x8d\x53\xc6\x0d\x66\xe6\x8f\xac\xba\xaf\x52\x9f\xd6\x36\xed\x64\xb5\x42\xca\x21\xc5\x93\x53\xa6\x50\x1f\xfe\x9e\xc0\x3c\x19\x11\x75\x58\x9c\x30\xde\xcd\xc6\xb9\xd8\xe4\x69\xfb\x53\xdf\xc7\x09\x17\x8b\x6d\xea\x7e\x81\xc4\x7a\x4b\x5f\xb6\xcc\x9f\x8c\xfa\xdc\xd3\x5b\x45\x5a\xa2\x66\x12\x9e\x1c\x44\xa2\x89\xa8\xd3\xf6\x43\x0f\x7d\x2c\x45\x13\x6a\x67\xfe\x0b\x90\x5c\x8b\x1d\xe9\x32\x55\x61\xf6\xee\x80\x7f\x78\xf7\xdc\x7f\x4f\xcc\xfc\xf2\x2c\x75\xec\x5c\xbb\x8e\x3b\xfa\x70\x60\x65\x7c\x93\x2f\x12\xf4\x95\xab\xae\x1c\xbe\x28\xbf\x4f\x0e\x58\xe3\xea\xbb\x61\x51\xe2\x29\x7f\x89\xa1\x18\xb3\x4b\xb9\x5b\xd9\xd2\x37\xaa\x25\xd9\xd2\xbf\x89\x0d\x10\xb3\xd6\x50\x53\xe2\x64\x95\xff\xab\x2f\xc0\x3d\x95\xa7\xe4\x99\xb6\x99\xef\xa4\x4d\xb9\xcd\xe4\x8b\x44\xa7\x8c\x54\x75\x14\xa7\x9a\x68\xa7\x56\x0d\x64\xc2\xb5\x3e\xd8\x70\x72\xf7\x56\xcb\x20\xfc\xdc\x55\xe1\xc3\x0b\x65\x82\x6f\x84\x49\xc3\x98\xb4\x9f\x51\xe4\x8c\xe5\xf4\xba\xfa\x63\x82\x2d\x90\x9f\x0b\x2f\xb1\xb3\x56\xc7\xdf\x8d\xc2\x95\xb8\x50\x3f\xfe\xc7\x0d\xe1\x80\xde\x6b\x5f\xfd\x2c\xbc\x28\x41\x5f\x3d\x27\x46\x85\xa5\x0b\xed\x28\xfe\x92\xf3\x91\x29\xe1\xb9\xa7\x2c\x9e\x6d\x32\x32\x1c\xe7\xbf\x02\x00\x00\xff\xff\x22\xd4\x7a\x3f\xe9\x06\x00\x00")
+var _templateDialectGremlinErrorsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\xc1\x8e\xdb\x36\x10\x3d\x47\x5f\xf1\xe2\x66\x03\xc9\x55\xe9\x4d\x6e\x75\xb1\x87\xc0\x75\xda\x00\xc5\x22\x6d\x92\xd3\x62\x0f\xb4\x38\x92\x89\xa5\x48\x67\x48\x29\x5d\x18\xfa\xf7\x82\x94\xe4\x2c\xec\x06\x0d\xd0\x93\x8d\x99\xd1\x7b\xf3\x66\x1e\xe7\x78\x5c\x2d\xb3\x8d\x3b\x3c\xb2\x6e\xf6\x01\xaf\xaf\x5f\xfd\xfc\xd3\x81\xc9\x93\x0d\x78\x2b\x2b\xda\x39\xf7\x80\x77\xb6\x12\x78\x63\x0c\x52\x91\x47\xcc\x73\x4f\x4a\x64\x1f\xf7\xda\xc3\xbb\x8e\x2b\x42\xe5\x14\x41\x7b\x18\x5d\x91\xf5\xa4\xd0\x59\x45\x8c\xb0\x27\xbc\x39\xc8\x6a\x4f\x78\x2d\xae\xe7\x2c\x6a\xd7\x59\x95\x69\x9b\xf2\x7f\xbc\xdb\x6c\x6f\x3f\x6c\x51\x6b\x43\x98\x62\xec\x5c\x80\xd2\x4c\x55\x70\xfc\x08\x57\x23\x3c\x21\x0b\x4c\x24\xb2\xe5\x6a\x18\xb2\x2c\x6a\x40\xd5\xf9\xe0\x5a\x10\xb3\x63\x0f\x69\xd5\xfc\x77\x2f\xad\x32\xc4\x1e\x35\xbb\x16\xfe\xb3\x81\xd2\xd2\x50\x15\x3c\xd2\xe7\xc7\x23\x14\xd5\xda\x12\x16\x53\x62\xd5\x30\xb5\x46\xdb\xd5\x88\xb0\xc0\x58\xf5\xe2\xf0\xd0\x60\x7d\x83\x9d\xf4\x84\x17\x62\xe3\x6c\xad\x1b\xf1\x5e\x56\x0f\xb2\xa1\x58\x93\xad\x56\xd8\xa4\x21\xb4\x07\x43\x2d\xd9\xe0\x93\x12\xe5\x8d\xb8\x4d\x71\x1b\x88\x6b\x59\x91\xc8\xea\xce\x56\xc8\x09\x1b\x67\x7d\x60\xa9\x6d\xd8\x46\xb2\x22\x01\xe4\x05\x72\x1f\x58\xdb\xa6\xc4\xdd\xfd\xe9\xab\xe3\x50\xe0\x98\x3d\x63\x0a\x1d\x5b\xf8\xc0\x95\xb3\xbd\xf8\xb3\x73\x81\x72\x12\x07\xa6\x5a\xff\x9d\x17\xf8\x11\x24\x5a\xdf\x14\x25\xac\x36\xd9\x90\x9d\xb8\x96\x17\x64\x9f\x6c\x2b\xd9\xef\xa5\xf9\x8d\xe5\x61\xef\x9d\xcd\x77\xb8\xbb\xdf\x3d\x06\x2a\xc6\xf1\x45\xbe\x5e\x32\x7a\xdc\xbd\xba\x5f\x8e\x3d\x65\xcf\x74\x1d\xb3\x71\x16\xcd\xf4\x9d\x38\x21\xe5\xbb\x12\x2f\xfb\xe2\x97\x54\xf1\xfc\x26\xf6\x10\x41\xe6\xae\x89\x39\x7b\x36\x24\x88\xfe\xee\xfa\x1e\x37\x17\x15\x75\x1b\x44\x6a\xaf\xce\x17\xf3\xd4\x87\x61\x8d\x56\x7b\xaf\x6d\x13\x75\xc7\x9f\x5e\x9a\x8e\x16\xc5\x0c\xf6\x7c\x0c\x7b\xf1\xbb\xf4\xef\xc7\x49\x2c\x23\x41\x89\xaf\x93\x29\xbe\x87\x46\xdb\x5e\x1a\xad\x66\x9a\xda\x71\x54\xe2\x78\x8d\x2b\xbf\x28\x91\x40\x47\xd6\x34\x65\xdc\x4c\x95\x5e\x7c\x64\xdd\x7e\x93\xfa\xb4\xb6\x69\x27\xab\x15\xc6\x1c\xc6\xf8\xe8\x94\x29\xd4\xc5\xd7\x13\x99\x27\x23\xa2\x8a\x8b\x93\x36\xf8\xd9\x38\x17\x9b\x9c\x89\xe6\xbe\x8f\x13\x2e\x16\xa9\x60\x8d\x05\x46\xd6\x5b\xfa\xb2\x65\xfe\x64\xf5\xe7\x8e\xde\x6a\x32\x0a\x15\x93\x0c\xe4\x21\x47\x9a\x64\xc6\x69\xfb\xb1\x87\x2e\x95\xa2\x8e\xb5\x33\xff\x05\x48\x6e\xe4\x8e\x4c\x39\x56\x4d\x3d\x94\xe8\xbf\x3a\x3e\x7a\xf7\xdc\x7f\x4f\xcc\xfc\xf2\x2c\x75\x6c\x7d\xb3\x4e\x3b\xfa\x70\x60\x6d\x43\x9d\x2f\x46\xe8\x2b\x2f\xae\x3c\xbe\xe8\xb0\x1f\x1d\xb0\xc6\xd5\x0f\xfd\xa2\xc4\x53\xfe\x12\x7d\x31\x64\x97\x72\xb7\xaa\xa1\xef\x54\x4b\xaa\xa1\x7f\x13\x1b\x21\x66\xad\xb1\xa6\xc4\xc9\x2a\xff\x57\x5f\x84\x7b\x2a\x4f\xab\x33\x6d\x33\xdf\x49\x9b\xf6\x9b\xc9\x17\x09\x13\xda\x2a\x5d\x25\x71\x3a\x1e\x4b\x42\xa3\x7b\xb2\xf1\x5a\x1f\x9c\xf5\x84\xbd\x33\x2a\xae\xf9\xdc\x55\x71\x12\x41\x6a\x1b\x7d\x23\xd3\x13\x75\x3c\x69\x3f\xa3\xc8\x19\xcb\xe9\x6b\xf1\xd7\x04\x5b\x20\x3f\x17\x5e\x62\xe7\x9c\x49\xcf\x8d\xe2\x95\xb8\x50\x3f\xfc\xc7\x0d\xe1\x88\xde\x99\x20\x7e\x95\x41\x96\xa0\x6f\x9e\x13\xab\xe3\xd2\xa5\xf1\x94\x9e\xe4\x14\xa5\x12\x81\x3b\xca\xd2\xd9\x26\xab\x30\x0c\xd9\x3f\x03\x00\x22\xd4\x7a\x3f\xe9\x06\x00\x00")
 
 func templateDialectGremlinErrorsTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -452,7 +467,7 @@ func templateDialectGremlinErrorsTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectGremlinGlobalsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8f\xc1\x6a\xc3\x30\x10\x44\xef\xfa\x8a\x21\xc7\x40\xed\x34\xb7\x1e\x43\x48\x21\x50\x7a\x69\x7f\xc0\x91\xc6\x91\xa8\xa2\x35\xbb\x72\x21\x98\xfc\x7b\xb1\xeb\x42\x6f\xcb\xbe\xb7\x3b\xcc\x34\xb5\x5b\x77\x94\xe1\xae\xe9\x1a\x2b\xf6\xbb\xe7\x97\xa7\x41\x69\x2c\x15\xaf\x9d\xe7\x45\xe4\x0b\xe7\xe2\x1b\x1c\x72\xc6\x22\x19\x66\xae\xdf\x0c\x8d\xfb\x8c\xc9\x60\x32\xaa\x27\xbc\x04\x22\x19\x72\xf2\x2c\xc6\x80\xb1\x04\x2a\x6a\x24\x0e\x43\xe7\x23\xb1\x6f\x76\x7f\x14\xbd\x8c\x25\xb8\x54\x16\xfe\x76\x3e\x9e\xde\x3f\x4e\xe8\x53\x26\xd6\x9d\x8a\x54\x84\xa4\xf4\x55\xf4\x0e\xe9\x51\xff\x85\x55\x25\x1b\xb7\x6d\x1f\x0f\xe7\xe6\x0e\xf0\xa3\x55\xb9\xe1\x9a\xe5\xd2\x65\x43\x57\x02\x22\xf3\x40\x35\xf4\xa2\xb8\x2a\x6f\x39\x15\x84\xd4\x65\xfa\x6a\x58\x4e\xa7\x09\x81\x7d\x2a\xc4\x66\x05\xed\x2a\xb6\xeb\xa3\x0d\x7e\x35\x96\x30\x4f\x3f\x01\x00\x00\xff\xff\x4f\x95\x19\xb3\x2f\x01\x00\x00")
+var _templateDialectGremlinGlobalsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8f\xc1\x6a\xc3\x30\x10\x44\xef\xfa\x8a\x21\xc7\x40\xed\x34\xb7\x1e\x43\x48\x21\x50\x7a\x69\x7f\xc0\x91\xc6\x91\xa8\xa2\x35\xbb\x72\x21\x18\xff\x7b\x89\xeb\x42\xaf\xfb\xde\xce\x30\xd3\xd4\x6e\xdd\x51\x86\xbb\xa6\x6b\xac\xd8\xef\x9e\x5f\x9e\x06\xa5\xb1\x54\xbc\x76\x9e\x17\x91\x2f\x9c\x8b\x6f\x70\xc8\x19\x8b\x64\x78\x70\xfd\x66\x68\xdc\x67\x4c\x06\x93\x51\x3d\xe1\x25\x10\xc9\x90\x93\x67\x31\x06\x8c\x25\x50\x51\x23\x71\x18\x3a\x1f\x89\x7d\xb3\xfb\xa3\xe8\x65\x2c\xc1\xa5\xb2\xf0\xb7\xf3\xf1\xf4\xfe\x71\x42\x9f\x32\xb1\xde\x54\xa4\x22\x24\xa5\xaf\xa2\x77\x48\x8f\xfa\xaf\xac\x2a\xd9\xb8\x6d\x3b\xcf\xce\x3d\x36\xc0\x8f\x56\xe5\x86\x6b\x96\x4b\x97\x0d\x5d\x09\x88\xcc\x03\xd5\xd0\x8b\xe2\xaa\xbc\xe5\x54\x10\x52\x97\xe9\xab\x61\x79\x9d\x26\x04\xf6\xa9\x10\x9b\x15\xb4\xab\xd8\xae\x41\x1b\xfc\x6a\x2c\x01\xf3\xec\x7e\x06\x00\x4f\x95\x19\xb3\x2f\x01\x00\x00")
 
 func templateDialectGremlinGlobalsTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -472,7 +487,7 @@ func templateDialectGremlinGlobalsTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectGremlinGroupTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\xd1\x6e\x1b\x2b\x10\x7d\x5e\xbe\x62\xae\x15\x5d\x2d\xbe\x7b\x71\x92\xb7\xb6\xf2\x43\x92\x26\x55\xa4\x26\x52\xe3\xb4\x2f\x55\x65\x11\x18\x6c\x54\x0c\x5b\x60\x57\xb6\x56\xfb\xef\x15\x78\x6d\x39\x89\x53\xa9\x4f\x2c\x33\x67\xe6\x9c\xe1\xb0\x74\xdd\x64\x4c\xae\x5c\xbd\xf1\x7a\xb1\x8c\x70\x7e\x7a\xf6\xee\xff\xda\x63\x40\x1b\xe1\x86\x0b\x7c\x72\xee\x27\xdc\x5a\xc1\xe0\xc2\x18\xc8\xa0\x00\x29\xef\x5b\x94\x8c\x3c\x2e\x75\x80\xe0\x1a\x2f\x10\x84\x93\x08\x3a\x80\xd1\x02\x6d\x40\x09\x8d\x95\xe8\x21\x2e\x11\x2e\x6a\x2e\x96\x08\xe7\xec\x74\x97\x05\xe5\x1a\x2b\x89\xb6\x39\xff\xf9\xf6\xea\xfa\x7e\x76\x0d\x4a\x1b\x84\x21\xe6\x9d\x8b\x20\xb5\x47\x11\x9d\xdf\x80\x53\x10\x0f\xc8\xa2\x47\x64\x64\x3c\xe9\x7b\x42\xba\x0e\x24\x2a\x6d\x11\x46\x52\x73\x83\x22\x4e\x16\x1e\x57\x46\xdb\xc9\xc2\xbb\xa6\x1e\x41\xdf\x27\xd0\xc9\x53\xa3\x4d\x92\xf4\x7e\x0a\x35\x0f\x82\x1b\x38\x61\x33\xe1\x6a\x64\x97\x43\x66\x00\x7a\x14\xa8\xdb\x2d\x72\xff\xbd\x2f\x4f\x9c\xaa\xb1\x02\xca\x67\xd8\xbe\x87\xf1\x21\x4b\xdf\x53\x18\x74\xcc\x04\xb7\xa5\x88\x6b\x10\xce\x46\x5c\x47\x76\xb5\x5d\x2b\x68\x41\xdb\x88\x5e\x71\x81\x5d\x4f\x01\xbd\x77\x1e\x3a\x52\x78\x0c\x89\xfc\xdf\xa1\x01\x7b\xc0\x50\x3b\x1b\xb0\xeb\x49\xf1\xab\x41\xbf\xa9\xe0\x49\x5b\xa9\xed\x22\xe3\x5e\x08\x61\x43\xd9\x97\x84\x2c\x29\x1b\x56\x52\x68\x95\x28\x8e\x55\x48\x9f\xbe\xd8\xf5\x1a\x45\x52\x5a\xc1\x0b\x96\x2a\xb9\x4e\x3f\xe4\xf2\x7f\xa6\x60\xb5\x49\x32\x0b\x8f\xb1\xf1\x36\x45\x49\xd1\xe7\xfe\x06\xed\xcb\x73\x61\x4a\xa3\x91\x81\xfe\x77\x34\x67\x03\x85\xe9\x14\xce\x0e\xfb\x79\x0c\xec\x01\xb9\xfc\xc6\x4d\xd9\xd2\xdc\xba\x5d\x55\x3b\xed\x07\xd9\x06\xef\x78\x7d\x30\xd9\xdb\xd2\x86\x6d\xbb\x62\x1f\x31\x5d\xd5\xd4\xb7\x27\x7f\xeb\xe4\x70\x92\x30\x96\xc1\xb0\x47\xcf\x5b\xf4\x81\x67\xbe\x96\x7b\x28\x49\x51\x44\x1f\xe0\xfb\x8f\x03\x57\x49\x51\x58\xbe\xc2\x57\x51\x4a\x0a\xe5\x3c\xcc\x2b\x50\x36\x4f\xc5\xed\x02\x5f\xf9\xa2\x6c\xc8\xd3\xa4\x16\x15\xc4\x3c\xbf\xb2\xe5\xa8\x1e\x55\x30\x1a\xd1\x81\x70\x0a\xbc\xae\xd1\xca\x32\xfa\x90\x50\x74\x4f\xba\xcf\xe4\x6d\x05\x69\xd9\x1e\xe8\x8e\xfc\x0f\xdc\xd9\xb6\x3d\xfd\xeb\x66\xea\x38\xff\x7c\xce\x2e\x42\x92\x48\xd9\x57\xab\x9c\x91\x25\x65\xd9\xab\x50\x2a\x9a\x52\x8a\xd2\x43\x4f\xde\xb8\xbd\xec\x53\xfa\x7b\x4b\xca\x48\x51\x14\xc5\xe5\xa6\x9c\xcf\x77\x6d\x8e\x2b\x65\x8c\x51\x76\x93\xf9\x9e\x15\x6d\x43\xec\x8e\x47\xb1\x4c\x0a\x33\x6e\x86\xe9\xa5\xd8\x4e\x92\x02\x43\xc5\x10\x4e\xf6\x6e\xb9\x86\xf8\x3d\xae\x63\x99\x6e\x4c\xd7\x01\x5a\x99\x1e\x81\xdf\x01\x00\x00\xff\xff\x22\x89\x20\x55\x3c\x05\x00\x00")
+var _templateDialectGremlinGroupTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\xc1\x6e\x1b\x2b\x14\x5d\x0f\x5f\x71\x9f\x15\x3d\x0d\x7e\xf3\x70\x92\x5d\x5b\x79\x91\xa4\x49\x15\xa9\x89\xd4\x38\xed\xa6\xaa\x2c\x02\x17\x1b\x75\x0c\x53\x60\x46\xb6\x46\xfc\x7b\x05\x1e\x5b\x4e\xe2\x54\xea\x8a\xe1\xde\x73\xcf\x39\x70\xb0\xfb\x7e\x32\x26\x57\xb6\xd9\x38\xbd\x58\x06\x38\x3f\x3d\x7b\xf7\x7f\xe3\xd0\xa3\x09\x70\xc3\x05\x3e\x59\xfb\x13\x6e\x8d\x60\x70\x51\xd7\x90\x41\x1e\x52\xdf\x75\x28\x19\x79\x5c\x6a\x0f\xde\xb6\x4e\x20\x08\x2b\x11\xb4\x87\x5a\x0b\x34\x1e\x25\xb4\x46\xa2\x83\xb0\x44\xb8\x68\xb8\x58\x22\x9c\xb3\xd3\x5d\x17\x94\x6d\x8d\x24\xda\xe4\xfe\xe7\xdb\xab\xeb\xfb\xd9\x35\x28\x5d\x23\x0c\x35\x67\x6d\x00\xa9\x1d\x8a\x60\xdd\x06\xac\x82\x70\x20\x16\x1c\x22\x23\xe3\x49\x8c\x84\xf4\x3d\x48\x54\xda\x20\x8c\xa4\xe6\x35\x8a\x30\x59\x38\x5c\xd5\xda\x4c\x16\xce\xb6\xcd\x08\x62\x4c\xa0\x93\xa7\x56\xd7\xc9\xd2\xfb\x29\x34\xdc\x0b\x5e\xc3\x09\x9b\x09\xdb\x20\xbb\x1c\x3a\x03\xd0\xa1\x40\xdd\x6d\x91\xfb\xef\xfd\x78\xd2\x54\xad\x11\x50\x3e\xc3\xc6\x08\xe3\x43\x95\x18\x29\x0c\x3e\x66\x82\x9b\x52\x84\x35\x08\x6b\x02\xae\x03\xbb\xda\xae\x15\x74\xa0\x4d\x40\xa7\xb8\xc0\x3e\x52\x40\xe7\xac\x83\x9e\x14\x0e\x7d\x12\xff\x77\x20\x60\x0f\xe8\x1b\x6b\x3c\xf6\x91\x14\xbf\x5a\x74\x9b\x0a\x9e\xb4\x91\xda\x2c\x32\xee\x85\x11\x36\x8c\x7d\x49\xc8\x92\xb2\x61\x25\x85\x56\x49\xe2\xd8\x84\x74\xe9\x8b\x5d\xaf\x51\x24\xa7\x15\xbc\x50\xa9\x52\xea\xf4\x43\x1e\xff\x67\x0a\x46\xd7\xc9\x66\xe1\x30\xb4\xce\xa4\x2a\x29\x62\xe6\xaf\xd1\xbc\xbc\x17\xa6\x34\xd6\xd2\xd3\xff\x8e\xf6\x8c\xa7\x30\x9d\xc2\xd9\x21\x9f\x43\xcf\x1e\x90\xcb\x6f\xbc\x2e\x3b\x9a\xa9\xbb\x55\xb5\xf3\x7e\xd0\x6d\xf1\x8e\x37\x07\x27\x7b\xdb\xda\xb0\xed\x56\xec\x23\xa6\xa7\x9a\x78\x23\xf9\xdb\x24\x87\x9b\x84\xb1\xf4\x35\x7b\x74\xbc\x43\xe7\x79\xbe\x8a\x8e\x3b\x28\x49\x51\x04\xe7\xe1\xfb\x8f\x83\x54\x49\x51\x18\xbe\xc2\x57\x55\x4a\x0a\x65\x1d\xcc\x2b\x50\x26\x9f\x8a\x9b\x05\xbe\xca\x45\x19\x9f\xd8\x33\x45\x05\x21\x3f\x49\x65\xca\x51\x33\xaa\x60\x34\xa2\x83\xe0\x14\x78\xd3\xa0\x91\x65\x70\x3e\xa1\xe8\x5e\x74\xdf\xc9\xdb\x0a\xd2\xb2\xbd\xd0\x9d\xf8\x1f\xb4\x73\x6c\xd0\xbf\x49\xa6\x8e\xeb\xcf\xe7\xec\xc2\x27\x8b\x94\x7d\x35\xca\xd6\xb2\xa4\x2c\x67\xe5\x4b\x45\x53\x4b\x51\x7a\x98\xc9\x1b\xaf\x97\x7d\x4a\xbf\xde\x92\x32\x52\x14\x45\x71\xb9\x29\xe7\xf3\x1d\xcd\x71\xa7\x8c\x31\xca\x6e\xb2\xde\xb3\xa1\x6d\x89\xdd\xf1\x20\x96\xc9\x61\xc6\xcd\x30\xfd\x53\x6c\x4f\x92\x0a\xc3\xc4\x50\x4e\xf1\x6e\xb5\x86\xfa\x3d\xae\x43\x99\x5e\x4c\xdf\x03\x1a\x09\x31\x92\xdf\x03\x00\x22\x89\x20\x55\x3c\x05\x00\x00")
 
 func templateDialectGremlinGroupTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -492,7 +507,7 @@ func templateDialectGremlinGroupTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectGremlinMetaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x90\xc1\x6a\x1b\x31\x14\x45\xd7\xd1\x57\x5c\x8c\x57\x01\xcf\xa4\xd9\xb5\x90\x45\x30\x2e\x18\x42\x36\xed\x0f\xc8\xd2\x9d\x19\x51\x59\x32\x7a\x72\x8a\x11\xfa\xf7\xa2\xc9\x24\xf6\xa2\xd0\x4d\x77\x92\xce\x3b\xef\xce\xdc\x52\xfa\x7b\xb5\x8d\xa7\x4b\x72\xe3\x94\xf1\xf8\xf0\xe5\xeb\xe6\x94\x28\x0c\x19\xdf\xb5\xe1\x21\xc6\x5f\xd8\x07\xd3\xe1\xd9\x7b\xcc\x43\x82\xc6\xd3\x1b\x6d\xa7\x7e\x4e\x4e\x20\xf1\x9c\x0c\x61\xa2\x25\x9c\xc0\x3b\xc3\x20\xb4\x38\x07\xcb\x84\x3c\x11\xcf\x27\x6d\x26\xe2\xb1\x7b\xf8\xa0\x18\xe2\x39\x58\xe5\xc2\xcc\x5f\xf6\xdb\xdd\xeb\x8f\x1d\x06\xe7\x89\xe5\x2d\xc5\x98\x61\x5d\xa2\xc9\x31\x5d\x10\x07\xe4\x9b\xb0\x9c\xc8\x4e\xdd\xf7\xb5\x2a\xd5\xfe\x01\x26\x06\xc9\x3a\x64\x41\x20\x2d\x2d\x86\x98\x30\x26\x1e\xbd\x0b\xb0\x4e\x7b\x9a\x2c\x1d\x66\xa3\x14\x58\x0e\x2e\x10\xab\x85\xf4\xcb\x64\x7f\x64\xd6\xfd\xe7\xae\x15\x6a\x55\x77\xa5\x20\xe9\x30\x12\x6b\xe2\xdb\x13\xd6\xdd\xce\x8e\x14\xd4\x5a\x0a\xd6\x5e\x1f\xe8\xe7\x67\x76\x2f\xed\xbc\x5d\x64\x6c\x9a\xdb\x64\x37\x34\xb8\x97\x7d\x78\x63\x12\xce\xe2\xe6\xc3\x9c\xc5\x85\xfc\xcd\xbf\xeb\x7b\x5c\x73\x6a\xc5\x14\xbd\x95\xb9\x21\xc9\xc9\x85\x11\xef\xc4\x32\xc4\xdc\xae\x8d\x94\x02\x1f\x7f\x33\xb5\xdd\xaf\xfa\xd8\x22\xe1\x96\x74\xda\x91\xc8\x97\xd3\x67\xd1\x56\x67\x7d\xd0\xc2\xee\xfd\x6b\xe9\x85\xff\x3b\xfb\x9f\x99\xc1\x5e\xeb\xba\xc6\x3d\x61\xd5\xee\x4b\xb1\xa8\x75\xa5\x6e\xa7\x97\x63\xad\xea\x4f\x00\x00\x00\xff\xff\x71\xf6\x7c\x35\xc6\x02\x00\x00")
+var _templateDialectGremlinMetaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x90\xc1\x6a\x1b\x31\x10\x86\xcf\xd9\xa7\xf8\x31\x3e\x05\xac\x4d\x73\x6b\x21\x87\x60\x5c\x30\x84\x5c\xda\x17\x90\xa5\x7f\x77\x45\xe5\x91\x91\xe4\x14\x23\xf4\xee\x45\x9b\x8d\xeb\x42\xa1\x97\xde\xa4\xf9\xe7\x9b\x4f\x9a\x52\xfa\xfb\x6e\x1b\x4e\x97\xe8\xc6\x29\xe3\xf1\xe1\xd3\xe7\xcd\x29\x32\x51\x32\xbe\x6a\xc3\x43\x08\x3f\xb0\x17\xa3\xf0\xec\x3d\xe6\xa6\x84\x96\xc7\x37\x5a\xd5\x7d\x9f\x5c\x42\x0a\xe7\x68\x08\x13\x2c\xe1\x12\xbc\x33\x94\x44\x8b\xb3\x58\x46\xe4\x89\x78\x3e\x69\x33\x11\x8f\xea\xe1\x23\xc5\x10\xce\x62\x3b\x27\x73\xfe\xb2\xdf\xee\x5e\xbf\xed\x30\x38\x4f\x2c\xb5\x18\x42\x86\x75\x91\x26\x87\x78\x41\x18\x90\x6f\x64\x39\x92\xaa\xbb\xef\x6b\xed\xba\xf6\x07\x98\x20\x29\x6b\xc9\x09\x42\x5a\x5a\x0c\x21\x62\x8c\x3c\x7a\x27\xb0\x4e\x7b\x9a\x9c\x14\x66\xa2\x14\x58\x0e\x4e\x88\xd5\x92\xf4\x4b\x67\x7f\x64\xd6\xfd\x75\xd6\x0a\xb5\x76\x77\xa5\x20\x6a\x19\x89\x35\xf1\xe5\x09\x6b\xb5\xb3\x23\x13\x6a\x2d\x05\x6b\xaf\x0f\xf4\x73\x99\xea\xa5\x9d\xb7\x0b\x8c\x4d\x63\x1b\xec\x06\xac\xa9\xf6\x69\x2f\x6f\x8c\x89\x33\xb8\xf9\x20\x67\x70\x49\xfe\xc6\xdf\xf5\x3d\x7e\x7b\x6a\xc5\x14\xbc\x4d\xf3\x86\x52\x8e\x4e\x46\xbc\x27\x96\x12\x72\xbb\xb6\xa4\x14\xf8\xf0\x93\xb1\xcd\x7e\xd5\xc7\xa6\x84\x5b\xec\xb4\x23\x91\x2f\xa7\xeb\xa2\xad\xce\xfa\xa0\x13\xd5\xfb\x6b\xe9\x13\xff\xb7\xfb\x9f\x4e\xb1\x8b\xf2\x0f\xdd\x13\x56\xa5\x5c\x17\x8b\x5a\x57\xdd\x6d\x77\x29\xa0\x58\xd4\xda\xfd\x1a\x00\x71\xf6\x7c\x35\xc6\x02\x00\x00")
 
 func templateDialectGremlinMetaTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -512,7 +527,7 @@ func templateDialectGremlinMetaTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectGremlinOpenTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x50\xc1\x6a\xdd\x30\x10\x3c\x4b\x5f\xb1\xcd\xc9\x0e\xae\x9c\xe6\xd6\x07\x39\x04\xf7\x15\x02\xe1\x51\x9a\xf6\x03\x54\x69\xfd\xbc\x54\x59\x89\x95\xec\x12\x8c\xff\xbd\xd8\x3c\xbf\x96\x9e\x7a\xdc\x99\xd9\x99\x61\xe6\xb9\xbd\xd5\x5d\x4c\x6f\x42\xe7\xa1\xc0\xfd\xdd\x87\x8f\xef\x93\x60\x46\x2e\xf0\xd9\x3a\xfc\x11\xe3\x4f\x78\x62\x67\xe0\x31\x04\xd8\x44\x19\x56\x5e\x26\xf4\x46\x7f\x1b\x28\x43\x8e\xa3\x38\x04\x17\x3d\x02\x65\x08\xe4\x90\x33\x7a\x18\xd9\xa3\x40\x19\x10\x1e\x93\x75\x03\xc2\xbd\xb9\xdb\x59\xe8\xe3\xc8\x5e\x13\x6f\xfc\xf3\x53\x77\x3c\xbd\x1c\xa1\xa7\x80\x70\xc1\x24\xc6\x02\x9e\x04\x5d\x89\xf2\x06\xb1\x87\xf2\x57\x58\x11\x44\xa3\x6f\xdb\x65\xd1\x7a\x9e\xc1\x63\x4f\x8c\x70\xe3\xc9\x06\x74\xa5\x3d\x0b\xbe\x06\xe2\xd6\x05\x42\x2e\x6d\x4c\xc8\x37\xb0\x2c\x5a\x8d\x0d\xa0\x08\x1c\x1e\x60\x94\x60\xbe\x58\xc9\x58\x79\x5b\xec\xcb\x66\x7b\xb2\xaf\x58\x6b\x45\xfd\x26\x7a\xf7\x00\x4c\x01\x66\xad\x94\x60\x19\x85\xd7\x73\xfb\xd7\x6a\xd1\xca\x5d\xad\x2e\x69\xe6\x84\xbf\xba\x2d\xb0\xda\x91\x2e\x72\x4f\xe7\xd5\xe1\xc8\x3e\x45\xe2\x72\xb8\xaa\x77\x64\x65\xd5\xf7\xaf\xcf\x07\x18\x1b\xad\xd4\xd2\x68\xb5\xfc\x77\x09\x2f\xd3\x3f\x0d\x3e\x09\x4d\x28\x95\xab\xf5\xfe\xf0\xa7\x96\x4d\x09\xd9\x57\x31\x15\x8a\x9c\x1b\xb8\x68\xbd\x4c\x75\x6d\x8c\xa9\x9b\xd5\x7c\xdd\x13\xd9\xaf\x73\xfd\x0e\x00\x00\xff\xff\xe0\x35\x12\x3f\x1e\x02\x00\x00")
+var _templateDialectGremlinOpenTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x8f\x41\x6b\xdc\x30\x14\x84\xcf\xd2\xaf\x98\xe6\xb4\x0e\xae\x9c\xe6\xd6\x85\x1c\xc2\x76\x0b\x81\xb0\x94\xa6\xfd\x01\xae\xf4\xbc\xfb\xa8\xf2\x64\x9e\xe5\x2d\x8b\xd1\x7f\x2f\x36\xeb\xb6\xf4\x94\xa3\xe6\x9b\x37\x33\x9a\xa6\xe6\xd6\xee\x52\x7f\x51\x3e\x9e\x32\xee\xef\x3e\x7c\x7c\xdf\x2b\x0d\x24\x19\x9f\x5b\x4f\x3f\x52\xfa\x89\x27\xf1\x0e\x8f\x31\x62\x31\x0d\x98\xb9\x9e\x29\x38\xfb\xed\xc4\x03\x86\x34\xaa\x27\xf8\x14\x08\x3c\x20\xb2\x27\x19\x28\x60\x94\x40\x8a\x7c\x22\x3c\xf6\xad\x3f\x11\xee\xdd\xdd\x4a\xd1\xa5\x51\x82\x65\x59\xf8\xf3\xd3\x6e\x7f\x78\xd9\xa3\xe3\x48\xb8\x6a\x9a\x52\x46\x60\x25\x9f\x93\x5e\x90\x3a\xe4\x7f\xca\xb2\x12\x39\x7b\xdb\x94\x62\xed\x34\x21\x50\xc7\x42\xb8\x09\xdc\x46\xf2\xb9\x39\x2a\xbd\x46\x96\xc6\x47\x26\xc9\x4d\xea\x49\x6e\x50\x8a\x35\x63\x0d\x52\xc5\xf6\x01\xa3\x46\xf7\xa5\xd5\x81\x36\xa1\xcd\xed\xcb\xf2\x87\x43\xfb\x4a\x95\x35\xdc\x2d\xa6\x77\x0f\x10\x8e\x98\xac\x31\x4a\x79\x54\x99\x9f\xcb\xbd\x35\xc5\x1a\xff\x27\xea\xda\xe6\x0e\xf4\x6b\xb7\x14\x6e\x56\x65\x97\xa4\xe3\xe3\x9c\xb0\x97\xd0\x27\x96\xbc\xc5\xca\x56\x65\xa6\xe6\xfb\xd7\xe7\x2d\xc6\xda\x1a\x53\x6a\x6b\xca\x9b\x47\x04\x3d\xff\xb7\xe0\x93\xf2\x99\x74\xe3\x2b\xbb\x1e\xfc\x9d\xd5\xf6\x3d\x49\xd8\xa4\x3e\x73\x92\xa1\xc6\xd5\x1b\xf4\x5c\x55\xce\xb9\xaa\x9e\xbb\xec\x34\x81\x24\xa0\x14\xfb\x7b\x00\xe0\x35\x12\x3f\x1e\x02\x00\x00")
 
 func templateDialectGremlinOpenTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -532,7 +547,27 @@ func templateDialectGremlinOpenTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectGremlinPredicateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x56\xdf\x6b\xe3\x38\x10\x7e\xb6\xff\x8a\x21\x14\xce\x0e\xa9\xd2\xdb\xb7\x3b\xe8\x43\xb7\x97\xe5\x02\x4b\xc3\xd1\xa5\xf7\x50\x4a\x50\xa5\x71\x22\xaa\x4a\x46\x92\x53\x16\xe3\xff\xfd\x90\xe4\x38\x76\x92\xbd\xf4\x72\x3f\x96\x7b\x8a\xf1\x8c\xe6\xfb\xe6\x9b\xcf\xd1\xd4\xf5\x74\x9c\xde\xea\xf2\xab\x11\xab\xb5\x83\x0f\x57\x3f\xfe\x74\x59\x1a\xb4\xa8\x1c\x7c\xa2\x0c\x9f\xb5\x7e\x81\xb9\x62\x04\x6e\xa4\x84\x90\x64\xc1\xc7\xcd\x06\x39\x49\xbf\xac\x85\x05\xab\x2b\xc3\x10\x98\xe6\x08\xc2\x82\x14\x0c\x95\x45\x0e\x95\xe2\x68\xc0\xad\x11\x6e\x4a\xca\xd6\x08\x1f\xc8\xd5\x36\x0a\x85\xae\x14\x4f\x85\x0a\xf1\xcf\xf3\xdb\xd9\xdd\xfd\x0c\x0a\x21\x11\xda\x77\x46\x6b\x07\x5c\x18\x64\x4e\x9b\xaf\xa0\x0b\x70\x3d\x30\x67\x10\x49\x3a\x9e\x36\x4d\x9a\xd6\x35\x70\x2c\x84\x42\x18\x71\x41\x25\x32\x37\x5d\x19\x7c\x95\x42\x4d\x4b\x83\x5c\x30\xea\x70\x2a\xf8\x08\x2e\x9b\x26\x4d\x8a\x4a\xb1\xcc\xc1\x98\x5b\x49\xbe\x18\xba\x41\x63\xa9\xcc\xa1\x4e\x93\xc4\x91\x5f\xa9\x9d\xff\x92\x09\x9e\xa7\x49\x93\xd6\xf5\x25\xa0\xe2\xf0\x17\x30\xa6\xba\xb4\x2d\x8e\x3f\x7d\xa1\x4b\xf8\xf9\x1a\x2e\xc8\x3d\xd3\x25\x92\x45\xd9\x0b\x51\xb3\xea\xc7\x6e\xcc\xaa\x17\xb4\x4e\x1b\xba\xc2\x7e\xc2\x7d\xfb\xea\x54\x13\xfe\xbc\x28\x3c\x34\x79\xa0\x46\x50\x2e\x98\xef\x20\x49\x92\x8d\x2f\xf7\x4a\x5f\x30\x7b\x7c\x12\xca\xa1\x29\x28\xc3\xba\x99\x80\x44\x95\xd5\x75\xa4\xd4\x34\x79\xee\x93\x0b\x6d\x40\xf8\x03\x86\xaa\x15\xc2\x26\xd4\x4e\x92\xcd\xa3\x78\x82\x6b\xd8\x65\x3f\x8a\x27\x1f\x68\x5a\xe4\x56\xaf\x9d\x96\x25\xa9\x6b\x60\x54\xca\xae\x29\xb2\x28\x6f\xbd\x55\xbc\x38\x4d\xe3\x81\x0f\xe9\x6e\x08\xf1\xe7\x50\x5a\x84\xa6\xd9\xa1\xf9\x77\x01\x21\x3f\x6f\x42\x85\x40\xc9\xfb\x03\x2a\xfa\x12\x7f\xf2\xd1\x77\x8c\xe8\x94\x85\xb2\xcf\xf4\x19\xe5\x24\xa8\x54\x90\x5b\xad\xac\xa3\xca\x41\xd3\x4c\xa0\x24\xb3\xdf\xf6\xb4\x3e\xb7\x8d\x7d\xaf\xfd\x69\x2b\xdf\xcf\x88\x4a\xbb\x30\xdd\x3b\x21\x7b\x5e\x3c\xad\xd3\x69\xdb\xec\x3c\x71\x68\xa0\xd6\x3f\x9d\x57\xa2\x39\xa3\x9d\x3a\xfc\x00\x1f\x49\xe4\xef\xc1\x1b\x70\xcc\xf7\x1c\x7f\xc6\x18\x91\xaf\x70\xba\xa6\x83\x29\x0e\xa4\x9e\xf1\xad\xce\x21\x26\x3d\xd3\x10\x47\x12\x58\x77\x74\x76\x39\xf1\x1f\x53\x68\xe5\xf3\x46\x8b\xca\xf5\x8a\x7b\x99\x90\xcc\xed\x5c\xf9\x39\xb5\x95\xf7\x8f\x5d\xc3\x68\xae\x46\x5d\x6c\x3a\x06\xba\xd1\x82\x03\x13\x86\x55\x92\x1a\xe0\x58\xa2\xe2\xc8\x04\x5a\x08\x7f\xc0\x49\x9f\x5d\x20\xd7\x02\x7c\x83\xa3\xd7\xe8\x3d\xe6\x99\x8e\x3d\x63\xe1\x7e\xb0\x40\x15\x78\xb1\xe0\x4d\xb8\x35\x58\x94\xc5\xa5\xc1\x02\x0d\x2a\x86\x13\x70\xf4\x05\xc3\x95\xe1\xde\x34\x6c\xd0\x38\xc1\x86\xd4\x62\xdf\x1f\x05\x17\xdd\xf4\x3f\x6a\xb7\x0e\x33\x8d\xac\x7b\xe3\xec\x7b\xc4\x27\xec\x94\x69\x9a\xd9\xf0\xc8\x41\xfc\x21\xfb\x07\x5d\xe1\x7b\xfd\xaf\x9c\x71\x21\xe2\xc4\x96\xc3\xa4\xce\x08\xe7\xba\xe7\x68\xe5\x01\xfa\xf7\xb5\xd8\xc0\x19\x81\x89\x37\x97\xc1\x02\x5e\x91\x2a\x0b\xc2\x81\x5d\xeb\x4a\x72\x78\xf6\x3b\x47\x15\xb6\x13\xad\x30\xae\x23\x08\x5d\x53\x1d\xcf\x44\xa8\x09\xe8\xca\x79\xfd\x96\x4b\x32\x57\x0f\x59\x3e\xf1\x4f\x8b\xca\x45\x7b\x84\xab\x75\x39\x81\x72\x77\xbb\xfa\xe1\xdb\xf6\x86\x2d\x33\xa1\xf2\xf6\x49\x57\x2e\xdf\xde\xae\x89\x23\xbf\xaf\xd1\x60\x16\x62\xbe\xa0\x89\x8f\x49\x2c\xbe\xef\xcd\x98\x2c\x54\x3e\xe9\xb2\xe6\xea\x78\x92\x87\x89\x59\xf1\xe7\xd8\xb7\x60\xda\x86\xfc\xf9\xc3\x91\x6e\xad\x7f\xb2\x37\x67\xfa\x0d\x9d\xfa\xbe\x22\x3d\x67\xfe\xa5\x2f\x8d\xaa\xe1\x52\x68\x8e\x5f\xe9\xc6\x7e\x6b\x71\xba\x8a\xbb\x53\x57\xd1\x86\xab\xe6\xb8\x06\x31\x21\x0a\xb1\x75\xc7\x1d\xbe\x45\xd9\xca\x2c\x0e\xda\x63\x5d\x03\x2d\xbd\xfd\x33\x67\xec\x04\xc2\xfb\xb0\x50\x99\x56\x8e\xe5\x92\xdc\xc4\x28\x21\xe4\xcc\x05\x42\x9b\xff\x67\xe3\x0b\xf3\xf7\xfa\x56\xda\xbd\xa7\xf1\x3d\x96\x2d\xc9\x3e\x91\x3b\xed\x32\x77\x40\xe2\x8f\x00\x00\x00\xff\xff\x2a\x4f\xad\x9d\x4e\x0d\x00\x00")
+var _templateDialectGremlinPaginationTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\x41\x6b\x1b\x3f\x10\xc5\xcf\xab\x4f\x31\xff\xf0\x3f\xec\x06\x47\x4e\x73\x6b\xc1\x87\x34\x4e\x5a\x43\x68\x5a\xea\xbb\x91\xa5\xd9\xf5\x60\x59\x5a\x46\x5a\x83\x59\xf6\xbb\x17\xc9\x5e\xec\xa6\x81\x36\x97\x65\x99\x99\xf7\x9e\x34\x3f\xf5\xfd\xf4\x5a\x3c\xf8\xf6\xc0\xd4\x6c\x22\xdc\xdd\x7e\xf8\x78\xd3\x32\x06\x74\x11\x9e\x94\xc6\xb5\xf7\x5b\x58\x38\x2d\xe1\xde\x5a\xc8\x43\x01\x52\x9f\xf7\x68\xa4\x58\x6e\x28\x40\xf0\x1d\x6b\x04\xed\x0d\x02\x05\xb0\xa4\xd1\x05\x34\xd0\x39\x83\x0c\x71\x83\x70\xdf\x2a\xbd\x41\xb8\x93\xb7\x63\x17\x6a\xdf\x39\x23\xc8\xe5\xfe\xf3\xe2\xe1\xf1\xdb\xcf\x47\xa8\xc9\x22\x9c\x6a\xec\x7d\x04\x43\x8c\x3a\x7a\x3e\x80\xaf\x21\x5e\x84\x45\x46\x94\xe2\x7a\x3a\x0c\x42\xa4\x3b\x40\xab\x1a\x72\x2a\x92\x77\xd3\x96\xd1\x90\x56\x11\xc1\x60\x4d\x0e\x43\xf6\xdb\xe2\x21\x60\x84\x73\x33\x3b\x22\xe8\x8e\x83\x67\x28\xd3\x0c\x99\x09\xec\x95\xed\x10\x94\x33\xa7\x70\xf2\x0e\xf6\x8a\x49\xad\x2d\x86\x4a\x42\xce\xec\xfb\x93\x37\x5c\x19\x52\x16\x75\x9c\x36\x8c\x3b\x4b\x6e\xfa\xd6\x41\xae\x60\x18\x44\xa1\x77\x2d\x7c\x9a\x41\x2b\xbf\x2c\x45\x41\xf5\x85\xff\x6c\x06\x2f\x6c\x90\xe7\x63\x65\x8e\x41\x43\x2f\x8a\xac\x49\x92\xe7\xa5\x28\x06\x51\x30\xc6\x8e\x1d\xd4\x9d\xd3\x65\x84\x6b\x13\xac\x5c\xb2\xda\x23\x07\x65\xab\x2c\xa0\x1a\xbc\x7c\x22\xb4\x06\x66\x33\x70\x64\x73\xb5\x88\xf2\xab\x0a\x8b\x79\xa9\x77\x6d\x49\xa6\xaa\x52\xed\x68\x26\x8a\x64\x5c\x44\xf9\xc2\x65\xaa\xae\x56\x69\xb4\xec\x7b\xf8\x5f\x7e\x57\x7a\xab\x1a\x84\x61\x90\xcf\x6a\x8d\x76\x32\x7a\xcb\x3a\x7d\x27\x90\xec\xf2\xc2\xaa\x6a\xf2\x6e\x71\x2b\x1f\x7f\x8c\xea\x57\xc7\x4b\x66\x95\x28\x86\x49\xba\x81\xe8\xfb\x1b\x40\x67\xe0\x2d\xd8\x3e\xed\xed\x37\xd0\xb9\x42\xae\x19\x01\xa3\x8b\x14\x09\x03\x94\xeb\x43\x1e\xc8\xf1\x99\x70\xdc\xe0\xf1\xb1\x91\xa9\xc6\x77\xd7\xd0\x1e\xdd\x99\xcd\xbb\x80\xe7\xe8\x23\xec\xfc\x9b\x70\x27\x46\x0b\xa7\xf9\x9f\x91\x1f\x95\x47\xe1\x1c\x35\xff\x09\x9e\xff\x46\xfe\xbf\x4b\xf2\x2c\x3f\x1f\xca\x57\xab\xcf\x19\xd5\x88\x3e\x4f\xac\x56\x72\x31\x2f\xab\x73\x6f\xb8\xdc\xfb\xaf\x01\x00\x15\x12\xbf\xce\x28\x04\x00\x00")
+
+func templateDialectGremlinPaginationTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateDialectGremlinPaginationTmpl,
+		"template/dialect/gremlin/pagination.tmpl",
+	)
+}
+
+func templateDialectGremlinPaginationTmpl() (*asset, error) {
+	bytes, err := templateDialectGremlinPaginationTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/dialect/gremlin/pagination.tmpl", size: 1064, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateDialectGremlinPredicateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x56\xdf\x6b\xe3\x38\x10\x7e\xb6\xff\x8a\xa1\x14\xce\x2e\xa9\xd2\xdb\xb7\x3b\xe8\x43\xb7\xd7\xe5\x02\x4b\xcb\xd1\xa5\xf7\x50\x4a\x50\xad\x71\x22\xea\x4a\x66\x34\x4e\x59\x8c\xfe\xf7\x43\xb2\x93\x38\x69\x7a\xc9\x75\x6f\x59\xf6\xcd\x68\x7e\x7d\xf3\xcd\x67\x69\xda\x76\x7c\x92\x5e\xda\xfa\x2b\xe9\xd9\x9c\xe1\xc3\xd9\xaf\xbf\x9d\xd6\x84\x0e\x0d\xc3\x27\x59\xe0\xa3\xb5\x4f\x30\x31\x85\x80\x8b\xaa\x82\xe8\xe4\x20\xd8\x69\x81\x4a\xa4\x5f\xe6\xda\x81\xb3\x0d\x15\x08\x85\x55\x08\xda\x41\xa5\x0b\x34\x0e\x15\x34\x46\x21\x01\xcf\x11\x2e\x6a\x59\xcc\x11\x3e\x88\xb3\xa5\x15\x4a\xdb\x18\x95\x6a\x13\xed\x9f\x27\x97\x57\xd7\xb7\x57\x50\xea\x0a\xa1\x3f\x23\x6b\x19\x94\x26\x2c\xd8\xd2\x57\xb0\x25\xf0\xa0\x18\x13\xa2\x48\x4f\xc6\xde\xa7\x69\xdb\x82\xc2\x52\x1b\x84\x23\xa5\x65\x85\x05\x8f\x67\x84\xcf\x95\x36\xe3\x9a\x50\xe9\x42\x32\x8e\xb5\x3a\x82\x53\xef\xd3\xa4\x6c\x4c\x91\x31\x9c\x28\x57\x89\x2f\x24\x17\x48\x4e\x56\x39\xb4\x69\x92\xb0\xf8\x53\xba\xc9\x1f\x99\x56\x79\x9a\xf8\xb4\x6d\x4f\x01\x8d\x82\xff\x50\x63\x6c\x6b\xd7\xd7\x09\xd1\xc7\xb6\x86\xdf\xcf\xe1\x58\xdc\x16\xb6\x46\x71\x53\x0f\x4c\x92\x66\x43\xdb\x05\xcd\x06\x46\xc7\x96\xe4\x0c\x87\x0e\xb7\xfd\xd1\xbe\x26\x42\xbc\x2e\xe1\xd8\xd6\xe2\x4e\x92\x96\x4a\x17\xa1\x83\x24\x49\x16\x21\xdd\xb3\x7c\xc2\xec\xfe\x41\x1b\x46\x2a\x65\x81\xad\x1f\x41\x85\x26\x6b\xdb\x0e\x92\xf7\x79\x9e\x26\x49\x52\x5a\x02\x1d\x02\x48\x9a\x19\xc2\x22\x12\x94\x24\x8b\x7b\xfd\x00\xe7\xb0\xf6\xbe\xd7\x0f\xc1\xe0\xfb\xca\x3d\x5f\x6b\x2e\x6b\xd1\xb6\x50\xc8\xaa\x5a\x35\x25\x6e\xea\xcb\x20\x95\x40\x8e\xf7\xa1\xf0\x6b\xb8\x0b\x21\x42\x1c\x56\x0e\xc1\xfb\x75\xb5\x70\x16\x2b\xe4\xef\x9b\x50\xa9\xb1\x5a\x0a\x21\x04\x1f\x97\x43\x8a\x3f\x05\xeb\x01\x23\xda\x27\xa1\xec\xb3\x7c\xc4\x6a\x14\x59\x2a\xc5\xa5\x35\x8e\xa5\x61\xf0\x7e\x04\xb5\xb8\xfa\x6b\x8b\xeb\xf7\xb6\xb1\xad\xb5\x7f\x6d\xe5\xc7\x09\xd1\x58\x8e\xd3\xbd\xd6\xd5\x40\x8b\xfb\x79\xda\x2f\x9b\xb5\x26\x5e\x0b\xa8\xd7\xcf\x4a\x2b\x11\x4d\x2f\xa7\x55\xfd\x58\xbe\x1b\x56\x7e\x48\xbd\x0d\x8c\xf9\x96\xe2\xdf\x31\x46\x54\x33\x1c\xcf\xe5\xc6\x14\x37\xa8\xbe\x52\x4b\x9e\xa3\xad\x0a\x48\xa3\x1d\x45\x44\xbd\x82\xb3\xf6\xe9\x6e\x4c\x6d\x4d\xf0\x3b\xba\x69\x78\x90\x3c\xd0\x84\x62\xe2\x26\x26\x5c\x18\x7d\xe6\xed\xb0\x73\x38\x9a\x98\x3e\x28\x09\xcf\x03\xc8\x85\xd5\x0a\x0a\x4d\x45\x53\x49\x02\x85\x35\x1a\x85\x85\x46\x07\xf1\x02\x4e\x86\xe8\x22\xb8\xbe\xc0\x1b\x18\x03\x47\x87\x88\x67\x7c\x12\xf4\xa3\xf9\x17\x07\xd2\x40\x20\x0b\x5e\x34\xcf\xc1\x61\x55\x9e\x12\x96\x48\x68\x0a\x1c\x01\xcb\x27\x8c\x4f\x06\xbf\x58\x58\x20\xb1\x2e\x36\xa1\x75\x7d\x7f\xd4\x4a\xaf\xa6\xff\xd1\xf2\x3c\x6a\xa8\xe3\xd4\xfb\x9d\x1a\x69\xdb\x21\x33\xde\x5f\x6d\x86\xbc\xb2\xdf\x65\xff\xa3\x2a\x42\xaf\x83\xe1\x7d\x57\x65\x1c\xeb\x6e\x62\xd3\x4d\xa7\x89\xf9\x46\xf5\xec\xcc\xbc\x51\xfd\xc7\x4a\x6c\x43\x19\x11\x49\x10\x17\x61\x09\xcf\x28\x8d\x03\xcd\xe0\xe6\xb6\xa9\x14\x3c\x22\x30\x35\x71\x3b\xb1\x06\xbb\x75\x04\xfb\xfd\x44\x5b\xb3\xc2\x99\x68\x33\x02\xdb\x70\x18\xd6\x74\x2a\x26\xe6\x2e\xcb\x47\x30\x9d\x8a\x9b\x86\x3b\x79\xc4\xa7\x75\x3a\x82\x7a\xfd\xba\x86\x35\xc5\xf5\x2f\x6c\x9d\x69\x93\xf7\x5f\xb6\xe1\x7c\xf9\xba\x26\x2c\xfe\x9e\x23\x61\x16\xbe\x93\x90\x90\xba\xcf\xf8\xdd\xf0\xb6\x36\x3b\x67\x6d\xf2\xd1\xca\x6b\x62\x76\x3b\x85\x32\x9d\x57\xe7\xbc\xeb\x5f\xa0\xbe\xa1\xb6\xdd\x35\x52\xef\x0f\xec\x8d\x69\xd8\xd0\xbe\xff\xab\x83\xc7\xf4\x9d\xfe\x34\x69\x36\x97\x42\xda\xa9\x13\x26\xf7\xd6\xe2\x74\xd6\xed\x4e\xab\x8c\x2e\xac\x25\x6f\x70\xd0\x39\xc4\x8c\xc9\x52\x1d\xd7\xf8\xd2\x49\xa2\xce\xba\x41\x87\x5a\xe7\x20\xeb\x70\xc3\x66\x4c\x6e\x04\xf1\x3c\x8c\x80\xa9\xa7\x63\x3a\x15\x17\x9d\x55\x08\xf1\xce\x05\xc2\xd2\xcf\xd9\xf8\x0d\x7d\x5b\xdf\xc6\xf2\x21\x8d\x6f\xa1\xec\x41\x0e\x81\x5c\x5b\xce\xf8\x15\x88\x7f\x06\x00\x2a\x4f\xad\x9d\x4e\x0d\x00\x00")
 
 func templateDialectGremlinPredicateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -552,7 +587,7 @@ func templateDialectGremlinPredicateTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectGremlinQueryTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\xd1\x4f\x23\xb7\x13\x7e\xde\xfd\x2b\xe6\x77\x42\x68\x37\xbf\xe0\x50\xfa\xd4\x3b\x51\x09\x72\x39\x35\x12\x07\x2d\x87\x78\x41\xa8\x32\xeb\xd9\xc4\xc2\xb1\xb7\xb6\x37\x17\x14\xed\xff\x5e\x8d\xbd\x21\x0b\x24\x90\xab\x44\xd5\x27\xb2\xf6\xcc\xf7\x8d\x3f\x7f\x1e\x66\xb9\x1c\xf4\xd2\xa1\xa9\x1e\xac\x9c\x4c\x3d\x1c\x1d\xfe\xf4\xcb\x41\x65\xd1\xa1\xf6\xf0\x85\x17\x78\x67\xcc\x3d\x8c\x75\xc1\xe0\x44\x29\x08\x41\x0e\x68\xdf\xce\x51\xb0\xf4\x6a\x2a\x1d\x38\x53\xdb\x02\xa1\x30\x02\x41\x3a\x50\xb2\x40\xed\x50\x40\xad\x05\x5a\xf0\x53\x84\x93\x8a\x17\x53\x84\x23\x76\xb8\xda\x85\xd2\xd4\x5a\xa4\x52\x87\xfd\xb3\xf1\x70\x74\xfe\x6d\x04\xa5\x54\x08\xed\x9a\x35\xc6\x83\x90\x16\x0b\x6f\xec\x03\x98\x12\x7c\x87\xcc\x5b\x44\x96\xf6\x06\x4d\x93\xa6\xcb\x25\x08\x2c\xa5\x46\xf8\x20\x24\x57\x58\xf8\xc1\xc4\xe2\x4c\x49\x3d\xf8\xab\x46\xfb\xf0\x01\x9a\x86\x82\xf6\xee\x6a\xa9\xa8\xa4\x8f\xc7\x50\x71\x57\x70\x05\x7b\xec\x5b\x61\x2a\x64\xa7\xed\x4e\x1b\x68\xb1\x40\x39\x8f\x91\x8f\xbf\x1f\xd3\x89\xb3\xac\x75\x01\xd9\x93\xd8\xa6\x81\x5e\x97\xa5\x69\x72\x68\xeb\x38\x51\x2a\x2b\xfc\x02\x0a\xa3\x3d\x2e\x3c\x1b\xc6\xbf\x39\x64\x37\xb7\x21\x87\x9d\xf3\x19\x42\xd3\xf4\x01\xad\x35\x36\x87\x65\x9a\x58\x74\xc4\xbf\xdf\x62\xb0\x4b\x74\x95\xd1\x0e\x97\x4d\x9a\x78\xcb\xe7\x68\x1d\x57\x14\xf1\xac\x0a\xd6\x26\xfc\x41\x67\x27\xda\x3c\x4d\x64\x09\x0a\xf5\xf3\x7a\x59\x29\x51\x09\x97\xc3\xaf\x70\x48\x8c\x49\xfc\x26\xcc\x19\xbf\xc7\xec\xe6\x56\x6a\x8f\xb6\xe4\x05\x2e\x9b\xfe\x6b\x08\x39\x25\x1b\x0b\xb2\x0f\x65\x10\x8d\xeb\x09\xbe\x28\xac\x85\x27\xa6\x96\xea\x46\xde\xc2\x31\x94\x69\x92\x34\x69\xb2\x3e\x15\xbb\xe6\xaa\xc6\xaf\xbc\xca\x62\x18\x63\x2c\x4f\x93\x06\x50\x39\x0c\xe9\x1b\x22\xbd\xad\x91\x82\xd2\x24\x5c\x7a\x1f\xee\xa4\x16\x52\x4f\xc2\x71\xd6\xf1\x51\x95\x28\x09\x5a\xbb\x49\x3f\x61\xe9\x17\x1b\x2d\xb0\x20\xf9\xfa\xf0\x0c\xb0\x4f\x0f\x20\xff\x14\xd2\xff\x77\x0c\x5a\xaa\x50\x93\x45\x5f\x5b\x4d\x9f\xe1\x16\x43\x29\x73\x6e\x09\xbe\x52\xb5\x0d\x6e\xbb\xec\x98\xa5\xbb\x1e\xae\x9f\x8c\xf5\xb4\xac\x4d\x79\xec\x8b\x35\xb3\x95\x17\xb2\x9d\x2b\xd9\x86\x56\x18\x5d\xca\xc9\x8b\x7b\x8d\xcb\x79\xba\xc2\xda\x92\xde\x27\x92\xf4\x87\xdf\xc3\xd0\xd4\xda\x6f\x79\x11\x52\xfb\x5d\x5f\xc1\x86\x8b\x7e\xf3\x2d\xb0\xc8\x9d\xbf\xbf\x11\x0e\xd7\xe2\xb7\x2b\x16\x1d\xbb\x44\x2e\xc6\x54\xc0\x8f\xab\x36\x5a\x48\xb7\x4d\xb5\x3b\x63\xd4\xbb\xca\xf6\x1b\x77\xe7\xb8\xf8\x57\x84\x2b\xb9\x72\xb8\x55\xbc\x53\x63\xd4\x3f\x51\xaf\x3d\xcb\x73\xe5\x7a\xc2\x29\x76\xf5\xd8\x4e\x97\x69\x32\xa7\x13\x4d\xd8\x75\x16\x0e\x7d\xc6\xef\x50\x05\x12\xf6\x3b\x2f\xee\xf9\x84\xda\x34\x0b\xab\x51\x82\x2d\xd2\x75\xcf\x35\x87\xad\x0a\xb3\xa1\x32\x1a\xb3\xd8\xb8\xa8\x83\xfe\xd9\x87\x6a\x7b\x07\xad\x2c\x0a\x59\x70\x8f\xb1\x8b\x56\xd9\x3c\x66\x6e\xe9\xef\xc6\x0a\xb4\xeb\xf6\x3e\x67\x17\xb4\x90\xad\xba\xf5\xeb\x5c\x21\x39\x36\xeb\xc8\x43\x44\x4d\x9a\xb8\xef\xd2\x17\x53\x50\x72\x26\x7d\x1f\x4c\x59\x3a\xf4\x9b\x4c\xd0\x06\xbc\x80\x0d\x09\x9f\x08\xb8\xe0\x0e\x23\xce\x4a\xad\xfd\xfd\x15\x60\x5c\xf8\x18\xaa\xbe\xa4\xfa\xb2\x5e\xdc\xe9\x43\xfb\x03\xfe\x0f\xbd\x90\x9c\xb7\x48\x6f\x67\xce\xb8\x9f\xb2\xaf\x7c\x31\xd6\xfe\xe7\xa3\x7c\x43\x01\x31\xeb\x8c\x56\xb2\x47\x70\xea\xe3\xec\x33\x8a\xba\xca\xd6\x0d\x71\x9e\x86\x51\x01\xb5\x80\x38\x83\x0c\x7a\xd1\xe5\x83\x8a\xfb\x69\x3b\x90\xb8\x30\xc9\x84\x65\x98\xa0\x46\xcb\xbd\x34\x1a\x48\xfb\x10\x65\x4a\xe0\x30\x91\x73\xd4\x80\x62\x82\x0c\xc2\x40\xf3\xd6\x3c\x13\x18\xc2\x50\x93\x2c\x97\x07\xb0\x87\xa4\xfe\x6a\x92\x19\x89\xe0\x50\x08\x05\x11\x3b\x01\xc3\x77\x04\x8d\x28\xc0\x9b\x50\xc7\xc4\x72\x8f\x61\x37\x94\xe1\x4d\xcb\x1c\xf1\xba\xd3\xcf\x0a\xb6\xd3\xf3\xdb\x28\x29\x68\x46\xec\x84\x8c\xc3\x02\xed\xaf\x9e\xc0\x6e\xb3\x09\xa1\xc9\x12\xf6\x90\x9d\x4a\x21\x03\x00\xfd\xb7\x6a\x09\x9a\x06\x8e\x57\x4f\x98\x9d\x1a\x3f\x7d\xf1\x16\xe9\x1b\xe3\x8b\x1c\x1a\xed\x3c\x0f\x59\x2d\x70\x98\x18\x22\xfa\xd8\x8d\x35\xbd\x72\x7c\x95\x62\xac\x47\x59\x44\xbc\x7a\xa8\x70\x07\x1e\x76\x51\xfb\xeb\xac\x4b\xf7\x1a\xfc\x45\xed\x47\xbb\x9e\x80\x8d\xf5\x1a\x38\xfa\xac\xe3\xb8\xae\xe5\x4a\x6b\x66\x6f\x5b\x8e\x47\x97\xb5\x9b\x21\x67\xe5\x3e\x6d\xc4\xce\xee\xa3\xc4\x8e\xfb\xc2\x35\xef\x3d\xb1\x1c\xa1\x91\xe5\x9c\xe7\xd6\x77\xea\xa1\xcc\x27\x4e\xfb\x4f\x39\xf7\x80\x54\xdd\xc9\x8d\xec\xfa\x45\xa7\x1d\x7f\xce\xd7\xee\xd4\xef\x60\xcf\x2d\x9c\xef\x65\xd7\x2d\x74\x8f\xf6\xdd\xe5\x88\xaf\xfa\xf7\xef\x00\x00\x00\xff\xff\x8c\x59\x1a\xcb\x79\x0e\x00\x00")
+var _templateDialectGremlinQueryTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\xdd\x6e\xe3\x36\x13\xbd\x96\x9e\x62\x76\x61\x2c\x24\x7f\x5e\x26\xdf\xf6\xaa\xbb\x48\x81\x8d\xe3\xa0\x02\xb2\x49\x9b\x04\xb9\x09\x82\x82\x11\x47\x36\x11\x9a\x54\x49\xca\x71\x60\xe8\xdd\x8b\xa1\xe4\x9f\xf8\x27\x71\x5a\xa4\xe8\x95\x2d\x72\xe6\x9c\xe1\xcc\xe1\x70\x66\xb3\x83\x6e\xdc\x37\xe5\x93\x95\xc3\x91\x87\x2f\x87\xff\xff\xf9\x73\x69\xd1\xa1\xf6\x70\xca\x73\xbc\x37\xe6\x01\x32\x9d\x33\xf8\xae\x14\x04\x23\x07\xb4\x6f\x27\x28\x58\x7c\x3d\x92\x0e\x9c\xa9\x6c\x8e\x90\x1b\x81\x20\x1d\x28\x99\xa3\x76\x28\xa0\xd2\x02\x2d\xf8\x11\xc2\xf7\x92\xe7\x23\x84\x2f\xec\x70\xbe\x0b\x85\xa9\xb4\x88\xa5\x0e\xfb\x67\x59\x7f\x70\x7e\x35\x80\x42\x2a\x84\x76\xcd\x1a\xe3\x41\x48\x8b\xb9\x37\xf6\x09\x4c\x01\x7e\x85\xcc\x5b\x44\x16\x77\x0f\xea\x3a\x8e\x67\x33\x10\x58\x48\x8d\xf0\x51\x48\xae\x30\xf7\x07\x43\x8b\x63\x25\xf5\xc1\x9f\x15\xda\xa7\x8f\x50\xd7\x64\xd4\xb9\xaf\xa4\xa2\x90\xbe\x1e\x41\xc9\x5d\xce\x15\x74\xd8\x55\x6e\x4a\x64\xc7\xed\x4e\x6b\x68\x31\x47\x39\x69\x2c\x17\xff\x17\xee\xc4\x59\x54\x3a\x87\xe4\x99\x6d\x5d\x43\x77\x95\xa5\xae\x53\x68\xe3\xf8\xae\x54\x92\xfb\x29\xe4\x46\x7b\x9c\x7a\xd6\x6f\x7e\x53\x48\x6e\xef\x82\x0f\x3b\xe7\x63\x84\xba\xee\x01\x5a\x6b\x6c\x0a\xb3\x38\xb2\xe8\x88\xff\x53\x8b\xc1\x2e\xd1\x95\x46\x3b\x9c\xd5\x71\xe4\x2d\x9f\xa0\x75\x5c\x91\xc5\x5a\x14\xac\x75\xf8\x9d\xce\x4e\xb4\x69\x1c\xc9\x02\x14\xea\xf5\x78\x59\x21\x51\x09\x97\xc2\x2f\x70\x48\x8c\x51\xf3\x4d\x98\x63\xfe\x80\xc9\xed\x9d\xd4\x1e\x6d\xc1\x73\x9c\xd5\xbd\x97\x10\x52\x72\x36\x16\x64\x0f\x0a\x72\xb7\x5c\x0f\x71\x23\xb0\x16\x9e\x98\x5a\xaa\x5b\x79\x07\x47\x50\xc4\x51\x54\xc7\xd1\xf2\x54\xec\x86\xab\x0a\x7f\xf0\x32\x69\xcc\x18\x63\x69\x1c\xd5\x80\xca\x21\xcc\xb6\x5b\x7a\x5b\x21\x19\xc5\x51\x28\x7a\x0f\xee\xa5\x16\x52\x0f\x43\x12\x97\xf6\x4d\x56\x9a\x94\xa0\xb5\xdb\xf2\x27\x2c\x65\x92\x0d\xa6\x98\x53\xfa\x7a\xb0\x06\xd8\xa3\x0b\x90\x7e\xa3\x52\xc1\x87\x23\xd0\x52\x85\x98\x2c\xfa\xca\x6a\xfa\x0c\x55\x0c\xa1\x4c\xb8\x25\xf8\x52\x55\x36\xa8\xed\x72\x49\xf3\x6c\x3d\x94\x9f\x64\xfa\x3c\xac\x6d\x7e\xec\xd4\x9a\xf1\x5c\x0b\xc9\xde\x91\xec\x42\xcb\x8d\x2e\xe4\x70\xa3\xae\xcd\x72\x1a\xcf\xb1\x76\xb8\xf7\x88\x33\x7e\xf3\x7d\xe8\x9b\x4a\xfb\x1d\x37\x42\x6a\xbf\xef\x2d\xd8\x52\xe8\xf5\x73\x6c\xdc\x05\xd6\x70\xa7\xef\x2f\x84\xc3\x65\xf2\xdb\x15\x8b\x8e\x5d\x22\x17\x99\xf6\x49\xfa\xf6\xac\x0d\xa6\xd2\xed\xca\xda\xbd\x31\xea\x5d\xd3\xf6\x2b\x77\xe7\x38\xfd\x57\x12\x57\x70\xe5\x70\x67\xf2\x8e\x8d\x51\x7f\x27\x7b\x8b\xb3\x6c\x66\xaf\x2b\x9c\x62\xd7\xf3\x16\x41\x35\x9c\x50\x52\x86\xec\x26\x09\x07\x3f\xe3\xf7\xa8\x82\xb8\xd9\x6f\x3c\x7f\xe0\x43\x6a\xd5\x2c\xac\x36\x69\xd8\x91\xbe\xd5\xb3\x4d\x60\x67\x96\x59\x5f\x19\x8d\x24\xc5\x3a\x0e\x5d\xf4\x8f\x1e\x94\xbb\xbb\x68\x69\x51\xc8\x9c\x7b\x74\x14\x69\x54\x26\x93\xb4\xbd\xe1\x9f\xe1\x51\xfa\x11\x74\x42\x0b\xee\xb0\x2b\x53\xf8\x13\x54\xe8\x29\xdc\x38\xa2\x40\x3f\x8c\xe5\x54\x6a\xd6\xac\x8a\x4c\xe7\xaa\x12\x28\xa8\x46\x41\x35\x51\xb4\x7e\x48\xfa\x2e\xd8\x95\xb7\x55\xee\x4f\xa9\x17\x43\x5d\x67\xee\x5c\xaa\x24\x0d\xbc\x73\x62\xd4\x62\xde\xbe\xb6\x3d\x13\xc6\x0a\xb4\xcb\x77\x66\xc2\x2e\x68\x21\x99\x3f\x1b\x2f\x1f\x38\x38\x37\xe1\x95\x0b\xd2\x3a\x8e\xdc\xa3\xf4\xf9\x08\x94\x1c\x4b\xdf\x03\x53\x14\x0e\xfd\x36\x35\xb6\x06\x1b\xb0\xc1\xe1\x1b\x01\xe7\xdc\x61\x83\x33\x2f\xd9\xa7\x4f\x73\xc0\x66\xe1\x6b\x88\xfa\x92\x9e\xb5\xa4\xdb\xec\xf4\xa0\xfd\x03\xff\x83\x6e\x70\x4e\x5b\xa4\xd7\x3d\xc7\xdc\x8f\xd8\x0f\x3e\xcd\xb4\xff\xe9\x4b\xba\x25\x80\x86\xef\x8c\x50\x93\x05\x38\x3d\x28\xec\x04\x45\x55\x26\xcb\xce\x3c\x89\xc3\xcc\xd2\x16\x20\xa6\x81\xae\xe9\x53\x07\x25\xf7\xa3\x76\x32\x72\x61\xa4\x0a\xcb\x30\x44\x8d\x96\x7b\x69\x34\x90\xd8\x82\x95\x29\x80\xc3\x50\x4e\x50\x03\x8a\x21\x32\x08\x93\xd5\x6b\x83\x55\x60\x08\xd3\x55\xd0\x40\x07\x5b\xdd\x85\x91\x6a\x20\x82\x82\x20\x04\x44\xec\x04\x0c\x8f\x08\x1a\x51\x80\x37\x21\x8e\xa1\xe5\x1e\x43\x6c\x04\x05\xde\xb4\xcc\x0d\xde\xa2\x5a\x2b\xb0\x2b\x8f\x4f\xa3\xbc\x8e\x14\x34\xac\xae\x98\x64\x61\x81\x50\xda\x68\xb7\x89\x62\xbd\x2b\xa4\x0d\x9a\x2c\xa0\x83\xec\x58\x0a\x19\x08\xc2\x7d\x90\x2d\x1e\x1c\xcd\x7b\x09\x3b\x36\x7e\xb4\xd1\x10\xe8\x1b\x9b\xb6\xd0\x37\xda\x79\x1e\xa2\x68\x81\xc3\xe8\xd2\xa0\x67\x2e\xd3\xd4\x6a\xf0\x45\x8a\x4c\x0f\x02\x03\xb2\xeb\xa7\x12\xf7\xe0\x61\x17\x95\xbf\x49\x56\xe9\x5e\x82\xbf\xa8\xfc\x60\xdf\x13\xb0\x4c\x2f\x81\x1b\x9d\xad\x28\x6e\x55\x72\x85\x35\xe3\xd7\x25\xc7\x1b\x95\xb5\x9b\xc1\x67\xae\x3e\x6d\xc4\xde\xea\x23\xc7\x15\xf5\x85\x32\x77\x9e\x49\x8e\xd0\x48\x72\xce\x73\xeb\x57\xe2\x21\xcf\x67\x4a\xfb\x4f\x29\xf7\x33\xdd\xe3\xbd\xd4\xc8\x6e\x36\x3a\x6d\x76\x92\x2e\xd5\xa9\xf7\x28\xee\x5b\xe5\xb9\x83\xf3\xbd\xe4\xba\x83\x6e\x21\x5f\xfd\x4f\xf5\xfb\xd7\x00\x01\x82\x22\x4b\x02\x0f\x00\x00")
 
 func templateDialectGremlinQueryTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -567,12 +602,12 @@ func templateDialectGremlinQueryTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/gremlin/query.tmpl", size: 3705, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/gremlin/query.tmpl", size: 3842, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectGremlinSelectTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4d\x4f\xdc\x30\x10\x3d\xc7\xbf\x62\x40\xa8\x4a\x56\xa9\x17\xb8\xb5\x55\x0e\x94\x0f\x69\xa5\xb6\x6a\x01\x71\x41\x1c\x8c\x3d\xd9\xb5\xf0\xda\xe9\xd8\x89\x40\x51\xfe\x7b\x65\x27\x4b\x53\x5a\x2a\x4e\xb1\xfd\xde\xbc\x79\x33\xf6\xa4\xef\x97\x0b\x76\xea\x9a\x27\xd2\xeb\x4d\x80\xe3\xc3\xa3\x0f\xef\x1b\x42\x8f\x36\xc0\x85\x90\x78\xef\xdc\x03\xac\xac\xe4\x70\x62\x0c\x24\x92\x87\x88\x53\x87\x8a\xb3\xeb\x8d\xf6\xe0\x5d\x4b\x12\x41\x3a\x85\xa0\x3d\x18\x2d\xd1\x7a\x54\xd0\x5a\x85\x04\x61\x83\x70\xd2\x08\xb9\x41\x38\xe6\x87\x3b\x14\x6a\xd7\x5a\xc5\xb4\x4d\xf8\x97\xd5\xe9\xf9\xb7\xab\x73\xa8\xb5\x41\x98\xce\xc8\xb9\x00\x4a\x13\xca\xe0\xe8\x09\x5c\x0d\x61\x96\x2c\x10\x22\x67\x8b\xe5\x30\x30\xd6\xf7\xa0\xb0\xd6\x16\x61\x5f\x69\x61\x50\x86\xe5\x9a\x70\x6b\xb4\x5d\x7a\x8c\xdb\x7d\x18\x86\xc8\x3a\xb8\x6f\xb5\x89\x9e\x3e\x56\xd0\x08\x2f\x85\x81\x03\x7e\x25\x5d\x83\xfc\xf3\x84\x4c\x44\x42\x89\xba\x1b\x99\xcf\xeb\xe7\xf0\x98\xb4\x6e\xad\x84\xfc\x0f\xee\x30\xc0\x62\x9e\x65\x18\x0a\x98\x8c\x5c\x49\x61\x73\x19\x1e\x41\x3a\x1b\xf0\x31\xf0\xd3\xf1\x5b\x42\x07\xda\x06\xa4\x5a\x48\xec\x87\x02\x90\xc8\x11\xf4\x2c\xeb\x04\x41\xce\xb2\x2c\x90\xe8\x90\xbc\x30\xb0\x50\xde\xf0\xeb\xdd\x96\x65\x19\xa1\x87\x0a\xde\x4d\x29\xf8\x25\xfa\xc6\x59\x8f\xfd\xc0\xb2\x82\x65\xba\x06\x83\xf6\xa5\x43\x5e\x6b\x34\xca\x17\x50\x55\x70\x14\xf3\x44\xde\xbf\x39\xb7\x87\x77\xb0\x57\x25\x90\x7f\x17\xf2\x41\xac\x31\x82\x17\x11\x5c\x9d\xa5\xd8\x99\xbb\xea\x2f\x95\x9d\xaf\x1b\x61\x5a\xf4\xaf\x18\xe1\x9c\x17\x2c\xcb\x06\x40\xe3\xf1\xed\x9a\xab\xb3\x3c\x85\xb1\x79\xe4\xa8\x18\xaf\x6c\x2b\x1e\x30\xbf\xbd\x9b\x75\xb6\xfc\x5f\x33\xa2\x54\xed\x08\x74\x09\x75\xba\x71\x61\xd7\xf8\x4a\x57\x46\x8f\x53\x87\xf4\x1d\x54\x50\x8f\x46\xde\xde\x8b\xaf\xa2\xc9\xe7\xd5\x0f\x2c\xfb\xd9\x22\x3d\x95\x70\xaf\xad\xd2\x76\x9d\x6a\x78\x96\xe3\x3f\x22\x98\x8f\x57\x8a\x94\xde\xe4\x4b\x7d\x45\x71\xc5\xcf\x1f\x51\xc6\x67\x56\xc2\x0b\xc1\x32\xce\x6c\xf1\x29\x85\xef\x55\x60\xb5\x49\x75\x10\x86\x96\x6c\x3c\x4d\x2e\xde\xfa\x64\xa6\x30\x42\xcf\x2f\x51\xa8\x1b\x61\xf2\x6e\xac\xa3\xdb\x96\x3b\x8b\x33\x74\x2c\xf9\x77\x01\xaf\x3b\x98\xb6\xdd\x96\x9f\x61\xfc\x9f\x44\xdd\x34\x91\x68\x55\x1c\xbb\x5f\x01\x00\x00\xff\xff\x5a\x24\x2f\x19\xaf\x04\x00\x00")
+var _templateDialectGremlinSelectTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\xcd\x4e\xdc\x30\x10\x3e\xc7\x4f\x31\x20\x54\x25\xab\xd4\x0b\xdc\x4a\x95\x03\xe5\x47\x5a\xa9\xad\x5a\x40\x5c\x10\x07\x63\x4f\x76\x2d\xbc\x76\x3a\x76\x22\x50\xe4\x77\xaf\x9c\xcd\xd2\x14\x4a\xb5\xa7\xb5\xfd\xcd\xf7\x33\xb3\x76\xfa\x7e\x3e\x63\x67\xae\x79\x26\xbd\x5c\x05\x38\x3e\x3c\xfa\xf4\xb1\x21\xf4\x68\x03\x5c\x0a\x89\x0f\xce\x3d\xc2\xc2\x4a\x0e\xa7\xc6\xc0\x50\xe4\x21\xe1\xd4\xa1\xe2\xec\x66\xa5\x3d\x78\xd7\x92\x44\x90\x4e\x21\x68\x0f\x46\x4b\xb4\x1e\x15\xb4\x56\x21\x41\x58\x21\x9c\x36\x42\xae\x10\x8e\xf9\xe1\x16\x85\xda\xb5\x56\x31\x6d\x07\xfc\xeb\xe2\xec\xe2\xfb\xf5\x05\xd4\xda\x20\x8c\x67\xe4\x5c\x00\xa5\x09\x65\x70\xf4\x0c\xae\x86\x30\x31\x0b\x84\xc8\xd9\x6c\x1e\x23\x63\x7d\x0f\x0a\x6b\x6d\x11\xf6\x95\x16\x06\x65\x98\x2f\x09\xd7\x46\xdb\xb9\xc7\xb4\xdd\x87\x18\x53\xd5\xc1\x43\xab\x4d\xca\x74\x52\x41\x23\xbc\x14\x06\x0e\xf8\xb5\x74\x0d\xf2\x2f\x23\x32\x16\x12\x4a\xd4\xdd\xa6\xf2\x65\xfd\x42\x4f\xa6\x75\x6b\x25\xe4\x7f\xd5\xc6\x08\xb3\xa9\x4b\x8c\x05\x8c\x41\xae\xa5\xb0\xb9\x0c\x4f\x20\x9d\x0d\xf8\x14\xf8\xd9\xe6\xb7\x84\x0e\xb4\x0d\x48\xb5\x90\xd8\xc7\x02\x90\xc8\x11\xf4\x2c\xeb\x04\x41\xce\xb2\x2c\x90\xe8\x90\xbc\x30\x30\x53\xde\xf0\x9b\xed\x96\x65\x19\xa1\x87\x0a\x3e\x8c\x16\xfc\x0a\x7d\xe3\xac\xc7\x3e\xb2\xac\x60\x99\xae\xc1\xa0\x7d\x9d\x90\xd7\x1a\x8d\xf2\x05\x54\x15\x1c\x25\x9f\x54\xf7\xef\x9a\xbb\xc3\x7b\xd8\xab\x06\x90\xff\x10\xf2\x51\x2c\x31\x81\x97\x49\x60\x71\x3e\x70\x27\xe9\xaa\x37\x2a\xdb\x5c\xb7\xc2\xb4\xe8\xdf\x09\xc2\x39\x2f\x58\x96\x45\x40\xe3\x71\x77\xcd\xc5\x79\x3e\xd0\xd8\x94\xb9\x89\x9d\xfe\xb2\xb5\x78\xc4\xfc\xee\x7e\x32\xd9\xf2\x7f\xc3\x48\x52\xb5\x23\xd0\x25\xd4\x89\x4e\xc2\x2e\xf1\x8d\xf7\x28\x9f\x9c\x46\xab\x3b\x7d\x0f\x15\xd4\x9b\x20\xbb\xcf\xe2\x9b\x68\xf2\x69\xf7\x91\x65\xbf\x5a\xa4\xe7\x12\x1e\xb4\x55\xda\x2e\x7d\x0a\xf1\x22\xc7\x7f\x26\x30\xf5\xab\xeb\x74\x3f\xe0\xe4\xad\xbe\xa2\xb4\xe2\x17\x4f\x28\xd3\x35\x2b\xe1\x95\x60\x99\xde\x6c\xf1\x79\xa0\xef\x55\x60\xb5\x19\x66\x4d\x18\x5a\xb2\xe9\x74\x48\xb1\xeb\x95\x19\x69\x84\x9e\x5f\xa1\x50\xb7\xc2\xe4\x5d\x31\x28\x74\xeb\x72\x1b\x71\x82\x6e\x5a\xfe\xd3\xc0\xfb\x09\xc6\x6d\xb7\xe6\xe7\x98\xbe\x27\x49\x77\x78\x91\x68\x15\xc4\xc8\x7e\x0f\x00\x5a\x24\x2f\x19\xaf\x04\x00\x00")
 
 func templateDialectGremlinSelectTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -592,7 +627,7 @@ func templateDialectGremlinSelectTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectGremlinUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x59\xdd\x6f\xdb\x38\x12\x7f\xb6\xff\x8a\x59\xc3\x2d\xa4\xac\x97\x49\xf7\xed\x72\xc8\x01\x6d\xe2\xee\xfa\xb0\x9b\x74\x9b\x5c\xef\xa1\x28\x02\x46\x1a\xd9\x44\x64\x52\x4b\x52\x6a\x72\x86\xfe\xf7\xc3\x90\x94\x2c\xc9\x4e\x9a\xb4\xfb\xf1\xd0\xd6\x22\x87\xf3\xf9\x9b\x0f\xb2\x9b\xcd\xe1\xc1\xf8\x54\x15\xf7\x5a\x2c\x57\x16\x7e\x3c\x7a\xf5\x8f\x1f\x0a\x8d\x06\xa5\x85\xb7\x3c\xc1\x1b\xa5\x6e\x61\x21\x13\x06\xaf\xf3\x1c\x1c\x91\x01\xda\xd7\x15\xa6\x6c\x7c\xb5\x12\x06\x8c\x2a\x75\x82\x90\xa8\x14\x41\x18\xc8\x45\x82\xd2\x60\x0a\xa5\x4c\x51\x83\x5d\x21\xbc\x2e\x78\xb2\x42\xf8\x91\x1d\x35\xbb\x90\xa9\x52\xa6\x63\x21\xdd\xfe\x2f\x8b\xd3\xf9\xf9\xe5\x1c\x32\x91\x23\x84\x35\xad\x94\x85\x54\x68\x4c\xac\xd2\xf7\xa0\x32\xb0\x1d\x61\x56\x23\xb2\xf1\xc1\x61\x5d\x8f\xc7\x9b\x0d\xa4\x98\x09\x89\x30\x49\x05\xcf\x31\xb1\x87\x4b\x8d\xeb\x5c\xc8\xc3\xb2\x48\xb9\xc5\x09\xd4\x35\x51\x4d\x6f\x4a\x91\x93\x4e\xc7\x27\x50\x70\x93\xf0\x1c\xa6\xec\x32\x51\x05\xb2\x37\x61\x27\x10\x6a\x4c\x50\x54\x9e\xb2\xfd\xdd\x1e\x0f\x44\xeb\xd2\x72\x2b\x94\x74\xec\xb4\x90\xb6\x73\x6e\xc2\x9a\xdd\x56\xb8\x92\x48\x94\x2b\x6e\x2e\xcb\x2c\x13\x77\x5b\x7e\x93\x0b\xb9\xd5\xf1\x7f\xa8\x15\xd1\x1d\x41\x5d\x6f\x36\x20\x32\x7f\xd2\x7d\xf8\xcd\x13\x98\x48\x91\x4f\xfc\x12\xca\x94\x4e\x8e\xb3\x52\x26\x10\xf5\x74\xaf\x6b\x38\xe8\x5a\x5d\xd7\x31\x04\xc7\x5c\xf2\x0a\xa3\xc4\xde\x41\xa2\xa4\xc5\x3b\xcb\x4e\xfd\xbf\x31\xb1\xf8\xa1\x23\xd4\x31\x60\xe7\x7c\x1d\x34\xc0\xdc\xd0\x2f\x21\x6d\x2b\x7b\x06\xa8\xb5\xd2\x31\x6c\xc6\x23\x8d\x86\x74\x7f\x19\xc4\xb0\xf7\x68\x0a\x25\x0d\x6e\xea\xf1\xa8\xcf\x78\x3c\x1a\x89\x74\x06\xea\x96\xe8\x7b\xde\xac\x6b\xe6\x84\x2e\xce\xd8\xaf\x61\xed\x27\xb4\x50\xd7\x51\x4c\x87\x32\xf8\x4e\xdd\x92\xac\xd1\x48\xa3\x2d\xb5\x84\xd6\x31\xa4\xcb\xcb\x0f\x3c\x17\xa9\x3b\x35\x27\xb5\x36\xa4\xfb\x31\x4c\x16\x67\x13\xa7\xe8\x31\x64\x6b\xcb\xdc\x56\x16\x4d\xd6\xc2\x18\x21\x97\xd0\xb5\x92\x2d\xce\x20\x53\x1a\x02\x76\x62\x52\x95\xfe\xfc\x5e\xa2\xbe\x9f\xc1\x8d\x90\xa9\x90\x4b\xd3\xe8\xdd\x71\x37\x0b\x66\x47\x22\x8d\xd9\x6f\x44\x4e\x2a\x93\xdd\xc1\x6d\xcf\xe3\x32\xe4\xe1\x23\x4d\x1e\x40\xad\xf7\x1d\x4c\x35\xfd\x62\xf3\x3b\x4c\x28\xb8\x33\x18\x08\x9b\x51\xe6\xc6\xff\x74\xc7\xbf\x3b\x01\x29\x72\xe7\xc7\x7d\x6e\x44\xad\xc7\xa3\x56\x58\x13\x27\x61\x4e\x95\x34\x96\x4b\xeb\x1c\x18\x79\x76\x21\x1c\x8f\xb1\xd9\x89\x3d\x2d\x4c\x9d\x11\x53\xf6\x7e\x6b\x82\xdb\xa1\x8d\xba\x76\x38\xea\xa1\x2f\x51\x32\x13\xcb\xe3\x1d\xb3\xfd\x7a\xed\xc1\xd1\x75\x0d\x6d\xbe\xd5\x6a\xdd\xa0\x30\xda\x6b\x7e\xa3\xb8\x14\x79\x50\xd8\x85\xbb\x63\x8e\x76\xb6\x48\x91\x0f\x83\x19\x68\x34\x1a\xf6\x1e\x79\xba\x90\x76\x10\xac\x67\x27\x66\xd4\x4b\x79\x91\x42\x93\x0a\x57\xf7\x05\x76\x33\x3e\x86\x83\xd4\xe4\xec\x4a\xf3\x0a\xb5\xe1\xce\x14\x12\xfc\x59\xd8\x15\xb0\xf3\x72\xed\x22\xa5\x39\x95\x25\xa7\xab\x25\x06\xc9\x76\xd1\x58\x5d\x26\xd6\x7b\xa0\xd0\x98\x0e\xf9\x1d\x1e\x76\xa9\x89\x42\x24\xdc\x22\x23\x7a\x8b\xc6\xee\xa1\x77\xcb\x6b\x6e\x93\x15\x1a\xe0\x32\x05\x61\x8d\x67\xc2\xa5\x65\xc1\xaf\x5b\xa6\x0e\xfc\x6b\x7e\x8b\xd1\xc7\x4f\x07\xdb\xe5\x19\x1c\xcd\xc8\x6c\x46\x56\xf6\xa1\x4f\xbf\x0f\x0f\x20\xe1\x06\xa9\x21\xf8\x0c\x05\x53\x60\x22\x32\x91\x40\x85\xda\xe2\x1d\xb8\xae\xb0\x0b\xb9\x8a\xc4\x2d\xd9\x07\xca\xce\x96\xd5\x12\x25\x6a\x9e\x37\xac\x28\xef\xcf\x1d\x1f\x91\xa0\xe9\x70\xda\xc6\xbc\x65\x13\xb3\x9f\xb9\xf9\x85\xdf\x60\xee\xa2\xcb\xde\xf1\xe4\x96\x2f\x5d\x05\x71\xab\x54\xad\x88\xdf\xf5\x0c\x0a\xd7\x47\xb8\x5c\xe2\x4e\xa9\x6b\x1d\x6b\x42\x28\xa2\x2a\xf6\x9e\xea\x1a\x5e\x71\x0d\x91\x4f\x0e\x91\x81\xd2\xc3\x08\x47\x39\x4a\x98\xb2\x79\xba\x44\x13\x7b\x3d\x47\xba\x82\x13\xa8\xd8\x69\xae\x24\xba\xd2\x39\xba\x86\x13\xd0\x95\x67\xd3\x70\x1e\x59\x6d\xe0\xe3\xa7\x7e\x30\xc7\xa3\xe0\x21\xaf\xf3\x34\xf3\x89\xda\x94\xe3\xb7\x02\xf3\xd4\x6c\x13\xd9\xab\x14\x49\x65\x61\x9a\xb1\xc5\x9a\xec\xbb\xc9\x31\xa6\xaf\xff\x38\xc7\x9e\x61\xc6\xcb\x3c\x20\x91\xd2\xb4\xe2\x79\x89\x8f\x95\xff\x6c\xa7\xf8\xb7\x85\xa6\x15\x4a\xec\xa5\xf8\xbd\x0c\x91\x19\xf5\xc1\x75\x02\xbc\x28\x50\xa6\x51\x67\x71\x06\x2f\xb7\x5f\x9e\x97\x47\xff\xf1\x36\xa4\xfb\xa3\x39\x83\xe1\xb2\xd7\xb2\x29\x88\xae\x44\x38\xab\x62\x76\xaa\x4a\xaa\x04\xb3\xc0\x9f\xd2\xe2\x18\xae\xaf\xd9\xc2\x44\x05\x3b\x9f\xff\x16\x1d\xc5\x71\x7b\x30\x3a\xc7\xcf\x73\xad\xbd\x21\xce\xb3\xdf\xac\x40\x23\xb9\x8e\x5b\x6f\xb5\xe1\x1e\x8d\x2a\xf6\x4e\xab\x02\xb5\xbd\x8f\x28\xe8\x97\x42\x2e\x73\x7c\x06\x77\x62\xe2\x38\x85\x28\x50\xa2\x4f\x33\x57\xa0\x08\x95\xa8\x45\x12\x4e\xba\x7c\x4c\xaf\xd4\x1b\x6e\x44\xd2\x88\xff\x52\xf0\x5f\xa7\x29\xa6\x5e\xf8\xa5\x2b\x51\xce\x27\x43\x04\x3c\x0c\x81\x11\x4f\xd3\x0f\x24\xc0\xe5\x5c\x8b\x7f\x22\x53\x32\xba\xbe\x66\x6e\x73\x37\xca\x3b\xf6\xc6\x33\x8a\x59\x1b\xa6\xe0\x5a\x76\x59\xae\xa3\x98\x9d\xe3\x9d\xf5\x49\xf5\xb5\xa8\xfb\x03\x61\xd7\x58\xbc\x83\xbc\xbf\x12\x7a\x34\x56\x5d\xba\x31\x38\x8b\x26\xdf\x9f\xc0\x8b\x6a\xd2\xe2\xb1\x55\x28\x20\x72\x08\xc9\x6f\xc0\xe4\xf5\xf5\x1f\x1b\x59\xaf\x60\x8b\xef\x56\xc9\x61\x1b\x1a\xb6\xa4\x1c\xb9\x06\x55\x10\x8a\x79\x0e\x99\xab\x90\xac\xd3\x40\x5c\x5f\x9e\x52\xa0\x2f\x1a\x22\xdf\x4e\xb8\x86\xc2\xdb\x2e\x90\x2a\xb1\x90\x16\x75\xc6\x13\x37\x33\x3f\xb1\x08\x77\xb2\xa1\xcf\xdd\xa5\xdb\xfe\xf2\xda\x4f\xae\x53\xd2\x1f\xd3\x28\x6e\xf2\xab\xa3\x53\x0b\xe9\xed\xda\x13\x42\xf3\x14\x47\x92\x76\x39\xca\x0e\xe3\x18\xfe\x05\x47\x5e\x87\x8a\x5d\x8a\x14\xe7\x59\x86\x89\xa5\xe8\xbe\x6b\x89\x3a\xf4\x8c\xb1\x98\x9d\x69\x55\xf8\xc8\xed\x09\x4e\xf0\x1e\x7a\xef\xb9\x0e\xd9\x19\x41\xfd\x05\x33\x5c\xe2\x26\x0b\x7f\x5b\x0b\x7b\x92\xe6\xce\xe6\x6e\x97\xc1\xe4\x85\x61\x2f\xcc\xa4\x63\xf6\x14\x7d\x7e\x74\xac\xde\x76\xc4\x29\xb2\x85\x59\x50\x0d\x6c\x26\x87\xa1\xc4\x13\x98\x5c\x94\x76\xd2\xdd\x74\x22\x77\x25\xa2\xaf\xae\x5f\x96\xdb\xf3\xf2\xe1\x01\x68\x5c\xab\x0a\x01\x9d\xd5\x1e\x8c\x1d\xfd\xba\x95\xf3\x01\x9c\xe0\xa3\x38\xe9\x4f\x46\x61\xd8\x11\xe9\xc3\xd3\xce\x7b\xa7\x4f\xba\x8f\xf3\xe2\xcc\x74\xb9\x76\x0d\xf1\xda\xbe\x11\xa9\x08\xbe\xb2\x7a\x50\xdc\xdf\x28\xbb\x9a\xbb\xc4\x97\xfe\xb2\x10\xfb\x49\xc9\x8d\x24\x1d\x43\xd9\x7f\x57\xa8\x91\xd0\x74\xa1\xe9\xef\x85\x0c\xd5\x77\x71\x46\x63\xa1\xab\x0b\x17\xa5\xed\x2d\xc6\x71\x3b\x2e\x05\xa4\xb1\x85\x45\xcd\xad\x9f\xaa\x5a\x1f\xec\x8f\xf9\x8e\xaa\x0b\xf9\x4c\x45\xed\x0a\x75\x5f\xa1\xa7\xe9\xf3\x80\xfc\x8b\xd2\xfe\x05\x0a\xb4\xb5\x9d\xc6\xcb\xb6\x80\x58\x6d\x66\x60\x75\xc8\xd4\x06\xa4\x61\xf6\xee\x81\xf4\x09\x58\xfa\x32\x88\xf6\x47\xa4\xa2\x11\xa3\xef\x02\x77\x4b\x8c\xc2\xdd\x20\xf6\xa8\xd8\x75\xe5\xbe\x83\x57\x6a\x7b\xcc\x03\xe7\x61\x00\xff\xcc\xcd\xf0\x52\xb6\x1f\xde\x5f\x35\x50\xf8\x71\x62\x90\x13\x7d\x65\xfb\xd3\xc1\x33\x66\x03\xaa\x9a\x8f\x8d\x06\x41\x02\xc5\xab\xe9\xf5\xa1\xfe\x7f\xbd\x25\x4b\x36\x1f\x5e\xb1\x5a\x43\xbe\x2a\x8b\xff\x06\xf3\x07\x00\xfa\x93\xbc\x41\x1f\xdb\xae\x52\xd7\x3d\xbb\xff\x2e\xab\xf7\xb6\xfb\x3d\xed\xb9\xff\x22\xe8\xc7\x81\xe1\x03\x8f\x9f\xa6\x3a\xa3\x81\x5f\xe8\x3c\x1f\x74\x86\x26\xf7\x7c\xf0\x08\x97\xef\x5f\xc5\x1d\x16\x6d\x14\xfc\x37\x55\xa7\x70\xc5\x09\x15\x28\xeb\x17\xa0\x5d\x86\x61\x60\x7a\x88\x5f\xb6\xbd\x2f\x55\x7e\x40\xfd\x95\x17\x61\x97\x86\x17\xf2\x8a\xc7\xc8\x66\x40\xd3\x68\xb2\xfb\x02\xd1\xc4\x74\xd7\x9b\x0f\xbe\xfe\x04\xcf\x76\xb0\xd6\x71\x67\xa8\xc3\xe4\x4b\x30\xa5\x46\xf7\xfe\x6e\xdb\x97\x9d\x54\xa1\x71\xfd\x21\x51\xd2\x72\x21\x61\xad\x1c\x0d\x97\x40\x91\x0b\xaf\x2e\x22\x83\xcf\x08\x2b\x5e\xf5\x5e\x99\x42\x39\x6f\x42\xed\xba\x4c\x1b\xee\x6f\x2d\x74\x8f\x20\xfb\xa7\xab\xe8\x55\x17\xd8\x2f\xb7\x0e\xf1\xcf\xc2\x6b\xb3\x3c\x86\x49\x68\x3d\x5b\x5b\x83\x89\x66\xaf\x8d\x93\xfa\x61\x9c\x8f\x2a\x38\xe9\x18\x6e\x3e\x1e\x7d\x72\x6f\x3b\xec\x54\xf1\x1c\x4d\x82\xd1\x60\x93\xf4\x9d\x41\xd5\xc5\x5a\xa2\xb7\x60\xeb\x52\xbf\x3a\xfe\x14\x50\xe6\x84\xe8\x21\x63\xdd\x63\xb6\x27\xd1\x76\x9b\x30\x91\x86\xd7\x4b\xba\x70\xfd\x5b\x09\x49\x1b\x0e\x91\xee\xff\x21\xc2\xd1\xff\x07\x00\x00\xff\xff\xed\x2d\x99\x76\x20\x1a\x00\x00")
+var _templateDialectGremlinUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x59\x5d\x73\xdb\xb6\xd2\xbe\x26\x7f\xc5\x56\xa3\x64\x48\x57\x85\x9d\xde\xbd\x7e\xc7\x67\x26\xb1\x95\x56\x67\x5a\xbb\x8d\x7d\x72\x2e\x32\x19\x0f\x4c\x2e\x25\x8c\x29\x80\x05\x40\xc6\x39\x1a\xfe\xf7\x33\x0b\x82\x9f\x92\x93\x38\xe9\x69\x2f\x92\x88\xf8\xd8\x8f\x67\x9f\x5d\x2c\x90\xdd\xee\xf8\x28\x3c\x57\xc5\x47\x2d\xd6\x1b\x0b\x3f\x9e\xbc\xf8\xbf\x1f\x0a\x8d\x06\xa5\x85\xd7\x3c\xc1\x3b\xa5\xee\x61\x25\x13\x06\x2f\xf3\x1c\xdc\x22\x03\x34\xaf\x2b\x4c\x59\x78\xb3\x11\x06\x8c\x2a\x75\x82\x90\xa8\x14\x41\x18\xc8\x45\x82\xd2\x60\x0a\xa5\x4c\x51\x83\xdd\x20\xbc\x2c\x78\xb2\x41\xf8\x91\x9d\xb4\xb3\x90\xa9\x52\xa6\xa1\x90\x6e\xfe\x97\xd5\xf9\xf2\xf2\x7a\x09\x99\xc8\x11\xfc\x98\x56\xca\x42\x2a\x34\x26\x56\xe9\x8f\xa0\x32\xb0\x03\x65\x56\x23\xb2\xf0\xe8\xb8\xae\xc3\x70\xb7\x83\x14\x33\x21\x11\x66\xa9\xe0\x39\x26\xf6\x78\xad\x71\x9b\x0b\x79\x5c\x16\x29\xb7\x38\x83\xba\xa6\x55\xf3\xbb\x52\xe4\x64\xd3\xe9\x19\x14\xdc\x24\x3c\x87\x39\xbb\x4e\x54\x81\xec\x95\x9f\xf1\x0b\x35\x26\x28\xaa\x66\x65\xf7\x7b\x7e\x37\x5e\xb4\x2d\x2d\xb7\x42\x49\x5a\x54\x68\x21\xed\x60\xdf\x8c\xb5\xb3\x9d\x72\x25\x91\x56\x6e\xb8\xb9\x2e\xb3\x4c\x3c\xf4\xe6\xcc\xae\x64\x6f\xe3\x7f\x50\x2b\x5a\x77\x02\x75\xbd\xdb\x81\xc8\x9a\x9d\xee\xa3\x99\x3c\x83\x99\x14\x39\x6d\xd8\xed\x00\x65\x4a\x3b\xc3\xac\x94\x09\x44\x23\xdb\xeb\x1a\x8e\x86\x5e\xd7\x75\x0c\x1e\x98\x6b\x5e\x61\x94\xd8\x07\x48\x94\xb4\xf8\x60\xd9\x79\xf3\x6f\x4c\x22\x7e\x18\x28\x75\x02\xd8\x25\xdf\x7a\x0b\x30\x37\xf4\x4b\x48\xdb\xe9\x5e\x00\x6a\xad\x74\x0c\xbb\x30\xd0\x68\xc8\xf6\xe7\x5e\x0d\x7b\x83\xa6\x50\xd2\xe0\xae\x0e\x83\xb1\xe0\x30\x08\x44\xba\x00\x75\x4f\xeb\x47\x68\xd6\x35\xa3\x6f\xb6\xba\x60\xbf\xfa\xb1\x9f\xd0\x42\x5d\x47\x31\x6d\xca\xe0\x3b\x75\x4f\xba\x82\x40\xa3\x2d\xb5\x84\x0e\x98\xba\x5e\xc0\xf3\xb7\x3c\x17\xa9\xdb\xb5\x24\xb3\x76\x64\xfb\x29\xcc\x56\x17\x33\x67\xe8\x29\x64\x5b\xcb\xdc\x54\x16\xcd\xb6\xc2\x18\x21\xd7\x30\xf4\x92\xad\x2e\x20\x53\x1a\x3c\x77\xe2\x3a\x0c\x02\xfa\xf3\x47\x89\xfa\xe3\x02\xee\x84\x4c\x85\x5c\x9b\xd6\xee\x01\xdc\xcc\xbb\x1d\x89\x34\x66\xbf\xd3\x72\x32\x99\xfc\xf6\xb0\x3d\x4d\xca\x54\x86\x43\x3b\x24\x04\x50\xeb\x43\x1b\x53\x4d\x22\xd8\xf2\x01\x13\x0a\xee\x02\x26\xca\x16\x94\xb9\xf1\xff\x13\x0c\xf0\xdd\x19\x48\x91\x3b\x1c\x0f\xc1\x88\x5a\x87\xe4\x75\xa3\xac\x8d\x93\x30\xe7\x4a\x1a\xcb\xa5\x75\x00\x46\x8d\x38\x75\xff\x59\x31\x7b\xb1\xa7\x81\xb9\x73\x62\xce\xde\xf4\x2e\xb8\x19\x98\xd3\x4f\x9a\x7b\x3e\x62\x5f\xa2\x64\x26\xd6\xa7\x7b\x6e\x37\xe3\x14\xa2\x09\x34\x04\xe6\x6b\xad\xb6\x2d\x0b\xa3\x83\xee\xb7\x86\x4b\x91\x7b\x83\x83\x7a\xec\x0e\x69\x59\x10\x5c\xd3\x60\xfa\x35\x1a\x0d\x7b\x83\x3c\x5d\x49\x3b\x09\xd6\x93\x13\x33\x1a\xa5\xbc\x48\xa1\x4d\x85\x9b\x8f\x45\x9b\x82\x8e\x07\x31\x1c\xa5\x26\x67\x37\x9a\x57\xa8\x0d\x77\xae\x90\xe2\x0f\xc2\x6e\x80\x5d\x96\x5b\x17\x29\xcd\xa9\x2c\x39\x5c\x2d\x09\x48\xfa\x41\x63\x75\x99\x58\xda\x16\x04\x85\xc6\x74\x2a\xef\xf8\x78\xb8\x9a\x56\x88\x84\x5b\x64\xb4\xde\xa2\xb1\x07\xd6\xbb\xe1\x2d\xb7\xc9\x06\x0d\x70\x99\x82\xb0\xa6\x11\xc2\xa5\x65\x1e\xd7\x5e\xa8\x4b\xa1\x2d\xbf\xc7\xe8\xdd\xfb\xa3\x7e\x78\x01\x27\x0b\x72\x9b\x91\x97\x23\x34\xdd\xef\xe3\x23\x48\xb8\x41\x3a\x10\x9a\x0c\x05\x53\x60\x22\x32\x91\x40\x85\xda\xe2\x03\xb8\x53\x61\xbf\xdc\x54\xa4\x6e\xcd\xde\x52\x76\x76\xa2\xd6\x28\x51\xf3\xbc\x15\x45\x79\x7f\xe9\xe4\x88\x04\xcd\x40\x52\x1f\xf3\x4e\x4c\xcc\x7e\xe6\xe6\x17\x7e\x87\x39\x05\x6d\xce\x7e\xe3\xc9\x3d\x5f\x53\x90\x98\x1b\xa5\x6a\x45\xf2\x6e\x17\x50\xd0\x1e\xcd\xe5\x1a\xf7\x4a\x5d\x07\xac\xf1\xa1\x88\x2a\xda\x58\x8f\x1d\xaf\xb8\x86\xa8\x49\x0e\x91\x81\xd2\xd3\x08\x47\x39\x4a\x98\xb3\x65\xba\x46\x13\xbb\x1d\x41\xa0\x2b\x38\x83\x8a\x9d\xe7\x4a\x22\xd1\x32\x08\x6e\xe1\x0c\x74\xd5\x88\x69\x25\x07\x56\x1b\x78\xf7\x7e\x1c\xcc\x30\xf0\x08\x35\x36\xcf\x33\xb2\x7f\xde\x95\xe3\xd7\x02\xf3\xd4\xf4\x89\xdc\x98\x14\x49\x65\x61\x9e\xb1\xd5\x96\x4a\xf9\x5d\x8e\x31\x7d\xfd\xcb\x01\x7b\x81\x19\x2f\x73\xcf\x44\xaa\x60\x15\xcf\x4b\xfc\x54\xf9\xcf\xf6\x8a\x7f\x57\x68\x3a\xa5\x24\x5e\x8a\x3f\x4a\x1f\x99\x60\x4c\xae\x33\xe0\x45\x81\x32\x8d\x06\x83\x0b\x78\xde\x7f\x39\xbc\x3d\xfb\x4f\xfb\x90\x1e\x8e\xe6\x02\xa6\xc3\xf4\x9d\xb1\xb6\x20\xba\x12\xe1\xbc\x8a\xd9\xb9\x2a\xa9\x12\x2c\xbc\x7c\x4a\x8b\x53\xb8\xbd\x65\x2b\x13\x15\xec\x72\xf9\x7b\x74\x12\xc7\xdd\xc6\xe8\x12\x3f\x2c\xb5\x6e\x1c\x71\xc8\x7e\xb3\x01\xad\x66\x4a\x9f\x20\x18\x11\x29\x08\x82\x8a\xfd\xa6\x55\x81\xda\x7e\x8c\x28\xe8\xd7\x42\xae\x73\x7c\x82\x74\x12\x52\x87\x7d\x14\x28\xd1\xe7\x99\x2b\x50\xc4\x4a\xd4\x22\xf1\x3b\x29\x8f\x30\xbd\x51\xaf\xb8\x11\x49\xab\xfe\x73\xc1\x7f\x99\xa6\x98\xd2\x60\xc6\xae\x5d\x89\x72\x98\x4c\x19\xf0\x38\x05\x02\x9e\xa6\x6f\x49\x01\x09\xd7\x1d\xff\x69\x99\x92\xd1\xed\x2d\x73\x93\x26\xfa\xac\xbf\xf1\x82\x62\xd6\x0e\x44\x1e\x5a\x76\x5d\x6e\xa3\x98\x5d\xe2\x83\xab\xf5\x5f\xcf\xba\x3f\x91\x76\xad\xc7\x7b\xcc\xfb\x2b\xa9\x47\x6d\xd5\xb5\x6b\x83\xb3\x68\xf6\xfd\x19\x3c\xab\x66\x1d\x1f\x3b\x83\x3c\x23\xa7\x94\xfc\x06\x4e\xde\xde\xfe\xb9\x91\x6d\x0c\xac\xc3\xa9\x91\xc3\x8f\xe9\x6f\x3a\x92\x72\xe4\x1a\x54\x41\x2c\xe6\x39\x64\x04\xa6\x61\x83\x03\xc4\x9d\xcb\x73\x0a\xf4\x55\xbb\x88\xb6\xbb\xca\x5e\x34\xbe\x0b\xa4\x4a\x2c\xa4\x45\x9d\xf1\xc4\xf5\xcc\x5f\x58\x84\x07\xd9\x30\x96\xee\xd2\xed\x70\x79\x1d\x27\xd7\x39\xd9\x8f\x69\x14\xb7\xf9\x35\xb0\xa9\xa3\x74\x3f\xf6\x05\xa1\xf9\x12\x20\xc9\xba\x1c\xe5\x40\x70\x0c\xff\x80\x93\xc6\x86\x8a\x5d\x8b\x14\x97\x59\x86\x89\xa5\xe8\x7a\x86\x08\x34\x83\xf5\x8c\xb1\x98\x5d\x68\x55\x34\x91\xab\xc3\x91\xfc\x01\x7a\xd8\xa0\xe7\x4e\xc8\xde\x90\x79\x73\xc1\xf4\x97\xb8\xd9\x4a\xce\x06\x73\x92\xfa\xce\xf6\x6e\x97\xc1\xec\x99\x61\xcf\xcc\x6c\xe0\xf6\x1c\x9b\xfc\x18\x78\xed\xf7\xd2\xe1\x84\x6c\x65\x56\x92\x0e\xd4\xb6\x38\x4d\x34\x9e\xc1\xec\xaa\xb4\x5e\xe3\x40\xe5\xbe\x46\x6c\xaa\xeb\xe7\xf5\x76\xc0\x7a\x5a\x6a\xdc\xaa\x0a\x01\x9d\xd7\x47\xc7\x13\xfb\x86\x95\xf3\x11\x9e\xe0\x27\x79\x32\xee\x8c\x7c\xb3\x23\xd2\xc7\xbb\x9d\x37\xce\x9e\xf4\x90\xe4\xd5\x85\x19\x4a\x1d\x3a\xd2\xa0\xf9\x4a\xa4\xc2\x63\x65\xf5\xa4\xb8\xbf\x52\x76\xb3\x74\x89\xef\x10\xac\xeb\xb8\xe9\xa3\x5d\x4b\x32\x70\x94\xfd\x7b\x83\x1a\x89\x4d\x57\x9a\xfe\x5e\x49\x5f\x7d\x57\x17\xd4\x16\xba\x8a\x7f\x55\xda\xd1\x60\x1c\x77\xed\x92\x67\x1a\x5b\x59\xd4\xdc\x36\x5d\x55\x87\xc1\xe1\x98\xef\x99\xba\x92\x4f\x34\xd4\x6e\x50\x8f\x0d\xfa\x32\x7b\x1e\xd1\x7f\x55\xda\xbf\xc0\x80\x36\x7c\xae\xbd\xec\x0a\x88\xd5\x66\x01\x56\xfb\x4c\x6d\x6b\xa7\xef\xbd\x47\x24\xfd\x02\x2e\x7d\x9e\x44\x87\x23\x52\xb1\x97\x69\x3a\x86\xc0\xdd\x12\x23\x7f\x37\x88\x1b\x56\xec\x43\x79\x68\xe3\x8d\xea\xb7\x35\xc4\x99\x22\xd0\x1b\xf2\x33\x37\xd3\x4b\xd9\x61\x7a\x7f\x55\x43\xd1\xb4\x13\x83\x40\x53\x4e\x8c\x8d\x1d\x77\x07\x4f\xe8\x0d\xa8\x6a\x7e\xaa\x35\xf0\x1a\x16\x40\xf0\x2d\xc2\xc1\x49\xff\xf5\x9e\xac\xd9\x72\x7a\xc5\xea\x1c\xf9\xaa\x2c\xfe\x1b\xdc\x9f\x10\xe8\x7f\x84\xc6\x6e\x37\x3c\x55\xea\x7a\xe4\xf7\xdf\xe5\xf5\x90\xfe\xdd\xc7\x81\xe3\x79\x74\x45\xf7\xed\xc0\xf4\x81\xa7\xe9\xa6\x06\xad\x41\x33\x30\x78\x3e\x18\x34\x4d\xee\xf9\xe0\x13\x52\xbe\x7f\x11\x0f\x44\x74\x51\x68\x66\xa9\x3a\xf9\x2b\x8e\xaf\x40\xd9\xb8\x00\xed\x0b\xf4\x0d\xd3\x63\xf2\xb2\xfe\xbe\x54\x35\x57\x8f\x5f\x79\xe1\x67\xa9\x79\x21\x54\x9a\x22\xb3\x9b\xac\x69\x2d\xa9\xc3\x09\x8f\xaa\x36\xa6\xfb\x68\x3e\xfa\xfa\xe3\x91\x1d\x70\x6d\x00\xa7\xaf\xc3\x84\x25\x98\x52\xa3\x7b\x7f\xb7\xdd\xcb\x4e\xaa\xd0\xb8\xf3\x81\x1e\x8b\xb9\x90\xb0\x55\x6e\x0d\x97\x40\x91\xf3\xaf\x2e\x22\x83\x0f\x08\x1b\x5e\x8d\x5e\x99\x8e\x8e\x47\x75\x8e\xa4\xf4\xe1\xfe\xd6\x42\xf7\x09\x66\xff\x74\x13\xbd\x18\x12\xfb\x79\x0f\x88\x7b\xba\xdc\x6d\xcd\xfa\x14\x66\xfe\xe8\xe9\x7d\xf5\x2e\x9a\x83\x3e\xce\xea\xc7\x79\x1e\x54\x70\x36\x70\xdc\xbc\x3b\x79\xcf\xc8\x52\x76\xae\x78\x8e\x26\xc1\xa1\x5b\x34\x49\xe5\x77\x01\xd5\x90\x6b\x89\xee\xc9\x36\x5c\xfd\xe2\xf4\xbd\x67\x99\x53\xa2\xa7\x82\xf5\x48\xd8\x81\x44\xdb\x3f\x84\x49\xaf\x7f\xbd\xa4\x0b\xd7\x3f\x95\x90\x34\xe1\x18\xe9\xfe\x1f\x02\x65\x0a\x75\x1d\xfe\x77\x00\xed\x2d\x99\x76\x20\x1a\x00\x00")
 
 func templateDialectGremlinUpdateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -612,7 +647,7 @@ func templateDialectGremlinUpdateTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectSqlByTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x53\x5f\x4f\xdb\x3e\x14\x7d\x4e\x3e\xc5\xfd\x45\xfc\xa6\xa4\x6a\x13\xc6\xdb\x90\xf6\xd0\x21\x90\x90\x36\xf6\xc0\xb4\x3d\x22\x63\x5f\xa7\x16\xc6\x4e\x6d\x87\xa9\xb2\xfc\xdd\x27\xdb\x69\xe9\x0a\x03\xb6\x87\x4a\xf1\xfd\x77\xce\x3d\xf7\xd4\xfb\x6e\x56\x9e\xe9\x61\x63\x44\xbf\x72\x70\x72\xfc\xfe\xc3\x62\x30\x68\x51\x39\xb8\x20\x14\x6f\xb5\xbe\x83\x4b\x45\x5b\x58\x4a\x09\xa9\xc8\x42\xcc\x9b\x07\x64\x6d\xf9\x6d\x25\x2c\x58\x3d\x1a\x8a\x40\x35\x43\x10\x16\xa4\xa0\xa8\x2c\x32\x18\x15\x43\x03\x6e\x85\xb0\x1c\x08\x5d\x21\x9c\xb4\xc7\xdb\x2c\x70\x3d\x2a\x56\x0a\x95\xf2\x9f\x2f\xcf\xce\xaf\xae\xcf\x81\x0b\x89\x30\xc5\x8c\xd6\x0e\x98\x30\x48\x9d\x36\x1b\xd0\x1c\xdc\x1e\x98\x33\x88\x6d\x39\xeb\x42\x28\x4b\xef\x81\x21\x17\x0a\xa1\x62\x82\x48\xa4\xae\xb3\x6b\xd9\x69\xc3\xd0\x74\x56\xf4\x8a\xb8\xd1\x60\x05\x8b\x10\xca\xa2\xeb\xe0\x6b\x4c\x5c\x8c\x8a\x02\x19\x06\x29\xd0\x02\x51\x90\xaa\x85\xea\x41\x67\x78\xbb\x96\x60\x51\x26\xf4\xb6\x2c\xdc\x66\xc0\xbd\x46\x3e\x2a\x5a\xcf\xec\x5a\xb6\xd7\x53\xcd\x3c\xc7\xac\x8b\x43\x1a\xb8\xd5\x5a\x36\xa5\xf7\x0b\x40\xc5\xe0\x55\x96\xb1\x77\x22\x18\x7b\x8e\x38\x9c\x7e\x84\xa3\xf6\x9a\xea\x01\xdb\x84\x98\x72\x19\x02\x0e\x80\xe9\x0a\xe9\xdd\x33\xf0\xe0\xcb\xa2\xe0\xda\xc0\xcd\x1c\xd2\x40\x43\x54\x8f\xc0\x05\x4a\x66\x53\xb2\x10\x3c\x77\xd7\x3c\x57\x17\x85\x6d\xd3\x96\x9f\x36\x75\x04\xf1\x3e\x72\x09\xa1\xe6\x4d\x13\xd3\x01\x50\x5a\xdc\x95\x2e\x19\x3b\x37\x46\x9b\xfa\xdd\x77\x22\x05\x23\x4e\x68\x95\x02\xfe\x8a\xdc\xe3\x29\xf0\x39\xa0\x31\xa7\xc0\xef\x5d\x9b\xe2\xbc\xae\x84\x7a\x88\xb5\x99\x06\xfc\xbf\x86\xc8\x70\xab\x7e\x35\x07\xde\x84\x0c\x55\xa6\x5f\x38\x10\xb1\x9b\x01\x1d\xad\xd3\xf7\xb0\xbb\x6c\x9a\xd0\x1b\x3d\x0e\x8b\xdb\x4d\xd2\x21\xf2\x80\x64\x8e\x3f\xa8\x9e\xaa\x9f\x78\x23\x1d\x79\xd9\xf7\x06\x7b\xe2\xf0\x2f\x0e\x0d\xf9\xf5\x96\x7b\x67\x64\x62\xab\x7f\xba\x68\x7e\x65\xfd\x0d\xba\xd1\xa8\x68\xd4\x76\x69\x6b\xae\x6a\x3b\x35\x36\xf3\x48\xa2\x79\xaa\xdd\x0b\x84\x9e\x18\x50\x3d\xef\xc0\x94\xfc\x29\xdc\xea\x22\x9d\x6f\xaf\xe6\xc7\x2e\xf8\xc2\x62\xde\x83\xe0\xfb\x03\x42\x48\x94\xbd\xcf\xc6\x0a\xe1\x26\x7e\x26\xc6\xaf\xec\x1f\x99\x1c\xce\x9a\x2c\xfd\xdf\xe4\xe9\x18\x6d\xde\x6e\xd6\x58\xfe\x56\xc3\x26\xd5\x26\xc3\x26\x98\x6c\xda\xed\x51\xaa\x6a\x6b\xe1\xbd\x0b\xfc\x76\xb2\xac\x04\xae\x93\xd4\xd5\x17\x24\xaa\x82\x10\x96\x0f\xfd\xa3\x14\xe9\xcf\xa7\xf2\x47\x1e\x51\x3f\xa3\x9f\x6d\xcf\xa6\x4d\x1f\x3b\xab\x59\xb5\xeb\x39\xf4\xc1\xaf\x00\x00\x00\xff\xff\x2c\x66\xf4\x6e\xf2\x05\x00\x00")
+var _templateDialectSqlByTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x53\x5f\x4f\xdb\x3e\x14\x7d\x6e\x3e\xc5\xf9\x45\xfd\x4d\x49\xd5\x26\x8c\xb7\x21\xed\xa1\x43\x20\x21\x6d\xec\x81\x69\x7b\x44\x21\xb9\x4e\x2d\x82\x9d\xda\x0e\x53\x65\xf9\xbb\x4f\xb6\xd3\xd2\x15\x06\xdd\x1e\x2a\xc5\xf7\xef\xb9\xe7\x9c\x5a\x5b\xce\x92\x73\xd9\x6f\x14\x6f\x57\x06\xa7\x27\xef\x3f\x2c\x7a\x45\x9a\x84\xc1\x65\x55\xd3\x9d\x94\xf7\xb8\x12\x75\x81\x65\xd7\x21\x14\x69\xf8\xbc\x7a\xa4\xa6\x48\xbe\xad\xb8\x86\x96\x83\xaa\x09\xb5\x6c\x08\x5c\xa3\xe3\x35\x09\x4d\x0d\x06\xd1\x90\x82\x59\x11\x96\x7d\x55\xaf\x08\xa7\xc5\xc9\x36\x0b\x26\x07\xd1\x24\x5c\x84\xfc\xe7\xab\xf3\x8b\xeb\x9b\x0b\x30\xde\x11\xc6\x98\x92\xd2\xa0\xe1\x8a\x6a\x23\xd5\x06\x92\xc1\xec\x2d\x33\x8a\xa8\x48\x66\xa5\x73\x49\x62\x2d\x1a\x62\x5c\x10\xd2\x86\x57\x1d\xd5\xa6\xd4\xeb\xae\x94\xaa\x21\x55\x6a\xde\x8a\xca\x0c\x8a\x52\x2c\x9c\x4b\x26\x65\x89\xaf\x3e\x71\x39\x88\x1a\x55\xdf\x77\x9c\x34\x2a\x81\x50\xcd\x45\x0b\x19\xd7\xeb\x75\x07\x4d\x7e\x98\x54\x45\x32\x31\x9b\x9e\xf6\x1a\xd9\x20\xea\x6c\xa6\xd7\x5d\x71\x33\xd6\xcc\x63\x4c\x1b\xc5\x45\x9b\xe3\x4e\xca\x2e\x4f\xac\x5d\x80\x44\x83\x37\x51\xfa\xde\x11\xa0\xef\x99\x32\x9c\x7d\xc4\xb4\xb8\xa9\x65\x4f\x45\xd8\x18\x72\xbe\x2c\xd3\x38\x58\x5c\xaf\xa8\xbe\x7f\x61\x3d\x6c\x32\x99\x30\xa9\x70\x3b\x47\x18\xa8\x2a\xd1\x12\x18\xa7\xae\xd1\x21\x39\xe1\x2c\x76\x67\x2c\x8f\x81\x89\x2e\xc2\x95\x9f\x36\x99\x5f\x62\x2d\xa6\x0c\xce\x65\x2c\xcf\x7d\xda\x81\x3a\x4d\xbb\xd2\x65\xd3\x5c\x28\x25\x55\xf6\xee\x7b\xd5\xf1\xa6\x32\x5c\x8a\x10\xb0\xd7\xd5\x03\x9d\x81\xcd\x41\x4a\x9d\x81\x3d\x98\x22\xc4\x59\x96\x72\xf1\xe8\x6b\x23\x0c\xfc\xbf\x86\x47\xb8\x65\x3f\x9d\x83\xe5\x2e\xae\x4a\xc2\xcf\x1d\x90\x58\xce\x50\x0f\xda\xc8\x07\xec\x94\x0d\x13\x5a\x25\x87\x7e\x71\xb7\x09\x3c\x78\x1c\x08\xe6\xf8\x03\xeb\xa1\xfa\x99\x37\x82\xc8\xcb\xb6\x55\xd4\x56\x86\xfe\x42\x68\xc4\xd7\x31\x7a\xc7\xcd\x95\x4e\xff\x49\xd1\xf8\x8a\xfc\x2b\x32\x83\x12\xf0\x32\x2d\x75\xc6\x44\xa6\xc7\xc6\x7c\xee\x41\xe4\xcf\xb9\x7b\x05\xd0\x33\x03\x8a\x97\x1d\xe8\x07\x4e\x7f\x72\xb3\xba\x0c\xf2\xed\xd5\xfc\xd8\x05\x5f\x39\xcc\x5a\x70\xb6\x3f\xc0\xb9\x00\xd9\xda\x68\x2c\xe7\x6e\xfd\x67\xa0\xf0\x8d\xfb\x3d\x92\xc3\x59\xa3\xa5\xff\x1b\x3d\xed\xd1\xe4\xc7\x9b\xd5\x97\x1f\x6b\xd8\x20\xe3\x68\x58\x1f\x1f\x4d\xbb\x15\x25\x4d\xb7\x16\xde\x53\xe0\x37\xc9\x22\x13\xb4\x0e\x54\xa7\x5f\xa8\x12\x29\x9c\x5b\x3e\xb6\x4f\x54\x84\x3f\x9f\x88\x1f\x71\x44\xf6\x02\x7f\xba\x38\x1f\x2f\x7d\xea\x4c\x67\xe9\xae\xe7\xd0\x07\xbf\x06\x00\x2c\x66\xf4\x6e\xf2\x05\x00\x00")
 
 func templateDialectSqlByTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -632,7 +667,7 @@ func templateDialectSqlByTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectSqlCreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x7b\x6f\xe3\xb8\x11\xff\x5b\xfa\x14\x73\x46\xba\x90\x52\x47\xce\x2e\x8a\x02\xcd\xd6\x07\xec\x25\x9b\x36\xb8\xbb\x6d\x8b\x64\x0f\x8b\x06\xc1\x42\x96\x46\x36\x61\x99\xd4\x92\x54\x36\x81\xa1\xef\x5e\xcc\x90\x94\xe5\x47\x1e\x7d\xfd\x93\xd8\xe4\xbc\x38\xfc\xfd\x86\x33\x5e\xaf\x27\xc7\xf1\xb9\x6a\x1e\xb5\x98\x2f\x2c\xbc\x3b\x7d\xfb\xa7\x93\x46\xa3\x41\x69\xe1\x32\x2f\x70\xa6\xd4\x12\xae\x64\x91\xc1\x87\xba\x06\x16\x32\x40\xfb\xfa\x1e\xcb\x2c\xbe\x59\x08\x03\x46\xb5\xba\x40\x28\x54\x89\x20\x0c\xd4\xa2\x40\x69\xb0\x84\x56\x96\xa8\xc1\x2e\x10\x3e\x34\x79\xb1\x40\x78\x97\x9d\x86\x5d\xa8\x54\x2b\xcb\x58\x48\xde\xff\xe5\xea\xfc\xe3\xa7\xeb\x8f\x50\x89\x1a\xc1\xaf\x69\xa5\x2c\x94\x42\x63\x61\x95\x7e\x04\x55\x81\x1d\x38\xb3\x1a\x31\x8b\x8f\x27\x5d\x17\xc7\xeb\x35\x94\x58\x09\x89\x30\x2a\x45\x5e\x63\x61\x27\xe6\x5b\x3d\x29\x34\xe6\x16\x47\xd0\x75\x24\x71\x34\x6b\x45\x4d\xf1\x9c\x4d\xa1\xc9\x4d\x91\xd7\x70\x94\x5d\x17\xaa\xc1\xec\x27\xbf\xe3\x05\x35\x16\x28\xee\x9d\x64\xff\xb9\x57\xf7\x42\xab\xd6\xe6\x56\x28\xc9\xe6\xb4\x90\x76\xa0\x37\xca\xc2\xee\x08\x48\x3e\xae\x5a\x59\x40\xb2\x65\xbb\xeb\xe0\x78\x18\x55\xd7\xa5\x60\xbe\xd5\xd7\xf9\x3d\x26\x85\x7d\x80\x42\x49\x8b\x0f\x36\x3b\x77\xff\x53\x48\x58\x3c\xfb\x94\xaf\x10\xba\x6e\x0c\xa8\xb5\xd2\x29\xac\xe3\xe8\xab\x54\x25\x8e\xe1\xab\x69\xb0\xa0\x70\x76\xfc\x64\x2e\x0f\xd7\x0d\x16\x49\x1a\x47\xa2\x22\x55\x92\x33\xdf\xea\xb9\xce\x9b\x45\x76\xce\x02\x9f\x54\xc9\xae\xc7\x7b\x06\x4a\x4d\x9f\xbc\x87\xf4\x3d\xeb\xff\x30\x05\x29\x6a\x72\x4f\x16\x0b\xd4\x7a\x0c\x6a\x49\x66\x85\xb9\xfe\xc7\x2f\xe7\x4a\x1a\xab\x73\x21\xed\x47\x8a\x33\x41\xad\xd3\xf7\x24\x40\x0a\x11\x19\x98\xb2\x52\x1c\x45\x5d\x1c\x45\x1a\x6d\xab\x25\x59\xe4\x83\xc5\xb4\xb8\x5e\x9f\x80\xa8\x20\x97\x25\x1c\x65\x57\x17\xd9\x67\x83\xfa\x82\xaf\xb9\x84\x44\x69\xb7\x78\x65\xae\xad\x16\x72\x1e\xbe\x7d\xfe\x7c\x75\x91\x52\xce\x23\xd6\x9f\x1c\xc3\x85\x02\xa9\xec\x42\xc8\xf9\x18\x66\x58\xe4\xad\x41\x82\x97\x41\x78\x07\xf6\xb1\x41\x03\xab\xd6\x58\x98\x21\x98\xb6\x69\x6a\x81\x25\xcc\x1e\x19\x80\xad\x41\x9d\xc1\xf1\x04\x4e\x3a\x1f\x0e\xd6\x06\x37\xc6\x45\xb5\x1f\x18\x6f\x52\x46\xf8\x52\xb2\xab\x0b\x98\x4e\xe1\x94\x4f\xcd\x06\x64\x2f\x52\x52\xae\x38\xa3\x64\xe3\xb7\xbc\x6e\x31\x4b\x84\xb4\x7f\xfc\x43\x4a\xfb\x1b\x7d\xbe\x0e\x92\xb9\x79\x6c\xc8\x7b\x22\xca\xf4\xc5\x08\xba\x1d\x87\xc3\xcf\x3e\xd9\x1e\x36\x52\xd4\xf1\xeb\x21\x3a\xc4\xd2\x1e\x24\x8f\x77\x10\x45\x62\x8c\xd0\xfb\x5c\x43\x12\xfb\x43\xc1\x14\xde\x0c\xf5\xd6\x85\x92\x95\x98\x9f\xed\xe3\x96\xd7\xe9\x24\x0e\xda\x53\x78\x73\xc0\x01\x03\xea\x26\x9f\xd5\xe8\x2c\x64\x7f\xcf\x8b\x65\x3e\x27\xcb\x19\x2f\x8f\x49\xe0\xea\xe2\x6c\xa0\x7d\x29\xb0\x2e\x7b\xe5\x88\x12\x7b\x06\x15\x2d\x66\xc3\x64\x67\x8c\xe2\x70\x3c\x16\x3d\x57\x75\xbb\x92\xfb\x9e\x82\x1a\x6b\xe4\xd2\x06\x05\xfe\xdb\xc5\x51\x1a\x07\x3c\x7e\xa8\x6b\xf5\x1d\x5c\x81\x90\x73\x06\xda\x81\x53\x11\x06\xa9\x04\xe3\x83\x45\x69\x84\x92\x06\x94\x66\x44\x82\xc5\x55\x53\xe7\x16\x4d\xc6\xd5\x8f\xed\x7e\x17\x76\x01\x47\x76\xd5\xd4\x86\x60\xb5\xca\x6d\xb1\xb8\xf1\x72\x87\x8a\xe2\x84\x12\x3a\x39\x1e\x6d\xc0\xac\x73\x39\x47\x67\x82\x2c\x78\x5b\x0e\x4c\xb4\xff\x10\xdc\x7a\x99\xa3\x8d\xea\x21\x8c\x3d\x8b\x4e\x51\x81\x28\x43\xb9\xd8\x2a\xa6\x83\x4c\xfe\xea\xd7\xfe\x82\x94\xcc\x64\x50\x3d\x06\xd4\x10\x25\x2f\x6c\xb1\x28\x2c\x1f\x88\xc8\x1f\xb2\xe2\x13\xf6\x1e\x18\x0d\xa6\x0f\xed\x9e\x8c\x3c\x17\x5d\xf5\x5c\x6c\x1c\x8a\xb7\x38\x85\xbc\x69\x50\x96\xc9\x70\x75\xfc\x7a\x1c\x56\x4f\xa1\x90\xcf\x79\xe6\x23\x7d\x11\x97\xd5\x3e\x2a\x07\x25\xc6\x49\x5c\x5b\xdd\x16\x96\xe3\x21\xde\xf3\xc1\xe9\xfe\xaa\xec\x93\xa8\x6b\xa2\x11\x74\x1d\xd1\xd6\xa5\x93\x3d\x3f\x9b\x63\x74\x39\xfe\x58\xce\x71\x93\x5a\x72\x68\x9e\x4a\x2b\xee\x04\x71\x75\x61\x28\xb3\x35\xca\x84\xf5\x52\xf8\xd1\x57\xd2\x0d\xe4\x99\x1e\x25\x1c\xc1\x88\x1c\x8d\xc8\xed\x88\xde\x31\x33\x02\xab\x5b\x84\xd1\x3f\x51\xab\x11\x8c\xa4\xa8\x3d\xd6\x49\xb9\xa7\xd0\x36\x35\x4a\xac\x90\xad\x64\x54\x95\xd6\x93\x63\xdf\x55\x94\xd4\x91\x90\x40\xdb\x94\xb9\xc5\x8c\xf1\xef\xb8\xb7\x5b\xd5\x03\xec\x31\xfb\xdb\x77\x79\xf9\xf3\xc6\xe5\x09\x1c\x55\x0c\xa8\x23\xcc\x2e\x95\x46\x31\x97\x3f\xe3\x63\xd8\x1f\xdc\xc4\xf2\xe9\xab\x58\x3a\xbc\x1c\xbe\x11\x4e\xd1\xed\xe9\xdd\x7e\x4c\x0e\x7c\xee\x22\x76\x10\xc9\x8b\x63\xa0\x53\xa7\xfb\xb7\xb9\xfd\x4e\xb0\x46\xec\xba\x2d\x2f\xf1\x7c\xe3\xf5\x75\xd6\xd6\xcb\xff\x43\xf7\x15\x4f\x26\x40\x6d\x92\x7f\x8b\x0c\x97\xd0\xe1\x83\x42\x85\x53\x58\x81\x26\x74\x92\x65\x6e\xf3\x59\x6e\x30\x7b\xed\x2b\xf7\x4c\x17\x76\x7b\xf7\x64\x1f\x46\x09\xf2\xe5\x77\x89\x24\x78\xa0\xae\x8f\x19\xcf\xbb\x2f\x9d\xf7\x6d\xd2\x34\x8e\x7a\x8e\x04\x2b\xdb\xee\x5e\x52\x67\x56\x29\x3d\xb4\xf0\xab\x5b\x7a\x59\xb7\x52\x1a\x04\xe7\x9d\x39\xfc\x94\x28\x73\x90\x32\x99\x08\x10\xd2\x8e\x5d\xa7\xbe\x97\x2a\x66\xea\xe0\xda\x9f\x32\x77\x2b\xee\x86\xd4\xc9\xfe\x9a\x9b\x0b\xac\xf2\xb6\xb6\x81\x1e\x5e\x32\x2b\xdd\xb2\x49\xd2\x7d\x94\x53\x8b\xb1\x6a\x2d\xf8\xc3\xc2\xd4\x7d\xc2\x4b\x8a\x93\x83\x3d\x70\x9f\x63\x58\x41\xa8\xe5\x29\x24\xbf\xb9\xca\xbf\xb9\xd1\x28\x8a\x42\x95\x0a\x0f\xc2\x2a\xf3\x5d\x4f\xd0\xf3\x37\xc3\x21\x51\x8d\xfb\x21\x3c\x05\xdb\x5d\x6d\xb5\xb2\x19\xb7\xc2\x55\x32\x6a\x25\x3e\x34\x58\x58\x2c\xa1\x2f\x82\xd4\x8a\xc2\xef\x6e\x46\x63\x58\x39\x53\x5d\x30\xe8\xbb\xf5\x90\x84\x62\x81\xc5\x32\xd9\xef\xc2\xa3\x03\x5d\x74\x6f\x26\x28\xf7\xee\xa6\xbd\x67\xde\x77\xd5\x43\xdc\x8d\x81\x41\x7c\x2b\xee\x60\xe0\x71\x6b\x7e\xf0\xb9\x26\xef\x9c\xa8\x10\xa6\x80\x3f\x33\xc0\x02\x00\xd3\x93\xb7\x21\xae\xaf\x1c\x4e\xf0\xa9\xe8\xca\x7f\xff\xf6\xce\x65\x10\x13\x42\xcf\xfe\xcc\xb1\x41\x07\x89\x86\x60\x7d\x6a\x5c\x23\xee\xad\x4f\x26\x70\x25\xef\xd5\x92\xdb\x7a\xc8\x0b\xdb\xe6\x35\xa8\x06\xb5\x3b\xa9\x72\x35\x80\x3b\x26\xbb\xc9\xb7\x2f\x0d\xc5\x22\x17\x32\x73\x86\x7c\xae\x07\x83\xd1\x4f\xd4\x47\x39\xf2\x3e\x3f\x19\xbd\x39\xa4\xc2\x0f\x3b\xbf\x46\x67\x2e\xad\xdd\xc1\x5b\xfb\x0f\xe6\xa7\x68\x77\x86\xda\xdc\xb4\xff\xd7\x6d\x81\x37\x2b\x95\xa4\xbe\x88\xde\xc4\x21\xac\x5e\x0b\x9f\xff\x76\x14\x8b\xfe\xe7\xd3\x58\xe0\x7f\x3f\x90\x45\x2f\x4d\x44\xd1\xa6\x03\xb9\x15\x77\x5b\x93\xd9\x5e\x31\xe9\xe7\xb3\xc0\x86\x83\x23\xda\x80\x37\xcf\x0d\x6a\xaf\x89\xac\x3b\x18\xc5\xce\xd7\x70\x3f\x3d\x57\x69\x74\xeb\xbb\xb9\xbe\x78\x13\x09\x03\x75\x17\x4a\x2d\x4d\x0a\x27\xf0\xf6\x3d\x08\xf8\x71\x0a\xa7\xef\x41\x9c\x9c\x6c\x6a\xdb\x80\xe6\x2c\x7b\x2b\xee\x88\xc1\x69\x98\x21\xa3\x0d\x65\xef\x1c\x81\xa9\x4f\x48\xc4\x18\x0a\xfb\x90\xf2\x9c\x2e\xaa\x6d\xde\xf7\x6d\x1a\x4d\xc1\xe3\x50\xbe\x7a\x3b\xa7\x3d\xf1\x0f\x32\xaa\xe7\xfd\xe9\x80\xf5\xfb\xb4\xd9\xc7\x6a\xc7\xc1\x0c\x73\xd4\xcf\xb6\xbe\x61\xf8\xe2\x7e\x9c\x5a\x22\x7f\x1b\xc3\xac\xb5\xd0\xe4\x52\x14\xc6\x81\xdb\xd5\x33\x50\x45\xd1\x6a\xf3\x6f\xf5\x0a\x5f\x0e\x37\x0b\x3b\x8f\x37\x4f\xc2\x7d\x42\x76\x0f\x1e\x7a\x8e\xcd\x4f\x34\x83\xf3\x72\x98\x5c\x04\x86\xa7\xbc\x8f\xbb\x41\x37\xf6\xaf\x00\x00\x00\xff\xff\x1f\xa2\xad\x55\xca\x13\x00\x00")
+var _templateDialectSqlCreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x59\x7b\x6f\xdb\xc8\x11\xff\x9b\xfa\x14\x73\x82\x7a\x20\x5d\x99\x72\x82\xa2\x40\x9d\xea\x80\x9c\x15\xf7\x84\xcb\xe5\x1a\xd8\x69\x0f\x35\x8c\x60\x45\x0e\xa5\x85\xa8\x5d\x66\x77\xe9\x07\x04\x7e\xf7\x62\xf6\x41\x51\x0f\xbf\x72\x97\xa2\x7f\x99\x22\x77\x67\x7e\x3b\xf3\x9b\x99\x9d\xf1\x7a\x3d\x3a\xea\x9d\xc9\xea\x5e\xf1\xf9\xc2\xc0\xeb\x93\x57\x7f\x3b\xae\x14\x6a\x14\x06\xce\x59\x86\x33\x29\x97\x30\x15\x59\x0a\x6f\xcb\x12\xec\x22\x0d\xf4\x5d\xdd\x60\x9e\xf6\x2e\x17\x5c\x83\x96\xb5\xca\x10\x32\x99\x23\x70\x0d\x25\xcf\x50\x68\xcc\xa1\x16\x39\x2a\x30\x0b\x84\xb7\x15\xcb\x16\x08\xaf\xd3\x93\xf0\x15\x0a\x59\x8b\xbc\xc7\x85\xfd\xfe\x7e\x7a\xf6\xee\xc3\xc5\x3b\x28\x78\x89\xe0\xdf\x29\x29\x0d\xe4\x5c\x61\x66\xa4\xba\x07\x59\x80\xe9\x28\x33\x0a\x31\xed\x1d\x8d\x9a\xa6\xd7\x5b\xaf\x21\xc7\x82\x0b\x84\x7e\xce\x59\x89\x99\x19\xe9\x2f\xe5\x28\x53\xc8\x0c\x8e\x0a\x8e\x65\xae\xfb\xd0\x34\xbd\x68\xbd\x3e\x86\x5b\x6e\x16\x30\x30\xab\xaa\xd4\x70\x3a\x86\x15\x33\xd9\xe2\x12\x57\x55\xc9\xcc\x63\x02\x46\x2c\xcf\xb9\xe1\x52\xb0\x72\x74\xe4\xa4\x59\x71\x8a\x89\x39\x3a\x79\x24\xce\x0b\xb6\x9f\xed\xf7\x3b\x13\x64\xdb\x4f\x30\xd8\x6c\x45\x91\xb7\xa8\xfc\x73\xe7\xf1\xf1\x63\x59\x04\xeb\x35\x0c\x66\x35\x2f\xc9\xcc\xa7\x63\xa8\x98\xce\x58\x09\x83\xf4\x22\x93\x15\xa6\x3f\xfa\x2f\x7e\xa1\xc2\x0c\xf9\x8d\x5b\xd9\x3e\x0f\x66\xdb\x8b\x56\xb5\x61\x74\x48\x5a\x54\x29\x2e\x4c\x67\x5f\x3f\x0d\x5f\xfb\x10\xb0\x8e\x8e\xe0\x17\xff\x52\x5b\x17\xdd\x57\xa8\x9d\x89\x17\x5c\x5b\xc7\xad\xd8\x3d\xcc\x10\xf0\x0e\xb3\xda\x60\x1e\xdc\x6b\x14\x13\x9a\x65\x24\xce\x6e\x5c\x20\x57\xed\x9e\x85\x94\xcb\x14\xac\x77\x09\x55\xae\x02\xf0\x7d\x4c\xee\x1b\x99\x63\xbd\x06\x5e\xc0\x20\xfd\x89\xe9\xcb\xbb\x9f\x88\xb7\x4d\xd3\xd9\xdd\x6e\x6e\xcf\xb8\xb3\x39\xd8\xbd\xa8\x45\x06\xf1\x96\xc9\x9a\x06\x8e\xba\xc6\x6e\x9a\x04\xf4\x97\xf2\x82\xdd\x60\x9c\x99\x3b\xc8\xa4\x30\x78\x67\xd2\x33\xf7\x37\x81\xd8\x2e\x4f\x3f\xb0\x15\x42\xd3\x0c\x01\x95\x92\x2a\x81\x75\x2f\xfa\x2c\x64\x8e\x43\xf8\xac\x2b\xcc\xe8\x44\x3b\x7a\x52\xe7\xde\x8b\x0a\xb3\x38\xe9\x45\xbc\xa0\xad\xb4\x4e\x7f\x29\xe7\x8a\x55\x8b\xf4\xcc\x2e\xf8\x20\x73\xab\x7a\x08\x9d\x23\x92\x26\x2b\x38\x79\x63\xb7\x7d\x37\x06\xc1\x4b\xd2\x4a\x82\x32\x54\x6a\x08\x72\x49\xd2\xb8\xbe\xf8\xf8\xfe\x4c\x0a\x6d\x14\xe3\xc2\xbc\x23\x78\x31\x2a\x95\xbc\xa1\x05\xb4\x21\x22\x01\x63\xbb\xa9\x17\x45\x44\x59\x85\xa6\x56\x82\x24\xda\xf3\xf4\x22\x4f\x5d\x5e\x80\x90\xc6\x19\xfe\x57\x81\xe7\x14\x6f\xd3\xc9\x86\xe6\xa3\x23\x38\x93\xab\x4a\x6a\x6e\x10\x78\x8e\xc2\xf0\x82\xa3\xd2\xc0\x14\x02\x2b\x6f\xd9\xbd\x06\x5d\x57\x55\xc9\x31\x87\xd9\xbd\x0d\xfe\x5a\xa3\x22\x02\xc0\x71\x1b\x20\xa5\x46\xf2\x2f\x13\x39\x0c\xd2\xe9\x24\xfd\xa4\x51\x4d\x6c\xd8\xe7\x10\x4b\xe5\x5e\x4e\xf5\x85\x51\x5c\xcc\xc3\xaf\x4f\x9f\xa6\x93\x64\x0b\xca\x44\x12\xda\x05\x17\xf3\x21\xcc\x30\x63\xb5\x46\xd2\xa8\x11\x5e\x7b\xf6\xae\x6a\x6d\x88\xb1\xcf\xc4\xd4\x0a\xe7\xc5\x3e\x30\xfb\x91\x8c\x6f\xdd\x9e\x4e\x27\x30\x1e\xc3\x89\xf5\x48\x27\xd4\x5b\x70\x97\x0b\x84\xe9\x84\x92\x28\x59\xd4\x19\xdc\x85\x4c\xc6\x1c\x4e\x50\xf2\x16\x6e\x99\x06\xbd\xe4\x55\xd5\x01\x57\x69\x54\x86\x88\x58\x94\x3c\x33\xe0\x22\xcb\xc7\x50\x07\xdf\x39\x32\x53\x2b\x7c\x27\xd8\xac\xc4\x1c\xfa\x94\x51\xdc\x5e\x9f\xd3\x2c\x58\xe2\x10\x1d\xe5\x5f\xac\xac\xb1\x4b\xa3\x2d\xd0\x11\xcf\x89\x4b\xdb\xab\xd3\x98\x0b\xf3\xd7\xbf\x24\xf4\x7d\x73\x68\xcb\x52\x92\x78\x79\x5f\x51\x44\xc4\x3c\x4f\x5e\x06\xab\xd9\xd5\xfe\xb8\xc9\x77\x97\x77\x9f\x3d\x91\x7d\x24\x0a\x5e\xf6\x9e\x1f\xf5\xdd\xf0\xdc\x8b\xf2\xa3\x9d\x20\xa5\x65\x36\xe8\x6f\x98\x82\xb8\xe7\x0d\x02\x63\xf8\xbe\xbb\x6f\x4d\x4e\xe3\xf3\xd3\xfd\x54\x60\xdf\xd3\x49\xac\x89\x69\xdf\x01\x05\xc4\xa5\xe8\x92\xec\xe6\x24\xa4\xff\x64\xd9\x92\xcd\x49\x72\x6a\x5f\x0f\x43\x29\xb2\x69\x99\x02\xc3\xdb\x28\x9a\x4e\x4e\x3b\x22\x6d\xe0\xb6\x12\xa3\x88\x5c\x75\x0a\xb6\xfa\xa5\xeb\x35\xa4\xf4\x9b\x32\x9c\x36\xe1\xc0\x24\x38\x8a\xce\x64\x59\xaf\xc4\xbe\x72\xda\x63\x97\x33\x61\xda\xd5\x4d\x8b\x26\x78\x83\xd2\x48\xd2\x0b\x11\xf0\xb6\x2c\xe5\x2d\xb8\x14\x2d\xe6\x96\xf1\x07\xce\x4c\xac\xa7\x1b\x0a\xde\x19\x14\xda\x15\x1f\x65\x03\x14\x42\xb9\xd5\xa9\xa3\xfe\x57\x95\x7c\x32\xf7\x37\xac\xf2\x7b\xde\x70\xa5\x6b\x9f\xc6\xbc\x00\x9e\x87\x9c\xbd\x55\x9f\xbd\x7d\x43\xf1\xfd\x07\x92\x89\xe3\x4e\xfa\xee\xc4\x1e\xcf\xc9\xe6\xdb\x61\x1a\x5e\x6f\x01\xdb\x14\xc1\xee\xa1\x0b\x52\x3e\x68\x55\x59\x9a\xf8\xb3\xf3\x02\x6e\x48\xda\x23\x10\x07\xc5\x63\x20\x2d\x26\x2f\x71\x0c\xac\xaa\x50\xe4\x71\xf7\xed\xf0\x61\x82\xee\xf0\x73\x50\x3c\xc4\x50\x9b\xc5\x4e\x3d\xd2\xde\x13\x94\x1d\x14\x7b\xa4\x6d\x3a\xc9\xcc\x29\xba\x30\xaa\xce\x8c\x45\x08\x4d\xe3\xd2\x1b\xe5\xa2\x22\xfd\xc0\xcb\x92\x82\x0e\x9a\xe6\xfb\xd6\x9c\x56\xf3\xae\xb1\xb7\x6c\x8c\xce\xc6\xef\xf2\x39\xea\x7f\x73\xb3\x08\x31\x6a\x6b\x6b\x8e\xfa\x21\xe3\xe2\x0e\x94\xe9\x44\x13\x09\x4a\x14\x31\xc1\xd5\x09\xfc\xe0\xcb\xcd\x86\x73\x36\x68\x72\x18\x40\x9f\xd4\xf5\x61\x80\xd0\xa7\xeb\x84\xee\x83\x51\x35\x42\xff\x3f\xa8\x64\x1f\xfa\x82\x97\x21\xf9\x46\xeb\x35\x98\x83\x01\x93\x63\x81\x56\x4a\x6a\xf9\x33\x3a\xf2\x77\x56\x5b\xb4\x28\xa2\xea\x2a\x67\x06\x53\x1b\x15\xbe\x18\x6d\x19\x61\x93\xc8\x31\xfd\xf5\x56\x9c\xff\xbc\x51\x79\x0c\x83\xc2\xde\x56\x06\x98\x9e\x4b\x85\x7c\x2e\x7e\xc6\xfb\xf0\xbd\xe3\x8f\xe5\xc3\x0e\x59\x3a\xd6\x1c\xf6\x0b\x49\xd0\x57\x27\xd7\xfb\x98\x1c\x05\xc9\x3e\x7b\xbc\xb4\x2f\x87\x40\xa7\x4e\xf6\x7d\xba\x5d\x5b\xac\x18\xaa\x2e\x5b\xd9\x8d\x3a\x09\x31\x87\x15\x9a\x85\xcc\x35\x18\x69\xd3\x9c\x4b\x3d\xc7\xa1\xd8\x3c\x3b\xc3\x7d\x55\x82\xdb\x6f\x66\x3a\x64\x3c\x94\xe5\x1e\x4e\x72\x9d\xd3\x77\x1e\x7b\xad\x91\x9f\xe8\xcf\x3e\xcf\xea\x72\xf9\xbb\x9b\xb4\xae\x94\xff\xb3\x4e\xcd\x42\xfb\x06\xed\x5a\x6f\x34\x02\x6a\x40\xfc\x95\x44\x5b\x12\x75\xef\x15\xc4\x1f\x6e\x38\xea\xd0\x72\xe5\xcc\xb0\x19\xd3\x98\x3e\xf7\xb2\xf3\x48\x7f\x73\x75\xfd\x60\x87\x43\x9c\xf7\x5e\x5b\x62\x7c\x75\x7d\xe8\x56\x34\xb4\x29\x6a\x07\x40\xea\x75\xeb\x24\xe9\x45\x6d\xda\x0b\x52\xb6\xd5\x3d\xb5\xdd\x56\x21\xa9\xba\x12\x6c\x19\x92\xea\xe9\xbd\x85\x54\xc0\x69\xa3\x63\xcc\x43\x4b\x6d\x5a\x25\x4b\xc6\x1c\xb8\x30\x43\x37\xb1\xd8\x33\x15\xad\x8a\xfc\x9e\x90\xc6\x0f\x89\xbb\xe2\x6d\x1e\x0a\x6d\xec\x04\x0b\x56\x97\xc6\x73\x34\x08\x49\x73\xf7\x5a\xc7\xc9\x7e\xe2\xa2\x9b\xe6\xaa\x36\xe0\x0f\x0b\x63\xf7\x84\xe7\x84\xd3\x82\x3d\xe0\xcf\x21\xac\xda\x36\x3e\x81\xd8\xd6\xcb\xae\x47\xa3\x28\x0a\x85\x27\x54\xfa\x55\xea\x2f\xbf\x61\x9f\xf7\x8c\x85\x44\x3d\xc4\x77\xa1\xc6\x6f\x37\x8e\xc5\xca\xa4\xb6\xdb\x2c\xe2\x7e\x2d\xf0\xae\xc2\x8c\x86\x02\x6d\x5d\xa3\x16\x0c\xfe\x74\xd9\x1f\xc2\xca\x89\x6a\x82\x40\xdf\x07\x07\x23\x64\x0b\xcc\x96\xf1\x7e\xa3\xbb\xa3\xcf\x36\xaa\xad\x98\xb0\xb9\x55\x37\x6e\x35\xdb\xef\x96\x74\x57\xfc\x7a\x08\x96\xc4\x57\xfc\x1a\x3a\x1a\x5b\xf6\x3a\xc3\x5b\x5b\x93\x76\x6b\xa8\x00\x93\xc3\xdf\x2d\xc1\x02\x01\x93\xe3\x57\x01\x57\xd7\xb5\xed\x84\xc2\x7d\x8a\x46\x23\xdb\xfc\x09\xbc\x33\x2d\x24\xd7\x21\x3f\x3d\x38\x71\xe3\x12\x2f\xe8\x61\x7a\xfd\xf9\xd5\x75\x7b\xf0\xb4\x1d\x8a\xec\xbc\xd9\x20\xdd\x70\x2a\x8a\x3e\x5b\x36\x84\xd5\x32\x48\xb3\xbe\xc7\x98\x78\x3f\x84\xe7\x29\xf6\x4e\x75\xad\xb3\xb7\x4b\x18\x85\x6c\x6e\x7a\x3f\x52\x01\xdb\x64\x8b\xb5\xbd\x9e\x9c\x3a\xa7\x78\x48\xcf\xe8\x17\x76\xa4\xbc\xa0\x69\x08\x0a\xbe\xae\x0c\x6d\x75\x0f\x51\xf4\x74\xf5\x89\x1e\xaf\x40\x87\x3c\xb2\xfb\x7b\x34\x82\xa9\xb8\x91\x4b\x37\x24\x60\x99\xa9\x59\x09\xb2\x42\x65\xdd\x0d\x14\x59\x0b\x04\xc2\xad\x37\x04\x0b\x94\xca\x16\x8c\x8b\xb4\x17\x75\x22\x6d\x7c\xd0\x8c\xed\xc4\x69\x8f\xc6\x41\x24\x95\x7d\x72\x6d\xd3\xec\xf0\xa1\xbd\x0f\x78\xa2\x0d\xe1\xf0\xa0\x2a\x8a\xbe\x66\x58\x15\xed\x0e\xac\x36\xa9\xc3\xff\x69\xb6\xd2\x58\x9a\x4b\x81\x30\xb6\x17\xde\x6e\x82\x79\x6e\x22\x79\x6a\xee\x15\xfd\x51\xa3\x2f\x2f\xe8\x77\x4f\xbf\xa2\x3f\x7c\x00\xd6\x45\xd6\x55\xf1\xf0\x4c\x26\xda\xf4\x33\x57\xfc\x7a\x6b\x18\x76\x80\xd1\x1e\xee\x74\xe2\xac\x45\x03\x31\x6f\xb1\xbd\xb9\x98\x2c\xc2\xfc\x2b\xc6\x74\x9e\xc2\x2f\xf7\x17\x1f\xdf\x5b\x5b\x5d\x7c\x7c\xcf\x0d\x26\xed\x1c\xcc\xcb\x7d\xe6\xcc\xc9\x01\x0e\xa5\x60\xd3\x3b\x6f\xb3\x64\x17\xb8\x1f\x8b\xed\x6d\xeb\x4e\xc6\x3a\xb5\xe6\xb1\xf9\xd8\x8b\xe1\x36\x87\x21\x3d\xe9\x97\xc3\xfb\x76\x7e\x86\x70\xf0\xc0\x87\x64\x84\x4d\x7f\xdc\xde\x9a\xa8\xfa\xf9\xe4\x9f\x52\x65\xd2\x09\x1c\xc3\xab\x37\xc0\xe1\x87\x31\x9c\xbc\x01\x7e\x7c\xec\x4d\x47\x17\x95\x4d\x7d\xb5\x6b\xaf\xf8\x75\xbc\xaa\x4d\x12\x66\x78\xed\x45\xce\xd5\xe2\x55\x6d\xa8\xe7\x8a\xf9\x10\x32\x73\x97\xd8\x19\x34\x2f\xb6\x0b\x6e\xdb\xf2\xd2\x24\x73\x18\xe6\xe7\xad\x9c\x93\xb6\x6e\xb5\xe3\xf3\x83\x65\xeb\xa4\x53\xb4\xf6\xb3\xd4\x7e\x6a\x68\x2c\x98\xae\x8d\xda\xd9\xa2\xbf\xa9\xff\x46\x83\xdd\x92\x2f\xd1\xfe\x1a\xc2\xac\x36\x50\x31\xc1\x33\x4d\x1e\x66\x82\x94\x48\x05\x32\xcb\x6a\xa5\x5f\x74\x49\xff\xed\xf0\x2d\x7d\xe7\xd6\x4c\xc8\x6f\x5a\x83\xec\x1e\x3c\x5c\xf6\x37\xff\x75\xe8\x9c\xd7\xc2\xb4\xff\x20\xe8\x9e\xf2\xe6\xa5\xad\x6d\xb9\xfc\xdf\xf4\xb7\xae\x0e\x7f\xf3\x26\x77\xbd\x06\x14\x39\x34\x4d\xef\xbf\x03\x00\xef\x16\xfb\x91\x47\x1d\x00\x00")
 
 func templateDialectSqlCreateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -647,12 +682,12 @@ func templateDialectSqlCreateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/create.tmpl", size: 5066, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/create.tmpl", size: 7495, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlDecodeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x59\x6f\xdb\xb8\x16\x7e\xb6\x7e\xc5\xa9\xe0\x14\x96\xe1\xc8\xb9\xc5\xc5\x05\x6e\x3a\x1e\xa0\xe8\x02\x78\x3a\xc8\x14\x49\xd3\x97\x20\x0f\xac\x74\x68\xb3\x96\x28\x87\xa4\x1b\x07\x82\xfe\xfb\x80\x8b\x24\xd2\x4b\x96\x19\x14\xc8\x43\x44\x9e\xfd\x7c\xdf\x21\xe9\xba\x9e\x8e\xa3\xf7\xd5\xfa\x41\xb0\xc5\x52\xc1\x9b\xb3\xff\xfc\xff\x74\x2d\x50\x22\x57\xf0\x89\x64\xf8\xbd\xaa\x56\x30\xe7\x59\x0a\xef\x8a\x02\x8c\x90\x04\xbd\x2f\x7e\x62\x9e\x46\x5f\x97\x4c\x82\xac\x36\x22\x43\xc8\xaa\x1c\x81\x49\x28\x58\x86\x5c\x62\x0e\x1b\x9e\xa3\x00\xb5\x44\x78\xb7\x26\xd9\x12\xe1\x4d\x7a\xd6\xee\x02\xad\x36\x3c\x8f\x18\x37\xfb\x7f\xce\xdf\x7f\xbc\xb8\xfa\x08\x94\x15\x08\x6e\x4d\x54\x95\x82\x9c\x09\xcc\x54\x25\x1e\xa0\xa2\xa0\x3c\x67\x4a\x20\xa6\xd1\x78\xda\x34\x51\x54\xd7\x90\x23\x65\x1c\x21\xce\x19\x29\x30\x53\x53\x79\x57\x4c\x73\xd4\x11\x4d\x2b\x8e\x31\x34\x8d\x96\x1a\x0a\xcc\x90\xfd\x44\x01\xe7\x33\x18\xa6\x97\xed\x97\x33\x32\x64\x39\xdf\x14\x85\x7a\x58\xa3\x15\x98\x7f\x48\x2f\x36\x45\xf1\x55\x2f\x34\x4d\x5d\x03\xa3\xc0\x2b\x65\x77\xae\x25\x8a\x0f\xc6\x6d\x6e\x37\x7d\xf5\x19\xc4\xf2\xae\x30\xda\x73\xae\xfe\xf7\xdf\xd8\x8a\x20\xcf\xdb\x50\x32\x2d\x27\xb5\x9f\x9c\x65\x2a\x50\x1e\x15\x4c\x3a\x27\xef\x2b\x2e\x15\xe1\x2a\x71\x6a\x82\xf0\x05\xc2\x90\xda\xf8\x3e\x31\x2c\x72\xa9\xb7\x06\xda\x24\x27\xa5\xb5\x68\xf4\xdd\x2a\xa3\xb0\x24\xf2\x33\x3e\x74\x2e\x87\xd4\xcf\x2a\x1a\x78\xba\x33\x58\xa0\x3a\x2a\xd8\x27\x10\xa8\x90\xf5\x5a\x2f\xbb\xef\x21\xed\x82\xee\x24\x9d\xbd\x19\xc8\x23\xd6\x9d\xae\xcd\xd1\xf9\x88\xa6\x53\x90\x19\xe1\xdf\x48\xb1\x41\x8d\x39\xb5\x11\x5c\x1a\x68\x58\x03\xb4\x12\x46\x80\x33\xbe\x80\x9f\x56\x8a\x8a\xaa\x04\x5d\xf9\xcb\xea\x5e\xa6\x11\xdd\xf0\x0c\x46\x63\x1d\x43\x7a\x41\x4a\x9d\x46\xe2\x19\x1d\x65\x55\xb1\x29\xb9\x84\x9b\x5b\xa9\x04\xe3\x8b\x04\x46\x37\xb7\x8c\x2b\x14\x94\x64\x58\x37\x13\x40\x21\x2a\x91\x40\x1d\x0d\x9c\x87\xf3\x19\x94\x64\x85\xbb\x72\x05\xf2\xd6\x5a\x92\x44\x03\x1d\x1b\xd3\xb2\xb6\x61\xad\x9f\x3a\x1a\x0c\xe4\x3d\x53\xd9\xb2\x5d\xba\x61\xb7\x66\x75\x50\xd7\xa7\x6d\x77\x75\x7a\x13\x18\xb6\x4a\xba\xd5\xae\x66\xa6\x5d\x83\x41\x46\x24\x42\x8f\x06\xa6\x85\xad\x98\x53\xe9\xc0\xaa\x77\xe1\x0c\x9a\x66\xd2\x15\xd6\x14\xe3\x0b\xc9\x56\x64\xa1\xeb\x91\x9a\x06\xf9\xf0\x3c\x37\x3e\x5c\xbe\x3a\xbe\x19\xbc\xd6\x42\xca\xd1\xa0\x69\xc3\x6d\xc1\x10\x04\xaf\x83\xa1\x2b\x8b\xcf\x6b\x8e\xdb\x75\x25\x14\xe6\x9f\x2a\x81\x6c\xc1\x3f\xe3\x43\x97\x84\xd6\x71\x40\xa6\x2b\x0b\xe5\xdd\xfc\x82\x38\x3d\x13\x37\x86\x6d\xd0\x34\xb7\xe7\x30\x9d\x1a\x49\xda\xf6\xf7\x70\xf0\x2d\x71\xe9\x0e\x6d\x03\x92\xea\x0a\x14\xd2\x51\x3d\x04\x7f\x5f\xbd\x03\xd9\xe7\x48\xc9\xa6\x50\xb6\x6e\x16\xa7\xc0\x59\x31\x01\x5a\xaa\xf4\xa3\x06\x10\x1d\xc5\x1b\x5d\x0b\xcc\x14\xe6\xae\xf5\x70\x72\x67\x20\x6c\xca\xea\x23\x34\x9e\x78\xe0\x48\xa2\xc1\xa0\x89\xf4\x9f\x33\x6c\x33\x9b\x68\x07\x91\x25\x09\x91\x92\x2d\x5a\x9a\xd8\x0f\x4b\x13\x87\x58\xb5\x24\x0a\xee\x51\xa0\xe3\x10\xe6\x21\x4d\x60\x44\xa8\xc2\x9e\x4b\x89\x36\xaa\x2a\x63\xc2\x8f\x0b\xa8\x19\x37\x2d\xab\x82\x79\xda\x34\xb0\xc3\x32\x3f\xaa\x3d\x9e\x4d\xda\xd8\x02\x16\x25\x96\x6d\x9a\x0f\x8c\x42\x39\x01\x6e\x86\x19\xf2\x91\x95\x4e\x42\x9a\xbd\x85\x12\x7e\x03\x6e\xe8\xe3\x8a\xe3\x17\xbc\x64\xb2\x24\x9a\x69\x7c\x53\x7e\x47\xa1\x0f\x10\x9d\xa1\xf3\x7c\x0e\x27\x39\xbc\x9a\xc1\x49\x1e\x4f\x8c\xab\xc4\x14\xd9\x80\x92\xe5\x5b\xed\x38\x66\x71\x47\x24\xbc\xb3\xcb\x7e\xc6\x76\xe4\x6f\xf5\xac\xff\x11\xce\x77\x43\xff\x76\xbb\x69\x9e\x35\x07\x7a\x71\x3b\x10\x0e\x72\xc0\x7c\x7b\xc7\x42\xcb\x56\x1d\x36\xa3\x40\xf4\x18\xde\x3d\x9b\x46\x95\xb0\x8b\x73\x79\x65\x8a\xdf\x7e\x5d\x5f\xcf\x3f\x24\x3e\x1b\xef\x99\x5a\x02\x6e\x95\x99\xe6\x10\xcf\xf3\x6d\x6c\x63\x8a\x0d\x3b\x63\xa3\x08\xf1\x25\x66\x71\x50\x08\x4b\xb9\xba\x06\x85\xe5\xba\x20\xea\xf0\x39\x4c\xad\x8d\xd4\xf7\x18\x4e\x10\x47\xbe\xa8\x23\xf0\x04\x2a\x33\x49\x1c\x9b\xbd\x12\xa5\xa3\x71\x40\xdd\xc4\x28\x31\x0a\xaf\xaa\x95\x9d\xa7\x07\x31\xe1\x91\xd0\xd0\xee\xe4\xab\xa1\xa0\x09\x0d\x98\x86\x82\x71\x65\xad\xb5\x61\x06\xb9\xea\x0a\xcc\xa0\xed\x83\x9b\x0e\x16\x9f\x69\x1f\x49\x90\x5b\x3f\x1c\x0f\x1d\xdc\x8f\x74\x9a\xee\xf5\xf9\x99\x6d\xa2\xbf\xa6\x49\x47\xb2\x7a\xf6\xc8\x3f\x3e\xf1\xdb\x12\x30\x6a\xb6\xf6\xaf\x56\x4f\x90\x20\x9c\xdc\x4f\x9e\x1a\x3b\x47\x9d\x63\x8f\xe6\xc9\x8e\xf3\x91\x61\x14\xdd\xe7\x13\xf5\xd8\x44\x5b\x2e\x25\x5e\x99\xff\x59\x9b\xe2\x2b\x25\x36\x99\xea\x04\x56\xa9\xb7\xd0\x59\x7f\x71\x17\x77\xb8\xb6\x47\x36\x4d\x9c\x97\x12\xee\xad\x4f\xb5\x17\x70\x0d\xf3\x05\x9e\x5a\xc2\xf5\xe7\xb6\x39\xf4\x3c\xea\x0d\x1a\x1b\x5f\x1b\x57\xfa\x8d\x14\x2c\xef\xbc\xed\x72\xd2\x18\xda\xad\x15\xcc\x80\xe3\xfd\xc8\x3a\x71\x44\x4d\x7a\x03\x06\x6a\xe9\x05\x2b\x0a\xf2\xbd\xd0\x7b\xe3\xe0\x86\xf4\x1c\xf3\x81\xe9\xbd\x19\xd0\x0f\x90\x63\x14\x0a\xce\x75\x7b\x9e\x7b\xd7\xdf\xc7\x5f\x35\xae\xc5\x4d\x77\x66\x59\xfa\x5d\x65\xd5\x1a\xd3\x79\xbe\x85\xd3\x6e\x8b\xfa\x5b\x36\xfc\x7e\x53\xa0\xf2\xb7\x2f\x31\xf3\x35\x8d\xb0\xe1\xeb\x4e\xf6\x75\x6d\xf1\xdd\xea\xed\xed\x3a\xdd\x99\x01\x61\xf0\x70\x38\x75\xa5\x9f\xcb\x3f\xae\xfe\xba\xb0\x95\x78\x0c\x7f\x0e\x7d\x7b\x57\x32\x1f\x81\x2f\x1d\xf5\xc1\x8d\xb1\x85\x9e\xe7\xcf\xdc\xba\x42\x08\xea\xcb\x02\x67\x05\xbc\x7e\x6d\x2e\x21\x63\x8b\x56\xf8\x1d\xce\x6c\x08\xfa\x92\x20\xcc\xc3\xf2\x87\xac\x78\x7a\xcd\x4b\x22\xe4\x92\x14\x4e\x72\x62\xef\xd0\xba\xdc\x2d\xa2\x5c\xb1\x92\xb7\x46\xd1\x99\xaf\xa3\xa3\x74\x72\x06\x0f\xa5\x70\x0e\x27\xf7\xb1\x79\xac\x18\xe8\x39\x70\x85\x34\x37\x1d\x0d\xde\xb7\x5e\x39\x4f\x5f\xd2\x86\xce\xc8\xaf\x6f\x82\x77\xb1\x19\xb9\x0b\xbc\x8e\x37\x35\xf7\xca\x2b\x7d\x61\x45\x91\xc0\x68\x49\xe4\x17\x81\x94\x6d\xbd\xe0\xf4\x03\x3c\x6e\x47\xf2\x63\xf3\xa4\x87\xa4\x37\x0d\x22\x6f\xd0\xec\xb5\xec\x91\xd1\x32\x3e\xae\x12\x22\xd8\x72\x25\x36\xe1\xc4\x47\x07\xf3\xbf\xb7\x16\x4c\x9e\xde\xf4\x11\x74\xd7\xd1\x93\x5e\xfb\xa7\xd4\xc1\xe1\x69\xcc\x1d\x98\x74\xde\xb7\xf7\xef\x13\x53\xae\x24\xfc\x21\x0e\x7f\x16\xf8\x3b\x00\x00\xff\xff\xdd\xe5\xe7\xfe\xb1\x12\x00\x00")
+var _templateDialectSqlDecodeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\xdd\x6e\xdb\xb8\x12\xbe\x96\x9e\x62\x2a\x28\x85\x65\x38\x72\x4e\x71\x70\x80\xe3\xae\x17\x28\x9a\x16\xeb\xed\x22\x2d\x92\xa6\x37\x41\x2e\x58\x69\x68\xb3\x96\x29\x87\xa4\x9b\x04\x82\xde\x7d\x31\x14\x25\x4b\xb2\x9d\x38\xbb\x28\xe0\x1b\x8a\xf3\x3f\xdf\x37\x24\x5d\x14\xe3\xa1\xff\x3e\x5f\x3f\x2a\x31\x5f\x18\x78\x73\xf6\x9f\xff\x9f\xae\x15\x6a\x94\x06\x3e\xb2\x04\xbf\xe7\xf9\x12\x66\x32\x89\xe1\x5d\x96\x81\x15\xd2\x40\xfb\xea\x27\xa6\xb1\xff\x75\x21\x34\xe8\x7c\xa3\x12\x84\x24\x4f\x11\x84\x86\x4c\x24\x28\x35\xa6\xb0\x91\x29\x2a\x30\x0b\x84\x77\x6b\x96\x2c\x10\xde\xc4\x67\xf5\x2e\xf0\x7c\x23\x53\x5f\x48\xbb\xff\xd7\xec\xfd\x87\x8b\xab\x0f\xc0\x45\x86\xe0\xbe\xa9\x3c\x37\x90\x0a\x85\x89\xc9\xd5\x23\xe4\x1c\x4c\xcb\x99\x51\x88\xb1\x3f\x1c\x97\xa5\xef\x17\x05\xa4\xc8\x85\x44\x08\x52\xc1\x32\x4c\xcc\x58\xdf\x65\xe3\x14\x29\xa2\x71\x2e\x31\x80\xb2\x24\xa9\x50\x61\x82\xe2\x27\x2a\x98\x4c\x21\x8c\x2f\xeb\x95\x33\x12\x26\xe6\x71\x8d\x9a\x36\x53\x91\x18\xa7\x74\x2f\xcc\x02\xc2\x78\x76\x4e\x6b\x8f\xc4\x44\x2a\x37\x59\x46\xb2\x24\x1a\x5f\x6c\xb2\xec\x2b\x2d\xca\xb2\x28\x40\x70\x90\xb9\x81\xf8\x5a\xa3\x3a\xb7\x51\xa5\x50\x96\x3d\xb5\x29\x04\xfa\x2e\xb3\x9a\x33\x69\xfe\xf7\x5f\x0a\xb0\x28\x00\x65\xda\x38\x71\xb1\xb8\x50\xda\xca\x83\x4c\x68\x03\xf1\xfb\x5c\x6a\xc3\xa4\x89\x5c\x9c\x4e\xb9\x28\x40\x31\x39\x47\x08\x39\x45\x17\xc6\x1f\x05\x66\xa9\x6e\xec\x4a\xb6\xaa\x52\xb4\x56\xdc\x57\xc1\x61\xc1\xf4\x27\x7c\x6c\xfc\x86\xbc\x9d\x97\xef\xb5\x74\xa7\x30\x47\x73\x50\xb0\x97\x46\xad\xc2\xd6\x6b\x0a\xd0\x99\x08\x79\x13\x7e\x23\xe9\xec\x4d\x41\x1f\xb0\xee\x74\x3b\xd9\xfa\xe3\x31\xe8\x84\xc9\x6f\x2c\xdb\x20\x01\xd3\x6c\x94\xd4\x16\x3f\x95\x39\x9e\x2b\x2b\x20\x85\x9c\xc3\xcf\x4a\x8a\xab\x7c\x05\x54\xff\xcb\xfc\x5e\xc7\x3e\xdf\xc8\x04\x06\x43\x4a\x30\xbe\x60\x2b\xca\x37\x6a\x19\x1d\x24\x79\xb6\x59\x49\x0d\x37\xb7\xda\x28\x21\xe7\x11\x0c\x6e\x6e\x85\x34\xa8\x38\x4b\xb0\x28\x47\x80\x4a\xe5\x2a\x82\xc2\xf7\x9c\x87\xc9\x14\x56\x6c\x89\x7d\xb9\x0c\x65\x6d\x2d\x8a\x7c\x8f\x62\x13\xd4\x8a\xaa\x61\xb5\x9f\xc2\xf7\x3c\x7d\x2f\x4c\xb2\xa8\x3f\xdd\x88\x5b\x32\x4e\x4d\x38\xad\xbb\x4b\xe9\x8d\x20\xac\x95\x26\xd3\xa6\x66\x54\x50\xcf\xf3\x12\xa6\x11\xb6\x68\x10\x24\x4c\xce\x1a\x95\x06\xae\x08\xa1\x80\x33\x28\xcb\x51\x53\x58\x5b\x8c\x2f\x2c\x59\xb2\x39\xd5\x23\xa6\x75\xd2\x06\xe9\xc4\xfa\x70\xf9\x52\x7c\x53\x78\x4d\x42\xc6\x11\xa1\xac\xc3\xad\xc1\xd0\x09\x9e\x82\xe1\x4b\x1b\x4d\x7c\x2d\xf1\x61\x9d\x2b\x83\xe9\xc7\x5c\xa1\x98\xcb\x4f\xf8\xd8\x24\x41\x3a\x0e\xc8\x7c\x59\x41\xb9\x9f\x5f\x27\xce\x96\x89\x1b\x0a\x47\x40\x59\xde\x4e\x60\x3c\xb6\x92\xbc\xee\xef\xfe\xe0\x1d\x75\x43\xde\x23\x6f\x87\xaa\x54\xa2\x4c\x3b\xb2\x77\xc1\xbf\xad\xde\x9e\xec\x53\xe4\x6c\x93\x99\xaa\x6e\x15\x4e\x41\x8a\x6c\x04\x7c\x65\xe2\x0f\x04\x20\x3e\x08\x36\x54\x0b\x4c\x0c\xa6\xae\xf5\x70\x72\x07\x04\x13\x5b\xd6\x36\x42\x83\x51\x0b\x1c\x91\xef\x79\xa5\x4f\x3f\x67\xb8\xca\x6c\x44\x0e\xfc\x8a\x24\x4c\x6b\x31\xaf\x69\x52\x2d\x2a\x9a\x38\xc4\x9a\x05\x33\x70\x8f\x0a\x1d\x87\x30\xed\xd2\x04\x06\x8c\x1b\xdc\x72\x29\x22\xa3\x26\xb7\x26\xda\x71\x01\xa7\x1e\x35\xac\xea\x0c\xdd\xb2\x84\x1e\xcb\xda\x51\xed\xf0\x6c\x54\xc7\xd6\x61\x51\x54\xb1\x8d\xf8\x20\x38\xac\x46\x20\x09\x1d\xc4\xac\x4a\x3a\xea\xd2\xec\x2d\xac\xe0\x37\x90\x24\x5e\x17\xa7\x5d\xf0\x95\xd0\x2b\x46\x4c\x93\x9b\xd5\x77\x54\x74\xca\x50\x86\xce\xf3\x04\x4e\x52\x78\x35\x85\x93\x34\x18\x59\x57\x91\x2d\xb2\x05\xa5\x48\x1f\xc8\x71\x20\xdc\xec\x16\x1c\xf0\x8e\x86\xfc\x43\x27\x63\xca\x97\xbe\x4d\x21\xf8\xd1\x9b\xf2\xd4\xd7\x7a\xbb\x2c\x8f\x9a\x03\x5b\xf1\x6a\x20\x50\x24\x82\x43\x18\xff\xc1\xf4\x67\x89\x96\x1f\xee\xa0\x3a\x40\x10\xb2\x10\xcf\xce\xdb\xe3\x77\xd2\x30\x4d\x70\x60\x34\xa4\x49\xa0\x4d\x81\x41\xae\xaa\x8f\x33\x7d\x65\x5b\x53\xaf\xae\xaf\x67\xe7\x91\x73\x57\x99\xb0\xa7\x25\x3e\x18\xa2\x7d\x08\xc1\x2c\x7d\x08\xaa\x90\x03\x1b\x5c\x60\x35\x21\xb8\xc4\x24\xe8\xd4\xa9\xb2\x40\x07\x81\xc1\xd5\x3a\x63\x66\xff\x61\x6e\xd1\x15\x40\xdc\xf1\xb9\x25\x59\xb5\xcc\x74\x8f\xe3\x23\xc8\xed\xb0\x71\x84\x6f\x55\x31\x1e\x0c\x3b\xec\x26\x26\x79\x9e\x27\x38\xbc\xca\x97\xb6\xc4\x9e\xb7\x17\x38\x2d\xa6\x5a\x6e\x9e\x7c\xb5\x3c\xb5\xf1\x81\x48\x03\x07\x5e\x67\xaf\x09\xb6\x93\x33\x55\x62\x0a\x75\x47\xdc\x10\x19\x58\xbd\xb8\x15\x4d\x27\xc5\x9d\x45\x85\x99\x7d\xa7\xfd\x13\x08\xe0\xfb\xfb\xff\x7c\xf3\xf8\xc1\xd6\xbd\xb4\x73\xc7\x64\x75\xf4\x39\x71\xf8\x98\xa8\x4b\x40\x2c\xe1\xcb\xde\x60\x3f\x82\x1c\xdd\x71\xff\xec\x51\xd3\xd0\xbb\x43\xaa\x5c\xed\x38\x1f\x58\xa2\x75\x4f\x1a\x4b\x33\xde\x22\x19\xaf\x29\xf6\x52\x8e\xed\xb6\x29\xb8\x32\x6a\x93\x98\x46\x60\x19\xb7\x3e\xfc\x42\xfe\x09\x0e\x2f\xa5\xe0\xdb\x7f\xc8\x3d\x4c\xe7\x78\x6a\x03\x6c\x1d\xf6\x65\xd9\xa7\x62\xd5\xcf\x3a\xae\xf8\x1b\xcb\x44\xda\x78\xeb\x33\x94\xd6\x3b\xb5\x82\x29\x48\xbc\x1f\xd8\xbd\x9a\xb6\xce\xba\xbb\x4c\x93\x73\x91\x65\xec\x7b\x46\xc8\x19\x36\xa8\x38\xd6\x7c\xc7\xf4\xee\x44\xf0\x9e\xa3\x50\xe7\x32\x50\x5d\x02\x9a\x18\x9e\x7b\x2f\xb9\x16\x97\xcd\x41\x47\x6d\x0b\xe3\xab\x24\x5f\x63\x3c\x4b\x1f\xe0\xb4\xd9\x72\x33\xa7\xda\xaa\xaa\xb3\xdd\x54\x68\xda\xdb\x97\x98\xb4\x35\xad\x30\x6d\xf3\x5e\xf6\xdb\x17\x57\xa5\xb7\xb3\xeb\x74\xa7\x16\x84\xdb\xac\x6a\xae\x59\xd2\xfc\x79\xf5\xf9\xc2\x7e\x7c\x12\x7f\x0e\x7d\x3b\xf7\xb8\x36\x02\x8f\x87\x5f\x1f\x79\xb0\x85\x5e\xcb\x5f\xe4\xef\x40\x90\x6e\x18\x52\x64\xf0\xfa\xb5\xbd\xb9\x0c\xed\xc7\x08\x7e\x87\xb3\x0a\x96\x74\xb3\x50\xf6\xc9\xfa\x43\xe7\x32\xbe\x96\x2b\xa6\xf4\x82\x65\x83\xa1\xcb\x8c\xee\xae\xb6\xdc\x35\xa2\x5c\xb1\xa2\xb7\x56\xd1\x99\x2f\x7c\xef\x50\x3e\xce\xe0\xbe\x14\x26\x70\x72\x1f\xd8\x17\x0e\x45\xee\x39\x70\x75\x69\x4e\xab\xb0\x79\x9e\x4e\xa6\x9d\x72\x9e\xbe\xa4\x0d\x8d\x91\x5f\xdf\x04\x07\x17\x1a\xc3\x03\x7a\xb0\x3b\xbe\xd1\x3c\xd8\xe0\x15\xbd\x18\x51\x45\x30\x58\x30\xfd\x45\x21\x17\x0f\xad\xe0\xe8\xed\x1e\xd4\x23\xf9\xa9\x79\xb2\x85\x64\x6b\x1a\xd4\x73\x73\x6f\xcb\x9e\x18\x2d\xc3\xc3\x2a\x45\xd1\x2e\x79\xc5\x95\xc0\x86\x13\x1c\x1c\xcc\xff\xde\x5a\x3d\x6c\x7a\xa6\x0f\xa0\xbb\xf0\x9f\xf5\xba\x7d\x7f\xed\x1d\x9e\xd6\x9c\xdf\x73\x5e\x83\xb1\xfe\xf3\xe3\xf4\xc8\x29\xb7\x62\xf2\xb1\xfe\x5b\x08\x65\x0a\x65\xe9\xff\x3d\x00\x56\x41\x6e\xc0\x0b\x13\x00\x00")
 
 func templateDialectSqlDecodeTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -667,12 +702,12 @@ func templateDialectSqlDecodeTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/decode.tmpl", size: 4785, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/decode.tmpl", size: 4875, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlDeleteTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x53\x5f\x6b\xdb\x30\x10\x7f\xb6\x3e\xc5\x51\xc2\x48\x42\x2a\x77\x7d\x5b\x46\x07\x5d\xda\x42\x60\x84\x42\xf2\x36\xc6\x50\xa4\x73\x2c\xaa\x48\x8a\x24\xb7\x09\x46\xdf\x7d\x48\xb6\xb3\x74\xeb\x43\x1c\xf9\x7e\x7f\xee\x24\xff\xd4\xb6\xe5\x94\x2c\x8c\x3d\x39\xb9\xab\x03\xdc\xde\x7c\xfe\x72\x6d\x1d\x7a\xd4\x01\x9e\x18\xc7\xad\x31\x2f\xb0\xd4\x9c\xc2\xbd\x52\x90\x49\x1e\x12\xee\x5e\x51\x50\xb2\xa9\xa5\x07\x6f\x1a\xc7\x11\xb8\x11\x08\xd2\x83\x92\x1c\xb5\x47\x01\x8d\x16\xe8\x20\xd4\x08\xf7\x96\xf1\x1a\xe1\x96\xde\x0c\x28\x54\xa6\xd1\x82\x48\x9d\xf1\x1f\xcb\xc5\xe3\x6a\xfd\x08\x95\x54\x08\x7d\xcd\x19\x13\x40\x48\x87\x3c\x18\x77\x02\x53\x41\xb8\x68\x16\x1c\x22\x25\xd3\x32\x46\x42\xda\x16\x04\x56\x52\x23\x5c\x09\xc9\x14\xf2\x50\xfa\x83\x2a\x05\x2a\x0c\x78\x05\x31\x26\xc6\x68\xdb\x48\x95\xe6\x99\xdf\x81\x65\x9e\x33\x05\x23\xba\xe6\xc6\x22\xfd\xde\x23\x3d\xd1\x21\x47\xf9\xda\x31\xcf\xeb\xb3\xbc\x27\xed\x9b\xc0\x82\x34\x3a\xdb\x39\xa9\xc3\x85\xee\x8a\x0e\x68\x6e\x4e\xaa\x46\x73\x18\x5f\x5a\xc7\x08\xd3\xcb\x99\x62\x9c\x80\x3f\xa8\xc7\x23\xf2\x31\x0f\x47\xe0\x46\x07\x3c\x06\xba\xe8\xfe\x27\x30\x96\x3a\xcc\x00\x9d\x33\x6e\x02\x2d\x29\x7e\x7b\x8b\x3c\xf5\xfe\xe4\x0f\x6a\xe7\x98\xad\xe9\x43\xde\xee\xda\x22\x6f\x49\x51\xac\x8c\xc0\xf9\x05\x9a\xde\x07\xac\xd8\xb0\xad\xc2\x39\xa4\x09\xe8\x33\xe3\x2f\x6c\x87\x10\x23\xcd\xe5\x59\x22\x2c\x1f\x2e\xb5\x4f\x12\x95\x38\x8b\x8b\xcd\xc9\xe2\x1c\xaa\x54\xa4\xd9\x62\xf9\x40\x53\x2d\x4d\xeb\xc3\x8a\xed\x93\x59\xb6\x29\x16\x46\x35\x7b\xfd\x7f\xa7\x41\x96\x15\x4c\x87\x41\x90\x9f\xe9\x11\x49\xd1\xb6\xd7\x50\x4e\x53\xec\xcc\x1b\x74\x07\xaa\x77\x39\x19\x1f\x6c\x19\xb6\x27\x48\x91\xc5\x63\x40\xed\xa5\xd1\x1e\x8c\x83\xc6\xa7\xfc\xe1\xde\x2a\x16\xd0\xd3\x9c\x96\xec\xfb\x26\x43\x0d\xa3\xb0\xb7\xca\xa7\x43\xdc\xb3\xc0\xeb\x4d\xcf\xfb\x28\x44\x65\x3a\xee\x72\x9a\x3f\x67\x91\x1d\x1c\xd3\x3b\xec\x2c\x92\x43\xef\x95\xe1\x8c\x1f\x87\xb6\x3d\x67\xf4\x57\x8a\x5a\xc0\x30\xc8\xb0\x96\x15\xd8\x3c\xca\xbb\x6c\xc5\x48\xad\x43\x21\x79\x1a\xff\x2b\x28\xd4\x63\xeb\x27\xf0\x0d\x6e\x52\x06\xba\x10\xd0\xe7\x81\x01\x77\x90\x92\x36\xf6\xa8\xf2\x9d\x81\xa9\x3f\x28\xba\xee\xdf\x72\x6c\x8a\xa2\x32\x0e\x64\x4e\x76\xde\x80\xf5\x5d\xb9\xb0\xfe\xa7\xfc\x75\x96\x4e\xf2\xc7\x20\xf9\x17\x49\xe1\x30\x34\x4e\xff\x7b\xee\x29\x52\x3e\xc5\x75\x06\xef\xb3\x4d\x85\x4b\x8b\x19\xe4\x01\x27\xa4\xbb\xa2\xfd\x56\xff\x04\x00\x00\xff\xff\x79\x70\x87\xa8\x71\x04\x00\x00")
+var _templateDialectSqlDeleteTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x54\x5d\x6b\x23\x37\x14\x7d\x9e\xf9\x15\x07\x63\x8a\x6d\x1c\x39\xdd\xb7\xa6\x6c\x21\x4d\x53\x76\xa1\x0d\x01\xfb\xad\x94\x22\x4b\x77\x3c\x22\xb2\x24\x4b\x9a\xc4\x66\xd0\x7f\x2f\xd2\x8c\x8d\x93\xcd\x93\x67\xee\xb9\x1f\xe7\xdc\x73\x3d\x7d\xbf\x5a\xd4\x0f\xd6\x9d\xbc\xda\xb5\x11\x5f\x6e\x7f\xfe\xe5\xc6\x79\x0a\x64\x22\xfe\xe4\x82\xb6\xd6\xbe\xe0\xbb\x11\x0c\xf7\x5a\xa3\x24\x05\x64\xdc\xbf\x92\x64\xf5\xa6\x55\x01\xc1\x76\x5e\x10\x84\x95\x04\x15\xa0\x95\x20\x13\x48\xa2\x33\x92\x3c\x62\x4b\xb8\x77\x5c\xb4\x84\x2f\xec\xf6\x8c\xa2\xb1\x9d\x91\xb5\x32\x05\xff\xeb\xfb\xc3\xe3\xd3\xfa\x11\x8d\xd2\x84\x31\xe6\xad\x8d\x90\xca\x93\x88\xd6\x9f\x60\x1b\xc4\xab\x61\xd1\x13\xb1\x7a\xb1\x4a\xa9\xae\xfb\x1e\x92\x1a\x65\x08\x13\xa9\xb8\x26\x11\x57\xe1\xa0\x57\x92\x34\x45\x9a\x20\xa5\x9c\x31\xdd\x76\x4a\x67\x3e\x77\x5f\xe1\x78\x10\x5c\x63\xca\xd6\xc2\x3a\x62\xbf\x8f\xc8\x98\xe8\x49\x90\x7a\x1d\x32\x2f\xcf\xd3\xed\xfb\xa4\x7d\x17\x79\x54\xd6\xe4\x24\xe7\x95\x89\x57\x75\x13\x76\x46\xc7\xe1\x37\x58\x2d\xf0\xf7\x18\x0b\x45\xca\xc9\x51\xc0\x9b\x8a\x2d\x5a\x15\x8a\xc0\x3d\x3f\x61\x4b\xa0\x23\x89\x2e\x92\x3c\xaf\x21\x7a\x6e\x02\x17\xb9\x5b\x29\x6c\x49\xf9\x4b\x4d\x6b\xed\x0b\x43\xd9\x42\x26\x25\xfd\x99\xf7\x8f\x94\x06\x2c\x13\xea\x7b\xa8\x06\x53\xf6\x8d\x87\xcd\xf1\x5b\xf6\x37\xa5\xab\xea\x4b\xf1\x45\xe2\x87\x62\x32\x32\xcb\xaa\x9b\xce\x08\xcc\xae\x37\x96\x12\x16\xd7\xab\x4e\x69\x8e\x70\xd0\x8f\x47\x12\x33\x11\x8f\x10\xd6\x44\x3a\x46\xf6\x30\xfc\xce\x31\x53\x26\x2e\x41\xde\x5b\x3f\x47\x5f\x57\xff\x05\x47\x22\xf3\xff\x29\x1c\xf4\xce\x73\xd7\xb2\x3f\x8a\x8b\x6b\x47\xa2\xaf\xab\xea\xc9\x4a\xba\xbb\x42\xf3\xfb\x19\xab\x36\x7c\xab\xe9\x0e\x99\x01\x7b\xe6\xe2\x85\xef\x08\x29\xb1\x12\x5e\xd6\x55\x55\xf5\xfd\x0d\x22\xed\x9d\xe6\xf1\xc3\xad\x18\x2b\x29\xcf\x5e\x29\x39\xc1\x34\xcb\xab\xaa\xb4\xac\xab\x54\x57\xa3\x7d\xf7\x5a\xdb\x37\x0c\x3b\x31\xbb\x72\xa1\x9f\x70\xc4\xf6\x84\xfc\xd7\xa1\x63\x24\x13\x06\xb7\x3d\xba\x40\xfe\x32\x38\xb0\xe2\x57\xe9\x5b\x0e\x60\x1a\xf7\x4e\x87\xac\x7a\xcf\xa3\x68\x37\x9f\x12\x1c\x8e\x79\x55\x38\x2e\xb2\x11\xf5\x20\xc7\x73\xb3\xa3\xa1\x45\xee\x30\xf6\x2a\x70\xc1\x8f\x17\xbd\x05\x3a\x6b\xcb\xd0\xe8\xe3\xbb\x67\xd5\xc0\x15\x2a\xef\x6e\x3c\x25\xe6\x3c\x49\x25\x32\xfd\x5f\xa1\xc9\xcc\x5c\x98\xe3\x37\xdc\x66\xd3\x06\xd7\xd8\xf3\x39\x03\x5f\x91\x4f\x63\x16\x28\xb3\xb7\x1e\x8b\x70\xd0\x6c\x3d\xbe\x15\x9f\xab\xaa\xb1\x1e\x2a\x0f\x1a\x04\xb8\x30\x84\x2b\x17\xfe\x51\xff\x5e\x4a\xe7\x39\x96\xf9\xa6\x62\x85\xa7\xd8\x79\xf3\x71\xef\xf9\x06\x42\xbe\xaf\x25\xae\xce\x38\xa5\x25\x0a\xb1\x79\x3d\x7c\x22\xc8\x48\xa4\x54\xff\x3f\x00\xe6\x86\x1a\x7a\xf1\x04\x00\x00")
 
 func templateDialectSqlDeleteTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -687,12 +722,12 @@ func templateDialectSqlDeleteTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/delete.tmpl", size: 1137, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/delete.tmpl", size: 1265, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlEntTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x91\x41\x6b\xdc\x30\x10\x85\xcf\xf5\xaf\x78\x04\x9f\x16\xd6\x4e\x73\x6b\xa1\x87\x25\x64\x21\xb4\xe4\x92\xed\x0f\xd0\x4a\x23\x5b\xac\x56\xe3\x4a\x72\xb3\x46\xf8\xbf\x17\xc9\x76\x92\x4b\x6f\x62\xde\x9b\x6f\x34\x6f\x52\x6a\x77\xd5\x23\x0f\x93\x37\x5d\x1f\xf1\x70\xff\xf5\xdb\x7e\xf0\x14\xc8\x45\x1c\x85\xa4\x33\xf3\x05\xcf\x4e\x36\x38\x58\x8b\x62\x0a\xc8\xba\xff\x4b\xaa\xa9\x4e\xbd\x09\x08\x3c\x7a\x49\x90\xac\x08\x26\xc0\x1a\x49\x2e\x90\xc2\xe8\x14\x79\xc4\x9e\x70\x18\x84\xec\x09\x0f\xcd\xfd\xa6\x42\xf3\xe8\x54\x65\x5c\xd1\x7f\x3d\x3f\x3e\xbd\xbc\x3e\x41\x1b\x4b\x58\x6b\x9e\x39\x42\x19\x4f\x32\xb2\x9f\xc0\x1a\xf1\xd3\xb0\xe8\x89\x9a\x6a\xd7\xce\x73\x55\xe5\x1d\x70\x50\xca\x44\xc3\x4e\x58\x68\x43\x56\x05\x68\x5e\x86\x77\xe4\xc8\x8b\x48\x0a\x57\x56\x64\x4b\xbd\x67\xab\x8c\xeb\x8a\xae\xd9\x93\xe9\xdc\xfe\x42\x53\x40\x21\xa6\x04\x45\xda\x38\xc2\x9d\x32\xc2\x92\x8c\x6d\xf8\x63\xdb\xd2\xde\x2e\xf4\x3b\xcc\x73\xf5\x25\xa5\x3d\xbc\x70\x1d\xa1\xd6\x17\x7c\xff\x81\xba\xf9\xed\xe8\x36\xb0\x8f\xa4\x8e\x0b\xf7\x67\xc6\x66\x73\x71\xd7\xba\xd8\xf4\xa5\x39\x66\xce\x26\x94\xca\x6b\xf4\xa3\x8c\x5b\x1d\x29\xc1\x68\xd4\xba\x79\x31\xd6\x8a\xb3\x25\xcc\xf3\x2e\x25\x90\xcb\x72\xe9\x69\x4e\xd3\x40\xef\x3f\x59\x84\xe5\x9d\x13\xb1\x96\xdf\x20\x54\x59\x34\x14\xf6\x16\xcd\x79\x42\x3e\x30\xdd\x22\xb9\x60\xd8\x05\xb0\xc7\x18\xf2\xb5\xe8\x3a\x58\x11\x29\x34\x25\x89\xc2\x7a\x33\xb1\x47\x1d\xaf\x83\x0d\xf9\xf3\x57\x11\x65\x7f\x5a\x7d\xff\x4f\xa8\xdd\xdd\xe1\x63\xef\x35\xa5\x0c\x29\x01\x2c\xb4\x22\x17\xfd\xb6\x0d\x5e\x3d\xf5\x47\xeb\xe7\xb5\xd6\xf7\x7b\x0a\xd5\xbf\x00\x00\x00\xff\xff\x03\x54\x2d\x21\xc0\x02\x00\x00")
+var _templateDialectSqlEntTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x91\xc1\x6e\xdb\x3c\x10\x84\xcf\xbf\x9e\x62\x60\xe8\x64\xc0\x54\xfe\xdc\x5a\xa0\x07\x23\x88\x81\xa0\x45\x2e\x71\x1f\x80\x26\x97\x16\x61\x9a\xab\x92\x74\x63\x81\xd0\xbb\x17\xa4\xa4\x26\x97\xde\x88\x9d\xd9\x6f\xb9\xb3\x39\x77\xdb\xe6\x89\x87\x31\xd8\x73\x9f\xf0\xf8\xf0\xff\x97\xdd\x10\x28\x92\x4f\x38\x48\x45\x27\xe6\x0b\x5e\xbc\x12\xd8\x3b\x87\x6a\x8a\x28\x7a\xf8\x4d\x5a\x34\xc7\xde\x46\x44\xbe\x05\x45\x50\xac\x09\x36\xc2\x59\x45\x3e\x92\xc6\xcd\x6b\x0a\x48\x3d\x61\x3f\x48\xd5\x13\x1e\xc5\xc3\xaa\xc2\xf0\xcd\xeb\xc6\xfa\xaa\xff\x78\x79\x7a\x7e\x7d\x7b\x86\xb1\x8e\xb0\xd4\x02\x73\x82\xb6\x81\x54\xe2\x30\x82\x0d\xd2\xa7\x61\x29\x10\x89\x66\xdb\x4d\x53\xd3\x94\x1d\xb0\xd7\xda\x26\xcb\x5e\x3a\x18\x4b\x4e\x47\x18\x9e\x87\x9f\xc9\x53\x90\x89\x34\xae\xac\xc9\xd5\x7a\xcf\x4e\x5b\x7f\xae\xba\xe1\x40\xf6\xec\x77\x17\x1a\x23\x2a\x31\x67\x68\x32\xd6\x13\x36\xda\x4a\x47\x2a\x75\xf1\x97\xeb\x6a\x7b\x37\xd3\x37\x98\xa6\xe6\xbf\x9c\x77\x08\xd2\x9f\x09\xad\xb9\xe0\xeb\x37\xb4\xe2\xa7\xa7\xfb\xc0\x21\x91\x3e\xcc\xdc\xef\x05\x5b\xcc\xd5\xdd\x9a\x6a\x33\x17\x71\x28\x9c\x55\x28\xfd\xe2\x2d\x85\x9b\x4a\x6b\x1d\x39\xc3\x1a\xb4\x46\xbc\x5a\xe7\xe4\xc9\x11\xa6\x69\x9b\x33\xc8\x17\xb9\xf6\x88\xe3\x38\xd0\xdf\x9f\xcc\xc2\xfc\x2e\x89\x38\xc7\xef\x90\xba\x2e\x1a\x2b\x7b\x8d\xe6\x34\xa2\x1c\x98\xee\x89\x7c\xb4\xec\x23\x38\xe0\x16\xcb\xb5\xe8\x3a\x38\x99\x28\x8a\x9a\x44\x65\xbd\xdb\xd4\xa3\x4d\xd7\xc1\xc5\xf2\xf9\xab\x4c\xaa\x3f\x2e\xbe\x7f\x27\xd4\x6d\x37\xf8\xd8\x7b\x49\xa9\x40\x0a\x63\xa1\x55\xb9\xce\xb8\xaf\x83\x17\x4f\xfb\xd1\xfa\x79\xad\xe5\x9d\x33\xc8\x6b\x4c\x53\xf3\x67\x00\x03\x54\x2d\x21\xc0\x02\x00\x00")
 
 func templateDialectSqlEntTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -712,7 +747,7 @@ func templateDialectSqlEntTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectSqlEntqlTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x5f\x4f\xdc\x48\x12\x7f\xb6\x3f\x45\x9d\x85\x22\x1b\x4d\x3c\xb9\xbc\x1d\x27\x1e\x08\x90\xcb\xdc\x2e\x2c\x1b\xc8\xe6\x01\x45\xab\xc6\x2e\x8f\x5b\xd8\x6d\xa7\xbb\x87\x08\x59\xfe\xee\xab\xae\xee\xb1\xdb\x66\x98\x80\x56\xfb\x00\x98\xae\x3f\x5d\xf5\xfb\x95\xab\xca\x5d\xb7\x3c\x0c\x4f\x9b\xf6\x51\xf2\x75\xa9\xe1\xfd\xbb\x7f\xff\xe7\x6d\x2b\x51\xa1\xd0\xf0\x91\x65\x78\xd7\x34\xf7\xb0\x12\x59\x0a\x27\x55\x05\xa4\xa4\xc0\xc8\xe5\x03\xe6\x69\x78\x53\x72\x05\xaa\xd9\xc8\x0c\x21\x6b\x72\x04\xae\xa0\xe2\x19\x0a\x85\x39\x6c\x44\x8e\x12\x74\x89\x70\xd2\xb2\xac\x44\x78\x9f\xbe\xdb\x4a\xa1\x68\x36\x22\x0f\xb9\x20\xf9\xaf\xab\xd3\xf3\xcb\xeb\x73\x28\x78\x85\xe0\xce\x64\xd3\x68\xc8\xb9\xc4\x4c\x37\xf2\x11\x9a\x02\xb4\x77\x99\x96\x88\x69\x78\xb8\xec\xfb\x30\xec\x3a\xc8\xb1\xe0\x02\x21\xca\x39\xab\x30\xd3\x4b\xf5\xbd\x5a\xa2\xd0\xdf\xab\x08\x9c\xc6\x41\x7b\xbf\x86\xa3\x63\xb8\x63\x0a\xe1\x20\x3d\x6d\x44\xc1\xd7\xe9\x15\xcb\xee\xd9\x1a\x8d\x52\xd7\x81\xc6\xba\xad\x98\x46\x88\x4a\x64\x39\xca\x08\x0e\xc8\x9c\xd7\x6d\x23\x35\xc4\x61\x10\x19\x4f\x4f\x8d\x97\xad\xc4\x9c\x67\x4c\x63\x14\x06\x5d\xf7\x16\x24\x13\x6b\x84\x03\x61\x6e\x3c\x48\x2f\x9b\x1c\x95\xf1\x14\x3c\xeb\xc0\x1c\x0b\xef\xc0\xf9\x41\x91\x53\x04\x41\x84\x42\xaf\x9b\x94\x37\x26\xad\xa5\x97\x66\xb4\x47\x66\x7e\xd6\x92\xb5\xe5\x13\x25\x8b\xcd\xfc\x54\x65\x25\xd6\x6c\x59\x70\xac\xf2\x28\x4c\xc2\x70\xb9\x04\x7b\xf6\x3f\xe3\x05\xca\xa6\xca\x15\x30\x90\xe8\x4a\x84\x69\xde\x08\x43\xcd\x68\x0d\x4c\x83\xdc\x08\xcd\x6b\x4c\xc3\x07\x26\x27\x0e\x8e\xa1\xd8\x88\x2c\x4e\xe0\x70\x1b\x59\x7a\x6d\xad\xba\x30\xa0\xff\x0d\x60\x6f\x66\xc2\x8e\xf0\x3b\x82\x9a\xdd\x63\x7c\xfb\x6d\xb4\x35\xe7\x0b\xe8\x3a\xa8\x50\x78\x30\x27\xfd\x84\x04\xbe\xd8\x45\xc4\xe8\x41\xdd\x1a\xf0\x39\xf4\xfd\x37\xf0\x2f\x37\xb2\x2e\x0c\x82\xc0\x3c\x5c\xb7\x98\x1d\xc1\x44\x66\x8e\x48\x1e\xdc\xb0\xbb\x0a\x8f\x60\xce\x61\x4a\xe7\x0b\x52\x39\x6d\xaa\x4d\x2d\xd4\x0e\x25\x27\xb1\x6a\xab\xb3\x23\x2f\x82\x8f\x86\x88\xf1\x9a\xe0\xe6\xb1\xc5\x23\x20\x7a\x52\xeb\x67\x75\x96\x9a\x43\x53\x50\x4a\x5f\xb2\xda\x78\xb4\x9e\xdc\x8d\x3b\x2e\x1c\x2c\xc9\x88\x09\x3d\xd8\xd8\x3f\xf6\xb7\xbd\x2b\xb2\xca\xce\x73\x44\x12\x8a\x8a\xe8\x68\x6f\x95\x96\x5c\xac\x3d\x4a\x66\x21\x7b\x34\x14\x44\x81\xb0\x1a\x8e\x04\xd2\xd8\x11\x5e\xe1\xc7\x76\x04\xdd\x3c\xf1\x62\x47\xd6\xb0\x37\xe1\x89\x47\x97\xae\xf7\x82\x6d\xd3\xee\x27\xaf\xdd\xfe\x57\xd9\x13\xa2\xcb\xed\x3c\x5f\x6f\xa5\xae\xc0\x2e\x36\x4a\x9f\xe4\xf9\x79\x4c\x37\x12\x9c\x38\x85\x33\x18\xf9\x36\xe6\x1e\xdd\x9f\xb1\xf2\x4a\xce\x9a\x7e\xc6\x8a\x72\x1f\x79\x5e\x89\x07\x94\xca\x95\x1f\xa6\x2b\xe5\x0e\x46\x8d\xe7\xca\xd3\x1a\x90\xf4\x49\x29\x4c\x0a\x96\x17\x46\xf1\xe2\xfd\x05\xf4\xfd\x33\x5e\xae\x7e\xf1\x5c\x74\x1d\x60\x45\x11\xdc\x7e\xb3\x15\xd2\x3d\x77\xbb\xbd\xc6\xb3\x05\xb2\x26\xfc\x5d\x24\x1f\x78\xce\xb7\xd9\x99\xe7\x59\xb9\x06\xbb\x6a\x74\x8b\x34\x95\xc9\x44\x92\x84\xc1\x13\x8a\xb7\xcf\x12\xf5\x46\x0a\x20\xbc\xc3\x3e\xb6\x0d\x70\x68\xed\x27\xb9\x19\x67\x3f\x24\x6b\x15\x0d\x28\x96\xe7\x57\x5b\x19\xd4\xa8\xcb\x26\x4f\x8d\x81\x19\x94\x9b\x36\x67\x1a\x17\xee\xef\xdb\x46\x20\x30\x91\xc3\xf7\x0d\xca\x47\xb8\xdb\xf0\x2a\x47\xa9\x80\xd7\x6d\x85\xb5\x19\xb5\x34\xda\xb8\xd0\x28\x0b\x96\x61\x1a\x6a\x43\xf1\xec\xe6\x41\x6c\x9a\xa5\x7f\x79\x4c\x4d\x55\x51\x53\x4d\xaf\xb1\xa2\x89\x99\x24\xa1\x1d\x7c\xfb\xba\xa0\x01\xc9\x45\xe3\x2a\xf8\x77\x13\xa1\x03\xcc\xca\x25\x66\xc8\x1f\xac\xc2\xf0\x3c\x58\x6d\xb5\xea\x8d\x1b\x06\xd6\xcd\x85\xfb\x77\xe2\xa9\xe0\x95\xb6\x7e\x5a\xc9\x85\xb6\xcd\xc0\x1c\x6d\xb5\xc2\x60\xb9\x9c\xc2\x3a\x20\x64\x21\x7f\x0e\x91\x34\x0c\x0c\x06\x10\x4f\x02\xee\x7b\x38\xf4\x33\xec\xfb\x64\xe2\x3d\x36\xee\x60\x37\x78\x06\xe3\x60\xe6\x2d\x1d\xae\x57\x70\x0c\xac\x6d\x51\xe4\xf3\x1b\x3d\x9d\x05\x85\x9b\x84\x81\x4b\xcc\xe6\x0a\xb6\xc8\xcc\x18\x75\x07\x43\x8e\x16\x40\xdd\x18\xd7\xd5\x23\x58\xb8\x14\x34\x76\x1f\x9a\x66\xb2\x2d\xa2\x97\x67\x6e\x6f\x33\xb3\xd7\xe3\xa2\xef\x29\x51\x57\xf8\x6f\x26\x12\xfb\xce\xfa\x3e\xfb\x21\x97\xbf\x45\x52\x6d\x43\x18\x4a\xe6\xd5\xbc\xd4\x3b\x99\xa8\x5f\x81\xbd\x00\xda\x80\xd2\xaf\x25\x4a\x7c\x0d\x03\x5e\xd0\x4f\x28\xd8\x95\xd7\xeb\x51\xaf\x47\x94\xa7\x26\xad\x6c\x1e\xb8\x79\x73\x19\xac\x51\xa0\xe4\x99\x0b\x90\x8b\x35\x64\xac\x65\x77\xbc\xe2\xfa\xd1\x5b\xc0\xa0\x68\xe4\xac\x6e\xd2\x30\xa0\xde\x32\x75\xad\xb4\xdc\x64\x9a\x82\x9a\xb2\x37\x84\x62\x81\x32\xb0\x70\xb4\x34\x13\x80\x23\xd9\x5b\x90\x6c\x8b\xb3\xae\x07\x64\x8a\x59\xfa\x89\xf5\x17\xb7\x8e\x86\x2b\xcb\x6b\x91\xfe\xbc\xad\x91\x62\xc0\x0b\x40\x49\xad\xc4\x5b\x2f\xd3\xf3\x07\x56\x5d\xc5\xfe\xc9\x6c\xbf\xa3\x79\xb0\x80\x76\x01\x2a\xf9\x2f\x79\xf8\xd7\x31\x08\x5e\x59\xa7\x81\x4a\xcd\xa8\x96\xb2\x91\x31\x4a\x99\xd0\x56\x60\x96\x02\x57\x47\xc6\x0d\x81\x67\x7b\xdc\x76\xf7\xa2\x5f\xd7\x34\xe6\x86\x56\xc7\xa9\x4f\x0f\x9d\x2e\x6e\x99\xca\x58\x65\xcd\x13\x88\xae\xa2\xad\xa6\x19\xad\xe4\x6a\xa5\xbe\x7c\x59\x9d\xb9\x01\x6b\xcd\x8f\x21\xfa\x83\x55\x1b\x24\xed\x61\x2a\x8e\x74\xac\xce\x76\x10\x32\x04\x49\x15\x33\x23\x87\xe7\x6e\x89\xfa\x09\x31\xab\xb3\x81\x9a\x31\x1c\x23\xb5\x2c\x39\xf2\xec\x2a\x17\xbf\x68\xc1\x4c\x46\x0c\xf7\xec\x83\x13\x84\x8b\xdd\xf0\xbe\x02\x5f\x1a\xee\x06\xe1\x22\x5d\xa9\x1b\x4e\x43\x66\x02\xaf\x39\xf3\x75\x69\x6b\xe1\x05\x34\xd2\xda\x7c\x78\x34\x1d\x86\x1e\xff\x7f\xfd\xdb\xe5\xdc\x9c\xc4\xbb\xec\xc9\x62\x3f\x9f\xb3\x35\x64\x20\xd5\x2e\xab\xd7\xf4\x42\x12\x34\x86\xc8\x57\xd2\x6c\x7d\xb8\xa9\x3a\x30\xbe\x9f\xf2\x5d\xf7\xee\x29\x82\x97\x55\x41\x31\x2f\x01\xbb\x60\x8f\x9f\xb5\x63\x39\xec\x58\xa1\xc9\x83\x89\x79\xe0\x39\xa2\x3b\x3f\x31\x15\x99\xe5\x6e\x1a\xac\xc5\x70\xb0\xf1\x50\x63\x1e\x42\xba\x81\xac\xc4\xec\xde\xb0\x64\x5b\x55\xc9\xec\x3c\xc8\xd7\x08\x93\xf5\x7c\x1f\x64\xde\x35\xf1\x0c\x10\x8b\xd8\x27\xa6\x4c\x26\xf1\x6c\xe3\x77\x18\xf8\xc9\xfd\x3c\xb7\xe8\x2b\xd7\x65\xf4\x4f\xa4\x08\x3f\xb8\x2e\xcd\x3c\xe1\x0f\x28\x20\x6b\x44\xce\xcd\xe4\x52\x10\x37\xba\x44\x39\x3a\x55\xc9\x4b\xd1\x30\x26\x0a\xd2\x74\x1c\xc6\xe9\xd3\x55\x7c\x1f\x64\x26\xd9\x39\x6c\x8b\xf1\x33\xe8\xab\x64\xed\x47\x33\x18\xf6\x4c\x87\xc0\xcc\xbd\x3f\x17\xd0\xd2\xce\x4a\xf5\x65\xc3\x72\x1f\x56\x6d\xac\x12\xfb\x05\x41\x2d\x3e\x79\x5a\x99\x5e\x8d\x8e\x8f\x7f\x05\x00\x00\xff\xff\xed\x18\xca\x57\x2c\x13\x00\x00")
+var _templateDialectSqlEntqlTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\xdf\x6f\xdb\xbe\x11\x7f\x96\xfe\x8a\x9b\x60\x7c\x21\x07\x2e\xdd\xf5\x6d\x1e\xf2\x90\xe6\xc7\xea\x6d\xc9\xb2\x26\x6d\x1f\x82\x60\x60\xa4\x93\x4d\x44\xa6\x54\x92\x4e\x11\x08\xfa\xdf\x87\x23\x69\x89\x72\x14\x37\xc1\xb0\x97\x58\xe1\xfd\xe0\xdd\xe7\x73\xba\x3b\x35\xcd\xfc\x28\x3e\xad\xea\x67\x25\x56\x6b\x03\x9f\x3e\xfe\xf9\x2f\x1f\x6a\x85\x1a\xa5\x81\x0b\x9e\xe1\x43\x55\x3d\xc2\x52\x66\x0c\x4e\xca\x12\xac\x92\x06\x92\xab\x27\xcc\x59\x7c\xbb\x16\x1a\x74\xb5\x55\x19\x42\x56\xe5\x08\x42\x43\x29\x32\x94\x1a\x73\xd8\xca\x1c\x15\x98\x35\xc2\x49\xcd\xb3\x35\xc2\x27\xf6\x71\x27\x85\xa2\xda\xca\x3c\x16\xd2\xca\xff\xb9\x3c\x3d\xbf\xba\x39\x87\x42\x94\x08\xfe\x4c\x55\x95\x81\x5c\x28\xcc\x4c\xa5\x9e\xa1\x2a\xc0\x04\x97\x19\x85\xc8\xe2\xa3\x79\xdb\xc6\x71\xd3\x40\x8e\x85\x90\x08\x49\x2e\x78\x89\x99\x99\xeb\x9f\xe5\x1c\xa5\xf9\x59\x26\xe0\x35\x26\xf5\xe3\x0a\x16\xc7\xf0\xc0\x35\xc2\x84\x9d\x56\xb2\x10\x2b\x76\xcd\xb3\x47\xbe\x42\x52\x6a\x1a\x30\xb8\xa9\x4b\x6e\x10\x92\x35\xf2\x1c\x55\x02\x13\x92\xc4\x62\x53\x57\xca\x40\x1a\x47\x49\xd3\x8c\x19\xcf\x6b\x85\xb9\xc8\xb8\xc1\x24\x8e\x9a\xe6\x03\x28\x2e\x57\x08\x13\x49\x37\x4e\xd8\x55\x95\xa3\x26\x4f\xd1\xab\x0e\xe8\x58\x06\x07\xde\x0f\xca\x9c\xec\xe2\x28\x41\x69\x56\x15\x13\x15\xa5\x35\x0f\xd2\x4c\x0e\xc8\x08\x86\x95\xe2\xf5\xfa\x85\x92\xc3\x66\xff\x54\x67\x6b\xdc\xf0\x79\x21\xb0\xcc\x93\x78\x1a\xc7\xf3\x39\xb8\xb3\xbf\x91\x17\x58\x57\x65\xae\x81\x83\x42\x5f\x22\xdc\x88\x4a\x12\x35\xbd\x35\x70\x03\x6a\x2b\x8d\xd8\x20\x8b\x9f\xb8\x1a\x38\x38\x86\x62\x2b\xb3\x74\x0a\x47\xbb\xc8\xd8\x8d\x15\x43\x13\x47\x36\x52\x02\xec\x8f\x3d\x61\x63\xf1\x5b\xc0\x86\x3f\x62\x7a\x77\xdf\xdb\xd2\xf9\x0c\x9a\x06\x4a\x94\x01\xcc\xd3\x76\x40\x82\x98\x8d\x11\xd1\x7b\xd0\x77\x04\xbe\x80\xb6\xbd\x87\xf0\x72\x92\x35\x71\x14\x45\xf4\x70\x53\x63\xb6\x80\x81\x8c\x8e\xac\x3c\xba\xe5\x0f\x25\x2e\x60\x9f\x43\x66\xcf\x67\x56\xe5\xb4\x2a\xb7\x1b\xa9\x47\x94\xbc\xc4\xa9\x2d\xcf\x16\x41\x04\x17\x44\x44\x7f\x4d\x74\xfb\x5c\xe3\x02\x2c\x3d\xcc\xf9\x59\x9e\x31\x3a\xa4\x82\xd2\xe6\x8a\x6f\xc8\xa3\xf3\x14\x39\xbf\x23\x17\x76\x96\xd6\x88\x4b\xd3\xd9\xb8\x1f\xf7\x97\xdc\x2e\xc0\x96\xab\x64\xde\x73\x62\x25\x36\x2a\x4b\x47\x7d\xa7\x8d\x12\x72\x75\x7f\xf4\x5a\xc8\x01\x0d\x05\x51\x3b\x91\x4e\xc3\x93\x10\x45\xd1\x68\x78\x45\x18\xdb\x02\x9a\xfd\xc4\x8b\x91\xac\xe1\x60\xc2\x03\x8f\x3e\xdd\xe0\x05\xdb\xa5\xdd\xc6\x83\xd3\x83\xaf\x72\x20\x44\x9f\xdb\x79\xbe\xda\x49\x7d\x81\x5d\x6e\xb5\x39\xc9\xf3\xf3\xd4\xde\x68\xe1\xc4\x21\x9c\x51\xcf\x37\x99\x07\x74\x7f\xc5\x32\x28\x39\x67\xfa\x15\x4b\x9b\x7b\xcf\xf3\x52\x3e\xa1\xd2\xbe\xfc\x90\x2d\xb5\x3f\xe8\x35\x5e\x2b\x4f\xe7\xd1\x4a\x03\x70\xbc\x51\x58\xb0\xa2\x20\xc5\xcb\x4f\x97\xd0\xb6\xaf\x78\xb9\xfe\x47\xe0\xa2\x69\x00\x4b\x4d\xd2\xbb\x7b\x57\x21\xcd\x6b\xb7\xbb\x6b\x02\x5b\xa0\x1b\x1c\x2b\x3e\x92\xcf\x22\x17\xbb\xec\xe8\xb9\x93\xf8\x9f\xb1\x1a\xdd\x21\x4d\x50\x0d\x25\xd3\x38\x7a\x41\xf1\xee\x59\xa1\xd9\x2a\x09\x96\x8c\xb8\x4d\x5d\x03\xec\x5a\xfb\x49\x4e\xe3\xec\x97\xe2\xb5\xb6\x03\x8a\xe7\xf9\xf5\x4e\x06\x1b\x34\xeb\x2a\x67\x64\x70\x52\x96\xb0\xad\x73\x6e\x70\xe6\x7f\x3f\x54\x12\x81\xcb\x1c\x7e\x6e\x51\x3d\xc3\xc3\x56\x94\x39\x2a\x0d\x62\x53\x97\xb8\xa1\x51\x6b\x47\x9b\x90\x06\x55\xc1\x33\x64\xb1\x21\x8a\xf7\x6e\xee\xc4\xd4\x2c\xc3\xcb\x53\xdb\x54\xb5\x6d\xaa\xec\x06\x69\x00\x56\x6a\x3a\x8d\xdd\xe0\x3b\xd4\x05\x09\x24\x1f\x8d\xaf\xe0\x7f\x53\x84\x1e\x30\x27\x57\x98\xa1\x78\x72\x0a\xdd\x73\x67\xb5\xd3\xda\x6c\xfd\x30\x70\x6e\x2e\xfd\xbf\x03\x4f\x85\x28\x8d\xf3\x53\x2b\x21\x0d\xe9\x5d\xd8\xa3\x9d\x56\x1c\xcd\xe7\x43\x58\x3b\x84\x1c\xe4\xaf\x21\xc2\xe2\x88\x30\x80\x74\x10\x70\xdb\xc2\x51\x98\x61\xdb\x4e\x07\xde\x53\x72\x07\xe3\xe0\x11\xc6\xc3\xf4\xdb\x96\x75\xd7\x6b\x38\x06\x5e\xd7\x28\xf3\xf4\x75\x9d\x19\x90\xfe\x34\x8e\x7c\x62\x2e\x57\x70\x45\x46\x63\xd4\x1f\x74\x39\x5a\xc4\xc0\x54\xe4\xba\x7c\xa6\x65\xc8\x50\x95\x54\x6e\x1f\x1a\x66\xb2\x2b\xa2\xb7\x67\xee\x6e\xa3\xd9\x1b\x70\xd1\xb6\x36\x51\x5f\xf8\x7f\x0c\x24\x0d\xec\xfb\x6c\x5d\x2e\xd4\xf8\x44\x01\xb2\xb2\x14\x2e\xf5\x77\x81\xbf\x88\xbe\xff\x99\xbd\x0d\x1c\x0d\x6a\xe9\xdd\x84\x6d\x46\x29\xda\xbc\x83\x14\x09\x76\x35\x62\x3f\xd6\xa8\xf0\x3d\xd4\x04\x41\xbf\xe0\x66\x2c\xaf\xf7\xd3\xb1\xb1\xf0\x87\x0d\xcb\x96\xd5\xd0\xbc\x56\xd5\x93\xa0\xd7\x9b\xc3\x0a\x25\x2a\x91\xf9\x60\x85\x5c\x41\xc6\x6b\xfe\x20\x4a\x61\x9e\x83\x2d\x0d\x8a\x4a\xed\x15\x17\x8b\x23\xdb\x80\x86\xae\xb5\x51\xdb\xcc\x58\x9c\x3b\x3c\x6d\x4f\xec\xc0\x74\xa0\x11\x44\x02\x1d\xe5\x16\xcc\x9e\xf8\x1d\x60\xae\x0f\x3a\xd7\x1d\x4a\xc5\x1e\x14\x53\xe7\x2f\xad\x3d\x25\xd7\x53\x7b\x77\xc1\x06\x25\x31\x5a\x0d\x56\x31\x12\x05\xa0\xb2\xfd\x26\xd8\x41\xd9\xf9\x13\x2f\xaf\xd3\xf0\x64\x6f\x09\xb4\xf3\x75\x06\xf5\x0c\xf4\xf4\xaf\xd6\xc3\x9f\x8e\x41\x8a\xd2\x39\x8d\x34\xa3\x79\xae\x54\xa5\x52\x54\x8a\xc6\x09\xb1\x12\xb5\xbe\xa6\xc8\x8d\x05\x6f\x71\x1c\x2e\x68\xe4\x93\xdd\xd8\x59\xd8\xf5\x43\x41\xc5\xdf\xb7\xc3\xb4\xe6\x3a\xe3\xa5\x33\x9f\x42\x72\x9d\xec\x34\x69\xfe\x5a\x57\x4b\xfd\xed\xdb\xf2\xcc\x4f\x61\x67\x7e\x0c\xc9\x77\x5e\x6e\xd1\x6a\x77\xa3\xb3\xa7\x63\x79\x36\x42\x48\x17\x64\xdb\xbe\x24\x47\xe4\x7e\xd3\xfa\x0d\x31\xcb\xb3\x8e\x9a\x3e\x1c\x92\x12\x4e\x05\xf3\xe4\xb9\x7d\x2f\x7d\xd3\x16\x3a\xed\x31\x3c\xb0\x34\x0e\x10\x2e\xc6\xe1\x7d\x07\xbe\xbb\x76\x36\x29\xd8\x52\xdf\xd2\x0b\xb1\x07\x2f\x9d\x85\xba\x76\xb5\x11\x05\x54\x8a\x16\xd1\xa5\xfe\xfc\x4c\xdd\xc6\x3e\xfe\xfd\xe6\x5f\x57\xfb\xe6\x56\x3c\x66\x3f\x29\x7e\xcb\x67\x1c\x0d\xf6\x93\x8e\x54\x52\x2f\xa8\x9c\xb6\x99\xb1\xf8\xd2\xfb\xf9\x4e\x9a\x49\x58\xec\x76\xa3\x8e\xf1\xc3\x94\x8f\xdd\x7b\xa0\x08\xde\x56\x05\x83\xd5\x9c\x4a\x80\xde\xa7\xbe\x92\xc3\x72\x18\xd9\xb3\xad\x07\x8a\xb9\xe3\x39\xb1\x10\x7d\xe1\x3a\xa1\x0d\x70\x18\x6c\x1c\x75\x2d\x93\x6c\x02\xd4\x78\x80\x90\xa9\x20\x5b\x63\xf6\x48\x2c\xb9\x56\xb5\xe6\x6e\x36\xe4\x2b\xf4\x9b\xa8\x07\xee\x10\x64\xc1\x35\xe9\x1e\x20\x96\x1f\xf6\x85\x6b\xca\x24\xdd\xfb\x2c\xf0\x18\x84\xc9\xfd\x3e\xb7\xe4\x87\x30\xeb\xe4\xff\x91\x22\xfc\x12\x66\x0d\x1c\x56\xe2\x09\x25\x64\x95\xcc\x05\x0d\x3a\x0d\x69\x65\xd6\xa8\x7a\xa7\x7a\xfa\x56\x34\xc8\x44\x03\x63\xfd\x60\x66\x2f\xf7\xf5\x43\x90\x51\xb2\xfb\xb0\xcd\xfa\x6f\xa5\x1f\x8a\xd7\x17\x34\x18\x0e\x4c\x87\x88\xe6\xde\x7f\x66\x50\x53\x4d\xb9\x76\x43\xd1\x68\x2f\x8d\xea\x54\x13\x0f\xf6\x9b\x30\x8a\xda\xe9\xcb\xca\x0c\x6a\xb4\x69\x00\x65\x0e\x6d\x1b\xff\x77\x00\xe4\x77\xc6\x2b\x51\x13\x00\x00")
 
 func templateDialectSqlEntqlTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -727,12 +762,12 @@ func templateDialectSqlEntqlTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/entql.tmpl", size: 4908, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/entql.tmpl", size: 4945, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlErrorsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x52\x5d\x6b\xdb\x30\x14\x7d\xae\x7e\xc5\x59\xa0\x23\x2e\x99\xdd\xf5\x6d\x2d\x7d\x28\x21\x83\x40\x19\x6c\xed\x1f\x70\xa4\xab\x58\x44\x95\xd2\x2b\x39\x49\x31\xfe\xef\x43\x52\x0c\xa1\x6c\x6f\xd6\x39\xe7\x7e\x9c\x73\x3d\x0c\xcd\x8d\x58\xfa\xfd\x07\x9b\x6d\x17\x71\x77\xfb\xfd\xc7\xb7\x3d\x53\x20\x17\xf1\xb3\x95\xb4\xf1\x7e\x87\xb5\x93\x35\x9e\xac\x45\x16\x05\x24\x9e\x0f\xa4\x6a\xf1\xda\x99\x80\xe0\x7b\x96\x04\xe9\x15\xc1\x04\x58\x23\xc9\x05\x52\xe8\x9d\x22\x46\xec\x08\x4f\xfb\x56\x76\x84\xbb\xfa\x76\x62\xa1\x7d\xef\x94\x30\x2e\xf3\xcf\xeb\xe5\xea\xd7\xcb\x0a\xda\x58\xc2\x19\x63\xef\x23\x94\x61\x92\xd1\xf3\x07\xbc\x46\xbc\x18\x16\x99\xa8\x16\x37\xcd\x38\x0a\x91\x3c\x40\xf6\x21\xfa\x37\x10\xb3\xe7\x80\xd6\xa9\xe9\xb3\x6b\x9d\xb2\xc4\x01\xda\x33\xc2\xbb\x85\x32\xad\x25\x19\x03\x72\xf5\x30\x40\x91\x36\x8e\x30\x3b\x13\x4d\x78\xb7\x4d\x29\x9e\x61\x1c\x85\xee\x9d\x84\x09\x2f\xbf\x9f\x97\xde\x85\xc8\xad\x71\x71\x95\xe8\x39\x31\x97\x29\x15\xe6\x37\x9f\xc8\x05\x36\xde\xdb\x0a\x83\xb8\x32\x3a\xcd\xdd\x72\xbb\xef\xea\x75\xf8\x47\x93\xac\xba\x62\x8a\x3d\x3b\x7c\xfd\x24\x18\x88\xb9\x2e\xd2\x6a\x91\xc6\x8d\x0b\x44\xee\x49\x5c\x8d\x62\xaa\x71\xc6\x2e\xa0\x5b\x1b\x48\x8c\x42\x34\x0d\xd8\x5b\xbb\x69\xe5\x0e\xb2\xb5\x36\x20\x9e\xea\x3f\x13\x92\xa2\x39\x72\xbb\x0f\x39\xe5\xad\x39\x90\x2b\x26\x70\x34\xb1\x3b\x47\x7f\xd6\x16\xdc\x68\x9c\x7f\x89\xba\x84\x31\xf1\xf3\x78\x9a\xd2\xac\x5f\x4f\x79\xb9\x29\x8f\x52\x59\xcc\x73\xc2\xef\x1f\x2f\xb7\x98\x57\x0f\x05\xfe\xf2\x98\x96\xcf\xfe\xd3\xf3\x11\xfa\x2d\x16\xb7\x7a\x3e\xbb\x3e\xde\xe3\xfa\x30\xcb\x8d\x17\x59\x5f\x65\xd7\x46\x17\xc4\xef\x52\xdb\xff\x9d\xa6\x7a\x48\x82\x8b\x64\x89\xf9\x32\xb4\xf4\xcc\xf7\x27\xa7\xd2\x9d\xff\x06\x00\x00\xff\xff\xb1\x83\xfa\x00\x0b\x03\x00\x00")
+var _templateDialectSqlErrorsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\x51\x6b\xdb\x3c\x14\x7d\x8e\x7e\xc5\xf9\x02\xfd\xb0\x83\xe7\x74\x7d\x5b\x4a\x1e\x4a\xd7\x41\xa1\x8c\x6d\xed\xfb\x50\xa4\xeb\x58\x44\x91\xd2\x2b\x39\x6d\x30\xfe\xef\x43\x8e\x1d\x42\xb6\xc1\xde\xec\x7b\xcf\xd5\xb9\x3a\xe7\xa8\x6d\xe7\x33\x71\xef\x77\x07\x36\xeb\x3a\xe2\xe6\xfa\xe3\xa7\x0f\x3b\xa6\x40\x2e\xe2\x8b\x54\xb4\xf2\x7e\x83\x47\xa7\x4a\xdc\x59\x8b\x1e\x14\x90\xfa\xbc\x27\x5d\x8a\x97\xda\x04\x04\xdf\xb0\x22\x28\xaf\x09\x26\xc0\x1a\x45\x2e\x90\x46\xe3\x34\x31\x62\x4d\xb8\xdb\x49\x55\x13\x6e\xca\xeb\xb1\x8b\xca\x37\x4e\x0b\xe3\xfa\xfe\xd3\xe3\xfd\xc3\xd7\xe7\x07\x54\xc6\x12\x86\x1a\x7b\x1f\xa1\x0d\x93\x8a\x9e\x0f\xf0\x15\xe2\x19\x59\x64\xa2\x52\xcc\xe6\x5d\x27\x44\xba\x03\x54\x13\xa2\xdf\x82\x98\x3d\x07\x48\xa7\xc7\xcf\x5a\x3a\x6d\x89\x03\x2a\xcf\x08\xaf\x16\xda\x48\x4b\x2a\x06\xf4\xd3\x6d\x0b\x4d\x95\x71\x84\xe9\xd0\x98\x87\x57\x3b\x3f\x0e\x4f\xd1\x75\xa2\x6a\x9c\x82\x09\xcf\xdf\x9f\xee\xbd\x0b\x91\xa5\x71\xf1\x21\xb5\x33\x62\x3e\xb2\xe4\xc8\x66\x17\xcd\x02\x2b\xef\x6d\x8e\x56\x4c\x4c\x05\x45\xcc\x05\xfc\x06\x8b\x65\xda\x61\xcd\x72\x57\x97\xdf\x24\x07\xfa\xc3\x99\xf9\x6d\x42\xb6\x62\x32\x61\x8a\x0d\x3b\xfc\x7f\x01\x6a\xb7\x61\xbd\x48\xcc\x65\xff\x9b\xe5\x05\xde\x58\xee\x16\x3d\x4d\x57\x20\x72\x43\x62\xd2\x89\x71\xde\x19\x5b\xa0\x92\x36\x90\xe8\x84\x98\xcf\xf1\x99\xa2\x34\x36\x19\x99\xce\x0f\xbd\xde\x7a\xa8\xf5\x42\x13\xf6\xc6\x5b\x19\x49\x43\x9d\xb8\x0b\x84\x46\xd5\x90\x01\x26\x06\x6c\x8c\xd3\xc8\xa8\x5c\x97\x68\x9c\x79\x6d\x08\x9e\x93\xc6\x64\xd6\x0e\x1b\x3a\xe4\x45\x62\x72\x72\x4b\x05\xa2\x5c\x59\xea\x4d\x51\xde\x36\x5b\x17\x8a\x04\x76\xc6\xc2\xf4\x6c\x07\x48\x26\x38\x1f\x21\xf7\xd2\xd8\x84\x2e\x4f\x4b\xc6\x5a\xc6\x53\x9f\x69\xe7\x39\xad\xb5\x3a\xa4\xc1\x44\xa1\x65\x94\x2b\x19\x08\x99\xe7\x7e\x33\xcd\x66\x4f\x9c\xf7\x33\xb4\xdd\xc5\x43\x79\xf4\x30\x23\x5c\xba\x94\x8f\x2c\x59\x8e\xd9\xc9\x98\x0b\x50\xf2\x30\x29\x5b\xe0\xe7\x3f\xf8\x57\x26\x2b\xf2\x93\xf6\x69\x70\x50\x9d\xbd\xb5\x2b\xa9\x36\x50\xd2\xa6\x7b\xbd\x97\x3f\xc6\x4a\x92\x26\xcd\x1d\xad\x58\x9b\x3d\xb9\xe4\xaf\x67\xbc\x99\x58\x0f\xef\x61\xc0\x1e\xeb\xa6\xc2\xf0\x4e\x87\xdb\x8d\xfd\x2c\xbe\x8f\x11\x2f\x5f\xde\x0b\x9c\x85\x94\xc6\xdb\x98\x0a\x9c\xea\x8b\xe5\xf9\x16\x59\x7e\x7b\x2c\xff\xb7\xec\xbd\x49\x09\x4c\xbf\x4b\x54\xdb\x78\x8c\x5a\x95\x4d\xaf\xde\x16\xb8\xda\x4f\xfb\x83\x8b\x1e\x9f\xf7\x59\x33\x15\xce\x42\xfe\xb7\xf7\xf2\x5b\xb6\x93\x3c\x67\x51\x4d\xbf\x9d\x68\x5b\x90\xd3\xe8\x3a\xf1\x6b\x00\x11\x01\x4f\xf6\xa0\x04\x00\x00")
 
 func templateDialectSqlErrorsTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -747,12 +782,12 @@ func templateDialectSqlErrorsTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/errors.tmpl", size: 779, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/errors.tmpl", size: 1184, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlFeatureSchemaconfigTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x58\x5d\x6b\x22\x49\x17\xbe\xb6\x7f\xc5\xa1\x11\x5e\x1d\x62\x39\x33\x77\x6f\x20\x0b\xd9\x90\x40\x98\x9d\xcc\xb2\x19\x76\x2f\x87\x4a\xd5\x51\x8b\xb4\x55\x3d\x55\xc7\x24\x22\xfd\xdf\x97\xfa\x68\xed\x56\xdb\x24\x12\xb2\x0b\x7b\xa7\xd6\xa9\xf3\xf1\x9c\xaf\xa7\x5c\xad\xc6\x1f\xb2\x0b\x53\x2e\xad\x9a\xce\x08\x3e\x7f\xfc\xf4\xff\x51\x69\xd1\xa1\x26\xb8\xe2\x02\xef\x8c\xb9\x87\x6b\x2d\x18\x9c\x17\x05\x04\x21\x07\xfe\xdc\x3e\xa0\x64\xd9\xf7\x99\x72\xe0\xcc\xc2\x0a\x04\x61\x24\x82\x72\x50\x28\x81\xda\xa1\x84\x85\x96\x68\x81\x66\x08\xe7\x25\x17\x33\x84\xcf\xec\x63\x7d\x0a\x13\xb3\xd0\x32\x53\x3a\x9c\xff\x76\x7d\x71\x79\x73\x7b\x09\x13\x55\x20\xa4\xdf\xac\x31\x04\x52\x59\x14\x64\xec\x12\xcc\x04\xa8\x61\x8c\x2c\x22\xcb\x3e\x8c\xab\x2a\xcb\x56\xab\x11\x48\x9c\x28\x8d\x90\x4b\xc5\x0b\x14\x34\x76\x3f\x8b\xb1\xd2\x84\x56\xf3\x62\xec\xc4\x0c\xe7\x5c\x18\x3d\x51\xd3\x1c\x46\x55\x95\xad\x56\xf0\xa8\x68\x06\xf8\x44\xa8\x25\xf4\x21\xff\x9d\x8b\x7b\x3e\xc5\x1c\xf2\xfa\x5a\x94\xec\xad\x56\x40\x38\x2f\x0b\x4e\x08\xf9\x0c\xb9\x44\x9b\x03\x83\xa8\xc4\x5f\xae\xaa\x4c\xcd\x4b\x63\x09\x72\x61\x34\xe1\x13\xe5\x59\x36\x1e\xc3\x6d\xb0\x7a\x11\xac\x82\xc5\x84\xaa\x03\x5e\x04\xfd\xa4\x1e\x10\xa2\x67\xa0\xf9\x1c\x1d\x4c\x8c\x05\x5e\x14\x40\xfc\xae\x40\xe7\x55\xd0\x8c\x13\x08\xae\xe1\x0e\xa1\xe4\xce\x83\xca\x09\xec\x42\x93\x9a\x23\xcb\x68\x59\x62\xdb\x8c\x23\xbb\x10\x04\x2b\xef\xf6\x08\x2c\xd7\x53\x84\xbe\x86\xd3\x33\xe8\xb3\x1b\x23\xd1\x79\x6f\x7b\x3e\xa6\xbe\x66\x37\x7c\x8e\x50\x55\xfe\x92\xd2\x53\x18\x8f\xa1\xfd\x7b\xf0\x83\x05\xf1\xb5\x2e\x0c\xba\x34\xbb\x94\xd3\x5a\x59\x38\x56\x13\xe0\x1e\x48\x64\x5f\x3f\x7f\x85\x81\x36\xe4\x3f\x5f\xeb\x07\xb4\x0e\x87\x49\xb0\x6d\xd7\x7f\x41\x76\x1b\x3c\xbe\x52\x58\xc8\x03\xbe\x8c\xa2\x70\xfd\xed\x97\xf8\xf5\xfb\xb2\xc4\x5d\x6f\x83\x3f\x29\x31\xed\x2f\x8d\xcf\x55\x16\xd1\x8b\x09\xb8\xa0\xa7\x2f\xb8\x4c\xe8\xad\xaa\x9d\xf4\x5d\x59\x33\xbf\x88\xb9\x05\x8b\xb4\xb0\xda\x01\xdf\x46\xde\x58\x94\xa0\xb4\x53\x12\x81\x43\x2a\x85\x13\x30\x16\x70\x5e\xd2\xd2\x43\x44\x33\xb4\xbe\x47\xf4\xff\x08\x8c\x46\x96\x4d\x16\x5a\x74\x59\x1a\x08\x7a\xaa\xd5\xb0\xf4\xdb\xb0\x6d\x74\x95\xf5\x62\x55\x9f\xc0\x0f\x9f\x18\x41\x4f\xec\x4f\x5e\x2c\x70\xd0\x8c\x6b\x55\x0d\xd9\xa0\x79\x6f\x98\xf5\x62\x14\x10\x6f\x67\x31\xe2\x1b\x7c\x6c\x4a\xed\x06\xac\xf1\xb1\x76\x28\xb6\x8f\xef\xd3\xa9\x7a\x40\xdd\x76\x8b\x13\xf9\x7e\x97\x29\xbe\xfd\x7a\x07\x25\xb7\x7e\xca\x6c\x45\x78\x92\x7c\x6a\x69\x1c\x6e\x4b\xf9\xc8\x37\x21\x84\x93\xbf\x14\xcd\x62\xec\x51\xf1\x09\xb4\x31\xa8\x15\x0f\xb3\x34\x32\x52\x25\x64\x7e\x04\xc2\xb9\x94\xa4\x8c\xe6\x05\xc4\x5e\x76\x70\xb7\x0c\xe1\x35\x67\x07\x4c\x90\xd3\xc2\x22\x83\x30\x78\x3a\xe7\x4e\xd0\x30\xe6\x52\xaa\xa8\x73\xcf\x00\xaa\x9b\xa6\xcf\xae\xa2\xce\x4b\xed\xeb\x57\x42\xee\x35\xb4\xe5\x43\x1d\xe7\xbe\xe4\x59\x84\x83\xa5\x61\x05\x55\xb5\x1e\x72\x79\xab\xbc\x0f\xc4\x37\xf1\xad\xe6\x80\x4c\x08\x4f\x34\xe7\xc6\x33\x71\x45\xd9\x71\x54\xf0\x16\x31\xb9\x66\xd1\x34\xf3\xbd\x37\x96\xd1\x9e\x60\xc8\x94\xa3\x02\x1f\xb0\x88\xdb\xc7\x4f\xd0\x50\x93\xa8\xd1\x72\x42\x99\xe2\x63\x53\x13\x76\xcb\xcb\x02\x34\xa5\xd7\xbe\x1d\xe1\x31\x01\xbe\xc1\x16\x08\x4a\x9e\xd9\x03\xbd\xde\xee\x26\x38\x83\xba\x32\x58\x0b\xd9\xa8\xf0\x3c\x59\xc7\x78\xe6\xdd\x29\xcc\xe3\xc6\xab\x2d\x9f\xc8\xc0\x1d\xc6\x9b\xc9\xbc\xd2\x64\xc0\xb7\xaf\x29\x3d\xd2\x1e\x2f\xef\x47\xe8\xf7\x2d\xe5\x83\xce\x34\x0f\xe1\x5b\x80\xda\x77\x73\xaf\xee\x67\xaf\x62\x20\xe0\x43\xea\xd6\x78\xd6\x13\xcc\xb5\xa3\x73\xad\x72\xe9\xf5\x3c\xdc\xd5\x81\x1e\xd8\x9f\x71\x89\x05\x12\x8e\x5d\x89\xa2\x23\xdd\x9b\xdd\xdf\xbc\xb7\xe7\x42\xff\xa5\x36\x17\xa5\xe4\xef\x6d\x53\x58\x7c\xc6\xe6\x16\x1d\xba\x96\xa8\x29\x87\xfc\x87\xbf\x92\x43\x7e\x7b\xaf\xca\x34\x7b\x73\x20\xbb\xc0\xcd\x76\x7d\xb1\xb7\x6c\x7b\x09\xbf\xc4\xf3\x9f\x0b\xb4\xcb\x37\x01\x8b\xbd\xce\x24\xf2\x29\xda\xc2\x70\xa9\xf4\xf4\x58\xe0\x02\x4d\xca\x9f\x83\x4a\xe2\x04\xe5\x74\x6f\x76\x8e\x82\xec\x80\xbe\x5a\x5b\xe2\x71\xec\x56\x98\x12\x83\x97\x9b\xa3\xbb\x85\x2a\x3c\x71\x3f\x3d\xf3\xcd\x2e\x78\xb1\x96\xfb\x35\x9d\xac\x45\x2d\x0a\x54\x0f\x51\x76\xfd\x79\xad\x60\x2d\xa6\x3c\x26\x5e\x26\xc7\x84\x47\xcd\xbf\x6b\xcd\x01\xb5\xc4\x09\xa3\xf4\x59\x88\x7d\x43\xb1\x5f\x3d\x7b\x83\xe5\x34\xc5\x7c\xac\x6d\xba\x98\xad\x69\x6b\x1f\xd9\xb7\x47\x7d\xf5\xa5\x41\x66\xeb\x6b\x81\x35\xb7\xe4\xb1\x70\x98\x2e\x79\x9e\xdb\xe2\xbf\x1b\xbe\xbb\xa1\xbb\x4d\x5d\xa5\x55\x9a\xda\x7e\x0c\x6a\x80\x77\xa8\xf2\xda\xd8\x21\x55\x51\xc9\x0e\x89\x3e\xc8\x81\x7b\x1b\x88\xab\x2a\xad\x06\x38\x0b\x6c\x7b\x9d\xc1\xaa\x6a\x4d\x5b\xe6\x0f\x93\xed\x23\xca\x71\x4f\x19\x8e\xde\xa5\xd8\x62\x0b\xfa\x67\xcf\xbb\x95\xdc\x51\xd0\xee\xd6\x64\x78\x3e\x25\x3f\x1b\x93\x37\xe5\xd6\xbf\x0b\x1a\xeb\xbd\x83\x5e\x0b\x7a\x3a\x39\x68\x7b\xd8\xfd\x3a\xda\xa2\x5c\x07\x27\x33\x16\xe1\x85\xfe\x7e\x19\x7e\x4d\x46\xe8\x53\xca\xc3\xe0\xc5\x59\xf0\xb0\xbc\x21\xc2\x35\x3e\xe1\x7d\xd2\xb8\x39\x3c\x16\xf0\x92\xd3\xec\x0d\xa8\x69\x8b\x4e\x9d\xc6\x2a\x4d\x79\xf9\xa3\x23\x9c\x83\x4b\x2c\x55\x03\x61\x79\x70\x85\x8d\x5e\x17\xec\xc4\x9a\xf9\x7f\x20\xd8\xd2\xa2\x54\xc2\x33\xb4\xb0\xb8\x67\xbc\xf3\x79\xb5\xdf\x9f\xad\xfb\x07\x78\xcf\x2b\xdd\xf0\x13\xf3\xdf\xe0\xca\x5b\x3f\x36\x4f\x3b\x9e\x47\xcd\xbf\x5f\x5c\xfd\x67\xc3\x60\x38\xfc\x07\x4a\x7f\x8f\xbe\xd1\x33\xf4\xcd\x5f\x61\xdf\xcd\x66\xf5\xec\x0c\xb9\x6d\x12\xf4\x1c\x49\xda\xcb\x91\x3a\x29\x52\x17\x43\xea\x22\x48\xc7\xf3\xa3\x2d\x7a\xf4\x4a\x76\xd4\xb1\xf5\x22\x7e\x1e\xcd\x03\x08\x6e\x78\x50\x33\x97\x7f\x07\x00\x00\xff\xff\x42\x1d\x8c\x6d\x47\x17\x00\x00")
+var _templateDialectSqlFeatureSchemaconfigTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x58\xdd\x6e\xdb\x38\x13\xbd\x96\x9e\x62\x20\x18\xf8\xec\x22\xa6\xdb\xde\x7d\x05\xbc\x40\x36\x48\x80\xa0\xdb\x74\xb1\x29\x76\x2f\x0b\x9a\x1c\xc9\x44\x65\x52\xa5\xe8\x24\x86\xa0\x77\x5f\xf0\x47\x8a\x24\xcb\x8e\x93\x0d\x82\x05\xf6\x4e\x3f\xc3\x99\x39\x67\x86\xc3\x23\x55\xd5\xe2\x5d\x7c\xa1\x8a\x9d\x16\xd9\xda\xc0\xc7\xf7\x1f\xfe\x3f\x2f\x34\x96\x28\x0d\x5c\x51\x86\x2b\xa5\x7e\xc0\xb5\x64\x04\xce\xf3\x1c\x9c\x51\x09\xf6\xbd\xbe\x43\x4e\xe2\x6f\x6b\x51\x42\xa9\xb6\x9a\x21\x30\xc5\x11\x44\x09\xb9\x60\x28\x4b\xe4\xb0\x95\x1c\x35\x98\x35\xc2\x79\x41\xd9\x1a\xe1\x23\x79\xdf\xbc\x85\x54\x6d\x25\x8f\x85\x74\xef\x7f\xbb\xbe\xb8\xbc\xb9\xbd\x84\x54\xe4\x08\xe1\x99\x56\xca\x00\x17\x1a\x99\x51\x7a\x07\x2a\x05\xd3\x09\x66\x34\x22\x89\xdf\x2d\xea\x3a\x8e\xab\x6a\x0e\x1c\x53\x21\x11\x12\x2e\x68\x8e\xcc\x2c\xca\x9f\xf9\x42\x48\x83\x5a\xd2\x7c\x51\xb2\x35\x6e\x28\x53\x32\x15\x59\x02\xf3\xba\x8e\xab\x0a\xee\x85\x59\x03\x3e\x18\x94\x1c\x26\x90\xfc\x4e\xd9\x0f\x9a\x61\x02\x49\xb3\xcc\x5b\x46\x55\x05\x06\x37\x45\x4e\x0d\x42\xb2\x46\xca\x51\x27\x40\xc0\x3b\xb1\x8b\xeb\x3a\x16\x9b\x42\x69\x03\x09\x53\xd2\xe0\x83\x49\xe2\x78\xb1\x80\x5b\x17\xf5\xc2\x45\x05\x8d\x81\xd5\x12\x68\xee\xfc\x1b\x71\x87\xe0\x33\x03\x49\x37\x58\x42\xaa\x34\xd0\x3c\x07\x43\x57\x39\x96\xd6\x85\x59\x53\x03\x8c\x4a\x58\x21\x14\xb4\xb4\xa4\x52\x03\x7a\x2b\x8d\xd8\x20\x89\xcd\xae\xc0\x7e\x98\xd2\xe8\x2d\x33\x50\xc5\x91\x25\x45\x53\x99\x21\x4c\x24\x7c\x5a\xc2\x84\xdc\x28\x8e\xa5\xcd\x3b\xb2\x98\x26\x92\xdc\xd0\x0d\x42\x5d\x43\x69\xb4\x90\x19\x2c\x16\xd0\x7f\xee\xf2\x20\x71\xd4\xf5\x85\xce\x97\x24\x97\x3c\x6b\x9c\xb9\xd7\x22\x05\x6a\x89\x44\xf2\xe5\xe3\x17\x98\x4a\x65\xec\xf5\xb5\xbc\x43\x5d\xe2\x2c\x18\xf6\xe3\xda\x1b\x24\xb7\x2e\xe3\x2b\x81\x39\x3f\x92\xcb\xdc\x1b\x37\x77\xbf\xf8\xdb\x6f\xbb\x02\xf7\xb3\x75\xf9\x84\xc2\xf4\x6f\x3a\xd7\x75\xec\xd9\xf3\x05\xb8\x30\x0f\x9f\x71\x17\xd8\xab\xea\xbd\xf2\x5d\x69\xb5\xb9\xf0\xb5\x05\x8d\x66\xab\x65\x09\x74\xc8\xbc\xd2\xc8\x41\xc8\x52\x70\x04\x0a\xa1\x15\xce\x40\x69\xc0\x4d\x61\x76\x20\x6c\x07\xa3\xb6\x7b\x44\xfe\xcf\x80\x92\x48\xe2\x74\x2b\xd9\xa1\x48\x53\x66\x1e\x1a\x37\x24\x3c\x9b\xf5\x83\x56\x71\xe4\xbb\xfa\x0c\xbe\xdb\xc2\x30\xf3\x40\xfe\xa4\xf9\x16\xa7\x5d\x5c\x55\x3d\x23\xd3\xee\xba\x59\x1c\x79\x14\xd6\x7d\x2a\xb2\xd8\x23\xbe\xc1\xfb\xae\xd5\x3e\x60\x89\xf7\x4d\x42\x7e\xfb\xd8\xbd\x9b\x89\x3b\x94\xfd\xb4\xa8\x31\x94\xad\xed\x7c\x70\xf8\xc6\xfd\x4e\x0b\xaa\xed\x94\x19\x20\x3c\x0b\x39\xf5\x3c\xce\x86\x56\x50\x75\x21\xd8\x27\xe4\x2f\x61\xd6\x1e\xbb\x77\x7c\xd6\xab\x6d\x55\x37\x8e\x67\x71\x18\x19\xa1\x13\x62\x3b\x02\xe1\x9c\x73\x23\x94\xa4\x39\xf8\xbd\x5c\xc2\x6a\x67\xcb\x15\x9c\x84\x9c\x52\xa4\x66\xab\x91\x80\x1b\x3c\x07\xe7\x8e\xf3\xb0\xa0\x9c\x0b\xef\x73\x64\x00\x35\x9b\x66\x42\xae\xbc\xcf\x4b\x69\x77\x1b\x87\xa4\xfc\x39\xb4\xb7\xe6\x51\x62\x5b\xde\xc2\x4f\x45\x46\xc2\xb0\x82\xba\x6e\x87\x5c\xd2\x6b\xef\x23\xf8\x52\xbb\xd5\x4a\x30\xca\xc1\x0b\xc0\x7c\xe7\x3f\x81\xcb\xdb\x2e\xbc\x83\xd7\xc0\x14\x0a\xb4\x5f\xef\x51\x2c\xf3\x11\x30\x46\x15\xf3\x1c\xef\x30\xf7\xa7\x8f\x9d\xa0\x16\x55\x86\x12\x35\x35\xc8\x43\xcd\x49\xa6\xdc\xd9\x72\x1a\x40\x55\x58\xaa\x86\x08\x5f\x02\xf0\x15\x4e\x81\x28\x7a\xfa\x1c\x88\xa2\xfd\x93\x60\x09\x4d\x67\x90\xee\xf3\xd8\x3b\x3c\x0f\xd1\xd1\xbf\xb3\x87\x52\xae\xee\x1f\xb3\x1a\xe4\x64\x14\xac\xd0\xaf\x0c\xe1\x85\x34\x0a\xec\xf6\x55\x85\x65\xda\xf2\x65\xf3\x70\xfb\x7d\xe0\x7c\x7a\xb0\xcc\x33\xf8\xea\xa8\xb6\xbb\x39\x6a\xf6\xb3\x75\x31\x65\xf0\x2e\xec\x56\xff\x2e\x62\xa4\xe7\x65\x09\x65\x17\x54\x14\x45\x96\xee\x7a\xb4\x6f\x8e\x49\x04\x8e\x39\x1a\x5c\x94\x05\xb2\x03\xe5\x7e\x3c\xfb\xbb\xeb\x46\x16\x4c\x4e\x8d\xb9\x2d\x38\x7d\xeb\x98\x4c\xe3\x13\x31\x07\x72\xe8\x9a\xa3\x34\x09\x24\xdf\xed\x92\x04\x92\xdb\x1f\xa2\x08\xb3\x37\x01\xa3\xb7\xf8\x78\xba\x9e\x9c\x2d\x69\x83\x85\x74\x4f\xc9\xfc\xe7\x16\xf5\xee\x55\xc8\x22\xcf\x0b\x89\x34\x43\x9d\x2b\xca\x85\xcc\x5e\x4a\x9c\x93\x49\xc9\x53\x54\x71\x4c\x91\x67\xf8\x6a\x94\x1d\xf1\xd7\x78\x0b\x3a\x8e\xdc\x32\x55\xa0\xcb\xb2\x0d\x34\x59\x6d\x45\x6e\x85\xfb\xa7\xa5\x9d\x35\x8c\xe6\xad\xdd\xaf\xe1\x4d\x6b\xaa\x91\xa1\xb8\xf3\xb6\xed\xf5\x64\x35\x34\x13\x96\x13\x6b\x93\x60\xe0\xa3\xd1\xdf\x8d\x67\xd7\x6e\x41\x13\x7a\xeb\xa5\xc3\xfe\x28\xb1\x9f\x3d\x7b\xad\xfd\x24\x4c\xb1\x4f\xcb\xa1\x5c\x0c\x06\x76\x98\x23\xf9\x7a\x2f\xaf\x3e\x77\xc4\x6c\xb3\xcc\xa9\xe6\x9e\x3d\xe6\x25\xba\x2c\xbc\xce\xed\xe9\xdf\x47\xbd\x1b\x9e\x0f\x7c\x15\x5a\x48\xd3\xcf\x63\xda\x10\xbc\x27\x95\xdb\x60\xc7\x5c\x79\x30\x7b\x22\x3a\x8e\xfa\xb2\x77\x78\xd3\x50\x5c\xd7\xe1\x68\x80\xa5\x53\xdb\x6d\x05\xeb\xba\x37\x6d\x49\x55\xb5\xb1\x9b\x4a\x04\x77\xa7\xb4\xe3\x48\x1b\xce\xdf\xa4\xd9\xdc\xec\x72\x9f\x3d\x6f\xd6\x72\x2f\xa2\x76\xbf\x27\xdd\xe7\x53\xc8\xb3\x33\x79\x43\x6d\xed\x77\x41\xe7\x78\x3f\x20\xaf\x99\x79\x38\x3b\x1a\x7b\x36\xe8\x8c\xce\xf5\x40\x72\x1d\x9d\xcc\x68\xe7\x98\xd2\x6f\x57\xe1\xe7\x54\xc4\x7c\x08\x75\x98\x9e\x5c\x85\x59\xfc\xaa\x0c\x37\xfc\xb8\xef\x93\xce\xca\xd9\x4b\x09\x2f\xa8\x59\x8f\x40\x7d\x2e\x33\xdd\x3c\x2d\xdf\x55\xd5\xd6\xe5\x8f\x03\x70\x8e\x1e\x62\xa1\x1b\x0c\x16\x47\x8f\xb0\xf9\xf3\xc0\xa6\x5a\x6d\xfe\x03\x60\x0b\x8d\x5c\x30\xab\xd0\xec\x01\xb9\x58\xd3\x83\x9f\x57\xe3\xf9\x0c\xd6\x8f\xe4\xf4\xc2\x34\xac\xb8\xf9\x37\xa4\x72\x20\x85\x7f\xd2\x03\xed\xee\x3e\xf4\xfb\xa5\x6c\x7e\x36\x4c\x67\xcd\xa8\x7c\x9b\x6e\x38\xe8\x6f\xfe\x84\x7c\xb3\x4b\xc8\x37\xf5\x78\xf4\x74\x31\x93\xfd\x7f\x66\x71\x4f\x56\x8c\x89\xa4\x51\x8d\xd4\xd7\x22\xed\xe0\x8c\x0f\x2a\xa4\x43\x02\x69\x54\xd4\x9c\xa4\x8f\x06\xf2\xe8\x99\xea\xa8\xa9\x42\x9b\x73\xb8\x76\xfc\x59\x31\x7c\x84\xc1\x10\x65\x50\xcb\xbf\x07\x00\x42\x1d\x8c\x6d\x47\x17\x00\x00")
 
 func templateDialectSqlFeatureSchemaconfigTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -772,7 +807,27 @@ func templateDialectSqlFeatureSchemaconfigTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectSqlGlobalsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\xcf\x41\x6b\x02\x31\x10\x05\xe0\x7b\x7e\xc5\xc3\xa3\xd0\x5d\xeb\xad\x47\x11\x0b\x42\xe9\xa5\xfd\x03\x6b\xf2\xd6\x0d\x4d\x33\xeb\x4c\xb6\x20\x21\xff\xbd\x28\x16\x7a\x9d\x6f\x66\x1e\xaf\xd6\x7e\xed\xf6\x32\x5f\x35\x9e\xa7\x82\xed\xe6\xf9\xe5\x69\x56\x1a\x73\xc1\xeb\xe0\x79\x12\xf9\xc2\x31\xfb\x0e\xbb\x94\x70\x5f\x32\xdc\x5c\x7f\x18\x3a\xf7\x39\x45\x83\xc9\xa2\x9e\xf0\x12\x88\x68\x48\xd1\x33\x1b\x03\x96\x1c\xa8\x28\x13\xb1\x9b\x07\x3f\x11\xdb\x6e\xf3\xa7\x18\x65\xc9\xc1\xc5\x7c\xf7\xb7\xe3\xfe\xf0\xfe\x71\xc0\x18\x13\xf1\x98\xa9\x48\x41\x88\x4a\x5f\x44\xaf\x90\x11\xe5\x5f\x58\x51\xb2\x73\xeb\xbe\x35\xe7\x6e\x1d\xe0\x17\x2b\xf2\x8d\x73\x92\xd3\x90\x0c\x43\x0e\x98\x98\x66\xaa\x61\x14\x85\x5d\x12\x42\x1c\x12\x7d\x31\xdc\xcf\x6a\x45\xe0\x18\x33\xb1\x7a\x40\x6f\x97\xd4\x3f\x1e\xac\xd0\x5a\xad\x60\x0e\x68\xcd\xfd\x06\x00\x00\xff\xff\xa2\x7b\x06\xae\x26\x01\x00\x00")
+var _templateDialectSqlFeatureUpsertTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x5f\x73\xdb\xc6\x11\x7f\x06\x3e\xc5\x96\xc3\x66\x00\x95\x3e\xba\x79\xab\x3b\x7a\x50\x44\x39\x61\xeb\x50\x4e\x28\xb5\xe9\x74\x3a\x16\x08\x2c\xc8\x1b\x83\x38\x18\x77\xa0\xe4\xe1\xe0\xbb\x77\xf6\xee\xf0\x97\xa4\x49\x45\x4a\x46\x69\xf3\x64\x11\xb8\xdb\xdb\xfd\xed\xee\xef\x76\x17\xde\x6e\xc7\x67\xee\xa5\xc8\x3e\xe7\x7c\xb9\x52\xf0\xf5\xeb\x3f\xff\xe5\x55\x96\xa3\xc4\x54\xc1\xdb\x20\xc4\x85\x10\x1f\x61\x9a\x86\x0c\x2e\x92\x04\xf4\x22\x09\xf4\x3e\xdf\x60\xc4\xdc\x9b\x15\x97\x20\x45\x91\x87\x08\xa1\x88\x10\xb8\x84\x84\x87\x98\x4a\x8c\xa0\x48\x23\xcc\x41\xad\x10\x2e\xb2\x20\x5c\x21\x7c\xcd\x5e\x57\x6f\x21\x16\x45\x1a\xb9\x3c\xd5\xef\xdf\x4d\x2f\xaf\x66\xf3\x2b\x88\x79\x82\x60\x9f\xe5\x42\x28\x88\x78\x8e\xa1\x12\xf9\x67\x10\x31\xa8\xd6\x61\x2a\x47\x64\xee\xd9\xb8\x2c\x5d\x97\x6c\x80\x8b\x28\xe2\x8a\x8b\x34\x48\x20\xe6\x98\x44\x12\x62\x61\x0e\x0f\x73\x0c\x14\xc2\xa2\xe0\x49\x84\x39\x03\xbd\x69\xbb\x7d\x05\x11\xc6\x3c\x45\x18\x44\x3c\x48\x30\x54\x63\xf9\x29\x19\x9b\xc5\x63\x23\x62\x1c\xd4\x42\xc7\x45\x26\x31\x57\x03\x78\x55\x96\xae\x43\xbb\x79\x0c\x43\xf6\x16\x03\x55\xe4\x78\x95\x06\x8b\x04\x23\x18\xc8\x4f\xcd\x4a\x5a\xe8\x84\x22\x8d\x13\x1e\x2a\xf8\xf7\x7f\xe4\xa7\x84\x5d\xda\x9f\xd7\x19\xe9\x6a\x04\x61\x1a\x81\xd5\x88\xfe\x7c\x75\xb2\x49\x1f\x16\x45\xf2\xf1\x51\x76\xe9\x1d\x2f\xc0\xb8\x2f\x62\x2f\x33\x0c\x3b\xe7\x9c\xae\x10\xad\x1c\xe6\x18\x22\xdf\x60\x0e\x6f\xce\xa1\xfe\xdb\xcb\x02\x19\x06\x09\x0c\xd9\x3c\x14\x19\xb2\x6f\x0c\x6a\xbe\xd9\xf7\x81\xce\x64\xd7\x69\x65\x01\x9c\xc3\x76\xdb\x92\x54\x96\xac\xb2\x75\xaf\x59\xc7\xad\x32\xc8\x3f\xc1\xb4\xfd\x1a\x5a\x6b\x7e\x7c\x3e\x45\xf7\x85\x85\x15\x70\x8a\xa2\xa4\x94\x8d\x48\xc2\x7f\x3f\xea\xd5\xc2\xbd\x9e\x1a\x2e\xba\x8b\x8c\x12\xd7\x29\xd2\xaa\x2c\xe7\xa9\x82\x21\x9b\x05\x6b\x84\xc1\x6d\xf5\x4a\xab\xe8\x8e\xc7\xd0\x02\x28\x48\x12\x71\x2f\x81\xfc\xc6\x97\x45\xce\xd3\xa5\x66\x83\xbb\xeb\x19\x5c\x5e\xcf\xde\xbe\x9b\x5e\xde\xdc\xc1\x58\xff\x9e\xdc\xbe\x7f\x37\xbd\xbc\xb8\xb9\x82\xbf\x5f\xfd\xeb\x0e\xc2\x24\x28\x24\x92\x38\x4d\x3a\x08\x77\xd3\xd9\xfc\xea\xc7\x9b\x3b\x90\x2a\x50\xb8\xc6\x54\x31\x78\x2b\x72\xc0\x87\x60\x9d\x25\xf8\xc6\x1d\x8f\xdd\xf1\xd8\x09\x13\x4e\xaf\x48\x69\xa3\x60\x59\xb2\x4b\x9d\x77\x9e\xcf\x34\x82\xf7\x5c\xad\x08\x43\x9d\x80\xa4\xf3\x78\xec\x38\x73\x54\xdb\x2d\x78\x3c\x8d\xf0\x01\x18\xbc\xf6\xd9\x5c\xe5\x45\xa8\xf4\x2a\x28\x4b\x6f\xe3\xb3\xb6\x03\x69\x4f\x63\xa7\x47\x27\x3b\xce\x78\x0c\xb7\x59\x44\x3c\x47\x46\xe6\xe2\xde\x9c\x45\x3f\x52\xbc\x87\x4d\x90\x14\x28\xeb\xa5\xf4\xf8\x3e\x90\x90\xe5\x22\x13\x44\xd5\x44\x2c\x3c\x25\x34\xb9\x48\x99\x59\x47\x7c\xf5\x23\x4a\x91\x6c\xf0\x9f\x5c\xad\x66\x78\xff\x0f\x2d\xc5\xf3\x47\x7a\x81\x6f\xd6\x11\xea\x1b\xcc\x73\x1e\x21\x48\xb1\xc6\x0a\x34\x4b\x59\x5a\x8d\xb0\x90\x4a\xac\xab\xe5\x85\x51\xd4\xe8\x64\x84\x18\xdd\xbd\xb8\x48\x43\xaf\x80\xb3\x3e\x8c\xc6\xd1\x3e\x6c\xdb\x28\x7e\x5f\x28\x8a\xc1\x2e\x98\x4e\xc1\x8e\xe3\xf9\xa7\x8d\xdf\x07\xb4\xb4\xd6\x5c\x3d\x60\xe8\x85\xea\xc1\x27\x9f\x92\x3a\xe0\xf5\x98\x00\xce\xda\x31\x5e\x96\x7e\x2b\xe8\x3c\x91\x29\x09\x8c\xb1\x5d\x3a\xf4\xe1\xac\x1b\xcd\x65\x09\x5b\xd7\xe9\x09\xaf\xb3\x17\xce\x81\x64\xb9\x4e\x8e\xaa\xc8\x53\xf8\xaa\xbf\x79\x4b\x97\x8b\x0e\xae\x37\x7d\xae\x1a\xb9\x4e\xe9\xf6\xf3\xe1\x52\x24\xc5\x3a\x95\x10\x06\x49\x22\xe1\xae\x79\x71\x07\x41\x1a\xd5\x69\x82\x52\x47\x7c\x68\x56\x93\x88\xc0\xe4\x10\x2d\x05\x15\xe4\x4b\x54\x0c\x6e\xa5\x49\x27\x2e\x49\x4b\x2e\x52\xba\xf7\xf1\x53\xc1\x37\x41\x42\x95\x83\x12\x50\xd0\x92\xd3\x32\xa3\x17\xd0\x6d\xec\xac\xd6\x9e\xd5\x87\x31\xe6\x3f\x8b\xa3\x7a\x72\xb5\xcb\x14\x71\xc4\xe3\xdd\x14\x64\x19\xa6\x91\x77\x68\xc5\x08\x8e\xd9\xf3\x24\x1f\x37\x5b\x0e\xd1\xa3\xe6\x46\xf5\x39\x43\xf0\x5c\xca\xbe\xfe\x21\xe4\x3a\x72\x79\x05\x14\x51\xc1\xc0\x32\xfa\x2b\x9e\x2e\xf5\x26\x10\x29\x42\xdb\x7d\x90\x8a\x08\x99\xeb\xec\x48\x93\x9a\xbb\xa0\x51\xbd\xef\x06\x52\xbc\xa7\x48\x4b\x8b\x41\xe3\xa3\x01\x48\x54\x0a\xf3\xce\x29\xbd\x23\xce\x08\x5c\x43\x1e\x73\x54\x24\xda\xa7\x0b\x0e\x14\xae\xb3\x24\x50\xbd\x1b\xce\x28\x3a\x36\x62\xe5\x00\x86\xf6\x3e\x34\x8c\x82\x0f\x8a\xae\xc8\x61\x05\x9c\xbd\xac\x06\xd5\xd1\x64\xe0\xc0\x44\x6d\xf3\xaa\x6d\xd7\xb1\x73\xed\xda\x01\x30\x7b\xb7\x59\xfe\xa1\x2c\x23\xde\x01\x7c\xc0\xb0\x50\x36\x05\x3f\x15\x98\x7f\x66\x36\xb4\x8b\xdd\xb0\xf4\xa1\x4a\x01\xca\x4f\x85\x0f\x8a\x0a\x30\xfa\xd7\x07\xcc\x73\x91\x13\x40\x3c\x86\x04\x53\xaf\x60\x26\x8c\xea\xa8\xf4\xe1\xfc\x1c\x5e\xd3\x8a\x2a\xf8\xf4\x16\xc9\x66\x78\xef\x0d\xb6\x5b\x58\x04\x12\x61\x48\x12\x63\xbe\x64\xef\x83\xf0\x63\xb0\xa4\x53\xdf\xc0\x9a\x4b\x4a\x6d\x9b\xf8\xa6\x26\xed\x7a\xb8\x55\xa6\x0c\x7c\xf2\x49\x75\x46\xad\x46\x93\xbc\x8d\xf5\x3f\x51\x20\x26\xfc\x23\x6a\xbb\x46\xb0\x28\x14\x64\x41\xca\x43\x49\x15\x52\x60\x35\x04\x11\x86\x45\x2e\x8f\xe2\xf2\xd3\x7e\x60\x0c\x24\x98\xeb\xe2\xb0\x68\xf4\xf8\x2b\x49\x87\x3f\x9c\x43\xca\x13\x8d\x8a\x3e\xd9\xc3\x3c\xf7\xeb\x44\xb3\x05\xd0\x77\x81\xbc\x4e\x51\x5f\x26\xd3\x09\x39\x72\x3c\x86\xe9\xa4\xeb\xbb\xdb\xf7\x54\x2f\x18\x17\x6a\x7a\x35\x00\x98\x97\xe6\x9e\xc5\x68\x6c\xee\xc1\x08\xa6\x13\x56\x89\x4f\x05\xa5\xef\x74\xc2\x6e\x25\xe6\x13\x5d\xc7\xdb\x2b\x0a\x2e\x60\x26\xd4\x5b\xea\x9a\xae\xc8\x55\x84\x96\x91\x8a\x11\xb5\x4c\x61\x20\x5b\x77\x7f\x20\x41\x7e\xe4\x59\x86\x11\x2c\x3e\x5b\x46\xb7\x65\x21\x51\x7a\x48\x37\x12\x78\xc8\x96\x0c\x26\xd7\x30\xbb\xbe\xf9\x6e\x3a\xfb\xd6\x1f\x01\xc9\x55\x92\xec\x09\x45\x91\x44\x5a\x9f\x05\x42\x6e\xca\x80\x5a\x58\x14\xa8\x80\x22\xa4\x53\x97\x7c\xc1\x23\xd3\xc9\x7e\x77\x78\x3c\xd2\x34\xc0\xa6\x13\x76\x43\x14\x55\x96\x23\xed\x09\xed\x6b\xed\xae\x0f\x44\x34\xa3\xc6\x65\x36\x82\xe6\xc1\x06\x4d\x04\x55\x0e\x6d\x39\xcf\x86\x1b\x8f\xf4\x3e\xd7\x69\x0a\xed\x43\xf8\x3a\x24\x45\x1f\xc5\xa6\x13\xca\x0d\x52\xcc\x75\x3a\xa2\xbe\xea\xe0\xbf\x35\x8a\x37\x99\xc1\xde\x05\x0b\x4c\x80\x64\xd9\xf3\x2c\x2e\x95\x8c\x4a\xfc\x88\xf4\xb4\x81\x3f\x9d\x34\x61\x3f\x9d\x3c\x25\xe8\xa7\x93\x43\x21\xdf\xc5\x97\xcc\xb2\xc0\x98\x1c\x98\x4e\x0e\xe2\xd8\x4d\x82\x06\x0b\xb7\xdb\x50\x1c\xa7\xaf\xa3\x37\x73\x95\x88\x5f\xa0\xb2\x0f\xb5\xce\x3d\x69\xed\x58\xb0\x2a\x92\xd7\x9f\xc8\x2c\x27\xa9\x7c\x0a\xcb\xf4\xb5\x3d\x9d\x73\x5a\x18\x9f\xde\xbf\x99\x46\xf3\x65\x36\x71\xdf\xd0\xbc\xe2\x40\x99\x42\xef\x5e\x7a\x1b\x47\x3a\x7a\xd6\x32\x5d\xbb\xb1\xdf\x9b\xb1\xdf\x7e\x33\x46\x5e\xfd\xf9\xdd\x98\xdd\xfd\x3f\xd2\x8e\x1d\x8f\xf0\x97\xd3\x9d\x9d\xe6\xb7\x5f\xa4\x3d\x7b\x94\xd3\x77\x35\x3e\xd6\x71\x91\x9b\x81\x78\x9c\x66\x29\x6d\x2f\xe9\xae\x4b\x32\xd3\xce\xed\xca\x6d\x1a\xa3\x03\xad\xd7\x23\xba\x1d\x2d\xf3\x60\xbb\x33\xa0\xd7\x03\x50\x79\x81\xbf\x6a\xeb\x63\x2d\x3d\xa9\x60\xd0\x34\x3a\x82\x05\x5d\xc2\x79\x90\x2e\xb1\xa9\x1e\xad\x3a\x92\xa0\xaa\x7a\xa4\x45\x1d\x13\x3e\x5d\xca\xaf\x3b\xf5\x5f\xbc\x56\x4c\x97\x7d\xf1\x91\xee\xa8\x09\x62\xcd\xe9\x12\x95\x76\xaf\x3d\x10\xfe\x18\x31\x98\xa3\x02\xae\x40\x98\xcf\x1c\x5d\x0f\x01\x4f\xa5\xc2\x20\x1a\x8c\x80\xfb\xa6\x92\x2c\xff\xcf\xba\xb8\x8e\x8b\x9f\xbd\x8d\x3b\x1e\x6f\x47\x79\xea\x84\xd0\xfb\xbd\x56\xad\x6b\xd5\xf1\x19\xdc\xac\xd0\x8e\x72\x64\x55\x8f\x0c\x26\xd7\x70\xfb\x7e\x42\x23\xf7\xf9\xd5\xcd\xc0\xd6\x6a\x23\x90\xab\x20\x6f\xda\x4c\x1a\x3a\x51\xf7\xac\xa9\xd0\xce\x7f\xac\x59\xf2\xc8\xf7\xae\xfe\xa8\xa7\x2c\x4f\x1d\x95\x91\x25\x86\x2e\x86\x31\x61\xb2\xa7\xb6\xe9\x74\x93\x31\x9b\xca\xbf\xcd\xaf\x67\xd5\x17\x28\x18\xea\x38\xaa\x8f\x18\xcc\x51\x0d\x60\x18\xf7\x0a\x1f\xd7\xa9\x66\x60\x7a\x39\x8d\xb5\x50\xd9\x19\x98\x16\x52\x71\xfe\xc0\x14\x6f\xcc\x75\x9c\x3d\xd9\xa2\x23\xb2\x25\xc5\xdb\x18\x99\x55\xbb\xd7\xb9\x31\xed\x6d\x69\xeb\x32\xaf\xdf\xc0\xd2\xef\x98\xa2\x59\xaa\x20\x55\x54\xb9\xc0\xc6\x6f\x91\x60\xb1\xd3\xda\xba\xfb\xec\x35\xe5\xe3\x5e\x93\x1f\x65\x31\x95\x2d\xf4\x52\x97\xa6\xa0\x56\x81\xaa\xca\xe4\x0d\x8f\x30\x22\x02\xb5\x44\xe4\x9e\x84\xcc\x5e\x28\x34\x12\x57\x0f\x61\x52\x44\x18\x1d\x47\xc4\x6f\xa8\xb6\xa0\xc0\xaf\x07\x0b\x14\xa6\x16\x75\x36\x2b\xd6\x98\xf3\xd0\x6e\xdd\xe8\x61\xcf\x8d\xf8\x26\x90\x3c\x04\xaf\x8e\x99\xab\x68\x69\x42\xca\x7e\x83\xdc\x83\xe4\x45\x14\x9d\x14\x39\x41\x14\x49\xd8\x54\x78\xfd\x1a\xe1\x73\x11\x45\xcf\x14\x3e\x1a\xbd\x61\xcc\x4c\x61\x1e\x24\x07\xc1\xb8\x4c\x30\xc8\x4f\x82\x23\xa4\x95\xb2\x15\x3b\x22\x7e\x3a\x30\x87\x90\x98\xa3\x9a\x15\x49\x72\x5a\xe8\x1c\x86\xe2\x28\x7f\x1a\x62\x5c\xa3\x5a\x89\xa8\xa6\xd1\xa7\x13\xa4\x5d\x5d\x13\xa4\xfd\x4d\xb8\x57\xfd\x7e\xa7\x3e\x7c\x14\x91\xc2\xd0\xd6\xa1\x2d\x69\x9d\x8a\xb2\x32\x77\xc8\xd7\x6b\xf3\x35\x8f\x24\x26\x5c\xaa\xfd\x4c\x5c\x53\xf0\x76\x6b\xc3\x66\x5a\x6f\x2c\xcb\xed\xb6\x2d\xa8\x2a\xfd\xdb\xcf\x86\xb1\xd9\x6b\x90\x6e\xca\x50\xad\x43\x21\x31\x9f\x4e\x48\x81\x38\x48\xa4\x15\x68\xdb\x5b\x3d\xe9\xdd\x6e\xeb\x45\xe7\xd0\x9b\x20\x36\xb2\xdc\xba\xfb\xaf\x9b\x74\xdb\x64\xcb\x76\x2b\x5e\xd8\x9e\xad\x3d\x12\xb0\x24\x87\xb9\xbe\x2f\x1b\x82\xab\x06\x37\x22\xaf\x15\x68\x19\x55\x96\x80\x0f\x21\x66\x4a\x8b\x9f\x4e\x6a\xad\x3b\x4b\x28\x4c\xe8\x7d\xf3\xd0\x28\x52\x2b\x5e\xff\x41\x1d\xdc\x2f\xfb\x85\xef\xf8\x48\xe3\x88\xc1\x7b\x05\xd4\x03\x8b\xce\x37\xa1\x6a\x4e\xc1\xe3\x5a\x54\xb5\xdf\x5c\x83\xd3\x65\x2a\x72\xdc\x9b\xbf\x34\x96\xee\x64\x70\x2f\x4b\x3b\xc1\xb9\xab\xdf\x51\xf9\xf1\x41\xe9\xda\xbe\xd2\x1f\xf5\x9f\x1d\x6e\xaf\x2d\x7b\x55\xf9\x4b\xf4\xd5\x8b\x42\xcf\xef\x2f\x21\x0e\xdb\x69\x2a\x9a\x9e\x79\xe7\xd5\x08\x7a\x90\xb7\x64\xfb\xae\x73\xcc\x69\xce\xd3\x0e\x33\xfe\x95\x7b\xfc\xeb\x38\xf5\x45\x62\x59\xc6\x36\x11\x44\xd1\x0e\xf5\x5f\x1f\x4e\xe8\x03\x5b\x52\x9a\x40\x21\x01\xe6\xe3\xc0\x08\xf0\x81\x4b\x25\xc9\xd9\x0b\x46\xbe\xa6\xb4\xa8\xe3\xe4\x7b\xfb\xe0\x5b\x24\xee\xf2\xa8\x62\x36\xcb\xad\x68\xc7\x91\x8f\x8d\x36\xbd\xcb\xb1\x3a\xb4\x02\xa1\xfe\x7d\x38\xfe\x4e\x52\x3b\x7e\x06\xa5\xe3\xd3\x55\xd6\x5a\xe9\x67\x86\x5d\xdd\x2f\x20\xde\xd7\xbc\xf6\xd8\xe3\x71\xff\x79\xb0\x97\xee\x1e\x0b\x4e\xc0\xfc\x34\xc5\xe3\xa7\xab\x1d\x9f\xa8\x74\xe7\x57\xe9\xfb\x6e\xe7\x49\x5d\x8f\xd8\xcf\x51\x1a\x26\xba\x7c\x24\x60\x10\xae\xec\xe0\x91\xd8\x9e\x2b\x89\x49\x5c\x7f\x69\x14\x71\x3d\x86\xfc\xe5\xef\x8b\x1e\x11\x58\x54\xfc\x47\x91\x61\xb5\xe9\xd9\x39\xb0\xd6\x66\x07\xcd\x89\x98\x09\xb5\xa2\x6b\x7e\x67\x9c\x6b\x64\x7d\xb0\x1f\x62\x95\x80\xbb\xe6\x33\xec\x1d\x99\x05\xf3\x22\xcb\x04\x75\x0e\x20\xd2\xe4\x33\x75\xc4\xf3\x1f\xde\x71\x65\xea\xbd\xf7\x42\xaa\x65\x8e\xf3\x1f\xde\xb1\xc3\x16\xd7\xc7\x3f\x97\xd1\x2d\x81\xbb\xc6\xda\x0f\x1e\xf6\x73\x8d\x30\xff\x23\x8c\x6c\xb7\xf5\xce\x9d\x69\xf7\xef\xaa\x2f\x0d\x30\x47\xac\x07\x60\xb6\x4c\xec\x0c\x9d\x48\x68\x24\xc2\x82\xfe\xbb\x9d\xce\x1c\x3d\xa3\x5a\x53\x7c\xf2\x34\x16\x5f\x30\xdc\x7e\xbf\xa0\x12\x8a\xd6\x78\xbd\xb2\xfe\xf9\x43\xc0\x56\x1e\xfa\xd8\xbd\xd7\x93\x44\xe5\xb5\xc6\xc7\x54\x6d\xd5\x2b\xde\xd8\xea\x90\x92\xb8\xec\x47\xd1\x6f\x65\x18\xd1\x86\xff\x78\x3b\xd9\x85\xbe\xe9\x8a\x2c\x6c\xf5\x45\xdf\xf5\x9b\xa5\x46\xc9\x3a\x07\xe8\x6b\xb2\xb4\xb3\xd2\x17\x3f\x9a\x38\x84\xd3\x7e\x60\x1e\x83\x4b\x17\x16\x8d\x87\xff\xdb\x9e\x50\xbc\xcc\x98\x7a\x71\xf3\x8a\xc7\x85\xd4\x53\x70\x39\x04\xcb\xfe\x3f\xff\x3b\x00\x64\x20\x76\x02\x5a\x32\x00\x00")
+
+func templateDialectSqlFeatureUpsertTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateDialectSqlFeatureUpsertTmpl,
+		"template/dialect/sql/feature/upsert.tmpl",
+	)
+}
+
+func templateDialectSqlFeatureUpsertTmpl() (*asset, error) {
+	bytes, err := templateDialectSqlFeatureUpsertTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/dialect/sql/feature/upsert.tmpl", size: 12890, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateDialectSqlGlobalsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\xcf\x41\x6b\x02\x31\x10\x05\xe0\x7b\x7e\xc5\xc3\xa3\xd0\x5d\xeb\xad\x47\x11\x0b\x42\xe9\xa5\xfd\x03\x6b\xf2\xd6\x0d\x4d\x33\xeb\x4c\xb6\x20\x21\xff\xbd\x28\x16\x7a\x9d\x6f\x66\x1e\xaf\xd6\x7e\xed\xf6\x32\x5f\x35\x9e\xa7\x82\xed\xe6\xf9\xe5\x69\x56\x1a\x73\xc1\xeb\xe0\x79\x12\xf9\xc2\x31\xfb\x0e\xbb\x94\x70\x5f\x32\xdc\x5c\x7f\x18\x3a\xf7\x39\x45\x83\xc9\xa2\x9e\xf0\x12\x88\x68\x48\xd1\x33\x1b\x03\x96\x1c\xa8\x28\x13\xb1\x9b\x07\x3f\x11\xdb\x6e\xf3\xa7\x18\x65\xc9\xc1\xc5\x7c\xf7\xb7\xe3\xfe\xf0\xfe\x71\xc0\x18\x13\xf1\x98\xa9\x48\x41\x88\x4a\x5f\x44\xaf\x90\x11\xe5\x5f\x58\x51\xb2\x73\xeb\xbe\x35\xe7\x6e\x1d\xe0\x17\x2b\xf2\x8d\x73\x92\xd3\x90\x0c\x43\x0e\x98\x98\x66\xaa\x61\x14\x85\x5d\x12\x42\x1c\x12\x7d\x31\xdc\xcf\x6a\x45\xe0\x18\x33\xb1\x7a\x40\x6f\x97\xd4\x3f\x1e\xac\xd0\x5a\xad\x60\x0e\x68\xcd\xfd\x0e\x00\xa2\x7b\x06\xae\x26\x01\x00\x00")
 
 func templateDialectSqlGlobalsTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -792,7 +847,7 @@ func templateDialectSqlGlobalsTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectSqlGroupTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\x5f\x6b\x1b\x3f\x10\x7c\xf6\x7d\x8a\x8d\xc9\x2f\xdc\xf9\x77\x91\xd3\xbc\x35\x25\x0f\x89\x49\x4b\xa0\x84\xb6\x2e\x7d\x29\xa5\x28\xd2\xca\x16\x91\xa5\xb3\xa4\x73\x62\x0e\x7d\xf7\xb2\xf2\x39\x7f\xdc\xba\xa1\x60\xb0\xd8\x19\xed\x8c\x76\xc7\xee\xba\xf1\xa8\x98\xb8\x66\xed\xf5\x6c\x1e\xe1\xf4\xe4\xcd\xdb\xe3\xc6\x63\x40\x1b\xe1\x3d\x17\x78\xeb\xdc\x1d\x5c\x5b\xc1\xe0\xc2\x18\xc8\xa4\x00\x84\xfb\x15\x4a\x56\x7c\x9d\xeb\x00\xc1\xb5\x5e\x20\x08\x27\x11\x74\x00\xa3\x05\xda\x80\x12\x5a\x2b\xd1\x43\x9c\x23\x5c\x34\x5c\xcc\x11\x4e\xd9\xc9\x16\x05\xe5\x5a\x2b\x0b\x6d\x33\xfe\xf1\x7a\x72\x75\x33\xbd\x02\xa5\x0d\x42\x5f\xf3\xce\x45\x90\xda\xa3\x88\xce\xaf\xc1\x29\x88\xcf\xc4\xa2\x47\x64\xc5\x68\x9c\x52\x51\x74\x1d\x48\x54\xda\x22\x0c\xa5\xe6\x06\x45\x1c\x87\xa5\x19\xcf\xbc\x6b\x9b\x21\xa4\x44\x84\xc3\xdb\x56\x1b\xb2\x73\x76\x0e\x0d\x0f\x82\x1b\x38\x64\x53\xe1\x1a\x64\x97\x3d\xd2\x13\x3d\x0a\xd4\xab\x0d\xf3\xf1\xfc\x78\x9d\xf4\x54\x6b\x05\x94\x2f\xb8\x29\xc1\xe8\xb9\x4a\x4a\x15\x84\xa5\x99\x0a\x6e\x4b\x11\x1f\x40\x38\x1b\xf1\x21\xb2\xc9\xe6\xbb\x86\x15\x68\x1b\xd1\x2b\x2e\xb0\x4b\x15\xa0\xf7\xce\x43\x57\x0c\x94\xf3\xf0\xb3\x06\x95\xd5\xb9\x9d\x21\xec\xe8\x30\xa5\xd1\xc8\x40\xdc\x81\x56\x70\x40\x30\xfb\xc4\xc5\x1d\x9f\x21\xc1\xdf\xb8\xd1\x72\xe2\x4c\xbb\xb0\xa5\xaa\x32\x6d\xe0\x31\xb6\xde\xc2\x51\xc6\x78\xd4\xce\x5e\x91\x5e\x77\xc3\x17\x78\x06\xaa\x26\xf9\x33\x50\x8b\xc8\x72\x5d\x95\x43\x6d\x57\xc4\x85\x2c\x06\xff\x2d\x81\x7c\xe5\x81\x1e\xdf\xae\x87\x35\xa8\x2a\x15\x83\x41\x2a\xe8\x13\xd0\xe4\x1d\x91\xe5\x5d\xb3\x61\x69\x3e\xb7\xe8\xd7\x65\x55\x90\x5b\xf4\x99\xb5\xbd\x41\x72\x65\xf5\x2e\x97\x0f\xce\xc1\x6a\x93\xfd\xf6\x76\xd1\xfb\xdc\xdf\xbb\xfb\x40\xb7\x8e\xc2\xd2\xb0\x2f\xee\x3e\x74\xa9\x18\x2c\xa9\x6b\x0d\xdc\xcf\xc2\x8b\x8e\x7f\x50\xdb\xf5\x24\x3d\x9d\x7a\xa6\x88\x0f\x35\x3c\x6b\x56\x03\xc9\xbd\xea\x49\xa2\x42\x9f\xa9\x6c\x62\x5c\x40\x52\xec\x29\xe4\x92\xf6\x3e\xa5\xa4\x97\x44\xa9\x61\x55\x15\xa9\xf8\x97\xe0\xf4\xcf\x80\x51\xee\xb6\x1d\x70\xf7\xea\xb0\x8b\x81\xc8\xab\xcf\x43\x59\xf0\x3b\x2c\xbf\xff\x08\xd1\x6b\x3b\xab\xe1\xa4\x06\x83\x76\x57\xbe\xcf\x53\x05\xff\xff\x86\x12\x68\x43\x55\x3d\x35\x3d\x07\xde\x34\x68\x65\xd9\x17\xea\x3d\xe9\x64\x8c\x55\x4f\x59\xb6\x7f\x09\xb3\xdd\x24\x79\xbf\x80\xb2\xe5\xf6\xcd\x1b\xb5\x3d\x61\x27\x9b\xe9\x69\x09\xdb\x3c\x6c\x66\xb7\x6d\x47\xbe\xd8\x07\x8a\xf1\xe5\x7a\xcf\x20\xb2\xf5\xfc\x4f\x80\x56\xd2\xcf\xfd\x57\x00\x00\x00\xff\xff\x39\xd5\x28\xb4\x22\x05\x00\x00")
+var _templateDialectSqlGroupTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\x5d\x6f\xdb\x36\x14\x7d\xb6\x7e\xc5\x69\xd0\x15\x52\xa6\xd0\x59\xdf\x96\x21\x0f\xad\x91\x0d\x05\x82\xa2\xad\x87\xbd\x0c\xc3\xc0\x50\x97\x32\x61\x86\xb4\x49\xca\x8e\x21\xf0\xbf\x0f\x97\x51\x5a\x37\x6b\x1a\x14\x30\x60\x82\xe7\xf0\x9e\x73\xbf\x34\x8e\xf3\xd3\x6a\xe1\x37\x87\x60\xfa\x55\xc2\xeb\xf3\x5f\x7e\x3d\xdb\x04\x8a\xe4\x12\x7e\x97\x8a\x6e\xbc\x5f\xe3\x9d\x53\x02\x6f\xac\x45\x21\x45\x30\x1e\x76\xd4\x89\xea\xcf\x95\x89\x88\x7e\x08\x8a\xa0\x7c\x47\x30\x11\xd6\x28\x72\x91\x3a\x0c\xae\xa3\x80\xb4\x22\xbc\xd9\x48\xb5\x22\xbc\x16\xe7\x0f\x28\xb4\x1f\x5c\x57\x19\x57\xf0\xeb\x77\x8b\xab\xf7\xcb\x2b\x68\x63\x09\xd3\x5d\xf0\x3e\xa1\x33\x81\x54\xf2\xe1\x00\xaf\x91\x8e\xc4\x52\x20\x12\xd5\xe9\x3c\xe7\xaa\x1a\x47\x74\xa4\x8d\x23\x9c\x74\x46\x5a\x52\x69\x1e\xb7\x76\xde\x07\x3f\x6c\x4e\x90\x33\x13\x5e\xde\x0c\xc6\xb2\x9d\x8b\x4b\x6c\x64\x54\xd2\xe2\xa5\x58\x2a\xbf\x21\xf1\x76\x42\x26\x62\x20\x45\x66\x77\xcf\xfc\x7c\xfe\xfc\x9c\xf5\xf4\xe0\x14\xea\xaf\xb8\x39\xe3\xf4\x58\x25\xe7\x06\x71\x6b\x97\x4a\xba\x5a\xa5\x3b\x28\xef\x12\xdd\x25\xb1\xb8\xff\x6f\xb1\x83\x71\x89\x82\x96\x8a\xc6\xdc\x80\x42\xf0\x01\x63\x35\xd3\x3e\xe0\xdf\x16\xba\xa8\x4b\xd7\x13\x1e\xe9\x08\x6d\xc8\x76\x91\xb9\x33\xa3\xf1\x82\x61\xf1\x41\xaa\xb5\xec\x89\xe1\xbf\xa4\x35\xdd\xc2\xdb\xe1\xd6\xd5\xba\x29\xb4\x59\xa0\x34\x04\x87\x57\x05\x93\xc9\x78\x77\xc5\x7a\xe3\x7b\x79\x4b\x17\xd0\x2d\xcb\x5f\x40\xdf\x26\x51\xee\x75\x7d\x62\xdc\x8e\xb9\x28\x62\xf8\x69\x0b\xf6\x55\x0a\x7a\x76\x73\x38\x69\xa1\x9b\x5c\xcd\x66\xb9\xe2\x5f\x24\x2e\xb9\x2f\x05\x7b\x6c\x36\x6e\xed\xc7\x81\xc2\xa1\x6e\x2a\x76\x4b\xa1\xb0\x1e\x5e\xb0\x5c\xdd\xfc\xc6\xf2\x78\x71\x09\x67\x6c\xf1\x3b\xd9\xa5\x10\x4a\xfc\xe0\xf7\x91\x5f\xbd\x8a\x5b\x2b\x3e\xf9\x7d\x1c\x73\x35\xdb\x72\xd4\x16\x32\xf4\xf1\xab\x88\xdf\x50\x7b\xec\xa9\x0b\x7c\x9a\x98\x2a\xdd\xb5\x38\x0a\xd6\x82\xe5\x9e\xf5\xd4\x91\xa6\x50\xa8\x62\x61\x7d\x24\xce\x6f\xa2\xb0\x4b\xee\xfb\x92\x27\xbd\x66\x4a\x8b\x5d\x53\xe5\xea\x47\x06\x67\x4a\x03\xa7\x25\xda\x94\x1c\x17\x67\x3e\xc7\x27\xbf\x3f\xb3\xb4\x23\x0b\xeb\xd5\x3a\x42\x06\x82\xf3\x09\xd2\x5a\xbf\xa7\x0e\x7b\x93\x56\x90\x7d\x1f\xa8\x97\x89\xc0\xa2\xdc\xf2\xc8\x8b\xf5\xc1\xc7\xd4\x07\x5a\x7e\xbc\x16\xcf\x36\x4e\x2c\x2c\xc9\x70\xed\xd5\x9a\xb3\x53\x65\xa4\x4a\xb1\x6f\xe5\x9a\xea\xbf\xff\x89\x29\x18\xd7\xb7\x38\x6f\x61\xc9\xd5\xdf\x9e\xd3\x06\x3f\xff\x0f\x65\xd0\xc5\xe6\x28\xe8\x25\xe4\x66\x43\xae\xab\xa7\x8b\xf6\x89\xa9\x17\x42\x34\x5f\x76\xc4\x7d\x67\x49\xdc\xfd\x86\x3c\x2d\xa0\x5d\xfd\x90\x7f\x8b\xef\x2c\x11\xdb\xcc\x5f\x9a\x3b\x3d\x99\x7a\xf2\x10\x8e\x7d\x89\x3f\x78\x3d\xde\x1e\x9e\x28\x04\x53\xaa\xf2\x85\x21\xd7\x21\xe7\xea\xbf\x01\x00\xab\x8f\xfe\x6f\x7a\x05\x00\x00")
 
 func templateDialectSqlGroupTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -807,12 +862,12 @@ func templateDialectSqlGroupTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/group.tmpl", size: 1314, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/group.tmpl", size: 1402, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlMetaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x55\x4d\x6f\xdb\x38\x10\x3d\x5b\xbf\x62\x60\xf8\x90\x14\x89\x94\xe6\xb6\x0b\xf8\x50\xe4\x03\x30\xd2\x04\xdd\x4d\xb6\x97\xa2\x58\x30\xe2\xc8\x26\x42\x93\x2e\x49\x3b\x11\x04\xff\xf7\xc5\xf0\x43\xa2\xbc\x71\x7b\xe8\xcd\x22\x87\x6f\x66\xde\xbc\x37\xee\xba\xea\x43\x71\xa5\x37\xad\x11\xcb\x95\x83\xcb\x8b\x8f\x7f\x9c\x6f\x0c\x5a\x54\x0e\x6e\x59\x8d\xcf\x5a\xbf\xc0\x42\xd5\x25\x7c\x92\x12\x7c\x90\x05\xba\x37\x3b\xe4\x65\xf1\xb4\x12\x16\xac\xde\x9a\x1a\xa1\xd6\x1c\x41\x58\x90\xa2\x46\x65\x91\xc3\x56\x71\x34\xe0\x56\x08\x9f\x36\xac\x5e\x21\x5c\x96\x17\xe9\x16\x1a\xbd\x55\xbc\x10\xca\xdf\x7f\x5e\x5c\xdd\x3c\x3c\xde\x40\x23\x24\x42\x3c\x33\x5a\x3b\xe0\xc2\x60\xed\xb4\x69\x41\x37\xe0\xb2\x64\xce\x20\x96\xc5\x87\x6a\xbf\x2f\x0a\xea\x01\x6a\xad\xac\x63\xca\x59\x50\x88\x1c\x39\x34\xda\x80\xfd\x21\x81\x0b\x26\xb1\x76\xb6\x04\x1f\xdd\x75\xc0\xb1\x11\x0a\x61\x1a\x6f\x2a\xfb\x43\x56\x6b\x74\xac\xea\x31\xa6\xb0\xdf\x17\x93\xae\x3b\x07\xc3\xd4\x12\x61\xe6\xe0\xcf\x39\xcc\xca\xbf\x51\x32\x87\xfc\xa9\xdd\xa0\xf5\x21\x3e\x46\x34\xa0\x28\xa6\x5c\x5c\x97\x8f\x4e\x1b\xb6\xc4\x3b\x6c\x61\x76\xf0\xed\xe3\x27\x55\x05\x5d\x47\xc1\x0f\x6c\x8d\xb0\xdf\xdf\x0a\x94\x7c\x71\x0d\x2b\x2d\xb9\xf5\x8d\x5b\x67\x84\x5a\x02\x47\xa5\x1d\xfd\xa0\xb3\xc5\x35\x34\x14\x18\x68\xc0\x31\x44\x49\xb8\xef\x82\xce\x61\x1a\xce\x0f\x2b\x99\xc6\xd2\x51\xf1\xbe\xd5\xf4\xbb\xaa\xe0\x89\x3d\x4b\xcc\x4a\x72\xfe\x5b\x11\xf8\x50\x80\xd4\xaf\x68\x60\x96\x72\xa6\xb9\x71\xe6\xd8\x33\xb3\x58\x16\x93\x00\x13\x8b\x28\xc3\x97\xcf\x9d\x31\x8b\x81\xd9\x1b\xbe\x4c\x94\x46\x86\x30\x3c\xb8\x8a\x33\xf1\x19\xf2\x6a\xe8\xd7\x50\x61\x78\x91\x4a\x31\x34\x27\xa1\x55\x85\x7c\x49\x85\xa4\x31\xcd\xb0\xbc\xbf\xbc\xa7\x88\xa7\x15\xc2\xc6\x88\x35\x33\x2d\xbc\x60\x0b\x1c\x6b\xc9\x0c\x72\x78\x46\xa9\x5f\xcb\xae\xeb\xe9\x98\x1c\x29\x26\xb6\x85\x24\x8a\xbc\xb7\x5c\x12\xf1\x9c\x9e\xb7\x1b\xec\xa3\x32\x1d\x60\xb9\x50\x3b\x34\x16\x7f\xde\xac\xa7\x9e\x14\x3d\xf4\xea\x11\x53\xc3\xa8\x9c\x70\x6d\x19\x81\x17\x0e\xf0\x4d\x58\x67\xc3\x4c\x84\x85\x0d\xab\x5f\xd8\xd2\x7b\x4b\x1b\xef\x4a\x0d\x6c\xa7\x05\x87\x5a\x98\x7a\x2b\x99\x01\x8e\x1b\x54\x1c\x55\xdd\xc2\xab\x70\x2b\x9f\x69\x9a\xa5\xfa\x12\x21\xf6\xfb\x69\x82\xeb\x85\x77\xbc\x8b\xf9\x08\xe3\x90\xa6\x8c\xe3\xc0\x99\x76\xc3\x8c\x46\x2c\x5d\x69\xb9\x5d\xab\xa3\xfc\xd4\xfe\x7a\xec\x99\x5f\x48\x62\x72\x0c\x78\x34\xd8\x70\xfd\x73\xc7\x0c\x62\x09\xab\xe8\x2b\x33\x82\xaa\xfa\x9d\x55\xb4\x4b\x18\xd3\xe4\xc9\x50\x89\x8d\x9a\x67\x52\xc2\xe3\x5f\x9f\x63\xe3\xd6\xa7\x78\xc7\x93\x7e\x69\xd8\xb2\x98\xec\x98\xe9\x11\xe6\xf0\xed\x7b\x58\x32\x5d\x94\x37\xed\x87\x8c\x82\xb3\xd8\x6b\xb4\x68\x13\x2c\xea\x97\x4a\xf4\xa8\x7f\xd5\xbc\xf7\x26\xf1\xe3\x29\xaa\x3e\xc0\xa2\x01\xa6\xda\xb4\x36\xd0\xdb\x5c\xbf\x2a\x0b\x8c\x6a\x46\xb1\x54\xe7\xe4\x3f\x4f\x08\xa1\x7a\xed\xcd\xca\x7f\x14\xbe\x6d\xb4\x71\xc8\x6f\x43\xd4\x1d\xb6\xc3\x7e\xc8\xcf\x86\x1d\x40\x7c\x64\x98\x74\xc8\x1c\x30\x83\x94\x90\xac\xdd\x0e\xa2\x1e\x49\xb1\xaa\xa2\x8c\x98\xe2\x3e\x9e\x74\x18\x46\xc3\x81\x59\xa0\x1e\x39\x93\x5a\x61\x24\x34\xed\x3a\x5b\xaf\x70\xcd\x48\x4d\xc4\x6f\x5e\xd5\x98\xe3\x11\x9b\x2f\x44\x67\x19\x79\x9c\xf8\x6a\x9a\x17\xbf\x00\x0f\x14\x77\x96\x5e\xf6\x36\x09\x14\x25\xb1\x65\x74\x3d\x6c\xd7\xbd\x69\xa8\x94\x93\x83\xa4\xff\x9e\xbd\xb7\x69\xff\xbf\x17\xfd\xe1\xe0\xba\x2f\x77\xb9\x31\x88\x9d\x23\xa6\xb9\xf4\xb4\x1d\xfa\xd1\x8e\x0c\xd9\x63\xe7\x7b\x77\xbc\xd3\x0e\xcd\x0a\x27\xf7\x97\xf7\xa7\x65\x78\xf9\x5e\x49\x19\xcd\x44\xa4\x50\x1c\xdf\xc6\xd6\xb5\x70\xe1\xb9\x84\xa3\xf7\x1f\xe9\x7e\xa0\xa3\x27\x7b\xfc\x75\x9a\x53\x7f\xe8\xf8\x66\xab\x6a\xaa\xf7\x77\x1c\xdf\x63\x78\xc7\x57\x15\x7c\x65\x52\xf0\xa8\x06\x83\x64\x06\x4b\xb3\x22\xb6\xe2\xba\xf3\xff\x0a\xc2\xc2\x8e\x22\xe1\x64\xc3\x8c\x4b\x46\x1b\x8d\xe1\xb4\x2c\x08\x3d\x47\x3c\x89\x10\x81\xbc\x53\x78\xd6\x5a\x42\x57\x4c\xa8\x70\x41\x42\x09\xc2\x49\x1c\x91\x88\x45\x93\xf2\xce\xe7\xe9\xe2\x9b\xf8\xee\xef\x26\x06\xdd\xd6\x28\x70\x66\x8b\x41\xa7\x71\x45\xfe\xda\xce\x07\x19\xf3\x7b\x8f\x3c\x4a\x9b\xdd\xf6\xa9\x0f\x72\x4f\x7a\xa3\x0c\xd3\x8b\x11\x0d\x93\x16\x8b\x7c\x7c\xff\x05\x00\x00\xff\xff\xf8\x5f\x6f\xc9\xff\x0a\x00\x00")
+var _templateDialectSqlMetaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x55\x4f\x6f\xdb\xb8\x13\x3d\x4b\x9f\x62\x60\xf8\x90\x04\x09\x95\xe6\xf6\xfb\x01\x3e\x14\xf9\x03\x18\x69\x82\xee\x26\xdb\x4b\x51\x2c\x18\x71\x64\x13\xa1\x49\x97\xa4\x9d\x18\x82\xbf\xfb\x62\x48\x4a\xa2\xdc\x24\x3d\xf4\x26\x91\xa3\x37\x33\x6f\xe6\x3d\xb5\x6d\x75\x52\x5e\x9a\xf5\xce\xca\xc5\xd2\xc3\xc5\xf9\xa7\xff\x9d\xad\x2d\x3a\xd4\x1e\x6e\x78\x8d\x4f\xc6\x3c\xc3\x5c\xd7\x0c\x3e\x2b\x05\x21\xc8\x01\xdd\xdb\x2d\x0a\x56\x3e\x2e\xa5\x03\x67\x36\xb6\x46\xa8\x8d\x40\x90\x0e\x94\xac\x51\x3b\x14\xb0\xd1\x02\x2d\xf8\x25\xc2\xe7\x35\xaf\x97\x08\x17\xec\xbc\xbb\x85\xc6\x6c\xb4\x28\xa5\x0e\xf7\x5f\xe6\x97\xd7\xf7\x0f\xd7\xd0\x48\x85\x90\xce\xac\x31\x1e\x84\xb4\x58\x7b\x63\x77\x60\x1a\xf0\x59\x32\x6f\x11\x59\x79\x52\xed\xf7\x65\x49\x3d\x40\x6d\xb4\xf3\x5c\x7b\x07\x1a\x51\xa0\x80\xc6\x58\x70\x3f\x15\x08\xc9\x15\xd6\xde\x31\x08\xd1\x6d\x0b\x02\x1b\xa9\x11\x26\xe9\xa6\x72\x3f\x55\xb5\x42\xcf\xab\x1e\x63\x02\xfb\x7d\x59\xb4\xed\x19\x4c\xa5\x78\xc6\x1d\xfc\x7f\x06\x13\x3a\x6c\x5b\x78\x91\x7e\x09\x53\x36\xbf\x8a\xaf\x29\x60\x06\xec\xc1\x1b\xcb\x17\x78\x8b\xbb\x78\x83\x5a\xf4\x30\x96\xeb\x05\xc2\xd4\x13\xd0\x94\xfd\x8d\x8a\x7b\x14\x8f\xbb\x35\xba\x10\x12\x62\x22\xb0\x8f\xc8\x65\x11\x0f\x65\x03\x1a\x47\xd8\x29\x61\x0c\x29\xaa\x0a\xda\x96\xbe\xba\xe7\x2b\x84\xfd\xfe\x46\xa2\x12\xf3\x2b\x58\x1a\x25\x5c\xe0\xd1\x79\x2b\xf5\x02\x04\x6a\xe3\xe9\x81\xce\xe6\x57\xd0\x50\x60\x64\x15\xc7\x10\x2c\x00\xbf\x89\x3a\x83\x49\xdb\x1e\x74\x3a\xe9\x4a\xed\xfa\x1d\xbd\xe4\xcf\x55\x05\x8f\xfc\x49\x61\x56\x9c\x0f\xef\x9a\x12\x0f\xa5\x28\xf3\x82\x16\xa6\x5d\xf2\x6e\x21\x04\xf7\xfc\x89\x3b\x64\x65\x11\x61\x62\x35\x53\x16\xdf\xf6\xfb\xc9\x88\x6b\x8c\x5c\x5f\x8b\x45\x47\x72\xe2\x0a\xe3\x07\x97\x69\xd8\x21\x43\x5e\x8d\x5f\xe6\x15\x12\x0f\xd8\x97\x62\x69\x72\xd2\xe8\x0a\xc5\x82\x0a\xe9\x66\x34\x45\x76\x77\x71\x47\x50\x8f\x4b\x84\xb5\x95\x2b\x6e\x77\x40\x9b\x23\xb0\x56\xdc\xa2\x80\x27\x54\xe6\x85\x65\x7b\x51\xbc\x53\x4c\x6a\x0b\x69\x4d\xf2\xde\xb2\x7d\xe8\x7a\x9e\x22\xa3\x25\xea\xa3\xca\x61\x23\x90\xcd\xf5\x16\xad\xc3\x8f\x9b\x0d\xd4\x93\x54\x86\x5e\x03\x62\xd7\x30\x6a\x2f\xfd\x8e\x25\xe0\xb9\x07\x7c\x95\xce\xbb\x38\x13\xe9\x60\xcd\xeb\x67\xbe\x08\xa2\x35\x36\xc8\xdd\x00\xdf\x1a\x29\xa0\x96\xb6\xde\x28\x6e\x41\xe0\x1a\xb5\x40\x5d\xef\xa2\x76\x28\x53\xea\x30\xa4\xfa\x9a\x20\xf6\xfb\x49\x07\x17\xf2\x7d\xdc\x45\xcf\xd2\x88\x80\x8e\xa6\x8c\xe3\xc8\x99\xf1\xc3\x8c\x46\x2c\x5d\x1a\xb5\x59\xe9\x77\xf9\xa9\xc3\xf5\x58\x3d\xbf\x59\x89\xe2\x3d\xe0\xd1\x60\x63\xde\x37\x4a\xce\x9e\x87\x65\x89\x1e\xf7\x8d\x5b\x49\x55\xfd\x89\xc7\x6d\x3b\x8c\x49\xa7\xc9\x58\x89\x4b\x3b\xcf\x95\x82\x87\xbf\xbe\x40\x9d\x4e\x69\x37\xde\xd0\x64\xb0\x0f\xc7\xca\x62\xcb\x6d\x8f\x30\x83\xef\x3f\xa2\xdd\xb4\xa9\xa9\xdc\x2c\x13\x35\x2c\xa3\xe4\xf4\xa0\xf7\x5c\xbf\x4d\xd4\x6f\x30\xb4\x24\xe0\xc0\x6c\xf3\x11\x40\xe0\xaf\x3a\x81\x79\x03\x5c\xa7\x9f\x06\x02\x89\xd5\x81\x79\xd1\x0e\x38\x71\x86\x72\xa1\xcf\x48\x9c\xe1\x8f\x50\x0c\xa6\xfe\x8f\xc6\xd7\xb5\xb1\x1e\xc5\x4d\x8c\xba\xc5\xdd\x60\x1e\xf9\xd9\x60\x10\x44\x56\x86\x49\x87\xdc\x03\xb7\x48\x09\x49\xf7\xbb\x61\xe3\x47\x7b\x5a\x55\x69\xc7\xb8\x16\x21\x5e\xd3\x0f\x2f\xcc\x4d\x00\x77\x40\x3d\x0a\xae\x8c\xc6\x68\xd6\x49\x74\x08\xae\x5e\xe2\x8a\x93\x46\x88\xfc\xbc\xaa\xf1\x00\x46\x6c\x3e\x13\x9d\x2c\xf1\x58\x84\x45\x6c\x9e\x83\x3b\x1e\xac\xe3\xe9\x2f\x7e\x1e\x48\xed\x5e\x73\xba\xee\x37\xab\x5e\x51\x54\xca\xd1\x41\xd2\x7f\x4f\xdf\xb2\xe1\x5f\x4d\x93\x3e\xcb\x24\xf9\xf5\x36\x9b\x70\x60\xe7\x1d\x45\x5d\x04\xda\x0e\xc5\xea\x46\x6a\xed\xb1\x73\x53\x1e\x1b\xde\xa1\x92\xe1\xe8\xee\xe2\xee\x98\xf8\xed\xc5\x3c\x2e\x29\xa3\x99\x88\x94\x5a\xe0\xeb\x58\xd7\x0e\xce\x69\xca\xa7\xf0\xee\xfd\x27\xba\x1f\xe8\xe8\xc9\x1e\xbf\x1d\xe7\xd4\x67\x43\x08\x76\xd0\x6c\x74\x4d\xf5\xfe\x89\x1d\xf4\x18\xc1\x0e\xaa\x0a\xbe\x71\x25\x45\xda\x06\x8b\x24\x06\x47\x06\xea\x97\xbd\x17\x86\x5f\x86\x74\xb0\xa5\x48\x38\x5a\x73\xeb\x3b\xa1\x8d\xc6\x70\xcc\x4a\x42\xcf\x11\x8f\x12\x44\x24\xef\x18\x9e\x8c\x51\xd0\x96\x05\xcd\x43\xd2\x82\xc6\xc5\xe9\x38\xa2\x25\x96\x4d\x97\x77\x36\xeb\x2e\xbe\xcb\x1f\xf4\x55\x51\x58\xf4\x1b\xab\xc1\xdb\x0d\xc6\x3d\x4d\xfe\xf9\x7b\x39\x1f\x64\xcc\xef\x03\xf2\x28\x6d\x76\xdb\xa7\x3e\xc8\x5d\xf4\x42\x19\xa6\x97\x22\x1a\xae\x1c\x96\xf9\xf8\xfe\x1b\x00\x5a\x2d\xd0\xd2\x75\x0b\x00\x00")
 
 func templateDialectSqlMetaTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -827,12 +882,12 @@ func templateDialectSqlMetaTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/meta.tmpl", size: 2815, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/meta.tmpl", size: 2933, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlOpenTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8e\x41\x8b\xdb\x30\x14\x84\xcf\xd6\xaf\x98\xe6\x64\x07\x57\x4e\x73\x6b\x21\x87\x90\xa6\x10\x28\xe9\x21\xfd\x03\xae\xf4\x5c\x8b\xd5\x3e\x29\x4f\xb2\x97\x60\xfc\xdf\x17\x9b\x0d\xec\xf1\xcd\xcc\x9b\x6f\xa6\xa9\xd9\xaa\x53\x88\x0f\x71\xff\xfb\x8c\xfd\xee\xdb\xf7\xaf\x51\x28\x11\x67\xfc\x6a\x0d\xfd\x0b\xe1\x05\x17\x36\x1a\x47\xef\xb1\x86\x12\x16\x5f\x46\xb2\x5a\xfd\xed\x5d\x42\x0a\x83\x18\x82\x09\x96\xe0\x12\xbc\x33\xc4\x89\x2c\x06\xb6\x24\xc8\x3d\xe1\x18\x5b\xd3\x13\xf6\x7a\xf7\x74\xd1\x85\x81\xad\x72\xbc\xfa\xbf\x2f\xa7\xf3\xf5\x76\x46\xe7\x3c\xe1\x43\x93\x10\x32\xac\x13\x32\x39\xc8\x03\xa1\x43\xfe\x04\xcb\x42\xa4\xd5\xb6\x99\x67\xa5\xa6\x09\x96\x3a\xc7\x84\x8d\x75\xad\x27\x93\x9b\x74\xf7\x8d\xf1\x8e\x38\x37\x21\x12\x6f\x30\xcf\xaa\xb0\x32\xd6\x20\x11\xfc\x38\x20\xdd\xbd\xfe\x13\x89\x4b\x2b\x6e\x24\xb9\xb6\xaf\x54\xc3\xb6\xb9\xbd\xad\xfd\xcb\x5d\xa9\xc2\x75\x6b\xfe\xcb\x01\xec\x3c\x26\x55\x14\x42\x79\x10\x5e\xce\xb5\x4a\x15\xb3\x7a\x6a\x57\x7a\x3b\xad\xc8\xb2\x8d\x91\xd8\x96\x21\x66\x17\x38\xd5\xf8\xb9\x32\x4a\x2b\x63\x55\x69\xad\xab\x7a\xf9\x5f\x66\x13\xdb\x65\xd9\x7b\x00\x00\x00\xff\xff\x01\xec\x54\x15\x85\x01\x00\x00")
+var _templateDialectSqlOpenTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8f\xc1\x8a\xdb\x30\x14\x45\xd7\xd6\x57\xdc\x66\x65\x07\x57\x4e\xb3\x6b\x21\x8b\x90\xa6\x10\x28\xe9\x22\xfd\x01\x57\x7a\xae\xc5\x68\x9e\x94\x27\xd9\x43\x30\xfe\xf7\xc1\x66\x02\xb3\xbc\xf7\x5c\xe9\xf0\xa6\xa9\xd9\xaa\x53\x88\x0f\x71\xff\xfb\x8c\xfd\xee\xdb\xf7\xaf\x51\x28\x11\x67\xfc\x6a\x0d\xfd\x0b\xe1\x05\x17\x36\x1a\x47\xef\xb1\x8e\x12\x16\x2e\x23\x59\xad\xfe\xf6\x2e\x21\x85\x41\x0c\xc1\x04\x4b\x70\x09\xde\x19\xe2\x44\x16\x03\x5b\x12\xe4\x9e\x70\x8c\xad\xe9\x09\x7b\xbd\x7b\x52\x74\x61\x60\xab\x1c\xaf\xfc\xf7\xe5\x74\xbe\xde\xce\xe8\x9c\x27\x7c\x74\x12\x42\x86\x75\x42\x26\x07\x79\x20\x74\xc8\x9f\x64\x59\x88\xb4\xda\x36\xf3\xac\xd4\x34\xc1\x52\xe7\x98\xb0\xb1\xae\xf5\x64\x72\x93\xee\xbe\x31\xde\x11\xe7\x26\x44\xe2\x0d\xe6\x59\x15\x56\xc6\x1a\x24\x82\x1f\x07\xa4\xbb\xd7\x7f\x22\x71\x69\xc5\x8d\x24\xd7\xf6\x95\x6a\xd8\x36\xb7\xb7\xf5\x98\x25\x57\xaa\x70\xdd\xba\xff\x72\x00\x3b\x8f\x49\x15\x85\x50\x1e\x84\x97\xb8\x7e\xa5\x8a\x59\x3d\xbb\x2b\xbd\x9d\x56\x65\xd9\xc6\x48\x6c\xcb\x10\xb3\x0b\x9c\x6a\xfc\x5c\x1d\xa5\x95\xb1\xaa\xb4\xd6\x55\xbd\xbc\x57\xd3\x04\x62\x8b\x79\x56\xef\x03\x00\x01\xec\x54\x15\x85\x01\x00\x00")
 
 func templateDialectSqlOpenTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -852,7 +907,27 @@ func templateDialectSqlOpenTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectSqlPredicateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\xdf\x6f\xdb\x36\x10\x7e\x96\xff\x8a\x83\x61\x60\x72\xe0\x52\x49\xde\x36\x20\x03\x02\x37\xc1\x8c\xb6\x4e\x36\x1b\xeb\x43\x10\x0c\x8c\x78\xb2\x88\xd2\x24\x43\xd2\x76\x0d\x41\xff\xfb\x40\xea\x87\x65\xa7\x4d\xd2\x64\x43\x5f\xf2\x26\xf1\x7e\xf0\xbe\xbb\xef\xb3\xce\x45\x91\x1c\xf5\xc6\x4a\x6f\x0d\x5f\xe4\x0e\x4e\x8f\x4f\x7e\x7d\xa7\x0d\x5a\x94\x0e\x2e\x69\x8a\x77\x4a\x7d\x81\x89\x4c\x09\x9c\x0b\x01\xc1\xc9\x82\xb7\x9b\x35\x32\xd2\x9b\xe7\xdc\x82\x55\x2b\x93\x22\xa4\x8a\x21\x70\x0b\x82\xa7\x28\x2d\x32\x58\x49\x86\x06\x5c\x8e\x70\xae\x69\x9a\x23\x9c\x92\xe3\xc6\x0a\x99\x5a\x49\xd6\xe3\x32\xd8\x3f\x4e\xc6\x17\xd3\xd9\x05\x64\x5c\x20\xd4\x67\x46\x29\x07\x8c\x1b\x4c\x9d\x32\x5b\x50\x19\xb8\xce\x65\xce\x20\x92\xde\x51\x52\x96\xbd\x5e\x51\x00\xc3\x8c\x4b\x84\x3e\xe3\x54\x60\xea\x12\x7b\x2f\x12\x6d\x90\xf1\x94\x3a\x4c\x38\xeb\xc3\xbb\xb2\xec\x45\xd9\x4a\xa6\xb1\x85\x23\x7b\x2f\xc8\x0c\x45\x48\x3d\x84\xa2\x17\x45\x96\x7c\xce\xd1\x60\xec\x2d\x17\x7f\xc6\x96\x8c\xe3\xa2\x80\x01\x99\xbc\x27\x63\x25\xad\xa3\xd2\x41\x59\x0e\x47\xc0\xd9\x70\xd8\x8b\xca\x5e\x51\xbc\x03\x94\x0c\x9e\x59\x40\xa2\xb4\xad\x8b\xf0\x91\x03\xa5\xe1\xb7\x33\x18\x90\x59\xaa\x34\x92\x2b\xdd\x31\x51\xb3\xe8\xda\xce\xcd\xa2\x63\xb4\x4e\x19\xba\xc0\xae\xc3\xac\x3e\x7a\x02\xa1\x0f\xe7\x99\xbf\x99\xfc\x4d\x0d\xa7\x8c\xa7\xbe\xf8\x28\x8a\x92\xc4\x1b\xa4\x72\x40\xcd\x62\xb5\x44\xe9\x2c\x6c\xd0\x20\x68\xa3\xd6\x9c\x21\x1b\x01\xd5\xda\x83\xf5\x73\xb9\x3c\xff\x38\xbb\x80\xb4\x6e\x8a\x1d\xd5\x19\x2c\x97\x29\xc2\x06\x21\xa5\xf2\x17\xe7\x03\xc4\x16\xfa\x93\x29\xc4\xc3\x3e\x81\xc0\x93\x0d\x17\x02\x96\xf4\x0b\x56\x93\x6c\xdb\x03\x19\x15\x76\x4b\x7c\x22\x9e\x81\x40\x19\x5a\xef\xdb\x50\x96\x43\x38\x3b\x83\xe3\x00\x60\x7f\x48\x97\x54\x58\x8c\xfd\x2c\xa2\x28\x32\xe8\x56\x46\xfa\xc7\x00\x68\xed\xdb\xe3\x2f\x8a\x6f\x6e\xb9\x74\x68\x32\x9a\x62\x51\x8e\x0e\x73\x87\xe0\x4c\x19\xe0\x3e\xc0\x50\xb9\x40\x58\xd7\x77\xad\x6f\xf8\x2d\x9c\xc1\xce\xfb\x86\xdf\x36\x17\x74\x66\xbf\x5f\x54\x51\x40\x4a\x85\x68\xc7\x44\xae\xf4\xd8\xab\xc2\x8f\xbb\x2c\xbf\xcb\xaa\xa2\x68\x06\xe0\xa7\x33\xe5\xa2\x1e\xce\xa8\x32\x1c\x8c\x6c\x4d\x88\xbf\x08\x85\x45\x28\x4b\xce\xfc\x73\x28\xa6\x7d\x78\x01\x43\x33\x8e\x82\x75\x09\x9a\x75\x29\x76\xe9\xad\xcf\xa0\xe8\x0f\xeb\x2b\x3b\x50\xd7\xfe\x70\x5e\x82\xe1\x50\x68\x8f\xe2\x78\x53\xe1\xff\xa7\xc2\xd7\x8a\x24\x7b\x9e\x44\x3a\x94\xf9\xa6\x5a\x6a\xb1\xbc\x5a\x20\xc8\x16\x98\xe4\x74\x8f\x5b\x7b\x04\xb8\x60\xcd\xf4\x83\xcd\x60\xc6\x59\x65\xdf\x57\x7b\x8d\x04\x61\x80\x64\xbe\xd5\xe8\xcd\x35\x79\x3e\xe0\xb6\x72\xef\xbc\x87\x80\x3a\xdb\x19\x68\xc3\xa5\x6b\x23\xa7\x74\x89\xd0\x0f\xa4\x9e\xbc\xef\xef\x00\x3e\xa5\x45\x87\x81\xf7\xf6\x5e\x2c\x0c\xd5\x39\x99\xe2\x66\xe6\x50\xc7\x7e\x74\xed\xe1\xa5\x51\xcb\x78\x4e\xef\x04\x56\x3d\x7e\xf0\x29\xdc\xf3\x9e\xab\x30\x34\x24\x21\xa2\xe3\x57\x05\x57\xf5\x3f\x88\xf2\x3d\x8b\xdb\xb7\x2a\xc1\x5f\x28\x02\xba\x36\x16\xc9\xc4\x4e\xe4\x1a\x8d\xed\x9e\x3d\xb8\x27\x50\xb0\x91\x17\x92\x4f\xa7\x9f\xaa\x3e\x54\xc7\xfe\xe8\xfa\x43\xc7\x9f\x10\xd2\x46\x84\x9f\xd2\x03\xe7\xb1\x12\xab\xa5\xec\x04\xec\xbc\x9b\x0e\x47\x51\x80\x33\xac\xc9\x9e\x1c\xf9\x1d\x49\x6d\x60\xb9\x72\xd4\x71\xb9\x08\x42\x6d\xd1\xf9\x0e\xc3\xdd\x16\xfc\x66\x85\x5f\x1d\x4a\xcb\x95\xb4\xa0\x0c\xac\xac\x5f\x93\x70\xa9\x05\x75\x68\x49\x58\x6a\xaa\x94\x1b\xee\x72\x18\xb8\xa5\x16\xb6\xfa\xa4\xb9\x34\x9f\xd7\x8e\x4f\x11\x35\x39\xea\xd7\x55\xfb\x4c\xd5\xc7\x2d\xa4\x0a\xa4\xac\x72\x76\x50\x7d\x6d\x0a\xa8\x9d\x06\x9d\xe0\x56\xc6\xfb\x9a\x6e\x90\xfd\x41\xed\x14\xf9\x22\xbf\x53\xc6\xc6\x76\x04\x9e\x5f\x2f\x17\x98\xc7\xfc\x26\xb2\x47\x44\x56\x03\xab\x14\xd0\x96\x59\xbd\x55\x40\x90\xd4\x7a\x39\x14\xc9\x6e\x6d\x08\x96\xf6\xf7\xf0\x4d\xa4\x3f\x49\xa4\x3e\xf6\xe7\x08\xf5\x33\x77\x79\x23\xd6\x11\x7c\x9f\xc7\x61\x3f\xfe\x67\x04\x7a\xb7\x22\x7b\x18\xb6\x5e\x06\x74\x6c\x87\xcd\x17\xbf\xfc\x71\xd1\x53\xf9\x8c\xbf\x66\x27\x41\x47\x64\x2c\x94\xc4\x78\x48\x66\xe8\xae\x63\xc9\x85\xbf\xf7\xdb\xc5\x85\xdc\x75\x85\x3a\xb6\x27\xde\x73\x6f\x0b\x39\x21\xd7\xf1\x0b\x76\x00\x65\x5e\x5d\x2c\x7f\xb4\x58\x9e\x01\x87\xdf\x77\x9b\xd6\x09\xb9\x32\x71\xdb\xdf\xff\x14\x8b\x54\xee\x49\x30\x3a\xb6\x64\xaa\xdc\xc3\xf4\xff\x06\x00\x00\xff\xff\xc2\xaf\x82\xbc\x36\x10\x00\x00")
+var _templateDialectSqlPaginationTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x53\x4f\x6b\x1b\x3f\x10\x3d\x5b\x9f\xe2\xfd\xc2\xef\xb0\x1b\x6c\x39\xcd\xad\x05\x1f\x8c\xf3\x87\x40\x68\x0b\x09\xf4\x50\x7a\xd8\x48\x63\x7b\x88\x22\x39\x92\xd6\x60\x16\x7d\xf7\x22\x79\x5d\xdb\xe0\x06\xd3\xcb\xc2\xce\x8c\xde\x9b\x99\xf7\xa6\xeb\xc6\x97\x62\xe6\x56\x1b\xcf\x8b\x65\xc4\xf5\xd5\xa7\xcf\xa3\x95\xa7\x40\x36\xe2\xae\x51\xf4\xe2\xdc\x2b\x1e\xac\x92\x98\x1a\x83\x52\x14\x90\xf3\x7e\x4d\x5a\x8a\xe7\x25\x07\x04\xd7\x7a\x45\x50\x4e\x13\x38\xc0\xb0\x22\x1b\x48\xa3\xb5\x9a\x3c\xe2\x92\x30\x5d\x35\x6a\x49\xb8\x96\x57\xbb\x2c\xe6\xae\xb5\x5a\xb0\x2d\xf9\xc7\x87\xd9\xed\xd7\xa7\x5b\xcc\xd9\x10\xfa\x98\x77\x2e\x42\xb3\x27\x15\x9d\xdf\xc0\xcd\x11\x0f\xc8\xa2\x27\x92\xe2\x72\x9c\x92\x10\x79\x06\xac\x9a\x05\xdb\x26\xb2\xb3\xe3\x95\x27\xcd\xaa\x89\x04\x4d\x73\xb6\x14\x0a\xde\x2b\x6d\x02\x45\xec\x93\x05\x91\xa0\x5a\x1f\x9c\x47\x95\x6b\x58\x0f\xb1\x6e\x4c\x4b\x68\xac\xee\xc9\xd9\x59\xac\x1b\xcf\xcd\x8b\xa1\x50\x4b\x14\xce\xae\xeb\xb1\x71\xa1\xb9\x31\xa4\xe2\x38\xbc\x9b\xf1\xa9\x26\x2e\x90\x92\x18\x78\x8a\xad\xb7\x98\xb7\x56\x55\x01\x97\xe1\xdd\xc8\x27\xca\xef\x9c\xaf\xd1\x89\xc1\x80\xe7\x70\xf2\x8e\xc9\x68\x4c\x26\xb0\x6c\x4a\x34\x87\xf7\x6d\x4c\x26\xf8\xe6\x35\xf9\x9b\x5d\x64\x1a\xd4\xb6\x6c\x10\xe4\x8f\x25\x79\xaa\x32\xf0\xfd\x73\x15\xe4\xac\xea\x3a\xfc\x2f\xbf\x37\xea\xb5\x59\x10\x52\x92\xe5\xff\xe1\x46\xce\x9c\x0d\xb1\xb1\x11\x29\xd5\x43\xb0\xae\xeb\x8c\x90\x40\x26\xd0\x09\xb4\xc7\x7f\x42\xcb\x9f\xed\xcc\x62\xfb\xa7\x9c\x69\xdf\x6c\xc0\x97\x09\x7e\xfe\x0a\xd1\xb3\x5d\x74\x19\xb7\x1f\x5a\xce\xf3\xe8\xf5\x10\xe7\x72\x25\x71\xf6\x72\x0e\xa7\x99\xb9\xb7\x95\x0b\x1c\xe9\xfe\xb9\xea\x5b\xea\x25\xff\xd3\xfc\xe1\x26\x4e\x3e\x7d\xfc\xfb\x53\x31\x48\xc3\x2c\x9e\xe8\xba\x11\xc8\x6a\x9c\xf2\xa7\xcb\x1a\x1e\x79\xb3\x44\xd8\x2e\x76\x9e\x24\x1b\x39\x32\x05\x54\x2f\x9b\x52\x50\xb6\x53\x4c\x19\x97\xb4\xbd\x0f\xd6\xf5\xee\x54\x16\xbc\x26\xbb\x5f\xc5\xd9\x1e\x2d\xb4\x5b\x7f\x16\x82\x63\x75\x90\x67\xf8\x58\x87\x51\x4a\x48\xe2\xd0\xbb\xff\xed\xbd\xdb\x43\x1e\x20\x1e\x69\x3d\xc4\x19\x32\x27\x91\x77\xfa\xb1\xce\x37\xd4\x0b\xdd\xdf\x58\xfe\xaf\x0a\x45\x90\x52\xd6\x62\xb0\x3f\xbf\xe9\x71\xa6\xeb\x46\x20\xab\x91\x92\xf8\x3d\x00\xae\x7e\x4f\x43\x08\x05\x00\x00")
+
+func templateDialectSqlPaginationTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateDialectSqlPaginationTmpl,
+		"template/dialect/sql/pagination.tmpl",
+	)
+}
+
+func templateDialectSqlPaginationTmpl() (*asset, error) {
+	bytes, err := templateDialectSqlPaginationTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/dialect/sql/pagination.tmpl", size: 1288, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateDialectSqlPredicateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\xdd\x6f\xdb\x36\x10\x7f\xb6\xff\x8a\x83\x61\x60\x72\xe0\x50\x4d\xdf\x36\xc0\x03\x02\x37\xc1\x8c\xb6\x6e\x3b\x07\xeb\x43\x10\x0c\x8c\x74\xb2\x89\x52\x24\x4b\xd2\x4e\x0d\x41\xff\xfb\x70\xd4\x47\xa4\xa4\xf9\x98\xbb\xa1\x2f\x79\xab\x79\xdf\x77\xbf\xfb\xe9\xd2\xa2\x88\x8f\x86\x73\x6d\xf6\x56\xac\x37\x1e\x5e\xbf\x3a\xf9\xf5\xd8\x58\x74\xa8\x3c\x9c\xf3\x04\xaf\xb5\xfe\x02\x0b\x95\x30\x38\x95\x12\x82\x92\x03\x92\xdb\x1d\xa6\x6c\x78\xb1\x11\x0e\x9c\xde\xda\x04\x21\xd1\x29\x82\x70\x20\x45\x82\xca\x61\x0a\x5b\x95\xa2\x05\xbf\x41\x38\x35\x3c\xd9\x20\xbc\x66\xaf\x1a\x29\x64\x7a\xab\xd2\xa1\x50\x41\xfe\x6e\x31\x3f\x5b\xae\xce\x20\x13\x12\xa1\x7e\xb3\x5a\x7b\x48\x85\xc5\xc4\x6b\xbb\x07\x9d\x81\xef\x04\xf3\x16\x91\x0d\x8f\xe2\xb2\x1c\x0e\x8b\x02\x52\xcc\x84\x42\x18\xa5\x82\x4b\x4c\x7c\xec\xbe\xca\xd8\x58\x4c\x45\xc2\x3d\xc6\x22\x1d\xc1\x71\x59\x0e\x07\xd9\x56\x25\x91\x83\x23\xf7\x55\xb2\x15\x92\xa6\xb6\x13\x28\x86\x83\x81\x63\x9f\x37\x68\x31\x22\xc9\xd9\xa7\xc8\xb1\x79\x54\x14\x30\x66\x8b\x37\x6c\xae\x95\xf3\x5c\x79\x28\xcb\xc9\x14\x44\x3a\x99\x0c\x07\xe5\xb0\x28\x8e\x01\x55\x0a\xcf\x4c\x20\x4e\x74\x6e\xb4\x13\x1e\x9f\x4c\x25\xd1\x72\x9b\x2b\x07\xbf\xcd\xe0\xf2\xca\x79\x2b\xd4\xba\x00\x0a\x67\xb9\x5a\x23\x8c\xc5\x14\xc6\x19\x49\xc7\x6c\xde\x38\x5d\xbc\x81\xb2\x2c\x0a\x10\x19\x8c\x05\x94\xe5\x14\x8a\xa2\x4e\xaf\x29\x25\xeb\x15\x52\x8b\x8f\xcb\x12\xca\x3b\xe5\xb7\x4e\xcf\x3e\x45\x75\x2e\xc1\xdd\x61\xe1\x8b\x02\x12\x9e\xa3\xa4\x04\x96\x3c\xc7\x2a\xcf\x4a\x76\x58\x27\xb5\x71\x75\x0f\xc9\x72\xac\x4d\x95\xcc\x2a\xd1\x06\xd9\x07\xd3\x11\x71\xbb\xee\xca\x4e\xed\xba\x23\x74\x5e\x5b\xbe\xc6\xae\xc2\xaa\x7e\x7a\x62\x40\x64\x4e\x8d\xd6\x86\xfd\xc5\xad\xe0\xa9\x48\x08\x06\x83\xc1\x20\x8e\xa9\x05\x4a\x7b\xe0\x76\xbd\xcd\x51\x79\x07\x37\x68\x11\x8c\xd5\x3b\x91\x62\x3a\x05\x6e\x0c\x15\x4b\xa8\x3f\x3f\x7d\xb7\x3a\x83\xa4\x9e\x8a\x9b\xd6\x1e\x9c\x50\x09\xc2\x0d\x42\xc2\xd5\x2f\x9e\x0c\xe4\x1e\x46\x8b\x25\x44\x93\x11\x83\xb0\x71\x37\x42\x4a\xc8\xf9\x17\xac\x76\xa2\x6d\x0f\x64\x5c\xba\x3d\x23\x47\x22\x03\x89\x2a\x80\x98\xda\x50\x96\x13\x98\xcd\xe0\x55\x28\xa0\x3f\xef\x73\x2e\x1d\x46\x34\x8b\xc1\x60\x60\xd1\x6f\xad\xa2\x7f\x86\x82\x76\xd4\x1e\x0a\x14\x5d\x5e\x09\xe5\xd1\x66\x3c\xc1\xa2\x9c\xde\xf5\x1d\x8c\x33\x6d\x41\x90\x41\x85\x94\x5d\x1d\x6b\x77\x29\xae\x60\x06\xb7\xda\x97\xe2\xaa\x09\xd0\x99\x7d\x3f\xa9\x00\x1b\x29\xdb\x31\xb1\x0f\x66\x4e\xfc\x42\xe3\x2e\xcb\x07\xf7\xb3\xc2\x20\x0d\x60\xac\x0d\x5b\x0a\x59\x0f\x67\x5a\x14\xdf\x19\xd9\x8e\x31\x46\x8b\x20\x1d\xc1\x52\xa4\x5d\xd0\x1e\x8c\xd0\x4c\xa0\x6c\xf8\x86\x0c\x9b\x65\xa9\x20\x76\x4e\xd2\x67\x40\xf4\x61\xf4\x3d\xc0\x54\xfd\xf5\x9e\x76\xda\x7d\x68\x0d\x77\x17\xed\xd1\x3a\x5e\xb6\xf0\xff\xdb\xc2\xce\xe8\x0e\x5a\x92\xec\x79\x2b\xd2\x81\xcc\x77\xb7\xa5\x5e\x96\x1f\x5e\x10\x4c\xd7\x18\x6f\x78\x0f\x5b\x3d\x00\x9c\xa5\xcd\xf4\x49\x16\x1f\x01\x3d\x38\xc8\xac\xce\x41\x5b\xf0\x1a\xfc\xde\x20\xf5\xdf\x6f\xa0\xfd\xae\x82\x48\x51\x79\x91\x09\xb4\x0e\xb8\x45\x20\x68\x61\x5a\x9f\x13\xc2\x82\xe7\xd7\x12\x1d\x83\x70\x34\x54\xe8\x13\x69\x88\x8b\x6c\x1e\xbe\x73\x9d\x36\x51\x2f\x44\xfa\x05\xf7\xa4\x30\x1a\x55\x2f\x21\x20\x71\x4e\xab\x00\x33\x60\xdf\xb5\x9a\x41\x83\xe3\xb7\xb8\xef\x36\xac\x2e\xd8\x62\xf6\x68\xf0\x2a\x14\xb2\x8b\xbd\xc1\xdb\x80\x95\xd5\x8c\x82\xb4\x53\x52\xd8\x0b\x55\xc7\xef\xeb\x1b\x2b\x94\x6f\xdd\x85\xcf\xf0\x28\x30\xd1\xe2\x4d\x5d\x5a\x8f\xf7\x9e\x62\x20\x8f\x61\xdb\xdd\x57\xb9\xb6\xdc\x6c\xd8\x12\x6f\x56\x1e\x4d\x44\x80\x6d\x1f\xcf\xad\xce\xa3\x0b\xea\x79\x45\x46\x21\xe5\xc9\xb4\xa7\x73\xa1\x03\x40\x91\x05\xbd\x4e\x07\x2a\x93\x2a\xfd\x7b\x56\x04\x87\xa8\xfd\x45\x8a\xc8\xfe\x44\x19\x7a\xd5\xda\x22\x5b\xb8\x85\xda\xa1\x75\xdd\xb7\x7b\x71\xc8\x71\x4b\x25\xc8\xde\xbf\x7e\x5f\x21\xaf\x7a\x26\xcf\x1f\xdf\x76\xf4\x19\x63\xad\x45\xf8\x6c\xdc\x51\xbe\x37\xca\x5b\xed\xa6\xaf\x83\x41\x28\x67\x52\x2f\x76\x7c\x44\x97\xb5\xbe\x81\x7c\xeb\xb9\x17\x6a\x1d\x8e\xdf\xb6\x3a\xea\x2b\x5c\xef\x81\xee\x71\xfc\xe6\x51\x39\xa1\x95\xa3\x35\xd8\x3a\x3a\xae\x31\x37\x92\x7b\x74\xac\x42\x75\x08\x55\x61\xc7\xe7\x46\x86\x23\x32\xe7\x3e\xd9\x5c\xd4\x8a\x4f\x2d\x65\x7c\x34\xaa\xb3\xee\x5c\x9c\xe4\x8a\x3c\xd5\x3e\x3b\x55\x7d\x6b\x12\xa8\x95\xc6\x1d\xe3\x06\xef\xfd\x1f\x6d\x65\x7f\x70\xb7\x44\xb1\xde\x5c\x6b\xeb\x22\x37\x05\xe7\xd1\x1c\x4e\x26\x54\xf3\x0b\xa1\xbc\x10\x4a\x20\x94\xaa\x88\x71\xc5\x2a\x6d\x96\xd5\xaf\x90\xdc\x18\x59\xcd\x0d\x77\x09\xe1\xf6\x1c\x0c\x92\xb6\x90\x17\x42\xfa\x49\x84\x44\x2b\xf2\x73\x48\xe9\xb3\xf0\x9b\x86\x98\xa6\xf0\x30\x7a\xc3\xdf\x3d\x7f\x4f\xc1\xdc\xfe\xe9\x43\xfc\xe4\xea\x23\xcf\x44\x6e\xd2\x5c\x72\xe5\xbf\x27\x38\xae\x9e\xf1\x9f\x17\x27\x14\xda\xb1\xb9\xd4\x0a\xa3\x09\x5b\xa1\xff\x18\x29\x21\x27\xc3\x87\x92\x0b\xbe\xeb\x0c\x4d\xe4\x4e\x48\xb3\x77\x5d\x9e\xb0\x8f\xd1\x01\xb7\x9d\xb6\x3f\x9c\xac\x78\x34\x59\x91\x81\x80\xdf\x6f\x2f\xe8\x13\xf6\xc1\x46\x6d\x7f\xff\xd3\x5a\x94\xf6\x4f\x16\x63\x22\xc7\x96\xda\xdf\x77\xff\xcf\x00\xd3\x80\xd3\x73\x58\x13\x00\x00")
 
 func templateDialectSqlPredicateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -867,12 +942,12 @@ func templateDialectSqlPredicateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/predicate.tmpl", size: 4150, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/predicate.tmpl", size: 4952, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlQueryTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x1b\x6b\x6f\xdb\x38\xf2\xb3\xfd\x2b\x66\x85\xb4\xb0\x03\x47\x49\x7b\x87\x03\xce\x45\x0e\xc8\x35\x09\x60\x74\x37\xed\x6d\xba\xbb\x1f\x02\x63\x57\x91\x28\x87\xb0\x4c\x29\x14\x9d\x07\x5c\xff\xf7\xc3\x0c\x1f\xa2\x1e\x76\x9c\xec\x76\x7b\xb8\xbb\x0f\x6d\x2d\x72\x86\x33\x9c\xf7\x90\xec\x6a\x75\xb8\xdf\x7f\x9f\x17\x8f\x92\xcf\x6e\x14\xbc\x3d\x7a\xf3\xf7\x83\x42\xb2\x92\x09\x05\xe7\x51\xcc\xae\xf3\x7c\x0e\x13\x11\x87\x70\x92\x65\x40\x40\x25\xe0\xbc\xbc\x63\x49\xd8\xff\x7c\xc3\x4b\x28\xf3\xa5\x8c\x19\xc4\x79\xc2\x80\x97\x90\xf1\x98\x89\x92\x25\xb0\x14\x09\x93\xa0\x6e\x18\x9c\x14\x51\x7c\xc3\xe0\x6d\x78\x64\x67\x21\xcd\x97\x22\xe9\x73\x41\xf3\xdf\x4f\xde\x9f\x5d\x5c\x9e\x41\xca\x33\x06\x66\x4c\xe6\xb9\x82\x84\x4b\x16\xab\x5c\x3e\x42\x9e\x82\xf2\x88\x29\xc9\x58\xd8\xdf\x3f\x5c\xaf\xfb\x7d\xdc\x03\x9c\x24\x09\x57\x3c\x17\x51\x06\x29\x67\x59\x52\x42\x9a\x6b\xe2\xd7\x4b\x9e\x25\x4c\x86\x40\xd0\xab\x15\x24\x2c\xe5\x82\x41\x90\xf0\x28\x63\xb1\x3a\x2c\x6f\xb3\xc3\xdb\x25\x93\x8f\x87\x1a\x33\x80\xf5\xba\xdf\x5b\xad\x0e\xe0\x9e\xab\x1b\xd8\x0b\x7f\x12\xec\xa1\xc8\xa5\x62\xc9\x79\x2e\x19\x9f\x89\x0f\xec\xb1\x24\xa0\x1e\x42\x9c\x7f\x28\xe1\x3a\xcf\x33\x8d\xc3\x44\x02\x44\xc7\xfd\xdc\x4a\x33\xd0\xc0\xb0\x57\xcc\x67\x30\x3e\x86\xbd\xf0\x32\xce\x0b\x16\x7e\x8a\xe2\x79\x34\x63\x76\xd6\x6c\x02\x21\x8a\xa8\x8c\xa3\xcc\x01\xfe\xd3\xcc\x18\x40\xc9\x62\xc6\xef\x34\xa4\xfb\xed\xd0\x91\x9b\x74\x29\x62\x18\xd4\x60\xd7\x6b\xd8\xf7\xa9\xac\xd7\x43\x28\x6f\xb3\x93\x2c\x1b\xc4\xea\x01\xe2\x5c\x28\xf6\xa0\xc2\xf7\xfa\xdf\x21\x0c\xae\xa6\x04\x1f\x5e\x44\x0b\x64\x71\x04\x4c\xca\x5c\x0e\x61\xd5\xef\xdd\x45\x12\x06\xfd\x5e\x4f\xe4\x09\x2b\xe1\x18\x1a\xa0\x2b\x94\xda\x6e\xb2\x75\xc2\x3d\x86\x06\xb7\xa1\x99\x31\x4b\x19\x39\xf7\x7a\xbf\x96\x05\x8b\x3b\xc0\x49\xd2\x97\x05\x8b\x07\xc3\x3a\xf5\xb3\x64\xc6\x2c\xb5\x2c\x8f\x12\x96\x7c\x7e\x2c\x34\xdb\xab\x15\x64\x4c\x40\x08\xeb\xf5\x14\xb5\xbb\x42\x18\xc2\x95\x91\x98\x31\xd8\x63\x28\xe2\xd0\x20\xe3\x4c\x9d\x26\x7e\xb3\xf0\x2c\x9a\x31\xf9\x7d\x1e\x25\xe7\x68\x5a\x28\xe8\xef\x8e\x41\xf0\x6c\xe4\x56\x73\xcc\xf7\xd6\x8d\xed\x0c\x77\x35\x42\x1f\xec\xfc\x83\xbf\xa7\x1e\x4f\x51\x18\x86\x63\x3e\xf2\xb8\x5e\xad\x80\xa7\x30\x53\xb0\xc7\xe1\x08\x19\xfb\xf2\x05\x41\x35\xf1\xe7\x6d\xc6\xa1\x81\x16\x92\xa7\x38\x25\x97\x8c\xc6\x1c\x9f\xd5\x7e\x79\x0a\x16\x50\xe3\x91\xfa\xc2\x8b\x3c\x61\xe1\xfb\x3c\x5b\x2e\x04\xae\x10\x15\x05\x13\xc9\xa0\x3d\x37\x22\x35\x7b\x8e\x12\x7a\x82\x09\xc3\x70\x68\x64\xea\x13\xd5\xab\x5c\xc6\x91\xf8\x39\xca\x96\xa4\x68\x74\x87\x41\x6c\xc8\x5d\x4d\x4b\x25\xb9\x98\x91\x89\x73\xa1\x98\x4c\xa3\x98\xad\x6a\x06\x4e\x96\x8d\x52\x7c\x5d\xb3\xeb\x38\x17\x29\x9f\x8d\x5b\xb6\xa7\xc7\xd7\x9e\x47\x98\x1d\xd1\xe7\x08\xf0\x1f\x64\x55\x32\xb5\x94\x82\x3e\xc3\xd2\x31\x68\x39\x1b\xf6\x7b\x8e\xfd\x93\xb2\xe4\x33\xb1\x89\xf5\x11\xdc\xe9\xad\xd5\x36\x30\xd4\x1b\x20\xfe\x79\x8a\x96\xad\xe9\x0f\xe1\xf8\x18\x8e\xb4\xfc\x0d\x07\xe9\x42\x85\x67\x08\x9c\x0e\x02\x1b\x98\xd6\xeb\x31\x18\xb2\x71\x94\x65\x2c\x21\xcd\xe5\x4b\x45\x9f\x5c\xcc\xa0\x92\x69\x80\xbb\x59\x7b\x72\x22\x42\x57\x15\xc9\x83\x37\xd3\xcd\x5e\x48\xfb\xa7\x81\xb0\xee\x90\xde\x57\xd3\xed\x7d\xd1\x45\xc4\x65\x5d\x78\x56\x24\x5a\x88\x88\x8a\x09\x23\xcb\xf2\x7b\x58\x2c\x55\xa4\x90\x7f\xcc\x14\xe5\x6d\x36\x93\x51\x71\x13\xfe\xcb\xc6\x0b\xb8\x7e\x04\x4c\x85\xec\x41\x31\x51\xf2\x5c\x94\x90\x4b\x58\x96\x98\xd7\xd8\xa2\xc8\x22\xc5\xca\x90\xf2\x8a\xb7\x1f\xb5\x28\xb2\x12\x37\xbe\x88\x54\x7c\xf3\xd9\xc0\x75\xe5\x1b\x54\xe7\xe1\xbe\xce\x37\x7e\x68\xc1\x15\x28\x19\xe8\xa5\x2a\x27\x7f\xb0\x54\x0d\xcc\x5e\x85\x6a\xa5\xe1\xff\xe6\x29\xaa\x1d\x57\xaa\x6f\x0d\xdd\xa8\xc4\xd0\x3e\x6a\x99\x6b\x22\xf1\xd7\x08\xc8\xd4\x86\xef\x08\x5f\x7b\x39\x19\x89\x15\x35\xcf\xc8\x25\x48\xa0\x1b\xec\xc9\xd3\x0a\x9a\x39\xcf\x9c\xf4\xfd\x00\x5a\xd3\xbe\x93\x21\xc9\x3b\x81\x3d\x08\x7e\x64\x71\xe0\x71\x18\x20\x74\x80\xb8\x56\x28\x4e\x11\x5d\x02\x66\x18\xb1\xd0\x72\xb8\x98\x05\x36\x58\x6f\x92\x56\x9b\xe1\x67\x65\xcb\xf7\xf9\x52\xa8\x0d\xf9\x92\x0b\xe5\x87\x10\x9d\xa7\xc6\x4f\x24\x2a\xc3\x8f\x53\x1d\x11\xd8\x59\x75\xcf\x63\xfe\xec\x81\x97\x9b\x98\xc7\xec\xe7\x73\x2f\x46\xd6\xaa\x9a\x1c\xf8\x52\x18\x3a\xf3\x6b\x9b\x4f\x1a\x65\x25\x1b\x6d\x8c\x34\xf1\x0d\x8b\xe7\xc0\x90\x25\x26\x62\x36\x86\x57\xf7\x01\xd1\xd4\x0e\x6c\xf5\x04\xff\x80\xa3\xe7\xea\xc9\x13\x30\xec\x77\xf8\xbb\xaf\x9c\xd7\xed\x79\xdc\x03\x6a\x60\xec\x4d\xe2\xb7\x9d\xeb\x7d\x8e\xae\x33\x36\x6e\x65\x26\x1a\xa6\x9c\x6f\x92\x57\x1b\xc4\x66\x35\x04\x9a\x9c\xfa\x04\x28\xdb\x3a\x0a\x3d\x0c\x81\x63\x5d\xe4\x52\x5e\x0e\x27\xa7\x21\x8e\xa1\xc6\x4a\x65\x2b\x32\x02\xd5\x6b\xb6\x69\x59\x34\xc2\x88\x84\xb2\x08\xf4\x37\xfd\x75\x2e\xf3\x45\x3b\x97\x95\xb7\x54\xb8\xfc\x24\xf8\xed\x92\x8d\x29\xb9\x8f\x6c\x08\x30\x55\x77\x87\x55\xe8\x99\x77\x14\x24\xf4\xef\x21\xaa\x8e\x0c\xa2\x33\xe5\x2f\xa2\x39\x1b\x54\xf9\xec\x68\xe4\xa3\x0e\x37\x61\x3d\xa3\x50\xe8\xd8\x3f\x2e\x8b\x1d\x03\xa7\xc2\x99\x02\x94\xd9\xd1\xca\xd4\x2a\xfa\xf3\x8a\x4f\xd1\xa0\x77\x58\xf1\x85\x45\x8d\x23\x63\xeb\x17\xfc\xa3\x45\x5c\x74\x8a\xb7\x90\x2c\xe1\x31\x66\x22\x2d\xe2\xa2\x25\xde\x4f\x16\xc2\xd6\x0c\x25\xcb\xa8\xad\x22\x17\x08\x2f\xcd\x97\xae\x6e\x9a\x52\x28\x6c\x75\x56\x20\x53\x0e\xb5\xcd\x5d\xc6\x17\x5c\x75\x31\x48\x13\xef\xcc\xbc\x17\x0c\x34\x73\xdf\xd3\xf0\x31\xec\xd3\xbc\x5d\x2c\x4f\xd3\x92\x75\xae\xa6\x67\xde\x59\x88\xd6\x7a\x1f\xf5\xf8\x31\xec\x6b\x88\xed\xc2\xcb\x65\xc2\xe4\x26\xb9\x7d\xc4\xc9\x3f\x50\x66\x6d\x43\xfc\x39\xca\x78\xa2\x55\xdf\x10\xa8\x09\x72\xc4\x48\x5f\xf7\x90\xdb\x12\x9d\x25\x11\xe8\x92\xa0\xdf\xaf\x55\x39\x51\x82\x09\x10\x16\x4c\xdd\xe4\x49\x09\x2a\xa7\x72\x87\x30\x0f\x6c\x74\xdc\xb9\xd2\x79\x49\xa1\x13\xb9\xee\xdc\x96\x3b\x4f\x54\x3b\x9b\x8b\x9d\xcd\x3d\xf6\x2e\xed\xb6\x27\xa8\x6f\xd9\x59\x53\x42\xe9\x4e\xb7\x35\xf3\x42\x33\xf2\x18\xc4\xa9\x53\xbd\xa5\x26\x19\x93\xfd\xdd\x34\xc6\x49\xf5\x06\x91\xec\xf9\x07\xe5\xa0\x41\x67\x66\x1a\xf6\x7b\xce\xbc\x3d\x0c\xcd\xc5\x40\xbd\xb1\xd1\xa9\x85\x6d\xc6\xb1\xdb\xa2\x3f\x98\x36\x06\xea\x8d\xce\xfd\x1d\xd9\xc3\x77\x57\x47\xb1\xb3\x8e\xf0\x00\x2c\x1f\xee\x7b\x47\x6e\x9e\xae\xf6\x2b\x39\x7f\xe5\x3a\xdf\x90\xf9\x9a\xb5\x3e\x86\xa0\x5f\x47\x50\x54\x51\x68\x73\xa2\x20\xf9\x17\x7e\x2c\xdf\x69\x01\x0a\x96\x0d\xdc\xa7\x62\xda\xcb\xc3\xf9\xe1\xa1\x49\x19\xbc\x84\x45\x24\x92\x88\xce\x01\x91\x4b\x03\x1b\x67\xd1\xb2\x64\x21\xfc\xc2\xa0\x54\x91\x54\x1a\x87\x74\x93\xb0\x34\x5a\x66\x4a\xf7\x7d\x23\x88\x44\x02\xf9\x1d\x93\x92\x27\x0c\xb8\x82\x6b\x86\xd6\xc0\x53\x10\x8c\x25\x2c\x09\x7d\x63\xd3\xf9\x63\x60\xb2\xc7\x50\xe7\xa7\xc1\x22\x52\x37\xe1\x0f\xd1\xc3\x44\xa8\xbf\xbc\x1d\xbe\x38\xe5\x39\x2a\x7a\x55\x9d\xf3\x6a\x55\xad\x85\xe8\xaf\xeb\x21\xed\x70\x5f\xc7\xeb\xc3\x22\xd2\xfb\xe3\x82\x95\x55\x18\x87\x19\x13\x4c\x46\x18\x63\x49\x44\x04\x95\xa7\x10\xc1\x8c\xdf\x31\x01\x2c\x99\xb1\x5d\x4e\x40\x11\xaf\x0a\xd0\x7b\x82\x2c\x93\x8a\x19\xe4\x00\xc9\x51\x57\x7f\x6f\x44\xee\x31\x90\xca\x7c\x61\x28\x68\x5c\xe6\x1f\x67\x62\xdb\x56\x5b\x06\x19\xc2\x65\x50\x03\x98\x90\x90\xff\x99\x44\x6b\xc7\x59\x62\x5f\xe5\xb5\xf5\x78\x82\x1e\xea\xad\x39\xa1\x81\x03\x07\xe0\x07\x68\x0b\xf3\x63\xa5\x94\x7a\x8c\xeb\x08\x39\x2e\x28\x0f\x6b\x9d\xb3\x55\xd9\x99\x94\x83\xdd\x1a\xe2\x52\xb1\xa2\xd6\x74\x5f\xb0\xfb\x4b\xc5\x8a\x01\x5a\x80\xab\xea\x31\x54\x22\x17\xa2\xdd\x28\x40\x6b\x5c\x0f\x34\x4a\x76\xc7\xdb\x70\xe4\xaf\xfc\x39\x1f\xe8\xf3\x3a\xea\x0a\xba\x17\x6f\x4f\x7a\xa3\x8d\xca\xb8\xb6\x38\x2a\x72\xe0\xbe\x34\xd2\x8f\x2c\x23\x44\xe2\x49\x0f\x4d\xca\x89\xb8\x63\xb2\xac\xc6\x5a\xdb\x61\x9a\x9f\x66\x0f\x82\xaa\xe4\x29\x4e\xff\xf0\xf6\x07\xad\x5d\x73\xba\xda\xb1\xc2\xa7\x0f\x1e\x7a\x18\x86\xee\x90\x31\x2b\xd9\x53\xb8\x3a\x44\x79\xf8\xfe\x09\xa5\xc6\xc5\xad\x0f\x77\x3a\x31\x42\xf5\x7e\xe5\x24\x82\x3e\xf1\x35\x13\x08\x4a\x45\xfb\xd8\x7a\x0d\x9e\xf1\x5e\x32\x75\xc1\xf8\xec\xe6\x3a\x97\xe5\x93\x55\xc7\x08\xd0\xf8\x87\x1b\x62\x17\xc6\x88\xa7\x63\x57\xa4\xc3\x95\x17\x57\x5c\x18\xa3\x23\xbe\x5d\x2e\x72\x64\xbe\xf8\xaf\x0c\x63\x04\xc6\x93\xae\x08\x36\x39\xfd\x13\x23\x0f\x4f\xfe\x1f\x73\xfe\x07\x62\x0e\x7a\xc3\xb7\x88\x39\xbf\x33\xe0\x6c\x89\x0c\xf5\x13\xe1\xad\x5e\xbe\xdd\x1f\xed\x01\xbb\x0e\x1b\x1d\xfe\xb8\xe1\xce\xec\x9d\xc1\xf0\x6a\x88\xba\xf9\x69\x61\xa6\x73\xa3\x21\x3a\x0a\x33\xbb\xfe\x59\x97\xb3\xe6\x40\x4c\x1f\xb8\xd3\xb9\x01\x4f\x2a\xe8\x45\x54\x5c\xf9\xe7\x82\xb0\x5e\x37\x6f\x6b\x1b\xd8\xa6\xf2\xb7\xf7\x35\x5a\xc7\xfa\xb6\x4a\x1f\x63\xf0\xa4\xbc\xa2\xd0\x3b\x39\x9d\x82\xbe\xd0\xa1\x71\x64\xd2\x1d\x66\xa5\x73\x73\x9b\x15\x4e\x4e\x69\x59\xff\x2e\x47\x0b\xe3\x52\xc9\x65\xac\xdc\xed\xa1\xbb\x1c\x36\x31\xc0\xbf\x22\xd6\x17\x86\xf6\x32\xb9\xd7\xc3\x40\x8b\xbb\xbc\x9a\xd6\x83\x86\xd9\xa1\x83\x71\xe7\x87\x56\x0c\x2d\xd0\x69\xe3\x46\x9a\x78\xa5\xbf\x3a\x4e\x7e\x91\xfb\xda\xe9\x6f\xaf\x87\x43\xe3\x06\x48\x35\xdb\x33\x31\x68\xdc\x15\x94\x34\xc4\x86\x33\xe2\x2d\xf1\x69\xcb\xb1\x71\x47\x4c\xd2\x28\xe6\x1f\x77\xf6\x37\x36\xc7\x58\x9d\xe7\x57\xbd\x5e\x19\xfe\x72\xc3\x24\x85\xd9\x70\x62\xef\xcf\x76\x20\x76\xa5\xef\x91\x1b\x3b\x7d\x83\xee\x98\xd1\xcf\x23\xe7\x99\xd3\x11\xa4\x73\xea\xce\x87\x3e\x87\xb8\x68\xbe\xa4\x94\x18\x20\xf5\x8b\x65\x96\x4d\x84\xfa\xdb\x5f\x03\x77\x4b\x4d\xb6\xfc\x53\xc9\xe4\x29\xf9\xb5\xbd\xa1\x46\xac\x63\x3d\x89\x48\x46\xbf\x55\x24\xb0\xab\x73\xb1\x75\xf1\xca\x42\xda\x24\xb8\x40\x0a\x15\xc4\x46\x3a\xd5\xfd\xa7\x11\xf4\x10\xae\xde\xfa\x37\xb0\x46\xce\xa6\x5f\x68\xcc\xbd\xb6\xdb\x41\xeb\x1f\xe9\x9b\x65\x2e\xe8\x6b\xed\xcb\x4a\xdf\xc1\x1a\x0a\xf9\x52\x8d\x80\x0b\xd8\x70\xcd\x8b\x0e\x41\x20\xf9\x1c\xb7\x9f\x2f\x55\x38\xd8\xaf\xe8\x68\x1d\x60\x04\xfb\x2e\x9f\xc3\x97\x2f\xc0\x48\x9c\x55\x54\xea\x75\x5f\x09\x2f\x05\x7b\x28\x58\xac\x58\x02\x3c\xd1\x0d\x36\x55\x6d\xe8\x7c\x07\xf9\x52\x05\x66\x61\xf3\x2c\x82\x71\x61\x39\xe0\xc2\x30\x40\x3b\x6b\xd3\x47\x59\xff\x3e\xf2\x5c\x34\xa8\xe7\x4b\x45\x4a\x31\xf1\xb9\x71\xb7\x78\x22\x67\x01\x04\xb8\xef\x00\x02\x8a\x4a\x01\x59\x13\x04\x56\xcd\x81\xd3\xca\xee\xf7\x8c\x87\x8b\xb7\x0b\x7d\x0b\x1d\xd8\x87\x16\x9e\x9d\xf4\xb8\x78\x9a\x23\x2e\x3c\x86\x9c\xf1\xd5\xd8\xd2\xd6\xf1\x87\x71\x85\xa1\xda\xe9\x29\x29\xaf\xac\xe0\xa6\x35\x2d\xed\xa6\x17\xca\x23\x3c\x41\xd3\xa4\x88\x3c\x86\x57\x77\xc1\x08\xec\x92\x0d\xfb\x30\x71\xdd\xa5\x11\x33\x80\x96\xed\x83\xd3\x4a\x57\x66\x6c\x5a\x07\xaf\xc6\xab\xe7\x14\x3d\xbf\x35\xf7\x5c\xc8\x55\x2a\x4f\x14\x57\xb5\xc0\xff\x8c\x2a\xab\xf7\xa2\x3a\xab\xa6\xa9\xda\xab\x80\xa7\xcb\xae\xad\x85\x57\xf3\xcd\x4d\xeb\x05\x4e\xe7\xfb\x00\xca\xd9\x2f\x7a\x1f\x50\x3f\x10\xf1\x0c\xe4\x37\x5d\xf5\xe8\x14\x1d\xe8\x44\x62\x12\x70\x30\x86\x57\xf7\xbf\xd9\x0b\x5e\xa3\x22\x02\x37\x39\xa9\xbb\x79\x98\x9c\x4e\x84\xb5\x16\x97\x54\x84\x2d\x1c\xdd\x1d\xb5\x5e\xc8\x3c\x65\x1b\x7a\xbb\xde\xc8\x35\x1d\xe3\x18\x36\x6c\x69\xe4\xd5\x45\x96\x82\xc1\x34\xcf\x05\xb4\xeb\x68\x6b\xc4\x76\x69\xda\x6f\xfb\xcd\x26\xd1\x78\xbe\xd3\x90\x8c\xf6\x25\x8d\xc7\x12\x78\x75\xf7\xdb\x08\x84\xab\xaf\xb4\x82\x1b\x77\x4f\x7e\xdd\xa6\x99\xbb\xe2\xd3\xed\x55\x98\xff\x20\x69\x3b\xf0\x08\x84\x47\xda\x55\xf6\x98\xe9\x75\x26\xfd\x78\x2f\xce\x3f\x58\xeb\x4a\xfc\x12\xb6\xb3\x16\xeb\xaa\x65\xf1\x67\x57\x3d\xbb\x5b\x21\xb7\x45\x1a\x54\xcd\xa7\xa4\xa7\x3d\xe6\x3d\x16\xb3\x9e\xa2\xa7\x9c\x14\x70\xe9\x74\xde\x10\x80\x5b\x08\xb7\x9b\xce\xf5\xdd\x7c\x78\xc1\xb3\x0c\xeb\xb6\x2a\xc8\xa7\x90\xce\xeb\x16\xa6\xe5\xe1\x84\x4d\x21\x6e\x3f\x9d\x9b\x58\x65\x77\x7d\xb5\x9f\xce\xa7\x75\x95\xd8\xd1\x91\x63\xad\x16\x41\x9d\x02\x1c\xed\x16\x19\x47\xc5\x2e\xd7\x49\xa3\x83\x44\xfb\x7d\xe2\xae\x7e\xf9\x1f\xe4\x93\x76\x7f\xbf\xc3\x2b\x53\x6d\x2b\x07\x73\xf6\xa8\x3d\xb4\xd2\xbc\x75\xd4\xaf\xed\xa3\x62\x83\xdb\xbd\xa4\x5b\xdc\xe4\x61\x1b\x3b\xc6\xa7\x3c\xab\xbb\x0f\xa4\x4d\xf9\xbd\xa0\x6d\x23\xcd\x84\x6d\x25\xf1\xb3\xe6\x57\x2c\xfc\xe8\xfa\xe0\x5d\xe5\xb3\xa5\x93\xec\x32\xe3\x2a\x49\x1b\x8c\x6d\xcf\x6b\x8d\xd1\xb7\x5f\xb5\xd6\x17\xf6\x5d\xc3\xdd\xb5\x85\x6d\xae\x06\xdb\x1a\xb1\x67\xf4\x61\xad\xc3\xa4\x7a\x7f\xb5\xfe\x56\xde\xb7\x63\x9c\xfd\x03\x02\x6c\x77\xbb\xd0\xf2\xe4\x1d\xdc\x97\x97\xb4\x12\x6e\x8e\x52\x6e\xc3\x8b\x7b\x5d\x86\x54\x2b\x9a\xad\x7d\x9b\x9e\xb2\x8b\xf3\x7d\x57\x73\xa7\xf3\x3f\x25\x10\x35\xb6\xb3\x23\x6b\xed\xe8\xf5\xf4\x39\x8e\xeb\xa4\xf5\xd3\x30\x58\xaf\x45\x75\x02\xe0\xe5\x99\x27\x4a\x8b\x5a\x53\xd2\x3c\x37\x5c\xbf\xe8\x8c\xcf\xef\x7b\xdc\x91\x5e\x24\x6b\xff\xd7\xe2\x44\xce\xaa\x39\x7a\x7f\xe5\xcf\x56\x96\xa9\xef\x12\x96\x59\xa6\x30\x48\x7a\x20\xde\xa9\x40\xdf\x1a\xef\x4d\x54\x7e\x92\x2c\xe5\x0f\x1e\x4a\x50\xde\x66\x81\x39\xe7\x25\xed\x69\x3d\x18\x6c\x4d\x88\x98\x73\xb7\x01\xde\xa1\xb2\x96\xb1\xc8\x95\xc3\xeb\xd2\x1f\x2e\x1b\x79\xfb\x79\xe6\x7f\x47\x39\x2c\x24\x2b\x22\xc9\xe8\x09\xa6\x27\xb1\xcd\xff\x3b\x65\xc7\xbb\x57\x13\x36\xd2\xcd\xaf\x0b\xbc\xe7\x76\xe8\x17\x5b\x9e\x15\x0c\xd2\x61\xed\xc5\xfa\x6b\x9a\xa3\x0b\x24\xf2\x99\x15\x3a\xc3\x18\x52\x0a\x5c\xe3\x8d\xcf\x4c\xb9\xb8\x43\x3c\xfd\xe2\x0e\x5e\xdd\x92\xbb\xe8\xff\x94\x33\x82\x74\xe8\xde\x62\x79\xb2\xfb\x77\x00\x00\x00\xff\xff\xd4\x41\xc3\x9d\x2f\x35\x00\x00")
+var _templateDialectSqlQueryTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3b\x6b\x6f\xdb\x3a\x96\x9f\xed\x5f\x71\xc6\x48\x0b\x3b\x70\x94\xb6\xbb\x58\x60\x5d\x64\x81\x6e\xd3\x62\x8d\x3e\xe7\xa6\x77\xe6\x43\x10\xcc\x55\x24\xca\x26\x2c\x93\x0a\x49\xe7\x01\x5f\xfd\xf7\xc5\x39\x7c\x88\xb2\x2d\xc7\xe9\x6d\xf7\xee\x62\xe7\x43\xd1\x48\x24\x0f\xcf\xfb\xa5\xe3\xf5\xfa\xf4\xb8\xff\x56\x56\x0f\x8a\xcf\xe6\x06\x5e\xbd\x78\xf9\xef\x27\x95\x62\x9a\x09\x03\xef\xd3\x8c\x5d\x4b\xb9\x80\xa9\xc8\x12\x78\x53\x96\x40\x9b\x34\xe0\xba\xba\x65\x79\xd2\xff\x36\xe7\x1a\xb4\x5c\xa9\x8c\x41\x26\x73\x06\x5c\x43\xc9\x33\x26\x34\xcb\x61\x25\x72\xa6\xc0\xcc\x19\xbc\xa9\xd2\x6c\xce\xe0\x55\xf2\xc2\xaf\x42\x21\x57\x22\xef\x73\x41\xeb\x1f\xa7\x6f\xdf\x7d\xbe\x78\x07\x05\x2f\x19\xb8\x77\x4a\x4a\x03\x39\x57\x2c\x33\x52\x3d\x80\x2c\xc0\x44\x97\x19\xc5\x58\xd2\x3f\x3e\xad\xeb\x7e\x1f\x69\x80\x37\x79\xce\x0d\x97\x22\x2d\xa1\xe0\xac\xcc\x35\x14\xd2\x5e\x7e\xbd\xe2\x65\xce\x54\x02\xb4\x7b\xbd\x86\x9c\x15\x5c\x30\x18\xe4\x3c\x2d\x59\x66\x4e\xf5\x4d\x79\x7a\xb3\x62\xea\xe1\xd4\x9e\x1c\x40\x5d\xf7\x7b\xeb\xf5\x09\xdc\x71\x33\x87\xa3\xe4\x57\xc1\xee\x2b\xa9\x0c\xcb\xdf\x4b\xc5\xf8\x4c\x7c\x60\x0f\x9a\x36\xf5\x70\xc7\xfb\x0f\x1a\xae\xa5\x2c\xed\x19\x26\x72\x5a\x5a\xca\x9c\x17\x9c\x29\x0d\x97\x57\xc5\x4a\x64\x43\x0d\xc7\xfa\xa6\x4c\x2e\x18\x5e\x2a\xd5\xa8\x1f\xed\xee\xa2\xc1\x48\xc8\x4a\x29\x02\x53\x9e\x4c\xcb\x29\x1d\x1f\xb4\x31\x9a\x40\x5a\x55\x4c\xe4\x43\x87\x59\x1b\xaf\x75\x3d\x86\xf5\x1a\x14\xcb\x18\xbf\x65\x0a\x8e\x92\xbf\x22\xc0\xcf\xe9\x92\x41\x5d\x27\x01\x4a\x92\x24\xa3\xf1\x06\x11\xdd\xf8\x10\x0a\xeb\x35\x1c\x55\x8b\x19\x4c\xce\xe0\x28\xb9\xc8\x64\xc5\x92\xaf\x69\xb6\x48\x67\xcc\xaf\x3a\x02\x71\x47\x95\xea\x2c\x2d\xc3\xc6\xff\x74\x2b\x6e\x63\x40\x6f\x72\x16\xa1\xea\x8f\x23\x4b\x91\x34\x18\xb6\xf6\xd6\x35\x1c\xc7\xb7\xd4\xf5\x08\xf4\x4d\xf9\xa6\x2c\x87\x99\xb9\x87\x4c\x0a\xc3\xee\x4d\xf2\xd6\xfe\x3f\x82\xe1\xe5\x15\xed\x4f\x1c\xf1\x63\x60\x4a\x49\x35\x82\x75\xbf\x77\x9b\x2a\x18\xf6\x7b\x3d\x21\x73\xa6\xe1\x0c\x36\xb6\xae\x51\x3b\x0e\xd3\xa1\xa0\x44\x67\xb0\x81\x6d\xe2\x56\x1c\x28\xc7\xe7\x5e\xef\x1f\xba\x62\xd9\x8e\xed\xa4\xc5\x17\x15\xcb\x90\x9c\x51\x1b\x81\x77\xf9\x8c\xf9\x0b\x4b\x99\xe6\x2c\xff\xf6\x50\x59\xcc\xd7\x6b\x28\x99\x80\x04\xea\xfa\x0a\x15\x79\x8d\x7b\xe8\xac\x4a\xc5\x8c\xc1\x11\x43\x79\x24\xee\x70\xaf\xb7\x79\x2d\x3e\xb3\xe4\x5d\x3a\x63\xea\xa3\x4c\xf3\xf7\xa8\x79\x50\xd7\xf0\x97\x33\x10\xbc\x1c\x07\x68\x01\xff\x5e\xdd\x6f\xbf\x19\x1d\x6a\x6f\xf1\xb6\xf7\x1f\x62\x9a\x7a\xbc\x40\x7e\x38\x8c\xf9\x38\xc2\x7a\xbd\x06\x5e\xc0\xcc\xc0\x11\x87\x17\x88\xd8\xef\xbf\xe3\x56\x7b\xf9\xd3\x88\x09\xc7\x50\x05\x7a\x2d\xd9\x19\xb5\x62\xf4\xae\xee\x6f\xd1\xcb\x0b\xf0\x1b\xed\x39\x92\x60\xf2\x59\xe6\x2c\x79\x2b\xcb\xd5\x52\x20\x04\x67\x95\xdb\x6b\x64\x90\x47\x91\xad\x24\x11\x63\xd0\x0e\x1d\x4f\xe3\x4b\x2d\x94\x8b\x2c\x15\x7f\x4b\xcb\x15\x09\x1a\x2d\x62\x98\xb9\xeb\x2e\xaf\xb4\x51\x5c\xcc\x48\xcb\xb9\x30\x4c\x15\x69\xc6\xd6\x2d\x1d\x27\xe5\x46\xd9\x3f\x6f\xa9\x76\x26\x45\xc1\x67\x93\x2d\xf5\xb3\xef\xeb\xc8\x28\x1c\x45\xf4\x38\x06\xfc\x0f\xb5\x52\x31\xb3\x52\x82\x1e\x13\x1d\x10\xf4\x98\x8d\xfa\xbd\x80\xfe\x1b\xad\xf9\x4c\x74\xa1\x3e\x86\x5b\x3a\x09\x2d\x02\x46\xd6\x48\x09\x7f\x5e\xa0\x66\x0f\xf1\x26\x3d\x82\xb3\x33\x78\x41\xaf\x3d\x06\xc5\xd2\x24\xef\x70\x73\x31\x1c\x78\xdf\x54\xd7\x13\x70\xd7\x66\x69\x59\xb2\x9c\x24\x27\x57\x86\x1e\xb9\x98\x41\xc3\xd3\x01\x52\xe3\xe9\x45\x3e\xe1\xff\xfa\xb2\xb9\xf2\xe4\xe5\x55\xb7\x15\xe2\x16\xfb\x22\x69\x1b\x64\xf4\xb4\x61\x27\x2d\xd6\xa5\x84\x65\x9b\x79\x9e\x25\x96\x89\xa8\x0f\x18\x1b\xcb\x52\xde\xc1\x72\x65\x52\x83\xf8\x63\x50\xd4\x37\xe5\x4c\xa5\xd5\xdc\xfa\x76\x74\x19\x70\xfd\x00\x18\xf5\xd9\xbd\x61\x42\x73\x29\x34\x48\x05\x2b\x8d\x21\x9c\x2d\xab\x32\x35\x4c\x27\x14\x42\x23\x7a\xcc\xb2\x2a\x35\x12\xbe\x4c\x4d\x36\xff\xe6\xf6\xed\x0a\x47\xa8\x8d\xa7\xc7\x14\x05\x5a\xae\x05\x21\x20\x00\xfa\xc3\x73\x06\xd7\xef\xfd\xad\x6e\xcf\x51\x73\xd4\x73\x23\xfe\x9b\x17\x28\x76\x84\xd4\x26\x0d\xcd\x48\xa3\x3b\x1c\x6f\xa9\x6b\xae\xf0\xaf\x31\x90\xaa\x8d\x5e\xd3\x79\x6b\xe5\xb0\x8e\x58\xcd\x4b\x32\x09\x62\x68\x87\x3e\x45\x52\xd1\x63\x04\x10\xb8\xef\xa8\x24\x57\xd4\x92\x7e\xe0\x21\xf1\x3b\x87\x23\x18\xfc\xc2\xb2\x41\x84\xe1\x00\x77\x0f\xd0\x8d\x79\xa6\x80\xd9\xc3\x60\x86\xee\x17\x35\x87\x8b\xd9\x00\x92\xfd\xdc\xda\x46\xf8\x49\x01\xf3\xad\x5c\x09\xd3\x11\x32\xb9\x30\xb1\x0b\x21\xe6\x22\xf5\x8f\xc5\x2a\x87\x52\x90\x1e\xdd\x71\xb0\xf4\x9e\x86\xff\xbb\x7b\xae\xbb\xf0\xc7\x00\x18\x13\x20\xc6\x5e\xb1\x36\x31\x88\x19\x31\x0a\x1a\xb8\xad\x41\x45\x5a\x6a\x36\xee\x74\x36\xd9\x9c\x65\x0b\x60\x88\x12\x13\x19\x9b\xc0\xb3\xbb\x01\xdd\x69\x6d\xd8\x01\x11\xf0\x1f\xf0\xe2\xa9\xa2\x6a\xf1\x78\x9b\xd6\xe3\xb6\xa9\x90\x17\x88\x45\xf6\x7c\x7b\x1d\x0d\x03\x85\x32\x89\x16\xf1\xd9\xaf\xf5\xbe\xa5\xd7\x25\x9b\x6c\xc5\x2b\x7a\x4d\x99\x80\x0b\x69\xdb\x5b\x7c\xac\xf3\x0e\x60\xb7\xb2\xa3\xe7\x43\xfc\x4e\x79\x3e\xf0\x3e\xa1\x46\xc0\xef\x95\x5c\x6e\x87\x24\x7d\x43\xf9\xc7\xaf\x82\xdf\xac\xd8\x84\x62\xf4\xd8\x5b\xb2\xcb\xb1\x77\x48\xd6\xae\xbc\x26\x5b\xb7\x7f\x8f\x90\xfd\x24\xd4\x9d\x91\x7b\x99\x2e\xd8\xb0\x09\x4b\x2f\xc6\xf1\x51\x9f\x88\xf1\x02\x8e\x92\xff\x4a\xf5\x17\xc1\x28\x45\x9a\x9e\x3b\xcb\xfe\xa3\xc9\x00\x3d\x4f\xcf\x51\xb0\xda\xa4\xc2\xa0\x9a\x23\x17\xb1\x02\xe2\x28\x48\xeb\x85\x1c\xbd\x6b\x9f\x2d\xd9\xe7\x4b\x7e\x85\x89\xda\x01\x30\x7d\xca\xf3\x54\x74\xc3\x3d\xa3\x26\x3d\x0a\xce\xa9\xd4\x8d\x7f\xa3\x60\x35\xcd\x99\x30\x54\x39\x81\x8f\xf7\xa9\x62\x90\x96\x77\xe9\x83\x06\x4d\x55\x0a\xcb\x5d\x05\xf4\x64\x6c\x9a\x0c\xb1\x40\xc6\x1c\x25\x6f\xe5\xb2\x92\x9a\x1b\x46\xd2\xd8\xcd\xdb\x22\xe6\x42\xc8\x00\x1f\x65\xb1\xbe\xe3\x26\x9b\x37\xe4\xbb\xd7\x59\xaa\xd9\x46\xa6\xba\x13\x17\x9b\xb5\x1e\x71\x8f\x56\x93\xae\x1e\x86\xdf\x84\x6e\xcb\x59\x91\xae\x4a\x33\xf9\x09\xa2\x73\xb1\xc4\x85\x16\x0a\x66\x9e\xab\x17\xb2\x30\xe7\xac\x64\xc6\x09\xb7\xda\x69\x66\x95\x62\x39\xcf\x30\xb1\xe8\xf7\x7a\xa7\xa7\x80\xa7\x4e\x72\x3a\x96\x63\x2e\xc2\x0d\x67\x56\xfa\x05\x2f\x0d\x53\x2c\x07\xcc\xc3\x30\x51\x49\xb3\x39\xb0\x7b\x96\xad\xb0\x5e\xb6\xcd\x01\x4c\xbe\x4f\x4f\xad\xcf\x1b\xc3\x4a\x94\x4c\x6b\xe0\x46\x7b\xbf\x07\x77\xa9\x86\x4c\xb1\xd4\xb8\x9c\x0e\x96\xfc\x9e\x8b\x64\x2a\xb2\x72\x95\x33\x8b\x6e\x9e\xd8\xac\xf1\x2f\x76\xcd\xbd\x74\x5b\x72\xf2\xf4\x56\x8c\x55\xc4\xb9\x4a\x5f\x4e\xd0\xde\x2b\x3d\xf2\xff\x5f\x75\x69\xd2\x85\x51\xab\xcc\xf8\xaa\x62\xaa\x3f\xf3\x72\x38\xf2\x69\xa4\x4b\x2f\xaa\xc6\xdd\xb4\x6d\x84\x17\xf0\x18\x27\x5f\xef\x84\xe0\x44\xe5\xe4\xff\xd5\xef\xf6\x89\xb5\x76\xb5\xff\x46\x87\x02\xd6\x3b\x54\xbc\xf2\xea\x5d\xa1\x5e\x84\xa3\xbe\x04\xe9\xd5\x21\x51\xe2\x4b\x6e\x76\x21\x4b\x0b\xaf\xdd\x7a\x14\x2e\x2d\x72\x1f\xe9\xf5\x19\x1c\xd3\xba\x07\x26\x8b\x42\xb3\x9d\xd0\xec\xca\x6b\xbf\x63\x0b\xde\x17\xfb\xfe\x0c\x8e\xed\x0e\x0f\x71\x37\x23\xa5\xca\x99\xda\xe2\xa1\x07\x85\x8b\x3f\x90\x67\xdb\x3a\xf2\xb7\xb4\xe4\xb9\xb5\xbe\x6d\x86\x2e\x77\x62\x1c\xfa\x31\x16\xeb\xe5\x16\xd6\x9f\xfc\x06\x38\x83\xa5\x8e\x73\x0a\xa2\x0a\x33\xa7\x47\x52\x4b\x8f\xaf\x0b\xb8\xfd\xd3\x53\x78\x2f\xd5\xaf\x55\x8e\xfb\x4b\x99\x2d\x34\x35\xa7\xbc\x6f\x06\x25\xef\x34\xa4\xb3\x94\x0b\x6d\xd0\xfa\xb2\x95\x52\x58\x5a\xac\xe8\x84\x1e\x43\x2a\x72\xa8\x14\xbb\xc5\x97\x66\xce\x96\x50\x28\xb9\x84\x6b\xc6\xc5\x0c\x81\xdb\x7d\xf9\x18\xbc\x2f\x90\x0a\x06\x01\x7a\x92\x24\xd4\xdb\xb3\xbb\x06\x58\xb7\x48\x33\x67\x0a\x34\xd3\x54\xb7\xa0\xf1\x1b\x5e\x12\x4e\x46\xa5\x42\xa7\x19\x79\x09\xae\x11\x38\xe3\xb4\x39\x93\xcb\x25\x37\x0e\xb8\x92\x58\xe8\x9d\x5c\xa7\xd9\x22\x39\x34\xb7\x0a\x1c\x18\xca\xca\x68\x48\x92\x04\xf5\xe0\xa3\xcc\x16\x5f\x2a\xbc\x6e\xb4\x79\x04\x45\xd2\x29\xbc\xc6\x99\x74\x6e\x19\x3b\xbd\xdb\xa5\x70\xa7\xa7\xf0\x55\x6a\x33\x53\xec\xe2\xaf\x1f\x21\x97\x4c\x83\x90\x06\xf4\xaa\xc2\xbe\x25\x71\xe2\x7c\x7a\xf1\x6d\xfa\xf9\xed\x37\xc8\xca\x74\xa5\x99\xf5\x80\x4a\xde\x9d\x94\xec\x96\x95\x56\x8c\xce\xf9\xe9\xe4\xdc\xea\xc0\x90\x6a\x1c\xa7\x10\x89\xbb\xc1\xe9\xb2\x4e\x2e\x98\x39\xe7\xda\x70\x91\x99\x21\xe5\xb8\xde\x8d\xe9\xa4\xcd\x1c\xdb\xa1\xa8\x9b\x24\x7f\x83\xc6\x7e\xd0\xa9\x8b\x39\x7a\xfb\x6b\x36\x4f\x6f\x99\x06\xcd\x97\xbc\x4c\x55\xf9\x00\x46\x36\xfc\x1e\x03\xbb\xcf\x58\x85\x9a\x93\x1a\xe0\x06\xd2\xec\x66\xc5\x15\x46\x0a\xd0\x78\x3e\x87\x25\xb6\x2d\x90\x22\x14\xb8\x14\x90\x8a\x07\xab\x94\x74\x04\xaf\x50\x2c\xcd\x13\xf8\xd2\xd2\x1b\xc8\x52\x41\x0b\xae\xff\x7c\xa7\xc7\x70\x4d\x75\xbf\x40\x66\x92\x20\x1e\x70\x6d\x89\x60\xad\x8e\x3d\xc8\x95\x6a\x29\x99\xd5\x2b\xfd\x14\x35\x22\xa2\xff\xa9\x45\x3b\xb5\xa8\xe1\xcd\x21\x4a\xd4\x6f\x35\x3c\xd2\x3c\xc7\x76\xc7\x92\x99\xb9\xb4\xbd\x74\x94\x2b\xe5\x07\x27\x9e\xa1\x07\x37\x3d\xbe\xa7\xe7\x91\x86\x7e\xbe\xef\x7c\x3c\xd2\xf8\xe8\xee\x7b\x44\x51\x3c\xfa\xb3\x1f\x92\x3d\xf7\x15\x24\xaa\x8e\x5c\x2b\x1e\x75\x9e\x01\x6f\x92\x6a\x97\xa2\xca\xa2\xdd\x07\xf2\x45\xdc\xfe\x4f\x0b\x11\xfc\x86\xa0\xae\xba\x66\x7a\x1e\x57\x89\x94\xf2\x34\x65\xe2\x43\xc5\x26\x36\xbd\x0c\xa5\x06\x36\xbc\x6c\xa6\xed\x1a\x8d\x51\xbd\x38\x39\xa4\x40\xc1\xfd\xf5\x78\x33\x69\x8a\xb2\xea\x09\x76\xe9\x3b\x30\x8a\x44\xe3\x92\xd8\xe8\xa0\x2b\x51\x7a\xae\x02\xda\xc4\xbe\xe8\xc0\x7d\x3f\xf6\xad\xb4\x1d\x71\x77\x95\x6c\x3b\x65\xab\xc7\xfb\xa4\xdf\xf9\xbd\x25\x0a\xda\x7f\xe6\xa7\x15\x6a\x2c\x74\x77\x1f\x82\x03\x42\xb7\xe6\x8f\xda\x1e\x5e\xf0\x1e\xbb\x1b\x3f\x8d\x73\x19\xf5\x7b\xe6\x25\xe2\xea\xce\xdb\x5e\xc3\x70\x93\xe3\xf4\x76\xd4\xef\x79\xbe\xc4\x27\x2c\x16\x43\xf3\xd2\x57\x3e\x5b\xa7\xdd\x7b\x74\x42\xf4\x0f\xbb\x0d\x43\xf3\xd2\xb6\x7d\x36\x31\xd4\x37\x65\x9c\x87\x86\x1b\xb7\x93\x37\x7d\x53\x46\x1b\x3c\x1e\xe1\xf9\x40\x6c\x1e\xef\xf5\x3a\xc8\x52\xfd\xec\x2e\xaf\xbb\xc6\xfb\xbb\x9f\xd1\xe9\xc5\xdc\xef\x1f\x63\xa8\x9a\xf4\xba\xbb\x1a\x42\xb5\xea\x55\x81\xa1\xa3\xc3\xaa\xd5\x43\xea\xbf\x27\x54\x77\xf1\xf5\xbd\x27\x13\x43\x15\xc9\x06\x1d\x8f\x15\x0e\xdf\x5f\x33\x9d\x9e\xba\xba\x8c\x6b\x58\xa6\x22\x4f\xe9\xe3\x3b\x62\xe9\xf6\xda\xb8\x9f\xc0\xdf\x19\x68\x93\x2a\x63\x8b\x6e\x62\xa7\x6b\x35\xd8\x2f\x10\x36\xc9\x97\xb7\x4c\x29\x8e\x73\x01\x06\xae\x19\x6a\x26\x2f\x40\x30\x96\xe3\xf0\x40\xa4\xf8\xb6\x48\x1b\xba\x12\x6d\x64\x8b\xc0\xe1\x32\x35\xf3\xe4\x53\x7a\x3f\x15\xe6\x5f\x5e\x05\xb2\x9e\x5c\x57\x86\x5b\x2c\x54\x5b\x58\x5a\x70\x8e\xfd\xcb\x6e\xf6\x37\xb9\x15\xb2\x67\x19\xcb\xb2\x29\xa4\xfc\xcb\x7e\xbd\x15\x91\xad\x5d\x54\xa9\x99\xb7\x02\x32\xbd\x86\x19\x13\x4c\xa5\x98\x22\x50\x45\x43\xbb\x64\x01\x29\xcc\xf8\x2d\x13\xc0\xf2\x19\xdb\x1f\x8f\x1b\xe8\x4d\x38\x3e\x12\x48\x0d\xa6\x0d\x40\x39\x01\xc6\x7f\x8c\xdb\x70\xe7\x44\x16\x21\x80\x75\x97\xbb\x81\x4c\xd1\x7f\xa8\xb0\xdf\xe6\xf1\x03\x44\x0b\x0c\x22\x84\x60\x50\x82\x98\x4f\x21\xfe\x33\x85\xb9\x0a\x82\x44\x34\xc0\xc8\x16\x3c\x4a\x3c\x62\x98\xd4\xde\x83\x93\xb0\x21\xf0\x3a\xda\xf3\x4b\xc3\xff\xe0\xd7\x10\x17\x4d\x8d\x1e\xfa\x6a\x4d\x0a\x97\xf9\x00\x1d\xe5\x37\xb6\x5f\xa4\x8d\xc4\x3a\xc0\x4e\x4d\x70\x05\x06\x9d\xbf\x86\x21\x4b\x66\x09\x7c\x7a\xf5\x65\xd4\x42\x12\xb9\x80\x38\x56\x8a\x0b\x03\x47\x22\x18\xd6\x20\xc1\xef\x2f\xce\xdd\x46\xc1\x7a\xbd\x76\x0e\x44\x24\xbe\x55\x67\x81\x74\xc0\x88\x03\x7d\xa3\x20\x44\xda\x91\x91\xdf\x77\x35\xb3\x39\x47\xb8\xdf\x48\x08\x60\xdc\xda\x01\x28\x78\xcd\xdd\x65\x4f\x71\xf8\x6e\xbe\x6f\x4c\xce\x82\xba\xe3\xe7\x8c\xe1\x61\x1f\xce\xb4\x61\x55\xeb\xe3\xdc\x67\x76\x77\x61\x58\x85\x13\x14\x4d\x42\xa6\xe4\x92\x22\xaf\xd8\x0a\xdc\xd6\xe3\x11\x8f\xb1\x1d\xea\x31\x18\x8d\xe3\xf3\xdf\x24\x9d\xde\xa0\xbe\x0d\xc2\x48\x4c\x4d\x5a\xc7\x50\xb5\x86\xe1\x09\x37\xb1\xe4\x17\x56\x12\x94\xd0\x13\x66\xc9\x54\x4f\xc5\x2d\x53\x94\x56\x8e\x61\x0b\x4d\x77\x33\xde\x14\xb1\x3a\xe4\x73\x98\x24\xb3\xe4\xd3\xab\x4f\x56\xf7\x5d\x04\xd9\x01\xe1\xeb\x87\xe8\x78\x92\x24\x1e\x00\x25\xb4\x8f\x9c\xdd\xd2\x96\x70\x58\xe4\xee\xde\xd1\x38\x4c\x5b\x74\x66\x0b\x56\x42\x28\x9e\x9f\x9c\x2e\xa0\xc7\xf8\x99\xa9\x02\xb2\x89\x5c\x03\x7a\xb1\x48\xf9\x2e\x98\xf9\xcc\xf8\x6c\x7e\x2d\x95\x7e\x34\xbf\x1c\x03\x2a\xef\xa8\xc3\xb3\x93\x4a\x3e\xea\xd9\x53\xeb\xcc\xdd\x22\x9d\xf1\x4e\x1e\x5d\xf3\x21\x4e\x1e\x0f\xfd\xff\x70\xf2\x46\xfe\x00\x27\xff\xe5\xd5\xa7\xb6\x93\xff\xf3\xfc\x2c\xf1\x81\xe7\xbb\x5c\xec\xf4\xfc\x47\xb9\xc6\xd6\x7b\xfb\x62\xa3\x38\x06\x9e\xff\xd3\x5d\xfe\x9f\x76\x97\x68\xc8\x7f\x86\xbb\xfc\x83\xbe\x72\x8f\x53\x6b\x0f\xad\xec\x75\x50\x6e\xa9\xc3\x95\xf8\x19\x20\xeb\xf1\x76\x58\x5a\xc7\x58\xdf\x6b\x77\x22\x4a\x5f\xda\xea\x87\x70\x7b\xbd\x62\xe1\x24\x44\x9f\xf9\x1d\xd5\x34\x79\x15\x3e\xf6\xa3\xf7\xb5\xdf\xfa\x7b\x3c\x6f\x76\x2f\xd3\xea\x32\x6e\x70\xe1\x8c\xe5\xc6\x4c\xe9\xc6\x69\x57\x93\xf8\x91\x32\x5b\x96\xe0\x93\x6b\x99\x22\xf8\x4b\x7c\x4e\xa6\xe7\x57\x60\x67\xce\x10\x47\x42\x32\x34\x80\x8b\x05\x4e\x22\xd9\x5d\x04\x36\x1e\x37\xc3\xfb\xd9\x46\xb1\xda\x8c\xb0\x3a\x87\xe0\xf0\x5b\x87\x8f\xbe\x61\xe4\xb5\xd7\xc3\x60\x86\x54\x5e\x5e\x45\x07\x1a\x0a\xc3\x1e\x0d\x1b\x6c\xd8\xda\x7a\xb5\x31\x37\x4b\xb8\x8e\xc2\x54\x44\x7b\x0c\x05\xb1\x6f\x8d\xa2\xf4\x7a\xf8\x2a\x6e\x33\xe2\x73\xb3\xda\x73\x3e\x68\xb2\xcb\x29\xb9\x5e\xde\xee\x81\x95\x3d\xfe\xc9\x77\xf5\x74\xd7\xa9\xaf\x1f\x3a\x1a\x7b\xbd\xf0\xe5\x75\xb2\xa7\x0d\x4f\x6d\xf1\xbf\xcf\x99\x22\x37\x9b\x4c\xfd\x7c\xe4\x01\x97\x5d\xba\xa1\x81\x36\xa5\x2f\xd1\x1c\xd1\x1f\xd6\xf5\x8b\x60\x99\x57\x63\x28\x16\xd4\x42\x72\xdf\xf6\xc7\xc1\x55\xca\x15\xd5\xd7\x03\xbc\xfd\xf3\xaa\x2c\xa7\xc2\xfc\xdb\xbf\x0e\x9a\x91\x04\x14\xdf\xaf\x9a\xa9\x73\xb2\x6b\x3f\x95\x80\xa7\xd0\x26\xa7\xe7\x74\xc8\xc9\xb7\xf1\x04\x1e\x3a\x17\x7b\x81\x37\x1a\xb2\x7d\x05\xc7\x51\xd0\x68\x47\xe7\x3d\xcd\x88\xa6\x63\xf4\x08\x2e\x5f\xc5\x43\xa2\x8e\xcf\xae\x54\xd9\x58\x7b\xee\xc9\xa9\x6b\x9c\x87\x7d\xee\xae\xc6\xa7\x3a\xe6\x95\x1d\x13\x75\x37\xc8\x95\x19\x63\x2e\xd2\x31\x89\x8a\x06\x41\x5b\xe4\x02\xc9\x97\x2b\x93\x0c\x8f\x9b\x7b\xac\x0c\xd0\x83\xfd\x45\x2e\xe0\xf7\xdf\x81\x11\x3b\x1b\xaf\xd4\xdb\x3d\xb5\xba\xc2\x51\x69\x9a\x88\x01\x9e\xdb\xce\x0b\x25\x9c\x68\xa0\x27\x72\x65\x06\x0e\x70\xed\x50\xe0\xc2\x63\xc0\x85\x43\x80\x8b\x9d\xf7\x73\xf1\x47\xaf\xe7\x62\xe3\x76\xb9\x32\x24\x14\xe7\x9f\x37\xc6\x1f\xdf\xa8\xd9\x00\x06\x48\xf7\x00\x06\xd4\x42\x1b\x90\x36\xc1\xc0\x8b\x79\x10\xa4\x72\xf8\x28\xe4\xe9\xf2\xd5\x32\x25\x39\xd9\xa1\xc8\xb6\x9e\xf4\xb8\x78\x1c\x23\x2e\x22\x84\x82\xf2\xb5\xd0\x22\x1e\xfe\x38\xac\xd0\x55\x07\x39\xe5\xfa\xd2\x33\xee\xaa\x25\xa5\xc3\xe4\x82\xb0\x50\x37\xb8\x2d\x41\xf4\x04\x9e\xdd\x0e\xc6\xe0\x41\xb6\x25\xe4\xfd\x7a\x08\x23\xee\x05\x6a\x76\xbc\x1d\x5f\xeb\x4b\xf7\xee\xaa\xbd\xbd\x79\xdf\x4c\x7c\x37\x58\xe2\x00\x63\x63\x42\x21\x53\x79\x24\xb9\x6a\x39\xfe\x27\x64\x59\xbd\xef\xca\xb3\x5a\x92\x6a\x0d\x2e\x3f\x9e\x76\xed\x4d\xbc\xda\xa9\xd7\xe6\x53\xd4\x60\xf1\x74\x13\xcd\x48\xfc\xf7\x8d\x30\xc7\x5c\x6f\x0d\xa0\xfe\x46\x84\x5a\x85\x00\x9a\x46\x0d\xf1\x7e\x30\x81\x67\x77\xbf\xf9\x01\x54\x27\x22\xda\xee\x62\x52\x14\xc6\xa3\x60\x34\x3d\x9f\x0a\xaf\x2d\x21\xa8\x08\x9f\x38\x86\x19\x5a\x0b\xc8\xfd\xe0\x66\x14\x51\xdd\x89\x35\x75\x90\x1c\x1a\x3e\x35\x8a\xf2\x22\x7f\x83\x3b\xe9\x26\x9a\xad\xe9\x20\x3a\xfa\x12\x0b\xa1\xab\xfe\xb6\xdd\x74\xb1\x26\xb2\x9d\x0d\xce\x90\x3a\x83\x3d\xc7\x72\x78\x76\xfb\xdb\x18\x44\xc8\xaf\x08\xc3\xcd\xc9\x9f\x38\x6f\xa3\x0c\x0c\x07\x80\xf6\x67\x61\xce\x94\x0e\xd8\x3c\x06\x11\x5d\x1d\x32\x7b\x8c\xf4\x36\x92\x7e\xb9\x13\xef\x3f\x78\xed\x8a\x92\xd2\x8e\xb4\x6d\x57\x2e\x8b\x68\xec\xca\x67\x0f\x4b\xe4\xf6\x70\x03\x51\x3d\x2a\x48\x4e\x47\x2c\xfa\x3d\x8b\x43\xb7\x67\x97\x02\x17\xf0\xce\x62\xb1\xc1\x80\x00\x08\xc9\x2d\x16\xf6\x8b\x6e\xf2\x99\x97\x25\x26\x75\x7e\x03\xaa\x58\xb1\x68\x6b\x58\xaf\xd7\x72\x73\xe4\xe2\x8e\x8b\x85\xf3\x55\x9e\xea\xcb\xe3\x62\x11\x79\xb7\xf8\xed\x38\xa0\xe6\xce\x44\xf6\x1d\x3e\x3d\xef\xbc\x26\xdc\xe2\xc1\xed\xbc\x63\xc7\x15\x6d\x6f\xf1\x14\xbb\xfc\x5f\x64\x93\x9e\xbe\x3f\x60\x95\x85\xd5\x95\x93\x05\x7b\xb0\xbe\xab\x91\xbc\x37\xd4\x9f\x6d\xa3\xa2\xc3\xec\xbe\xa7\x5a\xec\xb2\xb0\xd8\xb6\x9e\x64\x59\xbb\xeb\x40\x22\xca\xf3\x21\x48\xa1\x59\xf0\xa5\x24\xee\x0b\xea\xe6\xdc\x48\xa8\x83\x0f\xe5\x8f\xf3\x05\x4e\x1d\x1d\xde\xeb\x5d\x21\x70\x23\x48\xbb\x13\xfb\x7e\x01\xe8\x94\x7e\xfb\x87\x77\x6d\xc0\xb1\x69\x84\x0f\xc2\xc9\x36\x56\xc3\x7d\x85\xd8\x13\xea\xb0\xad\x66\x52\xbb\xbe\xaa\xff\x2c\xeb\x3b\xd0\xcf\xfe\x00\x07\xbb\xbb\x5c\xd8\xb2\xe4\x03\xcc\x97\x6b\x82\x84\xc4\xa1\xbe\x39\x2b\x6e\x17\x88\x9b\x3f\xa4\x48\xa6\xe7\x21\x8b\xde\x76\xc9\x41\x2d\x5a\xb9\xb5\x37\x83\xf5\xba\x93\xc0\xe3\x00\xb4\x58\xfc\x8f\xf8\xab\x0d\xaa\x0f\x44\xed\x3b\xd8\xd3\xb0\x63\xbf\x2d\x07\xb8\xf6\x47\x34\x50\xd7\xa2\xe9\x27\x44\x51\x6b\x0f\x14\x4c\x54\xd6\xeb\x0d\xab\x8f\xe5\x52\x7f\x57\xc7\x30\xae\xa2\x42\x83\x30\x55\xad\xdf\x97\xbf\x51\xb3\x66\x8d\x26\xb6\xe2\x55\x8f\xa0\x5b\x17\xab\xb2\x34\xd8\xb7\x88\xb6\xf8\x2a\x2f\xec\xe2\x05\xcc\x53\xfd\x55\xb1\x82\xdf\x47\x47\xb0\x5b\x32\x70\x5d\x63\x12\x32\x82\x0e\x9d\x10\x7b\x11\x21\x17\x3e\x8b\x44\x2d\x6a\xcb\x63\x9c\x32\x3d\x2a\xba\xc5\x8c\x60\xd3\x88\x1e\xc7\xb0\xe8\xcf\xfd\xbc\xab\x14\xab\x52\xc5\xe8\x07\x67\x11\xc7\xba\x7f\x91\x7f\xe0\xd7\x1b\xe7\x84\x8a\xc6\x09\x6d\xd6\x29\xd1\xef\x62\xd0\x7c\xf6\x4c\xaf\x0c\x0b\xe7\x7b\x9d\x61\x3d\xa7\x35\x9a\x91\x20\xd3\x5a\xa3\x93\x98\x40\x41\x6e\x70\xd2\xf9\xa3\x3a\x2e\x6e\xf1\x9c\x9d\x30\x84\x67\x37\x64\x55\xc4\x85\xc1\x18\x8a\x51\x98\xab\x5f\xaf\x4f\x80\x89\x1c\xea\xba\xff\xdf\x03\x00\x10\x5a\x12\xfe\x0b\x43\x00\x00")
 
 func templateDialectSqlQueryTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -887,12 +962,12 @@ func templateDialectSqlQueryTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/query.tmpl", size: 13615, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/query.tmpl", size: 17163, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlSelectTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\xc1\x6e\x13\x31\x10\x86\xcf\xeb\xa7\xf8\xa9\x2a\x94\x8d\x82\x53\x7a\x03\xd4\x43\x89\x8a\x54\x09\x21\x41\x78\x81\xad\x3d\xdb\x58\x18\x3b\x3b\xf6\xa6\x89\x56\x7e\x77\x34\x9b\x25\x0d\x55\x2f\x1c\x22\x4f\xfc\x7f\x33\xff\x8c\x67\x87\x61\x39\x57\xab\xb8\x3d\xb0\x7b\xdc\x64\x5c\x5f\xbd\xff\xf0\x6e\xcb\x94\x28\x64\x7c\x69\x0c\x3d\xc4\xf8\x0b\xf7\xc1\x68\xdc\x7a\x8f\x11\x4a\x10\x9d\x77\x64\xb5\xfa\xb9\x71\x09\x29\xf6\x6c\x08\x26\x5a\x82\x4b\xf0\xce\x50\x48\x64\xd1\x07\x4b\x8c\xbc\x21\xdc\x6e\x1b\xb3\x21\x5c\xeb\xab\xbf\x2a\xda\xd8\x07\xab\x5c\x18\xf5\xaf\xf7\xab\xbb\x6f\xeb\x3b\xb4\xce\x13\xa6\x3b\x8e\x31\xc3\x3a\x26\x93\x23\x1f\x10\x5b\xe4\x33\xb3\xcc\x44\x5a\xcd\x97\xa5\x28\x35\x0c\xb0\xd4\xba\x40\xb8\xb0\xae\xf1\x64\xf2\x32\x75\x7e\x99\x48\xc2\x0b\x94\x22\xc4\xe5\x43\xef\xbc\xf4\xf3\xf1\x06\xdb\x26\x99\xc6\xe3\x52\xaf\x4d\xdc\x92\xfe\x3c\x29\x13\xc8\x64\xc8\xed\x8e\xe4\x29\x3e\xa5\x8b\x61\xdb\x07\x83\xd9\x3f\x6c\x29\x98\x9f\xbb\x94\x52\x23\x75\x7e\x6d\x9a\x30\x33\x79\x0f\x13\x43\xa6\x7d\xd6\xab\xe3\xb9\xc0\x0e\x2e\x64\xe2\xb6\x31\x34\x94\x1a\xc4\x1c\x19\x83\xaa\x38\x3e\x25\x71\x7e\x9b\x3a\xaf\x7f\xc4\xa7\x34\x14\x55\x75\x3d\xf1\x61\x81\x86\x1f\x47\xed\x85\xb3\x4e\x9d\xff\x2e\xc4\xac\xd6\xd3\xa9\x2a\xd7\x4a\xcd\xd7\x68\xcb\x12\x4d\xa4\xc9\xfb\x05\xce\xca\x2f\x20\x0d\xd4\x9f\xc6\xe4\x37\x37\x08\xce\x4b\x57\x15\x53\xee\x39\xc8\xad\xaa\x8a\xaa\x2c\xb5\xc4\x23\xaa\x57\x3e\x26\x12\xc7\x09\x91\xbe\x65\xec\xb5\x6c\x7a\x26\xc8\x02\xbb\x5a\x15\xf5\x3f\xef\x36\x8d\x31\x16\x93\xd8\xd1\xf8\x38\xc7\x95\xc6\x57\xc7\x4a\x9d\x7f\x06\xf4\x7a\x0c\x66\xa7\xff\xab\xe8\xfb\xdf\x21\xbd\x34\xd7\xad\x23\x6f\x93\xd6\xba\x96\xdf\xf3\x10\x53\x9e\x1a\x3f\x09\x0a\x56\xf6\xfe\x27\x00\x00\xff\xff\xec\xf4\x7b\x6f\x2c\x03\x00\x00")
+var _templateDialectSqlSelectTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\xc1\x6e\xdb\x3c\x10\x84\xcf\xe2\x53\xcc\x1f\x04\x3f\x24\xc3\xa5\xd3\xdc\xda\x22\x87\x54\x48\x81\x00\x45\x81\x56\x7d\x01\x85\x5c\xc5\x44\x59\x52\x5a\x52\x8e\x0d\x81\xef\x5e\x50\x56\x1c\x37\xc8\xa5\x07\x83\x6b\xce\xb7\x9c\x59\x52\xd3\xb4\x59\x89\xda\xf7\x07\x36\x8f\xdb\x88\xeb\xab\xf7\x1f\xde\xf5\x4c\x81\x5c\xc4\x97\x56\xd1\x83\xf7\xbf\x70\xef\x94\xc4\xad\xb5\x98\xa1\x80\xac\xf3\x8e\xb4\x14\x3f\xb7\x26\x20\xf8\x91\x15\x41\x79\x4d\x30\x01\xd6\x28\x72\x81\x34\x46\xa7\x89\x11\xb7\x84\xdb\xbe\x55\x5b\xc2\xb5\xbc\x7a\x56\xd1\xf9\xd1\x69\x61\xdc\xac\x7f\xbd\xaf\xef\xbe\x35\x77\xe8\x8c\x25\x2c\x7b\xec\x7d\x84\x36\x4c\x2a\x7a\x3e\xc0\x77\x88\x67\x66\x91\x89\xa4\x58\x6d\x52\x12\x62\x9a\xa0\xa9\x33\x8e\x70\xa1\x4d\x6b\x49\xc5\x4d\x18\xec\x26\x50\x2e\x2f\x90\x52\x26\x2e\x1f\x46\x63\x73\x9e\x8f\x37\xe8\xdb\xa0\x5a\x8b\x4b\xd9\x28\xdf\x93\xfc\xbc\x28\x0b\xc8\xa4\xc8\xec\x8e\xe4\xa9\x3e\xb5\x67\xc3\x6e\x74\x0a\xe5\x5f\x6c\x4a\x58\x9d\xbb\xa4\x54\x21\x0c\xb6\x51\xad\x2b\x55\xdc\x43\x79\x17\x69\x1f\x65\x7d\x5c\xd7\xd8\xc1\xb8\x48\xdc\xb5\x8a\xa6\x54\x81\x98\x3d\x63\x12\x05\xfb\xa7\x90\x9d\xff\x0f\x83\x95\x3f\xfc\x53\x98\x92\x28\x86\x91\xf8\xb0\x46\xcb\x8f\xb3\xf6\xca\x59\x86\xc1\x7e\xcf\x44\x59\xc9\x65\x15\x85\xe9\xf2\x99\x6f\xd1\x9a\x73\xb5\x90\x2a\xee\xd7\x38\x3b\x7e\x8d\x1c\xa0\xfa\x34\x37\xff\x77\x03\x67\x6c\x4e\x55\x30\xc5\x91\x5d\xde\x15\x45\x12\x85\xa6\x8e\x78\x46\x65\x6d\x7d\xa0\xb2\x12\xcf\x48\xce\x9d\xc7\x6e\xf2\x4b\x97\x19\x59\x63\x57\x89\x24\xfe\xe5\xde\x96\x31\x72\x39\x07\x35\x34\x5f\xce\xf1\x49\xfd\x9b\x63\x85\xc1\xbe\x00\xb2\x99\xc9\xf2\xf4\xbf\xf6\x76\xfc\xed\xc2\x6b\x73\xd9\x19\xb2\x3a\x48\x29\xab\xfc\x7b\x19\x62\xe9\x13\xf3\x27\x41\x4e\x23\x25\xf1\x67\x00\xec\xf4\x7b\x6f\x2c\x03\x00\x00")
 
 func templateDialectSqlSelectTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -912,7 +987,7 @@ func templateDialectSqlSelectTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectSqlTxTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x92\xcf\x6e\xdb\x3c\x10\xc4\xcf\xe2\x53\xcc\x67\xf8\x0b\x2c\x43\xa1\xd3\xdc\xaa\x22\x87\xd4\x4d\x81\x00\x85\x7b\xa8\xef\x81\x4a\x2e\x65\x22\x0a\xe9\x90\xeb\x58\x81\xc0\x77\x2f\xf4\xc7\x69\xdc\x1e\x7a\xb2\x31\xbb\x9c\x99\xdf\x42\x5d\xb7\x5a\x8a\xb5\xdf\xbf\x06\x5b\xef\x18\xd7\x57\x1f\x3e\x5e\xee\x03\x45\x72\x8c\xaf\x95\xa2\x9f\xde\x3f\xe2\xde\x29\x89\xdb\xa6\xc1\xb0\x14\xd1\xcf\xc3\x0b\x69\x29\xb6\x3b\x1b\x11\xfd\x21\x28\x82\xf2\x9a\x60\x23\x1a\xab\xc8\x45\xd2\x38\x38\x4d\x01\xbc\x23\xdc\xee\x2b\xb5\x23\x5c\xcb\xab\xd3\x14\xc6\x1f\x9c\x16\xd6\x0d\xf3\x6f\xf7\xeb\xbb\xcd\x8f\x3b\x18\xdb\x10\x26\x2d\x78\xcf\xd0\x36\x90\x62\x1f\x5e\xe1\x0d\xf8\x5d\x18\x07\x22\x29\x96\xab\x94\x84\xe8\x3a\x68\x32\xd6\x11\x66\xda\x56\x0d\x29\x5e\xc5\xe7\x66\xc5\xad\xdf\xb3\xf5\x2e\xce\x90\x92\x58\xad\xf0\x99\x6a\xeb\xb6\x2d\x02\xf1\x21\xb8\x88\x0a\x1c\x2a\x17\x2b\xd5\x6f\x55\x0d\x54\x63\x7b\xec\xa3\xe5\x1d\xe2\x9e\x94\x35\x96\x34\x26\x13\x29\xcc\xc1\x29\x2c\x14\x96\xeb\x61\x2f\x3f\xf9\x2d\x14\xb7\x50\xde\x31\xb5\x2c\xd7\xe3\x6f\xd1\x3f\x8b\x58\xc6\xe7\x46\x6e\xdb\xef\xa3\x45\x8e\xc5\x72\xdb\x16\xa0\x10\x7c\xc8\xd1\x89\xcc\x1a\x3c\x14\xf0\x8f\x28\x6f\xa0\xa4\x0e\xf6\x85\x82\x5c\x2c\xb9\xfd\x32\xfc\xcd\x3f\xf5\xb3\x4e\x64\xd9\x58\x19\xce\x36\x05\xcc\x13\xcb\xbb\xde\xc2\x2c\x66\xe4\xb8\x84\xaa\x9c\xf3\x8c\xc8\x55\xe0\x73\xa8\x81\xc5\xba\x73\x71\x96\x8b\x2c\x89\x8c\xc7\x26\xe7\xd1\xd6\x31\x05\x53\x29\x1a\x52\xdf\x00\xff\x84\xfb\x8b\x6b\xba\xbb\xfc\x8d\x27\xb2\x94\xcb\x77\x17\x1a\x2f\x92\x0f\xcc\x7d\xec\x7f\x37\x3d\xcd\xbf\xe1\x06\x2a\xeb\xea\x73\x86\x12\xff\x1f\x67\x43\xd4\x08\xa3\x4c\x3d\x82\x28\xef\x8c\xad\x07\x61\x82\xc2\x0d\x2e\x4e\x07\xed\xb8\x2d\xd1\x77\xd1\xe1\xa5\x7c\xc3\x4e\xe2\x54\xe1\x62\xdb\xf6\x85\x46\x93\x12\xca\xd4\x85\xc8\xb2\xae\x43\xa8\x5c\x4d\x98\x3f\x14\x98\xbb\x3e\x68\x2e\x37\x5e\x53\xc4\x65\x4a\x22\x1b\x36\xe6\x4e\x6e\xaa\x27\x42\x4a\x25\x36\x74\x3c\x53\xc6\x0f\x66\xa1\x4c\x9d\x4f\x7e\xe4\xf4\xf8\x36\x15\x3d\xb7\x48\x62\x12\x53\x12\xbf\x02\x00\x00\xff\xff\x3c\xf6\xe6\x96\x93\x03\x00\x00")
+var _templateDialectSqlTxTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x58\x6d\x6f\xdb\x38\x12\xfe\x2c\xfd\x8a\xd9\xa0\x2d\xa4\x44\xa5\x9b\xf6\xb0\x87\x73\xea\x03\xba\x69\x17\x08\xd0\xeb\x1d\xb6\x59\xdc\xc7\x82\xa6\x46\x36\x2f\x32\xe9\x25\x29\x47\x39\x57\xff\xfd\x30\x24\x25\x4b\x76\x92\xbd\xe6\x43\x2c\xf1\x65\x5e\x9e\x99\x79\x38\xd4\x7e\x3f\x3b\x4f\xaf\xf5\xf6\xc1\xc8\xd5\xda\xc1\xdb\x37\x97\x7f\x7b\xbd\x35\x68\x51\x39\xf8\x95\x0b\x5c\x6a\x7d\x07\x37\x4a\x30\xf8\x50\xd7\xe0\x17\x59\xa0\x79\xb3\xc3\x92\xa5\xb7\x6b\x69\xc1\xea\xc6\x08\x04\xa1\x4b\x04\x69\xa1\x96\x02\x95\xc5\x12\x1a\x55\xa2\x01\xb7\x46\xf8\xb0\xe5\x62\x8d\xf0\x96\xbd\xe9\x67\xa1\xd2\x8d\x2a\x53\xa9\xfc\xfc\xe7\x9b\xeb\x4f\x5f\xbe\x7e\x82\x4a\xd6\x08\x71\xcc\x68\xed\xa0\x94\x06\x85\xd3\xe6\x01\x74\x05\x6e\xa4\xcc\x19\x44\x96\x9e\xcf\xba\x2e\x4d\xf7\x7b\x28\xb1\x92\x0a\xe1\xac\x94\xbc\x46\xe1\x66\xf6\x8f\x7a\xe6\x5a\xbd\x75\x52\x2b\x7b\x06\x5d\x97\xce\x66\xf0\x0b\xae\xa4\xba\x6d\xc1\xa0\x6b\x8c\xb2\xc0\xc1\x19\xae\x2c\x17\xb4\x8a\xd7\x20\x6a\x49\x6e\xdf\x4b\xb7\x06\xbb\x45\x21\x2b\x89\x25\x44\x21\x2c\xad\x1a\x25\x20\x13\x70\x7e\xed\xd7\xe5\xbd\xbc\x4c\xb8\x16\x84\x56\x0e\x5b\xc7\xae\xc3\x6f\x41\xdb\x2c\x9c\xdb\x3f\x6a\x76\xdb\xfe\x33\x88\xc8\x21\x3b\xbf\x6d\x0b\x40\x63\xb4\xc9\x61\x9f\x26\xb2\x82\x6f\x05\xe8\x3b\x98\x2f\x40\xb0\xd2\xc8\x1d\x1a\x96\x9d\xbb\xf6\xa3\x7f\xcc\xaf\x68\x6e\x9f\x26\x49\x30\x19\x94\xac\x0b\xa8\x36\x8e\x7d\x22\x11\x55\x76\x86\xca\xcd\x41\x70\xa5\xb4\x03\xeb\xb8\x71\x53\xa7\xbc\x2f\x52\x4d\x07\xcf\xf2\x34\xe9\xd2\xc4\x05\x4b\xa6\xaa\xa5\x72\x68\x2a\x2e\x90\xac\x4b\x06\x07\x8f\x9d\x3b\xf1\x2b\xe2\xce\x0e\xee\xa5\x49\x97\xb3\x11\x42\x01\x91\xdc\xfb\x8c\xc6\xc0\x4f\x0b\xf2\xe6\xcf\x9d\xf3\x5e\x49\xb5\x9a\xfa\x30\x87\x97\xf7\x67\x5e\x55\x70\x46\x54\xab\xe0\x88\xd0\xaa\x92\x2b\x3f\x10\x9d\x82\x05\xbc\xea\x01\xdd\xbb\x76\x0e\xe4\x78\x69\x76\xf3\xc1\xed\x2e\xed\x4d\x78\x75\xdb\x92\x41\x82\x96\x91\xcd\xf4\xec\x05\xce\x41\x54\xab\x22\x4d\x92\xfd\x1e\x0c\x57\x2b\x84\x17\xdf\x0a\x78\xa1\x48\xe9\x0b\xf6\x45\x97\x68\xe1\x75\xd7\xa5\x89\x5f\xf1\x42\xb1\x2f\x7c\x83\xd0\x75\x73\xf8\x82\xf7\x93\x91\x90\x3c\x99\xa8\x56\x79\x94\x87\xaa\x0c\x7b\xbb\x82\x20\x49\xbb\x34\x75\x0f\x5b\x84\x2c\x4d\x66\x33\xe8\x51\xa6\x0c\xab\xe4\xaa\x31\x68\x7d\x79\x8c\xc0\xa0\x01\xee\x80\x1b\x04\x6c\x51\x34\x0e\x4b\x58\x3e\x40\xd0\xc4\xfe\x2d\xdd\xfa\xb6\x65\x69\x32\x48\xa2\x44\xa6\x24\xbb\xf6\x12\xf3\xd4\xeb\xe9\x5f\x61\xad\xeb\x92\x24\xe2\xa0\x91\xfb\x5d\xba\x3a\x91\x38\xec\xb1\xce\x34\xc2\xf9\x60\x52\x94\xc1\xff\x4d\x93\x24\x4d\x12\xee\x1c\x6e\xfc\xb4\x54\x2e\x4d\x92\x25\x17\x77\xba\xaa\x00\x82\x45\x71\x1a\xa4\x72\x39\x38\xb9\x41\xf6\x31\xea\x0e\x39\x62\x1e\xf8\xb2\xc6\xb0\x36\x96\xd0\x52\xeb\x9a\xe2\x9f\xa7\x54\xdf\xc1\xae\xa8\x0f\x2c\xba\xe0\x46\x2c\x60\xc8\x90\xad\x98\x1f\x91\x56\xd7\x5e\x30\xd4\xb8\xc3\x3a\x0f\xe4\x32\xc5\x34\xd6\xfb\x44\x66\xf6\x68\x51\xf7\x8f\xb0\x1f\xf2\x88\xb6\x12\x53\xf4\x00\xf9\x62\x4f\x04\xf3\xfb\x17\xbe\x12\xc8\xec\x6e\x64\xf6\x87\x1e\x9c\xc1\xee\x0d\x6f\xe5\xa6\xd9\x80\x6a\x36\x4b\x34\xde\x46\xb9\xc1\x3e\xd8\x63\x63\x89\x75\xfb\xc8\x33\x12\x79\xbb\x46\xa2\x44\xde\xd4\x8e\xe6\xde\x15\xc0\x55\x09\x97\x50\x4a\x4b\x18\x12\x83\x3b\x23\x71\xea\x64\x6f\x41\xa6\x42\x08\x7e\xc0\xaf\x21\xb2\x0b\x50\xc7\x8e\xfd\x12\xa3\x3c\xf8\x45\xe0\x78\xb9\x3e\x6b\x7b\x32\x5e\xeb\x7b\xa8\xb5\x5a\x81\xd3\x70\xcf\xa5\x83\x25\x56\xda\xa0\xb7\xf4\xe1\xa4\xfe\x49\xbc\xdf\x5e\x71\x59\x13\x49\x87\x33\x63\x25\x77\xa8\xa0\x4f\xa4\xec\xc0\x1d\x0e\x2e\x73\x76\x0c\x0b\x57\x80\xed\x56\x2b\x54\x4e\xf2\x1a\x62\x3a\x92\x68\xa2\x4d\xf8\x8f\x74\x0e\x4d\x31\x62\x20\x07\x97\x6f\x36\x53\xd0\xa2\x77\x59\x15\xc1\x79\x3a\x89\x7f\x08\xd0\xbe\x34\x16\x50\x9d\x00\xfa\xdb\x50\x0a\x4f\x42\xba\xd5\xc6\x59\x90\xd5\x51\x9a\x8c\x11\xf3\x2e\x0e\x98\x91\xd3\xbe\xa6\xc0\xae\x75\x53\x97\xb0\xc4\x98\x23\xe5\x09\x6c\x94\xfe\x37\x76\xb0\xc2\x1f\x45\x27\x8a\x8f\xca\x89\xe4\xdf\x73\x0b\x7c\xa9\x0d\xd1\x53\xd9\x20\x05\x9a\x43\x89\xbc\xac\xb5\xb8\x2b\x80\x03\xfd\x7a\xcc\x74\xe3\x40\x1b\xe0\x60\xd1\x48\x5e\xcb\xff\x86\x62\xa5\x58\x37\x06\x27\xf8\x0f\x66\x0c\x11\x18\x51\xc3\x0f\x41\x7e\x60\x98\xc7\x40\xef\xeb\xcb\x42\x45\xe5\x31\x05\x36\x94\x97\xd0\x9b\x8d\x24\xd8\x1d\x21\x5f\xa9\xa1\xcf\xf0\x07\x9b\x36\x60\x74\x5d\xfb\x69\x8a\x2e\x89\x96\x0a\x04\xb7\x38\x5e\x4b\x39\x49\x0e\x90\xff\x5b\xae\xa4\xb0\x0c\x6e\x4f\xa8\x9e\x90\xe8\x31\xf4\x28\x13\xd3\x87\x8d\xb6\x00\xdb\x88\x35\x89\xe7\x76\x80\x97\x04\x97\x8f\xc3\x69\x0b\x7f\x72\x8c\xa3\x6d\x7c\xe9\x15\x64\x57\x4c\x07\xea\x2f\xd6\x7c\x87\x60\x65\x89\x24\x1b\xab\x0a\x85\xb3\xa0\x1b\x47\x43\xc7\xf4\xc9\xe0\x57\x6d\x00\x5b\xbe\xd9\xd6\x38\x4f\x67\xb3\x74\x36\x4b\xfa\x6e\x63\x7c\x8a\x50\xf3\x54\x84\x98\xb8\x16\xce\xf7\x7b\x58\x12\x22\x2f\xa8\xd7\xa8\xe4\x8a\xfd\x8b\x8b\x3b\xbe\xa2\xa3\x94\xdd\xb6\x79\xc4\x66\x4f\xd2\xe8\xd0\xfa\xdd\x22\xb8\x16\xc8\x62\xe2\xbd\x71\x2b\x41\xaf\x5d\x01\xcf\x09\x9c\x72\xfb\xab\x09\xad\xef\x6f\xfa\x33\x62\x0e\x34\xf1\x99\x0e\x8a\xaf\x3d\x7e\xcb\x1a\xbb\x3c\x0f\x6e\xc1\x4d\x38\x3c\x62\xf7\x28\x9f\xea\x2b\x3d\x9c\x23\xa2\x0e\x59\xa4\xd0\xd2\xf3\x68\x43\x41\xf0\x52\xb4\xa4\xaf\x36\x42\x3e\xc6\xa6\x00\x4e\xf1\x47\x8b\x31\xd4\xa1\x9c\x68\x88\xe2\x80\x66\xa3\xad\x1b\x8b\x7a\xa4\x67\x3d\xa0\x7e\xda\xb2\xf6\x15\x74\x3e\x20\x1d\xdb\x58\xc6\x06\x60\x0e\x21\xe8\x7b\xae\xbe\x8e\xf6\x91\xfa\xec\x1c\xde\x15\x3d\x99\x52\xb7\x15\x29\xb2\x80\xa1\xc2\xe6\x8f\x72\x48\x97\x26\x95\x36\xbe\x21\xde\x3a\x92\x1c\x1a\x2d\x6f\x41\xec\x2c\xb2\x57\xd4\x37\x51\x6d\xfe\xff\xad\xf3\xa4\xcf\x0d\xae\xe7\x69\xf2\x48\x1b\xda\x27\x0f\x1a\x93\x26\xa4\xa2\x1f\x30\x0d\xf5\xb0\x24\xa7\x52\xa1\xdd\x24\x3b\x7b\xa6\x9f\x2f\xe0\xf2\x0a\xae\xfa\xf7\x8b\x8b\x53\xad\x93\x36\x98\x3a\xd3\xd8\x0a\xf7\x46\x2c\x46\x46\xf8\xf7\x63\x95\x49\x77\xb2\xf8\xfb\xf7\xc1\x82\xbf\x2f\xa8\x3b\x65\xf1\xd5\xc2\xf7\xef\xf0\x13\x0d\x0c\x78\x67\xd4\x27\x3f\xe1\xa3\x45\xba\x30\xf9\x49\xcf\x45\xef\x5f\x0b\xd7\xb2\x8f\x5a\x61\x96\xcf\x4f\x76\xc4\x25\x44\xd2\xec\x43\xe5\xd0\x50\x23\xcb\x62\xb0\xb3\x68\x41\xee\x37\x76\x07\x0a\xf5\xde\x1c\x33\xe8\xe1\xbc\x7e\x92\x49\x8f\x39\x33\xe6\x73\x0f\x0e\xf8\xdb\xd4\x69\xd6\x8e\x72\xb4\xc4\x0a\x4d\x98\x0f\x3d\x98\xac\x60\x47\x41\x31\x28\xf4\x0e\x4d\x96\x5f\xc1\x6e\x92\x04\xae\x65\xbf\xe9\xba\x26\x75\x19\x85\x28\xf1\x2c\x9c\xed\x62\x14\xba\xec\x70\x85\x99\xd3\x41\x91\xb9\x36\xbf\x3a\x4e\x25\x59\x81\x89\x2b\x26\xf2\xae\xc0\x1c\xad\xf4\xa4\xb8\x98\x5c\x7b\x5e\xde\xcf\xbd\xdb\xd4\x72\x90\x19\x63\x7c\xe6\xf0\x72\x17\xee\x3d\x54\x4e\xc6\xf4\xb9\x31\x0e\x52\x37\x36\xd0\xb5\xec\xda\xa3\x99\x9d\x5a\x19\x37\x8d\x75\x3f\x43\x97\xf3\x18\x16\xdf\x50\x3d\x73\x15\x8b\x52\xe3\x4d\x66\x36\x3b\x30\x00\xd1\x99\x1b\xf5\x12\x31\x6d\x4e\x6f\x16\x70\xe3\xa9\x4f\xf0\xed\x16\x4b\xdf\xbc\xf5\x4d\xd7\x20\xeb\x99\x46\x8b\x7c\x2b\x29\xc6\x7e\xf4\x2b\x0a\xad\x4a\x0f\x49\xbf\xe5\xfd\x02\xfe\x4a\x8b\x92\x12\x16\x70\xf9\x06\xce\x7d\xd3\xc1\xfe\x21\xeb\x5a\x5a\xbf\x1c\xde\xbf\x87\x41\xc3\x6b\xb8\x9c\x78\x56\xce\xde\xc2\xc5\x54\x65\x66\xb8\x2a\xd9\x8d\x72\x3f\xbf\x53\x99\x54\xee\xe7\xbf\x64\xe5\xec\x6d\x9e\xe7\x69\x97\xc6\x1b\xde\xb3\x1f\x2a\xc2\x4d\x75\x66\xf9\x0e\xb7\x5a\x2a\xd7\x7f\xb1\x18\x06\x42\x13\x6a\x1f\x3d\x30\xe8\xae\x27\x0c\x72\x1f\x18\x3e\xda\x43\x1f\x52\xda\x08\x9c\x2f\x97\x81\x1c\x0f\x8b\x1e\x3b\x09\x72\x38\xf0\xe8\xf8\x6b\x85\x6b\xd9\xa6\x61\x9f\x75\xa8\x0c\xd7\xb2\x60\xcb\xc5\x45\x9a\x28\xba\xee\x52\x3d\x6c\x1c\xfb\xba\x35\x52\x39\x4a\x64\xfb\xed\x65\x79\x56\x90\x11\x34\x1f\x1e\xfc\x96\xbc\x17\xf6\xbb\xaa\xa3\x38\xea\x19\x5b\xb6\xe5\x86\x3a\x99\x11\x21\xd2\x4e\x58\x00\x7d\x0c\x38\x83\x0b\xa0\x57\x1f\x0c\xbb\x1d\x08\x96\x0e\x93\xaf\x63\x87\xbc\x26\xfa\xa1\xd5\x7f\xfa\xc9\xa1\x2f\x9a\x38\x36\xfd\x58\x40\x6a\xfc\xc7\x02\xd7\xb2\xd2\xec\x0a\x08\x16\xd2\xb9\x16\xc4\xcf\xfd\xff\xe1\xea\xbe\xdf\x03\xaa\x12\xba\x2e\xfd\xdf\x00\x92\x08\xab\x83\x5a\x13\x00\x00")
 
 func templateDialectSqlTxTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -927,12 +1002,12 @@ func templateDialectSqlTxTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/tx.tmpl", size: 915, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/tx.tmpl", size: 4954, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\x5b\x6f\xdb\xb8\x12\x7e\xb6\x7f\xc5\x54\xf0\x09\xec\xc0\x55\x72\xfa\x76\x5c\xf8\x00\x69\x2e\x07\xc6\x69\xd3\x6e\x9d\xf6\x61\x8b\x22\x50\xc4\x91\x4d\x58\x26\x15\x92\x4a\x93\x35\xf4\xdf\x17\xc3\x8b\x2c\x5b\x76\x2e\xdd\xee\x3e\x34\x95\x39\x17\x0e\xbf\xb9\x71\xb8\x5a\x1d\x1d\x76\x4f\x65\xf1\xa0\xf8\x6c\x6e\xe0\xcd\xf1\xbf\xff\xf3\xba\x50\xa8\x51\x18\xb8\x48\x52\xbc\x91\x72\x01\x13\x91\xc6\x70\x92\xe7\x60\x99\x34\x10\x5d\xdd\x21\x8b\xbb\x57\x73\xae\x41\xcb\x52\xa5\x08\xa9\x64\x08\x5c\x43\xce\x53\x14\x1a\x19\x94\x82\xa1\x02\x33\x47\x38\x29\x92\x74\x8e\xf0\x26\x3e\x0e\x54\xc8\x64\x29\x58\x97\x0b\x4b\x7f\x3f\x39\x3d\xbf\x9c\x9e\x43\xc6\x73\x04\xbf\xa6\xa4\x34\xc0\xb8\xc2\xd4\x48\xf5\x00\x32\x03\xd3\xd8\xcc\x28\xc4\xb8\x7b\x78\x54\x55\xdd\xee\x6a\x05\x0c\x33\x2e\x10\x22\xc6\x93\x1c\x53\x73\xa4\x6f\xf3\xa3\xb2\x60\x89\xc1\x08\xaa\x8a\x38\x7a\xc5\x62\x06\xa3\x31\xf4\xe2\x69\x2a\x0b\x8c\x3f\x25\xe9\x22\x99\x61\xa0\xde\x94\x3c\x27\x6b\x47\x63\x28\x12\x9d\x26\x79\xcd\xf8\xce\x53\x3c\xa3\xc2\x14\xf9\x9d\xe3\xac\xbf\x6b\x71\xcf\xb4\x2c\x4d\x62\xb8\x14\x56\x9d\xe2\xc2\x34\xe4\xa2\x38\x50\x6b\xd3\xa4\x40\xe2\x9c\x27\x7a\x5a\x66\x19\xbf\x5f\xeb\x8b\x3e\x8a\x70\x82\xd7\xd0\xfb\x03\x95\x24\xc6\x63\xa8\xaa\xd5\x0a\x78\xe6\x44\xed\x0f\x47\x1c\x43\x24\x78\x1e\xb9\x25\x14\xac\x16\x55\x68\x48\x32\x12\xd1\x2e\x59\xa2\x8e\x21\xba\x16\x92\xe1\xa6\x70\x37\x2b\x45\x0a\xfd\x8d\x93\x57\x15\x1c\x36\x31\xab\xaa\x01\xe8\xdb\x7c\x9a\xdc\x61\x3f\x35\xf7\x90\x4a\x61\xf0\xde\xc4\xa7\xee\xff\x41\x10\x37\x24\xb9\xb1\xb7\x55\x13\x5f\x26\x4b\x6f\x08\xe6\x9a\xbe\xb8\x30\xb5\x05\x43\x40\xa5\xe8\x9f\x54\x03\x58\x75\x3b\xd7\xba\xc0\x94\x8e\x72\xa0\x6f\xf3\x99\x4a\x8a\x79\xfc\xc5\x3a\x7a\x5a\x60\xba\xea\x76\x3a\x97\x92\xe1\xa8\x41\xa5\xdf\x81\xd6\xb9\x4a\x6e\x72\x1c\x81\xdd\x76\x1d\x01\xb1\x5d\x1e\x12\xc3\xa9\xcc\xcb\xa5\xd0\x6d\x16\x4f\xb0\x4c\x93\xb3\xe6\x06\x17\x1c\x73\x56\xef\xd0\xb9\x7a\x28\x70\x04\x19\x2d\xc6\x56\xc9\xe4\x2c\xa6\x35\x82\x43\x1b\x7f\x56\xab\xc6\x6f\xd6\xde\x2b\x88\x59\x89\x44\x98\x20\x60\xff\xd2\x9f\xaa\xdb\x21\xaf\xae\x81\xec\x76\x3a\x9c\x0d\x41\x2e\x08\x99\x8d\x08\x6c\xa8\xfb\xe0\xd7\xfe\x67\x3d\xd1\x1f\x90\x50\x06\xaf\xe4\x02\xac\xe5\x0a\x4d\xa9\x04\xd4\xb1\x44\xd8\x1f\x7c\x4d\x72\xce\xac\xd4\x39\xb9\x60\x45\xf6\x8f\x20\x9a\x9c\x45\xd6\x31\x23\xc8\x96\x26\xb6\xa4\xac\x1f\x2d\xb9\xd6\x5c\xcc\xa0\xe9\xd5\x78\x72\x06\x99\x54\xe0\xb3\x71\x40\xa6\xd2\x3f\xeb\x47\xeb\x1c\x32\xed\x6b\x92\x97\x08\x63\xe0\xcc\x19\x65\xe1\xd3\xe1\x30\x8d\xc8\x8b\x1d\xe5\x2d\xe4\x28\xfa\xee\x7b\x00\xff\x85\x63\x77\x84\x86\x52\xef\x2f\x18\xc3\x32\x59\x60\xff\xdb\x77\x6d\x14\x17\xb3\x21\x1c\x0f\x9b\xb2\x83\xbd\x62\x49\x51\xa0\x60\xfd\x36\x6d\xf8\x1c\x7f\x59\xbd\x74\xee\xeb\x21\x64\xb6\x56\x24\x62\x86\xe1\x5c\x2e\x52\x08\xfc\x6d\x55\x16\x6f\xb7\x51\x3f\x1b\x78\xc6\xe0\x1a\xc1\xf3\xbd\x2e\xc9\x76\xf8\x23\x94\xbd\xaa\x1a\x01\x17\x77\x24\xe7\x2c\x80\x7f\xdd\x5a\xa7\xdc\x96\xa8\x1e\xa2\x21\x64\xd6\x2d\xce\x31\x0e\x7f\x78\x35\x7e\xce\x31\x83\x81\x2f\x45\x30\x1b\xac\xf7\xf3\x11\x41\x01\xed\x8b\x0e\x59\x50\xe8\x5d\xa1\x5c\x28\x64\x3c\x4d\x0c\xfa\x08\x28\x1a\xde\x77\xfb\x7c\x0a\x1c\x30\x06\xaa\x5d\x7d\x8d\xb9\x6d\x21\x70\xa8\x6f\xf3\x78\xea\x7f\x79\x68\x09\x04\xbe\x76\x4f\x11\x5c\x53\xe8\x6f\xfc\x7b\x2d\x3a\x68\x98\xe9\x2d\x75\xfc\xbd\xcc\xb5\x94\x90\x59\x17\xce\xbd\x36\x1f\x43\x86\x4a\x05\x7d\x21\x0d\xf4\xb2\x78\xb2\xa4\xb3\xdc\xe4\x38\xa0\x5f\xae\x6c\x9d\x61\x96\x94\xb9\xf1\x32\x74\xf2\x3b\xca\x85\xc7\x72\x39\x6b\x65\xf2\x5b\x08\x49\x1c\x60\x70\x96\xc4\x53\x5b\xd8\x37\x1c\xb1\xa6\x0c\xf7\x17\xb1\x76\x19\xcb\xf6\x15\xb1\x4e\xc7\x26\xef\xc8\xdb\xed\xd7\x1e\x2b\x6d\x59\xab\xb0\x75\x3a\x55\x23\x20\x02\x72\x89\x60\xf5\xbe\x97\xe5\x12\x15\x4f\xbd\xf0\x1d\x2a\x83\xec\x4a\xbe\x4b\x34\x4f\x03\x74\x4f\x62\x77\xc2\x18\x32\x67\xc0\xd4\xa8\x32\x35\xf6\xd0\x2d\x00\x37\x11\x3c\x61\x6c\x0f\x82\x27\x8c\x3d\x8a\xe0\x4b\x20\xdc\x89\xe1\x8b\x41\x0c\x28\x36\x60\x0c\x19\xb5\xeb\x17\xb5\x8f\x2c\xfe\x58\x10\x3e\x49\xde\x88\xc0\xdd\x41\xb7\x89\xd9\x69\x8e\x89\x42\xd6\x1f\xec\x8c\x3b\x4b\xdd\x83\x9b\xa5\xfd\xaa\xd8\xfb\x2b\x71\xd6\x44\x64\xfb\xdb\xe7\x37\xba\xfc\x3e\x67\x33\xf4\x69\xbd\x07\x1e\xac\x73\xd2\x03\x63\xc3\xca\x61\x43\xfa\x7e\x70\x33\x07\xbc\x37\xb4\x47\x0f\x22\x52\x18\x91\xfa\x2a\xb8\x0a\x0c\x2e\x8b\x9c\xea\xd6\xc6\x25\x96\x61\x86\x96\x37\xde\xe9\x46\x07\xac\x35\x6f\x37\xe6\x0d\xd2\x10\x48\xd3\xc0\xf7\x5f\x1f\x00\xb6\x32\x61\xfc\x45\xf0\xdb\x32\x58\x63\x97\x19\xee\x2c\xc0\x9f\x71\x29\xef\x5c\x16\xe1\x56\x44\x4c\xce\x34\x25\x12\x95\x65\x2b\xee\x2a\xf3\xc1\x81\x6b\x72\x2f\x01\xec\x51\xc4\x22\xea\x24\x3a\x02\xa3\x4a\x84\xe8\x77\x54\x32\xaa\x2f\x2c\xce\xc7\xcf\x07\x73\x0b\xcd\x9f\x84\xb3\xc6\xb3\xd6\xf4\x18\x82\xcf\x87\xee\xc9\xe0\x79\x12\x8a\x5f\x11\x56\x3b\x0a\x60\x4d\xd8\x08\xa9\xed\x14\x3a\x3a\xa4\x99\x51\xfe\x00\x77\x7a\x31\xb3\x63\xdd\x8e\x5b\x3b\xdc\x3c\x00\xcd\x9b\xf6\x84\x9a\x4b\xa1\xa9\x69\x96\x9a\x86\x47\x6f\xbd\x8e\xed\xa8\xb7\x46\xa3\x67\x96\x45\x6e\xf1\x5d\x26\x26\x9d\x5f\xed\x3c\xa5\xbb\x73\x1e\x91\xcd\x47\x87\x91\x3b\x5b\x23\xb9\x49\x85\xcd\x6f\xa7\x6b\x0d\xc4\x7d\x0d\x9a\xe3\xe9\xad\x45\xf7\xd5\x8b\x8d\xab\x78\x63\xdc\x19\xc3\xc1\xc6\x8c\x93\x4a\x91\xf1\xd9\xa8\x75\xad\x75\xeb\xeb\x8b\xf1\x89\xd6\x7c\x26\x20\xdc\x7f\x49\x57\x9c\xd8\x35\xdb\x29\x74\xcd\x38\x4d\x13\xbf\xb4\xc9\xac\xeb\xf5\x27\x8c\xe5\x99\x9d\xb0\xc6\xdb\x9e\xa1\xd8\xa2\x71\x6e\xd8\xb2\x95\x29\xfa\x1a\x82\x35\x60\xf0\xd6\x8a\xbf\x1a\xd3\xed\x94\x42\xd6\x6e\xe6\xe6\xb8\x75\xb9\x74\x46\x0d\xf7\xef\xa4\x7f\x7a\xab\x46\xce\x5d\x87\xce\x8f\x4a\xc5\xfd\xc3\xc6\x08\x68\x2e\x64\x29\x98\xbd\x17\x37\x7a\xbd\xb3\xe6\x60\x83\xbc\x6a\xb5\x92\xf7\xc9\x0d\xe6\xf6\xf6\xe7\xce\xc5\x33\x48\x51\xa9\xb0\x17\xd7\xd3\xdf\xde\xdb\x46\xa3\x12\x2e\x8c\x55\xd2\x47\xd5\xde\x87\x84\x7c\xf1\xdd\x35\x6a\x59\x6a\xd5\x6d\xd2\x02\x6a\x82\xe7\x5d\xfb\x52\x10\x86\xf2\x3d\x2f\x1e\x75\x56\x07\x47\x87\xfe\xe5\x5e\x32\x28\x6d\xe1\x35\xd1\x88\x6b\x73\x86\x26\x5a\x68\xc1\x9f\x31\x1f\xad\x7d\xe4\xea\xd5\x67\xcc\x6d\x13\xf6\x9d\x74\x42\x57\x30\xed\x27\x69\x8c\x27\xda\x2f\x78\xf2\x9e\x31\xdb\x31\x5b\xe2\x56\x67\x6e\x8e\xdd\x14\x9d\x18\x7f\x78\xf3\xc1\x3f\x4e\xb4\x35\x7c\xfa\x7f\x43\x7c\xfd\x6c\x10\x66\xbc\xb6\x0b\x9d\x98\xdb\xa4\x39\xbd\xac\x1f\x3a\xc8\x88\x77\x9c\xf1\x70\x22\xfa\xae\x0f\xa3\x66\x68\x46\x5b\x60\xb9\xd5\x95\x7b\x0e\x20\xe4\x5e\xf0\x24\x80\xee\x3e\xf3\xbc\x87\x01\xcf\xdc\x86\xd1\xab\x78\xea\x91\xe0\x89\x32\x1c\x1c\xff\xb7\x15\x61\x1f\x94\xff\x48\x15\x76\x56\xf9\x70\xb7\x65\xc5\xc9\xf8\x41\x7c\xb1\x9e\xf4\x5c\x7b\x76\xd9\xc9\x66\x14\x94\xe4\x4e\x2f\x53\xb7\xbb\x16\x69\x08\x8b\x76\xb7\x6b\x7c\xfe\x19\x00\x00\xff\xff\x74\x6d\x9c\xa5\x4f\x15\x00\x00")
+var _templateDialectSqlUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x59\xdd\x72\xdb\xba\x11\xbe\x26\x9f\x62\xcd\x51\x3d\x92\x47\xa6\xdd\xdc\x55\x19\x75\xc6\xb1\x9d\x1e\xb5\x89\x93\x46\x3e\xe7\xa2\x99\x8c\x07\x26\x97\x12\xc6\x14\x40\x03\xa0\x6c\x97\xc3\x77\xef\x2c\x08\x50\xd4\x9f\x7f\x9a\xb4\x17\x49\x44\x2c\x16\x58\x7c\xfb\xed\x02\xbb\xa9\xaa\x93\xa3\xf0\x5c\x16\x4f\x8a\xcf\xe6\x06\xde\x9d\xfe\xf9\x2f\xc7\x85\x42\x8d\xc2\xc0\x47\x96\xe0\xad\x94\x77\x30\x11\x49\x0c\x67\x79\x0e\x76\x92\x06\x92\xab\x25\xa6\x71\x78\x3d\xe7\x1a\xb4\x2c\x55\x82\x90\xc8\x14\x81\x6b\xc8\x79\x82\x42\x63\x0a\xa5\x48\x51\x81\x99\x23\x9c\x15\x2c\x99\x23\xbc\x8b\x4f\xbd\x14\x32\x59\x8a\x34\xe4\xc2\xca\x3f\x4d\xce\x2f\xaf\xa6\x97\x90\xf1\x1c\xc1\x8d\x29\x29\x0d\xa4\x5c\x61\x62\xa4\x7a\x02\x99\x81\xe9\x6c\x66\x14\x62\x1c\x1e\x9d\xd4\x75\x18\x56\x15\xa4\x98\x71\x81\x10\xa5\x9c\xe5\x98\x98\x13\x7d\x9f\x9f\x94\x45\xca\x0c\x46\x50\xd7\x34\xa3\x57\xdc\xcd\x60\x34\x86\x5e\x3c\x4d\x64\x81\xf1\x57\x96\xdc\xb1\x19\x7a\xe9\x6d\xc9\x73\xb2\x76\x34\x86\x82\xe9\x84\xe5\xed\xc4\x0f\x4e\xe2\x26\x2a\x4c\x90\x2f\x9b\x99\xed\xef\xde\xed\xfa\xa4\x45\x69\x98\xe1\x52\xd0\xa4\x42\x71\x61\x3a\x7a\x51\xec\xa5\xce\xb4\x63\x38\x39\x82\xcf\x6e\x4c\xdb\x83\x3e\x15\xa8\xe1\x81\x9b\x39\xcc\xb9\xb6\xc7\x5f\xb0\x27\xb8\x45\xc0\x47\x4c\x4a\x83\xa9\x07\xc9\x28\x26\x34\x4b\x68\x35\xab\x38\x47\xae\x5a\x9d\xb9\x94\x77\x31\x58\x8c\xc8\xa8\x54\x79\xbb\xb7\x4d\x6a\x64\x64\x50\x55\x01\xcf\xa0\x17\xff\xc6\xf4\xf5\xe3\x6f\xe4\xfd\xba\xee\x68\xb7\xca\xed\x11\x37\x94\x51\xa4\x1e\x05\x29\x90\x76\x9b\x33\x3d\x2d\xb3\x8c\x3f\xae\x50\x8e\xbe\x08\xef\x98\x63\xe8\xfd\x1b\x95\xa4\x89\xa7\xab\xed\x49\xd5\x7e\x34\xc2\x31\x44\x82\xe7\x9b\x3b\x1c\x13\xaa\x86\x34\x23\x11\xed\xd2\x25\xe9\x18\xa2\x1b\x21\x53\x5c\x57\x0e\xb3\x52\x24\xd0\x5f\x73\x68\x5d\xc3\x51\x97\x0a\x75\x3d\x00\x7d\x9f\x4f\xd9\x12\xfb\x89\x79\x84\x44\x0a\x83\x8f\x26\x3e\x6f\xfe\x1d\x78\x75\x03\x75\x0d\x6b\x7b\xdb\x65\xe2\x2b\xb6\x70\x86\x60\xae\xe9\x17\x17\xa6\xb5\x60\x08\xa8\x14\xfd\x91\x6a\x00\x55\x18\xdc\xe8\x02\x13\x3a\xca\xa1\xbe\xcf\x67\x8a\x15\xf3\xf8\x77\xcb\xdf\x69\x81\x49\x15\x06\xc1\x95\x4c\x71\xd4\x91\xd2\xb7\x97\x05\xd7\xec\x36\xc7\x11\x19\xd1\xeb\x10\x3b\xb6\xc3\xc3\x30\x08\x82\x73\x99\x97\x0b\xa1\xb7\xa7\x38\x81\x9d\x44\x88\x1a\x5c\x14\x39\x33\x1b\xa1\x44\x00\x92\x81\x27\x3c\x8d\xa0\x47\xe8\x07\x41\x3d\x0c\x83\x3a\xb4\x4a\xab\x93\x87\x41\x3b\x40\x0c\xfa\x22\xf0\x23\xc7\x3c\x9d\x5c\x34\x3a\x01\x4f\x87\x20\xef\xe8\x9c\x6b\x61\x52\xd7\x31\x7d\xc7\x93\x8b\xd8\x07\xc2\xdf\x2c\xae\xfd\x01\x19\xc6\x33\x38\x90\x77\x04\x53\x10\x04\x0a\x4d\xa9\x04\xb4\xdc\xa8\xeb\x21\x1c\xfe\xc1\x72\x9e\xda\x00\xba\x24\x48\x2b\xc2\x7e\x04\xd1\xe4\x22\xb2\x40\x8f\x20\x5b\x98\xd8\x8a\xb2\x7e\xb4\xe0\x5a\x73\x31\x83\xae\x97\xe2\xc9\x05\x64\x52\x81\x4b\x1a\x03\x6b\xad\xfd\xcb\x7a\xc6\xc2\x4d\xe6\xfd\xc1\xf2\x12\x61\x0c\x3c\x75\x47\x75\xbe\xf5\xf8\x29\x26\x66\x08\x3d\x3e\x84\x5e\x46\xc7\xec\xc5\xe7\x72\x51\x48\xcd\x0d\xb6\x20\x04\x3c\x83\xe5\x73\x38\x64\x5b\x28\xbc\xef\x00\xf0\x26\x04\x68\x52\xe6\x0f\xf9\x6a\x30\xe8\x23\x8b\xa7\x46\x95\x89\xb1\x0e\x24\x8a\x6f\xc1\x13\xd4\xcd\xe9\x9d\x59\x1d\xa0\x3a\x67\xfe\x4e\x6b\x71\xa8\xeb\x1f\x2d\x76\x4b\x3b\xbf\x85\xcc\x85\xe4\xc6\x07\xcf\x20\xa3\x9d\xb5\x07\xa9\x13\xa7\x71\x23\x79\x0f\x39\x8a\x7e\xf3\x7b\x00\x7f\x85\x53\xa8\x36\x1c\xe6\xd8\x0d\x63\x58\xb0\x3b\xec\x7f\xff\xa1\x8d\xe2\x62\x36\x84\xd3\x61\x57\x77\x10\x06\x2f\xf0\x76\xf7\xa2\xac\x28\x50\xa4\xfd\x6d\xd9\x70\x2b\xce\x3c\xbd\xcf\xa5\xd0\x86\x09\xe2\x76\xbb\x6b\x87\x42\x6f\xdc\xa7\xaa\x3c\xe1\x76\x92\x6d\xb7\x19\x59\xd7\x88\x36\x21\x0d\xb6\xdc\x11\x90\xc3\x6f\x86\x60\x97\x6e\xb6\x71\x1e\xa9\x3c\x8b\x0f\x36\xd7\xb7\x2c\x6c\x8c\xeb\x67\x83\x0d\xc2\x0a\x9e\xef\x25\x6a\xb6\x83\x9a\xfe\xd6\xae\xeb\x11\x70\xb1\x24\xbd\x86\x13\xf0\xa7\x7b\x1b\xac\xf7\x25\xaa\xa7\x68\x08\x99\xe7\x63\x18\xbc\xe8\x48\x0a\xbe\x0c\x0e\xc6\xaf\xf1\x90\xb7\xff\xcd\xde\xcf\x2c\x9a\x5d\x83\xba\x3e\x0e\xf4\x03\x37\xc9\x1c\x32\xbf\x7e\xc2\x28\x8a\xaa\x17\x93\x87\xbb\x64\xb8\x77\x6d\x13\x2c\xaf\xf7\xf1\xa8\xd9\x2e\xc5\x8c\x95\xb9\x19\xfd\xba\xc3\xb5\x94\xa1\xbf\xea\x70\x8d\x47\x3c\x83\x42\xef\xca\x74\x85\xc2\x94\x27\xcc\xa0\x0b\xe4\xa2\x13\xc4\xcd\xbe\x5f\xfd\x0c\x18\x03\x5d\xd8\x7d\x8d\x74\x25\x49\x05\x47\xfa\x3e\x8f\xa7\xee\xcb\xf1\x8c\x18\xc1\x57\x5c\x2d\x3c\x4f\x0b\xfd\x9d\xff\x68\x55\x07\x1d\x33\x9d\xa5\xeb\x21\xe4\x53\xaf\xe5\x8d\x76\xe7\x72\x9c\x92\x0a\xfa\x42\x1a\xc2\x77\xb2\xa0\xac\x7d\x9b\xe3\x80\xbe\x9a\xbb\xfa\xa2\x01\xd6\xe9\x10\xd3\x96\x94\xf2\xde\x9a\xea\x57\x99\xbe\x81\xa1\xb1\x24\x9e\xa2\xd9\x74\xcc\x4a\x32\xec\x3c\x0d\xec\x68\xfb\x36\xa0\xe7\xc1\x53\x41\x21\x46\xc3\x6e\xd3\xeb\xa7\x82\xe8\x2a\xb4\x71\x29\x7f\xe8\xa6\xda\x1c\x3d\x72\x76\xbb\xb1\x86\xd6\x23\x78\x91\x68\x6e\x91\x7a\xb0\x1d\x8d\x4c\xa4\xed\xbe\x57\xe5\x02\x15\x4f\x9c\xf2\x12\x95\xc1\xf4\x5a\x7e\x60\x9a\x27\x1e\xba\x17\xb1\x3b\x4b\x53\x4c\x77\x5d\x53\xeb\x00\xae\x23\x78\x96\xa6\x7b\x10\x3c\x4b\xd3\x67\x11\x7c\x0b\x84\x3b\x31\x7c\x33\x88\x41\xfd\x4c\x98\x6d\x7f\xd1\x13\x2c\x8b\xbf\x14\xc4\x24\x96\x3b\x01\xa1\xb8\x9b\x74\xeb\x98\x9d\xe7\xc8\x14\xa6\xfd\x36\x61\xaf\x61\x63\xa5\x7b\x70\xb3\xb2\x5f\xc5\xbd\x9f\xe1\x59\x17\x91\xcd\xdf\x2e\xbe\x71\x15\xdf\xb7\x39\x5e\xa6\x33\x74\xd1\xbd\x07\x25\x6c\x43\xd3\xe1\x63\x5f\x62\x0d\x44\xb4\xac\xad\xcf\xf0\xd1\xd0\x56\x3d\x88\x68\xc1\x08\x7a\x6d\x96\xaf\xaa\x3d\x8f\xe9\x14\x33\xb4\x73\x63\xa8\xb7\x6c\xf7\xd8\xd3\x6a\x7b\xa0\xef\x88\x86\x40\x2b\x11\x51\x3a\x2f\x6f\x9b\xa0\x30\xfe\x5d\xf0\xfb\xd2\x5b\x63\x87\x53\xdc\x99\x87\xbf\xe1\x42\x2e\x9b\x60\xc2\x0d\x62\x4c\x2e\x34\xc5\x13\x3d\x95\x6c\x09\xd0\x24\xe8\xc3\x43\x38\x78\x2b\x60\xcf\x22\x16\xd1\x05\xa3\x23\x30\xaa\x44\x88\xfe\x85\x4a\x46\xed\xd3\x36\x0c\xde\x06\xe6\x06\x9a\xff\x25\x9c\x2d\x9e\xed\x4a\xcf\x21\xf8\x7a\xe8\xaa\xf0\x67\xa1\xf8\x15\xb4\xda\x91\x07\x5b\xc1\x1a\xa5\xba\x4b\xb8\x46\xc5\x59\x9e\xcb\x07\x68\x4e\x2f\x66\xb6\x53\xb3\xa3\x62\x85\xdb\x27\xa0\x16\x92\x3d\xa1\x6e\xfa\x1a\x0a\x4a\x8d\xaa\xb5\x5e\xc7\xb6\x33\xb1\x42\xa3\x67\x16\x45\x6e\x19\xba\x60\x26\x99\x5f\xef\x3c\x65\x53\x80\x9c\x90\xcd\x27\x47\xd1\xaa\x6e\x70\x31\x4e\x4b\xd0\x0a\x6e\xad\x15\x10\x8f\x2d\x68\x56\xe4\x8b\xd8\xcd\x03\x76\x7f\x6f\x54\xb5\x6d\xa9\x3f\x86\xc3\x6e\xb1\x54\x25\x52\x64\x7c\x36\xda\x2a\x52\x9a\xf1\xba\x7d\xcd\x9c\x69\xcd\x67\x02\x7c\x35\x43\x6b\xc5\xcc\x8e\xd9\x0b\x43\xb7\x13\xa7\x09\x73\x43\xeb\x93\x75\x3b\xfe\x82\xb1\x3c\xa3\xc7\x34\x8c\x37\x3d\x43\xdc\xa2\x56\xc6\x10\x3a\x1d\x1d\x7a\x4b\x5a\x03\x07\xef\xad\xd6\xc1\x18\x04\xcf\x29\xcd\xad\xbf\x5b\x5d\x96\x6c\x0c\x1f\xee\xdf\x40\xbf\x75\x07\x77\x0a\xba\xac\x6e\xfc\x75\x8f\x4a\xc5\xfd\xa3\x76\xf5\x2b\x69\x3e\x52\xbf\xd0\x16\xad\x9d\x0b\xbe\x31\xe2\x70\x4d\x5c\x6d\xdd\x1f\x9f\xd8\x2d\xe6\x75\xd8\xd6\xab\x3c\x83\x04\x95\xf2\x7b\x71\x3d\xfd\xe7\x27\x7b\xbb\x28\xc6\x85\xb1\x8b\xf4\x51\x6d\xef\x43\x4a\x2e\xd5\xee\x2a\xc1\xad\xb4\x0e\xbb\xe5\xb9\x07\x4b\xf0\x3c\xa4\x8e\x95\x3f\xec\xbe\x96\x65\x1b\xc3\xde\xad\xfe\xd2\x6a\x7a\x96\x14\xa4\x70\x4c\x32\x8a\xd1\xf5\x6e\x11\xc9\xfc\xbd\xfb\x0d\xf3\xd1\xca\x35\x64\x08\xc6\xdf\x30\xb7\x37\xaf\xbb\x3e\x27\x62\x89\x4a\xbb\x9e\x11\xc6\x13\xed\x06\x9c\x78\x4f\x43\xa9\x59\xca\x0a\x37\xae\x63\x57\x1d\x8c\x7c\x23\x0c\xe3\xcf\xef\x3e\xef\x29\x4e\x30\xfe\xfa\x8f\x8e\xfa\xaa\x41\xe6\xeb\xf3\x6d\x17\xd2\xb7\x2f\x41\x3a\xaa\xb0\xaa\x6d\xe8\x11\xf1\x81\xa7\xdc\x9f\x88\x7e\xbb\xe1\x6b\xa6\x66\x68\xba\xcd\x33\x02\xab\x19\x25\xb8\x82\xc9\x05\x21\x37\xda\xff\x8c\xd9\x7c\xc4\xa0\x85\x92\xaa\xc5\x3d\x8f\x99\xee\x53\xc6\x4d\xde\x3a\x8d\x5f\xa2\x73\x1e\x3a\x84\x6d\xac\x75\xbb\x6b\x2f\x24\x5d\xef\xf8\xff\x59\xca\x75\xa4\xfc\xbf\xe4\x5c\xfb\xa6\xf2\x74\xa7\x74\xe5\xd6\x73\xad\x88\xbb\x55\x2b\xc2\xbe\x47\x5c\x74\xa6\x33\x22\x25\xb9\xd3\xe9\xb4\x97\xdb\x96\x68\x08\x77\xdd\xe7\xd2\xc9\x11\xfc\x5d\x72\x01\x4a\x3e\xd8\xce\x3b\xcd\x07\x9d\xcc\x71\xc1\x34\x30\x85\x90\x28\x64\xd4\x6f\xb7\x86\x35\xed\x75\x57\x3e\x37\x35\x92\x76\x0d\xf6\xce\xad\xde\xb3\x2d\x69\x72\xf0\x5c\xc9\x72\x36\x5f\x15\xee\x86\x7a\xa2\xeb\x45\xa2\xeb\x62\xef\xf8\x3f\x85\xfe\xee\xff\x87\x18\x38\xc5\xc6\xb0\x4b\x52\xb1\xd7\x91\x89\xcf\xed\xc8\xeb\x6e\xa5\xa1\x23\x93\x14\x23\x10\xf8\x40\x93\x4c\xfb\x7a\x73\x4b\xf4\xf7\xa9\x7e\x29\x9a\xad\x06\x5d\x43\x62\x07\x8b\x6e\x5a\xb6\x37\x43\x20\xbe\x58\xfb\xfc\x8c\xe6\x5f\xe2\x6a\x33\xa7\xeb\x1c\x57\x6d\x8f\x1b\x2d\xf7\xb9\x46\x96\xaa\xda\xc5\x9a\xaa\x3a\x06\x14\x29\xd4\x75\xf8\x9f\x01\x00\x7d\x0e\xed\xee\xcb\x1a\x00\x00")
 
 func templateDialectSqlUpdateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -947,12 +1022,12 @@ func templateDialectSqlUpdateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/update.tmpl", size: 5455, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/update.tmpl", size: 6859, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateEntTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\xdf\x6f\xe3\xb8\xf1\x7f\xb6\xff\x8a\x39\xc1\x7b\x67\x07\x09\x7d\xdf\x7b\xfb\xa6\x4d\x81\xbd\x64\xb7\x0d\xb0\xc8\xb5\x4d\x16\x7d\xd8\x2e\x2e\xb4\x34\xb2\xd8\xc8\xa4\x97\xa4\x9c\x04\x86\xff\xf7\x62\x48\x8a\xa6\x24\x3b\x9b\xbd\x45\x9f\x6c\x89\xf3\xf3\x33\xc3\xe1\x70\xb4\xdd\xce\x4f\xc6\x97\x6a\xfd\xac\xc5\xb2\xb2\xf0\xcb\xcf\xff\xf7\xff\x67\x6b\x8d\x06\xa5\x85\xf7\x3c\xc7\x85\x52\x0f\x70\x2d\x73\x06\x6f\xeb\x1a\x1c\x91\x01\x5a\xd7\x1b\x2c\xd8\xf8\xae\x12\x06\x8c\x6a\x74\x8e\x90\xab\x02\x41\x18\xa8\x45\x8e\xd2\x60\x01\x8d\x2c\x50\x83\xad\x10\xde\xae\x79\x5e\x21\xfc\xc2\x7e\x6e\x57\xa1\x54\x8d\x2c\xc6\x42\xba\xf5\x0f\xd7\x97\xef\x6e\x6e\xdf\x41\x29\x6a\x84\xf0\x4e\x2b\x65\xa1\x10\x1a\x73\xab\xf4\x33\xa8\x12\x6c\xa2\xcc\x6a\x44\x36\x3e\x99\xef\x76\xe3\xf1\x76\x0b\x05\x96\x42\x22\x64\x2b\x55\x60\x9d\x41\x78\x3b\x59\x3f\x2c\xe1\xfc\x02\x16\xdc\x20\x4c\xd8\xa5\x92\xa5\x58\xb2\xbf\xf3\xfc\x81\x2f\x91\x88\xb6\x5b\xb0\xb8\x5a\xd7\xdc\x22\x64\x15\xf2\x02\x75\x06\x93\x96\x7d\xbf\x24\x56\x6b\xa5\x6d\xbb\xe4\x9f\x60\x3a\x1e\x6d\xb7\x67\xa0\xb9\x5c\x22\x4c\xd6\xdc\x56\xa4\x6b\xc2\x6e\xc5\xa2\x16\x72\x79\xed\xa8\x0c\x71\x8c\x46\x99\xb3\x86\x48\x76\xbb\xcc\xf3\xa1\x2c\x68\x6d\x36\x1e\xcf\xe7\x40\xcb\xec\x86\xaf\xc8\x2a\xc2\x90\x00\x70\xbe\x00\x4a\x2b\xec\x33\x94\xca\x23\xd9\x21\x34\x79\x85\x2b\xce\xc6\x24\xee\x51\xd8\x0a\x26\x76\xb5\xae\x0d\x99\xb1\xe2\x36\xaf\xee\xa2\x03\x4e\xd6\x3c\x57\xab\x15\x4a\x3b\xe7\x45\x21\xac\x50\x92\xd7\xf3\x13\x07\x56\xea\x08\x89\x70\x8e\x78\x59\xce\x7c\x5a\x7e\x8a\x70\x78\x92\x49\x64\x0c\x9e\x24\x7f\xed\xf3\xba\x6f\xaa\xd5\x4d\x6e\x61\x3b\x1e\xe5\x2e\x0a\xd0\xc1\xd7\x9b\xa7\x56\xc2\x5a\xbe\x34\x01\xe7\xd1\x7c\x0e\xd7\x57\x3e\xf0\x48\x38\x30\xaf\x4e\x94\x30\x61\xd7\x57\xec\xd2\x7b\xb3\xb7\x30\x38\x50\x53\x26\x9c\x5f\x80\x59\xd7\xc2\x76\x49\xb3\x7f\x4b\xef\xef\x68\x14\x40\x77\xc4\x51\x42\x30\xbf\xf3\xff\xfa\xca\x7b\x72\x7d\xc5\xee\xc8\xad\xdd\x8e\x9e\x3d\xdc\xec\xad\x94\xca\x72\xc2\xd2\xb0\xf7\x02\xeb\xc2\xb0\x5b\xe7\xe9\x1d\x5f\x32\x41\x02\xee\xb7\x5b\x60\xe1\x17\x6b\x83\xe1\xaf\x93\x17\x49\xdb\xf5\x44\x7d\x70\xa6\xf4\x39\xe5\x65\xef\x0d\x9d\x58\xee\x32\x7b\x52\x76\x84\x44\xc3\x08\x46\xcf\xf9\x92\x85\x09\x87\x90\x05\x3e\x05\xbe\x49\xd9\x86\x8d\x0c\x25\x4d\x17\xce\x85\x68\x61\x62\xaa\xb3\xa6\x1f\xc8\x92\xf4\x84\x64\xcb\xc8\x87\x40\xb9\x37\xd7\x59\x12\xa0\xa4\x78\x96\xec\x46\xd4\x35\x5f\xd4\xa4\xf5\x24\x55\x34\x29\x53\xd8\x45\x09\x52\x59\x27\x07\xa5\x11\x56\x6c\x22\xa0\x76\x0f\xa3\x87\xf9\xab\x19\xd6\x43\x3c\xfd\x1f\xc2\xfb\xae\x58\x62\x40\x7d\x3e\x07\xff\x54\x29\x8a\x84\xab\x50\x58\x7b\x60\xe7\xe8\x56\x68\x8f\x2a\x5b\xa1\x06\xa9\x0a\x34\x6d\x21\x5b\x6a\xbe\xae\x98\x17\x71\x57\x21\x6c\x78\xdd\xa0\x01\xae\x11\x16\x28\xe4\x12\xd6\x6a\xdd\x90\x95\x05\x2c\x9e\x07\x9b\xfc\x1f\x0d\xea\x67\x78\xac\x50\x02\xf2\x25\xea\xb3\x5a\xf1\x82\xb8\xa8\x16\x22\xed\x89\x91\xb7\x2b\x65\x8a\x6f\xfa\x00\x90\xa1\xe9\x16\x6b\xfd\x3e\x6b\x1f\xe6\x27\xf0\x36\x56\x07\x28\x7d\xda\x59\x05\xbc\x88\xd6\x19\xab\x34\xd5\xcf\x42\x8b\x0d\x6a\x06\xae\x08\x87\xac\x0c\xb5\x63\xad\x85\xb4\x25\x64\x85\xe0\x35\xe6\x76\xfe\xc6\xcc\x93\xcc\x20\xed\xec\x36\x48\x69\x79\x45\x09\x15\x37\x77\xdd\x1a\x73\xb4\xf8\xb0\x83\x51\x7b\x85\xf1\x8d\x49\x4d\x3e\x9c\xb9\x26\x29\x90\x01\x28\x77\x20\x0c\xd3\xa2\x57\xb9\xbf\x2f\x41\x06\x45\xd3\x8b\xdb\x57\xce\xa4\x2c\xb8\x02\xc7\x62\x66\x12\x17\xf6\xb7\xd6\xde\x0e\x97\x71\x6d\x21\xf5\xb4\x6d\x5d\x26\xc3\x58\xd8\x9c\x03\x09\xc9\x86\x43\xf6\x51\x8a\x2f\x0d\xf1\x7c\xfa\x1c\x37\xce\x89\x67\xa3\x0d\x1a\x25\xc6\x1a\x84\x83\xfa\xc6\x0e\xd4\xb9\xf6\xff\x7c\x0e\x94\xd9\x58\x90\xb0\x14\x44\x21\x4b\xa5\x57\x0e\x47\x07\xa0\x46\x3a\x57\xdd\x0e\x28\x81\x3b\x46\x87\xdc\x23\x37\x41\x02\x4c\x1d\xd9\x97\x06\x8d\xc5\x62\x46\x30\x77\xb7\x8e\xa2\x00\xd0\xd6\x49\x35\x7e\xda\x6e\xa1\x46\xe9\x8c\xfc\xbc\x50\xaa\x6e\x83\x1e\x20\x17\xa7\x1d\xd8\x8f\xa0\xfe\x9b\x7e\xa7\x49\xb9\x6d\xb4\x34\x09\xde\x3d\x64\x43\x44\x34\x70\x09\xa8\xb5\xd2\xe4\x8c\x3b\xe6\x8a\x25\x3a\xe1\xe4\x0e\x21\x1f\x5c\xea\xfb\x10\xea\x66\x12\x96\x53\x12\x17\xa8\x17\x8d\x8d\x02\x5c\xa3\x15\x41\x67\xe3\x51\xd9\xc8\x1c\xa6\x07\x52\x6d\x76\xdc\xa3\xe9\x0c\xa6\x7f\x24\x1b\x4e\xbd\x77\x33\x4a\xdf\x91\x28\x01\x59\x02\x39\x21\x3e\x11\x04\xb7\x5b\x8e\x87\x7b\x22\x9d\x5e\x7b\xbe\x83\x30\x5e\x5c\x80\x14\xb5\xe7\x8e\xf5\x95\x20\xec\x65\x79\x92\x1b\x7d\x20\x4f\x23\xef\x00\x34\xe6\x97\x7c\x30\x49\xd1\x29\xfc\x78\xa3\xec\x7b\x5a\x7b\x47\x6e\x6d\x6b\xbe\xc0\xfa\x1c\x12\xbf\xf7\xcd\x25\xfb\x40\x8b\xde\x83\x5d\xeb\x5e\x3c\x36\x5b\xa9\x87\x1d\x3b\x25\x6d\x63\xcf\xd7\x57\xff\xc1\xf9\xe1\xf5\x93\xab\xe7\x90\x75\x9c\xcd\x76\xe3\x51\xa7\x19\x4b\xfe\xba\xa6\xd8\x15\xd0\x83\x35\xba\x40\xea\xe1\xe7\x4a\x62\xaf\x42\x6f\xb7\x87\xdb\x3f\x27\x4f\x63\x8e\x74\x12\xf8\x7e\xe3\x9f\xed\x53\x58\x4e\x0b\x56\x7a\xa0\xba\xa3\x9d\x32\xb1\x3d\x2e\x20\x73\x47\x5d\x36\x44\x23\x6e\x36\x47\xbf\xdb\xc1\x97\x06\xb5\x40\xbf\xbd\x7a\xce\xfb\xe8\x27\xa5\x6e\x5f\xe9\x5c\x23\x1d\xf3\xbf\x63\xf9\x6e\x07\x27\x29\xf1\x2c\x55\x37\x9d\x41\x9a\xd9\xce\xca\x58\xec\xf6\x01\x9a\xfe\x98\x4a\xb8\xac\x05\x4a\xbb\xf5\xcd\xae\xcf\x90\x44\x1b\xf3\xef\x77\x33\x96\xea\xe9\x11\xcd\x7c\x1c\x63\xec\xe6\x73\xf8\xb8\x2e\x28\x02\x6d\x79\xe1\xb0\x68\x44\x4d\x97\x2c\x2a\x8c\x0d\x2d\x52\x79\x73\xf7\xa4\xd4\x18\x46\xbc\x37\xca\x22\xd8\x8a\x5b\x78\x56\x0d\x48\xc4\x82\x8e\xc6\x9c\xd7\x75\x97\xf6\xa3\x7c\xd4\x7c\x3d\x9d\xc1\x02\x4b\xa5\xd1\x51\x44\xa9\x2b\xb4\x95\x2a\x7c\xb1\xea\x29\x19\x87\xa2\xe5\x8d\xc3\x02\x4a\xad\x56\xc0\xc1\x6a\x2e\x0d\xcf\xa9\x7e\x9f\x02\x97\x85\x8b\x4c\xf2\xd2\x31\x51\xb3\x28\x2c\xb5\x40\x54\xba\x55\x5d\x53\x11\xe3\xf9\x03\x1b\xbf\x2a\x5a\x1e\x97\x36\x50\xcc\x3f\xfe\x26\x31\x09\xd3\x77\x45\x29\x0a\x1c\xc6\x28\x04\xc6\x81\x06\x8d\xfb\x31\xc7\x92\xcf\xc3\xff\x15\x90\x80\x97\x16\x35\x08\x4f\x98\xd7\xca\x60\x71\x4a\x3a\x8c\xf2\xfc\x14\xb1\xb2\xb1\x8d\xc6\xb8\x11\x1e\x45\x5d\xc3\x02\x01\x9f\x30\x6f\x08\x45\x5b\x69\xd5\x2c\x2b\x67\x88\xef\xd3\xe0\xb1\x12\x79\x05\xb9\x46\xee\x09\x3a\x41\x78\x2d\xce\x6d\x6e\x74\xde\x13\xbc\xf6\xe9\x14\xd4\x03\x6d\xe6\xc3\x20\xb2\xd0\x2d\x4e\x4f\xec\xd3\x95\xfb\x3b\x1b\x53\x61\xff\x41\x3d\xb8\x4d\xb4\xe6\x52\xe4\xd3\xac\xbd\xb4\xef\x76\xe7\x83\x3b\x31\xd5\xe5\x0e\x52\xbc\xbd\x1d\x67\x6e\xab\x8c\x5e\xd4\x0c\x17\x60\x9f\x58\xa1\x37\x31\x15\x7a\xe4\xe3\xfe\xa5\xdf\x77\x84\xc6\x6a\x21\x97\x47\xe6\x02\x9e\x64\xd0\x2d\xba\xb2\x68\x6a\x91\xbb\xaa\xb7\xae\x1b\xcd\xeb\xee\x46\xd9\x13\x78\xdf\x38\xac\xb9\x36\xee\xfe\xe3\x5f\xab\xb2\xb7\x83\x63\x77\x18\xd9\x3e\x7d\xee\x44\xc1\x69\x75\x9d\x17\x3e\x59\xaa\x19\x13\xc8\x6e\x89\x36\xdb\xf3\x78\x8c\x5e\xe8\xd2\xc3\x09\xb0\xe2\xf2\x79\xd8\xa4\x1f\xee\xc2\x93\x0a\x75\x38\x87\x52\xa3\x67\xe0\x83\x32\xcd\xcb\x65\xf8\xeb\x7a\x03\xaa\x5f\xbf\x0b\x32\xca\x9f\x17\x03\x19\xa1\x45\x4d\xde\x7d\xfa\x5d\x7c\x0e\x21\x86\x0b\xc8\xcb\x25\xe5\x40\xc7\x9c\xed\x96\x2e\x04\xfb\x70\x59\x05\x4b\x94\xa8\xe9\x3f\x87\x72\x65\xe9\x9c\x71\xc1\x05\xb1\x5a\xd7\x48\xd7\x55\xd7\x67\x86\x0b\x42\x7f\xae\x94\xe4\xc2\xae\x9f\x6e\xc3\xb3\x8f\x0e\x2d\x2f\x7f\x2f\xdd\x57\x86\x54\xf3\x6b\xcf\x23\x4f\x3f\x9d\x81\xb7\xc1\x01\xb2\xe1\x3a\x1e\x00\xfe\xb5\x61\xbf\xfa\xe7\xf1\x68\x14\x56\xd8\xbf\xb4\xb0\x18\xd8\xb3\x54\xe8\x94\xb6\xcd\x41\x32\x67\xa0\xcf\x8e\x69\x26\x8a\x8b\x37\x9b\xec\x74\xb0\xaf\xaf\xaf\x66\xb3\xee\xe0\x85\x3a\xe4\x03\xf3\x8a\xd8\xd7\x75\x2f\xed\xae\x31\x3a\x68\xa4\xd7\x15\x87\x10\x17\xdb\x6d\xdb\x21\xfc\xd9\xb4\x02\xfe\x42\x31\x70\x0e\xf8\xbe\xca\x5f\xf9\x9d\x4c\x77\x13\x35\x65\x9a\xe1\x6f\x0c\x7b\x43\xb7\xce\x68\xff\x60\x10\x11\x39\x07\xd3\x08\xdf\x04\x8a\x12\x36\x6d\x75\x33\x25\xec\x76\x7f\x82\x0d\xfc\xd0\x69\x3f\x5f\xe7\x8c\xb7\x79\xaf\x8c\x4e\xc4\x49\xc9\xae\xcd\x9d\x58\x21\x4c\xc3\x74\xe3\x6f\xdc\xfc\x55\x51\xbb\x31\x8b\x16\x1c\x96\xbf\x61\xef\xdd\xed\x68\x6a\xc5\x0a\xd9\xdb\x9b\xdb\xeb\xcb\x59\xaa\xc1\x01\x93\xaa\x09\x29\xf9\xcd\x8a\x4e\x36\x03\xb1\x2f\x33\x74\x92\xc8\x65\xd0\xc9\xa6\x6b\x5a\xec\x85\x43\x83\x3c\x94\xfc\x4d\x88\x7e\x33\xa0\x87\xa4\xc7\xf8\x1e\xc7\xf5\x8f\xc2\xfa\xa2\xba\xbe\xec\x17\xb9\x86\xd0\xee\xe5\xa4\x82\x64\x27\xb3\xf7\x8f\x9d\xa7\xce\x43\x47\xdb\xaf\xcf\x16\xa7\x3f\xcd\x7e\x9a\xed\x9b\xdc\x76\xbd\x2d\x47\xbd\x16\xb5\x5f\x71\xa9\xac\x87\x92\xeb\x5b\x48\x04\xcb\x97\x6d\x7b\x9e\xce\x37\xce\xdc\xe4\x25\x16\xde\xb3\x5e\xe5\xdd\xcf\xad\xf6\xb3\x26\x3f\x01\xbd\xff\x8f\x51\xf2\x3c\x73\x03\x96\xec\xbe\x3f\xc0\x4b\xa7\x9f\x4e\x5d\x67\x26\x31\x8e\x49\x63\xf9\xf2\x83\x52\x0f\xcd\x1a\x18\x64\x24\x31\x4b\xa0\x4a\x46\xa0\xe3\x41\x88\x12\x02\x5f\xa4\xdc\x43\x06\x59\x87\xfe\xc8\x98\x31\x94\xb5\xfb\x2c\x70\xdd\x67\xc3\x0b\xdb\x10\x51\x37\xc5\x24\x3c\x03\x74\x04\xcc\xb1\x23\x6b\x3f\xf1\x4c\xa6\xc3\xec\x8e\x2f\xfd\xd0\xc3\xcf\x63\x92\xfa\x6d\xdb\x09\x47\xb8\xed\xd3\x6b\xf8\x39\x1c\xe3\xfb\xa1\xac\xa5\x0e\x2d\x3b\xcb\xe2\xcb\xfb\x03\xc3\xe1\xe1\x01\x4c\xed\x6b\xce\x25\xb5\xaa\x6a\x83\x5a\x8b\xa2\x40\x49\x57\x72\xa5\xdd\xa7\x22\x3f\xaa\xe3\x87\x66\x78\x6e\x38\xc2\xf3\xca\x0d\x7b\xd8\x61\x67\x0f\x4c\xef\x5e\xb4\x87\xc0\x34\x68\x63\x6e\x3a\x7e\x08\x83\xeb\xa3\xb9\xd8\x9d\x6e\x27\xd7\xd3\x57\x0e\xe0\x3a\x87\x70\xe6\x95\xa6\x9f\x36\x5e\xfb\x5d\xe3\x3b\x3e\x6a\x7c\x2d\xc3\x08\x41\xb9\x4c\x03\xe1\x2f\x7e\x86\xae\x67\x15\xd6\x6b\xd4\x26\x7e\x92\x6a\x7b\xaa\xc2\x7f\xb1\x3a\x12\x9b\x6e\x50\xd2\x4d\xfa\xf2\x37\xab\xee\xb7\xaa\x01\x28\x87\xbe\x56\xbd\xf0\xb9\xea\x18\x20\xaf\xb0\x67\x7a\x74\xa8\xdd\x35\x31\x76\xcd\xb3\xff\x95\xb1\x31\xa3\xff\x1b\x00\x00\xff\xff\x7e\x1b\x8c\x1e\xbd\x1d\x00\x00")
+var _templateEntTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\xdd\x73\xe3\xb6\x11\x7f\x16\xff\x8a\x0d\x47\x97\x88\x1e\x9b\xba\xe6\xad\x6e\xdd\x99\xcb\x7d\xb4\x9e\xb9\x71\xda\xda\x69\x1f\xae\x37\x39\x88\x5c\x8a\xa8\x29\x80\x07\x80\xb2\x3d\x1c\xfd\xef\x9d\x05\x40\x12\x24\x65\xc5\x49\xa6\x4f\x12\x89\xc5\x7e\xfc\xf6\x03\x8b\x65\xdb\xae\xcf\xa2\xb7\xb2\x7e\x52\x7c\x5b\x1a\xf8\xfe\xf5\x1f\xfe\x78\x51\x2b\xd4\x28\x0c\x7c\x60\x19\x6e\xa4\xbc\x87\x6b\x91\xa5\xf0\xa6\xaa\xc0\x12\x69\xa0\x75\xb5\xc7\x3c\x8d\xee\x4a\xae\x41\xcb\x46\x65\x08\x99\xcc\x11\xb8\x86\x8a\x67\x28\x34\xe6\xd0\x88\x1c\x15\x98\x12\xe1\x4d\xcd\xb2\x12\xe1\xfb\xf4\x75\xb7\x0a\x85\x6c\x44\x1e\x71\x61\xd7\x3f\x5e\xbf\x7d\x7f\x73\xfb\x1e\x0a\x5e\x21\xf8\x77\x4a\x4a\x03\x39\x57\x98\x19\xa9\x9e\x40\x16\x60\x02\x61\x46\x21\xa6\xd1\xd9\xfa\x70\x88\xa2\xb6\x85\x1c\x0b\x2e\x10\xe2\x9d\xcc\xb1\x8a\xc1\xbf\x5d\xd6\xf7\x5b\xb8\xbc\x82\x0d\xd3\x08\xcb\xf4\xad\x14\x05\xdf\xa6\x7f\x67\xd9\x3d\xdb\x22\x11\xb5\x2d\x18\xdc\xd5\x15\x33\x08\x71\x89\x2c\x47\x15\xc3\xb2\xdb\x3e\x2c\xf1\x5d\x2d\x95\xe9\x96\xdc\x13\xac\xa2\x45\xdb\x5e\x80\x62\x62\x8b\xb0\xac\x99\x29\x49\xd6\x32\xbd\xe5\x9b\x8a\x8b\xed\xb5\xa5\xd2\xc4\x6c\xb1\x88\xad\x36\x44\x72\x38\xc4\x6e\x1f\x8a\x9c\xd6\x92\x28\x5a\xaf\x81\x96\xd3\x1b\xb6\x23\xad\x08\x43\x02\xc5\xda\x02\x28\x0c\x37\x4f\x50\x48\x87\xe4\x88\x50\x67\x25\xee\x58\x1a\x11\xbb\x07\x6e\x4a\x58\x9a\x5d\x5d\x69\x52\x63\xc7\x4c\x56\xde\xf5\x06\x58\x5e\xeb\x4c\xee\x76\x28\xcc\x9a\xe5\x39\x37\x5c\x0a\x56\xad\xcf\x2c\x58\xa1\x21\xc4\x82\x38\x78\x5e\xb4\x6a\x97\x1f\x7b\x38\xec\x8a\xc3\x22\xb4\x24\xf8\x6b\x9e\xea\xa9\xaa\x46\x35\x99\x81\x36\x5a\x64\xd6\x0b\x30\xc2\xd7\xa9\x27\x77\xdc\x18\xb6\xd5\x1e\x67\xcb\x9b\x17\xb0\x4c\xff\xc6\xf4\x8f\x02\x3f\x70\xac\xf2\xeb\x77\x24\x6a\xb1\x58\xaf\xe1\xfa\x9d\x0b\x0a\x24\x8c\x52\xaf\xa5\xa5\xbf\x7e\x97\xbe\x75\xa6\x3a\xe2\xd0\xbc\x8a\xe2\xe4\xf2\x0a\x74\x5d\x71\x33\xa6\x8d\xff\x23\x62\xbf\x61\xe1\x7d\x62\xa9\x07\x1e\xde\xbc\xc9\xc3\xf5\x3b\xb2\xc6\xb2\xba\x23\xc3\x0f\x07\x7a\x76\x0e\x49\xdf\x08\x21\x0d\x23\xb4\x75\x6a\x2d\xd0\xe9\xad\xc5\xe2\x8e\x6d\x53\x4e\x1c\xbe\xb4\x2d\xa4\xfe\x17\x2b\x8d\xfe\xaf\xe5\xd7\x93\x76\xeb\x5e\x66\x28\x3f\x30\xae\xb0\x8e\xf3\x72\x06\x4d\x97\x86\xd9\x3c\x58\x16\x83\x6c\x38\x1c\x7a\x25\x09\x74\xbb\x7e\x52\xdb\x60\x07\x17\x39\x3e\xfa\x7d\xcb\xa2\x73\x32\x81\x40\x92\xae\xac\x39\xbd\xb6\x81\xda\x56\x9b\xa9\xdb\x0b\x92\xe3\x43\x33\x86\x65\xd1\x51\x0e\xea\x5a\x7b\x3c\xac\xe4\xe0\x22\xbd\xe1\x55\xc5\x36\x15\x49\x3d\x0b\x05\x2d\x8b\xd0\x05\xbc\x00\x21\x0d\xd1\xdf\xa2\xd0\xdc\xf0\x7d\x0f\xae\x19\x20\x75\x90\xff\x62\x3c\x9e\x40\xdf\xbb\xfa\x7d\xbe\x45\x9f\x30\xeb\x35\xb8\xa7\x52\x92\x27\x28\x48\x15\x56\x0e\xd8\x35\xda\x15\xca\x68\x69\x4a\x54\x20\x64\x8e\xba\x2b\x7b\x5b\xc5\xea\x92\xa2\x79\xbd\x86\xbb\x12\x61\xcf\xaa\x06\x35\x30\x85\xb0\x41\x2e\xb6\x50\xcb\xba\x21\xf8\x72\xd8\x3c\xcd\x4a\xc2\x3f\x1a\x54\x4f\xf0\x50\xa2\x00\x64\x5b\x54\x17\x95\x64\x39\xed\xa2\xca\x89\x86\xf8\x3a\xbd\xc2\x4d\xfd\x9b\x29\x00\xa4\xe8\x34\x21\x29\xea\x2e\xba\x87\xf5\x19\xbc\xe9\x6b\x09\x58\x47\x6a\x30\x12\x58\xde\x6b\xa7\x8d\x54\x54\x6d\x73\xc5\xf7\xa8\x52\xb0\x25\xdb\x47\xa5\xaf\x34\xb5\xe2\xc2\x14\x10\xe7\x9c\x55\x98\x99\xf5\x2b\xbd\x0e\x22\x83\xe0\x4f\x6f\x3d\x97\x6e\x2f\x2f\xa0\x64\xba\xaf\x6f\xae\x22\x3d\x5b\xaa\xd2\x69\xa9\x7a\xa9\xf2\x8d\x0e\x55\x3e\x1e\xb9\x3a\x28\xa7\x1e\xa8\x43\x14\x94\xe4\x21\x2c\x26\x75\xfe\xf7\x05\xc8\xac\xc4\x3a\x76\x43\x9d\x0d\xca\x82\x2d\x78\x69\x1f\x99\xe4\x7b\x9c\xa6\xd6\xa0\x87\x8d\xb8\xae\xb4\x3a\x5a\x2f\x02\x48\xb1\xd4\x27\xe7\x8c\x43\x90\x70\x98\xfe\x24\xf8\xd7\x86\x82\xeb\xd3\xe7\x3e\x71\x28\x53\x97\x68\x13\xb4\xe7\xd8\xd7\x20\x9c\xd5\xba\xbe\x26\x8a\x7c\xe6\xbf\xf5\x1a\x28\xb2\x31\x27\x66\x21\x88\x5c\x14\x52\xed\x6c\xa2\xd9\x33\x53\x21\x9d\xc2\x36\x03\x0a\x60\x11\x99\x6f\x91\x7b\x60\xda\x73\x80\x95\x25\xfb\xda\xa0\x36\x98\x27\xc0\xa7\xa9\x23\xc9\x01\x94\x3a\xa1\xc4\x4f\x6d\x0b\x15\x0a\x5b\xe9\x3e\x6f\xa4\xac\x3a\xa7\x7b\xc8\xf9\xf9\x08\xf6\x67\x50\xff\x51\xbd\x57\x24\xdc\x34\x4a\xe8\x00\xef\x09\xb2\xde\x23\x0a\x98\x00\x54\x4a\x2a\x02\x9a\xa8\xc9\x1f\xd6\x26\x32\x87\x90\xf7\x26\x4d\x6d\xf0\x75\x33\x70\xcb\x39\x48\xd5\x51\x6f\x1a\xd3\x33\xb0\x6d\x59\x0f\x7a\x1a\x2d\x8a\x46\x64\xb0\x3a\x12\x6a\xc9\xf3\x16\xad\x12\x58\xfd\x96\x68\x38\x77\xd6\x25\x14\xbe\x0b\x5e\x00\xa6\x01\xe4\x84\xf8\x92\x13\xdc\x76\xb9\x3f\xed\x03\xee\xf4\xda\xed\x3b\x0a\xe3\xd5\x15\x08\x5e\xb9\xdd\x7d\x7d\x25\x08\xbd\x25\x5e\x8b\x30\x36\xa6\x40\x9e\xf7\x7b\x67\xa0\x51\x5e\x2c\x16\x0b\xe7\x4c\x12\x74\x0e\xdf\xde\x48\xf3\x81\x00\x7d\x4f\x66\xb5\x15\xdb\x60\x75\xe9\x85\x91\x4d\x41\x2b\x9a\x7e\xa4\x45\x2a\x60\x8b\xc5\xbc\xdd\xe8\xb8\x1e\x37\xec\x9c\xa4\x45\x6e\xdf\x54\xfc\x47\x6b\x87\x93\x4f\xa6\x5e\x42\x3c\x32\x36\x3e\x44\x8b\x51\xeb\x16\xfc\xa5\x1e\xd8\x55\xd6\xa3\x35\x3a\x47\xea\xf8\xd7\x52\xe0\xa4\x42\xb7\xed\xac\x02\xf7\x3d\xf5\x52\x61\x86\x74\x12\x50\x49\x5a\xa6\xff\xec\x9e\xfc\x72\x58\xb0\xc2\x03\x95\x76\xda\x48\xec\x8e\x0b\x88\xed\x51\x17\xcf\xd1\xe8\x93\xcd\xd2\x1f\x0e\xf0\xb5\x41\xc5\xd1\xa5\xd7\xc4\x78\x5b\xd0\xc2\x52\xd7\xad\xf8\xb6\xbb\x8f\xff\x91\xe6\x87\x03\x9c\x85\xc4\x49\x28\x6e\x95\x40\x18\xd9\x56\xcb\x8e\x69\x3b\x38\x68\xf5\x6d\xc8\xe1\x6d\xc5\x51\x98\xd6\xb5\xc6\x97\x30\x91\x96\xba\xf7\x87\x24\x0d\xe5\x4c\x88\x12\xe7\xc7\xc0\x77\x17\x7d\x02\xa6\xd7\xfa\x5f\x1c\x1f\xfc\x19\xf4\x53\x9d\xd3\x19\xd6\x55\x1d\x06\x9b\x86\x57\x74\x53\xa3\x7a\xd9\xd0\x22\x55\x3d\x7b\xd9\x0a\x75\x4c\xe9\x9e\x72\x23\x0d\x82\x29\x99\x81\x27\xd9\x80\x40\xcc\xe9\xb8\xcf\x58\x55\x8d\xe0\x4b\x7f\x12\x0f\x8a\xd5\xab\x04\x36\x58\x48\x85\x96\xa2\xe7\xba\x43\x53\xca\x9c\xb4\x9b\x09\x89\x7c\x2d\x73\xca\x61\x0e\x85\x92\x3b\x60\x60\x14\x13\x9a\x65\x54\xd6\xcf\x81\x89\xdc\x3a\x33\x78\x69\x73\x96\x7a\x48\x6e\xa8\x33\xa2\x8a\x2e\xab\x0a\x73\xd8\xb0\xec\x3e\x8d\x5e\xe4\x44\x87\x4b\xe7\xbf\xd4\x3d\xfe\x28\xd0\x13\x50\xdd\xf8\x5d\xce\xeb\x19\x4e\x15\x49\xa2\x71\xda\x91\x8f\x2c\x7e\xd0\xd8\x9f\xe1\x64\x98\x84\xa7\xf3\xc4\x2f\xe0\x05\xac\x30\xa8\x80\x3b\xc2\xac\x92\x1a\xf3\x73\xc2\x59\x4b\xb7\x9f\x9c\x57\x34\xa6\x51\xd8\xa7\xca\x03\xaf\x2a\xd8\x20\xe0\x23\x66\x0d\x01\x6a\x4a\x25\x9b\x6d\x69\x15\x71\x9d\x1c\x3c\x94\x3c\x2b\x21\x53\x68\x7b\xd1\x89\x3f\x5e\x0a\x79\x17\x26\xa3\xf7\x84\xb4\x79\x3c\x07\x79\x4f\x65\xe0\x38\x9e\xa9\xef\x27\x57\x67\xe6\xf1\x9d\xfd\x9b\x44\x54\xfa\xbf\x91\xf7\xb4\x7d\x51\x33\xc1\xb3\x55\xdc\x0d\x01\x0e\x87\xcb\x31\x80\xdc\x55\xee\x11\x52\xac\xf2\xb8\xc6\x36\x99\x16\x27\x25\xc3\x15\x98\xc7\x34\x57\xfb\x3e\x2a\x26\xe4\xd1\x74\x88\xe0\x7a\x5a\x6d\x14\x17\xdb\x67\xe6\x0c\x8e\x64\xd6\x4f\xda\x42\xac\x69\x72\x42\x80\xd4\x55\xa3\x58\x35\xd8\xd2\xcd\x0f\x1c\x81\xb3\x8d\x41\xcd\x94\xb6\x37\x24\xf7\x5a\x16\x23\xfb\x83\xfe\xb1\xdf\xf6\xe9\xf3\xc8\x0b\x56\x35\x7b\xb3\xc1\x47\x43\xa1\xb9\x84\xf8\x96\x68\xe3\x61\x8f\x2b\xcc\x27\xfa\x78\x7f\x46\xec\x98\x78\x9a\xb7\xf1\xc7\xfb\xf4\xa0\x86\x1d\x8f\xa1\x50\xe9\x04\x9c\x53\x56\x59\xb1\xf5\x7f\x13\xf2\x3f\x95\xb2\x9f\x39\x29\xe5\x4e\x94\x19\x0f\xdf\xc4\x06\xef\x3e\xfd\xcc\x3f\x7b\x17\xc3\x15\x64\xc5\x96\x62\x60\x52\x52\xe9\xbe\x33\xb8\xcb\x48\xd8\xa2\x40\x45\xff\x19\x14\x3b\x43\xbd\x90\x75\x2e\xf0\x5d\x5d\x21\x0d\x20\x6c\x27\xea\xaf\x10\xd3\x39\x55\x10\x0b\x1d\x94\x27\x4e\x47\x3a\xd6\x1c\xff\x81\xbb\xab\x0c\xa1\xe4\x97\x9e\x58\x8e\x7e\x95\xd0\x60\x86\x0a\x33\xe5\xcc\x9e\xa9\xfe\x2c\x70\xaf\x75\xfa\x83\x7b\x8e\x16\x0b\xbf\x92\xfe\x5b\x71\x83\x7e\x7b\x1c\x32\x5d\x51\xda\x9c\x1c\xda\x1c\xe5\x61\xb5\x77\xa1\xb3\x8a\x79\x7e\xf5\x6a\x1f\x9f\xcf\x92\xfe\xfa\x5d\xd2\x31\xf7\xde\x18\x0d\x75\xf8\xf9\xd1\xd9\x47\x77\xcd\xa3\x93\x58\xbb\x7b\x46\x26\x77\xb5\xd4\xdc\x20\xf0\x9c\x92\xbd\xe0\xa8\xe8\xd6\xc4\x94\x71\x04\xa6\x44\xae\xa0\xe0\x4a\x1b\x77\x27\xec\xee\x7f\x7e\x94\xa2\xb1\x26\xe7\xc4\xe7\x40\xb3\x22\xd7\xe5\xd2\x81\xb4\xa2\x72\x32\xb1\x3a\x81\x15\x7e\x85\x25\x87\xd7\x89\x1f\x4f\xd0\xee\x2b\x88\xfd\xd6\xde\x94\x1e\xb5\xf1\xa4\x22\x5a\x3c\x83\x59\xdc\xf1\xea\x86\x1e\xde\x05\x57\x6d\xdb\xb5\x47\x7f\xd6\x1d\xa3\xbf\x90\x34\xeb\x1b\x2b\xc6\xcf\x3b\xa2\x45\x6f\x51\x11\x26\xef\x2b\x9d\xbe\xd2\x71\x80\xfe\x6c\x0a\xd3\xef\x9c\x8d\x62\xec\x0a\x55\xe1\x7d\x57\xb8\x35\x4d\x73\xfe\x04\x7b\xf8\x66\xd4\x7b\xff\x3a\xa3\x9c\xee\x83\x50\x82\x7b\x59\xa4\xd7\xfa\x8e\xef\xd0\x03\x5f\x10\xf2\x7f\x95\xe4\xe9\xa4\xd7\xe4\x38\x78\xfb\xf4\x83\xbd\x22\xae\x0c\xdf\x61\xfa\xe6\xe6\xf6\xfa\x6d\x12\x4a\xb0\x00\x85\x62\x7c\xd6\xfd\x6a\x41\x67\xfb\x19\xdb\xd3\x1b\x46\xa9\x60\xf3\xe0\x6c\x3f\x56\xad\x0f\x18\x7f\x4b\x98\x73\xfe\x4d\xc8\xfe\x6a\x60\x8f\x49\xe9\xfd\xfd\x3c\xbe\xbf\x15\xde\x93\xe2\xa6\xbc\x4f\xee\x9a\x43\x3c\xf0\x49\xa2\x63\x40\x8f\x1f\x47\x4f\xa3\x87\x91\xb4\x1f\x9e\x0c\xae\xbe\x4b\xbe\x4b\x86\x8e\xbf\x5b\xf7\x8a\xcc\xfb\xf5\xf1\xe1\x42\x27\x98\x3f\x5d\x08\x1d\xaa\xf3\x86\x6d\xbb\xbb\x4a\x38\xec\xb9\x08\xcb\x14\xa9\x34\x3e\x64\x86\x21\x5e\x37\x44\xe9\xc6\xc1\x5f\xfe\xab\xa5\xb8\x8c\x89\x40\xc7\x5f\xa6\xd3\xcc\x70\x14\x6c\xc5\xf9\x32\xe0\x06\x34\xde\x7a\xea\xe1\xd9\xf6\xa3\x94\xf7\x4d\x0d\x29\xc4\xc4\xb1\x9b\xa1\xf7\xa2\xba\x21\xc8\xd4\x45\x01\x81\x2b\x5a\xf6\x21\x86\x18\xd2\x39\xbe\xd3\xff\xbe\xcc\x7d\x89\xfd\xae\x2f\xf1\xfc\xf6\x3a\x47\xd4\x8e\x74\x09\x4f\x0f\x1d\x01\xf3\xdc\xe9\x3c\x8c\x7f\x87\xc1\xf7\x32\xbd\x63\x5b\xca\x05\xed\x87\x53\xc1\x01\x64\xba\x71\x8f\x1f\x7d\xd0\x6b\x78\xed\x3b\x96\x61\x42\x6d\xe0\x70\xb8\x8c\x2f\xe2\xfe\xe5\x30\xe3\x6a\xdb\x67\x95\xb7\x9d\x7a\xc6\x04\x75\xe5\x72\x8f\x4a\xf1\x3c\x47\x41\xc3\x2a\xa9\xe8\xee\xe6\xe7\x96\xec\xd8\x40\x93\x02\x06\x59\x56\x02\xf5\x7c\xe9\x71\x63\x8f\x8c\x32\x4f\xea\x43\x60\x6a\x34\xfe\xaa\x88\x4e\x1a\xf8\x29\xfe\xb3\xb1\x38\x1e\xf5\x07\x77\xf5\x17\x4e\x23\x47\xfd\x46\xec\xcf\xe7\x7e\x22\xbc\x1c\x7f\xf5\x39\xf1\xd1\xe7\xc8\x17\x9f\xd9\x07\x9f\x69\xc0\xf9\xff\xc1\xdf\xa3\xa0\x90\x07\xc4\x36\x74\x84\xbb\xee\x6a\x1a\xb8\x95\x58\xd5\xd4\x6f\x74\x5f\xf3\xba\xf6\x31\x07\xeb\x85\x67\x7c\x33\x76\x4a\xb4\x78\xe9\xe7\xbe\x61\x1f\x7d\xe6\x9b\x81\x72\xec\x43\xdf\x89\x2f\x7d\xcf\x01\xf2\x02\x7d\x56\x47\x6e\x06\xc7\x54\xec\x2f\x08\xc9\xff\x4b\xd9\xb6\x05\x14\x39\x1c\x0e\xff\x1b\x00\x5d\xb2\x51\x45\xf8\x1e\x00\x00")
 
 func templateEntTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -967,12 +1042,12 @@ func templateEntTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/ent.tmpl", size: 7613, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/ent.tmpl", size: 7928, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateEnttestTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x55\x5f\x8b\xdb\x38\x10\x7f\x96\x3e\xc5\x9c\x59\x0e\x67\xc9\xca\xbd\xbe\x5d\x8f\x7d\x68\xc3\x16\x0a\xd7\xdd\x83\x5d\xb8\x87\x52\x8a\x56\x1e\xdb\x22\x8e\xe4\x4a\xe3\xa6\x8b\xf1\x77\x3f\x24\xff\x89\x93\x34\xe5\xe0\x9e\xee\x2d\xd2\x68\xe6\xf7\x67\x66\x9c\xae\xcb\xae\xf9\xc6\x36\x2f\x4e\x97\x15\xc1\xeb\x57\xbf\xfd\x7e\xd3\x38\xf4\x68\x08\xde\x4b\x85\xcf\xd6\x6e\xe1\x83\x51\x02\xde\xd6\x35\xc4\x47\x1e\x42\xdc\x7d\xc3\x5c\xf0\xa7\x4a\x7b\xf0\xb6\x75\x0a\x41\xd9\x1c\x41\x7b\xa8\xb5\x42\xe3\x31\x87\xd6\xe4\xe8\x80\x2a\x84\xb7\x8d\x54\x15\xc2\x6b\xf1\x6a\x8a\x42\x61\x5b\x93\x73\x6d\x62\xfc\xcf\x0f\x9b\xbb\xfb\xc7\x3b\x28\x74\x8d\x30\xde\x39\x6b\x09\x72\xed\x50\x91\x75\x2f\x60\x0b\xa0\x05\x18\x39\x44\xc1\xaf\xb3\xbe\xe7\xbc\xeb\x20\xc7\x42\x1b\x84\x04\x0d\x11\x7a\x4a\x60\xbc\xbf\x6a\xb6\x25\xbc\xb9\x85\x67\xe9\x11\xae\xc4\xc6\x9a\x42\x97\xe2\x2f\xa9\xb6\xb2\xc4\xe9\xd1\x5e\x53\x05\xf8\x9d\xd0\xe4\x70\x05\xc9\x18\x4d\x16\xd5\x6e\xfa\x9e\xb3\xae\x03\xc2\x5d\x53\x4b\x42\x48\x2a\x94\x39\xba\x04\x44\x28\xd2\x75\x10\x72\x43\x39\xbd\x6b\xac\x23\x48\x39\x4b\x8a\x1d\x25\x9c\xb3\x24\xd0\x38\x47\x4e\x38\xcb\x32\x70\xf8\xb5\xd5\x0e\x73\x78\x7e\x01\xaf\x2a\xdc\x49\xa8\xac\xdd\x7a\xc1\xd9\x17\xb8\x90\x99\xb9\xd6\x90\xde\x61\x28\xde\x75\xa0\x0b\xb8\x12\x8f\x6d\x13\x70\x3f\xea\xd2\x05\x7a\x81\x2e\x0b\xf4\x4b\x2b\xb4\xcd\xd0\x50\x96\x6b\x59\xa3\xa2\xcc\x7f\xad\xb3\x01\x29\x89\xe9\x23\xf1\x15\xe7\xf4\xd2\x60\x20\x9e\x65\xf0\x84\x9e\xb4\x29\x9f\x42\x3b\x43\x2f\xb4\x21\x74\x85\x0c\xbe\x57\x92\xc2\xad\xaf\x64\xa4\x8d\xb4\x47\x34\x31\x89\x86\x24\xf1\x04\xd2\xe4\xf3\xe9\x5d\x3c\xb5\x7e\xd0\x38\x3a\x2a\x38\x3b\x40\xcc\xb5\x3b\xce\xd8\x7b\xa9\xeb\x7b\xbb\x4f\x57\x9c\xb1\x3b\xe7\xac\x4b\x85\x10\xf3\x93\xae\x5f\x71\xd6\xf3\x08\xf7\xd0\x90\xb6\x06\x54\xb4\xa7\x75\xe8\x41\xd5\x3a\x8c\xad\x72\x28\x43\x48\x70\x36\xbe\x29\x5a\xa3\xd2\x6b\x1b\x0f\x7e\xc5\x39\x1b\x7f\x82\x27\xd7\x2a\x8a\xc0\xb6\x21\x0f\x9f\x3e\x4f\x33\xd3\xf7\x62\x48\xe6\x8c\x75\xdd\xcd\x65\x97\xd9\x6e\x38\x3d\x0c\xf9\x83\xb5\xe2\xe3\x7c\x79\x28\x31\x3a\xcd\xa2\xd9\x59\x06\x7f\x6b\xaa\x1e\x46\x22\x85\x75\x7b\xe9\x72\x0f\x13\x33\xb2\xe7\x72\x82\x8c\x65\x56\x1a\x39\x0b\x21\xce\x48\xaf\x26\x77\x3a\xce\x1c\x52\xeb\x46\x0f\x2c\xcc\x2e\x0c\xa2\x45\x2c\x71\x0b\xb2\x69\xd0\xe4\xe9\x70\x5e\x07\x16\x5e\x08\x11\xcd\x8e\x3b\x72\xd1\x80\x51\xc7\x91\xde\x1f\xcb\x91\x2d\x59\x18\xcc\x3a\x56\x73\x9c\x3b\x8b\xfa\x91\x93\xff\x5e\xd7\xb2\x2b\x0b\x79\x8b\xeb\x53\x95\x8b\x16\x0d\xdc\x0c\xee\x8f\x38\x7d\xfa\x3c\x91\x98\xc0\x02\x96\x0d\x9f\x97\x5f\xc7\x8b\xae\xe7\xac\xb0\x0e\xbe\xc4\xe2\x21\xe2\xa4\x29\x31\x22\x4d\x53\x96\xda\x08\x38\xf1\xb7\xc1\xe0\x38\xce\x68\x40\xc9\xba\xf6\x70\xd4\x4e\x34\x71\x81\x82\x7b\x37\xae\x35\x67\x0e\x86\x17\x29\xcd\x1b\xbb\x86\xdc\xe9\x6f\xe8\xee\xe5\x0e\xd7\x90\x4b\x92\x8f\xf1\x83\x19\xce\x61\xdc\xb5\x29\x07\xe1\xc1\xe2\x59\xcf\x02\x71\x33\x4c\xdd\xa4\xec\xc4\x84\x15\x67\x6a\x0d\xe8\x5c\x88\x9d\xf0\x4c\x2f\x23\xaf\x61\x98\xac\xc1\x6d\x5d\xc4\x0a\xbf\xdc\x82\xd1\x75\xb4\x85\xc4\xb0\xeb\xe8\xdc\x2a\x1e\x17\x9f\x81\xf8\xe5\xfd\xc9\x02\x8e\xd5\xde\xdc\x82\x12\x8f\xc3\xcc\x6c\xc2\xca\x60\xaa\xac\x21\xfc\x4e\xe2\x9d\x54\xdb\xd2\x85\xff\x9b\x74\x15\x98\x2c\x86\x20\x10\xfa\xe3\x94\xcd\x29\x9d\x63\x3e\x13\xa1\x69\x9d\xc7\x3e\xaa\xb1\x8f\xf7\xb8\x1f\x2d\x3c\x6b\xe6\x21\xf4\xd3\x8e\xce\xcf\x8e\xda\xfa\xdf\x7a\x76\xd2\xae\x03\xc4\xb2\x2f\xff\x1b\x9b\x0f\x7f\x5b\xff\x04\x00\x00\xff\xff\x6d\xa1\xc5\xd8\xbf\x08\x00\x00")
+var _templateEnttestTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x55\xdf\x6b\xdc\xb8\x13\x7f\x96\xfe\x8a\xf9\x9a\xf0\xc5\x1b\x36\x72\xaf\x6f\xd7\x23\x0f\xed\x92\x42\xe1\x2e\x39\x48\xe0\x1e\x4a\x29\x8a\x3c\xb6\xc5\x7a\x25\x57\x1a\x77\xbb\x08\xff\xef\x87\x64\xef\xc6\xde\x24\xe5\xe0\x9e\xee\x6d\xa5\xd1\xcc\x7c\x7e\xcc\x78\x43\x28\x2e\xf9\xc6\x76\x07\xa7\xeb\x86\xe0\xed\x9b\x5f\x7e\xbd\xea\x1c\x7a\x34\x04\x1f\xa5\xc2\x47\x6b\xb7\xf0\xc9\x28\x01\xef\xdb\x16\xd2\x23\x0f\x31\xee\xbe\x63\x29\xf8\x43\xa3\x3d\x78\xdb\x3b\x85\xa0\x6c\x89\xa0\x3d\xb4\x5a\xa1\xf1\x58\x42\x6f\x4a\x74\x40\x0d\xc2\xfb\x4e\xaa\x06\xe1\xad\x78\x73\x8c\x42\x65\x7b\x53\x72\x6d\x52\xfc\xf7\x4f\x9b\x9b\xdb\xfb\x1b\xa8\x74\x8b\x30\xdd\x39\x6b\x09\x4a\xed\x50\x91\x75\x07\xb0\x15\xd0\xac\x19\x39\x44\xc1\x2f\x8b\x61\xe0\x3c\x04\x28\xb1\xd2\x06\x21\x43\x43\x84\x9e\x32\x98\xee\x2f\xba\x6d\x0d\xef\xae\xe1\x51\x7a\x84\x0b\xb1\xb1\xa6\xd2\xb5\xf8\x53\xaa\xad\xac\xf1\xf8\x68\xaf\xa9\x01\xfc\x41\x68\x4a\xb8\x80\x6c\x8a\x66\xb3\x6a\x57\xc3\xc0\x59\x08\x40\xb8\xeb\x5a\x49\x08\x59\x83\xb2\x44\x97\x81\x88\x45\x42\x80\x98\x1b\xcb\xe9\x5d\x67\x1d\x41\xce\x59\x56\xed\x28\xe3\x9c\x65\x21\xbc\xd4\x39\xe3\xac\x28\xc0\xe1\xb7\x5e\x3b\x2c\xe1\xf1\x00\x5e\x35\xb8\x93\xd0\x58\xbb\xf5\x82\xb3\xaf\xf0\x4a\x66\xe1\x7a\x43\x7a\x87\xb1\x78\x08\xa0\x2b\xb8\x10\xf7\x7d\x17\xfb\xfe\xa1\x6b\x17\xe1\x45\xb8\x2c\xc2\xaf\xad\xd0\xb6\x40\x43\x45\xa9\x65\x8b\x8a\x0a\xff\xad\x2d\xc6\x4e\x19\x67\x4f\xc0\x57\x9c\xd3\xa1\xc3\x08\xbc\x28\xe0\x01\x3d\x69\x53\x3f\x44\x3b\xa3\x3f\xda\x10\xba\x4a\x46\xdd\x1b\x49\xf1\xd6\x37\x32\xc1\x46\xda\x23\x9a\x94\x44\x63\x92\x78\x00\x69\xca\xd3\xe9\x43\x3a\xf5\x71\x1e\x1e\x0f\x30\x29\x2a\x38\x7b\x6a\x71\xaa\x1d\x38\x63\x1f\xa5\x6e\x6f\xed\x3e\x5f\x71\xc6\x6e\x9c\xb3\x2e\x17\x42\x9c\x9e\x84\x61\xc5\xd9\xc0\x53\xbb\xbb\x8e\xb4\x35\xa0\x92\xb0\xbd\x43\x0f\xaa\xd5\x71\x6c\x95\x43\x19\x43\x82\xb3\xe9\x4d\xd5\x1b\x95\x5f\xda\x74\xf0\x2b\xce\xd9\xf4\x13\x3c\xb9\x5e\x51\x6a\x6c\x3b\xf2\xf0\xf9\xcb\x71\x66\x86\x41\x8c\xc9\x9c\xb1\x10\xae\x5e\x57\x99\xed\xc6\xd3\xdd\x98\x3f\x4a\x2b\xa6\x27\x8b\x12\x93\xd2\x2c\x89\x5d\x14\xf0\x97\xa6\xe6\x6e\x02\x52\x59\xb7\x97\xae\xf4\x70\x44\x46\xf6\x39\x9d\x48\x63\x9e\x95\x27\xcc\x42\x88\x67\xa0\x57\x30\x31\x0f\x9c\x39\xa4\xde\x4d\x1a\x58\x38\xa9\x30\x92\x16\xa9\xc4\x35\xc8\xae\x43\x53\xe6\xe3\x79\x1d\x51\x78\x21\x44\x12\x3b\xed\xc8\xab\x02\x4c\x3c\x16\x7c\x5f\xa6\x23\x7b\xb2\x30\x8a\xb5\x64\xb3\xcc\x3d\x91\x7a\x49\xc9\x7f\xce\x6b\xee\xca\x8c\xde\xec\xfa\x9c\xe5\xcc\xa2\x11\x9b\xc1\xfd\x02\xd3\xe7\x2f\x47\x10\xc7\x66\x11\x86\x8d\x9f\x97\xff\x4f\x17\x61\xe0\xac\xb2\x0e\xbe\xa6\xe2\x31\xe2\xa4\xa9\x31\x1e\xfc\x71\xca\x72\x9b\x64\x3d\xe2\xb7\x7c\xe0\x3c\x8d\x33\x1a\x50\xb2\x6d\x3d\x2c\xec\x44\x93\x16\x28\xaa\x77\xe5\x7a\xf3\x4c\xc1\xbb\x0e\x4d\x4e\xa7\x8d\x5d\x43\xe9\xf4\x77\x74\xb7\x72\x87\x6b\x28\x25\xc9\xfb\xf4\x75\x8e\xe7\x38\xee\xda\xd4\x09\x5b\x92\xf8\xc4\x67\xd6\x71\x33\x4e\xdd\x91\xd9\x99\x08\x2b\xce\xd4\x1a\xd0\xb9\x18\x3b\xc3\x99\xbf\xde\x79\x0d\xe3\x64\x8d\x6a\xeb\x2a\x55\xf8\xdf\x35\x18\xdd\x26\x59\x48\x8c\xbb\x8e\xce\xc5\xc5\x27\x31\xfb\x0c\x0c\xfc\xe7\x0b\x38\x55\x7b\x77\x0d\x4a\xdc\x8f\x33\xb3\x89\x5f\x00\xcc\x95\x35\x84\x3f\x48\x7c\x90\x6a\x5b\xbb\xf8\x7f\x93\xaf\xd6\xb0\x18\x82\x08\xe8\xb7\x73\x34\xe7\x70\x96\x78\xd8\xc0\x17\xeb\x3c\xf9\xa8\x26\x1f\x6f\x71\x3f\x49\xf8\xcc\xcc\xa7\xd0\x4f\x1d\x3d\x3d\x5b\xd8\xfa\xef\x3c\x3b\xb3\xeb\xa9\xc5\xdc\x97\xff\x8c\xcc\x21\x00\x9a\x12\x86\x81\xff\x3d\x00\x6d\xa1\xc5\xd8\xbf\x08\x00\x00")
 
 func templateEnttestTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -992,7 +1067,187 @@ func templateEnttestTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateHeaderTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8e\xc1\x6a\xe3\x30\x14\x45\xd7\xe3\xaf\xb8\x04\xaf\xc2\x8c\x9c\xc9\xae\x85\x2c\x82\x93\xd0\x40\x49\x0a\xc9\x0f\x28\xd2\xb5\x2d\x62\x24\x23\x29\x2d\xc1\xe8\xdf\x8b\x5d\x07\xda\xae\x04\xe7\xbc\xa7\xf3\xfa\xbe\x98\x67\xa5\xeb\xee\xde\xd4\x4d\xc4\x72\xf1\xff\xe9\x5f\xe7\x19\x68\x23\x76\x52\xf1\xe2\xdc\x15\x7b\xab\x04\xd6\x6d\x8b\x71\x28\x60\xf0\xfe\x9d\x5a\x64\xe7\xc6\x04\x04\x77\xf3\x8a\x50\x4e\x13\x26\xa0\x35\x8a\x36\x50\xe3\x66\x35\x3d\x62\x43\xac\x3b\xa9\x1a\x62\x29\x16\x0f\x8b\xca\xdd\xac\xce\x8c\x1d\xfd\xeb\xbe\xdc\x1e\x4e\x5b\x54\xa6\x25\x26\xe6\x9d\x8b\xd0\xc6\x53\x45\xe7\xef\x70\x15\xe2\xb7\x58\xf4\xa4\xc8\xe6\x45\x4a\x59\xd6\xf7\xd0\xac\x8c\x25\x66\x0d\xa5\xa6\x9f\x21\xa5\x81\x7e\x98\xd8\x20\x17\x2f\x23\x44\x4a\x7d\x0f\xf1\xf5\xb0\x0d\x44\x4a\x45\x81\x72\xb8\xba\xa6\xa5\x97\x91\x1a\x97\x3b\x68\xa3\xfa\x8b\xcd\x11\x87\xe3\x19\xdb\xcd\xfe\x2c\x86\x05\xab\x31\xb5\xf2\xee\x5a\xe3\x79\x85\x8b\x0c\x44\x2e\x4a\x67\x2b\x53\x8b\x37\xa9\xae\xb2\xe6\x54\x36\x15\x1a\x19\x76\x86\xad\x46\x8e\xd9\x49\xb9\x8e\xe3\x55\x7f\x1e\x1f\xac\x90\x8b\x11\xff\xda\x9c\x42\xdd\x04\x1f\xe3\x3f\xe4\x67\x00\x00\x00\xff\xff\x57\x0c\x81\xe0\xb5\x01\x00\x00")
+var _templateEventsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x7a\x5b\x6f\xe3\x38\x96\xff\xb3\xf5\x29\xce\x04\xe9\x82\x14\xb8\xe4\xfa\xcf\xdb\xdf\x05\x2f\x90\xa9\x54\x63\x02\xf4\x54\x66\xb7\xd2\xbb\x0f\x41\x30\xc3\x48\x47\x31\x37\x32\xa9\xa6\xe8\xd8\x86\xd7\xdf\x7d\x71\x0e\x2f\xa2\x6c\xa7\xaa\xba\xb1\xd8\x87\x7d\xb3\x29\xf1\xf0\x5c\x7f\xe7\x42\xed\xf7\xb3\xab\xec\x93\xee\x76\x46\x3e\x2f\x2d\xfc\xf9\xc3\xff\xfb\xff\xef\x3b\x83\x3d\x2a\x0b\x3f\x8b\x0a\x9f\xb4\x7e\x81\x5b\x55\x95\x70\xdd\xb6\xc0\x2f\xf5\x40\xcf\xcd\x2b\xd6\x65\x76\xbf\x94\x3d\xf4\x7a\x6d\x2a\x84\x4a\xd7\x08\xb2\x87\x56\x56\xa8\x7a\xac\x61\xad\x6a\x34\x60\x97\x08\xd7\x9d\xa8\x96\x08\x7f\x2e\x3f\x84\xa7\xd0\xe8\xb5\xaa\x33\xa9\xf8\xf9\x2f\xb7\x9f\x3e\x7f\xf9\xfa\x19\x1a\xd9\x22\xf8\x35\xa3\xb5\x85\x5a\x1a\xac\xac\x36\x3b\xd0\x0d\xd8\xe4\x30\x6b\x10\xcb\xec\x6a\x76\x38\x64\xd9\x7e\x0f\x35\x36\x52\x21\x5c\xe0\x2b\x2a\xdb\x5f\x80\x5f\xbe\xec\x5e\x9e\x61\xbe\x80\x27\xd1\x23\x5c\x96\x9f\xb4\x6a\xe4\x73\xf9\x77\x51\xbd\x88\x67\xa4\x97\xf6\x7b\xb0\xb8\xea\x5a\x61\x11\x2e\x96\x28\x6a\x34\x17\x70\x49\x4f\x32\xb9\xea\xb4\xb1\x90\x67\x93\x8b\x4a\x2b\x8b\x5b\x7b\x91\x4d\x2e\x50\x55\xba\x96\xea\x79\xf6\x9f\xbd\x56\xb4\xd0\xac\x78\xdd\xca\x15\x5e\x64\xd9\x64\xbf\x7f\x0f\x97\xbd\x6e\xec\x0d\xb6\x68\x91\x0e\x6f\x44\xdb\xd3\x61\xfb\x3d\x18\xa1\x9e\x11\x2e\x15\x2d\x5f\x96\x9f\x89\xd9\x2f\xba\xc6\xde\x3d\x95\x0d\x5c\xaa\xf2\xeb\xb0\x99\x57\x53\x6a\x0b\xb0\x66\xed\xd7\x51\xd5\xe9\x0f\x77\xb4\x6c\x46\xef\xd3\x32\xf1\x6c\x9f\x75\x29\xf5\x0c\x95\x9d\xf5\xd5\x12\x57\x62\xb6\x92\x5b\x49\xfc\xd3\xa6\x94\xc0\x37\x38\x24\x52\xfb\xfd\x39\x35\xce\x68\x59\x25\x0b\x67\x08\x6f\xa4\x5d\xc2\x65\x79\xb7\xb6\x4f\x7a\xfb\x5d\x6a\x6f\xd2\x2a\xb2\x6c\x36\x03\xe6\x8b\x7c\x8d\x9c\x47\x2a\x8b\xa6\x11\xe4\x14\x4b\xc1\xab\x72\xd5\xb5\xb8\x42\x65\xb1\x86\xa7\x1d\x7b\x53\xb5\x64\xc1\x9c\x7f\x90\x33\x89\xb6\x05\xbb\xeb\xb0\x2f\xe1\x7e\x19\x1e\x10\x6d\x61\x10\xba\xf5\x53\x2b\xfb\xe5\xb0\x7d\xb5\xb6\xc2\x4a\xad\x78\x2b\x2d\xa0\xb2\xd2\x4a\xec\xa7\x20\x54\x4d\x27\xec\x78\x63\x2d\xfb\x4e\xd8\x8a\x76\x5a\x4d\xcb\xd0\xaf\x9f\xfa\xca\xc8\x27\x34\x8e\x7a\x63\x7d\x4c\x58\x23\x54\x2f\xaa\x11\xd5\xe1\x18\x22\x56\xe9\xd5\x4a\x5a\x4b\x71\x46\x9c\x06\xa9\xa3\xb8\xfb\x6c\x32\x9b\xc1\x3d\x3d\x32\x68\xd7\x46\x39\x7d\x28\xb1\xc2\x40\xd0\x89\x5d\x3b\x76\x77\x2c\x70\x99\x4d\x68\x4b\x5e\x40\x6f\x8d\x54\xcf\x4c\xe4\xae\x43\xc3\x02\x8e\x28\xe9\xb8\xca\x9a\x0d\xc4\xa2\xfc\xbb\x32\x9b\xc4\x9d\x79\x01\x77\x5d\x76\x60\xfb\x7c\x8d\x42\xbf\x61\xa4\x8d\x11\x9d\x7b\xf0\x45\x5b\xd9\xec\x60\x85\x76\xa9\xeb\x32\xd9\xc9\x3a\x20\x62\x06\x9f\x65\x6f\xd1\x60\x0d\xcc\x09\x42\xd5\x4a\xb2\xff\xba\x97\xea\x99\x17\xd2\x5d\xba\x23\x8e\xbd\xca\x86\x07\x27\x7a\xf3\x07\xcb\x1e\x2a\xd1\xb6\x58\x3b\x0f\x3d\xf5\x15\xc7\x2f\x8e\xed\x31\x71\xbb\x73\x8f\x0c\x14\x12\x84\x10\x53\x67\xa3\x02\xd0\x18\x6d\xbc\x32\xee\x47\x0c\xfe\xbc\x56\x15\xdb\x81\x34\x23\x14\x88\x5a\x74\xec\x12\x1a\x44\xdb\xea\x0d\xcb\xb3\xee\xd9\x82\xda\xd4\x52\x09\xb3\x83\x66\xad\x9c\xa3\x90\x3e\x44\x9f\x3a\x55\x09\xb7\x0d\x34\x4c\x2c\xbe\x36\x88\x22\xba\xce\xe8\xce\x48\xc2\xb7\x5e\x3e\x2b\x61\xd7\x06\xa7\x63\x13\x11\x47\x79\x53\x38\x12\xc3\xb2\x8b\x26\xd2\x4d\x0f\xcd\x89\x3a\x69\x13\x9f\xf7\x1d\x15\x64\x83\xa6\x3d\xa9\xbc\xb2\xdb\x29\x60\x51\x66\xb4\x1d\xf2\xe6\x88\x6a\xe1\xdf\xa7\xf7\xe0\x84\x38\x8e\xc8\x93\x29\x9d\xc3\x0e\x74\x4f\x5c\xb0\x8f\x1e\x44\xc6\x44\x78\x96\xaf\xa8\x52\x15\x42\xa3\xcd\x79\x90\x18\x9c\xcd\x73\x9b\x50\xcd\x89\x02\x94\x65\x39\xac\x51\x04\x90\x99\x52\xb6\x58\x45\x70\x55\x31\x66\x16\xf4\x64\x52\x95\xe9\xe1\x0b\x10\x5d\x87\xaa\xce\x47\xcb\x53\x66\xb0\x2c\xcb\x22\x9b\x1c\x48\xa4\x6f\x67\x0f\xca\x81\x97\x8c\x61\x04\xdc\x9d\x91\xca\x12\x22\x7f\x21\x28\xb8\x60\x8d\x71\x4e\x9c\xcd\x60\x78\xf1\x70\x80\x1a\x9d\x0e\xc8\xf2\x5e\x78\x82\x46\x7e\xc9\xef\x3e\x1c\x62\xac\xb3\x0b\x8c\xf6\xf7\xd6\xac\x2b\xeb\x03\xea\xae\x03\xf9\x7b\x70\x03\xee\x3a\xf8\x27\xa5\xd1\xf9\x85\xee\x2e\xfe\xc9\x34\x6e\x6f\xce\x23\x57\x99\x4d\x6e\x6f\x3c\x5b\xb7\x37\xe5\x3d\x71\x72\x38\x84\xed\xb2\xf6\xdb\x7f\x96\xd8\xd6\x3d\x2c\x75\x5b\x0f\x58\x18\x2d\xd9\xb8\xa7\x43\x40\xf7\x68\xa7\x20\xea\x9a\x90\xc5\x40\xd5\xa2\x30\x58\x33\xa1\x23\xd8\x2f\xe1\x96\x13\x0b\xae\x3a\xbb\x63\x77\xa9\x29\x21\x53\x44\x96\xd9\xc4\x9f\xfa\xf0\xe8\x00\x35\x70\xe5\x8e\x9b\xea\x95\xa4\x12\xc3\xee\x3c\x8f\x7f\xc1\x46\x1b\x4c\x78\xf4\xd8\xfc\xe4\xd6\x07\xd9\xc3\xa1\x4a\xb6\x7c\x64\x65\x50\x84\x23\x3d\x91\xab\xb1\xa1\xfc\xc1\x8e\xd2\xc9\xc1\xd7\x9c\x7a\x4e\xce\x1d\x32\xd2\xf9\x63\x53\x49\x1d\x89\xf3\xa7\x32\x9d\xd1\xa1\x1e\xfe\x8e\xf3\x13\xbb\xd1\x1b\x56\xe6\x20\xcb\xaf\x52\x27\x2b\x60\x94\xae\x92\xd8\xa2\xf5\x11\x2b\x3e\xf4\xff\x70\x32\x73\xa7\x23\x1c\x9d\x3f\x4e\x71\x09\x03\x58\xc6\x8c\x47\x20\x9f\xee\xfa\x9f\x86\x79\x7a\x6f\xac\x76\x3e\xa9\x2f\x1d\x1e\xb2\x8f\x6b\xbb\x24\x4b\x52\x51\x43\xb9\x13\xe4\xb3\xd2\x26\x56\x0f\x27\xdc\x9d\x47\xef\x23\xd9\xbf\x87\xe2\x53\x90\x0d\x38\x21\x47\xf2\x07\x65\x36\x27\x5a\xf9\xbd\xf8\x4e\xf4\xa7\xa0\x5f\x08\xd8\xb0\x3c\xf2\x8d\x8f\xf4\x80\x30\xf5\x24\x0b\x4c\x0e\xd1\x4c\x4a\xb6\x1e\x41\x2f\x97\xd4\xce\x44\x84\xcc\x2b\xb1\xc2\x36\x28\xb5\xf0\x48\xd9\xff\x55\xeb\x97\x14\x2e\x79\xd3\xe1\x10\xab\xc2\x63\x59\x39\x3a\x51\x54\xcb\x63\x0b\xf9\xa2\xcb\x57\xa5\xa2\x69\xb0\xf2\x25\xa9\x18\xa0\x25\x0b\x75\x7b\x52\x1b\xcf\x66\xf0\x37\xff\xdc\xe3\x15\xd9\x53\x69\x0b\xb8\xc5\x6a\x4d\x44\xa4\x02\x91\xd6\x90\x6c\xf1\xf1\x53\x85\x1b\xd0\x0a\xa7\x60\xf5\x33\xb2\x6f\x84\xba\x80\x24\xdb\x18\x69\xa9\x7e\x72\x91\x28\x4d\x48\x7b\xbe\x6e\xd5\x8e\x19\x2b\x9e\x5a\x2c\xb3\xa4\x02\x67\xbb\x26\x6a\xc9\x15\x6e\xad\x63\x57\x9b\x22\xfc\x48\xa2\x84\x57\x90\x4c\x9f\xd3\xde\xf3\x76\x5f\x45\x81\x0b\xc8\xff\x5d\xb4\x6b\x9c\x3a\x17\x70\x29\x33\x68\x2b\x38\xc2\xca\x3b\x82\x2a\xc3\x36\xaf\xf4\x22\x9b\x4c\x64\x03\x7f\xf2\x6e\x91\xb8\xc0\x14\x9a\x95\x2d\x3f\x13\xcd\x26\xbf\x58\x2b\xdc\x76\xce\x1c\x81\x36\x47\x0e\xfc\x74\x7f\x31\x85\x15\x91\xa1\x16\xe5\x8c\x6d\x26\x7c\xc0\x3f\x22\x27\x7e\x77\x59\x1b\xf9\x8a\xa6\xcc\xaf\xec\xf6\x86\x7f\x16\x1f\x07\x36\x26\xec\x95\xc6\x8c\x76\x7c\xe2\x32\x36\x2f\xca\xfb\x2d\x29\x85\xce\x64\xda\x68\x0c\xfc\x69\xc1\x00\x4c\xb2\x1f\x49\x81\xc6\xf0\x22\xb1\x37\x99\xbc\x0a\x03\xaf\xc0\x0a\xe3\xff\xb4\x77\x01\x66\xad\xee\xb7\x39\x9d\xc9\x1a\xb7\x5b\xb8\xba\xdf\x26\x31\x45\x6f\x56\xcd\xf3\x88\x1b\x57\xa1\x30\x91\x49\x8d\x0d\x1a\x86\xa3\xbc\x80\xfd\xf1\x3b\xb0\x00\xda\x7c\xc8\x1d\xc7\x93\xd3\xc7\x76\xeb\x7f\xbb\x17\x5e\x9d\xec\x8b\x34\x9a\xd8\x6d\x0a\x67\x3e\x24\xf1\xa7\xf1\x98\x62\x24\x74\x94\xf7\x0f\xe9\xc7\x3f\x79\x9d\x92\x3a\x33\xbf\x9c\x78\xb3\xd3\x60\x4e\x4f\x64\xdd\x03\x00\x3c\x3c\x1e\x97\x19\xf4\x90\xb3\x26\xc0\x02\x56\xe2\x05\xf3\x95\xe8\x1e\x8e\xdf\x7a\x1c\xa7\x45\xe6\x56\xe1\xe6\x0f\xec\xf2\xed\xcd\x19\x4f\xc9\x26\x93\x22\xf1\xca\xa3\x61\x01\x9d\xf8\x1b\x45\xd7\x7c\x01\x95\xdd\xd2\xdf\xd9\x0c\x7e\x55\xad\x7c\xc1\x21\x8d\x4f\x61\xdd\xd5\xc2\xfa\x1c\x21\xba\xae\x95\x58\x83\x68\x7b\x4d\xed\x15\x0d\x0f\xde\xf3\xbb\x21\x2b\x4b\xa4\xcc\xcf\x8a\x8f\xfc\xdc\x75\x79\x51\xde\xf6\xf9\x5d\xf7\x2b\xd3\x82\xff\x82\xf0\xf3\x4e\x61\xe1\xcd\xc2\xbc\x2c\x80\x47\x0d\xe5\xad\xaa\xda\x75\x8d\x8e\xd9\x3a\xfa\xfb\x89\x39\x7c\x2e\x8d\x60\x19\x78\x60\x6e\x7f\x5b\xa3\x21\x6e\x93\x5a\x29\xf0\x44\x29\x28\xc0\x5f\xe9\x41\xe0\x0c\xbf\x9f\xa8\x88\x0a\x1c\x12\xb9\x1d\x05\x81\xaf\xf1\x47\xa6\x28\xff\x95\x9e\xe6\x45\xf9\x1f\x4b\x34\x98\x47\x5a\x9d\xc1\x5a\x56\xa4\x40\x57\x9d\xf3\x51\xb2\x9e\x02\x6e\x65\x6f\xfb\x51\x4c\xdd\xde\xe4\xc5\xc7\xf0\xc0\x2b\x85\xa8\x7a\x9a\xc7\x63\x93\xf2\xf6\x26\x97\x75\x11\x15\x33\x51\x34\x7a\x89\xc0\xc1\xec\x96\xd7\x6d\x9b\x9f\x1f\x16\x91\xbe\x69\x30\xe4\xc6\x4d\xfe\x0f\x83\x76\x91\xbd\x11\x39\x6f\xc1\x63\x98\x9d\x1d\x0e\x73\x77\x2c\x65\x8a\x91\x7a\x5c\xba\x80\x57\x42\x9e\x7e\x0e\x3f\x6d\x2e\x98\xcf\x81\x77\x4a\x8a\xff\x98\x02\x89\x40\x3a\x71\x83\x25\xfa\x17\x34\x21\xeb\xa4\xf9\x91\x75\xef\xde\x2d\x6f\x6f\x98\x06\x47\xdc\x83\x5f\x79\x84\x05\x3f\x0c\xc4\x0f\x59\x04\x95\xf9\x02\x08\x49\xc6\x40\x52\x64\x67\xc5\x4d\xa5\x75\xb0\x42\x84\xfa\x8d\xb4\x94\xbb\xa9\x33\xa3\x39\xe1\xdb\x5e\x33\x0f\x36\x09\xe0\xff\x1a\xd3\x90\xd7\x4a\x50\xf4\x00\xfc\x3f\x90\x80\x88\x62\x48\x3e\xbe\x64\xc5\x1a\x1a\xa3\x57\x70\x36\xc7\x5d\x4c\xe1\x75\xd0\xb3\xd3\x1c\x6e\x46\xca\x3a\x05\xb1\xbd\x7f\x7a\x98\x06\x4d\xbe\x25\xec\xf9\x90\x7e\xf7\x0e\x5a\x54\xb9\xac\xfb\x02\xfe\x05\x3e\xcc\x4f\xdd\xf3\x07\x82\xe8\x8c\xc3\xdf\x32\x4d\x0a\xa5\xe2\xff\x8e\x6b\x1f\x9b\xe3\xc4\x77\x85\x81\x66\xdc\x35\xbe\x0d\x59\x5e\x09\x04\xb1\xee\xe7\x00\xb1\x9e\xc6\xc2\x71\xec\xfa\xd0\x88\x54\xa1\x86\xf1\x75\xdd\xdc\x67\xa1\x87\x47\xae\x72\xa7\xf0\x61\x1a\x2d\x5a\x64\x51\x28\x59\x0f\x22\x51\x84\xb2\x40\x9e\x44\x8c\x56\xf7\x7f\x0a\xef\xd2\x32\x78\x7f\xd7\xcd\xc7\xfe\x34\x85\xdb\x9b\x39\x63\xa3\x63\x6d\xee\x85\x9e\x82\xeb\x5f\xe7\xc0\x41\x2e\xeb\xc7\x29\x70\x6b\x39\x77\x8e\x2c\xeb\xc7\x43\xe0\xde\x9b\x96\x6a\x76\x57\x7e\x33\xf7\xfd\xb8\x62\xf0\xd5\xc6\xd4\xd7\xb0\xc5\xc7\x13\x77\x38\x1f\xfa\x47\xd5\xc1\xa1\xc8\xf8\x4a\xc0\x27\x23\xaa\x92\x13\xc5\x8e\xba\xc9\x3f\x30\x58\x08\x17\x1b\x91\x69\xd7\x22\x8d\x4c\x97\x54\xc1\x71\x9c\xb0\xcf\x62\x8d\x72\xea\x33\x3d\xa2\x1a\x57\x18\x6e\xd7\xe3\x93\xd6\x6d\x91\x51\xb9\x10\xbc\x95\x39\x8e\xb6\x7d\x78\x0c\x54\xf6\xab\xd2\x1f\x5f\x4c\x61\x55\x5e\x13\xdb\xe9\xc2\x27\x37\x17\x09\x4b\x07\xd8\x0f\xde\x42\x6a\x48\x42\x80\x8f\xd8\x07\x0c\x24\xde\x1e\xe8\x8d\x47\x1f\x16\xc9\x82\xbb\xc9\xe0\x55\x2f\x54\x74\xae\xe0\x23\xb4\x33\xc6\xdc\x21\x6d\xe8\xdc\x1b\xbe\xf3\x0e\x23\x77\xdf\x07\x2b\x1a\x1e\x72\xbd\xd0\xb6\xc7\x0d\xf4\x30\xfd\x0b\x0e\x4c\xc3\xfb\xd4\xac\x8d\x34\xbd\xf5\xa3\xcb\xd9\xcc\x5b\x54\xf4\xfe\x9d\x78\x1f\xb0\x2a\xa9\x89\xf5\xb3\xec\xa3\x29\x7f\x68\xd5\x88\x8b\x3a\xdc\x34\xc4\xf3\xa8\xcc\xda\x2c\x51\x91\x6f\xf5\x3a\x0e\xea\x57\xd0\x08\xd9\xfa\xa6\x79\x2c\xd1\xf9\x7e\x89\x0e\x84\x87\xc7\x61\xf6\x18\x7c\x1f\x1e\x1e\x8f\x3b\x68\xf2\x1e\x43\x20\xed\xe4\x0a\xb6\x4b\x0c\xe7\xb7\x26\x86\x4d\x3c\x85\x8f\x0a\x46\xf5\xb1\xd8\x97\x43\x13\x4f\xed\xf6\x28\xe0\xde\xbd\x73\xc7\x2d\xc6\x70\x4c\x2b\x6f\x22\x71\x90\x99\xea\x8c\x9f\x7a\x27\x4b\x80\x5e\x4e\x61\x79\x31\x06\xe1\x91\x43\x10\x71\x72\x87\xe1\xa2\x49\x13\xff\x69\xdb\x46\x0a\x1f\x21\x08\x37\xc0\xd8\x27\x63\xa8\xa0\x41\xb2\x59\x8c\xd2\x73\xfd\xf0\x74\xb8\x7a\x20\xb2\xae\xf3\x0b\x0e\x16\xc3\x1b\xfe\x8a\xaa\xa2\xee\x3b\x5e\x2f\xb1\x6b\xd0\xb1\x16\x55\x40\x83\xb4\x85\x3f\xa2\xc0\x73\xfa\xe3\xeb\xa5\x4a\x18\x9a\x57\x52\xcd\xc9\x53\x05\xa3\xf9\xf2\xe2\x49\x54\x2f\xde\x7d\x4e\x70\xf2\xd4\x7b\x2a\x18\x03\xe6\x19\xa7\x91\x0d\x67\x07\x0f\xa8\xb0\x58\xc0\x87\x74\xd0\xe2\xf0\x72\x40\x26\xa5\x37\xd4\x3c\x11\x12\xd1\x55\x68\xf9\x45\x6f\xb8\x3d\xf4\xbd\x0c\xad\x7f\xc1\x0d\xa5\x0b\x1d\xd2\xac\x6f\x6a\x2a\x7a\xed\x69\x2d\xdb\x9a\x82\x27\x26\x29\xae\xab\x74\xe9\xea\x75\xbf\x63\x9a\xb2\x34\x80\x9b\x7c\xcb\x97\x3b\xb1\x6b\xb5\xa8\x63\x85\x42\x83\xca\xf2\x6f\xc2\xf4\x4b\xd1\xe6\xf8\xed\x22\xf1\x2d\x47\x0d\x17\xc0\xdf\xf5\xd2\x43\x22\xd6\x83\x7c\x84\x58\x20\x39\x91\xf2\x82\xba\x94\xc9\x57\xb4\x9f\xa9\xc3\xd9\xf1\xee\x40\x24\x3e\xfb\xbb\x93\x20\xf7\x92\xc4\x75\x47\xa3\xbe\xb6\xb9\xd2\x1b\xbe\x22\xf0\x43\x09\x2f\xe9\xe8\xa8\xbf\xac\xdb\x97\x3c\xb0\x42\x95\x56\xf9\x55\xbc\x72\xa9\x7c\x9a\x28\xbf\x23\x7d\x18\x1a\x9d\x9b\x15\x05\x45\x18\x73\x6e\xfa\x36\x9b\xc1\xbf\x61\x2b\x76\x3e\xf8\x42\xbc\x63\x0f\xeb\x8e\xe8\xb4\x72\x25\x2d\x50\x02\x48\xe8\x73\x0d\x7c\x1c\x7d\xe1\xd4\x04\x73\x39\x52\x7c\x40\x69\xe3\xbf\x3a\xd8\xb9\x5c\xec\xe3\xcd\x61\xbd\xeb\x6c\x7b\x37\xad\xe3\x43\x86\x33\x78\xd4\xe5\x2f\x68\xa5\x1d\x4d\x5f\x89\xfc\x06\x13\x5c\xe7\x21\x79\x6f\x75\xd7\x87\x3b\xc9\x24\x73\xc4\x3b\xe1\xa8\xa6\x58\x18\xd0\x08\x2f\x74\xd7\xe3\xfb\x62\x3a\x42\x3c\x0b\xa9\x02\x41\xea\x6a\x78\xda\x1a\x81\xc4\x53\xe3\x7d\xd8\x12\xe6\x50\xe3\x6e\xa1\x45\xd1\x5b\xd0\x0c\x36\x74\x72\xc2\x38\xf4\x4b\xbd\x6e\x6b\x58\x0a\x55\xb7\x48\x67\x70\xb2\x91\x35\xae\x3a\x6d\x51\xd9\x76\x57\x8e\xec\x32\xaa\x71\xd6\x2b\xba\xc1\xd5\xcd\xa9\x1c\x86\xb6\x50\xa3\xcd\x88\x93\x57\x70\xe5\xa2\xb9\x48\x89\x9d\xc7\x1e\x67\x68\x49\xef\xe6\x52\xd9\x74\xcc\x67\xf4\x26\x69\x27\xca\x11\x5a\xc4\x4e\x22\x9b\x4c\xee\xc8\xc4\xf9\x75\x5f\x51\xb7\x70\xa9\xd3\x86\x82\xab\x94\xdb\x9b\x82\x23\xe5\x17\x3a\x2a\xe7\x03\xf9\xff\x75\xdb\xfa\xc1\x83\x6c\xde\x70\xfc\x0f\x3f\xd0\x2c\xbc\xed\xf2\x1e\x8d\x8c\xde\x0c\x78\x44\x42\x31\xb2\x60\x14\xad\x46\xfa\x56\x86\x95\x94\x1b\xbd\xf9\x36\x10\xc9\xb4\x60\xf5\xaf\xcd\x17\xd1\x73\x06\x55\x4f\xe1\xe8\x06\xd1\x83\xfa\x1e\x0f\xc5\xc7\xdf\x49\xfd\x58\xf9\xb1\xf1\x60\x7e\xcb\xcf\x5b\xac\xce\xe3\x47\x42\xf7\x2d\x3d\xb2\xff\x53\x90\x7b\x27\x1a\xc3\x68\x04\xcf\x01\x41\x08\xf4\x49\x8b\xc5\x34\x01\x93\x44\x87\xfe\xf7\x69\x2a\x8f\xa3\xf7\xde\xea\xa4\x00\x77\x25\xa0\x87\x14\xa3\x37\xde\x8b\x8f\xac\x02\x57\x23\x15\x14\x90\xfb\xbe\x69\xf0\x57\x4a\x7e\xfe\xc2\x22\x0b\x63\x04\xa2\x37\x20\x3a\xec\x7f\xe0\xb3\x1a\x6e\xc4\x4f\x2e\xb3\xa8\xc3\xa6\x2f\x7e\xde\x8d\x56\x79\xeb\xfe\x90\x8d\x86\x66\x35\x36\x62\xdd\xda\x79\xf6\x63\x5d\x6f\x32\x7a\xf0\x6a\xa2\x11\xc1\x4f\xbf\x05\xfd\x38\xcd\x5c\x4c\x8f\x64\x89\x69\xc6\xfb\x08\x67\xd3\x5f\xd5\xca\xe7\x53\x7a\xd9\xa7\xab\x93\x8a\x70\xff\x83\x9c\xd5\x78\x3e\xb9\x8e\x19\x49\x02\xce\x13\xc5\xe0\x19\xa4\x6b\x3f\x24\x38\x53\xf1\xf9\x7f\xdf\x2f\xfa\xd2\x6f\x82\xe8\x49\x58\xf7\x10\xe8\x3f\x4c\x49\x6f\x5b\x92\x32\xce\x0d\x56\x9f\xd6\x4d\x43\x00\xed\xf3\x4e\xc4\x79\xd0\xaa\x4d\x2f\x5d\x4f\x36\x26\x5f\x99\xfc\xef\x97\x73\x84\x22\x6e\xb4\x55\x9d\xb9\xd0\xc8\xd2\xd1\x96\xdf\xf9\x03\x38\xe4\x0f\xf5\x07\x94\xab\x75\xf9\x8b\xae\x5e\xa8\x3c\xf4\xc3\x5e\x27\x32\xf0\x85\x12\x2b\x38\x10\xf5\x96\x0a\xba\x0c\xe2\xc9\x3e\x9b\x8c\x3f\x0c\xa2\x96\x2a\x4d\xc3\x43\xa6\x74\xd7\xfb\xbe\x64\x70\x84\x4a\x42\x16\xf7\x45\x08\x49\x4a\x7a\xb1\xdb\x32\x55\x4d\x36\x89\x0b\xc3\x88\x32\x2e\x05\x89\xa8\x94\x0a\x12\xfd\xaa\x5a\x2f\x93\x6c\x22\x63\xac\xa7\xfc\xdd\xfd\x76\xef\xec\x33\x87\xea\x50\x94\x77\xea\x13\xcb\x9b\x07\x29\x3f\x05\x8b\x7f\x4e\x14\x95\x18\x66\xdc\xe7\x1e\xbd\x4d\x3d\x80\xf8\xdd\x1a\xd4\xcd\xb1\xf3\x0d\x1f\xa7\x49\xd7\xf2\x0e\x7e\x78\xd4\xeb\xd2\x21\xdc\x24\x29\xec\xa9\x8e\x49\x3d\x38\x96\x3e\xd2\x1c\x9f\x39\x0d\x1f\xd7\x89\x5a\x77\xc3\x97\x79\xd2\x40\x27\x0c\xa3\xd0\x39\x3a\x86\x07\x45\x27\x4d\x54\x14\x8f\xbe\x09\x6b\x77\x5c\xfc\x04\x18\x3f\xaf\x27\xbe\x6f\x82\xb0\x68\x8a\xe1\x67\x72\x55\xe9\xd6\xbe\x73\x55\x79\x7a\x9b\x36\x60\x22\x1d\x52\x7a\xf3\xf2\x84\xea\x5b\x39\x72\x48\xbc\x21\xc2\x60\x9e\xdc\x9d\x9d\x0d\xc0\xf8\x6a\x1a\x46\xc9\x88\x2f\x3e\xf6\x1f\x34\x0e\xb4\x07\x7f\xe6\x60\x1f\x11\x1a\xbc\x37\xf0\x76\x2e\xac\xed\xf6\xad\xb8\x2e\x02\xf4\xba\x84\x94\x8c\xd0\xe8\xfb\x62\xb8\xae\x6b\x49\x66\x15\x6d\x1c\x95\xb9\x78\xf4\x17\x86\xee\x33\xa2\x12\xf8\x73\x5e\x82\xf0\xf0\x39\xaf\x7b\x3e\x73\x9b\x66\xe1\xe3\xde\xf7\xe1\x9b\x52\x1a\x14\x97\x3f\x23\x7f\xd4\xf6\x59\x51\xf9\x5e\x8f\x3e\x01\xe6\xfb\xaf\x84\xe5\xd0\x60\x8f\x90\x9f\x0a\xc3\xf4\x9d\x74\xa8\x32\x4a\xb3\xe1\xe7\xfb\xc3\x21\xfb\xef\x01\x00\x59\x48\x14\x9c\x37\x2d\x00\x00")
+
+func templateEventsTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateEventsTmpl,
+		"template/events.tmpl",
+	)
+}
+
+func templateEventsTmpl() (*asset, error) {
+	bytes, err := templateEventsTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/events.tmpl", size: 11575, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateGraphqlEdgeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\xdf\x6f\xdb\x36\x10\x7e\x96\xfe\x8a\x9b\x60\xa0\x56\xa6\x32\x6d\xdf\xe6\xc1\x03\xda\x20\x29\x02\x64\xe9\xd2\x76\xe8\x83\x11\x0c\xb4\x78\x92\x88\xc8\xa4\x42\x52\x4d\x0c\xc3\xff\xfb\x70\x24\x65\x5b\xae\x57\xe4\x61\x2f\x09\xcd\xbb\xfb\x78\x3f\xbe\x8f\xd4\x66\x73\x7e\x96\x5e\xe8\x6e\x6d\x64\xdd\x38\x78\xf7\xe6\xed\x6f\xaf\x3b\x83\x16\x95\x83\x2b\x5e\xe2\x52\xeb\x07\xb8\x56\x25\x83\xf7\x6d\x0b\xde\xc9\x02\xd9\xcd\x77\x14\x2c\xfd\xda\x48\x0b\x56\xf7\xa6\x44\x28\xb5\x40\x90\x16\x5a\x59\xa2\xb2\x28\xa0\x57\x02\x0d\xb8\x06\xe1\x7d\xc7\xcb\x06\xe1\x1d\x7b\x33\x58\xa1\xd2\xbd\x12\xa9\x54\xde\x7e\x73\x7d\x71\x79\xfb\xe5\x12\x2a\xd9\x22\xc4\x3d\xa3\xb5\x03\x21\x0d\x96\x4e\x9b\x35\xe8\x0a\xdc\xc1\x61\xce\x20\xb2\xf4\xec\x7c\xbb\x4d\xd3\xcd\x06\x04\x56\x52\x21\x64\xb5\xe1\x5d\xf3\xd8\x9e\xa3\xa8\x31\x83\x68\x9c\x74\x0f\x35\xcc\xe6\xb0\xe4\x16\x61\xc2\x2e\xb4\xaa\x64\xcd\xfe\xe2\xe5\x03\xaf\x91\x9c\x36\x1b\x70\xb8\xea\x5a\xee\x10\xb2\x06\xb9\x40\x93\xc1\x84\x2c\xa9\x5c\x75\xda\x38\x98\xa6\x49\x56\x6a\xe5\xf0\xd9\x65\x69\x92\x59\x67\xa4\xaa\x6d\x96\xe6\xfe\x00\xc3\x55\x8d\x30\x51\x74\xc8\x84\x7d\xbc\xbb\xb9\xd5\x02\x6d\x44\x9e\x18\x2c\xbd\x41\xb1\xcf\x58\xa2\xfc\x8e\x26\x5a\x62\x18\x46\xeb\xc7\xbb\x9b\x4b\x51\xef\xe2\x64\x05\x13\x24\xb0\x0b\xad\x14\x96\x4e\x6a\x45\x96\xf3\x73\x20\x50\x64\x5f\x9c\xe9\x4b\x77\x25\xb1\x15\xb0\xdd\x82\x41\xd7\x1b\x65\x7d\xeb\x3e\x63\xcb\xd7\x50\xee\xe3\x7c\xf3\x10\xb2\x10\x79\xcb\x57\x54\x76\x06\xd4\xa5\xc1\x46\x26\x35\x98\x58\x5a\xf5\xaa\x84\xe9\x90\xfe\x76\x0b\x67\x23\x87\xfc\x64\x12\xd3\xd2\x3d\x43\x6c\x13\xb5\x99\xfe\x17\xc0\x2b\x87\x06\xce\x2e\x7a\x63\xb5\x29\xa0\x92\xc6\x3a\x38\x93\xca\x15\xb0\xc4\x4a\x1b\xdc\xdb\x5a\xbe\x33\x69\x23\xd0\x7c\x58\x87\x73\x91\x7d\x5d\x77\xbb\xc4\x3f\x91\xa9\x80\xa7\x06\x0d\x9e\xb2\x7f\x23\xc3\xb5\xea\x7a\x97\xc3\xf4\x84\x7d\xdf\xd0\x02\xd0\x18\x6d\x72\xd8\xa4\x49\x68\x20\xec\x4b\x66\x77\x3d\x9a\xf5\xc9\x3a\x73\xb6\xc7\xa0\xa2\x63\x91\xb1\xb8\xa1\xae\x50\xcf\xae\x94\x98\x71\x9e\xfa\xf1\x62\x6b\xf1\xa5\xf3\x7c\xf9\xdc\xe0\x6b\x83\x80\xbc\x46\xf3\xba\xd5\x5c\xa0\x20\x7c\xef\x2e\x6d\x84\x44\x01\xb2\x02\xe9\xe0\x89\x5b\x08\x4e\x30\xb5\x88\x70\xa1\xdb\x16\x63\x06\x36\x2f\x80\x2b\x41\x6e\xd2\xc2\x63\x8f\x46\xa2\x00\xed\x1a\x34\x4f\xd2\xe2\xff\xc8\x8f\xdc\xa3\xc8\x0a\x94\x76\xd4\x86\xbf\x95\x7c\xec\xa9\xcc\xc5\x3d\x75\x49\x51\x33\x4e\xcc\x70\x3c\x39\xdb\xb7\xce\xef\x90\x94\x0e\x26\xe8\xf5\xc4\x4e\xa5\xf2\xc9\x5c\x1a\x33\xcd\xd3\x44\x56\x70\x6d\x6f\xb5\xbb\xf1\x9d\x98\xa2\x09\x98\x23\xd0\xf9\x4b\x59\xb1\x93\xed\xae\x8a\x4f\xaa\x5d\xef\xa7\xfd\xbe\x6d\x77\x45\x11\x6f\xf2\x34\xd9\xa6\xc9\x66\xf3\xfa\x38\xcc\x27\x40\xd3\x82\x21\x8f\x3f\xb9\x7d\xb8\xd5\xee\x8a\xae\x4d\x9f\x65\x88\x1b\x68\xf4\x83\x3f\x1a\x13\x3d\xfc\x69\x91\x74\x61\xbd\x5f\xd1\xe6\x84\xc6\xbb\x8e\x97\x90\xe7\x7c\xec\xf1\x70\x7b\x85\x3b\x6b\x36\x87\xdd\x3a\x86\x04\xfa\x8e\x78\x73\x40\xbe\x70\x17\x11\xf9\x68\xc5\x1d\x70\x83\x60\x91\x38\x86\x02\x96\x6b\x6f\xae\xe5\x77\x54\xf0\x91\xae\xed\xbb\x1b\xa8\x08\x03\x3a\xee\x1a\x5b\x80\x54\x84\xee\xc5\x03\x4e\xc3\x92\xbb\xb2\xa1\x18\x69\x3c\x6d\xa5\xaa\x41\x2a\xeb\x90\x0b\xd2\x82\xcf\x88\xf6\x5c\x83\x2b\xa8\xb4\x01\xe4\x65\x73\x2c\x8f\x5b\xb4\x0e\x85\xbf\xf8\x2c\xa1\x87\x94\x3a\x6e\x78\xcc\x49\x68\x67\x19\x5c\x51\xf8\x33\x5f\x75\x2d\x16\x90\x75\xe8\x2c\xd3\x4f\x8a\x5e\x85\x7d\x61\x7e\x63\x50\x61\xd4\x91\xf7\x24\xdc\xe3\x1b\xd8\x7a\x35\xf5\xea\x41\xe9\x27\x15\xca\xb4\xbe\x1f\xb2\x56\xda\xa0\x38\x12\xd4\xf0\x4a\x04\x55\x0d\xcd\xce\xc7\xad\x9e\x46\x18\xc6\x58\x78\x8f\xf2\xb1\x3b\xd1\x98\x08\x30\xf1\x23\xa1\xf9\x56\x3c\xb0\xe5\xa7\x6f\xcf\x48\x8d\xc7\xcf\x0f\xe1\x07\xb8\x39\x38\xd3\x47\x30\x54\xe2\x70\x71\xc0\x68\xef\x4a\x3b\x09\x4d\x44\xf1\x15\x16\x43\xf5\xc4\x27\x9f\x44\x79\xa2\xa8\xa0\xc1\xc4\x3e\x49\x1a\x3a\xc5\x85\x0d\x02\xfe\xc9\xb3\x99\x44\x97\xff\x2e\x80\x40\x92\x92\x3e\x04\x36\x1b\x78\xec\xb5\x43\x98\x96\x7c\x85\xed\x70\xc1\xe6\xb0\xdd\xce\xbc\x57\x72\x34\x0c\xf6\x4d\xba\xe6\xa4\xf0\x69\x76\xd3\xc7\xd1\x53\x74\xa8\xa3\x58\x4c\x92\x24\x8f\xec\xd4\x04\x19\x63\x79\x38\x71\x1b\xfe\x1f\xc8\xf6\x87\x5f\xf4\x67\x9b\x8e\x36\xa3\xf2\x8f\xd2\x1d\x29\x9e\x38\x39\xea\x33\xd4\x46\xf7\x9d\x3d\xd0\xe0\x81\xf6\xa2\x38\xa5\x89\x8f\xb5\x37\x8d\x55\x41\x80\x23\x61\x10\xbf\xc3\x46\x65\x24\x2a\x61\x33\xcf\x6f\x7f\x0c\x0a\x12\xf0\xc6\x9b\xb3\x19\x2c\xb2\x10\x53\x40\x36\xf8\xde\x0f\x9f\x1c\xa7\xc8\x00\x8b\xfb\x81\xe0\x2b\xde\x2d\xc2\xfa\x7e\xd8\xa4\xe6\x86\xac\x67\x73\x58\xf1\x07\x9c\x9e\x70\x2a\xa0\x45\x15\xe1\xf2\x3c\xf5\x6c\xfc\xa7\x80\x6a\xcf\xc2\x78\x14\x4d\x8a\xe8\x56\xd0\x45\xea\xc8\x5c\x15\x90\x65\x69\x42\x0f\x85\xa4\xdf\xf1\xdb\x8f\x5d\x2b\x81\xcf\x1f\xd6\x0e\xa7\x55\x01\xaf\xd8\xab\xfc\x77\x90\xf0\xc7\x1c\xde\x50\x42\x23\x90\x39\x54\x8b\x99\xbc\x2f\xa0\x5a\xc8\x5f\xdf\xce\xee\xc3\x04\x13\xdb\x2f\x09\xcf\xe7\xbe\x20\x77\x32\xc8\x2a\x1c\xfc\xcb\x1c\xb2\x2c\x8a\xa0\x5f\xc2\x1c\x78\xd7\xa1\x12\x53\xdb\x2f\x03\x6a\x1e\x51\x0e\xc2\x61\x0e\xb6\x5f\xa6\xc9\x9e\x12\xde\x38\x22\xc2\xbf\x03\x00\xf7\xc1\xfe\x9f\xf0\x0b\x00\x00")
+
+func templateGraphqlEdgeTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateGraphqlEdgeTmpl,
+		"template/graphql/edge.tmpl",
+	)
+}
+
+func templateGraphqlEdgeTmpl() (*asset, error) {
+	bytes, err := templateGraphqlEdgeTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/graphql/edge.tmpl", size: 3056, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateGraphqlNodeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x57\x4d\x6f\xdb\x38\x10\x3d\x4b\xbf\x62\x2a\x64\x0b\x29\x70\xe9\x6e\x6f\x1b\x20\x87\x22\xfd\x58\x03\x45\xda\xa2\x05\x7a\x08\x82\x05\x2d\x8e\x2c\xc2\x32\xa9\x90\x54\x1c\x43\xf0\x7f\x5f\x0c\x49\xd9\x72\x6d\xa3\x69\x6f\xb1\x48\x3e\xbe\x79\xef\x71\xc8\xf4\xfd\xf4\x32\xbd\xd1\xed\xc6\xc8\x45\xed\xe0\xcd\xeb\xbf\xff\x79\xd5\x1a\xb4\xa8\x1c\x7c\xe0\x25\xce\xb5\x5e\xc2\x4c\x95\x0c\xde\x36\x0d\xf8\x49\x16\x68\xdc\x3c\xa2\x60\xe9\xf7\x5a\x5a\xb0\xba\x33\x25\x42\xa9\x05\x82\xb4\xd0\xc8\x12\x95\x45\x01\x9d\x12\x68\xc0\xd5\x08\x6f\x5b\x5e\xd6\x08\x6f\xd8\xeb\x61\x14\x2a\xdd\x29\x91\x4a\xe5\xc7\x3f\xcd\x6e\xde\xdf\x7e\x7b\x0f\x95\x6c\x10\xe2\x37\xa3\xb5\x03\x21\x0d\x96\x4e\x9b\x0d\xe8\x0a\xdc\x68\x33\x67\x10\x59\x7a\x39\xdd\x6e\xd3\xb4\xef\x41\x60\x25\x15\x42\xb6\x30\xbc\xad\x1f\x9a\xa9\xd2\x02\x33\x88\x83\x17\xed\x72\x01\x57\xd7\x30\xe7\x16\xe1\x82\xdd\x68\x55\xc9\x05\xfb\xc2\xcb\x25\x5f\x20\x4d\xea\x7b\x70\xb8\x6a\x1b\xee\x10\xb2\x1a\xb9\x40\x93\xc1\x05\x8d\xa4\x72\xd5\x6a\xe3\x20\x4f\x93\xac\xd4\xca\xe1\x93\xcb\xd2\x24\xab\x56\x2e\x4b\xd3\xa4\xef\x5f\x81\xe1\x6a\x81\x70\xa1\x68\x83\x0b\xf6\xf1\xeb\xa7\x5b\x2d\xd0\xd2\xda\x24\xc9\x68\x73\x75\xbc\xe1\x34\x7c\xdf\x7f\xc8\x02\x16\x2a\xe1\x17\xd2\xdf\x6b\xe9\xea\x00\x38\x7b\xf7\x7d\xd3\x22\xfb\xb2\x5c\x7c\xe1\xae\xde\x23\xb3\xa3\x85\x45\x9a\x4e\xa7\x40\x04\x0c\xac\x0d\x6f\xad\x17\x72\xce\xad\x2c\x61\x66\xe9\x3b\xac\xd0\xd5\x5a\x30\x98\x39\xb2\x4a\xae\xda\x06\x57\xa8\x1c\x0a\x98\x6f\x80\x37\x0d\x01\xb8\x4d\x8b\xb4\x94\xbb\xfd\x04\x8f\xe4\x11\xa4\x72\x68\x2a\x5e\x62\xb0\x04\xe1\x23\x69\xfe\xf5\x13\xd8\xb2\xc6\x15\x67\x29\x2d\x8f\x24\xf6\x73\xfb\x34\x09\x0c\xf2\x22\x0d\xae\x9c\x17\x6e\x3a\x1d\xd8\xee\xb6\xb7\xa7\xf6\x2f\x6b\x2c\x97\x50\x69\x33\x50\x60\x69\xd5\xa9\x12\xf2\xcb\xa0\xef\x2d\x5f\x91\xb8\x45\x44\xcb\x0b\xe8\xbd\xd7\x51\x2d\x2a\x95\x72\xf2\xb9\x75\x52\x2b\x0b\xb5\x6e\x44\xd8\x47\xc7\x2f\xb1\x40\xda\xd6\x00\x57\x22\x54\x65\xa3\x88\x36\x96\x3a\xc6\xb0\xce\x74\xa5\x83\x3e\x4d\xe8\x2b\xf9\x06\x44\x29\x8f\xd9\xa1\x28\x50\x86\x26\xd0\xf7\x63\x73\x3d\xcb\xdc\x3a\x23\xd5\x62\x02\x68\x8c\x36\x5e\xa6\xe8\x66\x40\x07\xde\x34\x7a\x6d\xa1\xf4\x71\xea\x68\xee\x73\xe8\x8d\x00\x3c\x95\xcb\x11\xdf\x10\x98\x1f\xd2\xd5\xb7\x03\x5d\x8b\x2e\x88\x40\x93\x69\x52\x08\x82\x41\xab\x9b\x47\xb4\x07\x8e\x87\xf2\x49\x65\x5d\x01\x07\x02\x26\xbc\xf9\x06\xa4\xb3\x20\x05\x2a\x27\x2b\x89\x66\x88\x9b\xc1\x87\x4e\x1a\x14\xb0\xae\x51\x8d\xc6\x2d\x70\x83\xa0\xb4\x83\x45\xa3\xe7\xbc\x69\x36\xd0\x29\xf9\xd0\x61\x34\x74\x4c\x30\xaf\xfe\x58\xd1\x62\xac\x45\x9f\x26\x06\x5d\x67\xa2\x2a\x1a\x0e\x74\x21\x07\x13\xcd\x76\x26\x5e\x43\x95\x26\xdb\x68\x09\xd1\xf9\x20\x9f\x50\x1c\x8b\x76\x52\x19\x1a\x88\xfa\x09\x2f\x92\x1d\xd5\x75\x00\x94\x3b\x08\x21\x38\x43\xf5\x50\x88\x3f\x94\x81\xf0\x06\x40\x37\x01\x25\x9b\x34\xd9\x8e\xe3\x66\x20\x8c\x86\x92\x88\x70\xe8\x46\xf4\x6b\x21\x1f\x0f\xac\x63\x30\x0b\x87\xc4\x57\x1c\x8b\x0d\x4b\xb8\x25\x40\x72\x75\x48\x2c\x0a\xe8\x2c\xc5\x76\x5c\x07\xe4\xda\x1c\x2b\x51\x4c\x80\x37\x4d\x6c\x45\x94\x8e\x87\x0e\x8d\x44\x41\x90\xf1\x82\xd0\x26\x5e\x2f\x1b\x1f\x9f\x70\x05\x88\x89\x3f\x09\xc4\xa8\x92\xc6\x3a\xaf\x77\x88\xf0\x9a\xdb\x70\xeb\x84\x28\x52\x85\x74\x85\x4d\xa7\xf0\x2f\xaa\x12\x27\x91\x5b\x6c\x9f\xd2\xd5\xba\x73\x31\xd5\x9e\x07\xe0\x53\x8b\x65\x34\x7a\x1c\x5e\xa7\x61\x8e\x67\xa2\x9b\x97\x70\x79\xd3\x48\x54\x2e\x58\x6a\xf2\xd2\x3d\xc1\x91\x6b\x52\x1c\x1b\x37\x01\xdd\x3a\x0b\x8c\xb1\x7d\x16\x0a\xc8\xe9\x87\x19\x7b\xf9\xc8\x0d\xe8\x71\x27\x4b\x13\xea\x89\xff\xf9\xf5\xd4\x5d\x43\xa7\xf5\x60\x64\xbd\x6e\x5d\xfe\x52\x17\x94\xe7\x44\x56\x30\x4a\xf9\x8b\x6b\x4a\x03\x61\x26\xce\xef\x40\xab\xf7\xe3\x44\x9d\xa8\x16\x69\x42\x0b\x69\x7c\xb4\x62\x88\x94\x92\x8d\x5f\x9b\x26\xc9\x76\x1f\xb4\xd2\xa3\xf8\xea\x27\xe0\x22\xca\x76\xc7\x74\xc4\x73\xd8\xcd\x7a\x54\xb5\xe3\x71\x12\x81\x78\xbc\x98\xd9\x5b\xed\x3e\x90\xb3\x39\x1a\x53\x1c\xb2\x19\x71\xd9\xee\xce\x91\xe7\xf8\x72\x58\xf5\x9e\x8e\x45\x9f\x11\x7c\x36\x9c\xf1\xd8\x4a\x7f\x3e\x08\xf6\xfc\x49\xb0\x0c\xbe\xe1\xd0\x8d\xa9\xac\xd1\x4d\x72\x2e\x0c\xf6\x5c\x1a\x2c\xdc\xdd\x3f\x37\x10\x77\xf7\x47\x91\x08\x54\xaf\xae\x61\xc5\x97\xb8\x9f\xd0\xa0\xca\xa5\xb0\x45\x11\x74\x97\xb4\xd3\x5e\x78\x29\x8e\x25\xdf\x45\x96\xa6\xfa\x40\x59\xc6\xd8\x6f\x05\xc0\x73\xb9\x93\xf7\x70\x0d\xea\xc0\x03\xfa\x1e\xda\xcf\xfe\x2e\xa6\x4a\xc7\x37\xf1\x51\x3f\xdd\x5d\xca\xcf\x7c\xa4\xb0\x94\x8e\xc7\x1e\xfa\x1a\xee\x18\x63\xf7\xa1\xcb\xf6\xbf\x7e\xc2\xf5\x3d\x3c\x74\xda\xd1\x30\x79\x11\x9f\x14\x93\x83\x67\xd7\x9e\xfe\x89\xce\x19\xe9\x86\xb4\x1c\x94\x73\xbe\xa7\x1e\xa7\x45\x9d\x6f\x1d\xc3\x95\x71\xba\x89\x9c\x6a\x18\x76\x2d\x5d\x59\x83\x83\x5f\xd7\x5f\xd2\x93\xf9\xb4\x06\x57\x3f\x45\xe5\xe0\xcd\xc5\xbe\x76\x68\x36\x79\xc1\xe8\x28\xfe\xa8\xd1\x60\xfe\xf3\x9b\x97\xcd\xde\xe5\x52\x14\x61\xca\x67\xd5\x6c\xe8\x2c\xfc\x56\xb2\x86\xaf\xf1\x0e\x1b\x39\x92\x08\xac\x78\xd7\xb8\xab\xd1\x2c\x3a\xf2\xd5\xca\x31\x7f\xda\xab\x3c\x1b\xfe\x31\xd8\x6e\xaf\xa0\xe4\x4a\xe9\xdd\x23\x27\x5a\xa9\x2b\xe8\xd4\x52\xe9\xb5\x0a\xed\xff\xaf\x87\x6c\x02\xce\xb7\xad\xf1\x33\xf2\xff\x01\x00\x4d\xd7\x5a\xc0\x42\x0d\x00\x00")
+
+func templateGraphqlNodeTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateGraphqlNodeTmpl,
+		"template/graphql/node.tmpl",
+	)
+}
+
+func templateGraphqlNodeTmpl() (*asset, error) {
+	bytes, err := templateGraphqlNodeTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/graphql/node.tmpl", size: 3394, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateGraphqlPaginationTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x57\x5d\x6f\xdb\xb8\x12\x7d\x96\x7e\xc5\x54\x70\x0b\xc9\x50\xe9\xde\xbe\x5d\x03\x79\xe8\xf5\x4d\x83\x00\x69\xb7\xde\x66\xb7\x0f\x45\x81\x32\xd2\x48\xe6\x46\x22\x1d\x92\x72\x62\x08\xfa\xef\x8b\xa1\x28\xf9\xa3\x4e\x53\xa0\xbb\xed\x43\x23\x73\x46\xc3\x73\x66\x86\x67\xa8\xb6\x9d\x4d\xc3\x85\x5a\x6f\xb5\x28\x57\x16\x5e\xbf\xfa\xcf\x7f\x5f\xae\x35\x1a\x94\x16\xde\xf2\x0c\x6f\x94\xba\x85\x4b\x99\x31\x78\x53\x55\xe0\x9c\x0c\x90\x5d\x6f\x30\x67\xe1\xf5\x4a\x18\x30\xaa\xd1\x19\x42\xa6\x72\x04\x61\xa0\x12\x19\x4a\x83\x39\x34\x32\x47\x0d\x76\x85\xf0\x66\xcd\xb3\x15\xc2\x6b\xf6\x6a\xb0\x42\xa1\x1a\x99\x87\x42\x3a\xfb\xd5\xe5\xe2\xfc\xfd\xc7\x73\x28\x44\x85\xe0\xd7\xb4\x52\x16\x72\xa1\x31\xb3\x4a\x6f\x41\x15\x60\xf7\x36\xb3\x1a\x91\x85\xd3\x59\xd7\x85\x61\xdb\x42\x8e\x85\x90\x08\x51\xa9\xf9\x7a\x75\x57\xcd\xd6\xbc\x14\x92\x5b\xa1\x64\x04\xde\x65\xb2\xbe\x2d\x61\x7e\x06\x37\xdc\x20\x4c\xd8\x42\xc9\x42\x94\xec\x03\xcf\x6e\x79\x89\xe4\xd4\xb6\x60\xb1\x5e\x57\xdc\x22\x44\x2b\xe4\x39\xea\x08\x26\x64\x09\x45\xbd\x56\xda\x42\x1c\x06\x51\xa6\xa4\xc5\x07\x1b\x85\x41\x54\xd4\xee\x8f\x50\xf4\xbf\xb1\x3a\x53\x72\x13\x85\x61\xd0\xb6\x2f\x41\x73\x59\x22\x4c\x24\xed\x38\x61\x17\xcb\xab\xf7\x2a\x47\x43\xc1\x82\x20\x22\x34\xf2\x5b\x04\xb3\x7e\x7d\xb7\x10\xf5\xb1\x50\xe6\xf4\x62\x12\x86\xb3\x19\xbc\xe3\xda\xac\x78\x75\xb1\xbc\x02\x51\xaf\x2b\xac\x51\x5a\xe3\x12\xe6\xb9\x33\xef\x81\x1a\x84\xb4\xa8\x0b\x9e\x21\x0b\x8b\x46\x66\x10\x67\xb0\x68\xb4\x51\x3a\xd9\x0b\x13\xdf\x83\x50\xec\x93\x16\x16\x75\x02\x6d\x18\x0c\xbf\x3e\x5a\x2d\x64\x19\xdf\xa7\xe0\xb9\xb1\x65\xa3\x2c\xc6\x19\xf3\x96\x24\x49\xc2\xce\x81\xfa\x43\xd6\x4f\xc2\x1a\x7d\x4e\x03\x9b\x0e\xc8\xf6\x63\xc5\x9b\x9d\x6b\xdb\x25\x80\x5a\x2b\x4d\x18\x4d\x0a\xea\x96\x72\xbb\x61\xb1\x71\x68\x92\x30\x10\x05\x3c\x53\xb7\x64\x0e\x34\xda\x46\x4b\x28\x6a\xcb\xce\xe9\x9d\x22\x8e\x86\x16\xe8\xba\x39\x64\x6e\x2f\x78\x7e\x0d\x75\x63\x2c\xdc\x20\x70\x22\x29\x64\x19\xa5\xb0\x49\xc2\xa0\x0b\x87\x10\x19\x1b\x01\x5d\xe3\x83\x8d\x3f\x7f\xb9\xd9\x5a\x8c\xcd\xc8\xfd\xdd\x93\xcc\xdf\x3d\xce\x5b\xc1\x6f\x3a\x47\xfd\x7f\xd7\xe6\x42\xc9\x9f\x2a\x8c\xfa\x17\x0a\xa3\x60\x7a\x8c\xf0\x57\x14\x48\xd1\x9e\xfe\xf4\x0b\x25\xbf\x5f\xa9\xa9\x82\xb3\xa3\x3c\xc6\x26\x19\x2b\xa8\xd8\x9f\xbc\x12\x39\xb7\x18\xbb\xb4\xb4\xed\x77\x4e\x27\x81\xb8\x6b\x50\x6f\x09\xfb\x44\xb2\x25\x3d\xbf\xe7\xf5\xa0\x10\x13\x8d\x19\x8a\x0d\x6a\xb2\x8f\xcf\xfe\x15\xef\xd2\x83\x9f\x9f\xc1\x5a\x0b\x69\xe9\x48\xbb\x00\x91\x83\x18\x0d\x81\x0a\x81\x55\xfe\x98\xd7\x5b\x32\x8e\xae\xf7\x2b\xd4\x78\xc2\xf5\x13\xad\x5f\xca\x75\x63\x47\x57\xcc\xcb\x53\x9e\xe7\x79\x89\xa3\x4f\xa6\xa4\x3c\xe1\xb3\x50\x52\xf6\xe9\x76\x9e\xd4\x3e\x63\xc4\xae\x23\x65\xa7\xae\x71\x3f\x35\xfa\x19\xe1\x24\x96\xb4\xb9\x6d\xc7\x40\xe4\x2b\x81\xc3\xef\x58\xf1\x2d\x64\x63\x54\x16\xda\xed\x1a\x0f\x62\x1a\xab\x9b\xcc\x52\x43\x50\x01\x00\x60\x7a\x18\xe7\xeb\x5f\x46\xc9\x79\x24\x55\x8e\xd1\xd7\x30\xe8\x25\xc2\x6b\xd8\x60\xec\xcf\x72\xf4\xd5\x77\xfc\x48\x70\x07\xf9\x18\xc8\xb7\x78\x51\x5a\x61\x05\x9a\x3d\x88\x43\x8c\x1d\x44\xca\xa1\x01\xf7\xef\xf3\x97\xe9\x3e\x0d\x8f\x84\x52\x63\x08\xe7\x07\x5e\xe2\xa5\x2c\x14\x00\x8c\x8f\xde\x67\xed\x7f\x93\xdb\xb5\xb2\xbc\x5a\xa8\x46\x5a\x3a\x44\x03\x1f\x3b\xae\x8e\x9c\x76\x95\x01\x7c\xc0\xac\xb1\xd8\x13\xeb\xbb\x8e\xcb\x1c\xfa\x56\x37\x27\xb2\x4e\x64\xc9\xf7\x34\x61\x2a\xb2\x5d\x71\x0b\x35\xb7\xd9\xca\x05\xed\x9b\x4d\x50\x57\x41\x2c\x0a\xe0\x72\x9b\x30\xf8\x88\x48\x5c\x84\xa4\xe9\x58\xa8\x7e\xac\xef\xa6\x2c\x70\x5d\x36\x4e\x5a\x18\x5c\xaf\x10\x1c\x09\x0a\x9e\x39\x7a\x1e\xc3\x1e\x2a\x5f\x1a\xd9\xd4\x37\xa8\x09\xe3\x80\xe8\x18\x8e\xe3\x98\x82\xc6\x92\xeb\xbc\x42\x63\x3c\x21\x17\xdc\x95\xde\xb8\x0c\x90\x6f\x25\x6a\x61\x07\x07\xa0\x54\x0f\x32\x76\x70\x72\xbb\xae\xef\xb3\xe1\xd0\x26\x7b\x09\x8e\x33\xfb\x00\x7e\xc6\xd3\x84\xa6\xbf\x29\xf0\xc2\xa2\x1e\x46\x54\x0a\x85\xd0\xc6\xc2\x54\x48\x9b\xc2\x0d\x16\x4a\xe3\xce\x56\xf1\xd1\xe4\x74\xe0\x7f\xdb\x7e\x33\xf7\x03\xba\x2e\xf5\x09\x9e\xee\x0e\x36\x21\x88\xa7\x7b\x4d\x97\xf6\x83\xce\x89\xfe\x11\x72\x67\xa2\xc3\xeb\xa2\xb0\xb7\xa2\xb2\xa8\x8f\xe9\xf5\xb3\x90\x1c\x9f\x9d\x81\x14\xd5\xbe\xe2\x4a\x51\xb9\x18\x6e\xc6\xb9\x32\x8d\x21\x8f\xa2\xb0\x45\xa5\x24\xc6\x09\x73\xcd\x48\x89\xf9\xe1\xb8\xa2\x18\xc9\x9f\xed\x3c\xc7\x25\x78\xb1\x9f\x91\x76\x54\xed\xf9\x91\x8a\xbf\x31\x59\xe7\xe2\x51\x29\x1f\x85\x39\xb4\x25\x21\xf4\xa5\xf2\x25\x1a\xaa\xd3\x57\x65\x2c\xc8\x0f\xd3\x18\x84\xf2\xc5\x5e\x6d\x88\x88\x53\x82\x39\x09\x01\x40\xcd\x6f\x31\x3e\xd4\x83\x14\x2a\x94\x31\x61\x66\x24\x6b\x26\x49\xd2\x30\x18\x45\x81\xde\x73\xb6\x61\x81\x8c\x3b\x29\x98\xf7\x67\x27\x75\x00\xe8\xa0\x89\x14\x48\xff\x08\x47\x3f\xb6\x76\x81\x1d\x70\x02\xc9\x1c\xa2\xcf\xe2\xcb\x90\x5b\x8f\xa4\xa5\xfd\xe7\xee\xfd\xd4\xcb\xe6\x1c\xa6\x3e\x0d\xac\x57\xce\x98\xac\x49\x77\x70\xe7\x51\x52\xa6\x94\x19\x3f\x2e\xef\x85\x5d\x91\x7a\x5c\x2c\xaf\x76\xd3\xc9\x8d\xcb\x9f\xbd\xff\xf4\x42\xdc\x8f\x42\x3a\x06\x3f\x71\xfb\x29\x58\x79\x57\x91\xbe\xfd\x93\xd7\x9f\x02\xa6\x87\x00\x7f\xc5\xe5\x67\x7f\xc7\xef\xdf\x7c\xcc\xbd\x20\x9d\x34\xd0\x1e\x7c\x75\x14\xb4\x37\xa3\xfa\x04\x19\x7d\xed\xb4\x2d\xdc\x51\x8e\x60\x52\xb0\x73\x69\x2f\x96\x57\x6c\x57\x47\xe8\xba\x79\x18\x04\xd3\x02\xce\x0e\xc9\xba\x67\xba\x52\x36\x99\x1d\x3c\x0f\x3e\x48\x82\x1c\x0b\xde\x54\x76\xfe\x34\xa5\xe7\x77\x34\x8c\xa5\xb2\xc0\x61\x43\xb7\xb1\x03\x92\x51\x0a\xe6\xe0\xd2\xed\x5b\x6f\x36\x03\x5f\xd3\x71\xc0\x91\xcc\x5f\x50\xe5\x96\x57\x80\xb2\xa9\x29\x5a\x83\x83\xe4\xbb\xce\x06\x47\xe0\xb1\x0e\x1b\x9b\xc4\x27\x13\xda\x31\x8d\x05\xeb\xdd\x9e\x4c\xe6\xc1\x47\x1a\xa3\x85\x82\x66\x85\xb1\x5c\x5a\x9f\x4e\x4f\xe4\xa9\xcc\x3f\x99\xcf\x1e\x12\xe5\xc6\xdd\x2d\xbd\xe7\xa9\xa7\xbf\x07\x00\x07\x73\x6c\xd9\xca\x0f\x00\x00")
+
+func templateGraphqlPaginationTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateGraphqlPaginationTmpl,
+		"template/graphql/pagination.tmpl",
+	)
+}
+
+func templateGraphqlPaginationTmpl() (*asset, error) {
+	bytes, err := templateGraphqlPaginationTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/graphql/pagination.tmpl", size: 4042, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateGraphqlSchemaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x57\x4f\x6f\xdb\x3a\x12\xbf\xeb\x53\x8c\xfd\x7c\x48\x1e\x12\xe5\xed\xeb\xa9\x02\x72\x48\x6d\xa7\x30\x10\x24\x4d\x93\x6d\x0f\x45\x0f\xb4\x34\xb2\xb8\xa5\x49\x95\xa4\x92\x0d\x04\x7d\xf7\xc5\x90\xb4\x44\xdb\x72\xf6\xdd\x2c\xce\x70\xfe\xfe\xe6\x37\x74\xdb\x5e\xfd\x99\xcc\x55\xfd\xa6\xf9\xa6\xb2\xf0\xf7\x5f\xff\xfa\x78\x59\x6b\x34\x28\x2d\xdc\xb2\x1c\xd7\x4a\xfd\x82\x95\xcc\x53\xb8\x11\x02\x9c\x92\x01\x92\xeb\x17\x2c\xd2\xe4\xb9\xe2\x06\x8c\x6a\x74\x8e\x90\xab\x02\x81\x1b\x10\x3c\x47\x69\xb0\x80\x46\x16\xa8\xc1\x56\x08\x37\x35\xcb\x2b\x84\xbf\xd3\xbf\x76\x52\x28\x55\x23\x8b\x84\x4b\x27\xbf\x5b\xcd\x97\xf7\x4f\x4b\x28\xb9\x40\x08\x67\x5a\x29\x0b\x05\xd7\x98\x5b\xa5\xdf\x40\x95\x60\x23\x67\x56\x23\xa6\xc9\x9f\x57\x5d\x97\x24\x94\x03\x6c\x34\xab\xab\xdf\xe2\x2a\x57\x52\x62\x6e\xb9\x92\x57\x4c\x6f\x0c\x14\x58\x72\x89\xc6\x99\x64\x7a\xd3\x6c\x51\x5a\x43\xd6\x18\x7c\x45\xc1\xde\x60\xb8\x00\x25\x47\x51\x90\x8c\x94\x37\xfc\x05\x25\xd8\xb7\x1a\x53\x70\x7e\xda\xf6\x32\x58\x83\xe9\x09\x6f\x53\xb8\xec\xba\xe4\x8c\x95\x16\x75\x06\xf3\x46\x1b\xa5\x2f\xa0\xe4\xda\xd8\x0c\x56\xd2\x5e\xc0\x1a\x4b\xa5\x71\x90\x09\x16\x44\x6d\x0b\xbc\x84\xf4\xf3\xe3\xdd\x83\x2e\x50\xdf\x52\x28\x06\xba\xee\x02\x14\x7d\x7f\x7a\xcb\xa0\x6d\x9d\xfc\x9e\x6d\x11\xba\xce\xa9\xb5\x2d\xa0\x2c\x9c\xda\x6b\x85\x1a\x0f\x95\xbe\xd3\xe1\x4a\xd6\x8d\x3d\x77\xf1\x93\x32\x85\x38\x9a\x8c\xc9\x2b\xdc\x32\x9f\xc3\x1f\x30\xa7\x76\x6e\x50\xa2\x66\x16\x0b\x58\xbf\x01\x4a\x9b\x5f\xc0\xe2\x01\xee\x1f\x9e\x61\xb9\x58\x3d\xa7\x49\x32\x9d\x4e\x13\x9f\x0b\xb5\x9e\x49\x50\x35\xfb\xdd\x20\xe4\xfe\xcc\x56\xcc\x42\xad\x38\xd5\xdc\x2a\x60\x20\xc9\x2a\x97\x23\xd5\x4f\x93\x27\x44\xa8\xac\xad\x4d\x76\x75\xa5\xa9\x37\x69\x81\x2f\x57\xc7\xa5\x36\x69\x65\xb7\x7f\x18\xcc\x2f\xbd\xe7\xd4\x45\x61\x72\x26\x98\x0e\x85\xa5\x93\x67\xbe\xc5\x10\xd4\xd7\xdb\x39\x7c\xf8\xf0\xe1\x23\x58\xbe\x45\x63\xd9\xb6\x4e\xa3\x2b\xa4\xe8\x0a\xa2\x99\xdc\x20\xcc\x0c\x64\xd7\x30\xa3\x52\x3f\x39\x9b\xd4\x86\x9d\x6e\xdb\x92\x3c\xa0\x81\xaa\x49\xc5\x9c\x4e\xa7\x37\x12\xd4\xfa\x3f\x98\x5b\x78\xe5\xb6\x22\x9f\xab\x45\x0a\xb7\x4a\x08\xf5\xea\xc1\xe7\xf3\xfd\x2c\xd4\x9a\x09\x78\xf0\xba\xab\x02\xa5\xe5\x25\xcf\x19\xe5\x05\xa6\xc6\xbc\xff\x72\x11\x72\x69\x51\x97\x2c\x47\xb8\xa7\xc2\xb5\x09\x00\x2f\x32\x58\x2d\x26\x89\xf7\xbb\x92\xa5\xd2\x5b\x77\x01\xd8\x5a\x35\x16\x6a\xb6\xe1\xd2\x1f\xb8\x3a\x47\x15\x26\x8b\x04\x68\xf8\xc2\x36\x48\x37\x9d\xc1\x8a\x99\x7b\xfc\xaf\xa5\xb3\x0c\x3e\x29\x25\x90\xc9\x89\x3f\xff\xa2\xf1\x85\xab\xc6\x1c\xc9\x8c\x65\xda\xfa\x52\xef\xb0\x9c\x00\x41\xf1\xe0\xcc\x07\xf9\x45\x19\xc3\xd7\x02\xc3\x38\x73\x25\x0d\x4d\xf9\x6b\xc5\xf3\x0a\xac\xf2\x08\x07\x06\x82\x1b\x4b\xe3\xc7\x2d\x6e\x8d\xcb\x1f\x65\xb3\x05\x87\xf4\xc5\xee\xaa\x8b\xf9\xe6\x69\x9e\x00\x2c\x96\x4f\xf3\xa4\x8b\x5b\x27\xfb\xd6\x51\xbd\xfa\x46\xcd\x24\x8d\x03\x89\x64\x34\x1c\x89\x2f\x06\xb5\xd4\xc9\xbb\x0e\xf8\xb6\x16\xe8\x29\xe2\xa8\xe0\x00\x91\xa3\x72\xb0\xd6\x8f\xaa\xd3\x80\x9c\x6d\x51\xc0\xac\x4c\x83\x17\x37\x92\x91\xe6\x33\xf9\x9c\x95\xd0\x75\x7e\xe4\xa5\xb2\x4e\x9b\x0b\xc1\xa8\x46\x5d\x37\xe9\xa7\xda\x59\xec\x71\xb6\x17\x40\x94\xce\xb2\xd8\x60\xef\xff\x92\x6c\xce\x90\xdc\xcd\xfb\xce\x1f\x06\x87\xbb\xe0\xda\x16\x2c\x6e\x6b\xc1\x6c\xc4\x03\x47\xa4\x36\xc3\xd4\x45\xbd\x4b\xc6\x7f\x46\x95\x1c\x3c\xed\xca\x84\xc2\x60\x88\xe4\xdf\x92\x13\x23\x9c\x0a\xe1\x84\xc9\xa8\x38\x98\x3e\xd4\x64\x9b\x89\xd1\xe2\x08\xf3\x9e\xf1\x1f\xa3\xd6\x27\x3f\xc7\x4a\x1b\x3e\xba\xe4\xff\x35\x3a\x94\x99\xc9\x82\x5a\xb7\x32\x4b\x82\xe9\x59\xe8\xe4\x52\xda\xcf\x8f\x77\xae\x44\xe7\x3b\x7a\x38\x05\x01\xe2\x27\x87\xf1\xd2\x91\x25\x86\x15\xd4\xb6\x11\x80\xfa\x39\x38\x69\xa4\x1d\x83\x46\x99\x52\x54\x03\x2e\x67\x98\x7e\x63\xa2\xc1\x13\xe9\x86\x8f\xe8\x27\xc5\x7d\x13\xaf\x47\xab\xa2\x19\xdd\x9b\x9a\x7e\x5e\x0f\x07\x2a\x82\x20\x05\x89\x84\xd4\xd0\x93\xa0\x41\xe0\xa5\x66\xd4\x81\x92\xb2\x9e\x9c\x08\x4a\x56\x59\x26\xe6\xaa\x91\x7e\x49\xee\x58\xef\x46\x3a\x53\x44\x22\x2c\x76\x37\x4a\x76\x07\xce\xdc\x4c\xd3\x1a\x0a\x83\x19\xb8\x00\x20\xdf\xa3\xae\x49\xa8\x8b\xa3\xf3\x99\x3c\x5e\xcc\x9e\xd9\xb4\xaa\x51\x5b\x8e\x86\x16\xa4\x27\xb4\xf1\x78\x0c\xe4\x4c\xc2\x1a\x3d\xd9\x61\x31\xd0\x5b\xa4\x3f\x78\x38\xec\xa9\xe3\x9b\x74\x68\x66\x0f\xb3\xe8\xca\x71\x63\x29\x44\xa7\xc0\xe5\x06\x94\x1b\x22\xe3\xa0\x76\x22\xc6\xb0\x73\xea\xc6\x1e\x45\xe5\x02\xea\xf9\x3b\x3b\x20\xe5\x09\x5c\x07\x4e\x76\x00\xce\xc6\x93\xda\x87\x1a\x05\x97\x44\x7a\xc3\x5b\x85\xa6\xa2\xa1\x17\x24\x85\x5a\x72\x61\x7d\x02\x91\x6e\x58\xb6\x26\x4d\xdc\xe3\x06\x5e\x99\x39\x7a\xab\xa4\xc9\x68\x36\x91\x1b\x4a\x49\x2a\x9b\x8d\x8b\x13\x00\x26\x8b\x7d\xb4\x0e\x52\xc7\x20\x4a\xbf\x2b\x3e\xec\x9f\x87\x91\x53\x1a\x60\x44\xfd\xbc\x84\x99\x83\x6a\x76\x3d\x3e\xe1\x09\x40\x20\x91\x9e\x15\x02\x53\xd4\x1a\x0b\x7a\x30\xa0\xa1\x64\xf7\x5c\xaa\xfa\xc0\xe7\x43\x6d\x7a\x73\xa4\x37\xf3\x26\xb2\xeb\x1d\x6d\x96\xe9\x3e\xf9\x3a\x23\xfe\x6c\xba\x7c\x9c\x7a\x41\xb8\x75\x0d\xb5\xe6\xd2\xee\x3e\x7b\x45\xa7\x13\x3a\x3c\xec\x23\x92\x72\xc1\x0a\x9e\xef\xce\x77\x17\xbb\xae\x7f\x58\x1c\xae\x0e\x55\xa7\xdf\x98\xe6\xa7\x6e\xb9\xc2\xbb\xb2\xc5\x7c\x1e\x6f\x83\x48\x39\xd2\x7d\x87\xf9\x47\x3e\xde\x5d\xb7\xa1\x29\xfd\xba\xf1\xac\x74\xd8\x93\x8a\x99\xb6\x85\x9a\xd1\x13\x72\x6f\x37\x0d\x79\x9f\x50\xf9\xce\x6d\x75\x6a\x85\x8d\x60\x2d\x04\xbe\x3f\x65\x2e\xeb\xc7\x06\xf5\x9b\x1b\xe1\xe9\x74\x7a\x8b\x36\xaf\xd0\xbf\xd6\xdd\x18\x85\xff\x3a\xdc\x1a\x58\x2d\x1c\x07\xd0\x5c\x14\x78\x16\x9e\x3e\xe7\x99\x7b\x7e\xfa\xdb\x77\x4a\xfd\x6a\x6a\x27\x77\xa4\x37\xac\x84\xd5\xc2\x44\x97\xcd\x19\x2f\x4c\x06\x3f\x56\x8b\xc9\xcf\xc9\x79\x06\x3f\xc8\xc4\xcf\x83\x67\xd4\xc8\x7b\x6d\x00\x8d\xdc\x71\x9c\x7b\x3a\x47\xab\x64\x7f\xdb\x9f\xd5\xa2\xd1\x54\x36\xe9\xda\x70\xfe\xcf\x9f\x35\xb2\x87\x46\xfc\x2c\x1c\x79\xcc\x8c\xa0\x83\x8a\x0c\x28\x0b\xe8\xba\xe4\x7f\x03\x00\xac\xef\x12\x98\x45\x0f\x00\x00")
+
+func templateGraphqlSchemaTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateGraphqlSchemaTmpl,
+		"template/graphql/schema.tmpl",
+	)
+}
+
+func templateGraphqlSchemaTmpl() (*asset, error) {
+	bytes, err := templateGraphqlSchemaTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/graphql/schema.tmpl", size: 3909, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateGraphqlWhere_inputTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\x5d\x6f\xdb\x3a\x12\x7d\x96\x7e\xc5\x54\xf0\xa2\x92\xe1\xca\x6e\xdf\x36\x0b\x17\x30\xba\xee\x6e\x80\x22\x4d\xd1\xe2\xf6\x21\x08\x5a\x46\x1a\xd9\xbc\x91\x49\x85\xa2\x63\x18\x82\xfe\xfb\xc5\x50\x94\x44\xf9\x23\x4d\x3f\xde\x6a\x71\x78\x66\xe6\xcc\xe1\xcc\x34\x55\x35\x1d\xfb\xef\x64\xb1\x57\x7c\xb5\xd6\xf0\x66\xf6\xfa\xdf\xaf\x0a\x85\x25\x0a\x0d\xef\x59\x82\x77\x52\xde\xc3\xa5\x48\x62\x58\xe4\x39\x18\xa3\x12\xe8\x5c\x3d\x62\x1a\xfb\x5f\xd6\xbc\x84\x52\x6e\x55\x82\x90\xc8\x14\x81\x97\x90\xf3\x04\x45\x89\x29\x6c\x45\x8a\x0a\xf4\x1a\x61\x51\xb0\x64\x8d\xf0\x26\x9e\xb5\xa7\x90\xc9\xad\x48\x7d\x2e\xcc\xf9\x87\xcb\x77\xcb\xab\xcf\x4b\xc8\x78\x8e\x60\xbf\x29\x29\x35\xa4\x5c\x61\xa2\xa5\xda\x83\xcc\x40\x3b\xce\xb4\x42\x8c\xfd\xf1\xb4\xae\x7d\xbf\xaa\x20\xc5\x8c\x0b\x84\x60\xa5\x58\xb1\x7e\xc8\xa7\xbb\x35\x2a\xfc\xc6\x45\xb1\xd5\x01\x58\x9b\x51\x71\xbf\x82\x8b\x39\xdc\xb1\x12\x61\x14\xbf\x93\x22\xe3\xab\xf8\x9a\x25\xf7\x6c\x85\x64\x54\x55\xa0\x71\x53\xe4\x4c\x23\x04\x6b\x64\x29\xaa\x00\x46\x74\xe2\xf3\x4d\x21\x95\x86\xd0\xf7\x02\x54\x4a\xaa\x32\xf0\xbd\x20\xdb\xe8\xc0\xf7\xbd\xa0\xaa\x4e\xc1\x4d\x0b\x85\x29\x4f\x98\xc6\xc0\xf7\xaa\xea\x15\x28\x26\x56\x08\x23\x41\x31\x8c\xe2\xff\x7d\xfa\x70\x25\x53\x2c\x09\xde\x6b\x30\xc4\x09\x90\xe6\x7b\xff\xc1\x62\xa1\x48\xcd\x45\xc2\xdd\x71\xbd\x6e\x00\x2f\xff\xfb\x65\x5f\x60\x7c\x7d\xbf\xba\x66\x7a\xdd\x23\xc7\x47\x17\x23\xc3\xc8\xf9\x88\xc8\xad\x61\x8f\x8e\x0a\xc5\x85\xa6\x30\xae\xd8\x06\x21\xf8\x4a\xdc\x5e\x76\xd4\x92\x29\x2a\xb5\xdc\x14\x7a\xdf\x5b\x07\x4b\xfb\x29\x68\x81\x88\xc6\xe9\x14\x7a\xe4\xba\x06\x85\x56\x6b\x25\x30\x30\x35\x83\xe6\x2c\x93\x8a\xc4\xa0\x51\x71\xb1\x32\x77\xac\xf7\xba\x86\x87\x2d\x2a\x8e\x65\xec\x4f\xa7\x70\xa9\x61\xc3\x4d\x41\x8c\x66\x3a\xca\xcb\x46\x2f\x08\x87\xfc\x41\xd1\xfc\x33\xf6\xf5\xbe\xc0\x61\x34\xa5\x56\xdb\x44\x43\xe5\x7b\x57\x52\xc3\x78\x70\xf6\xfd\xef\x52\x8a\x8b\x40\x48\x3d\x91\x1b\x4e\x32\xd1\xfb\xe0\xbb\xef\x7d\x54\x00\x37\xb7\x27\x6d\xa5\x1a\x9a\x2e\x44\x7a\xce\x94\x89\x74\x68\xdb\x17\x27\x23\x4e\x47\x82\xca\x6b\x88\x7f\xcf\x31\x4f\xad\x6a\xa6\x53\xa0\xf2\x8e\xb2\x96\x9b\x00\x32\x3a\x76\x78\x88\x7d\xcf\x15\x9f\x2c\x0e\xe0\x3e\x16\x25\xf9\x30\x70\xc6\x70\x24\x08\x8a\x8c\xb2\xf8\xb3\x21\xc4\x78\x84\xba\x26\x4f\x9a\x99\x27\x94\xb0\x0d\xe6\x8e\xdf\xf6\x32\xcf\x40\x18\x2f\x56\x2a\xcb\x4f\x81\xbd\x68\x50\x7b\x29\xb1\x8d\x63\xd6\x43\x77\x06\xf4\x63\x78\xde\x2a\xbe\x73\x64\x8e\x79\xce\x52\x9e\xd8\x03\xaf\x73\x54\xd7\x70\x27\x65\xde\xd2\xdb\xc2\xd7\xf5\x90\xe5\x06\x0b\xf3\x12\x5b\xc0\xbf\x98\xe2\xe7\x10\x6f\x6e\xe9\x57\x16\xd3\x1b\x73\x6a\xf7\x0c\xf0\x13\x60\xe3\x5f\xc1\x6a\x29\x70\x7f\x38\xd4\x38\x75\xc6\xbe\xcc\xcb\x74\x85\x07\x7a\xc1\x96\xd7\x00\x30\x5d\xb9\xcf\x86\xe4\xf2\x7f\x56\x36\x46\xc3\xf2\xc3\xd8\xa5\x74\x6d\x8c\x0a\x56\x26\x2c\x77\x00\x0f\xc2\x3e\x03\xf5\x95\x3a\x96\x7d\x0a\x68\x38\x68\xef\xf7\xbd\xe5\x07\x8e\x08\x62\xe8\xcc\x21\xa5\xef\x34\x5d\x63\xaa\x6b\x1a\x4d\x0a\xf5\x56\x09\x4c\x69\xc6\x24\x34\x06\xda\x16\xd1\x3d\x48\x5e\x82\x79\xdb\xb1\xff\xc8\xd4\x11\xc4\x1c\x9a\xee\x1f\x5f\xe1\x2e\x0c\xda\x99\x52\xd7\x17\xcd\xa5\x9e\xc8\x01\x68\x10\x99\x78\xde\x9b\x7e\x06\xac\x28\x72\x8e\xe5\xb1\xeb\xa6\xdf\x81\x14\xdd\x91\x88\x3f\x6d\x51\xed\x6d\xca\x70\xb7\xe5\x79\x8a\xca\x34\xbe\x05\x08\x9e\x83\x54\xc0\x84\xf5\xdd\xa4\x90\x23\x7b\xb4\xe0\xd4\x27\xf7\xed\x25\x60\x25\xf0\x32\xf6\xb3\xad\x48\x20\xe4\xc3\xee\x16\xd9\xd8\xc2\x07\x18\x1f\xfb\x8d\x20\x3c\xf1\x75\xd2\x50\x11\x51\xbb\xe4\x19\x70\x98\xcf\x4d\x48\x95\xef\x79\x0d\xcd\xf0\x30\xa1\x2f\xbe\x57\xfb\x5e\x61\xcc\x49\x94\x3c\xbe\x0e\x23\x73\x85\x3e\xbc\xe8\x2f\xd9\x2f\xf3\xf9\x11\xeb\x74\x7a\x88\xe9\xd5\xbd\x1f\xc1\x73\x03\x6f\x3c\xb5\x76\xb1\x91\x52\x58\x44\x4d\x10\x8d\x24\xae\xad\x02\x68\xdc\xf4\xb5\x7a\x6a\xd8\xa0\xd0\x5c\xb7\xd3\x66\x21\xc8\x8d\x54\x43\x29\x35\x83\xa6\xe1\xb2\x95\x0f\x55\x86\x8b\x47\x96\xf3\xf4\x2c\xe7\xd7\x61\x04\x61\x17\x45\x3c\xf0\xeb\xb2\x4b\x42\xec\xcc\x4a\xb8\xb9\x3d\x73\xc7\x90\xca\x63\x9a\x5d\x0e\xad\x2e\xf3\x57\x52\x37\xec\x9f\xa0\x7f\x40\x66\xb6\xd1\xf1\x92\x32\xcd\xc2\xe0\x5f\xbb\x0b\x3b\x55\x5e\x0a\xa9\x5f\x06\x06\x2f\xb2\x15\x70\xe2\x9a\x93\xb2\x51\xa4\x7d\x4a\xe5\xe4\x68\xfc\x52\x74\x61\x11\x45\xbe\x37\xec\x57\xcd\x5c\xca\x79\xa9\x21\xf8\xa8\x02\x08\x16\x22\xa5\xd1\xe1\x7b\xe5\x8e\xeb\x64\x0d\x66\x6b\xca\x51\x84\xdc\x10\x25\x0b\xa2\xf0\x3f\x14\xb9\x79\xc7\x82\xe4\xf7\xfa\xe2\x20\xdf\xce\xf2\x66\x76\xfb\x5b\x89\x57\x15\xe4\x72\x87\xca\xc2\xfd\x24\x09\x45\xd4\x45\xf9\xb6\x09\xf2\x00\x8f\x72\xdb\xb0\x7b\x0c\xcf\xd6\x76\x02\xb3\x09\x08\x62\x9d\xc4\xfa\x6d\x02\x3b\xba\xd3\x34\x7b\x27\xcf\x26\xa1\x9e\x82\x9d\xcd\xfa\x54\xda\xbf\x97\xb7\x67\x47\xf1\x30\x8f\x2e\xfd\x83\x83\x09\x14\xbf\xac\x98\x2e\xb9\x43\xd0\x38\x8e\x1d\x21\x9d\x18\x82\x4f\xad\x4e\x7f\x70\x27\x32\xcf\xbb\x5f\x93\x87\x26\xce\x12\xf3\x07\x96\xa4\xe7\x2c\x41\xa6\x09\xb8\xab\x86\xd1\xc4\x2f\x12\x6f\x72\xab\xeb\x90\x88\xee\x8b\xfe\xf4\xce\xc4\x33\xe7\xa1\xda\x20\x22\x78\x0b\xb3\x3f\x12\xc9\x00\xd6\x4a\xe0\x28\xb2\x73\x54\x0c\xf4\xff\x7b\x71\x8c\x07\xc8\x47\x51\xb4\x85\x3a\x94\xe6\x09\x99\x9e\xde\xd5\x4c\x19\xcf\xad\x62\x6e\x1e\x46\xbd\x47\xa1\x9e\xb9\xd9\x75\x83\x17\xe3\xf3\xe8\x4d\x99\x0a\x38\x01\xdb\xb4\xef\xee\xfd\xff\x88\xc1\xee\xd5\x77\x9a\x38\xe3\x93\xd6\x39\x47\x23\xe6\xbf\xb4\xe7\x9a\xe2\x70\x63\x34\x9d\xf1\xc7\xd8\x14\xc8\xc9\xde\xf9\xc4\xa5\x96\x88\xe3\x76\x7a\xb2\x9f\x3e\xa7\xa1\x3e\xb1\xcd\xba\xcd\xd5\x70\x66\x69\xe8\x88\xa5\x5f\x13\xf8\x09\xf6\x9f\x2b\x0a\x22\xdf\xa0\xb7\xcf\xe9\x40\xab\x76\x04\x13\xc9\x3d\x7c\xd4\xcd\xde\xd9\xc5\xc1\x2a\x76\xb0\xbf\x59\xb3\xd7\x8e\x59\x0f\x73\x33\xbb\xb5\x1b\x5d\x8a\x19\xdb\xe6\xda\xb1\x3a\x8a\x7f\x31\x48\x90\xa2\xb5\x77\x6b\xdf\xfc\xc9\xc2\x06\x5c\x55\x80\x22\x85\xba\xf6\xff\x19\x00\xb3\xd0\x3d\x1a\xde\x12\x00\x00")
+
+func templateGraphqlWhere_inputTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateGraphqlWhere_inputTmpl,
+		"template/graphql/where_input.tmpl",
+	)
+}
+
+func templateGraphqlWhere_inputTmpl() (*asset, error) {
+	bytes, err := templateGraphqlWhere_inputTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/graphql/where_input.tmpl", size: 4830, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateGrpcGenerateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8f\x4f\x8b\xdb\x30\x14\xc4\xcf\xd5\xa7\x18\xcc\x1e\xda\x05\xcb\xdb\xbd\xb5\x90\x43\x08\x69\x09\x94\x12\x68\xef\x41\x91\x9e\x6d\x11\x57\x4f\x48\xcf\x69\x83\xf0\x77\x2f\xce\x1f\xf0\xa1\xdd\xdb\x63\x7e\xc3\xbc\x99\x52\x9a\x67\xb5\xe1\x78\x49\xbe\xeb\x05\xaf\x2f\x1f\x3f\xd5\x31\x51\xa6\x20\xf8\x62\x2c\x1d\x99\x4f\xd8\x05\xab\xb1\x1e\x06\x5c\x4d\x19\x33\x4f\x67\x72\x5a\xfd\xec\x7d\x46\xe6\x31\x59\x82\x65\x47\xf0\x19\x83\xb7\x14\x32\x39\x8c\xc1\x51\x82\xf4\x84\x75\x34\xb6\x27\xbc\xea\x97\x07\x45\xcb\x63\x70\xca\x87\x2b\xff\xb6\xdb\x6c\xbf\xff\xd8\xa2\xf5\x03\xe1\xae\x25\x66\x81\xf3\x89\xac\x70\xba\x80\x5b\xc8\xe2\x99\x24\x22\xad\x9e\x9b\x69\x52\xaa\x14\x38\x6a\x7d\x20\x54\x5d\x8a\xb6\xe9\x28\x50\x32\x42\x15\x6e\xb4\xc6\x6f\x2f\x3d\xe8\x8f\x50\x70\x78\x42\xb5\x37\xf6\x64\x3a\xaa\xf0\xfe\x68\x32\xe1\x49\xef\x13\x0b\x7f\xe5\xbb\xfe\x01\xf5\x34\xa9\x77\xa5\x40\xe8\x57\x1c\x8c\x10\xaa\x9e\x8c\xa3\x54\x41\xcf\x99\xa5\x60\x4e\x9a\xd3\x9b\xa6\xe3\xcf\x8f\x87\x88\x73\x8e\x45\xbd\x5b\x69\x8d\xba\xee\xf8\xc0\xa3\x3c\xee\x7a\x2e\xb7\x14\x0e\x1c\x65\x15\x8d\xf4\x79\x75\x5b\x75\x48\x34\x18\xf1\x67\x5a\xfa\xff\xeb\x29\x05\xff\xac\x8f\x69\x6a\xde\x60\xfa\x5a\x52\x2d\x46\xfc\x1d\x00\x93\xac\xb6\x94\x04\x02\x00\x00")
+
+func templateGrpcGenerateTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateGrpcGenerateTmpl,
+		"template/grpc/generate.tmpl",
+	)
+}
+
+func templateGrpcGenerateTmpl() (*asset, error) {
+	bytes, err := templateGrpcGenerateTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/grpc/generate.tmpl", size: 516, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateGrpcProtoTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x94\xcd\x6e\xe3\x36\x14\x85\xf7\x7c\x8a\x03\x43\x0b\x67\x50\xd3\xc9\x74\xd5\x0a\x5e\x0c\x6c\x25\x10\xd0\x3a\x41\x93\x74\x3b\x90\xa5\x2b\x99\x88\x4c\x6a\x48\xca\xad\x21\xe8\xdd\x0b\xd2\xb4\x2d\xbb\xe3\xa4\x45\xbb\x13\x79\xee\xcf\xb9\x9f\x48\x76\xdd\xf4\x13\x9b\xab\x66\xa7\x45\xb5\xb6\xf8\x7c\x7b\xf7\xd3\xa4\xd1\x64\x48\x5a\xdc\x67\x39\xad\x94\x7a\x43\x2a\x73\x8e\x2f\x75\x0d\x1f\x64\xe0\x74\xbd\xa5\x82\xb3\x97\xb5\x30\x30\xaa\xd5\x39\x21\x57\x05\x41\x18\xd4\x22\x27\x69\xa8\x40\x2b\x0b\xd2\xb0\x6b\xc2\x97\x26\xcb\xd7\x84\xcf\xfc\xf6\xa0\xa2\x54\xad\x2c\x98\x90\x5e\xff\x25\x9d\x27\xcb\xe7\x04\xa5\xa8\x09\x61\x4f\x2b\x65\x51\x08\x4d\xb9\x55\x7a\x07\x55\xc2\x0e\x9a\x59\x4d\xc4\xd9\xa7\x69\xdf\x33\xd6\x75\x13\x14\x54\x0a\x49\x18\x55\xba\xc9\xa7\x8d\x56\x56\x8d\x30\xe9\x7b\x36\x9d\x62\xee\x7c\x55\x24\x49\x67\x96\x0a\xac\x76\x20\x69\xf3\x1f\xb0\x78\xc4\xf2\xf1\x05\xc9\x22\x7d\xe1\x8c\x99\x9d\xb4\xd9\x9f\x98\x61\xe4\xb3\x7f\x1c\xc5\x8c\x35\x59\xfe\x96\x55\x84\xae\xc3\x2a\x33\x84\x88\x3f\x39\xed\x41\x3d\x05\xa1\xef\x63\xc6\x54\x63\x85\x92\xa8\xd4\xd7\x43\xfc\x0c\xa3\xae\xfb\x5e\xf4\x28\xf6\x66\xff\x10\x76\x7d\x90\xd3\x4d\xa3\xb4\x35\xe8\x7b\xd6\x75\xd0\x99\xac\x08\x91\xc0\xcf\x33\x70\xb7\x27\xbc\xbc\xaf\x27\x4e\x15\x48\x16\x4e\xbd\xf8\x0c\xd9\xd2\x65\x87\xf2\xbf\x92\x31\x59\x45\xbe\x3e\xdb\xec\x17\x6e\x9e\x48\xee\xf5\x65\xb6\x71\xc6\xd0\x31\x0c\xb7\x5f\x76\x8d\x2b\xc4\xd3\x85\x13\x45\x81\x19\xee\x62\x1f\x72\xec\x52\xfa\x2e\x21\xfe\x5e\x50\x5d\xf8\x26\x2e\x06\xa2\x3c\x2a\x8f\x9e\x4e\x56\x23\x2a\xd1\xf7\xea\xb0\xea\xba\x60\xfc\x6f\x4d\x5d\x98\xab\x61\x64\xf6\xe6\x96\xfc\x60\x71\xe6\x76\xa3\x92\x27\xd2\xfa\x70\xee\x9b\xfa\x7f\x00\x0c\x48\x9c\xb9\xa4\xa1\xcb\xa4\xa8\xe8\xcc\xa4\x54\x16\x11\xf1\x57\x29\xbe\xb5\x0e\x83\xa6\x86\xfc\x21\x39\xb3\x47\xdc\xe1\xb8\xe0\x75\x34\x48\x17\x06\xe9\xdf\x19\x7c\x07\xe3\xc4\x73\x2c\x79\x6a\x12\xd9\x6e\x1c\x5c\x06\x90\xfb\xbc\x02\x8d\x01\xae\x11\xda\xa6\x21\x8d\xf1\x19\xc1\x1b\xf4\xfd\xd7\xd7\xe5\xf3\x53\x32\x4f\xef\xd3\x64\x81\x19\x6e\xe3\x90\x70\x34\xb3\x3d\xa3\x25\xdb\xcd\xef\x59\xdd\x92\x71\x2e\xbd\x27\x17\x8c\x68\xbb\xd7\x4f\x23\x6f\xf9\xb2\xdd\xac\x48\x87\x51\x2f\x86\xed\xd9\xc5\xc6\x60\xf1\x4f\x8e\xf0\x33\xe9\xad\xc8\x29\x5c\x91\x09\x22\xe9\x78\x0f\x8c\x06\xfe\x3e\x3f\x2a\x1d\x42\x37\x46\x98\x5e\x06\xf1\x78\xf8\xe7\xda\xfd\xe2\xae\x3b\x4a\xbf\xd1\xb7\x96\x8c\x3d\x5d\x81\xd3\x2f\x0e\xd5\xfc\xa0\x77\x31\x1b\x54\x79\x20\xfb\x5e\x89\xf7\x6e\xd1\xa0\xca\x6b\x53\xfc\x0f\x5e\x16\x54\x93\xa5\xff\x60\xc7\xbd\xe6\x22\x0f\xef\xc2\xde\x48\x80\xee\x4b\xe8\x26\x0f\xd4\xc6\xd7\xe0\xdd\x40\x93\x6d\xb5\x34\x18\x0f\xc4\x9b\x38\x64\x3f\x90\x1d\x7f\x97\xd8\x07\x79\x7b\x3e\xe3\x6b\x98\x3e\xc8\xde\x73\x19\x5f\xc3\x33\xc8\xae\x94\xaa\x6a\xe2\xfe\xe5\x5f\xb5\x25\x4f\x36\x8d\xdd\xdd\xc4\x97\xc7\x13\x24\x0b\xf4\x3d\xfb\x6b\x00\x4c\x42\x74\xb6\x35\x07\x00\x00")
+
+func templateGrpcProtoTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateGrpcProtoTmpl,
+		"template/grpc/proto.tmpl",
+	)
+}
+
+func templateGrpcProtoTmpl() (*asset, error) {
+	bytes, err := templateGrpcProtoTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/grpc/proto.tmpl", size: 1845, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateGrpcServiceTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x59\x5f\x6f\xe3\x36\x12\x7f\x96\x3e\xc5\x54\x30\x16\x52\x90\xa5\x7b\x7d\xbb\x2d\xf6\x61\x91\x38\x39\x03\x6d\x36\xd7\x6c\xda\xc7\x85\x22\x8d\x6c\x22\x12\xa9\x90\x94\x13\x43\xd0\x77\x3f\x0c\x45\xd9\x92\x2d\x3b\xce\x76\xf7\xd0\xa7\x58\xd4\xcc\xf0\xc7\xf9\xf7\x1b\x31\x75\x3d\x3d\xf3\x2f\x64\xb9\x56\x7c\xb1\x34\xf0\xcb\xcf\xff\xfa\xf7\xfb\x52\xa1\x46\x61\xe0\x2a\x4e\xf0\x41\xca\x47\x98\x8b\x84\xc1\xa7\x3c\x07\x2b\xa4\x81\xde\xab\x15\xa6\xcc\xff\xb2\xe4\x1a\xb4\xac\x54\x82\x90\xc8\x14\x81\x6b\xc8\x79\x82\x42\x63\x0a\x95\x48\x51\x81\x59\x22\x7c\x2a\xe3\x64\x89\xf0\x0b\xfb\xb9\x7b\x0b\x99\xac\x44\xea\x73\x61\xdf\xff\x36\xbf\x98\xdd\xdc\xcd\x20\xe3\x39\x82\x5b\x53\x52\x1a\x48\xb9\xc2\xc4\x48\xb5\x06\x99\x81\xe9\x6d\x66\x14\x22\xf3\xcf\xa6\x4d\xe3\xfb\x75\x0d\x29\x66\x5c\x20\x04\x0b\x55\x26\x53\x02\xc7\x13\x0c\xc0\xbd\x9c\x94\x8f\x0b\xf8\xf0\x11\x1e\x62\x8d\x30\x61\x17\x52\x64\x7c\xc1\x6e\xe3\xe4\x31\x5e\x20\x09\xd5\xf5\x7b\x78\xe6\x66\x09\xf8\x62\x50\xa4\x30\x81\xc0\xbd\x0d\x20\x74\x5a\xb7\x4a\x1a\x79\x2d\xdd\x7a\x04\xef\x9b\xc6\xf7\xea\x1a\x0c\x16\x65\x1e\x1b\x84\x60\x89\x71\x8a\x2a\x00\xd6\x9a\x04\xb2\x44\x08\x78\x51\x4a\x65\x20\xf4\xbd\x20\x91\xc2\xe0\x8b\x09\x7c\xdf\x0b\xea\x7a\x0c\x4b\x40\x46\xdf\x83\x8a\xc5\x02\x61\x22\x08\xb6\xdb\xfb\x77\xd4\x3a\x5e\xa0\x26\xeb\x5e\xab\x2e\xf6\xf5\xa7\xed\xfa\x9e\xc1\x0e\x8c\x17\x2c\xa4\x5c\xe4\xc8\x16\x32\x8f\xc5\x82\x49\xb5\x98\x5a\xa7\x51\xf4\x74\x70\xf8\xbd\x36\xb1\xa9\x0e\x08\x94\xe4\x9b\x87\x2a\x9b\x9a\x75\x89\x7a\xfa\x28\xe4\xb3\x98\x62\x51\x9a\x75\xf9\xf0\x06\x0d\xc3\x0b\xd4\x26\x2e\x4a\xd2\x8a\x6c\xe8\x46\xfc\x70\xd7\x46\x57\x3b\x2f\x4f\x44\x5c\xa0\x7d\x2d\xba\x18\xdd\xd0\x4a\xf7\x68\x1f\x9c\xa8\x5e\x25\x24\x59\x2a\x2e\x8c\x53\x0c\x9c\xb9\xa0\x33\x57\x8c\xd8\x0a\xb5\x88\x1f\x87\x26\x23\x92\x9f\x4e\xa1\x33\xdb\x34\xc0\x8b\x32\xc7\x02\x85\xd1\x36\x7f\xb7\x6f\x68\x0b\x54\xc0\x85\x41\x95\xc5\x09\x42\xa5\xb9\x58\x58\x21\x2a\xb3\x24\xe7\x28\x0c\xf3\xc9\x79\x7d\x7b\xda\xa8\x2a\x31\x50\xfb\x5e\x2b\x01\x67\x5d\x2e\x37\x0d\xbb\xb0\x4b\xbe\x77\x2f\x36\xdb\x62\xba\xbb\xa5\xdf\xf8\xfe\x74\x0a\x37\xf8\xdc\x33\xab\xd0\x54\x4a\x68\x88\x41\xe0\x73\x7f\x3f\xb3\x8c\x0d\xd5\xef\x43\x9c\x3c\x62\x0a\x0f\x6b\x8b\x70\xc1\x57\x28\x36\x18\xb3\x4a\x24\x43\x7b\xe1\x41\x70\x11\x9c\xf5\xac\xd7\xbe\xd7\xee\x0c\xef\xb6\xab\x75\xab\xfc\xc1\xd9\x6f\x1c\xe0\x0b\x85\x54\x51\x27\x3b\xd4\xe1\x0a\xc9\x68\x6f\xcf\xc8\x19\x0a\x13\xf3\x02\xae\xf4\xa8\x62\xa8\x04\xcf\x41\xe1\x13\x9c\xb5\x02\x9b\x34\x6a\x9a\x3f\xf0\xa9\x42\x6d\x22\x08\xcf\x7a\xab\xe7\x80\x4a\x49\x15\x51\x30\x6c\x82\x28\x7c\x62\xd7\x68\x48\xa4\x80\xa6\x09\x23\xdf\xe3\x19\x14\xf0\xf1\x23\x08\x9e\x93\x58\x77\x5a\xc1\xf3\x73\x68\x8b\x87\xcd\xc8\x48\x68\x2b\x8d\xcd\xc5\x2a\xce\x79\xfa\x49\x2d\x2a\xca\x99\x73\x08\x0a\xae\x6d\x5e\xd4\x35\xe4\xf2\x19\xd5\x20\xdf\x28\x40\x45\xdb\x01\x82\xc8\xf7\x1a\xdf\x4b\x2c\x74\x02\xa3\x57\x09\x73\x01\x22\x40\x82\x39\x05\xe6\x8e\x1f\xb5\x3d\xc5\x76\xb8\x89\x60\xf3\x4b\xca\x5d\xcf\xae\xf1\x0c\xd8\xbd\x46\x75\x69\xfb\xa7\x6d\x11\x9e\x47\x47\x71\x6d\x84\xd2\xfd\x3f\xb1\xfe\x33\xce\x2b\x04\x06\x41\xc1\xe6\x69\xe0\xc2\xe9\x79\x0e\x03\xbb\x43\x33\xbf\x0c\x7b\x2a\x5f\xe4\x4c\x98\x56\xfe\x1a\xcd\x3c\x0d\x23\x52\x8a\x48\xa7\xdb\xd9\x35\xa4\xbd\xdf\xae\xe2\xb3\x7e\x19\x5e\x71\xcc\x53\xd7\xf8\x48\x7e\x92\xd1\xc2\x91\x42\xcd\xd8\xa6\x46\x37\xe7\x94\x0a\xc2\x4e\xfc\x96\x18\x4c\x24\x24\x19\xc1\x24\x63\x9f\x4b\xc3\xa5\x88\x73\xfa\x7d\x89\x59\x5c\xe5\xe6\xb8\x2b\x26\x19\x84\x6d\x1b\x09\x0a\x16\x38\x40\xd1\x9e\x63\x48\x35\x63\xbf\x57\x26\x26\xfb\x77\x48\x56\xf7\xfd\x34\x30\x76\x8d\xa6\xb3\x07\x41\x18\x05\xd1\x9e\xe3\x72\x8d\x0e\xdc\xf7\xdf\xe5\x84\xc8\x0c\x9a\xed\x2c\xdd\x30\x12\x89\x4c\x30\x5d\xe0\x91\xb8\xe0\x48\x5c\x26\xc8\xee\x05\x7f\xaa\xba\x43\x51\x1d\xd9\x23\x59\x5b\x4d\x03\x3f\x6d\x6b\x6a\x70\x64\x1c\x3b\x32\xb2\x2f\xeb\x12\x07\xe7\x76\x4b\xf3\xcb\xde\xf9\x03\x67\x3e\xd8\xe4\xe7\x31\x3f\x67\x52\xc1\xd7\x73\xb0\x6c\xdc\x7a\x61\x88\xf0\x08\xb4\x4f\x69\x7a\x12\xb4\x40\xbc\xa9\x52\xd0\x76\x24\xc2\xe3\x76\xbd\x8b\x57\xb6\xcf\xb5\x8d\x88\xde\xfd\x34\xde\x8a\x2c\xf3\xb6\x8d\x08\x95\x6a\x5b\x89\x7b\x4f\x75\x21\x8d\xec\xf5\xbd\x10\xa3\x73\xb2\xe2\xfa\xf2\x35\x9a\x37\x34\x65\xf8\xb2\x44\xa2\x1f\xf2\x92\x6e\xe7\x37\x3c\xdc\xdc\x62\x85\xd0\xe2\xc0\xb4\x1d\xc6\xcc\x12\xb9\x82\xf9\xa5\x3e\xd8\xde\xaf\xd1\x1c\xe9\xed\xae\x3f\x9f\xdc\xd8\xb7\x3e\x3d\xd8\x50\xff\x5b\xa1\x5a\x87\x11\xf3\x3d\xef\xaf\x25\x2a\x0c\x77\xa7\x2d\x36\xd6\x07\xdb\x9e\x1b\x38\xce\xd8\xc4\xd8\x9a\x39\xa1\xb0\xbc\xbf\xb8\x59\xd2\x46\xc8\xee\xec\x48\x60\xdb\x21\x45\x27\x62\x3b\x29\xe2\x7d\x16\xf9\xfa\x87\xa7\xc1\x7d\x99\xbe\x8d\x9e\xa1\x3d\x8d\x1d\x30\x62\x65\x93\x42\x48\x03\x9a\xd2\xa9\x1d\xf6\x1d\xb5\xd9\x2c\x48\x72\x8c\x15\xa6\xe7\x50\x89\x1c\x35\xa9\xe1\xda\xbe\x50\xf8\x54\x71\x85\xe9\xc1\x7c\x68\x81\x1d\x49\x89\x56\xe0\x4d\x59\xf1\x4f\xa1\xfb\xaa\x4c\x5f\xa3\xfb\xf6\x74\x9f\x05\x1e\x49\xc2\x5d\x42\x3e\x95\x72\x79\x66\x43\x36\xc9\xd8\xbc\x28\x2a\x13\x3f\xe4\x5d\x73\x7c\x3b\x21\x6f\x3a\xff\x3e\x1b\x3b\x81\x6f\xa5\x5d\xe7\xa5\xef\xca\xbb\x5e\x53\xd7\x16\x6d\x6f\x4c\x68\x9a\x96\x1e\x86\xbb\x5e\x50\xe2\xd2\x2e\xd9\x5e\xa1\x6e\x0c\x6d\x2a\x75\x97\x63\x7e\x04\xf4\x41\x6b\xe8\x3f\xec\xfe\x7e\xa5\x03\xf5\x13\x00\xc7\x13\xe0\x2d\xc4\x7f\x88\xf9\x5f\xa1\xfe\xbe\x87\x7e\x10\xf7\x6f\x62\x8d\xaf\xc5\x7a\x08\xc2\x46\xfe\x1b\x02\x7d\xd0\xc2\x69\x13\xc7\xb8\xa9\xbf\x31\x72\x78\x5b\xc0\x1d\xfc\x43\x69\xb3\x65\x4b\x07\xe2\xff\x32\x81\x5c\x62\x8e\xdf\xe5\xcb\xb0\x35\x74\x84\x2a\x5a\x81\x71\xaa\x70\x57\x1b\x6c\x46\x7f\xfb\x64\xc1\xb3\x57\x47\x88\xd6\xee\xf1\x26\xbd\x3b\x29\xb0\xd9\x0b\x26\x84\x35\xfa\xf5\x1b\x1d\xfb\x6e\x00\xb9\x6e\x3a\x9f\x6e\x13\xf5\xc0\x6d\x4b\xff\xd6\x69\xe3\x8c\xfd\x3a\xef\x1e\xdd\x29\x89\xe1\xf7\x63\x49\x9e\x5e\xa1\x72\x41\x6b\x2f\x16\x7a\xb7\x06\x03\x3f\x81\x91\xc0\x8d\x86\xee\xa2\xa8\x63\x43\x46\xa6\x7f\x93\x71\x8a\xa9\x1b\x2c\x69\x32\x70\x86\x31\x25\x35\x27\xe9\xe6\x8d\xa5\xcc\x53\x90\x22\x5f\xef\xcd\x93\x63\xc9\x06\x67\x87\x00\xb9\xdb\x8c\xee\x28\xb5\xef\xad\xc8\x4d\xef\x7a\x8b\x14\x8f\x79\xfa\xa1\xcf\x5c\x57\x4a\x16\xbd\xc0\x22\x9b\x5f\x52\xb1\x9d\xfb\x5e\xbf\xef\x1e\x64\xde\x1d\xea\xbd\xe1\x79\xde\x6b\xbc\x5e\x5d\xef\xc6\x61\x9f\x6f\xc7\xe1\x6c\xe9\x03\x59\xb0\x43\x58\x91\x03\x38\x28\xf7\xc1\x43\xe3\x9f\x84\xbe\x6b\xf3\x7b\xc0\xa9\x4c\xd8\x18\x51\xf6\x33\x7b\xab\xef\x4c\x6f\x3a\xf2\x76\x4c\xf0\x5e\xc8\x73\xc7\x0f\x78\x36\x7e\x42\xf2\xae\xe7\xad\xd8\x29\x3e\x84\x8f\xf0\xee\xc5\xf7\x46\x7a\xf9\xe9\x06\xbe\x1d\xe4\x20\x0c\x47\xbf\x0a\x7b\x41\x39\x46\xe5\x6f\xfe\x4a\x9f\x14\xda\x5e\xa0\x0f\xf8\xc4\x29\x0d\xd6\x5c\xad\xf8\x07\x29\x9e\xa6\x08\xb2\x84\xcc\x8e\x17\x8e\xb9\x7a\xa7\x86\xa6\xf9\x15\xc4\x30\x0f\x56\x03\xea\x73\x35\x47\x90\x9a\xa6\xee\xea\xad\x8f\x62\xe3\x5f\xb7\x48\x2d\x55\xb8\xca\x1b\xfa\xf0\xd8\x27\xfe\x31\x88\x07\x80\xc5\x65\x89\x22\x0d\x07\xcb\xe7\x7f\x0f\xee\xab\x37\x01\xae\xc1\xaf\x88\x20\x4f\x28\xca\x2e\x2e\x19\x9b\xeb\x99\xa8\x8a\x36\x2e\x84\x10\xe9\x69\xf7\x76\xfc\x6b\xb0\xbd\x34\x23\x70\xf6\xc2\x6c\xa3\x62\xb9\x75\x47\x25\x2c\x63\x9d\xc4\x79\x3f\xfb\x7d\xdf\xf3\x86\x84\x60\x15\x0f\x13\x42\x66\xbd\x33\xc6\x02\x16\xe4\x8a\x6e\x23\xe9\xd3\x77\xb7\x8d\x3b\xb3\xe1\x6a\x68\x25\x82\xcd\xf9\xba\xd0\xe9\x67\x6e\x92\x25\xac\xda\xa7\x9e\xdf\x56\x7d\xbf\x91\x83\xec\x87\x87\xde\x7e\x9a\x24\xf4\xef\xa0\xbd\xcf\x7e\x5a\x58\x75\x54\xf1\x81\xe4\x3a\xe6\xed\xf1\xc3\x57\xfa\xbd\x6a\x9d\xe9\xac\xf5\x23\xe9\x79\x69\x7b\xf5\x78\x4c\xbf\x2a\x4b\x54\x23\x1d\xe6\xeb\xfd\xcd\xdd\xed\xec\x62\x7e\x35\x9f\x5d\x76\x29\xb3\x75\xfc\x4c\x98\x57\xdc\x3e\xe2\xe1\xce\xf9\x03\x5f\x92\xdb\xa7\x53\xba\xda\x81\x4a\xe8\x12\x13\x9e\x71\x4c\x9d\x02\xd7\x9d\xe9\x96\x89\x63\x01\x76\xee\x80\x30\x16\x29\xf0\xf6\x13\x38\xda\x8b\xdf\x10\x5d\xb8\xea\x87\x2b\x1a\x6e\xff\xfd\xa2\x37\x1a\x92\x7d\xcf\x1f\x0c\xf2\x69\xd1\x0b\x82\x6d\x30\xfa\xe2\x83\x9f\xdd\x2f\x1a\x6d\xb6\x33\xdc\x58\x94\xe8\xdf\x45\x76\xda\xb4\xde\x85\xc5\x1f\xb7\x17\xee\xb2\x81\xe6\x42\xa9\xdc\x64\x33\x1c\x04\xbb\xf9\xd4\xfe\x21\x97\x39\xf7\xd5\xfe\xd6\x1d\x6e\xe2\x99\xeb\x1b\x69\xae\xe8\x3f\xb3\xa4\x18\x7d\xd8\x0e\x98\x23\x57\x1a\x9d\xa8\x1d\x80\xdd\x9b\x28\x1a\x33\xfa\x27\xc5\xdd\x7e\x8a\x6f\xa7\xd3\xe3\xb6\xf7\xae\x4b\x5e\xdb\xe2\x42\x0a\x6d\x54\xcc\x85\x39\x75\x8b\xab\x98\xe7\x98\xde\x2a\x4c\xa4\x48\x39\x81\xdb\xdd\xa5\x17\xd0\x63\x48\x0d\x2a\x11\xe7\xbb\xca\xcd\x60\xba\xfe\xdf\x00\x80\x0e\x77\xcd\x67\x1f\x00\x00")
+
+func templateGrpcServiceTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateGrpcServiceTmpl,
+		"template/grpc/service.tmpl",
+	)
+}
+
+func templateGrpcServiceTmpl() (*asset, error) {
+	bytes, err := templateGrpcServiceTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/grpc/service.tmpl", size: 8039, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateHeaderTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8e\xd1\x8a\xe2\x30\x14\x86\xaf\xb7\x4f\xf1\x23\xbd\x92\xdd\xd4\xf5\x6e\x17\xbc\x90\xaa\xac\xb0\xe8\x80\xbe\x40\x4c\xfe\xb6\xc1\x92\x94\x24\xce\x20\x21\xef\x3e\xd8\xa9\x30\x33\x57\x81\xef\x3b\x39\xdf\x49\xa9\x9a\x17\xb5\x1b\xee\xde\xb4\x5d\xc4\x72\xf1\xfb\xcf\xaf\xc1\x33\xd0\x46\xec\xa4\xe2\xc5\xb9\x2b\xf6\x56\x09\xac\xfb\x1e\xe3\x50\xc0\xc3\xfb\x57\x6a\x51\x9c\x3b\x13\x10\xdc\xcd\x2b\x42\x39\x4d\x98\x80\xde\x28\xda\x40\x8d\x9b\xd5\xf4\x88\x1d\xb1\x1e\xa4\xea\x88\xa5\x58\x3c\x2d\x1a\x77\xb3\xba\x30\x76\xf4\xff\xf7\xf5\xf6\x70\xda\xa2\x31\x3d\x31\x31\xef\x5c\x84\x36\x9e\x2a\x3a\x7f\x87\x6b\x10\x3f\xc5\xa2\x27\x45\x31\xaf\x72\x2e\x8a\x94\xa0\xd9\x18\x4b\xcc\x3a\x4a\x4d\x3f\x43\xce\x0f\xfa\x66\x62\x87\x52\xfc\x1b\x21\x72\x4e\x09\xe2\xe3\x61\x1f\x88\x9c\xab\x0a\xf5\xe3\xea\x96\x96\x5e\x46\x6a\x5c\xee\xa0\x8d\xea\x27\x36\x47\x1c\x8e\x67\x6c\x37\xfb\xb3\x48\x09\xb4\x1a\x53\xab\x1c\xae\x2d\xfe\xae\x70\x91\x81\x28\x45\xed\x6c\x63\x5a\xf1\x22\xd5\x55\xb6\x9c\xca\xa6\x41\x27\xc3\xce\xb0\xd7\x28\x31\x3b\x29\x37\x70\xbc\xea\xc7\x73\xc1\x0a\xa5\x18\xf1\xb7\x9f\x53\x68\x98\xe0\x73\xfc\x8b\x7c\x1f\x00\x57\x0c\x81\xe0\xb5\x01\x00\x00")
 
 func templateHeaderTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1012,7 +1267,27 @@ func templateHeaderTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateHookTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\xdd\x8f\xdb\xb8\x11\x7f\xb6\xff\x8a\x89\xe0\x00\x52\xaa\xa5\x73\xf7\xd6\x06\x29\xb0\xf0\x25\xc8\x02\xd7\x75\x71\xcd\xf5\xe5\x70\xc8\x31\xe2\xc8\x66\x2d\x93\x02\x49\xef\x07\x1c\xfd\xef\xc5\x90\x94\x44\x5b\x89\x6f\x2f\x4d\x71\x2f\xbb\xf6\xcc\x70\x3e\x7e\xf3\xc1\xa1\x8f\xc7\xe5\x8b\xf9\x4a\xb7\x8f\x46\x6e\xb6\x0e\xbe\x7f\xf9\xdd\x5f\xaf\x5a\x83\x16\x95\x83\xb7\xbc\xc2\x8f\x5a\xef\xe0\x46\x55\x0c\xae\x9b\x06\xbc\x90\x05\xe2\x9b\x3b\x14\x6c\xfe\x7e\x2b\x2d\x58\x7d\x30\x15\x42\xa5\x05\x82\xb4\xd0\xc8\x0a\x95\x45\x01\x07\x25\xd0\x80\xdb\x22\x5c\xb7\xbc\xda\x22\x7c\xcf\x5e\xf6\x5c\xa8\xf5\x41\x89\xb9\x54\x9e\xff\xe3\xcd\xea\xcd\xed\xbf\xde\x40\x2d\x1b\x84\x48\x33\x5a\x3b\x10\xd2\x60\xe5\xb4\x79\x04\x5d\x83\x4b\x8c\x39\x83\xc8\xe6\x2f\x96\x5d\x37\x9f\x1f\x8f\x20\xb0\x96\x0a\x21\xdb\x6a\xbd\xcb\x20\x12\xef\xa5\xdb\x02\x3e\x38\x54\x02\x16\x90\xfd\x93\x57\x3b\xbe\xc1\x2c\x91\x9a\x1d\x8f\xe0\x70\xdf\x36\xdc\xd1\x61\xe4\x02\x4d\x06\x8c\x58\xc7\x23\xd0\x39\x52\x25\xf7\xad\x36\x0e\xb2\xe3\x11\x16\x6c\xa5\x55\x2d\x37\x2c\x2a\x83\xae\xcb\xbc\xad\x45\xbb\xdb\xc0\xdf\x5e\xc3\x47\x6e\xf1\x73\x52\x5e\xc8\x70\xb5\x41\x58\x28\x12\x5c\xb0\x5b\x2d\xd0\xf6\x5e\x2c\x14\xdf\x23\xd1\x5b\x23\x95\x83\x85\x62\xb7\x44\xc8\xde\x1e\x54\x35\xb8\xba\x70\x8f\xed\x28\x54\x43\xf6\xe2\xb9\x65\xcf\x6d\x16\xac\x2f\x14\xfb\xc7\xc1\x71\x27\xb5\xf2\x67\xc9\xe8\x6c\xb9\x84\xf7\x5b\x84\xc1\x42\xd7\x81\x57\x22\x2d\x70\x05\x5c\xf0\xd6\x51\x8e\x34\xf0\xa6\xd1\xf7\x1e\xf8\x83\x45\x42\x5b\x1b\x21\x15\x37\x8f\x5e\x47\x7d\x50\x15\x29\x06\x6e\x83\x2e\x16\x4d\xc0\x9e\x4c\x6a\xc3\xe6\x33\xaf\x37\x35\x44\x87\xf2\x4a\x2b\x87\x0f\x8e\x10\xa1\xff\x25\x0c\x71\x74\x5d\x01\x79\x0f\x5d\xd7\xb1\x7f\xf3\xe6\x80\x25\xa0\x31\xda\x14\xc1\x75\x1f\x0f\x42\xc5\x9b\xc6\x42\x9d\x57\xee\xa1\x84\x7d\xc1\xe6\x33\x52\x0d\x79\x9d\x9a\x2b\xa2\x34\x49\xc1\xc4\xea\x1e\x12\x4b\x3d\x4c\x17\xec\xc3\x71\x3e\x9b\xed\xef\x4a\xd0\x3b\x02\x7c\xcf\xf2\xd4\xef\xf9\x6c\x26\x6b\x78\xa6\x77\x5e\x6c\x66\xd0\x1d\x8c\x02\x25\x9b\x12\xea\xbd\x63\x6f\x48\x45\x9d\x67\x07\x85\x0f\x2d\x56\x0e\x45\x80\x89\x00\xf4\x2a\x9e\xbf\x67\x10\x58\x29\x1c\x19\x05\x37\x9f\xcd\xba\xf9\xa0\xb2\x8f\xf9\xae\x98\xcf\x4e\x6a\x72\xb9\x84\x95\x56\x42\x7a\x9d\x94\x4c\xa0\xa2\xa6\xb8\x23\xad\xcf\x18\x9b\x7b\xed\xab\x13\xc6\xe7\xb3\x32\x41\xe7\xa3\xd6\x8d\x37\x75\xad\x04\x6c\x8c\x3e\xb4\x76\xb4\x60\x43\x7f\xf9\xf6\xbe\xfd\x01\x74\x8b\x26\x14\x82\xcf\xcd\xb5\x12\x79\x2d\x8d\x75\x25\x58\xa4\x33\xa3\x07\x25\xcd\x0f\x07\x8c\xb1\x81\x54\x24\xfe\x1d\xe7\x43\xf0\xde\xd3\x3f\x90\x4d\xf2\xd7\x67\x84\x92\xe3\xad\xf7\x25\x03\x9f\x3e\xc1\xb3\xe0\xc8\x40\x4a\x53\x57\xf3\xc6\x62\x84\xbe\xd6\x06\x3e\x94\x3e\x50\x4a\x7d\x68\x5a\xef\xb2\x3f\x41\xba\xa7\x7a\xce\x15\x79\x4d\x49\x22\x9d\x39\x20\xa5\x30\xa4\x6e\x6d\x2e\xc1\xb9\xfe\xe9\x1c\xcd\xb5\xf9\x73\xc1\x9c\x60\x79\x01\xca\x10\xe9\x13\x91\xfc\x32\x90\x51\xcd\x39\x8e\x11\xdf\x1e\xc8\x5b\xed\x40\xe1\x86\x3b\xa4\x16\xd8\xc8\x3b\x54\x23\xa4\x11\xbc\x5b\xed\xf2\x53\xd0\xbe\x35\x42\x51\xc1\x49\x59\x8c\x3e\xbe\xe3\x76\xdd\x86\x1e\x1d\xdb\xd3\xa1\x75\x52\x6d\xc6\xb9\x10\x32\x3e\x7a\xed\x4f\xe5\xba\x4d\x4d\xaf\xdb\x0b\x9e\x7f\xf8\x6a\xbf\xf7\x6c\xdd\xe6\x05\xbb\xb1\xb9\x6e\x7b\xbf\xe9\x20\x79\x6b\x29\x71\x42\x56\x0e\xb2\x77\xdc\xbe\x95\xd8\x08\x9b\x41\xe6\x3f\x64\x9e\x76\x2d\x04\x8a\x81\x31\x7e\x0b\xdc\x55\x83\xdc\x24\x7c\xff\x21\x12\x33\xb8\xf2\x17\xed\x55\x7f\x31\xf6\x85\xb2\xc3\x47\xdb\x9b\xef\xaf\xbe\x3d\xba\xad\xf6\xdc\x0d\xba\x9e\x19\x4e\x90\x16\xba\x2d\x7a\x9f\xe9\xee\x39\x83\xfb\x8e\x37\x52\x70\x8f\xf8\x6f\x2c\x51\xd7\x75\xbf\x01\xcd\x44\xef\x5e\x7f\xaf\x24\x7a\x72\xcf\x01\xeb\x8c\x54\x9b\x32\xca\x51\xb7\x05\xca\x59\x3a\xfe\xd7\x7c\x50\x47\x1c\x8f\x20\x6b\x50\x38\xb8\x78\x06\x59\xd7\x7d\xf0\xd3\x3a\x5c\x04\xf8\x20\xad\xb3\xe1\x86\x3a\x09\x2b\x38\x5e\xbc\x82\x67\x51\xe4\xcb\x53\xaa\x6f\xd3\x10\xea\xd0\xa7\x31\xd6\x70\xee\xff\xee\xd8\xc4\xb3\xe0\x5a\xf7\xd9\xc1\x12\x8a\x26\xb9\x0a\x6f\x6a\xc0\x07\xac\x0e\x34\x06\x68\x82\x86\x41\xe0\x6f\xc4\xb0\x84\x26\x33\x61\xb9\x9c\x2f\x97\x33\xe2\xb1\x9b\x3a\x5f\xe9\x7d\x7b\x70\x78\x7d\x87\x86\x6f\xb0\xf4\xf7\xd6\x50\xe8\x39\x63\xac\x28\xe1\xb4\xc8\x3d\xb1\x28\x48\x8f\xaf\x96\x9b\x3a\xdf\xee\xd2\xc4\xbe\xd3\x7a\x17\x87\x5e\x32\x71\xce\x04\xce\xbb\x57\xe1\x83\x9b\x54\x87\xdf\x43\x26\xb4\xb4\xd2\xce\xb9\x48\xfb\x62\xfe\x87\x27\xd9\xe5\x35\xe8\xf2\xa0\xde\xee\xbc\xf3\x05\x1b\xd7\xaf\xb8\xc7\x9c\x64\x8f\x64\xa6\x22\x5d\x32\x27\xd7\xea\x8b\x49\xd4\xaa\x79\x04\x2a\xd3\x91\x9e\xcc\xcb\x24\xa3\x6b\x95\xff\xa8\x37\x27\xeb\xcc\x0f\xd8\xa0\xc3\x4f\x09\x65\x65\x90\x3b\x1c\x33\xb8\x56\x9f\xcd\xe0\x74\xf6\x7e\x39\x87\xbe\x08\xca\x61\x68\x17\x45\x8c\xe9\x67\xd5\xa0\xb5\x60\x77\xb2\xfd\xfa\xa0\x82\x92\x49\x60\x3f\xb7\x82\x9f\x06\x16\x28\x6b\x95\xc4\x16\xcf\x7e\xa3\xf8\xe8\x2a\x1d\x63\xec\x83\x7c\x2b\x1f\x50\xf8\x9d\x37\xd9\x44\xc3\x41\x1a\xb8\x1c\x6a\x12\x08\x15\x15\x6f\xb7\xf1\x48\x8e\xc6\x0c\xc5\x76\xb9\x47\xbe\x6d\x7b\x3c\x69\x03\xfe\x9d\xc6\x48\x17\x7f\x34\x26\x2d\x68\x02\xe6\x27\xfc\x0f\xad\xf8\x41\x6a\x40\xc6\x6d\x39\xd1\x88\x65\xe9\xd9\x35\x66\xdd\x06\xde\x9e\xbb\x6a\x0b\xba\xed\x8b\x20\x3c\x76\xde\x17\x40\xa0\xd8\xbc\x80\x5f\x7e\x9d\x22\xb5\x5c\x0e\xee\x4c\xd8\x81\x3b\x0b\xee\xe4\x17\x7b\x23\x94\x50\x51\xfa\x13\x1d\xfd\xed\x86\x5a\x8a\xe7\x9f\x54\x37\x5b\xff\x6c\x4a\xd2\x9c\xbe\x8b\x9e\xdb\x31\x66\x2a\x19\xa5\x5d\x78\x7f\xa2\xc8\xa8\x2e\x8b\x62\x48\xbc\xef\x4d\x4f\x8b\xc5\xb6\xda\x72\xa9\x80\x7b\xec\x08\xd2\x46\x5a\x47\xcf\x55\x82\x96\xde\xb4\x82\x14\x62\x5d\x63\xe5\xe4\x1d\x36\x8f\x20\xf7\xb4\x63\x7d\x6c\x90\x85\x21\x53\x21\x54\x7e\x00\x88\x12\xa4\x83\x7b\xd9\x34\xc0\x9b\x7b\xfe\x68\x61\xab\x1b\xe1\xbb\xd1\xd2\x93\xd2\x62\xa2\x38\xfe\x28\xe1\x19\xda\x08\x34\xfd\xbb\xca\xbb\x63\x9d\x39\x54\x7e\xab\x0d\xd2\x93\x0c\xf4\xeb\x2a\xde\x87\x03\xc1\x03\xf2\x5f\xe1\x3d\x54\x9e\xd6\xdb\xea\xd7\xd6\x28\x9b\x07\x95\x8c\xb1\x33\x9d\x45\x34\x3e\x76\x89\xff\x7e\xe4\x6d\x8b\x4a\xe4\x13\x1f\x72\x25\x9b\xa2\x8c\x36\x18\x2b\x86\xfd\xd4\x3f\x1c\xe9\x68\x18\x51\x53\x44\xfb\x02\x26\x6e\x2d\x15\x6f\x3c\x2f\xfa\x99\x57\xc1\x6e\x28\xcf\xfc\x77\xbb\x78\xdf\xb7\xe9\xd3\xbb\x99\x86\xa4\xa4\x7a\x6a\x50\xe5\x15\xf3\x8e\x15\x70\x05\xdf\xbd\x02\x09\x7f\x7f\x0d\x2f\x5f\x81\xbc\xba\x0a\x2d\xd9\xab\x7f\x0d\x51\xf0\x17\xf9\x6b\x6f\xf3\xec\x71\x1d\xa9\xe3\x0d\x74\xed\x91\x8b\x3f\x19\xf9\x05\x92\x02\x2b\x81\x0b\x41\x83\xcc\x17\x40\x8b\x95\xac\x25\x0a\x0f\x01\x1d\xe2\x11\x35\x4e\xa8\x29\x1c\x4a\x65\xd8\xec\xeb\x46\xdf\x4f\xb0\x0a\xa6\x9e\x92\x5b\x85\xf7\xbe\xef\xfd\x2e\xc5\x77\x38\x4d\x6c\x09\x2f\xcb\x13\x68\xfe\x42\x5f\xc2\xc7\x22\x51\xf0\x1a\x62\x69\xf4\x94\xb2\xc7\x88\xca\xe1\xb2\x60\x22\x76\x52\x6c\xbd\x44\x0f\xe1\x9b\xf0\x73\xdb\xd3\x20\xf4\xbc\xaf\xc7\x30\xd8\xca\x43\xf7\x44\xda\x79\x4b\x54\x2c\x22\xed\xa5\x92\x68\xc3\x1b\x27\xee\x90\xff\x0d\x00\x00\xff\xff\x27\x1e\xba\x4e\x06\x15\x00\x00")
+var _templateHistoryTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x19\xd9\x6e\x23\xc7\xf1\x99\xf3\x15\x65\x42\x36\x66\x04\x6e\x73\xe3\xb7\x68\xc1\x00\x1b\x69\x17\x26\x6c\x4b\xc9\x4a\x1b\x3f\x2c\x16\xc6\x68\xba\x86\xec\x68\xd8\x3d\xee\x6e\x1e\x0a\x33\xff\x1e\x54\x1f\x73\xf0\x58\x6b\x8d\xbc\x24\x0f\x02\xa8\xee\xaa\xea\xba\xaf\xd9\xef\xa7\x97\xc9\xb5\xaa\x9f\xb5\x58\x2c\x2d\x7c\xff\xfa\x4f\x7f\x7e\x55\x6b\x34\x28\x2d\xbc\xcf\x0b\x7c\x54\xea\x09\xe6\xb2\x60\xf0\xb6\xaa\xc0\x01\x19\xa0\x7b\xbd\x41\xce\x92\x87\xa5\x30\x60\xd4\x5a\x17\x08\x85\xe2\x08\xc2\x40\x25\x0a\x94\x06\x39\xac\x25\x47\x0d\x76\x89\xf0\xb6\xce\x8b\x25\xc2\xf7\xec\x75\xbc\x85\x52\xad\x25\x4f\x84\x74\xf7\x3f\xcd\xaf\xdf\xdd\xde\xbf\x83\x52\x54\x08\xe1\x4c\x2b\x65\x81\x0b\x8d\x85\x55\xfa\x19\x54\x09\xb6\xf7\x98\xd5\x88\x2c\xb9\x9c\x36\x4d\x92\xec\xf7\xc0\xb1\x14\x12\x61\xbc\x14\x86\xa0\xc7\x10\xce\x2f\xea\xa7\x05\x5c\xcd\xe0\x31\x37\x08\x17\xec\x5a\xc9\x52\x2c\xd8\xdf\xf2\xe2\x29\x5f\x20\x01\xed\xf7\x60\x71\x55\x57\xb9\x25\x6c\xcc\x39\xea\x31\x5c\xd0\x4d\x22\x56\xb5\xd2\x16\xd2\x64\x34\x2e\x94\xb4\xb8\xb3\xe3\x64\x34\x46\x59\x28\x2e\xe4\x62\xfa\x4f\xa3\x24\x1d\x94\x2b\x77\x6e\xc5\x0a\xc7\x89\x03\xb0\x0b\xc5\x84\x9a\xa2\xb4\xf4\x17\x59\x4a\x46\xfb\xfd\x2b\xb8\x30\xaa\xb4\x37\x58\xa1\x45\xe2\xab\xcc\x2b\x43\x7c\xec\xf7\xa0\x73\xb9\x40\xb8\x90\x74\x7c\xc1\x7e\xf0\x58\xb7\x8a\xa3\xf1\xf7\xa2\x84\x0b\xc9\xee\x3b\x74\x77\xda\xa7\x37\x03\xab\xd7\xe1\x1c\x25\xef\xff\xf0\x8f\x8b\x72\x00\x4f\xc7\x07\xfc\x9a\x62\x89\xab\x7c\xba\x12\x3b\x21\x03\xc7\x7d\x02\x5f\xe4\x91\x88\xed\xf7\xa7\xb4\x3c\xa5\x63\xd9\x3b\x18\xff\x2e\x6c\xa0\x3d\xc4\xe9\xb1\x93\x25\xc9\xef\xe9\x8c\x00\x2e\x96\x8e\xd5\x96\x5e\x3c\x2e\x2a\x41\x1e\x7e\x35\x83\x5a\x0b\x69\x89\xbb\xdb\x7c\x85\x30\xbe\x76\x17\xe3\x16\x9d\xdc\xbf\x85\x4a\x8b\x7c\x85\x55\x04\xce\x60\x1c\xa8\xfe\xa0\xd4\x93\x43\x99\x4e\xa1\xc5\x6a\x1a\xd0\x58\x28\xcd\x0d\xe4\xfe\xd4\x61\x41\xd3\x00\x4a\x2b\xec\x33\x94\x4a\x03\xe6\xc5\xd2\xdd\xca\xc3\x5b\xbb\xcc\x2d\x85\x53\x5e\x96\x58\x58\xe4\xf0\xf8\x0c\x39\xac\xd6\x36\xb7\x42\x49\x96\x4c\xa7\xf0\xb0\xc4\xee\x0d\x8d\x50\x68\xcc\x09\x72\x6d\x84\x5c\xb8\x20\x0a\x72\xba\xe0\xc1\x0e\x19\x7e\x40\x59\xe0\xa4\x3d\x30\xfe\xb5\x5c\x23\xe0\x0e\x8b\x35\x11\x11\x12\x72\x7a\xc4\xea\x5c\x9a\xbc\xa0\x47\xc3\x63\x44\x4b\x68\x08\x8e\x1d\xe3\xd5\x90\x70\x3d\xe0\x09\xe4\x92\x83\x70\x32\x70\x61\x8a\x5c\x73\xa2\x5a\x86\x23\xad\xaa\x8a\x84\xca\x8b\x27\x27\xcb\x9d\x5d\xa2\xee\x31\x74\xc4\x0b\x48\xdc\x0e\xe9\x5b\xb5\x40\x87\xb5\x15\x76\x09\xb6\x55\x06\x09\xaf\xca\x21\x97\x2c\x29\xd7\xb2\xe8\x5b\x27\x95\xb8\xb3\xf0\x33\x3d\xa8\x74\x16\x7f\xc0\x3e\x19\x69\xb4\x6b\x2d\xfd\x09\xbe\x5f\xcb\x22\x25\xdc\xb4\xb0\x3b\x08\x89\x80\xdc\x96\x12\xc2\x04\x56\x1e\x4c\x28\x99\x41\xfa\x8f\xbc\x5a\xe3\x04\x50\x6b\xa2\xb8\x4f\x46\xa3\x28\xcf\x04\xbc\x1f\xad\x58\x7a\x49\x3c\x48\x16\xd1\x82\xd5\xb3\x64\x34\x12\x25\x7c\xa3\x9e\x88\x83\x51\xe4\x41\x8a\x6a\x02\xe5\xca\xb2\x77\x44\xb3\x4c\xc7\x6b\x89\xbb\xda\xfb\x43\xa4\x0d\xf6\xb9\x46\xf8\xf6\x61\x3c\x81\x15\x91\xa1\xa8\x8e\xd1\x7e\x90\x30\x88\xf2\x74\x0a\x74\xf6\x8a\x53\xd2\x68\x55\xed\x2d\x4b\x06\x79\x26\xc5\x81\x73\xe1\xe0\x36\x11\xd2\x5b\x54\x2a\x7b\x0a\x68\x5d\x73\xca\xa1\xce\x8f\x82\xdd\xc8\xab\x70\xc5\xe8\x51\x51\x12\x97\x13\xf8\x95\x94\x50\xd8\x1d\x73\xaa\x4a\x83\x71\x3a\x1e\x7f\xc4\xe7\x7d\x93\xb1\xd4\x58\x2d\xe4\x22\x7b\x43\x58\x30\x9b\xb5\xb2\xb2\x87\xe7\x1a\x53\xaf\xdb\x4e\x49\x64\x11\xa7\x4f\x4c\xa3\x81\x7e\x11\x76\xe9\xdf\x28\xec\x6e\x02\xa7\x1f\x9a\x80\x14\x55\x16\xd4\xd6\xea\x2d\x26\x3c\xb2\xc7\xaf\xad\xe1\x22\x03\x5c\x8b\x0d\x6a\x96\x5e\xda\xdd\x8d\xfb\x99\xbd\xe9\xac\x46\x4f\xa1\xd6\x03\x04\x9f\x51\xd2\x8c\x3d\xec\xc8\x85\xb2\xa0\x0e\x02\xfb\x66\x46\x0c\x1c\x08\x43\x16\x47\xad\x23\x47\xa3\x4d\xae\x61\x03\x4e\x16\x3a\x23\xbc\x19\xe8\xb5\x7c\xd8\xa5\xf4\x9c\x73\x4d\xbb\x83\xcb\x87\x5d\xe6\x3d\x2f\xd0\x2b\xca\xc5\x80\x8f\xc2\x65\x5a\x77\xc5\xb1\x44\xed\x31\x33\xd8\x1f\x82\xc0\x0c\x08\xb7\x49\x1d\xab\xa3\xe3\x5b\xbb\x0b\xbf\xdd\xfd\xc6\xb1\x0b\xb3\xa3\xd8\xca\x5a\x9b\x10\x9f\x91\x4c\xd6\x17\x36\xca\xf9\xd5\x4a\x09\xe7\x1b\x67\xc1\xe0\xf3\xa4\xa8\x94\x20\x04\x37\x00\x00\x9f\x3e\x13\x47\x92\xcd\x6f\x9c\xd7\x04\xf7\x57\x95\xbb\x9d\xc1\x2a\x7f\xc2\x74\x95\xd7\x9f\x0e\xa1\x3e\x5f\x0e\xf2\xb1\xe3\x4d\xe2\xf6\x0f\x60\x85\xec\x7b\xc2\x19\x92\xd1\x28\xfb\x72\x98\xfe\x46\xe9\xc6\x07\x4b\x88\xda\x8f\xb2\x12\x4f\x5d\x34\x9a\x49\x88\x39\x1f\xc0\x79\x5d\x57\x02\x39\xe4\x95\x51\xa0\x24\x98\x36\xc6\x91\xfb\x82\x23\xd0\xc4\x58\x6c\xf9\xb9\xab\xd3\x8c\xcd\x4d\x7a\x57\x7f\x74\xb4\xe0\xdf\x10\x7f\xde\x49\x8c\x71\xe6\x78\x99\x81\xeb\x0a\xd8\x5c\x16\xd5\x9a\xa3\x67\x96\xb7\x2e\x7d\x14\x3d\xa1\x3e\x6d\xc8\x71\x4d\xcc\x12\x6d\x31\x8b\x1c\x39\xde\x7f\x5b\xa3\x26\xde\x1f\xb1\x54\x1a\x07\xa5\x8a\xaa\x47\x2c\x03\x2c\xe4\xc8\x13\xdc\x5f\xbb\xca\x17\xf8\x25\x72\xcf\xe4\xfb\x5e\xff\x6c\x60\x18\xf6\x77\xba\x4d\x33\xf6\xcb\x12\x35\xa6\x2d\xad\x5a\x23\x17\x05\xa9\x93\x31\x16\xfd\x51\xf0\x09\xe0\x4e\x18\x6b\x06\xa1\x34\xbf\x49\xb3\x37\xf1\x22\xa8\x88\xa8\x06\x9a\x87\xdd\x0e\x9b\xdf\xa4\x82\x67\xad\x9a\x46\x92\x3a\xa6\x36\x53\x38\x76\xd9\xdb\xaa\x4a\x4f\x77\x79\xa4\x7d\xea\xe8\x7c\xa7\x18\xfe\x71\x39\xea\x45\x61\xd3\xaf\x1e\xb1\x23\x6e\x9a\x2b\xff\x2c\x95\xca\x81\x7a\x62\xa6\x0c\x76\xbb\x82\x6f\xb7\x63\xc7\x69\xc7\x3d\xb5\x2d\xbf\x4e\x80\x84\x20\xad\xf8\x9e\x90\xfe\x8b\xba\xa0\xf8\x9b\x41\x5e\xd7\x28\x79\x2a\xb8\xf1\xb0\x6c\x7e\xe3\x68\xb8\x08\xfc\x14\x4e\x3e\xc3\xcc\x5d\x46\xe2\x4d\xd2\x26\x94\xab\xd9\x30\xb1\xbb\x24\x92\x25\x27\x05\xee\xcb\xeb\x73\x0a\x11\x52\x35\xf1\x47\xe2\x2d\xfb\xd6\xb8\xab\x51\xbb\x8c\xe6\xfd\x3c\x19\x8d\xcc\x56\x58\x6a\xc3\x92\xd1\xa8\xa0\x41\xe1\xbc\x83\x5d\x45\xf3\xc5\xba\xb0\x69\x0b\x7a\x50\x60\xb4\x49\x5b\x13\x5e\x52\xca\x89\x62\x2c\xe3\xe0\xe1\x91\x43\xa9\xd5\x0a\x4e\x76\x0b\xe3\x09\x6c\x3a\x83\xa8\x7a\x02\x5e\xcd\xb8\x1d\x68\xf6\xbc\xe8\x3e\x5e\x26\x27\x72\xe4\x3e\xe0\x37\x93\x68\x98\x73\x2a\x09\x0e\x4a\x19\xc3\xff\xa4\x8c\xe1\x14\xa4\xea\x2f\x3e\xee\xa1\x23\xe5\x0a\x25\x39\x49\x06\x7f\x81\xd7\x57\xc7\xd1\xf1\x82\x18\x3e\x11\x6f\x73\x47\x93\x22\x39\xfb\x7f\x8a\xac\x43\x03\x1f\x85\x4e\x2c\x81\x52\x6d\xa9\x04\x52\xc5\xa2\xc1\x94\xdd\xaa\xad\x2f\xe7\xc8\x17\x48\x95\x0c\x66\x91\x9f\x77\x7c\x81\xd7\x4b\x0a\x62\xd3\x66\x43\x07\x9a\xd3\xe0\x4d\x2d\xdb\x0c\xba\x31\x96\xbd\xa5\xd3\xf7\x5a\xad\x42\x0b\xdc\x16\x80\xc7\xb5\xa8\x38\x6a\x13\x8b\xe4\x27\x5f\x47\x97\xcc\xfb\x5a\xd0\xc2\x04\x5e\x4f\x5a\x93\xc7\x32\x18\xe4\x16\xbc\x93\x9a\x72\x88\x93\xd9\xcf\x34\x07\x9e\xd0\x8e\x52\x81\x78\x9a\x51\x6d\x18\x8d\xee\xd1\x86\x91\xec\x41\xac\x30\x95\x6a\xdb\x5d\xb4\xee\x97\xaa\xba\x3b\xfd\x80\x65\x2a\x78\x34\xb7\x13\x99\x0c\x3e\x1e\x07\x8d\xfb\xe7\xd9\x3d\x5a\x27\x78\xea\x20\x3a\x8b\x89\xd2\x09\xe3\xb4\xea\x3c\xf8\x08\xad\xaf\x5e\x0f\xd6\x47\xee\xa7\x12\x97\x17\x05\xff\xfc\x06\xda\xd4\xe1\x93\x70\x1b\x0b\xb4\x72\x60\x3f\xe7\xda\x2c\xf3\x2a\x25\x54\xc7\xc8\x49\x3f\x7d\x91\xa3\xc6\x5d\xc6\xd7\x38\xea\xa8\x39\x10\xf0\xae\xe2\xae\x39\x35\xa9\xe7\xf6\xac\x7c\xce\x79\xff\xf7\xe4\xbb\xc5\xed\x19\xf9\x7a\x1e\x1f\x8a\x5d\x3c\x99\x84\x51\x3c\x8e\x63\xc1\x4d\xe2\x75\xcf\x53\xfc\x8c\x71\x9c\xeb\x0e\x3d\xfc\xaf\xeb\xea\xa9\xc5\xa7\xbc\xc6\xee\xf3\x8d\x6b\xad\xb3\x37\xc7\xda\x79\x89\x72\xba\x59\xf9\xa4\x76\x0e\xd4\xd2\x04\x49\x0e\x1a\xef\x26\x4b\x9a\x84\xc6\x77\xd7\x55\x85\xd8\x73\xad\x85\xf0\xe3\x5f\xab\xec\xb8\xa7\x08\x8d\xe0\xc9\x95\x47\x3b\xc7\x2f\xc4\x06\x25\x08\x3e\x21\xd2\x4a\x73\xd4\xb1\x1c\x12\x49\x55\x71\x34\x16\xac\x72\xb0\x12\xb7\x68\x2c\x83\x87\xde\x63\xaa\x84\xc3\xd6\x97\x1a\xc9\x27\xac\x6d\x58\x04\xa4\x05\x5c\xf6\x16\x41\x4d\x93\x0d\x44\x48\x05\x87\xc3\xca\x98\x41\x48\x68\x0e\x30\xb2\xde\xed\x0b\x6e\x71\x3b\xb0\x5c\xe8\xf5\x8b\x30\x2c\x65\x6d\xd5\x4a\x46\xa3\xae\x70\x0d\x4a\x64\xc8\x46\x2e\x3d\xdd\x91\xd8\xe9\x5b\x53\x1c\x83\xbd\x17\x58\xf1\xc0\x29\x25\xba\xc9\x71\xb1\x75\x20\xf3\x9b\xcc\xd9\x87\x6e\x35\x16\x48\xe3\x6a\xd8\x87\x7d\x88\xff\x36\xcd\x7f\xcb\x7c\x5f\x61\xac\x60\x83\x01\x5f\x4d\x03\x07\x9d\xd4\xd0\x22\xbf\xa7\xfe\xf4\xbb\x81\x3d\xf7\x5e\xeb\x57\x70\xf0\x48\xb0\x46\x93\xb1\x01\xf5\x43\x28\x6a\x56\xbd\x67\x7f\x40\x7a\x3f\x2e\x38\xcc\x79\xef\x0d\x22\x6e\x50\x1b\xda\x95\xb9\x4d\xc8\x36\x37\x47\xab\x15\xef\xdc\x41\xb1\xf4\x82\x07\xf0\xdb\x15\x1f\x5f\x06\x84\x65\xf0\xa1\xd3\x79\x3b\xf4\xd1\xee\xdd\xc6\x21\x29\xcc\x56\xee\x25\xa2\x1c\x18\x59\xe6\xed\x28\x25\x2c\x3d\x40\x5c\x84\x78\xf0\xaf\xd0\xd6\xcc\xcd\x5a\x13\xa2\xf3\x0c\x45\x2e\xe1\x11\x61\x4d\x5b\x7b\x2a\xc6\xfe\x11\xca\x0d\xa7\xc7\x48\x98\xaf\xa8\x55\x78\xac\x90\xc8\xd1\x13\x06\xa5\x11\x56\x6c\x10\x4a\xf2\x3c\x3f\x93\xd2\xaa\x28\xf0\x1b\xc4\x23\x2e\x3b\xe2\xc2\x1a\xac\x4a\x10\x3d\x1d\x09\xd9\x77\xbb\xf3\xc1\x1a\xac\x72\x7a\x29\xb7\x8c\xae\x12\x2c\x94\xc1\x41\x93\xde\xdf\xd1\x05\x25\x5e\xcd\x60\xc9\xda\x5c\x9f\x50\x51\x5d\x76\x2d\x2b\xed\xa0\x8e\x62\xac\xbd\x0d\x0d\x25\xe5\xde\x40\x8d\x88\xb5\x85\x31\xa1\x72\x18\x9b\xba\xef\x06\x8c\xec\x9b\x24\x96\xb7\x58\xff\x3e\xca\x55\xa8\x80\xb1\x3a\x12\xea\x71\x96\x7f\x49\x8e\xe7\xf8\xb5\x05\xb0\x49\x46\x61\x93\x47\xf5\x88\xb5\xab\x80\xf9\x4d\xba\x64\x1f\xb0\xcc\x06\x1f\x02\xca\xe1\x76\xdd\x25\x9d\xf0\x29\x80\xa0\x2e\x0c\xf6\x76\xec\xe3\x7b\xb4\x63\xb8\x28\xd9\xbd\xd5\xeb\xc2\x3a\xe0\x0e\x96\xda\xf3\x92\xdd\x8a\xaa\x72\x6e\xd5\xf4\x3b\x08\xd7\xf3\x1d\xe2\x0d\x2b\x9e\x67\xda\x01\xd2\xa3\x4d\x93\x5e\x9e\xc5\xf4\x05\x2d\x8c\x04\x25\xbb\xab\xc9\xc2\x79\xe5\x42\x99\x86\xec\x01\xc5\xeb\x0a\x73\x7d\x8a\x4a\xda\x92\x69\x57\x1e\x24\x87\x1b\x26\x44\xe9\xbc\xfd\xa2\x64\x73\x43\x0d\xa0\xc7\x19\xbe\x45\xd8\x84\x30\xbd\x84\x8f\x92\x78\xa6\xee\xf0\x55\x2f\x7a\x9c\x83\x73\xc8\x0d\xdc\x7e\xfc\xe9\x27\x1f\x3f\x95\xca\x69\x8b\x9e\x1b\xf8\x17\x6a\x15\x0c\xc8\xc0\x7d\x07\xeb\x56\x16\xee\xee\x6a\x76\x5e\x77\x13\xb8\x94\xb8\x75\x85\xa5\x6c\xcb\xdb\x1b\x10\x9c\x94\xea\xb0\xcf\x69\x35\xf4\xca\x7f\x44\x53\x7d\x05\x79\x76\x8f\xc9\x7f\xd1\x66\xfd\xf5\x52\xff\x77\x88\x84\x40\xad\xed\x8b\x12\xf7\xa9\x27\x00\x25\xa7\xbf\x7f\x4d\xa7\x31\x18\xba\xc1\xf0\x47\x7c\xa6\x94\x44\xa9\x2a\x64\x16\x78\xc2\xe7\xe1\x9e\x3b\x24\xdd\x5e\x76\xec\x27\x47\x4b\x19\x71\x49\xfd\xbc\x43\x71\x83\x7d\x40\x3f\x4c\xa5\x6d\x5a\x14\xba\x25\xdd\xa5\xce\x2e\x29\x06\x2e\x59\xe2\x88\x9d\xe4\xd9\x38\x85\xed\x9b\xa4\xa7\x9b\x9e\x80\xbd\x41\xa4\xad\x32\xc4\x5d\x11\xce\x02\x83\xe4\x85\x26\x7e\xd3\xf1\xa5\x2a\x4e\x85\x21\x1b\x1f\xd3\x4b\xfb\x5f\x41\x68\xa5\xea\xd7\xf7\x9f\x7b\x63\x63\x07\x4d\x4e\x13\xdf\xbc\xea\x2d\x54\xbf\x84\x93\x25\xed\x6c\x9c\xaf\x7a\xb3\xf1\x8a\xbd\xe5\x1c\x39\x01\x9a\xf0\x55\xa0\xa0\x84\x13\xe8\x7f\x92\xf9\x0a\x3f\xd3\xa1\x87\x03\xfa\x00\xe3\x7e\xcd\x6f\x4c\x4a\x97\xe4\x99\x03\x60\x5a\x7e\xbb\x2c\x78\xe6\xbd\x0f\xb8\x52\x9b\x17\xbd\x18\x20\x61\xd6\x61\xfd\xe1\x57\x5d\x68\xbd\xe8\xd5\x00\x19\xbe\x05\x9f\x7d\x27\x84\x4c\xb8\x1c\x44\xca\x7f\x06\x00\x75\xe2\x62\xd6\x12\x20\x00\x00")
+
+func templateHistoryTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateHistoryTmpl,
+		"template/history.tmpl",
+	)
+}
+
+func templateHistoryTmpl() (*asset, error) {
+	bytes, err := templateHistoryTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/history.tmpl", size: 8210, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateHookTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\xdd\x8f\xdb\xb8\x11\x7f\xb6\xfe\x8a\x89\xe0\x00\x52\xaa\xa5\x73\xf7\xd6\x06\x29\xb0\xd8\x4b\x90\x05\xae\xeb\xe2\x9a\xeb\xcb\xe1\x90\xe3\x8a\x23\x9b\xb5\x4c\x0a\x24\xbd\xf6\x42\xd1\xff\x5e\x0c\x49\x7d\xd8\xde\x35\xf6\xd2\x2b\xee\xc5\x1f\xc3\xe1\xcc\x6f\x7e\xf3\x41\x4a\x6d\xbb\x78\x93\xdc\xe8\xe6\xd1\xc8\xd5\xda\xc1\xf7\x6f\xbf\xfb\xeb\x55\x63\xd0\xa2\x72\xf0\x91\x97\x78\xaf\xf5\x06\x6e\x55\xc9\xe0\xba\xae\xc1\x2b\x59\xa0\x75\xf3\x80\x82\x25\x9f\xd7\xd2\x82\xd5\x3b\x53\x22\x94\x5a\x20\x48\x0b\xb5\x2c\x51\x59\x14\xb0\x53\x02\x0d\xb8\x35\xc2\x75\xc3\xcb\x35\xc2\xf7\xec\x6d\xbf\x0a\x95\xde\x29\x91\x48\xe5\xd7\x7f\xbc\xbd\xf9\x70\xf7\xaf\x0f\x50\xc9\x1a\x21\xca\x8c\xd6\x0e\x84\x34\x58\x3a\x6d\x1e\x41\x57\xe0\x26\xce\x9c\x41\x64\xc9\x9b\x45\xd7\x25\x49\xdb\x82\xc0\x4a\x2a\x84\x74\xad\xf5\x26\x85\x28\xdc\x4b\xb7\x06\x3c\x38\x54\x02\xe6\x90\xfe\x93\x97\x1b\xbe\xc2\x74\xa2\x35\x6b\x5b\x70\xb8\x6d\x6a\xee\x68\x33\x72\x81\x26\x05\x46\x06\xda\x16\x68\x1f\x99\x92\xdb\x46\x1b\x07\x69\xdb\xc2\x9c\xdd\x68\x55\xc9\x15\x8b\xc6\xa0\xeb\x52\xef\x6b\xde\x6c\x56\xf0\xb7\xf7\x70\xcf\x2d\x3e\xa5\xe5\x95\x0c\x57\x2b\x84\xb9\x22\xc5\x39\xfb\xc7\xce\xf1\xfb\x1a\xef\xb4\x40\xdb\x83\x99\x2b\xbe\x45\x5a\x6e\x8c\x54\x0e\xe6\x8a\xdd\x91\x20\xfd\xb8\x53\xe5\x80\x78\xee\x1e\x9b\x51\xa9\x82\xf4\xcd\x6b\xcb\x5e\xdb\x34\x80\x98\x2b\x6f\xd9\x49\xad\xfc\x5e\xf2\x3d\x5b\x2c\xe0\xf3\x1a\x61\xf0\xd0\x75\xe0\x8d\x48\x0b\x5c\x01\x17\xbc\x71\x94\x2a\x0d\xbc\xae\xf5\xde\xf3\xbf\xb3\x48\xa4\x6b\x23\xa4\xe2\xe6\xd1\xdb\xa8\x76\xaa\x24\xc3\xc0\x6d\xb0\xc5\xa2\x0b\xd8\x92\x4b\x6d\x58\x32\xf3\x76\xa7\x8e\x68\x53\x56\x6a\xe5\xf0\xe0\x88\x18\xfa\x2e\x60\x88\xa3\xeb\x72\xc8\x7a\x06\xbb\x8e\xfd\x9b\xd7\x3b\x2c\x00\x8d\xd1\x26\x0f\xd0\x7d\x3c\x08\x25\xaf\x6b\x0b\x55\x56\xba\x43\x01\xdb\x9c\x25\x33\x32\x0d\x59\x35\x8d\x2b\x8f\xda\xa4\x05\x67\x5e\xb7\x30\xf1\xd4\xd3\x74\xc1\x3f\xb4\xc9\x6c\xb6\x7d\x28\x40\x6f\x88\xf0\x2d\xcb\xa6\xb8\x93\xd9\x4c\x56\xf0\x4a\x6f\xbc\xda\xcc\xa0\xdb\x19\x05\x4a\xd6\x05\x54\x5b\xc7\x3e\x90\x89\x2a\x4b\x77\x0a\x0f\x0d\x96\x0e\x45\xa0\x89\x08\xf4\x2c\xbd\xfe\xcc\x20\x2c\x4d\xe9\x48\x29\xb8\x64\x36\xeb\x92\xc1\x64\x1f\xf3\x43\x9e\xcc\x8e\x4a\x73\xb1\x80\x1b\xad\x84\xa4\x30\xa8\xf7\x38\x50\x6d\x43\x39\xc8\xfa\x8c\xb1\xc4\x7b\x1c\x95\x9f\xcf\xca\x19\x3b\xf7\x5a\xd7\x49\xb2\x58\xc0\xb5\x12\xb0\x32\x7a\xd7\xd8\xd1\x83\x0d\x6d\x46\x15\x73\x7d\xf7\x03\xe8\x06\x4d\x28\x04\x72\x40\x3b\xb2\x4a\x1a\xeb\x0a\xb0\x48\x7b\x46\xb8\x05\x8d\x11\x07\x8c\xb1\x41\x94\x4f\x82\x69\x93\x21\x78\x8f\xf4\x77\x64\x93\xf0\xfa\x8c\x50\x72\xbc\xf7\xbe\x64\xe0\xeb\x57\x78\x15\x80\x0c\xa2\x69\xea\x2a\x5e\x5b\x8c\xd4\x57\xda\xc0\x97\x82\x9c\x0a\x4a\x7d\xe8\x5d\x0f\xd9\xef\x20\xdb\xe7\x76\x4e\x0d\xcd\xba\xe3\x44\x3a\xb3\x43\x4a\x61\x48\xdd\xd2\x5c\xa2\x73\xf9\xd3\x29\x9b\x4b\xf3\xe7\x92\x79\xc6\xe5\x05\x2a\x43\xa4\x2f\x64\xf2\x79\x22\xa3\x99\x53\x1e\x23\xbf\x3d\x91\x77\xda\x81\xc2\x15\x77\x48\x2d\xb0\x92\x0f\xa8\x46\x4a\x23\x79\x77\xda\x65\xc7\xa4\xfd\xd1\x0c\x45\x03\x47\x65\x31\x62\xfc\xc4\xed\xb2\x09\x3d\x3a\x40\x03\x87\xd6\x49\xb5\x1a\xe7\x42\xc8\xf8\x88\xda\xef\xca\x74\x33\x75\xbd\x6c\x2e\x20\xff\xf2\xcd\xb8\xb7\x6c\xd9\x64\x39\xbb\xb5\x99\x6e\x7a\xdc\xb4\x91\xd0\x5a\x4a\x9c\x90\xa5\x83\xf4\x13\xb7\x1f\x25\xd6\xc2\xa6\x90\xfa\x1f\xa9\x97\x5d\x0b\x81\x62\x58\x18\xff\x85\xd5\x9b\x1a\xb9\x99\xac\xfb\x1f\x51\x98\xc2\x95\x3f\x6f\xaf\x62\x65\xcc\xfb\x42\xd9\xe0\xa3\xed\xdd\xf7\x47\xdf\x16\xdd\x5a\xfb\x86\x5c\xa1\xeb\x17\xfd\x97\xb7\x42\xa7\x45\x8f\x99\xce\x9e\x13\xba\x1f\x78\x2d\x05\xf7\x8c\xff\xc6\x26\xe6\xba\xee\x37\xa0\x61\x49\xa8\x6c\x7f\xae\x4c\xec\x64\x7e\x05\xac\x33\x52\xad\x8a\xa8\x47\xdd\x16\x24\x27\xe9\xf8\x5f\xf3\x41\xad\xd6\xb6\x20\x2b\x50\x38\x40\x3c\xa1\xac\xeb\xbe\xf8\x69\x1d\xee\x28\x78\x90\xd6\xf9\x1c\x6d\x8f\xc3\x0a\xc0\xf3\x77\xf0\x2a\xaa\x3c\x3f\xa5\xfa\x36\xf5\x3b\xc6\x3e\x8d\xb1\x86\x7d\xff\x77\x60\x67\xc8\x7c\xd3\x87\x8f\xe3\x89\xd0\x51\x85\xb6\xed\xd5\xf4\x28\xbc\xad\x00\x0f\x58\xee\x68\x0c\xd0\x04\x0d\x83\xc0\x9f\x88\xe1\x2e\x3a\x54\x02\x4b\x16\x8b\x64\xb1\x98\xd1\x1a\xbb\xad\xb2\x1b\xbd\x6d\x76\x0e\xaf\x1f\xd0\xf0\x15\x16\xfe\xdc\x1a\x0a\x3d\x63\x8c\xe5\x05\x1c\x17\xb9\x17\xe6\x39\xd9\xa1\x3c\xc3\x6d\x95\xad\x37\xd3\xc4\x7e\xd2\x7a\x13\x87\xde\x50\x1d\xf9\xa9\xc2\x69\xf7\x2a\x3c\xb8\xa9\x8e\x9f\xc3\xda\x1c\xed\x8b\xb2\x69\xa5\x9d\xae\x22\xdd\x17\xb3\xdf\x3d\xc9\x2e\x5f\x83\x2e\x0f\xea\xf5\xc6\x83\xcf\x23\x80\x71\xfe\xc5\xea\x8a\x6a\xa4\x73\xae\xd2\xf5\xf3\x66\xb1\x80\xa5\x7a\x36\x89\x5a\xd5\x8f\x40\x65\x3a\xca\x27\xf3\x72\x92\xd1\xa5\xca\x7e\xd4\xab\xa3\xeb\xcc\x0f\x58\xa3\xc3\xaf\x13\xc9\x8d\x41\xee\x70\xcc\xe0\x52\x3d\x99\xc1\xf3\xd9\xfb\x7c\x0e\x7d\x11\x14\xc3\xd0\xce\xf3\x18\xd3\xcf\xaa\x46\x6b\xc1\x6e\x64\xf3\xed\x41\x05\x23\x67\x81\xfd\xdc\x08\x7e\x1c\x58\x90\x2c\xd5\x24\xb6\xb8\xf7\x0f\x8a\x8f\x8e\xd2\x31\xc6\x3e\xc8\x8f\xf2\x80\xc2\xdf\x79\x27\x37\xd1\x90\x76\x1a\xb8\x1c\x2a\x52\x08\x15\x15\x4f\xb7\x71\x4b\x86\xc6\x0c\xc5\xf6\xac\x7f\xda\x94\x9d\xb7\x42\x0e\xe7\xb2\x97\xb6\xc7\x69\x6b\x7c\x4b\x63\x44\x37\xfe\xe2\x8f\xc6\x4c\x0b\x9a\x88\xf9\x09\xff\x83\xa5\x83\xa0\x35\x30\xe3\xd6\x9c\x64\xb4\x64\xe9\xb1\x6b\xcc\x3a\x95\x08\x77\xb0\xe5\xae\x5c\x83\x6e\xfa\x22\x20\xbc\x90\x7d\xce\x81\x48\xb1\x59\x0e\xbf\xfc\x7a\xce\xd4\x62\x31\xc0\x39\x5b\x0e\xab\xb3\x00\x27\xbb\xd8\x1b\xa1\x84\xf2\xc2\xef\xe8\xe8\xb3\x1b\x6a\x29\xee\x7f\x51\xdd\xac\xfd\x63\xd3\x24\xcd\xd3\xe7\xa2\xd7\x76\x8c\x99\x4a\x46\x69\x47\x44\xe8\x3d\x8a\x94\xea\x32\xcf\x87\xc4\xfb\xde\xf4\xb2\x58\x6c\x37\x6b\x2e\x15\x70\xcf\x1d\x51\x5a\x4b\xeb\xe8\x71\x95\xa8\xa5\x67\x5a\x41\x06\xb1\xaa\xb0\x74\xf2\x01\xeb\x47\x90\x5b\xba\x63\xdd\xd7\x48\x74\xc2\x52\xd1\x0b\x0b\x3f\x00\x44\x01\xd2\xc1\x5e\xd6\x35\xf0\x7a\xcf\x1f\x2d\xac\x75\x2d\x7c\x97\x5a\x7a\xa4\xb4\x38\x31\x1c\xdf\x4d\xf8\x05\x6d\x04\x9a\xfe\xb9\xca\xc3\xb1\xce\xec\x4a\x47\x35\x11\x60\x9c\x65\x20\x82\xbf\xc3\x7d\xc0\x1f\x10\x10\x7e\x85\x7b\x28\xbd\xac\xf7\x15\x5b\xa4\xd7\xcd\x82\x49\xc6\xd8\x89\xcd\x3c\x3a\x1f\xbb\xc4\xff\x6f\x79\xd3\xa0\x12\xd9\x19\x86\x4c\xc9\x3a\x2f\xa2\x0f\xc6\xf2\xe1\x7e\x4a\x29\xf3\x10\xa8\xfe\xf0\x09\x46\xfb\x02\xa6\xd5\x4a\x2a\x5e\xfb\xb5\x88\x33\x2b\x03\x8e\x50\x9e\xd9\x93\xd5\x10\xf1\x91\x7e\x16\x5f\x18\x3c\xd1\xb9\xf9\x13\x32\xe2\xd4\x5f\x50\x24\xd5\x53\x8d\x2a\x2b\x19\x39\xb7\x39\x5c\xc1\x77\xef\x40\xc2\xdf\xdf\xc3\xdb\x77\x20\xaf\xae\xbc\xea\xac\x37\xff\x1e\xa2\xe2\x2f\xf2\xd7\xde\xe7\xc9\xc3\x75\x94\x8e\x27\xd0\xb5\x67\x2e\xbe\x39\xa2\xe4\x78\x56\x0a\xe0\x42\xd0\x20\xa3\xf0\x6d\x83\xa5\xac\x24\x0a\x4f\x01\x6d\xe2\x91\x35\x4e\x75\xa8\x70\x28\x95\xe1\x66\x5f\xd5\x7a\x7f\xc6\x55\x70\xf5\x92\xdc\x2a\xdc\x13\xb1\xe1\x2e\xc5\x37\x78\x9e\xd8\x02\xde\x16\x47\xd4\xfc\x85\xfe\x78\xd3\x79\x3e\x31\xf0\x1e\x62\x69\xf4\x92\xa2\xe7\x88\xae\x34\x97\x15\x27\x6a\x91\x3d\x1f\x48\xdb\x6b\xf4\x14\x7e\x38\xb8\x97\x53\xe8\xd7\xbe\x9d\xc3\xe0\x2b\xf3\x56\x7a\xd9\x69\x4b\x94\x2c\x32\xed\xb5\x26\xd1\x86\x37\x85\xa8\x04\x74\x5d\xf2\xdf\x01\x00\x09\x4a\x9c\x01\x0d\x15\x00\x00")
 
 func templateHookTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1027,12 +1302,12 @@ func templateHookTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/hook.tmpl", size: 5382, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/hook.tmpl", size: 5389, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateImportTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x54\x5d\x6b\xeb\x38\x10\x7d\x8e\x7f\xc5\x60\xbc\x90\x04\x6a\x77\xfb\xb6\x85\x3e\x94\x7e\x40\x60\x59\x0a\xed\xfe\x00\x55\x1e\xd9\x22\xb6\xa4\x2b\x4d\x6e\x1b\x8c\xff\xfb\x45\x5f\x8d\xb9\x69\xfb\x76\xfb\xd2\x89\xce\xcc\xe8\xcc\x99\x23\x4f\x53\xb3\x2d\xee\xb4\x39\x5a\xd9\xf5\x04\x57\x97\x7f\xff\x73\x61\x2c\x3a\x54\x04\x8f\x8c\xe3\xab\xd6\x7b\xd8\x29\x5e\xc3\xed\x30\x40\x48\x72\xe0\x71\xfb\x13\xdb\xba\x78\xe9\xa5\x03\xa7\x0f\x96\x23\x70\xdd\x22\x48\x07\x83\xe4\xa8\x1c\xb6\x70\x50\x2d\x5a\xa0\x1e\xe1\xd6\x30\xde\x23\x5c\xd5\x97\x19\x05\xa1\x0f\xaa\x2d\xa4\x0a\xf8\xbf\xbb\xbb\x87\xff\x9e\x1f\x40\xc8\x01\x21\x9d\x59\xad\x09\x5a\x69\x91\x93\xb6\x47\xd0\x02\x68\x71\x19\x59\xc4\xba\xd8\x36\xf3\x5c\x14\xd3\x04\x2d\x0a\xa9\x10\x4a\x39\x1a\x6d\xa9\x84\x79\x2e\x62\x08\xeb\x62\x55\x72\xad\x08\xdf\xa9\x2c\x56\x25\x5a\xab\xad\xf3\x91\x18\xc3\xc1\xc8\xa8\xf7\xff\x1d\x59\xa9\xba\x80\x90\x1c\xb1\x2c\x56\xd3\x74\x01\xcd\x16\x76\x9d\xd2\x16\xa1\x43\x85\x96\xa4\xea\x40\x2b\xe8\x2c\x33\x3d\x38\x83\x5c\x0a\x29\x38\x10\x8e\x66\x60\x84\x0e\x02\xa3\x50\x2a\x05\x28\x4d\xb0\xc6\x1f\x50\xd5\x77\x5a\x09\xd9\xd5\x4f\x8c\xef\x59\x87\x50\xe5\x68\xe3\x99\xae\x56\xe5\x34\x9d\x27\xcd\x73\x63\x2c\xb6\x92\x33\xf2\x74\x42\xd3\x37\x49\x3d\x54\xf5\xee\xbe\x7e\x39\x1a\xac\x9f\xf6\xdd\x13\xa3\x3e\x36\x09\x5d\x6a\x98\xe7\x9c\x8c\xaa\x8d\x48\x9e\x24\x2a\x82\xef\x84\x56\xb1\x01\x4c\xbc\x28\x91\x86\xf4\xe7\x93\xf3\x3c\x59\xd0\x86\x8e\x06\x5d\x09\x15\xe4\xe9\x72\xef\x12\x15\x75\xba\x96\xba\x41\x15\x05\x5e\xfc\x6e\x5a\xc9\x06\xe4\x94\xc4\x4c\xe4\x9f\x49\xdb\x38\x5f\xa2\x66\x99\xf2\x9a\xa4\x85\x5d\xdf\x40\x1d\x99\xba\xc5\x5c\x19\x3d\x9f\xee\xf7\xf8\x8c\x3b\x6b\x5b\x49\x52\x2b\x36\xa4\x01\x36\xde\x31\xa9\xa4\xf0\x0f\x00\x6e\x4f\x55\x42\x5b\x88\x85\x7e\xd7\x42\xe2\xd0\xba\xc8\x9c\x1f\x1c\xe9\x11\x82\x14\x51\xb2\x33\xe3\x65\x9d\x2e\x32\x99\x2a\x35\xb8\xbe\x81\xaa\x7e\x8c\xf1\x3c\x4f\x93\x77\x47\x58\xe3\xff\x0e\xed\x7d\xe8\xd1\x46\x20\x57\xdc\x00\x33\xc6\x93\xcc\x07\x3e\x3d\xa6\x2c\xa7\x4d\xda\x89\x70\x83\xc8\x17\x24\x89\x2a\xb3\xef\x22\xf0\x89\x5d\x92\x47\x99\xbf\xc2\xe7\xad\x83\x5b\x7b\xe6\x92\x4d\xd6\xaf\xcc\x61\x84\x36\x9b\xcd\x72\x15\xfe\xe8\xdb\x3d\x2c\xc2\x4f\xf5\x65\xc3\xa0\xdf\xbc\xbc\xa7\xd5\x24\xcd\x1d\xbc\x1e\xc1\x7f\x7b\xbc\x49\x95\x93\x5a\x39\xd0\x16\x0e\xce\x7f\x48\xf2\x23\xab\xbf\x10\x7f\xb9\xe8\xcc\x28\x7a\x8e\x46\x33\x84\x25\x8c\x8c\x78\xff\xf2\xb5\x43\x9a\x6d\x79\xe6\x4b\x5f\x1c\x54\x8c\x5d\xa2\x10\x1e\x7f\xff\x18\x2a\xe6\x54\xa7\xd2\xaf\xcc\xf9\x2d\x9d\xb5\xb1\x52\x91\x80\x32\x3d\x9b\xe6\x2f\xd7\x7c\xca\xf0\xe3\x0d\x6d\xfe\x14\xd9\x93\xcb\x7e\x05\x00\x00\xff\xff\x98\x8a\x70\x5e\x21\x06\x00\x00")
+var _templateImportTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x54\xdd\x6a\xf3\x38\x10\xbd\x8e\x9f\x62\x30\x5e\x48\x02\x95\xbb\xbd\xdb\x42\x2e\x4a\x7f\x20\xb0\x2c\x85\x76\x1f\x40\x95\x47\xb6\x88\x2d\x69\xa5\xc9\x36\xc1\xf8\xdd\x17\xc9\x72\xe2\x6e\xf2\xf5\xbb\xfa\x72\x93\xb1\x46\x73\x7c\xe6\xcc\x19\xf7\x7d\xb9\xce\x1e\x8d\x3d\x3a\x55\x37\x04\x77\xb7\xbf\xff\x71\x63\x1d\x7a\xd4\x04\x2f\x5c\xe0\x87\x31\x3b\xd8\x6a\xc1\xe0\xa1\x6d\x21\x5e\xf2\x10\xf2\xee\x5f\xac\x58\xf6\xde\x28\x0f\xde\xec\x9d\x40\x10\xa6\x42\x50\x1e\x5a\x25\x50\x7b\xac\x60\xaf\x2b\x74\x40\x0d\xc2\x83\xe5\xa2\x41\xb8\x63\xb7\x53\x16\xa4\xd9\xeb\x2a\x53\x3a\xe6\xff\xdc\x3e\x3e\xff\xf5\xf6\x0c\x52\xb5\x08\xe9\xcc\x19\x43\x50\x29\x87\x82\x8c\x3b\x82\x91\x40\xb3\x97\x91\x43\x64\xd9\xba\x1c\x86\x2c\xeb\x7b\xa8\x50\x2a\x8d\x90\xab\xce\x1a\x47\x39\x0c\x43\x36\x86\xb0\xcc\x16\xb9\x30\x9a\xf0\x40\x79\xb6\xc8\xd1\x39\xe3\x7c\x88\x64\x17\x0f\x3a\x4e\x4d\xf8\xf7\xe4\x94\xae\x63\x86\x54\x87\x79\xb6\xe8\xfb\x1b\x28\xd7\xb0\xad\xb5\x71\x08\x35\x6a\x74\xa4\x74\x0d\x46\x43\xed\xb8\x6d\xc0\x5b\x14\x4a\x2a\x29\x80\xb0\xb3\x2d\x27\xf4\x10\x19\xc5\x52\x25\x41\x1b\x82\x25\xfe\x03\x05\x7b\x34\x5a\xaa\x9a\xbd\x72\xb1\xe3\x35\x42\x31\x45\xab\xc0\x74\xb1\xc8\xfb\xfe\xf2\xd2\x30\x94\xd6\x61\xa5\x04\xa7\x40\x27\x82\x7e\x2a\x6a\xa0\x60\xdb\x27\x18\x86\xbe\x1f\x1f\xd9\xfb\xd1\x22\x7b\xdd\xd5\xaf\x9c\x9a\x11\x2f\x02\x32\x18\x86\xa9\x0e\x75\x35\x56\x8c\x41\x3a\x0d\xdd\x8d\x2a\xe1\x81\xd0\x69\xde\x82\x1d\x79\xa5\x46\x20\xfd\x02\xc4\xd4\xe3\x24\x72\x49\x47\x8b\x3e\x87\xe2\x8c\xa7\x24\x14\xec\xcd\x48\x7a\xc2\x16\x09\x27\x2e\xa8\xa9\x36\x4c\x99\x12\x35\x95\x5e\x34\xd8\xf1\xb2\x53\x07\xa5\xbf\xb2\xcb\xbe\xc4\x5f\xaa\xf2\xff\x3d\x97\x95\xe2\x2d\x0a\xca\xb3\xb9\x2a\x6f\x64\xdc\x28\x5c\xc2\x75\x5c\x07\xb1\x93\x13\xee\x37\xc0\xb6\x31\xf4\x33\x95\xa6\xec\x30\x7c\xc7\xe6\xaa\x00\xbc\xaa\x14\x29\xa3\x79\x9b\x54\x58\x65\x67\x85\xb3\xb0\x59\xf0\x70\xae\x92\xc6\xc1\x58\x18\x4c\x24\x15\xb6\x95\x1f\x07\x28\xf6\x9e\x4c\x07\x51\xcf\x51\xf7\x0b\x47\x4f\x62\xdf\x4c\x64\x8a\x04\x70\xbf\x81\x82\xbd\x8c\xf1\xd9\x12\x67\x87\x28\x09\xec\x6f\x8f\xee\x29\xc2\x25\x13\x4c\xc5\x1b\xe0\xd6\x06\xbe\xd3\x01\x9b\x9b\xe4\xdc\xcb\x5c\x4c\x09\xf7\x9b\xd3\xfd\x93\xd2\x85\xdd\xd5\x63\xe2\x8a\x1b\x93\x35\x78\x78\x51\xb8\xb7\x8c\x7b\xd1\x70\x9f\xcc\xb7\xfc\xe0\x1e\xc7\xd4\x6a\x95\x36\x62\x9c\x4d\x38\xfa\x76\x30\xb3\xf0\xaa\xe0\xbc\x6d\xcd\x67\xd0\xfb\x3c\xab\x34\x04\x0f\x1f\x47\x08\x5f\xb9\x60\x7d\xed\x95\xd1\x1e\x8c\x83\xbd\x47\x77\x82\xf0\xec\x07\xd3\x98\x4f\x7e\x62\x14\x47\x59\x50\x67\x5b\x1f\x94\xe8\x38\x89\xe6\x7d\xe2\x72\x59\x58\xae\xf3\x0b\xa3\x86\xe2\x50\x9b\x50\x62\x3a\xe6\x0f\xa7\xa6\x62\x6a\xbe\x73\x57\x44\xf9\x39\x9d\xa5\x75\x4a\x93\x84\x3c\xed\x51\xf9\x9b\x2f\xaf\x32\x3c\x2d\xd5\xea\x57\x91\xed\x7b\x40\x5d\xc1\x30\x64\xff\x0d\x00\xca\xa6\x81\x70\x8b\x06\x00\x00")
 
 func templateImportTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1047,12 +1322,12 @@ func templateImportTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/import.tmpl", size: 1569, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/import.tmpl", size: 1675, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateInternalTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8f\xc1\xae\xd3\x30\x10\x45\xf7\xfe\x8a\xab\x27\x56\x0f\x70\x1e\xdd\xb1\x60\x51\xb5\x45\x54\x42\x2d\x52\xfb\x01\x75\xec\x49\x6d\xd5\x78\x22\x7b\x52\x54\x45\xfe\x77\x94\xa4\x08\xc4\xca\xf2\x1c\x5f\xcf\xb9\xe3\xd8\xbc\xaa\x0d\xf7\x8f\x1c\xae\x5e\xb0\x7a\xfb\xf4\xf9\x63\x9f\xa9\x50\x12\x7c\x35\x96\x5a\xe6\x1b\xf6\xc9\x6a\xac\x63\xc4\xfc\xa8\x60\xe2\xf9\x4e\x4e\xab\xb3\x0f\x05\x85\x87\x6c\x09\x96\x1d\x21\x14\xc4\x60\x29\x15\x72\x18\x92\xa3\x0c\xf1\x84\x75\x6f\xac\x27\xac\xf4\xdb\x1f\x8a\x8e\x87\xe4\x54\x48\x33\xff\xbe\xdf\xec\x0e\xa7\x1d\xba\x10\x09\xcf\x59\x66\x16\xb8\x90\xc9\x0a\xe7\x07\xb8\x83\xfc\xb3\x4c\x32\x91\x56\xaf\x4d\xad\x4a\x8d\x23\x1c\x75\x21\x11\x5e\x42\x12\xca\xc9\xc4\xa6\x58\x4f\x3f\xcd\x0b\x9e\xfc\x57\x10\x8f\x77\xfa\x1b\x99\x49\xa9\xd6\x71\x84\x5e\x0e\x8a\x85\x50\x6b\xd3\x60\x33\xf9\x5f\x29\x51\x36\x42\x0e\xed\x03\x94\xc4\x7e\xc0\xf6\x88\xc3\xf1\x8c\xdd\x76\x7f\xd6\x53\x20\xb9\xf9\xd7\xa6\xc1\xfb\x76\x08\xd1\x41\x98\x63\x99\x07\x3f\x8c\xbd\x99\xeb\x54\x61\xd1\x80\xe7\xe8\x0a\x0c\x22\x1b\x67\xda\x48\xb8\x53\x2e\x81\xd3\x52\x87\x10\x8d\x50\x11\x2c\xb6\x5a\xf5\xff\xe5\x95\xb2\x9c\x8a\xe0\x34\x73\x7c\xc1\x65\x12\x5f\x6e\xa7\x64\xfa\xe2\x59\x50\xeb\x45\xfd\xf5\xfa\x1d\x00\x00\xff\xff\x78\x8a\x42\x42\xd1\x01\x00\x00")
+var _templateInternalTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8f\xc1\x6a\x5b\x31\x10\x45\xf7\xfa\x8a\x4b\xe8\x2a\x6d\xa5\x34\xbb\x2e\xba\x08\x8e\x4b\x0d\x25\x29\xd8\x1f\x10\x59\x1a\x5b\x22\xea\xcc\x43\x1a\xa7\x18\xa1\x7f\x2f\xcf\xcf\x85\x92\x95\x90\x8e\xee\xcc\xb9\xbd\xbb\x5b\xb3\x92\xe9\x5c\xf3\x31\x29\xee\xef\xbe\x7c\xfd\x3c\x55\x6a\xc4\x8a\xef\x3e\xd0\x5e\xe4\x15\x1b\x0e\x16\x0f\xa5\xe0\xf2\xa9\x61\xe6\xf5\x8d\xa2\x35\xbb\x94\x1b\x9a\x9c\x6a\x20\x04\x89\x84\xdc\x50\x72\x20\x6e\x14\x71\xe2\x48\x15\x9a\x08\x0f\x93\x0f\x89\x70\x6f\xef\xfe\x51\x1c\xe4\xc4\xd1\x64\xbe\xf0\x9f\x9b\xd5\xfa\x69\xbb\xc6\x21\x17\xc2\xf5\xad\x8a\x28\x62\xae\x14\x54\xea\x19\x72\x80\xfe\xb7\x4c\x2b\x91\x35\xb7\x6e\x0c\x63\x7a\x47\xa4\x43\x66\xc2\x4d\x66\xa5\xca\xbe\xb8\x16\x12\xfd\xf6\x37\xb8\xf2\x3f\x59\x13\x3e\xd8\x1f\xe4\x67\xa5\x31\x7a\x87\x5d\x0e\x2a\x8d\x30\x86\x73\x58\xcd\xfe\x47\x62\xaa\x5e\x29\x62\x7f\x06\xb1\x86\x4f\x78\x7c\xc6\xd3\xf3\x0e\xeb\xc7\xcd\xce\xce\x01\x8e\x97\xa9\xce\xe1\xe3\xfe\x94\x4b\x84\x8a\x94\x66\x8c\x73\xf8\xe5\xc3\xab\x3f\xce\x15\x16\x0d\x24\x29\xb1\xc1\xa3\x88\x8f\x7e\x5f\x08\x6f\x54\x5b\x16\x5e\xea\x10\x8a\x57\x6a\x8a\xc5\xd6\x9a\xe9\x5d\xde\x98\x20\xdc\x14\xdb\x0b\xc7\x37\xbc\xcc\xe2\xcb\x6d\xcb\x7e\x6a\x49\x14\x63\xbc\x98\xde\x41\x1c\x31\x86\xf9\x3b\x00\x78\x8a\x42\x42\xd1\x01\x00\x00")
 
 func templateInternalTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1072,7 +1347,7 @@ func templateInternalTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateMetaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x5b\x6f\xdb\x3a\x12\x7e\x96\x7f\xc5\xc0\x50\x81\x38\x48\xe5\xb6\x6f\x1b\xc0\x0f\xdd\x5c\x9a\x60\x8b\xa0\x40\xd3\xbe\x2c\x16\x0b\x5a\x1c\x5a\x44\x64\xd2\x25\xa9\xa4\x39\x82\xff\xfb\x01\x87\x94\x44\xf9\xd2\x9c\xb6\xe7\xbc\x24\x12\x39\x9c\xcb\xc7\x99\x6f\x46\x6e\xdb\xf9\xe9\xe4\x42\x6f\x9e\x8d\x5c\x55\x0e\xde\xbd\x79\xfb\xaf\xd7\x1b\x83\x16\x95\x83\x6b\x56\xe2\x52\xeb\x07\xb8\x55\x65\x01\xef\xeb\x1a\x48\xc8\x82\xdf\x37\x8f\xc8\x8b\xc9\x7d\x25\x2d\x58\xdd\x98\x12\xa1\xd4\x1c\x41\x5a\xa8\x65\x89\xca\x22\x87\x46\x71\x34\xe0\x2a\x84\xf7\x1b\x56\x56\x08\xef\x8a\x37\xdd\x2e\x08\xdd\x28\x3e\x91\x8a\xf6\x3f\xde\x5e\x5c\xdd\x7d\xbe\x02\x21\x6b\x84\xb8\x66\xb4\x76\xc0\xa5\xc1\xd2\x69\xf3\x0c\x5a\x80\x4b\x8c\x39\x83\x58\x4c\x4e\xe7\xdb\xed\x64\xd2\xb6\xc0\x51\x48\x85\x30\x5d\xa3\x63\x53\x08\x8b\xaf\xe1\x49\xba\x0a\xf0\xbb\x43\xc5\x21\x87\xe9\x27\x56\x3e\xb0\x15\x4e\x21\x2f\xe2\x23\xbc\xde\x6e\x27\x59\xdb\x82\xc3\xf5\xa6\x66\x0e\x61\x5a\x21\xe3\x68\xa6\x50\x78\x2d\x6d\x0b\xfe\x6c\x34\x32\x08\xc9\xf5\x46\x1b\x37\x85\x9c\xb6\x4a\xad\xac\x83\x93\x49\x36\x9f\xc3\x47\xb6\xc4\x1a\x2a\x5d\x73\x4b\x51\x58\x67\xa4\x5a\x41\x4d\xcb\x1c\x95\x76\xfe\xd5\xef\xb4\x2d\xd4\xfa\x09\x0d\xe4\xc5\x1d\x5b\x23\x6c\xb7\xe0\x9e\x37\x7d\xf8\x9c\x39\xb6\x64\x16\x8b\x49\x16\x74\x2e\x60\xda\xb6\x90\x17\xe1\x6d\xbb\x9d\x92\x3d\x5a\xba\xbd\x2c\x2e\xbc\x0f\x4c\x39\xaf\x66\xcf\xfa\xc8\xae\xe4\x20\x24\xd6\xfc\x80\xa1\x43\xca\x3a\xb3\xb7\x97\xc5\x67\xa7\x0d\x5b\xe1\x7f\xf0\x39\x98\xf7\x10\x1b\xa6\x56\x08\xb9\x80\xf3\x05\xe4\xc5\xb5\x57\x6c\x3d\x28\x19\xed\xe6\xc1\x92\xdf\x13\xa9\xd6\x49\xd6\xf9\x1e\x04\x5e\x74\x7a\x00\x4b\xf4\x68\x1d\x8b\x22\x1b\xe9\x8d\xfe\x8b\x83\xde\xc7\xcb\x4d\x23\xc1\x10\xc9\x15\x5f\x61\x1a\x08\xf2\x55\xd8\xc1\xc3\x71\xd0\xfe\x4f\x84\x81\x7d\x18\x74\x52\xf9\x17\xa9\x60\xdd\x38\xe6\xa4\x56\xb6\x8b\xa3\xd3\x1b\xc3\xe8\x8f\x1d\x08\x20\x77\xeb\x4d\xed\x7d\xdc\x18\xa9\x9c\x80\x29\x97\xac\xc6\xd2\xcd\x5f\xd9\xb9\xaf\x8b\x79\x19\x1d\xb7\xbe\x02\x22\x1c\xfd\xe9\xef\x7d\x76\x07\x3d\x94\xda\x33\xca\xfb\xb0\x70\x5c\xef\x23\x33\x92\x2d\x6b\xdc\xd5\xdb\xb6\x20\x05\x54\xcc\xde\x8f\x55\xc7\x9a\x3b\x68\x71\x5c\x71\x2f\x59\x16\x8d\x2a\x09\xae\xbf\xdb\xf2\xfc\x14\x6e\x98\x05\xe6\xa0\x46\x66\x1d\x68\x85\x31\xdf\x4e\x94\x76\x80\xaa\x59\xcf\x02\xbd\x70\x14\xac\xa9\x1d\x3c\xb2\xba\x41\x20\x42\xea\xf3\xcf\xee\x54\x45\x70\x8b\x6a\xe9\x8b\x45\x73\x49\xa4\xc5\xc3\x46\x77\x62\x01\x6c\xb3\x21\xc2\x8a\x0b\x5e\x3c\x88\x44\xf7\xbc\x70\xc5\xec\x65\x34\x7c\xbe\x00\xc1\x6a\x8b\x41\x66\x54\x8f\x62\x6c\x98\x91\xd6\xa2\x3b\x48\x91\xe4\xa2\xb8\xb5\x57\x14\x4e\x70\x23\xd1\xbc\x00\x67\x1a\x4c\x6d\xef\x62\xf4\x01\x15\x1a\x8f\xe3\xaa\xd6\x4b\x56\x43\x9f\x09\x20\xb4\x81\x4a\xeb\x07\x7b\xe6\x91\x91\x9c\x39\x6d\x2c\x79\xb0\xd1\xb5\x2c\x9f\xa1\xac\xb0\x7c\x40\x63\x7b\xc8\xa4\x00\x6d\x46\xf6\xf3\xe2\x86\xd9\xaf\xc3\xe9\xbc\xb8\x6b\xd6\x37\x5e\x69\x78\xfc\x14\x34\xf5\xf9\xaf\xba\x5d\x42\xbd\x97\xed\xf6\x09\xfa\xf1\xb1\x9d\x73\x0b\x60\x9c\x27\xef\x6f\xfb\xb3\x69\x9d\x79\x3d\x2a\x55\xee\x39\xe0\x4e\x3b\x04\x57\x31\x47\x75\x3e\xe0\xb0\xc4\x5a\x3f\x01\x33\xbe\xba\xa5\x93\xac\x96\x7f\x20\x87\xe5\x73\x68\x71\x8d\x72\x72\x8d\x41\xc3\x26\xb6\x24\x1d\x08\xad\x17\x27\x3e\x08\xed\x0f\x7d\x6e\xd4\xb2\xa4\xa5\x02\xee\x2b\x34\x28\xb4\xc1\xb3\xa0\x41\x3a\xb0\x95\x6e\x6a\x0e\x4b\x84\xd0\xa2\xb0\x27\xc8\x35\x93\x0a\x98\xbf\x98\xba\xd6\x4f\xf6\x9c\x8e\xd0\x9f\x2c\x88\xc2\xff\x23\xd3\x5f\x68\x25\xe4\xaa\x6f\x91\xdb\xed\x3c\xfa\x39\x8d\x67\x52\x40\x1e\x99\xf1\x9d\xef\x08\x30\x59\x78\xfe\xaf\xd7\x9b\xec\xfc\x0f\x95\x2b\xfc\x4b\x3c\xd8\x29\x3b\x7a\x4d\x59\x7c\xf1\xe7\xc2\xe3\xa1\x93\xff\x64\xd1\x65\xfb\xdd\x4e\x24\xcd\xae\xf3\xfc\xc5\x12\xf3\xb2\xc1\x59\x3e\xd4\xef\x70\x22\xb2\x3b\x49\xc5\xce\xd2\xc9\x8d\x9a\xcb\x98\x75\xb4\x82\xd2\x60\x48\x14\x5f\x78\x5e\x22\x76\xbd\xae\x5d\x04\xf6\x2a\xa2\xf9\x91\xd6\x08\x50\xef\xc3\x75\xa3\x4a\xd8\x6e\x3d\xb7\x9e\xcc\x20\xad\xfd\x5c\x14\xf7\x7e\x44\x19\x42\xee\xd1\xe9\xaf\x4e\x14\x5f\x36\x9c\x39\xbc\xec\x0d\x1c\x0b\x79\x24\xf7\xcb\x81\x37\xa4\xe5\x77\xc2\xbe\xb5\xf7\x92\xa4\x7f\x21\x62\x6a\x01\xb9\x28\x12\x96\x4a\x03\xa6\xb6\x1e\xa2\xed\x25\x46\x02\x34\xf1\x9d\x2f\xa0\xef\x70\xde\x07\x38\x79\x65\x67\x80\xc6\x68\x33\xed\x3c\x48\xdd\xe8\x00\x52\x71\x82\x90\x16\xd8\xc0\xb2\x2f\x40\x01\xb7\xce\x1f\x28\x59\x5d\x0f\x44\xb4\x6c\x64\xcd\x3d\x19\x2f\x89\x4f\xc0\xb2\x47\x1c\x40\xeb\xec\x50\x43\x3e\x82\x46\xfa\x32\xdb\xeb\xa5\x71\x3c\x2f\x1b\xeb\xf4\x3a\x8c\xb9\xde\x4b\xdf\x46\x21\x96\x51\xd7\x06\xc6\x03\xa5\x2f\x9b\x64\xa8\xa4\x11\xc8\x1f\x0a\x98\xf6\x98\xf8\x75\x83\x25\xca\x47\x34\x7e\xaf\x7f\xce\x45\xf1\xef\x10\xdb\x75\x1c\x08\x49\x38\x5c\xfc\x0d\xb3\x1f\xf4\x80\xeb\x6e\x1d\xc4\x30\xc3\x74\x1f\xb0\x1c\x27\x2b\xf4\xee\xa4\x73\x66\x94\xf9\x4a\x09\x4a\x83\x5a\x96\xd0\x88\x7f\x0c\xcd\x9a\xd6\xe7\xa7\xa0\xd7\x32\x34\x8d\xae\x01\x10\xdc\xc2\x78\xa0\x2a\x24\xb0\x8a\x80\x4e\x36\xc4\xef\x5b\xb3\x5c\x77\x14\xdd\xe5\xc8\xe7\x30\x72\xe6\x09\x77\x27\x13\x6a\x74\x34\xdc\x85\xed\x95\x1f\xcd\x97\xe1\x76\x7c\x2a\x90\x68\xaa\x27\xcc\xb7\x93\x49\x5a\xfa\x63\xe4\xfc\xfa\xfc\x14\x40\x48\xc5\xc9\x02\x1d\xa5\x26\x79\xa4\xa0\x7d\xa0\xe1\x9b\x2c\xdb\x9f\xc9\x05\x65\xc3\xa8\xc0\xa4\x00\xfc\xe6\xa7\xe2\x80\xf6\x3e\xfa\x24\xd9\x23\xd0\xcf\xdc\xf2\x20\x99\x88\x6e\x46\xef\x63\xf4\xff\x43\x1d\xfc\x28\x0d\x16\x63\xed\xbd\x77\x63\xaa\x38\x54\x2a\xfb\xb7\x43\xae\xd0\xf4\xdf\x7f\x55\xfe\x15\x28\xd2\xe0\x0e\x64\x65\x07\x50\x48\x47\xd2\x37\xf8\x33\xf3\x6e\x04\xde\x19\xd5\xd1\x58\xd5\x0c\x42\x76\x9d\xcc\xba\x2f\x9b\xd6\xab\x32\xe8\x1a\xa3\xe2\xd2\xee\xf9\xd9\x24\xcb\x62\xce\xc7\x78\x27\x03\xa1\x1c\xa2\xc5\xdf\x20\xb6\x90\x5c\x11\xbe\x9f\x21\x39\x8a\x3c\xb1\xfa\x63\x10\x88\x95\x29\x74\xfb\x24\x5d\x59\xc1\x9e\x34\x71\x06\xb3\x94\x48\xf1\xd2\xe4\xd9\xfe\xc5\x05\xb6\x51\x7e\x17\xde\xc0\x76\x7b\x96\xb6\x9d\x7d\x7e\xda\xbd\xc6\x81\x47\x46\x97\xbf\x3f\xa9\x9f\x53\x86\xc4\x6b\x52\xb2\xf6\xaf\x31\xef\x47\x5b\x62\xed\x8a\x2b\x1f\x9c\x38\x09\x63\xe0\xc0\x21\xe7\x20\x15\xdd\x42\x82\x31\x5d\xc6\xe8\x1e\xc2\x35\x9c\xc3\xab\x6f\xd3\xb3\x5d\x54\x62\x22\x1c\xff\x41\x85\xbe\xe6\x18\xe7\xd2\xcf\x30\xac\xee\x7e\x59\x69\xdb\xd8\x61\xfd\x67\x1a\x0d\x76\x6b\xe6\xca\xea\xfe\xd8\xb9\xf9\xe9\xb4\x63\xd9\x08\x7d\xf7\x4d\x1c\x35\x74\x2c\x7a\xf0\x0b\x30\x1b\x7d\x63\x25\x7e\x8e\x7a\xd9\xfb\xc1\x6d\xa2\xb2\x92\x29\x3f\x6f\xeb\x47\x34\x46\x72\x8e\xca\x4f\xdc\xda\xd0\x4f\x5f\x9a\x3e\x28\x06\xff\xc2\x6f\x64\x5d\x1e\x13\xa5\x46\xd6\x2f\xfa\x06\x98\xfe\x94\x35\x82\x24\x1d\x47\xff\x0c\x00\x00\xff\xff\xdc\x33\xeb\x4a\xb7\x13\x00\x00")
+var _templateMetaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\x5b\x8f\xdb\xb8\x15\x7e\x96\x7e\xc5\x59\xc1\x01\xec\xc1\xac\x9c\xdd\xb7\xba\xf0\x43\x9a\x4c\x92\x41\xd3\x60\x17\x99\x6c\x1f\x8a\xa2\xa0\xa5\x23\x8b\x18\x8a\x74\x48\xca\xb3\x53\x41\xff\xbd\x38\xbc\xe8\x62\x7b\x32\xd9\xb4\xe8\xcb\x8c\x45\xf2\xdc\x2f\xdf\x21\xbb\x6e\x7d\x95\xbe\x56\x87\x47\xcd\xf7\xb5\x85\x9f\x5f\xfe\xf4\xa7\x1f\x0f\x1a\x0d\x4a\x0b\x6f\x59\x81\x3b\xa5\xee\xe1\x56\x16\x39\xbc\x12\x02\xdc\x21\x03\xb4\xaf\x8f\x58\xe6\xe9\x5d\xcd\x0d\x18\xd5\xea\x02\xa1\x50\x25\x02\x37\x20\x78\x81\xd2\x60\x09\xad\x2c\x51\x83\xad\x11\x5e\x1d\x58\x51\x23\xfc\x9c\xbf\x8c\xbb\x50\xa9\x56\x96\x29\x97\x6e\xff\xc3\xed\xeb\x9b\x8f\x9f\x6e\xa0\xe2\x02\x21\xac\x69\xa5\x2c\x94\x5c\x63\x61\x95\x7e\x04\x55\x81\x9d\x08\xb3\x1a\x31\x4f\xaf\xd6\x7d\x9f\xa6\x5d\x07\x25\x56\x5c\x22\x64\x0d\x5a\x96\x81\x5f\xfc\x11\x1e\xb8\xad\x01\x7f\xb7\x28\x4b\x58\x40\xf6\x0b\x2b\xee\xd9\x1e\x33\x58\xe4\xe1\x27\xfc\xd8\xf7\x69\xd2\x75\x60\xb1\x39\x08\x66\x11\xb2\x1a\x59\x89\x3a\x83\x9c\xb8\x74\x1d\x10\x6d\x10\x32\x1e\xe2\xcd\x41\x69\x9b\xc1\x82\x0e\xa5\x85\x92\xc6\xc2\x32\x4d\xd6\x6b\xf8\xc0\x76\x28\xa0\x56\xa2\x34\xce\x0a\x63\x35\x97\x7b\x10\x6e\xb9\x44\xa9\x2c\x7d\xd2\x4e\xd7\x81\x50\x0f\xa8\x61\x91\x7f\x64\x0d\x42\xdf\x83\x7d\x3c\x0c\xe6\x97\xcc\xb2\x1d\x33\x98\xa7\x89\xe7\xb9\x85\xac\xeb\x60\x91\xfb\xaf\xbe\xcf\xd2\x64\xb0\x71\x91\xdf\xbe\x21\x55\x12\x52\xa1\xeb\x20\x7f\x4d\x2a\x31\x69\x89\xeb\x99\x32\x33\x35\x78\x09\x15\x47\x51\x5e\x90\x9b\x9c\x72\xf2\x2a\xe4\x9f\xac\xd2\x6c\x8f\x7f\xc5\xc7\x51\x8d\xe0\x26\xa7\x92\x66\x72\x8f\xb0\xa8\x60\xb3\x85\x45\xfe\x96\xb8\x1b\xaf\x1d\xed\x2e\xbc\x38\xda\xab\xa6\xdc\x07\xe5\xc3\x81\x67\x35\x1f\x1d\x58\x0d\x1e\xfc\x9a\x29\x23\xdf\xe0\xca\xea\x5b\x2d\x41\x6f\xc9\x4d\xb9\xc7\xa9\x21\x58\xee\xfd\x0e\x5e\xb6\xc3\xed\xff\x01\x33\x70\x30\xc3\x51\x4a\xfa\xe0\x12\x9a\xd6\x32\xcb\x95\x34\xd1\x8e\xc8\x37\x98\x31\x90\x5d\x30\x60\x61\x9b\x83\x20\x1d\x0f\x9a\x4b\x5b\x41\x56\x72\x26\xb0\xb0\xeb\x17\x66\x4d\xb5\xb2\x2e\x82\xe2\x86\xaa\x22\xb8\x63\xa0\xfe\x7d\xc8\x78\xcf\xc7\xa5\xfb\xca\xd5\x82\x5f\x78\x9a\xef\x91\x69\xce\x76\x02\x4f\xf9\x76\x1d\xf0\x0a\x6a\x66\xee\xe6\xac\x43\x1d\x5e\x94\x38\xaf\xc2\xe7\x24\x57\xad\x2c\x9c\xbb\xfe\xd7\x92\xd7\x57\xf0\x9e\x19\x60\x16\x04\x32\x63\x41\x49\x0c\xa5\xb3\x94\xca\x02\xca\xb6\x59\xf9\x96\x53\x62\xc5\x5a\x61\xe1\xc8\x44\x8b\xe0\x9a\xd4\x90\x7f\xe6\xa4\x2a\xba\x6e\x56\xc2\xde\x3f\xf9\x67\x83\xfa\x8d\xeb\x69\x24\x7d\x42\xbc\x05\x76\x38\x90\x4e\x71\x81\xfa\xd4\xa0\xe6\xa8\x2f\x91\xd4\xcc\xbc\x09\x9a\x6c\xb6\x50\x31\x61\xc8\x11\x5d\x17\xd3\xda\x17\x68\xe0\x13\x45\x33\xc7\x3b\x8f\x84\xce\xb4\x45\x95\xdf\x9a\x1b\x67\x5f\xdf\x9f\x70\xde\x82\xd5\x2d\x5e\x56\xc2\x3b\xed\x1d\x4a\xd4\xe4\xd8\xbd\x50\x3b\x26\x60\x48\x0d\xa8\x94\x86\x5a\xa9\x7b\x73\x0d\x5c\x5a\xd4\x05\x1e\xac\xd2\xe6\x9a\x1c\xc7\x4b\x46\xbf\x9d\x3e\x07\x25\x78\xf1\x08\x45\x8d\xc5\x3d\x6a\x33\x78\x94\x57\xa0\xf4\x4c\x9b\x45\xfe\x9e\x99\xdf\x46\xea\x45\xfe\xb1\x6d\xde\x93\x08\x6a\xb3\x6d\x73\x3b\x11\xe3\x57\x7e\xf1\xbc\x87\x82\x91\xf1\xfc\x66\x3b\xa5\x8e\xfb\xbc\x3a\x23\x3b\xa1\xdb\x02\x2b\xcb\xc9\xf7\x4f\x03\x6d\xf0\x4a\xe4\xa3\xf4\xe4\xd4\xb9\x76\xb1\x93\x7c\x54\x16\xc1\xd6\xcc\xba\x6e\x31\x3a\x6f\x87\x42\x3d\x00\xd3\xd4\x23\xb8\xe5\x4c\xf0\x7f\x63\x09\xbb\x47\x77\x4c\xb7\xd2\xf2\x06\x3d\x87\x43\x00\x3b\xe5\x3b\xfc\x70\xdc\x75\x15\x0f\xac\x48\x69\x25\x78\xe1\x96\x72\xb8\xab\x51\x63\xa5\x34\x5e\x7b\x0e\xdc\x82\xa9\x55\x2b\x4a\xd8\x21\x78\xf0\xc3\xa1\xcd\x36\x8c\x4b\x60\x06\x2a\x25\x84\x7a\x30\x1b\x47\xe2\xfe\x24\xfe\x28\xfc\x2b\x40\xd7\x6b\x25\x2b\xbe\x1f\xc0\xb7\xef\xd7\x41\xcf\x2c\xd0\x4c\xbd\x74\x64\x9a\x30\x75\xf0\xfa\xe0\x2a\xda\x4c\x12\x1f\x96\x7f\x74\xdd\x6c\xe7\x9f\x28\x6d\x4e\x5b\x69\x32\x63\x36\x85\xca\x8b\x8e\x4e\x66\x4b\xc4\x36\x8f\xec\x26\x3b\x97\xb8\x5e\xca\x88\x24\x7c\x90\x36\xfe\xe7\x25\xca\xff\x6b\x43\x48\xce\xa1\x39\x10\xb8\xcd\x68\xca\xb3\xe5\x4f\x67\xdd\xe1\x45\xec\x72\x9b\xed\x84\x22\x40\x91\x3b\x15\x60\x30\x9e\x9b\x21\x61\x5c\xf4\x2d\x52\x49\x28\x34\xba\xe4\x73\x4d\x81\x12\x32\x40\x74\xc4\x36\xdf\x6a\xf3\x20\x7e\xc6\xd5\x77\x82\x51\x87\xb7\xad\x2c\xa0\xef\x09\x08\x96\x2b\x98\xfa\x62\x51\xe5\x77\x34\x63\x8d\x26\x0f\xde\x19\x62\x59\xe5\x9f\x0f\x25\xb3\x18\x98\x7d\xc5\xe4\xd9\xb9\xef\x36\xbc\x75\x5c\xfe\x1b\xb3\x6f\xcd\x1d\x6f\xf0\xfb\x2c\xf6\xd8\x53\xe5\x93\x9e\x39\x35\xd8\xcd\x20\x9b\xed\xec\x44\xa0\x0e\xf3\x05\x31\xdf\x6c\x61\x80\x63\xf2\x3a\x2c\x5f\x98\x15\xa0\xd6\x4a\x67\x51\x83\xa9\x1a\xd1\x41\x32\x8c\x3b\xdc\x00\x1b\x7b\xfe\x33\xae\x80\x5b\x4b\xb7\x8c\x82\x09\x31\xf6\xbb\x5d\xcb\x45\x49\xd0\xb0\x73\x6d\x0b\x0c\x3b\xe2\xe8\xb4\x28\x87\xf8\xd9\x27\xbc\x31\xfd\x58\x9d\x01\x7f\xb8\x5f\x14\xad\xb1\xaa\xf1\x73\x3a\x69\x49\x98\x0f\xa1\x8c\x22\x28\xcd\x4a\x2c\x27\xd4\x1c\x4a\x9b\x20\x04\x16\x8e\x68\xb3\x9d\x85\x86\xd6\x35\x16\xc8\x8f\xa8\x89\x70\xf8\xbd\xa8\xf2\xbf\x78\xdb\xde\x86\xe9\xd5\x31\xf1\x81\x7f\xcf\xcc\x3b\x35\xfa\x75\x58\x9f\xa7\xae\x1b\xf1\x28\x5b\xc6\x9d\xe0\x52\x18\xd4\x99\x0e\xc5\xe1\xcc\x6f\xae\x32\xdd\x58\x9c\x8c\xee\x70\x3f\xfd\x20\xe1\x5a\xda\xfa\x0a\x54\xc3\x3d\x36\x45\x9c\x71\xee\xae\x34\x39\xaa\x46\xe7\xac\xdc\x43\x76\x18\x5f\x49\x20\x8d\x0d\xbc\x89\x48\x10\x73\xe4\x93\x9f\x8f\xc7\xfb\xd9\x6c\x9c\x0e\x8a\xfa\x58\x98\x81\xf9\x93\xf9\x32\x46\x87\x52\xc1\x1d\x9d\xf2\xf1\xb7\xa1\x34\xc4\xfe\x92\xe7\x28\x27\xd6\x57\x00\x15\x97\xa5\x93\xe0\x48\x1d\x16\x3f\x51\xd0\x64\xa8\xbf\x54\xce\xfa\x6d\xac\x21\xca\x86\x59\x81\xf1\x0a\xf0\x0b\x5d\x20\xbc\xb7\xcf\xbd\x9f\x26\x93\x7a\x19\x2f\x08\xfc\x62\x33\xa9\xe2\x85\x62\xb0\x91\xfe\x93\xf1\x5f\x4f\x83\xed\x9c\xfb\xa0\x5d\x8c\xf9\xd3\xa5\x72\x1e\x1d\xa7\x8a\x21\x99\xc3\xb5\xf8\x5b\x5c\x31\x35\xee\x42\x56\x46\x07\xf9\x74\x74\xfc\x46\x7d\x56\x14\x43\xdf\x77\x66\x75\x34\x67\xb5\x02\x9f\x5d\xcb\x55\xbc\x86\x75\x64\x99\x46\xdb\x6a\x19\x96\x4e\xe9\x57\x69\x92\xf4\xd3\x0c\xc9\xdf\x22\xb3\xad\xc6\x1b\x49\x43\x58\x09\xd9\x5e\xb3\x43\xfd\x45\x64\xc1\x92\xf5\x1a\xfe\xc6\xb4\xa9\x99\x78\xf7\xeb\x07\x9a\x94\x04\x36\x28\xad\x8f\x56\x38\x9b\x87\x13\xa8\xfd\xd4\x5b\xb1\xc2\xf7\xaa\x6f\x30\x61\x64\xbe\x7c\x00\xae\xf2\xbf\x6b\x6e\x51\xaf\xbc\x29\x49\x5c\x08\x86\x3e\x5c\x93\x59\x85\x92\xc7\xfc\xd7\x56\x59\x3c\x65\x1d\xca\x6d\xb9\x5a\x91\x9d\xde\x50\x0a\xe7\x67\xd9\x3c\x6b\xc3\x70\xe6\x1b\xad\xb8\x9a\x99\x31\x95\xb0\x3c\x32\x31\xb2\xe8\xfa\x00\x1c\xc1\x24\x63\xf5\x35\xa8\x7b\x4a\x9a\x23\x13\xf9\xd2\xc7\xc9\xe9\x9b\xf0\x0a\x7e\x50\xf7\xe1\x60\x0c\x64\xd5\xd8\xfc\x86\x90\xa7\x5a\x66\x4e\xde\x8b\x3b\x68\x5a\x63\x69\x72\x65\x21\xcc\x99\xbb\x61\x78\x26\x94\x40\x49\x72\x75\xaa\x6f\x28\x09\x62\xd0\xf7\x24\x75\x10\x89\xda\x75\xe8\xae\x3b\x05\xc5\xe5\x29\x93\xd5\x9f\xc9\x14\xf8\x61\x0b\x92\x8b\xaf\xa8\xf9\xc2\x10\xa0\xd1\xa4\x15\x50\x70\x2a\x3b\x73\x41\x9c\xea\x1a\x38\x48\x2e\xd2\xb0\x36\xad\xce\x09\x72\xa5\x23\xf8\x5d\x82\xf0\x50\xb8\xdf\x03\xc2\xee\xb2\x1b\x4b\xfd\x8f\x00\xb2\x4b\xf1\x89\xd4\xb3\x3c\x99\x58\x3e\x4d\x04\xf3\xc0\x6d\x51\xc3\xd9\x69\xf2\x40\xc1\x8c\x6b\x7a\xa1\xc1\xf0\xeb\xf3\x26\xe3\x91\x51\xd2\x2e\xbc\x84\xbe\xbf\x1e\xbc\x74\x11\x4b\x4f\x5b\xce\x88\x79\xb3\x46\x35\x65\xe2\xdd\xbf\xb9\x10\xa0\xd0\xa3\x37\xe9\x13\xd1\xef\xba\x19\xde\x6d\x80\x4b\x9f\x04\xa3\x8f\x5d\x30\x66\x71\xf0\x61\xd8\xc0\x8b\x2f\xd9\xf5\xa9\x57\x28\x57\xfa\x34\x39\x7d\x37\x19\xde\x37\xdc\x23\xe9\x9a\x95\x25\xa7\x79\x9b\x89\xf8\x8c\x39\x5c\x3c\xe8\x59\xc4\xdd\x4a\x1a\x66\x8b\xfa\xee\x29\xba\xf5\x55\x16\x33\x2e\xb8\x3e\x3e\x36\xb9\x17\x94\xd0\xdc\x9f\x7a\x5a\x99\x24\xea\x5c\xcf\xf1\xe7\xfa\x0a\x5e\x8d\x6a\x3b\xd8\x2d\x98\xa4\x42\x56\x47\xd4\x9a\x97\x25\x4a\xba\x84\x2a\xed\xde\x99\x95\xbb\x78\x8f\xfa\xf9\x07\xe9\x98\xc7\x0e\xfe\xc3\x84\x12\xc6\x91\x93\x77\xe3\x99\x4b\x26\x41\x4d\xff\x33\x00\x70\xea\xf3\x6e\x24\x17\x00\x00")
 
 func templateMetaTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1087,12 +1362,12 @@ func templateMetaTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/meta.tmpl", size: 5047, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/meta.tmpl", size: 5924, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateMigrateMigrateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x56\x5f\x6f\xdb\xb6\x17\x7d\x96\x3e\xc5\xfd\xe9\xb7\x75\x76\xe1\x50\x69\x86\x01\x9b\xd7\x3c\x74\x71\x3a\x18\xeb\xb2\x0e\x69\xd1\x3d\x96\x16\xaf\x24\x22\x14\xa9\x92\x57\x96\x0d\xc3\xdf\x7d\x20\x29\xf9\x4f\x9b\x60\x7b\xd8\xb0\xbc\x18\xb9\x97\x3c\xe7\x9e\x7b\x2e\x49\xed\x76\xf9\xf3\xf4\xc6\xb4\x5b\x2b\xab\x9a\xe0\xea\xf2\xc5\x0f\x17\xad\x45\x87\x9a\xe0\x35\x2f\x70\x65\xcc\x03\x2c\x75\xc1\xe0\x95\x52\x10\x16\x39\xf0\x79\xbb\x46\xc1\xd2\x77\xb5\x74\xe0\x4c\x67\x0b\x84\xc2\x08\x04\xe9\x40\xc9\x02\xb5\x43\x01\x9d\x16\x68\x81\x6a\x84\x57\x2d\x2f\x6a\x84\x2b\x76\x39\x66\xa1\x34\x9d\x16\xa9\xd4\x21\xff\x66\x79\x73\x7b\x77\x7f\x0b\xa5\x54\x08\x43\xcc\x1a\x43\x20\xa4\xc5\x82\x8c\xdd\x82\x29\x81\x4e\xc8\xc8\x22\xb2\xf4\x79\xbe\xdf\xa7\xe9\x6e\x07\x02\x4b\xa9\x11\xb2\x46\x56\x96\x13\x66\x10\xe3\x17\xd0\x4b\xaa\x01\x37\x84\x5a\xc0\x57\x90\xbd\xe5\xc5\x03\xaf\x30\x3b\x59\x79\xb1\xdf\xa7\xc9\x6e\x07\x84\x4d\xab\x38\x21\x64\x35\x72\x81\x36\x03\xe6\x51\x76\x3b\xf0\x7b\x3d\x9e\x6c\x5a\x63\x09\x26\x69\x92\x15\x46\x13\x6e\x28\x4b\x93\xac\x6c\x28\x4b\xd3\x24\x43\x4d\x95\x61\xd2\xe4\xa8\x29\x17\x92\x2b\x2c\x42\xfe\xb1\x78\xee\x3e\xa9\xdc\x15\x35\x36\x3c\x4b\xa7\x69\xba\xe6\xd6\xc3\xe6\x39\x7c\x90\x54\xff\xac\xcc\x8a\xab\xf7\x5a\x7e\xea\x70\xb9\x00\x87\xe4\x42\x47\x3a\x2d\xd7\x68\x1d\x57\x20\x85\x03\xd3\x92\x34\xda\x01\x99\x90\x8c\x7a\xa4\xd1\x2c\xe0\x2c\x87\x76\xc5\x55\xde\x16\xd4\x7c\xa5\x50\xcc\xc0\x5b\x7b\x58\x0d\xbd\x54\x0a\xb8\x52\xa6\xf0\xda\x39\xbc\x78\xf9\xf2\xdb\x2b\xb0\x5c\x57\x18\x80\x4a\x13\x2d\x0c\x94\x25\x20\x2f\x6a\x8f\x20\x69\x0b\x13\xf2\x88\xd3\x48\x78\x67\x08\x81\x6a\x4e\x67\xbc\x05\xd7\xda\x10\xac\x10\x78\xdb\x2a\x89\x02\x8c\x86\xb0\xcd\xc5\xc5\x5c\x59\xe4\x62\x0b\xb8\x91\x8e\x58\x9a\x3c\xa2\xff\x1a\x62\xa7\xd8\x97\xb9\x43\xcb\x16\xd6\xb4\x37\x46\x75\x8d\x3e\xb6\x4b\x58\xd3\x42\x11\x83\x43\x39\xff\x44\xaf\x02\xac\x51\x62\x80\x76\x01\x22\x68\xe9\xd1\x22\x74\x7e\xf2\x7d\xd3\x56\x86\x6a\x28\x25\x2a\xe1\x80\x6b\x01\x28\x2a\x74\x0c\xc2\x89\x11\x58\xf2\x4e\x51\x30\xaf\xe4\xca\xe1\xa0\xfc\x44\xc6\x99\xea\x63\xfc\x4c\xf1\x52\x0b\xdc\x7c\x26\x58\x86\xd8\xbf\xa1\x37\x20\xe3\xe7\x7a\xe3\xc9\x13\xe3\xa9\x1d\x8a\x7e\x5a\xe6\xd9\xa8\x74\xc1\x47\x28\x8c\x76\x64\xb9\xd4\xe4\x80\x9f\x60\x76\x4e\xea\x0a\x3e\xbe\xbf\x5b\xfe\xfe\xfe\x16\x96\x77\x8b\xdb\x3f\x3e\xce\x02\x84\x6f\x28\xd5\x68\xb1\x34\x16\x67\x20\xe9\x1b\x7f\x2b\x15\xa6\x69\x50\x0b\x14\x9e\x30\x6a\x3a\x53\x4a\x06\x2a\x24\x68\x8c\x1d\x66\x5b\xe1\x46\xae\xa4\xf2\xc3\x7c\x56\x3f\x14\xb5\x3f\x00\xee\xc4\x96\xd8\xeb\x2f\x5c\x09\xe1\x83\x29\xaf\xe5\x86\x3a\x8b\x47\x4b\x7c\x79\xb2\xd2\x17\x0f\xb8\x05\x8b\x9a\x37\x5e\xd0\x13\xe6\x40\x5f\xa3\x86\xae\xad\x2c\x17\x52\x57\x01\xd4\xfb\x51\x5a\xd3\xc0\xfa\x92\xbd\x60\x97\x30\x91\xce\x75\x78\xf1\xff\xab\xef\xbf\x9b\x32\x58\x3c\x31\x46\x63\x19\x67\xd5\x0e\xc1\x63\xad\xb1\xb4\x5f\x70\x3b\xfa\xef\xa0\xb0\xc8\xc9\x97\x78\x5a\xb7\xd4\x63\x57\x16\x8b\x37\x8f\x38\x4b\xb6\x3b\x10\x9f\x60\x9e\x93\x1f\x13\xfe\xbe\xcb\x73\xb8\x8f\x90\x32\xf6\xe9\xd5\xdb\x65\x38\x34\x63\x01\xb3\xb1\x2d\xba\x0a\x5e\xfb\x21\x6c\xc3\x3f\x23\x6a\x4a\xdb\x16\x47\x14\x47\xb6\x2b\x08\x76\x69\x22\xec\x1a\xc6\xbf\xe1\xb2\x65\x0b\xeb\xef\xcd\x34\x39\xdc\x9f\xcb\x05\xac\x8c\x51\xe9\x3e\x54\x72\x87\xfd\x00\x13\xd8\xd1\x01\x07\x8d\xfd\x61\x14\x94\x44\x4d\x2c\x2d\x3b\x5d\x1c\xd7\x4e\x3c\xd1\x39\xc1\x14\x9e\x0f\x38\x3b\xb0\x48\x9d\xd5\xf0\x2c\x06\x76\xc2\xae\xe7\x20\xec\x7a\x0f\x91\xf2\x26\x10\x1d\xf9\x94\x1a\xd9\x2c\xc6\x07\xce\x0d\x84\x13\x37\xa2\x4e\x87\x5d\x93\x82\x36\x30\xbc\x3f\xec\x26\xfe\xce\xfc\x4c\x39\x60\x8c\x0d\xdd\xf9\x35\xbe\x6e\xbf\x85\x49\x9b\x02\x5a\x6b\xac\x6f\xcf\xf0\xea\xcd\x7c\x04\xe6\x07\x8b\xee\xb0\x1f\x76\x4c\x1c\x13\x76\x1d\xf1\x18\x63\xd3\x34\x91\x65\x58\xfc\xbf\x6b\xd0\x52\x79\x8c\x64\x10\x57\x36\xc4\x6e\x3d\x70\x39\xf1\x8f\x5c\x3e\x60\xcf\xe1\xeb\x3e\x0b\x04\xd3\x34\xd9\xa7\xe3\xea\x21\xcb\x8e\x22\x66\xf0\x2e\x4c\x5d\xa0\x89\x7d\xf9\x60\x25\xe1\x3b\x03\xbd\xff\x75\x8f\x1c\x48\x3f\x6f\x3d\x48\xed\x08\xb9\xf0\xef\x91\xed\xb4\xf6\x73\x41\x35\x36\xc0\x2b\xee\x53\xf1\x32\xe4\xc4\x57\xdc\x1f\x89\x3c\xf7\xd0\xa3\x8e\xf9\xf5\xe8\xe8\xfd\x30\x9e\x91\x73\x32\xb6\xf4\x27\x5e\x3c\x54\xd6\x7f\x9d\x4c\xa6\x33\x30\x8e\xdd\x93\x30\x1d\x4d\x7f\x3c\x6f\x43\x9e\x27\x89\x32\x15\x7b\xcd\x89\xab\x49\x50\xeb\x59\xf6\x9e\xee\x0b\xe7\x0e\x1c\x8f\x59\xd7\x83\x34\xb1\x0a\xfb\xb7\x7d\xf4\xd3\x37\xbf\x86\x67\xee\x44\x43\x9c\x42\x6f\x50\x04\x9b\x43\x3f\x4b\x93\x24\x86\xe7\x10\x8d\x0d\x96\xfc\xf5\x14\xfc\x47\x33\x70\xfc\xd8\xfa\x33\x00\x00\xff\xff\x7f\x71\x51\x2a\x98\x0a\x00\x00")
+var _templateMigrateMigrateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\x6f\x6f\xdb\xbe\x11\x7e\x6d\x7d\x8a\x9b\xb6\xfd\x66\x17\x8e\x94\x66\x18\xb0\x65\xcd\x8b\x2e\x4e\x37\xa3\x5d\xd6\x21\x29\xba\x97\xa5\xc5\x93\x44\x84\x22\x55\xf2\x64\xc7\x30\xfc\xdd\x87\xa3\x28\xff\x89\x93\xb5\xe8\x56\xac\x6f\x0a\x93\xd4\x73\xf7\xdc\xf3\xdc\x91\xd9\x6c\xf2\x57\xc9\xb5\x6d\xd7\x4e\x55\x35\xc1\xc5\xf9\xeb\x3f\x9d\xb5\x0e\x3d\x1a\x82\x77\xa2\xc0\x85\xb5\x0f\x30\x37\x45\x06\x6f\xb5\x86\x70\xc8\x03\xef\xbb\x25\xca\x2c\xb9\xaf\x95\x07\x6f\x3b\x57\x20\x14\x56\x22\x28\x0f\x5a\x15\x68\x3c\x4a\xe8\x8c\x44\x07\x54\x23\xbc\x6d\x45\x51\x23\x5c\x64\xe7\xc3\x2e\x94\xb6\x33\x32\x51\x26\xec\x7f\x98\x5f\xdf\xdc\xde\xdd\x40\xa9\x34\x42\x5c\x73\xd6\x12\x48\xe5\xb0\x20\xeb\xd6\x60\x4b\xa0\x83\x60\xe4\x10\xb3\xe4\x55\xbe\xdd\x26\xc9\x66\x03\x12\x4b\x65\x10\xd2\x46\x55\x4e\x10\xa6\xd0\xaf\x9f\xc1\x4a\x51\x0d\xf8\x48\x68\x24\xfc\x06\xd2\x8f\xa2\x78\x10\x15\xa6\x07\x27\xcf\xb6\xdb\x64\xb4\xd9\x00\x61\xd3\x6a\x41\x08\x69\x8d\x42\xa2\x4b\x21\x63\x94\xcd\x06\xf8\x5b\xc6\x53\x4d\x6b\x1d\xc1\x38\x19\xa5\x85\x35\x84\x8f\x94\x26\xa3\xb4\x6c\x28\x4d\x92\x51\x8a\x86\x2a\x9b\x29\x9b\xa3\xa1\x5c\x2a\xa1\xb1\xa0\xf4\x85\xf5\xdc\x7f\xd5\xb9\x2f\x6a\x6c\x44\x9a\x4c\x92\x64\x29\x1c\xc3\xe6\x39\x7c\x56\x54\xff\x55\xdb\x85\xd0\x9f\x8c\xfa\xda\xe1\x7c\x06\x1e\xc9\x87\x8a\x74\x46\x2d\xd1\x79\xa1\x41\x49\x0f\xb6\x25\x65\x8d\x07\xb2\x61\xb3\xe7\xa3\xac\xc9\x02\xce\x3c\x96\xab\x3f\xc5\xb2\xa0\x11\x0b\x8d\x72\x0a\x2c\xed\xee\x34\xac\x94\xd6\x20\xb4\xb6\x05\x73\x17\xf0\xfa\xcd\x9b\xdf\x5f\x80\x13\xa6\xc2\x00\x54\xda\x5e\xc2\x10\xb2\x04\x14\x45\xcd\x08\x8a\xd6\x30\x26\x46\x9c\xf4\x01\x6f\x2d\x21\x50\x2d\xe8\x28\x6e\x21\x8c\xb1\x04\x0b\x04\xd1\xb6\x5a\xa1\x04\x6b\x20\x7c\xc6\x94\x04\x81\xd0\x0e\x85\x5c\x03\x3e\x2a\x4f\x59\x32\x7a\x86\xff\x15\xf4\x95\xca\x4e\xf7\x76\x25\x9b\x39\xdb\x5e\x5b\xdd\x35\x66\x5f\x2e\xe9\x6c\x0b\x45\xbf\x18\xd3\xf9\x5f\xd4\x2a\xc0\x5a\x2d\x23\xb4\x0f\x39\x04\x2e\x2b\x74\x08\x1d\x3b\x9f\x8b\xb6\xb0\x54\x43\xa9\x50\x4b\x0f\xc2\x48\x40\x59\xa1\xcf\x20\x74\x8c\xc4\x52\x74\x9a\x65\xb5\x50\x0a\xed\x31\x32\x3f\xa0\x71\xc4\x7a\xbf\x7e\xc4\x78\x6e\x24\x3e\x3e\x21\xac\xc2\xda\xcf\xe0\x1b\x90\xf1\x29\xdf\xbe\xf3\xe4\xd0\xb5\x31\xe9\x97\x69\x1e\x59\xa5\x0b\x1e\x87\xc2\x1a\x4f\x4e\x28\x43\x1e\xc4\x01\x66\xe7\x95\xa9\xe0\xcb\xa7\xdb\xf9\x3f\x3f\xdd\xc0\xfc\x76\x76\xf3\xaf\x2f\xd3\x00\xc1\x05\xa5\x1a\x1d\x96\xd6\xe1\x14\x14\xfd\x8e\xa7\x52\x61\x9b\x06\x8d\x44\xc9\x01\x7b\x0d\x8f\x98\x92\x85\x0a\x09\x1a\xeb\xa2\xb7\x35\x3e\xaa\x85\xd2\x6c\xe6\xa3\xfc\xa1\xa8\xb9\x01\xfc\x81\x2c\x7d\xad\x4f\x54\x09\xcb\xc7\x36\xac\xb1\x78\x78\xea\xc2\xb0\xf6\x33\x44\xb9\xfe\xdb\xcd\xf5\xfb\x00\x70\x58\xc4\x1f\x97\xe7\x98\xc5\xa9\x09\x99\xc8\x8e\xee\x3b\xf5\x48\x9d\xc3\x3d\x59\x56\x43\x55\xe6\xec\x01\xd7\xe0\xd0\x88\x86\xf5\x7b\x81\x36\xac\x6a\x34\xd0\xb5\x95\x13\x52\x99\x2a\x80\x32\xd3\xd2\xd9\x06\x96\xe7\xd9\xeb\xec\x1c\xc6\xca\xfb\x0e\xcf\x7e\x7d\xf1\xc7\x3f\x4c\x32\x98\xbd\x90\xef\x90\xc6\x51\xb6\x71\x71\x9f\x6b\x9f\xda\x7b\x5c\x0f\x95\xf5\x50\x38\x14\xc4\x29\x1e\xe6\xad\x4c\x84\x81\xd9\xec\xc3\x33\x95\x22\xd7\xed\x02\x1f\x60\x1e\x07\xdf\x6f\xec\x12\x98\x29\xb7\x2f\xd4\xbe\x0a\xfb\x1b\x2e\x88\xa6\x7c\x3f\x41\x16\xfc\x1b\x61\xa6\xca\x72\x67\xf8\x5b\xd1\xa0\xe4\x15\x68\x90\x6a\x2b\x7d\x98\x33\x2b\xa7\x02\x07\xbe\x1a\x94\x65\xad\xf7\xe0\x7c\x9f\xee\x4c\xac\xdc\x13\x3d\x95\xe3\xbb\x27\xcf\xe1\x2e\x2c\xf2\xe0\xe3\x98\x6f\x3f\xce\x03\xf0\x50\x9d\xe9\x00\x68\xaa\x90\x06\x3b\xba\xe5\x88\x62\x40\x4b\x68\xdd\xe2\x80\xe2\xc9\x75\x05\xc1\x26\x19\x49\xb7\x84\xe1\x5f\xbc\xf8\xb2\x99\xe3\x3b\x2c\x19\xed\xee\xb2\xf9\x0c\x16\xd6\xea\x64\x1b\x32\xb9\xc5\x55\x84\x09\xd1\xd1\x83\x00\x83\xab\x18\x08\x0a\xad\xd0\x50\x96\x94\x9d\x29\xf6\x67\xc7\x1c\xe8\x38\xc0\x04\x5e\x45\x9c\x0d\x38\xa4\xce\x19\xf8\xa5\x5f\xd8\x48\xb7\xbc\x04\xe9\x96\x5b\xe8\x43\x5e\x87\x40\xfb\x78\x5a\x0f\xd1\x1c\xf6\x8f\x0d\x1f\x03\x8e\xfd\x80\x3a\x89\x5f\x8d\x0b\x7a\x84\xf8\x16\xc8\xae\xfb\xff\xa7\xdc\xc2\x1e\xb2\x2c\x8b\xd5\xf9\x7b\xa8\x1e\xfe\x23\xb4\xc1\x04\xd0\x39\xeb\xb8\x3c\xf1\x05\x32\xe5\x15\xb8\xdc\x49\x73\x8b\xab\xf8\xc5\xd8\x67\xd2\x2d\x7b\xbc\x2c\xcb\x26\xc9\x48\x95\xe1\xf0\xaf\xae\xc0\x28\xcd\x18\xa3\x48\xae\x6c\x28\xbb\x61\xe0\x72\xcc\x0f\x8e\x3c\x62\x5f\xc2\x6f\x57\x69\x08\x30\x49\x46\xdb\x64\x38\x1d\x77\xb3\x3d\x89\x29\xdc\x87\x96\x08\x61\xfa\xba\x7c\x76\x8a\xf0\xde\x06\x7f\xa1\x7f\x66\x38\x72\x47\xaf\x40\x19\x4f\x28\x24\xbf\xcf\x5c\x67\x0c\xfb\x82\x6a\x6c\x40\x54\x82\xb7\xc2\x77\x52\x90\x58\x08\xee\xd7\x3c\x67\xe8\x81\xc7\xe5\xd5\xa0\xe8\x5d\xb4\x25\xc7\xba\xb7\xe3\xa1\xa4\x7f\x11\xc5\x43\xe5\xf8\xa5\x38\x9e\x4c\xc1\xfa\xec\x8e\xa4\xed\x68\xf2\xe7\xe3\x32\xe4\xf9\x68\xa4\x6d\x95\xbd\x13\x24\xf4\x38\xb0\xe5\x28\xdb\x24\xcf\x4f\x95\xdb\xc5\x78\x4e\xba\x15\x28\xdb\x67\xe1\xbe\x5b\x47\x76\xdf\xe5\x15\xfc\x12\x8f\x85\xaf\x7b\x17\xb2\x40\xe1\xa7\xbb\x84\xd5\x34\x19\x8d\xfa\xe5\x4b\xe8\x85\x0d\x92\x7c\xdb\x05\xff\x47\x0f\x84\x61\xf3\xb2\x01\xc4\xd0\x9e\xcf\xcd\x1e\xb2\x2f\x4d\x3a\xb6\x40\x61\x4d\xa9\xaa\xce\xed\x47\xdd\x30\xa3\xfa\xeb\x82\x07\xef\x2e\x9e\x0c\x65\xdb\xcd\xc6\x10\x36\x95\xb8\xdc\x19\x2b\xe5\x81\xc5\xb0\x0e\x5b\x2d\xd6\xd1\x84\xfd\xa3\x92\x7f\xec\x93\x08\x13\x31\x8c\xb1\xc2\x36\x6d\x47\xc3\xd1\x48\x69\x1a\xb6\x14\x41\xd3\x79\x7e\xb6\xc6\x54\x0d\x16\xd4\xbf\x2a\x84\x01\x6c\x5a\x5a\x9f\x7a\x5a\x2a\xf7\x8c\x88\x1f\x6c\x21\xf4\x4c\xb9\x71\xfc\x83\x83\x9f\xec\xe9\x24\xc9\xf3\x13\x1d\x9f\x35\xf1\x68\xfb\x1f\xfb\x85\xf5\xe9\xa5\x1b\xe4\x8c\x55\x1c\x4b\xe5\x26\xff\x55\x9b\x0c\xd0\x3f\x3c\xde\xa2\xcf\x7c\xb6\xbb\xb6\x18\x6e\x0a\x69\x2c\x75\x7a\x60\xea\x38\xfc\x77\xf7\x5b\xf8\x2b\xf2\xa1\xbf\xfe\xa6\xb0\xe8\x88\x2f\xc5\x7e\x06\x55\x6a\x89\x06\x8c\x68\xc2\x6b\xe3\x89\xc5\xe2\x7d\x77\x42\xe6\x28\x85\x53\x46\x01\xcd\x93\x53\xa6\xfa\x6e\x7a\xdf\xee\xdb\x9f\x3d\xbd\x9f\xd4\x95\x49\x3c\x69\xe1\xcd\x06\xd0\x48\xd8\x6e\x93\x7f\x0f\x00\x66\x6d\x54\x46\xe7\x0f\x00\x00")
 
 func templateMigrateMigrateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1107,12 +1382,12 @@ func templateMigrateMigrateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/migrate/migrate.tmpl", size: 2712, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/migrate/migrate.tmpl", size: 4071, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateMigrateSchemaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\xcd\x6e\xdb\x38\x10\x3e\x5b\x4f\x31\x10\xbc\x45\x12\x38\x52\x9b\xdb\x1a\xf0\x21\x48\x5b\x20\xe8\x22\x2d\xb6\xe9\x29\x08\x16\x8c\x34\xb2\x09\xd3\xa4\x42\xd2\x69\xbc\x5a\xbd\xfb\x82\x3f\x92\x28\x59\x8e\x93\xa2\xb9\x44\x24\xe7\x8f\xdf\xcc\x37\x24\x5d\x55\xe9\x59\x74\x25\xca\x9d\xa4\xcb\x95\x86\x8b\xf7\x1f\xfe\x3c\x2f\x25\x2a\xe4\x1a\x3e\x93\x0c\x1f\x84\x58\xc3\x35\xcf\x12\xb8\x64\x0c\xac\x90\x02\xb3\x2e\x9f\x30\x4f\xa2\xdb\x15\x55\xa0\xc4\x56\x66\x08\x99\xc8\x11\xa8\x02\x46\x33\xe4\x0a\x73\xd8\xf2\x1c\x25\xe8\x15\xc2\x65\x49\xb2\x15\xc2\x45\xf2\xbe\x59\x85\x42\x6c\x79\x1e\x51\x6e\xd7\xff\xba\xbe\xfa\x74\xf3\xfd\x13\x14\x94\x21\xf8\x39\x29\x84\x86\x9c\x4a\xcc\xb4\x90\x3b\x10\x05\xe8\xc0\x99\x96\x88\x49\x74\x96\xd6\x75\x14\x55\x15\xe4\x58\x50\x8e\x10\xab\x6c\x85\x1b\x12\x83\x9b\x3e\x87\x9f\x54\xaf\x00\x9f\x35\xf2\x1c\xa6\x10\x7f\x23\xd9\x9a\x2c\x31\x86\x78\x43\x97\x92\x68\x8c\xe1\xbc\xae\xa3\x49\x55\x81\xc6\x4d\xc9\x88\x46\x88\x57\x48\x72\x94\x31\x24\xc6\x4a\x55\x81\xd1\x35\xf6\xe8\xa6\x14\x52\xc3\x89\x15\x97\x84\x2f\x11\xa6\xff\xcc\x60\xca\x61\xbe\x80\x69\x72\x23\x72\x54\x46\x70\x32\x89\xab\x0a\xa6\xc9\x95\xe0\x05\x5d\x26\xde\x27\xd4\x75\x6a\xa6\x79\x30\x11\x1b\x53\xe7\xad\x83\x49\x8c\x5c\x2f\x45\x42\x45\x8a\x5c\xa7\x39\x25\x0c\x33\x6d\xbe\xd5\x23\x8b\x0f\x2d\xab\x47\x96\xfa\x6d\x0f\x45\xdc\x74\x5a\x50\x64\x79\x1c\x9d\x46\xd1\x13\x91\x2e\xfe\xf3\x70\x03\xda\x6d\xe0\x96\x3c\xb0\x66\x07\x46\x22\x3d\x83\x82\xf2\x1c\xf4\xae\x44\xe0\x36\xb9\x2e\x33\x4b\x49\xca\x55\x9b\x10\x6d\xd4\x66\x40\x0b\xc0\x67\xaa\xb4\x02\x9b\x14\x67\x62\x6a\xd5\xe6\x0b\xa0\x3c\xc7\xe7\x16\xa4\xf7\x9d\x93\xc3\x38\x56\x95\xb5\xf9\x08\x53\x9d\xdc\x90\x0d\x1a\xe8\x6c\x88\x6e\xcd\x99\x5e\x18\x35\x3b\x76\x20\x76\xe9\xf2\x01\x64\x82\x6d\x37\x5c\x19\xd3\x25\x51\x19\x61\xad\xb9\xff\xa0\x94\x94\xeb\x02\xe2\x3f\xd4\x95\x93\x8a\x9d\x62\x9a\x82\x71\xd0\xa8\xd6\x35\xac\x04\xcb\x95\xdd\x7b\x33\x59\x08\x57\xd9\x36\xd5\xde\x62\x5d\xc7\x0e\x8d\xc4\x7a\xef\x59\x58\xc0\xdd\xfd\x99\xcb\x47\xe2\xbc\x55\xd1\xa4\x07\x41\x66\xb7\xaf\xfd\xaa\xcf\xc3\x64\x52\x81\xb1\x3d\x77\x8e\xb2\xd6\xd1\x0c\x6e\x77\x25\xce\xc1\xe6\x36\x71\x6b\x66\xc6\x54\x9d\xd2\x5e\x6a\xe6\x2c\x54\xe7\x06\xc9\x69\x96\xfc\xe0\xf4\x71\x6b\x16\xc0\x7d\xcd\x41\xcb\x2d\xce\x42\xd0\x42\xf1\x6b\x9e\x49\xdc\x98\x4e\x50\xd7\xd0\x0e\x8e\x28\xdd\x6c\x19\xf3\x59\x82\xe6\x7b\x0e\x3e\xf8\x6e\x6d\x44\xdf\x72\x75\x9a\x25\xdf\xe9\xbf\x56\xdb\xfc\xb7\x9a\xc9\xcb\xf2\x97\x5a\x4b\x23\x6f\xfe\x3b\x9c\x12\x8b\xd0\x61\x8d\x4f\x7c\xbb\xb1\x59\xb1\x1f\x73\xb8\xbb\x57\x5a\x52\xbe\xac\xa0\x63\xb6\x2d\x5b\x6b\xc8\xc4\x8e\x7d\x8b\x30\x16\x0f\x2d\x80\x0b\x0d\x27\x54\xdd\x50\x66\xdc\x7c\xc4\x82\x6c\x99\x3e\x35\x0a\xfe\xbb\x41\xc2\x0f\x8f\x00\x61\xcb\xc5\x64\xd5\xc2\xd1\x8e\xe6\xb0\x21\xe5\x9d\x0b\x79\x24\xf2\xf5\x0c\xa6\x4f\xbd\xe8\xd7\xe6\xc3\x97\xd0\x53\x7f\x27\x1d\x63\x5c\xb5\x04\xfd\x68\x32\x69\x59\x64\xab\xfa\x08\x87\x2c\x37\xfb\x0c\xd2\x4d\x21\x74\xfc\x71\x14\x00\xca\x0b\x21\x37\x44\x53\xc1\x5f\x47\xa5\xd6\xd4\x02\xde\x79\x1a\x59\x87\x96\x45\x01\x43\x3a\x7d\xbb\x1d\x4f\xa6\xf9\x80\xd0\x76\xed\x9b\xa4\x1b\x22\x77\x5f\x70\x37\x1f\x27\xe7\xb0\x41\x95\x6b\x4f\xd1\x4e\xb3\x49\x5b\x28\x4a\x67\x07\xc9\xdc\x16\x8a\x69\x6b\xe5\xda\xf7\xb5\x96\xd5\xfd\x20\xef\xcc\x90\x42\x5d\xdf\x0f\x6a\xa4\x9f\xa4\xe1\xd0\x6d\xee\xb3\x90\x48\x97\xfc\x0b\xee\x54\xb8\xbb\x6e\x7a\x6f\x87\x45\xb3\xbb\x40\xb5\xf3\xe8\xc3\xff\xbe\xdb\x3c\x08\xe6\xb1\x2e\xd6\x89\x1b\xb7\x70\x87\x88\x8f\x43\x3a\x01\xe8\x77\xbd\x0f\xd6\x6b\xb1\xde\x87\x6a\x1f\xd4\x8b\x43\xa8\xf6\x81\xcd\x3e\x34\xc0\x5e\xbc\x15\xd9\x7d\x70\xc7\x66\xea\x59\x90\xcd\xf4\x0c\x4a\xa1\x74\x29\x38\x82\xc4\x42\x22\xcf\x28\x5f\x82\x16\x40\x9e\x04\x75\xc7\x67\xb6\xc2\x6c\x6d\x66\x99\x10\x65\x7b\x42\x9a\xbf\xbf\xb1\xf8\x65\xc4\x3a\xdd\xe3\xa0\x39\x71\x4b\x99\x5f\x83\xaf\x61\x7e\x68\xe8\xa5\x73\xf4\xb7\x62\xec\x3a\x62\xb1\x4e\xbe\xf2\x1f\x65\x4e\x74\xff\x98\x6b\x6c\x34\x8b\x73\xdf\x65\x92\xe6\xec\x88\x0e\xf8\x18\x98\xfe\x88\x0c\x0f\x9a\x76\x8b\xaf\x35\x1d\x1c\xbd\x43\x66\x36\x47\xa5\x4e\xae\xcd\xa5\x08\xdb\x3c\xf8\x61\x58\x07\x76\xaa\xda\xeb\x30\xa6\x19\xd1\xfc\xd9\xb3\x61\x60\xa6\x23\x6b\xd8\x17\x69\xfe\xdc\xef\x8c\xe6\xaf\xb9\x05\x34\x02\xed\xfd\xa0\x95\x38\x56\x9b\xfb\x71\xf9\xf2\x34\xe6\x0e\xd5\xd9\x6b\x29\xfd\xfb\x38\x3d\x52\x70\x23\x53\xed\xb6\x9b\x8f\x81\xc8\xf8\x09\x19\x8e\xd3\x14\xfc\x2d\xda\x9d\x78\x84\x31\x7b\xb4\x69\x37\xe9\xef\xcf\x1e\xc8\x68\xe2\x65\xc3\xbb\x61\x7b\xa8\x1d\xbf\xa3\x4f\x02\x56\xbe\x74\x1e\xcf\xa2\x7e\xd0\xb5\x79\x09\x14\x5b\x9e\x01\xe5\x54\x9f\x9c\x42\xd5\x7b\x11\x1c\x78\x0d\xbc\xf9\x0e\x30\xc8\xf4\x0b\xc7\x4b\x78\xbe\x87\xcb\x5d\x4a\xdb\x96\x03\x0b\x78\x6d\x2f\x1a\xc6\xd2\x7b\x17\x38\xd6\x13\xae\x7d\x50\x97\x9c\x0b\xed\xae\x23\x23\x31\x05\xab\x0b\x78\xe7\xde\x63\xc1\x64\x35\xb8\xb9\x11\xae\xdb\x97\x8a\x2b\xa4\x5b\x77\x0b\xee\xee\xa6\x23\xdd\x61\x60\xe0\x6a\x45\xa4\x42\xdd\x9a\xf0\xe3\x37\x1a\x11\x8c\x85\xbb\xb2\x84\x76\x33\x6f\x33\xf4\xb5\x34\x3a\x1d\x3d\xfd\xf8\x88\x91\x3a\xda\x33\xd4\x3e\x23\x08\xeb\x3c\x8d\x42\xdd\x13\x5d\x00\xc7\x9f\x27\x0f\x42\xb0\x53\xab\x73\xf6\x3a\xa5\xaa\x72\xae\x29\x77\x3f\x24\xd8\x50\xcd\xf3\xc5\xb4\x08\xa6\x8c\x72\x41\x98\xc2\x5e\xc7\xd8\x2b\x96\x66\x10\x7c\xbb\x5f\x1d\xfc\xe0\xff\x00\x00\x00\xff\xff\xd1\xec\xb0\xee\x44\x11\x00\x00")
+var _templateMigrateSchemaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\xcd\x6e\xdb\x38\x10\x3e\x4b\x4f\x31\x10\xbc\x45\x12\x38\x72\x9b\xdb\x1a\xf0\x21\x48\x53\x20\xe8\x22\xed\x36\xed\x5e\x82\xa2\x50\xa4\x91\x4d\x58\x26\x15\x8a\x76\xe3\xe5\xea\xdd\x17\x43\x52\x12\x65\xcb\x71\x52\xf4\x64\x91\x33\xf3\x71\xfe\x39\xb4\xd6\x93\xb3\xf0\x4a\x94\x5b\xc9\xe6\x0b\x05\x17\x6f\xdf\xfd\x79\x5e\x4a\xac\x90\x2b\xf8\x90\xa4\xf8\x20\xc4\x12\x6e\x78\x1a\xc3\x65\x51\x80\x61\xaa\x80\xe8\x72\x83\x59\x1c\x7e\x5d\xb0\x0a\x2a\xb1\x96\x29\x42\x2a\x32\x04\x56\x41\xc1\x52\xe4\x15\x66\xb0\xe6\x19\x4a\x50\x0b\x84\xcb\x32\x49\x17\x08\x17\xf1\xdb\x86\x0a\xb9\x58\xf3\x2c\x64\xdc\xd0\xff\xba\xb9\xba\xbe\xbd\xbb\x86\x9c\x15\x08\x6e\x4f\x0a\xa1\x20\x63\x12\x53\x25\xe4\x16\x44\x0e\xca\x3b\x4c\x49\xc4\x38\x3c\x9b\xd4\x75\x18\x6a\x0d\x19\xe6\x8c\x23\x44\x55\xba\xc0\x55\x12\x81\xdd\x3e\x87\x9f\x4c\x2d\x00\x9f\x14\xf2\x0c\x46\x10\x7d\x4e\xd2\x65\x32\xc7\x08\xa2\x15\x9b\xcb\x44\x61\x04\xe7\x75\x1d\x06\x5a\x83\xc2\x55\x59\x24\x0a\x21\x5a\x60\x92\xa1\x8c\x20\x26\x14\xad\x81\x64\x09\x8f\xad\x4a\x21\x15\x9c\x18\x76\x99\xf0\x39\xc2\xe8\xc7\x18\x46\x1c\xa6\x33\x18\xc5\xb7\x22\xc3\x8a\x44\x82\x20\xd2\x1a\x46\xf1\x95\xe0\x39\x9b\xc7\xee\x4c\xa8\xeb\x09\x6d\x73\x6f\x23\x22\xa8\xf3\xf6\x80\x20\x42\xae\xe6\x22\x66\x62\x82\x5c\x4d\x32\x96\x14\x98\x2a\xfa\xae\x1e\x8b\xe8\x10\xb9\x7a\x2c\x26\xce\xec\x5d\x16\xbb\x3d\xc9\x19\x16\x59\x14\x9e\x86\xe1\x26\x91\x56\xff\x73\xdf\x00\x65\x0d\xf8\x9a\x3c\x14\x8d\x05\xc4\x31\x39\x83\x9c\xf1\x0c\xd4\xb6\x44\xe0\x26\xb8\x36\x32\x73\x99\x94\x8b\x36\x20\x8a\xc4\xc6\xc0\x72\xc0\x27\x56\xa9\x0a\x4c\x50\x2c\xc4\xc8\x88\x4d\x67\xc0\x78\x86\x4f\xad\x93\xde\x76\x87\x1c\xf6\xa3\xd6\x06\xf3\x11\x46\x2a\xbe\x4d\x56\x48\xae\x33\x2a\x5a\x9a\x85\x9e\x91\xfb\xcd\xda\x3a\xb1\x0b\x97\x53\x20\x15\xc5\x7a\xc5\x2b\x82\x2e\x93\x2a\x4d\x8a\x16\xee\x3f\x28\x25\xe3\x2a\x87\xe8\x8f\xea\xca\x72\x99\xbc\x09\x82\xc9\x04\xb4\xee\x44\xeb\x1a\x16\xa2\xc8\x2a\x63\x7b\xb3\x99\x0b\x9b\xd9\x26\xd4\x0e\xb1\xae\x23\xeb\x8d\x38\x0c\x82\x1d\x84\x19\xdc\x7f\x3f\xb3\xf1\x88\xed\x69\x3a\x0c\x7a\x2e\x48\x49\xc7\x91\x72\x54\x17\x87\x20\xd0\x40\xd8\x53\x7b\x50\xda\x1e\x34\x86\xaf\xdb\x12\xa7\x60\x62\x1b\x5b\x1a\xed\x50\xd6\x55\xca\x71\x8d\x2d\x82\x3e\x27\x4f\x8e\xd2\xf8\x1b\x67\x8f\x6b\x12\x07\xfb\x35\x05\x25\xd7\x38\xf6\x9d\xe6\xb3\xdf\xf0\x54\xe2\x8a\x3a\x41\x5d\x43\xbb\x38\x22\x74\xbb\x2e\x0a\x17\x25\x68\xbe\xa7\xa0\xf5\x0e\x6d\x40\xde\xd4\xea\x28\x8d\xef\xd8\xbf\xc4\x01\xf4\x6b\x24\xe3\xe7\xf9\x2f\x95\x92\xc4\x4f\xbf\xd6\x4f\x24\x10\x3d\x23\x71\xcd\xd7\x2b\x72\x30\x98\x8f\x29\xdc\x7f\xaf\x94\x64\x7c\xae\xa1\xab\x6c\xa4\x70\x18\x20\xd2\x1d\xfb\x88\x30\xa4\x0f\xcb\x81\x0b\x05\x27\xac\xba\x65\x05\xf9\xef\x3d\xe6\xc9\xba\x50\xa7\x24\xe0\xbe\x1b\x4f\xb8\xe5\xf3\x86\x7d\x41\x9e\xac\x30\xfb\x20\xc5\x8a\x20\xbc\xe5\xcb\xcc\xbc\x33\xe9\x46\x59\x41\xe2\xdd\x6a\x0a\xab\xa4\xbc\xb7\x26\x0f\x58\xbe\x1c\xc3\x68\xd3\xb3\x7e\x49\xd6\xbb\x14\xdc\xf4\x0f\xed\x2a\xae\x1e\x37\x09\xdd\xaa\xd3\x56\xa1\xa9\x8a\x23\x35\x68\x6a\xbb\x5f\x81\xaa\x49\xa4\xae\xfe\x6c\x09\x01\xe3\xb9\x90\xab\x44\x31\xc1\x5f\x56\x8a\x2d\xd4\x0c\xde\xb8\x32\x34\x07\x9a\x2a\xf4\x2a\xac\x93\x37\xe6\xb8\x62\x9c\x42\xbf\x9c\x0d\xed\xb3\x64\xab\x44\x6e\x3f\xe2\x76\x3a\x5c\xdc\xbb\x0d\xae\x5c\xba\x12\xef\x24\x9b\xb0\xf9\xac\x6c\x7c\xb0\x19\xb4\x89\x86\x8f\x04\xe7\xfa\x62\xdb\x15\xfa\x4a\xde\xd3\x92\x41\x5d\x7f\xdf\xc9\x91\x7e\x90\x76\x62\x16\xd8\x38\x7e\x10\x12\xd9\x9c\x7f\xc4\x6d\xe5\x5b\xd7\x6d\xef\x59\x98\x37\xd6\x79\xa2\xcd\x09\x81\x76\xea\xdf\x6d\x57\x0f\xa2\x70\xbe\xce\x97\xb1\x5d\xb7\xee\xf6\x3d\x3e\xec\xd2\x00\xa0\x77\x6a\xfa\xce\x9c\x9a\x2f\xf7\x5d\xd5\xe3\x33\x4e\xbd\x38\xe4\xd5\xbe\x63\xd3\x77\x8d\x63\x2f\x5e\xeb\xd9\x3d\x6f\x0e\xee\xd4\x8d\xb1\xee\x9a\x2d\x45\xa5\x4a\xc1\x11\x24\xe6\x12\x79\xca\xf8\x1c\x94\x80\x64\x23\x98\xbd\x7e\xd3\x05\xa6\x4b\xda\x2d\x84\x28\xdb\x1b\x96\x20\xbe\x60\xfe\xcb\x1e\xeb\x64\x8f\x3b\xcd\xb2\x9b\x92\xf9\x35\xf7\x35\x95\xef\x03\x3d\x77\x0f\xff\x56\x1f\xdb\x8e\x98\x2f\xe3\x4f\xfc\x5b\x99\x25\xaa\x7f\x4d\x3a\xc6\xa0\x21\x4e\x5d\x97\x89\x5d\x8f\x1d\x87\x07\xce\xd8\x81\x7e\x8f\x05\x1e\x84\xb6\xc4\x97\x42\x3b\x42\x7f\xbb\xeb\xb0\x74\x3f\xab\xf8\x86\x86\xaa\x66\x62\x0b\x02\xb7\xf4\xf3\xc0\x6c\xe9\x70\x37\xae\xd4\x8c\x58\xf6\xe4\xaa\x61\x07\xa6\x2b\x56\xbf\x2f\xb2\xec\xa9\x09\x66\x5b\xaa\x41\x33\x45\x34\x0c\xed\x7c\xd1\x72\x1c\xcb\xcd\x7d\xbd\x5c\x7a\x12\x9c\x13\xee\x14\x3b\x98\x9d\xc3\x25\xfd\xfb\x6a\x7a\x3f\xf4\x43\x5b\x6d\x34\x9b\x8f\x1d\x96\x81\x1b\xd2\x8b\xe6\x15\xd5\x78\x6b\x80\x5d\xf5\xfc\x46\x3b\x3a\xdc\xf5\xc2\x0f\xff\xb6\xe8\x41\xec\x05\x52\x6b\x78\x5c\x0b\xe5\xdf\x18\x8d\xc6\xc1\xf5\x53\x29\xfb\x1c\xb4\xe3\x73\x74\xc9\x6e\x69\xde\x39\x56\xbc\x1a\x9a\x2b\x06\x23\xd7\x9b\x30\x3a\x8e\xa0\x3b\x9e\x26\x0e\x5f\x9d\x8d\xaf\xc9\x9e\x63\x7d\x9f\x0f\x10\x1b\xd2\xf1\x70\xd4\xbd\x07\x19\x8d\x21\xee\x51\x64\x07\x90\xa4\x28\xcc\xa4\x61\x86\x89\xaa\x79\x0e\xb9\xf8\x84\x81\xe3\xf5\x47\xfd\x76\xc6\x38\xfe\xe4\x0a\xbc\x26\xa9\xf6\x5b\x63\x3b\x1e\x8d\xc3\xa0\xa7\x64\x4d\x0f\xbb\x7c\xcd\x53\x60\x9c\xa9\x93\x53\xd0\xbd\x07\xde\x81\xc7\xdd\xab\x47\x32\x0f\x92\x8d\x9f\xbd\xed\xfd\x71\xcb\x27\x77\x15\xd6\xde\x00\x30\x83\x97\x5e\x0d\xbb\xba\x34\xe6\x7b\x79\x99\x70\xe5\x94\xba\xe4\x5c\x28\x3b\x1d\x0e\xe8\xe4\x51\x67\xf0\xc6\x3e\xaf\x3d\x91\x6e\xb2\x69\x61\xdb\x87\xa7\x21\xd9\x40\xfb\x33\xf8\x40\xb3\xde\x01\xb8\x5a\x24\xb2\x42\xd5\x42\xb8\xf5\x2b\x41\x44\x51\xf8\x56\x99\x69\xc9\xee\xbc\x0e\xe8\x53\x49\x32\x4d\xc0\x82\xc0\xad\x8f\x80\xd4\xe1\x1e\x50\xfb\x2a\x4c\x8a\x06\xec\x80\xab\x7b\xac\x33\xe0\xf8\xf3\xe4\x41\x88\xe2\x94\x30\x83\xb3\x97\x09\x69\x6d\x8f\x66\xdc\xfe\x2f\x64\xfa\x07\xbd\x46\xa9\x63\x17\x15\x09\xe7\x49\x51\x61\xaf\x81\xf7\x6d\xe8\xd4\xdf\x30\xfc\x69\xd2\x85\x1c\xf2\x0f\x2d\x8e\x18\x60\x78\xba\x84\xa1\xa5\x6b\x6f\x7d\xd0\xf8\xef\x35\xca\x6e\xb2\x0f\xcc\xd2\x6b\x66\xde\xbd\xdf\xd7\x6d\x10\x88\x61\xd5\x87\x62\xf8\x6c\xa3\x3d\xda\x66\x5f\xd8\x64\x77\x55\x0b\x0e\xe8\xbc\xef\x63\x7f\xe1\x7d\x9b\x7f\xe4\x00\x79\x06\x75\x1d\xfe\x3f\x00\x98\x5b\x16\x1d\x77\x14\x00\x00")
 
 func templateMigrateSchemaTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1127,12 +1402,52 @@ func templateMigrateSchemaTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/migrate/schema.tmpl", size: 4420, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/migrate/schema.tmpl", size: 5239, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatePredicateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x90\x51\x6b\x14\x31\x14\x85\x9f\x37\xbf\xe2\x10\xf6\xa1\x2d\x6e\xa6\xf6\x4d\xc1\x87\x5a\x2a\x14\x64\x11\xea\xbb\xa4\xc9\x9d\x99\xd0\x4c\x32\xde\xdc\x51\x97\x30\xff\x5d\xb2\xbb\x6a\x15\x7c\x0b\xf7\x7c\xf7\xe4\x9e\x53\x6b\x77\xa5\xee\xf2\x7c\xe0\x30\x8c\x82\x9b\xeb\xd7\x6f\x76\x33\x53\xa1\x24\xf8\x60\x1d\x3d\xe5\xfc\x8c\x87\xe4\x0c\x6e\x63\xc4\x11\x2a\x68\x3a\x7f\x23\x6f\xd4\xe7\x31\x14\x94\xbc\xb0\x23\xb8\xec\x09\xa1\x20\x06\x47\xa9\x90\xc7\x92\x3c\x31\x64\x24\xdc\xce\xd6\x8d\x84\x1b\x73\xfd\x4b\x45\x9f\x97\xe4\x55\x48\x47\xfd\xe3\xc3\xdd\xfd\xfe\xf1\x1e\x7d\x88\x84\xf3\x8c\x73\x16\xf8\xc0\xe4\x24\xf3\x01\xb9\x87\xbc\xf8\x4c\x98\xc8\xa8\xab\x6e\x5d\x95\xaa\x15\x9e\xfa\x90\x08\x7a\x66\xf2\xc1\x59\x21\x8d\x93\xb2\xc3\xf7\x20\x23\xe8\x87\x50\xf2\xd8\x42\x7f\xb2\xee\xd9\x0e\xa4\xff\x62\x77\xeb\xaa\x36\xb5\x42\x68\x9a\xa3\x15\x82\x1e\xc9\x7a\x62\x0d\xd3\x7c\x6a\x45\xdb\x6e\x8e\x61\x9a\x33\x0b\x2e\xd4\x46\xf7\x93\x68\xa5\x36\x9a\x92\x0c\xd9\x84\xdc\x51\x92\xce\x07\x1b\xc9\x49\x37\x30\x4d\x31\xa4\x6e\x60\x3b\x8f\x9d\x2f\x51\xff\x8f\x2c\x5f\xa3\x56\x97\xc7\x14\x6c\xd3\x40\xd8\x7e\x79\x85\x6d\xc2\xdb\x77\xd8\x9a\x7d\xf6\x54\x4e\xe7\x75\x1d\x6a\xc5\x36\x99\xbd\x9d\x08\xeb\xda\xaa\x6e\x3d\xfd\x8e\x81\x7e\x49\x4e\x42\x4e\xe8\x33\x9f\xd9\x73\xda\x86\x3f\x2d\x21\x7a\xe2\x62\xd4\x46\x0e\x33\xfd\x63\xd6\x76\x2f\xda\xc8\x3c\x4a\x66\x3b\x90\x79\x7f\xe2\xb1\xae\x97\x2f\x0a\xf8\xf3\xfa\x19\x00\x00\xff\xff\x76\x8b\x5c\x5e\x3a\x02\x00\x00")
+var _templateOpenapiHandlerTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5a\x5f\x73\xdb\xb6\xb2\x7f\x26\x3f\xc5\x96\xe3\xa6\xa4\xaf\x0c\x25\x7d\xbb\xce\xf5\x9d\x71\x6d\xa7\xd1\x39\x8d\xed\xc6\x4e\xfb\x90\xc9\x4c\x61\x72\x29\xe1\x84\x02\x69\x00\x92\xec\x51\xf5\xdd\xcf\x2c\x00\x52\xa4\x44\x39\x72\xd3\x9e\xce\x79\xf0\x58\xc2\x9f\xc5\x62\xff\xfc\x7e\x0b\x40\xcb\xe5\xf0\x30\x3c\x2b\xab\x47\x25\xc6\x13\x03\xdf\xbf\x7c\xf5\xbf\x47\x95\x42\x8d\xd2\xc0\x1b\x9e\xe2\x5d\x59\x7e\x86\x91\x4c\x19\x9c\x16\x05\xd8\x41\x1a\xa8\x5f\xcd\x31\x63\xe1\xed\x44\x68\xd0\xe5\x4c\xa5\x08\x69\x99\x21\x08\x0d\x85\x48\x51\x6a\xcc\x60\x26\x33\x54\x60\x26\x08\xa7\x15\x4f\x27\x08\xdf\xb3\x97\x75\x2f\xe4\xe5\x4c\x66\xa1\x90\xb6\xff\xa7\xd1\xd9\xc5\xe5\xcd\x05\xe4\xa2\x40\xf0\x6d\xaa\x2c\x0d\x64\x42\x61\x6a\x4a\xf5\x08\x65\x0e\xa6\xb5\x98\x51\x88\x2c\x3c\x1c\xae\x56\x61\xb8\x5c\x42\x86\xb9\x90\x08\x51\x59\xa1\xe4\x95\x18\xea\x0a\xd3\x08\x56\xab\xe5\x12\x0e\xd8\x55\x85\xf2\xf4\x7a\x04\xab\x15\x0d\x45\x99\xc1\x8e\x59\x13\x2e\xb3\x02\x55\x54\xf7\x1f\x54\x9f\xc7\x70\x7c\x02\x77\x5c\x23\x1c\xb0\xb3\x52\xe6\x62\xcc\xae\x79\xfa\x99\x8f\xd1\x8b\x33\x38\xad\x0a\x6e\x10\xa2\x09\xf2\x8c\x26\x1f\x50\x4f\x28\xa6\x55\xa9\x0c\xc4\x61\xb0\x5c\x1e\x81\xe2\x72\x8c\x70\x50\x91\xb4\x03\x76\x75\x7a\x33\xb2\xdd\x1a\xa2\xb4\x94\x06\x1f\x4c\x04\x11\xca\xb4\xcc\x84\x1c\xb7\x3e\x0e\xff\xa5\x4b\x49\xdf\x95\x2a\x95\x8e\x20\xca\xa7\x34\x52\xa2\x19\x4e\x8c\xa9\x22\x88\xb4\x51\x69\x29\xe7\xee\x93\x90\x63\x6d\x95\x0f\x82\xc8\xaa\x0f\xab\x55\xe4\x14\xf0\xbb\x6e\x2b\x23\x1b\x65\x2e\xcb\x0c\x75\x6b\x9e\xdc\xde\xea\x90\xe4\xc9\x56\x43\x57\x6e\x12\x86\xc3\x21\xbc\xbd\xbd\xbd\x7e\xeb\x6c\x48\x81\xc0\x25\x90\x96\xac\x6e\x32\x13\x6e\xc0\x46\x8e\xb6\x1e\x3e\x7b\xff\xe1\x1c\xca\x0a\x15\x37\xa2\x94\xda\xb9\x18\x81\x42\xcf\x3c\x56\xa8\x49\x24\xd7\xc0\xe1\xfd\xc5\xcd\x2d\x9c\x5e\x8f\x18\xdc\x52\x30\x5d\x8f\x48\x7a\x86\x3a\x55\xe2\x0e\x33\xb8\x7b\xb4\xe2\x6a\x37\x67\x65\x3a\x9b\x92\x90\xd8\xfb\x95\x91\x15\x13\xbb\x3c\x89\x5c\x70\x0d\x63\x94\xb4\x2c\x66\xc0\x8b\x52\x8e\x61\x21\xcc\x04\x84\x61\xf0\xa6\x54\x80\x0f\x7c\x5a\x15\x78\x1c\x0e\x87\xe1\x70\x18\xb4\xf6\x10\x47\x43\x8a\x93\x68\xe0\x36\x76\x63\x94\xa8\xae\x15\xe6\xe2\xc1\xf5\x44\x03\xa8\xc3\x66\xb5\x62\x97\xb8\x68\x59\x24\x4e\x0b\x81\xd2\x24\x49\x42\x72\x69\x83\x1d\x7b\x69\xa3\x66\xa9\x81\x65\x18\xb8\x71\x70\x78\x66\xff\x87\x2b\x6b\xda\xae\x2c\x50\x68\x66\x4a\x92\x6d\x24\x2e\x3a\x72\x36\x8d\x5c\xdb\x0e\x66\x5a\xc8\xb1\x35\xd4\x58\xcc\x51\x82\x5b\x86\x85\xf9\x4c\xa6\xd0\xab\x6a\xad\x42\x02\x87\xed\x15\x96\x61\xe0\x96\x87\x17\xad\xe6\xa5\x93\x77\xec\xe5\xae\xbc\xde\x37\xa4\x09\x0d\x03\x41\x36\x25\xbf\x38\xbd\x3a\x91\x21\xa4\x41\x95\xf3\x14\xbd\x3a\xf1\xa4\xb3\x64\xb2\x16\x13\x2f\x9c\xe9\xdf\xa3\xae\x4a\xa9\xf1\x57\x25\x0c\xaa\x01\x28\x38\xf4\xed\xf7\x33\xd4\x26\x21\x43\x56\xdc\x4c\x28\xc6\x7d\x66\xb0\x5b\x25\xa6\xb1\x62\x1f\xde\xff\xc4\xae\xb9\x99\x0c\x20\x1a\x46\x49\x18\xa4\x65\x51\x60\x4a\x21\x38\x00\x91\xd1\x04\x9a\x38\x80\x28\x0a\x03\x91\x83\x68\x8b\x18\xc9\x0c\x1f\x7e\x78\x34\x18\xbb\x31\xdf\x0d\xbf\x4b\x5e\x83\x80\x6f\x4e\xe0\xe8\x15\xad\xb9\x29\xcd\x09\xfb\x78\x2c\x3e\x0d\xdc\x27\xf1\x3f\xaf\x8e\x3f\x85\xc1\x2a\x0c\xf4\x42\x98\x74\x02\xcb\x2f\x27\x65\x4a\xf8\xb3\x16\x0c\x27\x27\xe0\xf3\xf4\xea\xf4\x86\xb6\x42\xf9\x08\x2f\x5e\xd8\x05\x4f\x20\x8a\x8e\xc3\x20\xa8\xe5\x2b\xf6\x0e\xcd\xa4\xcc\x68\x21\x2f\xca\x5a\xca\xb5\xfe\x88\xc6\x0e\x0e\x26\xac\x10\xda\x38\xa9\x97\x7c\x4a\x39\x1f\x2f\x06\xa0\x12\xea\x25\x05\x45\x0e\xb2\x34\xd4\x3d\xd2\xbf\x08\x5c\x38\xd5\xb6\x25\x5e\x97\xda\x8b\x0c\x26\x2c\x55\xc8\x0d\x3e\x21\xb5\xc6\xa5\x20\xc8\x30\xe7\xb3\xc2\x4f\x5d\x90\x5b\x29\x02\x2e\x08\xf9\xe2\x45\x93\x70\xdc\xcc\xb4\x5b\xe7\xb2\x34\xa7\x45\x51\x2e\x30\x1b\x00\xd2\x28\x4d\x09\x17\x47\x53\xdb\x6b\x75\xe5\xae\x3f\x4a\xec\x72\xcf\xb2\xe4\x37\xb5\xc7\xcf\x4a\x69\xb8\x90\x3a\x16\x99\x0b\x18\xab\xe0\x9c\x2b\x98\xdb\x5c\x97\x6c\x74\xce\x6e\x29\x9b\xdd\x36\x44\x4e\xda\x90\x17\x2b\xae\xb4\x8d\xd9\x6b\xae\xf8\xd4\xce\x7f\x31\x4f\x5e\xdb\xee\x6f\x4e\x40\x8a\xc2\xb9\xe4\xe9\xcd\xfe\xc0\x33\x1f\xd3\x03\xc8\xa7\x86\x59\x83\xe4\x71\x24\xe4\x9c\x17\x22\x23\x1d\x68\x93\xaa\x65\x61\x0a\x83\x6f\xef\x8f\xe1\xdb\x45\x44\x11\x6d\xcd\xe3\x4c\xe0\x13\xb7\xb6\xc6\xb3\x43\x44\x21\xcf\xb6\x9d\x39\x80\xf9\xf3\xa3\x84\x9b\x74\xd2\x84\xc9\xac\xca\x7a\xc3\xa4\x96\xbc\x35\xfd\x1c\x0b\x34\xd8\xcc\xcf\xec\xd7\xa7\x35\xfb\xcf\x46\x5a\x7b\xc5\xd6\x7a\x4f\xae\x76\x59\x9a\x37\x54\x15\x75\x1d\x4d\xa8\x01\xdf\xde\xc3\x82\x6b\xbb\x94\x2d\x9c\xa2\x01\xac\x81\x8c\x7c\x6b\xf1\x76\xb9\x7c\x02\x46\x86\x43\xd8\xca\xf0\x9a\x28\x38\x54\x44\xf3\x65\x0e\xdd\x6e\x69\x31\xa8\x54\x19\xaa\x86\x67\x85\x82\xd1\xb9\x76\x54\x6c\xfb\x89\x9e\x52\x2e\xe1\x0e\xa9\x7e\x33\xdd\xa1\x82\x00\x13\x33\xc8\x05\x16\x99\x6e\x91\xd0\xfd\x0c\xd5\xa3\xc7\x55\xa8\x28\x43\xd0\xa0\xd2\xbb\x08\xa0\x07\x9c\x9e\x43\x04\x63\x1c\x80\x30\x38\xd5\xd7\xa8\xae\xed\xb7\xed\x24\x1d\x63\x4c\x68\x27\xf2\xcd\x0c\xdd\x3b\x3f\x29\xcf\xc2\x75\x92\xad\xc2\xc0\xed\xf2\xf8\x04\x26\xcc\xf3\x6d\x67\x13\xec\x67\xea\x8f\x13\x17\x2f\xb6\x08\x71\xa0\xfe\xc6\x1a\xf2\x8d\x33\x1a\xc5\x50\x60\x4d\xa4\x09\x57\x9c\xe3\x9b\x99\x6d\xf6\xc8\xa9\x9f\xf9\x30\x17\x39\xe8\x01\x94\x9f\xfd\x36\xf9\x54\x7f\xb4\xac\x91\xb3\x7f\xdc\x5c\x5d\x7a\x0d\xa2\x4f\xaf\x69\x08\x6d\xb3\x49\xe0\x83\x9c\x8d\xf4\x85\x9c\x4d\xbd\xa0\x20\x98\x93\x0c\x37\xd7\x43\x5d\xac\x3f\xbe\xfc\x44\xab\x77\x20\x6f\xb3\x48\x64\xd4\x90\xb3\x5f\x78\x21\x32\x6e\x4a\x45\x13\x7b\x21\xf0\xf9\x46\xee\xa2\x99\xc7\x33\xbb\x03\x2c\x74\x0d\xc6\x6d\xa0\x6e\x54\xdf\x54\x7a\x03\xa7\x69\x63\xbb\x90\xfa\xeb\xb1\xba\x6b\xfd\x75\xe0\xaf\x01\xdb\xad\xbf\x86\xec\x9d\xdb\x6c\xf0\xcc\x45\x19\xfb\x75\x82\x0a\xe3\x1d\x2e\xb8\xb1\xe5\xa5\x0d\x28\x58\xad\x2e\x7e\x8e\xe7\x6b\x52\x6c\x4b\x6b\x7f\xb6\xd9\xdd\x64\x8a\x5b\x24\x0c\x82\x2b\x82\x83\xf8\x54\xa7\xfd\x6b\x59\x42\x3c\x2b\xa5\x36\x5c\x1a\x58\xad\x92\x84\x85\x41\xf0\x93\x98\x0a\x13\xb7\x53\xd0\x36\x5f\xe5\xb9\x46\x13\xc7\x94\xa1\x70\x04\xaf\x12\x38\xec\xe4\xa9\x1d\x74\x5a\x14\xb1\xa2\x23\x09\x1d\x94\xe2\x64\x77\x8e\x5e\x48\xd3\xb8\x65\x3b\x17\x6d\x01\x43\x78\x76\xb2\x9e\xe6\x1b\xe0\xe3\xa7\xc3\x4e\x62\x2e\x57\x36\x7d\xad\x54\xf2\xd7\x86\xa3\xaf\xfe\x39\x70\xb2\x12\x5f\xe6\x6e\x11\x63\xbb\x04\xef\x76\xd0\x3c\x77\xde\x58\xd7\xe2\xa3\xf3\x01\x70\x99\x81\x30\x1a\x90\x8f\x51\x1d\x15\x25\xcf\x30\x03\xcc\xc6\xb8\x13\x13\x7b\xd8\x78\x2f\x4c\xa4\xa2\x60\xab\x76\x49\x28\x13\x49\xb7\xc6\xe1\x4f\x43\x16\x39\x66\x47\xc4\x8d\xce\x63\x91\x39\xb7\xb7\xb0\x09\x29\xd7\x0e\x24\xbb\xa0\x2d\xf9\xc8\xfd\x55\x98\xc9\x72\x09\x15\xd7\x29\x2f\xe0\x00\x9b\x8d\x24\x6c\x23\x2e\x83\x2b\x59\x3c\xfe\x19\x51\xf0\x25\x97\x26\x9e\x48\xfb\x0a\x99\xe1\xb0\xeb\xcb\x33\x5b\xdd\x7a\xab\xd2\x99\x94\x3c\xaa\xfc\xd7\xbb\x32\xf3\xb7\x16\x08\xae\x0c\x5e\x9f\x75\xb7\x98\x96\x11\x89\x7a\xb8\xb7\x27\x38\xae\x88\x5d\xe9\xb8\x6c\x9a\x0b\x11\x2f\xb8\xdd\x55\xba\xae\xbb\x99\x28\x32\x54\x0c\xde\xa2\x4c\x71\x40\xc2\xa6\x42\x5b\xae\x25\x75\x84\x5a\x33\x30\xcd\x56\x48\x97\x0f\x0d\x4d\xd7\xd3\xc1\x56\x94\xf6\x30\xce\xdc\x01\xf5\x89\xdd\xae\xcf\xab\x35\x6b\xd8\xc4\xff\xa0\x51\x9d\xdb\xcb\x18\xef\xb7\xd1\x39\x1c\x6e\xc6\x1a\xfc\x46\x27\xf2\x63\x5f\x79\x8f\xce\xdb\x90\x38\x28\xa7\x04\x00\x95\x79\x8c\x7e\xeb\xc2\xd1\x26\xcf\x39\xa6\x74\x3e\x68\x33\x65\x1f\xe0\xc1\x61\x87\x02\xda\x0a\xe4\xcf\x5e\xbd\x13\xc9\x14\xc2\xa3\xf3\xf5\xca\xb8\xb9\xb2\x8b\xa5\x03\x64\x1f\xa4\xb8\x9f\xd1\x16\x49\x17\x4f\x52\x1f\x3f\x35\x37\x52\x6e\x32\x29\xb8\xc3\x52\xb8\x97\xa2\x0e\x8f\x7a\xce\x5d\xbe\x8d\x8a\xbd\x6e\x3b\x45\x3d\xe4\xaa\x9c\x6e\x85\xaf\x03\x25\x8f\x64\xc2\xec\xc2\xa1\xde\x43\xde\xfe\xd5\x19\x51\xb4\xc2\xfb\x27\x82\xad\x49\xf5\xe3\x13\x20\xc7\x51\xf9\x7d\x8e\x74\xdf\xa8\x62\xc5\x7e\x28\xb3\xc7\x84\xb9\xef\xf1\x0b\x85\xf7\xdb\xcc\xfd\x55\xac\xdd\xb6\x88\xe7\xe8\x9a\x9d\x5b\xb8\xe2\x53\x7c\x37\x6e\xba\x1d\xc5\xc9\x97\x12\x46\xe4\xe4\x03\x36\x3a\x6f\x6f\xc0\x8b\x67\x37\x68\x46\xe7\xf1\xa1\x1b\x40\x1a\xac\x3a\xce\xdf\x3b\x47\xfc\x22\xbd\xa9\xd2\xb3\xac\x1b\xf7\x6e\x66\x2c\x38\xdc\x20\xf1\x7a\x7c\xb8\x4b\xc2\xd3\x7a\x3d\x91\x3d\x47\x9b\x99\x12\x06\x1d\x55\xf1\x29\x55\xdb\xba\xe2\x4e\x5d\x37\x45\x74\x8b\xa0\x75\xe5\xd8\x2b\xec\x34\xa3\x29\xf1\x2e\x59\x8c\xb1\xba\x1e\x6f\xef\xbb\xfe\xdc\x21\x57\x2f\xff\x86\xcf\xf1\xaf\x64\x35\x17\x74\x59\x8b\xda\x36\x39\xec\x43\x95\xad\x13\xed\x29\x0e\x73\x67\xf4\x3d\x39\x8c\x70\xc3\xd6\x2e\x7b\xb3\x59\x3a\xa1\xf0\xc8\x1c\xe4\xc8\x52\x1e\xcd\x2c\x5c\x92\xcc\x0d\x41\x3d\x42\x14\x56\x05\xa7\x57\x81\x09\x02\x3e\x08\x6d\x88\xf9\xdc\x34\xaf\x3d\xed\xbf\x8f\xd4\xba\xdb\xef\x92\xda\x76\x22\xb9\xd1\xed\x44\xfa\x3b\xc9\x66\x7d\xdb\x82\x6c\x34\x9d\xce\x0c\xbf\x2b\xea\xf8\xfd\xdb\xa8\x68\x57\xf8\xbb\xd0\xeb\xb9\xe8\xf1\x6d\xfb\x97\xca\x5f\xc5\x55\xbd\x37\x4d\x5f\x59\x35\xf7\x12\x58\x27\xb0\xfe\x1b\x08\xcc\xe7\xf7\x6e\x02\x73\x3b\xba\x92\xe8\xea\xfb\xfd\x73\x64\x5f\xb2\x71\x1a\xf8\x71\xbb\x00\xfc\xcf\x25\x9b\x9d\xd9\xd3\x4b\x45\xfb\x73\x51\x7b\x2f\xcf\x24\xa3\xa0\xd1\xa0\x45\x47\x5f\xbb\xf2\x59\x81\xdc\xde\xbe\x24\x7f\x88\xd2\xfc\xbd\x43\xdb\xc8\x5b\x16\xaf\x3f\x77\x68\xce\x2b\xf3\x57\xd3\x5c\xe7\xf0\x36\x1c\x42\xcf\x7d\xb0\x6f\xdb\x1f\x66\x76\x21\x48\xef\x5d\xf3\x57\x22\x88\x37\xc6\xee\xdc\x73\xf7\xdd\x4d\xee\xb1\x8b\x07\x4c\x3b\x06\x7d\xfd\x47\xac\xc9\xac\xa2\x6f\xed\xbb\x75\xdc\x32\xe7\x65\x69\x2f\x5b\xa4\x21\x7b\x36\xac\xd0\x7e\x3c\x1f\x0e\xbb\x37\xa7\xee\x9b\x33\x6e\xc5\xc7\x42\xda\xe0\x6a\xdd\xeb\xd6\x3c\x4c\xf7\xb8\xeb\x1a\xa2\xbe\xda\xe8\xc8\x8a\x37\xcc\x96\x40\xbc\x7d\x81\x0b\x42\xba\xbb\x40\xfa\x2b\xd5\x8e\x6b\x5e\x38\x81\x57\xee\x49\x96\x90\x69\xd4\xee\xa1\x50\xdd\x79\xa7\x4a\x57\xa7\xeb\x6b\x53\xf6\x23\x1a\xba\x87\x1f\x63\x94\xbc\x06\x4d\xf9\x16\x45\xb4\x20\x05\x71\xd5\xdc\x26\xdb\x57\x42\x7a\x8c\x67\xa7\xa6\x14\xb1\xee\xa2\xf8\xef\xbf\xbb\x3b\xf6\xff\x03\xf7\x4e\xe8\x1d\x01\x2f\x07\xf0\xb2\x1f\xb6\x2b\x6f\xd7\xe6\x82\x30\x1a\x80\xf6\x70\xb7\xea\xd7\xb1\xbd\xf7\x1e\x5d\xdb\xdd\x7b\xea\xdc\x9e\x62\x75\xdf\x6c\xfb\xff\xc6\xbc\xef\xf8\xc3\x86\x85\xf7\xdc\x68\x47\x5e\x7b\xc3\x10\x4f\x67\x74\x14\x45\xb8\x43\xb3\x40\x94\xf0\xca\x96\x87\xbb\x57\x4c\xba\x36\xf2\x4b\x6f\x07\xc6\x80\xf2\x24\xdc\x0a\x64\xc5\xa7\xed\x48\x76\x50\x60\x1f\x60\x4a\xb5\xe3\xc5\x82\x02\xb1\x84\xf9\x76\x20\xd3\x9b\x9f\xf6\xc3\x07\x30\xa7\x71\xee\x8d\x7b\xb9\x4a\xc8\xc8\xa5\x7d\x49\xf7\x2f\x70\xf6\x82\x7d\xce\x62\x2a\x50\x6d\x2c\xdb\x07\xaf\x43\x37\x9b\x9e\xb9\x0e\xe7\x70\x02\xba\xc9\x5f\xab\xbe\x7b\x15\xab\x7f\x2b\xc2\x6e\xf1\xc1\x7c\x90\x53\xae\xf4\x84\x17\xa8\x8e\xd7\x83\xe7\xac\x69\xa7\x41\xf1\xc7\x4f\x77\xf4\x84\xad\x93\xa4\xf3\x38\xe5\x47\xdb\x02\xa5\x99\xb0\x1e\xec\x1e\xd3\xea\x4a\xae\x83\x2f\xee\x5b\xdb\x68\xf4\xf3\x01\xb7\x49\xff\xd3\x0a\x0d\x53\x7a\xf2\xa3\xc2\x9c\xec\x03\xda\x22\x8d\xfd\xd1\x90\xb7\xdd\x06\x62\xf5\x43\x6a\x37\xe3\xbd\xf5\x6a\x7b\x8d\x9a\x27\xb4\x98\xd0\xee\x19\x0f\x6e\x34\xbc\x11\xe2\x9f\x2b\x44\x29\x9d\x2a\x7b\xc9\x6a\x17\x61\x1d\x69\xf6\x2a\x5c\x71\x21\xcd\x33\xa4\xd1\xef\x6f\x0a\x91\x36\xb2\xf6\x7d\x42\x1c\x51\x90\x49\x5e\xd8\xdf\x4b\x28\x3b\xa2\xf3\x66\xd9\x1a\x6a\x23\xe1\xe9\xa9\x49\xb2\xe9\xf0\x66\xdd\x1e\x8f\xd3\x0c\xe0\xae\x89\x8e\x07\x9d\xd3\xa3\xf2\x7e\x6c\xfb\xba\xb5\x89\x7e\x67\x53\x70\xf4\x01\x7d\xa7\x0c\xa0\x41\x83\xd6\xc9\x2d\x38\xa3\x59\x00\x34\x11\x00\xea\x63\x0b\x0d\xa3\x63\x56\xe0\x0c\x05\x75\x22\xfb\x6e\x17\x8e\x76\xc0\x3b\xd4\x9a\xd0\xa4\x3b\x60\xea\x5a\x69\xc4\x6a\x49\xc2\x3a\x76\xb7\xc6\xa4\xd6\xc4\xea\xea\x20\x2e\x4e\x56\x75\x41\xd2\x68\xbc\x6d\xb7\x39\x2f\x66\xf8\x2c\xbb\xd1\xa0\x2f\x9b\x6c\x03\x71\x96\xc4\xf7\x9e\xea\x13\x76\x43\x6c\xe1\x49\xfe\x88\xce\xa9\xd1\x00\x22\x5e\x55\x85\x48\x2d\x73\xbb\x1f\x9e\x25\x9b\x35\x02\x49\x4f\xc2\xa0\x3e\xc2\x5c\x48\x77\x84\x59\x24\xcc\x7d\x8c\xe7\xdd\x8a\xe1\xdf\x03\x00\xd3\x7d\x76\x26\x5e\x28\x00\x00")
+
+func templateOpenapiHandlerTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateOpenapiHandlerTmpl,
+		"template/openapi/handler.tmpl",
+	)
+}
+
+func templateOpenapiHandlerTmpl() (*asset, error) {
+	bytes, err := templateOpenapiHandlerTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/openapi/handler.tmpl", size: 10334, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatePaginationTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x3a\x6b\x6f\xdb\x38\xb6\x9f\xad\x5f\x71\xc6\x48\x03\x29\xab\xc8\xed\x62\xb1\xc0\xcd\xac\x17\xc8\x6d\xd2\xbb\xb9\xe8\x66\x7a\x9b\xce\xdc\x0f\x45\x80\xa1\xa5\xa3\x98\x8d\x4c\xba\x14\xed\xc4\xf0\xfa\xbf\x2f\x0e\x5f\xa2\xfc\x4a\x8a\x9d\x7c\x89\x44\x1e\x9e\x17\xcf\x5b\x5e\xaf\x47\x67\xc9\x7b\x39\x5f\x29\xfe\x30\xd5\xf0\xe7\xb7\xef\xfe\xeb\x7c\xae\xb0\x45\xa1\xe1\x03\x2b\x71\x22\xe5\x23\xdc\x88\xb2\x80\xcb\xa6\x01\x03\xd4\x02\xed\xab\x25\x56\x45\xf2\x65\xca\x5b\x68\xe5\x42\x95\x08\xa5\xac\x10\x78\x0b\x0d\x2f\x51\xb4\x58\xc1\x42\x54\xa8\x40\x4f\x11\x2e\xe7\xac\x9c\x22\xfc\xb9\x78\xeb\x77\xa1\x96\x0b\x51\x25\x5c\x98\xfd\x8f\x37\xef\xaf\x6f\xef\xae\xa1\xe6\x0d\x82\x5b\x53\x52\x6a\xa8\xb8\xc2\x52\x4b\xb5\x02\x59\x83\x8e\x88\x69\x85\x58\x24\x67\xa3\xcd\x26\x49\xd6\x6b\xa8\xb0\xe6\x02\x61\x38\x67\x0f\x5c\x30\xcd\xa5\x18\x82\xdb\x3a\x99\x3f\x3e\xc0\xc5\x18\x26\xac\x45\x38\x29\xde\x4b\x51\xf3\x87\xe2\x13\x2b\x1f\xd9\x03\x12\xd0\x7a\x0d\x1a\x67\xf3\x86\x69\x84\xe1\x14\x59\x85\x6a\x08\x27\xb4\x93\xf0\xd9\x5c\x2a\x0d\x69\x32\x18\xa2\x28\x65\xc5\xc5\xc3\x88\xf0\xfc\xf5\x2f\xc3\x78\xe9\x5b\x2b\x85\x59\x50\x4a\xaa\x96\x9e\xea\x99\x1e\x26\x59\x92\x8c\x46\xf0\x8b\xaa\x50\x5d\x19\x39\xb8\x14\x8e\xd3\xd6\x88\x58\x85\x55\x2e\xe0\x69\xca\xcb\x29\x68\x09\x52\x79\xb5\xa1\xd0\x5c\x73\x6c\x49\x78\x06\x73\xf6\x80\x45\xa2\x57\x73\xdc\xc6\xd9\x6a\xc5\xc5\x43\x92\x94\x52\xb4\x86\xdb\x1d\xb2\x97\x6d\x09\xed\x1c\x4b\x5e\x13\x3a\x26\x80\xb5\x25\x0a\x92\xc7\x92\x2b\x92\xc1\xee\x81\xfe\x0a\x8c\x61\x78\x79\xf7\x7e\xb8\x07\xfb\x15\xf6\xd1\x43\x85\x2f\xa0\x37\x27\xfa\x4b\x84\xff\xea\x9a\x08\x58\xbd\xfd\xc6\x1a\x5e\xd1\x9d\x90\xa6\x0c\x93\xce\x1a\x48\xe2\x25\x6b\x16\x58\x24\xf5\x42\x94\x90\xca\x2d\x76\xb2\x70\x36\xcd\xc0\xdc\x09\xac\x93\x01\xaf\x41\xc2\x4f\xe3\x2d\x58\x92\xf3\xf4\x74\xdf\x8e\x61\x71\x9d\x0c\x06\x0a\xf5\x42\x09\xa8\x67\xba\xb8\x26\x64\x75\x3a\xf4\x66\xb5\xd9\x5c\xc0\x9b\xef\x64\xf4\x42\x6a\x60\xb0\x24\xba\xdb\xcc\x0e\x73\x90\x59\x32\xd8\x24\x1e\x93\xe0\x4d\xb2\x31\x32\xde\x99\x8b\x03\x3e\x9b\x37\x38\x43\xa1\xad\x59\x10\x25\xbb\x83\x0a\xb8\xd0\xa8\x6a\x56\x1e\x91\xd6\xc2\xa6\x99\xb3\x03\x58\x07\x4a\x76\x21\x95\x99\xa3\xa7\x70\x89\xaa\x45\xb0\x8c\x58\x6a\x72\x3e\x97\x2d\xd7\xb1\x35\xca\x1a\xe4\x61\x72\x0e\x49\x9a\x6d\xed\x04\x25\x8f\x5f\x52\x65\x7f\xf7\xb2\x2d\x63\xed\xec\x1e\x75\xcc\x97\x0b\xd5\x4a\xf5\x1b\xaa\x96\x88\x71\xcb\xfd\xd2\xbd\x9a\xf0\x80\x0e\x06\xbc\x67\x16\x70\xa3\xe9\x76\x5a\x2d\x15\x56\xc0\x05\x29\x1d\x59\x39\x75\x80\x39\x30\x51\x01\x6b\x1a\xf9\xd4\x42\x39\x65\xe2\x81\xf4\x47\x78\x3d\x06\x8a\x46\xf5\x42\x2f\x54\x20\xd5\xc2\x13\xd7\x53\xb9\xd0\x84\x6b\xa2\x90\x3d\xd2\x19\x8b\x8f\x78\x62\x1a\x9e\x50\x21\x4c\x99\xa8\xb0\x02\xb9\xd0\xe4\xd4\x65\xc3\xe9\x7e\x0b\xe7\xa3\x7d\x59\xc6\xf0\xce\x48\xf8\xde\xac\xc2\x5c\x72\x63\x0a\x12\x98\xb0\x31\x60\x45\x6c\xd8\x08\x40\xb1\xc0\xc7\x05\x23\xde\x54\x36\x15\xd1\x45\xb8\xb9\xf2\x6a\x70\x87\x98\xa8\x08\x2d\xd7\x2d\x59\xe6\x02\xfd\xb6\x31\x50\xe2\xba\xe6\xd8\x54\x05\xbc\x77\xcc\x33\x45\xe6\xc0\xbe\x2f\x90\x58\x26\x48\xc7\xb6\xd5\x93\x9e\x22\x57\xa0\xf1\xd9\x88\x9e\xd2\xd2\xff\xde\xfd\x72\x4b\x16\xe1\x52\x86\x89\xbc\xa4\x70\xe6\xb5\x85\x55\x0e\xbf\x7e\xfe\x78\xde\xb2\x1a\x4d\x08\xfe\xeb\x5f\x3a\xdd\x5a\x76\x5a\xb4\xdc\xb5\x2e\xb8\x39\x2d\xb4\x5a\x2d\x4a\x4d\x66\x73\x73\x05\x00\x9d\x23\xac\x37\xc9\xe0\x37\x3a\xd0\x5b\x8a\x6d\xe4\x0b\x3e\x6b\x6f\x20\x86\x18\x56\xdb\x3c\x9a\x88\x6a\x29\x39\xb2\xd1\xd1\x8e\x74\xb0\x36\xa1\xc1\xff\xfd\x4e\xe1\xfe\x62\xb8\x1c\xfe\xee\x59\xeb\x31\xe7\xf7\x79\x45\x00\x96\xd1\xbd\x00\x46\xe8\x5c\xce\x38\x65\x1f\xbd\x1a\xfe\xee\xec\xfc\x9f\x4c\xb5\x53\xd6\x18\x4e\xb6\x22\x83\xd7\x5c\x41\x9b\x0e\x6e\x5f\x8c\x28\xdd\x95\x66\x31\xb2\x34\x83\xf4\xeb\xfd\x64\xa5\x31\xb7\x51\x31\x23\x09\x27\xe6\x85\x12\x24\xb1\x5d\x38\xf8\xb4\xd3\xc6\xda\xe9\xe0\xc2\x29\xd7\xbd\xe6\x70\x73\x75\x01\x65\x71\x73\x95\x53\xb8\x5d\x20\xbd\x98\x87\x4d\x66\xe2\x00\x61\xfd\x69\x0c\x82\x37\xb1\xeb\x0b\xde\xe4\x07\x43\xa9\x11\xcf\xdf\xc4\x05\xbc\x79\x1a\x1a\xe6\x6c\xf0\x24\xbb\x23\x36\x67\xec\x11\x83\x1c\xd6\xa2\x8a\xcf\xec\xe9\xd7\xcf\x1f\xaf\xbd\x76\xcc\x03\x56\x1f\x51\xa4\x0d\x8a\x74\x92\x65\x59\x32\x38\x06\x9a\x12\xf2\x1c\x26\x59\x88\x42\x76\xa1\x8b\xd4\xbf\x8a\xd9\x2b\xaf\x25\x40\xee\xbf\x98\x33\x7f\x33\x3d\x8c\x86\x01\xb0\x52\x45\x39\x6b\xf2\x4a\x81\xaf\xb0\x27\x30\x21\x33\x32\x8b\x70\xb9\xc7\xce\xa5\x93\xdc\x78\xf5\xb1\x7b\x3b\x74\x65\x15\x1e\xb9\xb2\x25\x53\xb0\x8c\xbc\x69\xaf\x3b\x6d\xbb\x54\xf0\x29\x63\x8f\x9f\xd9\xd3\x3f\xb1\x6d\xa9\x54\x73\x40\xd6\xaf\x82\x63\x1d\x80\x32\xce\x45\x80\x9b\x20\x94\x37\xf1\xa0\xf8\x74\xf2\xf5\x42\xdc\xe7\x70\xba\xcc\x7e\xfe\x23\xc5\xe6\x35\x2c\x0b\x2f\xea\x4f\x63\x07\xe5\x17\x5e\x81\x7d\x21\xda\xc5\x9c\x2a\x4f\xac\xdc\x61\x1f\x4e\x2f\xe0\x4d\x35\xcc\x3b\xf4\x81\x20\xd9\xf9\xb2\xb8\xb9\xca\x60\x3c\x86\xb7\x31\x11\x63\x4c\x6d\x71\x8b\x4f\x47\x45\x98\xf1\xb6\xa5\x98\xcc\xab\xa1\x45\x6a\x5d\xdb\xf9\x34\x8c\x81\xb0\x5b\x8f\xe8\xe8\x99\xbd\x0c\xfe\xee\x28\xc6\xc0\xe6\xe9\x68\xd5\x13\x17\x21\x64\x7e\x7b\x42\x74\x97\xd2\x77\x23\xdb\x9e\xaa\x87\xb0\x04\x8b\x2f\x7d\x2c\x23\x8f\x4d\x8f\xd9\xf6\x70\x18\xf3\xe9\x6a\x26\xc2\xe5\xcb\x26\xab\x2a\x4b\xd8\xc8\xe5\x56\x2c\xef\x0f\x7c\x89\xc2\xb1\xe9\xf2\x2c\x17\x2e\x7f\x52\x66\xa9\xdc\xa2\x49\xeb\x58\xc1\x64\x05\xcb\x22\x4a\xf7\x66\xd7\x26\x5f\xe4\x7a\x8a\x2a\x3e\xd5\x42\x2a\xeb\x3d\xd5\x85\x55\x9e\xc5\xf6\xc9\x36\x3d\x98\xe5\x84\x55\x2a\x50\xec\xc9\x64\xe6\xe3\x28\xac\x0c\x15\xd4\x4a\xce\xa2\xdc\xbe\x75\x0d\x99\xd3\xfc\x8e\x0e\x52\x83\x3c\x87\x65\x17\xe5\xd6\x9b\x38\x76\xed\x4f\x2c\xe6\xd4\x7f\x1c\x69\xac\x64\x7b\x1c\xef\x80\xa3\xe7\xf0\x1f\xfa\xf8\x7e\x82\x3b\xa6\xfd\x89\x3d\xe0\x8d\xa8\xa5\xab\xca\xb8\xa8\xa5\x9a\x19\x3d\x02\x9b\x98\x52\x70\x8a\xd0\x35\xa9\x3b\x8d\x5d\x38\xdf\x05\xcd\xd1\x08\xfe\xc1\xda\x5b\x7c\xd6\xb4\x49\xb7\x23\x95\x6e\x81\xd7\x30\x93\x2a\xea\x11\xf1\x99\xb7\x1a\x58\xad\xc9\x80\xa8\x53\xb6\x68\xa9\x5b\x73\x45\x30\x6a\x90\xa2\x59\xc1\xd3\x14\x45\x60\x82\xaa\x40\xa9\x9e\x98\xaa\x20\x5d\x98\x08\x50\x73\xd5\xea\xac\x48\x06\x31\xd9\x89\x94\x8d\x8f\xad\xd3\x6e\x9d\x22\xac\x65\xf0\x93\xc2\x25\x97\x8b\xf6\x35\x4c\x4e\xb0\x26\xd6\x7f\x8c\xcb\x09\x2b\x1f\x63\x36\x1b\x16\xb8\xec\xd1\xde\xe2\x34\xde\x73\xdc\xde\x69\xa6\xb4\x73\x3e\x2a\x62\xaf\x45\xe5\xde\x8c\x8b\xfa\xda\xd7\xe8\xc1\x14\xbe\x44\x2a\xc8\x60\x50\xb8\xd8\x44\x2a\x0e\xb5\xf1\xca\xb8\x30\x25\x10\xde\xed\x92\xe2\x4d\x79\x57\x24\x83\x98\xae\x2b\x03\x3c\xa3\x6d\xb7\x45\x4c\x76\x1c\xc1\x36\x24\xfa\xad\x50\x2f\x2e\x5d\xb3\xfb\x81\xf8\xfd\x48\xac\xfa\x95\x76\x9f\x1c\x4c\x3d\x2c\x6c\xe9\x22\xeb\x10\x3a\x9c\x93\xef\xa0\x4a\xcd\xe1\xdc\x9e\x3c\xe3\x42\x47\x0e\xde\x3e\x71\x5d\x4e\xc9\x44\x4b\x1a\xad\x18\x48\xef\x5e\xa7\xa7\xf6\x88\x7d\xbd\x78\x39\x1b\xcd\x99\x4d\x3f\x13\xa9\xa7\xdb\x1c\x6b\x19\xf8\xf4\xad\x76\xc8\x8f\xc3\xec\x00\xf9\x33\x8b\xe4\x6f\xf0\xf6\xe2\x65\x57\xb7\xb0\xe9\x9b\x2a\x83\xd9\xa2\xd5\x30\x41\x60\x20\xa4\x38\x17\xf8\xc0\x34\x5f\xd2\x50\x4a\xe3\x03\xaa\x61\xee\x10\x7b\xb2\x91\x94\x86\x6a\xc3\x5e\x4d\xb4\x61\xaf\xa7\x49\xb0\x7b\x42\xce\x7a\x0d\x28\x2a\x37\xe9\x1a\x9d\x05\x77\x91\x62\xf4\x7d\x81\x6a\xd5\x9b\x36\x05\x1d\x5e\x7e\xba\xf1\xc9\xd5\x42\x4d\x16\xbc\xa9\x50\x15\x60\xe6\x69\x7b\xc7\x69\x16\xdf\xd0\xcd\xcb\x4e\xdc\x09\x2a\x4f\x4f\x8a\xff\xa3\xad\x5b\x36\xf3\xd3\xb4\x13\x85\x25\xf2\x25\x9a\x48\x1c\x9e\xc3\x19\x07\x64\x9a\x50\x82\x98\x2b\x72\xba\x93\xc2\x60\x18\x9a\xf6\x3f\xd0\x31\x0d\xea\x01\xa0\x0f\xb4\x17\x20\x8d\xb3\xed\x00\x1a\xaf\xf7\x20\x0a\x4b\x42\x75\x52\x7c\xf6\x3c\x6d\x36\xeb\x35\x05\x29\xfc\x6e\x77\x87\x92\xf0\x79\xd8\x31\x0c\x85\x7b\xf7\x5a\x1e\x8d\xa0\x63\x6b\xb3\x09\xfa\x65\x60\x97\x4c\x76\x25\x88\xc2\xe9\xa3\x0b\x7d\x25\x13\x74\xc7\x46\x6c\x9b\xb7\xb9\x88\xfc\xcf\x84\xff\x1e\xee\x2e\x05\xd8\x25\x57\x99\x50\x59\x4d\x25\x08\x90\xc7\xa6\x67\x31\xb1\x2c\x4e\xc5\xc9\xc0\x25\x30\x03\xd7\xcb\xd1\xf1\x9b\xef\x05\x0f\xd7\x66\x82\x90\xef\x9f\x1c\xb8\xa2\xac\x86\xb3\x98\xf3\xbd\xb5\x99\x77\x86\xc2\x9c\x24\x6a\xd4\x1d\xa4\xc9\x60\xbd\x3e\x07\xc5\xc4\x03\xc2\x49\x6d\xaf\xc7\x5c\x6c\x4b\xb7\x36\x30\xbb\xbc\x36\xb1\x20\x25\xc7\x3f\xa9\x8b\x5f\xe6\x94\x4f\x59\x93\x85\x95\x5b\xde\x34\x6c\xd2\x60\xb7\x72\x87\xa2\xe5\xe4\x44\xdd\xd2\x3f\x58\xfb\x3f\xf2\xcb\x6a\x4e\x50\x52\xd1\x0a\xbd\x14\xb7\x8b\x19\x2a\x5e\xd2\xfb\x4d\xeb\xa4\x37\xcf\x5f\xf8\x8c\x58\x2a\x6e\xda\x6b\xb1\x98\x65\x96\x9d\xc1\x96\x01\x98\xe7\xe2\xce\xdc\xd4\x07\xb7\x66\x95\xd4\x42\x7c\x33\x9d\x19\x4c\x56\x46\x91\x26\x12\xd4\x7e\x7b\xe8\xf5\x39\x18\x0c\x5e\x44\x3f\x86\xd3\x1e\x4c\x32\x18\x0c\x06\xe6\xed\xc2\xd2\xec\x66\xdb\x05\xbd\xd7\x34\xf3\x6e\x35\x13\x1a\x36\x9b\xdc\x40\xbb\x62\x86\x6e\x2f\xf5\xd6\xbe\xd9\xc0\x61\x63\x22\x2b\xa4\x83\xee\x16\xbb\x33\xc5\x3e\x16\x0d\xac\x23\x65\x8d\xd0\xd1\x0a\xd5\xf1\x71\x5b\xf4\xd4\xc8\x42\x96\x46\x26\x7b\x59\x1e\xf5\xc0\x55\x79\x07\x6b\xd2\xd3\x65\xd6\xe3\x77\x69\x30\x47\x6c\x79\xd3\x72\x7e\x1d\x3f\x67\xc1\xcb\xcd\x3d\xc6\x5e\xde\xf3\x00\x59\x1f\xb8\xe1\x9e\x5b\x8f\x46\x70\xed\x37\x98\xea\xb9\x7f\xd7\x3b\x98\xcb\x83\xd4\xd8\xf9\x2a\xf3\xf5\x84\x70\x40\x5c\xc1\xcd\x55\x14\x20\x02\x5b\x5d\x80\x08\xe3\xd2\xad\xe9\x69\x32\xb0\x56\x43\xfd\x74\xcf\x45\x9d\xb7\xbb\xda\x36\xf6\x76\xb7\xe4\xfc\xdd\xf2\xd7\xcd\x20\x63\x0d\x78\xef\x97\x70\x16\xb3\x95\x39\x14\x47\x0c\xcb\x97\x34\x6e\x60\x5c\x58\x26\xc7\x3b\x95\xf9\xa9\x85\x5b\xd3\xa4\x29\x32\xb9\x9b\xab\x4d\x9c\x0a\x0f\x42\x85\xc1\x94\xa3\x50\x18\xeb\x88\xf8\xca\xfc\xd8\xd0\x45\xca\x58\x0f\xa4\xee\x8a\x46\xaa\x74\x1b\xe1\xd2\x7b\x63\xd4\xb8\xf1\x3b\xa8\x0c\x8b\x3a\x1e\xfc\xa4\xbc\x32\xf7\x58\xdc\x5c\x79\xab\xce\x61\xc7\x33\x8c\xc5\x46\xfe\xd0\xf5\x36\xbb\x56\x6f\x5b\xf5\x53\x5e\x1d\xec\x71\x78\x65\x5a\x77\xe7\x06\x9b\x17\xf4\x1e\xa0\xa9\x67\x77\x8d\x55\xe8\xed\x0f\x43\x6f\x15\x3a\x87\x3e\xb8\xf9\x41\x22\x54\x12\x6d\x35\x37\x63\x54\x48\xf6\xbc\x6b\xb2\x82\x37\xdf\x87\xb9\x67\xd2\x66\x8d\xd0\xe5\x39\x37\x27\x0d\x8d\x03\x88\xd7\xb4\x9b\x4a\xbc\x5a\x15\xd1\xba\xc3\xdb\xf5\x73\x96\xd3\x4f\x0a\x2b\x5e\x32\xdd\x59\x08\x83\x79\x58\x33\x39\xdf\x88\xe0\x42\x44\x88\x04\x66\xa7\x94\x33\x24\x54\xbe\x33\x0b\x2e\x66\x1a\x9c\x1e\x7c\x1c\x20\x9c\xb3\x59\x1b\x0b\x9f\x66\x5e\xf0\xb9\xc0\x68\x67\x6f\x39\x54\x07\x82\x43\x06\x69\x10\xa2\x88\x1d\x34\x0e\xc3\x91\x56\x9c\xf9\xc9\xa0\xe8\x63\x5d\x7c\x4f\xc3\x14\x5d\x4f\xf4\x6c\xde\x84\x4a\xae\x86\x61\xc5\x59\x83\xa5\x1e\xbd\x69\x47\x51\x99\x19\x38\x1a\xc2\x49\x71\xa7\xa5\x72\x1f\x69\x4d\x84\x7e\x0e\xdf\x69\x2d\xb6\x93\x2e\x92\x19\xb5\x7d\xa0\x6a\x24\x76\xe2\x60\x4e\xa4\x35\xdf\x70\xf7\x54\xfe\x83\x6a\x0e\x64\xd2\xc3\x5a\xfd\x25\xb0\xb2\x8e\x45\x7f\x49\x72\x83\xfa\x07\xa5\x0e\x85\xef\x66\x43\x9d\x51\xf7\x85\x28\xbe\xce\x4e\xd8\x7d\x43\xa3\x28\xb1\x78\x4c\x5d\x5e\xb9\x35\x43\x2e\x00\xf8\x7a\xdf\x0b\xe1\xbe\x19\x15\xb4\x4f\x0d\x6b\x98\x5b\x84\x07\x07\x31\x77\xef\xa1\x5b\xf5\x74\x01\x9f\xb1\x5c\xf8\x1e\xd5\x74\x17\x26\xf1\x45\x1e\x76\x54\x16\x3b\x04\xb0\xae\x74\x4e\xa1\xc6\x7c\xea\x4a\x1f\x71\xd5\xa2\xce\xa2\x4e\xa8\x80\x2f\x87\x7c\xac\x97\x84\xcd\x62\x0e\x52\xc5\x69\x97\x9a\x03\x6e\xe6\x12\x82\x37\x66\x68\x47\xc8\xac\x2b\x13\xb7\x6e\x92\xe1\x87\x6b\x0d\x9f\xf1\x30\xe3\x09\x1f\xd1\x76\xe3\x81\x1d\xd3\x8c\xc2\x1c\x04\x67\xee\xdb\xa4\x19\x89\x38\x4e\x08\xbf\xe9\x35\x47\xd4\xfd\x1d\xc0\x1d\x01\xdc\x06\x21\x0b\xb8\x95\x3e\x30\x79\x7d\x13\xef\x72\x89\x4a\xf1\x0a\xb7\x9c\xc3\xd5\x1b\x8e\x82\xf3\x10\x73\x23\x24\x71\x32\x1a\x0d\x48\x9a\x10\x00\xec\x37\xc1\x5e\xc4\xb0\x2d\x60\x9a\x11\xfc\x60\xe0\x49\xa6\xa5\x7e\xce\xad\xa8\x39\x9c\xba\x49\x82\xcf\x2b\x39\x9c\x1e\xc9\x11\x45\xec\x76\xeb\xe0\x5c\x17\x70\xec\x4c\xdf\x11\x2f\xdb\x72\x93\x11\xff\xd6\x95\x5d\xd6\xf7\x7d\x1f\x9c\xc5\x6d\x2c\x55\x2d\x31\xd7\x50\x4a\x41\x03\x60\x4a\x5e\xf4\xdf\x49\xd1\xc5\x54\x23\x8c\x19\x88\xe4\xde\x06\xc2\x5e\x98\x95\x90\x31\x55\xa8\xfe\x7b\xb5\x1d\x45\xd2\xb3\xc8\xdd\xf2\xbd\x49\xfe\xe8\x24\x26\xfb\xf9\x35\x51\x97\xd7\x81\x7e\x94\xe2\xc3\x12\x9c\xc6\x4c\xc5\x3a\xde\xd5\xa3\xc7\xe7\x98\x73\x38\x8a\x00\x53\x74\x3f\xb8\x78\x15\x6b\xbe\x59\x3f\x8d\xf4\x40\x1d\x2b\xaf\x0f\xcd\x71\xcc\x57\x8d\x7f\xfd\x6b\xff\xbc\x65\xfb\x93\x07\xa1\xef\x15\x2f\xf6\xf6\xdc\x31\xd2\xd7\x3c\xdf\x96\x65\x3b\x81\x3a\xb3\xdd\x11\x95\x1a\x0b\xa7\x89\x08\xdf\xae\x94\x44\x79\xb0\x65\x74\xc5\xff\x4f\x51\x61\x3a\x0f\x75\x8c\x33\x9d\x1f\x62\xcc\x9e\xd9\xc3\x59\x11\x7e\x98\xf1\xc7\xf1\x68\x43\xc2\xc5\x18\xce\xdf\x25\x83\x2e\xe5\x5d\x8c\x77\xc9\x1f\x1d\x08\xd2\x38\xcc\xe2\x1a\xbb\x1b\xdd\x9d\x9f\x11\x4c\x97\x20\x7a\x23\x5f\x0a\x46\x1c\xdb\x7e\x34\x75\xa9\xdb\x49\x6d\x0f\x47\xa1\x33\x44\x4a\xde\xfa\x9f\xac\x54\x06\xa3\xf3\x65\x1b\xd6\x1b\xc9\x2a\xfa\x21\x5b\xc4\x1e\x99\x58\x32\x88\xa4\x1d\x47\x85\x81\xc3\x44\xdf\x93\x36\xc9\x8e\xee\x0c\x79\x18\xc3\xd7\xfb\x50\x04\xac\xbd\xa2\xf6\x94\x0e\x99\xfb\x76\x67\x14\xf3\x77\x6f\xc4\xa3\x11\x98\x70\x0a\x52\x20\xe0\xb3\x56\xcc\x37\x60\xf4\x3b\x92\x29\x96\x8f\x6e\xc0\xac\xcc\xcf\xed\x98\x90\xf4\xec\x07\xe9\x3b\x3c\x7d\x24\xec\xa9\x95\xee\x4f\xf0\xce\x32\x6e\x12\x77\x30\xb5\xed\x23\x97\x4d\x43\x51\xf0\xd5\xf5\x5d\x5f\x06\x9a\xfd\xa2\x48\x0d\x09\xfa\x36\x68\x49\x93\x64\xdb\xee\x4d\x6b\x26\xb7\x14\xbe\x6c\x28\xe2\x6f\x0e\x63\xd0\x6a\x81\x64\xa6\x80\x4d\x8b\x07\xc0\x7b\xc3\xff\xee\x48\x32\x18\x18\x0e\x60\x0c\xe6\xff\xd7\x0b\xc3\xc7\x7d\x60\xb8\x33\x3c\x83\xb8\x96\x0a\x78\x0e\xdf\x28\x2c\xbd\xcd\x23\x09\xce\xdf\xfd\x0c\x1c\xfe\x06\xdf\x7e\xb6\xfb\x63\xe0\x7f\x7a\x97\xc3\xb7\xf3\x77\xe6\x9c\xa5\xf2\x95\xdf\xe7\x8e\xce\xb7\xfb\x40\xf2\x5b\x58\xe4\xf7\x96\x27\x47\x3b\x56\x8f\xbd\xf4\xbe\x58\xf1\x97\x82\xed\x48\x60\x4f\x7e\x7d\x7b\x9f\xed\x1c\xeb\x3e\x1b\x1c\x38\x14\x4b\x75\x9f\x85\x50\x5c\xdc\xc6\x9a\x4a\x76\xc3\x68\x3c\x69\xfe\xf7\x00\x30\x4f\x7d\x12\x32\x2a\x00\x00")
+
+func templatePaginationTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatePaginationTmpl,
+		"template/pagination.tmpl",
+	)
+}
+
+func templatePaginationTmpl() (*asset, error) {
+	bytes, err := templatePaginationTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/pagination.tmpl", size: 10802, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatePredicateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x90\xc1\x6a\x1c\x3d\x10\x84\xcf\xab\xa7\x28\xc4\x1c\x6c\xf3\xaf\xe4\xdf\xb7\x04\x72\x70\x8c\x03\x86\xb0\x04\x9c\x7b\x90\xa5\x9e\x19\x61\x8d\x34\x91\x7a\x92\x2c\x42\xef\x1e\xe4\xdd\x04\x27\x90\x6b\xd7\xd7\xd5\x5d\x55\xab\xbe\x12\x77\x69\x3d\x66\x3f\xcd\x8c\x9b\xeb\xff\xdf\xec\xd7\x4c\x85\x22\xe3\x83\xb1\xf4\x94\xd2\x33\x1e\xa2\x55\xb8\x0d\x01\x2f\x50\x41\xd7\xf3\x37\x72\x4a\x7c\x9e\x7d\x41\x49\x5b\xb6\x04\x9b\x1c\xc1\x17\x04\x6f\x29\x16\x72\xd8\xa2\xa3\x0c\x9e\x09\xb7\xab\xb1\x33\xe1\x46\x5d\xff\x52\x31\xa6\x2d\x3a\xe1\xe3\x8b\xfe\xf1\xe1\xee\xfe\xf0\x78\x8f\xd1\x07\xc2\x79\x96\x53\x62\x38\x9f\xc9\x72\xca\x47\xa4\x11\xfc\xea\x18\x67\x22\x25\xae\x74\x6b\x42\xd4\x0a\x47\xa3\x8f\x04\xb9\x66\x72\xde\x1a\x26\x89\x93\xb2\xc7\x77\xcf\x33\xe8\x07\x53\x74\x18\x20\x3f\x19\xfb\x6c\x26\x92\x7f\xb0\xfb\xd6\xc4\xae\x56\x30\x2d\x6b\x30\x4c\x90\x33\x19\x47\x59\x42\x75\x9f\x5a\xd1\xb7\xbb\xa3\x5f\xd6\x94\x19\x17\x62\x27\xc7\x85\xa5\x10\x3b\x49\x91\xa7\xa4\x7c\xd2\x14\x59\x3b\x6f\x02\x59\xd6\x53\xa6\x25\xf8\xa8\xa7\x6c\xd6\x59\xbb\x12\xe4\xbf\xc8\xf2\x35\x48\x71\xd9\x7f\x45\x36\x71\x22\x0c\x5f\xfe\xc3\x10\xf1\xf6\x1d\x06\x75\x48\x8e\xca\xe9\x3d\xad\x51\x2b\x86\xa8\x0e\x66\x21\xb4\xd6\xab\xee\xdd\xfd\x8e\x81\x71\x8b\x96\x7d\x8a\x18\x53\x3e\xb3\xe7\xb4\x1d\x7f\xda\x7c\x70\x94\x8b\x12\x3b\x3e\xae\xf4\x97\x59\xdf\xbd\xe8\x23\xf5\xc8\x29\x9b\x89\xd4\xfb\x13\x8f\xd6\x2e\x5f\x15\x50\x2b\x28\x3a\xb4\x26\x7e\x0e\x00\x76\x8b\x5c\x5e\x3a\x02\x00\x00")
 
 func templatePredicateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1152,7 +1467,7 @@ func templatePredicateTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatePrivacyFilterTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x94\x4f\x4f\xe4\x38\x10\xc5\xcf\xf1\xa7\xa8\x45\xbd\x52\x82\x9a\x84\xe5\xb6\x2d\x71\x40\x2c\x48\x48\xbb\x88\x9d\x41\x9a\xe3\xc8\x38\xe5\x8e\x85\x63\xa7\x6d\xa7\xff\x28\xca\x77\x1f\x95\x93\x74\x37\x4c\x73\x99\x3f\x27\x68\x97\x5d\xef\xbd\x5f\x39\xee\xba\xe2\x9c\xdd\xda\x66\xe7\xd4\xb2\x0a\x70\x75\xf9\xd7\xdf\x17\x8d\x43\x8f\x26\xc0\x3d\x17\xf8\x62\xed\x2b\x3c\x18\x91\xc3\x8d\xd6\x10\x37\x79\xa0\xba\x5b\x63\x99\xb3\xe7\x4a\x79\xf0\xb6\x75\x02\x41\xd8\x12\x41\x79\xd0\x4a\xa0\xf1\x58\x42\x6b\x4a\x74\x10\x2a\x84\x9b\x86\x8b\x0a\xe1\x2a\xbf\x9c\xaa\x20\x6d\x6b\x4a\xa6\x4c\xac\xff\xfb\x70\x7b\xf7\xf8\xf9\x0e\xa4\xd2\x08\xe3\x9a\xb3\x36\x40\xa9\x1c\x8a\x60\xdd\x0e\xac\x84\x70\x24\x16\x1c\x62\xce\xce\x8b\xbe\x67\xac\xeb\xa0\x44\xa9\x0c\xc2\x59\xe3\xd4\x9a\x8b\x5d\x21\x95\x0e\xe8\xce\x60\x2c\xcf\x9a\xd7\x25\x2c\xae\xe1\x85\x7b\x84\x59\x7e\x6b\x8d\x54\xcb\xfc\x89\x8b\x57\xbe\xc4\xb8\x29\xec\x1a\x84\x94\x25\x45\x01\xf7\xf1\x2c\x25\x21\x1b\xca\x04\x74\x92\x93\x64\xc5\x03\x6c\x1c\x6f\x86\xc2\x97\x0a\x1d\x82\x6c\x8d\x08\xca\x9a\x78\x52\x5a\x07\x83\xb2\x32\x4b\x30\xb6\x44\x4f\x69\x56\x2d\x3a\x85\x1e\xb8\x29\xa1\x6e\x03\xa7\xfd\x3e\x67\xc9\x24\xb4\x57\xe8\x58\x42\x6d\x86\xce\xbc\x69\x74\x3c\x35\xb6\x04\x3b\x70\xc1\x2d\x8a\x36\x60\x19\xdb\xee\x8a\xa9\x61\xce\x92\x24\x1e\x4c\xd1\x84\x95\xce\x9f\x32\x96\xf4\x2c\xda\x7a\xae\x70\x0c\x75\xdf\x1a\x01\x31\xaa\x22\x3b\xc0\x4b\xde\x84\x38\x23\x1e\x80\x6b\x6d\x37\x43\xb6\xd6\x23\x01\xb7\xae\x54\x86\xbb\xdd\x10\x6e\x4c\xea\x81\xfb\xd1\x92\x8f\x89\xa3\x8f\x37\xe1\xa2\xc4\x21\x61\x54\xa5\xe3\xa9\xb0\x26\xe0\x36\xd0\x00\xe8\xef\x7c\xb4\x95\x01\x3a\x67\x1d\xcb\x18\x2b\x0a\xb8\x5b\x73\xfd\x7f\xec\x29\xb8\xd6\x1e\x64\x2a\xc2\x76\x0e\xab\x0c\x94\x8c\xee\x06\x41\x55\x37\x1a\x6b\x34\x61\xb0\xfc\x1e\xe6\x1c\x6c\xa8\xd0\x6d\x94\x47\x50\x81\xf2\x96\x68\x14\xdd\x59\x72\x02\xa9\x3c\x42\x92\x1d\x34\x49\x0b\xbe\xb3\xb9\x82\xe9\x12\xf5\x7d\x1e\xf7\x8d\x96\x69\x64\xd2\xcd\xe9\x07\xdd\xaf\xe8\x6c\xe8\x9b\xae\x32\x96\x28\x19\x2b\x7f\x5c\x83\x51\x3a\x8e\xd7\x61\x68\x9d\xa1\x55\x1a\xcf\xf4\x73\x8c\x28\x5d\xc6\xfa\x3d\x83\xff\x26\x9a\x1f\x60\xd8\xd3\xfe\xe5\x24\x26\xe5\xd3\x30\xea\x63\x18\xd3\xd6\xd3\x3c\x26\x8b\x23\x92\xfa\x87\x91\xac\xb9\x83\xaf\x10\xc9\x4f\x8a\x9f\x5a\x8d\x70\x7d\xe4\x3d\x35\x4a\x67\x6c\x08\xf5\x66\x10\x27\x86\x97\x0e\xb5\xf9\xe0\x3a\x23\x1f\x7e\xa3\x82\xa8\x60\x15\xe7\x98\xa7\x74\x85\xe3\x7a\xd7\x5d\x80\xe3\x66\x89\x30\x33\x54\x9b\xe5\x8f\xf1\xc3\xee\x7b\x96\x24\x82\x1e\x94\xf3\xa3\xfe\xf4\xaf\x19\x54\x1e\x79\x4d\x6f\xcb\x82\x25\xfb\x8c\xab\x7c\xf4\x94\xcd\x29\xfe\xd0\x1c\x4d\x19\x9b\x95\x28\x79\xab\xc3\xe2\x80\xc4\x28\x3d\x87\x7f\xd0\xec\x64\x7a\x76\xd0\x28\xc6\x47\x6e\x01\xad\xc1\x6d\x83\x62\xff\x18\x0c\x9f\xf6\x9f\xcf\x47\x9f\xe5\xf8\x10\xd2\xbd\x21\xba\xfd\xc8\xe7\xfd\x60\x4e\x8f\xf4\x63\x4a\x75\x9c\xee\x4f\x52\x9a\x84\x4e\x80\xaa\x7f\x1b\xa8\x37\x6f\xd4\xc4\x6a\xbf\xb8\xc7\x55\x8f\xb8\xba\x6e\x92\x65\xec\x5b\x00\x00\x00\xff\xff\x1e\xf7\xc2\x1c\x2c\x07\x00\x00")
+var _templatePrivacyFilterTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x94\x51\x6b\xe4\x36\x10\xc7\x9f\xad\x4f\x31\x0d\x29\xd8\x61\xcf\xbe\xde\x5b\x03\x79\x38\xd2\x04\x0e\xda\x70\x6d\x03\x7d\x2c\x8a\x34\x5a\x8b\xc8\x92\x2d\xc9\xc9\x2e\xc6\xdf\xbd\x8c\x2c\xef\x6e\xae\x7b\x2d\x94\xde\xd3\xae\x25\xcd\xcc\xff\xff\x9b\x91\xa6\xa9\xb9\x62\xb7\xae\xdf\x7b\xbd\x6d\x23\x7c\x78\xff\xc3\x8f\xef\x7a\x8f\x01\x6d\x84\x7b\x2e\xf0\xc9\xb9\x67\xf8\x64\x45\x0d\x1f\x8d\x81\x74\x28\x00\xed\xfb\x17\x94\x35\x7b\x6c\x75\x80\xe0\x46\x2f\x10\x84\x93\x08\x3a\x80\xd1\x02\x6d\x40\x09\xa3\x95\xe8\x21\xb6\x08\x1f\x7b\x2e\x5a\x84\x0f\xf5\xfb\x75\x17\x94\x1b\xad\x64\xda\xa6\xfd\x9f\x3f\xdd\xde\x3d\xfc\x7e\x07\x4a\x1b\x84\xbc\xe6\x9d\x8b\x20\xb5\x47\x11\x9d\xdf\x83\x53\x10\x4f\x8a\x45\x8f\x58\xb3\xab\x66\x9e\x19\x9b\x26\x90\xa8\xb4\x45\xb8\xe8\xbd\x7e\xe1\x62\xdf\x28\x6d\x22\xfa\x0b\xc8\xdb\x97\xfd\xf3\x16\xae\x6f\xe0\x89\x07\x84\xcb\xfa\xd6\x59\xa5\xb7\xf5\x67\x2e\x9e\xf9\x16\xd3\xa1\xb8\xef\x11\x4a\x56\x34\x0d\xdc\xa7\x58\x72\x42\xd2\xb4\x8d\xe8\x15\xa7\x92\x2d\x8f\xf0\xea\x79\xbf\x6c\xfc\xd1\xa2\x47\x50\xa3\x15\x51\x3b\x9b\x22\x95\xf3\x64\x21\xa2\xd7\x76\x0b\xd6\x49\x0c\xe4\x66\x18\xd1\x6b\x0c\xc0\xad\x84\x6e\x8c\x9c\xce\x87\x9a\x15\x6b\xa1\x43\x85\x89\x15\x94\x66\xc9\xcc\xfb\xde\xa4\xa8\x9c\x12\xdc\xc2\x05\x77\x28\xc6\x88\x32\xa5\xdd\x37\x6b\xc2\x9a\x15\x45\x0a\x2c\xd1\xc6\xc1\xd4\x9f\x2b\x56\xcc\x2c\xc9\x7a\x6c\x31\x9b\xba\x1f\xad\x80\x64\x55\x93\x1c\xe0\x92\xf7\x94\x3a\x59\xe3\xc6\xb8\xd7\xc5\xdb\x18\x90\x80\x3b\x2f\xb5\xe5\x7e\x9f\xb2\xac\x4e\x03\xf0\x90\x25\x85\xe4\x38\xe9\x78\x63\x2e\x95\x38\x3a\x4c\x55\x29\xbc\x14\xce\x46\xdc\x45\x6a\x00\xfd\x6e\xb2\xac\x0a\xd0\x7b\xe7\x59\xc5\x58\xd3\xc0\xdd\x0b\x37\xbf\xa6\x9c\x82\x1b\x13\x40\x95\x22\xee\x36\x30\x54\xa0\x69\x06\x30\x17\xd4\x5d\x6f\xb0\x43\x1b\x17\xc9\x5f\xc2\xdc\x80\x8b\x2d\xfa\x57\x1d\x10\x74\xa4\x6e\x4a\xb4\x9a\x66\x96\x94\x40\xa9\x72\x04\x89\xab\x8e\x35\xa9\x16\xfc\x4d\xe6\x00\xeb\x10\xcd\x73\x9d\xb4\x65\xc9\x30\xb1\x42\xf9\x0d\x7d\xd0\x7c\x25\x65\x4b\xde\x72\xa8\x58\xa1\x55\xda\xf9\xee\x06\xac\x36\x74\xb6\xf0\x18\x47\x6f\x69\x95\xda\xb3\x7e\x66\x8b\xca\x57\x6c\x3e\x30\xf8\x65\xa5\xf9\x15\x0c\x07\xda\xff\x3b\x89\xb5\xf2\x79\x18\xdd\x29\x8c\xf5\xe8\x79\x1e\xab\xc4\x8c\xa4\xfb\xcf\x48\x5e\xb8\x87\x3f\x21\x91\x5f\x2b\xfe\x36\x1a\x84\x9b\x13\xed\xa5\xd5\xa6\x62\x8b\xa9\x37\x8d\x38\xd3\xbc\x72\x09\x4b\x8d\x73\xbe\x22\x1d\xe1\x55\x47\xd1\xc2\x40\xba\x87\xba\xa4\x11\x4e\xeb\xd3\xf4\x0e\x3c\xb7\x5b\x84\x4b\x4b\x7b\x97\xf5\x43\xba\xd8\xf3\xcc\x8a\x42\xd0\x83\x72\x75\x92\x9f\xfe\xda\x65\x44\x1e\x78\x47\x6f\xcb\x35\x2b\x0e\x1e\x87\x3a\x6b\xaa\x36\x64\x7f\x49\x8e\x56\xd2\x13\x54\x48\x54\x7c\x34\xf1\xfa\x88\xc4\x6a\xb3\x81\x9f\xd0\xee\x55\x79\x71\xac\xd1\xe4\x47\xee\x1a\x46\x8b\xbb\x1e\xc5\xe1\x31\x48\xf7\x0e\xbe\x7f\x3c\xb9\x96\xf9\x21\xa4\xeb\x43\x03\x37\x67\x3e\x5f\x36\xe6\x7c\x4b\xbf\x4e\xa9\x23\x12\xdd\x3f\x52\xa2\x34\x4f\x06\xff\x1d\xd6\x5a\xef\x0c\xaf\xee\x9b\xf1\x5a\x01\xbc\x41\x76\x58\x3c\x50\xeb\x32\xb5\x69\x02\xb4\x12\xe6\x99\x31\xf6\xd7\x00\x13\x38\xa2\xb5\x33\x07\x00\x00")
 
 func templatePrivacyFilterTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1167,12 +1482,12 @@ func templatePrivacyFilterTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/privacy/filter.tmpl", size: 1836, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/privacy/filter.tmpl", size: 1843, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatePrivacyPrivacyTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x4d\x6f\xe3\x38\x12\x3d\x5b\xbf\xa2\x20\x38\x80\xd4\xeb\xa6\x67\xe7\xb6\xbd\xe8\x43\x23\x9b\x00\x0d\xec\x74\x66\x77\xfa\xde\x60\xa4\x92\x4d\x44\x22\x15\x8a\x8a\x63\x18\xfa\xef\x8b\x2a\x51\x9f\xfe\x88\x77\x26\x7d\x4a\x4c\x91\xaf\x1e\x5f\x3d\x96\x8a\x3a\x1c\xd6\x1f\x82\x5b\x53\xee\xad\xda\x6c\x1d\xfc\xfa\xcb\xdf\xff\xf1\xb1\xb4\x58\xa1\x76\x70\x2f\x13\x7c\x34\xe6\x09\xbe\xea\x44\xc0\x97\x3c\x07\x9e\x54\x01\x3d\xb7\x2f\x98\x8a\xe0\xfb\x56\x55\x50\x99\xda\x26\x08\x89\x49\x11\x54\x05\xb9\x4a\x50\x57\x98\x42\xad\x53\xb4\xe0\xb6\x08\x5f\x4a\x99\x6c\x11\x7e\x15\xbf\x74\x4f\x21\x33\xb5\x4e\x03\xa5\xf9\xf9\xbf\xbf\xde\xde\x7d\xfb\xe3\x0e\x32\x95\x23\xf8\x31\x6b\x8c\x83\x54\x59\x4c\x9c\xb1\x7b\x30\x19\xb8\x51\x30\x67\x11\x45\xf0\x61\xdd\x34\x41\x70\x38\x40\x8a\x99\xd2\x08\x61\x69\xd5\x8b\x4c\xf6\x21\xb4\xe3\x1f\x61\xa7\xdc\x16\xf0\xd5\xa1\x4e\x61\x09\xe1\xef\x32\x79\x92\x1b\x0c\x47\x33\x3f\x36\x4d\xb0\x38\x1c\xc0\x61\x51\xe6\xd2\x21\x84\x5b\x94\x29\xda\x10\x04\xa1\x1c\x0e\x40\x6b\x09\x4f\x15\xa5\xb1\x0e\xa2\x60\x11\x1e\x0e\xb0\x14\xb7\x46\x67\x6a\x23\x3c\x26\x34\x4d\x18\x04\x8b\x10\xb5\xdb\x18\xa1\xcc\x1a\xb5\x5b\x77\x51\x82\x98\x69\x2e\xcb\xa7\x0d\x7c\xfa\x0c\x8f\xb2\xc2\x53\x00\x41\xf0\x22\x2d\x05\x58\xaf\x49\x6f\xb3\x83\x42\xee\xe1\x11\xc1\xa2\xab\xad\xc6\x14\x1e\xf7\x60\xeb\x1c\x2b\x70\x06\x94\x4e\x55\x42\x8c\xdd\x56\x3a\xd6\xac\x34\xb9\x4a\xf6\xbc\x1c\x5f\x64\x5e\x4b\xa7\x8c\x86\x6a\x6b\xea\x3c\x05\x87\xb6\x50\x9a\xe6\xb3\x28\x52\x83\xe4\x10\x29\x26\xaa\x52\x46\x8b\x60\xd1\xc6\xfc\x0c\x9e\xb6\xe0\xdf\x01\xe3\xfd\x0b\xf5\xfe\xe7\xb2\x49\x29\xc2\x88\x0c\x47\x1c\xb8\xd0\xcf\x96\xca\x1f\x4f\xaa\x7c\x4f\x2a\x89\xd1\x4e\xe9\x1a\x69\x29\x4d\xd6\xf8\xea\x18\x4c\x04\x0b\x8e\x35\x90\xa0\x9f\x6d\x2e\x3f\x82\x95\x7a\x83\xb0\xec\x18\x53\x5e\x73\x55\x39\x08\x59\xb5\x10\x42\x62\x1c\x42\x48\x6b\xd8\x90\x14\x9d\x4c\xd0\xaf\x68\x9a\xcc\x6f\xa0\x22\x01\x32\x63\x0b\xe9\x1c\xa6\xb0\xb3\xb2\x2c\x31\x9d\xcf\x1e\xab\x93\xd5\x3a\x39\x42\x8b\x5a\x08\xa8\x9c\x55\x7a\xb3\x02\x09\x42\x08\xa5\x1d\xda\x4c\x26\x78\x68\x62\x40\x6b\x8d\x85\x43\xb0\x58\xb4\x81\x21\x2b\x9c\xb8\xa3\xc1\x6e\xf1\xdf\xc2\x4f\x70\xb3\x0b\x57\x40\x14\x74\x1a\xc9\xd5\x3c\x4c\x2c\x84\x88\x83\x45\xc3\x2a\x74\x87\x83\x2d\xd2\x4e\xb9\x35\xda\x91\x84\x89\x45\xe9\xb0\x02\x09\x1a\x77\xac\x32\x8d\x66\xd6\x14\x2c\xf3\x46\xbd\xa0\x86\x52\x5a\xaa\x35\xdd\x53\x32\x03\x61\x49\x9f\xb2\x7e\xcb\x20\x9d\x93\xc9\x96\xd3\xeb\x44\xc0\xdb\x9f\x05\x8c\xa6\x58\xc2\x0f\xaf\x06\x0c\xde\x7d\x3c\x9f\x40\x72\x78\x35\x06\xb3\x9d\x42\x1e\x90\xe2\x60\xba\xe5\x7b\x6b\x8a\x0e\xcd\xa2\xb3\x0a\x5f\xc8\x8a\xbd\xf3\x06\x0a\xfd\xf6\x3b\x12\xd3\xad\x8c\x80\xa2\xc4\xbd\xce\xa9\xc6\x10\xf1\x1e\x56\xf0\x68\x4c\x1e\x5f\x60\x3e\x03\x62\xc2\x6e\x5f\xa2\xaf\x2e\xff\xa9\xd1\xee\xff\x5b\xe7\xe8\x4b\x67\x4b\xb6\xb7\x0a\xf3\x4d\x95\xde\xc0\x6e\x8b\x6e\x8b\x16\x24\x2f\x7b\xa6\x65\x54\xe4\xb9\x74\x60\x0a\x52\xa7\x60\x4a\x3a\x4d\x32\xcf\xf7\x50\x98\x54\x65\x7b\xce\xd0\x62\x08\x31\x9c\x9f\x7e\x6c\x20\xf1\x7b\x2b\x50\x62\x8a\x47\xe6\x51\xd4\xb9\x53\x65\x8e\x3e\x56\x7b\xa8\x95\x76\x06\x24\x54\x4a\x6f\xf2\x4e\xd3\x2e\x84\x07\x98\x05\x69\x47\xe9\xac\x8e\x77\x7b\x4f\x5a\xb3\x0c\x8a\x8f\x9c\x4c\x65\xe9\xe8\x25\x65\x7c\x31\x24\x15\xea\x0a\xc1\x64\xb4\xce\xd8\x54\x69\x69\xf7\x40\x39\xa2\x4d\x56\x20\xab\x31\x31\xd1\x6a\x3a\xc5\xa7\xc9\xd1\x91\x09\xbb\xf2\xdf\x34\x2d\x41\x7f\x16\x99\xdf\xdd\x8b\xcc\xfb\x4a\x90\x51\xbe\x56\xf0\x1c\x7b\x6b\x44\xd9\x14\x3f\xe6\xe9\x3c\x74\xca\x22\x2b\x78\x3e\x17\x6b\x64\x97\x3e\xc8\xcc\x17\xbf\xd5\x8e\x6b\xe3\x05\x6b\xec\xb6\x2a\xd9\xb6\x06\xc1\x6a\xe6\x8f\xc2\x2f\x7f\xcb\x22\x8a\x72\x4a\x26\x99\xc4\x1b\x52\x38\x1e\x9e\xf0\x3a\xeb\x96\x3e\xf2\x45\xc3\xcc\x60\x8e\x03\x4e\x6c\x33\x66\x71\xce\x39\xad\x1a\xbc\xd7\xea\x2a\xf7\x4c\x89\x7a\x03\x1d\x45\x7a\xd3\x43\xdd\x8a\xb9\x8d\x7e\xeb\xe1\xa7\x76\x2a\x46\x76\x9a\x47\x8b\x27\x2b\x4f\x9b\xaa\xb8\x10\xfc\xd8\x57\x45\x57\x20\xbd\xd0\x1b\x6b\xea\xb2\x3b\x39\x64\x88\x5e\x05\x4e\x8d\xea\x85\xf0\xf3\x2b\x67\xeb\x84\x0b\x33\xfb\x77\x5c\x29\x86\x24\xce\x4c\xe1\x23\xf6\x87\x83\xde\xa7\x3b\x69\xd3\x6a\xfc\xca\x77\xa6\x23\xd1\x9b\xa2\x55\xc5\xd7\xe9\x16\xea\x5d\x8e\x98\xc7\xe7\xa7\x62\x82\xd7\x9d\xbb\x79\xca\xce\x10\x66\xad\x10\x24\xbc\x4d\xf9\x5d\x72\xe8\xa3\x74\x13\xc4\x1c\x78\x94\x5e\xde\xd2\xe4\x0c\xb7\xa7\x63\x5e\x2d\xce\xe7\xff\xa8\x8c\x4e\xd1\x7a\x9c\xc3\xe8\x85\x32\x2d\x1b\x9e\xca\x97\x7c\x27\xf7\x15\xf7\x5e\xbc\xb4\x6f\xac\x38\x44\xdb\x0a\x8e\x9a\xad\x79\xef\xcb\x92\xce\x30\xa2\xf8\x04\xa5\x91\xd7\xd5\x2b\xa6\xdd\xfb\xf6\xc0\xab\x9a\x09\x19\x6a\x00\xdf\xe6\x32\x6f\x7c\x47\x4c\x3a\x80\xff\x8b\x08\x2d\x6a\xfa\xb2\x3e\x79\x36\x3a\x57\xd3\x9e\x88\xa6\x77\xd5\x61\xb2\x62\x72\x12\xae\x7c\xa5\x8d\x99\x89\x2e\xce\x1b\x11\x06\x7f\x5d\x5f\xf3\xce\xc6\xe1\x8d\x7b\xa0\x13\x5b\xa7\xd3\x75\xb2\xc0\xc6\x83\x18\xeb\x35\xf8\xc1\x63\xe1\x87\xbe\x96\xfd\xbc\x9e\x78\xb9\x6d\xf0\x64\xdf\xd0\xf6\xc1\x7c\x62\xcf\xa1\x46\x6f\xb1\xba\x6c\x81\xd9\x6e\x0f\x84\xd6\x0c\x9a\x27\xf3\x09\x6f\x56\xb8\x1f\x57\x64\x37\x11\x14\xa6\x6f\x2f\x2f\x86\xba\x5c\x99\x7e\x5c\x97\xe6\x59\xc0\xf5\x1a\x1e\x74\x37\xfb\xa1\x44\xdb\xa6\xc1\x57\x4f\xdf\xb8\xb4\xd7\x0c\x4e\x8d\xd1\xf9\x1e\xe8\x2a\xe1\x07\xfb\xc4\x99\x6e\xad\x4f\xd2\x09\xd4\x88\x11\xc6\xea\xaf\xc0\x94\x63\xda\x0f\x65\x0c\x67\xb2\x33\x7f\xe1\x46\x6d\x9e\xff\x4c\x8d\x5e\xa8\x0c\x0a\xf1\x50\x46\xb1\xf8\x5a\x45\xa6\xe4\x3b\x40\x7f\x9b\xe3\x5b\xeb\xc9\x7a\xbd\x58\x34\xc3\xa5\x8f\xaf\xb1\x8b\x66\xb8\xc5\xe8\xfd\xd1\x86\x4f\x15\x2e\xaa\x54\x74\x29\xa8\x4a\x4c\xa8\x89\x4b\xcf\x4b\x78\x16\x33\xba\x42\x35\xfa\xfb\xe9\xf3\x19\xd9\x7e\xfc\x09\xd1\xfc\xbe\x89\x54\x16\x85\xc3\xe4\xee\x03\xcd\xa7\x81\x3f\xdc\x54\xf4\x12\xd3\xc6\x75\x1d\x6c\xb8\xf2\x8a\xc7\xac\x59\x07\x76\xce\x24\xe4\x0b\x56\x76\xf4\x91\x80\xbf\x0e\x2c\xc5\x37\x43\x2d\xb3\xff\xe0\xb4\xd4\xb2\xe0\x6d\x96\x56\x69\x07\x4b\x2d\xbe\xd1\x40\x38\x69\xf6\xc3\x7e\x36\x17\xb5\x6e\x76\x06\xe1\x87\x9b\x4a\xdc\x54\x61\xbb\x93\xa5\x6e\xcf\x28\x23\xf8\x4f\x0d\xdf\xb7\x08\x7d\x98\xa6\xb9\xf2\xda\xd3\x77\xad\x8c\x31\xe9\x5c\xe5\xe8\xe6\x23\x82\x05\xe3\x8d\x03\x9c\xef\x59\x79\x6a\xd3\xf4\xbd\xea\x62\xd2\xa7\xcd\xaf\x24\xdd\xd7\x8d\x28\x1b\xc3\xff\xb5\x9e\x8c\x8e\xcd\xf3\x0a\xcc\x13\x49\xf8\x2c\xa2\x31\xab\x7f\xd2\xf0\xf8\x14\x0d\x97\xa3\xc9\xa9\xb9\xe0\x9e\x5a\xe3\x6b\x89\x89\xc3\xd4\x6b\xc4\xd8\x37\xdf\x57\xd0\x8e\x8f\x55\x08\x5b\xe4\x26\x18\xb9\xe0\xc8\x04\x73\xeb\xcf\x7c\x70\xde\x06\xdd\xc2\x9f\xec\x84\xc9\x3b\xef\x7d\xcc\xd0\xb7\xc1\x89\xcc\xf3\xc9\xbd\xe5\xbc\x1d\xfe\x5a\xbf\xcb\xb5\xb4\x33\x45\x71\x9d\x29\xe6\xa5\xf4\x3a\x53\xf4\x72\x5d\xf6\x45\x71\xf4\x59\x8d\xfe\x57\x19\x2c\xc5\x3d\x4a\x57\x5b\xbc\xd3\xf2\x31\xc7\x14\x42\xd4\xee\x39\xef\x5d\x31\x7c\xbc\xf6\xd1\xd7\x99\xca\x1d\xda\x10\x96\xed\x47\xec\x31\x62\xf7\xef\xff\x02\x00\x00\xff\xff\x2d\x5a\x14\xf5\xfc\x17\x00\x00")
+var _templatePrivacyPrivacyTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x4d\x6f\xe4\xb8\x11\x3d\xb7\x7e\x45\x41\x68\x03\xea\x49\x9b\xbd\xd9\x5b\x26\x98\xc3\x60\xe2\x01\x0c\x24\xe3\x4d\x76\x90\xeb\x82\x96\x4a\xdd\x84\x25\x52\x26\x29\xb7\x1b\x82\xfe\xfb\xa2\x28\x52\x5f\xfd\xe5\x9d\xf5\x1e\x55\x22\xeb\xbd\xaa\x7a\x55\xa2\xd8\x34\x9b\x0f\xd1\x17\x55\x1d\xb4\xd8\xee\x2c\xfc\xfc\xd3\xdf\xff\x71\x5b\x69\x34\x28\x2d\x7c\xe5\x29\x3e\x2a\xf5\x04\xf7\x32\x65\xf0\xb9\x28\xc0\x2d\x32\x40\xef\xf5\x0b\x66\x2c\xfa\xbe\x13\x06\x8c\xaa\x75\x8a\x90\xaa\x0c\x41\x18\x28\x44\x8a\xd2\x60\x06\xb5\xcc\x50\x83\xdd\x21\x7c\xae\x78\xba\x43\xf8\x99\xfd\x14\xde\x42\xae\x6a\x99\x45\x42\xba\xf7\xff\xbe\xff\x72\xf7\xed\xd7\x3b\xc8\x45\x81\xe0\x6d\x5a\x29\x0b\x99\xd0\x98\x5a\xa5\x0f\xa0\x72\xb0\x23\x30\xab\x11\x59\xf4\x61\xd3\xb6\x51\xd4\x34\x90\x61\x2e\x24\x42\x5c\x69\xf1\xc2\xd3\x43\x0c\x9d\xfd\x16\xf6\xc2\xee\x00\x5f\x2d\xca\x0c\x96\x10\xff\xc2\xd3\x27\xbe\xc5\x78\xb4\xf2\xb6\x6d\xa3\x45\xd3\x80\xc5\xb2\x2a\xb8\x45\x88\x77\xc8\x33\xd4\x31\x30\xf2\xd2\x34\x40\x7b\xc9\x9f\x28\x2b\xa5\x2d\x24\xd1\x22\x6e\x1a\x58\xb2\x2f\x4a\xe6\x62\xcb\xbc\x4f\x68\xdb\x38\x8a\x16\x31\x4a\xbb\x55\x4c\xa8\x0d\x4a\xbb\x09\x28\xd1\x8a\xe8\xc0\xb2\x7a\xda\xc2\xc7\x4f\xf0\xc8\x0d\x9e\x72\x10\x45\x2f\x5c\x13\xc0\x66\x43\xf9\x56\x7b\x28\xf9\x01\x1e\x11\x34\xda\x5a\x4b\xcc\xe0\xf1\x00\xba\x2e\xd0\x80\x55\x20\x64\x26\x52\x62\x6c\x77\xdc\xba\x9c\x55\xaa\x10\xe9\xc1\x6d\xc7\x17\x5e\xd4\xdc\x0a\x25\xc1\xec\x54\x5d\x64\x60\x51\x97\x42\xd2\x7a\x97\x14\x2e\x81\x3b\x88\x0c\x53\x61\x84\x92\x2c\x5a\x74\x98\x9f\xc0\xd3\x66\xee\x39\x72\xfe\xfe\x85\xf2\xf0\xd7\xb2\xc9\x08\x61\x44\xc6\x21\x0e\x5c\xe8\xb1\xa3\xf2\xeb\x93\xa8\xde\x93\x4a\xaa\xa4\x15\xb2\x46\xca\x29\x65\x51\xe2\xab\x75\x59\x66\xd1\xc2\x61\x0d\x24\xe8\xb1\xab\xe5\x2d\x68\x2e\xb7\x08\xcb\xc0\x98\xea\x5a\x08\x63\x21\x76\x59\x8b\x21\x26\xc6\x31\xc4\xb4\xc7\x09\x92\xd0\x9b\x66\xb4\xa3\x6d\x73\x1f\x80\xa1\x04\xe4\x4a\x97\xdc\x5a\xcc\x60\xaf\x79\x55\x61\x36\x5f\x3d\xce\x4e\x5e\xcb\xf4\xc8\x5b\xd2\xb9\x00\x63\xb5\x90\xdb\x35\x70\x60\x8c\x09\x69\x51\xe7\x3c\xc5\xa6\x5d\x01\x6a\xad\x34\x34\xd1\x62\xd1\x01\x43\x5e\x5a\x76\x47\xc6\xb0\xf9\x6f\xf1\x47\xb8\xd9\xc7\x6b\x20\x0a\x32\x4b\xf8\x7a\x0e\xb3\x62\x8c\xad\xa2\x05\xf5\xc6\x6d\xdf\x1c\x4e\x22\x5d\x5c\x5f\x94\xb4\x94\xc2\x54\x23\xb7\x68\x80\x83\xc4\x3d\xa4\xde\x9a\x6b\x55\xba\x9a\x6c\xc5\x0b\x4a\xa8\xb8\xa6\x59\x13\xde\x92\x18\xa2\xcd\x06\xb8\x2f\x59\x1f\x32\x70\x6b\x79\xba\xa3\x1a\x09\xcb\x22\x17\xfe\x0c\x30\x99\xfa\x62\xde\xbc\x1e\x7c\xb8\xe8\x57\xf3\x05\x94\x0e\x9f\x8d\x41\x6c\xa7\x3c\x0f\x9e\x56\xd1\x34\xe4\xaf\x5a\x95\xc1\x9b\x46\xab\x05\xbe\xa0\x19\x29\x6f\xa0\xd0\x87\x1f\x48\x4c\x43\x19\x39\x4a\x52\xfb\x3a\xa7\xba\x82\xc4\xc5\xb0\x86\x47\xa5\x8a\xd5\x05\xe6\x33\x47\x8e\xb0\x3d\x54\xe8\xa7\xcb\x7f\x6b\xd4\x87\xff\xd5\x05\xfa\xd1\xd9\x91\xed\xa5\xe2\xf8\x66\x42\x6e\x61\xbf\x43\xbb\x43\x0d\xdc\x6d\x7b\xa6\x6d\x34\xe4\xdd\xe8\xc0\x0c\xb8\xcc\x40\x55\xd4\x4d\xbc\x28\x0e\x50\xaa\x4c\xe4\x07\x57\xa1\xc5\x00\x31\xf4\x4f\x6f\x1b\x48\xfc\xd2\x25\x28\x55\xe5\xa3\xe3\x51\xd6\x85\x15\x55\x81\x1e\x8b\xfa\xd0\x80\x90\x56\x01\x07\x23\xe4\xb6\x08\x39\x0d\x10\xde\xc1\x0c\xa4\xb3\x52\xaf\x8e\xa3\xfd\x4a\xb9\x76\x69\xa0\x18\x24\xf0\x8c\x57\x96\x3e\x52\xca\x0f\x43\xca\x42\x6d\x10\x54\x4e\xe5\x55\x3a\x13\x92\xeb\x03\x50\x8d\x28\x48\x03\xdc\x8c\x89\xb1\xc8\x39\x9b\xfa\xa7\xc5\xc9\xac\x70\x5d\x13\xd1\xf8\x6f\x5b\xe6\x96\xfb\x5e\x74\xfc\xee\x5e\x78\xd1\x4f\x82\x9c\x0a\xbf\x86\xe7\x95\x97\x46\x92\x4f\xf9\xaf\xdc\x72\x67\x3a\x25\x91\x35\x3c\x9f\xc3\x1a\xc9\xa5\x07\x99\xe9\xe2\x3f\xb5\x75\x1f\x8d\x0b\xd2\xd8\xef\x44\xba\xeb\x04\x82\x66\xa6\x8f\xd2\x6f\xbf\x26\x11\x81\xa6\x13\xc9\x04\x6f\x28\xe1\xd8\x3c\xe1\x75\x56\x2d\x3d\xf2\x45\xc1\xcc\xdc\x1c\x03\x4e\x64\x13\x8c\x97\x94\xd3\x65\xc3\xc5\x6a\xde\xa4\x9e\x29\x51\x2f\xa0\x23\xa4\xab\x1a\x0a\x3b\xe6\x32\x0a\xf6\xb9\x9c\xca\x91\x9c\xe6\x68\xab\xc9\xce\xd3\xa2\x2a\x2f\x80\x1f\xeb\xaa\x0c\x03\xd2\x27\x7a\xab\x55\x5d\x85\xce\x21\x41\xf4\x59\x70\xa5\x11\x7d\x22\xfc\x7a\x63\x75\x9d\x5a\xf2\xeb\xf4\x3b\x9e\x14\x43\x11\x67\xa2\xf0\x88\x7d\x73\x40\xae\xf4\x9e\xeb\xcc\x8c\x3f\xf9\x56\x05\x12\xbd\x28\x28\xd5\x90\xf8\x39\xdd\x61\xbc\x4b\x8b\x79\xff\xee\x2d\x9b\xf8\x0b\x7d\x37\x2f\xd9\x19\xc2\x2e\x57\x08\x1c\xae\x53\x7e\x97\x1a\x7a\x94\xb0\x80\xcd\x1d\x8f\xca\xeb\x42\x0a\xef\x48\x4e\xbe\x3b\xe6\xd3\xe2\x7c\xfd\x8f\xc6\xe8\xd4\x5b\xef\x27\x48\x81\xcc\x83\x04\xdc\x53\x57\xf7\xcf\xc5\x9e\x1f\x8c\x3b\x7b\x91\xb5\xd7\x3f\x77\x8d\xd6\x1d\x05\x7b\xdb\xf1\xd9\xd7\xa9\x60\xe6\x23\x59\x9d\xa0\x34\xe4\x29\x17\xaf\x98\x85\xef\x6d\xe3\x76\xb5\x3e\x2f\x9d\x23\x3a\x00\x5e\xe7\x32\x3f\xf8\x8e\x98\x04\x07\x7f\x88\x08\x6d\x6a\xfb\xb1\x3e\x79\x37\xea\xab\x80\xe7\xc7\x47\x1b\xf5\xd3\x61\xb2\x63\xd2\x09\x73\x3d\x5d\xef\x81\x9c\x05\x9c\x2b\x08\x21\xb2\x3f\x32\xf3\xce\xe2\xb8\x49\xe2\x1d\x9d\x08\x9d\xc6\xc1\xc9\x8f\xb4\xf7\xeb\x6b\xe8\x8d\xc7\x89\x1f\xce\xb5\x6e\x94\x6c\x26\x5a\xee\x0e\x78\x3c\x34\x1f\xf4\x60\xbe\xb0\xe7\xbc\x26\xd7\x58\x5d\x96\xc0\x2c\xda\x86\xbc\xb5\x43\xce\xd3\xf9\x82\xab\x13\xee\xb7\x37\x54\x37\x65\x04\xd3\x1f\x2f\x2f\x42\x5d\x9e\x4c\x13\xb4\xb0\xf4\x2a\xe0\x66\x03\x0f\x32\xac\x7e\xa8\x50\xbb\xcc\x84\xe9\xe9\x0f\x2e\xdd\x6f\x86\x9b\x01\x4a\x16\x07\x50\x12\xb8\x37\xf6\x85\x53\x61\xaf\x2f\xd2\x09\xaf\x89\xf3\x10\xcc\x54\xb1\x35\xa8\x6a\x4c\xfb\xa1\x5a\xc1\x99\xea\x8c\xcd\xf4\xc1\x4d\x08\xe5\xc7\x66\xf4\x42\xe4\x50\xb2\x87\x2a\x59\xb1\x7b\x93\xa8\xca\xfd\x03\xf4\x7f\x73\x44\xf2\xf4\xbc\x5e\x2c\xda\xe1\xa7\x8f\x7e\x49\xa3\x45\x1b\x92\x48\x13\xe3\x28\xe0\x53\x83\x8b\x26\x15\xfd\x14\x98\x0a\x53\x3a\xc4\x65\xe7\x53\x78\xd6\x67\xf2\x86\xac\x11\xd6\xc7\x4f\x67\xd2\xf6\xdb\x0f\x24\xcd\xc7\x4d\xa4\xf2\x24\x1e\x16\x87\x0b\x9a\x8f\x03\x7f\xb8\x31\xf4\x11\x93\xca\x86\x13\x6c\xbc\xf6\x19\xa7\x9f\xde\x55\x5f\xd4\x73\x22\x21\x5d\xb8\xcc\x8e\x2e\x09\xdc\xed\xc0\x92\x7d\x53\x19\x1a\xf0\x17\x4e\x4b\xc9\x4b\x17\x66\xa5\x85\xb4\xb0\x94\xec\x1b\x19\xe2\xc9\x61\x3f\xee\x57\xbb\xa1\x16\x56\xe7\x10\x7f\xb8\x31\xec\xc6\xc4\x5d\xd8\x4b\xd9\x4d\x60\xe7\xc1\x5f\x35\x7c\xdf\x21\xf4\x30\x6d\xfb\xc6\xdf\x9e\xfe\xd4\xea\x7c\x4c\x4e\xae\x7e\xde\x85\xab\x11\xe7\x6f\x0c\x70\x6a\x78\x75\xf3\xdb\x2d\x6d\xc3\xf5\x43\x77\x8b\xd3\xcf\x1f\x98\x1e\x1d\xe9\xbf\x67\x11\xbe\x14\x23\xf7\x7f\xee\x4c\x46\x6d\xf3\xbc\x06\xf5\x44\x09\x7f\x66\xc9\x98\xd5\x3f\xc9\x3c\xee\xa2\x9e\xc9\xb4\x6b\x2e\xa8\xa7\x96\xf8\x5a\x61\x4a\x77\x37\x5d\x8e\x9c\xef\x9b\xef\x6b\xe8\xec\xe3\x2c\xc4\xee\xf8\xb7\x68\x23\xaa\xeb\x2d\x88\xdc\x69\x6d\x29\xd9\xbd\xf9\xbf\xc0\x7d\x5f\x70\x17\xf7\x91\x3a\x82\xe6\xce\x08\xe4\xbc\x3e\xc2\xc6\xbf\x58\x22\xfd\x40\x78\x3f\x95\x04\xe6\x90\xf2\xa2\x98\xfc\xd0\x9c\xd7\x49\xd8\xf3\x27\x86\x6c\x50\x4b\xf9\x36\xb5\x94\x3f\xa4\x96\x3e\x5d\x97\x05\x53\x3a\xc1\x2c\x46\x17\x6e\xe3\xbb\x37\x2f\xa3\x25\xfb\x8a\xdc\xd6\x1a\xef\x24\x7f\x2c\x30\x03\xba\x8b\x7e\x2e\x62\x38\xba\xe1\xf6\x4c\x36\xb9\x28\x2c\xdd\x74\x2f\x8f\x3d\x02\xca\x0c\xda\x36\xfa\x7d\x00\xa0\x81\x7a\xf8\x21\x18\x00\x00")
 
 func templatePrivacyPrivacyTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1187,12 +1502,12 @@ func templatePrivacyPrivacyTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/privacy/privacy.tmpl", size: 6140, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/privacy/privacy.tmpl", size: 6177, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateRuntimeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x5f\x6f\xdb\x38\x12\x7f\xb6\x3e\xc5\x9c\xe0\x03\xec\xc0\xa1\xdb\xbe\x5d\x0f\x7e\xe8\xf5\xcf\xd5\xc0\xb5\x28\xae\xdd\xbe\x14\xc1\x82\x91\x46\x36\x37\x32\xa9\x25\xa9\x34\x81\xa1\xef\x7e\xe0\x90\x94\x28\xd9\xf1\xe6\xf6\x69\x5f\x12\x91\x1c\x0e\x67\x7e\xf3\x9b\xe1\xd0\xc7\xe3\xfa\x2a\x7b\xab\x9a\x47\x2d\x76\x7b\x0b\xaf\x5e\xbc\xfc\xc7\x75\xa3\xd1\xa0\xb4\xf0\x81\x17\x78\xab\xd4\x1d\x6c\x65\xc1\xe0\x4d\x5d\x03\x09\x19\x70\xeb\xfa\x1e\x4b\x96\x7d\xdb\x0b\x03\x46\xb5\xba\x40\x28\x54\x89\x20\x0c\xd4\xa2\x40\x69\xb0\x84\x56\x96\xa8\xc1\xee\x11\xde\x34\xbc\xd8\x23\xbc\x62\x2f\xe2\x2a\x54\xaa\x95\x65\x26\x24\xad\xff\x67\xfb\xf6\xfd\xe7\xaf\xef\xa1\x12\x35\x42\x98\xd3\x4a\x59\x28\x85\xc6\xc2\x2a\xfd\x08\xaa\x02\x9b\x1c\x66\x35\x22\xcb\xae\xd6\x5d\x97\x65\xe4\xc3\x37\xb7\xa5\x95\x56\x1c\x10\x2c\x1e\x9a\x9a\x5b\x84\x1d\x4a\xd4\xdc\xa2\x21\x8d\xa6\xd8\xe3\x81\x5f\x1b\x2b\x6c\xb1\x17\x72\x07\xb5\xda\x89\x02\xb8\x2c\x61\xaf\xea\x92\x84\xb2\x83\x2a\xdb\x1a\xe1\x1e\xb5\x11\xca\x59\xc2\x2d\xfc\xe4\x06\x5a\xe7\x91\x55\xbd\x4a\xd2\xc8\x8d\x41\x6b\x58\x96\x6d\x2d\xec\xb9\x81\x57\x50\x29\x7d\xe0\xd6\x30\x78\x03\x79\x30\x27\x87\x86\x17\x77\x7c\x87\x5e\x99\xd9\xab\xb6\x2e\xe1\x16\x01\x0f\x8d\x7d\xbc\x16\x87\x46\x69\x8b\x65\xf0\x3b\x3b\x70\x21\xfb\x1d\x95\xd2\xc1\x6c\x03\x3f\x85\xdd\xc3\x5e\xa9\x3b\x03\x4a\x43\xa3\x6a\x51\x08\x34\xb0\x68\x94\x45\x69\x05\xaf\xa1\x78\x2c\x6a\x51\x04\x8d\x4b\x46\x98\x18\x2c\x94\x2c\x83\x5d\x2e\x3c\xd1\x81\x34\x3e\x39\x4a\xdb\x9b\xb9\x22\x44\x52\xe3\x40\x98\x4c\x2a\x0b\x12\x0b\x34\x86\xeb\x47\x58\x48\x05\xaa\xb1\x0e\x21\x67\xe2\xe4\x60\x38\x3d\x38\xc2\x77\x87\xd8\x64\xb7\xbc\xb8\xfb\xc9\x75\x69\xae\x0b\x75\x68\xb8\x15\xb7\xa2\x16\xf6\xd1\x7b\xd8\x68\xbc\x17\xaa\x35\x31\x04\xc6\x85\x1e\xa5\x1d\xa2\x0d\x25\x56\x42\x62\x0f\xf0\x9a\xac\xef\xba\x6c\x76\x3c\x0e\xb1\x1f\xe0\x9f\xbb\xb5\xe3\x11\x50\x96\xf0\x84\x86\xe6\x6e\xd7\x6b\x20\x2b\xf0\xc1\x3a\xf1\x39\xe4\x5f\x3c\x2a\x79\xa2\xd0\x09\x3e\x71\x16\x8b\x5a\xc2\x61\xee\x73\x1e\x38\xf5\x7a\x03\x73\xf6\x89\xbe\xb7\xb2\x52\x71\x59\x54\x2e\x9e\x41\x88\x7d\x0f\xc4\x8b\xe3\xaf\xed\x81\x04\x0b\x25\x8d\x85\x05\x1d\x7c\xed\x6d\x9c\x6e\x21\xb3\x66\x71\xb4\x81\xfc\x78\x24\x7b\x72\x58\xaf\x21\x4e\x7b\x30\x29\x59\x77\x28\x59\xd0\x17\xad\x3d\x55\x1e\xcf\x9f\xcd\xdc\xd7\x44\xa9\x9b\xba\xac\x70\x99\x82\x71\x31\x06\x79\x9c\x1f\x50\xdd\x23\x2f\x51\x07\x50\xdd\xd2\xdc\xd3\xff\xf5\x06\x5e\x04\x7d\x9a\xcb\x1d\xc2\x5c\x7a\x70\x3f\xab\x12\x4d\x0f\xbb\x6c\x0f\x1f\xa3\xfc\x5c\xb2\xcf\x71\xd8\x75\x1e\x75\x3f\xf7\xc5\x25\xd2\xa3\x9f\x1c\xb6\x6c\x80\x97\x65\x32\x7e\xe9\x05\xd2\xa8\xee\x53\x41\x3f\x18\xe4\x47\xde\x3a\x69\x6d\x9b\xbb\x9d\xb3\xa4\xe2\xb5\xc1\xde\x86\x3d\x37\x1f\x04\xd6\xc4\xb4\xaf\x85\x6a\x08\x86\x41\x7e\x03\xf8\x3b\xcc\x19\xad\xb0\xc0\xc4\x11\x62\x63\x48\x9d\x53\x7e\x63\xd7\x81\x2b\x8b\xf0\xd2\xd8\x98\x82\xd7\xb1\x3e\xae\xc3\x7f\xb6\x53\x40\x39\x15\x58\x18\x9c\x78\x9a\xde\x6b\x8d\x3b\x61\xac\x0b\xc9\xbc\xe7\xb9\xf7\x26\x9b\xcd\xd6\x6b\x9f\xf7\xe7\xab\xec\xa8\xf2\x08\x09\xce\x47\xf6\x56\xc9\x4a\xec\x7a\xc7\xba\x2e\x31\x6d\x4a\x9c\x88\xda\xfa\x0a\x5e\x0d\x75\xc5\x31\xcd\x3e\xe5\x90\xab\x59\x7f\x21\xa7\x2e\x38\x77\x92\x1f\x74\xa9\x41\x34\x2d\x9c\x0f\x7b\x2e\xcb\x1a\xb5\x71\x95\xd4\x3e\x36\x18\x4b\xb6\xf1\x6e\x9f\x29\x6c\x83\x73\x5d\x97\x85\x6a\xbe\xc8\x92\x34\x8f\xe6\x7e\xf5\x27\x90\xd3\x7d\x8e\x67\xa3\x5c\x76\xdf\x4f\xe5\x1b\xed\x39\xe7\x3b\x65\x55\x32\x91\x87\x02\xb1\xbe\x82\xad\x37\xc7\x95\x5a\x2d\x79\x3d\x71\xc6\x8b\x0d\xe1\xf2\xc6\xaf\x9d\xdb\x26\x77\x16\x44\x93\x22\x64\x33\x77\x8b\xed\x14\x13\x8a\x2e\x84\xc9\x78\xdd\x68\x71\xcf\x8b\xc7\x3c\x5b\x66\x59\x16\xc2\x2a\xa4\xb0\x50\xb5\xb2\xa0\x9b\x4c\x23\x2f\x0d\xf0\xba\x8e\x70\x97\x68\x0a\x2d\x1a\xab\x74\xb8\x7d\x63\x83\xe1\x8a\x9d\xd3\xb1\x28\xb1\xe2\x6d\x6d\xe1\x9e\xd7\x2d\x9a\x95\xfb\x2f\x4a\xee\x36\xac\xc2\x55\xed\xee\xd2\x78\x57\x2f\x69\xe4\x49\x84\x06\x84\x75\x3a\x5c\x28\xf7\x28\x74\x7f\xe7\xdf\x73\x2d\xf8\x6d\x8d\x86\x65\xce\x34\x32\x72\xb1\x84\x63\x76\x09\x7f\xb7\x36\x0f\x15\x66\x84\x77\x58\x0a\x1e\xbd\xde\xc0\x2d\x37\x78\x36\xec\x03\x27\x24\xfb\xaf\x77\xf4\x93\x78\x10\xb2\x4f\x9d\xb9\x2f\x2c\x7e\xf2\xf5\x86\xd8\x6e\xe2\x7e\xe6\x03\xfd\x99\x1f\x28\x4d\x3b\x46\x62\x8b\xe5\x29\x85\xfc\x19\x7d\xff\x12\x0c\xa6\x2a\xfc\x45\x19\x61\xe9\xce\xef\x09\xb0\xbe\x1a\x5a\x1d\x4f\x6e\xca\xb4\x70\xae\xc3\xf3\xe0\x0e\x32\x6c\x60\x4d\x34\x34\x28\x85\x0d\x84\xd8\xb3\xcf\xf8\xf3\x4b\x50\xb6\x18\x2e\x0f\x51\x3e\x04\x2b\x3e\x89\x07\x2c\xb7\x32\xca\x84\x52\x9c\xb8\xfd\xc3\x8d\x9d\x7c\xd7\xdd\xac\xfa\x74\xbd\x08\xc4\x72\x6c\x13\x5d\x0f\x3f\x5e\xdc\xc0\x86\x88\xb7\x90\xf8\x60\xa9\xbd\xf9\xd4\x5a\x47\x9c\x65\x3a\x80\xa3\xbb\x78\x35\xda\x56\xcb\x61\x1e\x3f\xb8\x8d\xb4\xbb\xb0\x0f\x50\x28\x69\xf1\xc1\xba\x88\xba\xff\x2b\x38\x0c\xa2\x42\xc9\x25\x2c\xdc\xf0\xbb\x63\xe8\x0a\x50\x6b\x77\x06\xe9\x9d\x89\xca\x8d\x63\x28\x47\xa0\xb1\xf7\xf7\xbc\x8e\x2a\xdc\x31\x2b\x38\x2c\xff\x49\xe2\x7f\xdb\x80\x14\x75\x50\x11\x8d\x93\xa2\x26\xe5\x34\x49\xed\x42\xbf\xe2\x6c\xf3\x76\x47\x3d\x6e\x99\x70\xe9\x9e\x62\xc7\x3e\xb9\xb7\x1d\x62\xe7\x89\xe1\xaf\xda\x53\x56\x9c\x30\x22\xaa\x3d\x89\x74\x7f\x59\xcf\x66\x69\x79\x13\x24\xe5\xe3\x4c\xbe\x1c\x8f\x8e\x43\xd2\x7a\x94\x72\xe2\x02\xed\xcd\x9d\x70\xd7\x8d\x31\x4c\xa8\xe2\x88\xe2\x63\xbe\x58\xc6\x43\x46\xcd\xd6\x68\xe0\x8a\xa2\x7b\xe7\xa0\x46\xe0\x1a\x43\x09\x49\x1c\x1c\x9e\x31\x23\xef\x02\xfb\xfa\x6e\x27\xe9\x31\x82\x47\x0d\x79\xb4\x1f\xf5\x3f\x74\x3d\x36\x11\x8a\x11\x8f\x63\x13\x64\x75\x8b\x69\x97\x91\xb4\x40\x74\xb0\xeb\x1a\xd2\x1d\x11\xc9\x11\x5a\x01\xa8\x01\xa5\xa7\x8b\xc6\x80\xd4\x09\x36\x31\x34\xab\xa9\x33\x11\xd6\xd3\x8e\x2e\x86\x6e\x2e\x62\x9b\x26\xa8\x91\x3b\x09\x44\xbf\x3f\x45\xe3\x5c\xe0\x59\xef\xcb\x10\x5e\xd8\x00\x5c\xe2\x47\xe3\x4b\xe1\x56\x96\xf8\x10\x37\x36\x2c\x0e\x6f\x7a\x63\xfa\x9e\xe3\xb9\xa7\x3e\x05\xf3\x93\x27\x9c\xe3\xdd\xf4\x3b\xbc\x46\x24\xfb\xc8\xcd\xbb\x70\xbb\xf9\xd1\xf7\xfe\x6e\x9b\x3e\x15\x4e\xd2\x8a\x5a\xda\x3f\x9b\x57\x7e\xf3\xb3\x12\xcb\x8b\xfa\xcc\x9a\xfd\x0a\x27\xa2\x7e\x3d\xca\xff\x71\xfe\xcd\x2b\x6f\xb8\x77\xa6\xf7\xa2\x7f\x2e\x6c\xdf\xb1\x5f\x0c\xea\x77\x21\x21\x7d\xc6\x84\x3d\x1b\xe0\x4d\x43\x6f\xc6\x30\x41\xf2\x67\x72\xc6\x63\x56\x8d\x21\x8a\x66\x7f\xe8\x0d\xb8\x9c\x28\xa9\xe7\x63\xc7\xfd\xca\x1f\x64\x50\x45\x2e\x56\xd3\x30\xcd\x5d\xc7\xe3\x96\xd2\xa0\xbc\x43\x53\xe4\x30\xaf\xd8\x57\xab\xdb\xc2\xfa\xe7\xca\xb0\x67\x7d\x05\x28\xdb\x03\x8c\x5b\xa1\xd0\xaa\x96\x20\x91\xeb\xd0\xe0\x94\x58\xd4\x5c\x73\x5f\xc7\x17\xae\x9a\x25\x2d\xec\xb2\x2f\x68\x09\x0d\x17\x9c\xf0\x64\x91\x88\x0b\x2a\x59\x15\xdb\x9a\xf7\xb2\x3d\x2c\x97\xee\xfb\x97\xa6\xe4\x16\x7b\xaa\x56\x6c\xca\x53\x6a\xe2\x1d\x3e\xe4\x5c\xd7\xb9\xae\x7d\xa8\xa3\x49\x93\x47\x3f\x65\x50\x44\x23\xd0\x40\x08\xb1\x40\x55\x5f\x22\x2a\x16\x2f\xa3\x69\xad\x98\xa5\x87\xbc\x3e\xc9\xcf\x31\xb9\xc7\x6a\x26\xe5\x21\x59\xec\xb3\x98\xbd\xeb\x0d\x0d\x84\x9f\x56\x8d\x33\xe7\x8f\x38\xf1\xff\xaa\x1e\x55\xc7\x93\x5a\x79\x39\x34\x43\x82\x13\xab\xbc\xcc\x84\x58\x2c\x4f\x14\x04\xc4\xc7\xf1\xf2\xdb\xba\x6e\xf8\x7d\x6e\x4c\x33\x50\x12\x0a\x8d\xbc\xff\x21\xca\x49\x5c\x8c\x60\x54\xfa\xcd\x51\x6f\xb0\xa7\x62\x6e\x82\xfe\x0c\xf9\xde\xdb\xe6\xfa\xad\x90\xec\xe9\xf6\xb8\x3b\xa7\x56\x6c\x09\x79\xd4\x33\x49\xfa\x29\x64\x1f\xb9\xf9\xb7\x22\x39\x02\x6d\xb1\xe7\xe6\x8b\xc6\x4a\x3c\x8c\xd5\x93\xda\x7c\xb9\x9c\x84\xb7\xc7\x64\x13\x3c\x0d\x27\x2e\x92\xe8\x47\xc3\xd9\x62\x6a\x73\xd7\x2d\x2f\x92\x67\xa2\xfd\x39\xfa\x9e\x4b\x98\x93\x5c\x7d\x36\x45\x46\xdb\xfe\x34\x51\x5a\xd2\xf2\x1c\x9a\x5c\x00\x62\x64\x0a\xc1\xe1\x3d\xdb\x9a\x6f\x82\x34\x05\x2e\x8c\xde\x06\x7d\x8c\x4e\x5b\xc0\xf4\x46\x38\x53\xb9\x08\x1b\xe9\x6c\x3c\x0b\x4c\xbf\x61\x24\x6f\x03\xb7\xfd\x86\xca\x13\x09\x16\x7f\x37\x4b\xdf\xfe\xe7\x53\xbe\xa7\x58\xca\x00\x88\x30\xc0\x87\xe7\x6c\x8f\x5a\x3e\x82\x2d\x0f\xb8\xc1\x96\x7e\x5a\x2e\x78\xed\x4a\xfe\xed\x23\x89\xde\xb6\xa2\x2e\x51\x1b\xb8\xc5\x4a\x69\x04\xc3\xef\x71\x54\x4a\xf1\xf7\x89\xd3\x2f\x47\x64\x8c\x96\x8c\x03\x30\x88\xff\x78\x71\xe3\xf9\x68\x4f\x88\x38\xe5\xf5\xa0\x6a\x08\x4f\xdc\x16\x1f\x32\xb3\xe1\xed\x1e\x8b\xe7\xe9\x99\x41\xb4\x92\x24\xf3\x83\x31\x76\x43\x1a\xc7\x51\xf6\x30\xf7\x8a\xd3\xcb\xf7\xb7\x55\x78\xbc\x3f\x84\x89\x73\x51\x1f\x5b\x43\x65\xfb\x37\xaa\xd3\x8b\xc9\x61\x93\x13\x97\xab\xf4\xc4\xa4\xfe\xc4\x27\x59\xff\x28\x4b\xf4\xfc\xcb\x87\x29\x5e\xeb\x70\xd9\x15\xc7\x83\x5f\x57\x50\x91\x0f\xde\x05\x07\x46\xbf\x9e\xbc\x29\x2b\x79\xfe\x84\xb3\xcf\xc8\xc4\xb6\xf8\x8a\x4c\xcd\x1e\x3e\x86\xf7\xe6\xd8\xb3\xee\xdc\xcd\xf5\x44\xa3\x7b\xfe\x33\xfd\xfd\xed\x7f\x01\x00\x00\xff\xff\xc2\x57\x8f\xb1\x17\x1b\x00\x00")
+var _templateRuntimeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x4b\x8f\xdb\x38\x12\x3e\x5b\xbf\xa2\xd6\xd0\x02\x76\xc3\x4d\x27\xb9\x6d\x2f\x7c\xc8\xe6\xb1\x31\xb0\x09\x82\x4d\x26\x97\xa0\x31\x60\x4b\x25\x9b\xd3\x32\xa9\x21\xa9\x4e\x1b\x86\xfe\xfb\xa2\x28\x52\xa2\xe4\x47\xba\x67\xf6\x30\x97\x6e\x8b\x2c\xd6\xe3\xab\xaf\x8a\x8f\xc3\x61\x79\x95\xbc\x51\xd5\x5e\x8b\xcd\xd6\xc2\xab\x17\x2f\xff\x71\x5d\x69\x34\x28\x2d\xbc\xe7\x19\xde\x29\x75\x0f\x6b\x99\x31\x78\x5d\x96\xe0\x84\x0c\xd0\xbc\x7e\xc0\x9c\x25\x5f\xb7\xc2\x80\x51\xb5\xce\x10\x32\x95\x23\x08\x03\xa5\xc8\x50\x1a\xcc\xa1\x96\x39\x6a\xb0\x5b\x84\xd7\x15\xcf\xb6\x08\xaf\xd8\x8b\x30\x0b\x85\xaa\x65\x9e\x08\xe9\xe6\xff\xb3\x7e\xf3\xee\xd3\x97\x77\x50\x88\x12\xc1\x8f\x69\xa5\x2c\xe4\x42\x63\x66\x95\xde\x83\x2a\xc0\x46\xc6\xac\x46\x64\xc9\xd5\xb2\x69\x92\xc4\xc5\xf0\x95\x96\xd4\xd2\x8a\x1d\x82\xc5\x5d\x55\x72\x8b\xb0\x41\x89\x9a\x5b\x34\x4e\xa3\xc9\xb6\xb8\xe3\xd7\xc6\x0a\x9b\x6d\x85\xdc\x40\xa9\x36\x22\x03\x2e\x73\xd8\xaa\x32\x77\x42\xc9\x4e\xe5\x75\x89\xf0\x80\xda\x08\x45\x9e\x70\x0b\x3f\xb8\x81\x9a\x22\xb2\xaa\x53\x49\xc2\xc0\x8d\x41\x6b\x58\x92\xac\x2d\x6c\xb9\x81\x57\x50\x28\xbd\xe3\xd6\x30\x78\x0d\x53\xef\xce\x14\x2a\x9e\xdd\xf3\x0d\xb6\xca\xcc\x56\xd5\x65\x0e\x77\x08\xb8\xab\xec\xfe\x5a\xec\x2a\xa5\x2d\xe6\x3e\xee\x64\xc7\x85\xec\x56\x14\x4a\x7b\xb7\x0d\xfc\x10\x76\x0b\x5b\xa5\xee\xcd\x02\x84\xb4\xa8\x33\xac\xac\xd2\x06\x94\x86\x4a\x95\x22\x13\x68\x60\x56\x29\x8b\xd2\x0a\x5e\x42\xb6\xcf\x4a\x91\x79\xfd\x73\xca\x15\x82\xc1\x4c\xc9\xdc\x7b\x49\xc9\x0a\xe1\xc4\xd9\x9a\xa2\xb4\x9d\xd3\x0b\x87\x4f\xec\x2a\x08\x93\x48\x65\x41\x62\x86\xc6\x70\xbd\x87\x99\x54\xa0\x2a\x4b\x78\x91\xc3\x23\xc3\x70\x6c\x38\x80\x79\x8f\x58\x25\x77\x3c\xbb\xff\xc1\x75\x6e\xae\x33\xb5\xab\xb8\x15\x77\xa2\x14\x76\xdf\xc6\x5b\x69\x7c\x10\xaa\x36\x21\x21\x86\x88\x80\xd2\xf6\xb9\x87\x1c\x0b\x21\xb1\x83\x7b\xe9\xbc\x6f\x9a\x64\x72\x38\xf4\x4c\xe8\x93\x91\x42\xd3\x24\x87\x03\xa0\xcc\xe1\x8c\x86\xea\x7e\xd3\x69\x70\x5e\xe0\xa3\x25\xf1\x14\xa6\x9f\xdb\xc4\x4c\x23\x85\x24\x78\xc6\x16\x0b\x5a\xbc\x31\xfa\x99\x7a\x86\xdd\xac\x20\x65\x1f\xdd\xef\xb5\x2c\x54\x98\x16\x05\xe5\xd3\x0b\xb1\x6f\x9e\x86\xe1\xfb\x4b\xbd\x73\x82\x99\x92\xc6\xc2\xcc\x19\xbe\x6e\x91\x1a\x2f\x21\xb1\xc9\x24\x7c\xad\x60\x7a\x38\x38\x7f\xa6\xb0\x5c\x42\x18\x6e\xc1\x74\xa5\xbb\x41\xc9\xbc\xbe\xe0\xed\xb1\xf2\x60\x7f\x32\xa1\x5f\x23\xa5\x34\x74\x59\xe1\x3c\x06\xe3\x62\x0e\xa6\x61\xbc\x47\x75\x8b\x3c\x47\xed\x41\xa5\x25\xa9\x2b\x06\xb8\x59\xc1\x0b\xaf\x4f\x73\xb9\x41\x48\x25\x8d\xa5\xec\x93\xca\xd1\x74\xb0\xcb\x7a\xf7\x21\xc8\xf3\x3c\x87\x54\xb2\x4f\x61\xa8\xfd\xbd\x8e\x8b\xaa\x69\xda\x64\xb4\x53\x9f\xa9\xbe\xf6\xed\x60\xaf\x29\x28\x0a\xdf\x2f\x5b\x01\x1f\xd5\xa4\x77\xd1\x0b\x6e\xbd\xb1\x20\x3f\x00\x81\xa4\xb5\xad\xee\x37\xe4\x60\xc1\x4b\x83\x9d\x0f\x5b\x6e\xde\x0b\x2c\x1d\x01\xbf\x64\xaa\x72\xa4\xeb\xe5\x57\x80\xbf\x43\xca\xdc\x0c\xf3\x04\x1d\x00\xd9\x1b\x49\x7c\x50\xed\xc2\xa6\x01\xea\x9d\xf0\xd2\xd8\x50\x99\xd7\xa1\x89\x2e\xfd\x7f\xb6\x51\x70\xb5\xec\xc9\xe9\x23\x3a\xcf\xfa\xa5\xc6\x8d\x30\x96\x32\x95\x06\x18\xb0\x8d\x26\x99\x4c\x96\x4b\xf8\x7a\xbe\x15\x0f\x1a\x92\x90\x40\x31\xb2\x37\x4a\x16\x62\xd3\x05\xd6\x34\x91\x6b\x63\x3e\x05\xd4\x96\x57\xf0\xaa\x6f\x37\x44\x40\x7b\x2e\x20\x6a\x65\x7f\xa1\xa0\x2e\x04\x37\xfe\x45\xf5\xb1\xbc\x82\xe0\x9a\xb7\x0f\x5b\x2e\xf3\x12\xb5\xa1\x06\x6b\xf7\x15\x86\x4e\x6e\xda\x3c\x9e\xe8\x77\x7d\x70\x4d\x93\xf8\x26\x3f\x4b\xa2\xea\x0f\xee\x7e\x69\x2d\xb8\xa0\xbb\xd2\x4f\x06\x25\x4e\xbf\xcf\x95\xe1\x64\x7a\x26\x76\x1a\x96\xd1\xc0\xd4\xf7\x8d\xe5\x15\xac\x5b\x77\xa8\x03\x6b\xc9\xcb\x51\x30\xad\x58\x9f\xae\xd6\xf9\x25\x85\x6d\xa6\xd4\x08\x9a\x66\xe0\x5e\x32\xa1\xcd\x6d\xa3\x98\x50\x6e\x9f\x18\x7d\x2f\x2b\x2d\x1e\x78\xb6\x9f\x26\xf3\x24\x49\x7c\x5a\x85\x14\x16\x8a\x5a\x66\x6e\x83\xd3\xc8\x73\x03\xbc\x2c\x03\xdc\x39\x9a\x4c\x8b\xb6\x69\x38\xb4\x3c\xaa\xae\x07\x92\x8e\x59\x8e\x05\xaf\x4b\x0b\x0f\xbc\xac\xd1\x2c\xe8\xbf\xc8\x39\x2d\x58\x9c\xdc\xcf\x69\xc3\x0d\x1b\xfa\xdc\x6d\xbf\x6d\x9d\xa0\x01\x61\x49\x23\x25\x76\x8b\x42\x07\x30\xe0\x81\x6b\xc1\xef\x4a\x34\x2c\x21\x47\x9d\xcb\xb3\x39\x1c\x92\x4b\xd9\xa0\xb9\xd4\xf7\x9b\x01\xfa\x7e\xca\xc7\x77\xb3\x82\x3b\x6e\xf0\x24\x09\x7a\x86\x48\xf6\xdf\x36\xec\x8f\xe2\x51\xf8\xcd\x87\xb2\x4a\xfa\x9b\xa6\x1d\xbc\x59\x39\xee\x7b\xbd\x4d\xc3\xe8\x4b\xb2\x4f\x7c\x47\x75\x74\x68\x98\x13\x9b\xcd\x8f\x09\xd5\xda\xe8\x0e\x39\xde\x61\xfa\xdc\x7f\x56\x46\x50\x62\x3c\xc3\x3c\x6b\x3a\xd1\x76\x5b\x71\x75\xe7\xed\x12\x9e\x3b\x32\x64\x58\xcf\xa1\xe0\xa8\x57\x0a\x2b\xf0\x4c\x60\x9f\xf0\xc7\x67\xaf\x6c\xd6\xef\x30\x22\x7f\xf4\xb0\x7d\x14\x8f\x98\xaf\x65\x90\xf1\x8d\x39\x0a\xfb\x3b\x69\x27\xf9\xa6\xb9\x5d\x74\xc5\x7b\x11\x88\xf9\xd0\x27\xb7\x59\x7c\x7f\x71\x0b\x2b\x47\xc3\x99\xc4\x47\x4b\x1d\x8d\x7d\xac\x2d\xd1\x68\x1e\x7f\xc0\x81\x76\x67\x8d\xb6\xd6\xb2\x1f\xc7\xf7\xb4\xd0\xad\xce\xec\x23\x64\x4a\x5a\x7c\xb4\x94\x51\xfa\xbf\x80\x5d\x2f\x2a\x94\x9c\xc3\x8c\x3e\xbf\x11\x5f\x17\x80\x5a\x93\x0d\xa7\x77\x22\x0a\xfa\x0e\xa9\x1c\x80\xc6\xde\x3d\xf0\x32\xa8\x98\x65\xf6\x71\x01\xbb\xf9\x3f\x9d\xf8\xdf\x56\x20\x45\xe9\x55\x04\xe7\xa4\x28\x9d\x72\x37\x48\x69\xe8\xdc\xa6\x00\xbd\xdf\x41\x0f\x4d\x3b\x5c\x9a\x73\xec\xe8\x0e\x03\xa9\x74\x88\x9d\x26\x06\xcd\x9c\x62\xc5\x11\x23\x82\xda\xa3\x4c\x77\x5b\xf7\x64\x12\x37\x3b\x41\x98\xf8\x3c\x93\xb3\x94\xc0\x4a\x0b\x69\x5b\x94\xa6\x8e\x0b\x6e\xed\x14\x52\x01\x4d\x33\xc4\x30\xa2\x0a\x34\xcd\xad\x8b\xc0\x50\x29\x9c\x38\x91\x0d\x3e\xa8\x45\xd2\xd5\x08\x35\x02\xd7\x08\xdb\x71\x80\xfd\xcd\x67\x10\x9d\x67\x5f\x77\x24\x8a\x4e\x1c\x3e\xa2\x8a\x3c\xf4\x3b\x63\x38\x89\xb8\xcd\xb2\x0a\x50\x0c\x78\x1c\x8e\x44\x56\xd7\x5e\x4f\xc7\xf4\x81\xc3\xa2\x18\xae\x08\x48\x0e\xd0\xf2\x40\xf5\x28\x9d\xad\x95\x08\xa9\x23\x6c\x42\x6a\x16\xe3\x60\x02\xac\xc7\xe7\xbb\x90\xba\x54\x84\x43\x9b\x80\x97\xd1\x8a\x4e\x7f\xb7\x3e\x46\xe3\x54\xe2\x59\x17\x4b\x9f\x5e\x58\x01\x5c\xe2\x87\xd3\x29\xe4\x5a\xe6\xf8\x18\x16\x56\x2c\x7c\xde\x76\xce\x74\x27\x90\xa7\x5a\x1d\x49\x0d\x85\x4e\x59\x88\xe1\x0c\x1f\xe3\xdf\xbe\x50\xe8\xf4\x1c\x0a\x30\x3a\x4a\x9f\xae\xc3\xc1\x59\xfb\xcf\x94\xe3\x40\x51\x9f\x99\xe7\x54\xa5\x53\xf1\xb4\xb2\x8c\xad\xfd\x91\xea\x14\x67\xc2\xbe\x5c\xa4\xeb\x0e\xda\x73\x55\x2a\x5a\x89\x27\x94\xa9\xd7\xf5\x9c\x3a\xf5\x4b\x02\xb8\x03\x00\x03\x76\x4f\xa9\xd4\x23\xf0\x8e\xe0\x3a\x2a\xd8\x2e\xae\x67\x55\x5c\x6c\xe8\x67\x25\x30\x64\xc0\xff\xa1\xf0\x9e\x63\xbc\x47\xef\x4f\x15\xa0\x7f\x33\x90\xec\x03\x37\x6f\xfd\x61\x93\x76\x41\x6e\xbe\x75\x47\x4d\xf8\x59\x21\xb9\x1b\x66\x0c\xf5\x73\x4a\xa8\x5d\xfc\xa4\x12\x6a\x45\x5d\xfe\x27\x93\x5f\xe1\x48\xb4\x9d\x0f\xf2\x17\x71\x70\x1f\x69\xe1\x16\xf8\x60\xba\x28\xc2\xd3\x4d\x2a\xd9\xfa\x6d\x57\x18\xec\x17\x83\xfa\xad\xaf\x3b\x37\x18\x96\xaf\x80\x57\x15\x59\x09\x03\xec\x52\x71\xb4\x18\x16\x43\xc8\x42\x18\xde\x87\x9f\xd6\x43\x8c\xc4\x10\x88\x76\xe6\x54\xb4\x51\x85\x14\x2e\xe4\x91\x0f\xd7\x90\xd2\x85\x84\xa6\xe2\x24\xbd\x45\x93\x4d\x21\x2d\xd8\x17\xab\xeb\xcc\x3a\xfd\xd1\x9a\xe5\x15\xa0\xac\x77\x30\xbc\xa9\xf8\x9b\x64\x0e\x12\xb9\xf6\x37\x8e\x1c\xb3\x92\x6b\x77\x4a\x34\x30\x13\x72\x70\xc3\x9c\x77\xcd\x2b\xa2\xe5\x8c\x0e\xdc\x69\xc1\x02\x31\x67\xae\x39\x15\x6c\x6d\xde\xc9\x7a\x37\x9f\x93\x57\xbf\x54\x39\xb7\xd8\x51\xb7\x60\x63\xde\xba\x87\x03\xc2\xc7\x05\xd7\x34\x74\xa9\xee\x7b\x66\x74\x07\xa3\x2b\xbf\x83\xbd\x08\x40\x83\x43\x88\x79\xea\xb6\x1d\xa4\x60\x61\x57\x1a\xb7\x92\x49\x6c\xe4\x66\x05\x17\xc9\x3e\x54\x33\x6a\x1b\xd1\x64\x57\xd5\xec\x6d\xe7\xa8\x2f\x80\x71\x37\x39\x61\x7f\xc0\x89\xe7\xaa\x0e\xdc\x39\xf1\x25\x0a\xb8\x9c\x1a\x2f\x1b\x58\xd5\xca\x8c\x88\xc5\xa6\x91\x02\x8f\xf8\x30\x5f\xed\xb2\xa6\xe9\xdf\xd8\x87\x34\x03\x25\x21\xd3\xe8\x28\xe5\xb2\x47\x79\xbd\x98\xc1\xa0\xf4\x2b\x51\xaf\xf7\xa7\x60\x34\xe0\xfe\x74\xf5\xde\xfb\x46\x17\x20\xbf\x11\xc6\xcb\xc3\xea\xa9\xbb\x1b\xcd\x61\x1a\xf4\x8c\x8a\x7e\x0c\xd9\x07\x6e\xfe\xad\x9c\x29\x07\xda\x6c\xcb\xcd\x67\x8d\x85\x78\x1c\xaa\x77\x6a\xa7\xf3\xf9\x28\xbd\x1d\x26\x2b\x1f\xa9\xb7\x38\x8b\xb2\x1f\x1c\x67\xb3\xb1\xcf\x4d\x33\xbf\x48\x9e\x91\xf6\xa7\xe8\x4b\x26\x27\x29\x32\xfc\x12\xc5\x71\xad\x36\xcd\x51\x5a\x4e\x53\x64\xb0\xec\x0f\x13\xa5\x76\x5a\x9e\x42\x93\x0b\x40\x0c\x5c\x71\xf0\xb6\x91\xad\xcd\x57\x7a\xb7\x69\x1a\xcf\x85\x2e\xff\x83\x1c\x1d\x9f\xfa\xe2\x1d\xe1\x44\xe7\xa2\xd9\x54\xf2\x5d\xcc\xd5\x08\x98\x6e\xc1\x40\xde\x7a\x6e\xb7\x0b\x8a\x96\x48\x30\xfb\xbb\x99\xd3\x95\x59\xe9\x8e\xa7\xc1\xad\x18\x4b\xe9\x01\x11\x06\x78\xff\xda\xd4\xa1\x36\x1d\xc0\x36\xf5\xb8\xc1\xda\x52\x57\xcd\x78\x59\x62\x0e\x77\x7b\x27\x7a\x57\x8b\x32\xa7\x93\xe5\x1d\x16\x4a\x23\x18\xfe\x80\x7d\x21\xd2\x63\xc0\xef\xa3\xa0\xc3\x65\x69\x32\x89\x3d\x19\x26\xa0\x17\xff\xfe\xe2\xd6\x25\x20\xb5\x47\x44\x1c\xf3\xba\x57\xd5\xa7\x27\x2c\x0b\x2f\x0b\x93\xfe\x69\x0d\x6e\xce\xd9\xf4\xa2\x85\x74\x97\x95\xef\x8c\xb1\x5b\xa7\x71\x98\xe5\x16\xe6\x4e\x71\xbc\xf9\xfe\xb6\xf0\xaf\x69\x8f\x7e\x60\x00\x40\xf0\x79\xe0\x8d\x6b\xdb\xbf\xb9\xf3\xcf\x6c\x64\x6c\x64\x71\xbe\x88\x2d\xf6\x1c\xeb\xde\x48\xba\x57\x92\x48\xcf\xbf\xda\x34\x85\x6d\x1d\x2e\x87\x42\x3c\xf8\x75\x01\x85\x8b\xa1\x0d\x81\xc0\xe8\xe6\xa3\x47\x9e\x42\x9e\xb6\x70\xf2\x5d\x27\xf2\x2d\x3c\xeb\xc4\x6e\xf7\x3f\xbc\x90\x14\x65\x32\x98\x69\x4e\xed\x5c\xf1\x47\xf4\xfb\xf4\xcf\xf8\x79\xfc\x7f\x03\x00\xec\xb5\xef\xca\xdb\x1e\x00\x00")
 
 func templateRuntimeTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1207,12 +1522,12 @@ func templateRuntimeTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/runtime.tmpl", size: 6935, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/runtime.tmpl", size: 7899, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateTxTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\xdf\x8f\xdb\xb8\x11\x7e\x96\xfe\x8a\xa9\xb1\x38\x58\x0b\xaf\x94\xbb\xb7\x6e\xe0\x02\xc1\x26\x41\x17\xb8\xee\xa1\xbd\x3d\x34\x40\x10\xdc\x71\xc5\x91\x4d\xac\x4c\xaa\x14\xb5\x2b\xd7\xf0\xff\x5e\xcc\x90\xd4\x0f\xdb\x49\x5a\xa0\x79\x48\x64\x72\x38\xfc\x66\xe6\xe3\xf0\x63\x0e\x87\xe2\x3a\xbd\x33\xcd\xde\xaa\xcd\xd6\xc1\x4f\x6f\x7e\xfc\xf3\x4d\x63\xb1\x45\xed\xe0\xa3\x28\xf1\xc9\x98\x67\xb8\xd7\x65\x0e\xef\xea\x1a\xd8\xa8\x05\x9a\xb7\x2f\x28\xf3\xf4\x71\xab\x5a\x68\x4d\x67\x4b\x84\xd2\x48\x04\xd5\x42\xad\x4a\xd4\x2d\x4a\xe8\xb4\x44\x0b\x6e\x8b\xf0\xae\x11\xe5\x16\xe1\xa7\xfc\x4d\x9c\x85\xca\x74\x5a\xa6\x4a\xf3\xfc\xcf\xf7\x77\x1f\x1e\x7e\xfd\x00\x95\xaa\x11\xc2\x98\x35\xc6\x81\x54\x16\x4b\x67\xec\x1e\x4c\x05\x6e\xb2\x99\xb3\x88\x79\x7a\x5d\x1c\x8f\x69\x7a\x38\x80\xc4\x4a\x69\x84\x85\xeb\x17\x10\x86\x1c\xee\x9a\x5a\x38\x84\xc5\x16\x85\x44\xbb\x80\x2b\x9e\x52\xbb\xc6\x58\x07\xcb\x34\x59\x94\x46\x3b\xec\xdd\x22\x4d\x16\xed\x5e\x97\x8b\x34\x4d\x16\xa8\xdd\xc6\xe4\xca\x14\xa8\x5d\x21\x95\xa8\xb1\x74\x8b\x34\x4b\xd3\xa2\x80\xc7\x9e\xe2\x13\xe0\xac\xd0\xad\x28\x9d\x32\x5a\xd4\x50\xd6\x8a\xb2\xe5\xb6\xc2\xd1\x74\x69\x51\x38\x94\xf0\xb4\x87\x52\xd4\xb5\xd2\x1b\xb8\x63\x8b\xfc\xb1\x5f\x66\x79\xea\xf6\x0d\x92\xa7\xd6\xd9\xae\x74\x70\x48\x93\xd2\xe8\x4a\x6d\xd2\xe4\x70\x00\x2b\xf4\x06\xe1\xea\xf7\x15\x5c\x69\xb8\x5d\xc3\x55\xfe\x60\x24\xb6\x70\x73\x3c\xa6\x49\x52\x14\x70\x38\xc0\x95\xce\x1f\xc4\x0e\xe1\x78\xa4\xed\x28\x55\x01\x41\x65\x2c\x28\xed\xd0\x12\x34\xbd\x81\x57\xe5\xb6\x3c\x3f\x5f\xf4\xd4\xa9\x5a\xa2\x6d\xf3\x34\x49\xe6\x33\xd7\xb3\x9f\x1e\x35\xc3\x42\x2d\x39\x77\x84\xa0\x16\xff\x56\xf5\x1e\x6a\x23\x24\x31\x20\x09\x9b\xd3\x9f\xeb\xb8\xc4\x8f\xfd\xa2\x4b\x04\x4a\x6c\x4e\x5f\x7e\x75\x69\x76\x4d\x8d\x94\x39\xce\xce\x93\x28\x9f\x09\xc8\xae\x83\xf8\x87\x17\xfc\xad\x73\xd8\xa7\x89\xd1\x77\x66\xb7\x53\xe4\xfd\xf3\x17\xff\xf9\x57\x63\x9e\x69\xe2\x1f\xc6\xaf\x86\xcf\x5f\xe2\x27\x4f\xf9\x5d\x5c\x0f\xb5\x7a\xc1\x96\x73\x42\x29\xa8\x55\x85\x9e\x43\x38\x2d\x5f\x0e\xf7\x54\x34\x5e\x44\x53\x2d\x85\x1e\x68\x01\x5d\xeb\xcb\x48\x13\xcc\xe5\x7a\x4f\x69\x2d\x8d\xd6\xe8\x57\xa7\x09\xed\x14\xec\xf3\x3b\xff\x6f\xea\xf9\x77\x55\x75\xba\x6c\xa9\x88\x52\x95\x0e\x16\x1e\xfe\x22\x7e\x38\x62\xe4\x22\x42\x9f\x7c\xd2\xf8\xf1\x98\x8e\x64\x20\x3f\xe4\xe6\x19\xf7\x6d\xf4\x4a\x74\xa0\x3d\x54\x25\x4a\xa4\xc9\x0d\xba\x38\xe7\x17\x90\x05\x53\x6d\x39\x12\xc7\x5b\x8f\xbc\x61\xb2\xf0\x18\x93\xf7\xd5\x8a\xa6\x1d\x08\x33\x18\xef\xd0\x6d\x8d\x8c\x6c\x19\x7d\x0c\x8b\x0f\x69\xe2\xe7\xc2\xc6\xcb\x93\x84\xac\xe0\xfa\xb1\xcf\x00\xad\x35\x36\x4d\x12\xe2\x11\x21\x7a\x0c\x1b\x85\x55\x1f\xe9\x1f\x46\x4c\x67\x4c\x83\x90\xa2\x71\xd4\x3f\x0c\x88\xba\x36\xaf\xbe\x0c\x2d\x57\xd1\x58\xa9\xb4\xb0\x7b\xef\x88\x1c\x30\xa3\x04\x1d\xce\x29\xc8\x1c\xee\x2b\xa8\xfc\xa1\x1d\xac\x86\x63\x21\x9a\xc6\x9a\xc6\x2a\xe1\xd0\x3b\x6a\xd5\x46\x0b\xd7\x59\x5c\x9d\x02\x5b\x56\x99\xf7\x32\x4b\x01\x67\x8d\x68\xdc\x42\x15\xf3\x33\x8d\x86\xbe\xbf\x99\x8c\xb1\x34\x61\x19\x51\x38\x34\x33\x5f\x89\xc5\xe1\x00\xb5\x79\x45\x3b\x98\xc0\x4e\x49\x59\xe3\xab\xb0\xb8\xc8\xe1\xdd\x18\x17\xa3\xd9\xa0\x3b\x85\xe9\x37\x11\x5a\x82\x45\xd7\x59\x7d\x9e\xa4\x8f\xc6\x02\xf6\x82\x4e\xe6\x2d\x5b\xf3\x5f\xc9\x96\xc0\xdc\xae\x7d\x18\x9a\x4e\x04\x75\xb1\xe9\xd2\xec\x6c\x84\xc9\x50\x14\x49\xe2\xf7\x1a\xe6\x67\xb9\xbc\x98\x16\xd7\xc3\xb5\xef\x92\x21\x3b\x83\x2b\x82\xff\xde\x40\x6b\x76\x08\xad\xeb\xaa\x0a\x9e\xb0\x32\x16\xf3\x38\xaf\x2a\x5a\x41\x50\x09\x65\x3e\xa3\xa2\xeb\xc9\x75\xf6\x96\x2d\xfe\xb4\x06\xad\xea\xd1\xf1\x80\xd2\xda\x38\x74\xfc\xea\xa6\xa2\x72\x68\x87\x3d\xc3\x4a\xad\xea\x30\x72\xcc\xfc\xc7\x31\x66\xf0\xb4\xac\x1c\xf6\x2c\x57\xd9\x49\x9d\x32\xdf\xbd\x26\x0b\x23\xbb\x7c\x1c\xbb\x2c\x4f\x13\x9e\x59\x56\xa7\x0c\xcd\xe0\x24\xec\xd3\xb6\xe4\x33\x3c\xcf\x6e\x08\xa2\x1a\xd2\x94\xf2\xe9\x3c\x1c\x6e\xe0\xca\xe8\x8f\xb1\x81\x35\x56\x69\x07\x0b\xa3\x17\x93\xe6\x72\x02\xf4\x8c\xa7\xed\x59\xaf\x8d\xd8\x23\x8e\x29\xe0\x29\x2a\xd7\xbf\xb7\xea\x05\xb9\xa0\xae\xcf\xfd\xf5\x98\x4b\x1e\xcb\x97\xd7\x71\x9a\xf2\xfd\x22\x2c\x54\x7a\x7e\x2a\xd7\xe7\x87\xf7\x3b\xe7\xd0\x77\xb0\x90\x8c\xe8\x3e\x77\xfd\x8c\x49\xb4\x1d\xd7\xd8\xf5\xf9\xae\xcb\x7f\x36\xe5\x33\x8f\xd1\x29\xe1\x2c\x89\xa6\x41\x2d\x97\x9f\xbf\x9c\x14\x7e\xa9\x55\x9d\x51\x76\xd9\x5d\x4c\xeb\xf1\x98\xe7\xf9\xe8\xee\x37\x5d\x47\x87\x7c\x8d\x93\xc3\x1a\xf5\x92\xbd\x67\x70\x03\x3f\xbe\x05\x05\x7f\x59\xc3\x9b\xb7\xa0\x6e\x6e\x3c\xe2\x4a\xc3\x1a\xd8\xe2\xb3\xfa\xb2\xac\x34\x43\x9c\x94\x55\xcf\x02\xa0\x54\xce\xca\x5c\x14\xf0\x8b\x9e\xd6\x50\x48\x49\xcd\x81\xcf\xbd\x33\xcc\x3d\x30\xfa\xbc\xb4\x67\x95\x9c\xb9\x99\x73\x93\x32\x90\x85\xb2\xce\xf2\x26\xb1\xa2\xee\x7e\x1a\xfd\x59\x9a\x60\xc8\xec\xd9\xd4\x0a\x2a\x0e\x85\xf8\x1a\x25\x4a\x51\x04\xb9\x35\xe9\x76\x77\x13\x85\xf6\xa4\xb4\x6c\x39\xba\xce\x5a\x1e\x9d\x32\x74\x1e\x96\x5f\xb7\xcc\xa2\xb0\xa1\x30\x28\x8b\x83\xba\xc9\xdf\x1b\x4f\xae\x21\xc2\xa0\x86\xd6\xf0\x83\x5f\x72\xf0\xec\xbd\x1d\x89\x7c\x9c\x1a\xe6\x4a\x2b\x47\x71\x13\xb3\x06\x02\x86\x49\x12\x12\x73\x40\xde\x9a\xf6\xfa\xae\x56\x0c\xc9\x1a\xc5\xdd\x1a\x1e\xf0\xf5\x82\xc0\x5b\x0e\xc8\xb2\x41\xeb\x91\x0b\x56\x31\xc5\x35\x54\xca\xb6\x0e\x34\x89\x7b\x62\xa6\x34\x65\xbc\x2b\x80\xe5\x37\x37\x0b\x6f\x74\xbb\x06\xa5\x25\xf6\x03\x94\x37\xb1\x24\xc3\x91\x1e\x35\xc6\x46\xbd\xa0\x86\xa0\xb0\xf3\xc7\xde\xdf\xcb\x02\xb4\x69\x86\xd1\xb0\x48\xd1\x6e\x3b\xd4\x4e\xf8\x2a\x05\xf1\xa0\x24\x0a\x96\x32\x06\xda\xae\x61\x55\x3f\x29\x66\xcb\x0e\x4d\xe7\x88\xd6\xa4\xdb\x84\xde\x03\xf6\xce\x0a\xff\x52\x71\x86\x61\x8c\x6a\xb8\x28\xe0\x9f\x5b\xd4\x20\xe2\x58\x68\xbe\xce\x40\xe8\x3d\x24\xe0\x57\xa0\xc2\x2d\x3b\xe8\xc5\x49\x0c\x4a\xb7\x4e\x10\x31\x98\x87\x5e\xbb\xd2\xcd\x3b\xa8\x55\x61\x91\x23\x8c\xba\x94\x55\x14\xbd\x22\x22\x0e\x36\x0f\x52\xc7\xc2\xae\x6b\x5d\x3c\x87\x48\x3e\xbd\x84\xdd\xd1\x23\xc9\x58\xe9\xe5\x51\xe9\xf7\x31\x16\x6c\xdc\xe6\xac\xf5\x16\x05\xad\xbe\xaf\x40\x40\x59\x1b\x12\xb6\x93\x69\x4a\x22\xee\x9e\x50\x4a\x94\xec\x59\x0f\x5a\x79\x83\x1a\x2d\xbf\x67\x50\x3b\xe5\x14\xb6\xab\x01\x21\x8f\xec\xc9\xaf\x68\x9a\x5a\x21\x1d\xb5\x7f\x75\x68\xf7\x2b\x0e\x2f\x2a\x0a\xdf\x0d\x88\x20\x91\x78\xf9\xdf\xc9\xea\xd3\xa7\x4f\x94\x4e\xf2\xc4\xab\xe0\x55\xd5\x35\x3c\x21\x60\x8f\x65\xe7\x50\x32\x71\xb6\xd6\x74\x1b\xaf\xd7\x64\xa0\xd0\x56\x95\xdb\xe1\x99\xc5\x8f\xc2\x0b\xa1\x3e\x18\x17\x64\xed\xc0\x3d\xd5\x82\x36\x0e\x36\xc6\x9a\xce\xd1\x73\xb1\x15\x15\x86\x07\xd9\x60\x34\x3e\xcb\xc2\x93\x20\xee\x4a\x32\x40\x58\xbf\xe5\x2c\xb9\x50\x59\xb3\xcb\xd3\x44\xda\x97\x13\xe2\x7a\x1f\x7d\x94\xdb\x93\x37\xc4\xfc\x5a\x74\xfd\x84\x43\xa9\x3f\x31\x1a\x5f\x1f\xfb\x10\x25\x25\x56\xe3\xeb\xc9\xbb\x33\x90\xd2\xb7\x08\x36\xbf\x7c\xed\x9f\xe3\xca\x60\xbc\x45\x57\xfe\x0a\xcc\x7c\x6f\x5b\x45\x21\x25\xed\x4b\xee\x1d\x66\x69\xd4\x57\x13\xf5\x34\xaa\x9f\x95\x17\x4f\xc7\xa1\x7d\xfd\x10\x3d\x1f\x5c\x4f\x6d\x8f\x01\xdc\xd2\x5f\xc7\x15\xcb\xa5\x63\x7c\x4b\xc7\x06\x7d\x9a\x4f\xea\x11\x0d\x5a\x6a\x7b\x11\x2f\x3d\x02\x5e\x8c\x92\xf1\x4c\x19\x3b\x1e\x29\x3e\xa5\xe4\x92\xea\x70\xf9\x50\xe5\xf0\xeb\xd6\x74\xb5\x24\x76\x91\x39\x4a\x30\xba\xde\xc7\xc7\xdd\xb9\xfd\xa4\xef\x8e\x20\x28\x1f\xf3\xe4\x66\xb0\x1c\x0b\x37\x66\x12\x86\x4e\xce\x11\x83\x8f\xf8\xbd\xb7\x9c\x85\x1d\x56\xc7\xd3\xf6\xdf\x72\xed\x12\xba\xe0\x7e\x99\x11\x85\x89\x63\x13\x18\x39\x95\x73\x34\x88\xd7\xa4\x69\xfd\x63\x8b\x3b\x12\xb7\x85\xe8\x7a\xe2\x97\xcd\x46\x7d\x06\x63\xe9\xa3\x1f\x5f\x92\xd1\x91\xff\xfd\xd5\x0e\xc7\xbd\xf1\xb7\x79\x77\xfb\xe3\xb1\xcf\xbd\x9f\x3f\x2e\xb5\xb6\xb3\x76\x76\x8e\x92\x0d\xbf\x05\x73\xe0\xcb\x00\x74\xe8\x96\xff\x33\xd4\xe8\x6b\x0e\xf6\xeb\xdd\xf7\x0c\x6e\x74\xf0\x2d\xc0\x1f\x7a\x2c\xe3\x15\xd4\xe7\xf4\xeb\x72\xe1\x69\xe6\xf2\xc9\xf7\x6d\xd5\xd3\x61\x05\xc2\x6e\xda\x15\xbc\x8c\x0f\xf7\xc3\x71\xa2\x7f\x47\xae\x84\xcd\xfc\x9b\x20\xf4\xf3\xb0\x36\x0b\x87\x97\xfb\xf7\x88\x8d\x7f\x5e\x06\xc7\x53\xff\x67\x74\x83\xcf\x8b\xf0\xe8\x49\xf0\xfb\xa9\x82\x58\x4f\xb3\xcf\x82\x9c\xff\x8f\x26\x88\xc6\xff\x04\x00\x00\xff\xff\x6b\x98\xe7\x0b\x05\x15\x00\x00")
+var _templateTxTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x39\x5b\x8f\xe3\xb6\xd5\xcf\xd2\xaf\x38\x9f\x31\x5f\x20\x0d\x3c\xf2\x26\x6f\x9d\x60\x0a\x04\x7b\x41\x07\x48\x37\x68\x33\x41\x03\x2c\x16\x09\x2d\x1e\xd9\xc4\xca\xa4\x43\x52\x63\xb9\x86\xff\x7b\x71\x78\x13\xad\xf1\x04\x09\xda\x3c\xec\xc8\xe4\xb9\xdf\x0f\x73\x3a\xad\x6e\xcb\xb7\x6a\x7f\xd4\x62\xb3\xb5\xf0\xcd\x9b\xaf\xff\x72\xb7\xd7\x68\x50\x5a\xf8\xc0\x5a\x5c\x2b\xf5\x05\x1e\x65\xdb\xc0\x77\x7d\x0f\x0e\xc8\x00\xdd\xeb\x67\xe4\x4d\xf9\xb4\x15\x06\x8c\x1a\x74\x8b\xd0\x2a\x8e\x20\x0c\xf4\xa2\x45\x69\x90\xc3\x20\x39\x6a\xb0\x5b\x84\xef\xf6\xac\xdd\x22\x7c\xd3\xbc\x89\xb7\xd0\xa9\x41\xf2\x52\x48\x77\xff\xfd\xe3\xdb\xf7\x1f\x7f\x7c\x0f\x9d\xe8\x11\xc2\x99\x56\xca\x02\x17\x1a\x5b\xab\xf4\x11\x54\x07\x36\x63\x66\x35\x62\x53\xde\xae\xce\xe7\xb2\x3c\x9d\x80\x63\x27\x24\xc2\xc2\x8e\x0b\x08\x47\x16\x77\xfb\x9e\x59\x84\xc5\x16\x19\x47\xbd\x80\x9b\x78\x75\x63\xd8\x33\xee\x95\x90\x16\xee\x1f\x60\xcb\xcc\x53\x84\xad\xf6\x5a\x48\xdb\xc1\x82\x0b\xd6\x63\x6b\x57\xff\x6f\x56\x76\xe4\x5a\x3c\xa3\x5e\x25\xac\x05\xdc\x34\x3f\x5a\xa5\xd9\x06\x6b\xa2\x79\x3a\xdd\xc1\xea\x16\xde\x6e\x99\xdc\x20\xe0\x33\x4a\x6b\x80\x69\x84\xf5\xd0\x75\xa8\x91\x3b\x9d\x34\x93\x86\xb5\x56\x28\x69\x96\x30\xc8\x1e\x8d\x21\x45\x8f\x0e\xf2\xa0\x85\xb5\x28\xc1\x2a\x3a\x03\x35\xd8\xb5\x1a\x1b\x70\x0a\x9e\x4e\x70\x13\x88\xde\x3f\x00\x93\x1c\xaa\x9b\xe6\x03\x32\x3b\x68\x7c\x2f\xd9\xba\x47\x0e\x0b\x0f\xb0\xa8\xa1\x92\xca\xbe\x0e\xb0\xf2\x94\x17\xb5\x13\xbc\x14\xbb\xbd\xd2\x16\xaa\xb2\x58\xb4\x4a\x5a\x1c\xed\xa2\x2c\x16\xdd\xce\xfd\x31\x47\xd9\x2e\xca\xb2\x58\xa0\xb4\x1b\xd5\x08\xb5\x42\x69\x57\xc1\x32\x8b\xb2\x20\xb5\x45\x97\x5b\xf3\x7c\x2e\x0b\x77\xac\x9d\x25\x6e\x02\xf9\xfb\x87\xc9\x60\xcd\xa3\x3b\x33\xc4\xbe\x28\x8a\xc5\xe9\x94\xc0\xce\xe7\x45\x40\x47\xc9\xdd\x7d\xf6\x5d\x97\xe5\x6a\x05\x4f\x23\x45\x18\xcb\x8d\xc9\x7a\x68\x7b\x41\xf1\x6a\xb7\xcc\xd2\x75\xab\x91\x59\xe4\xb0\x3e\x42\xcb\xfa\x5e\xc8\x0d\xbc\x75\x10\xcd\xd3\x58\xd5\x4d\x69\x8f\x7b\x24\x4a\xc6\xea\xa1\xb5\x70\x2a\x8b\x56\xc9\x4e\x6c\x48\xa3\x28\xf9\x2f\x4b\xb8\x91\x14\x1d\x37\xcd\x47\xc5\xd1\xc0\x1d\xc9\x53\xac\x56\x40\x02\xcb\xe6\x23\xdb\x21\x9c\xcf\xc4\x8e\xfc\x15\x24\xe8\x94\x06\x21\x2d\x6a\x12\x4d\x6e\xe0\x20\xec\xd6\xf9\xf3\x12\x69\x3d\x88\x9e\xa3\x36\x8d\x53\x37\xbf\xb9\xbd\xf8\xe9\xa5\x76\x62\x05\x2b\x94\x24\x41\xcf\xfe\x2d\xfa\x23\xf4\x8a\x71\xca\xc1\x22\x30\x07\x00\xb8\x8d\x28\xfe\xec\x07\xd9\x22\x90\x1b\x1b\xfa\xf2\xd8\xad\x1d\xa1\x17\xcf\x68\x9c\xb4\x24\x5c\x2f\x3a\xf4\xf9\x85\xb9\x61\x1b\x78\x24\x73\x3a\x24\xba\x32\xa4\x72\x88\x12\x18\x28\xc1\xd7\x47\xa7\x9c\xcb\xf3\xfe\x48\x76\x6e\x95\x94\xe8\x62\x9c\xe4\xb2\x63\x84\x6f\xde\xfa\xbf\x65\x48\xc0\x6e\x90\xad\x21\xf3\x72\xd1\x5a\x58\xbc\x55\xbb\x9d\xb0\x8b\xf8\x61\x29\x5b\x17\xff\x54\x7d\xbf\x66\xed\x97\xec\x93\xce\x7d\x42\x04\x37\x11\x1d\x22\xf3\x05\x8f\x26\x52\x25\x47\x91\x19\x45\xc7\x5a\xa4\xcb\x0d\xda\x78\xe7\xfe\xb8\xd0\x72\x41\x50\x4d\x2e\xf5\xd0\x93\x47\x9d\x1b\xdd\x99\x0b\xab\x83\x66\x7b\x93\x5c\x99\x80\x77\x68\xb7\x8a\x47\x3f\x4e\x34\x12\xf2\xa9\x2c\xbc\x8f\x03\xe3\x6a\x66\x90\x25\xdc\x3e\x8d\x35\xa0\xd6\x4a\x97\x45\x71\x2e\xbd\x44\x4f\x81\x51\xc0\xfa\x40\x7f\x9c\xc4\x14\xfd\x12\x18\x67\x7b\x4b\xb5\x55\x01\xeb\x7b\x75\x70\x82\x0d\xc6\x79\x51\x69\x2e\x24\xd3\x47\x4f\x88\x08\x90\x3b\x80\x51\xda\xe4\x42\x36\xf0\xd8\x41\x47\xea\xb2\x09\x2a\x05\x2c\xdb\xef\xb5\xda\x6b\xc1\x2c\x7a\x42\x46\x6c\xa4\x2b\x28\xcb\xb9\x60\x55\x57\x83\x98\x53\xf7\x56\xa3\xf4\x33\xd0\x45\xfb\xe4\xda\xd0\xf7\xef\x1a\x63\x72\x4d\x40\xfb\x1b\xb5\x22\x5f\xe8\xbd\x27\xa8\x76\xf4\xea\x80\x3a\x81\xc0\x4e\x70\xde\xe3\x81\x69\x5c\x34\xf0\xdd\xa4\x97\x93\x66\x83\x76\x2e\xa6\x67\x42\x25\x55\xa3\x1d\xb4\x9c\xdf\x37\xf0\x41\x69\xc0\x91\xed\xf6\x3d\xde\x3b\x68\xf7\x4f\xb1\x25\x61\xee\x1f\x1c\x87\x4a\x52\x46\x50\x7d\xc9\x51\xeb\x17\x27\x54\x69\x08\xb7\xf0\xbc\xd2\x7d\x10\xde\xdb\xf2\xaa\x59\xec\x08\xb7\x04\x9d\xac\x93\x48\x91\xf8\xef\x14\x18\xb5\x43\x30\x76\xe8\x3a\x58\x63\xa7\x34\x36\xf1\x5e\x74\x64\x4f\x4a\x03\x92\x32\xe7\x57\xb5\x76\x5c\x82\x1d\xeb\x6f\x1d\xc4\xff\x3d\x80\x14\xfd\x44\x38\x49\xa9\x75\x3c\x3a\xbf\xca\x94\x75\x16\x75\xe2\x19\x30\xa5\xe8\xc3\xc9\xb9\xf6\x1f\xe7\x68\xc1\x4c\x0c\xe7\x56\xfa\xae\x2e\x6c\x55\xcf\xfc\x54\xfb\xea\x95\x21\xc6\xe8\xf2\x7a\xec\xea\xa6\x2c\xdc\x4d\xd5\xe5\x50\x64\xd5\x3a\x3f\xa8\xae\x94\x25\x32\x43\x16\x7b\xce\x08\x41\x89\x40\xde\x8e\x75\xe9\xb2\x93\x5a\xd2\x8d\x92\x1f\x62\x01\x73\x03\x03\x2c\x94\x5c\x24\x0e\x2f\x04\x7d\x11\xa7\xe6\x45\xad\xfd\xdd\x6e\x4a\x3e\xfc\x2d\x20\xa7\x5a\xe9\x6e\x89\x53\xa8\x99\x54\x7e\x19\x48\x34\xd4\xfa\x32\xd2\xa0\xb1\x47\x66\xd0\x80\xb0\x06\x12\xf5\xa5\x1b\x24\xe8\x88\x42\xd9\x8d\x2b\x81\x1e\xc7\x0e\x35\x0d\x2d\x56\x39\x94\x3d\xd3\x28\xed\x12\xd6\xd8\x32\xaa\x31\x74\xd6\xba\x51\xc7\x61\xc1\x1e\xb5\x11\x8e\x6b\xa5\x34\x70\x61\x5a\xa6\x39\xf2\x3a\x90\x53\xb2\x3f\xc2\x61\x4b\xb3\x8d\x1f\x6c\x50\xef\x94\xb1\x17\x22\x52\xd3\x0e\x95\xdf\x53\xd1\xaa\xa7\xa9\x86\xca\x3f\xf9\xd5\x59\x01\x7b\x13\x52\x96\xc8\x52\x77\x20\x95\x09\xe4\xba\xde\x41\x12\xe3\x25\x20\x83\x47\xa9\x5d\x35\x38\xa0\x46\xd8\x31\x9a\x59\x25\x08\xdb\xbc\x3e\x7a\x84\xb0\x8a\x21\x92\xc7\x52\x1e\x30\x76\x7c\xe7\xe6\x44\xca\x35\x3b\x36\x7e\xa6\x68\xfc\xec\xd8\x54\xb7\xf1\xba\x0e\x8c\x44\xe7\x3c\x90\xf9\xbb\x7a\xe1\xe4\x3a\xe8\x2b\x3a\x88\xe8\x8d\x77\x47\x9e\xae\x79\x92\x27\x30\x3b\x36\x9e\x48\xf5\x32\xbf\xe7\xc9\x4d\x7d\x87\xfe\x9d\xf1\x68\x18\x57\x7b\x5b\xc5\xd3\xba\xcc\x10\x7d\x6e\x17\xe7\x99\xd5\x8a\x67\xa6\xa1\x93\x17\xb9\x0b\x0f\xf0\xc7\xaa\xdc\x2c\x01\x23\xab\x24\x95\x1d\x2f\xca\x17\xc9\xe3\x0a\x4b\x02\xd8\x0d\xcd\xf7\xaa\xfd\x52\xd1\xa1\x8f\x6a\x9a\x97\xf7\x7b\x94\xbc\xfa\xf4\x39\xc3\xa5\x9a\x53\x49\xd1\xd7\xcb\x89\xfa\xe9\x34\xe5\xf5\xf9\xdc\x34\xcd\x9c\xf4\x4f\xb2\x8f\xc4\x69\x72\x12\x64\xee\x1e\x65\xe5\x38\xd5\x70\x07\x5f\x7f\x0b\x02\xfe\xfa\x00\x6f\xbe\x05\x71\x77\xe7\x75\xe8\x24\x3c\x80\x83\xf8\x24\x3e\x57\x9d\x24\xec\x73\x56\x5d\xe4\x85\x4a\x14\x36\x17\xd5\x66\xb5\x82\x1f\x64\x06\x01\x8c\x73\xea\x51\x44\x91\xba\x3f\x95\x40\x50\xf2\x65\x85\x69\xe6\x51\x7b\x41\xe6\xb2\x44\x92\x35\xea\x3f\x1b\xc2\xf1\xfb\xc2\xe8\xae\x74\xc0\x2b\x46\x7b\xc5\xd2\x90\x5c\xf4\x0a\xc0\x12\x3a\x67\x8d\x2c\xce\x68\x15\xf0\x93\x6e\xd6\xb7\xc3\x81\x4b\xee\xb5\x90\xdc\x38\x03\x0d\xda\xa5\x4b\x56\x18\x9a\xf2\xd2\x32\x1e\xaf\xaa\xe3\xf0\x4c\x96\x20\x47\xa4\x09\xba\x79\xa7\x7c\xc4\x46\x23\x85\x3b\x78\x80\xaf\x3c\xca\xc9\x5b\xea\x7e\x32\xda\x39\x07\x6c\x84\xa4\x3c\x2c\x5d\xb8\xa6\xa8\x0e\x97\x34\x12\x5f\x0a\xe4\xa1\xe1\xf4\x07\xf6\x91\x90\x12\xd3\x02\xf1\x00\x1f\xf1\x70\x65\x89\xa8\x92\x64\x75\xda\x27\x68\xa5\x71\xf3\xf8\xea\x16\x3a\xa1\x8d\x05\x49\x2b\x3c\x05\x37\x57\x6d\x9c\x7a\xe2\x0e\x7a\x07\x37\x1e\xe8\xfe\x01\x84\xe4\x38\x26\x51\xde\x44\x97\x44\x07\x66\xd3\xf2\x46\x3c\x23\x95\x62\xb7\x33\x36\x4f\xa3\x5f\x89\x18\x48\xb5\x4f\xa7\x01\x49\x10\xb7\x1d\x4a\xcb\xa8\x27\x34\x65\x18\x83\x05\x47\x46\xf3\xa5\x55\x60\x86\xbd\x5b\x14\x33\x67\x1a\x47\x50\x0d\x96\x32\x83\xfa\x01\x93\x47\xc0\xd1\x6a\xe6\xdf\x23\xc2\x3e\x3d\x6d\x5c\xab\x15\xfc\x8b\x9a\x11\x8b\x5b\x58\x18\x23\xac\x82\x10\xe7\xb4\x24\x2e\x41\x84\x79\x31\x6d\x3e\x99\x0e\x42\x1a\xcb\x28\x30\xca\xd4\x7e\x5d\x2d\x8f\x6b\x8a\x6b\x8b\xa4\x61\xdc\xb0\xdc\x3e\x20\x59\x1f\x79\xd2\x10\xcf\xe3\xd0\xae\x61\x37\x18\x1b\x53\x19\x89\xa6\x5f\xc6\x76\xd4\x99\x94\x76\x8f\x28\x2a\x34\x48\x08\xcd\xd1\xb1\x79\x31\x44\xac\x56\x84\xfd\xd8\x01\x83\xb6\x57\x66\xd6\x10\x85\x01\xdc\xad\x91\x73\xff\x20\xa1\x64\xda\xfa\x36\x28\x51\xbb\x9d\x19\xa5\x15\x56\xa0\x59\x26\x09\xdd\xc9\x91\xe8\xb2\xfd\xbe\x17\x48\xe5\xe7\xb7\x01\xf5\x71\x09\x5d\x36\x1b\xfb\x82\x42\x01\x12\x03\xaf\xf9\x07\x41\xfd\xfc\xf3\xcf\x64\x4e\xa2\xe4\xb0\xe0\x20\xfa\x1e\xd6\x08\x38\x62\x3b\x58\xe4\x44\xd9\x6e\xb5\x1a\x36\x7e\x55\xe6\x21\x84\xb6\xa2\xdd\xa6\x55\xde\x3d\xfd\x5c\x51\xf5\xa3\xb2\xe8\x57\x8d\x14\x7b\xc2\x00\xbd\x80\x6c\x94\x56\x83\xa5\x47\x21\xc3\x3a\x0c\x4b\x7f\x02\x9a\x56\xff\xd5\xea\x82\x2b\x0d\xb4\x4c\x93\x25\x66\xc6\x85\x4e\xab\x5d\x53\x16\x5c\x3f\xcf\x02\xd7\xd3\x18\xe3\xe2\x98\x6d\xc3\x17\x02\x17\x76\x4c\x88\x4f\xa3\x43\x6a\x15\x99\x8e\xa2\xdd\x79\x9f\x7c\x4a\x6f\x02\xbb\x01\xe2\x7f\x6e\x77\xff\xfb\x60\x71\x2c\x0b\x25\x43\xa8\x01\x7c\xfa\xec\x3f\xa9\x72\xd3\x45\x0a\xbc\x4f\x9f\xe3\xa7\xbf\x0a\x83\x46\x7c\x3e\x8a\x0b\x4f\xf8\xb9\x55\x3d\x0f\xef\x17\x17\x4f\x57\x21\x2a\x76\x83\x4f\xc5\x7c\x60\x4a\x5e\x73\xa3\xa2\x90\x73\x33\x35\xf0\x14\x9f\xb4\xb8\x30\x7b\x66\xdb\x2d\x72\xbf\x1e\x50\x4a\xe5\x83\x1e\x4d\x5c\x81\xe1\xa7\xcf\xef\x9f\xc3\x4b\xc7\x34\x4b\x44\xd9\xa7\x01\x29\x8a\x1f\x46\x20\x61\xe6\xdc\xbd\xa0\x57\xa7\xc1\x03\x33\xc9\xb5\xe4\xca\xe5\xb4\xfa\x49\x8a\xd7\x40\xcc\x7d\xab\xee\x72\x56\x6e\x22\xc1\x56\x0d\xd2\xbe\xe0\x6a\x3c\xa9\xc9\x48\x39\x9f\x2b\xa1\x5b\x14\x41\x81\xd4\x50\xcb\xa2\x70\x8c\x01\x8c\xd5\x42\x6e\xe8\xb7\xe7\x28\x66\x56\xf1\x85\x56\xe2\xe1\x69\x0c\xc9\x41\xf9\x28\xf1\x90\xb3\x60\x7d\x88\xe7\xd0\xea\x1c\xf8\xf5\xbd\xe7\x65\x38\xd7\x30\x35\xfa\xa5\x1f\xc7\x5c\xdf\xa3\xb9\x24\x0c\x99\x5c\x3f\xd3\x53\x5a\xeb\xc6\x14\xd1\xcd\xc7\xcb\xd0\xe1\xa4\xe8\x1d\x06\xf5\xee\x78\xf6\x55\xa4\x7c\xb2\x23\x75\x4b\x27\xc0\x3d\xfd\x73\x5e\xd2\x3c\x4b\xcd\x90\xea\xfe\x98\xfa\xfa\xdc\xc3\xd4\x5a\xf6\xa8\x61\x1a\x4b\xa9\xcd\xb3\x67\x25\x78\x2c\xc5\x4a\x43\x4a\x08\xca\x2b\x43\x26\x0b\xbe\xb8\x52\x8b\x1b\xf8\x71\xab\x86\x9e\x53\x51\x22\x70\xe4\x7e\x63\x58\x1f\x5f\x81\xcf\xda\xf5\x24\xc4\xd3\x38\x1f\x68\x6b\xa8\xa6\x7c\x9f\x2c\x19\x34\x73\xca\x93\xc5\xbc\xc6\xef\x3c\xe4\x85\xda\x01\x3b\x16\xe9\x3f\x5a\xa2\xae\x49\x17\xc8\x57\x75\x08\xb0\x5c\x8c\x86\xdc\x39\x01\xc4\xe9\x4a\xd1\xaa\x67\x42\xab\x76\xdd\x24\x92\xce\xe8\x3a\xb0\x69\x0b\x8a\x44\x27\xbd\x82\x4b\x26\x42\xfe\xf7\xab\x8d\xd1\x35\xe8\x9f\x2e\x9b\xe2\xaf\x4f\x71\x97\xf9\xf5\x5a\x47\x9c\x59\xe1\x9a\x94\x61\x11\x7a\x5d\xcc\x14\x2f\x49\xd0\xd4\x64\xff\xb4\xa8\x91\xd6\xa5\xb0\xaf\x37\xed\x17\xe2\x46\x02\xbf\x27\xf0\xfb\x11\xdb\x38\xb9\x8c\x0d\xfd\xba\xee\x78\xba\xb9\x9e\xf9\xbe\x1b\xfb\x70\x58\x02\xd3\x1b\xb3\x84\xe7\xe9\xe5\xf2\x74\x4e\xdc\xf3\x99\x35\x30\x23\x92\x81\x44\xc2\xad\x43\xf2\xba\xb6\x3f\xc9\xe6\x7e\x5e\x17\xce\x5d\xfd\x8f\xa5\x4b\x34\xaf\x8a\x77\xbd\xa7\x9c\x4e\x30\xda\xff\xe2\x7f\x01\xdd\xa4\xa7\x79\xb7\x31\xd3\x28\xea\x9b\x04\xad\x69\xa9\x9d\xfa\x51\x38\xd4\xf5\x2c\x02\x96\xa1\x73\xb0\xac\x37\x2e\x1d\x35\x1a\x5e\xc7\x25\x3d\xdd\xce\xdf\x5c\x5e\x7f\x2d\x01\xd6\xab\xf4\x7f\x1e\xc6\x8b\x35\x70\x32\xbc\x13\xb3\x0a\xa2\x64\x0e\x39\xa5\xc6\x73\xb1\xd6\xc5\xc9\x63\x09\xd9\xa8\xe1\x5e\x14\x1d\xe8\x74\x9d\x0e\x22\x54\x59\x5c\x1d\x41\x62\xdf\x9f\x68\xf8\x83\xf9\x6b\x42\xb8\x9c\xef\x91\x57\x77\xce\x17\xdb\x66\x92\x2b\xdf\x30\xd3\x21\xe9\xe2\xaf\xd3\xaa\x9f\xc9\x3d\x43\x89\xc7\xb9\x01\x02\xda\x55\xf5\xec\x18\x14\xba\xa0\xe3\x8f\x96\x61\xca\xca\xf0\xa3\xbe\x97\x3b\x2e\x3d\xa5\xfc\x32\x6b\xce\xf0\x90\x57\x0a\xf7\x84\x41\x0b\x1c\xa0\xe4\x70\x3e\x97\xff\x19\x00\x69\x40\xe8\xfa\xce\x1d\x00\x00")
 
 func templateTxTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1227,12 +1542,12 @@ func templateTxTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/tx.tmpl", size: 5381, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/tx.tmpl", size: 7630, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateWhereTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x4b\x6f\xe3\x36\x10\x3e\x5b\xbf\x62\x20\x38\xa8\x1d\x34\xd2\x76\x6f\x5d\x20\x87\x74\x93\x6d\x5c\x2c\x92\x6d\x13\xb4\x87\x20\x07\x46\x1c\x59\x44\x64\x52\x4b\xd2\xce\x06\x82\xff\x7b\x31\x24\xf5\xf0\x23\xb6\xd3\x0d\x8a\xbd\xd9\xe4\x70\x1e\xdf\xcc\x37\x1c\xb1\xae\xd3\xe3\xe8\xa3\xaa\x9e\xb5\x98\x16\x16\xde\xbf\xfb\xe5\xd7\x93\x4a\xa3\x41\x69\xe1\x13\xcb\xf0\x41\xa9\x47\x98\xc8\x2c\x81\xb3\xb2\x04\x27\x64\x80\xf6\xf5\x02\x79\x12\xdd\x16\xc2\x80\x51\x73\x9d\x21\x64\x8a\x23\x08\x03\xa5\xc8\x50\x1a\xe4\x30\x97\x1c\x35\xd8\x02\xe1\xac\x62\x59\x81\xf0\x3e\x79\xd7\xec\x42\xae\xe6\x92\x47\x42\xba\xfd\xcf\x93\x8f\x17\x57\x37\x17\x90\x8b\x12\x21\xac\x69\xa5\x2c\x70\xa1\x31\xb3\x4a\x3f\x83\xca\xc1\xf6\x8c\x59\x8d\x98\x44\xc7\xe9\x72\x19\x45\x75\x0d\x1c\x73\x21\x11\xe2\xa7\x02\x35\xc6\xe0\x57\x4f\xe0\x49\xd8\x02\xf0\x9b\x45\xc9\x61\x08\xf1\x17\x96\x3d\xb2\x29\xc6\x30\x4c\xc2\x4f\x38\x59\x2e\xa3\x41\x5d\x83\xc5\x59\x55\x32\x8b\x10\x17\xc8\x38\xea\x18\x12\xd2\x52\xd7\x40\x67\x83\x95\x4e\x48\xcc\x2a\xa5\x6d\x0c\x43\xb7\x95\xa6\x30\x39\x27\xe7\x2d\x6a\x03\x0b\xd4\x56\x64\x68\xe0\x81\x11\x0a\xca\x85\x23\xb4\x17\xc1\x92\x27\x51\x3e\x97\x19\x4c\xce\x47\x82\x43\x5d\xc3\x30\x99\x9c\x27\xb7\xcf\x15\xc2\x72\x39\x86\x4a\x23\x17\x19\xb3\x98\xb8\xad\x2b\x36\xa3\x75\xa8\xa3\x81\x46\x3b\xd7\xf2\x05\x81\x51\x34\x18\x50\xc4\x43\x3b\xab\x4a\xf8\x70\x0a\x95\x16\xd2\xe6\x10\x73\xc1\x4a\xcc\x6c\x7a\x64\xd2\xf6\x64\x2a\x38\x61\x70\x63\x95\x26\x0c\x08\x02\x77\xf8\x5b\x1b\xa0\x57\x33\xf4\xf0\x8c\x23\x1f\xbe\x66\x72\x8a\x30\x54\x15\xe9\x57\x95\x71\x9e\x43\x00\x70\xc8\xf4\x94\xd6\x63\xd2\xbd\x5c\xd6\x35\x88\x9c\x64\x93\xbf\x99\x16\x8c\x8b\xcc\x2f\x3a\x31\x27\x65\x82\x58\xc0\xd7\xe9\x70\xc0\xf4\x9c\x9f\x9c\x1f\x99\xd8\x69\x09\x61\x46\x83\x34\x85\x56\x72\xb9\x04\x56\x55\xa5\x40\xe3\x2a\x86\xd6\x3b\xd1\x0e\xa8\x90\x82\x5e\x02\x06\xee\x78\x4f\xcf\xa8\x71\x8d\xa0\xde\xe6\x7a\x92\x24\xad\xaf\xaf\xc8\xd9\xfe\xa4\x0d\xb6\xd4\xe9\x99\x9e\xc6\xde\x9d\xf8\xba\x72\xf1\x43\x1c\x92\xd5\xcf\x9b\x4b\x8e\xd3\x70\x70\xda\x53\x55\x99\x8d\xd4\x6f\x4f\x7e\x12\x36\x69\x8f\xfc\xf2\xd6\xc6\xd1\x60\x9d\x15\xa1\x2c\x72\x32\x3f\x4c\x3e\x11\xc2\x66\x23\xa3\xc3\x3c\xb9\xb1\x7a\x9e\x59\xb7\x1f\xb6\xd3\x63\xf8\xe3\xe6\xfa\x0a\x32\x26\xa5\xb2\xf0\x40\x3d\x64\x56\x31\x4d\xbd\xc3\x08\x39\x85\xf8\x34\x06\x26\x39\x5c\xc8\xf9\x0c\x0a\x66\x80\x81\x25\xd0\x3d\xdd\xb9\xc7\x8d\x52\xeb\xf2\x0a\x92\x60\x75\x3d\xc1\xd9\x2e\x98\xf9\x42\xb6\x49\xf7\x48\x69\xf2\x61\x62\x9c\x41\xf7\x8b\x94\x8e\x5b\x47\xbd\x65\xf6\x50\x62\x70\xf7\xa3\x92\xc4\x64\xe4\xb7\xea\x37\x66\x5c\x11\x78\x49\x6a\x6b\x59\xe9\xbc\xfc\x70\x0a\x23\xf2\x6f\x24\x31\x84\x1a\x7f\x66\x0f\x58\xc6\xe3\xfe\xd2\xa5\x52\x8f\x66\x75\xe9\x8b\x2a\x45\xf6\x1c\x8f\x1b\xfb\x27\x54\x72\xa4\xc9\x3b\xdd\xf7\xa6\x6f\x2f\x30\xb5\x23\xdb\x22\xf6\x6b\x2f\xb0\x02\xbf\xce\x59\x29\xec\x33\x64\x05\x66\x8f\x9b\x8c\xa8\x6b\xf8\x3a\x57\x94\xf2\xbc\xad\x5a\x4f\x11\x98\xd8\x9f\x0c\x08\x8e\xd2\x8a\x8c\x95\x60\x55\xdf\xc0\xc5\x9f\x49\x34\xd8\x47\xa2\x61\x7e\x10\x43\x9a\xe8\x87\x79\x72\xc9\xcc\xef\x2a\x9c\x71\x75\xb9\x70\xb5\xe3\x75\xb9\x24\xb8\xcd\x36\xea\xd0\xf2\x42\x4b\x59\x64\xf1\x5a\xc9\xfa\x7f\xfb\x29\xb8\x87\x83\xae\x64\x63\xaa\xf0\x86\x71\x87\x53\x2e\x0f\x67\xd7\x19\xb7\x93\x72\x6b\x9c\x23\xd2\x0d\x42\x99\x84\xb0\x5e\xc1\x40\x6a\xd4\x4d\xbf\xce\x9b\x55\x17\x6c\xeb\x54\x72\x5d\x99\xae\xb6\x48\xf2\x94\x4a\x08\x25\x37\xfe\xef\x28\x63\x65\xb9\x26\x3f\xcc\x5b\xf2\xf4\x5a\xf8\xca\x1d\xe1\xce\xae\xdf\x0f\x8b\x43\xae\x87\xc5\xde\xdb\x61\xbd\xa3\xac\x5c\x12\x2e\x3d\x54\x2a\x9e\xca\x54\x35\x24\x4c\x2d\xa5\xb5\xdd\xb4\x80\x60\xd8\x89\x9f\x82\xd5\x62\xd6\xcc\x06\x7e\xad\x9b\x15\x56\x1c\xfa\x8e\x7b\xe8\x65\xd6\x6d\xbf\x98\x44\xee\x5a\x98\xd3\x29\xca\x35\xb0\x0e\xbd\xb0\xac\xa7\x55\xbb\xb6\x93\x93\x0d\x25\x57\x55\x52\x29\x2e\x08\xd2\x19\x7b\xc4\xd1\xdd\xbd\x90\x16\x75\xce\x32\xac\x97\x3f\x43\x89\xb2\xc7\xff\x31\x95\xec\x20\x57\x1a\x04\x1d\xf0\x55\xb1\xf0\x7c\xef\xd8\xb6\xc2\xea\x51\xc3\xa3\x23\x73\x27\xee\x3d\xf7\xc6\x2d\x5d\x16\x77\xe2\x1e\x5c\x2b\x58\x25\x49\x69\x70\x8b\x4c\xf0\xe2\x4e\xdc\xaf\xd0\xc9\x0b\xb6\x2d\xa2\x2d\xb6\xb8\x1b\x81\x9c\xc2\xd0\x8b\x47\x6b\xa8\x8f\xb7\xf5\xa8\x7d\x2d\x6a\x6b\x87\x5a\x71\xe8\x7b\x47\x84\xae\x3d\xbd\xed\xb4\xe0\x4a\xf2\x6d\x06\x86\x5e\x93\xd8\xda\xb9\x3c\x51\x93\x0b\x3e\xc5\xcd\xd1\xc1\xd3\x3d\xbe\x64\xe4\x08\x6e\x8c\x11\x3b\x88\x78\xc9\x0c\xa9\xdc\xc5\x40\x6c\xeb\x1e\xf9\x14\xb7\x11\xf0\xed\xc7\x3b\xf2\x89\x42\x79\x7d\x4a\xc8\xc7\xb4\x60\x6f\x94\x11\x1f\x62\x67\xf2\xc8\xfc\x23\x6c\x11\xb7\xa1\xbf\x2d\xb6\x1e\x05\x06\x53\xb1\x40\x09\x99\x92\x5c\x58\xa1\xa4\x81\x91\xb2\x05\xea\x4e\x91\x19\x6f\x4b\x03\x6d\x1b\x48\x92\x64\x15\x6b\xf4\xcd\x3d\x18\xfa\x11\x73\xf5\xe4\x31\x7d\xbb\x91\x3b\x4d\xe1\x4c\x72\x98\x6a\x35\xaf\x4c\x0f\xb6\x6e\x1a\x3e\xbb\x3a\x07\x55\xa1\x66\x56\x69\x78\x40\xfb\x84\xe8\x72\x33\x0b\x9f\x9e\x67\x92\x8f\x7a\xe7\x36\x40\x3d\x04\xce\x57\x7c\x8d\xee\x01\x8a\xc9\xc3\xbe\x46\x93\xde\xd7\x68\x9a\xc2\xb5\xde\x05\xc1\xf5\x5f\x3b\x11\xb8\xd6\x3f\x10\x00\x4a\xff\x97\xf8\xaf\x94\x5d\x21\x24\x5d\x56\x6d\xc8\x81\x8b\x9e\x6b\x9d\x8b\x3e\xf8\x2b\x65\x47\xd5\x0b\x8e\xff\x3f\x11\x4b\x65\x5f\x1d\xf2\xca\xfb\x8b\x7b\xe7\x49\x19\xf7\x3d\x84\x95\xcd\x4b\x4c\x3b\xda\xd2\x71\xe3\x67\x15\x9b\x15\xb7\x2f\x1e\x4c\x8f\xe3\xb5\xd1\xb5\x21\x77\x50\xd1\x8c\xc5\x9b\x6f\x23\xcb\x1d\xf7\x5a\xf7\x33\x3d\x86\xb3\xce\x71\x5b\x30\x4b\x9f\xbb\xf4\xad\xab\x16\xa8\xb5\xe0\x1c\x25\x08\x09\x4a\xbb\xc7\x32\x05\x8c\x73\xe8\xfc\xf3\xaf\x6a\x34\x48\x51\x3e\xdd\x10\x57\xf9\x71\x34\xf1\x5f\xba\xeb\x8f\x5f\x2b\xa0\xf4\x27\xd6\x7f\x03\x00\x00\xff\xff\x7f\xa8\x59\x19\xea\x13\x00\x00")
+var _templateWhereTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\xcf\x6f\xdb\xb8\x12\x3e\x4b\x7f\xc5\x40\x50\xf0\xec\xa0\x91\xfa\x7a\x7b\x05\x72\xc8\x4b\xd2\x17\x3f\x14\x49\x77\x13\xec\x1e\x82\x1c\x18\x71\x64\x11\x91\x49\x95\xa4\xdd\x06\x82\xfe\xf7\xc5\x50\xd4\x0f\x3b\x4e\xec\xb4\xc1\x6e\x6f\x36\x39\x24\x67\xbe\x99\xef\xe3\x88\x75\x9d\x1e\x86\xa7\xaa\x7a\xd4\x62\x5e\x58\xf8\xf0\xfe\xdf\xff\x39\xaa\x34\x1a\x94\x16\x3e\xb1\x0c\xef\x95\x7a\x80\x99\xcc\x12\x38\x29\x4b\x70\x46\x06\x68\x5e\xaf\x90\x27\xe1\x4d\x21\x0c\x18\xb5\xd4\x19\x42\xa6\x38\x82\x30\x50\x8a\x0c\xa5\x41\x0e\x4b\xc9\x51\x83\x2d\x10\x4e\x2a\x96\x15\x08\x1f\x92\xf7\xdd\x2c\xe4\x6a\x29\x79\x28\xa4\x9b\xff\x3c\x3b\x3d\xbf\xbc\x3e\x87\x5c\x94\x08\x7e\x4c\x2b\x65\x81\x0b\x8d\x99\x55\xfa\x11\x54\x0e\x76\x74\x98\xd5\x88\x49\x78\x98\x36\x4d\x18\xd6\x35\x70\xcc\x85\x44\x88\xbe\x15\xa8\x31\x82\x76\xf4\x08\xbe\x09\x5b\x00\x7e\xb7\x28\x39\xc4\x10\x7d\x61\xd9\x03\x9b\x63\x04\x71\xe2\x7f\xc2\x51\xd3\x84\x41\x5d\x83\xc5\x45\x55\x32\x8b\x10\x15\xc8\x38\xea\x08\x12\xda\xa5\xae\x81\xd6\xfa\x53\x06\x23\xb1\xa8\x94\xb6\x11\xc4\xdd\x54\x6c\x17\x55\x09\x1f\x8f\x21\x8a\xfc\x3a\x91\x43\x9c\x5c\x30\x73\x25\xf1\x93\xc0\x92\xcf\xce\x68\x22\x4d\x61\x76\x46\x71\x5a\xd4\x06\x56\xa8\xad\xc8\xd0\xc0\x3d\x23\xc0\x94\x8b\x5c\xe8\xd6\x04\x4b\x9e\x84\xf9\x52\x66\x30\x3b\x9b\x08\x0e\x75\x0d\x71\x32\x3b\x4b\x6e\x1e\x2b\x84\xa6\x99\x42\xa5\x91\x8b\x8c\x59\x4c\xdc\xd4\x25\x5b\xd0\x38\xd4\x61\xa0\xd1\x2e\xb5\x7c\xc6\x60\x12\x06\x01\x81\xd3\xba\x7c\x0c\x95\x16\xd2\xe6\x10\x71\xc1\x4a\xcc\x6c\x7a\x60\xd2\x7e\x61\x2a\x38\xa1\x75\x6d\x95\x26\xb4\x08\x2c\xb7\xf6\x7b\x0f\x45\xbb\x4b\xdc\x02\x39\x0d\x5b\x34\x34\x93\x73\x84\x58\x55\x84\x88\xaa\x8c\x73\x1c\x3c\xd4\x31\xd3\x73\x1a\x8f\x68\xef\xa6\xf1\x50\xa9\x2a\xf9\x83\x69\xc1\xb8\xc8\xda\x41\x67\xe6\xac\x8c\x37\xf3\x99\x70\x7b\x38\x5c\x3e\x0e\xce\xcf\xce\x0e\x4c\x44\x27\x76\x51\x86\x41\x9a\x42\x6f\xd9\x34\xc0\xaa\xaa\x14\x68\x08\x61\x37\x3e\x98\x0e\x38\xf9\x0c\x8c\xf0\x0f\xdc\x41\xa3\x7d\x26\x9d\x6b\x84\xf4\x36\xd7\x93\x24\xe9\x7d\x7d\x45\xca\x76\xe7\x2c\xd8\x52\xd1\x27\x7a\x1e\xb5\xee\x44\x57\x95\x8b\x1f\x22\x9f\xac\x71\xde\x5c\x72\xdc\x0e\x43\xa1\xee\x48\x7b\xaa\x2a\xf3\x24\xf5\xdb\x93\x9f\xf8\x49\x9a\xa3\xb8\xdb\xd3\xa6\x61\x30\xe6\x0f\xfd\x2a\x0d\xee\xcf\x81\x4c\x2d\x2a\x65\x84\x45\x10\x1c\xa5\x15\xb9\x40\x3d\xf0\x61\x28\x32\xf1\x0e\xe2\x9c\x02\x8a\x93\xd3\x6e\xc9\xec\x6c\x28\x2c\x01\x4d\xf3\x0e\xc6\x29\xc9\xd8\x02\x4b\x88\xf3\x01\x7e\x4a\x6f\x97\xa4\xde\xf2\xef\x22\x58\xda\x47\xfa\x5a\xaa\xf5\xae\x8e\x49\xe7\xb1\x70\x9a\x63\x9e\xf0\x25\xce\x93\x6b\xab\x97\x99\x75\xf3\x7e\x3a\x3d\x84\xff\x5f\x5f\x5d\x42\xc6\xa4\x54\x16\xee\x49\xcb\x17\x15\xd3\xa4\xe1\x46\xc8\x39\x44\xc7\x11\x30\xc9\xe1\x5c\x2e\x17\x50\x30\x03\x0c\x2c\xa1\xd5\xca\x2e\x6f\xab\x92\x88\xe3\x58\x03\x92\x80\x72\xda\xec\xce\x2e\x98\xf9\x42\x4e\xd1\xde\x13\xa5\x09\xeb\x99\x71\x07\xba\x5f\xb4\xe9\xb4\x77\xb4\x3d\x99\xdd\x97\xe8\xdd\x3d\x55\x92\x64\x12\xf9\x8d\xfa\x2f\x33\x4e\x1d\x9c\x62\xc7\x74\xbd\x64\xa5\xf3\xf2\xe3\x31\x4c\xc8\xbf\x89\x44\x1f\x6a\xf4\x99\xdd\x63\x19\x4d\xc7\x43\x17\x4a\x3d\x98\xf5\xa1\x2f\xaa\x14\xd9\x63\x34\xed\xce\x3f\x22\x42\xd3\x4e\xad\xd3\x63\x6f\xc6\xe7\xf9\xe4\x0c\x52\xb6\x22\x89\x0a\x83\xe7\x34\x07\xbf\x2e\x59\x29\xec\x23\x64\x05\x66\x0f\x4f\xf5\xa6\xae\xe1\xeb\x52\x59\x1c\x17\xa5\x83\x32\x81\x99\xfd\x97\xf1\x0c\xc8\x58\x09\x56\x8d\x0f\x38\xff\x2d\x09\x83\x5d\x12\x15\xe7\x7b\xe9\x4f\x17\x7d\x9c\xd3\xad\xf5\x3f\xe5\xd7\xd0\x4c\xb0\x72\xb5\x43\x4b\xf2\xc4\x25\xc1\x4d\xf6\x51\xfb\x72\xf7\x82\xbd\xca\xa2\x0d\x41\x68\xff\xed\x16\xb8\x1d\x0a\xe7\x4a\x36\xa2\x0a\xef\xf4\x6c\x7f\x41\xcb\xfd\xda\x4d\x3d\x7b\x51\xd0\x36\x14\x2d\x98\x86\x41\xd0\x84\xe3\xb0\x5e\xc1\x40\xba\x06\xbb\xdb\x30\xef\x46\x1d\x71\x7a\xa7\x92\xab\xca\x0c\xb5\x45\x0b\x8e\xe9\xda\x42\xc9\x0d\x49\xbb\x81\x49\xc6\xca\x72\x08\xc2\xd9\xc7\x79\x4f\x1e\xef\x4a\x30\xb8\xd2\xde\xc0\x6e\xed\xe6\xed\xbb\xda\xe7\xf2\x5d\xed\xbc\x7b\x37\x15\x65\xed\x0a\x26\x6b\x27\x14\x2d\x95\xa9\x6a\x48\x7e\x48\x52\xfa\xb3\x3b\x09\xf0\x07\x3b\xf3\x63\xb0\x5a\x2c\xba\x1e\xad\x1d\x1b\x7a\xb6\x35\x87\x7e\xe2\x96\x7f\x9e\x75\xe1\x56\x4e\x89\xdc\x49\x98\xdb\x53\x94\x1b\x60\xed\xdb\x0e\xd8\x57\xdc\x32\x3d\x25\xd7\xb7\xa4\x52\x5c\x51\x02\x16\xec\x01\x27\xb7\x77\x42\x5a\xd4\x39\xcb\xb0\x6e\xde\x41\x89\x72\xc4\xff\x29\x95\x6c\x90\x2b\x0d\x82\x16\xb4\x55\xb1\x82\x7a\x9d\x6d\x6b\xac\x9e\x74\x3c\x3a\x30\xb7\xe2\xae\xed\x2e\xa6\x1d\x21\x82\xd5\xad\xb8\x83\x63\x0a\x75\x9d\x24\xfe\x6a\xdf\xb0\xf1\x5e\xdc\x8a\xbb\xc1\xd2\xe7\xcd\x31\x69\x43\x38\xbc\x6e\xf4\x1b\x7a\x2d\x9e\x6c\xa0\x3e\xdd\xa6\x51\xbb\x24\x6a\xab\x42\xad\x39\xb4\x5b\x9f\xf6\x95\xa7\xb7\xed\xc5\x5c\x49\xbe\x4d\x3b\x36\x12\x89\xad\xca\xd5\xde\xb9\xc9\x39\x9f\xa3\x79\x86\xee\xd1\x05\xa3\xbe\x10\x9f\xb4\x11\x2f\x10\xf1\x82\x19\xda\xf2\x25\x06\x62\x5f\xf7\xc8\xe7\xb8\x8d\x80\x2f\x12\xe5\x87\x72\x47\x3e\x51\x28\xaf\x4f\x09\xf9\x98\x16\xec\x8d\x32\xd2\x62\x36\x1c\x79\x60\xfe\x14\xb6\x88\xfa\xd0\xdf\x16\xdb\x96\xf2\x0c\xe6\x62\x85\x12\x32\x25\xb9\xb0\x42\x49\x03\x13\x65\x0b\xd4\xc3\x46\x66\xba\x2d\x0d\x34\x6d\x20\x49\x92\xde\xce\x61\x8d\xae\xc7\xe8\x0e\xfa\x15\x73\x45\x61\xff\x7c\xbe\x46\xb4\x49\x53\x38\x91\x1c\xe6\x5a\x2d\x2b\x33\x84\x63\x86\x6e\xf8\xe4\xf2\x0c\x54\x85\x9a\x59\xa5\xe1\x1e\xed\x37\x44\x97\x9b\x85\xff\x8e\x39\x91\x7c\x32\x5a\xf7\x04\xd4\x7d\xe0\x7c\xbb\x2f\x11\x26\xf7\xfb\xd6\x4f\x46\xdf\xfa\x69\x0a\x57\xfa\x25\x08\xae\x7e\x7f\x11\x81\x2b\xfd\x0b\x01\xa0\xf4\x8f\xc4\x7f\xa9\xec\x1a\x21\xe9\xb2\xea\x43\xf6\x5c\x6c\xb9\x36\xb8\xd8\xa6\xff\x52\xd9\x49\x05\xff\x64\xc4\x52\xd9\x57\x87\xbc\xf6\x0e\xe6\xde\xdb\x52\xc6\x5b\x0d\x61\xe5\xe8\x45\xcc\xb1\xc0\x2d\x77\x7d\xef\x82\xd9\xac\xb8\x79\x76\x61\x7a\x18\x6d\xb4\xae\x1d\xb9\xfd\x16\xde\xb7\x27\xae\xc5\xdd\x32\x4f\xcb\x11\x41\xd7\x7e\xa6\x87\x70\x32\x38\x6e\x0b\x66\xe9\x73\x97\xbe\x75\xd5\x0a\xb5\x16\x9c\xa3\xa4\xa7\x47\xa5\xdd\xa3\xa5\x02\xc6\x39\x0c\xfe\xb5\xaf\x9b\xd4\x48\x51\x55\xbb\x26\xae\x6a\xdb\xd3\xa4\xfd\xd2\xdd\x7c\x84\x5c\x03\x65\xdc\xb1\xfe\x35\x00\x2c\x7d\xed\x47\x72\x15\x00\x00")
 
 func templateWhereTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1247,7 +1562,7 @@ func templateWhereTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/where.tmpl", size: 5098, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/where.tmpl", size: 5490, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -1324,6 +1639,7 @@ var _bindata = map[string]func() (*asset, error){
 	"template/dialect/gremlin/group.tmpl":            templateDialectGremlinGroupTmpl,
 	"template/dialect/gremlin/meta.tmpl":             templateDialectGremlinMetaTmpl,
 	"template/dialect/gremlin/open.tmpl":             templateDialectGremlinOpenTmpl,
+	"template/dialect/gremlin/pagination.tmpl":       templateDialectGremlinPaginationTmpl,
 	"template/dialect/gremlin/predicate.tmpl":        templateDialectGremlinPredicateTmpl,
 	"template/dialect/gremlin/query.tmpl":            templateDialectGremlinQueryTmpl,
 	"template/dialect/gremlin/select.tmpl":           templateDialectGremlinSelectTmpl,
@@ -1336,10 +1652,12 @@ var _bindata = map[string]func() (*asset, error){
 	"template/dialect/sql/entql.tmpl":                templateDialectSqlEntqlTmpl,
 	"template/dialect/sql/errors.tmpl":               templateDialectSqlErrorsTmpl,
 	"template/dialect/sql/feature/schemaconfig.tmpl": templateDialectSqlFeatureSchemaconfigTmpl,
+	"template/dialect/sql/feature/upsert.tmpl":       templateDialectSqlFeatureUpsertTmpl,
 	"template/dialect/sql/globals.tmpl":              templateDialectSqlGlobalsTmpl,
 	"template/dialect/sql/group.tmpl":                templateDialectSqlGroupTmpl,
 	"template/dialect/sql/meta.tmpl":                 templateDialectSqlMetaTmpl,
 	"template/dialect/sql/open.tmpl":                 templateDialectSqlOpenTmpl,
+	"template/dialect/sql/pagination.tmpl":           templateDialectSqlPaginationTmpl,
 	"template/dialect/sql/predicate.tmpl":            templateDialectSqlPredicateTmpl,
 	"template/dialect/sql/query.tmpl":                templateDialectSqlQueryTmpl,
 	"template/dialect/sql/select.tmpl":               templateDialectSqlSelectTmpl,
@@ -1347,13 +1665,25 @@ var _bindata = map[string]func() (*asset, error){
 	"template/dialect/sql/update.tmpl":               templateDialectSqlUpdateTmpl,
 	"template/ent.tmpl":                              templateEntTmpl,
 	"template/enttest.tmpl":                          templateEnttestTmpl,
+	"template/events.tmpl":                           templateEventsTmpl,
+	"template/graphql/edge.tmpl":                     templateGraphqlEdgeTmpl,
+	"template/graphql/node.tmpl":                     templateGraphqlNodeTmpl,
+	"template/graphql/pagination.tmpl":               templateGraphqlPaginationTmpl,
+	"template/graphql/schema.tmpl":                   templateGraphqlSchemaTmpl,
+	"template/graphql/where_input.tmpl":              templateGraphqlWhere_inputTmpl,
+	"template/grpc/generate.tmpl":                    templateGrpcGenerateTmpl,
+	"template/grpc/proto.tmpl":                       templateGrpcProtoTmpl,
+	"template/grpc/service.tmpl":                     templateGrpcServiceTmpl,
 	"template/header.tmpl":                           templateHeaderTmpl,
+	"template/history.tmpl":                          templateHistoryTmpl,
 	"template/hook.tmpl":                             templateHookTmpl,
 	"template/import.tmpl":                           templateImportTmpl,
 	"template/internal.tmpl":                         templateInternalTmpl,
 	"template/meta.tmpl":                             templateMetaTmpl,
 	"template/migrate/migrate.tmpl":                  templateMigrateMigrateTmpl,
 	"template/migrate/schema.tmpl":                   templateMigrateSchemaTmpl,
+	"template/openapi/handler.tmpl":                  templateOpenapiHandlerTmpl,
+	"template/pagination.tmpl":                       templatePaginationTmpl,
 	"template/predicate.tmpl":                        templatePredicateTmpl,
 	"template/privacy/filter.tmpl":                   templatePrivacyFilterTmpl,
 	"template/privacy/privacy.tmpl":                  templatePrivacyPrivacyTmpl,
@@ -1419,19 +1749,20 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"context.tmpl": &bintree{templateContextTmpl, map[string]*bintree{}},
 		"dialect": &bintree{nil, map[string]*bintree{
 			"gremlin": &bintree{nil, map[string]*bintree{
-				"by.tmpl":        &bintree{templateDialectGremlinByTmpl, map[string]*bintree{}},
-				"create.tmpl":    &bintree{templateDialectGremlinCreateTmpl, map[string]*bintree{}},
-				"decode.tmpl":    &bintree{templateDialectGremlinDecodeTmpl, map[string]*bintree{}},
-				"delete.tmpl":    &bintree{templateDialectGremlinDeleteTmpl, map[string]*bintree{}},
-				"errors.tmpl":    &bintree{templateDialectGremlinErrorsTmpl, map[string]*bintree{}},
-				"globals.tmpl":   &bintree{templateDialectGremlinGlobalsTmpl, map[string]*bintree{}},
-				"group.tmpl":     &bintree{templateDialectGremlinGroupTmpl, map[string]*bintree{}},
-				"meta.tmpl":      &bintree{templateDialectGremlinMetaTmpl, map[string]*bintree{}},
-				"open.tmpl":      &bintree{templateDialectGremlinOpenTmpl, map[string]*bintree{}},
-				"predicate.tmpl": &bintree{templateDialectGremlinPredicateTmpl, map[string]*bintree{}},
-				"query.tmpl":     &bintree{templateDialectGremlinQueryTmpl, map[string]*bintree{}},
-				"select.tmpl":    &bintree{templateDialectGremlinSelectTmpl, map[string]*bintree{}},
-				"update.tmpl":    &bintree{templateDialectGremlinUpdateTmpl, map[string]*bintree{}},
+				"by.tmpl":         &bintree{templateDialectGremlinByTmpl, map[string]*bintree{}},
+				"create.tmpl":     &bintree{templateDialectGremlinCreateTmpl, map[string]*bintree{}},
+				"decode.tmpl":     &bintree{templateDialectGremlinDecodeTmpl, map[string]*bintree{}},
+				"delete.tmpl":     &bintree{templateDialectGremlinDeleteTmpl, map[string]*bintree{}},
+				"errors.tmpl":     &bintree{templateDialectGremlinErrorsTmpl, map[string]*bintree{}},
+				"globals.tmpl":    &bintree{templateDialectGremlinGlobalsTmpl, map[string]*bintree{}},
+				"group.tmpl":      &bintree{templateDialectGremlinGroupTmpl, map[string]*bintree{}},
+				"meta.tmpl":       &bintree{templateDialectGremlinMetaTmpl, map[string]*bintree{}},
+				"open.tmpl":       &bintree{templateDialectGremlinOpenTmpl, map[string]*bintree{}},
+				"pagination.tmpl": &bintree{templateDialectGremlinPaginationTmpl, map[string]*bintree{}},
+				"predicate.tmpl":  &bintree{templateDialectGremlinPredicateTmpl, map[string]*bintree{}},
+				"query.tmpl":      &bintree{templateDialectGremlinQueryTmpl, map[string]*bintree{}},
+				"select.tmpl":     &bintree{templateDialectGremlinSelectTmpl, map[string]*bintree{}},
+				"update.tmpl":     &bintree{templateDialectGremlinUpdateTmpl, map[string]*bintree{}},
 			}},
 			"sql": &bintree{nil, map[string]*bintree{
 				"by.tmpl":     &bintree{templateDialectSqlByTmpl, map[string]*bintree{}},
@@ -1443,21 +1774,37 @@ var _bintree = &bintree{nil, map[string]*bintree{
 				"errors.tmpl": &bintree{templateDialectSqlErrorsTmpl, map[string]*bintree{}},
 				"feature": &bintree{nil, map[string]*bintree{
 					"schemaconfig.tmpl": &bintree{templateDialectSqlFeatureSchemaconfigTmpl, map[string]*bintree{}},
+					"upsert.tmpl":       &bintree{templateDialectSqlFeatureUpsertTmpl, map[string]*bintree{}},
 				}},
-				"globals.tmpl":   &bintree{templateDialectSqlGlobalsTmpl, map[string]*bintree{}},
-				"group.tmpl":     &bintree{templateDialectSqlGroupTmpl, map[string]*bintree{}},
-				"meta.tmpl":      &bintree{templateDialectSqlMetaTmpl, map[string]*bintree{}},
-				"open.tmpl":      &bintree{templateDialectSqlOpenTmpl, map[string]*bintree{}},
-				"predicate.tmpl": &bintree{templateDialectSqlPredicateTmpl, map[string]*bintree{}},
-				"query.tmpl":     &bintree{templateDialectSqlQueryTmpl, map[string]*bintree{}},
-				"select.tmpl":    &bintree{templateDialectSqlSelectTmpl, map[string]*bintree{}},
-				"tx.tmpl":        &bintree{templateDialectSqlTxTmpl, map[string]*bintree{}},
-				"update.tmpl":    &bintree{templateDialectSqlUpdateTmpl, map[string]*bintree{}},
+				"globals.tmpl":    &bintree{templateDialectSqlGlobalsTmpl, map[string]*bintree{}},
+				"group.tmpl":      &bintree{templateDialectSqlGroupTmpl, map[string]*bintree{}},
+				"meta.tmpl":       &bintree{templateDialectSqlMetaTmpl, map[string]*bintree{}},
+				"open.tmpl":       &bintree{templateDialectSqlOpenTmpl, map[string]*bintree{}},
+				"pagination.tmpl": &bintree{templateDialectSqlPaginationTmpl, map[string]*bintree{}},
+				"predicate.tmpl":  &bintree{templateDialectSqlPredicateTmpl, map[string]*bintree{}},
+				"query.tmpl":      &bintree{templateDialectSqlQueryTmpl, map[string]*bintree{}},
+				"select.tmpl":     &bintree{templateDialectSqlSelectTmpl, map[string]*bintree{}},
+				"tx.tmpl":         &bintree{templateDialectSqlTxTmpl, map[string]*bintree{}},
+				"update.tmpl":     &bintree{templateDialectSqlUpdateTmpl, map[string]*bintree{}},
 			}},
 		}},
-		"ent.tmpl":      &bintree{templateEntTmpl, map[string]*bintree{}},
-		"enttest.tmpl":  &bintree{templateEnttestTmpl, map[string]*bintree{}},
+		"ent.tmpl":     &bintree{templateEntTmpl, map[string]*bintree{}},
+		"enttest.tmpl": &bintree{templateEnttestTmpl, map[string]*bintree{}},
+		"events.tmpl":  &bintree{templateEventsTmpl, map[string]*bintree{}},
+		"graphql": &bintree{nil, map[string]*bintree{
+			"edge.tmpl":        &bintree{templateGraphqlEdgeTmpl, map[string]*bintree{}},
+			"node.tmpl":        &bintree{templateGraphqlNodeTmpl, map[string]*bintree{}},
+			"pagination.tmpl":  &bintree{templateGraphqlPaginationTmpl, map[string]*bintree{}},
+			"schema.tmpl":      &bintree{templateGraphqlSchemaTmpl, map[string]*bintree{}},
+			"where_input.tmpl": &bintree{templateGraphqlWhere_inputTmpl, map[string]*bintree{}},
+		}},
+		"grpc": &bintree{nil, map[string]*bintree{
+			"generate.tmpl": &bintree{templateGrpcGenerateTmpl, map[string]*bintree{}},
+			"proto.tmpl":    &bintree{templateGrpcProtoTmpl, map[string]*bintree{}},
+			"service.tmpl":  &bintree{templateGrpcServiceTmpl, map[string]*bintree{}},
+		}},
 		"header.tmpl":   &bintree{templateHeaderTmpl, map[string]*bintree{}},
+		"history.tmpl":  &bintree{templateHistoryTmpl, map[string]*bintree{}},
 		"hook.tmpl":     &bintree{templateHookTmpl, map[string]*bintree{}},
 		"import.tmpl":   &bintree{templateImportTmpl, map[string]*bintree{}},
 		"internal.tmpl": &bintree{templateInternalTmpl, map[string]*bintree{}},
@@ -1466,7 +1813,11 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"migrate.tmpl": &bintree{templateMigrateMigrateTmpl, map[string]*bintree{}},
 			"schema.tmpl":  &bintree{templateMigrateSchemaTmpl, map[string]*bintree{}},
 		}},
-		"predicate.tmpl": &bintree{templatePredicateTmpl, map[string]*bintree{}},
+		"openapi": &bintree{nil, map[string]*bintree{
+			"handler.tmpl": &bintree{templateOpenapiHandlerTmpl, map[string]*bintree{}},
+		}},
+		"pagination.tmpl": &bintree{templatePaginationTmpl, map[string]*bintree{}},
+		"predicate.tmpl":  &bintree{templatePredicateTmpl, map[string]*bintree{}},
 		"privacy": &bintree{nil, map[string]*bintree{
 			"filter.tmpl":  &bintree{templatePrivacyFilterTmpl, map[string]*bintree{}},
 			"privacy.tmpl": &bintree{templatePrivacyPrivacyTmpl, map[string]*bintree{}},
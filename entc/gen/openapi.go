@@ -0,0 +1,453 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"entgo.io/ent/schema/field"
+)
+
+// Pagination defaults of the list operations of the REST API.
+const (
+	oasItemsPerPage    = 30
+	oasMaxItemsPerPage = 100
+)
+
+type (
+	// oasObject is a generic OpenAPI object. Maps are used instead of typed structs,
+	// because encoding/json sorts their keys, and keeps the document deterministic.
+	oasObject map[string]interface{}
+	// oasOp describes an operation of the REST API.
+	oasOp struct {
+		method, summary, id string
+		params              []interface{}
+		body                string
+		responses           oasObject
+	}
+)
+
+// OASNodes returns the types that are exposed by the REST API. Types
+// with composite identifiers are omitted, and are described only as
+// schemas of the edges that point to them.
+func (g *Graph) OASNodes() []*Type {
+	var nodes []*Type
+	for _, n := range g.Nodes {
+		if n.HasOneFieldID() {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
+// OASPath returns the path of the REST collection of the type.
+func (t Type) OASPath() string {
+	return snake(rules.Pluralize(t.Name))
+}
+
+// OASFilterFields returns the indexed fields that can be used for filtering the list operation of the
+// type. Unlike the other fields, they are passed in the query string, and therefore, should be parsed
+// from strings. Hence, only fields with a basic type (and without a custom Go type) are supported.
+func (t Type) OASFilterFields() []*Field {
+	indexed := make(map[string]bool)
+	for _, idx := range t.Indexes {
+		for _, c := range idx.Columns {
+			indexed[c] = true
+		}
+	}
+	var fields []*Field
+	for _, f := range t.Fields {
+		switch {
+		case f.Sensitive(), f.HasGoType(), f.IsJSON(), f.IsBytes(), f.IsUUID(), f.Type.Type == field.TypeOther:
+		case f.Unique || indexed[f.StorageKey()]:
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// OASCreateFields returns the fields that are set by the create operation of the type.
+// Edge-fields are omitted, because they are set by the edges that hold them.
+func (t Type) OASCreateFields() []*Field {
+	var fields []*Field
+	for _, f := range t.Fields {
+		if !f.IsEdgeField() && f.JSONName() != "-" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// OASUpdateFields returns the fields that are set by the update operation of the type.
+func (t Type) OASUpdateFields() []*Field {
+	var fields []*Field
+	for _, f := range t.OASCreateFields() {
+		if !f.Immutable {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// OASItemsPerPage returns the default number of items per page of the list operations.
+func (Graph) OASItemsPerPage() int { return oasItemsPerPage }
+
+// OASMaxItemsPerPage returns the maximum number of items per page of the list operations.
+func (Graph) OASMaxItemsPerPage() int { return oasMaxItemsPerPage }
+
+// OASImports returns the given packages with the packages of the custom
+// Go types that are decoded by the generated REST API handlers.
+func (g *Graph) OASImports(pkgs ...string) []string {
+	seen := make(map[string]bool)
+	for _, p := range pkgs {
+		seen[p] = true
+	}
+	for _, n := range g.OASNodes() {
+		for _, f := range append(n.OASCreateFields(), n.ID) {
+			if p := f.Type.PkgPath; p != "" && !seen[p] {
+				seen[p] = true
+				pkgs = append(pkgs, p)
+			}
+		}
+	}
+	sort.Strings(pkgs)
+	return pkgs
+}
+
+// JSONName returns the name of the field in the JSON representation of its type.
+func (f Field) JSONName() string {
+	return jsonName(f.StructTag, f.Name)
+}
+
+// JSONName returns the name of the edge in the JSON representation of its type.
+func (e Edge) JSONName() string {
+	return jsonName(e.StructTag, e.Name)
+}
+
+// OpenAPI returns the OpenAPI 3 document (in JSON format) that describes the REST API of the graph.
+func (g *Graph) OpenAPI() (string, error) {
+	var (
+		paths   = make(oasObject)
+		schemas = oasObject{
+			"Error": oasObject{
+				"type":     "object",
+				"required": []string{"code", "status", "message"},
+				"properties": oasObject{
+					"code":    oasObject{"type": "integer"},
+					"status":  oasObject{"type": "string"},
+					"message": oasObject{"type": "string"},
+				},
+			},
+		}
+	)
+	for _, n := range g.Nodes {
+		schemas[n.Name] = n.oasSchema()
+	}
+	for _, n := range g.OASNodes() {
+		var (
+			list = oasOp{
+				method:  http.MethodGet,
+				summary: fmt.Sprintf("List %s nodes", n.Name),
+				id:      "list" + n.Name,
+				params: []interface{}{
+					oasObject{"name": "page", "in": "query", "description": "The page number to return (starts at 1).", "schema": oasObject{"type": "integer", "minimum": 1}},
+					oasObject{"name": "itemsPerPage", "in": "query", "description": "The number of items per page.", "schema": oasObject{"type": "integer", "minimum": 1, "maximum": oasMaxItemsPerPage, "default": oasItemsPerPage}},
+				},
+				responses: oasObject{
+					"200": oasResponse(fmt.Sprintf("A page of %s nodes ordered by their IDs.", n.Name), oasObject{"type": "array", "items": oasRef(n.Name)}),
+					"400": oasError(),
+				},
+			}
+			read = oasOp{
+				method:  http.MethodGet,
+				summary: fmt.Sprintf("Get a %s node with its edges", n.Name),
+				id:      "read" + n.Name,
+				responses: oasObject{
+					"200": oasResponse(fmt.Sprintf("The %s node with its edges.", n.Name), oasRef(n.Name)),
+					"400": oasError(),
+					"404": oasError(),
+				},
+			}
+		)
+		for _, f := range n.OASFilterFields() {
+			list.params = append(list.params, oasObject{"name": f.JSONName(), "in": "query", "description": fmt.Sprintf("Filter by the %q field.", f.Name), "schema": n.oasFieldSchema(f)})
+		}
+		collection, item := []oasOp{list}, []oasOp{read}
+		if !n.IsView() {
+			collection = append(collection, oasOp{
+				method:  http.MethodPost,
+				summary: fmt.Sprintf("Create a %s node", n.Name),
+				id:      "create" + n.Name,
+				body:    n.Name + "Create",
+				responses: oasObject{
+					"201": oasResponse(fmt.Sprintf("The created %s node.", n.Name), oasRef(n.Name)),
+					"400": oasError(),
+					"409": oasError(),
+				},
+			})
+			item = append(item, oasOp{
+				method:  http.MethodPatch,
+				summary: fmt.Sprintf("Update a %s node", n.Name),
+				id:      "update" + n.Name,
+				body:    n.Name + "Update",
+				responses: oasObject{
+					"200": oasResponse(fmt.Sprintf("The updated %s node.", n.Name), oasRef(n.Name)),
+					"400": oasError(),
+					"404": oasError(),
+					"409": oasError(),
+				},
+			}, oasOp{
+				method:  http.MethodDelete,
+				summary: fmt.Sprintf("Delete a %s node", n.Name),
+				id:      "delete" + n.Name,
+				responses: oasObject{
+					"204": oasObject{"description": fmt.Sprintf("The %s node was deleted.", n.Name)},
+					"400": oasError(),
+					"404": oasError(),
+					"409": oasError(),
+				},
+			})
+			schemas[n.Name+"Create"] = n.oasBodySchema(n.OASCreateFields(), true)
+			schemas[n.Name+"Update"] = n.oasBodySchema(n.OASUpdateFields(), false)
+		}
+		paths["/"+n.OASPath()] = oasPath(collection, nil)
+		paths["/"+n.OASPath()+"/{id}"] = oasPath(item, []interface{}{
+			oasObject{"name": "id", "in": "path", "required": true, "description": fmt.Sprintf("The ID of the %s node.", n.Name), "schema": n.oasFieldSchema(n.ID)},
+		})
+	}
+	buf, err := json.MarshalIndent(oasObject{
+		"openapi": "3.0.3",
+		"info": oasObject{
+			"title":   "Ent Schema API",
+			"version": "0.1.0",
+		},
+		"paths":      paths,
+		"components": oasObject{"schemas": schemas},
+	}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshal openapi document: %w", err)
+	}
+	return string(buf), nil
+}
+
+// checkOpenAPI checks that the REST collections of the types do not conflict.
+func (g *Graph) checkOpenAPI() {
+	if !g.featureEnabled(FeatureOpenAPI) {
+		return
+	}
+	paths := make(map[string]string)
+	for _, n := range g.OASNodes() {
+		prev, ok := paths[n.OASPath()]
+		expect(!ok, "types %q and %q have the same REST path %q", prev, n.Name, "/"+n.OASPath())
+		paths[n.OASPath()] = n.Name
+	}
+}
+
+// oasSchema returns the schema of the JSON representation of the type. Sensitive
+// fields are omitted, because they are not encoded to JSON by the generated types.
+func (t Type) oasSchema() oasObject {
+	props := make(oasObject)
+	if t.HasOneFieldID() {
+		props[t.ID.JSONName()] = t.oasFieldSchema(t.ID)
+	}
+	for _, f := range t.Fields {
+		if name := f.JSONName(); name != "-" && !f.Sensitive() {
+			props[name] = t.oasFieldSchema(f)
+		}
+	}
+	if len(t.Edges) > 0 {
+		edges := make(oasObject)
+		for _, e := range t.Edges {
+			if name := e.JSONName(); name != "-" {
+				edges[name] = oasEdgeSchema(e, oasRef(e.Type.Name))
+			}
+		}
+		props["edges"] = oasObject{
+			"type":        "object",
+			"description": "The edges of the node. They are returned only by the read operation.",
+			"properties":  edges,
+		}
+	}
+	schema := oasObject{"type": "object", "properties": props}
+	if t.IsView() {
+		schema["readOnly"] = true
+	}
+	return schema
+}
+
+// oasBodySchema returns the schema of the request body of the create or the update operation of the type.
+func (t Type) oasBodySchema(fields []*Field, create bool) oasObject {
+	var (
+		required []string
+		props    = make(oasObject)
+	)
+	if create && t.ID.UserDefined {
+		props[t.ID.JSONName()] = t.oasFieldSchema(t.ID)
+	}
+	for _, f := range fields {
+		props[f.JSONName()] = t.oasFieldSchema(f)
+		if create && !f.Optional && !f.Default {
+			required = append(required, f.JSONName())
+		}
+	}
+	for _, e := range t.EdgesWithID() {
+		if e.Immutable() && !create {
+			continue
+		}
+		props[e.JSONName()] = oasEdgeSchema(e, e.Type.oasFieldSchema(e.Type.ID))
+		if create && !e.Optional {
+			required = append(required, e.JSONName())
+		}
+	}
+	schema := oasObject{"type": "object", "properties": props}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// oasFieldSchema returns the schema of the given field.
+func (t Type) oasFieldSchema(f *Field) oasObject {
+	var schema oasObject
+	switch f.Type.Type {
+	case field.TypeBool:
+		schema = oasObject{"type": "boolean"}
+	case field.TypeInt8, field.TypeInt16, field.TypeInt32, field.TypeUint8, field.TypeUint16, field.TypeUint32:
+		schema = oasObject{"type": "integer", "format": "int32"}
+	case field.TypeInt, field.TypeInt64, field.TypeUint, field.TypeUint64:
+		schema = oasObject{"type": "integer", "format": "int64"}
+	case field.TypeFloat32:
+		schema = oasObject{"type": "number", "format": "float"}
+	case field.TypeFloat64:
+		schema = oasObject{"type": "number", "format": "double"}
+	case field.TypeTime:
+		schema = oasObject{"type": "string", "format": "date-time"}
+	case field.TypeUUID:
+		schema = oasObject{"type": "string", "format": "uuid"}
+	case field.TypeBytes:
+		schema = oasObject{"type": "string", "format": "byte"}
+	case field.TypeString:
+		schema = oasObject{"type": "string"}
+	case field.TypeEnum:
+		schema = oasObject{"type": "string", "enum": f.EnumValues()}
+	default:
+		// JSON and other types are described
+		// by an empty (any value) schema.
+		schema = oasObject{}
+	}
+	if f.def != nil && f.def.Constraints != nil {
+		c := f.def.Constraints
+		if c.MinLen != nil {
+			schema["minLength"] = *c.MinLen
+		}
+		if c.MaxLen != nil {
+			schema["maxLength"] = *c.MaxLen
+		}
+		if c.Min != nil {
+			schema["minimum"] = *c.Min
+		}
+		if c.Max != nil {
+			schema["maximum"] = *c.Max
+		}
+		switch len(c.Patterns) {
+		case 0:
+		case 1:
+			schema["pattern"] = c.Patterns[0]
+		default:
+			all := make([]interface{}, len(c.Patterns))
+			for i, p := range c.Patterns {
+				all[i] = oasObject{"pattern": p}
+			}
+			schema["allOf"] = all
+		}
+	}
+	if f.Nillable {
+		schema["nullable"] = true
+	}
+	if f.Comment() != "" {
+		schema["description"] = f.Comment()
+	}
+	return schema
+}
+
+// oasEdgeSchema returns the schema of an edge, where its items are described by the given schema.
+func oasEdgeSchema(e *Edge, items oasObject) oasObject {
+	if e.Unique {
+		return items
+	}
+	return oasObject{"type": "array", "items": items}
+}
+
+// oasPath returns the path item object of the given operations.
+func oasPath(ops []oasOp, params []interface{}) oasObject {
+	path := make(oasObject)
+	if len(params) > 0 {
+		path["parameters"] = params
+	}
+	for _, op := range ops {
+		o := oasObject{
+			"summary":     op.summary,
+			"operationId": op.id,
+			"responses":   op.responses,
+		}
+		if len(op.params) > 0 {
+			o["parameters"] = op.params
+		}
+		if op.body != "" {
+			o["requestBody"] = oasObject{
+				"required": true,
+				"content":  oasObject{"application/json": oasObject{"schema": oasRef(op.body)}},
+			}
+		}
+		path[strings.ToLower(op.method)] = o
+	}
+	return path
+}
+
+// oasResponse returns a JSON response object with the given schema.
+func oasResponse(desc string, schema oasObject) oasObject {
+	return oasObject{
+		"description": desc,
+		"content":     oasObject{"application/json": oasObject{"schema": schema}},
+	}
+}
+
+// oasError returns the response object of failed operations.
+func oasError() oasObject {
+	return oasResponse("The operation failed.", oasRef("Error"))
+}
+
+// oasRef returns a reference to the schema with the given name.
+func oasRef(name string) oasObject {
+	return oasObject{"$ref": "#/components/schemas/" + name}
+}
+
+// jsonName returns the name of the "json" struct tag, or the default name if it is not set.
+func jsonName(tag, def string) string {
+	if name := strings.Split(reflect.StructTag(tag).Get("json"), ",")[0]; name != "" {
+		return name
+	}
+	return def
+}
+
+// oasFiles holds the files that are generated by the openapi feature.
+var oasFiles = []string{"openapi.json", "http_handler.go"}
+
+// oasCleanup removes the files that were generated by the openapi feature.
+func oasCleanup(c *Config) error {
+	for _, name := range oasFiles {
+		if err := os.Remove(filepath.Join(c.Target, name)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("remove %s: %w", name, err)
+		}
+	}
+	return nil
+}
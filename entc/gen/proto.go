@@ -0,0 +1,379 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"entgo.io/ent/entproto"
+	"entgo.io/ent/schema/field"
+)
+
+// ProtoPackage is the name of the package (protobuf and Go) that
+// holds the protobuf definitions and the gRPC services.
+const ProtoPackage = "entpb"
+
+// EntProto returns the EntProto annotation of the type.
+// An empty annotation is returned if it does not exist.
+func (t Type) EntProto() *entproto.Annotation {
+	return entprotoAnnotate(t.Annotations)
+}
+
+// EntProto returns the EntProto annotation of the field.
+// An empty annotation is returned if it does not exist.
+func (f Field) EntProto() *entproto.Annotation {
+	return entprotoAnnotate(f.Annotations)
+}
+
+// EntProto returns the EntProto annotation of the edge.
+// An empty annotation is returned if it does not exist.
+func (e Edge) EntProto() *entproto.Annotation {
+	return entprotoAnnotate(e.Annotations)
+}
+
+// ProtoMessages returns the types that are generated as protobuf messages.
+func (g *Graph) ProtoMessages() []*Type {
+	var nodes []*Type
+	for _, n := range g.Nodes {
+		if n.IsProtoMessage() {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
+// ProtoServices returns the types that are generated as gRPC services.
+func (g *Graph) ProtoServices() []*Type {
+	var nodes []*Type
+	for _, n := range g.Nodes {
+		if n.EntProto().Service {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
+// ProtoGoPackage returns the Go import path of the protobuf package.
+func (g *Graph) ProtoGoPackage() string {
+	return g.Config.Package + "/proto/" + ProtoPackage
+}
+
+// ProtoImports returns the well-known protobuf types that are imported by the generated messages.
+func (g *Graph) ProtoImports() []string {
+	var hasEmpty, hasTime bool
+	for _, n := range g.ProtoMessages() {
+		hasEmpty = hasEmpty || n.EntProto().Service
+		for _, f := range n.ProtoFields() {
+			hasTime = hasTime || f.IsTime()
+		}
+	}
+	var imports []string
+	if hasEmpty {
+		imports = append(imports, "google/protobuf/empty.proto")
+	}
+	if hasTime {
+		imports = append(imports, "google/protobuf/timestamp.proto")
+	}
+	return imports
+}
+
+// IsProtoMessage reports if the type is generated as a protobuf message.
+func (t Type) IsProtoMessage() bool {
+	ant := t.EntProto()
+	return ant.Message || ant.Service
+}
+
+// ProtoName returns the name of the protobuf message of the type.
+func (t Type) ProtoName() string {
+	if name := t.EntProto().MessageName; name != "" {
+		return name
+	}
+	return t.Name
+}
+
+// ProtoFields returns the fields of the type that are generated as protobuf fields. Edge-fields
+// are omitted from the message, because they are represented by the fields of their edges.
+func (t Type) ProtoFields() []*Field {
+	var fields []*Field
+	for _, f := range t.Fields {
+		if !f.EntProto().Skip && !f.IsEdgeField() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// ProtoEdges returns the edges of the type that are generated as protobuf fields.
+func (t Type) ProtoEdges() []*Edge {
+	var edges []*Edge
+	for _, e := range t.Edges {
+		if !e.EntProto().Skip {
+			edges = append(edges, e)
+		}
+	}
+	return edges
+}
+
+// ProtoType returns the protobuf type of the given field, or an empty
+// string if the field type does not have a protobuf representation.
+func (t Type) ProtoType(f *Field) string {
+	switch {
+	case f.HasGoType():
+		return ""
+	case f.IsTime():
+		return "google.protobuf.Timestamp"
+	case f.IsEnum():
+		return pascal(f.Name)
+	case f.IsBytes():
+		return "bytes"
+	default:
+		return protoScalars[f.Type.Type][0]
+	}
+}
+
+// ProtoOptional reports if the field is generated as an optional protobuf field (with an explicit
+// presence). Nillable fields that are represented as messages or bytes have an implicit presence.
+func (t Type) ProtoOptional(f *Field) bool {
+	return f.Nillable && !f.IsTime() && !f.IsBytes()
+}
+
+// ProtoPresence reports if the presence of the given field can be detected in the protobuf message.
+// Enum fields are detected by their unspecified (zero) value.
+func (t Type) ProtoPresence(f *Field) bool {
+	return t.ProtoOptional(f) || f.IsTime() || f.IsBytes() || f.IsEnum()
+}
+
+// ProtoHasValue returns the Go condition that checks if the given
+// expression of the protobuf field holds a (non-zero) value.
+func (t Type) ProtoHasValue(f *Field, expr string) string {
+	switch {
+	case t.ProtoOptional(f), f.IsTime(), f.IsBytes():
+		return expr + " != nil"
+	case f.IsBool():
+		return expr
+	case f.IsString():
+		return expr + ` != ""`
+	default:
+		return expr + " != 0"
+	}
+}
+
+// ProtoGoName returns the Go name of the protobuf field that is generated for the given name.
+func (Type) ProtoGoName(name string) string {
+	return protoGoName(name)
+}
+
+// ProtoEnumValue describes a protobuf enum value that is generated for an enum field.
+type ProtoEnumValue struct {
+	Enum
+	// Proto is the name of the protobuf enum value.
+	Proto string
+	// Number is the number of the protobuf enum value.
+	Number int32
+}
+
+// ProtoEnumValues returns the protobuf enum values of the given enum field.
+func (t Type) ProtoEnumValues(f *Field) []ProtoEnumValue {
+	numbers := f.EntProto().Enum
+	values := make([]ProtoEnumValue, len(f.Enums))
+	for i, e := range f.Enums {
+		values[i] = ProtoEnumValue{Enum: e, Proto: protoEnumPrefix(f) + protoEnumName(e.Value), Number: numbers[e.Value]}
+	}
+	return values
+}
+
+// ProtoFromEnt returns the Go expression that converts the given
+// expression of the ent field to its protobuf representation.
+func (t Type) ProtoFromEnt(f *Field, expr string) string {
+	switch {
+	case f.IsTime():
+		return fmt.Sprintf("timestamppb.New(%s)", expr)
+	case f.IsEnum():
+		return fmt.Sprintf("toProto%s%s(%s)", protoGoName(t.ProtoName()), pascal(f.Name), expr)
+	case f.IsBytes(), f.Type.String() == protoScalars[f.Type.Type][1]:
+		return expr
+	default:
+		return fmt.Sprintf("%s(%s)", protoScalars[f.Type.Type][1], expr)
+	}
+}
+
+// ProtoToEnt returns the Go expression that converts the given expression
+// of the protobuf field (a non-pointer value) to its ent representation.
+func (t Type) ProtoToEnt(f *Field, expr string) string {
+	switch {
+	case f.IsTime():
+		return fmt.Sprintf("%s.AsTime()", expr)
+	case f.IsEnum():
+		return fmt.Sprintf("toEnt%s%s(%s)", protoGoName(t.ProtoName()), pascal(f.Name), expr)
+	case f.IsBytes(), f.Type.String() == protoScalars[f.Type.Type][1]:
+		return expr
+	default:
+		return fmt.Sprintf("%s(%s)", f.Type, expr)
+	}
+}
+
+// protoScalars maps field types to their protobuf types and the Go types that are generated for them.
+var protoScalars = map[field.Type][2]string{
+	field.TypeBool:    {"bool", "bool"},
+	field.TypeString:  {"string", "string"},
+	field.TypeInt:     {"int64", "int64"},
+	field.TypeInt8:    {"int32", "int32"},
+	field.TypeInt16:   {"int32", "int32"},
+	field.TypeInt32:   {"int32", "int32"},
+	field.TypeInt64:   {"int64", "int64"},
+	field.TypeUint:    {"uint64", "uint64"},
+	field.TypeUint8:   {"uint32", "uint32"},
+	field.TypeUint16:  {"uint32", "uint32"},
+	field.TypeUint32:  {"uint32", "uint32"},
+	field.TypeUint64:  {"uint64", "uint64"},
+	field.TypeFloat32: {"float", "float32"},
+	field.TypeFloat64: {"double", "float64"},
+}
+
+// Field numbers that are valid in protobuf messages. Note that
+// the number of the ID field (1) is reserved for the identifier.
+const (
+	protoMinField      = 2
+	protoMaxField      = 1<<29 - 1
+	protoReservedStart = 19000
+	protoReservedEnd   = 19999
+)
+
+// protoIdent matches a valid protobuf identifier.
+var protoIdent = regexp.MustCompile(`^[A-Za-z][0-9A-Za-z_]*$`)
+
+// checkProto checks that the protobuf messages can be generated with stable field numbers.
+func (g *Graph) checkProto() {
+	if !g.featureEnabled(FeatureGRPC) {
+		return
+	}
+	names := make(map[string]string)
+	for _, n := range g.ProtoMessages() {
+		name := n.ProtoName()
+		expect(protoIdent.MatchString(name), "invalid protobuf message name %q for type %q", name, n.Name)
+		prev, ok := names[name]
+		expect(!ok, "types %q and %q are generated as the same protobuf message %q", prev, n.Name, name)
+		names[name] = n.Name
+		expect(n.HasOneFieldID(), "type %q with a composite identifier is not supported by the %s feature", n.Name, FeatureGRPC.Name)
+		expect(n.ProtoType(n.ID) != "" && !n.ID.IsBytes() && !n.ID.IsTime(), "type %q: unsupported ID type %s for protobuf messages", n.Name, n.ID.Type)
+		expect(!n.EntProto().Service || !n.IsView(), "view %q cannot have a gRPC service, as it cannot be mutated", n.Name)
+		numbers := make(map[int]string)
+		number := func(num int, name string) {
+			expect(num != 0, "%s.%s is missing a protobuf field number. Set it using entproto.Field, or skip it using entproto.Skip", n.Name, name)
+			expect(num >= protoMinField && num <= protoMaxField && (num < protoReservedStart || num > protoReservedEnd),
+				"invalid protobuf field number %d for %s.%s (1 is reserved for the ID field)", num, n.Name, name)
+			prev, ok := numbers[num]
+			expect(!ok, "%s.%s and %s.%s have the same protobuf field number %d", n.Name, prev, n.Name, name, num)
+			numbers[num] = name
+		}
+		for _, f := range n.ProtoFields() {
+			number(f.EntProto().Field, f.Name)
+			expect(n.ProtoType(f) != "", "%s.%s: unsupported field type %s for protobuf messages. Skip it using entproto.Skip", n.Name, f.Name, f.Type)
+			if f.IsEnum() {
+				checkProtoEnum(n, f)
+			}
+		}
+		for _, e := range n.ProtoEdges() {
+			number(e.EntProto().Field, e.Name)
+			expect(e.Type.IsProtoMessage(), "%s.%s: edge type %q is not a protobuf message. Skip the edge using entproto.Skip", n.Name, e.Name, e.Type.Name)
+		}
+	}
+}
+
+// checkProtoEnum checks that all values of the enum field have stable protobuf numbers.
+func checkProtoEnum(t *Type, f *Field) {
+	numbers := f.EntProto().Enum
+	expect(len(numbers) == len(f.Enums), "%s.%s: the protobuf numbers (entproto.Enum) of the enum values must be set for all values", t.Name, f.Name)
+	seen := make(map[int32]string)
+	names := make(map[string]string)
+	for _, v := range t.ProtoEnumValues(f) {
+		num, ok := numbers[v.Value]
+		expect(ok, "%s.%s: missing protobuf number for enum value %q", t.Name, f.Name, v.Value)
+		expect(num > 0, "%s.%s: invalid protobuf number %d for enum value %q (0 is reserved for the unspecified value)", t.Name, f.Name, num, v.Value)
+		prev, ok := seen[num]
+		expect(!ok, "%s.%s: enum values %q and %q have the same protobuf number %d", t.Name, f.Name, prev, v.Value, num)
+		seen[num] = v.Value
+		expect(protoIdent.MatchString(v.Proto), "%s.%s: invalid protobuf enum value name %q", t.Name, f.Name, v.Proto)
+		prev, ok = names[v.Proto]
+		expect(!ok, "%s.%s: enum values %q and %q have the same protobuf name %q", t.Name, f.Name, prev, v.Value, v.Proto)
+		names[v.Proto] = v.Value
+	}
+}
+
+// protoEnumPrefix returns the prefix of the protobuf enum values of the enum field.
+func protoEnumPrefix(f *Field) string {
+	return strings.ToUpper(snake(f.Name)) + "_"
+}
+
+// protoEnumName returns the name of the protobuf enum value that is generated for the given value.
+func protoEnumName(v string) string {
+	return strings.ToUpper(reProtoEnum.ReplaceAllString(snake(v), "_"))
+}
+
+var reProtoEnum = regexp.MustCompile(`[^0-9A-Za-z]+`)
+
+// protoGoName returns the Go name of a protobuf identifier,
+// as it is generated by the protobuf compiler (protoc-gen-go).
+func protoGoName(s string) string {
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '_' && i == 0:
+			b = append(b, 'X')
+		case c == '_' && i+1 < len(s) && isASCIILower(s[i+1]):
+			// Skip over the underscore, and capitalize the next letter.
+		case isASCIIDigit(c):
+			b = append(b, c)
+		default:
+			if isASCIILower(c) {
+				c -= 'a' - 'A'
+			}
+			b = append(b, c)
+			for ; i+1 < len(s) && isASCIILower(s[i+1]); i++ {
+				b = append(b, s[i+1])
+			}
+		}
+	}
+	return string(b)
+}
+
+func isASCIILower(c byte) bool { return 'a' <= c && c <= 'z' }
+func isASCIIDigit(c byte) bool { return '0' <= c && c <= '9' }
+
+// entprotoAnnotate extracts the entproto annotation from a loaded annotation format.
+func entprotoAnnotate(annotation map[string]interface{}) *entproto.Annotation {
+	annotate := &entproto.Annotation{}
+	if annotation == nil || annotation[annotate.Name()] == nil {
+		return annotate
+	}
+	if buf, err := json.Marshal(annotation[annotate.Name()]); err == nil {
+		_ = json.Unmarshal(buf, &annotate)
+	}
+	return annotate
+}
+
+// protoFiles holds the files that are generated by the grpc feature.
+var protoFiles = []string{ProtoPackage + ".proto", "generate.go", ProtoPackage + "_service.go"}
+
+// protoCleanup removes the files that were generated by the grpc feature.
+func protoCleanup(c *Config) error {
+	dir := filepath.Join(c.Target, "proto")
+	for _, name := range protoFiles {
+		if err := remove(filepath.Join(dir, ProtoPackage), name); err != nil {
+			return fmt.Errorf("remove %s: %w", name, err)
+		}
+	}
+	if infos, err := ioutil.ReadDir(dir); err == nil && len(infos) == 0 {
+		return os.Remove(dir)
+	}
+	return nil
+}
@@ -26,6 +26,7 @@ type (
 	// each Type object of the graph.
 	TypeTemplate struct {
 		Name           string             // template name.
+		Skip           func(*Type) bool   // skip condition (e.g. mutation builders of views).
 		Format         func(*Type) string // file name format.
 		ExtendPatterns []string           // extend patterns.
 	}
@@ -44,14 +45,17 @@ var (
 	Templates = []TypeTemplate{
 		{
 			Name:   "create",
+			Skip:   isView,
 			Format: pkgf("%s_create.go"),
 		},
 		{
 			Name:   "update",
+			Skip:   isView,
 			Format: pkgf("%s_update.go"),
 		},
 		{
 			Name:   "delete",
+			Skip:   isView,
 			Format: pkgf("%s_delete.go"),
 		},
 		{
@@ -290,6 +294,10 @@ func pkgf(s string) func(t *Type) string {
 	return func(t *Type) string { return fmt.Sprintf(s, t.Package()) }
 }
 
+// isView reports if the given type is a view. Views are read-only,
+// and their mutation builders are not generated.
+func isView(t *Type) bool { return t.IsView() }
+
 // match reports if the given name matches the extended pattern.
 func match(patterns []string, name string) bool {
 	for _, pat := range patterns {
@@ -23,6 +23,7 @@ import (
 	"entgo.io/ent/entc/load"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
 )
 
 // The following types and their exported methods used by the codegen
@@ -37,6 +38,10 @@ type (
 		Name string
 		// ID holds the ID field of this type.
 		ID *Field
+		// CompositeID holds the fields of the type identifier in case it was
+		// defined as a composite identifier (multi-column primary key) using
+		// the field.ID annotation. In this case, the ID field is nil.
+		CompositeID []*Field
 		// Fields holds all the primitive fields of this type.
 		Fields []*Field
 		fields map[string]*Field
@@ -50,6 +55,23 @@ type (
 		// Annotations that were defined for the field in the schema.
 		// The mapping is from the Annotation.Name() to a JSON decoded object.
 		Annotations map[string]interface{}
+		// EdgeSchema holds the edges of the type in case it is used as an
+		// edge schema (join table) of an M2M edge. From points to the owner
+		// of the M2M edge, and To points to the edge target.
+		EdgeSchema struct {
+			To, From *Edge
+		}
+		// SoftDelete holds the field that stores the deletion time of the
+		// type entities, in case it was defined as a soft-deleted type using
+		// the mixin.SoftDelete mixin (or its annotation). Otherwise, it is nil.
+		SoftDelete *Field
+		// History holds the companion type that records the history of the
+		// type entities, in case it was annotated with the enthistory annotation
+		// and the history feature is enabled. Otherwise, it is nil.
+		History *Type
+		// derived indicates if the type was not loaded from the schema,
+		// but derived from the loaded types by a feature (e.g. history).
+		derived bool
 	}
 
 	// Field holds the information of a type field used for the templates.
@@ -122,6 +144,13 @@ type (
 		//	edge.To("spouse", User.Type).Unique()   // one 2 one.
 		//
 		Bidi bool
+		// Through holds the edge schema type of M2M edges that were
+		// defined with the Through option. For example:
+		//
+		//	edge.To("groups", Group.Type).
+		//		Through("memberships", Membership.Type)
+		//
+		Through *Type
 		// Annotations that were defined for the edge in the schema.
 		// The mapping is from the Annotation.Name() to a JSON decoded object.
 		Annotations map[string]interface{}
@@ -237,9 +266,135 @@ func NewType(c *Config, schema *load.Schema) (*Type, error) {
 			typ.fields[f.Name] = tf
 		}
 	}
+	if ant := fieldAnnotate(schema.Annotations); ant != nil && len(ant.ID) > 0 {
+		if err := typ.setupCompositeID(ant.ID); err != nil {
+			return nil, err
+		}
+	}
+	if ant := entsqlAnnotate(schema.Annotations); ant != nil {
+		for _, c := range ant.Checks {
+			if c.Name == "" {
+				return nil, fmt.Errorf("check %q of type %q must have a name", c.Expr, typ.Name)
+			}
+		}
+	}
+	if ant := softDeleteAnnotate(schema.Annotations); ant != nil {
+		if err := typ.setupSoftDelete(ant.Field); err != nil {
+			return nil, err
+		}
+	}
+	if typ.IsView() {
+		if err := typ.checkView(); err != nil {
+			return nil, err
+		}
+	}
 	return typ, nil
 }
 
+// checkView checks that the type can be generated as a read-only view.
+func (t *Type) checkView() error {
+	switch {
+	case t.Storage != nil && t.Storage.Name != "sql":
+		return fmt.Errorf("views are supported only by the SQL storage: %s", t.Name)
+	case t.NumHooks() > 0 || t.NumPolicy() > 0:
+		return fmt.Errorf("view %q cannot have hooks or privacy policies, as it cannot be mutated", t.Name)
+	case t.SoftDelete != nil:
+		return fmt.Errorf("view %q cannot be soft-deleted", t.Name)
+	}
+	return nil
+}
+
+// setupSoftDelete sets the field that holds the deletion time of soft-deleted types.
+func (t *Type) setupSoftDelete(name string) error {
+	f, ok := t.fields[name]
+	if !ok {
+		return fmt.Errorf("soft-delete field %q was not found in %q", name, t.Name)
+	}
+	if !f.IsTime() || !f.Optional || !f.Nillable || f.Immutable {
+		return fmt.Errorf("soft-delete field %q of %q must be an optional, nillable and mutable time field", name, t.Name)
+	}
+	t.SoftDelete = f
+	return nil
+}
+
+// setupCompositeID sets the fields of the composite identifier of the type.
+func (t *Type) setupCompositeID(names []string) error {
+	if t.Storage != nil && t.Storage.Name != "sql" {
+		return fmt.Errorf("composite identifiers are supported only by the SQL storage: %s", t.Name)
+	}
+	if len(names) < 2 {
+		return fmt.Errorf("composite identifier of %q must contain at least 2 fields", t.Name)
+	}
+	// Features that operate on the node identifiers
+	// do not support composite identifiers.
+	for _, f := range []Feature{FeatureEntQL, FeaturePrivacy} {
+		if t.featureEnabled(f) {
+			return fmt.Errorf("type %q with a composite identifier is not supported by the %s feature", t.Name, f.Name)
+		}
+	}
+	if t.ID.UserDefined {
+		return fmt.Errorf("type %q with a composite identifier can't have an id field", t.Name)
+	}
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		f, ok := t.fields[name]
+		if !ok {
+			return fmt.Errorf("field %q of the composite identifier was not found in %q", name, t.Name)
+		}
+		if _, ok := seen[name]; ok {
+			return fmt.Errorf("field %q was defined twice in the composite identifier of %q", name, t.Name)
+		}
+		if f.Optional || f.Nillable {
+			return fmt.Errorf("field %q of the composite identifier of %q can't be optional or nillable", name, t.Name)
+		}
+		seen[name] = struct{}{}
+		// Identifier fields can't be modified after creation.
+		f.Immutable = true
+		t.CompositeID = append(t.CompositeID, f)
+	}
+	t.ID = nil
+	return nil
+}
+
+// HasCompositeID reports if the type identifier is composed from multiple fields.
+func (t Type) HasCompositeID() bool {
+	return t.ID == nil && len(t.CompositeID) > 1
+}
+
+// HasOneFieldID reports if the type identifier is a single field (the common case).
+func (t Type) HasOneFieldID() bool {
+	return t.ID != nil
+}
+
+// EdgesWithID returns all edges that point to types with a single-field
+// identifier. Edges to types with composite identifiers are excluded from
+// the mutation APIs, as they are modified from their edge-field side.
+func (t Type) EdgesWithID() (edges []*Edge) {
+	for _, e := range t.Edges {
+		if e.Type.HasOneFieldID() {
+			edges = append(edges, e)
+		}
+	}
+	return
+}
+
+// MutableEdges returns all edges that can be modified by the update builders.
+// Edges that are held by the composite identifier of the type are excluded.
+func (t Type) MutableEdges() (edges []*Edge) {
+Edges:
+	for _, e := range t.EdgesWithID() {
+		if fk, err := e.ForeignKey(); err == nil && fk.UserDefined {
+			for _, f := range t.CompositeID {
+				if f == fk.Field {
+					continue Edges
+				}
+			}
+		}
+		edges = append(edges, e)
+	}
+	return
+}
+
 // Label returns Gremlin label name of the node/type.
 func (t Type) Label() string {
 	return snake(t.Name)
@@ -261,6 +416,38 @@ func (t Type) EntSQL() *entsql.Annotation {
 	return entsqlAnnotate(t.Annotations)
 }
 
+// IsView reports if the type is defined as a read-only SQL view.
+// Only query builders are generated for views.
+func (t Type) IsView() bool {
+	ant := t.EntSQL()
+	return ant != nil && ant.View != nil
+}
+
+// checks returns the CHECK constraints of the type table, defined on the
+// type and its fields. Unnamed field checks are named by their column.
+func (t Type) checks() []*schema.Check {
+	var checks []*schema.Check
+	if ant := t.EntSQL(); ant != nil {
+		for _, c := range ant.Checks {
+			checks = append(checks, &schema.Check{Name: c.Name, Expr: c.Expr, Exprs: c.Exprs})
+		}
+	}
+	for _, f := range t.Fields {
+		ant := f.EntSQL()
+		if ant == nil {
+			continue
+		}
+		for _, c := range ant.Checks {
+			name := c.Name
+			if name == "" {
+				name = fmt.Sprintf("%s_%s_check", t.Table(), f.StorageKey())
+			}
+			checks = append(checks, &schema.Check{Name: name, Expr: c.Expr, Exprs: c.Exprs})
+		}
+	}
+	return checks
+}
+
 // Package returns the package name of this node.
 func (t Type) Package() string {
 	return strings.ToLower(t.Name)
@@ -272,6 +459,29 @@ func (t Type) Receiver() string {
 	return receiver(t.Name)
 }
 
+// edge returns the edge of this type with the given name.
+func (t Type) edge(name string) (*Edge, bool) {
+	for _, e := range t.Edges {
+		if name == e.Name {
+			return e, true
+		}
+	}
+	return nil, false
+}
+
+// compositeIDOf reports if the composite identifier of this type is composed of the given fields.
+func (t Type) compositeIDOf(names []string) bool {
+	if len(t.CompositeID) != len(names) {
+		return false
+	}
+	for i, f := range t.CompositeID {
+		if f.Name != names[i] {
+			return false
+		}
+	}
+	return true
+}
+
 // HasAssoc returns true if this type has an assoc-edge (edge.To)
 // with the given name. faster than map access for most cases.
 func (t Type) HasAssoc(name string) (*Edge, bool) {
@@ -286,7 +496,7 @@ func (t Type) HasAssoc(name string) (*Edge, bool) {
 // HasValidators reports if any of the type's field has validators.
 func (t Type) HasValidators() bool {
 	fields := t.Fields
-	if t.ID.UserDefined {
+	if t.HasOneFieldID() && t.ID.UserDefined {
 		fields = append(fields, t.ID)
 	}
 	for _, f := range fields {
@@ -300,7 +510,7 @@ func (t Type) HasValidators() bool {
 // HasDefault reports if any of this type's fields has default value on creation.
 func (t Type) HasDefault() bool {
 	fields := t.Fields
-	if t.ID.UserDefined {
+	if t.HasOneFieldID() && t.ID.UserDefined {
 		fields = append(fields, t.ID)
 	}
 	for _, f := range fields {
@@ -367,16 +577,16 @@ func (t Type) FKEdges() (edges []*Edge) {
 }
 
 // RuntimeMixin returns schema mixin that needs to be loaded at
-// runtime. For example, for default values, validators or hooks.
+// runtime. For example, for default values, validators, hooks or interceptors.
 func (t Type) RuntimeMixin() bool {
-	return len(t.MixedInFields()) > 0 || len(t.MixedInHooks()) > 0 || len(t.MixedInPolicies()) > 0
+	return len(t.MixedInFields()) > 0 || len(t.MixedInHooks()) > 0 || len(t.MixedInInterceptors()) > 0 || len(t.MixedInPolicies()) > 0
 }
 
 // MixedInFields returns the indices of mixin holds runtime code.
 func (t Type) MixedInFields() []int {
 	idx := make(map[int]struct{})
 	fields := t.Fields
-	if t.ID.UserDefined {
+	if t.HasOneFieldID() && t.ID.UserDefined {
 		fields = append(fields, t.ID)
 	}
 	for _, f := range fields {
@@ -401,6 +611,20 @@ func (t Type) MixedInHooks() []int {
 	return sortedKeys(idx)
 }
 
+// MixedInInterceptors returns the indices of mixin with interceptors.
+func (t Type) MixedInInterceptors() []int {
+	if t.schema == nil {
+		return nil
+	}
+	idx := make(map[int]struct{})
+	for _, i := range t.schema.Interceptors {
+		if i.MixedIn {
+			idx[i.MixinIndex] = struct{}{}
+		}
+	}
+	return sortedKeys(idx)
+}
+
 // MixedInPolicies returns the indices of mixin with policies.
 func (t Type) MixedInPolicies() []int {
 	if t.schema == nil {
@@ -477,7 +701,7 @@ func (t Type) EnumFields() []*Field {
 
 // FieldBy returns the first field that the given function returns true on it.
 func (t Type) FieldBy(fn func(*Field) bool) (*Field, bool) {
-	if fn(t.ID) {
+	if t.HasOneFieldID() && fn(t.ID) {
 		return t.ID, true
 	}
 	for _, f := range t.Fields {
@@ -531,7 +755,7 @@ func (t *Type) AddIndex(idx *load.Index) error {
 	}
 	for _, name := range idx.Fields {
 		var f *Field
-		if name == t.ID.Name {
+		if t.HasOneFieldID() && name == t.ID.Name {
 			f = t.ID
 		} else {
 			var ok bool
@@ -584,6 +808,16 @@ func (t *Type) setupFKs() error {
 		if ef := e.def.Field; ef != "" && !e.OwnFK() {
 			return fmt.Errorf("edge %q has a field %q but it is not holding a foreign key", e.Name, ef)
 		}
+		// Types with composite identifiers can be referenced only by the foreign-keys
+		// they hold, because their identifiers can't be stored in a single column.
+		switch {
+		case t.HasCompositeID() && (!e.OwnFK() || e.def.Field == ""):
+			return fmt.Errorf("edge %q of type %q with a composite identifier must hold a foreign-key field", e.Name, t.Name)
+		case t.HasCompositeID() && t.fields[e.def.Field] != nil && t.fields[e.def.Field].Nillable:
+			return fmt.Errorf("edge field %q of type %q with a composite identifier can't be nillable", e.def.Field, t.Name)
+		case e.Type.HasCompositeID() && (e.OwnFK() || e.M2M()):
+			return fmt.Errorf("edge %q can't hold a foreign-key to type %q with a composite identifier", e.Name, e.Type.Name)
+		}
 		if e.IsInverse() || e.M2M() {
 			continue
 		}
@@ -669,6 +903,37 @@ func (t *Type) addFK(fk *ForeignKey) {
 	t.ForeignKeys = append(t.ForeignKeys, fk)
 }
 
+// IsEdgeSchema indicates if the type is used as an edge schema (join table) of an M2M edge.
+func (t Type) IsEdgeSchema() bool {
+	return t.EdgeSchema.To != nil && t.EdgeSchema.From != nil
+}
+
+// edgeSchemaEdges returns the edges of an edge schema that point to the owner
+// and the target of its M2M edge. In case both types are the same, the first
+// edge that was defined in the schema is the one that points to the owner.
+func (t Type) edgeSchemaEdges(owner, target *Type) (from, to *Edge, err error) {
+	for _, e := range t.Edges {
+		if e.IsInverse() || !e.Unique || e.def.Field == "" {
+			continue
+		}
+		switch {
+		case from == nil && e.Type == owner:
+			from = e
+		case to == nil && e.Type == target:
+			to = e
+		}
+	}
+	if from == nil || to == nil {
+		return nil, nil, fmt.Errorf("edge schema %q must have 2 unique edges with fields to %q and %q", t.Name, owner.Name, target.Name)
+	}
+	for _, e := range []*Edge{from, to} {
+		if e.Optional {
+			return nil, nil, fmt.Errorf("edge %q of edge schema %q must be required", e.Name, t.Name)
+		}
+	}
+	return from, to, nil
+}
+
 // QueryName returns the struct name denoting the query-builder for this type.
 func (t Type) QueryName() string {
 	return pascal(t.Name) + "Query"
@@ -746,6 +1011,22 @@ func (t Type) HookPositions() []*load.Position {
 	return nil
 }
 
+// NumInterceptors returns the number of interceptors declared in the type schema.
+func (t Type) NumInterceptors() int {
+	if t.schema != nil {
+		return len(t.schema.Interceptors)
+	}
+	return 0
+}
+
+// InterceptorPositions returns the position information of interceptors declared in the type schema.
+func (t Type) InterceptorPositions() []*load.Position {
+	if t.schema != nil {
+		return t.schema.Interceptors
+	}
+	return nil
+}
+
 // NumPolicy returns the number of privacy-policy declared in the type schema.
 func (t Type) NumPolicy() int {
 	if t.schema != nil {
@@ -1074,6 +1355,9 @@ func (f Field) Column() *schema.Column {
 	if f.def != nil {
 		c.SchemaType = f.def.SchemaType
 	}
+	if ant := f.EntSQL(); ant != nil {
+		c.RenamedFrom = ant.RenamedFrom
+	}
 	return c
 }
 
@@ -1343,6 +1627,12 @@ func (e Edge) Field() *Field {
 	return nil
 }
 
+// Immutable reports if the edge cannot be changed after creation, because its edge-field is immutable.
+func (e Edge) Immutable() bool {
+	f := e.Field()
+	return f != nil && f.Immutable
+}
+
 // HasFieldSetter reports if this edge already has a field-edge setters for its mutation API.
 // It's used by the codegen templates to avoid generating duplicate setters for id APIs (e.g. SetOwnerID).
 func (e Edge) HasFieldSetter() bool {
@@ -1506,6 +1796,30 @@ func builderField(name string) string {
 	return name
 }
 
+// fieldAnnotate extracts the field annotation from a loaded annotation format.
+func fieldAnnotate(annotation map[string]interface{}) *field.Annotation {
+	annotate := &field.Annotation{}
+	if annotation == nil || annotation[annotate.Name()] == nil {
+		return nil
+	}
+	if buf, err := json.Marshal(annotation[annotate.Name()]); err == nil {
+		_ = json.Unmarshal(buf, &annotate)
+	}
+	return annotate
+}
+
+// softDeleteAnnotate extracts the soft-delete annotation from a loaded annotation format.
+func softDeleteAnnotate(annotation map[string]interface{}) *mixin.SoftDeleteAnnotation {
+	annotate := &mixin.SoftDeleteAnnotation{}
+	if annotation == nil || annotation[annotate.Name()] == nil {
+		return nil
+	}
+	if buf, err := json.Marshal(annotation[annotate.Name()]); err == nil {
+		_ = json.Unmarshal(buf, &annotate)
+	}
+	return annotate
+}
+
 // entsqlAnnotate extracts the entsql annotation from a loaded annotation format.
 func entsqlAnnotate(annotation map[string]interface{}) *entsql.Annotation {
 	annotate := &entsql.Annotation{}
@@ -1531,6 +1845,7 @@ var (
 		"Desc",
 		"Driver",
 		"Hook",
+		"Interceptor",
 		"Log",
 		"MutateFunc",
 		"Mutation",
@@ -1543,6 +1858,8 @@ var (
 		"Min",
 		"Sum",
 		"Policy",
+		"Querier",
+		"QuerierFunc",
 		"Query",
 		"Value",
 	)
@@ -1551,6 +1868,7 @@ var (
 		"config",
 		"done",
 		"hooks",
+		"inters",
 		"limit",
 		"mutation",
 		"offset",
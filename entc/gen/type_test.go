@@ -7,8 +7,10 @@ package gen
 import (
 	"testing"
 
+	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/entc/load"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
 
 	"github.com/stretchr/testify/require"
 )
@@ -166,6 +168,35 @@ func TestType_WithRuntimeMixin(t *testing.T) {
 	require.True(t, typ.RuntimeMixin())
 }
 
+func TestType_SoftDelete(t *testing.T) {
+	require := require.New(t)
+	def := &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "deleted_at", Info: &field.TypeInfo{Type: field.TypeTime}, Optional: true, Nillable: true},
+		},
+		Annotations: map[string]interface{}{
+			mixin.SoftDeleteAnnotation{}.Name(): map[string]interface{}{"field": "deleted_at"},
+		},
+	}
+	typ, err := NewType(&Config{Package: "entc/gen"}, def)
+	require.NoError(err)
+	require.NotNil(typ.SoftDelete)
+	require.Equal("deleted_at", typ.SoftDelete.Name)
+
+	def.Fields[0].Nillable = false
+	_, err = NewType(&Config{Package: "entc/gen"}, def)
+	require.EqualError(err, `soft-delete field "deleted_at" of "T" must be an optional, nillable and mutable time field`)
+
+	def.Annotations[mixin.SoftDeleteAnnotation{}.Name()] = map[string]interface{}{"field": "removed_at"}
+	_, err = NewType(&Config{Package: "entc/gen"}, def)
+	require.EqualError(err, `soft-delete field "removed_at" was not found in "T"`)
+
+	typ, err = NewType(&Config{Package: "entc/gen"}, T1)
+	require.NoError(err)
+	require.Nil(typ.SoftDelete)
+}
+
 func TestType_TagTypes(t *testing.T) {
 	typ := &Type{
 		Fields: []*Field{
@@ -290,6 +321,42 @@ func TestField_incremental(t *testing.T) {
 	}
 }
 
+func TestField_Column(t *testing.T) {
+	f := &Field{Name: "nickname", Type: &field.TypeInfo{Type: field.TypeString}, def: &load.Field{}}
+	require.Empty(t, f.Column().RenamedFrom)
+	f.Annotations = dict("EntSQL", dict("renamed_from", "name"))
+	c := f.Column()
+	require.Equal(t, "nickname", c.Name)
+	require.Equal(t, "name", c.RenamedFrom)
+}
+
+func TestType_checks(t *testing.T) {
+	typ := &Type{
+		Name: "User",
+		Annotations: dict("EntSQL", dict("checks", []interface{}{
+			dict("name", "name_check", "expr", "name <> ''"),
+		})),
+		Fields: []*Field{
+			{Name: "age", Annotations: dict("EntSQL", dict("checks", []interface{}{
+				dict("expr", "age > 0", "exprs", dict("mysql", "`age` > 0")),
+			}))},
+			{Name: "name"},
+		},
+	}
+	checks := typ.checks()
+	require.Len(t, checks, 2)
+	require.Equal(t, &schema.Check{Name: "name_check", Expr: "name <> ''"}, checks[0])
+	require.Equal(t, &schema.Check{Name: "users_age_check", Expr: "age > 0", Exprs: map[string]string{"mysql": "`age` > 0"}}, checks[1])
+
+	_, err := NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "User",
+		Annotations: dict("EntSQL", dict("checks", []interface{}{
+			dict("expr", "name <> ''"),
+		})),
+	})
+	require.EqualError(t, err, `check "name <> ''" of type "User" must have a name`)
+}
+
 func TestBuilderField(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -10,6 +10,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"math/rand"
+	"time"
 
 	"entgo.io/ent/entc/integration/config/ent/migrate"
 
@@ -30,7 +32,7 @@ type Client struct {
 
 // NewClient creates a new client configured with the given options.
 func NewClient(opts ...Option) *Client {
-	cfg := config{log: log.Println, hooks: &hooks{}}
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
 	cfg.options(opts...)
 	client := &Client{config: cfg}
 	client.init()
@@ -60,9 +62,20 @@ func Open(driverName, dataSourceName string, options ...Option) (*Client, error)
 
 // Tx returns a new transactional client. The provided context
 // is used until the transaction is committed or rolled back.
+//
+// Calling Tx on a transactional client (e.g. tx.Client()) starts a nested transaction
+// that is backed by a savepoint, and can be committed or rolled back independently.
 func (c *Client) Tx(ctx context.Context) (*Tx, error) {
-	if _, ok := c.driver.(*txDriver); ok {
-		return nil, fmt.Errorf("ent: cannot start a transaction within a transaction")
+	if parent, ok := c.driver.(*txDriver); ok {
+		nested, err := parent.savepoint(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("ent: starting a nested transaction: %w", err)
+		}
+		cfg := c.config
+		cfg.driver = nested
+		tx := &Tx{ctx: ctx, config: cfg}
+		tx.init()
+		return tx, nil
 	}
 	tx, err := newTx(ctx, c.driver)
 	if err != nil {
@@ -91,11 +104,127 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
+		ctx:    ctx,
 		config: cfg,
 		User:   NewUserClient(cfg),
 	}, nil
 }
 
+type (
+	// TxOption configures the transactions that are executed by Client.WithTx.
+	TxOption func(*txConfig)
+
+	// txConfig holds the configuration of Client.WithTx.
+	txConfig struct {
+		opts      *sql.TxOptions
+		attempts  int
+		backoff   func(attempt int) time.Duration
+		retryable func(error) bool
+	}
+)
+
+// WithTxOptions sets the options (e.g. the isolation level) of the transactions.
+func WithTxOptions(opts *sql.TxOptions) TxOption {
+	return func(c *txConfig) {
+		c.opts = opts
+	}
+}
+
+// WithTxAttempts sets the maximum number of times that a transaction is executed.
+// The default is 3, and 1 disables retries.
+func WithTxAttempts(n int) TxOption {
+	return func(c *txConfig) {
+		c.attempts = n
+	}
+}
+
+// WithTxBackoff sets the function that returns how long to wait before retrying a transaction
+// that failed on the given attempt (starting at 1). The default is an exponential backoff
+// with jitter, starting at 10ms.
+func WithTxBackoff(fn func(attempt int) time.Duration) TxOption {
+	return func(c *txConfig) {
+		c.backoff = fn
+	}
+}
+
+// WithTxRetryable sets the function that reports if a transaction that failed with the given
+// error should be retried. The default is sql.IsRetryableError that reports if the transaction
+// was aborted due to a deadlock, a lock timeout or a serialization failure.
+func WithTxRetryable(fn func(error) bool) TxOption {
+	return func(c *txConfig) {
+		c.retryable = fn
+	}
+}
+
+// WithTx executes fn in a transaction, and commits it if fn returns nil, or rolls it back
+// in case fn returns an error or panics. Transactions that fail due to transient errors, such
+// as deadlocks and serialization failures, are retried. Therefore, fn should not have side
+// effects outside the transaction. For example:
+//
+//	err := client.WithTx(ctx, func(tx *ent.Tx) error {
+//		// Use tx here.
+//		return nil
+//	}, ent.WithTxOptions(&sql.TxOptions{Isolation: sql.LevelSerializable}))
+//
+// If the client is a transactional client, fn is executed in a nested transaction,
+// and it is not retried, as these errors abort the outermost transaction.
+func (c *Client) WithTx(ctx context.Context, fn func(*Tx) error, opts ...TxOption) error {
+	cfg := txConfig{attempts: 3, backoff: txBackoff, retryable: sql.IsRetryableError}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		tx, err := c.Tx(ctx)
+		if err != nil {
+			return err
+		}
+		return runTx(tx, fn)
+	}
+	for attempt := 1; ; attempt++ {
+		tx, err := c.BeginTx(ctx, cfg.opts)
+		if err == nil {
+			err = runTx(tx, fn)
+		}
+		if err == nil || attempt >= cfg.attempts || !cfg.retryable(err) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(cfg.backoff(attempt)):
+		}
+	}
+}
+
+// runTx executes fn in the given transaction, and commits or rolls it back.
+func runTx(tx *Tx, fn func(*Tx) error) error {
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	return nil
+}
+
+// txBackoff is the default backoff of Client.WithTx. It is capped at 1s.
+func txBackoff(attempt int) time.Duration {
+	d := time.Second
+	if attempt <= 7 {
+		d = 10 * time.Millisecond << (attempt - 1)
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)))
+}
+
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
@@ -125,6 +254,12 @@ func (c *Client) Use(hooks ...Hook) {
 	c.User.Use(hooks...)
 }
 
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.User.Intercept(interceptors...)
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -141,6 +276,12 @@ func (c *UserClient) Use(hooks ...Hook) {
 	c.hooks.User = append(c.hooks.User, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
 // Create returns a create builder for User.
 func (c *UserClient) Create() *UserCreate {
 	mutation := newUserMutation(c.config, OpCreate)
@@ -191,7 +332,7 @@ func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
 
 // Query returns a query builder for User.
 func (c *UserClient) Query() *UserQuery {
-	return &UserQuery{config: c.config}
+	return &UserQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a User entity by its id.
@@ -212,3 +353,8 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
 }
+
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
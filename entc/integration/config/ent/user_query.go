@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/config/ent/predicate"
@@ -27,6 +28,8 @@ type UserQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.User
+	inters     []Interceptor
+	modifiers  []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -56,6 +59,48 @@ func (uq *UserQuery) Order(o ...OrderFunc) *UserQuery {
 	return uq
 }
 
+// Type returns the node type of this query (User). It implements the ent.Query interface.
+func (uq *UserQuery) Type() string {
+	return TypeUser
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (uq *UserQuery) SetLimit(limit int) {
+	uq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (uq *UserQuery) SetOffset(offset int) {
+	uq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (uq *UserQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for UserQuery", fn)
+		}
+		uq.order = append(uq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (uq *UserQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.User:
+			uq.predicates = append(uq.predicates, p)
+		case func(*sql.Selector):
+			uq.predicates = append(uq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for UserQuery", p)
+		}
+	}
+	return nil
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (uq *UserQuery) First(ctx context.Context) (*User, error) {
@@ -161,7 +206,22 @@ func (uq *UserQuery) All(ctx context.Context) ([]*User, error) {
 	if err := uq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return uq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*UserQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, uq, qr, uq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*User)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -196,7 +256,22 @@ func (uq *UserQuery) Count(ctx context.Context) (int, error) {
 	if err := uq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return uq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*UserQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, uq, qr, uq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -213,7 +288,22 @@ func (uq *UserQuery) Exist(ctx context.Context) (bool, error) {
 	if err := uq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return uq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*UserQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, uq, qr, uq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -237,9 +327,11 @@ func (uq *UserQuery) Clone() *UserQuery {
 		offset:     uq.offset,
 		order:      append([]OrderFunc{}, uq.order...),
 		predicates: append([]predicate.User{}, uq.predicates...),
+		inters:     append([]Interceptor{}, uq.inters...),
 		// clone intermediate query.
-		sql:  uq.sql.Clone(),
-		path: uq.path,
+		sql:       uq.sql.Clone(),
+		path:      uq.path,
+		modifiers: append([]func(*sql.Selector){}, uq.modifiers...),
 	}
 }
 
@@ -307,7 +399,7 @@ func (uq *UserQuery) prepareQuery(ctx context.Context) error {
 func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 	var (
 		nodes = []*User{}
-		_spec = uq.querySpec()
+		_spec = uq.querySpec(ctx)
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		node := &User{config: uq.config}
@@ -331,7 +423,7 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 }
 
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := uq.querySpec()
+	_spec := uq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, uq.driver, _spec)
 }
 
@@ -343,7 +435,7 @@ func (uq *UserQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (uq *UserQuery) querySpec() *sqlgraph.QuerySpec {
+func (uq *UserQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   user.Table,
@@ -385,6 +477,9 @@ func (uq *UserQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := uq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -410,9 +505,40 @@ func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := uq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range uq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (uq *UserQuery) ForUpdate(opts ...sql.LockOption) *UserQuery {
+	uq.modifiers = append(uq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return uq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (uq *UserQuery) ForShare(opts ...sql.LockOption) *UserQuery {
+	uq.modifiers = append(uq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return uq
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	config
@@ -662,7 +788,8 @@ func (ugb *UserGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (ugb *UserGroupBy) sqlQuery() *sql.Selector {
-	selector := ugb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := ugb.sql.ClearLock()
 	columns := make([]string, 0, len(ugb.fields)+len(ugb.fns))
 	columns = append(columns, ugb.fields...)
 	for _, fn := range ugb.fns {
@@ -683,8 +810,12 @@ func (us *UserSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := us.prepareQuery(ctx); err != nil {
 		return err
 	}
-	us.sql = us.UserQuery.sqlQuery(ctx)
-	return us.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		us.sql = us.UserQuery.sqlQuery(ctx)
+		return v, us.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, us.UserQuery, qr, us.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -245,8 +245,9 @@ func (bcb *BlobCreateBulk) Save(ctx context.Context) ([]*Blob, error) {
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
 				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, bcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
 						if cerr, ok := isSQLConstraintError(err); ok {
 							err = cerr
 						}
@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/customid/ent/blob"
@@ -29,10 +30,12 @@ type BlobQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Blob
+	inters     []Interceptor
 	// eager-loading edges.
 	withParent *BlobQuery
 	withLinks  *BlobQuery
 	withFKs    bool
+	modifiers  []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -62,9 +65,51 @@ func (bq *BlobQuery) Order(o ...OrderFunc) *BlobQuery {
 	return bq
 }
 
+// Type returns the node type of this query (Blob). It implements the ent.Query interface.
+func (bq *BlobQuery) Type() string {
+	return TypeBlob
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (bq *BlobQuery) SetLimit(limit int) {
+	bq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (bq *BlobQuery) SetOffset(offset int) {
+	bq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (bq *BlobQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for BlobQuery", fn)
+		}
+		bq.order = append(bq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (bq *BlobQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Blob:
+			bq.predicates = append(bq.predicates, p)
+		case func(*sql.Selector):
+			bq.predicates = append(bq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for BlobQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryParent chains the current query on the "parent" edge.
 func (bq *BlobQuery) QueryParent() *BlobQuery {
-	query := &BlobQuery{config: bq.config}
+	query := (&BlobClient{config: bq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := bq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -86,7 +131,7 @@ func (bq *BlobQuery) QueryParent() *BlobQuery {
 
 // QueryLinks chains the current query on the "links" edge.
 func (bq *BlobQuery) QueryLinks() *BlobQuery {
-	query := &BlobQuery{config: bq.config}
+	query := (&BlobClient{config: bq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := bq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -211,7 +256,22 @@ func (bq *BlobQuery) All(ctx context.Context) ([]*Blob, error) {
 	if err := bq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return bq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*BlobQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, bq, qr, bq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Blob)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -246,7 +306,22 @@ func (bq *BlobQuery) Count(ctx context.Context) (int, error) {
 	if err := bq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return bq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*BlobQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, bq, qr, bq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -263,7 +338,22 @@ func (bq *BlobQuery) Exist(ctx context.Context) (bool, error) {
 	if err := bq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return bq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*BlobQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, bq, qr, bq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -287,18 +377,20 @@ func (bq *BlobQuery) Clone() *BlobQuery {
 		offset:     bq.offset,
 		order:      append([]OrderFunc{}, bq.order...),
 		predicates: append([]predicate.Blob{}, bq.predicates...),
+		inters:     append([]Interceptor{}, bq.inters...),
 		withParent: bq.withParent.Clone(),
 		withLinks:  bq.withLinks.Clone(),
 		// clone intermediate query.
-		sql:  bq.sql.Clone(),
-		path: bq.path,
+		sql:       bq.sql.Clone(),
+		path:      bq.path,
+		modifiers: append([]func(*sql.Selector){}, bq.modifiers...),
 	}
 }
 
 // WithParent tells the query-builder to eager-load the nodes that are connected to
 // the "parent" edge. The optional arguments are used to configure the query builder of the edge.
 func (bq *BlobQuery) WithParent(opts ...func(*BlobQuery)) *BlobQuery {
-	query := &BlobQuery{config: bq.config}
+	query := (&BlobClient{config: bq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -309,7 +401,7 @@ func (bq *BlobQuery) WithParent(opts ...func(*BlobQuery)) *BlobQuery {
 // WithLinks tells the query-builder to eager-load the nodes that are connected to
 // the "links" edge. The optional arguments are used to configure the query builder of the edge.
 func (bq *BlobQuery) WithLinks(opts ...func(*BlobQuery)) *BlobQuery {
-	query := &BlobQuery{config: bq.config}
+	query := (&BlobClient{config: bq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -382,7 +474,7 @@ func (bq *BlobQuery) sqlAll(ctx context.Context) ([]*Blob, error) {
 	var (
 		nodes       = []*Blob{}
 		withFKs     = bq.withFKs
-		_spec       = bq.querySpec()
+		_spec       = bq.querySpec(ctx)
 		loadedTypes = [2]bool{
 			bq.withParent != nil,
 			bq.withLinks != nil,
@@ -508,7 +600,7 @@ func (bq *BlobQuery) sqlAll(ctx context.Context) ([]*Blob, error) {
 }
 
 func (bq *BlobQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := bq.querySpec()
+	_spec := bq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, bq.driver, _spec)
 }
 
@@ -520,7 +612,7 @@ func (bq *BlobQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (bq *BlobQuery) querySpec() *sqlgraph.QuerySpec {
+func (bq *BlobQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   blob.Table,
@@ -562,6 +654,9 @@ func (bq *BlobQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := bq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -587,9 +682,40 @@ func (bq *BlobQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := bq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range bq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (bq *BlobQuery) ForUpdate(opts ...sql.LockOption) *BlobQuery {
+	bq.modifiers = append(bq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return bq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (bq *BlobQuery) ForShare(opts ...sql.LockOption) *BlobQuery {
+	bq.modifiers = append(bq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return bq
+}
+
 // BlobGroupBy is the group-by builder for Blob entities.
 type BlobGroupBy struct {
 	config
@@ -839,7 +965,8 @@ func (bgb *BlobGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (bgb *BlobGroupBy) sqlQuery() *sql.Selector {
-	selector := bgb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := bgb.sql.ClearLock()
 	columns := make([]string, 0, len(bgb.fields)+len(bgb.fns))
 	columns = append(columns, bgb.fields...)
 	for _, fn := range bgb.fns {
@@ -860,8 +987,12 @@ func (bs *BlobSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := bs.prepareQuery(ctx); err != nil {
 		return err
 	}
-	bs.sql = bs.BlobQuery.sqlQuery(ctx)
-	return bs.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		bs.sql = bs.BlobQuery.sqlQuery(ctx)
+		return v, bs.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, bs.BlobQuery, qr, bs.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/customid/ent/car"
@@ -28,9 +29,11 @@ type CarQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Car
+	inters     []Interceptor
 	// eager-loading edges.
 	withOwner *PetQuery
 	withFKs   bool
+	modifiers []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -60,9 +63,51 @@ func (cq *CarQuery) Order(o ...OrderFunc) *CarQuery {
 	return cq
 }
 
+// Type returns the node type of this query (Car). It implements the ent.Query interface.
+func (cq *CarQuery) Type() string {
+	return TypeCar
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (cq *CarQuery) SetLimit(limit int) {
+	cq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (cq *CarQuery) SetOffset(offset int) {
+	cq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (cq *CarQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for CarQuery", fn)
+		}
+		cq.order = append(cq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (cq *CarQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Car:
+			cq.predicates = append(cq.predicates, p)
+		case func(*sql.Selector):
+			cq.predicates = append(cq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for CarQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryOwner chains the current query on the "owner" edge.
 func (cq *CarQuery) QueryOwner() *PetQuery {
-	query := &PetQuery{config: cq.config}
+	query := (&PetClient{config: cq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := cq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -187,7 +232,22 @@ func (cq *CarQuery) All(ctx context.Context) ([]*Car, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return cq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CarQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, cq, qr, cq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Car)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -222,7 +282,22 @@ func (cq *CarQuery) Count(ctx context.Context) (int, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return cq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CarQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, cq, qr, cq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -239,7 +314,22 @@ func (cq *CarQuery) Exist(ctx context.Context) (bool, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return cq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CarQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, cq, qr, cq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -263,17 +353,19 @@ func (cq *CarQuery) Clone() *CarQuery {
 		offset:     cq.offset,
 		order:      append([]OrderFunc{}, cq.order...),
 		predicates: append([]predicate.Car{}, cq.predicates...),
+		inters:     append([]Interceptor{}, cq.inters...),
 		withOwner:  cq.withOwner.Clone(),
 		// clone intermediate query.
-		sql:  cq.sql.Clone(),
-		path: cq.path,
+		sql:       cq.sql.Clone(),
+		path:      cq.path,
+		modifiers: append([]func(*sql.Selector){}, cq.modifiers...),
 	}
 }
 
 // WithOwner tells the query-builder to eager-load the nodes that are connected to
 // the "owner" edge. The optional arguments are used to configure the query builder of the edge.
 func (cq *CarQuery) WithOwner(opts ...func(*PetQuery)) *CarQuery {
-	query := &PetQuery{config: cq.config}
+	query := (&PetClient{config: cq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -346,7 +438,7 @@ func (cq *CarQuery) sqlAll(ctx context.Context) ([]*Car, error) {
 	var (
 		nodes       = []*Car{}
 		withFKs     = cq.withFKs
-		_spec       = cq.querySpec()
+		_spec       = cq.querySpec(ctx)
 		loadedTypes = [1]bool{
 			cq.withOwner != nil,
 		}
@@ -407,7 +499,7 @@ func (cq *CarQuery) sqlAll(ctx context.Context) ([]*Car, error) {
 }
 
 func (cq *CarQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := cq.querySpec()
+	_spec := cq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, cq.driver, _spec)
 }
 
@@ -419,7 +511,7 @@ func (cq *CarQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (cq *CarQuery) querySpec() *sqlgraph.QuerySpec {
+func (cq *CarQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   car.Table,
@@ -461,6 +553,9 @@ func (cq *CarQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := cq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -486,9 +581,40 @@ func (cq *CarQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := cq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range cq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (cq *CarQuery) ForUpdate(opts ...sql.LockOption) *CarQuery {
+	cq.modifiers = append(cq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return cq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (cq *CarQuery) ForShare(opts ...sql.LockOption) *CarQuery {
+	cq.modifiers = append(cq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return cq
+}
+
 // CarGroupBy is the group-by builder for Car entities.
 type CarGroupBy struct {
 	config
@@ -738,7 +864,8 @@ func (cgb *CarGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (cgb *CarGroupBy) sqlQuery() *sql.Selector {
-	selector := cgb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := cgb.sql.ClearLock()
 	columns := make([]string, 0, len(cgb.fields)+len(cgb.fns))
 	columns = append(columns, cgb.fields...)
 	for _, fn := range cgb.fns {
@@ -759,8 +886,12 @@ func (cs *CarSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := cs.prepareQuery(ctx); err != nil {
 		return err
 	}
-	cs.sql = cs.CarQuery.sqlQuery(ctx)
-	return cs.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		cs.sql = cs.CarQuery.sqlQuery(ctx)
+		return v, cs.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, cs.CarQuery, qr, cs.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -10,6 +10,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"math/rand"
+	"time"
 
 	"entgo.io/ent/entc/integration/customid/ent/migrate"
 	"github.com/google/uuid"
@@ -47,7 +49,7 @@ type Client struct {
 
 // NewClient creates a new client configured with the given options.
 func NewClient(opts ...Option) *Client {
-	cfg := config{log: log.Println, hooks: &hooks{}}
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
 	cfg.options(opts...)
 	client := &Client{config: cfg}
 	client.init()
@@ -82,9 +84,20 @@ func Open(driverName, dataSourceName string, options ...Option) (*Client, error)
 
 // Tx returns a new transactional client. The provided context
 // is used until the transaction is committed or rolled back.
+//
+// Calling Tx on a transactional client (e.g. tx.Client()) starts a nested transaction
+// that is backed by a savepoint, and can be committed or rolled back independently.
 func (c *Client) Tx(ctx context.Context) (*Tx, error) {
-	if _, ok := c.driver.(*txDriver); ok {
-		return nil, fmt.Errorf("ent: cannot start a transaction within a transaction")
+	if parent, ok := c.driver.(*txDriver); ok {
+		nested, err := parent.savepoint(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("ent: starting a nested transaction: %w", err)
+		}
+		cfg := c.config
+		cfg.driver = nested
+		tx := &Tx{ctx: ctx, config: cfg}
+		tx.init()
+		return tx, nil
 	}
 	tx, err := newTx(ctx, c.driver)
 	if err != nil {
@@ -118,6 +131,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
+		ctx:     ctx,
 		config:  cfg,
 		Blob:    NewBlobClient(cfg),
 		Car:     NewCarClient(cfg),
@@ -128,6 +142,121 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	}, nil
 }
 
+type (
+	// TxOption configures the transactions that are executed by Client.WithTx.
+	TxOption func(*txConfig)
+
+	// txConfig holds the configuration of Client.WithTx.
+	txConfig struct {
+		opts      *sql.TxOptions
+		attempts  int
+		backoff   func(attempt int) time.Duration
+		retryable func(error) bool
+	}
+)
+
+// WithTxOptions sets the options (e.g. the isolation level) of the transactions.
+func WithTxOptions(opts *sql.TxOptions) TxOption {
+	return func(c *txConfig) {
+		c.opts = opts
+	}
+}
+
+// WithTxAttempts sets the maximum number of times that a transaction is executed.
+// The default is 3, and 1 disables retries.
+func WithTxAttempts(n int) TxOption {
+	return func(c *txConfig) {
+		c.attempts = n
+	}
+}
+
+// WithTxBackoff sets the function that returns how long to wait before retrying a transaction
+// that failed on the given attempt (starting at 1). The default is an exponential backoff
+// with jitter, starting at 10ms.
+func WithTxBackoff(fn func(attempt int) time.Duration) TxOption {
+	return func(c *txConfig) {
+		c.backoff = fn
+	}
+}
+
+// WithTxRetryable sets the function that reports if a transaction that failed with the given
+// error should be retried. The default is sql.IsRetryableError that reports if the transaction
+// was aborted due to a deadlock, a lock timeout or a serialization failure.
+func WithTxRetryable(fn func(error) bool) TxOption {
+	return func(c *txConfig) {
+		c.retryable = fn
+	}
+}
+
+// WithTx executes fn in a transaction, and commits it if fn returns nil, or rolls it back
+// in case fn returns an error or panics. Transactions that fail due to transient errors, such
+// as deadlocks and serialization failures, are retried. Therefore, fn should not have side
+// effects outside the transaction. For example:
+//
+//	err := client.WithTx(ctx, func(tx *ent.Tx) error {
+//		// Use tx here.
+//		return nil
+//	}, ent.WithTxOptions(&sql.TxOptions{Isolation: sql.LevelSerializable}))
+//
+// If the client is a transactional client, fn is executed in a nested transaction,
+// and it is not retried, as these errors abort the outermost transaction.
+func (c *Client) WithTx(ctx context.Context, fn func(*Tx) error, opts ...TxOption) error {
+	cfg := txConfig{attempts: 3, backoff: txBackoff, retryable: sql.IsRetryableError}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		tx, err := c.Tx(ctx)
+		if err != nil {
+			return err
+		}
+		return runTx(tx, fn)
+	}
+	for attempt := 1; ; attempt++ {
+		tx, err := c.BeginTx(ctx, cfg.opts)
+		if err == nil {
+			err = runTx(tx, fn)
+		}
+		if err == nil || attempt >= cfg.attempts || !cfg.retryable(err) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(cfg.backoff(attempt)):
+		}
+	}
+}
+
+// runTx executes fn in the given transaction, and commits or rolls it back.
+func runTx(tx *Tx, fn func(*Tx) error) error {
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	return nil
+}
+
+// txBackoff is the default backoff of Client.WithTx. It is capped at 1s.
+func txBackoff(attempt int) time.Duration {
+	d := time.Second
+	if attempt <= 7 {
+		d = 10 * time.Millisecond << (attempt - 1)
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)))
+}
+
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
@@ -162,6 +291,17 @@ func (c *Client) Use(hooks ...Hook) {
 	c.User.Use(hooks...)
 }
 
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.Blob.Intercept(interceptors...)
+	c.Car.Intercept(interceptors...)
+	c.Group.Intercept(interceptors...)
+	c.MixinID.Intercept(interceptors...)
+	c.Pet.Intercept(interceptors...)
+	c.User.Intercept(interceptors...)
+}
+
 // BlobClient is a client for the Blob schema.
 type BlobClient struct {
 	config
@@ -178,6 +318,12 @@ func (c *BlobClient) Use(hooks ...Hook) {
 	c.hooks.Blob = append(c.hooks.Blob, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `blob.Intercept(f(g(h())))`.
+func (c *BlobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Blob = append(c.inters.Blob, interceptors...)
+}
+
 // Create returns a create builder for Blob.
 func (c *BlobClient) Create() *BlobCreate {
 	mutation := newBlobMutation(c.config, OpCreate)
@@ -228,7 +374,7 @@ func (c *BlobClient) DeleteOneID(id uuid.UUID) *BlobDeleteOne {
 
 // Query returns a query builder for Blob.
 func (c *BlobClient) Query() *BlobQuery {
-	return &BlobQuery{config: c.config}
+	return &BlobQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Blob entity by its id.
@@ -247,7 +393,7 @@ func (c *BlobClient) GetX(ctx context.Context, id uuid.UUID) *Blob {
 
 // QueryParent queries the parent edge of a Blob.
 func (c *BlobClient) QueryParent(b *Blob) *BlobQuery {
-	query := &BlobQuery{config: c.config}
+	query := (&BlobClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := b.ID
 		step := sqlgraph.NewStep(
@@ -263,7 +409,7 @@ func (c *BlobClient) QueryParent(b *Blob) *BlobQuery {
 
 // QueryLinks queries the links edge of a Blob.
 func (c *BlobClient) QueryLinks(b *Blob) *BlobQuery {
-	query := &BlobQuery{config: c.config}
+	query := (&BlobClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := b.ID
 		step := sqlgraph.NewStep(
@@ -282,6 +428,11 @@ func (c *BlobClient) Hooks() []Hook {
 	return c.hooks.Blob
 }
 
+// Interceptors returns the client interceptors.
+func (c *BlobClient) Interceptors() []Interceptor {
+	return c.inters.Blob
+}
+
 // CarClient is a client for the Car schema.
 type CarClient struct {
 	config
@@ -298,6 +449,12 @@ func (c *CarClient) Use(hooks ...Hook) {
 	c.hooks.Car = append(c.hooks.Car, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `car.Intercept(f(g(h())))`.
+func (c *CarClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Car = append(c.inters.Car, interceptors...)
+}
+
 // Create returns a create builder for Car.
 func (c *CarClient) Create() *CarCreate {
 	mutation := newCarMutation(c.config, OpCreate)
@@ -348,7 +505,7 @@ func (c *CarClient) DeleteOneID(id int) *CarDeleteOne {
 
 // Query returns a query builder for Car.
 func (c *CarClient) Query() *CarQuery {
-	return &CarQuery{config: c.config}
+	return &CarQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Car entity by its id.
@@ -367,7 +524,7 @@ func (c *CarClient) GetX(ctx context.Context, id int) *Car {
 
 // QueryOwner queries the owner edge of a Car.
 func (c *CarClient) QueryOwner(ca *Car) *PetQuery {
-	query := &PetQuery{config: c.config}
+	query := (&PetClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := ca.ID
 		step := sqlgraph.NewStep(
@@ -386,6 +543,11 @@ func (c *CarClient) Hooks() []Hook {
 	return c.hooks.Car
 }
 
+// Interceptors returns the client interceptors.
+func (c *CarClient) Interceptors() []Interceptor {
+	return c.inters.Car
+}
+
 // GroupClient is a client for the Group schema.
 type GroupClient struct {
 	config
@@ -402,6 +564,12 @@ func (c *GroupClient) Use(hooks ...Hook) {
 	c.hooks.Group = append(c.hooks.Group, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `group.Intercept(f(g(h())))`.
+func (c *GroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Group = append(c.inters.Group, interceptors...)
+}
+
 // Create returns a create builder for Group.
 func (c *GroupClient) Create() *GroupCreate {
 	mutation := newGroupMutation(c.config, OpCreate)
@@ -452,7 +620,7 @@ func (c *GroupClient) DeleteOneID(id int) *GroupDeleteOne {
 
 // Query returns a query builder for Group.
 func (c *GroupClient) Query() *GroupQuery {
-	return &GroupQuery{config: c.config}
+	return &GroupQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Group entity by its id.
@@ -471,7 +639,7 @@ func (c *GroupClient) GetX(ctx context.Context, id int) *Group {
 
 // QueryUsers queries the users edge of a Group.
 func (c *GroupClient) QueryUsers(gr *Group) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := gr.ID
 		step := sqlgraph.NewStep(
@@ -490,6 +658,11 @@ func (c *GroupClient) Hooks() []Hook {
 	return c.hooks.Group
 }
 
+// Interceptors returns the client interceptors.
+func (c *GroupClient) Interceptors() []Interceptor {
+	return c.inters.Group
+}
+
 // MixinIDClient is a client for the MixinID schema.
 type MixinIDClient struct {
 	config
@@ -506,6 +679,12 @@ func (c *MixinIDClient) Use(hooks ...Hook) {
 	c.hooks.MixinID = append(c.hooks.MixinID, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `mixinid.Intercept(f(g(h())))`.
+func (c *MixinIDClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MixinID = append(c.inters.MixinID, interceptors...)
+}
+
 // Create returns a create builder for MixinID.
 func (c *MixinIDClient) Create() *MixinIDCreate {
 	mutation := newMixinIDMutation(c.config, OpCreate)
@@ -556,7 +735,7 @@ func (c *MixinIDClient) DeleteOneID(id uuid.UUID) *MixinIDDeleteOne {
 
 // Query returns a query builder for MixinID.
 func (c *MixinIDClient) Query() *MixinIDQuery {
-	return &MixinIDQuery{config: c.config}
+	return &MixinIDQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a MixinID entity by its id.
@@ -578,6 +757,11 @@ func (c *MixinIDClient) Hooks() []Hook {
 	return c.hooks.MixinID
 }
 
+// Interceptors returns the client interceptors.
+func (c *MixinIDClient) Interceptors() []Interceptor {
+	return c.inters.MixinID
+}
+
 // PetClient is a client for the Pet schema.
 type PetClient struct {
 	config
@@ -594,6 +778,12 @@ func (c *PetClient) Use(hooks ...Hook) {
 	c.hooks.Pet = append(c.hooks.Pet, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pet.Intercept(f(g(h())))`.
+func (c *PetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Pet = append(c.inters.Pet, interceptors...)
+}
+
 // Create returns a create builder for Pet.
 func (c *PetClient) Create() *PetCreate {
 	mutation := newPetMutation(c.config, OpCreate)
@@ -644,7 +834,7 @@ func (c *PetClient) DeleteOneID(id string) *PetDeleteOne {
 
 // Query returns a query builder for Pet.
 func (c *PetClient) Query() *PetQuery {
-	return &PetQuery{config: c.config}
+	return &PetQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Pet entity by its id.
@@ -663,7 +853,7 @@ func (c *PetClient) GetX(ctx context.Context, id string) *Pet {
 
 // QueryOwner queries the owner edge of a Pet.
 func (c *PetClient) QueryOwner(pe *Pet) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := pe.ID
 		step := sqlgraph.NewStep(
@@ -679,7 +869,7 @@ func (c *PetClient) QueryOwner(pe *Pet) *UserQuery {
 
 // QueryCars queries the cars edge of a Pet.
 func (c *PetClient) QueryCars(pe *Pet) *CarQuery {
-	query := &CarQuery{config: c.config}
+	query := (&CarClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := pe.ID
 		step := sqlgraph.NewStep(
@@ -695,7 +885,7 @@ func (c *PetClient) QueryCars(pe *Pet) *CarQuery {
 
 // QueryFriends queries the friends edge of a Pet.
 func (c *PetClient) QueryFriends(pe *Pet) *PetQuery {
-	query := &PetQuery{config: c.config}
+	query := (&PetClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := pe.ID
 		step := sqlgraph.NewStep(
@@ -711,7 +901,7 @@ func (c *PetClient) QueryFriends(pe *Pet) *PetQuery {
 
 // QueryBestFriend queries the best_friend edge of a Pet.
 func (c *PetClient) QueryBestFriend(pe *Pet) *PetQuery {
-	query := &PetQuery{config: c.config}
+	query := (&PetClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := pe.ID
 		step := sqlgraph.NewStep(
@@ -730,6 +920,11 @@ func (c *PetClient) Hooks() []Hook {
 	return c.hooks.Pet
 }
 
+// Interceptors returns the client interceptors.
+func (c *PetClient) Interceptors() []Interceptor {
+	return c.inters.Pet
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -746,6 +941,12 @@ func (c *UserClient) Use(hooks ...Hook) {
 	c.hooks.User = append(c.hooks.User, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
 // Create returns a create builder for User.
 func (c *UserClient) Create() *UserCreate {
 	mutation := newUserMutation(c.config, OpCreate)
@@ -796,7 +997,7 @@ func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
 
 // Query returns a query builder for User.
 func (c *UserClient) Query() *UserQuery {
-	return &UserQuery{config: c.config}
+	return &UserQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a User entity by its id.
@@ -815,7 +1016,7 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 
 // QueryGroups queries the groups edge of a User.
 func (c *UserClient) QueryGroups(u *User) *GroupQuery {
-	query := &GroupQuery{config: c.config}
+	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -831,7 +1032,7 @@ func (c *UserClient) QueryGroups(u *User) *GroupQuery {
 
 // QueryParent queries the parent edge of a User.
 func (c *UserClient) QueryParent(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -847,7 +1048,7 @@ func (c *UserClient) QueryParent(u *User) *UserQuery {
 
 // QueryChildren queries the children edge of a User.
 func (c *UserClient) QueryChildren(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -863,7 +1064,7 @@ func (c *UserClient) QueryChildren(u *User) *UserQuery {
 
 // QueryPets queries the pets edge of a User.
 func (c *UserClient) QueryPets(u *User) *PetQuery {
-	query := &PetQuery{config: c.config}
+	query := (&PetClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -881,3 +1082,8 @@ func (c *UserClient) QueryPets(u *User) *PetQuery {
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
 }
+
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
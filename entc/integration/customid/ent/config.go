@@ -24,6 +24,8 @@ type config struct {
 	log func(...interface{})
 	// hooks to execute on mutations.
 	hooks *hooks
+	// interceptors to execute on queries.
+	inters *inters
 }
 
 // hooks per client, for fast access.
@@ -36,6 +38,16 @@ type hooks struct {
 	User    []ent.Hook
 }
 
+// interceptors per client, for fast access.
+type inters struct {
+	Blob    []ent.Interceptor
+	Car     []ent.Interceptor
+	Group   []ent.Interceptor
+	MixinID []ent.Interceptor
+	Pet     []ent.Interceptor
+	User    []ent.Interceptor
+}
+
 // Options applies the options on the config object.
 func (c *config) options(opts ...Option) {
 	for _, opt := range opts {
@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/customid/ent/group"
@@ -29,8 +30,10 @@ type GroupQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Group
+	inters     []Interceptor
 	// eager-loading edges.
 	withUsers *UserQuery
+	modifiers []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -60,9 +63,51 @@ func (gq *GroupQuery) Order(o ...OrderFunc) *GroupQuery {
 	return gq
 }
 
+// Type returns the node type of this query (Group). It implements the ent.Query interface.
+func (gq *GroupQuery) Type() string {
+	return TypeGroup
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (gq *GroupQuery) SetLimit(limit int) {
+	gq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (gq *GroupQuery) SetOffset(offset int) {
+	gq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (gq *GroupQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for GroupQuery", fn)
+		}
+		gq.order = append(gq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (gq *GroupQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Group:
+			gq.predicates = append(gq.predicates, p)
+		case func(*sql.Selector):
+			gq.predicates = append(gq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for GroupQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryUsers chains the current query on the "users" edge.
 func (gq *GroupQuery) QueryUsers() *UserQuery {
-	query := &UserQuery{config: gq.config}
+	query := (&UserClient{config: gq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := gq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -187,7 +232,22 @@ func (gq *GroupQuery) All(ctx context.Context) ([]*Group, error) {
 	if err := gq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return gq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*GroupQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, gq, qr, gq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Group)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -222,7 +282,22 @@ func (gq *GroupQuery) Count(ctx context.Context) (int, error) {
 	if err := gq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return gq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*GroupQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, gq, qr, gq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -239,7 +314,22 @@ func (gq *GroupQuery) Exist(ctx context.Context) (bool, error) {
 	if err := gq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return gq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*GroupQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, gq, qr, gq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -263,17 +353,19 @@ func (gq *GroupQuery) Clone() *GroupQuery {
 		offset:     gq.offset,
 		order:      append([]OrderFunc{}, gq.order...),
 		predicates: append([]predicate.Group{}, gq.predicates...),
+		inters:     append([]Interceptor{}, gq.inters...),
 		withUsers:  gq.withUsers.Clone(),
 		// clone intermediate query.
-		sql:  gq.sql.Clone(),
-		path: gq.path,
+		sql:       gq.sql.Clone(),
+		path:      gq.path,
+		modifiers: append([]func(*sql.Selector){}, gq.modifiers...),
 	}
 }
 
 // WithUsers tells the query-builder to eager-load the nodes that are connected to
 // the "users" edge. The optional arguments are used to configure the query builder of the edge.
 func (gq *GroupQuery) WithUsers(opts ...func(*UserQuery)) *GroupQuery {
-	query := &UserQuery{config: gq.config}
+	query := (&UserClient{config: gq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -321,7 +413,7 @@ func (gq *GroupQuery) prepareQuery(ctx context.Context) error {
 func (gq *GroupQuery) sqlAll(ctx context.Context) ([]*Group, error) {
 	var (
 		nodes       = []*Group{}
-		_spec       = gq.querySpec()
+		_spec       = gq.querySpec(ctx)
 		loadedTypes = [1]bool{
 			gq.withUsers != nil,
 		}
@@ -414,7 +506,7 @@ func (gq *GroupQuery) sqlAll(ctx context.Context) ([]*Group, error) {
 }
 
 func (gq *GroupQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := gq.querySpec()
+	_spec := gq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, gq.driver, _spec)
 }
 
@@ -426,7 +518,7 @@ func (gq *GroupQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (gq *GroupQuery) querySpec() *sqlgraph.QuerySpec {
+func (gq *GroupQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   group.Table,
@@ -468,6 +560,9 @@ func (gq *GroupQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := gq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -493,9 +588,40 @@ func (gq *GroupQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := gq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range gq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (gq *GroupQuery) ForUpdate(opts ...sql.LockOption) *GroupQuery {
+	gq.modifiers = append(gq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return gq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (gq *GroupQuery) ForShare(opts ...sql.LockOption) *GroupQuery {
+	gq.modifiers = append(gq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return gq
+}
+
 // GroupGroupBy is the group-by builder for Group entities.
 type GroupGroupBy struct {
 	config
@@ -745,7 +871,8 @@ func (ggb *GroupGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (ggb *GroupGroupBy) sqlQuery() *sql.Selector {
-	selector := ggb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := ggb.sql.ClearLock()
 	columns := make([]string, 0, len(ggb.fields)+len(ggb.fns))
 	columns = append(columns, ggb.fields...)
 	for _, fn := range ggb.fns {
@@ -766,8 +893,12 @@ func (gs *GroupSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := gs.prepareQuery(ctx); err != nil {
 		return err
 	}
-	gs.sql = gs.GroupQuery.sqlQuery(ctx)
-	return gs.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		gs.sql = gs.GroupQuery.sqlQuery(ctx)
+		return v, gs.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, gs.GroupQuery, qr, gs.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
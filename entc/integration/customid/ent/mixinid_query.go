@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/customid/ent/mixinid"
@@ -28,6 +29,8 @@ type MixinIDQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.MixinID
+	inters     []Interceptor
+	modifiers  []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -57,6 +60,48 @@ func (miq *MixinIDQuery) Order(o ...OrderFunc) *MixinIDQuery {
 	return miq
 }
 
+// Type returns the node type of this query (MixinID). It implements the ent.Query interface.
+func (miq *MixinIDQuery) Type() string {
+	return TypeMixinID
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (miq *MixinIDQuery) SetLimit(limit int) {
+	miq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (miq *MixinIDQuery) SetOffset(offset int) {
+	miq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (miq *MixinIDQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for MixinIDQuery", fn)
+		}
+		miq.order = append(miq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (miq *MixinIDQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.MixinID:
+			miq.predicates = append(miq.predicates, p)
+		case func(*sql.Selector):
+			miq.predicates = append(miq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for MixinIDQuery", p)
+		}
+	}
+	return nil
+}
+
 // First returns the first MixinID entity from the query.
 // Returns a *NotFoundError when no MixinID was found.
 func (miq *MixinIDQuery) First(ctx context.Context) (*MixinID, error) {
@@ -162,7 +207,22 @@ func (miq *MixinIDQuery) All(ctx context.Context) ([]*MixinID, error) {
 	if err := miq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return miq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*MixinIDQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, miq, qr, miq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*MixinID)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -197,7 +257,22 @@ func (miq *MixinIDQuery) Count(ctx context.Context) (int, error) {
 	if err := miq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return miq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*MixinIDQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, miq, qr, miq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -214,7 +289,22 @@ func (miq *MixinIDQuery) Exist(ctx context.Context) (bool, error) {
 	if err := miq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return miq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*MixinIDQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, miq, qr, miq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -238,9 +328,11 @@ func (miq *MixinIDQuery) Clone() *MixinIDQuery {
 		offset:     miq.offset,
 		order:      append([]OrderFunc{}, miq.order...),
 		predicates: append([]predicate.MixinID{}, miq.predicates...),
+		inters:     append([]Interceptor{}, miq.inters...),
 		// clone intermediate query.
-		sql:  miq.sql.Clone(),
-		path: miq.path,
+		sql:       miq.sql.Clone(),
+		path:      miq.path,
+		modifiers: append([]func(*sql.Selector){}, miq.modifiers...),
 	}
 }
 
@@ -308,7 +400,7 @@ func (miq *MixinIDQuery) prepareQuery(ctx context.Context) error {
 func (miq *MixinIDQuery) sqlAll(ctx context.Context) ([]*MixinID, error) {
 	var (
 		nodes = []*MixinID{}
-		_spec = miq.querySpec()
+		_spec = miq.querySpec(ctx)
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		node := &MixinID{config: miq.config}
@@ -332,7 +424,7 @@ func (miq *MixinIDQuery) sqlAll(ctx context.Context) ([]*MixinID, error) {
 }
 
 func (miq *MixinIDQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := miq.querySpec()
+	_spec := miq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, miq.driver, _spec)
 }
 
@@ -344,7 +436,7 @@ func (miq *MixinIDQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (miq *MixinIDQuery) querySpec() *sqlgraph.QuerySpec {
+func (miq *MixinIDQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   mixinid.Table,
@@ -386,6 +478,9 @@ func (miq *MixinIDQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := miq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -411,9 +506,40 @@ func (miq *MixinIDQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := miq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range miq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (miq *MixinIDQuery) ForUpdate(opts ...sql.LockOption) *MixinIDQuery {
+	miq.modifiers = append(miq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return miq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (miq *MixinIDQuery) ForShare(opts ...sql.LockOption) *MixinIDQuery {
+	miq.modifiers = append(miq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return miq
+}
+
 // MixinIDGroupBy is the group-by builder for MixinID entities.
 type MixinIDGroupBy struct {
 	config
@@ -663,7 +789,8 @@ func (migb *MixinIDGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (migb *MixinIDGroupBy) sqlQuery() *sql.Selector {
-	selector := migb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := migb.sql.ClearLock()
 	columns := make([]string, 0, len(migb.fields)+len(migb.fns))
 	columns = append(columns, migb.fields...)
 	for _, fn := range migb.fns {
@@ -684,8 +811,12 @@ func (mis *MixinIDSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := mis.prepareQuery(ctx); err != nil {
 		return err
 	}
-	mis.sql = mis.MixinIDQuery.sqlQuery(ctx)
-	return mis.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		mis.sql = mis.MixinIDQuery.sqlQuery(ctx)
+		return v, mis.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, mis.MixinIDQuery, qr, mis.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
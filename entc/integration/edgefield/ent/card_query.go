@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgefield/ent/card"
@@ -28,8 +29,10 @@ type CardQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Card
+	inters     []Interceptor
 	// eager-loading edges.
 	withOwner *UserQuery
+	modifiers []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -59,9 +62,51 @@ func (cq *CardQuery) Order(o ...OrderFunc) *CardQuery {
 	return cq
 }
 
+// Type returns the node type of this query (Card). It implements the ent.Query interface.
+func (cq *CardQuery) Type() string {
+	return TypeCard
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (cq *CardQuery) SetLimit(limit int) {
+	cq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (cq *CardQuery) SetOffset(offset int) {
+	cq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (cq *CardQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for CardQuery", fn)
+		}
+		cq.order = append(cq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (cq *CardQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Card:
+			cq.predicates = append(cq.predicates, p)
+		case func(*sql.Selector):
+			cq.predicates = append(cq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for CardQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryOwner chains the current query on the "owner" edge.
 func (cq *CardQuery) QueryOwner() *UserQuery {
-	query := &UserQuery{config: cq.config}
+	query := (&UserClient{config: cq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := cq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -186,7 +231,22 @@ func (cq *CardQuery) All(ctx context.Context) ([]*Card, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return cq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CardQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, cq, qr, cq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Card)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -221,7 +281,22 @@ func (cq *CardQuery) Count(ctx context.Context) (int, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return cq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CardQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, cq, qr, cq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -238,7 +313,22 @@ func (cq *CardQuery) Exist(ctx context.Context) (bool, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return cq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CardQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, cq, qr, cq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -262,17 +352,19 @@ func (cq *CardQuery) Clone() *CardQuery {
 		offset:     cq.offset,
 		order:      append([]OrderFunc{}, cq.order...),
 		predicates: append([]predicate.Card{}, cq.predicates...),
+		inters:     append([]Interceptor{}, cq.inters...),
 		withOwner:  cq.withOwner.Clone(),
 		// clone intermediate query.
-		sql:  cq.sql.Clone(),
-		path: cq.path,
+		sql:       cq.sql.Clone(),
+		path:      cq.path,
+		modifiers: append([]func(*sql.Selector){}, cq.modifiers...),
 	}
 }
 
 // WithOwner tells the query-builder to eager-load the nodes that are connected to
 // the "owner" edge. The optional arguments are used to configure the query builder of the edge.
 func (cq *CardQuery) WithOwner(opts ...func(*UserQuery)) *CardQuery {
-	query := &UserQuery{config: cq.config}
+	query := (&UserClient{config: cq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -344,7 +436,7 @@ func (cq *CardQuery) prepareQuery(ctx context.Context) error {
 func (cq *CardQuery) sqlAll(ctx context.Context) ([]*Card, error) {
 	var (
 		nodes       = []*Card{}
-		_spec       = cq.querySpec()
+		_spec       = cq.querySpec(ctx)
 		loadedTypes = [1]bool{
 			cq.withOwner != nil,
 		}
@@ -397,7 +489,7 @@ func (cq *CardQuery) sqlAll(ctx context.Context) ([]*Card, error) {
 }
 
 func (cq *CardQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := cq.querySpec()
+	_spec := cq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, cq.driver, _spec)
 }
 
@@ -409,7 +501,7 @@ func (cq *CardQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (cq *CardQuery) querySpec() *sqlgraph.QuerySpec {
+func (cq *CardQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   card.Table,
@@ -451,6 +543,9 @@ func (cq *CardQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := cq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -476,9 +571,40 @@ func (cq *CardQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := cq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range cq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (cq *CardQuery) ForUpdate(opts ...sql.LockOption) *CardQuery {
+	cq.modifiers = append(cq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return cq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (cq *CardQuery) ForShare(opts ...sql.LockOption) *CardQuery {
+	cq.modifiers = append(cq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return cq
+}
+
 // CardGroupBy is the group-by builder for Card entities.
 type CardGroupBy struct {
 	config
@@ -728,7 +854,8 @@ func (cgb *CardGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (cgb *CardGroupBy) sqlQuery() *sql.Selector {
-	selector := cgb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := cgb.sql.ClearLock()
 	columns := make([]string, 0, len(cgb.fields)+len(cgb.fns))
 	columns = append(columns, cgb.fields...)
 	for _, fn := range cgb.fns {
@@ -749,8 +876,12 @@ func (cs *CardSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := cs.prepareQuery(ctx); err != nil {
 		return err
 	}
-	cs.sql = cs.CardQuery.sqlQuery(ctx)
-	return cs.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		cs.sql = cs.CardQuery.sqlQuery(ctx)
+		return v, cs.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, cs.CardQuery, qr, cs.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -10,6 +10,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"math/rand"
+	"time"
 
 	"entgo.io/ent/entc/integration/edgefield/ent/migrate"
 
@@ -46,7 +48,7 @@ type Client struct {
 
 // NewClient creates a new client configured with the given options.
 func NewClient(opts ...Option) *Client {
-	cfg := config{log: log.Println, hooks: &hooks{}}
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
 	cfg.options(opts...)
 	client := &Client{config: cfg}
 	client.init()
@@ -81,9 +83,20 @@ func Open(driverName, dataSourceName string, options ...Option) (*Client, error)
 
 // Tx returns a new transactional client. The provided context
 // is used until the transaction is committed or rolled back.
+//
+// Calling Tx on a transactional client (e.g. tx.Client()) starts a nested transaction
+// that is backed by a savepoint, and can be committed or rolled back independently.
 func (c *Client) Tx(ctx context.Context) (*Tx, error) {
-	if _, ok := c.driver.(*txDriver); ok {
-		return nil, fmt.Errorf("ent: cannot start a transaction within a transaction")
+	if parent, ok := c.driver.(*txDriver); ok {
+		nested, err := parent.savepoint(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("ent: starting a nested transaction: %w", err)
+		}
+		cfg := c.config
+		cfg.driver = nested
+		tx := &Tx{ctx: ctx, config: cfg}
+		tx.init()
+		return tx, nil
 	}
 	tx, err := newTx(ctx, c.driver)
 	if err != nil {
@@ -117,6 +130,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
+		ctx:      ctx,
 		config:   cfg,
 		Card:     NewCardClient(cfg),
 		Info:     NewInfoClient(cfg),
@@ -127,6 +141,121 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	}, nil
 }
 
+type (
+	// TxOption configures the transactions that are executed by Client.WithTx.
+	TxOption func(*txConfig)
+
+	// txConfig holds the configuration of Client.WithTx.
+	txConfig struct {
+		opts      *sql.TxOptions
+		attempts  int
+		backoff   func(attempt int) time.Duration
+		retryable func(error) bool
+	}
+)
+
+// WithTxOptions sets the options (e.g. the isolation level) of the transactions.
+func WithTxOptions(opts *sql.TxOptions) TxOption {
+	return func(c *txConfig) {
+		c.opts = opts
+	}
+}
+
+// WithTxAttempts sets the maximum number of times that a transaction is executed.
+// The default is 3, and 1 disables retries.
+func WithTxAttempts(n int) TxOption {
+	return func(c *txConfig) {
+		c.attempts = n
+	}
+}
+
+// WithTxBackoff sets the function that returns how long to wait before retrying a transaction
+// that failed on the given attempt (starting at 1). The default is an exponential backoff
+// with jitter, starting at 10ms.
+func WithTxBackoff(fn func(attempt int) time.Duration) TxOption {
+	return func(c *txConfig) {
+		c.backoff = fn
+	}
+}
+
+// WithTxRetryable sets the function that reports if a transaction that failed with the given
+// error should be retried. The default is sql.IsRetryableError that reports if the transaction
+// was aborted due to a deadlock, a lock timeout or a serialization failure.
+func WithTxRetryable(fn func(error) bool) TxOption {
+	return func(c *txConfig) {
+		c.retryable = fn
+	}
+}
+
+// WithTx executes fn in a transaction, and commits it if fn returns nil, or rolls it back
+// in case fn returns an error or panics. Transactions that fail due to transient errors, such
+// as deadlocks and serialization failures, are retried. Therefore, fn should not have side
+// effects outside the transaction. For example:
+//
+//	err := client.WithTx(ctx, func(tx *ent.Tx) error {
+//		// Use tx here.
+//		return nil
+//	}, ent.WithTxOptions(&sql.TxOptions{Isolation: sql.LevelSerializable}))
+//
+// If the client is a transactional client, fn is executed in a nested transaction,
+// and it is not retried, as these errors abort the outermost transaction.
+func (c *Client) WithTx(ctx context.Context, fn func(*Tx) error, opts ...TxOption) error {
+	cfg := txConfig{attempts: 3, backoff: txBackoff, retryable: sql.IsRetryableError}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		tx, err := c.Tx(ctx)
+		if err != nil {
+			return err
+		}
+		return runTx(tx, fn)
+	}
+	for attempt := 1; ; attempt++ {
+		tx, err := c.BeginTx(ctx, cfg.opts)
+		if err == nil {
+			err = runTx(tx, fn)
+		}
+		if err == nil || attempt >= cfg.attempts || !cfg.retryable(err) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(cfg.backoff(attempt)):
+		}
+	}
+}
+
+// runTx executes fn in the given transaction, and commits or rolls it back.
+func runTx(tx *Tx, fn func(*Tx) error) error {
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	return nil
+}
+
+// txBackoff is the default backoff of Client.WithTx. It is capped at 1s.
+func txBackoff(attempt int) time.Duration {
+	d := time.Second
+	if attempt <= 7 {
+		d = 10 * time.Millisecond << (attempt - 1)
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)))
+}
+
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
@@ -161,6 +290,17 @@ func (c *Client) Use(hooks ...Hook) {
 	c.User.Use(hooks...)
 }
 
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.Card.Intercept(interceptors...)
+	c.Info.Intercept(interceptors...)
+	c.Metadata.Intercept(interceptors...)
+	c.Pet.Intercept(interceptors...)
+	c.Post.Intercept(interceptors...)
+	c.User.Intercept(interceptors...)
+}
+
 // CardClient is a client for the Card schema.
 type CardClient struct {
 	config
@@ -177,6 +317,12 @@ func (c *CardClient) Use(hooks ...Hook) {
 	c.hooks.Card = append(c.hooks.Card, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `card.Intercept(f(g(h())))`.
+func (c *CardClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Card = append(c.inters.Card, interceptors...)
+}
+
 // Create returns a create builder for Card.
 func (c *CardClient) Create() *CardCreate {
 	mutation := newCardMutation(c.config, OpCreate)
@@ -227,7 +373,7 @@ func (c *CardClient) DeleteOneID(id int) *CardDeleteOne {
 
 // Query returns a query builder for Card.
 func (c *CardClient) Query() *CardQuery {
-	return &CardQuery{config: c.config}
+	return &CardQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Card entity by its id.
@@ -246,7 +392,7 @@ func (c *CardClient) GetX(ctx context.Context, id int) *Card {
 
 // QueryOwner queries the owner edge of a Card.
 func (c *CardClient) QueryOwner(ca *Card) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := ca.ID
 		step := sqlgraph.NewStep(
@@ -265,6 +411,11 @@ func (c *CardClient) Hooks() []Hook {
 	return c.hooks.Card
 }
 
+// Interceptors returns the client interceptors.
+func (c *CardClient) Interceptors() []Interceptor {
+	return c.inters.Card
+}
+
 // InfoClient is a client for the Info schema.
 type InfoClient struct {
 	config
@@ -281,6 +432,12 @@ func (c *InfoClient) Use(hooks ...Hook) {
 	c.hooks.Info = append(c.hooks.Info, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `info.Intercept(f(g(h())))`.
+func (c *InfoClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Info = append(c.inters.Info, interceptors...)
+}
+
 // Create returns a create builder for Info.
 func (c *InfoClient) Create() *InfoCreate {
 	mutation := newInfoMutation(c.config, OpCreate)
@@ -331,7 +488,7 @@ func (c *InfoClient) DeleteOneID(id int) *InfoDeleteOne {
 
 // Query returns a query builder for Info.
 func (c *InfoClient) Query() *InfoQuery {
-	return &InfoQuery{config: c.config}
+	return &InfoQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Info entity by its id.
@@ -350,7 +507,7 @@ func (c *InfoClient) GetX(ctx context.Context, id int) *Info {
 
 // QueryUser queries the user edge of a Info.
 func (c *InfoClient) QueryUser(i *Info) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := i.ID
 		step := sqlgraph.NewStep(
@@ -369,6 +526,11 @@ func (c *InfoClient) Hooks() []Hook {
 	return c.hooks.Info
 }
 
+// Interceptors returns the client interceptors.
+func (c *InfoClient) Interceptors() []Interceptor {
+	return c.inters.Info
+}
+
 // MetadataClient is a client for the Metadata schema.
 type MetadataClient struct {
 	config
@@ -385,6 +547,12 @@ func (c *MetadataClient) Use(hooks ...Hook) {
 	c.hooks.Metadata = append(c.hooks.Metadata, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `metadata.Intercept(f(g(h())))`.
+func (c *MetadataClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Metadata = append(c.inters.Metadata, interceptors...)
+}
+
 // Create returns a create builder for Metadata.
 func (c *MetadataClient) Create() *MetadataCreate {
 	mutation := newMetadataMutation(c.config, OpCreate)
@@ -435,7 +603,7 @@ func (c *MetadataClient) DeleteOneID(id int) *MetadataDeleteOne {
 
 // Query returns a query builder for Metadata.
 func (c *MetadataClient) Query() *MetadataQuery {
-	return &MetadataQuery{config: c.config}
+	return &MetadataQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Metadata entity by its id.
@@ -454,7 +622,7 @@ func (c *MetadataClient) GetX(ctx context.Context, id int) *Metadata {
 
 // QueryUser queries the user edge of a Metadata.
 func (c *MetadataClient) QueryUser(m *Metadata) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := m.ID
 		step := sqlgraph.NewStep(
@@ -473,6 +641,11 @@ func (c *MetadataClient) Hooks() []Hook {
 	return c.hooks.Metadata
 }
 
+// Interceptors returns the client interceptors.
+func (c *MetadataClient) Interceptors() []Interceptor {
+	return c.inters.Metadata
+}
+
 // PetClient is a client for the Pet schema.
 type PetClient struct {
 	config
@@ -489,6 +662,12 @@ func (c *PetClient) Use(hooks ...Hook) {
 	c.hooks.Pet = append(c.hooks.Pet, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pet.Intercept(f(g(h())))`.
+func (c *PetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Pet = append(c.inters.Pet, interceptors...)
+}
+
 // Create returns a create builder for Pet.
 func (c *PetClient) Create() *PetCreate {
 	mutation := newPetMutation(c.config, OpCreate)
@@ -539,7 +718,7 @@ func (c *PetClient) DeleteOneID(id int) *PetDeleteOne {
 
 // Query returns a query builder for Pet.
 func (c *PetClient) Query() *PetQuery {
-	return &PetQuery{config: c.config}
+	return &PetQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Pet entity by its id.
@@ -558,7 +737,7 @@ func (c *PetClient) GetX(ctx context.Context, id int) *Pet {
 
 // QueryOwner queries the owner edge of a Pet.
 func (c *PetClient) QueryOwner(pe *Pet) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := pe.ID
 		step := sqlgraph.NewStep(
@@ -577,6 +756,11 @@ func (c *PetClient) Hooks() []Hook {
 	return c.hooks.Pet
 }
 
+// Interceptors returns the client interceptors.
+func (c *PetClient) Interceptors() []Interceptor {
+	return c.inters.Pet
+}
+
 // PostClient is a client for the Post schema.
 type PostClient struct {
 	config
@@ -593,6 +777,12 @@ func (c *PostClient) Use(hooks ...Hook) {
 	c.hooks.Post = append(c.hooks.Post, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `post.Intercept(f(g(h())))`.
+func (c *PostClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Post = append(c.inters.Post, interceptors...)
+}
+
 // Create returns a create builder for Post.
 func (c *PostClient) Create() *PostCreate {
 	mutation := newPostMutation(c.config, OpCreate)
@@ -643,7 +833,7 @@ func (c *PostClient) DeleteOneID(id int) *PostDeleteOne {
 
 // Query returns a query builder for Post.
 func (c *PostClient) Query() *PostQuery {
-	return &PostQuery{config: c.config}
+	return &PostQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Post entity by its id.
@@ -662,7 +852,7 @@ func (c *PostClient) GetX(ctx context.Context, id int) *Post {
 
 // QueryAuthor queries the author edge of a Post.
 func (c *PostClient) QueryAuthor(po *Post) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := po.ID
 		step := sqlgraph.NewStep(
@@ -681,6 +871,11 @@ func (c *PostClient) Hooks() []Hook {
 	return c.hooks.Post
 }
 
+// Interceptors returns the client interceptors.
+func (c *PostClient) Interceptors() []Interceptor {
+	return c.inters.Post
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -697,6 +892,12 @@ func (c *UserClient) Use(hooks ...Hook) {
 	c.hooks.User = append(c.hooks.User, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
 // Create returns a create builder for User.
 func (c *UserClient) Create() *UserCreate {
 	mutation := newUserMutation(c.config, OpCreate)
@@ -747,7 +948,7 @@ func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
 
 // Query returns a query builder for User.
 func (c *UserClient) Query() *UserQuery {
-	return &UserQuery{config: c.config}
+	return &UserQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a User entity by its id.
@@ -766,7 +967,7 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 
 // QueryPets queries the pets edge of a User.
 func (c *UserClient) QueryPets(u *User) *PetQuery {
-	query := &PetQuery{config: c.config}
+	query := (&PetClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -782,7 +983,7 @@ func (c *UserClient) QueryPets(u *User) *PetQuery {
 
 // QueryParent queries the parent edge of a User.
 func (c *UserClient) QueryParent(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -798,7 +999,7 @@ func (c *UserClient) QueryParent(u *User) *UserQuery {
 
 // QueryChildren queries the children edge of a User.
 func (c *UserClient) QueryChildren(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -814,7 +1015,7 @@ func (c *UserClient) QueryChildren(u *User) *UserQuery {
 
 // QuerySpouse queries the spouse edge of a User.
 func (c *UserClient) QuerySpouse(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -830,7 +1031,7 @@ func (c *UserClient) QuerySpouse(u *User) *UserQuery {
 
 // QueryCard queries the card edge of a User.
 func (c *UserClient) QueryCard(u *User) *CardQuery {
-	query := &CardQuery{config: c.config}
+	query := (&CardClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -846,7 +1047,7 @@ func (c *UserClient) QueryCard(u *User) *CardQuery {
 
 // QueryMetadata queries the metadata edge of a User.
 func (c *UserClient) QueryMetadata(u *User) *MetadataQuery {
-	query := &MetadataQuery{config: c.config}
+	query := (&MetadataClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -862,7 +1063,7 @@ func (c *UserClient) QueryMetadata(u *User) *MetadataQuery {
 
 // QueryInfo queries the info edge of a User.
 func (c *UserClient) QueryInfo(u *User) *InfoQuery {
-	query := &InfoQuery{config: c.config}
+	query := (&InfoClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -880,3 +1081,8 @@ func (c *UserClient) QueryInfo(u *User) *InfoQuery {
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
 }
+
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
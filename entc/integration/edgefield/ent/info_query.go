@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgefield/ent/info"
@@ -28,8 +29,10 @@ type InfoQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Info
+	inters     []Interceptor
 	// eager-loading edges.
-	withUser *UserQuery
+	withUser  *UserQuery
+	modifiers []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -59,9 +62,51 @@ func (iq *InfoQuery) Order(o ...OrderFunc) *InfoQuery {
 	return iq
 }
 
+// Type returns the node type of this query (Info). It implements the ent.Query interface.
+func (iq *InfoQuery) Type() string {
+	return TypeInfo
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (iq *InfoQuery) SetLimit(limit int) {
+	iq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (iq *InfoQuery) SetOffset(offset int) {
+	iq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (iq *InfoQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for InfoQuery", fn)
+		}
+		iq.order = append(iq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (iq *InfoQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Info:
+			iq.predicates = append(iq.predicates, p)
+		case func(*sql.Selector):
+			iq.predicates = append(iq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for InfoQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryUser chains the current query on the "user" edge.
 func (iq *InfoQuery) QueryUser() *UserQuery {
-	query := &UserQuery{config: iq.config}
+	query := (&UserClient{config: iq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := iq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -186,7 +231,22 @@ func (iq *InfoQuery) All(ctx context.Context) ([]*Info, error) {
 	if err := iq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return iq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*InfoQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, iq, qr, iq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Info)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -221,7 +281,22 @@ func (iq *InfoQuery) Count(ctx context.Context) (int, error) {
 	if err := iq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return iq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*InfoQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, iq, qr, iq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -238,7 +313,22 @@ func (iq *InfoQuery) Exist(ctx context.Context) (bool, error) {
 	if err := iq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return iq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*InfoQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, iq, qr, iq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -262,17 +352,19 @@ func (iq *InfoQuery) Clone() *InfoQuery {
 		offset:     iq.offset,
 		order:      append([]OrderFunc{}, iq.order...),
 		predicates: append([]predicate.Info{}, iq.predicates...),
+		inters:     append([]Interceptor{}, iq.inters...),
 		withUser:   iq.withUser.Clone(),
 		// clone intermediate query.
-		sql:  iq.sql.Clone(),
-		path: iq.path,
+		sql:       iq.sql.Clone(),
+		path:      iq.path,
+		modifiers: append([]func(*sql.Selector){}, iq.modifiers...),
 	}
 }
 
 // WithUser tells the query-builder to eager-load the nodes that are connected to
 // the "user" edge. The optional arguments are used to configure the query builder of the edge.
 func (iq *InfoQuery) WithUser(opts ...func(*UserQuery)) *InfoQuery {
-	query := &UserQuery{config: iq.config}
+	query := (&UserClient{config: iq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -344,7 +436,7 @@ func (iq *InfoQuery) prepareQuery(ctx context.Context) error {
 func (iq *InfoQuery) sqlAll(ctx context.Context) ([]*Info, error) {
 	var (
 		nodes       = []*Info{}
-		_spec       = iq.querySpec()
+		_spec       = iq.querySpec(ctx)
 		loadedTypes = [1]bool{
 			iq.withUser != nil,
 		}
@@ -397,7 +489,7 @@ func (iq *InfoQuery) sqlAll(ctx context.Context) ([]*Info, error) {
 }
 
 func (iq *InfoQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := iq.querySpec()
+	_spec := iq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, iq.driver, _spec)
 }
 
@@ -409,7 +501,7 @@ func (iq *InfoQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (iq *InfoQuery) querySpec() *sqlgraph.QuerySpec {
+func (iq *InfoQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   info.Table,
@@ -451,6 +543,9 @@ func (iq *InfoQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := iq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -476,9 +571,40 @@ func (iq *InfoQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := iq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range iq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (iq *InfoQuery) ForUpdate(opts ...sql.LockOption) *InfoQuery {
+	iq.modifiers = append(iq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return iq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (iq *InfoQuery) ForShare(opts ...sql.LockOption) *InfoQuery {
+	iq.modifiers = append(iq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return iq
+}
+
 // InfoGroupBy is the group-by builder for Info entities.
 type InfoGroupBy struct {
 	config
@@ -728,7 +854,8 @@ func (igb *InfoGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (igb *InfoGroupBy) sqlQuery() *sql.Selector {
-	selector := igb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := igb.sql.ClearLock()
 	columns := make([]string, 0, len(igb.fields)+len(igb.fns))
 	columns = append(columns, igb.fields...)
 	for _, fn := range igb.fns {
@@ -749,8 +876,12 @@ func (is *InfoSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := is.prepareQuery(ctx); err != nil {
 		return err
 	}
-	is.sql = is.InfoQuery.sqlQuery(ctx)
-	return is.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		is.sql = is.InfoQuery.sqlQuery(ctx)
+		return v, is.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, is.InfoQuery, qr, is.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgefield/ent/metadata"
@@ -28,8 +29,10 @@ type MetadataQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Metadata
+	inters     []Interceptor
 	// eager-loading edges.
-	withUser *UserQuery
+	withUser  *UserQuery
+	modifiers []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -59,9 +62,51 @@ func (mq *MetadataQuery) Order(o ...OrderFunc) *MetadataQuery {
 	return mq
 }
 
+// Type returns the node type of this query (Metadata). It implements the ent.Query interface.
+func (mq *MetadataQuery) Type() string {
+	return TypeMetadata
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (mq *MetadataQuery) SetLimit(limit int) {
+	mq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (mq *MetadataQuery) SetOffset(offset int) {
+	mq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (mq *MetadataQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for MetadataQuery", fn)
+		}
+		mq.order = append(mq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (mq *MetadataQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Metadata:
+			mq.predicates = append(mq.predicates, p)
+		case func(*sql.Selector):
+			mq.predicates = append(mq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for MetadataQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryUser chains the current query on the "user" edge.
 func (mq *MetadataQuery) QueryUser() *UserQuery {
-	query := &UserQuery{config: mq.config}
+	query := (&UserClient{config: mq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := mq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -186,7 +231,22 @@ func (mq *MetadataQuery) All(ctx context.Context) ([]*Metadata, error) {
 	if err := mq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return mq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*MetadataQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, mq, qr, mq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Metadata)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -221,7 +281,22 @@ func (mq *MetadataQuery) Count(ctx context.Context) (int, error) {
 	if err := mq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return mq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*MetadataQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, mq, qr, mq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -238,7 +313,22 @@ func (mq *MetadataQuery) Exist(ctx context.Context) (bool, error) {
 	if err := mq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return mq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*MetadataQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, mq, qr, mq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -262,17 +352,19 @@ func (mq *MetadataQuery) Clone() *MetadataQuery {
 		offset:     mq.offset,
 		order:      append([]OrderFunc{}, mq.order...),
 		predicates: append([]predicate.Metadata{}, mq.predicates...),
+		inters:     append([]Interceptor{}, mq.inters...),
 		withUser:   mq.withUser.Clone(),
 		// clone intermediate query.
-		sql:  mq.sql.Clone(),
-		path: mq.path,
+		sql:       mq.sql.Clone(),
+		path:      mq.path,
+		modifiers: append([]func(*sql.Selector){}, mq.modifiers...),
 	}
 }
 
 // WithUser tells the query-builder to eager-load the nodes that are connected to
 // the "user" edge. The optional arguments are used to configure the query builder of the edge.
 func (mq *MetadataQuery) WithUser(opts ...func(*UserQuery)) *MetadataQuery {
-	query := &UserQuery{config: mq.config}
+	query := (&UserClient{config: mq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -344,7 +436,7 @@ func (mq *MetadataQuery) prepareQuery(ctx context.Context) error {
 func (mq *MetadataQuery) sqlAll(ctx context.Context) ([]*Metadata, error) {
 	var (
 		nodes       = []*Metadata{}
-		_spec       = mq.querySpec()
+		_spec       = mq.querySpec(ctx)
 		loadedTypes = [1]bool{
 			mq.withUser != nil,
 		}
@@ -397,7 +489,7 @@ func (mq *MetadataQuery) sqlAll(ctx context.Context) ([]*Metadata, error) {
 }
 
 func (mq *MetadataQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := mq.querySpec()
+	_spec := mq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, mq.driver, _spec)
 }
 
@@ -409,7 +501,7 @@ func (mq *MetadataQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (mq *MetadataQuery) querySpec() *sqlgraph.QuerySpec {
+func (mq *MetadataQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   metadata.Table,
@@ -451,6 +543,9 @@ func (mq *MetadataQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := mq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -476,9 +571,40 @@ func (mq *MetadataQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := mq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range mq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (mq *MetadataQuery) ForUpdate(opts ...sql.LockOption) *MetadataQuery {
+	mq.modifiers = append(mq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return mq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (mq *MetadataQuery) ForShare(opts ...sql.LockOption) *MetadataQuery {
+	mq.modifiers = append(mq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return mq
+}
+
 // MetadataGroupBy is the group-by builder for Metadata entities.
 type MetadataGroupBy struct {
 	config
@@ -728,7 +854,8 @@ func (mgb *MetadataGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (mgb *MetadataGroupBy) sqlQuery() *sql.Selector {
-	selector := mgb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := mgb.sql.ClearLock()
 	columns := make([]string, 0, len(mgb.fields)+len(mgb.fns))
 	columns = append(columns, mgb.fields...)
 	for _, fn := range mgb.fns {
@@ -749,8 +876,12 @@ func (ms *MetadataSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := ms.prepareQuery(ctx); err != nil {
 		return err
 	}
-	ms.sql = ms.MetadataQuery.sqlQuery(ctx)
-	return ms.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		ms.sql = ms.MetadataQuery.sqlQuery(ctx)
+		return v, ms.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, ms.MetadataQuery, qr, ms.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
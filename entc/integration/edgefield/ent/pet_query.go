@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgefield/ent/pet"
@@ -28,8 +29,10 @@ type PetQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Pet
+	inters     []Interceptor
 	// eager-loading edges.
 	withOwner *UserQuery
+	modifiers []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -59,9 +62,51 @@ func (pq *PetQuery) Order(o ...OrderFunc) *PetQuery {
 	return pq
 }
 
+// Type returns the node type of this query (Pet). It implements the ent.Query interface.
+func (pq *PetQuery) Type() string {
+	return TypePet
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (pq *PetQuery) SetLimit(limit int) {
+	pq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (pq *PetQuery) SetOffset(offset int) {
+	pq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (pq *PetQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for PetQuery", fn)
+		}
+		pq.order = append(pq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (pq *PetQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Pet:
+			pq.predicates = append(pq.predicates, p)
+		case func(*sql.Selector):
+			pq.predicates = append(pq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for PetQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryOwner chains the current query on the "owner" edge.
 func (pq *PetQuery) QueryOwner() *UserQuery {
-	query := &UserQuery{config: pq.config}
+	query := (&UserClient{config: pq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := pq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -186,7 +231,22 @@ func (pq *PetQuery) All(ctx context.Context) ([]*Pet, error) {
 	if err := pq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return pq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*PetQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, pq, qr, pq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Pet)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -221,7 +281,22 @@ func (pq *PetQuery) Count(ctx context.Context) (int, error) {
 	if err := pq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return pq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*PetQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, pq, qr, pq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -238,7 +313,22 @@ func (pq *PetQuery) Exist(ctx context.Context) (bool, error) {
 	if err := pq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return pq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*PetQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, pq, qr, pq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -262,17 +352,19 @@ func (pq *PetQuery) Clone() *PetQuery {
 		offset:     pq.offset,
 		order:      append([]OrderFunc{}, pq.order...),
 		predicates: append([]predicate.Pet{}, pq.predicates...),
+		inters:     append([]Interceptor{}, pq.inters...),
 		withOwner:  pq.withOwner.Clone(),
 		// clone intermediate query.
-		sql:  pq.sql.Clone(),
-		path: pq.path,
+		sql:       pq.sql.Clone(),
+		path:      pq.path,
+		modifiers: append([]func(*sql.Selector){}, pq.modifiers...),
 	}
 }
 
 // WithOwner tells the query-builder to eager-load the nodes that are connected to
 // the "owner" edge. The optional arguments are used to configure the query builder of the edge.
 func (pq *PetQuery) WithOwner(opts ...func(*UserQuery)) *PetQuery {
-	query := &UserQuery{config: pq.config}
+	query := (&UserClient{config: pq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -344,7 +436,7 @@ func (pq *PetQuery) prepareQuery(ctx context.Context) error {
 func (pq *PetQuery) sqlAll(ctx context.Context) ([]*Pet, error) {
 	var (
 		nodes       = []*Pet{}
-		_spec       = pq.querySpec()
+		_spec       = pq.querySpec(ctx)
 		loadedTypes = [1]bool{
 			pq.withOwner != nil,
 		}
@@ -397,7 +489,7 @@ func (pq *PetQuery) sqlAll(ctx context.Context) ([]*Pet, error) {
 }
 
 func (pq *PetQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := pq.querySpec()
+	_spec := pq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, pq.driver, _spec)
 }
 
@@ -409,7 +501,7 @@ func (pq *PetQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (pq *PetQuery) querySpec() *sqlgraph.QuerySpec {
+func (pq *PetQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   pet.Table,
@@ -451,6 +543,9 @@ func (pq *PetQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := pq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -476,9 +571,40 @@ func (pq *PetQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := pq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range pq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pq *PetQuery) ForUpdate(opts ...sql.LockOption) *PetQuery {
+	pq.modifiers = append(pq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return pq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pq *PetQuery) ForShare(opts ...sql.LockOption) *PetQuery {
+	pq.modifiers = append(pq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return pq
+}
+
 // PetGroupBy is the group-by builder for Pet entities.
 type PetGroupBy struct {
 	config
@@ -728,7 +854,8 @@ func (pgb *PetGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (pgb *PetGroupBy) sqlQuery() *sql.Selector {
-	selector := pgb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := pgb.sql.ClearLock()
 	columns := make([]string, 0, len(pgb.fields)+len(pgb.fns))
 	columns = append(columns, pgb.fields...)
 	for _, fn := range pgb.fns {
@@ -749,8 +876,12 @@ func (ps *PetSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := ps.prepareQuery(ctx); err != nil {
 		return err
 	}
-	ps.sql = ps.PetQuery.sqlQuery(ctx)
-	return ps.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		ps.sql = ps.PetQuery.sqlQuery(ctx)
+		return v, ps.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, ps.PetQuery, qr, ps.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgefield/ent/post"
@@ -28,8 +29,10 @@ type PostQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Post
+	inters     []Interceptor
 	// eager-loading edges.
 	withAuthor *UserQuery
+	modifiers  []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -59,9 +62,51 @@ func (pq *PostQuery) Order(o ...OrderFunc) *PostQuery {
 	return pq
 }
 
+// Type returns the node type of this query (Post). It implements the ent.Query interface.
+func (pq *PostQuery) Type() string {
+	return TypePost
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (pq *PostQuery) SetLimit(limit int) {
+	pq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (pq *PostQuery) SetOffset(offset int) {
+	pq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (pq *PostQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for PostQuery", fn)
+		}
+		pq.order = append(pq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (pq *PostQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Post:
+			pq.predicates = append(pq.predicates, p)
+		case func(*sql.Selector):
+			pq.predicates = append(pq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for PostQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryAuthor chains the current query on the "author" edge.
 func (pq *PostQuery) QueryAuthor() *UserQuery {
-	query := &UserQuery{config: pq.config}
+	query := (&UserClient{config: pq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := pq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -186,7 +231,22 @@ func (pq *PostQuery) All(ctx context.Context) ([]*Post, error) {
 	if err := pq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return pq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*PostQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, pq, qr, pq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Post)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -221,7 +281,22 @@ func (pq *PostQuery) Count(ctx context.Context) (int, error) {
 	if err := pq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return pq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*PostQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, pq, qr, pq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -238,7 +313,22 @@ func (pq *PostQuery) Exist(ctx context.Context) (bool, error) {
 	if err := pq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return pq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*PostQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, pq, qr, pq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -262,17 +352,19 @@ func (pq *PostQuery) Clone() *PostQuery {
 		offset:     pq.offset,
 		order:      append([]OrderFunc{}, pq.order...),
 		predicates: append([]predicate.Post{}, pq.predicates...),
+		inters:     append([]Interceptor{}, pq.inters...),
 		withAuthor: pq.withAuthor.Clone(),
 		// clone intermediate query.
-		sql:  pq.sql.Clone(),
-		path: pq.path,
+		sql:       pq.sql.Clone(),
+		path:      pq.path,
+		modifiers: append([]func(*sql.Selector){}, pq.modifiers...),
 	}
 }
 
 // WithAuthor tells the query-builder to eager-load the nodes that are connected to
 // the "author" edge. The optional arguments are used to configure the query builder of the edge.
 func (pq *PostQuery) WithAuthor(opts ...func(*UserQuery)) *PostQuery {
-	query := &UserQuery{config: pq.config}
+	query := (&UserClient{config: pq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -344,7 +436,7 @@ func (pq *PostQuery) prepareQuery(ctx context.Context) error {
 func (pq *PostQuery) sqlAll(ctx context.Context) ([]*Post, error) {
 	var (
 		nodes       = []*Post{}
-		_spec       = pq.querySpec()
+		_spec       = pq.querySpec(ctx)
 		loadedTypes = [1]bool{
 			pq.withAuthor != nil,
 		}
@@ -399,7 +491,7 @@ func (pq *PostQuery) sqlAll(ctx context.Context) ([]*Post, error) {
 }
 
 func (pq *PostQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := pq.querySpec()
+	_spec := pq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, pq.driver, _spec)
 }
 
@@ -411,7 +503,7 @@ func (pq *PostQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (pq *PostQuery) querySpec() *sqlgraph.QuerySpec {
+func (pq *PostQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   post.Table,
@@ -453,6 +545,9 @@ func (pq *PostQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := pq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -478,9 +573,40 @@ func (pq *PostQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := pq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range pq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pq *PostQuery) ForUpdate(opts ...sql.LockOption) *PostQuery {
+	pq.modifiers = append(pq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return pq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pq *PostQuery) ForShare(opts ...sql.LockOption) *PostQuery {
+	pq.modifiers = append(pq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return pq
+}
+
 // PostGroupBy is the group-by builder for Post entities.
 type PostGroupBy struct {
 	config
@@ -730,7 +856,8 @@ func (pgb *PostGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (pgb *PostGroupBy) sqlQuery() *sql.Selector {
-	selector := pgb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := pgb.sql.ClearLock()
 	columns := make([]string, 0, len(pgb.fields)+len(pgb.fns))
 	columns = append(columns, pgb.fields...)
 	for _, fn := range pgb.fns {
@@ -751,8 +878,12 @@ func (ps *PostSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := ps.prepareQuery(ctx); err != nil {
 		return err
 	}
-	ps.sql = ps.PostQuery.sqlQuery(ctx)
-	return ps.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		ps.sql = ps.PostQuery.sqlQuery(ctx)
+		return v, ps.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, ps.PostQuery, qr, ps.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/edgefield/ent/card"
@@ -32,6 +33,7 @@ type UserQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.User
+	inters     []Interceptor
 	// eager-loading edges.
 	withPets     *PetQuery
 	withParent   *UserQuery
@@ -40,6 +42,7 @@ type UserQuery struct {
 	withCard     *CardQuery
 	withMetadata *MetadataQuery
 	withInfo     *InfoQuery
+	modifiers    []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -69,9 +72,51 @@ func (uq *UserQuery) Order(o ...OrderFunc) *UserQuery {
 	return uq
 }
 
+// Type returns the node type of this query (User). It implements the ent.Query interface.
+func (uq *UserQuery) Type() string {
+	return TypeUser
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (uq *UserQuery) SetLimit(limit int) {
+	uq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (uq *UserQuery) SetOffset(offset int) {
+	uq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (uq *UserQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for UserQuery", fn)
+		}
+		uq.order = append(uq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (uq *UserQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.User:
+			uq.predicates = append(uq.predicates, p)
+		case func(*sql.Selector):
+			uq.predicates = append(uq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for UserQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryPets chains the current query on the "pets" edge.
 func (uq *UserQuery) QueryPets() *PetQuery {
-	query := &PetQuery{config: uq.config}
+	query := (&PetClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -93,7 +138,7 @@ func (uq *UserQuery) QueryPets() *PetQuery {
 
 // QueryParent chains the current query on the "parent" edge.
 func (uq *UserQuery) QueryParent() *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -115,7 +160,7 @@ func (uq *UserQuery) QueryParent() *UserQuery {
 
 // QueryChildren chains the current query on the "children" edge.
 func (uq *UserQuery) QueryChildren() *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -137,7 +182,7 @@ func (uq *UserQuery) QueryChildren() *UserQuery {
 
 // QuerySpouse chains the current query on the "spouse" edge.
 func (uq *UserQuery) QuerySpouse() *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -159,7 +204,7 @@ func (uq *UserQuery) QuerySpouse() *UserQuery {
 
 // QueryCard chains the current query on the "card" edge.
 func (uq *UserQuery) QueryCard() *CardQuery {
-	query := &CardQuery{config: uq.config}
+	query := (&CardClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -181,7 +226,7 @@ func (uq *UserQuery) QueryCard() *CardQuery {
 
 // QueryMetadata chains the current query on the "metadata" edge.
 func (uq *UserQuery) QueryMetadata() *MetadataQuery {
-	query := &MetadataQuery{config: uq.config}
+	query := (&MetadataClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -203,7 +248,7 @@ func (uq *UserQuery) QueryMetadata() *MetadataQuery {
 
 // QueryInfo chains the current query on the "info" edge.
 func (uq *UserQuery) QueryInfo() *InfoQuery {
-	query := &InfoQuery{config: uq.config}
+	query := (&InfoClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -328,7 +373,22 @@ func (uq *UserQuery) All(ctx context.Context) ([]*User, error) {
 	if err := uq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return uq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*UserQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, uq, qr, uq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*User)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -363,7 +423,22 @@ func (uq *UserQuery) Count(ctx context.Context) (int, error) {
 	if err := uq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return uq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*UserQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, uq, qr, uq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -380,7 +455,22 @@ func (uq *UserQuery) Exist(ctx context.Context) (bool, error) {
 	if err := uq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return uq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*UserQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, uq, qr, uq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -404,6 +494,7 @@ func (uq *UserQuery) Clone() *UserQuery {
 		offset:       uq.offset,
 		order:        append([]OrderFunc{}, uq.order...),
 		predicates:   append([]predicate.User{}, uq.predicates...),
+		inters:       append([]Interceptor{}, uq.inters...),
 		withPets:     uq.withPets.Clone(),
 		withParent:   uq.withParent.Clone(),
 		withChildren: uq.withChildren.Clone(),
@@ -412,15 +503,16 @@ func (uq *UserQuery) Clone() *UserQuery {
 		withMetadata: uq.withMetadata.Clone(),
 		withInfo:     uq.withInfo.Clone(),
 		// clone intermediate query.
-		sql:  uq.sql.Clone(),
-		path: uq.path,
+		sql:       uq.sql.Clone(),
+		path:      uq.path,
+		modifiers: append([]func(*sql.Selector){}, uq.modifiers...),
 	}
 }
 
 // WithPets tells the query-builder to eager-load the nodes that are connected to
 // the "pets" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithPets(opts ...func(*PetQuery)) *UserQuery {
-	query := &PetQuery{config: uq.config}
+	query := (&PetClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -431,7 +523,7 @@ func (uq *UserQuery) WithPets(opts ...func(*PetQuery)) *UserQuery {
 // WithParent tells the query-builder to eager-load the nodes that are connected to
 // the "parent" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithParent(opts ...func(*UserQuery)) *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -442,7 +534,7 @@ func (uq *UserQuery) WithParent(opts ...func(*UserQuery)) *UserQuery {
 // WithChildren tells the query-builder to eager-load the nodes that are connected to
 // the "children" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithChildren(opts ...func(*UserQuery)) *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -453,7 +545,7 @@ func (uq *UserQuery) WithChildren(opts ...func(*UserQuery)) *UserQuery {
 // WithSpouse tells the query-builder to eager-load the nodes that are connected to
 // the "spouse" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithSpouse(opts ...func(*UserQuery)) *UserQuery {
-	query := &UserQuery{config: uq.config}
+	query := (&UserClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -464,7 +556,7 @@ func (uq *UserQuery) WithSpouse(opts ...func(*UserQuery)) *UserQuery {
 // WithCard tells the query-builder to eager-load the nodes that are connected to
 // the "card" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithCard(opts ...func(*CardQuery)) *UserQuery {
-	query := &CardQuery{config: uq.config}
+	query := (&CardClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -475,7 +567,7 @@ func (uq *UserQuery) WithCard(opts ...func(*CardQuery)) *UserQuery {
 // WithMetadata tells the query-builder to eager-load the nodes that are connected to
 // the "metadata" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithMetadata(opts ...func(*MetadataQuery)) *UserQuery {
-	query := &MetadataQuery{config: uq.config}
+	query := (&MetadataClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -486,7 +578,7 @@ func (uq *UserQuery) WithMetadata(opts ...func(*MetadataQuery)) *UserQuery {
 // WithInfo tells the query-builder to eager-load the nodes that are connected to
 // the "info" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithInfo(opts ...func(*InfoQuery)) *UserQuery {
-	query := &InfoQuery{config: uq.config}
+	query := (&InfoClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -558,7 +650,7 @@ func (uq *UserQuery) prepareQuery(ctx context.Context) error {
 func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 	var (
 		nodes       = []*User{}
-		_spec       = uq.querySpec()
+		_spec       = uq.querySpec(ctx)
 		loadedTypes = [7]bool{
 			uq.withPets != nil,
 			uq.withParent != nil,
@@ -764,7 +856,7 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 }
 
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := uq.querySpec()
+	_spec := uq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, uq.driver, _spec)
 }
 
@@ -776,7 +868,7 @@ func (uq *UserQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (uq *UserQuery) querySpec() *sqlgraph.QuerySpec {
+func (uq *UserQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   user.Table,
@@ -818,6 +910,9 @@ func (uq *UserQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := uq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -843,9 +938,40 @@ func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := uq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range uq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (uq *UserQuery) ForUpdate(opts ...sql.LockOption) *UserQuery {
+	uq.modifiers = append(uq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return uq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (uq *UserQuery) ForShare(opts ...sql.LockOption) *UserQuery {
+	uq.modifiers = append(uq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return uq
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	config
@@ -1095,7 +1221,8 @@ func (ugb *UserGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (ugb *UserGroupBy) sqlQuery() *sql.Selector {
-	selector := ugb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := ugb.sql.ClearLock()
 	columns := make([]string, 0, len(ugb.fields)+len(ugb.fns))
 	columns = append(columns, ugb.fields...)
 	for _, fn := range ugb.fns {
@@ -1116,8 +1243,12 @@ func (us *UserSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := us.prepareQuery(ctx); err != nil {
 		return err
 	}
-	us.sql = us.UserQuery.sqlQuery(ctx)
-	return us.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		us.sql = us.UserQuery.sqlQuery(ctx)
+		return v, us.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, us.UserQuery, qr, us.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -0,0 +1,158 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package edgeschema
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/entc/integration/edgeschema/ent"
+	"entgo.io/ent/entc/integration/edgeschema/ent/friendship"
+	"entgo.io/ent/entc/integration/edgeschema/ent/group"
+	"entgo.io/ent/entc/integration/edgeschema/ent/membership"
+	"entgo.io/ent/entc/integration/edgeschema/ent/user"
+	"entgo.io/ent/entc/integration/edgeschema/ent/usersetting"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEdgeSchema(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	g1 := client.Group.Create().SetName("GitHub").SaveX(ctx)
+	g2 := client.Group.Create().SetName("GitLab").SaveX(ctx)
+	a8m := client.User.Create().SetName("a8m").AddGroups(g1, g2).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").AddGroups(g1).AddFriends(a8m).SaveX(ctx)
+
+	// Join rows are created with the edge schema defaults.
+	ms := a8m.QueryMemberships().Order(ent.Asc(membership.FieldGroupID)).AllX(ctx)
+	require.Len(t, ms, 2)
+	for i, g := range []*ent.Group{g1, g2} {
+		require.Equal(t, a8m.ID, ms[i].UserID)
+		require.Equal(t, g.ID, ms[i].GroupID)
+		require.Equal(t, "member", ms[i].Role)
+		require.False(t, ms[i].CreatedAt.IsZero())
+	}
+	require.Equal(t, 3, client.Membership.Query().CountX(ctx))
+	require.Equal(t, 2, g1.QueryMemberships().CountX(ctx))
+	require.Equal(t, []int{a8m.ID, nati.ID}, g1.QueryUsers().Order(ent.Asc(user.FieldID)).IDsX(ctx))
+	require.Equal(t, a8m.ID, ms[0].QueryUser().OnlyIDX(ctx))
+	require.Equal(t, g1.ID, ms[0].QueryGroup().OnlyIDX(ctx))
+
+	// Extra columns of the join table can be updated and used as predicates.
+	client.Membership.Update().
+		Where(membership.UserID(a8m.ID), membership.GroupID(g1.ID)).
+		SetRole("admin").
+		ExecX(ctx)
+	admins := g1.QueryUsers().Where(user.HasMembershipsWith(membership.Role("admin"))).AllX(ctx)
+	require.Len(t, admins, 1)
+	require.Equal(t, a8m.ID, admins[0].ID)
+	require.Equal(t, "admin", client.Membership.GetX(ctx, a8m.ID, g1.ID).Role)
+
+	// Eager-loading of the edge schema.
+	u := client.User.Query().Where(user.ID(a8m.ID)).WithMemberships(func(q *ent.MembershipQuery) { q.WithGroup() }).OnlyX(ctx)
+	require.Len(t, u.Edges.Memberships, 2)
+	for _, m := range u.Edges.Memberships {
+		require.NotNil(t, m.Edges.Group)
+	}
+
+	// Bidirectional edges create a join row in each direction.
+	require.Equal(t, 2, client.Friendship.Query().CountX(ctx))
+	require.Equal(t, nati.ID, a8m.QueryFriends().OnlyIDX(ctx))
+	require.Equal(t, nati.ID, a8m.QueryFriendships().QueryFriend().OnlyIDX(ctx))
+	require.Equal(t, 1, client.Friendship.Query().Where(friendship.UserID(nati.ID), friendship.FriendID(a8m.ID)).CountX(ctx))
+
+	// Removing edges deletes their join rows.
+	a8m.Update().RemoveGroups(g2).ExecX(ctx)
+	require.False(t, client.Membership.Query().Where(membership.GroupID(g2.ID)).ExistX(ctx))
+	require.Equal(t, 0, g2.QueryUsers().CountX(ctx))
+	require.Equal(t, 1, a8m.QueryGroups().Where(group.ID(g1.ID)).CountX(ctx))
+
+	// Join rows are deleted with their edge ends.
+	client.User.DeleteOne(nati).ExecX(ctx)
+	require.Equal(t, 0, client.Friendship.Query().CountX(ctx))
+	require.Equal(t, 1, client.Membership.Query().CountX(ctx))
+}
+
+func TestEdgeSchemaID(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:edgeschemaid?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	a8m := client.User.Create().SetName("a8m").SaveX(ctx)
+	nati := client.User.Create().SetName("nati").AddFriends(a8m).SaveX(ctx)
+	f := client.Friendship.Create().SetUserID(a8m.ID).SetFriendID(a8m.ID).SetWeight(5).SaveX(ctx)
+	require.Equal(t, 5, f.Weight)
+
+	// Edge schemas are fetched, updated and deleted by their edge fields.
+	f = client.Friendship.GetX(ctx, nati.ID, a8m.ID)
+	require.Equal(t, nati.ID, f.UserID)
+	require.Equal(t, a8m.ID, f.FriendID)
+	require.Equal(t, 1, f.Weight)
+	_, err = client.Friendship.Get(ctx, a8m.ID, 0)
+	require.True(t, ent.IsNotFound(err))
+
+	f = client.Friendship.UpdateOneID(nati.ID, a8m.ID).SetWeight(2).SaveX(ctx)
+	require.Equal(t, 2, f.Weight)
+	f = f.Update().AddWeight(3).SaveX(ctx)
+	require.Equal(t, 5, f.Weight)
+	require.Equal(t, 1, client.Friendship.GetX(ctx, a8m.ID, nati.ID).Weight, "sibling rows are not affected")
+	_, err = client.Friendship.UpdateOneID(a8m.ID, 0).SetWeight(2).Save(ctx)
+	require.True(t, ent.IsNotFound(err))
+
+	require.Equal(t, 2, client.Friendship.Query().Where(friendship.Weight(5)).CountX(ctx))
+	require.Equal(t, 1, client.Friendship.Query().Where(friendship.Or(friendship.ID(nati.ID, a8m.ID), friendship.ID(a8m.ID, 0))).CountX(ctx))
+	require.Equal(t, []int{nati.ID}, client.Friendship.Query().Where(friendship.ID(a8m.ID, nati.ID)).QueryFriend().IDsX(ctx))
+	require.Equal(t, a8m.ID, client.Friendship.QueryUser(f).QueryFriends().OnlyIDX(ctx))
+
+	client.Friendship.DeleteOneID(a8m.ID, nati.ID).ExecX(ctx)
+	require.False(t, client.Friendship.Query().Where(friendship.ID(a8m.ID, nati.ID)).ExistX(ctx))
+	client.Friendship.DeleteOne(f).ExecX(ctx)
+	require.Equal(t, 1, client.Friendship.Query().CountX(ctx))
+	err = client.Friendship.DeleteOneID(nati.ID, a8m.ID).Exec(ctx)
+	require.True(t, ent.IsNotFound(err))
+}
+
+func TestCompositeID(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:composite?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	a8m := client.User.Create().SetName("a8m").SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SaveX(ctx)
+	client.UserSetting.CreateBulk(
+		client.UserSetting.Create().SetUser(a8m).SetKey("theme").SetValue("dark"),
+		client.UserSetting.Create().SetUser(a8m).SetKey("lang").SetValue("en"),
+		client.UserSetting.Create().SetUser(nati).SetKey("theme").SetValue("light"),
+	).SaveX(ctx)
+	_, err = client.UserSetting.Create().SetUser(a8m).SetKey("theme").SetValue("light").Save(ctx)
+	require.True(t, ent.IsConstraintError(err), "identifiers are unique")
+
+	// Entities are fetched, updated and deleted by their key tuple.
+	s := client.UserSetting.GetX(ctx, a8m.ID, "theme")
+	require.Equal(t, "dark", s.Value)
+	_, err = client.UserSetting.Get(ctx, a8m.ID, "font")
+	require.True(t, ent.IsNotFound(err))
+	s = client.UserSetting.UpdateOneID(a8m.ID, "theme").SetValue("light").SaveX(ctx)
+	require.Equal(t, "light", s.Value)
+	require.Equal(t, 2, client.UserSetting.Query().Where(usersetting.Value("light")).CountX(ctx))
+	require.Equal(t, []string{"lang", "theme"}, a8m.QuerySettings().Order(ent.Asc(usersetting.FieldKey)).Select(usersetting.FieldKey).StringsX(ctx))
+	u := client.User.Query().Where(user.ID(nati.ID)).WithSettings().OnlyX(ctx)
+	require.Len(t, u.Edges.Settings, 1)
+	require.Equal(t, "light", u.Edges.Settings[0].Value)
+
+	client.UserSetting.DeleteOneID(a8m.ID, "lang").ExecX(ctx)
+	require.False(t, client.UserSetting.Query().Where(usersetting.ID(a8m.ID, "lang")).ExistX(ctx))
+	require.Equal(t, 2, client.UserSetting.Query().CountX(ctx))
+}
@@ -0,0 +1,44 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Friendship holds the edge schema definition of the User.friends edge.
+type Friendship struct {
+	ent.Schema
+}
+
+// Fields of the Friendship.
+func (Friendship) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("weight").
+			Default(1),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Int("user_id"),
+		field.Int("friend_id"),
+	}
+}
+
+// Edges of the Friendship.
+func (Friendship) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Required().
+			Unique().
+			Field("user_id"),
+		edge.To("friend", User.Type).
+			Required().
+			Unique().
+			Field("friend_id"),
+	}
+}
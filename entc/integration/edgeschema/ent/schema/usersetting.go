@@ -0,0 +1,44 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// UserSetting holds the schema definition for the UserSetting entity.
+type UserSetting struct {
+	ent.Schema
+}
+
+// Annotations of the UserSetting.
+func (UserSetting) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		field.ID("user_id", "key"),
+	}
+}
+
+// Fields of the UserSetting.
+func (UserSetting) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("key"),
+		field.String("value"),
+	}
+}
+
+// Edges of the UserSetting.
+func (UserSetting) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("settings").
+			Required().
+			Unique().
+			Field("user_id"),
+	}
+}
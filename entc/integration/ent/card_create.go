@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/ent/card"
 	"entgo.io/ent/entc/integration/ent/spec"
@@ -24,6 +25,7 @@ type CardCreate struct {
 	config
 	mutation *CardMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreateTime sets the "create_time" field.
@@ -223,8 +225,10 @@ func (cc *CardCreate) sqlSave(ctx context.Context) (*Card, error) {
 		}
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	_node.ID = int(id)
+	if _spec.ID.Value != nil {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
 	return _node, nil
 }
 
@@ -239,6 +243,7 @@ func (cc *CardCreate) createSpec() (*Card, *sqlgraph.CreateSpec) {
 			},
 		}
 	)
+	_spec.OnConflict = cc.conflict
 	if value, ok := cc.mutation.CreateTime(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -321,10 +326,249 @@ func (cc *CardCreate) createSpec() (*Card, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Card.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CardUpsert) {
+//			u.SetBalance(v+v)
+//		}).
+//		Exec(ctx)
+//
+func (cc *CardCreate) OnConflict(opts ...sql.ConflictOption) *CardUpsertOne {
+	cc.conflict = opts
+	return &CardUpsertOne{
+		create: cc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Card.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (cc *CardCreate) OnConflictColumns(columns ...string) *CardUpsertOne {
+	cc.conflict = append(cc.conflict, sql.ConflictColumns(columns...))
+	return &CardUpsertOne{
+		create: cc,
+	}
+}
+
+type (
+	// CardUpsertOne is the builder for "upsert"-ing
+	//  one Card node.
+	CardUpsertOne struct {
+		create *CardCreate
+	}
+
+	// CardUpsert is the "OnConflict" setter.
+	CardUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetBalance sets the "balance" field.
+func (u *CardUpsert) SetBalance(v float64) *CardUpsert {
+	u.Set(card.FieldBalance, v)
+	return u
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *CardUpsert) UpdateBalance() *CardUpsert {
+	u.SetExcluded(card.FieldBalance)
+	return u
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *CardUpsert) AddBalance(v float64) *CardUpsert {
+	u.Add(card.FieldBalance, v)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *CardUpsert) SetName(v string) *CardUpsert {
+	u.Set(card.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CardUpsert) UpdateName() *CardUpsert {
+	u.SetExcluded(card.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *CardUpsert) ClearName() *CardUpsert {
+	u.SetNull(card.FieldName)
+	return u
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create except the ID and the immutable fields.
+// Using this option is equivalent to using:
+//
+//	client.Card.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(card.FieldCreateTime)
+//				u.SetIgnore(card.FieldUpdateTime)
+//				u.SetIgnore(card.FieldNumber)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *CardUpsertOne) UpdateNewValues() *CardUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(card.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.UpdateTime(); exists {
+			s.SetIgnore(card.FieldUpdateTime)
+		}
+		if _, exists := u.create.mutation.Number(); exists {
+			s.SetIgnore(card.FieldNumber)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Card.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *CardUpsertOne) Ignore() *CardUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CardUpsertOne) DoNothing() *CardUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CardCreate.OnConflict
+// documentation for more info.
+func (u *CardUpsertOne) Update(set func(*CardUpsert)) *CardUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CardUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetBalance sets the "balance" field.
+func (u *CardUpsertOne) SetBalance(v float64) *CardUpsertOne {
+	return u.Update(func(s *CardUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *CardUpsertOne) UpdateBalance() *CardUpsertOne {
+	return u.Update(func(s *CardUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *CardUpsertOne) AddBalance(v float64) *CardUpsertOne {
+	return u.Update(func(s *CardUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CardUpsertOne) SetName(v string) *CardUpsertOne {
+	return u.Update(func(s *CardUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CardUpsertOne) UpdateName() *CardUpsertOne {
+	return u.Update(func(s *CardUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *CardUpsertOne) ClearName() *CardUpsertOne {
+	return u.Update(func(s *CardUpsert) {
+		s.ClearName()
+	})
+}
+
+// Exec executes the query.
+func (u *CardUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CardCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CardUpsertOne) ExecX(ctx context.Context) {
+	if err := u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// ID executes the UPSERT query and returns the inserted/updated ID.
+// A NotFoundError is returned in case the row was skipped by the conflict
+// action (e.g. DO NOTHING), or its ID could not be resolved by the database.
+func (u *CardUpsertOne) ID(ctx context.Context) (id int, err error) {
+	_node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	if _node.ID == id {
+		return id, &NotFoundError{card.Label}
+	}
+	return _node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CardUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// Exec executes the query.
+func (cc *CardCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CardCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
 // CardCreateBulk is the builder for creating many Card entities in bulk.
 type CardCreateBulk struct {
 	config
 	builders []*CardCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Card entities in the database.
@@ -350,8 +594,10 @@ func (ccb *CardCreateBulk) Save(ctx context.Context) ([]*Card, error) {
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
 				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ccb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, ccb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
 						if cerr, ok := isSQLConstraintError(err); ok {
 							err = cerr
 						}
@@ -361,8 +607,10 @@ func (ccb *CardCreateBulk) Save(ctx context.Context) ([]*Card, error) {
 				if err != nil {
 					return nil, err
 				}
-				id := specs[i].ID.Value.(int64)
-				nodes[i].ID = int(id)
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
 				return nodes[i], nil
 			})
 			for i := len(builder.hooks) - 1; i >= 0; i-- {
@@ -387,3 +635,181 @@ func (ccb *CardCreateBulk) SaveX(ctx context.Context) []*Card {
 	}
 	return v
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Card.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CardUpsert) {
+//			u.SetBalance(v+v)
+//		}).
+//		Exec(ctx)
+//
+func (ccb *CardCreateBulk) OnConflict(opts ...sql.ConflictOption) *CardUpsertBulk {
+	ccb.conflict = opts
+	return &CardUpsertBulk{
+		create: ccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Card.CreateBulk(builders...).
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (ccb *CardCreateBulk) OnConflictColumns(columns ...string) *CardUpsertBulk {
+	ccb.conflict = append(ccb.conflict, sql.ConflictColumns(columns...))
+	return &CardUpsertBulk{
+		create: ccb,
+	}
+}
+
+// CardUpsertBulk is the builder for "upsert"-ing
+// a bulk of Card nodes.
+type CardUpsertBulk struct {
+	create *CardCreateBulk
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create except the ID and the immutable fields.
+// Using this option is equivalent to using:
+//
+//	client.Card.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(card.FieldCreateTime)
+//				u.SetIgnore(card.FieldUpdateTime)
+//				u.SetIgnore(card.FieldNumber)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *CardUpsertBulk) UpdateNewValues() *CardUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(card.FieldCreateTime)
+			}
+			if _, exists := b.mutation.UpdateTime(); exists {
+				s.SetIgnore(card.FieldUpdateTime)
+			}
+			if _, exists := b.mutation.Number(); exists {
+				s.SetIgnore(card.FieldNumber)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Card.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *CardUpsertBulk) Ignore() *CardUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CardUpsertBulk) DoNothing() *CardUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CardCreateBulk.OnConflict
+// documentation for more info.
+func (u *CardUpsertBulk) Update(set func(*CardUpsert)) *CardUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CardUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetBalance sets the "balance" field.
+func (u *CardUpsertBulk) SetBalance(v float64) *CardUpsertBulk {
+	return u.Update(func(s *CardUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *CardUpsertBulk) UpdateBalance() *CardUpsertBulk {
+	return u.Update(func(s *CardUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *CardUpsertBulk) AddBalance(v float64) *CardUpsertBulk {
+	return u.Update(func(s *CardUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CardUpsertBulk) SetName(v string) *CardUpsertBulk {
+	return u.Update(func(s *CardUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CardUpsertBulk) UpdateName() *CardUpsertBulk {
+	return u.Update(func(s *CardUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *CardUpsertBulk) ClearName() *CardUpsertBulk {
+	return u.Update(func(s *CardUpsert) {
+		s.ClearName()
+	})
+}
+
+// Exec executes the query.
+func (u *CardUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CardCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CardCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CardUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the query.
+func (ccb *CardCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CardCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"math/rand"
+	"time"
 
 	"entgo.io/ent/entc/integration/ent/migrate"
 
@@ -71,7 +73,7 @@ type Client struct {
 
 // NewClient creates a new client configured with the given options.
 func NewClient(opts ...Option) *Client {
-	cfg := config{log: log.Println, hooks: &hooks{}}
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
 	cfg.options(opts...)
 	client := &Client{config: cfg}
 	client.init()
@@ -114,9 +116,20 @@ func Open(driverName, dataSourceName string, options ...Option) (*Client, error)
 
 // Tx returns a new transactional client. The provided context
 // is used until the transaction is committed or rolled back.
+//
+// Calling Tx on a transactional client (e.g. tx.Client()) starts a nested transaction
+// that is backed by a savepoint, and can be committed or rolled back independently.
 func (c *Client) Tx(ctx context.Context) (*Tx, error) {
-	if _, ok := c.driver.(*txDriver); ok {
-		return nil, fmt.Errorf("ent: cannot start a transaction within a transaction")
+	if parent, ok := c.driver.(*txDriver); ok {
+		nested, err := parent.savepoint(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("ent: starting a nested transaction: %w", err)
+		}
+		cfg := c.config
+		cfg.driver = nested
+		tx := &Tx{ctx: ctx, config: cfg}
+		tx.init()
+		return tx, nil
 	}
 	tx, err := newTx(ctx, c.driver)
 	if err != nil {
@@ -158,6 +171,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
+		ctx:       ctx,
 		config:    cfg,
 		Card:      NewCardClient(cfg),
 		Comment:   NewCommentClient(cfg),
@@ -176,6 +190,121 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	}, nil
 }
 
+type (
+	// TxOption configures the transactions that are executed by Client.WithTx.
+	TxOption func(*txConfig)
+
+	// txConfig holds the configuration of Client.WithTx.
+	txConfig struct {
+		opts      *sql.TxOptions
+		attempts  int
+		backoff   func(attempt int) time.Duration
+		retryable func(error) bool
+	}
+)
+
+// WithTxOptions sets the options (e.g. the isolation level) of the transactions.
+func WithTxOptions(opts *sql.TxOptions) TxOption {
+	return func(c *txConfig) {
+		c.opts = opts
+	}
+}
+
+// WithTxAttempts sets the maximum number of times that a transaction is executed.
+// The default is 3, and 1 disables retries.
+func WithTxAttempts(n int) TxOption {
+	return func(c *txConfig) {
+		c.attempts = n
+	}
+}
+
+// WithTxBackoff sets the function that returns how long to wait before retrying a transaction
+// that failed on the given attempt (starting at 1). The default is an exponential backoff
+// with jitter, starting at 10ms.
+func WithTxBackoff(fn func(attempt int) time.Duration) TxOption {
+	return func(c *txConfig) {
+		c.backoff = fn
+	}
+}
+
+// WithTxRetryable sets the function that reports if a transaction that failed with the given
+// error should be retried. The default is sql.IsRetryableError that reports if the transaction
+// was aborted due to a deadlock, a lock timeout or a serialization failure.
+func WithTxRetryable(fn func(error) bool) TxOption {
+	return func(c *txConfig) {
+		c.retryable = fn
+	}
+}
+
+// WithTx executes fn in a transaction, and commits it if fn returns nil, or rolls it back
+// in case fn returns an error or panics. Transactions that fail due to transient errors, such
+// as deadlocks and serialization failures, are retried. Therefore, fn should not have side
+// effects outside the transaction. For example:
+//
+//	err := client.WithTx(ctx, func(tx *ent.Tx) error {
+//		// Use tx here.
+//		return nil
+//	}, ent.WithTxOptions(&sql.TxOptions{Isolation: sql.LevelSerializable}))
+//
+// If the client is a transactional client, fn is executed in a nested transaction,
+// and it is not retried, as these errors abort the outermost transaction.
+func (c *Client) WithTx(ctx context.Context, fn func(*Tx) error, opts ...TxOption) error {
+	cfg := txConfig{attempts: 3, backoff: txBackoff, retryable: sql.IsRetryableError}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		tx, err := c.Tx(ctx)
+		if err != nil {
+			return err
+		}
+		return runTx(tx, fn)
+	}
+	for attempt := 1; ; attempt++ {
+		tx, err := c.BeginTx(ctx, cfg.opts)
+		if err == nil {
+			err = runTx(tx, fn)
+		}
+		if err == nil || attempt >= cfg.attempts || !cfg.retryable(err) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(cfg.backoff(attempt)):
+		}
+	}
+}
+
+// runTx executes fn in the given transaction, and commits or rolls it back.
+func runTx(tx *Tx, fn func(*Tx) error) error {
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	return nil
+}
+
+// txBackoff is the default backoff of Client.WithTx. It is capped at 1s.
+func txBackoff(attempt int) time.Duration {
+	d := time.Second
+	if attempt <= 7 {
+		d = 10 * time.Millisecond << (attempt - 1)
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)))
+}
+
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
@@ -218,6 +347,25 @@ func (c *Client) Use(hooks ...Hook) {
 	c.User.Use(hooks...)
 }
 
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.Card.Intercept(interceptors...)
+	c.Comment.Intercept(interceptors...)
+	c.FieldType.Intercept(interceptors...)
+	c.File.Intercept(interceptors...)
+	c.FileType.Intercept(interceptors...)
+	c.Goods.Intercept(interceptors...)
+	c.Group.Intercept(interceptors...)
+	c.GroupInfo.Intercept(interceptors...)
+	c.Item.Intercept(interceptors...)
+	c.Node.Intercept(interceptors...)
+	c.Pet.Intercept(interceptors...)
+	c.Spec.Intercept(interceptors...)
+	c.Task.Intercept(interceptors...)
+	c.User.Intercept(interceptors...)
+}
+
 // CardClient is a client for the Card schema.
 type CardClient struct {
 	config
@@ -234,6 +382,12 @@ func (c *CardClient) Use(hooks ...Hook) {
 	c.hooks.Card = append(c.hooks.Card, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `card.Intercept(f(g(h())))`.
+func (c *CardClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Card = append(c.inters.Card, interceptors...)
+}
+
 // Create returns a create builder for Card.
 func (c *CardClient) Create() *CardCreate {
 	mutation := newCardMutation(c.config, OpCreate)
@@ -284,7 +438,7 @@ func (c *CardClient) DeleteOneID(id int) *CardDeleteOne {
 
 // Query returns a query builder for Card.
 func (c *CardClient) Query() *CardQuery {
-	return &CardQuery{config: c.config}
+	return &CardQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Card entity by its id.
@@ -303,7 +457,7 @@ func (c *CardClient) GetX(ctx context.Context, id int) *Card {
 
 // QueryOwner queries the owner edge of a Card.
 func (c *CardClient) QueryOwner(ca *Card) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := ca.ID
 		step := sqlgraph.NewStep(
@@ -319,7 +473,7 @@ func (c *CardClient) QueryOwner(ca *Card) *UserQuery {
 
 // QuerySpec queries the spec edge of a Card.
 func (c *CardClient) QuerySpec(ca *Card) *SpecQuery {
-	query := &SpecQuery{config: c.config}
+	query := (&SpecClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := ca.ID
 		step := sqlgraph.NewStep(
@@ -338,6 +492,11 @@ func (c *CardClient) Hooks() []Hook {
 	return c.hooks.Card
 }
 
+// Interceptors returns the client interceptors.
+func (c *CardClient) Interceptors() []Interceptor {
+	return c.inters.Card
+}
+
 // CommentClient is a client for the Comment schema.
 type CommentClient struct {
 	config
@@ -354,6 +513,12 @@ func (c *CommentClient) Use(hooks ...Hook) {
 	c.hooks.Comment = append(c.hooks.Comment, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `comment.Intercept(f(g(h())))`.
+func (c *CommentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Comment = append(c.inters.Comment, interceptors...)
+}
+
 // Create returns a create builder for Comment.
 func (c *CommentClient) Create() *CommentCreate {
 	mutation := newCommentMutation(c.config, OpCreate)
@@ -404,7 +569,7 @@ func (c *CommentClient) DeleteOneID(id int) *CommentDeleteOne {
 
 // Query returns a query builder for Comment.
 func (c *CommentClient) Query() *CommentQuery {
-	return &CommentQuery{config: c.config}
+	return &CommentQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Comment entity by its id.
@@ -426,6 +591,11 @@ func (c *CommentClient) Hooks() []Hook {
 	return c.hooks.Comment
 }
 
+// Interceptors returns the client interceptors.
+func (c *CommentClient) Interceptors() []Interceptor {
+	return c.inters.Comment
+}
+
 // FieldTypeClient is a client for the FieldType schema.
 type FieldTypeClient struct {
 	config
@@ -442,6 +612,12 @@ func (c *FieldTypeClient) Use(hooks ...Hook) {
 	c.hooks.FieldType = append(c.hooks.FieldType, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `fieldtype.Intercept(f(g(h())))`.
+func (c *FieldTypeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FieldType = append(c.inters.FieldType, interceptors...)
+}
+
 // Create returns a create builder for FieldType.
 func (c *FieldTypeClient) Create() *FieldTypeCreate {
 	mutation := newFieldTypeMutation(c.config, OpCreate)
@@ -492,7 +668,7 @@ func (c *FieldTypeClient) DeleteOneID(id int) *FieldTypeDeleteOne {
 
 // Query returns a query builder for FieldType.
 func (c *FieldTypeClient) Query() *FieldTypeQuery {
-	return &FieldTypeQuery{config: c.config}
+	return &FieldTypeQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a FieldType entity by its id.
@@ -514,6 +690,11 @@ func (c *FieldTypeClient) Hooks() []Hook {
 	return c.hooks.FieldType
 }
 
+// Interceptors returns the client interceptors.
+func (c *FieldTypeClient) Interceptors() []Interceptor {
+	return c.inters.FieldType
+}
+
 // FileClient is a client for the File schema.
 type FileClient struct {
 	config
@@ -530,6 +711,12 @@ func (c *FileClient) Use(hooks ...Hook) {
 	c.hooks.File = append(c.hooks.File, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `file.Intercept(f(g(h())))`.
+func (c *FileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.File = append(c.inters.File, interceptors...)
+}
+
 // Create returns a create builder for File.
 func (c *FileClient) Create() *FileCreate {
 	mutation := newFileMutation(c.config, OpCreate)
@@ -580,7 +767,7 @@ func (c *FileClient) DeleteOneID(id int) *FileDeleteOne {
 
 // Query returns a query builder for File.
 func (c *FileClient) Query() *FileQuery {
-	return &FileQuery{config: c.config}
+	return &FileQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a File entity by its id.
@@ -599,7 +786,7 @@ func (c *FileClient) GetX(ctx context.Context, id int) *File {
 
 // QueryOwner queries the owner edge of a File.
 func (c *FileClient) QueryOwner(f *File) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := f.ID
 		step := sqlgraph.NewStep(
@@ -615,7 +802,7 @@ func (c *FileClient) QueryOwner(f *File) *UserQuery {
 
 // QueryType queries the type edge of a File.
 func (c *FileClient) QueryType(f *File) *FileTypeQuery {
-	query := &FileTypeQuery{config: c.config}
+	query := (&FileTypeClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := f.ID
 		step := sqlgraph.NewStep(
@@ -631,7 +818,7 @@ func (c *FileClient) QueryType(f *File) *FileTypeQuery {
 
 // QueryField queries the field edge of a File.
 func (c *FileClient) QueryField(f *File) *FieldTypeQuery {
-	query := &FieldTypeQuery{config: c.config}
+	query := (&FieldTypeClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := f.ID
 		step := sqlgraph.NewStep(
@@ -650,6 +837,11 @@ func (c *FileClient) Hooks() []Hook {
 	return c.hooks.File
 }
 
+// Interceptors returns the client interceptors.
+func (c *FileClient) Interceptors() []Interceptor {
+	return c.inters.File
+}
+
 // FileTypeClient is a client for the FileType schema.
 type FileTypeClient struct {
 	config
@@ -666,6 +858,12 @@ func (c *FileTypeClient) Use(hooks ...Hook) {
 	c.hooks.FileType = append(c.hooks.FileType, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `filetype.Intercept(f(g(h())))`.
+func (c *FileTypeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FileType = append(c.inters.FileType, interceptors...)
+}
+
 // Create returns a create builder for FileType.
 func (c *FileTypeClient) Create() *FileTypeCreate {
 	mutation := newFileTypeMutation(c.config, OpCreate)
@@ -716,7 +914,7 @@ func (c *FileTypeClient) DeleteOneID(id int) *FileTypeDeleteOne {
 
 // Query returns a query builder for FileType.
 func (c *FileTypeClient) Query() *FileTypeQuery {
-	return &FileTypeQuery{config: c.config}
+	return &FileTypeQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a FileType entity by its id.
@@ -735,7 +933,7 @@ func (c *FileTypeClient) GetX(ctx context.Context, id int) *FileType {
 
 // QueryFiles queries the files edge of a FileType.
 func (c *FileTypeClient) QueryFiles(ft *FileType) *FileQuery {
-	query := &FileQuery{config: c.config}
+	query := (&FileClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := ft.ID
 		step := sqlgraph.NewStep(
@@ -754,6 +952,11 @@ func (c *FileTypeClient) Hooks() []Hook {
 	return c.hooks.FileType
 }
 
+// Interceptors returns the client interceptors.
+func (c *FileTypeClient) Interceptors() []Interceptor {
+	return c.inters.FileType
+}
+
 // GoodsClient is a client for the Goods schema.
 type GoodsClient struct {
 	config
@@ -770,6 +973,12 @@ func (c *GoodsClient) Use(hooks ...Hook) {
 	c.hooks.Goods = append(c.hooks.Goods, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `goods.Intercept(f(g(h())))`.
+func (c *GoodsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Goods = append(c.inters.Goods, interceptors...)
+}
+
 // Create returns a create builder for Goods.
 func (c *GoodsClient) Create() *GoodsCreate {
 	mutation := newGoodsMutation(c.config, OpCreate)
@@ -820,7 +1029,7 @@ func (c *GoodsClient) DeleteOneID(id int) *GoodsDeleteOne {
 
 // Query returns a query builder for Goods.
 func (c *GoodsClient) Query() *GoodsQuery {
-	return &GoodsQuery{config: c.config}
+	return &GoodsQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Goods entity by its id.
@@ -842,6 +1051,11 @@ func (c *GoodsClient) Hooks() []Hook {
 	return c.hooks.Goods
 }
 
+// Interceptors returns the client interceptors.
+func (c *GoodsClient) Interceptors() []Interceptor {
+	return c.inters.Goods
+}
+
 // GroupClient is a client for the Group schema.
 type GroupClient struct {
 	config
@@ -858,6 +1072,12 @@ func (c *GroupClient) Use(hooks ...Hook) {
 	c.hooks.Group = append(c.hooks.Group, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `group.Intercept(f(g(h())))`.
+func (c *GroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Group = append(c.inters.Group, interceptors...)
+}
+
 // Create returns a create builder for Group.
 func (c *GroupClient) Create() *GroupCreate {
 	mutation := newGroupMutation(c.config, OpCreate)
@@ -908,7 +1128,7 @@ func (c *GroupClient) DeleteOneID(id int) *GroupDeleteOne {
 
 // Query returns a query builder for Group.
 func (c *GroupClient) Query() *GroupQuery {
-	return &GroupQuery{config: c.config}
+	return &GroupQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Group entity by its id.
@@ -927,7 +1147,7 @@ func (c *GroupClient) GetX(ctx context.Context, id int) *Group {
 
 // QueryFiles queries the files edge of a Group.
 func (c *GroupClient) QueryFiles(gr *Group) *FileQuery {
-	query := &FileQuery{config: c.config}
+	query := (&FileClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := gr.ID
 		step := sqlgraph.NewStep(
@@ -943,7 +1163,7 @@ func (c *GroupClient) QueryFiles(gr *Group) *FileQuery {
 
 // QueryBlocked queries the blocked edge of a Group.
 func (c *GroupClient) QueryBlocked(gr *Group) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := gr.ID
 		step := sqlgraph.NewStep(
@@ -959,7 +1179,7 @@ func (c *GroupClient) QueryBlocked(gr *Group) *UserQuery {
 
 // QueryUsers queries the users edge of a Group.
 func (c *GroupClient) QueryUsers(gr *Group) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := gr.ID
 		step := sqlgraph.NewStep(
@@ -975,7 +1195,7 @@ func (c *GroupClient) QueryUsers(gr *Group) *UserQuery {
 
 // QueryInfo queries the info edge of a Group.
 func (c *GroupClient) QueryInfo(gr *Group) *GroupInfoQuery {
-	query := &GroupInfoQuery{config: c.config}
+	query := (&GroupInfoClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := gr.ID
 		step := sqlgraph.NewStep(
@@ -994,6 +1214,11 @@ func (c *GroupClient) Hooks() []Hook {
 	return c.hooks.Group
 }
 
+// Interceptors returns the client interceptors.
+func (c *GroupClient) Interceptors() []Interceptor {
+	return c.inters.Group
+}
+
 // GroupInfoClient is a client for the GroupInfo schema.
 type GroupInfoClient struct {
 	config
@@ -1010,6 +1235,12 @@ func (c *GroupInfoClient) Use(hooks ...Hook) {
 	c.hooks.GroupInfo = append(c.hooks.GroupInfo, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `groupinfo.Intercept(f(g(h())))`.
+func (c *GroupInfoClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GroupInfo = append(c.inters.GroupInfo, interceptors...)
+}
+
 // Create returns a create builder for GroupInfo.
 func (c *GroupInfoClient) Create() *GroupInfoCreate {
 	mutation := newGroupInfoMutation(c.config, OpCreate)
@@ -1060,7 +1291,7 @@ func (c *GroupInfoClient) DeleteOneID(id int) *GroupInfoDeleteOne {
 
 // Query returns a query builder for GroupInfo.
 func (c *GroupInfoClient) Query() *GroupInfoQuery {
-	return &GroupInfoQuery{config: c.config}
+	return &GroupInfoQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a GroupInfo entity by its id.
@@ -1079,7 +1310,7 @@ func (c *GroupInfoClient) GetX(ctx context.Context, id int) *GroupInfo {
 
 // QueryGroups queries the groups edge of a GroupInfo.
 func (c *GroupInfoClient) QueryGroups(gi *GroupInfo) *GroupQuery {
-	query := &GroupQuery{config: c.config}
+	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := gi.ID
 		step := sqlgraph.NewStep(
@@ -1098,6 +1329,11 @@ func (c *GroupInfoClient) Hooks() []Hook {
 	return c.hooks.GroupInfo
 }
 
+// Interceptors returns the client interceptors.
+func (c *GroupInfoClient) Interceptors() []Interceptor {
+	return c.inters.GroupInfo
+}
+
 // ItemClient is a client for the Item schema.
 type ItemClient struct {
 	config
@@ -1114,6 +1350,12 @@ func (c *ItemClient) Use(hooks ...Hook) {
 	c.hooks.Item = append(c.hooks.Item, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `item.Intercept(f(g(h())))`.
+func (c *ItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Item = append(c.inters.Item, interceptors...)
+}
+
 // Create returns a create builder for Item.
 func (c *ItemClient) Create() *ItemCreate {
 	mutation := newItemMutation(c.config, OpCreate)
@@ -1164,7 +1406,7 @@ func (c *ItemClient) DeleteOneID(id int) *ItemDeleteOne {
 
 // Query returns a query builder for Item.
 func (c *ItemClient) Query() *ItemQuery {
-	return &ItemQuery{config: c.config}
+	return &ItemQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Item entity by its id.
@@ -1186,6 +1428,11 @@ func (c *ItemClient) Hooks() []Hook {
 	return c.hooks.Item
 }
 
+// Interceptors returns the client interceptors.
+func (c *ItemClient) Interceptors() []Interceptor {
+	return c.inters.Item
+}
+
 // NodeClient is a client for the Node schema.
 type NodeClient struct {
 	config
@@ -1202,6 +1449,12 @@ func (c *NodeClient) Use(hooks ...Hook) {
 	c.hooks.Node = append(c.hooks.Node, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `node.Intercept(f(g(h())))`.
+func (c *NodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Node = append(c.inters.Node, interceptors...)
+}
+
 // Create returns a create builder for Node.
 func (c *NodeClient) Create() *NodeCreate {
 	mutation := newNodeMutation(c.config, OpCreate)
@@ -1252,7 +1505,7 @@ func (c *NodeClient) DeleteOneID(id int) *NodeDeleteOne {
 
 // Query returns a query builder for Node.
 func (c *NodeClient) Query() *NodeQuery {
-	return &NodeQuery{config: c.config}
+	return &NodeQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Node entity by its id.
@@ -1271,7 +1524,7 @@ func (c *NodeClient) GetX(ctx context.Context, id int) *Node {
 
 // QueryPrev queries the prev edge of a Node.
 func (c *NodeClient) QueryPrev(n *Node) *NodeQuery {
-	query := &NodeQuery{config: c.config}
+	query := (&NodeClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := n.ID
 		step := sqlgraph.NewStep(
@@ -1287,7 +1540,7 @@ func (c *NodeClient) QueryPrev(n *Node) *NodeQuery {
 
 // QueryNext queries the next edge of a Node.
 func (c *NodeClient) QueryNext(n *Node) *NodeQuery {
-	query := &NodeQuery{config: c.config}
+	query := (&NodeClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := n.ID
 		step := sqlgraph.NewStep(
@@ -1306,6 +1559,11 @@ func (c *NodeClient) Hooks() []Hook {
 	return c.hooks.Node
 }
 
+// Interceptors returns the client interceptors.
+func (c *NodeClient) Interceptors() []Interceptor {
+	return c.inters.Node
+}
+
 // PetClient is a client for the Pet schema.
 type PetClient struct {
 	config
@@ -1322,6 +1580,12 @@ func (c *PetClient) Use(hooks ...Hook) {
 	c.hooks.Pet = append(c.hooks.Pet, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pet.Intercept(f(g(h())))`.
+func (c *PetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Pet = append(c.inters.Pet, interceptors...)
+}
+
 // Create returns a create builder for Pet.
 func (c *PetClient) Create() *PetCreate {
 	mutation := newPetMutation(c.config, OpCreate)
@@ -1372,7 +1636,7 @@ func (c *PetClient) DeleteOneID(id int) *PetDeleteOne {
 
 // Query returns a query builder for Pet.
 func (c *PetClient) Query() *PetQuery {
-	return &PetQuery{config: c.config}
+	return &PetQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Pet entity by its id.
@@ -1391,7 +1655,7 @@ func (c *PetClient) GetX(ctx context.Context, id int) *Pet {
 
 // QueryTeam queries the team edge of a Pet.
 func (c *PetClient) QueryTeam(pe *Pet) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := pe.ID
 		step := sqlgraph.NewStep(
@@ -1407,7 +1671,7 @@ func (c *PetClient) QueryTeam(pe *Pet) *UserQuery {
 
 // QueryOwner queries the owner edge of a Pet.
 func (c *PetClient) QueryOwner(pe *Pet) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := pe.ID
 		step := sqlgraph.NewStep(
@@ -1426,6 +1690,11 @@ func (c *PetClient) Hooks() []Hook {
 	return c.hooks.Pet
 }
 
+// Interceptors returns the client interceptors.
+func (c *PetClient) Interceptors() []Interceptor {
+	return c.inters.Pet
+}
+
 // SpecClient is a client for the Spec schema.
 type SpecClient struct {
 	config
@@ -1442,6 +1711,12 @@ func (c *SpecClient) Use(hooks ...Hook) {
 	c.hooks.Spec = append(c.hooks.Spec, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `spec.Intercept(f(g(h())))`.
+func (c *SpecClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Spec = append(c.inters.Spec, interceptors...)
+}
+
 // Create returns a create builder for Spec.
 func (c *SpecClient) Create() *SpecCreate {
 	mutation := newSpecMutation(c.config, OpCreate)
@@ -1492,7 +1767,7 @@ func (c *SpecClient) DeleteOneID(id int) *SpecDeleteOne {
 
 // Query returns a query builder for Spec.
 func (c *SpecClient) Query() *SpecQuery {
-	return &SpecQuery{config: c.config}
+	return &SpecQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Spec entity by its id.
@@ -1511,7 +1786,7 @@ func (c *SpecClient) GetX(ctx context.Context, id int) *Spec {
 
 // QueryCard queries the card edge of a Spec.
 func (c *SpecClient) QueryCard(s *Spec) *CardQuery {
-	query := &CardQuery{config: c.config}
+	query := (&CardClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := s.ID
 		step := sqlgraph.NewStep(
@@ -1530,6 +1805,11 @@ func (c *SpecClient) Hooks() []Hook {
 	return c.hooks.Spec
 }
 
+// Interceptors returns the client interceptors.
+func (c *SpecClient) Interceptors() []Interceptor {
+	return c.inters.Spec
+}
+
 // TaskClient is a client for the Task schema.
 type TaskClient struct {
 	config
@@ -1546,6 +1826,12 @@ func (c *TaskClient) Use(hooks ...Hook) {
 	c.hooks.Task = append(c.hooks.Task, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `task.Intercept(f(g(h())))`.
+func (c *TaskClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Task = append(c.inters.Task, interceptors...)
+}
+
 // Create returns a create builder for Task.
 func (c *TaskClient) Create() *TaskCreate {
 	mutation := newTaskMutation(c.config, OpCreate)
@@ -1596,7 +1882,7 @@ func (c *TaskClient) DeleteOneID(id int) *TaskDeleteOne {
 
 // Query returns a query builder for Task.
 func (c *TaskClient) Query() *TaskQuery {
-	return &TaskQuery{config: c.config}
+	return &TaskQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Task entity by its id.
@@ -1618,6 +1904,11 @@ func (c *TaskClient) Hooks() []Hook {
 	return c.hooks.Task
 }
 
+// Interceptors returns the client interceptors.
+func (c *TaskClient) Interceptors() []Interceptor {
+	return c.inters.Task
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -1634,6 +1925,12 @@ func (c *UserClient) Use(hooks ...Hook) {
 	c.hooks.User = append(c.hooks.User, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
 // Create returns a create builder for User.
 func (c *UserClient) Create() *UserCreate {
 	mutation := newUserMutation(c.config, OpCreate)
@@ -1684,7 +1981,7 @@ func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
 
 // Query returns a query builder for User.
 func (c *UserClient) Query() *UserQuery {
-	return &UserQuery{config: c.config}
+	return &UserQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a User entity by its id.
@@ -1703,7 +2000,7 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 
 // QueryCard queries the card edge of a User.
 func (c *UserClient) QueryCard(u *User) *CardQuery {
-	query := &CardQuery{config: c.config}
+	query := (&CardClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1719,7 +2016,7 @@ func (c *UserClient) QueryCard(u *User) *CardQuery {
 
 // QueryPets queries the pets edge of a User.
 func (c *UserClient) QueryPets(u *User) *PetQuery {
-	query := &PetQuery{config: c.config}
+	query := (&PetClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1735,7 +2032,7 @@ func (c *UserClient) QueryPets(u *User) *PetQuery {
 
 // QueryFiles queries the files edge of a User.
 func (c *UserClient) QueryFiles(u *User) *FileQuery {
-	query := &FileQuery{config: c.config}
+	query := (&FileClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1751,7 +2048,7 @@ func (c *UserClient) QueryFiles(u *User) *FileQuery {
 
 // QueryGroups queries the groups edge of a User.
 func (c *UserClient) QueryGroups(u *User) *GroupQuery {
-	query := &GroupQuery{config: c.config}
+	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1767,7 +2064,7 @@ func (c *UserClient) QueryGroups(u *User) *GroupQuery {
 
 // QueryFriends queries the friends edge of a User.
 func (c *UserClient) QueryFriends(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1783,7 +2080,7 @@ func (c *UserClient) QueryFriends(u *User) *UserQuery {
 
 // QueryFollowers queries the followers edge of a User.
 func (c *UserClient) QueryFollowers(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1799,7 +2096,7 @@ func (c *UserClient) QueryFollowers(u *User) *UserQuery {
 
 // QueryFollowing queries the following edge of a User.
 func (c *UserClient) QueryFollowing(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1815,7 +2112,7 @@ func (c *UserClient) QueryFollowing(u *User) *UserQuery {
 
 // QueryTeam queries the team edge of a User.
 func (c *UserClient) QueryTeam(u *User) *PetQuery {
-	query := &PetQuery{config: c.config}
+	query := (&PetClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1831,7 +2128,7 @@ func (c *UserClient) QueryTeam(u *User) *PetQuery {
 
 // QuerySpouse queries the spouse edge of a User.
 func (c *UserClient) QuerySpouse(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1847,7 +2144,7 @@ func (c *UserClient) QuerySpouse(u *User) *UserQuery {
 
 // QueryChildren queries the children edge of a User.
 func (c *UserClient) QueryChildren(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1863,7 +2160,7 @@ func (c *UserClient) QueryChildren(u *User) *UserQuery {
 
 // QueryParent queries the parent edge of a User.
 func (c *UserClient) QueryParent(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
@@ -1881,3 +2178,8 @@ func (c *UserClient) QueryParent(u *User) *UserQuery {
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
 }
+
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/ent/comment"
 	"entgo.io/ent/schema/field"
@@ -21,6 +22,7 @@ type CommentCreate struct {
 	config
 	mutation *CommentMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUniqueInt sets the "unique_int" field.
@@ -117,8 +119,10 @@ func (cc *CommentCreate) sqlSave(ctx context.Context) (*Comment, error) {
 		}
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	_node.ID = int(id)
+	if _spec.ID.Value != nil {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
 	return _node, nil
 }
 
@@ -133,6 +137,7 @@ func (cc *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
 			},
 		}
 	)
+	_spec.OnConflict = cc.conflict
 	if value, ok := cc.mutation.UniqueInt(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -160,10 +165,285 @@ func (cc *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Comment.Create().
+//		SetUniqueInt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CommentUpsert) {
+//			u.SetUniqueInt(v+v)
+//		}).
+//		Exec(ctx)
+//
+func (cc *CommentCreate) OnConflict(opts ...sql.ConflictOption) *CommentUpsertOne {
+	cc.conflict = opts
+	return &CommentUpsertOne{
+		create: cc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Comment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (cc *CommentCreate) OnConflictColumns(columns ...string) *CommentUpsertOne {
+	cc.conflict = append(cc.conflict, sql.ConflictColumns(columns...))
+	return &CommentUpsertOne{
+		create: cc,
+	}
+}
+
+type (
+	// CommentUpsertOne is the builder for "upsert"-ing
+	//  one Comment node.
+	CommentUpsertOne struct {
+		create *CommentCreate
+	}
+
+	// CommentUpsert is the "OnConflict" setter.
+	CommentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUniqueInt sets the "unique_int" field.
+func (u *CommentUpsert) SetUniqueInt(v int) *CommentUpsert {
+	u.Set(comment.FieldUniqueInt, v)
+	return u
+}
+
+// UpdateUniqueInt sets the "unique_int" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateUniqueInt() *CommentUpsert {
+	u.SetExcluded(comment.FieldUniqueInt)
+	return u
+}
+
+// AddUniqueInt adds v to the "unique_int" field.
+func (u *CommentUpsert) AddUniqueInt(v int) *CommentUpsert {
+	u.Add(comment.FieldUniqueInt, v)
+	return u
+}
+
+// SetUniqueFloat sets the "unique_float" field.
+func (u *CommentUpsert) SetUniqueFloat(v float64) *CommentUpsert {
+	u.Set(comment.FieldUniqueFloat, v)
+	return u
+}
+
+// UpdateUniqueFloat sets the "unique_float" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateUniqueFloat() *CommentUpsert {
+	u.SetExcluded(comment.FieldUniqueFloat)
+	return u
+}
+
+// AddUniqueFloat adds v to the "unique_float" field.
+func (u *CommentUpsert) AddUniqueFloat(v float64) *CommentUpsert {
+	u.Add(comment.FieldUniqueFloat, v)
+	return u
+}
+
+// SetNillableInt sets the "nillable_int" field.
+func (u *CommentUpsert) SetNillableInt(v int) *CommentUpsert {
+	u.Set(comment.FieldNillableInt, v)
+	return u
+}
+
+// UpdateNillableInt sets the "nillable_int" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateNillableInt() *CommentUpsert {
+	u.SetExcluded(comment.FieldNillableInt)
+	return u
+}
+
+// AddNillableInt adds v to the "nillable_int" field.
+func (u *CommentUpsert) AddNillableInt(v int) *CommentUpsert {
+	u.Add(comment.FieldNillableInt, v)
+	return u
+}
+
+// ClearNillableInt clears the value of the "nillable_int" field.
+func (u *CommentUpsert) ClearNillableInt() *CommentUpsert {
+	u.SetNull(comment.FieldNillableInt)
+	return u
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Comment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *CommentUpsertOne) UpdateNewValues() *CommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Comment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *CommentUpsertOne) Ignore() *CommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CommentUpsertOne) DoNothing() *CommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CommentCreate.OnConflict
+// documentation for more info.
+func (u *CommentUpsertOne) Update(set func(*CommentUpsert)) *CommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CommentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUniqueInt sets the "unique_int" field.
+func (u *CommentUpsertOne) SetUniqueInt(v int) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetUniqueInt(v)
+	})
+}
+
+// UpdateUniqueInt sets the "unique_int" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateUniqueInt() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateUniqueInt()
+	})
+}
+
+// AddUniqueInt adds v to the "unique_int" field.
+func (u *CommentUpsertOne) AddUniqueInt(v int) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.AddUniqueInt(v)
+	})
+}
+
+// SetUniqueFloat sets the "unique_float" field.
+func (u *CommentUpsertOne) SetUniqueFloat(v float64) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetUniqueFloat(v)
+	})
+}
+
+// UpdateUniqueFloat sets the "unique_float" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateUniqueFloat() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateUniqueFloat()
+	})
+}
+
+// AddUniqueFloat adds v to the "unique_float" field.
+func (u *CommentUpsertOne) AddUniqueFloat(v float64) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.AddUniqueFloat(v)
+	})
+}
+
+// SetNillableInt sets the "nillable_int" field.
+func (u *CommentUpsertOne) SetNillableInt(v int) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetNillableInt(v)
+	})
+}
+
+// UpdateNillableInt sets the "nillable_int" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateNillableInt() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateNillableInt()
+	})
+}
+
+// AddNillableInt adds v to the "nillable_int" field.
+func (u *CommentUpsertOne) AddNillableInt(v int) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.AddNillableInt(v)
+	})
+}
+
+// ClearNillableInt clears the value of the "nillable_int" field.
+func (u *CommentUpsertOne) ClearNillableInt() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearNillableInt()
+	})
+}
+
+// Exec executes the query.
+func (u *CommentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CommentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CommentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// ID executes the UPSERT query and returns the inserted/updated ID.
+// A NotFoundError is returned in case the row was skipped by the conflict
+// action (e.g. DO NOTHING), or its ID could not be resolved by the database.
+func (u *CommentUpsertOne) ID(ctx context.Context) (id int, err error) {
+	_node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	if _node.ID == id {
+		return id, &NotFoundError{comment.Label}
+	}
+	return _node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CommentUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// Exec executes the query.
+func (cc *CommentCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CommentCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
 // CommentCreateBulk is the builder for creating many Comment entities in bulk.
 type CommentCreateBulk struct {
 	config
 	builders []*CommentCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Comment entities in the database.
@@ -188,8 +468,10 @@ func (ccb *CommentCreateBulk) Save(ctx context.Context) ([]*Comment, error) {
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
 				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ccb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, ccb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
 						if cerr, ok := isSQLConstraintError(err); ok {
 							err = cerr
 						}
@@ -199,8 +481,10 @@ func (ccb *CommentCreateBulk) Save(ctx context.Context) ([]*Comment, error) {
 				if err != nil {
 					return nil, err
 				}
-				id := specs[i].ID.Value.(int64)
-				nodes[i].ID = int(id)
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
 				return nodes[i], nil
 			})
 			for i := len(builder.hooks) - 1; i >= 0; i-- {
@@ -225,3 +509,191 @@ func (ccb *CommentCreateBulk) SaveX(ctx context.Context) []*Comment {
 	}
 	return v
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Comment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CommentUpsert) {
+//			u.SetUniqueInt(v+v)
+//		}).
+//		Exec(ctx)
+//
+func (ccb *CommentCreateBulk) OnConflict(opts ...sql.ConflictOption) *CommentUpsertBulk {
+	ccb.conflict = opts
+	return &CommentUpsertBulk{
+		create: ccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Comment.CreateBulk(builders...).
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (ccb *CommentCreateBulk) OnConflictColumns(columns ...string) *CommentUpsertBulk {
+	ccb.conflict = append(ccb.conflict, sql.ConflictColumns(columns...))
+	return &CommentUpsertBulk{
+		create: ccb,
+	}
+}
+
+// CommentUpsertBulk is the builder for "upsert"-ing
+// a bulk of Comment nodes.
+type CommentUpsertBulk struct {
+	create *CommentCreateBulk
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Comment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *CommentUpsertBulk) UpdateNewValues() *CommentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Comment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *CommentUpsertBulk) Ignore() *CommentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CommentUpsertBulk) DoNothing() *CommentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CommentCreateBulk.OnConflict
+// documentation for more info.
+func (u *CommentUpsertBulk) Update(set func(*CommentUpsert)) *CommentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CommentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUniqueInt sets the "unique_int" field.
+func (u *CommentUpsertBulk) SetUniqueInt(v int) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetUniqueInt(v)
+	})
+}
+
+// UpdateUniqueInt sets the "unique_int" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateUniqueInt() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateUniqueInt()
+	})
+}
+
+// AddUniqueInt adds v to the "unique_int" field.
+func (u *CommentUpsertBulk) AddUniqueInt(v int) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.AddUniqueInt(v)
+	})
+}
+
+// SetUniqueFloat sets the "unique_float" field.
+func (u *CommentUpsertBulk) SetUniqueFloat(v float64) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetUniqueFloat(v)
+	})
+}
+
+// UpdateUniqueFloat sets the "unique_float" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateUniqueFloat() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateUniqueFloat()
+	})
+}
+
+// AddUniqueFloat adds v to the "unique_float" field.
+func (u *CommentUpsertBulk) AddUniqueFloat(v float64) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.AddUniqueFloat(v)
+	})
+}
+
+// SetNillableInt sets the "nillable_int" field.
+func (u *CommentUpsertBulk) SetNillableInt(v int) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetNillableInt(v)
+	})
+}
+
+// UpdateNillableInt sets the "nillable_int" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateNillableInt() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateNillableInt()
+	})
+}
+
+// AddNillableInt adds v to the "nillable_int" field.
+func (u *CommentUpsertBulk) AddNillableInt(v int) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.AddNillableInt(v)
+	})
+}
+
+// ClearNillableInt clears the value of the "nillable_int" field.
+func (u *CommentUpsertBulk) ClearNillableInt() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearNillableInt()
+	})
+}
+
+// Exec executes the query.
+func (u *CommentUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CommentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CommentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CommentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the query.
+func (ccb *CommentCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CommentCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/ent/comment"
@@ -27,6 +28,8 @@ type CommentQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Comment
+	inters     []Interceptor
+	modifiers  []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -56,6 +59,48 @@ func (cq *CommentQuery) Order(o ...OrderFunc) *CommentQuery {
 	return cq
 }
 
+// Type returns the node type of this query (Comment). It implements the ent.Query interface.
+func (cq *CommentQuery) Type() string {
+	return TypeComment
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (cq *CommentQuery) SetLimit(limit int) {
+	cq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (cq *CommentQuery) SetOffset(offset int) {
+	cq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (cq *CommentQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for CommentQuery", fn)
+		}
+		cq.order = append(cq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (cq *CommentQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Comment:
+			cq.predicates = append(cq.predicates, p)
+		case func(*sql.Selector):
+			cq.predicates = append(cq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for CommentQuery", p)
+		}
+	}
+	return nil
+}
+
 // First returns the first Comment entity from the query.
 // Returns a *NotFoundError when no Comment was found.
 func (cq *CommentQuery) First(ctx context.Context) (*Comment, error) {
@@ -161,7 +206,22 @@ func (cq *CommentQuery) All(ctx context.Context) ([]*Comment, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return cq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CommentQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, cq, qr, cq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Comment)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -196,7 +256,22 @@ func (cq *CommentQuery) Count(ctx context.Context) (int, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return cq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CommentQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, cq, qr, cq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -213,7 +288,22 @@ func (cq *CommentQuery) Exist(ctx context.Context) (bool, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return cq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CommentQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, cq, qr, cq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -237,9 +327,11 @@ func (cq *CommentQuery) Clone() *CommentQuery {
 		offset:     cq.offset,
 		order:      append([]OrderFunc{}, cq.order...),
 		predicates: append([]predicate.Comment{}, cq.predicates...),
+		inters:     append([]Interceptor{}, cq.inters...),
 		// clone intermediate query.
-		sql:  cq.sql.Clone(),
-		path: cq.path,
+		sql:       cq.sql.Clone(),
+		path:      cq.path,
+		modifiers: append([]func(*sql.Selector){}, cq.modifiers...),
 	}
 }
 
@@ -307,7 +399,7 @@ func (cq *CommentQuery) prepareQuery(ctx context.Context) error {
 func (cq *CommentQuery) sqlAll(ctx context.Context) ([]*Comment, error) {
 	var (
 		nodes = []*Comment{}
-		_spec = cq.querySpec()
+		_spec = cq.querySpec(ctx)
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		node := &Comment{config: cq.config}
@@ -331,7 +423,7 @@ func (cq *CommentQuery) sqlAll(ctx context.Context) ([]*Comment, error) {
 }
 
 func (cq *CommentQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := cq.querySpec()
+	_spec := cq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, cq.driver, _spec)
 }
 
@@ -343,7 +435,7 @@ func (cq *CommentQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (cq *CommentQuery) querySpec() *sqlgraph.QuerySpec {
+func (cq *CommentQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   comment.Table,
@@ -385,6 +477,9 @@ func (cq *CommentQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := cq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -410,9 +505,40 @@ func (cq *CommentQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := cq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range cq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (cq *CommentQuery) ForUpdate(opts ...sql.LockOption) *CommentQuery {
+	cq.modifiers = append(cq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return cq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (cq *CommentQuery) ForShare(opts ...sql.LockOption) *CommentQuery {
+	cq.modifiers = append(cq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return cq
+}
+
 // CommentGroupBy is the group-by builder for Comment entities.
 type CommentGroupBy struct {
 	config
@@ -662,7 +788,8 @@ func (cgb *CommentGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (cgb *CommentGroupBy) sqlQuery() *sql.Selector {
-	selector := cgb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := cgb.sql.ClearLock()
 	columns := make([]string, 0, len(cgb.fields)+len(cgb.fns))
 	columns = append(columns, cgb.fields...)
 	for _, fn := range cgb.fns {
@@ -683,8 +810,12 @@ func (cs *CommentSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := cs.prepareQuery(ctx); err != nil {
 		return err
 	}
-	cs.sql = cs.CommentQuery.sqlQuery(ctx)
-	return cs.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		cs.sql = cs.CommentQuery.sqlQuery(ctx)
+		return v, cs.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, cs.CommentQuery, qr, cs.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -897,3 +1028,195 @@ func (cs *CommentSelect) sqlQuery() sql.Querier {
 	selector.Select(selector.Columns(cs.fields...)...)
 	return selector
 }
+
+// CommentOrderField defines a field that Comment entities can be ordered by in Paginate.
+type CommentOrderField struct {
+	field  string
+	value  func(*Comment) interface{}
+	decode func(interface{}) (interface{}, error)
+}
+
+// String returns the name of the ordering field.
+func (f *CommentOrderField) String() string {
+	return f.field
+}
+
+var (
+	// CommentOrderFieldUniqueInt orders Comment entities by the "unique_int" field.
+	CommentOrderFieldUniqueInt = &CommentOrderField{
+		field: comment.FieldUniqueInt,
+		value: func(c *Comment) interface{} {
+			return c.UniqueInt
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v int
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+	// CommentOrderFieldUniqueFloat orders Comment entities by the "unique_float" field.
+	CommentOrderFieldUniqueFloat = &CommentOrderField{
+		field: comment.FieldUniqueFloat,
+		value: func(c *Comment) interface{} {
+			return c.UniqueFloat
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v float64
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+)
+
+// CommentOrder defines the ordering of Comment entities in Paginate.
+// Entities are ordered by the given field (if any) and then by their ID.
+type CommentOrder struct {
+	Direction OrderDirection
+	Field     *CommentOrderField
+}
+
+// cursor returns the cursor of the given entity in the ordering.
+func (o *CommentOrder) cursor(c *Comment) *Cursor {
+	if o.Field == nil {
+		return &Cursor{ID: c.ID}
+	}
+	return &Cursor{ID: c.ID, Value: o.Field.value(c)}
+}
+
+// decode returns the typed ID and ordering value of the given cursor.
+func (o *CommentOrder) decode(c *Cursor) (id int, value interface{}, err error) {
+	if err := decodeCursorValue(c.ID, &id); err != nil {
+		return id, nil, err
+	}
+	if o.Field == nil {
+		return id, nil, nil
+	}
+	if c.Value == nil {
+		return id, nil, fmt.Errorf("ent: cursor does not match the ordering by %q", o.Field.field)
+	}
+	if value, err = o.Field.decode(c.Value); err != nil {
+		return id, nil, err
+	}
+	return id, value, nil
+}
+
+// cursorPredicate returns a predicate that matches the entities that come
+// after the cursor when the entities are ordered in the given direction.
+func (o *CommentOrder) cursorPredicate(c *Cursor, direction OrderDirection) (predicate.Comment, error) {
+	id, value, err := o.decode(c)
+	if err != nil {
+		return nil, err
+	}
+	return func(s *sql.Selector) {
+		if o.Field == nil {
+			if direction == OrderDirectionAsc {
+				s.Where(sql.GT(s.C(comment.FieldID), id))
+			} else {
+				s.Where(sql.LT(s.C(comment.FieldID), id))
+			}
+			return
+		}
+		columns := []string{s.C(o.Field.field), s.C(comment.FieldID)}
+		if direction == OrderDirectionAsc {
+			s.Where(sql.CompositeGT(columns, value, id))
+		} else {
+			s.Where(sql.CompositeLT(columns, value, id))
+		}
+	}, nil
+}
+
+// orderFunc returns the ordering function of the entities in the given direction.
+func (o *CommentOrder) orderFunc(direction OrderDirection) OrderFunc {
+	fields := []string{comment.FieldID}
+	if o.Field != nil {
+		fields = []string{o.Field.field, comment.FieldID}
+	}
+	if direction == OrderDirectionDesc {
+		return Desc(fields...)
+	}
+	return Asc(fields...)
+}
+
+// CommentPage is a page of Comment entities returned by Paginate.
+type CommentPage struct {
+	Nodes    []*Comment `json:"nodes"`
+	PageInfo PageInfo   `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns a page of Comment entities using cursor-based
+// (keyset) pagination. The entities are ordered by the given order, or by their ID if it is nil.
+// The after and before cursors limit the page to the entities that come after/before them in
+// this order, and first/last limit the page to the first/last N entities. Note that Paginate
+// overrides the ordering and the limit of the query.
+//
+//	page, err := client.Comment.Query().
+//		Paginate(ctx, after, &first, nil, nil, &ent.CommentOrder{Direction: ent.OrderDirectionAsc})
+//
+func (cq *CommentQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *CommentOrder) (*CommentPage, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &CommentOrder{Direction: OrderDirectionAsc}
+	}
+	if err := orderBy.Direction.Validate(); err != nil {
+		return nil, err
+	}
+	page := &CommentPage{}
+	if first != nil && *first == 0 || last != nil && *last == 0 {
+		return page, nil
+	}
+	if after != nil {
+		p, err := orderBy.cursorPredicate(after, orderBy.Direction)
+		if err != nil {
+			return nil, err
+		}
+		cq.Where(p)
+	}
+	if before != nil {
+		p, err := orderBy.cursorPredicate(before, orderBy.Direction.reverse())
+		if err != nil {
+			return nil, err
+		}
+		cq.Where(p)
+	}
+	limit := -1
+	direction := orderBy.Direction
+	switch {
+	case first != nil:
+		limit = *first
+	case last != nil:
+		// Paginating backward queries the entities in the reverse
+		// order, and the page is reversed back after it is loaded.
+		limit = *last
+		direction = direction.reverse()
+	}
+	cq.order = []OrderFunc{orderBy.orderFunc(direction)}
+	if limit >= 0 {
+		// Query one extra entity to check if there is another page.
+		cq.Limit(limit + 1)
+	}
+	nodes, err := cq.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= 0 && len(nodes) > limit {
+		if first != nil {
+			page.PageInfo.HasNextPage = true
+		} else {
+			page.PageInfo.HasPreviousPage = true
+		}
+		nodes = nodes[:limit]
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	if len(nodes) > 0 {
+		page.PageInfo.StartCursor = orderBy.cursor(nodes[0])
+		page.PageInfo.EndCursor = orderBy.cursor(nodes[len(nodes)-1])
+	}
+	page.Nodes = nodes
+	return page, nil
+}
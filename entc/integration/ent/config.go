@@ -24,6 +24,8 @@ type config struct {
 	log func(...interface{})
 	// hooks to execute on mutations.
 	hooks *hooks
+	// interceptors to execute on queries.
+	inters *inters
 }
 
 // hooks per client, for fast access.
@@ -44,6 +46,24 @@ type hooks struct {
 	User      []ent.Hook
 }
 
+// interceptors per client, for fast access.
+type inters struct {
+	Card      []ent.Interceptor
+	Comment   []ent.Interceptor
+	FieldType []ent.Interceptor
+	File      []ent.Interceptor
+	FileType  []ent.Interceptor
+	Goods     []ent.Interceptor
+	Group     []ent.Interceptor
+	GroupInfo []ent.Interceptor
+	Item      []ent.Interceptor
+	Node      []ent.Interceptor
+	Pet       []ent.Interceptor
+	Spec      []ent.Interceptor
+	Task      []ent.Interceptor
+	User      []ent.Interceptor
+}
+
 // Options applies the options on the config object.
 func (c *config) options(opts ...Option) {
 	for _, opt := range opts {
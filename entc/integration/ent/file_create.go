@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/ent/fieldtype"
 	"entgo.io/ent/entc/integration/ent/file"
@@ -24,6 +25,7 @@ type FileCreate struct {
 	config
 	mutation *FileMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetSize sets the "size" field.
@@ -223,8 +225,10 @@ func (fc *FileCreate) sqlSave(ctx context.Context) (*File, error) {
 		}
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	_node.ID = int(id)
+	if _spec.ID.Value != nil {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
 	return _node, nil
 }
 
@@ -239,6 +243,7 @@ func (fc *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
 			},
 		}
 	)
+	_spec.OnConflict = fc.conflict
 	if value, ok := fc.mutation.Size(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -341,10 +346,337 @@ func (fc *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.File.Create().
+//		SetSize(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FileUpsert) {
+//			u.SetSize(v+v)
+//		}).
+//		Exec(ctx)
+//
+func (fc *FileCreate) OnConflict(opts ...sql.ConflictOption) *FileUpsertOne {
+	fc.conflict = opts
+	return &FileUpsertOne{
+		create: fc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.File.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (fc *FileCreate) OnConflictColumns(columns ...string) *FileUpsertOne {
+	fc.conflict = append(fc.conflict, sql.ConflictColumns(columns...))
+	return &FileUpsertOne{
+		create: fc,
+	}
+}
+
+type (
+	// FileUpsertOne is the builder for "upsert"-ing
+	//  one File node.
+	FileUpsertOne struct {
+		create *FileCreate
+	}
+
+	// FileUpsert is the "OnConflict" setter.
+	FileUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetSize sets the "size" field.
+func (u *FileUpsert) SetSize(v int) *FileUpsert {
+	u.Set(file.FieldSize, v)
+	return u
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *FileUpsert) UpdateSize() *FileUpsert {
+	u.SetExcluded(file.FieldSize)
+	return u
+}
+
+// AddSize adds v to the "size" field.
+func (u *FileUpsert) AddSize(v int) *FileUpsert {
+	u.Add(file.FieldSize, v)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *FileUpsert) SetName(v string) *FileUpsert {
+	u.Set(file.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *FileUpsert) UpdateName() *FileUpsert {
+	u.SetExcluded(file.FieldName)
+	return u
+}
+
+// SetUser sets the "user" field.
+func (u *FileUpsert) SetUser(v string) *FileUpsert {
+	u.Set(file.FieldUser, v)
+	return u
+}
+
+// UpdateUser sets the "user" field to the value that was provided on create.
+func (u *FileUpsert) UpdateUser() *FileUpsert {
+	u.SetExcluded(file.FieldUser)
+	return u
+}
+
+// ClearUser clears the value of the "user" field.
+func (u *FileUpsert) ClearUser() *FileUpsert {
+	u.SetNull(file.FieldUser)
+	return u
+}
+
+// SetGroup sets the "group" field.
+func (u *FileUpsert) SetGroup(v string) *FileUpsert {
+	u.Set(file.FieldGroup, v)
+	return u
+}
+
+// UpdateGroup sets the "group" field to the value that was provided on create.
+func (u *FileUpsert) UpdateGroup() *FileUpsert {
+	u.SetExcluded(file.FieldGroup)
+	return u
+}
+
+// ClearGroup clears the value of the "group" field.
+func (u *FileUpsert) ClearGroup() *FileUpsert {
+	u.SetNull(file.FieldGroup)
+	return u
+}
+
+// SetOp sets the "op" field.
+func (u *FileUpsert) SetOp(v bool) *FileUpsert {
+	u.Set(file.FieldOp, v)
+	return u
+}
+
+// UpdateOp sets the "op" field to the value that was provided on create.
+func (u *FileUpsert) UpdateOp() *FileUpsert {
+	u.SetExcluded(file.FieldOp)
+	return u
+}
+
+// ClearOp clears the value of the "op" field.
+func (u *FileUpsert) ClearOp() *FileUpsert {
+	u.SetNull(file.FieldOp)
+	return u
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.File.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *FileUpsertOne) UpdateNewValues() *FileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.File.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *FileUpsertOne) Ignore() *FileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FileUpsertOne) DoNothing() *FileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FileCreate.OnConflict
+// documentation for more info.
+func (u *FileUpsertOne) Update(set func(*FileUpsert)) *FileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSize sets the "size" field.
+func (u *FileUpsertOne) SetSize(v int) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateSize() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *FileUpsertOne) AddSize(v int) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// SetName sets the "name" field.
+func (u *FileUpsertOne) SetName(v string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateName() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetUser sets the "user" field.
+func (u *FileUpsertOne) SetUser(v string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetUser(v)
+	})
+}
+
+// UpdateUser sets the "user" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateUser() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateUser()
+	})
+}
+
+// ClearUser clears the value of the "user" field.
+func (u *FileUpsertOne) ClearUser() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearUser()
+	})
+}
+
+// SetGroup sets the "group" field.
+func (u *FileUpsertOne) SetGroup(v string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetGroup(v)
+	})
+}
+
+// UpdateGroup sets the "group" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateGroup() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateGroup()
+	})
+}
+
+// ClearGroup clears the value of the "group" field.
+func (u *FileUpsertOne) ClearGroup() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearGroup()
+	})
+}
+
+// SetOp sets the "op" field.
+func (u *FileUpsertOne) SetOp(v bool) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetOp(v)
+	})
+}
+
+// UpdateOp sets the "op" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateOp() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateOp()
+	})
+}
+
+// ClearOp clears the value of the "op" field.
+func (u *FileUpsertOne) ClearOp() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearOp()
+	})
+}
+
+// Exec executes the query.
+func (u *FileUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FileCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FileUpsertOne) ExecX(ctx context.Context) {
+	if err := u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// ID executes the UPSERT query and returns the inserted/updated ID.
+// A NotFoundError is returned in case the row was skipped by the conflict
+// action (e.g. DO NOTHING), or its ID could not be resolved by the database.
+func (u *FileUpsertOne) ID(ctx context.Context) (id int, err error) {
+	_node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	if _node.ID == id {
+		return id, &NotFoundError{file.Label}
+	}
+	return _node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *FileUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// Exec executes the query.
+func (fc *FileCreate) Exec(ctx context.Context) error {
+	_, err := fc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fc *FileCreate) ExecX(ctx context.Context) {
+	if err := fc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
 // FileCreateBulk is the builder for creating many File entities in bulk.
 type FileCreateBulk struct {
 	config
 	builders []*FileCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the File entities in the database.
@@ -370,8 +702,10 @@ func (fcb *FileCreateBulk) Save(ctx context.Context) ([]*File, error) {
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, fcb.builders[i+1].mutation)
 				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = fcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, fcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+					if err = sqlgraph.BatchCreate(ctx, fcb.driver, spec); err != nil {
 						if cerr, ok := isSQLConstraintError(err); ok {
 							err = cerr
 						}
@@ -381,8 +715,10 @@ func (fcb *FileCreateBulk) Save(ctx context.Context) ([]*File, error) {
 				if err != nil {
 					return nil, err
 				}
-				id := specs[i].ID.Value.(int64)
-				nodes[i].ID = int(id)
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
 				return nodes[i], nil
 			})
 			for i := len(builder.hooks) - 1; i >= 0; i-- {
@@ -407,3 +743,219 @@ func (fcb *FileCreateBulk) SaveX(ctx context.Context) []*File {
 	}
 	return v
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.File.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FileUpsert) {
+//			u.SetSize(v+v)
+//		}).
+//		Exec(ctx)
+//
+func (fcb *FileCreateBulk) OnConflict(opts ...sql.ConflictOption) *FileUpsertBulk {
+	fcb.conflict = opts
+	return &FileUpsertBulk{
+		create: fcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.File.CreateBulk(builders...).
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (fcb *FileCreateBulk) OnConflictColumns(columns ...string) *FileUpsertBulk {
+	fcb.conflict = append(fcb.conflict, sql.ConflictColumns(columns...))
+	return &FileUpsertBulk{
+		create: fcb,
+	}
+}
+
+// FileUpsertBulk is the builder for "upsert"-ing
+// a bulk of File nodes.
+type FileUpsertBulk struct {
+	create *FileCreateBulk
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.File.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *FileUpsertBulk) UpdateNewValues() *FileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.File.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *FileUpsertBulk) Ignore() *FileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FileUpsertBulk) DoNothing() *FileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FileCreateBulk.OnConflict
+// documentation for more info.
+func (u *FileUpsertBulk) Update(set func(*FileUpsert)) *FileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSize sets the "size" field.
+func (u *FileUpsertBulk) SetSize(v int) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateSize() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *FileUpsertBulk) AddSize(v int) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// SetName sets the "name" field.
+func (u *FileUpsertBulk) SetName(v string) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateName() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetUser sets the "user" field.
+func (u *FileUpsertBulk) SetUser(v string) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetUser(v)
+	})
+}
+
+// UpdateUser sets the "user" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateUser() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateUser()
+	})
+}
+
+// ClearUser clears the value of the "user" field.
+func (u *FileUpsertBulk) ClearUser() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearUser()
+	})
+}
+
+// SetGroup sets the "group" field.
+func (u *FileUpsertBulk) SetGroup(v string) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetGroup(v)
+	})
+}
+
+// UpdateGroup sets the "group" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateGroup() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateGroup()
+	})
+}
+
+// ClearGroup clears the value of the "group" field.
+func (u *FileUpsertBulk) ClearGroup() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearGroup()
+	})
+}
+
+// SetOp sets the "op" field.
+func (u *FileUpsertBulk) SetOp(v bool) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetOp(v)
+	})
+}
+
+// UpdateOp sets the "op" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateOp() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateOp()
+	})
+}
+
+// ClearOp clears the value of the "op" field.
+func (u *FileUpsertBulk) ClearOp() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearOp()
+	})
+}
+
+// Exec executes the query.
+func (u *FileUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FileCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FileCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FileUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the query.
+func (fcb *FileCreateBulk) Exec(ctx context.Context) error {
+	_, err := fcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fcb *FileCreateBulk) ExecX(ctx context.Context) {
+	if err := fcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
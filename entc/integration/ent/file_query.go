@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/ent/fieldtype"
@@ -31,11 +32,13 @@ type FileQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.File
+	inters     []Interceptor
 	// eager-loading edges.
 	withOwner *UserQuery
 	withType  *FileTypeQuery
 	withField *FieldTypeQuery
 	withFKs   bool
+	modifiers []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -65,9 +68,51 @@ func (fq *FileQuery) Order(o ...OrderFunc) *FileQuery {
 	return fq
 }
 
+// Type returns the node type of this query (File). It implements the ent.Query interface.
+func (fq *FileQuery) Type() string {
+	return TypeFile
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (fq *FileQuery) SetLimit(limit int) {
+	fq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (fq *FileQuery) SetOffset(offset int) {
+	fq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (fq *FileQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for FileQuery", fn)
+		}
+		fq.order = append(fq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (fq *FileQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.File:
+			fq.predicates = append(fq.predicates, p)
+		case func(*sql.Selector):
+			fq.predicates = append(fq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for FileQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryOwner chains the current query on the "owner" edge.
 func (fq *FileQuery) QueryOwner() *UserQuery {
-	query := &UserQuery{config: fq.config}
+	query := (&UserClient{config: fq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := fq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -89,7 +134,7 @@ func (fq *FileQuery) QueryOwner() *UserQuery {
 
 // QueryType chains the current query on the "type" edge.
 func (fq *FileQuery) QueryType() *FileTypeQuery {
-	query := &FileTypeQuery{config: fq.config}
+	query := (&FileTypeClient{config: fq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := fq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -111,7 +156,7 @@ func (fq *FileQuery) QueryType() *FileTypeQuery {
 
 // QueryField chains the current query on the "field" edge.
 func (fq *FileQuery) QueryField() *FieldTypeQuery {
-	query := &FieldTypeQuery{config: fq.config}
+	query := (&FieldTypeClient{config: fq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := fq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -236,7 +281,22 @@ func (fq *FileQuery) All(ctx context.Context) ([]*File, error) {
 	if err := fq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return fq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*FileQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, fq, qr, fq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*File)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -271,7 +331,22 @@ func (fq *FileQuery) Count(ctx context.Context) (int, error) {
 	if err := fq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return fq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*FileQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, fq, qr, fq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -288,7 +363,22 @@ func (fq *FileQuery) Exist(ctx context.Context) (bool, error) {
 	if err := fq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return fq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*FileQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, fq, qr, fq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -312,19 +402,21 @@ func (fq *FileQuery) Clone() *FileQuery {
 		offset:     fq.offset,
 		order:      append([]OrderFunc{}, fq.order...),
 		predicates: append([]predicate.File{}, fq.predicates...),
+		inters:     append([]Interceptor{}, fq.inters...),
 		withOwner:  fq.withOwner.Clone(),
 		withType:   fq.withType.Clone(),
 		withField:  fq.withField.Clone(),
 		// clone intermediate query.
-		sql:  fq.sql.Clone(),
-		path: fq.path,
+		sql:       fq.sql.Clone(),
+		path:      fq.path,
+		modifiers: append([]func(*sql.Selector){}, fq.modifiers...),
 	}
 }
 
 // WithOwner tells the query-builder to eager-load the nodes that are connected to
 // the "owner" edge. The optional arguments are used to configure the query builder of the edge.
 func (fq *FileQuery) WithOwner(opts ...func(*UserQuery)) *FileQuery {
-	query := &UserQuery{config: fq.config}
+	query := (&UserClient{config: fq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -335,7 +427,7 @@ func (fq *FileQuery) WithOwner(opts ...func(*UserQuery)) *FileQuery {
 // WithType tells the query-builder to eager-load the nodes that are connected to
 // the "type" edge. The optional arguments are used to configure the query builder of the edge.
 func (fq *FileQuery) WithType(opts ...func(*FileTypeQuery)) *FileQuery {
-	query := &FileTypeQuery{config: fq.config}
+	query := (&FileTypeClient{config: fq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -346,7 +438,7 @@ func (fq *FileQuery) WithType(opts ...func(*FileTypeQuery)) *FileQuery {
 // WithField tells the query-builder to eager-load the nodes that are connected to
 // the "field" edge. The optional arguments are used to configure the query builder of the edge.
 func (fq *FileQuery) WithField(opts ...func(*FieldTypeQuery)) *FileQuery {
-	query := &FieldTypeQuery{config: fq.config}
+	query := (&FieldTypeClient{config: fq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -419,7 +511,7 @@ func (fq *FileQuery) sqlAll(ctx context.Context) ([]*File, error) {
 	var (
 		nodes       = []*File{}
 		withFKs     = fq.withFKs
-		_spec       = fq.querySpec()
+		_spec       = fq.querySpec(ctx)
 		loadedTypes = [3]bool{
 			fq.withOwner != nil,
 			fq.withType != nil,
@@ -537,7 +629,7 @@ func (fq *FileQuery) sqlAll(ctx context.Context) ([]*File, error) {
 }
 
 func (fq *FileQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := fq.querySpec()
+	_spec := fq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, fq.driver, _spec)
 }
 
@@ -549,7 +641,7 @@ func (fq *FileQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (fq *FileQuery) querySpec() *sqlgraph.QuerySpec {
+func (fq *FileQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   file.Table,
@@ -591,6 +683,9 @@ func (fq *FileQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := fq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -616,9 +711,40 @@ func (fq *FileQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := fq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range fq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (fq *FileQuery) ForUpdate(opts ...sql.LockOption) *FileQuery {
+	fq.modifiers = append(fq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return fq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (fq *FileQuery) ForShare(opts ...sql.LockOption) *FileQuery {
+	fq.modifiers = append(fq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return fq
+}
+
 // FileGroupBy is the group-by builder for File entities.
 type FileGroupBy struct {
 	config
@@ -868,7 +994,8 @@ func (fgb *FileGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (fgb *FileGroupBy) sqlQuery() *sql.Selector {
-	selector := fgb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := fgb.sql.ClearLock()
 	columns := make([]string, 0, len(fgb.fields)+len(fgb.fns))
 	columns = append(columns, fgb.fields...)
 	for _, fn := range fgb.fns {
@@ -889,8 +1016,12 @@ func (fs *FileSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := fs.prepareQuery(ctx); err != nil {
 		return err
 	}
-	fs.sql = fs.FileQuery.sqlQuery(ctx)
-	return fs.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		fs.sql = fs.FileQuery.sqlQuery(ctx)
+		return v, fs.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, fs.FileQuery, qr, fs.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -1103,3 +1234,195 @@ func (fs *FileSelect) sqlQuery() sql.Querier {
 	selector.Select(selector.Columns(fs.fields...)...)
 	return selector
 }
+
+// FileOrderField defines a field that File entities can be ordered by in Paginate.
+type FileOrderField struct {
+	field  string
+	value  func(*File) interface{}
+	decode func(interface{}) (interface{}, error)
+}
+
+// String returns the name of the ordering field.
+func (f *FileOrderField) String() string {
+	return f.field
+}
+
+var (
+	// FileOrderFieldSize orders File entities by the "size" field.
+	FileOrderFieldSize = &FileOrderField{
+		field: file.FieldSize,
+		value: func(f *File) interface{} {
+			return f.Size
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v int
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+	// FileOrderFieldName orders File entities by the "name" field.
+	FileOrderFieldName = &FileOrderField{
+		field: file.FieldName,
+		value: func(f *File) interface{} {
+			return f.Name
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v string
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+)
+
+// FileOrder defines the ordering of File entities in Paginate.
+// Entities are ordered by the given field (if any) and then by their ID.
+type FileOrder struct {
+	Direction OrderDirection
+	Field     *FileOrderField
+}
+
+// cursor returns the cursor of the given entity in the ordering.
+func (o *FileOrder) cursor(f *File) *Cursor {
+	if o.Field == nil {
+		return &Cursor{ID: f.ID}
+	}
+	return &Cursor{ID: f.ID, Value: o.Field.value(f)}
+}
+
+// decode returns the typed ID and ordering value of the given cursor.
+func (o *FileOrder) decode(c *Cursor) (id int, value interface{}, err error) {
+	if err := decodeCursorValue(c.ID, &id); err != nil {
+		return id, nil, err
+	}
+	if o.Field == nil {
+		return id, nil, nil
+	}
+	if c.Value == nil {
+		return id, nil, fmt.Errorf("ent: cursor does not match the ordering by %q", o.Field.field)
+	}
+	if value, err = o.Field.decode(c.Value); err != nil {
+		return id, nil, err
+	}
+	return id, value, nil
+}
+
+// cursorPredicate returns a predicate that matches the entities that come
+// after the cursor when the entities are ordered in the given direction.
+func (o *FileOrder) cursorPredicate(c *Cursor, direction OrderDirection) (predicate.File, error) {
+	id, value, err := o.decode(c)
+	if err != nil {
+		return nil, err
+	}
+	return func(s *sql.Selector) {
+		if o.Field == nil {
+			if direction == OrderDirectionAsc {
+				s.Where(sql.GT(s.C(file.FieldID), id))
+			} else {
+				s.Where(sql.LT(s.C(file.FieldID), id))
+			}
+			return
+		}
+		columns := []string{s.C(o.Field.field), s.C(file.FieldID)}
+		if direction == OrderDirectionAsc {
+			s.Where(sql.CompositeGT(columns, value, id))
+		} else {
+			s.Where(sql.CompositeLT(columns, value, id))
+		}
+	}, nil
+}
+
+// orderFunc returns the ordering function of the entities in the given direction.
+func (o *FileOrder) orderFunc(direction OrderDirection) OrderFunc {
+	fields := []string{file.FieldID}
+	if o.Field != nil {
+		fields = []string{o.Field.field, file.FieldID}
+	}
+	if direction == OrderDirectionDesc {
+		return Desc(fields...)
+	}
+	return Asc(fields...)
+}
+
+// FilePage is a page of File entities returned by Paginate.
+type FilePage struct {
+	Nodes    []*File  `json:"nodes"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns a page of File entities using cursor-based
+// (keyset) pagination. The entities are ordered by the given order, or by their ID if it is nil.
+// The after and before cursors limit the page to the entities that come after/before them in
+// this order, and first/last limit the page to the first/last N entities. Note that Paginate
+// overrides the ordering and the limit of the query.
+//
+//	page, err := client.File.Query().
+//		Paginate(ctx, after, &first, nil, nil, &ent.FileOrder{Direction: ent.OrderDirectionAsc})
+//
+func (fq *FileQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *FileOrder) (*FilePage, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &FileOrder{Direction: OrderDirectionAsc}
+	}
+	if err := orderBy.Direction.Validate(); err != nil {
+		return nil, err
+	}
+	page := &FilePage{}
+	if first != nil && *first == 0 || last != nil && *last == 0 {
+		return page, nil
+	}
+	if after != nil {
+		p, err := orderBy.cursorPredicate(after, orderBy.Direction)
+		if err != nil {
+			return nil, err
+		}
+		fq.Where(p)
+	}
+	if before != nil {
+		p, err := orderBy.cursorPredicate(before, orderBy.Direction.reverse())
+		if err != nil {
+			return nil, err
+		}
+		fq.Where(p)
+	}
+	limit := -1
+	direction := orderBy.Direction
+	switch {
+	case first != nil:
+		limit = *first
+	case last != nil:
+		// Paginating backward queries the entities in the reverse
+		// order, and the page is reversed back after it is loaded.
+		limit = *last
+		direction = direction.reverse()
+	}
+	fq.order = []OrderFunc{orderBy.orderFunc(direction)}
+	if limit >= 0 {
+		// Query one extra entity to check if there is another page.
+		fq.Limit(limit + 1)
+	}
+	nodes, err := fq.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= 0 && len(nodes) > limit {
+		if first != nil {
+			page.PageInfo.HasNextPage = true
+		} else {
+			page.PageInfo.HasPreviousPage = true
+		}
+		nodes = nodes[:limit]
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	if len(nodes) > 0 {
+		page.PageInfo.StartCursor = orderBy.cursor(nodes[0])
+		page.PageInfo.EndCursor = orderBy.cursor(nodes[len(nodes)-1])
+	}
+	page.Nodes = nodes
+	return page, nil
+}
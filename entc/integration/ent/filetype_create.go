@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/ent/file"
 	"entgo.io/ent/entc/integration/ent/filetype"
@@ -22,6 +23,7 @@ type FileTypeCreate struct {
 	config
 	mutation *FileTypeMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetName sets the "name" field.
@@ -167,8 +169,10 @@ func (ftc *FileTypeCreate) sqlSave(ctx context.Context) (*FileType, error) {
 		}
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	_node.ID = int(id)
+	if _spec.ID.Value != nil {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
 	return _node, nil
 }
 
@@ -183,6 +187,7 @@ func (ftc *FileTypeCreate) createSpec() (*FileType, *sqlgraph.CreateSpec) {
 			},
 		}
 	)
+	_spec.OnConflict = ftc.conflict
 	if value, ok := ftc.mutation.Name(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -229,10 +234,233 @@ func (ftc *FileTypeCreate) createSpec() (*FileType, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FileType.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FileTypeUpsert) {
+//			u.SetName(v+v)
+//		}).
+//		Exec(ctx)
+//
+func (ftc *FileTypeCreate) OnConflict(opts ...sql.ConflictOption) *FileTypeUpsertOne {
+	ftc.conflict = opts
+	return &FileTypeUpsertOne{
+		create: ftc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FileType.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (ftc *FileTypeCreate) OnConflictColumns(columns ...string) *FileTypeUpsertOne {
+	ftc.conflict = append(ftc.conflict, sql.ConflictColumns(columns...))
+	return &FileTypeUpsertOne{
+		create: ftc,
+	}
+}
+
+type (
+	// FileTypeUpsertOne is the builder for "upsert"-ing
+	//  one FileType node.
+	FileTypeUpsertOne struct {
+		create *FileTypeCreate
+	}
+
+	// FileTypeUpsert is the "OnConflict" setter.
+	FileTypeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *FileTypeUpsert) SetName(v string) *FileTypeUpsert {
+	u.Set(filetype.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *FileTypeUpsert) UpdateName() *FileTypeUpsert {
+	u.SetExcluded(filetype.FieldName)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *FileTypeUpsert) SetType(v filetype.Type) *FileTypeUpsert {
+	u.Set(filetype.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *FileTypeUpsert) UpdateType() *FileTypeUpsert {
+	u.SetExcluded(filetype.FieldType)
+	return u
+}
+
+// SetState sets the "state" field.
+func (u *FileTypeUpsert) SetState(v filetype.State) *FileTypeUpsert {
+	u.Set(filetype.FieldState, v)
+	return u
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *FileTypeUpsert) UpdateState() *FileTypeUpsert {
+	u.SetExcluded(filetype.FieldState)
+	return u
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.FileType.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *FileTypeUpsertOne) UpdateNewValues() *FileTypeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FileType.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *FileTypeUpsertOne) Ignore() *FileTypeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FileTypeUpsertOne) DoNothing() *FileTypeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FileTypeCreate.OnConflict
+// documentation for more info.
+func (u *FileTypeUpsertOne) Update(set func(*FileTypeUpsert)) *FileTypeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FileTypeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *FileTypeUpsertOne) SetName(v string) *FileTypeUpsertOne {
+	return u.Update(func(s *FileTypeUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *FileTypeUpsertOne) UpdateName() *FileTypeUpsertOne {
+	return u.Update(func(s *FileTypeUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *FileTypeUpsertOne) SetType(v filetype.Type) *FileTypeUpsertOne {
+	return u.Update(func(s *FileTypeUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *FileTypeUpsertOne) UpdateType() *FileTypeUpsertOne {
+	return u.Update(func(s *FileTypeUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *FileTypeUpsertOne) SetState(v filetype.State) *FileTypeUpsertOne {
+	return u.Update(func(s *FileTypeUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *FileTypeUpsertOne) UpdateState() *FileTypeUpsertOne {
+	return u.Update(func(s *FileTypeUpsert) {
+		s.UpdateState()
+	})
+}
+
+// Exec executes the query.
+func (u *FileTypeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FileTypeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FileTypeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// ID executes the UPSERT query and returns the inserted/updated ID.
+// A NotFoundError is returned in case the row was skipped by the conflict
+// action (e.g. DO NOTHING), or its ID could not be resolved by the database.
+func (u *FileTypeUpsertOne) ID(ctx context.Context) (id int, err error) {
+	_node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	if _node.ID == id {
+		return id, &NotFoundError{filetype.Label}
+	}
+	return _node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *FileTypeUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// Exec executes the query.
+func (ftc *FileTypeCreate) Exec(ctx context.Context) error {
+	_, err := ftc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ftc *FileTypeCreate) ExecX(ctx context.Context) {
+	if err := ftc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
 // FileTypeCreateBulk is the builder for creating many FileType entities in bulk.
 type FileTypeCreateBulk struct {
 	config
 	builders []*FileTypeCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the FileType entities in the database.
@@ -258,8 +486,10 @@ func (ftcb *FileTypeCreateBulk) Save(ctx context.Context) ([]*FileType, error) {
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, ftcb.builders[i+1].mutation)
 				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ftcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, ftcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+					if err = sqlgraph.BatchCreate(ctx, ftcb.driver, spec); err != nil {
 						if cerr, ok := isSQLConstraintError(err); ok {
 							err = cerr
 						}
@@ -269,8 +499,10 @@ func (ftcb *FileTypeCreateBulk) Save(ctx context.Context) ([]*FileType, error) {
 				if err != nil {
 					return nil, err
 				}
-				id := specs[i].ID.Value.(int64)
-				nodes[i].ID = int(id)
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
 				return nodes[i], nil
 			})
 			for i := len(builder.hooks) - 1; i >= 0; i-- {
@@ -295,3 +527,163 @@ func (ftcb *FileTypeCreateBulk) SaveX(ctx context.Context) []*FileType {
 	}
 	return v
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FileType.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FileTypeUpsert) {
+//			u.SetName(v+v)
+//		}).
+//		Exec(ctx)
+//
+func (ftcb *FileTypeCreateBulk) OnConflict(opts ...sql.ConflictOption) *FileTypeUpsertBulk {
+	ftcb.conflict = opts
+	return &FileTypeUpsertBulk{
+		create: ftcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FileType.CreateBulk(builders...).
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (ftcb *FileTypeCreateBulk) OnConflictColumns(columns ...string) *FileTypeUpsertBulk {
+	ftcb.conflict = append(ftcb.conflict, sql.ConflictColumns(columns...))
+	return &FileTypeUpsertBulk{
+		create: ftcb,
+	}
+}
+
+// FileTypeUpsertBulk is the builder for "upsert"-ing
+// a bulk of FileType nodes.
+type FileTypeUpsertBulk struct {
+	create *FileTypeCreateBulk
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.FileType.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *FileTypeUpsertBulk) UpdateNewValues() *FileTypeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FileType.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *FileTypeUpsertBulk) Ignore() *FileTypeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FileTypeUpsertBulk) DoNothing() *FileTypeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FileTypeCreateBulk.OnConflict
+// documentation for more info.
+func (u *FileTypeUpsertBulk) Update(set func(*FileTypeUpsert)) *FileTypeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FileTypeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *FileTypeUpsertBulk) SetName(v string) *FileTypeUpsertBulk {
+	return u.Update(func(s *FileTypeUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *FileTypeUpsertBulk) UpdateName() *FileTypeUpsertBulk {
+	return u.Update(func(s *FileTypeUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *FileTypeUpsertBulk) SetType(v filetype.Type) *FileTypeUpsertBulk {
+	return u.Update(func(s *FileTypeUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *FileTypeUpsertBulk) UpdateType() *FileTypeUpsertBulk {
+	return u.Update(func(s *FileTypeUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *FileTypeUpsertBulk) SetState(v filetype.State) *FileTypeUpsertBulk {
+	return u.Update(func(s *FileTypeUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *FileTypeUpsertBulk) UpdateState() *FileTypeUpsertBulk {
+	return u.Update(func(s *FileTypeUpsert) {
+		s.UpdateState()
+	})
+}
+
+// Exec executes the query.
+func (u *FileTypeUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FileTypeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FileTypeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FileTypeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the query.
+func (ftcb *FileTypeCreateBulk) Exec(ctx context.Context) error {
+	_, err := ftcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ftcb *FileTypeCreateBulk) ExecX(ctx context.Context) {
+	if err := ftcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
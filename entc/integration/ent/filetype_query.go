@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/ent/file"
@@ -29,8 +30,10 @@ type FileTypeQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.FileType
+	inters     []Interceptor
 	// eager-loading edges.
 	withFiles *FileQuery
+	modifiers []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -60,9 +63,51 @@ func (ftq *FileTypeQuery) Order(o ...OrderFunc) *FileTypeQuery {
 	return ftq
 }
 
+// Type returns the node type of this query (FileType). It implements the ent.Query interface.
+func (ftq *FileTypeQuery) Type() string {
+	return TypeFileType
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (ftq *FileTypeQuery) SetLimit(limit int) {
+	ftq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (ftq *FileTypeQuery) SetOffset(offset int) {
+	ftq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (ftq *FileTypeQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for FileTypeQuery", fn)
+		}
+		ftq.order = append(ftq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (ftq *FileTypeQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.FileType:
+			ftq.predicates = append(ftq.predicates, p)
+		case func(*sql.Selector):
+			ftq.predicates = append(ftq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for FileTypeQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryFiles chains the current query on the "files" edge.
 func (ftq *FileTypeQuery) QueryFiles() *FileQuery {
-	query := &FileQuery{config: ftq.config}
+	query := (&FileClient{config: ftq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := ftq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -187,7 +232,22 @@ func (ftq *FileTypeQuery) All(ctx context.Context) ([]*FileType, error) {
 	if err := ftq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return ftq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*FileTypeQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, ftq, qr, ftq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*FileType)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -222,7 +282,22 @@ func (ftq *FileTypeQuery) Count(ctx context.Context) (int, error) {
 	if err := ftq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return ftq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*FileTypeQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, ftq, qr, ftq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -239,7 +314,22 @@ func (ftq *FileTypeQuery) Exist(ctx context.Context) (bool, error) {
 	if err := ftq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return ftq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*FileTypeQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, ftq, qr, ftq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -263,17 +353,19 @@ func (ftq *FileTypeQuery) Clone() *FileTypeQuery {
 		offset:     ftq.offset,
 		order:      append([]OrderFunc{}, ftq.order...),
 		predicates: append([]predicate.FileType{}, ftq.predicates...),
+		inters:     append([]Interceptor{}, ftq.inters...),
 		withFiles:  ftq.withFiles.Clone(),
 		// clone intermediate query.
-		sql:  ftq.sql.Clone(),
-		path: ftq.path,
+		sql:       ftq.sql.Clone(),
+		path:      ftq.path,
+		modifiers: append([]func(*sql.Selector){}, ftq.modifiers...),
 	}
 }
 
 // WithFiles tells the query-builder to eager-load the nodes that are connected to
 // the "files" edge. The optional arguments are used to configure the query builder of the edge.
 func (ftq *FileTypeQuery) WithFiles(opts ...func(*FileQuery)) *FileTypeQuery {
-	query := &FileQuery{config: ftq.config}
+	query := (&FileClient{config: ftq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -345,7 +437,7 @@ func (ftq *FileTypeQuery) prepareQuery(ctx context.Context) error {
 func (ftq *FileTypeQuery) sqlAll(ctx context.Context) ([]*FileType, error) {
 	var (
 		nodes       = []*FileType{}
-		_spec       = ftq.querySpec()
+		_spec       = ftq.querySpec(ctx)
 		loadedTypes = [1]bool{
 			ftq.withFiles != nil,
 		}
@@ -403,7 +495,7 @@ func (ftq *FileTypeQuery) sqlAll(ctx context.Context) ([]*FileType, error) {
 }
 
 func (ftq *FileTypeQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := ftq.querySpec()
+	_spec := ftq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, ftq.driver, _spec)
 }
 
@@ -415,7 +507,7 @@ func (ftq *FileTypeQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (ftq *FileTypeQuery) querySpec() *sqlgraph.QuerySpec {
+func (ftq *FileTypeQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   filetype.Table,
@@ -457,6 +549,9 @@ func (ftq *FileTypeQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := ftq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -482,9 +577,40 @@ func (ftq *FileTypeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := ftq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range ftq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ftq *FileTypeQuery) ForUpdate(opts ...sql.LockOption) *FileTypeQuery {
+	ftq.modifiers = append(ftq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return ftq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ftq *FileTypeQuery) ForShare(opts ...sql.LockOption) *FileTypeQuery {
+	ftq.modifiers = append(ftq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return ftq
+}
+
 // FileTypeGroupBy is the group-by builder for FileType entities.
 type FileTypeGroupBy struct {
 	config
@@ -734,7 +860,8 @@ func (ftgb *FileTypeGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (ftgb *FileTypeGroupBy) sqlQuery() *sql.Selector {
-	selector := ftgb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := ftgb.sql.ClearLock()
 	columns := make([]string, 0, len(ftgb.fields)+len(ftgb.fns))
 	columns = append(columns, ftgb.fields...)
 	for _, fn := range ftgb.fns {
@@ -755,8 +882,12 @@ func (fts *FileTypeSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := fts.prepareQuery(ctx); err != nil {
 		return err
 	}
-	fts.sql = fts.FileTypeQuery.sqlQuery(ctx)
-	return fts.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		fts.sql = fts.FileTypeQuery.sqlQuery(ctx)
+		return v, fts.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, fts.FileTypeQuery, qr, fts.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -969,3 +1100,207 @@ func (fts *FileTypeSelect) sqlQuery() sql.Querier {
 	selector.Select(selector.Columns(fts.fields...)...)
 	return selector
 }
+
+// FileTypeOrderField defines a field that FileType entities can be ordered by in Paginate.
+type FileTypeOrderField struct {
+	field  string
+	value  func(*FileType) interface{}
+	decode func(interface{}) (interface{}, error)
+}
+
+// String returns the name of the ordering field.
+func (f *FileTypeOrderField) String() string {
+	return f.field
+}
+
+var (
+	// FileTypeOrderFieldName orders FileType entities by the "name" field.
+	FileTypeOrderFieldName = &FileTypeOrderField{
+		field: filetype.FieldName,
+		value: func(ft *FileType) interface{} {
+			return ft.Name
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v string
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+	// FileTypeOrderFieldType orders FileType entities by the "type" field.
+	FileTypeOrderFieldType = &FileTypeOrderField{
+		field: filetype.FieldType,
+		value: func(ft *FileType) interface{} {
+			return ft.Type
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v filetype.Type
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+	// FileTypeOrderFieldState orders FileType entities by the "state" field.
+	FileTypeOrderFieldState = &FileTypeOrderField{
+		field: filetype.FieldState,
+		value: func(ft *FileType) interface{} {
+			return ft.State
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v filetype.State
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+)
+
+// FileTypeOrder defines the ordering of FileType entities in Paginate.
+// Entities are ordered by the given field (if any) and then by their ID.
+type FileTypeOrder struct {
+	Direction OrderDirection
+	Field     *FileTypeOrderField
+}
+
+// cursor returns the cursor of the given entity in the ordering.
+func (o *FileTypeOrder) cursor(ft *FileType) *Cursor {
+	if o.Field == nil {
+		return &Cursor{ID: ft.ID}
+	}
+	return &Cursor{ID: ft.ID, Value: o.Field.value(ft)}
+}
+
+// decode returns the typed ID and ordering value of the given cursor.
+func (o *FileTypeOrder) decode(c *Cursor) (id int, value interface{}, err error) {
+	if err := decodeCursorValue(c.ID, &id); err != nil {
+		return id, nil, err
+	}
+	if o.Field == nil {
+		return id, nil, nil
+	}
+	if c.Value == nil {
+		return id, nil, fmt.Errorf("ent: cursor does not match the ordering by %q", o.Field.field)
+	}
+	if value, err = o.Field.decode(c.Value); err != nil {
+		return id, nil, err
+	}
+	return id, value, nil
+}
+
+// cursorPredicate returns a predicate that matches the entities that come
+// after the cursor when the entities are ordered in the given direction.
+func (o *FileTypeOrder) cursorPredicate(c *Cursor, direction OrderDirection) (predicate.FileType, error) {
+	id, value, err := o.decode(c)
+	if err != nil {
+		return nil, err
+	}
+	return func(s *sql.Selector) {
+		if o.Field == nil {
+			if direction == OrderDirectionAsc {
+				s.Where(sql.GT(s.C(filetype.FieldID), id))
+			} else {
+				s.Where(sql.LT(s.C(filetype.FieldID), id))
+			}
+			return
+		}
+		columns := []string{s.C(o.Field.field), s.C(filetype.FieldID)}
+		if direction == OrderDirectionAsc {
+			s.Where(sql.CompositeGT(columns, value, id))
+		} else {
+			s.Where(sql.CompositeLT(columns, value, id))
+		}
+	}, nil
+}
+
+// orderFunc returns the ordering function of the entities in the given direction.
+func (o *FileTypeOrder) orderFunc(direction OrderDirection) OrderFunc {
+	fields := []string{filetype.FieldID}
+	if o.Field != nil {
+		fields = []string{o.Field.field, filetype.FieldID}
+	}
+	if direction == OrderDirectionDesc {
+		return Desc(fields...)
+	}
+	return Asc(fields...)
+}
+
+// FileTypePage is a page of FileType entities returned by Paginate.
+type FileTypePage struct {
+	Nodes    []*FileType `json:"nodes"`
+	PageInfo PageInfo    `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns a page of FileType entities using cursor-based
+// (keyset) pagination. The entities are ordered by the given order, or by their ID if it is nil.
+// The after and before cursors limit the page to the entities that come after/before them in
+// this order, and first/last limit the page to the first/last N entities. Note that Paginate
+// overrides the ordering and the limit of the query.
+//
+//	page, err := client.FileType.Query().
+//		Paginate(ctx, after, &first, nil, nil, &ent.FileTypeOrder{Direction: ent.OrderDirectionAsc})
+//
+func (ftq *FileTypeQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *FileTypeOrder) (*FileTypePage, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &FileTypeOrder{Direction: OrderDirectionAsc}
+	}
+	if err := orderBy.Direction.Validate(); err != nil {
+		return nil, err
+	}
+	page := &FileTypePage{}
+	if first != nil && *first == 0 || last != nil && *last == 0 {
+		return page, nil
+	}
+	if after != nil {
+		p, err := orderBy.cursorPredicate(after, orderBy.Direction)
+		if err != nil {
+			return nil, err
+		}
+		ftq.Where(p)
+	}
+	if before != nil {
+		p, err := orderBy.cursorPredicate(before, orderBy.Direction.reverse())
+		if err != nil {
+			return nil, err
+		}
+		ftq.Where(p)
+	}
+	limit := -1
+	direction := orderBy.Direction
+	switch {
+	case first != nil:
+		limit = *first
+	case last != nil:
+		// Paginating backward queries the entities in the reverse
+		// order, and the page is reversed back after it is loaded.
+		limit = *last
+		direction = direction.reverse()
+	}
+	ftq.order = []OrderFunc{orderBy.orderFunc(direction)}
+	if limit >= 0 {
+		// Query one extra entity to check if there is another page.
+		ftq.Limit(limit + 1)
+	}
+	nodes, err := ftq.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= 0 && len(nodes) > limit {
+		if first != nil {
+			page.PageInfo.HasNextPage = true
+		} else {
+			page.PageInfo.HasPreviousPage = true
+		}
+		nodes = nodes[:limit]
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	if len(nodes) > 0 {
+		page.PageInfo.StartCursor = orderBy.cursor(nodes[0])
+		page.PageInfo.EndCursor = orderBy.cursor(nodes[len(nodes)-1])
+	}
+	page.Nodes = nodes
+	return page, nil
+}
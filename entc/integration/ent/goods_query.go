@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/ent/goods"
@@ -27,6 +28,8 @@ type GoodsQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Goods
+	inters     []Interceptor
+	modifiers  []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -56,6 +59,48 @@ func (gq *GoodsQuery) Order(o ...OrderFunc) *GoodsQuery {
 	return gq
 }
 
+// Type returns the node type of this query (Goods). It implements the ent.Query interface.
+func (gq *GoodsQuery) Type() string {
+	return TypeGoods
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (gq *GoodsQuery) SetLimit(limit int) {
+	gq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (gq *GoodsQuery) SetOffset(offset int) {
+	gq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (gq *GoodsQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for GoodsQuery", fn)
+		}
+		gq.order = append(gq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (gq *GoodsQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Goods:
+			gq.predicates = append(gq.predicates, p)
+		case func(*sql.Selector):
+			gq.predicates = append(gq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for GoodsQuery", p)
+		}
+	}
+	return nil
+}
+
 // First returns the first Goods entity from the query.
 // Returns a *NotFoundError when no Goods was found.
 func (gq *GoodsQuery) First(ctx context.Context) (*Goods, error) {
@@ -161,7 +206,22 @@ func (gq *GoodsQuery) All(ctx context.Context) ([]*Goods, error) {
 	if err := gq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return gq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*GoodsQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, gq, qr, gq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Goods)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -196,7 +256,22 @@ func (gq *GoodsQuery) Count(ctx context.Context) (int, error) {
 	if err := gq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return gq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*GoodsQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, gq, qr, gq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -213,7 +288,22 @@ func (gq *GoodsQuery) Exist(ctx context.Context) (bool, error) {
 	if err := gq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return gq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*GoodsQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, gq, qr, gq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -237,9 +327,11 @@ func (gq *GoodsQuery) Clone() *GoodsQuery {
 		offset:     gq.offset,
 		order:      append([]OrderFunc{}, gq.order...),
 		predicates: append([]predicate.Goods{}, gq.predicates...),
+		inters:     append([]Interceptor{}, gq.inters...),
 		// clone intermediate query.
-		sql:  gq.sql.Clone(),
-		path: gq.path,
+		sql:       gq.sql.Clone(),
+		path:      gq.path,
+		modifiers: append([]func(*sql.Selector){}, gq.modifiers...),
 	}
 }
 
@@ -283,7 +375,7 @@ func (gq *GoodsQuery) prepareQuery(ctx context.Context) error {
 func (gq *GoodsQuery) sqlAll(ctx context.Context) ([]*Goods, error) {
 	var (
 		nodes = []*Goods{}
-		_spec = gq.querySpec()
+		_spec = gq.querySpec(ctx)
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		node := &Goods{config: gq.config}
@@ -307,7 +399,7 @@ func (gq *GoodsQuery) sqlAll(ctx context.Context) ([]*Goods, error) {
 }
 
 func (gq *GoodsQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := gq.querySpec()
+	_spec := gq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, gq.driver, _spec)
 }
 
@@ -319,7 +411,7 @@ func (gq *GoodsQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (gq *GoodsQuery) querySpec() *sqlgraph.QuerySpec {
+func (gq *GoodsQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   goods.Table,
@@ -361,6 +453,9 @@ func (gq *GoodsQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := gq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -386,9 +481,40 @@ func (gq *GoodsQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := gq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range gq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (gq *GoodsQuery) ForUpdate(opts ...sql.LockOption) *GoodsQuery {
+	gq.modifiers = append(gq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return gq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (gq *GoodsQuery) ForShare(opts ...sql.LockOption) *GoodsQuery {
+	gq.modifiers = append(gq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return gq
+}
+
 // GoodsGroupBy is the group-by builder for Goods entities.
 type GoodsGroupBy struct {
 	config
@@ -638,7 +764,8 @@ func (ggb *GoodsGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (ggb *GoodsGroupBy) sqlQuery() *sql.Selector {
-	selector := ggb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := ggb.sql.ClearLock()
 	columns := make([]string, 0, len(ggb.fields)+len(ggb.fns))
 	columns = append(columns, ggb.fields...)
 	for _, fn := range ggb.fns {
@@ -659,8 +786,12 @@ func (gs *GoodsSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := gs.prepareQuery(ctx); err != nil {
 		return err
 	}
-	gs.sql = gs.GoodsQuery.sqlQuery(ctx)
-	return gs.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		gs.sql = gs.GoodsQuery.sqlQuery(ctx)
+		return v, gs.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, gs.GoodsQuery, qr, gs.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -873,3 +1004,170 @@ func (gs *GoodsSelect) sqlQuery() sql.Querier {
 	selector.Select(selector.Columns(gs.fields...)...)
 	return selector
 }
+
+// GoodsOrderField defines a field that Goods entities can be ordered by in Paginate.
+type GoodsOrderField struct {
+	field  string
+	value  func(*Goods) interface{}
+	decode func(interface{}) (interface{}, error)
+}
+
+// String returns the name of the ordering field.
+func (f *GoodsOrderField) String() string {
+	return f.field
+}
+
+var ()
+
+// GoodsOrder defines the ordering of Goods entities in Paginate.
+// Entities are ordered by the given field (if any) and then by their ID.
+type GoodsOrder struct {
+	Direction OrderDirection
+	Field     *GoodsOrderField
+}
+
+// cursor returns the cursor of the given entity in the ordering.
+func (o *GoodsOrder) cursor(_go *Goods) *Cursor {
+	if o.Field == nil {
+		return &Cursor{ID: _go.ID}
+	}
+	return &Cursor{ID: _go.ID, Value: o.Field.value(_go)}
+}
+
+// decode returns the typed ID and ordering value of the given cursor.
+func (o *GoodsOrder) decode(c *Cursor) (id int, value interface{}, err error) {
+	if err := decodeCursorValue(c.ID, &id); err != nil {
+		return id, nil, err
+	}
+	if o.Field == nil {
+		return id, nil, nil
+	}
+	if c.Value == nil {
+		return id, nil, fmt.Errorf("ent: cursor does not match the ordering by %q", o.Field.field)
+	}
+	if value, err = o.Field.decode(c.Value); err != nil {
+		return id, nil, err
+	}
+	return id, value, nil
+}
+
+// cursorPredicate returns a predicate that matches the entities that come
+// after the cursor when the entities are ordered in the given direction.
+func (o *GoodsOrder) cursorPredicate(c *Cursor, direction OrderDirection) (predicate.Goods, error) {
+	id, value, err := o.decode(c)
+	if err != nil {
+		return nil, err
+	}
+	return func(s *sql.Selector) {
+		if o.Field == nil {
+			if direction == OrderDirectionAsc {
+				s.Where(sql.GT(s.C(goods.FieldID), id))
+			} else {
+				s.Where(sql.LT(s.C(goods.FieldID), id))
+			}
+			return
+		}
+		columns := []string{s.C(o.Field.field), s.C(goods.FieldID)}
+		if direction == OrderDirectionAsc {
+			s.Where(sql.CompositeGT(columns, value, id))
+		} else {
+			s.Where(sql.CompositeLT(columns, value, id))
+		}
+	}, nil
+}
+
+// orderFunc returns the ordering function of the entities in the given direction.
+func (o *GoodsOrder) orderFunc(direction OrderDirection) OrderFunc {
+	fields := []string{goods.FieldID}
+	if o.Field != nil {
+		fields = []string{o.Field.field, goods.FieldID}
+	}
+	if direction == OrderDirectionDesc {
+		return Desc(fields...)
+	}
+	return Asc(fields...)
+}
+
+// GoodsPage is a page of Goods entities returned by Paginate.
+type GoodsPage struct {
+	Nodes    []*Goods `json:"nodes"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns a page of Goods entities using cursor-based
+// (keyset) pagination. The entities are ordered by the given order, or by their ID if it is nil.
+// The after and before cursors limit the page to the entities that come after/before them in
+// this order, and first/last limit the page to the first/last N entities. Note that Paginate
+// overrides the ordering and the limit of the query.
+//
+//	page, err := client.Goods.Query().
+//		Paginate(ctx, after, &first, nil, nil, &ent.GoodsOrder{Direction: ent.OrderDirectionAsc})
+//
+func (gq *GoodsQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *GoodsOrder) (*GoodsPage, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &GoodsOrder{Direction: OrderDirectionAsc}
+	}
+	if err := orderBy.Direction.Validate(); err != nil {
+		return nil, err
+	}
+	page := &GoodsPage{}
+	if first != nil && *first == 0 || last != nil && *last == 0 {
+		return page, nil
+	}
+	if after != nil {
+		p, err := orderBy.cursorPredicate(after, orderBy.Direction)
+		if err != nil {
+			return nil, err
+		}
+		gq.Where(p)
+	}
+	if before != nil {
+		p, err := orderBy.cursorPredicate(before, orderBy.Direction.reverse())
+		if err != nil {
+			return nil, err
+		}
+		gq.Where(p)
+	}
+	limit := -1
+	direction := orderBy.Direction
+	switch {
+	case first != nil:
+		limit = *first
+	case last != nil:
+		// Paginating backward queries the entities in the reverse
+		// order, and the page is reversed back after it is loaded.
+		limit = *last
+		direction = direction.reverse()
+	}
+	gq.order = []OrderFunc{orderBy.orderFunc(direction)}
+	if limit >= 0 {
+		// Query one extra entity to check if there is another page.
+		gq.Limit(limit + 1)
+	}
+	nodes, err := gq.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= 0 && len(nodes) > limit {
+		if first != nil {
+			page.PageInfo.HasNextPage = true
+		} else {
+			page.PageInfo.HasPreviousPage = true
+		}
+		nodes = nodes[:limit]
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	if len(nodes) > 0 {
+		page.PageInfo.StartCursor = orderBy.cursor(nodes[0])
+		page.PageInfo.EndCursor = orderBy.cursor(nodes[len(nodes)-1])
+	}
+	page.Nodes = nodes
+	return page, nil
+}
@@ -12,7 +12,9 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"time"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/ent/file"
@@ -31,12 +33,14 @@ type GroupQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Group
+	inters     []Interceptor
 	// eager-loading edges.
 	withFiles   *FileQuery
 	withBlocked *UserQuery
 	withUsers   *UserQuery
 	withInfo    *GroupInfoQuery
 	withFKs     bool
+	modifiers   []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -66,9 +70,51 @@ func (gq *GroupQuery) Order(o ...OrderFunc) *GroupQuery {
 	return gq
 }
 
+// Type returns the node type of this query (Group). It implements the ent.Query interface.
+func (gq *GroupQuery) Type() string {
+	return TypeGroup
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (gq *GroupQuery) SetLimit(limit int) {
+	gq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (gq *GroupQuery) SetOffset(offset int) {
+	gq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (gq *GroupQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for GroupQuery", fn)
+		}
+		gq.order = append(gq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (gq *GroupQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Group:
+			gq.predicates = append(gq.predicates, p)
+		case func(*sql.Selector):
+			gq.predicates = append(gq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for GroupQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryFiles chains the current query on the "files" edge.
 func (gq *GroupQuery) QueryFiles() *FileQuery {
-	query := &FileQuery{config: gq.config}
+	query := (&FileClient{config: gq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := gq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -90,7 +136,7 @@ func (gq *GroupQuery) QueryFiles() *FileQuery {
 
 // QueryBlocked chains the current query on the "blocked" edge.
 func (gq *GroupQuery) QueryBlocked() *UserQuery {
-	query := &UserQuery{config: gq.config}
+	query := (&UserClient{config: gq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := gq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -112,7 +158,7 @@ func (gq *GroupQuery) QueryBlocked() *UserQuery {
 
 // QueryUsers chains the current query on the "users" edge.
 func (gq *GroupQuery) QueryUsers() *UserQuery {
-	query := &UserQuery{config: gq.config}
+	query := (&UserClient{config: gq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := gq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -134,7 +180,7 @@ func (gq *GroupQuery) QueryUsers() *UserQuery {
 
 // QueryInfo chains the current query on the "info" edge.
 func (gq *GroupQuery) QueryInfo() *GroupInfoQuery {
-	query := &GroupInfoQuery{config: gq.config}
+	query := (&GroupInfoClient{config: gq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := gq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -259,7 +305,22 @@ func (gq *GroupQuery) All(ctx context.Context) ([]*Group, error) {
 	if err := gq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return gq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*GroupQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, gq, qr, gq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Group)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -294,7 +355,22 @@ func (gq *GroupQuery) Count(ctx context.Context) (int, error) {
 	if err := gq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return gq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*GroupQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, gq, qr, gq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -311,7 +387,22 @@ func (gq *GroupQuery) Exist(ctx context.Context) (bool, error) {
 	if err := gq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return gq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*GroupQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, gq, qr, gq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -335,20 +426,22 @@ func (gq *GroupQuery) Clone() *GroupQuery {
 		offset:      gq.offset,
 		order:       append([]OrderFunc{}, gq.order...),
 		predicates:  append([]predicate.Group{}, gq.predicates...),
+		inters:      append([]Interceptor{}, gq.inters...),
 		withFiles:   gq.withFiles.Clone(),
 		withBlocked: gq.withBlocked.Clone(),
 		withUsers:   gq.withUsers.Clone(),
 		withInfo:    gq.withInfo.Clone(),
 		// clone intermediate query.
-		sql:  gq.sql.Clone(),
-		path: gq.path,
+		sql:       gq.sql.Clone(),
+		path:      gq.path,
+		modifiers: append([]func(*sql.Selector){}, gq.modifiers...),
 	}
 }
 
 // WithFiles tells the query-builder to eager-load the nodes that are connected to
 // the "files" edge. The optional arguments are used to configure the query builder of the edge.
 func (gq *GroupQuery) WithFiles(opts ...func(*FileQuery)) *GroupQuery {
-	query := &FileQuery{config: gq.config}
+	query := (&FileClient{config: gq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -359,7 +452,7 @@ func (gq *GroupQuery) WithFiles(opts ...func(*FileQuery)) *GroupQuery {
 // WithBlocked tells the query-builder to eager-load the nodes that are connected to
 // the "blocked" edge. The optional arguments are used to configure the query builder of the edge.
 func (gq *GroupQuery) WithBlocked(opts ...func(*UserQuery)) *GroupQuery {
-	query := &UserQuery{config: gq.config}
+	query := (&UserClient{config: gq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -370,7 +463,7 @@ func (gq *GroupQuery) WithBlocked(opts ...func(*UserQuery)) *GroupQuery {
 // WithUsers tells the query-builder to eager-load the nodes that are connected to
 // the "users" edge. The optional arguments are used to configure the query builder of the edge.
 func (gq *GroupQuery) WithUsers(opts ...func(*UserQuery)) *GroupQuery {
-	query := &UserQuery{config: gq.config}
+	query := (&UserClient{config: gq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -381,7 +474,7 @@ func (gq *GroupQuery) WithUsers(opts ...func(*UserQuery)) *GroupQuery {
 // WithInfo tells the query-builder to eager-load the nodes that are connected to
 // the "info" edge. The optional arguments are used to configure the query builder of the edge.
 func (gq *GroupQuery) WithInfo(opts ...func(*GroupInfoQuery)) *GroupQuery {
-	query := &GroupInfoQuery{config: gq.config}
+	query := (&GroupInfoClient{config: gq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -454,7 +547,7 @@ func (gq *GroupQuery) sqlAll(ctx context.Context) ([]*Group, error) {
 	var (
 		nodes       = []*Group{}
 		withFKs     = gq.withFKs
-		_spec       = gq.querySpec()
+		_spec       = gq.querySpec(ctx)
 		loadedTypes = [4]bool{
 			gq.withFiles != nil,
 			gq.withBlocked != nil,
@@ -640,7 +733,7 @@ func (gq *GroupQuery) sqlAll(ctx context.Context) ([]*Group, error) {
 }
 
 func (gq *GroupQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := gq.querySpec()
+	_spec := gq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, gq.driver, _spec)
 }
 
@@ -652,7 +745,7 @@ func (gq *GroupQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (gq *GroupQuery) querySpec() *sqlgraph.QuerySpec {
+func (gq *GroupQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   group.Table,
@@ -694,6 +787,9 @@ func (gq *GroupQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := gq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -719,9 +815,40 @@ func (gq *GroupQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := gq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range gq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (gq *GroupQuery) ForUpdate(opts ...sql.LockOption) *GroupQuery {
+	gq.modifiers = append(gq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return gq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (gq *GroupQuery) ForShare(opts ...sql.LockOption) *GroupQuery {
+	gq.modifiers = append(gq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return gq
+}
+
 // GroupGroupBy is the group-by builder for Group entities.
 type GroupGroupBy struct {
 	config
@@ -971,7 +1098,8 @@ func (ggb *GroupGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (ggb *GroupGroupBy) sqlQuery() *sql.Selector {
-	selector := ggb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := ggb.sql.ClearLock()
 	columns := make([]string, 0, len(ggb.fields)+len(ggb.fns))
 	columns = append(columns, ggb.fields...)
 	for _, fn := range ggb.fns {
@@ -992,8 +1120,12 @@ func (gs *GroupSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := gs.prepareQuery(ctx); err != nil {
 		return err
 	}
-	gs.sql = gs.GroupQuery.sqlQuery(ctx)
-	return gs.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		gs.sql = gs.GroupQuery.sqlQuery(ctx)
+		return v, gs.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, gs.GroupQuery, qr, gs.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -1206,3 +1338,195 @@ func (gs *GroupSelect) sqlQuery() sql.Querier {
 	selector.Select(selector.Columns(gs.fields...)...)
 	return selector
 }
+
+// GroupOrderField defines a field that Group entities can be ordered by in Paginate.
+type GroupOrderField struct {
+	field  string
+	value  func(*Group) interface{}
+	decode func(interface{}) (interface{}, error)
+}
+
+// String returns the name of the ordering field.
+func (f *GroupOrderField) String() string {
+	return f.field
+}
+
+var (
+	// GroupOrderFieldExpire orders Group entities by the "expire" field.
+	GroupOrderFieldExpire = &GroupOrderField{
+		field: group.FieldExpire,
+		value: func(gr *Group) interface{} {
+			return gr.Expire
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v time.Time
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+	// GroupOrderFieldName orders Group entities by the "name" field.
+	GroupOrderFieldName = &GroupOrderField{
+		field: group.FieldName,
+		value: func(gr *Group) interface{} {
+			return gr.Name
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v string
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+)
+
+// GroupOrder defines the ordering of Group entities in Paginate.
+// Entities are ordered by the given field (if any) and then by their ID.
+type GroupOrder struct {
+	Direction OrderDirection
+	Field     *GroupOrderField
+}
+
+// cursor returns the cursor of the given entity in the ordering.
+func (o *GroupOrder) cursor(gr *Group) *Cursor {
+	if o.Field == nil {
+		return &Cursor{ID: gr.ID}
+	}
+	return &Cursor{ID: gr.ID, Value: o.Field.value(gr)}
+}
+
+// decode returns the typed ID and ordering value of the given cursor.
+func (o *GroupOrder) decode(c *Cursor) (id int, value interface{}, err error) {
+	if err := decodeCursorValue(c.ID, &id); err != nil {
+		return id, nil, err
+	}
+	if o.Field == nil {
+		return id, nil, nil
+	}
+	if c.Value == nil {
+		return id, nil, fmt.Errorf("ent: cursor does not match the ordering by %q", o.Field.field)
+	}
+	if value, err = o.Field.decode(c.Value); err != nil {
+		return id, nil, err
+	}
+	return id, value, nil
+}
+
+// cursorPredicate returns a predicate that matches the entities that come
+// after the cursor when the entities are ordered in the given direction.
+func (o *GroupOrder) cursorPredicate(c *Cursor, direction OrderDirection) (predicate.Group, error) {
+	id, value, err := o.decode(c)
+	if err != nil {
+		return nil, err
+	}
+	return func(s *sql.Selector) {
+		if o.Field == nil {
+			if direction == OrderDirectionAsc {
+				s.Where(sql.GT(s.C(group.FieldID), id))
+			} else {
+				s.Where(sql.LT(s.C(group.FieldID), id))
+			}
+			return
+		}
+		columns := []string{s.C(o.Field.field), s.C(group.FieldID)}
+		if direction == OrderDirectionAsc {
+			s.Where(sql.CompositeGT(columns, value, id))
+		} else {
+			s.Where(sql.CompositeLT(columns, value, id))
+		}
+	}, nil
+}
+
+// orderFunc returns the ordering function of the entities in the given direction.
+func (o *GroupOrder) orderFunc(direction OrderDirection) OrderFunc {
+	fields := []string{group.FieldID}
+	if o.Field != nil {
+		fields = []string{o.Field.field, group.FieldID}
+	}
+	if direction == OrderDirectionDesc {
+		return Desc(fields...)
+	}
+	return Asc(fields...)
+}
+
+// GroupPage is a page of Group entities returned by Paginate.
+type GroupPage struct {
+	Nodes    []*Group `json:"nodes"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns a page of Group entities using cursor-based
+// (keyset) pagination. The entities are ordered by the given order, or by their ID if it is nil.
+// The after and before cursors limit the page to the entities that come after/before them in
+// this order, and first/last limit the page to the first/last N entities. Note that Paginate
+// overrides the ordering and the limit of the query.
+//
+//	page, err := client.Group.Query().
+//		Paginate(ctx, after, &first, nil, nil, &ent.GroupOrder{Direction: ent.OrderDirectionAsc})
+//
+func (gq *GroupQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *GroupOrder) (*GroupPage, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &GroupOrder{Direction: OrderDirectionAsc}
+	}
+	if err := orderBy.Direction.Validate(); err != nil {
+		return nil, err
+	}
+	page := &GroupPage{}
+	if first != nil && *first == 0 || last != nil && *last == 0 {
+		return page, nil
+	}
+	if after != nil {
+		p, err := orderBy.cursorPredicate(after, orderBy.Direction)
+		if err != nil {
+			return nil, err
+		}
+		gq.Where(p)
+	}
+	if before != nil {
+		p, err := orderBy.cursorPredicate(before, orderBy.Direction.reverse())
+		if err != nil {
+			return nil, err
+		}
+		gq.Where(p)
+	}
+	limit := -1
+	direction := orderBy.Direction
+	switch {
+	case first != nil:
+		limit = *first
+	case last != nil:
+		// Paginating backward queries the entities in the reverse
+		// order, and the page is reversed back after it is loaded.
+		limit = *last
+		direction = direction.reverse()
+	}
+	gq.order = []OrderFunc{orderBy.orderFunc(direction)}
+	if limit >= 0 {
+		// Query one extra entity to check if there is another page.
+		gq.Limit(limit + 1)
+	}
+	nodes, err := gq.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= 0 && len(nodes) > limit {
+		if first != nil {
+			page.PageInfo.HasNextPage = true
+		} else {
+			page.PageInfo.HasPreviousPage = true
+		}
+		nodes = nodes[:limit]
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	if len(nodes) > 0 {
+		page.PageInfo.StartCursor = orderBy.cursor(nodes[0])
+		page.PageInfo.EndCursor = orderBy.cursor(nodes[len(nodes)-1])
+	}
+	page.Nodes = nodes
+	return page, nil
+}
@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/ent/group"
@@ -29,8 +30,10 @@ type GroupInfoQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.GroupInfo
+	inters     []Interceptor
 	// eager-loading edges.
 	withGroups *GroupQuery
+	modifiers  []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -60,9 +63,51 @@ func (giq *GroupInfoQuery) Order(o ...OrderFunc) *GroupInfoQuery {
 	return giq
 }
 
+// Type returns the node type of this query (GroupInfo). It implements the ent.Query interface.
+func (giq *GroupInfoQuery) Type() string {
+	return TypeGroupInfo
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (giq *GroupInfoQuery) SetLimit(limit int) {
+	giq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (giq *GroupInfoQuery) SetOffset(offset int) {
+	giq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (giq *GroupInfoQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for GroupInfoQuery", fn)
+		}
+		giq.order = append(giq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (giq *GroupInfoQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.GroupInfo:
+			giq.predicates = append(giq.predicates, p)
+		case func(*sql.Selector):
+			giq.predicates = append(giq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for GroupInfoQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryGroups chains the current query on the "groups" edge.
 func (giq *GroupInfoQuery) QueryGroups() *GroupQuery {
-	query := &GroupQuery{config: giq.config}
+	query := (&GroupClient{config: giq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := giq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -187,7 +232,22 @@ func (giq *GroupInfoQuery) All(ctx context.Context) ([]*GroupInfo, error) {
 	if err := giq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return giq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*GroupInfoQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, giq, qr, giq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*GroupInfo)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -222,7 +282,22 @@ func (giq *GroupInfoQuery) Count(ctx context.Context) (int, error) {
 	if err := giq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return giq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*GroupInfoQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, giq, qr, giq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -239,7 +314,22 @@ func (giq *GroupInfoQuery) Exist(ctx context.Context) (bool, error) {
 	if err := giq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return giq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*GroupInfoQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, giq, qr, giq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -263,17 +353,19 @@ func (giq *GroupInfoQuery) Clone() *GroupInfoQuery {
 		offset:     giq.offset,
 		order:      append([]OrderFunc{}, giq.order...),
 		predicates: append([]predicate.GroupInfo{}, giq.predicates...),
+		inters:     append([]Interceptor{}, giq.inters...),
 		withGroups: giq.withGroups.Clone(),
 		// clone intermediate query.
-		sql:  giq.sql.Clone(),
-		path: giq.path,
+		sql:       giq.sql.Clone(),
+		path:      giq.path,
+		modifiers: append([]func(*sql.Selector){}, giq.modifiers...),
 	}
 }
 
 // WithGroups tells the query-builder to eager-load the nodes that are connected to
 // the "groups" edge. The optional arguments are used to configure the query builder of the edge.
 func (giq *GroupInfoQuery) WithGroups(opts ...func(*GroupQuery)) *GroupInfoQuery {
-	query := &GroupQuery{config: giq.config}
+	query := (&GroupClient{config: giq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -345,7 +437,7 @@ func (giq *GroupInfoQuery) prepareQuery(ctx context.Context) error {
 func (giq *GroupInfoQuery) sqlAll(ctx context.Context) ([]*GroupInfo, error) {
 	var (
 		nodes       = []*GroupInfo{}
-		_spec       = giq.querySpec()
+		_spec       = giq.querySpec(ctx)
 		loadedTypes = [1]bool{
 			giq.withGroups != nil,
 		}
@@ -403,7 +495,7 @@ func (giq *GroupInfoQuery) sqlAll(ctx context.Context) ([]*GroupInfo, error) {
 }
 
 func (giq *GroupInfoQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := giq.querySpec()
+	_spec := giq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, giq.driver, _spec)
 }
 
@@ -415,7 +507,7 @@ func (giq *GroupInfoQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (giq *GroupInfoQuery) querySpec() *sqlgraph.QuerySpec {
+func (giq *GroupInfoQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   groupinfo.Table,
@@ -457,6 +549,9 @@ func (giq *GroupInfoQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := giq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -482,9 +577,40 @@ func (giq *GroupInfoQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := giq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range giq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (giq *GroupInfoQuery) ForUpdate(opts ...sql.LockOption) *GroupInfoQuery {
+	giq.modifiers = append(giq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return giq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (giq *GroupInfoQuery) ForShare(opts ...sql.LockOption) *GroupInfoQuery {
+	giq.modifiers = append(giq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return giq
+}
+
 // GroupInfoGroupBy is the group-by builder for GroupInfo entities.
 type GroupInfoGroupBy struct {
 	config
@@ -734,7 +860,8 @@ func (gigb *GroupInfoGroupBy) sqlScan(ctx context.Context, v interface{}) error
 }
 
 func (gigb *GroupInfoGroupBy) sqlQuery() *sql.Selector {
-	selector := gigb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := gigb.sql.ClearLock()
 	columns := make([]string, 0, len(gigb.fields)+len(gigb.fns))
 	columns = append(columns, gigb.fields...)
 	for _, fn := range gigb.fns {
@@ -755,8 +882,12 @@ func (gis *GroupInfoSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := gis.prepareQuery(ctx); err != nil {
 		return err
 	}
-	gis.sql = gis.GroupInfoQuery.sqlQuery(ctx)
-	return gis.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		gis.sql = gis.GroupInfoQuery.sqlQuery(ctx)
+		return v, gis.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, gis.GroupInfoQuery, qr, gis.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -969,3 +1100,195 @@ func (gis *GroupInfoSelect) sqlQuery() sql.Querier {
 	selector.Select(selector.Columns(gis.fields...)...)
 	return selector
 }
+
+// GroupInfoOrderField defines a field that GroupInfo entities can be ordered by in Paginate.
+type GroupInfoOrderField struct {
+	field  string
+	value  func(*GroupInfo) interface{}
+	decode func(interface{}) (interface{}, error)
+}
+
+// String returns the name of the ordering field.
+func (f *GroupInfoOrderField) String() string {
+	return f.field
+}
+
+var (
+	// GroupInfoOrderFieldDesc orders GroupInfo entities by the "desc" field.
+	GroupInfoOrderFieldDesc = &GroupInfoOrderField{
+		field: groupinfo.FieldDesc,
+		value: func(gi *GroupInfo) interface{} {
+			return gi.Desc
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v string
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+	// GroupInfoOrderFieldMaxUsers orders GroupInfo entities by the "max_users" field.
+	GroupInfoOrderFieldMaxUsers = &GroupInfoOrderField{
+		field: groupinfo.FieldMaxUsers,
+		value: func(gi *GroupInfo) interface{} {
+			return gi.MaxUsers
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v int
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+)
+
+// GroupInfoOrder defines the ordering of GroupInfo entities in Paginate.
+// Entities are ordered by the given field (if any) and then by their ID.
+type GroupInfoOrder struct {
+	Direction OrderDirection
+	Field     *GroupInfoOrderField
+}
+
+// cursor returns the cursor of the given entity in the ordering.
+func (o *GroupInfoOrder) cursor(gi *GroupInfo) *Cursor {
+	if o.Field == nil {
+		return &Cursor{ID: gi.ID}
+	}
+	return &Cursor{ID: gi.ID, Value: o.Field.value(gi)}
+}
+
+// decode returns the typed ID and ordering value of the given cursor.
+func (o *GroupInfoOrder) decode(c *Cursor) (id int, value interface{}, err error) {
+	if err := decodeCursorValue(c.ID, &id); err != nil {
+		return id, nil, err
+	}
+	if o.Field == nil {
+		return id, nil, nil
+	}
+	if c.Value == nil {
+		return id, nil, fmt.Errorf("ent: cursor does not match the ordering by %q", o.Field.field)
+	}
+	if value, err = o.Field.decode(c.Value); err != nil {
+		return id, nil, err
+	}
+	return id, value, nil
+}
+
+// cursorPredicate returns a predicate that matches the entities that come
+// after the cursor when the entities are ordered in the given direction.
+func (o *GroupInfoOrder) cursorPredicate(c *Cursor, direction OrderDirection) (predicate.GroupInfo, error) {
+	id, value, err := o.decode(c)
+	if err != nil {
+		return nil, err
+	}
+	return func(s *sql.Selector) {
+		if o.Field == nil {
+			if direction == OrderDirectionAsc {
+				s.Where(sql.GT(s.C(groupinfo.FieldID), id))
+			} else {
+				s.Where(sql.LT(s.C(groupinfo.FieldID), id))
+			}
+			return
+		}
+		columns := []string{s.C(o.Field.field), s.C(groupinfo.FieldID)}
+		if direction == OrderDirectionAsc {
+			s.Where(sql.CompositeGT(columns, value, id))
+		} else {
+			s.Where(sql.CompositeLT(columns, value, id))
+		}
+	}, nil
+}
+
+// orderFunc returns the ordering function of the entities in the given direction.
+func (o *GroupInfoOrder) orderFunc(direction OrderDirection) OrderFunc {
+	fields := []string{groupinfo.FieldID}
+	if o.Field != nil {
+		fields = []string{o.Field.field, groupinfo.FieldID}
+	}
+	if direction == OrderDirectionDesc {
+		return Desc(fields...)
+	}
+	return Asc(fields...)
+}
+
+// GroupInfoPage is a page of GroupInfo entities returned by Paginate.
+type GroupInfoPage struct {
+	Nodes    []*GroupInfo `json:"nodes"`
+	PageInfo PageInfo     `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns a page of GroupInfo entities using cursor-based
+// (keyset) pagination. The entities are ordered by the given order, or by their ID if it is nil.
+// The after and before cursors limit the page to the entities that come after/before them in
+// this order, and first/last limit the page to the first/last N entities. Note that Paginate
+// overrides the ordering and the limit of the query.
+//
+//	page, err := client.GroupInfo.Query().
+//		Paginate(ctx, after, &first, nil, nil, &ent.GroupInfoOrder{Direction: ent.OrderDirectionAsc})
+//
+func (giq *GroupInfoQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *GroupInfoOrder) (*GroupInfoPage, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &GroupInfoOrder{Direction: OrderDirectionAsc}
+	}
+	if err := orderBy.Direction.Validate(); err != nil {
+		return nil, err
+	}
+	page := &GroupInfoPage{}
+	if first != nil && *first == 0 || last != nil && *last == 0 {
+		return page, nil
+	}
+	if after != nil {
+		p, err := orderBy.cursorPredicate(after, orderBy.Direction)
+		if err != nil {
+			return nil, err
+		}
+		giq.Where(p)
+	}
+	if before != nil {
+		p, err := orderBy.cursorPredicate(before, orderBy.Direction.reverse())
+		if err != nil {
+			return nil, err
+		}
+		giq.Where(p)
+	}
+	limit := -1
+	direction := orderBy.Direction
+	switch {
+	case first != nil:
+		limit = *first
+	case last != nil:
+		// Paginating backward queries the entities in the reverse
+		// order, and the page is reversed back after it is loaded.
+		limit = *last
+		direction = direction.reverse()
+	}
+	giq.order = []OrderFunc{orderBy.orderFunc(direction)}
+	if limit >= 0 {
+		// Query one extra entity to check if there is another page.
+		giq.Limit(limit + 1)
+	}
+	nodes, err := giq.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= 0 && len(nodes) > limit {
+		if first != nil {
+			page.PageInfo.HasNextPage = true
+		} else {
+			page.PageInfo.HasPreviousPage = true
+		}
+		nodes = nodes[:limit]
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	if len(nodes) > 0 {
+		page.PageInfo.StartCursor = orderBy.cursor(nodes[0])
+		page.PageInfo.EndCursor = orderBy.cursor(nodes[len(nodes)-1])
+	}
+	page.Nodes = nodes
+	return page, nil
+}
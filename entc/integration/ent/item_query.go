@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/ent/item"
@@ -27,6 +28,8 @@ type ItemQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Item
+	inters     []Interceptor
+	modifiers  []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -56,6 +59,48 @@ func (iq *ItemQuery) Order(o ...OrderFunc) *ItemQuery {
 	return iq
 }
 
+// Type returns the node type of this query (Item). It implements the ent.Query interface.
+func (iq *ItemQuery) Type() string {
+	return TypeItem
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (iq *ItemQuery) SetLimit(limit int) {
+	iq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (iq *ItemQuery) SetOffset(offset int) {
+	iq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (iq *ItemQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for ItemQuery", fn)
+		}
+		iq.order = append(iq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (iq *ItemQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Item:
+			iq.predicates = append(iq.predicates, p)
+		case func(*sql.Selector):
+			iq.predicates = append(iq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for ItemQuery", p)
+		}
+	}
+	return nil
+}
+
 // First returns the first Item entity from the query.
 // Returns a *NotFoundError when no Item was found.
 func (iq *ItemQuery) First(ctx context.Context) (*Item, error) {
@@ -161,7 +206,22 @@ func (iq *ItemQuery) All(ctx context.Context) ([]*Item, error) {
 	if err := iq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return iq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*ItemQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, iq, qr, iq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Item)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -196,7 +256,22 @@ func (iq *ItemQuery) Count(ctx context.Context) (int, error) {
 	if err := iq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return iq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*ItemQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, iq, qr, iq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -213,7 +288,22 @@ func (iq *ItemQuery) Exist(ctx context.Context) (bool, error) {
 	if err := iq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return iq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*ItemQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, iq, qr, iq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -237,9 +327,11 @@ func (iq *ItemQuery) Clone() *ItemQuery {
 		offset:     iq.offset,
 		order:      append([]OrderFunc{}, iq.order...),
 		predicates: append([]predicate.Item{}, iq.predicates...),
+		inters:     append([]Interceptor{}, iq.inters...),
 		// clone intermediate query.
-		sql:  iq.sql.Clone(),
-		path: iq.path,
+		sql:       iq.sql.Clone(),
+		path:      iq.path,
+		modifiers: append([]func(*sql.Selector){}, iq.modifiers...),
 	}
 }
 
@@ -283,7 +375,7 @@ func (iq *ItemQuery) prepareQuery(ctx context.Context) error {
 func (iq *ItemQuery) sqlAll(ctx context.Context) ([]*Item, error) {
 	var (
 		nodes = []*Item{}
-		_spec = iq.querySpec()
+		_spec = iq.querySpec(ctx)
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		node := &Item{config: iq.config}
@@ -307,7 +399,7 @@ func (iq *ItemQuery) sqlAll(ctx context.Context) ([]*Item, error) {
 }
 
 func (iq *ItemQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := iq.querySpec()
+	_spec := iq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, iq.driver, _spec)
 }
 
@@ -319,7 +411,7 @@ func (iq *ItemQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (iq *ItemQuery) querySpec() *sqlgraph.QuerySpec {
+func (iq *ItemQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   item.Table,
@@ -361,6 +453,9 @@ func (iq *ItemQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := iq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -386,9 +481,40 @@ func (iq *ItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := iq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range iq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (iq *ItemQuery) ForUpdate(opts ...sql.LockOption) *ItemQuery {
+	iq.modifiers = append(iq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return iq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (iq *ItemQuery) ForShare(opts ...sql.LockOption) *ItemQuery {
+	iq.modifiers = append(iq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return iq
+}
+
 // ItemGroupBy is the group-by builder for Item entities.
 type ItemGroupBy struct {
 	config
@@ -638,7 +764,8 @@ func (igb *ItemGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (igb *ItemGroupBy) sqlQuery() *sql.Selector {
-	selector := igb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := igb.sql.ClearLock()
 	columns := make([]string, 0, len(igb.fields)+len(igb.fns))
 	columns = append(columns, igb.fields...)
 	for _, fn := range igb.fns {
@@ -659,8 +786,12 @@ func (is *ItemSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := is.prepareQuery(ctx); err != nil {
 		return err
 	}
-	is.sql = is.ItemQuery.sqlQuery(ctx)
-	return is.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		is.sql = is.ItemQuery.sqlQuery(ctx)
+		return v, is.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, is.ItemQuery, qr, is.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -873,3 +1004,170 @@ func (is *ItemSelect) sqlQuery() sql.Querier {
 	selector.Select(selector.Columns(is.fields...)...)
 	return selector
 }
+
+// ItemOrderField defines a field that Item entities can be ordered by in Paginate.
+type ItemOrderField struct {
+	field  string
+	value  func(*Item) interface{}
+	decode func(interface{}) (interface{}, error)
+}
+
+// String returns the name of the ordering field.
+func (f *ItemOrderField) String() string {
+	return f.field
+}
+
+var ()
+
+// ItemOrder defines the ordering of Item entities in Paginate.
+// Entities are ordered by the given field (if any) and then by their ID.
+type ItemOrder struct {
+	Direction OrderDirection
+	Field     *ItemOrderField
+}
+
+// cursor returns the cursor of the given entity in the ordering.
+func (o *ItemOrder) cursor(i *Item) *Cursor {
+	if o.Field == nil {
+		return &Cursor{ID: i.ID}
+	}
+	return &Cursor{ID: i.ID, Value: o.Field.value(i)}
+}
+
+// decode returns the typed ID and ordering value of the given cursor.
+func (o *ItemOrder) decode(c *Cursor) (id int, value interface{}, err error) {
+	if err := decodeCursorValue(c.ID, &id); err != nil {
+		return id, nil, err
+	}
+	if o.Field == nil {
+		return id, nil, nil
+	}
+	if c.Value == nil {
+		return id, nil, fmt.Errorf("ent: cursor does not match the ordering by %q", o.Field.field)
+	}
+	if value, err = o.Field.decode(c.Value); err != nil {
+		return id, nil, err
+	}
+	return id, value, nil
+}
+
+// cursorPredicate returns a predicate that matches the entities that come
+// after the cursor when the entities are ordered in the given direction.
+func (o *ItemOrder) cursorPredicate(c *Cursor, direction OrderDirection) (predicate.Item, error) {
+	id, value, err := o.decode(c)
+	if err != nil {
+		return nil, err
+	}
+	return func(s *sql.Selector) {
+		if o.Field == nil {
+			if direction == OrderDirectionAsc {
+				s.Where(sql.GT(s.C(item.FieldID), id))
+			} else {
+				s.Where(sql.LT(s.C(item.FieldID), id))
+			}
+			return
+		}
+		columns := []string{s.C(o.Field.field), s.C(item.FieldID)}
+		if direction == OrderDirectionAsc {
+			s.Where(sql.CompositeGT(columns, value, id))
+		} else {
+			s.Where(sql.CompositeLT(columns, value, id))
+		}
+	}, nil
+}
+
+// orderFunc returns the ordering function of the entities in the given direction.
+func (o *ItemOrder) orderFunc(direction OrderDirection) OrderFunc {
+	fields := []string{item.FieldID}
+	if o.Field != nil {
+		fields = []string{o.Field.field, item.FieldID}
+	}
+	if direction == OrderDirectionDesc {
+		return Desc(fields...)
+	}
+	return Asc(fields...)
+}
+
+// ItemPage is a page of Item entities returned by Paginate.
+type ItemPage struct {
+	Nodes    []*Item  `json:"nodes"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns a page of Item entities using cursor-based
+// (keyset) pagination. The entities are ordered by the given order, or by their ID if it is nil.
+// The after and before cursors limit the page to the entities that come after/before them in
+// this order, and first/last limit the page to the first/last N entities. Note that Paginate
+// overrides the ordering and the limit of the query.
+//
+//	page, err := client.Item.Query().
+//		Paginate(ctx, after, &first, nil, nil, &ent.ItemOrder{Direction: ent.OrderDirectionAsc})
+//
+func (iq *ItemQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *ItemOrder) (*ItemPage, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &ItemOrder{Direction: OrderDirectionAsc}
+	}
+	if err := orderBy.Direction.Validate(); err != nil {
+		return nil, err
+	}
+	page := &ItemPage{}
+	if first != nil && *first == 0 || last != nil && *last == 0 {
+		return page, nil
+	}
+	if after != nil {
+		p, err := orderBy.cursorPredicate(after, orderBy.Direction)
+		if err != nil {
+			return nil, err
+		}
+		iq.Where(p)
+	}
+	if before != nil {
+		p, err := orderBy.cursorPredicate(before, orderBy.Direction.reverse())
+		if err != nil {
+			return nil, err
+		}
+		iq.Where(p)
+	}
+	limit := -1
+	direction := orderBy.Direction
+	switch {
+	case first != nil:
+		limit = *first
+	case last != nil:
+		// Paginating backward queries the entities in the reverse
+		// order, and the page is reversed back after it is loaded.
+		limit = *last
+		direction = direction.reverse()
+	}
+	iq.order = []OrderFunc{orderBy.orderFunc(direction)}
+	if limit >= 0 {
+		// Query one extra entity to check if there is another page.
+		iq.Limit(limit + 1)
+	}
+	nodes, err := iq.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= 0 && len(nodes) > limit {
+		if first != nil {
+			page.PageInfo.HasNextPage = true
+		} else {
+			page.PageInfo.HasPreviousPage = true
+		}
+		nodes = nodes[:limit]
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	if len(nodes) > 0 {
+		page.PageInfo.StartCursor = orderBy.cursor(nodes[0])
+		page.PageInfo.EndCursor = orderBy.cursor(nodes[len(nodes)-1])
+	}
+	page.Nodes = nodes
+	return page, nil
+}
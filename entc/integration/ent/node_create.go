@@ -8,8 +8,10 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/ent/node"
 	"entgo.io/ent/schema/field"
@@ -20,6 +22,7 @@ type NodeCreate struct {
 	config
 	mutation *NodeMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetValue sets the "value" field.
@@ -136,8 +139,10 @@ func (nc *NodeCreate) sqlSave(ctx context.Context) (*Node, error) {
 		}
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	_node.ID = int(id)
+	if _spec.ID.Value != nil {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
 	return _node, nil
 }
 
@@ -152,6 +157,7 @@ func (nc *NodeCreate) createSpec() (*Node, *sqlgraph.CreateSpec) {
 			},
 		}
 	)
+	_spec.OnConflict = nc.conflict
 	if value, ok := nc.mutation.Value(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -202,10 +208,207 @@ func (nc *NodeCreate) createSpec() (*Node, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Node.Create().
+//		SetValue(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NodeUpsert) {
+//			u.SetValue(v+v)
+//		}).
+//		Exec(ctx)
+//
+func (nc *NodeCreate) OnConflict(opts ...sql.ConflictOption) *NodeUpsertOne {
+	nc.conflict = opts
+	return &NodeUpsertOne{
+		create: nc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Node.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (nc *NodeCreate) OnConflictColumns(columns ...string) *NodeUpsertOne {
+	nc.conflict = append(nc.conflict, sql.ConflictColumns(columns...))
+	return &NodeUpsertOne{
+		create: nc,
+	}
+}
+
+type (
+	// NodeUpsertOne is the builder for "upsert"-ing
+	//  one Node node.
+	NodeUpsertOne struct {
+		create *NodeCreate
+	}
+
+	// NodeUpsert is the "OnConflict" setter.
+	NodeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetValue sets the "value" field.
+func (u *NodeUpsert) SetValue(v int) *NodeUpsert {
+	u.Set(node.FieldValue, v)
+	return u
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *NodeUpsert) UpdateValue() *NodeUpsert {
+	u.SetExcluded(node.FieldValue)
+	return u
+}
+
+// AddValue adds v to the "value" field.
+func (u *NodeUpsert) AddValue(v int) *NodeUpsert {
+	u.Add(node.FieldValue, v)
+	return u
+}
+
+// ClearValue clears the value of the "value" field.
+func (u *NodeUpsert) ClearValue() *NodeUpsert {
+	u.SetNull(node.FieldValue)
+	return u
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Node.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *NodeUpsertOne) UpdateNewValues() *NodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Node.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *NodeUpsertOne) Ignore() *NodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NodeUpsertOne) DoNothing() *NodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NodeCreate.OnConflict
+// documentation for more info.
+func (u *NodeUpsertOne) Update(set func(*NodeUpsert)) *NodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NodeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetValue sets the "value" field.
+func (u *NodeUpsertOne) SetValue(v int) *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *NodeUpsertOne) UpdateValue() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// AddValue adds v to the "value" field.
+func (u *NodeUpsertOne) AddValue(v int) *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.AddValue(v)
+	})
+}
+
+// ClearValue clears the value of the "value" field.
+func (u *NodeUpsertOne) ClearValue() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.ClearValue()
+	})
+}
+
+// Exec executes the query.
+func (u *NodeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NodeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NodeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// ID executes the UPSERT query and returns the inserted/updated ID.
+// A NotFoundError is returned in case the row was skipped by the conflict
+// action (e.g. DO NOTHING), or its ID could not be resolved by the database.
+func (u *NodeUpsertOne) ID(ctx context.Context) (id int, err error) {
+	_node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	if _node.ID == id {
+		return id, &NotFoundError{node.Label}
+	}
+	return _node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NodeUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// Exec executes the query.
+func (nc *NodeCreate) Exec(ctx context.Context) error {
+	_, err := nc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nc *NodeCreate) ExecX(ctx context.Context) {
+	if err := nc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
 // NodeCreateBulk is the builder for creating many Node entities in bulk.
 type NodeCreateBulk struct {
 	config
 	builders []*NodeCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Node entities in the database.
@@ -230,8 +433,10 @@ func (ncb *NodeCreateBulk) Save(ctx context.Context) ([]*Node, error) {
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, ncb.builders[i+1].mutation)
 				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ncb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, ncb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+					if err = sqlgraph.BatchCreate(ctx, ncb.driver, spec); err != nil {
 						if cerr, ok := isSQLConstraintError(err); ok {
 							err = cerr
 						}
@@ -241,8 +446,10 @@ func (ncb *NodeCreateBulk) Save(ctx context.Context) ([]*Node, error) {
 				if err != nil {
 					return nil, err
 				}
-				id := specs[i].ID.Value.(int64)
-				nodes[i].ID = int(id)
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
 				return nodes[i], nil
 			})
 			for i := len(builder.hooks) - 1; i >= 0; i-- {
@@ -267,3 +474,149 @@ func (ncb *NodeCreateBulk) SaveX(ctx context.Context) []*Node {
 	}
 	return v
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Node.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NodeUpsert) {
+//			u.SetValue(v+v)
+//		}).
+//		Exec(ctx)
+//
+func (ncb *NodeCreateBulk) OnConflict(opts ...sql.ConflictOption) *NodeUpsertBulk {
+	ncb.conflict = opts
+	return &NodeUpsertBulk{
+		create: ncb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Node.CreateBulk(builders...).
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (ncb *NodeCreateBulk) OnConflictColumns(columns ...string) *NodeUpsertBulk {
+	ncb.conflict = append(ncb.conflict, sql.ConflictColumns(columns...))
+	return &NodeUpsertBulk{
+		create: ncb,
+	}
+}
+
+// NodeUpsertBulk is the builder for "upsert"-ing
+// a bulk of Node nodes.
+type NodeUpsertBulk struct {
+	create *NodeCreateBulk
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Node.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *NodeUpsertBulk) UpdateNewValues() *NodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Node.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *NodeUpsertBulk) Ignore() *NodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NodeUpsertBulk) DoNothing() *NodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NodeCreateBulk.OnConflict
+// documentation for more info.
+func (u *NodeUpsertBulk) Update(set func(*NodeUpsert)) *NodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NodeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetValue sets the "value" field.
+func (u *NodeUpsertBulk) SetValue(v int) *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *NodeUpsertBulk) UpdateValue() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// AddValue adds v to the "value" field.
+func (u *NodeUpsertBulk) AddValue(v int) *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.AddValue(v)
+	})
+}
+
+// ClearValue clears the value of the "value" field.
+func (u *NodeUpsertBulk) ClearValue() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.ClearValue()
+	})
+}
+
+// Exec executes the query.
+func (u *NodeUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NodeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NodeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NodeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the query.
+func (ncb *NodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := ncb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ncb *NodeCreateBulk) ExecX(ctx context.Context) {
+	if err := ncb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/ent/pet"
@@ -28,10 +29,12 @@ type PetQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Pet
+	inters     []Interceptor
 	// eager-loading edges.
 	withTeam  *UserQuery
 	withOwner *UserQuery
 	withFKs   bool
+	modifiers []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -61,9 +64,51 @@ func (pq *PetQuery) Order(o ...OrderFunc) *PetQuery {
 	return pq
 }
 
+// Type returns the node type of this query (Pet). It implements the ent.Query interface.
+func (pq *PetQuery) Type() string {
+	return TypePet
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (pq *PetQuery) SetLimit(limit int) {
+	pq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (pq *PetQuery) SetOffset(offset int) {
+	pq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (pq *PetQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for PetQuery", fn)
+		}
+		pq.order = append(pq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (pq *PetQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Pet:
+			pq.predicates = append(pq.predicates, p)
+		case func(*sql.Selector):
+			pq.predicates = append(pq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for PetQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryTeam chains the current query on the "team" edge.
 func (pq *PetQuery) QueryTeam() *UserQuery {
-	query := &UserQuery{config: pq.config}
+	query := (&UserClient{config: pq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := pq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -85,7 +130,7 @@ func (pq *PetQuery) QueryTeam() *UserQuery {
 
 // QueryOwner chains the current query on the "owner" edge.
 func (pq *PetQuery) QueryOwner() *UserQuery {
-	query := &UserQuery{config: pq.config}
+	query := (&UserClient{config: pq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := pq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -210,7 +255,22 @@ func (pq *PetQuery) All(ctx context.Context) ([]*Pet, error) {
 	if err := pq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return pq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*PetQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, pq, qr, pq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Pet)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -245,7 +305,22 @@ func (pq *PetQuery) Count(ctx context.Context) (int, error) {
 	if err := pq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return pq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*PetQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, pq, qr, pq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -262,7 +337,22 @@ func (pq *PetQuery) Exist(ctx context.Context) (bool, error) {
 	if err := pq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return pq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*PetQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, pq, qr, pq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -286,18 +376,20 @@ func (pq *PetQuery) Clone() *PetQuery {
 		offset:     pq.offset,
 		order:      append([]OrderFunc{}, pq.order...),
 		predicates: append([]predicate.Pet{}, pq.predicates...),
+		inters:     append([]Interceptor{}, pq.inters...),
 		withTeam:   pq.withTeam.Clone(),
 		withOwner:  pq.withOwner.Clone(),
 		// clone intermediate query.
-		sql:  pq.sql.Clone(),
-		path: pq.path,
+		sql:       pq.sql.Clone(),
+		path:      pq.path,
+		modifiers: append([]func(*sql.Selector){}, pq.modifiers...),
 	}
 }
 
 // WithTeam tells the query-builder to eager-load the nodes that are connected to
 // the "team" edge. The optional arguments are used to configure the query builder of the edge.
 func (pq *PetQuery) WithTeam(opts ...func(*UserQuery)) *PetQuery {
-	query := &UserQuery{config: pq.config}
+	query := (&UserClient{config: pq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -308,7 +400,7 @@ func (pq *PetQuery) WithTeam(opts ...func(*UserQuery)) *PetQuery {
 // WithOwner tells the query-builder to eager-load the nodes that are connected to
 // the "owner" edge. The optional arguments are used to configure the query builder of the edge.
 func (pq *PetQuery) WithOwner(opts ...func(*UserQuery)) *PetQuery {
-	query := &UserQuery{config: pq.config}
+	query := (&UserClient{config: pq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -381,7 +473,7 @@ func (pq *PetQuery) sqlAll(ctx context.Context) ([]*Pet, error) {
 	var (
 		nodes       = []*Pet{}
 		withFKs     = pq.withFKs
-		_spec       = pq.querySpec()
+		_spec       = pq.querySpec(ctx)
 		loadedTypes = [2]bool{
 			pq.withTeam != nil,
 			pq.withOwner != nil,
@@ -469,7 +561,7 @@ func (pq *PetQuery) sqlAll(ctx context.Context) ([]*Pet, error) {
 }
 
 func (pq *PetQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := pq.querySpec()
+	_spec := pq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, pq.driver, _spec)
 }
 
@@ -481,7 +573,7 @@ func (pq *PetQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (pq *PetQuery) querySpec() *sqlgraph.QuerySpec {
+func (pq *PetQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   pet.Table,
@@ -523,6 +615,9 @@ func (pq *PetQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := pq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -548,9 +643,40 @@ func (pq *PetQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := pq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range pq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pq *PetQuery) ForUpdate(opts ...sql.LockOption) *PetQuery {
+	pq.modifiers = append(pq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return pq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pq *PetQuery) ForShare(opts ...sql.LockOption) *PetQuery {
+	pq.modifiers = append(pq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return pq
+}
+
 // PetGroupBy is the group-by builder for Pet entities.
 type PetGroupBy struct {
 	config
@@ -800,7 +926,8 @@ func (pgb *PetGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (pgb *PetGroupBy) sqlQuery() *sql.Selector {
-	selector := pgb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := pgb.sql.ClearLock()
 	columns := make([]string, 0, len(pgb.fields)+len(pgb.fns))
 	columns = append(columns, pgb.fields...)
 	for _, fn := range pgb.fns {
@@ -821,8 +948,12 @@ func (ps *PetSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := ps.prepareQuery(ctx); err != nil {
 		return err
 	}
-	ps.sql = ps.PetQuery.sqlQuery(ctx)
-	return ps.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		ps.sql = ps.PetQuery.sqlQuery(ctx)
+		return v, ps.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, ps.PetQuery, qr, ps.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -1035,3 +1166,183 @@ func (ps *PetSelect) sqlQuery() sql.Querier {
 	selector.Select(selector.Columns(ps.fields...)...)
 	return selector
 }
+
+// PetOrderField defines a field that Pet entities can be ordered by in Paginate.
+type PetOrderField struct {
+	field  string
+	value  func(*Pet) interface{}
+	decode func(interface{}) (interface{}, error)
+}
+
+// String returns the name of the ordering field.
+func (f *PetOrderField) String() string {
+	return f.field
+}
+
+var (
+	// PetOrderFieldName orders Pet entities by the "name" field.
+	PetOrderFieldName = &PetOrderField{
+		field: pet.FieldName,
+		value: func(pe *Pet) interface{} {
+			return pe.Name
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v string
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+)
+
+// PetOrder defines the ordering of Pet entities in Paginate.
+// Entities are ordered by the given field (if any) and then by their ID.
+type PetOrder struct {
+	Direction OrderDirection
+	Field     *PetOrderField
+}
+
+// cursor returns the cursor of the given entity in the ordering.
+func (o *PetOrder) cursor(pe *Pet) *Cursor {
+	if o.Field == nil {
+		return &Cursor{ID: pe.ID}
+	}
+	return &Cursor{ID: pe.ID, Value: o.Field.value(pe)}
+}
+
+// decode returns the typed ID and ordering value of the given cursor.
+func (o *PetOrder) decode(c *Cursor) (id int, value interface{}, err error) {
+	if err := decodeCursorValue(c.ID, &id); err != nil {
+		return id, nil, err
+	}
+	if o.Field == nil {
+		return id, nil, nil
+	}
+	if c.Value == nil {
+		return id, nil, fmt.Errorf("ent: cursor does not match the ordering by %q", o.Field.field)
+	}
+	if value, err = o.Field.decode(c.Value); err != nil {
+		return id, nil, err
+	}
+	return id, value, nil
+}
+
+// cursorPredicate returns a predicate that matches the entities that come
+// after the cursor when the entities are ordered in the given direction.
+func (o *PetOrder) cursorPredicate(c *Cursor, direction OrderDirection) (predicate.Pet, error) {
+	id, value, err := o.decode(c)
+	if err != nil {
+		return nil, err
+	}
+	return func(s *sql.Selector) {
+		if o.Field == nil {
+			if direction == OrderDirectionAsc {
+				s.Where(sql.GT(s.C(pet.FieldID), id))
+			} else {
+				s.Where(sql.LT(s.C(pet.FieldID), id))
+			}
+			return
+		}
+		columns := []string{s.C(o.Field.field), s.C(pet.FieldID)}
+		if direction == OrderDirectionAsc {
+			s.Where(sql.CompositeGT(columns, value, id))
+		} else {
+			s.Where(sql.CompositeLT(columns, value, id))
+		}
+	}, nil
+}
+
+// orderFunc returns the ordering function of the entities in the given direction.
+func (o *PetOrder) orderFunc(direction OrderDirection) OrderFunc {
+	fields := []string{pet.FieldID}
+	if o.Field != nil {
+		fields = []string{o.Field.field, pet.FieldID}
+	}
+	if direction == OrderDirectionDesc {
+		return Desc(fields...)
+	}
+	return Asc(fields...)
+}
+
+// PetPage is a page of Pet entities returned by Paginate.
+type PetPage struct {
+	Nodes    []*Pet   `json:"nodes"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns a page of Pet entities using cursor-based
+// (keyset) pagination. The entities are ordered by the given order, or by their ID if it is nil.
+// The after and before cursors limit the page to the entities that come after/before them in
+// this order, and first/last limit the page to the first/last N entities. Note that Paginate
+// overrides the ordering and the limit of the query.
+//
+//	page, err := client.Pet.Query().
+//		Paginate(ctx, after, &first, nil, nil, &ent.PetOrder{Direction: ent.OrderDirectionAsc})
+//
+func (pq *PetQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *PetOrder) (*PetPage, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &PetOrder{Direction: OrderDirectionAsc}
+	}
+	if err := orderBy.Direction.Validate(); err != nil {
+		return nil, err
+	}
+	page := &PetPage{}
+	if first != nil && *first == 0 || last != nil && *last == 0 {
+		return page, nil
+	}
+	if after != nil {
+		p, err := orderBy.cursorPredicate(after, orderBy.Direction)
+		if err != nil {
+			return nil, err
+		}
+		pq.Where(p)
+	}
+	if before != nil {
+		p, err := orderBy.cursorPredicate(before, orderBy.Direction.reverse())
+		if err != nil {
+			return nil, err
+		}
+		pq.Where(p)
+	}
+	limit := -1
+	direction := orderBy.Direction
+	switch {
+	case first != nil:
+		limit = *first
+	case last != nil:
+		// Paginating backward queries the entities in the reverse
+		// order, and the page is reversed back after it is loaded.
+		limit = *last
+		direction = direction.reverse()
+	}
+	pq.order = []OrderFunc{orderBy.orderFunc(direction)}
+	if limit >= 0 {
+		// Query one extra entity to check if there is another page.
+		pq.Limit(limit + 1)
+	}
+	nodes, err := pq.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= 0 && len(nodes) > limit {
+		if first != nil {
+			page.PageInfo.HasNextPage = true
+		} else {
+			page.PageInfo.HasPreviousPage = true
+		}
+		nodes = nodes[:limit]
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	if len(nodes) > 0 {
+		page.PageInfo.StartCursor = orderBy.cursor(nodes[0])
+		page.PageInfo.EndCursor = orderBy.cursor(nodes[len(nodes)-1])
+	}
+	page.Nodes = nodes
+	return page, nil
+}
@@ -22,7 +22,7 @@ import (
 )
 
 // The init function reads all schema descriptors with runtime code
-// (default values, validators, hooks and policies) and stitches it
+// (default values, validators, hooks, interceptors and policies) and stitches it
 // to their package variables.
 func init() {
 	cardMixin := schema.Card{}.Mixin()
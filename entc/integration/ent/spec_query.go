@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/ent/card"
@@ -29,8 +30,10 @@ type SpecQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Spec
+	inters     []Interceptor
 	// eager-loading edges.
-	withCard *CardQuery
+	withCard  *CardQuery
+	modifiers []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -60,9 +63,51 @@ func (sq *SpecQuery) Order(o ...OrderFunc) *SpecQuery {
 	return sq
 }
 
+// Type returns the node type of this query (Spec). It implements the ent.Query interface.
+func (sq *SpecQuery) Type() string {
+	return TypeSpec
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (sq *SpecQuery) SetLimit(limit int) {
+	sq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (sq *SpecQuery) SetOffset(offset int) {
+	sq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (sq *SpecQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for SpecQuery", fn)
+		}
+		sq.order = append(sq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (sq *SpecQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Spec:
+			sq.predicates = append(sq.predicates, p)
+		case func(*sql.Selector):
+			sq.predicates = append(sq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for SpecQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryCard chains the current query on the "card" edge.
 func (sq *SpecQuery) QueryCard() *CardQuery {
-	query := &CardQuery{config: sq.config}
+	query := (&CardClient{config: sq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := sq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -187,7 +232,22 @@ func (sq *SpecQuery) All(ctx context.Context) ([]*Spec, error) {
 	if err := sq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return sq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*SpecQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, sq, qr, sq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Spec)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -222,7 +282,22 @@ func (sq *SpecQuery) Count(ctx context.Context) (int, error) {
 	if err := sq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return sq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*SpecQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, sq, qr, sq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -239,7 +314,22 @@ func (sq *SpecQuery) Exist(ctx context.Context) (bool, error) {
 	if err := sq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return sq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*SpecQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, sq, qr, sq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -263,17 +353,19 @@ func (sq *SpecQuery) Clone() *SpecQuery {
 		offset:     sq.offset,
 		order:      append([]OrderFunc{}, sq.order...),
 		predicates: append([]predicate.Spec{}, sq.predicates...),
+		inters:     append([]Interceptor{}, sq.inters...),
 		withCard:   sq.withCard.Clone(),
 		// clone intermediate query.
-		sql:  sq.sql.Clone(),
-		path: sq.path,
+		sql:       sq.sql.Clone(),
+		path:      sq.path,
+		modifiers: append([]func(*sql.Selector){}, sq.modifiers...),
 	}
 }
 
 // WithCard tells the query-builder to eager-load the nodes that are connected to
 // the "card" edge. The optional arguments are used to configure the query builder of the edge.
 func (sq *SpecQuery) WithCard(opts ...func(*CardQuery)) *SpecQuery {
-	query := &CardQuery{config: sq.config}
+	query := (&CardClient{config: sq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -321,7 +413,7 @@ func (sq *SpecQuery) prepareQuery(ctx context.Context) error {
 func (sq *SpecQuery) sqlAll(ctx context.Context) ([]*Spec, error) {
 	var (
 		nodes       = []*Spec{}
-		_spec       = sq.querySpec()
+		_spec       = sq.querySpec(ctx)
 		loadedTypes = [1]bool{
 			sq.withCard != nil,
 		}
@@ -414,7 +506,7 @@ func (sq *SpecQuery) sqlAll(ctx context.Context) ([]*Spec, error) {
 }
 
 func (sq *SpecQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := sq.querySpec()
+	_spec := sq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, sq.driver, _spec)
 }
 
@@ -426,7 +518,7 @@ func (sq *SpecQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (sq *SpecQuery) querySpec() *sqlgraph.QuerySpec {
+func (sq *SpecQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   spec.Table,
@@ -468,6 +560,9 @@ func (sq *SpecQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := sq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -493,9 +588,40 @@ func (sq *SpecQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := sq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range sq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (sq *SpecQuery) ForUpdate(opts ...sql.LockOption) *SpecQuery {
+	sq.modifiers = append(sq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return sq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (sq *SpecQuery) ForShare(opts ...sql.LockOption) *SpecQuery {
+	sq.modifiers = append(sq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return sq
+}
+
 // SpecGroupBy is the group-by builder for Spec entities.
 type SpecGroupBy struct {
 	config
@@ -745,7 +871,8 @@ func (sgb *SpecGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (sgb *SpecGroupBy) sqlQuery() *sql.Selector {
-	selector := sgb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := sgb.sql.ClearLock()
 	columns := make([]string, 0, len(sgb.fields)+len(sgb.fns))
 	columns = append(columns, sgb.fields...)
 	for _, fn := range sgb.fns {
@@ -766,8 +893,12 @@ func (ss *SpecSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := ss.prepareQuery(ctx); err != nil {
 		return err
 	}
-	ss.sql = ss.SpecQuery.sqlQuery(ctx)
-	return ss.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		ss.sql = ss.SpecQuery.sqlQuery(ctx)
+		return v, ss.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, ss.SpecQuery, qr, ss.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -980,3 +1111,170 @@ func (ss *SpecSelect) sqlQuery() sql.Querier {
 	selector.Select(selector.Columns(ss.fields...)...)
 	return selector
 }
+
+// SpecOrderField defines a field that Spec entities can be ordered by in Paginate.
+type SpecOrderField struct {
+	field  string
+	value  func(*Spec) interface{}
+	decode func(interface{}) (interface{}, error)
+}
+
+// String returns the name of the ordering field.
+func (f *SpecOrderField) String() string {
+	return f.field
+}
+
+var ()
+
+// SpecOrder defines the ordering of Spec entities in Paginate.
+// Entities are ordered by the given field (if any) and then by their ID.
+type SpecOrder struct {
+	Direction OrderDirection
+	Field     *SpecOrderField
+}
+
+// cursor returns the cursor of the given entity in the ordering.
+func (o *SpecOrder) cursor(s *Spec) *Cursor {
+	if o.Field == nil {
+		return &Cursor{ID: s.ID}
+	}
+	return &Cursor{ID: s.ID, Value: o.Field.value(s)}
+}
+
+// decode returns the typed ID and ordering value of the given cursor.
+func (o *SpecOrder) decode(c *Cursor) (id int, value interface{}, err error) {
+	if err := decodeCursorValue(c.ID, &id); err != nil {
+		return id, nil, err
+	}
+	if o.Field == nil {
+		return id, nil, nil
+	}
+	if c.Value == nil {
+		return id, nil, fmt.Errorf("ent: cursor does not match the ordering by %q", o.Field.field)
+	}
+	if value, err = o.Field.decode(c.Value); err != nil {
+		return id, nil, err
+	}
+	return id, value, nil
+}
+
+// cursorPredicate returns a predicate that matches the entities that come
+// after the cursor when the entities are ordered in the given direction.
+func (o *SpecOrder) cursorPredicate(c *Cursor, direction OrderDirection) (predicate.Spec, error) {
+	id, value, err := o.decode(c)
+	if err != nil {
+		return nil, err
+	}
+	return func(s *sql.Selector) {
+		if o.Field == nil {
+			if direction == OrderDirectionAsc {
+				s.Where(sql.GT(s.C(spec.FieldID), id))
+			} else {
+				s.Where(sql.LT(s.C(spec.FieldID), id))
+			}
+			return
+		}
+		columns := []string{s.C(o.Field.field), s.C(spec.FieldID)}
+		if direction == OrderDirectionAsc {
+			s.Where(sql.CompositeGT(columns, value, id))
+		} else {
+			s.Where(sql.CompositeLT(columns, value, id))
+		}
+	}, nil
+}
+
+// orderFunc returns the ordering function of the entities in the given direction.
+func (o *SpecOrder) orderFunc(direction OrderDirection) OrderFunc {
+	fields := []string{spec.FieldID}
+	if o.Field != nil {
+		fields = []string{o.Field.field, spec.FieldID}
+	}
+	if direction == OrderDirectionDesc {
+		return Desc(fields...)
+	}
+	return Asc(fields...)
+}
+
+// SpecPage is a page of Spec entities returned by Paginate.
+type SpecPage struct {
+	Nodes    []*Spec  `json:"nodes"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns a page of Spec entities using cursor-based
+// (keyset) pagination. The entities are ordered by the given order, or by their ID if it is nil.
+// The after and before cursors limit the page to the entities that come after/before them in
+// this order, and first/last limit the page to the first/last N entities. Note that Paginate
+// overrides the ordering and the limit of the query.
+//
+//	page, err := client.Spec.Query().
+//		Paginate(ctx, after, &first, nil, nil, &ent.SpecOrder{Direction: ent.OrderDirectionAsc})
+//
+func (sq *SpecQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *SpecOrder) (*SpecPage, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &SpecOrder{Direction: OrderDirectionAsc}
+	}
+	if err := orderBy.Direction.Validate(); err != nil {
+		return nil, err
+	}
+	page := &SpecPage{}
+	if first != nil && *first == 0 || last != nil && *last == 0 {
+		return page, nil
+	}
+	if after != nil {
+		p, err := orderBy.cursorPredicate(after, orderBy.Direction)
+		if err != nil {
+			return nil, err
+		}
+		sq.Where(p)
+	}
+	if before != nil {
+		p, err := orderBy.cursorPredicate(before, orderBy.Direction.reverse())
+		if err != nil {
+			return nil, err
+		}
+		sq.Where(p)
+	}
+	limit := -1
+	direction := orderBy.Direction
+	switch {
+	case first != nil:
+		limit = *first
+	case last != nil:
+		// Paginating backward queries the entities in the reverse
+		// order, and the page is reversed back after it is loaded.
+		limit = *last
+		direction = direction.reverse()
+	}
+	sq.order = []OrderFunc{orderBy.orderFunc(direction)}
+	if limit >= 0 {
+		// Query one extra entity to check if there is another page.
+		sq.Limit(limit + 1)
+	}
+	nodes, err := sq.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= 0 && len(nodes) > limit {
+		if first != nil {
+			page.PageInfo.HasNextPage = true
+		} else {
+			page.PageInfo.HasPreviousPage = true
+		}
+		nodes = nodes[:limit]
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	if len(nodes) > 0 {
+		page.PageInfo.StartCursor = orderBy.cursor(nodes[0])
+		page.PageInfo.EndCursor = orderBy.cursor(nodes[len(nodes)-1])
+	}
+	page.Nodes = nodes
+	return page, nil
+}
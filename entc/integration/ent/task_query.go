@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/ent/predicate"
@@ -27,6 +28,8 @@ type TaskQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Task
+	inters     []Interceptor
+	modifiers  []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -56,6 +59,48 @@ func (tq *TaskQuery) Order(o ...OrderFunc) *TaskQuery {
 	return tq
 }
 
+// Type returns the node type of this query (Task). It implements the ent.Query interface.
+func (tq *TaskQuery) Type() string {
+	return TypeTask
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (tq *TaskQuery) SetLimit(limit int) {
+	tq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (tq *TaskQuery) SetOffset(offset int) {
+	tq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (tq *TaskQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for TaskQuery", fn)
+		}
+		tq.order = append(tq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (tq *TaskQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Task:
+			tq.predicates = append(tq.predicates, p)
+		case func(*sql.Selector):
+			tq.predicates = append(tq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for TaskQuery", p)
+		}
+	}
+	return nil
+}
+
 // First returns the first Task entity from the query.
 // Returns a *NotFoundError when no Task was found.
 func (tq *TaskQuery) First(ctx context.Context) (*Task, error) {
@@ -161,7 +206,22 @@ func (tq *TaskQuery) All(ctx context.Context) ([]*Task, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return tq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*TaskQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, tq, qr, tq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Task)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -196,7 +256,22 @@ func (tq *TaskQuery) Count(ctx context.Context) (int, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return tq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*TaskQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, tq, qr, tq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -213,7 +288,22 @@ func (tq *TaskQuery) Exist(ctx context.Context) (bool, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return tq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*TaskQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, tq, qr, tq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -237,9 +327,11 @@ func (tq *TaskQuery) Clone() *TaskQuery {
 		offset:     tq.offset,
 		order:      append([]OrderFunc{}, tq.order...),
 		predicates: append([]predicate.Task{}, tq.predicates...),
+		inters:     append([]Interceptor{}, tq.inters...),
 		// clone intermediate query.
-		sql:  tq.sql.Clone(),
-		path: tq.path,
+		sql:       tq.sql.Clone(),
+		path:      tq.path,
+		modifiers: append([]func(*sql.Selector){}, tq.modifiers...),
 	}
 }
 
@@ -307,7 +399,7 @@ func (tq *TaskQuery) prepareQuery(ctx context.Context) error {
 func (tq *TaskQuery) sqlAll(ctx context.Context) ([]*Task, error) {
 	var (
 		nodes = []*Task{}
-		_spec = tq.querySpec()
+		_spec = tq.querySpec(ctx)
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		node := &Task{config: tq.config}
@@ -331,7 +423,7 @@ func (tq *TaskQuery) sqlAll(ctx context.Context) ([]*Task, error) {
 }
 
 func (tq *TaskQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := tq.querySpec()
+	_spec := tq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, tq.driver, _spec)
 }
 
@@ -343,7 +435,7 @@ func (tq *TaskQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (tq *TaskQuery) querySpec() *sqlgraph.QuerySpec {
+func (tq *TaskQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   task.Table,
@@ -385,6 +477,9 @@ func (tq *TaskQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := tq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -410,9 +505,40 @@ func (tq *TaskQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := tq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range tq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tq *TaskQuery) ForUpdate(opts ...sql.LockOption) *TaskQuery {
+	tq.modifiers = append(tq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return tq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tq *TaskQuery) ForShare(opts ...sql.LockOption) *TaskQuery {
+	tq.modifiers = append(tq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return tq
+}
+
 // TaskGroupBy is the group-by builder for Task entities.
 type TaskGroupBy struct {
 	config
@@ -662,7 +788,8 @@ func (tgb *TaskGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (tgb *TaskGroupBy) sqlQuery() *sql.Selector {
-	selector := tgb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := tgb.sql.ClearLock()
 	columns := make([]string, 0, len(tgb.fields)+len(tgb.fns))
 	columns = append(columns, tgb.fields...)
 	for _, fn := range tgb.fns {
@@ -683,8 +810,12 @@ func (ts *TaskSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := ts.prepareQuery(ctx); err != nil {
 		return err
 	}
-	ts.sql = ts.TaskQuery.sqlQuery(ctx)
-	return ts.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		ts.sql = ts.TaskQuery.sqlQuery(ctx)
+		return v, ts.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, ts.TaskQuery, qr, ts.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -897,3 +1028,170 @@ func (ts *TaskSelect) sqlQuery() sql.Querier {
 	selector.Select(selector.Columns(ts.fields...)...)
 	return selector
 }
+
+// TaskOrderField defines a field that Task entities can be ordered by in Paginate.
+type TaskOrderField struct {
+	field  string
+	value  func(*Task) interface{}
+	decode func(interface{}) (interface{}, error)
+}
+
+// String returns the name of the ordering field.
+func (f *TaskOrderField) String() string {
+	return f.field
+}
+
+var ()
+
+// TaskOrder defines the ordering of Task entities in Paginate.
+// Entities are ordered by the given field (if any) and then by their ID.
+type TaskOrder struct {
+	Direction OrderDirection
+	Field     *TaskOrderField
+}
+
+// cursor returns the cursor of the given entity in the ordering.
+func (o *TaskOrder) cursor(t *Task) *Cursor {
+	if o.Field == nil {
+		return &Cursor{ID: t.ID}
+	}
+	return &Cursor{ID: t.ID, Value: o.Field.value(t)}
+}
+
+// decode returns the typed ID and ordering value of the given cursor.
+func (o *TaskOrder) decode(c *Cursor) (id int, value interface{}, err error) {
+	if err := decodeCursorValue(c.ID, &id); err != nil {
+		return id, nil, err
+	}
+	if o.Field == nil {
+		return id, nil, nil
+	}
+	if c.Value == nil {
+		return id, nil, fmt.Errorf("ent: cursor does not match the ordering by %q", o.Field.field)
+	}
+	if value, err = o.Field.decode(c.Value); err != nil {
+		return id, nil, err
+	}
+	return id, value, nil
+}
+
+// cursorPredicate returns a predicate that matches the entities that come
+// after the cursor when the entities are ordered in the given direction.
+func (o *TaskOrder) cursorPredicate(c *Cursor, direction OrderDirection) (predicate.Task, error) {
+	id, value, err := o.decode(c)
+	if err != nil {
+		return nil, err
+	}
+	return func(s *sql.Selector) {
+		if o.Field == nil {
+			if direction == OrderDirectionAsc {
+				s.Where(sql.GT(s.C(task.FieldID), id))
+			} else {
+				s.Where(sql.LT(s.C(task.FieldID), id))
+			}
+			return
+		}
+		columns := []string{s.C(o.Field.field), s.C(task.FieldID)}
+		if direction == OrderDirectionAsc {
+			s.Where(sql.CompositeGT(columns, value, id))
+		} else {
+			s.Where(sql.CompositeLT(columns, value, id))
+		}
+	}, nil
+}
+
+// orderFunc returns the ordering function of the entities in the given direction.
+func (o *TaskOrder) orderFunc(direction OrderDirection) OrderFunc {
+	fields := []string{task.FieldID}
+	if o.Field != nil {
+		fields = []string{o.Field.field, task.FieldID}
+	}
+	if direction == OrderDirectionDesc {
+		return Desc(fields...)
+	}
+	return Asc(fields...)
+}
+
+// TaskPage is a page of Task entities returned by Paginate.
+type TaskPage struct {
+	Nodes    []*Task  `json:"nodes"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns a page of Task entities using cursor-based
+// (keyset) pagination. The entities are ordered by the given order, or by their ID if it is nil.
+// The after and before cursors limit the page to the entities that come after/before them in
+// this order, and first/last limit the page to the first/last N entities. Note that Paginate
+// overrides the ordering and the limit of the query.
+//
+//	page, err := client.Task.Query().
+//		Paginate(ctx, after, &first, nil, nil, &ent.TaskOrder{Direction: ent.OrderDirectionAsc})
+//
+func (tq *TaskQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *TaskOrder) (*TaskPage, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &TaskOrder{Direction: OrderDirectionAsc}
+	}
+	if err := orderBy.Direction.Validate(); err != nil {
+		return nil, err
+	}
+	page := &TaskPage{}
+	if first != nil && *first == 0 || last != nil && *last == 0 {
+		return page, nil
+	}
+	if after != nil {
+		p, err := orderBy.cursorPredicate(after, orderBy.Direction)
+		if err != nil {
+			return nil, err
+		}
+		tq.Where(p)
+	}
+	if before != nil {
+		p, err := orderBy.cursorPredicate(before, orderBy.Direction.reverse())
+		if err != nil {
+			return nil, err
+		}
+		tq.Where(p)
+	}
+	limit := -1
+	direction := orderBy.Direction
+	switch {
+	case first != nil:
+		limit = *first
+	case last != nil:
+		// Paginating backward queries the entities in the reverse
+		// order, and the page is reversed back after it is loaded.
+		limit = *last
+		direction = direction.reverse()
+	}
+	tq.order = []OrderFunc{orderBy.orderFunc(direction)}
+	if limit >= 0 {
+		// Query one extra entity to check if there is another page.
+		tq.Limit(limit + 1)
+	}
+	nodes, err := tq.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= 0 && len(nodes) > limit {
+		if first != nil {
+			page.PageInfo.HasNextPage = true
+		} else {
+			page.PageInfo.HasPreviousPage = true
+		}
+		nodes = nodes[:limit]
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	if len(nodes) > 0 {
+		page.PageInfo.StartCursor = orderBy.cursor(nodes[0])
+		page.PageInfo.EndCursor = orderBy.cursor(nodes[len(nodes)-1])
+	}
+	page.Nodes = nodes
+	return page, nil
+}
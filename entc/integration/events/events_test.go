@@ -0,0 +1,212 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"entgo.io/ent/entc/integration/events/ent"
+	"entgo.io/ent/entc/integration/events/ent/user"
+	outbox "entgo.io/ent/entc/integration/events/outbox/ent"
+	"entgo.io/ent/schema/mixin"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvents(t *testing.T) {
+	var (
+		events []ent.Event
+		users  []*ent.UserEvent
+	)
+	client, err := ent.Open("sqlite3", "file:events?mode=memory&cache=shared&_fk=1", ent.Subscribers(
+		ent.SubscriberFunc(func(_ context.Context, e ent.Event) error {
+			events = append(events, e)
+			return nil
+		}),
+		ent.UserEventFunc(func(_ context.Context, e *ent.UserEvent) error {
+			users = append(users, e)
+			return nil
+		}),
+	))
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	// Events of non-transactional clients are dispatched immediately.
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	require.Len(t, events, 1)
+	require.Len(t, users, 1)
+	require.Equal(t, ent.TypeUser, events[0].Type())
+	require.Equal(t, ent.OpCreate, events[0].Operation())
+	require.Equal(t, a8m.ID, users[0].ID)
+	require.ElementsMatch(t, []string{user.FieldName, user.FieldAge}, users[0].Fields)
+	require.Nil(t, users[0].Before)
+	require.Equal(t, "a8m", users[0].After.Name)
+
+	a8m = a8m.Update().SetName("Ariel").ClearAge().SaveX(ctx)
+	require.Len(t, users, 2)
+	require.Equal(t, ent.OpUpdateOne, users[1].Op)
+	require.ElementsMatch(t, []string{user.FieldName, user.FieldAge}, users[1].Fields)
+	require.Equal(t, "a8m", users[1].Before.Name)
+	require.Equal(t, "Ariel", users[1].After.Name)
+	require.Zero(t, users[1].After.Age)
+
+	// Pet events are ignored by the typed subscriber.
+	pedro := client.Pet.Create().SetName("pedro").SetOwner(a8m).SaveX(ctx)
+	require.Len(t, events, 3)
+	require.Len(t, users, 2)
+	pet := events[2].(*ent.PetEvent)
+	require.Equal(t, pedro.ID, pet.ID)
+
+	// Soft-deletion is published as an update and a deletion.
+	client.Pet.DeleteOne(pedro).ExecX(ctx)
+	require.Len(t, events, 5)
+	require.Equal(t, ent.OpUpdate, events[3].Operation())
+	require.NotNil(t, events[3].(*ent.PetEvent).After.DeletedAt)
+	require.Equal(t, ent.OpDeleteOne, events[4].Operation())
+	require.Empty(t, events[4].(*ent.PetEvent).Fields)
+	require.Nil(t, events[4].(*ent.PetEvent).After)
+	client.Pet.UpdateOneID(pedro.ID).ClearDeletedAt().ExecX(mixin.IncludeDeleted(ctx))
+	require.Len(t, events, 6, "updates of soft-deleted entities are published")
+
+	// Bulk updates publish an event for each entity.
+	nati := client.User.Create().SetName("nati").SaveX(ctx)
+	events = nil
+	n := client.User.Update().Where(user.IDIn(a8m.ID, nati.ID)).AddAge(1).SaveX(ctx)
+	require.Equal(t, 2, n)
+	require.Len(t, events, 2)
+	require.Equal(t, []string{user.FieldAge}, events[0].(*ent.UserEvent).Fields)
+
+	// Events of transactions are dispatched only after they are committed.
+	events = nil
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	tx.User.UpdateOneID(a8m.ID).SetName("rollback").ExecX(ctx)
+	require.Empty(t, events)
+	require.NoError(t, tx.Rollback())
+	require.Empty(t, events)
+	err = client.WithTx(ctx, func(tx *ent.Tx) error {
+		tx.User.UpdateOneID(a8m.ID).SetName("rollback").ExecX(ctx)
+		return errors.New("failed")
+	})
+	require.EqualError(t, err, "failed")
+	require.Empty(t, events)
+
+	tx, err = client.Tx(ctx)
+	require.NoError(t, err)
+	tx.User.UpdateOneID(a8m.ID).SetName("a8m").ExecX(ctx)
+	tx.User.UpdateOneID(nati.ID).SetName("Nati").ExecX(ctx)
+	require.Empty(t, events)
+	require.NoError(t, tx.Commit())
+	require.Len(t, events, 2)
+	require.Equal(t, a8m.ID, events[0].(*ent.UserEvent).ID)
+	require.Equal(t, nati.ID, events[1].(*ent.UserEvent).ID)
+
+	// Events of nested transactions are adopted by their parents, or discarded on rollback.
+	events = nil
+	tx, err = client.Tx(ctx)
+	require.NoError(t, err)
+	tx.User.UpdateOneID(a8m.ID).SetAge(1).ExecX(ctx)
+	nested, err := tx.Client().Tx(ctx)
+	require.NoError(t, err)
+	nested.User.UpdateOneID(nati.ID).SetAge(1).ExecX(ctx)
+	require.NoError(t, nested.Commit())
+	nested, err = tx.Client().Tx(ctx)
+	require.NoError(t, err)
+	nested.User.UpdateOneID(nati.ID).SetAge(2).ExecX(ctx)
+	require.NoError(t, nested.Rollback())
+	require.Empty(t, events)
+	require.NoError(t, tx.Commit())
+	require.Len(t, events, 2)
+	require.Equal(t, a8m.ID, events[0].(*ent.UserEvent).ID)
+	require.Equal(t, nati.ID, events[1].(*ent.UserEvent).ID)
+	require.Equal(t, 1, events[1].(*ent.UserEvent).After.Age)
+}
+
+func TestOutbox(t *testing.T) {
+	var (
+		fail   bool
+		events []outbox.Event
+	)
+	client, err := outbox.Open("sqlite3", "file:outbox?mode=memory&cache=shared&_fk=1", outbox.Subscribers(
+		outbox.SubscriberFunc(func(_ context.Context, e outbox.Event) error {
+			if fail {
+				return errors.New("unavailable")
+			}
+			events = append(events, e)
+			return nil
+		}),
+	))
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	// Events are written to the outbox, and dispatched only when they are relayed.
+	a8m := client.User.Create().SetName("a8m").SaveX(ctx)
+	require.Empty(t, events)
+	require.Equal(t, 1, client.EventOutbox.Query().CountX(ctx))
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	tx.User.UpdateOneID(a8m.ID).SetName("rollback").ExecX(ctx)
+	require.Equal(t, 2, tx.EventOutbox.Query().CountX(ctx))
+	require.NoError(t, tx.Rollback())
+	require.Equal(t, 1, client.EventOutbox.Query().CountX(ctx), "events of rolled back transactions are discarded")
+	err = client.WithTx(ctx, func(tx *outbox.Tx) error {
+		tx.User.UpdateOneID(a8m.ID).SetName("Ariel").ExecX(ctx)
+		tx.Pet.Create().SetName("pedro").SetOwnerID(a8m.ID).SaveX(ctx)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, client.EventOutbox.Query().CountX(ctx))
+
+	// Failed events are kept in the outbox, and dispatched again on the next relay.
+	fail = true
+	n, err := client.RelayEvents(ctx, 10)
+	require.EqualError(t, err, "ent: dispatching User event: unavailable")
+	require.Zero(t, n)
+	require.Equal(t, 3, client.EventOutbox.Query().CountX(ctx))
+	fail = false
+	n, err = client.RelayEvents(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.Len(t, events, 2)
+	require.Equal(t, outbox.OpCreate, events[0].Operation())
+	update := events[1].(*outbox.UserEvent)
+	require.Equal(t, a8m.ID, update.ID)
+	require.Equal(t, "a8m", update.Before.Name)
+	require.Equal(t, "Ariel", update.After.Name)
+	n, err = client.RelayEvents(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Equal(t, outbox.TypePet, events[2].Type())
+	require.Zero(t, client.EventOutbox.Query().CountX(ctx))
+	n, err = client.RelayEvents(ctx, 2)
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	// Mutations that are not executed in transactions are executed in new
+	// ones, and they are rolled back if their events cannot be written.
+	client.EventOutbox.Use(func(outbox.Mutator) outbox.Mutator {
+		return outbox.MutateFunc(func(context.Context, outbox.Mutation) (outbox.Value, error) {
+			return nil, errors.New("outbox is full")
+		})
+	})
+	_, err = client.User.Create().SetName("nati").Save(ctx)
+	require.EqualError(t, err, "ent: writing events to the outbox: outbox is full")
+	_, err = client.User.CreateBulk(client.User.Create().SetName("nati"), client.User.Create().SetName("noam")).Save(ctx)
+	require.Error(t, err)
+	err = client.User.UpdateOneID(a8m.ID).SetName("a8m").Exec(ctx)
+	require.Error(t, err)
+	err = client.User.DeleteOneID(a8m.ID).Exec(mixin.HardDelete(ctx))
+	require.Error(t, err)
+	users := client.User.Query().AllX(ctx)
+	require.Len(t, users, 1, "mutations are rolled back with their events")
+	require.Equal(t, "Ariel", users[0].Name)
+}
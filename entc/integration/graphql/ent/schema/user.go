@@ -0,0 +1,63 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/entgql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// User holds the schema definition for the User entity.
+type User struct {
+	ent.Schema
+}
+
+// Annotations of the User.
+func (User) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+	}
+}
+
+// Fields of the User.
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Annotations(entgql.OrderField("NAME")),
+		field.Int("age").
+			Annotations(entgql.OrderField("AGE")),
+		field.String("nickname").
+			Optional().
+			Nillable(),
+		field.Enum("status").
+			Values("ACTIVE", "SUSPENDED").
+			Default("ACTIVE"),
+		field.String("password").
+			Optional().
+			Sensitive(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Annotations(entgql.OrderField("CREATED_AT")),
+	}
+}
+
+// Edges of the User.
+func (User) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("pets", Pet.Type),
+		edge.To("friends", User.Type).
+			Annotations(entgql.RelayConnection()),
+		edge.To("groups", Group.Type).
+			Annotations(entgql.RelayConnection()),
+		edge.To("card", Card.Type).
+			Unique(),
+	}
+}
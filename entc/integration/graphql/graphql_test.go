@@ -0,0 +1,224 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"strconv"
+	"testing"
+
+	"entgo.io/ent/entc/integration/graphql/ent"
+	"entgo.io/ent/entc/integration/graphql/ent/user"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphQL(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	users := make([]*ent.User, 5)
+	for i := range users {
+		users[i] = client.User.Create().SetName("u" + strconv.Itoa(i)).SetAge(30 - i).SaveX(ctx)
+	}
+	client.User.UpdateOne(users[0]).AddFriends(users[1], users[2], users[3]).ExecX(ctx)
+	client.User.UpdateOne(users[4]).SetStatus(user.StatusSUSPENDED).SetNickname("a8m").ExecX(ctx)
+	client.Pet.Create().SetName("xabi").SetOwner(users[0]).SaveX(ctx)
+	client.Pet.Create().SetName("luna").SetOwner(users[0]).SaveX(ctx)
+	client.Pet.Create().SetName("pedro").SetOwner(users[1]).SaveX(ctx)
+	client.Pet.Create().SetName("lost").SaveX(ctx)
+	client.Group.Create().SetName("github").AddUsers(users[0], users[1]).SaveX(ctx)
+
+	t.Run("Connection", func(t *testing.T) {
+		first := 2
+		conn, err := client.User.Query().Connection(ctx, nil, &first, nil, nil, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, 5, conn.TotalCount)
+		require.Len(t, conn.Edges, 2)
+		require.Equal(t, users[0].ID, conn.Edges[0].Node.ID)
+		require.Equal(t, users[1].ID, conn.Edges[1].Node.ID)
+		require.True(t, conn.PageInfo.HasNextPage)
+		require.Equal(t, conn.Edges[1].Cursor.String(), conn.PageInfo.EndCursor.String())
+
+		// Cursors are decoded from their GraphQL representation.
+		var after ent.Cursor
+		require.NoError(t, after.UnmarshalGQL(conn.PageInfo.EndCursor.String()))
+		first = 10
+		conn, err = client.User.Query().Connection(ctx, &after, &first, nil, nil, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, 5, conn.TotalCount)
+		require.Len(t, conn.Edges, 3)
+		require.Equal(t, users[2].ID, conn.Edges[0].Node.ID)
+		require.False(t, conn.PageInfo.HasNextPage)
+
+		// Order by the "AGE" order field.
+		var field ent.UserOrderField
+		require.NoError(t, field.UnmarshalGQL("AGE"))
+		first = 1
+		conn, err = client.User.Query().Connection(ctx, nil, &first, nil, nil, &ent.UserOrder{Direction: ent.OrderDirectionAsc, Field: &field}, nil)
+		require.NoError(t, err)
+		require.Equal(t, users[4].ID, conn.Edges[0].Node.ID)
+		require.Error(t, field.UnmarshalGQL("PASSWORD"))
+
+		// The total count is affected only by the where input.
+		conn, err = client.User.Query().Connection(ctx, nil, &first, nil, nil, nil, &ent.UserWhereInput{HasPets: boolP(true)})
+		require.NoError(t, err)
+		require.Equal(t, 2, conn.TotalCount)
+		require.Len(t, conn.Edges, 1)
+	})
+
+	t.Run("WhereInput", func(t *testing.T) {
+		for _, tt := range []struct {
+			input *ent.UserWhereInput
+			want  []int
+		}{
+			{
+				input: &ent.UserWhereInput{Name: strP("u1")},
+				want:  []int{users[1].ID},
+			},
+			{
+				input: &ent.UserWhereInput{AgeGTE: intP(28), NameNEQ: strP("u0")},
+				want:  []int{users[1].ID, users[2].ID},
+			},
+			{
+				input: &ent.UserWhereInput{Or: []*ent.UserWhereInput{{IDIn: []int{users[3].ID}}, {NicknameNotNil: true}}},
+				want:  []int{users[3].ID, users[4].ID},
+			},
+			{
+				input: &ent.UserWhereInput{Not: &ent.UserWhereInput{Status: statusP(user.StatusACTIVE)}},
+				want:  []int{users[4].ID},
+			},
+			{
+				input: &ent.UserWhereInput{HasPetsWith: []*ent.PetWhereInput{{NameHasPrefix: strP("p")}}},
+				want:  []int{users[1].ID},
+			},
+			{
+				input: &ent.UserWhereInput{HasGroups: boolP(false), HasFriends: boolP(false)},
+				want:  []int{users[4].ID},
+			},
+			{
+				input: &ent.UserWhereInput{},
+				want:  []int{users[0].ID, users[1].ID, users[2].ID, users[3].ID, users[4].ID},
+			},
+		} {
+			q, err := tt.input.Filter(client.User.Query())
+			require.NoError(t, err)
+			require.Equal(t, tt.want, q.Order(ent.Asc(user.FieldID)).IDsX(ctx))
+		}
+		_, err := (&ent.UserWhereInput{}).P()
+		require.Equal(t, ent.ErrEmptyUserWhereInput, err)
+		_, err = (&ent.UserWhereInput{And: []*ent.UserWhereInput{{}, {Name: strP("u1")}}}).P()
+		require.Error(t, err)
+	})
+
+	t.Run("Noder", func(t *testing.T) {
+		n, err := client.Noder(ctx, users[0].ID, ent.WithFixedNodeType("User"))
+		require.NoError(t, err)
+		require.Equal(t, users[0].ID, n.(*ent.User).ID)
+		g, err := client.Noder(ctx, users[0].ID, ent.WithFixedNodeType("Group"))
+		require.NoError(t, err)
+		require.IsType(t, (*ent.Group)(nil), g)
+		// Without a node type, the first node that was found is returned.
+		n, err = client.Noder(ctx, users[3].ID)
+		require.NoError(t, err)
+		require.IsType(t, (*ent.Pet)(nil), n)
+		_, err = client.Noder(ctx, 100)
+		require.True(t, ent.IsNotFound(err))
+		_, err = client.Noder(ctx, users[0].ID, ent.WithFixedNodeType("Card"))
+		require.EqualError(t, err, `ent: cannot resolve noder of unknown type "Card"`)
+		nodes, err := client.Noders(ctx, []int{users[0].ID, users[1].ID}, ent.WithFixedNodeType("User"))
+		require.NoError(t, err)
+		require.Len(t, nodes, 2)
+	})
+
+	t.Run("Edges", func(t *testing.T) {
+		u := client.User.Query().
+			Where(user.ID(users[0].ID)).
+			CollectFields("name", "pets.owner.groups", "pets.name", "friends").
+			OnlyX(ctx)
+		// Edges that were selected are eager-loaded.
+		require.Len(t, u.Edges.Pets, 2)
+		require.NotNil(t, u.Edges.Pets[0].Edges.Owner)
+		_, err := u.Edges.FriendsOrErr()
+		require.True(t, ent.IsNotLoaded(err), "connections are not eager-loaded")
+		pets, err := u.Pets(ctx)
+		require.NoError(t, err)
+		require.Len(t, pets, 2)
+		owner, err := pets[0].Owner(ctx)
+		require.NoError(t, err)
+		require.Equal(t, u.ID, owner.ID)
+
+		// Edges that were not loaded are queried.
+		u = client.User.GetX(ctx, users[1].ID)
+		pets, err = u.Pets(ctx)
+		require.NoError(t, err)
+		require.Len(t, pets, 1)
+		lost := client.Pet.Query().Order(ent.Desc("id")).FirstX(ctx)
+		owner, err = lost.Owner(ctx)
+		require.NoError(t, err)
+		require.Nil(t, owner)
+
+		first := 2
+		friends, err := users[0].Friends(ctx, nil, &first, nil, nil, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, 3, friends.TotalCount)
+		require.Len(t, friends.Edges, 2)
+		require.True(t, friends.PageInfo.HasNextPage)
+		groups, err := users[1].Groups(ctx, nil, nil, nil, nil, nil, &ent.GroupWhereInput{Name: strP("github")})
+		require.NoError(t, err)
+		require.Equal(t, 1, groups.TotalCount)
+	})
+
+	t.Run("Marshal", func(t *testing.T) {
+		var b bytes.Buffer
+		user.StatusSUSPENDED.MarshalGQL(&b)
+		require.Equal(t, `"SUSPENDED"`, b.String())
+		var s user.Status
+		require.NoError(t, s.UnmarshalGQL("ACTIVE"))
+		require.Equal(t, user.StatusACTIVE, s)
+		require.Error(t, s.UnmarshalGQL("UNKNOWN"))
+
+		b.Reset()
+		ent.UserOrderFieldCreatedAt.MarshalGQL(&b)
+		require.Equal(t, `"CREATED_AT"`, b.String())
+		var d ent.OrderDirection
+		require.NoError(t, d.UnmarshalGQL("DESC"))
+		require.Equal(t, ent.OrderDirectionDesc, d)
+		require.Error(t, d.UnmarshalGQL("UP"))
+	})
+}
+
+func TestSchema(t *testing.T) {
+	b, err := ioutil.ReadFile("ent/ent.graphql")
+	require.NoError(t, err)
+	schema := string(b)
+	for _, s := range []string{
+		"type User implements Node {",
+		"  status: UserStatus!",
+		"  nickname: String\n",
+		"  friends(after: Cursor, first: Int, before: Cursor, last: Int, orderBy: UserOrder, where: UserWhereInput): UserConnection!",
+		"  groups(after: Cursor, first: Int, before: Cursor, last: Int, where: GroupWhereInput): GroupConnection!",
+		"enum UserOrderField {\n  NAME\n  AGE\n  CREATED_AT\n}",
+		"  hasPetsWith: [PetWhereInput!]",
+		"  users(after: Cursor, first: Int, before: Cursor, last: Int, orderBy: UserOrder, where: UserWhereInput): UserConnection!",
+	} {
+		require.Contains(t, schema, s)
+	}
+	// Sensitive fields, skipped types and edges to skipped types are omitted.
+	for _, s := range []string{"password", "Card", "card"} {
+		require.NotContains(t, schema, s)
+	}
+}
+
+func boolP(b bool) *bool                 { return &b }
+func intP(i int) *int                    { return &i }
+func strP(s string) *string              { return &s }
+func statusP(s user.Status) *user.Status { return &s }
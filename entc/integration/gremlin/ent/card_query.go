@@ -11,11 +11,13 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"time"
 
 	"entgo.io/ent/dialect/gremlin"
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/g"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/p"
 	"entgo.io/ent/entc/integration/gremlin/ent/card"
 	"entgo.io/ent/entc/integration/gremlin/ent/predicate"
 	"entgo.io/ent/entc/integration/gremlin/ent/spec"
@@ -30,6 +32,7 @@ type CardQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Card
+	inters     []Interceptor
 	// eager-loading edges.
 	withOwner *UserQuery
 	withSpec  *SpecQuery
@@ -62,9 +65,51 @@ func (cq *CardQuery) Order(o ...OrderFunc) *CardQuery {
 	return cq
 }
 
+// Type returns the node type of this query (Card). It implements the ent.Query interface.
+func (cq *CardQuery) Type() string {
+	return TypeCard
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (cq *CardQuery) SetLimit(limit int) {
+	cq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (cq *CardQuery) SetOffset(offset int) {
+	cq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (cq *CardQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for CardQuery", fn)
+		}
+		cq.order = append(cq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (cq *CardQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Card:
+			cq.predicates = append(cq.predicates, p)
+		case func(*dsl.Traversal):
+			cq.predicates = append(cq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for CardQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryOwner chains the current query on the "owner" edge.
 func (cq *CardQuery) QueryOwner() *UserQuery {
-	query := &UserQuery{config: cq.config}
+	query := (&UserClient{config: cq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
 		if err := cq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -78,7 +123,7 @@ func (cq *CardQuery) QueryOwner() *UserQuery {
 
 // QuerySpec chains the current query on the "spec" edge.
 func (cq *CardQuery) QuerySpec() *SpecQuery {
-	query := &SpecQuery{config: cq.config}
+	query := (&SpecClient{config: cq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
 		if err := cq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -195,7 +240,22 @@ func (cq *CardQuery) All(ctx context.Context) ([]*Card, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return cq.gremlinAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CardQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinAll(ctx)
+	})
+	v, err := withInterceptors(ctx, cq, qr, cq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Card)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -230,7 +290,22 @@ func (cq *CardQuery) Count(ctx context.Context) (int, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return cq.gremlinCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CardQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinCount(ctx)
+	})
+	v, err := withInterceptors(ctx, cq, qr, cq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -247,7 +322,22 @@ func (cq *CardQuery) Exist(ctx context.Context) (bool, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return cq.gremlinExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CardQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinExist(ctx)
+	})
+	v, err := withInterceptors(ctx, cq, qr, cq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -271,6 +361,7 @@ func (cq *CardQuery) Clone() *CardQuery {
 		offset:     cq.offset,
 		order:      append([]OrderFunc{}, cq.order...),
 		predicates: append([]predicate.Card{}, cq.predicates...),
+		inters:     append([]Interceptor{}, cq.inters...),
 		withOwner:  cq.withOwner.Clone(),
 		withSpec:   cq.withSpec.Clone(),
 		// clone intermediate query.
@@ -282,7 +373,7 @@ func (cq *CardQuery) Clone() *CardQuery {
 // WithOwner tells the query-builder to eager-load the nodes that are connected to
 // the "owner" edge. The optional arguments are used to configure the query builder of the edge.
 func (cq *CardQuery) WithOwner(opts ...func(*UserQuery)) *CardQuery {
-	query := &UserQuery{config: cq.config}
+	query := (&UserClient{config: cq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -293,7 +384,7 @@ func (cq *CardQuery) WithOwner(opts ...func(*UserQuery)) *CardQuery {
 // WithSpec tells the query-builder to eager-load the nodes that are connected to
 // the "spec" edge. The optional arguments are used to configure the query builder of the edge.
 func (cq *CardQuery) WithSpec(opts ...func(*SpecQuery)) *CardQuery {
-	query := &SpecQuery{config: cq.config}
+	query := (&SpecClient{config: cq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -399,7 +490,7 @@ func (cq *CardQuery) gremlinExist(ctx context.Context) (bool, error) {
 	return res.ReadBool()
 }
 
-func (cq *CardQuery) gremlinQuery(context.Context) *dsl.Traversal {
+func (cq *CardQuery) gremlinQuery(ctx context.Context) *dsl.Traversal {
 	v := g.V().HasLabel(card.Label)
 	if cq.gremlin != nil {
 		v = cq.gremlin.Clone()
@@ -703,8 +794,12 @@ func (cs *CardSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := cs.prepareQuery(ctx); err != nil {
 		return err
 	}
-	cs.gremlin = cs.CardQuery.gremlinQuery(ctx)
-	return cs.gremlinScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		cs.gremlin = cs.CardQuery.gremlinQuery(ctx)
+		return v, cs.gremlinScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, cs.CardQuery, qr, cs.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -933,3 +1028,219 @@ func (cs *CardSelect) gremlinScan(ctx context.Context, v interface{}) error {
 	}
 	return vm.Decode(v)
 }
+
+// CardOrderField defines a field that Card entities can be ordered by in Paginate.
+type CardOrderField struct {
+	field  string
+	value  func(*Card) interface{}
+	decode func(interface{}) (interface{}, error)
+}
+
+// String returns the name of the ordering field.
+func (f *CardOrderField) String() string {
+	return f.field
+}
+
+var (
+	// CardOrderFieldCreateTime orders Card entities by the "create_time" field.
+	CardOrderFieldCreateTime = &CardOrderField{
+		field: card.FieldCreateTime,
+		value: func(c *Card) interface{} {
+			return c.CreateTime
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v time.Time
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+	// CardOrderFieldUpdateTime orders Card entities by the "update_time" field.
+	CardOrderFieldUpdateTime = &CardOrderField{
+		field: card.FieldUpdateTime,
+		value: func(c *Card) interface{} {
+			return c.UpdateTime
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v time.Time
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+	// CardOrderFieldBalance orders Card entities by the "balance" field.
+	CardOrderFieldBalance = &CardOrderField{
+		field: card.FieldBalance,
+		value: func(c *Card) interface{} {
+			return c.Balance
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v float64
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+	// CardOrderFieldNumber orders Card entities by the "number" field.
+	CardOrderFieldNumber = &CardOrderField{
+		field: card.FieldNumber,
+		value: func(c *Card) interface{} {
+			return c.Number
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v string
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+)
+
+// CardOrder defines the ordering of Card entities in Paginate.
+// Entities are ordered by the given field (if any) and then by their ID.
+type CardOrder struct {
+	Direction OrderDirection
+	Field     *CardOrderField
+}
+
+// cursor returns the cursor of the given entity in the ordering.
+func (o *CardOrder) cursor(c *Card) *Cursor {
+	if o.Field == nil {
+		return &Cursor{ID: c.ID}
+	}
+	return &Cursor{ID: c.ID, Value: o.Field.value(c)}
+}
+
+// decode returns the typed ID and ordering value of the given cursor.
+func (o *CardOrder) decode(c *Cursor) (id string, value interface{}, err error) {
+	if err := decodeCursorValue(c.ID, &id); err != nil {
+		return id, nil, err
+	}
+	if o.Field == nil {
+		return id, nil, nil
+	}
+	if c.Value == nil {
+		return id, nil, fmt.Errorf("ent: cursor does not match the ordering by %q", o.Field.field)
+	}
+	if value, err = o.Field.decode(c.Value); err != nil {
+		return id, nil, err
+	}
+	return id, value, nil
+}
+
+// cursorPredicate returns a predicate that matches the entities that come
+// after the cursor when the entities are ordered in the given direction.
+func (o *CardOrder) cursorPredicate(c *Cursor, direction OrderDirection) (predicate.Card, error) {
+	id, value, err := o.decode(c)
+	if err != nil {
+		return nil, err
+	}
+	cmp := p.GT
+	if direction == OrderDirectionDesc {
+		cmp = p.LT
+	}
+	return func(t *dsl.Traversal) {
+		if o.Field == nil {
+			t.HasID(cmp(id))
+			return
+		}
+		t.Or(
+			__.Has(card.Label, o.Field.field, cmp(value)),
+			__.Has(card.Label, o.Field.field, p.EQ(value)).HasID(cmp(id)),
+		)
+	}, nil
+}
+
+// orderFunc returns the ordering function of the entities in the given direction.
+func (o *CardOrder) orderFunc(direction OrderDirection) OrderFunc {
+	order := dsl.Incr
+	if direction == OrderDirectionDesc {
+		order = dsl.Decr
+	}
+	return func(tr *dsl.Traversal) {
+		if o.Field != nil {
+			tr.By(o.Field.field, order)
+		}
+		tr.By(__.ID(), order)
+	}
+}
+
+// CardPage is a page of Card entities returned by Paginate.
+type CardPage struct {
+	Nodes    []*Card  `json:"nodes"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns a page of Card entities using cursor-based
+// (keyset) pagination. The entities are ordered by the given order, or by their ID if it is nil.
+// The after and before cursors limit the page to the entities that come after/before them in
+// this order, and first/last limit the page to the first/last N entities. Note that Paginate
+// overrides the ordering and the limit of the query.
+//
+//	page, err := client.Card.Query().
+//		Paginate(ctx, after, &first, nil, nil, &ent.CardOrder{Direction: ent.OrderDirectionAsc})
+//
+func (cq *CardQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *CardOrder) (*CardPage, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &CardOrder{Direction: OrderDirectionAsc}
+	}
+	if err := orderBy.Direction.Validate(); err != nil {
+		return nil, err
+	}
+	page := &CardPage{}
+	if first != nil && *first == 0 || last != nil && *last == 0 {
+		return page, nil
+	}
+	if after != nil {
+		p, err := orderBy.cursorPredicate(after, orderBy.Direction)
+		if err != nil {
+			return nil, err
+		}
+		cq.Where(p)
+	}
+	if before != nil {
+		p, err := orderBy.cursorPredicate(before, orderBy.Direction.reverse())
+		if err != nil {
+			return nil, err
+		}
+		cq.Where(p)
+	}
+	limit := -1
+	direction := orderBy.Direction
+	switch {
+	case first != nil:
+		limit = *first
+	case last != nil:
+		// Paginating backward queries the entities in the reverse
+		// order, and the page is reversed back after it is loaded.
+		limit = *last
+		direction = direction.reverse()
+	}
+	cq.order = []OrderFunc{orderBy.orderFunc(direction)}
+	if limit >= 0 {
+		// Query one extra entity to check if there is another page.
+		cq.Limit(limit + 1)
+	}
+	nodes, err := cq.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= 0 && len(nodes) > limit {
+		if first != nil {
+			page.PageInfo.HasNextPage = true
+		} else {
+			page.PageInfo.HasPreviousPage = true
+		}
+		nodes = nodes[:limit]
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	if len(nodes) > 0 {
+		page.PageInfo.StartCursor = orderBy.cursor(nodes[0])
+		page.PageInfo.EndCursor = orderBy.cursor(nodes[len(nodes)-1])
+	}
+	page.Nodes = nodes
+	return page, nil
+}
@@ -69,7 +69,7 @@ type Client struct {
 
 // NewClient creates a new client configured with the given options.
 func NewClient(opts ...Option) *Client {
-	cfg := config{log: log.Println, hooks: &hooks{}}
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
 	cfg.options(opts...)
 	client := &Client{config: cfg}
 	client.init()
@@ -192,6 +192,25 @@ func (c *Client) Use(hooks ...Hook) {
 	c.User.Use(hooks...)
 }
 
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.Card.Intercept(interceptors...)
+	c.Comment.Intercept(interceptors...)
+	c.FieldType.Intercept(interceptors...)
+	c.File.Intercept(interceptors...)
+	c.FileType.Intercept(interceptors...)
+	c.Goods.Intercept(interceptors...)
+	c.Group.Intercept(interceptors...)
+	c.GroupInfo.Intercept(interceptors...)
+	c.Item.Intercept(interceptors...)
+	c.Node.Intercept(interceptors...)
+	c.Pet.Intercept(interceptors...)
+	c.Spec.Intercept(interceptors...)
+	c.Task.Intercept(interceptors...)
+	c.User.Intercept(interceptors...)
+}
+
 // CardClient is a client for the Card schema.
 type CardClient struct {
 	config
@@ -208,6 +227,12 @@ func (c *CardClient) Use(hooks ...Hook) {
 	c.hooks.Card = append(c.hooks.Card, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `card.Intercept(f(g(h())))`.
+func (c *CardClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Card = append(c.inters.Card, interceptors...)
+}
+
 // Create returns a create builder for Card.
 func (c *CardClient) Create() *CardCreate {
 	mutation := newCardMutation(c.config, OpCreate)
@@ -258,7 +283,7 @@ func (c *CardClient) DeleteOneID(id string) *CardDeleteOne {
 
 // Query returns a query builder for Card.
 func (c *CardClient) Query() *CardQuery {
-	return &CardQuery{config: c.config}
+	return &CardQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Card entity by its id.
@@ -277,7 +302,7 @@ func (c *CardClient) GetX(ctx context.Context, id string) *Card {
 
 // QueryOwner queries the owner edge of a Card.
 func (c *CardClient) QueryOwner(ca *Card) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(ca.ID).InE(user.CardLabel).OutV()
@@ -288,7 +313,7 @@ func (c *CardClient) QueryOwner(ca *Card) *UserQuery {
 
 // QuerySpec queries the spec edge of a Card.
 func (c *CardClient) QuerySpec(ca *Card) *SpecQuery {
-	query := &SpecQuery{config: c.config}
+	query := (&SpecClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(ca.ID).InE(spec.CardLabel).OutV()
@@ -302,6 +327,11 @@ func (c *CardClient) Hooks() []Hook {
 	return c.hooks.Card
 }
 
+// Interceptors returns the client interceptors.
+func (c *CardClient) Interceptors() []Interceptor {
+	return c.inters.Card
+}
+
 // CommentClient is a client for the Comment schema.
 type CommentClient struct {
 	config
@@ -318,6 +348,12 @@ func (c *CommentClient) Use(hooks ...Hook) {
 	c.hooks.Comment = append(c.hooks.Comment, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `comment.Intercept(f(g(h())))`.
+func (c *CommentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Comment = append(c.inters.Comment, interceptors...)
+}
+
 // Create returns a create builder for Comment.
 func (c *CommentClient) Create() *CommentCreate {
 	mutation := newCommentMutation(c.config, OpCreate)
@@ -368,7 +404,7 @@ func (c *CommentClient) DeleteOneID(id string) *CommentDeleteOne {
 
 // Query returns a query builder for Comment.
 func (c *CommentClient) Query() *CommentQuery {
-	return &CommentQuery{config: c.config}
+	return &CommentQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Comment entity by its id.
@@ -390,6 +426,11 @@ func (c *CommentClient) Hooks() []Hook {
 	return c.hooks.Comment
 }
 
+// Interceptors returns the client interceptors.
+func (c *CommentClient) Interceptors() []Interceptor {
+	return c.inters.Comment
+}
+
 // FieldTypeClient is a client for the FieldType schema.
 type FieldTypeClient struct {
 	config
@@ -406,6 +447,12 @@ func (c *FieldTypeClient) Use(hooks ...Hook) {
 	c.hooks.FieldType = append(c.hooks.FieldType, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `fieldtype.Intercept(f(g(h())))`.
+func (c *FieldTypeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FieldType = append(c.inters.FieldType, interceptors...)
+}
+
 // Create returns a create builder for FieldType.
 func (c *FieldTypeClient) Create() *FieldTypeCreate {
 	mutation := newFieldTypeMutation(c.config, OpCreate)
@@ -456,7 +503,7 @@ func (c *FieldTypeClient) DeleteOneID(id string) *FieldTypeDeleteOne {
 
 // Query returns a query builder for FieldType.
 func (c *FieldTypeClient) Query() *FieldTypeQuery {
-	return &FieldTypeQuery{config: c.config}
+	return &FieldTypeQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a FieldType entity by its id.
@@ -478,6 +525,11 @@ func (c *FieldTypeClient) Hooks() []Hook {
 	return c.hooks.FieldType
 }
 
+// Interceptors returns the client interceptors.
+func (c *FieldTypeClient) Interceptors() []Interceptor {
+	return c.inters.FieldType
+}
+
 // FileClient is a client for the File schema.
 type FileClient struct {
 	config
@@ -494,6 +546,12 @@ func (c *FileClient) Use(hooks ...Hook) {
 	c.hooks.File = append(c.hooks.File, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `file.Intercept(f(g(h())))`.
+func (c *FileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.File = append(c.inters.File, interceptors...)
+}
+
 // Create returns a create builder for File.
 func (c *FileClient) Create() *FileCreate {
 	mutation := newFileMutation(c.config, OpCreate)
@@ -544,7 +602,7 @@ func (c *FileClient) DeleteOneID(id string) *FileDeleteOne {
 
 // Query returns a query builder for File.
 func (c *FileClient) Query() *FileQuery {
-	return &FileQuery{config: c.config}
+	return &FileQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a File entity by its id.
@@ -563,7 +621,7 @@ func (c *FileClient) GetX(ctx context.Context, id string) *File {
 
 // QueryOwner queries the owner edge of a File.
 func (c *FileClient) QueryOwner(f *File) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(f.ID).InE(user.FilesLabel).OutV()
@@ -574,7 +632,7 @@ func (c *FileClient) QueryOwner(f *File) *UserQuery {
 
 // QueryType queries the type edge of a File.
 func (c *FileClient) QueryType(f *File) *FileTypeQuery {
-	query := &FileTypeQuery{config: c.config}
+	query := (&FileTypeClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(f.ID).InE(filetype.FilesLabel).OutV()
@@ -585,7 +643,7 @@ func (c *FileClient) QueryType(f *File) *FileTypeQuery {
 
 // QueryField queries the field edge of a File.
 func (c *FileClient) QueryField(f *File) *FieldTypeQuery {
-	query := &FieldTypeQuery{config: c.config}
+	query := (&FieldTypeClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(f.ID).OutE(file.FieldLabel).InV()
@@ -599,6 +657,11 @@ func (c *FileClient) Hooks() []Hook {
 	return c.hooks.File
 }
 
+// Interceptors returns the client interceptors.
+func (c *FileClient) Interceptors() []Interceptor {
+	return c.inters.File
+}
+
 // FileTypeClient is a client for the FileType schema.
 type FileTypeClient struct {
 	config
@@ -615,6 +678,12 @@ func (c *FileTypeClient) Use(hooks ...Hook) {
 	c.hooks.FileType = append(c.hooks.FileType, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `filetype.Intercept(f(g(h())))`.
+func (c *FileTypeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FileType = append(c.inters.FileType, interceptors...)
+}
+
 // Create returns a create builder for FileType.
 func (c *FileTypeClient) Create() *FileTypeCreate {
 	mutation := newFileTypeMutation(c.config, OpCreate)
@@ -665,7 +734,7 @@ func (c *FileTypeClient) DeleteOneID(id string) *FileTypeDeleteOne {
 
 // Query returns a query builder for FileType.
 func (c *FileTypeClient) Query() *FileTypeQuery {
-	return &FileTypeQuery{config: c.config}
+	return &FileTypeQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a FileType entity by its id.
@@ -684,7 +753,7 @@ func (c *FileTypeClient) GetX(ctx context.Context, id string) *FileType {
 
 // QueryFiles queries the files edge of a FileType.
 func (c *FileTypeClient) QueryFiles(ft *FileType) *FileQuery {
-	query := &FileQuery{config: c.config}
+	query := (&FileClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(ft.ID).OutE(filetype.FilesLabel).InV()
@@ -698,6 +767,11 @@ func (c *FileTypeClient) Hooks() []Hook {
 	return c.hooks.FileType
 }
 
+// Interceptors returns the client interceptors.
+func (c *FileTypeClient) Interceptors() []Interceptor {
+	return c.inters.FileType
+}
+
 // GoodsClient is a client for the Goods schema.
 type GoodsClient struct {
 	config
@@ -714,6 +788,12 @@ func (c *GoodsClient) Use(hooks ...Hook) {
 	c.hooks.Goods = append(c.hooks.Goods, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `goods.Intercept(f(g(h())))`.
+func (c *GoodsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Goods = append(c.inters.Goods, interceptors...)
+}
+
 // Create returns a create builder for Goods.
 func (c *GoodsClient) Create() *GoodsCreate {
 	mutation := newGoodsMutation(c.config, OpCreate)
@@ -764,7 +844,7 @@ func (c *GoodsClient) DeleteOneID(id string) *GoodsDeleteOne {
 
 // Query returns a query builder for Goods.
 func (c *GoodsClient) Query() *GoodsQuery {
-	return &GoodsQuery{config: c.config}
+	return &GoodsQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Goods entity by its id.
@@ -786,6 +866,11 @@ func (c *GoodsClient) Hooks() []Hook {
 	return c.hooks.Goods
 }
 
+// Interceptors returns the client interceptors.
+func (c *GoodsClient) Interceptors() []Interceptor {
+	return c.inters.Goods
+}
+
 // GroupClient is a client for the Group schema.
 type GroupClient struct {
 	config
@@ -802,6 +887,12 @@ func (c *GroupClient) Use(hooks ...Hook) {
 	c.hooks.Group = append(c.hooks.Group, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `group.Intercept(f(g(h())))`.
+func (c *GroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Group = append(c.inters.Group, interceptors...)
+}
+
 // Create returns a create builder for Group.
 func (c *GroupClient) Create() *GroupCreate {
 	mutation := newGroupMutation(c.config, OpCreate)
@@ -852,7 +943,7 @@ func (c *GroupClient) DeleteOneID(id string) *GroupDeleteOne {
 
 // Query returns a query builder for Group.
 func (c *GroupClient) Query() *GroupQuery {
-	return &GroupQuery{config: c.config}
+	return &GroupQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Group entity by its id.
@@ -871,7 +962,7 @@ func (c *GroupClient) GetX(ctx context.Context, id string) *Group {
 
 // QueryFiles queries the files edge of a Group.
 func (c *GroupClient) QueryFiles(gr *Group) *FileQuery {
-	query := &FileQuery{config: c.config}
+	query := (&FileClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(gr.ID).OutE(group.FilesLabel).InV()
@@ -882,7 +973,7 @@ func (c *GroupClient) QueryFiles(gr *Group) *FileQuery {
 
 // QueryBlocked queries the blocked edge of a Group.
 func (c *GroupClient) QueryBlocked(gr *Group) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(gr.ID).OutE(group.BlockedLabel).InV()
@@ -893,7 +984,7 @@ func (c *GroupClient) QueryBlocked(gr *Group) *UserQuery {
 
 // QueryUsers queries the users edge of a Group.
 func (c *GroupClient) QueryUsers(gr *Group) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(gr.ID).InE(user.GroupsLabel).OutV()
@@ -904,7 +995,7 @@ func (c *GroupClient) QueryUsers(gr *Group) *UserQuery {
 
 // QueryInfo queries the info edge of a Group.
 func (c *GroupClient) QueryInfo(gr *Group) *GroupInfoQuery {
-	query := &GroupInfoQuery{config: c.config}
+	query := (&GroupInfoClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(gr.ID).OutE(group.InfoLabel).InV()
@@ -918,6 +1009,11 @@ func (c *GroupClient) Hooks() []Hook {
 	return c.hooks.Group
 }
 
+// Interceptors returns the client interceptors.
+func (c *GroupClient) Interceptors() []Interceptor {
+	return c.inters.Group
+}
+
 // GroupInfoClient is a client for the GroupInfo schema.
 type GroupInfoClient struct {
 	config
@@ -934,6 +1030,12 @@ func (c *GroupInfoClient) Use(hooks ...Hook) {
 	c.hooks.GroupInfo = append(c.hooks.GroupInfo, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `groupinfo.Intercept(f(g(h())))`.
+func (c *GroupInfoClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GroupInfo = append(c.inters.GroupInfo, interceptors...)
+}
+
 // Create returns a create builder for GroupInfo.
 func (c *GroupInfoClient) Create() *GroupInfoCreate {
 	mutation := newGroupInfoMutation(c.config, OpCreate)
@@ -984,7 +1086,7 @@ func (c *GroupInfoClient) DeleteOneID(id string) *GroupInfoDeleteOne {
 
 // Query returns a query builder for GroupInfo.
 func (c *GroupInfoClient) Query() *GroupInfoQuery {
-	return &GroupInfoQuery{config: c.config}
+	return &GroupInfoQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a GroupInfo entity by its id.
@@ -1003,7 +1105,7 @@ func (c *GroupInfoClient) GetX(ctx context.Context, id string) *GroupInfo {
 
 // QueryGroups queries the groups edge of a GroupInfo.
 func (c *GroupInfoClient) QueryGroups(gi *GroupInfo) *GroupQuery {
-	query := &GroupQuery{config: c.config}
+	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(gi.ID).InE(group.InfoLabel).OutV()
@@ -1017,6 +1119,11 @@ func (c *GroupInfoClient) Hooks() []Hook {
 	return c.hooks.GroupInfo
 }
 
+// Interceptors returns the client interceptors.
+func (c *GroupInfoClient) Interceptors() []Interceptor {
+	return c.inters.GroupInfo
+}
+
 // ItemClient is a client for the Item schema.
 type ItemClient struct {
 	config
@@ -1033,6 +1140,12 @@ func (c *ItemClient) Use(hooks ...Hook) {
 	c.hooks.Item = append(c.hooks.Item, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `item.Intercept(f(g(h())))`.
+func (c *ItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Item = append(c.inters.Item, interceptors...)
+}
+
 // Create returns a create builder for Item.
 func (c *ItemClient) Create() *ItemCreate {
 	mutation := newItemMutation(c.config, OpCreate)
@@ -1083,7 +1196,7 @@ func (c *ItemClient) DeleteOneID(id string) *ItemDeleteOne {
 
 // Query returns a query builder for Item.
 func (c *ItemClient) Query() *ItemQuery {
-	return &ItemQuery{config: c.config}
+	return &ItemQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Item entity by its id.
@@ -1105,6 +1218,11 @@ func (c *ItemClient) Hooks() []Hook {
 	return c.hooks.Item
 }
 
+// Interceptors returns the client interceptors.
+func (c *ItemClient) Interceptors() []Interceptor {
+	return c.inters.Item
+}
+
 // NodeClient is a client for the Node schema.
 type NodeClient struct {
 	config
@@ -1121,6 +1239,12 @@ func (c *NodeClient) Use(hooks ...Hook) {
 	c.hooks.Node = append(c.hooks.Node, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `node.Intercept(f(g(h())))`.
+func (c *NodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Node = append(c.inters.Node, interceptors...)
+}
+
 // Create returns a create builder for Node.
 func (c *NodeClient) Create() *NodeCreate {
 	mutation := newNodeMutation(c.config, OpCreate)
@@ -1171,7 +1295,7 @@ func (c *NodeClient) DeleteOneID(id string) *NodeDeleteOne {
 
 // Query returns a query builder for Node.
 func (c *NodeClient) Query() *NodeQuery {
-	return &NodeQuery{config: c.config}
+	return &NodeQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Node entity by its id.
@@ -1190,7 +1314,7 @@ func (c *NodeClient) GetX(ctx context.Context, id string) *Node {
 
 // QueryPrev queries the prev edge of a Node.
 func (c *NodeClient) QueryPrev(n *Node) *NodeQuery {
-	query := &NodeQuery{config: c.config}
+	query := (&NodeClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(n.ID).InE(node.NextLabel).OutV()
@@ -1201,7 +1325,7 @@ func (c *NodeClient) QueryPrev(n *Node) *NodeQuery {
 
 // QueryNext queries the next edge of a Node.
 func (c *NodeClient) QueryNext(n *Node) *NodeQuery {
-	query := &NodeQuery{config: c.config}
+	query := (&NodeClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(n.ID).OutE(node.NextLabel).InV()
@@ -1215,6 +1339,11 @@ func (c *NodeClient) Hooks() []Hook {
 	return c.hooks.Node
 }
 
+// Interceptors returns the client interceptors.
+func (c *NodeClient) Interceptors() []Interceptor {
+	return c.inters.Node
+}
+
 // PetClient is a client for the Pet schema.
 type PetClient struct {
 	config
@@ -1231,6 +1360,12 @@ func (c *PetClient) Use(hooks ...Hook) {
 	c.hooks.Pet = append(c.hooks.Pet, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pet.Intercept(f(g(h())))`.
+func (c *PetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Pet = append(c.inters.Pet, interceptors...)
+}
+
 // Create returns a create builder for Pet.
 func (c *PetClient) Create() *PetCreate {
 	mutation := newPetMutation(c.config, OpCreate)
@@ -1281,7 +1416,7 @@ func (c *PetClient) DeleteOneID(id string) *PetDeleteOne {
 
 // Query returns a query builder for Pet.
 func (c *PetClient) Query() *PetQuery {
-	return &PetQuery{config: c.config}
+	return &PetQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Pet entity by its id.
@@ -1300,7 +1435,7 @@ func (c *PetClient) GetX(ctx context.Context, id string) *Pet {
 
 // QueryTeam queries the team edge of a Pet.
 func (c *PetClient) QueryTeam(pe *Pet) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(pe.ID).InE(user.TeamLabel).OutV()
@@ -1311,7 +1446,7 @@ func (c *PetClient) QueryTeam(pe *Pet) *UserQuery {
 
 // QueryOwner queries the owner edge of a Pet.
 func (c *PetClient) QueryOwner(pe *Pet) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(pe.ID).InE(user.PetsLabel).OutV()
@@ -1325,6 +1460,11 @@ func (c *PetClient) Hooks() []Hook {
 	return c.hooks.Pet
 }
 
+// Interceptors returns the client interceptors.
+func (c *PetClient) Interceptors() []Interceptor {
+	return c.inters.Pet
+}
+
 // SpecClient is a client for the Spec schema.
 type SpecClient struct {
 	config
@@ -1341,6 +1481,12 @@ func (c *SpecClient) Use(hooks ...Hook) {
 	c.hooks.Spec = append(c.hooks.Spec, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `spec.Intercept(f(g(h())))`.
+func (c *SpecClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Spec = append(c.inters.Spec, interceptors...)
+}
+
 // Create returns a create builder for Spec.
 func (c *SpecClient) Create() *SpecCreate {
 	mutation := newSpecMutation(c.config, OpCreate)
@@ -1391,7 +1537,7 @@ func (c *SpecClient) DeleteOneID(id string) *SpecDeleteOne {
 
 // Query returns a query builder for Spec.
 func (c *SpecClient) Query() *SpecQuery {
-	return &SpecQuery{config: c.config}
+	return &SpecQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Spec entity by its id.
@@ -1410,7 +1556,7 @@ func (c *SpecClient) GetX(ctx context.Context, id string) *Spec {
 
 // QueryCard queries the card edge of a Spec.
 func (c *SpecClient) QueryCard(s *Spec) *CardQuery {
-	query := &CardQuery{config: c.config}
+	query := (&CardClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(s.ID).OutE(spec.CardLabel).InV()
@@ -1424,6 +1570,11 @@ func (c *SpecClient) Hooks() []Hook {
 	return c.hooks.Spec
 }
 
+// Interceptors returns the client interceptors.
+func (c *SpecClient) Interceptors() []Interceptor {
+	return c.inters.Spec
+}
+
 // TaskClient is a client for the Task schema.
 type TaskClient struct {
 	config
@@ -1440,6 +1591,12 @@ func (c *TaskClient) Use(hooks ...Hook) {
 	c.hooks.Task = append(c.hooks.Task, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `task.Intercept(f(g(h())))`.
+func (c *TaskClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Task = append(c.inters.Task, interceptors...)
+}
+
 // Create returns a create builder for Task.
 func (c *TaskClient) Create() *TaskCreate {
 	mutation := newTaskMutation(c.config, OpCreate)
@@ -1490,7 +1647,7 @@ func (c *TaskClient) DeleteOneID(id string) *TaskDeleteOne {
 
 // Query returns a query builder for Task.
 func (c *TaskClient) Query() *TaskQuery {
-	return &TaskQuery{config: c.config}
+	return &TaskQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a Task entity by its id.
@@ -1512,6 +1669,11 @@ func (c *TaskClient) Hooks() []Hook {
 	return c.hooks.Task
 }
 
+// Interceptors returns the client interceptors.
+func (c *TaskClient) Interceptors() []Interceptor {
+	return c.inters.Task
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -1528,6 +1690,12 @@ func (c *UserClient) Use(hooks ...Hook) {
 	c.hooks.User = append(c.hooks.User, hooks...)
 }
 
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
 // Create returns a create builder for User.
 func (c *UserClient) Create() *UserCreate {
 	mutation := newUserMutation(c.config, OpCreate)
@@ -1578,7 +1746,7 @@ func (c *UserClient) DeleteOneID(id string) *UserDeleteOne {
 
 // Query returns a query builder for User.
 func (c *UserClient) Query() *UserQuery {
-	return &UserQuery{config: c.config}
+	return &UserQuery{config: c.config, inters: c.Interceptors()}
 }
 
 // Get returns a User entity by its id.
@@ -1597,7 +1765,7 @@ func (c *UserClient) GetX(ctx context.Context, id string) *User {
 
 // QueryCard queries the card edge of a User.
 func (c *UserClient) QueryCard(u *User) *CardQuery {
-	query := &CardQuery{config: c.config}
+	query := (&CardClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(u.ID).OutE(user.CardLabel).InV()
@@ -1608,7 +1776,7 @@ func (c *UserClient) QueryCard(u *User) *CardQuery {
 
 // QueryPets queries the pets edge of a User.
 func (c *UserClient) QueryPets(u *User) *PetQuery {
-	query := &PetQuery{config: c.config}
+	query := (&PetClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(u.ID).OutE(user.PetsLabel).InV()
@@ -1619,7 +1787,7 @@ func (c *UserClient) QueryPets(u *User) *PetQuery {
 
 // QueryFiles queries the files edge of a User.
 func (c *UserClient) QueryFiles(u *User) *FileQuery {
-	query := &FileQuery{config: c.config}
+	query := (&FileClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(u.ID).OutE(user.FilesLabel).InV()
@@ -1630,7 +1798,7 @@ func (c *UserClient) QueryFiles(u *User) *FileQuery {
 
 // QueryGroups queries the groups edge of a User.
 func (c *UserClient) QueryGroups(u *User) *GroupQuery {
-	query := &GroupQuery{config: c.config}
+	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(u.ID).OutE(user.GroupsLabel).InV()
@@ -1641,7 +1809,7 @@ func (c *UserClient) QueryGroups(u *User) *GroupQuery {
 
 // QueryFriends queries the friends edge of a User.
 func (c *UserClient) QueryFriends(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(u.ID).Both(user.FriendsLabel)
@@ -1652,7 +1820,7 @@ func (c *UserClient) QueryFriends(u *User) *UserQuery {
 
 // QueryFollowers queries the followers edge of a User.
 func (c *UserClient) QueryFollowers(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(u.ID).InE(user.FollowingLabel).OutV()
@@ -1663,7 +1831,7 @@ func (c *UserClient) QueryFollowers(u *User) *UserQuery {
 
 // QueryFollowing queries the following edge of a User.
 func (c *UserClient) QueryFollowing(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(u.ID).OutE(user.FollowingLabel).InV()
@@ -1674,7 +1842,7 @@ func (c *UserClient) QueryFollowing(u *User) *UserQuery {
 
 // QueryTeam queries the team edge of a User.
 func (c *UserClient) QueryTeam(u *User) *PetQuery {
-	query := &PetQuery{config: c.config}
+	query := (&PetClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(u.ID).OutE(user.TeamLabel).InV()
@@ -1685,7 +1853,7 @@ func (c *UserClient) QueryTeam(u *User) *PetQuery {
 
 // QuerySpouse queries the spouse edge of a User.
 func (c *UserClient) QuerySpouse(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(u.ID).Both(user.SpouseLabel)
@@ -1696,7 +1864,7 @@ func (c *UserClient) QuerySpouse(u *User) *UserQuery {
 
 // QueryChildren queries the children edge of a User.
 func (c *UserClient) QueryChildren(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(u.ID).InE(user.ParentLabel).OutV()
@@ -1707,7 +1875,7 @@ func (c *UserClient) QueryChildren(u *User) *UserQuery {
 
 // QueryParent queries the parent edge of a User.
 func (c *UserClient) QueryParent(u *User) *UserQuery {
-	query := &UserQuery{config: c.config}
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(ctx context.Context) (fromV *dsl.Traversal, _ error) {
 
 		fromV = g.V(u.ID).OutE(user.ParentLabel).InV()
@@ -1720,3 +1888,8 @@ func (c *UserClient) QueryParent(u *User) *UserQuery {
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
 }
+
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
@@ -16,6 +16,7 @@ import (
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/g"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/p"
 	"entgo.io/ent/entc/integration/gremlin/ent/comment"
 	"entgo.io/ent/entc/integration/gremlin/ent/predicate"
 )
@@ -28,6 +29,7 @@ type CommentQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Comment
+	inters     []Interceptor
 	// intermediate query (i.e. traversal path).
 	gremlin *dsl.Traversal
 	path    func(context.Context) (*dsl.Traversal, error)
@@ -57,6 +59,48 @@ func (cq *CommentQuery) Order(o ...OrderFunc) *CommentQuery {
 	return cq
 }
 
+// Type returns the node type of this query (Comment). It implements the ent.Query interface.
+func (cq *CommentQuery) Type() string {
+	return TypeComment
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (cq *CommentQuery) SetLimit(limit int) {
+	cq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (cq *CommentQuery) SetOffset(offset int) {
+	cq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (cq *CommentQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for CommentQuery", fn)
+		}
+		cq.order = append(cq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (cq *CommentQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Comment:
+			cq.predicates = append(cq.predicates, p)
+		case func(*dsl.Traversal):
+			cq.predicates = append(cq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for CommentQuery", p)
+		}
+	}
+	return nil
+}
+
 // First returns the first Comment entity from the query.
 // Returns a *NotFoundError when no Comment was found.
 func (cq *CommentQuery) First(ctx context.Context) (*Comment, error) {
@@ -162,7 +206,22 @@ func (cq *CommentQuery) All(ctx context.Context) ([]*Comment, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return cq.gremlinAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CommentQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinAll(ctx)
+	})
+	v, err := withInterceptors(ctx, cq, qr, cq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Comment)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -197,7 +256,22 @@ func (cq *CommentQuery) Count(ctx context.Context) (int, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return cq.gremlinCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CommentQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinCount(ctx)
+	})
+	v, err := withInterceptors(ctx, cq, qr, cq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -214,7 +288,22 @@ func (cq *CommentQuery) Exist(ctx context.Context) (bool, error) {
 	if err := cq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return cq.gremlinExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CommentQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinExist(ctx)
+	})
+	v, err := withInterceptors(ctx, cq, qr, cq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -238,6 +327,7 @@ func (cq *CommentQuery) Clone() *CommentQuery {
 		offset:     cq.offset,
 		order:      append([]OrderFunc{}, cq.order...),
 		predicates: append([]predicate.Comment{}, cq.predicates...),
+		inters:     append([]Interceptor{}, cq.inters...),
 		// clone intermediate query.
 		gremlin: cq.gremlin.Clone(),
 		path:    cq.path,
@@ -342,7 +432,7 @@ func (cq *CommentQuery) gremlinExist(ctx context.Context) (bool, error) {
 	return res.ReadBool()
 }
 
-func (cq *CommentQuery) gremlinQuery(context.Context) *dsl.Traversal {
+func (cq *CommentQuery) gremlinQuery(ctx context.Context) *dsl.Traversal {
 	v := g.V().HasLabel(comment.Label)
 	if cq.gremlin != nil {
 		v = cq.gremlin.Clone()
@@ -646,8 +736,12 @@ func (cs *CommentSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := cs.prepareQuery(ctx); err != nil {
 		return err
 	}
-	cs.gremlin = cs.CommentQuery.gremlinQuery(ctx)
-	return cs.gremlinScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		cs.gremlin = cs.CommentQuery.gremlinQuery(ctx)
+		return v, cs.gremlinScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, cs.CommentQuery, qr, cs.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -876,3 +970,195 @@ func (cs *CommentSelect) gremlinScan(ctx context.Context, v interface{}) error {
 	}
 	return vm.Decode(v)
 }
+
+// CommentOrderField defines a field that Comment entities can be ordered by in Paginate.
+type CommentOrderField struct {
+	field  string
+	value  func(*Comment) interface{}
+	decode func(interface{}) (interface{}, error)
+}
+
+// String returns the name of the ordering field.
+func (f *CommentOrderField) String() string {
+	return f.field
+}
+
+var (
+	// CommentOrderFieldUniqueInt orders Comment entities by the "unique_int" field.
+	CommentOrderFieldUniqueInt = &CommentOrderField{
+		field: comment.FieldUniqueInt,
+		value: func(c *Comment) interface{} {
+			return c.UniqueInt
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v int
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+	// CommentOrderFieldUniqueFloat orders Comment entities by the "unique_float" field.
+	CommentOrderFieldUniqueFloat = &CommentOrderField{
+		field: comment.FieldUniqueFloat,
+		value: func(c *Comment) interface{} {
+			return c.UniqueFloat
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v float64
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+)
+
+// CommentOrder defines the ordering of Comment entities in Paginate.
+// Entities are ordered by the given field (if any) and then by their ID.
+type CommentOrder struct {
+	Direction OrderDirection
+	Field     *CommentOrderField
+}
+
+// cursor returns the cursor of the given entity in the ordering.
+func (o *CommentOrder) cursor(c *Comment) *Cursor {
+	if o.Field == nil {
+		return &Cursor{ID: c.ID}
+	}
+	return &Cursor{ID: c.ID, Value: o.Field.value(c)}
+}
+
+// decode returns the typed ID and ordering value of the given cursor.
+func (o *CommentOrder) decode(c *Cursor) (id string, value interface{}, err error) {
+	if err := decodeCursorValue(c.ID, &id); err != nil {
+		return id, nil, err
+	}
+	if o.Field == nil {
+		return id, nil, nil
+	}
+	if c.Value == nil {
+		return id, nil, fmt.Errorf("ent: cursor does not match the ordering by %q", o.Field.field)
+	}
+	if value, err = o.Field.decode(c.Value); err != nil {
+		return id, nil, err
+	}
+	return id, value, nil
+}
+
+// cursorPredicate returns a predicate that matches the entities that come
+// after the cursor when the entities are ordered in the given direction.
+func (o *CommentOrder) cursorPredicate(c *Cursor, direction OrderDirection) (predicate.Comment, error) {
+	id, value, err := o.decode(c)
+	if err != nil {
+		return nil, err
+	}
+	cmp := p.GT
+	if direction == OrderDirectionDesc {
+		cmp = p.LT
+	}
+	return func(t *dsl.Traversal) {
+		if o.Field == nil {
+			t.HasID(cmp(id))
+			return
+		}
+		t.Or(
+			__.Has(comment.Label, o.Field.field, cmp(value)),
+			__.Has(comment.Label, o.Field.field, p.EQ(value)).HasID(cmp(id)),
+		)
+	}, nil
+}
+
+// orderFunc returns the ordering function of the entities in the given direction.
+func (o *CommentOrder) orderFunc(direction OrderDirection) OrderFunc {
+	order := dsl.Incr
+	if direction == OrderDirectionDesc {
+		order = dsl.Decr
+	}
+	return func(tr *dsl.Traversal) {
+		if o.Field != nil {
+			tr.By(o.Field.field, order)
+		}
+		tr.By(__.ID(), order)
+	}
+}
+
+// CommentPage is a page of Comment entities returned by Paginate.
+type CommentPage struct {
+	Nodes    []*Comment `json:"nodes"`
+	PageInfo PageInfo   `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns a page of Comment entities using cursor-based
+// (keyset) pagination. The entities are ordered by the given order, or by their ID if it is nil.
+// The after and before cursors limit the page to the entities that come after/before them in
+// this order, and first/last limit the page to the first/last N entities. Note that Paginate
+// overrides the ordering and the limit of the query.
+//
+//	page, err := client.Comment.Query().
+//		Paginate(ctx, after, &first, nil, nil, &ent.CommentOrder{Direction: ent.OrderDirectionAsc})
+//
+func (cq *CommentQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *CommentOrder) (*CommentPage, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &CommentOrder{Direction: OrderDirectionAsc}
+	}
+	if err := orderBy.Direction.Validate(); err != nil {
+		return nil, err
+	}
+	page := &CommentPage{}
+	if first != nil && *first == 0 || last != nil && *last == 0 {
+		return page, nil
+	}
+	if after != nil {
+		p, err := orderBy.cursorPredicate(after, orderBy.Direction)
+		if err != nil {
+			return nil, err
+		}
+		cq.Where(p)
+	}
+	if before != nil {
+		p, err := orderBy.cursorPredicate(before, orderBy.Direction.reverse())
+		if err != nil {
+			return nil, err
+		}
+		cq.Where(p)
+	}
+	limit := -1
+	direction := orderBy.Direction
+	switch {
+	case first != nil:
+		limit = *first
+	case last != nil:
+		// Paginating backward queries the entities in the reverse
+		// order, and the page is reversed back after it is loaded.
+		limit = *last
+		direction = direction.reverse()
+	}
+	cq.order = []OrderFunc{orderBy.orderFunc(direction)}
+	if limit >= 0 {
+		// Query one extra entity to check if there is another page.
+		cq.Limit(limit + 1)
+	}
+	nodes, err := cq.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= 0 && len(nodes) > limit {
+		if first != nil {
+			page.PageInfo.HasNextPage = true
+		} else {
+			page.PageInfo.HasPreviousPage = true
+		}
+		nodes = nodes[:limit]
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	if len(nodes) > 0 {
+		page.PageInfo.StartCursor = orderBy.cursor(nodes[0])
+		page.PageInfo.EndCursor = orderBy.cursor(nodes[len(nodes)-1])
+	}
+	page.Nodes = nodes
+	return page, nil
+}
@@ -7,6 +7,7 @@
 package ent
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -21,14 +22,17 @@ import (
 
 // ent aliases to avoid import conflicts in user's code.
 type (
-	Op         = ent.Op
-	Hook       = ent.Hook
-	Value      = ent.Value
-	Query      = ent.Query
-	Policy     = ent.Policy
-	Mutator    = ent.Mutator
-	Mutation   = ent.Mutation
-	MutateFunc = ent.MutateFunc
+	Op          = ent.Op
+	Hook        = ent.Hook
+	Value       = ent.Value
+	Query       = ent.Query
+	Policy      = ent.Policy
+	Querier     = ent.Querier
+	QuerierFunc = ent.QuerierFunc
+	Interceptor = ent.Interceptor
+	Mutator     = ent.Mutator
+	Mutation    = ent.Mutation
+	MutateFunc  = ent.MutateFunc
 )
 
 // OrderFunc applies an ordering on the graph traversal.
@@ -266,6 +270,15 @@ func IsConstraintError(err error) bool {
 	return errors.As(err, &e)
 }
 
+// withInterceptors wraps the given querier with the interceptors
+// chain and executes it on the given query.
+func withInterceptors(ctx context.Context, q Query, qr Querier, inters []Interceptor) (Value, error) {
+	for i := len(inters) - 1; i >= 0; i-- {
+		qr = inters[i](qr)
+	}
+	return qr.Query(ctx, q)
+}
+
 // Code implements the dsl.Node interface.
 func (e ConstraintError) Code() (string, []interface{}) {
 	return strconv.Quote(e.prefix() + e.msg), nil
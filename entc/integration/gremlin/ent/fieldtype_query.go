@@ -16,6 +16,7 @@ import (
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/g"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/p"
 	"entgo.io/ent/entc/integration/gremlin/ent/fieldtype"
 	"entgo.io/ent/entc/integration/gremlin/ent/predicate"
 )
@@ -28,6 +29,7 @@ type FieldTypeQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.FieldType
+	inters     []Interceptor
 	// intermediate query (i.e. traversal path).
 	gremlin *dsl.Traversal
 	path    func(context.Context) (*dsl.Traversal, error)
@@ -57,6 +59,48 @@ func (ftq *FieldTypeQuery) Order(o ...OrderFunc) *FieldTypeQuery {
 	return ftq
 }
 
+// Type returns the node type of this query (FieldType). It implements the ent.Query interface.
+func (ftq *FieldTypeQuery) Type() string {
+	return TypeFieldType
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (ftq *FieldTypeQuery) SetLimit(limit int) {
+	ftq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (ftq *FieldTypeQuery) SetOffset(offset int) {
+	ftq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (ftq *FieldTypeQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for FieldTypeQuery", fn)
+		}
+		ftq.order = append(ftq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (ftq *FieldTypeQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.FieldType:
+			ftq.predicates = append(ftq.predicates, p)
+		case func(*dsl.Traversal):
+			ftq.predicates = append(ftq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for FieldTypeQuery", p)
+		}
+	}
+	return nil
+}
+
 // First returns the first FieldType entity from the query.
 // Returns a *NotFoundError when no FieldType was found.
 func (ftq *FieldTypeQuery) First(ctx context.Context) (*FieldType, error) {
@@ -162,7 +206,22 @@ func (ftq *FieldTypeQuery) All(ctx context.Context) ([]*FieldType, error) {
 	if err := ftq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return ftq.gremlinAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*FieldTypeQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinAll(ctx)
+	})
+	v, err := withInterceptors(ctx, ftq, qr, ftq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*FieldType)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -197,7 +256,22 @@ func (ftq *FieldTypeQuery) Count(ctx context.Context) (int, error) {
 	if err := ftq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return ftq.gremlinCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*FieldTypeQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinCount(ctx)
+	})
+	v, err := withInterceptors(ctx, ftq, qr, ftq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -214,7 +288,22 @@ func (ftq *FieldTypeQuery) Exist(ctx context.Context) (bool, error) {
 	if err := ftq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return ftq.gremlinExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*FieldTypeQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinExist(ctx)
+	})
+	v, err := withInterceptors(ctx, ftq, qr, ftq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -238,6 +327,7 @@ func (ftq *FieldTypeQuery) Clone() *FieldTypeQuery {
 		offset:     ftq.offset,
 		order:      append([]OrderFunc{}, ftq.order...),
 		predicates: append([]predicate.FieldType{}, ftq.predicates...),
+		inters:     append([]Interceptor{}, ftq.inters...),
 		// clone intermediate query.
 		gremlin: ftq.gremlin.Clone(),
 		path:    ftq.path,
@@ -342,7 +432,7 @@ func (ftq *FieldTypeQuery) gremlinExist(ctx context.Context) (bool, error) {
 	return res.ReadBool()
 }
 
-func (ftq *FieldTypeQuery) gremlinQuery(context.Context) *dsl.Traversal {
+func (ftq *FieldTypeQuery) gremlinQuery(ctx context.Context) *dsl.Traversal {
 	v := g.V().HasLabel(fieldtype.Label)
 	if ftq.gremlin != nil {
 		v = ftq.gremlin.Clone()
@@ -646,8 +736,12 @@ func (fts *FieldTypeSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := fts.prepareQuery(ctx); err != nil {
 		return err
 	}
-	fts.gremlin = fts.FieldTypeQuery.gremlinQuery(ctx)
-	return fts.gremlinScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		fts.gremlin = fts.FieldTypeQuery.gremlinQuery(ctx)
+		return v, fts.gremlinScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, fts.FieldTypeQuery, qr, fts.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -876,3 +970,231 @@ func (fts *FieldTypeSelect) gremlinScan(ctx context.Context, v interface{}) erro
 	}
 	return vm.Decode(v)
 }
+
+// FieldTypeOrderField defines a field that FieldType entities can be ordered by in Paginate.
+type FieldTypeOrderField struct {
+	field  string
+	value  func(*FieldType) interface{}
+	decode func(interface{}) (interface{}, error)
+}
+
+// String returns the name of the ordering field.
+func (f *FieldTypeOrderField) String() string {
+	return f.field
+}
+
+var (
+	// FieldTypeOrderFieldInt orders FieldType entities by the "int" field.
+	FieldTypeOrderFieldInt = &FieldTypeOrderField{
+		field: fieldtype.FieldInt,
+		value: func(ft *FieldType) interface{} {
+			return ft.Int
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v int
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+	// FieldTypeOrderFieldInt8 orders FieldType entities by the "int8" field.
+	FieldTypeOrderFieldInt8 = &FieldTypeOrderField{
+		field: fieldtype.FieldInt8,
+		value: func(ft *FieldType) interface{} {
+			return ft.Int8
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v int8
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+	// FieldTypeOrderFieldInt16 orders FieldType entities by the "int16" field.
+	FieldTypeOrderFieldInt16 = &FieldTypeOrderField{
+		field: fieldtype.FieldInt16,
+		value: func(ft *FieldType) interface{} {
+			return ft.Int16
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v int16
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+	// FieldTypeOrderFieldInt32 orders FieldType entities by the "int32" field.
+	FieldTypeOrderFieldInt32 = &FieldTypeOrderField{
+		field: fieldtype.FieldInt32,
+		value: func(ft *FieldType) interface{} {
+			return ft.Int32
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v int32
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+	// FieldTypeOrderFieldInt64 orders FieldType entities by the "int64" field.
+	FieldTypeOrderFieldInt64 = &FieldTypeOrderField{
+		field: fieldtype.FieldInt64,
+		value: func(ft *FieldType) interface{} {
+			return ft.Int64
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v int64
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+)
+
+// FieldTypeOrder defines the ordering of FieldType entities in Paginate.
+// Entities are ordered by the given field (if any) and then by their ID.
+type FieldTypeOrder struct {
+	Direction OrderDirection
+	Field     *FieldTypeOrderField
+}
+
+// cursor returns the cursor of the given entity in the ordering.
+func (o *FieldTypeOrder) cursor(ft *FieldType) *Cursor {
+	if o.Field == nil {
+		return &Cursor{ID: ft.ID}
+	}
+	return &Cursor{ID: ft.ID, Value: o.Field.value(ft)}
+}
+
+// decode returns the typed ID and ordering value of the given cursor.
+func (o *FieldTypeOrder) decode(c *Cursor) (id string, value interface{}, err error) {
+	if err := decodeCursorValue(c.ID, &id); err != nil {
+		return id, nil, err
+	}
+	if o.Field == nil {
+		return id, nil, nil
+	}
+	if c.Value == nil {
+		return id, nil, fmt.Errorf("ent: cursor does not match the ordering by %q", o.Field.field)
+	}
+	if value, err = o.Field.decode(c.Value); err != nil {
+		return id, nil, err
+	}
+	return id, value, nil
+}
+
+// cursorPredicate returns a predicate that matches the entities that come
+// after the cursor when the entities are ordered in the given direction.
+func (o *FieldTypeOrder) cursorPredicate(c *Cursor, direction OrderDirection) (predicate.FieldType, error) {
+	id, value, err := o.decode(c)
+	if err != nil {
+		return nil, err
+	}
+	cmp := p.GT
+	if direction == OrderDirectionDesc {
+		cmp = p.LT
+	}
+	return func(t *dsl.Traversal) {
+		if o.Field == nil {
+			t.HasID(cmp(id))
+			return
+		}
+		t.Or(
+			__.Has(fieldtype.Label, o.Field.field, cmp(value)),
+			__.Has(fieldtype.Label, o.Field.field, p.EQ(value)).HasID(cmp(id)),
+		)
+	}, nil
+}
+
+// orderFunc returns the ordering function of the entities in the given direction.
+func (o *FieldTypeOrder) orderFunc(direction OrderDirection) OrderFunc {
+	order := dsl.Incr
+	if direction == OrderDirectionDesc {
+		order = dsl.Decr
+	}
+	return func(tr *dsl.Traversal) {
+		if o.Field != nil {
+			tr.By(o.Field.field, order)
+		}
+		tr.By(__.ID(), order)
+	}
+}
+
+// FieldTypePage is a page of FieldType entities returned by Paginate.
+type FieldTypePage struct {
+	Nodes    []*FieldType `json:"nodes"`
+	PageInfo PageInfo     `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns a page of FieldType entities using cursor-based
+// (keyset) pagination. The entities are ordered by the given order, or by their ID if it is nil.
+// The after and before cursors limit the page to the entities that come after/before them in
+// this order, and first/last limit the page to the first/last N entities. Note that Paginate
+// overrides the ordering and the limit of the query.
+//
+//	page, err := client.FieldType.Query().
+//		Paginate(ctx, after, &first, nil, nil, &ent.FieldTypeOrder{Direction: ent.OrderDirectionAsc})
+//
+func (ftq *FieldTypeQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *FieldTypeOrder) (*FieldTypePage, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &FieldTypeOrder{Direction: OrderDirectionAsc}
+	}
+	if err := orderBy.Direction.Validate(); err != nil {
+		return nil, err
+	}
+	page := &FieldTypePage{}
+	if first != nil && *first == 0 || last != nil && *last == 0 {
+		return page, nil
+	}
+	if after != nil {
+		p, err := orderBy.cursorPredicate(after, orderBy.Direction)
+		if err != nil {
+			return nil, err
+		}
+		ftq.Where(p)
+	}
+	if before != nil {
+		p, err := orderBy.cursorPredicate(before, orderBy.Direction.reverse())
+		if err != nil {
+			return nil, err
+		}
+		ftq.Where(p)
+	}
+	limit := -1
+	direction := orderBy.Direction
+	switch {
+	case first != nil:
+		limit = *first
+	case last != nil:
+		// Paginating backward queries the entities in the reverse
+		// order, and the page is reversed back after it is loaded.
+		limit = *last
+		direction = direction.reverse()
+	}
+	ftq.order = []OrderFunc{orderBy.orderFunc(direction)}
+	if limit >= 0 {
+		// Query one extra entity to check if there is another page.
+		ftq.Limit(limit + 1)
+	}
+	nodes, err := ftq.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= 0 && len(nodes) > limit {
+		if first != nil {
+			page.PageInfo.HasNextPage = true
+		} else {
+			page.PageInfo.HasPreviousPage = true
+		}
+		nodes = nodes[:limit]
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	if len(nodes) > 0 {
+		page.PageInfo.StartCursor = orderBy.cursor(nodes[0])
+		page.PageInfo.EndCursor = orderBy.cursor(nodes[len(nodes)-1])
+	}
+	page.Nodes = nodes
+	return page, nil
+}
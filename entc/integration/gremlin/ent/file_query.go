@@ -16,6 +16,7 @@ import (
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/g"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/p"
 	"entgo.io/ent/entc/integration/gremlin/ent/file"
 	"entgo.io/ent/entc/integration/gremlin/ent/filetype"
 	"entgo.io/ent/entc/integration/gremlin/ent/predicate"
@@ -30,6 +31,7 @@ type FileQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.File
+	inters     []Interceptor
 	// eager-loading edges.
 	withOwner *UserQuery
 	withType  *FileTypeQuery
@@ -63,9 +65,51 @@ func (fq *FileQuery) Order(o ...OrderFunc) *FileQuery {
 	return fq
 }
 
+// Type returns the node type of this query (File). It implements the ent.Query interface.
+func (fq *FileQuery) Type() string {
+	return TypeFile
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (fq *FileQuery) SetLimit(limit int) {
+	fq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (fq *FileQuery) SetOffset(offset int) {
+	fq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (fq *FileQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for FileQuery", fn)
+		}
+		fq.order = append(fq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (fq *FileQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.File:
+			fq.predicates = append(fq.predicates, p)
+		case func(*dsl.Traversal):
+			fq.predicates = append(fq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for FileQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryOwner chains the current query on the "owner" edge.
 func (fq *FileQuery) QueryOwner() *UserQuery {
-	query := &UserQuery{config: fq.config}
+	query := (&UserClient{config: fq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
 		if err := fq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -79,7 +123,7 @@ func (fq *FileQuery) QueryOwner() *UserQuery {
 
 // QueryType chains the current query on the "type" edge.
 func (fq *FileQuery) QueryType() *FileTypeQuery {
-	query := &FileTypeQuery{config: fq.config}
+	query := (&FileTypeClient{config: fq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
 		if err := fq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -93,7 +137,7 @@ func (fq *FileQuery) QueryType() *FileTypeQuery {
 
 // QueryField chains the current query on the "field" edge.
 func (fq *FileQuery) QueryField() *FieldTypeQuery {
-	query := &FieldTypeQuery{config: fq.config}
+	query := (&FieldTypeClient{config: fq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
 		if err := fq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -210,7 +254,22 @@ func (fq *FileQuery) All(ctx context.Context) ([]*File, error) {
 	if err := fq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return fq.gremlinAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*FileQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinAll(ctx)
+	})
+	v, err := withInterceptors(ctx, fq, qr, fq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*File)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -245,7 +304,22 @@ func (fq *FileQuery) Count(ctx context.Context) (int, error) {
 	if err := fq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return fq.gremlinCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*FileQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinCount(ctx)
+	})
+	v, err := withInterceptors(ctx, fq, qr, fq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -262,7 +336,22 @@ func (fq *FileQuery) Exist(ctx context.Context) (bool, error) {
 	if err := fq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return fq.gremlinExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*FileQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinExist(ctx)
+	})
+	v, err := withInterceptors(ctx, fq, qr, fq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -286,6 +375,7 @@ func (fq *FileQuery) Clone() *FileQuery {
 		offset:     fq.offset,
 		order:      append([]OrderFunc{}, fq.order...),
 		predicates: append([]predicate.File{}, fq.predicates...),
+		inters:     append([]Interceptor{}, fq.inters...),
 		withOwner:  fq.withOwner.Clone(),
 		withType:   fq.withType.Clone(),
 		withField:  fq.withField.Clone(),
@@ -298,7 +388,7 @@ func (fq *FileQuery) Clone() *FileQuery {
 // WithOwner tells the query-builder to eager-load the nodes that are connected to
 // the "owner" edge. The optional arguments are used to configure the query builder of the edge.
 func (fq *FileQuery) WithOwner(opts ...func(*UserQuery)) *FileQuery {
-	query := &UserQuery{config: fq.config}
+	query := (&UserClient{config: fq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -309,7 +399,7 @@ func (fq *FileQuery) WithOwner(opts ...func(*UserQuery)) *FileQuery {
 // WithType tells the query-builder to eager-load the nodes that are connected to
 // the "type" edge. The optional arguments are used to configure the query builder of the edge.
 func (fq *FileQuery) WithType(opts ...func(*FileTypeQuery)) *FileQuery {
-	query := &FileTypeQuery{config: fq.config}
+	query := (&FileTypeClient{config: fq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -320,7 +410,7 @@ func (fq *FileQuery) WithType(opts ...func(*FileTypeQuery)) *FileQuery {
 // WithField tells the query-builder to eager-load the nodes that are connected to
 // the "field" edge. The optional arguments are used to configure the query builder of the edge.
 func (fq *FileQuery) WithField(opts ...func(*FieldTypeQuery)) *FileQuery {
-	query := &FieldTypeQuery{config: fq.config}
+	query := (&FieldTypeClient{config: fq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -426,7 +516,7 @@ func (fq *FileQuery) gremlinExist(ctx context.Context) (bool, error) {
 	return res.ReadBool()
 }
 
-func (fq *FileQuery) gremlinQuery(context.Context) *dsl.Traversal {
+func (fq *FileQuery) gremlinQuery(ctx context.Context) *dsl.Traversal {
 	v := g.V().HasLabel(file.Label)
 	if fq.gremlin != nil {
 		v = fq.gremlin.Clone()
@@ -730,8 +820,12 @@ func (fs *FileSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := fs.prepareQuery(ctx); err != nil {
 		return err
 	}
-	fs.gremlin = fs.FileQuery.gremlinQuery(ctx)
-	return fs.gremlinScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		fs.gremlin = fs.FileQuery.gremlinQuery(ctx)
+		return v, fs.gremlinScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, fs.FileQuery, qr, fs.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -960,3 +1054,195 @@ func (fs *FileSelect) gremlinScan(ctx context.Context, v interface{}) error {
 	}
 	return vm.Decode(v)
 }
+
+// FileOrderField defines a field that File entities can be ordered by in Paginate.
+type FileOrderField struct {
+	field  string
+	value  func(*File) interface{}
+	decode func(interface{}) (interface{}, error)
+}
+
+// String returns the name of the ordering field.
+func (f *FileOrderField) String() string {
+	return f.field
+}
+
+var (
+	// FileOrderFieldSize orders File entities by the "size" field.
+	FileOrderFieldSize = &FileOrderField{
+		field: file.FieldSize,
+		value: func(f *File) interface{} {
+			return f.Size
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v int
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+	// FileOrderFieldName orders File entities by the "name" field.
+	FileOrderFieldName = &FileOrderField{
+		field: file.FieldName,
+		value: func(f *File) interface{} {
+			return f.Name
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v string
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+)
+
+// FileOrder defines the ordering of File entities in Paginate.
+// Entities are ordered by the given field (if any) and then by their ID.
+type FileOrder struct {
+	Direction OrderDirection
+	Field     *FileOrderField
+}
+
+// cursor returns the cursor of the given entity in the ordering.
+func (o *FileOrder) cursor(f *File) *Cursor {
+	if o.Field == nil {
+		return &Cursor{ID: f.ID}
+	}
+	return &Cursor{ID: f.ID, Value: o.Field.value(f)}
+}
+
+// decode returns the typed ID and ordering value of the given cursor.
+func (o *FileOrder) decode(c *Cursor) (id string, value interface{}, err error) {
+	if err := decodeCursorValue(c.ID, &id); err != nil {
+		return id, nil, err
+	}
+	if o.Field == nil {
+		return id, nil, nil
+	}
+	if c.Value == nil {
+		return id, nil, fmt.Errorf("ent: cursor does not match the ordering by %q", o.Field.field)
+	}
+	if value, err = o.Field.decode(c.Value); err != nil {
+		return id, nil, err
+	}
+	return id, value, nil
+}
+
+// cursorPredicate returns a predicate that matches the entities that come
+// after the cursor when the entities are ordered in the given direction.
+func (o *FileOrder) cursorPredicate(c *Cursor, direction OrderDirection) (predicate.File, error) {
+	id, value, err := o.decode(c)
+	if err != nil {
+		return nil, err
+	}
+	cmp := p.GT
+	if direction == OrderDirectionDesc {
+		cmp = p.LT
+	}
+	return func(t *dsl.Traversal) {
+		if o.Field == nil {
+			t.HasID(cmp(id))
+			return
+		}
+		t.Or(
+			__.Has(file.Label, o.Field.field, cmp(value)),
+			__.Has(file.Label, o.Field.field, p.EQ(value)).HasID(cmp(id)),
+		)
+	}, nil
+}
+
+// orderFunc returns the ordering function of the entities in the given direction.
+func (o *FileOrder) orderFunc(direction OrderDirection) OrderFunc {
+	order := dsl.Incr
+	if direction == OrderDirectionDesc {
+		order = dsl.Decr
+	}
+	return func(tr *dsl.Traversal) {
+		if o.Field != nil {
+			tr.By(o.Field.field, order)
+		}
+		tr.By(__.ID(), order)
+	}
+}
+
+// FilePage is a page of File entities returned by Paginate.
+type FilePage struct {
+	Nodes    []*File  `json:"nodes"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns a page of File entities using cursor-based
+// (keyset) pagination. The entities are ordered by the given order, or by their ID if it is nil.
+// The after and before cursors limit the page to the entities that come after/before them in
+// this order, and first/last limit the page to the first/last N entities. Note that Paginate
+// overrides the ordering and the limit of the query.
+//
+//	page, err := client.File.Query().
+//		Paginate(ctx, after, &first, nil, nil, &ent.FileOrder{Direction: ent.OrderDirectionAsc})
+//
+func (fq *FileQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *FileOrder) (*FilePage, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &FileOrder{Direction: OrderDirectionAsc}
+	}
+	if err := orderBy.Direction.Validate(); err != nil {
+		return nil, err
+	}
+	page := &FilePage{}
+	if first != nil && *first == 0 || last != nil && *last == 0 {
+		return page, nil
+	}
+	if after != nil {
+		p, err := orderBy.cursorPredicate(after, orderBy.Direction)
+		if err != nil {
+			return nil, err
+		}
+		fq.Where(p)
+	}
+	if before != nil {
+		p, err := orderBy.cursorPredicate(before, orderBy.Direction.reverse())
+		if err != nil {
+			return nil, err
+		}
+		fq.Where(p)
+	}
+	limit := -1
+	direction := orderBy.Direction
+	switch {
+	case first != nil:
+		limit = *first
+	case last != nil:
+		// Paginating backward queries the entities in the reverse
+		// order, and the page is reversed back after it is loaded.
+		limit = *last
+		direction = direction.reverse()
+	}
+	fq.order = []OrderFunc{orderBy.orderFunc(direction)}
+	if limit >= 0 {
+		// Query one extra entity to check if there is another page.
+		fq.Limit(limit + 1)
+	}
+	nodes, err := fq.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= 0 && len(nodes) > limit {
+		if first != nil {
+			page.PageInfo.HasNextPage = true
+		} else {
+			page.PageInfo.HasPreviousPage = true
+		}
+		nodes = nodes[:limit]
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	if len(nodes) > 0 {
+		page.PageInfo.StartCursor = orderBy.cursor(nodes[0])
+		page.PageInfo.EndCursor = orderBy.cursor(nodes[len(nodes)-1])
+	}
+	page.Nodes = nodes
+	return page, nil
+}
@@ -16,6 +16,7 @@ import (
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/g"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/p"
 	"entgo.io/ent/entc/integration/gremlin/ent/filetype"
 	"entgo.io/ent/entc/integration/gremlin/ent/predicate"
 )
@@ -28,6 +29,7 @@ type FileTypeQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.FileType
+	inters     []Interceptor
 	// eager-loading edges.
 	withFiles *FileQuery
 	// intermediate query (i.e. traversal path).
@@ -59,9 +61,51 @@ func (ftq *FileTypeQuery) Order(o ...OrderFunc) *FileTypeQuery {
 	return ftq
 }
 
+// Type returns the node type of this query (FileType). It implements the ent.Query interface.
+func (ftq *FileTypeQuery) Type() string {
+	return TypeFileType
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (ftq *FileTypeQuery) SetLimit(limit int) {
+	ftq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (ftq *FileTypeQuery) SetOffset(offset int) {
+	ftq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (ftq *FileTypeQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for FileTypeQuery", fn)
+		}
+		ftq.order = append(ftq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (ftq *FileTypeQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.FileType:
+			ftq.predicates = append(ftq.predicates, p)
+		case func(*dsl.Traversal):
+			ftq.predicates = append(ftq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for FileTypeQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryFiles chains the current query on the "files" edge.
 func (ftq *FileTypeQuery) QueryFiles() *FileQuery {
-	query := &FileQuery{config: ftq.config}
+	query := (&FileClient{config: ftq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
 		if err := ftq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -178,7 +222,22 @@ func (ftq *FileTypeQuery) All(ctx context.Context) ([]*FileType, error) {
 	if err := ftq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return ftq.gremlinAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*FileTypeQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinAll(ctx)
+	})
+	v, err := withInterceptors(ctx, ftq, qr, ftq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*FileType)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -213,7 +272,22 @@ func (ftq *FileTypeQuery) Count(ctx context.Context) (int, error) {
 	if err := ftq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return ftq.gremlinCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*FileTypeQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinCount(ctx)
+	})
+	v, err := withInterceptors(ctx, ftq, qr, ftq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -230,7 +304,22 @@ func (ftq *FileTypeQuery) Exist(ctx context.Context) (bool, error) {
 	if err := ftq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return ftq.gremlinExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*FileTypeQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinExist(ctx)
+	})
+	v, err := withInterceptors(ctx, ftq, qr, ftq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -254,6 +343,7 @@ func (ftq *FileTypeQuery) Clone() *FileTypeQuery {
 		offset:     ftq.offset,
 		order:      append([]OrderFunc{}, ftq.order...),
 		predicates: append([]predicate.FileType{}, ftq.predicates...),
+		inters:     append([]Interceptor{}, ftq.inters...),
 		withFiles:  ftq.withFiles.Clone(),
 		// clone intermediate query.
 		gremlin: ftq.gremlin.Clone(),
@@ -264,7 +354,7 @@ func (ftq *FileTypeQuery) Clone() *FileTypeQuery {
 // WithFiles tells the query-builder to eager-load the nodes that are connected to
 // the "files" edge. The optional arguments are used to configure the query builder of the edge.
 func (ftq *FileTypeQuery) WithFiles(opts ...func(*FileQuery)) *FileTypeQuery {
-	query := &FileQuery{config: ftq.config}
+	query := (&FileClient{config: ftq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -370,7 +460,7 @@ func (ftq *FileTypeQuery) gremlinExist(ctx context.Context) (bool, error) {
 	return res.ReadBool()
 }
 
-func (ftq *FileTypeQuery) gremlinQuery(context.Context) *dsl.Traversal {
+func (ftq *FileTypeQuery) gremlinQuery(ctx context.Context) *dsl.Traversal {
 	v := g.V().HasLabel(filetype.Label)
 	if ftq.gremlin != nil {
 		v = ftq.gremlin.Clone()
@@ -674,8 +764,12 @@ func (fts *FileTypeSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := fts.prepareQuery(ctx); err != nil {
 		return err
 	}
-	fts.gremlin = fts.FileTypeQuery.gremlinQuery(ctx)
-	return fts.gremlinScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		fts.gremlin = fts.FileTypeQuery.gremlinQuery(ctx)
+		return v, fts.gremlinScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, fts.FileTypeQuery, qr, fts.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -904,3 +998,207 @@ func (fts *FileTypeSelect) gremlinScan(ctx context.Context, v interface{}) error
 	}
 	return vm.Decode(v)
 }
+
+// FileTypeOrderField defines a field that FileType entities can be ordered by in Paginate.
+type FileTypeOrderField struct {
+	field  string
+	value  func(*FileType) interface{}
+	decode func(interface{}) (interface{}, error)
+}
+
+// String returns the name of the ordering field.
+func (f *FileTypeOrderField) String() string {
+	return f.field
+}
+
+var (
+	// FileTypeOrderFieldName orders FileType entities by the "name" field.
+	FileTypeOrderFieldName = &FileTypeOrderField{
+		field: filetype.FieldName,
+		value: func(ft *FileType) interface{} {
+			return ft.Name
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v string
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+	// FileTypeOrderFieldType orders FileType entities by the "type" field.
+	FileTypeOrderFieldType = &FileTypeOrderField{
+		field: filetype.FieldType,
+		value: func(ft *FileType) interface{} {
+			return ft.Type
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v filetype.Type
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+	// FileTypeOrderFieldState orders FileType entities by the "state" field.
+	FileTypeOrderFieldState = &FileTypeOrderField{
+		field: filetype.FieldState,
+		value: func(ft *FileType) interface{} {
+			return ft.State
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v filetype.State
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+)
+
+// FileTypeOrder defines the ordering of FileType entities in Paginate.
+// Entities are ordered by the given field (if any) and then by their ID.
+type FileTypeOrder struct {
+	Direction OrderDirection
+	Field     *FileTypeOrderField
+}
+
+// cursor returns the cursor of the given entity in the ordering.
+func (o *FileTypeOrder) cursor(ft *FileType) *Cursor {
+	if o.Field == nil {
+		return &Cursor{ID: ft.ID}
+	}
+	return &Cursor{ID: ft.ID, Value: o.Field.value(ft)}
+}
+
+// decode returns the typed ID and ordering value of the given cursor.
+func (o *FileTypeOrder) decode(c *Cursor) (id string, value interface{}, err error) {
+	if err := decodeCursorValue(c.ID, &id); err != nil {
+		return id, nil, err
+	}
+	if o.Field == nil {
+		return id, nil, nil
+	}
+	if c.Value == nil {
+		return id, nil, fmt.Errorf("ent: cursor does not match the ordering by %q", o.Field.field)
+	}
+	if value, err = o.Field.decode(c.Value); err != nil {
+		return id, nil, err
+	}
+	return id, value, nil
+}
+
+// cursorPredicate returns a predicate that matches the entities that come
+// after the cursor when the entities are ordered in the given direction.
+func (o *FileTypeOrder) cursorPredicate(c *Cursor, direction OrderDirection) (predicate.FileType, error) {
+	id, value, err := o.decode(c)
+	if err != nil {
+		return nil, err
+	}
+	cmp := p.GT
+	if direction == OrderDirectionDesc {
+		cmp = p.LT
+	}
+	return func(t *dsl.Traversal) {
+		if o.Field == nil {
+			t.HasID(cmp(id))
+			return
+		}
+		t.Or(
+			__.Has(filetype.Label, o.Field.field, cmp(value)),
+			__.Has(filetype.Label, o.Field.field, p.EQ(value)).HasID(cmp(id)),
+		)
+	}, nil
+}
+
+// orderFunc returns the ordering function of the entities in the given direction.
+func (o *FileTypeOrder) orderFunc(direction OrderDirection) OrderFunc {
+	order := dsl.Incr
+	if direction == OrderDirectionDesc {
+		order = dsl.Decr
+	}
+	return func(tr *dsl.Traversal) {
+		if o.Field != nil {
+			tr.By(o.Field.field, order)
+		}
+		tr.By(__.ID(), order)
+	}
+}
+
+// FileTypePage is a page of FileType entities returned by Paginate.
+type FileTypePage struct {
+	Nodes    []*FileType `json:"nodes"`
+	PageInfo PageInfo    `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns a page of FileType entities using cursor-based
+// (keyset) pagination. The entities are ordered by the given order, or by their ID if it is nil.
+// The after and before cursors limit the page to the entities that come after/before them in
+// this order, and first/last limit the page to the first/last N entities. Note that Paginate
+// overrides the ordering and the limit of the query.
+//
+//	page, err := client.FileType.Query().
+//		Paginate(ctx, after, &first, nil, nil, &ent.FileTypeOrder{Direction: ent.OrderDirectionAsc})
+//
+func (ftq *FileTypeQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *FileTypeOrder) (*FileTypePage, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &FileTypeOrder{Direction: OrderDirectionAsc}
+	}
+	if err := orderBy.Direction.Validate(); err != nil {
+		return nil, err
+	}
+	page := &FileTypePage{}
+	if first != nil && *first == 0 || last != nil && *last == 0 {
+		return page, nil
+	}
+	if after != nil {
+		p, err := orderBy.cursorPredicate(after, orderBy.Direction)
+		if err != nil {
+			return nil, err
+		}
+		ftq.Where(p)
+	}
+	if before != nil {
+		p, err := orderBy.cursorPredicate(before, orderBy.Direction.reverse())
+		if err != nil {
+			return nil, err
+		}
+		ftq.Where(p)
+	}
+	limit := -1
+	direction := orderBy.Direction
+	switch {
+	case first != nil:
+		limit = *first
+	case last != nil:
+		// Paginating backward queries the entities in the reverse
+		// order, and the page is reversed back after it is loaded.
+		limit = *last
+		direction = direction.reverse()
+	}
+	ftq.order = []OrderFunc{orderBy.orderFunc(direction)}
+	if limit >= 0 {
+		// Query one extra entity to check if there is another page.
+		ftq.Limit(limit + 1)
+	}
+	nodes, err := ftq.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= 0 && len(nodes) > limit {
+		if first != nil {
+			page.PageInfo.HasNextPage = true
+		} else {
+			page.PageInfo.HasPreviousPage = true
+		}
+		nodes = nodes[:limit]
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	if len(nodes) > 0 {
+		page.PageInfo.StartCursor = orderBy.cursor(nodes[0])
+		page.PageInfo.EndCursor = orderBy.cursor(nodes[len(nodes)-1])
+	}
+	page.Nodes = nodes
+	return page, nil
+}
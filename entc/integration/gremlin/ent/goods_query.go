@@ -16,6 +16,7 @@ import (
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/g"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/p"
 	"entgo.io/ent/entc/integration/gremlin/ent/goods"
 	"entgo.io/ent/entc/integration/gremlin/ent/predicate"
 )
@@ -28,6 +29,7 @@ type GoodsQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Goods
+	inters     []Interceptor
 	// intermediate query (i.e. traversal path).
 	gremlin *dsl.Traversal
 	path    func(context.Context) (*dsl.Traversal, error)
@@ -57,6 +59,48 @@ func (gq *GoodsQuery) Order(o ...OrderFunc) *GoodsQuery {
 	return gq
 }
 
+// Type returns the node type of this query (Goods). It implements the ent.Query interface.
+func (gq *GoodsQuery) Type() string {
+	return TypeGoods
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (gq *GoodsQuery) SetLimit(limit int) {
+	gq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (gq *GoodsQuery) SetOffset(offset int) {
+	gq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (gq *GoodsQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for GoodsQuery", fn)
+		}
+		gq.order = append(gq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (gq *GoodsQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Goods:
+			gq.predicates = append(gq.predicates, p)
+		case func(*dsl.Traversal):
+			gq.predicates = append(gq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for GoodsQuery", p)
+		}
+	}
+	return nil
+}
+
 // First returns the first Goods entity from the query.
 // Returns a *NotFoundError when no Goods was found.
 func (gq *GoodsQuery) First(ctx context.Context) (*Goods, error) {
@@ -162,7 +206,22 @@ func (gq *GoodsQuery) All(ctx context.Context) ([]*Goods, error) {
 	if err := gq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return gq.gremlinAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*GoodsQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinAll(ctx)
+	})
+	v, err := withInterceptors(ctx, gq, qr, gq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Goods)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -197,7 +256,22 @@ func (gq *GoodsQuery) Count(ctx context.Context) (int, error) {
 	if err := gq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return gq.gremlinCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*GoodsQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinCount(ctx)
+	})
+	v, err := withInterceptors(ctx, gq, qr, gq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -214,7 +288,22 @@ func (gq *GoodsQuery) Exist(ctx context.Context) (bool, error) {
 	if err := gq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return gq.gremlinExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*GoodsQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinExist(ctx)
+	})
+	v, err := withInterceptors(ctx, gq, qr, gq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -238,6 +327,7 @@ func (gq *GoodsQuery) Clone() *GoodsQuery {
 		offset:     gq.offset,
 		order:      append([]OrderFunc{}, gq.order...),
 		predicates: append([]predicate.Goods{}, gq.predicates...),
+		inters:     append([]Interceptor{}, gq.inters...),
 		// clone intermediate query.
 		gremlin: gq.gremlin.Clone(),
 		path:    gq.path,
@@ -318,7 +408,7 @@ func (gq *GoodsQuery) gremlinExist(ctx context.Context) (bool, error) {
 	return res.ReadBool()
 }
 
-func (gq *GoodsQuery) gremlinQuery(context.Context) *dsl.Traversal {
+func (gq *GoodsQuery) gremlinQuery(ctx context.Context) *dsl.Traversal {
 	v := g.V().HasLabel(goods.Label)
 	if gq.gremlin != nil {
 		v = gq.gremlin.Clone()
@@ -622,8 +712,12 @@ func (gs *GoodsSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := gs.prepareQuery(ctx); err != nil {
 		return err
 	}
-	gs.gremlin = gs.GoodsQuery.gremlinQuery(ctx)
-	return gs.gremlinScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		gs.gremlin = gs.GoodsQuery.gremlinQuery(ctx)
+		return v, gs.gremlinScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, gs.GoodsQuery, qr, gs.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -852,3 +946,170 @@ func (gs *GoodsSelect) gremlinScan(ctx context.Context, v interface{}) error {
 	}
 	return vm.Decode(v)
 }
+
+// GoodsOrderField defines a field that Goods entities can be ordered by in Paginate.
+type GoodsOrderField struct {
+	field  string
+	value  func(*Goods) interface{}
+	decode func(interface{}) (interface{}, error)
+}
+
+// String returns the name of the ordering field.
+func (f *GoodsOrderField) String() string {
+	return f.field
+}
+
+var ()
+
+// GoodsOrder defines the ordering of Goods entities in Paginate.
+// Entities are ordered by the given field (if any) and then by their ID.
+type GoodsOrder struct {
+	Direction OrderDirection
+	Field     *GoodsOrderField
+}
+
+// cursor returns the cursor of the given entity in the ordering.
+func (o *GoodsOrder) cursor(_go *Goods) *Cursor {
+	if o.Field == nil {
+		return &Cursor{ID: _go.ID}
+	}
+	return &Cursor{ID: _go.ID, Value: o.Field.value(_go)}
+}
+
+// decode returns the typed ID and ordering value of the given cursor.
+func (o *GoodsOrder) decode(c *Cursor) (id string, value interface{}, err error) {
+	if err := decodeCursorValue(c.ID, &id); err != nil {
+		return id, nil, err
+	}
+	if o.Field == nil {
+		return id, nil, nil
+	}
+	if c.Value == nil {
+		return id, nil, fmt.Errorf("ent: cursor does not match the ordering by %q", o.Field.field)
+	}
+	if value, err = o.Field.decode(c.Value); err != nil {
+		return id, nil, err
+	}
+	return id, value, nil
+}
+
+// cursorPredicate returns a predicate that matches the entities that come
+// after the cursor when the entities are ordered in the given direction.
+func (o *GoodsOrder) cursorPredicate(c *Cursor, direction OrderDirection) (predicate.Goods, error) {
+	id, value, err := o.decode(c)
+	if err != nil {
+		return nil, err
+	}
+	cmp := p.GT
+	if direction == OrderDirectionDesc {
+		cmp = p.LT
+	}
+	return func(t *dsl.Traversal) {
+		if o.Field == nil {
+			t.HasID(cmp(id))
+			return
+		}
+		t.Or(
+			__.Has(goods.Label, o.Field.field, cmp(value)),
+			__.Has(goods.Label, o.Field.field, p.EQ(value)).HasID(cmp(id)),
+		)
+	}, nil
+}
+
+// orderFunc returns the ordering function of the entities in the given direction.
+func (o *GoodsOrder) orderFunc(direction OrderDirection) OrderFunc {
+	order := dsl.Incr
+	if direction == OrderDirectionDesc {
+		order = dsl.Decr
+	}
+	return func(tr *dsl.Traversal) {
+		if o.Field != nil {
+			tr.By(o.Field.field, order)
+		}
+		tr.By(__.ID(), order)
+	}
+}
+
+// GoodsPage is a page of Goods entities returned by Paginate.
+type GoodsPage struct {
+	Nodes    []*Goods `json:"nodes"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns a page of Goods entities using cursor-based
+// (keyset) pagination. The entities are ordered by the given order, or by their ID if it is nil.
+// The after and before cursors limit the page to the entities that come after/before them in
+// this order, and first/last limit the page to the first/last N entities. Note that Paginate
+// overrides the ordering and the limit of the query.
+//
+//	page, err := client.Goods.Query().
+//		Paginate(ctx, after, &first, nil, nil, &ent.GoodsOrder{Direction: ent.OrderDirectionAsc})
+//
+func (gq *GoodsQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *GoodsOrder) (*GoodsPage, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &GoodsOrder{Direction: OrderDirectionAsc}
+	}
+	if err := orderBy.Direction.Validate(); err != nil {
+		return nil, err
+	}
+	page := &GoodsPage{}
+	if first != nil && *first == 0 || last != nil && *last == 0 {
+		return page, nil
+	}
+	if after != nil {
+		p, err := orderBy.cursorPredicate(after, orderBy.Direction)
+		if err != nil {
+			return nil, err
+		}
+		gq.Where(p)
+	}
+	if before != nil {
+		p, err := orderBy.cursorPredicate(before, orderBy.Direction.reverse())
+		if err != nil {
+			return nil, err
+		}
+		gq.Where(p)
+	}
+	limit := -1
+	direction := orderBy.Direction
+	switch {
+	case first != nil:
+		limit = *first
+	case last != nil:
+		// Paginating backward queries the entities in the reverse
+		// order, and the page is reversed back after it is loaded.
+		limit = *last
+		direction = direction.reverse()
+	}
+	gq.order = []OrderFunc{orderBy.orderFunc(direction)}
+	if limit >= 0 {
+		// Query one extra entity to check if there is another page.
+		gq.Limit(limit + 1)
+	}
+	nodes, err := gq.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= 0 && len(nodes) > limit {
+		if first != nil {
+			page.PageInfo.HasNextPage = true
+		} else {
+			page.PageInfo.HasPreviousPage = true
+		}
+		nodes = nodes[:limit]
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	if len(nodes) > 0 {
+		page.PageInfo.StartCursor = orderBy.cursor(nodes[0])
+		page.PageInfo.EndCursor = orderBy.cursor(nodes[len(nodes)-1])
+	}
+	page.Nodes = nodes
+	return page, nil
+}
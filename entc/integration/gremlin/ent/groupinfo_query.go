@@ -16,6 +16,7 @@ import (
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/g"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/p"
 	"entgo.io/ent/entc/integration/gremlin/ent/group"
 	"entgo.io/ent/entc/integration/gremlin/ent/groupinfo"
 	"entgo.io/ent/entc/integration/gremlin/ent/predicate"
@@ -29,6 +30,7 @@ type GroupInfoQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.GroupInfo
+	inters     []Interceptor
 	// eager-loading edges.
 	withGroups *GroupQuery
 	// intermediate query (i.e. traversal path).
@@ -60,9 +62,51 @@ func (giq *GroupInfoQuery) Order(o ...OrderFunc) *GroupInfoQuery {
 	return giq
 }
 
+// Type returns the node type of this query (GroupInfo). It implements the ent.Query interface.
+func (giq *GroupInfoQuery) Type() string {
+	return TypeGroupInfo
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (giq *GroupInfoQuery) SetLimit(limit int) {
+	giq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (giq *GroupInfoQuery) SetOffset(offset int) {
+	giq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (giq *GroupInfoQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for GroupInfoQuery", fn)
+		}
+		giq.order = append(giq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (giq *GroupInfoQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.GroupInfo:
+			giq.predicates = append(giq.predicates, p)
+		case func(*dsl.Traversal):
+			giq.predicates = append(giq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for GroupInfoQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryGroups chains the current query on the "groups" edge.
 func (giq *GroupInfoQuery) QueryGroups() *GroupQuery {
-	query := &GroupQuery{config: giq.config}
+	query := (&GroupClient{config: giq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
 		if err := giq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -179,7 +223,22 @@ func (giq *GroupInfoQuery) All(ctx context.Context) ([]*GroupInfo, error) {
 	if err := giq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return giq.gremlinAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*GroupInfoQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinAll(ctx)
+	})
+	v, err := withInterceptors(ctx, giq, qr, giq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*GroupInfo)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -214,7 +273,22 @@ func (giq *GroupInfoQuery) Count(ctx context.Context) (int, error) {
 	if err := giq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return giq.gremlinCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*GroupInfoQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinCount(ctx)
+	})
+	v, err := withInterceptors(ctx, giq, qr, giq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -231,7 +305,22 @@ func (giq *GroupInfoQuery) Exist(ctx context.Context) (bool, error) {
 	if err := giq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return giq.gremlinExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*GroupInfoQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinExist(ctx)
+	})
+	v, err := withInterceptors(ctx, giq, qr, giq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -255,6 +344,7 @@ func (giq *GroupInfoQuery) Clone() *GroupInfoQuery {
 		offset:     giq.offset,
 		order:      append([]OrderFunc{}, giq.order...),
 		predicates: append([]predicate.GroupInfo{}, giq.predicates...),
+		inters:     append([]Interceptor{}, giq.inters...),
 		withGroups: giq.withGroups.Clone(),
 		// clone intermediate query.
 		gremlin: giq.gremlin.Clone(),
@@ -265,7 +355,7 @@ func (giq *GroupInfoQuery) Clone() *GroupInfoQuery {
 // WithGroups tells the query-builder to eager-load the nodes that are connected to
 // the "groups" edge. The optional arguments are used to configure the query builder of the edge.
 func (giq *GroupInfoQuery) WithGroups(opts ...func(*GroupQuery)) *GroupInfoQuery {
-	query := &GroupQuery{config: giq.config}
+	query := (&GroupClient{config: giq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -371,7 +461,7 @@ func (giq *GroupInfoQuery) gremlinExist(ctx context.Context) (bool, error) {
 	return res.ReadBool()
 }
 
-func (giq *GroupInfoQuery) gremlinQuery(context.Context) *dsl.Traversal {
+func (giq *GroupInfoQuery) gremlinQuery(ctx context.Context) *dsl.Traversal {
 	v := g.V().HasLabel(groupinfo.Label)
 	if giq.gremlin != nil {
 		v = giq.gremlin.Clone()
@@ -675,8 +765,12 @@ func (gis *GroupInfoSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := gis.prepareQuery(ctx); err != nil {
 		return err
 	}
-	gis.gremlin = gis.GroupInfoQuery.gremlinQuery(ctx)
-	return gis.gremlinScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		gis.gremlin = gis.GroupInfoQuery.gremlinQuery(ctx)
+		return v, gis.gremlinScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, gis.GroupInfoQuery, qr, gis.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -905,3 +999,195 @@ func (gis *GroupInfoSelect) gremlinScan(ctx context.Context, v interface{}) erro
 	}
 	return vm.Decode(v)
 }
+
+// GroupInfoOrderField defines a field that GroupInfo entities can be ordered by in Paginate.
+type GroupInfoOrderField struct {
+	field  string
+	value  func(*GroupInfo) interface{}
+	decode func(interface{}) (interface{}, error)
+}
+
+// String returns the name of the ordering field.
+func (f *GroupInfoOrderField) String() string {
+	return f.field
+}
+
+var (
+	// GroupInfoOrderFieldDesc orders GroupInfo entities by the "desc" field.
+	GroupInfoOrderFieldDesc = &GroupInfoOrderField{
+		field: groupinfo.FieldDesc,
+		value: func(gi *GroupInfo) interface{} {
+			return gi.Desc
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v string
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+	// GroupInfoOrderFieldMaxUsers orders GroupInfo entities by the "max_users" field.
+	GroupInfoOrderFieldMaxUsers = &GroupInfoOrderField{
+		field: groupinfo.FieldMaxUsers,
+		value: func(gi *GroupInfo) interface{} {
+			return gi.MaxUsers
+		},
+		decode: func(value interface{}) (interface{}, error) {
+			var v int
+			err := decodeCursorValue(value, &v)
+			return v, err
+		},
+	}
+)
+
+// GroupInfoOrder defines the ordering of GroupInfo entities in Paginate.
+// Entities are ordered by the given field (if any) and then by their ID.
+type GroupInfoOrder struct {
+	Direction OrderDirection
+	Field     *GroupInfoOrderField
+}
+
+// cursor returns the cursor of the given entity in the ordering.
+func (o *GroupInfoOrder) cursor(gi *GroupInfo) *Cursor {
+	if o.Field == nil {
+		return &Cursor{ID: gi.ID}
+	}
+	return &Cursor{ID: gi.ID, Value: o.Field.value(gi)}
+}
+
+// decode returns the typed ID and ordering value of the given cursor.
+func (o *GroupInfoOrder) decode(c *Cursor) (id string, value interface{}, err error) {
+	if err := decodeCursorValue(c.ID, &id); err != nil {
+		return id, nil, err
+	}
+	if o.Field == nil {
+		return id, nil, nil
+	}
+	if c.Value == nil {
+		return id, nil, fmt.Errorf("ent: cursor does not match the ordering by %q", o.Field.field)
+	}
+	if value, err = o.Field.decode(c.Value); err != nil {
+		return id, nil, err
+	}
+	return id, value, nil
+}
+
+// cursorPredicate returns a predicate that matches the entities that come
+// after the cursor when the entities are ordered in the given direction.
+func (o *GroupInfoOrder) cursorPredicate(c *Cursor, direction OrderDirection) (predicate.GroupInfo, error) {
+	id, value, err := o.decode(c)
+	if err != nil {
+		return nil, err
+	}
+	cmp := p.GT
+	if direction == OrderDirectionDesc {
+		cmp = p.LT
+	}
+	return func(t *dsl.Traversal) {
+		if o.Field == nil {
+			t.HasID(cmp(id))
+			return
+		}
+		t.Or(
+			__.Has(groupinfo.Label, o.Field.field, cmp(value)),
+			__.Has(groupinfo.Label, o.Field.field, p.EQ(value)).HasID(cmp(id)),
+		)
+	}, nil
+}
+
+// orderFunc returns the ordering function of the entities in the given direction.
+func (o *GroupInfoOrder) orderFunc(direction OrderDirection) OrderFunc {
+	order := dsl.Incr
+	if direction == OrderDirectionDesc {
+		order = dsl.Decr
+	}
+	return func(tr *dsl.Traversal) {
+		if o.Field != nil {
+			tr.By(o.Field.field, order)
+		}
+		tr.By(__.ID(), order)
+	}
+}
+
+// GroupInfoPage is a page of GroupInfo entities returned by Paginate.
+type GroupInfoPage struct {
+	Nodes    []*GroupInfo `json:"nodes"`
+	PageInfo PageInfo     `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns a page of GroupInfo entities using cursor-based
+// (keyset) pagination. The entities are ordered by the given order, or by their ID if it is nil.
+// The after and before cursors limit the page to the entities that come after/before them in
+// this order, and first/last limit the page to the first/last N entities. Note that Paginate
+// overrides the ordering and the limit of the query.
+//
+//	page, err := client.GroupInfo.Query().
+//		Paginate(ctx, after, &first, nil, nil, &ent.GroupInfoOrder{Direction: ent.OrderDirectionAsc})
+//
+func (giq *GroupInfoQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *GroupInfoOrder) (*GroupInfoPage, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &GroupInfoOrder{Direction: OrderDirectionAsc}
+	}
+	if err := orderBy.Direction.Validate(); err != nil {
+		return nil, err
+	}
+	page := &GroupInfoPage{}
+	if first != nil && *first == 0 || last != nil && *last == 0 {
+		return page, nil
+	}
+	if after != nil {
+		p, err := orderBy.cursorPredicate(after, orderBy.Direction)
+		if err != nil {
+			return nil, err
+		}
+		giq.Where(p)
+	}
+	if before != nil {
+		p, err := orderBy.cursorPredicate(before, orderBy.Direction.reverse())
+		if err != nil {
+			return nil, err
+		}
+		giq.Where(p)
+	}
+	limit := -1
+	direction := orderBy.Direction
+	switch {
+	case first != nil:
+		limit = *first
+	case last != nil:
+		// Paginating backward queries the entities in the reverse
+		// order, and the page is reversed back after it is loaded.
+		limit = *last
+		direction = direction.reverse()
+	}
+	giq.order = []OrderFunc{orderBy.orderFunc(direction)}
+	if limit >= 0 {
+		// Query one extra entity to check if there is another page.
+		giq.Limit(limit + 1)
+	}
+	nodes, err := giq.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= 0 && len(nodes) > limit {
+		if first != nil {
+			page.PageInfo.HasNextPage = true
+		} else {
+			page.PageInfo.HasPreviousPage = true
+		}
+		nodes = nodes[:limit]
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	if len(nodes) > 0 {
+		page.PageInfo.StartCursor = orderBy.cursor(nodes[0])
+		page.PageInfo.EndCursor = orderBy.cursor(nodes[len(nodes)-1])
+	}
+	page.Nodes = nodes
+	return page, nil
+}
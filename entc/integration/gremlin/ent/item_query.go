@@ -16,6 +16,7 @@ import (
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/g"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/p"
 	"entgo.io/ent/entc/integration/gremlin/ent/item"
 	"entgo.io/ent/entc/integration/gremlin/ent/predicate"
 )
@@ -28,6 +29,7 @@ type ItemQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Item
+	inters     []Interceptor
 	// intermediate query (i.e. traversal path).
 	gremlin *dsl.Traversal
 	path    func(context.Context) (*dsl.Traversal, error)
@@ -57,6 +59,48 @@ func (iq *ItemQuery) Order(o ...OrderFunc) *ItemQuery {
 	return iq
 }
 
+// Type returns the node type of this query (Item). It implements the ent.Query interface.
+func (iq *ItemQuery) Type() string {
+	return TypeItem
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (iq *ItemQuery) SetLimit(limit int) {
+	iq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (iq *ItemQuery) SetOffset(offset int) {
+	iq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (iq *ItemQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for ItemQuery", fn)
+		}
+		iq.order = append(iq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (iq *ItemQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Item:
+			iq.predicates = append(iq.predicates, p)
+		case func(*dsl.Traversal):
+			iq.predicates = append(iq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for ItemQuery", p)
+		}
+	}
+	return nil
+}
+
 // First returns the first Item entity from the query.
 // Returns a *NotFoundError when no Item was found.
 func (iq *ItemQuery) First(ctx context.Context) (*Item, error) {
@@ -162,7 +206,22 @@ func (iq *ItemQuery) All(ctx context.Context) ([]*Item, error) {
 	if err := iq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return iq.gremlinAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*ItemQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinAll(ctx)
+	})
+	v, err := withInterceptors(ctx, iq, qr, iq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Item)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -197,7 +256,22 @@ func (iq *ItemQuery) Count(ctx context.Context) (int, error) {
 	if err := iq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return iq.gremlinCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*ItemQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinCount(ctx)
+	})
+	v, err := withInterceptors(ctx, iq, qr, iq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -214,7 +288,22 @@ func (iq *ItemQuery) Exist(ctx context.Context) (bool, error) {
 	if err := iq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return iq.gremlinExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*ItemQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinExist(ctx)
+	})
+	v, err := withInterceptors(ctx, iq, qr, iq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -238,6 +327,7 @@ func (iq *ItemQuery) Clone() *ItemQuery {
 		offset:     iq.offset,
 		order:      append([]OrderFunc{}, iq.order...),
 		predicates: append([]predicate.Item{}, iq.predicates...),
+		inters:     append([]Interceptor{}, iq.inters...),
 		// clone intermediate query.
 		gremlin: iq.gremlin.Clone(),
 		path:    iq.path,
@@ -318,7 +408,7 @@ func (iq *ItemQuery) gremlinExist(ctx context.Context) (bool, error) {
 	return res.ReadBool()
 }
 
-func (iq *ItemQuery) gremlinQuery(context.Context) *dsl.Traversal {
+func (iq *ItemQuery) gremlinQuery(ctx context.Context) *dsl.Traversal {
 	v := g.V().HasLabel(item.Label)
 	if iq.gremlin != nil {
 		v = iq.gremlin.Clone()
@@ -622,8 +712,12 @@ func (is *ItemSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := is.prepareQuery(ctx); err != nil {
 		return err
 	}
-	is.gremlin = is.ItemQuery.gremlinQuery(ctx)
-	return is.gremlinScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		is.gremlin = is.ItemQuery.gremlinQuery(ctx)
+		return v, is.gremlinScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, is.ItemQuery, qr, is.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -852,3 +946,170 @@ func (is *ItemSelect) gremlinScan(ctx context.Context, v interface{}) error {
 	}
 	return vm.Decode(v)
 }
+
+// ItemOrderField defines a field that Item entities can be ordered by in Paginate.
+type ItemOrderField struct {
+	field  string
+	value  func(*Item) interface{}
+	decode func(interface{}) (interface{}, error)
+}
+
+// String returns the name of the ordering field.
+func (f *ItemOrderField) String() string {
+	return f.field
+}
+
+var ()
+
+// ItemOrder defines the ordering of Item entities in Paginate.
+// Entities are ordered by the given field (if any) and then by their ID.
+type ItemOrder struct {
+	Direction OrderDirection
+	Field     *ItemOrderField
+}
+
+// cursor returns the cursor of the given entity in the ordering.
+func (o *ItemOrder) cursor(i *Item) *Cursor {
+	if o.Field == nil {
+		return &Cursor{ID: i.ID}
+	}
+	return &Cursor{ID: i.ID, Value: o.Field.value(i)}
+}
+
+// decode returns the typed ID and ordering value of the given cursor.
+func (o *ItemOrder) decode(c *Cursor) (id string, value interface{}, err error) {
+	if err := decodeCursorValue(c.ID, &id); err != nil {
+		return id, nil, err
+	}
+	if o.Field == nil {
+		return id, nil, nil
+	}
+	if c.Value == nil {
+		return id, nil, fmt.Errorf("ent: cursor does not match the ordering by %q", o.Field.field)
+	}
+	if value, err = o.Field.decode(c.Value); err != nil {
+		return id, nil, err
+	}
+	return id, value, nil
+}
+
+// cursorPredicate returns a predicate that matches the entities that come
+// after the cursor when the entities are ordered in the given direction.
+func (o *ItemOrder) cursorPredicate(c *Cursor, direction OrderDirection) (predicate.Item, error) {
+	id, value, err := o.decode(c)
+	if err != nil {
+		return nil, err
+	}
+	cmp := p.GT
+	if direction == OrderDirectionDesc {
+		cmp = p.LT
+	}
+	return func(t *dsl.Traversal) {
+		if o.Field == nil {
+			t.HasID(cmp(id))
+			return
+		}
+		t.Or(
+			__.Has(item.Label, o.Field.field, cmp(value)),
+			__.Has(item.Label, o.Field.field, p.EQ(value)).HasID(cmp(id)),
+		)
+	}, nil
+}
+
+// orderFunc returns the ordering function of the entities in the given direction.
+func (o *ItemOrder) orderFunc(direction OrderDirection) OrderFunc {
+	order := dsl.Incr
+	if direction == OrderDirectionDesc {
+		order = dsl.Decr
+	}
+	return func(tr *dsl.Traversal) {
+		if o.Field != nil {
+			tr.By(o.Field.field, order)
+		}
+		tr.By(__.ID(), order)
+	}
+}
+
+// ItemPage is a page of Item entities returned by Paginate.
+type ItemPage struct {
+	Nodes    []*Item  `json:"nodes"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns a page of Item entities using cursor-based
+// (keyset) pagination. The entities are ordered by the given order, or by their ID if it is nil.
+// The after and before cursors limit the page to the entities that come after/before them in
+// this order, and first/last limit the page to the first/last N entities. Note that Paginate
+// overrides the ordering and the limit of the query.
+//
+//	page, err := client.Item.Query().
+//		Paginate(ctx, after, &first, nil, nil, &ent.ItemOrder{Direction: ent.OrderDirectionAsc})
+//
+func (iq *ItemQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *ItemOrder) (*ItemPage, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &ItemOrder{Direction: OrderDirectionAsc}
+	}
+	if err := orderBy.Direction.Validate(); err != nil {
+		return nil, err
+	}
+	page := &ItemPage{}
+	if first != nil && *first == 0 || last != nil && *last == 0 {
+		return page, nil
+	}
+	if after != nil {
+		p, err := orderBy.cursorPredicate(after, orderBy.Direction)
+		if err != nil {
+			return nil, err
+		}
+		iq.Where(p)
+	}
+	if before != nil {
+		p, err := orderBy.cursorPredicate(before, orderBy.Direction.reverse())
+		if err != nil {
+			return nil, err
+		}
+		iq.Where(p)
+	}
+	limit := -1
+	direction := orderBy.Direction
+	switch {
+	case first != nil:
+		limit = *first
+	case last != nil:
+		// Paginating backward queries the entities in the reverse
+		// order, and the page is reversed back after it is loaded.
+		limit = *last
+		direction = direction.reverse()
+	}
+	iq.order = []OrderFunc{orderBy.orderFunc(direction)}
+	if limit >= 0 {
+		// Query one extra entity to check if there is another page.
+		iq.Limit(limit + 1)
+	}
+	nodes, err := iq.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= 0 && len(nodes) > limit {
+		if first != nil {
+			page.PageInfo.HasNextPage = true
+		} else {
+			page.PageInfo.HasPreviousPage = true
+		}
+		nodes = nodes[:limit]
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	if len(nodes) > 0 {
+		page.PageInfo.StartCursor = orderBy.cursor(nodes[0])
+		page.PageInfo.EndCursor = orderBy.cursor(nodes[len(nodes)-1])
+	}
+	page.Nodes = nodes
+	return page, nil
+}
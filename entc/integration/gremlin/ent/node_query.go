@@ -16,6 +16,7 @@ import (
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/g"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/p"
 	"entgo.io/ent/entc/integration/gremlin/ent/node"
 	"entgo.io/ent/entc/integration/gremlin/ent/predicate"
 )
@@ -28,6 +29,7 @@ type NodeQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Node
+	inters     []Interceptor
 	// eager-loading edges.
 	withPrev *NodeQuery
 	withNext *NodeQuery
@@ -60,9 +62,51 @@ func (nq *NodeQuery) Order(o ...OrderFunc) *NodeQuery {
 	return nq
 }
 
+// Type returns the node type of this query (Node). It implements the ent.Query interface.
+func (nq *NodeQuery) Type() string {
+	return TypeNode
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (nq *NodeQuery) SetLimit(limit int) {
+	nq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (nq *NodeQuery) SetOffset(offset int) {
+	nq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (nq *NodeQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for NodeQuery", fn)
+		}
+		nq.order = append(nq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (nq *NodeQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Node:
+			nq.predicates = append(nq.predicates, p)
+		case func(*dsl.Traversal):
+			nq.predicates = append(nq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for NodeQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryPrev chains the current query on the "prev" edge.
 func (nq *NodeQuery) QueryPrev() *NodeQuery {
-	query := &NodeQuery{config: nq.config}
+	query := (&NodeClient{config: nq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
 		if err := nq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -76,7 +120,7 @@ func (nq *NodeQuery) QueryPrev() *NodeQuery {
 
 // QueryNext chains the current query on the "next" edge.
 func (nq *NodeQuery) QueryNext() *NodeQuery {
-	query := &NodeQuery{config: nq.config}
+	query := (&NodeClient{config: nq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
 		if err := nq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -193,7 +237,22 @@ func (nq *NodeQuery) All(ctx context.Context) ([]*Node, error) {
 	if err := nq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return nq.gremlinAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*NodeQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinAll(ctx)
+	})
+	v, err := withInterceptors(ctx, nq, qr, nq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Node)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -228,7 +287,22 @@ func (nq *NodeQuery) Count(ctx context.Context) (int, error) {
 	if err := nq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return nq.gremlinCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*NodeQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinCount(ctx)
+	})
+	v, err := withInterceptors(ctx, nq, qr, nq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -245,7 +319,22 @@ func (nq *NodeQuery) Exist(ctx context.Context) (bool, error) {
 	if err := nq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return nq.gremlinExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*NodeQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinExist(ctx)
+	})
+	v, err := withInterceptors(ctx, nq, qr, nq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -269,6 +358,7 @@ func (nq *NodeQuery) Clone() *NodeQuery {
 		offset:     nq.offset,
 		order:      append([]OrderFunc{}, nq.order...),
 		predicates: append([]predicate.Node{}, nq.predicates...),
+		inters:     append([]Interceptor{}, nq.inters...),
 		withPrev:   nq.withPrev.Clone(),
 		withNext:   nq.withNext.Clone(),
 		// clone intermediate query.
@@ -280,7 +370,7 @@ func (nq *NodeQuery) Clone() *NodeQuery {
 // WithPrev tells the query-builder to eager-load the nodes that are connected to
 // the "prev" edge. The optional arguments are used to configure the query builder of the edge.
 func (nq *NodeQuery) WithPrev(opts ...func(*NodeQuery)) *NodeQuery {
-	query := &NodeQuery{config: nq.config}
+	query := (&NodeClient{config: nq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -291,7 +381,7 @@ func (nq *NodeQuery) WithPrev(opts ...func(*NodeQuery)) *NodeQuery {
 // WithNext tells the query-builder to eager-load the nodes that are connected to
 // the "next" edge. The optional arguments are used to configure the query builder of the edge.
 func (nq *NodeQuery) WithNext(opts ...func(*NodeQuery)) *NodeQuery {
-	query := &NodeQuery{config: nq.config}
+	query := (&NodeClient{config: nq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -397,7 +487,7 @@ func (nq *NodeQuery) gremlinExist(ctx context.Context) (bool, error) {
 	return res.ReadBool()
 }
 
-func (nq *NodeQuery) gremlinQuery(context.Context) *dsl.Traversal {
+func (nq *NodeQuery) gremlinQuery(ctx context.Context) *dsl.Traversal {
 	v := g.V().HasLabel(node.Label)
 	if nq.gremlin != nil {
 		v = nq.gremlin.Clone()
@@ -701,8 +791,12 @@ func (ns *NodeSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := ns.prepareQuery(ctx); err != nil {
 		return err
 	}
-	ns.gremlin = ns.NodeQuery.gremlinQuery(ctx)
-	return ns.gremlinScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		ns.gremlin = ns.NodeQuery.gremlinQuery(ctx)
+		return v, ns.gremlinScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, ns.NodeQuery, qr, ns.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -931,3 +1025,170 @@ func (ns *NodeSelect) gremlinScan(ctx context.Context, v interface{}) error {
 	}
 	return vm.Decode(v)
 }
+
+// NodeOrderField defines a field that Node entities can be ordered by in Paginate.
+type NodeOrderField struct {
+	field  string
+	value  func(*Node) interface{}
+	decode func(interface{}) (interface{}, error)
+}
+
+// String returns the name of the ordering field.
+func (f *NodeOrderField) String() string {
+	return f.field
+}
+
+var ()
+
+// NodeOrder defines the ordering of Node entities in Paginate.
+// Entities are ordered by the given field (if any) and then by their ID.
+type NodeOrder struct {
+	Direction OrderDirection
+	Field     *NodeOrderField
+}
+
+// cursor returns the cursor of the given entity in the ordering.
+func (o *NodeOrder) cursor(n *Node) *Cursor {
+	if o.Field == nil {
+		return &Cursor{ID: n.ID}
+	}
+	return &Cursor{ID: n.ID, Value: o.Field.value(n)}
+}
+
+// decode returns the typed ID and ordering value of the given cursor.
+func (o *NodeOrder) decode(c *Cursor) (id string, value interface{}, err error) {
+	if err := decodeCursorValue(c.ID, &id); err != nil {
+		return id, nil, err
+	}
+	if o.Field == nil {
+		return id, nil, nil
+	}
+	if c.Value == nil {
+		return id, nil, fmt.Errorf("ent: cursor does not match the ordering by %q", o.Field.field)
+	}
+	if value, err = o.Field.decode(c.Value); err != nil {
+		return id, nil, err
+	}
+	return id, value, nil
+}
+
+// cursorPredicate returns a predicate that matches the entities that come
+// after the cursor when the entities are ordered in the given direction.
+func (o *NodeOrder) cursorPredicate(c *Cursor, direction OrderDirection) (predicate.Node, error) {
+	id, value, err := o.decode(c)
+	if err != nil {
+		return nil, err
+	}
+	cmp := p.GT
+	if direction == OrderDirectionDesc {
+		cmp = p.LT
+	}
+	return func(t *dsl.Traversal) {
+		if o.Field == nil {
+			t.HasID(cmp(id))
+			return
+		}
+		t.Or(
+			__.Has(node.Label, o.Field.field, cmp(value)),
+			__.Has(node.Label, o.Field.field, p.EQ(value)).HasID(cmp(id)),
+		)
+	}, nil
+}
+
+// orderFunc returns the ordering function of the entities in the given direction.
+func (o *NodeOrder) orderFunc(direction OrderDirection) OrderFunc {
+	order := dsl.Incr
+	if direction == OrderDirectionDesc {
+		order = dsl.Decr
+	}
+	return func(tr *dsl.Traversal) {
+		if o.Field != nil {
+			tr.By(o.Field.field, order)
+		}
+		tr.By(__.ID(), order)
+	}
+}
+
+// NodePage is a page of Node entities returned by Paginate.
+type NodePage struct {
+	Nodes    []*Node  `json:"nodes"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns a page of Node entities using cursor-based
+// (keyset) pagination. The entities are ordered by the given order, or by their ID if it is nil.
+// The after and before cursors limit the page to the entities that come after/before them in
+// this order, and first/last limit the page to the first/last N entities. Note that Paginate
+// overrides the ordering and the limit of the query.
+//
+//	page, err := client.Node.Query().
+//		Paginate(ctx, after, &first, nil, nil, &ent.NodeOrder{Direction: ent.OrderDirectionAsc})
+//
+func (nq *NodeQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *NodeOrder) (*NodePage, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &NodeOrder{Direction: OrderDirectionAsc}
+	}
+	if err := orderBy.Direction.Validate(); err != nil {
+		return nil, err
+	}
+	page := &NodePage{}
+	if first != nil && *first == 0 || last != nil && *last == 0 {
+		return page, nil
+	}
+	if after != nil {
+		p, err := orderBy.cursorPredicate(after, orderBy.Direction)
+		if err != nil {
+			return nil, err
+		}
+		nq.Where(p)
+	}
+	if before != nil {
+		p, err := orderBy.cursorPredicate(before, orderBy.Direction.reverse())
+		if err != nil {
+			return nil, err
+		}
+		nq.Where(p)
+	}
+	limit := -1
+	direction := orderBy.Direction
+	switch {
+	case first != nil:
+		limit = *first
+	case last != nil:
+		// Paginating backward queries the entities in the reverse
+		// order, and the page is reversed back after it is loaded.
+		limit = *last
+		direction = direction.reverse()
+	}
+	nq.order = []OrderFunc{orderBy.orderFunc(direction)}
+	if limit >= 0 {
+		// Query one extra entity to check if there is another page.
+		nq.Limit(limit + 1)
+	}
+	nodes, err := nq.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= 0 && len(nodes) > limit {
+		if first != nil {
+			page.PageInfo.HasNextPage = true
+		} else {
+			page.PageInfo.HasPreviousPage = true
+		}
+		nodes = nodes[:limit]
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	if len(nodes) > 0 {
+		page.PageInfo.StartCursor = orderBy.cursor(nodes[0])
+		page.PageInfo.EndCursor = orderBy.cursor(nodes[len(nodes)-1])
+	}
+	page.Nodes = nodes
+	return page, nil
+}
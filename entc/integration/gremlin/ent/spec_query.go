@@ -16,6 +16,7 @@ import (
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/g"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/p"
 	"entgo.io/ent/entc/integration/gremlin/ent/predicate"
 	"entgo.io/ent/entc/integration/gremlin/ent/spec"
 )
@@ -28,6 +29,7 @@ type SpecQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Spec
+	inters     []Interceptor
 	// eager-loading edges.
 	withCard *CardQuery
 	// intermediate query (i.e. traversal path).
@@ -59,9 +61,51 @@ func (sq *SpecQuery) Order(o ...OrderFunc) *SpecQuery {
 	return sq
 }
 
+// Type returns the node type of this query (Spec). It implements the ent.Query interface.
+func (sq *SpecQuery) Type() string {
+	return TypeSpec
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (sq *SpecQuery) SetLimit(limit int) {
+	sq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (sq *SpecQuery) SetOffset(offset int) {
+	sq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (sq *SpecQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for SpecQuery", fn)
+		}
+		sq.order = append(sq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (sq *SpecQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Spec:
+			sq.predicates = append(sq.predicates, p)
+		case func(*dsl.Traversal):
+			sq.predicates = append(sq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for SpecQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryCard chains the current query on the "card" edge.
 func (sq *SpecQuery) QueryCard() *CardQuery {
-	query := &CardQuery{config: sq.config}
+	query := (&CardClient{config: sq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
 		if err := sq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -178,7 +222,22 @@ func (sq *SpecQuery) All(ctx context.Context) ([]*Spec, error) {
 	if err := sq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return sq.gremlinAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*SpecQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinAll(ctx)
+	})
+	v, err := withInterceptors(ctx, sq, qr, sq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Spec)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -213,7 +272,22 @@ func (sq *SpecQuery) Count(ctx context.Context) (int, error) {
 	if err := sq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return sq.gremlinCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*SpecQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinCount(ctx)
+	})
+	v, err := withInterceptors(ctx, sq, qr, sq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -230,7 +304,22 @@ func (sq *SpecQuery) Exist(ctx context.Context) (bool, error) {
 	if err := sq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return sq.gremlinExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*SpecQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinExist(ctx)
+	})
+	v, err := withInterceptors(ctx, sq, qr, sq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -254,6 +343,7 @@ func (sq *SpecQuery) Clone() *SpecQuery {
 		offset:     sq.offset,
 		order:      append([]OrderFunc{}, sq.order...),
 		predicates: append([]predicate.Spec{}, sq.predicates...),
+		inters:     append([]Interceptor{}, sq.inters...),
 		withCard:   sq.withCard.Clone(),
 		// clone intermediate query.
 		gremlin: sq.gremlin.Clone(),
@@ -264,7 +354,7 @@ func (sq *SpecQuery) Clone() *SpecQuery {
 // WithCard tells the query-builder to eager-load the nodes that are connected to
 // the "card" edge. The optional arguments are used to configure the query builder of the edge.
 func (sq *SpecQuery) WithCard(opts ...func(*CardQuery)) *SpecQuery {
-	query := &CardQuery{config: sq.config}
+	query := (&CardClient{config: sq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -346,7 +436,7 @@ func (sq *SpecQuery) gremlinExist(ctx context.Context) (bool, error) {
 	return res.ReadBool()
 }
 
-func (sq *SpecQuery) gremlinQuery(context.Context) *dsl.Traversal {
+func (sq *SpecQuery) gremlinQuery(ctx context.Context) *dsl.Traversal {
 	v := g.V().HasLabel(spec.Label)
 	if sq.gremlin != nil {
 		v = sq.gremlin.Clone()
@@ -650,8 +740,12 @@ func (ss *SpecSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := ss.prepareQuery(ctx); err != nil {
 		return err
 	}
-	ss.gremlin = ss.SpecQuery.gremlinQuery(ctx)
-	return ss.gremlinScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		ss.gremlin = ss.SpecQuery.gremlinQuery(ctx)
+		return v, ss.gremlinScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, ss.SpecQuery, qr, ss.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -880,3 +974,170 @@ func (ss *SpecSelect) gremlinScan(ctx context.Context, v interface{}) error {
 	}
 	return vm.Decode(v)
 }
+
+// SpecOrderField defines a field that Spec entities can be ordered by in Paginate.
+type SpecOrderField struct {
+	field  string
+	value  func(*Spec) interface{}
+	decode func(interface{}) (interface{}, error)
+}
+
+// String returns the name of the ordering field.
+func (f *SpecOrderField) String() string {
+	return f.field
+}
+
+var ()
+
+// SpecOrder defines the ordering of Spec entities in Paginate.
+// Entities are ordered by the given field (if any) and then by their ID.
+type SpecOrder struct {
+	Direction OrderDirection
+	Field     *SpecOrderField
+}
+
+// cursor returns the cursor of the given entity in the ordering.
+func (o *SpecOrder) cursor(s *Spec) *Cursor {
+	if o.Field == nil {
+		return &Cursor{ID: s.ID}
+	}
+	return &Cursor{ID: s.ID, Value: o.Field.value(s)}
+}
+
+// decode returns the typed ID and ordering value of the given cursor.
+func (o *SpecOrder) decode(c *Cursor) (id string, value interface{}, err error) {
+	if err := decodeCursorValue(c.ID, &id); err != nil {
+		return id, nil, err
+	}
+	if o.Field == nil {
+		return id, nil, nil
+	}
+	if c.Value == nil {
+		return id, nil, fmt.Errorf("ent: cursor does not match the ordering by %q", o.Field.field)
+	}
+	if value, err = o.Field.decode(c.Value); err != nil {
+		return id, nil, err
+	}
+	return id, value, nil
+}
+
+// cursorPredicate returns a predicate that matches the entities that come
+// after the cursor when the entities are ordered in the given direction.
+func (o *SpecOrder) cursorPredicate(c *Cursor, direction OrderDirection) (predicate.Spec, error) {
+	id, value, err := o.decode(c)
+	if err != nil {
+		return nil, err
+	}
+	cmp := p.GT
+	if direction == OrderDirectionDesc {
+		cmp = p.LT
+	}
+	return func(t *dsl.Traversal) {
+		if o.Field == nil {
+			t.HasID(cmp(id))
+			return
+		}
+		t.Or(
+			__.Has(spec.Label, o.Field.field, cmp(value)),
+			__.Has(spec.Label, o.Field.field, p.EQ(value)).HasID(cmp(id)),
+		)
+	}, nil
+}
+
+// orderFunc returns the ordering function of the entities in the given direction.
+func (o *SpecOrder) orderFunc(direction OrderDirection) OrderFunc {
+	order := dsl.Incr
+	if direction == OrderDirectionDesc {
+		order = dsl.Decr
+	}
+	return func(tr *dsl.Traversal) {
+		if o.Field != nil {
+			tr.By(o.Field.field, order)
+		}
+		tr.By(__.ID(), order)
+	}
+}
+
+// SpecPage is a page of Spec entities returned by Paginate.
+type SpecPage struct {
+	Nodes    []*Spec  `json:"nodes"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns a page of Spec entities using cursor-based
+// (keyset) pagination. The entities are ordered by the given order, or by their ID if it is nil.
+// The after and before cursors limit the page to the entities that come after/before them in
+// this order, and first/last limit the page to the first/last N entities. Note that Paginate
+// overrides the ordering and the limit of the query.
+//
+//	page, err := client.Spec.Query().
+//		Paginate(ctx, after, &first, nil, nil, &ent.SpecOrder{Direction: ent.OrderDirectionAsc})
+//
+func (sq *SpecQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *SpecOrder) (*SpecPage, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &SpecOrder{Direction: OrderDirectionAsc}
+	}
+	if err := orderBy.Direction.Validate(); err != nil {
+		return nil, err
+	}
+	page := &SpecPage{}
+	if first != nil && *first == 0 || last != nil && *last == 0 {
+		return page, nil
+	}
+	if after != nil {
+		p, err := orderBy.cursorPredicate(after, orderBy.Direction)
+		if err != nil {
+			return nil, err
+		}
+		sq.Where(p)
+	}
+	if before != nil {
+		p, err := orderBy.cursorPredicate(before, orderBy.Direction.reverse())
+		if err != nil {
+			return nil, err
+		}
+		sq.Where(p)
+	}
+	limit := -1
+	direction := orderBy.Direction
+	switch {
+	case first != nil:
+		limit = *first
+	case last != nil:
+		// Paginating backward queries the entities in the reverse
+		// order, and the page is reversed back after it is loaded.
+		limit = *last
+		direction = direction.reverse()
+	}
+	sq.order = []OrderFunc{orderBy.orderFunc(direction)}
+	if limit >= 0 {
+		// Query one extra entity to check if there is another page.
+		sq.Limit(limit + 1)
+	}
+	nodes, err := sq.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= 0 && len(nodes) > limit {
+		if first != nil {
+			page.PageInfo.HasNextPage = true
+		} else {
+			page.PageInfo.HasPreviousPage = true
+		}
+		nodes = nodes[:limit]
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	if len(nodes) > 0 {
+		page.PageInfo.StartCursor = orderBy.cursor(nodes[0])
+		page.PageInfo.EndCursor = orderBy.cursor(nodes[len(nodes)-1])
+	}
+	page.Nodes = nodes
+	return page, nil
+}
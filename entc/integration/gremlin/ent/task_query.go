@@ -16,6 +16,7 @@ import (
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
 	"entgo.io/ent/dialect/gremlin/graph/dsl/g"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/p"
 	"entgo.io/ent/entc/integration/gremlin/ent/predicate"
 	"entgo.io/ent/entc/integration/gremlin/ent/task"
 )
@@ -28,6 +29,7 @@ type TaskQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Task
+	inters     []Interceptor
 	// intermediate query (i.e. traversal path).
 	gremlin *dsl.Traversal
 	path    func(context.Context) (*dsl.Traversal, error)
@@ -57,6 +59,48 @@ func (tq *TaskQuery) Order(o ...OrderFunc) *TaskQuery {
 	return tq
 }
 
+// Type returns the node type of this query (Task). It implements the ent.Query interface.
+func (tq *TaskQuery) Type() string {
+	return TypeTask
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (tq *TaskQuery) SetLimit(limit int) {
+	tq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (tq *TaskQuery) SetOffset(offset int) {
+	tq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (tq *TaskQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for TaskQuery", fn)
+		}
+		tq.order = append(tq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (tq *TaskQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Task:
+			tq.predicates = append(tq.predicates, p)
+		case func(*dsl.Traversal):
+			tq.predicates = append(tq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for TaskQuery", p)
+		}
+	}
+	return nil
+}
+
 // First returns the first Task entity from the query.
 // Returns a *NotFoundError when no Task was found.
 func (tq *TaskQuery) First(ctx context.Context) (*Task, error) {
@@ -162,7 +206,22 @@ func (tq *TaskQuery) All(ctx context.Context) ([]*Task, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return tq.gremlinAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*TaskQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinAll(ctx)
+	})
+	v, err := withInterceptors(ctx, tq, qr, tq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Task)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -197,7 +256,22 @@ func (tq *TaskQuery) Count(ctx context.Context) (int, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return tq.gremlinCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*TaskQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinCount(ctx)
+	})
+	v, err := withInterceptors(ctx, tq, qr, tq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -214,7 +288,22 @@ func (tq *TaskQuery) Exist(ctx context.Context) (bool, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return tq.gremlinExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*TaskQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.gremlinExist(ctx)
+	})
+	v, err := withInterceptors(ctx, tq, qr, tq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -238,6 +327,7 @@ func (tq *TaskQuery) Clone() *TaskQuery {
 		offset:     tq.offset,
 		order:      append([]OrderFunc{}, tq.order...),
 		predicates: append([]predicate.Task{}, tq.predicates...),
+		inters:     append([]Interceptor{}, tq.inters...),
 		// clone intermediate query.
 		gremlin: tq.gremlin.Clone(),
 		path:    tq.path,
@@ -342,7 +432,7 @@ func (tq *TaskQuery) gremlinExist(ctx context.Context) (bool, error) {
 	return res.ReadBool()
 }
 
-func (tq *TaskQuery) gremlinQuery(context.Context) *dsl.Traversal {
+func (tq *TaskQuery) gremlinQuery(ctx context.Context) *dsl.Traversal {
 	v := g.V().HasLabel(task.Label)
 	if tq.gremlin != nil {
 		v = tq.gremlin.Clone()
@@ -646,8 +736,12 @@ func (ts *TaskSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := ts.prepareQuery(ctx); err != nil {
 		return err
 	}
-	ts.gremlin = ts.TaskQuery.gremlinQuery(ctx)
-	return ts.gremlinScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		ts.gremlin = ts.TaskQuery.gremlinQuery(ctx)
+		return v, ts.gremlinScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, ts.TaskQuery, qr, ts.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -876,3 +970,170 @@ func (ts *TaskSelect) gremlinScan(ctx context.Context, v interface{}) error {
 	}
 	return vm.Decode(v)
 }
+
+// TaskOrderField defines a field that Task entities can be ordered by in Paginate.
+type TaskOrderField struct {
+	field  string
+	value  func(*Task) interface{}
+	decode func(interface{}) (interface{}, error)
+}
+
+// String returns the name of the ordering field.
+func (f *TaskOrderField) String() string {
+	return f.field
+}
+
+var ()
+
+// TaskOrder defines the ordering of Task entities in Paginate.
+// Entities are ordered by the given field (if any) and then by their ID.
+type TaskOrder struct {
+	Direction OrderDirection
+	Field     *TaskOrderField
+}
+
+// cursor returns the cursor of the given entity in the ordering.
+func (o *TaskOrder) cursor(t *Task) *Cursor {
+	if o.Field == nil {
+		return &Cursor{ID: t.ID}
+	}
+	return &Cursor{ID: t.ID, Value: o.Field.value(t)}
+}
+
+// decode returns the typed ID and ordering value of the given cursor.
+func (o *TaskOrder) decode(c *Cursor) (id string, value interface{}, err error) {
+	if err := decodeCursorValue(c.ID, &id); err != nil {
+		return id, nil, err
+	}
+	if o.Field == nil {
+		return id, nil, nil
+	}
+	if c.Value == nil {
+		return id, nil, fmt.Errorf("ent: cursor does not match the ordering by %q", o.Field.field)
+	}
+	if value, err = o.Field.decode(c.Value); err != nil {
+		return id, nil, err
+	}
+	return id, value, nil
+}
+
+// cursorPredicate returns a predicate that matches the entities that come
+// after the cursor when the entities are ordered in the given direction.
+func (o *TaskOrder) cursorPredicate(c *Cursor, direction OrderDirection) (predicate.Task, error) {
+	id, value, err := o.decode(c)
+	if err != nil {
+		return nil, err
+	}
+	cmp := p.GT
+	if direction == OrderDirectionDesc {
+		cmp = p.LT
+	}
+	return func(t *dsl.Traversal) {
+		if o.Field == nil {
+			t.HasID(cmp(id))
+			return
+		}
+		t.Or(
+			__.Has(task.Label, o.Field.field, cmp(value)),
+			__.Has(task.Label, o.Field.field, p.EQ(value)).HasID(cmp(id)),
+		)
+	}, nil
+}
+
+// orderFunc returns the ordering function of the entities in the given direction.
+func (o *TaskOrder) orderFunc(direction OrderDirection) OrderFunc {
+	order := dsl.Incr
+	if direction == OrderDirectionDesc {
+		order = dsl.Decr
+	}
+	return func(tr *dsl.Traversal) {
+		if o.Field != nil {
+			tr.By(o.Field.field, order)
+		}
+		tr.By(__.ID(), order)
+	}
+}
+
+// TaskPage is a page of Task entities returned by Paginate.
+type TaskPage struct {
+	Nodes    []*Task  `json:"nodes"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Paginate executes the query and returns a page of Task entities using cursor-based
+// (keyset) pagination. The entities are ordered by the given order, or by their ID if it is nil.
+// The after and before cursors limit the page to the entities that come after/before them in
+// this order, and first/last limit the page to the first/last N entities. Note that Paginate
+// overrides the ordering and the limit of the query.
+//
+//	page, err := client.Task.Query().
+//		Paginate(ctx, after, &first, nil, nil, &ent.TaskOrder{Direction: ent.OrderDirectionAsc})
+//
+func (tq *TaskQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *TaskOrder) (*TaskPage, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	if orderBy == nil {
+		orderBy = &TaskOrder{Direction: OrderDirectionAsc}
+	}
+	if err := orderBy.Direction.Validate(); err != nil {
+		return nil, err
+	}
+	page := &TaskPage{}
+	if first != nil && *first == 0 || last != nil && *last == 0 {
+		return page, nil
+	}
+	if after != nil {
+		p, err := orderBy.cursorPredicate(after, orderBy.Direction)
+		if err != nil {
+			return nil, err
+		}
+		tq.Where(p)
+	}
+	if before != nil {
+		p, err := orderBy.cursorPredicate(before, orderBy.Direction.reverse())
+		if err != nil {
+			return nil, err
+		}
+		tq.Where(p)
+	}
+	limit := -1
+	direction := orderBy.Direction
+	switch {
+	case first != nil:
+		limit = *first
+	case last != nil:
+		// Paginating backward queries the entities in the reverse
+		// order, and the page is reversed back after it is loaded.
+		limit = *last
+		direction = direction.reverse()
+	}
+	tq.order = []OrderFunc{orderBy.orderFunc(direction)}
+	if limit >= 0 {
+		// Query one extra entity to check if there is another page.
+		tq.Limit(limit + 1)
+	}
+	nodes, err := tq.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= 0 && len(nodes) > limit {
+		if first != nil {
+			page.PageInfo.HasNextPage = true
+		} else {
+			page.PageInfo.HasPreviousPage = true
+		}
+		nodes = nodes[:limit]
+	}
+	if last != nil {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	if len(nodes) > 0 {
+		page.PageInfo.StartCursor = orderBy.cursor(nodes[0])
+		page.PageInfo.EndCursor = orderBy.cursor(nodes[len(nodes)-1])
+	}
+	page.Nodes = nodes
+	return page, nil
+}
@@ -53,6 +53,7 @@ var tests = []func(*testing.T, *ent.Client){
 	Clone,
 	Sanity,
 	Paging,
+	CursorPaging,
 	Select,
 	Delete,
 	Relation,
@@ -201,6 +202,52 @@ func Paging(t *testing.T, client *ent.Client) {
 	}
 }
 
+func CursorPaging(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	for i := 1; i <= 10; i++ {
+		client.User.Create().SetName(fmt.Sprintf("name-%d", i)).SetAge(i % 5).SaveX(ctx)
+	}
+	first, last := 4, 3
+
+	t.Log("paginate forward by id")
+	var ids []string
+	var after *ent.Cursor
+	for {
+		page, err := client.User.Query().Paginate(ctx, after, &first, nil, nil, nil)
+		require.NoError(err)
+		for _, u := range page.Nodes {
+			ids = append(ids, u.ID)
+		}
+		if !page.PageInfo.HasNextPage {
+			require.Len(page.Nodes, 2)
+			break
+		}
+		require.Len(page.Nodes, first)
+		after = page.PageInfo.EndCursor
+	}
+	require.Equal(client.User.Query().Order(ent.Asc(user.FieldID)).IDsX(ctx), ids)
+
+	t.Log("paginate backward by age")
+	order := &ent.UserOrder{Direction: ent.OrderDirectionDesc, Field: ent.UserOrderFieldAge}
+	page, err := client.User.Query().Paginate(ctx, nil, nil, nil, &last, order)
+	require.NoError(err)
+	require.True(page.PageInfo.HasPreviousPage)
+	require.Len(page.Nodes, last)
+	require.Equal([]int{1, 0, 0}, []int{page.Nodes[0].Age, page.Nodes[1].Age, page.Nodes[2].Age})
+
+	t.Log("cursors are opaque and versioned")
+	text, err := page.PageInfo.StartCursor.MarshalText()
+	require.NoError(err)
+	cursor := &ent.Cursor{}
+	require.NoError(cursor.UnmarshalText(text))
+	prev, err := client.User.Query().Paginate(ctx, nil, nil, cursor, &last, order)
+	require.NoError(err)
+	require.Equal([]int{2, 2, 1}, []int{prev.Nodes[0].Age, prev.Nodes[1].Age, prev.Nodes[2].Age})
+	_, err = client.User.Query().Paginate(ctx, nil, &first, nil, &last, nil)
+	require.Error(err)
+}
+
 func Select(t *testing.T, client *ent.Client) {
 	ctx := context.Background()
 	require := require.New(t)
@@ -0,0 +1,71 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/entproto"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// User holds the schema definition for the User entity.
+type User struct {
+	ent.Schema
+}
+
+// Annotations of the User.
+func (User) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Service(),
+	}
+}
+
+// Fields of the User.
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Annotations(entproto.Field(2)),
+		field.Int("age").
+			Optional().
+			Annotations(entproto.Field(3)),
+		field.String("nickname").
+			Optional().
+			Nillable().
+			Annotations(entproto.Field(4)),
+		field.Enum("status").
+			Values("active", "suspended").
+			Default("active").
+			Annotations(
+				entproto.Field(5),
+				entproto.Enum(map[string]int32{
+					"active":    1,
+					"suspended": 2,
+				}),
+			),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Annotations(entproto.Field(6)),
+		field.JSON("tags", []string{}).
+			Optional().
+			Annotations(entproto.Skip()),
+	}
+}
+
+// Edges of the User.
+func (User) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("pets", Pet.Type).
+			Annotations(entproto.Field(7)),
+		edge.To("best_friend", User.Type).
+			Unique().
+			Annotations(entproto.Field(8)),
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"entgo.io/ent/entc/integration/grpc/ent"
+	"entgo.io/ent/entc/integration/grpc/ent/proto/entpb"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestUserService(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	entpb.RegisterUserServiceServer(server, entpb.NewUserService(client))
+	go server.Serve(lis)
+	defer server.Stop()
+	conn, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+	svc := entpb.NewUserServiceClient(conn)
+
+	// Create.
+	nati, err := svc.Create(ctx, &entpb.CreateUserRequest{User: &entpb.User{Name: "nati"}})
+	require.NoError(t, err)
+	a8m, err := svc.Create(ctx, &entpb.CreateUserRequest{User: &entpb.User{
+		Name:       "a8m",
+		Age:        30,
+		Nickname:   proto.String("ariel"),
+		BestFriend: &entpb.User{Id: nati.Id},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, "a8m", a8m.Name)
+	require.Equal(t, int64(30), a8m.Age)
+	require.Equal(t, "ariel", a8m.GetNickname())
+	require.Equal(t, entpb.User_STATUS_ACTIVE, a8m.Status, "default values are set by the builder")
+	require.NotNil(t, a8m.CreatedAt)
+	require.Nil(t, a8m.BestFriend, "edges are not loaded on creation")
+	pedro := client.Pet.Create().SetName("pedro").SetOwnerID(int(a8m.Id)).SaveX(ctx)
+
+	// Get.
+	got, err := svc.Get(ctx, &entpb.GetUserRequest{Id: a8m.Id})
+	require.NoError(t, err)
+	require.Equal(t, nati.Id, got.BestFriend.GetId())
+	require.Len(t, got.Pets, 1)
+	require.Equal(t, int64(pedro.ID), got.Pets[0].Id)
+	_, err = svc.Get(ctx, &entpb.GetUserRequest{Id: 1000})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Update.
+	got.Nickname = nil
+	got.Status = entpb.User_STATUS_SUSPENDED
+	got.BestFriend = nil
+	got, err = svc.Update(ctx, &entpb.UpdateUserRequest{User: got})
+	require.NoError(t, err)
+	require.Nil(t, got.Nickname)
+	require.Equal(t, entpb.User_STATUS_SUSPENDED, got.Status)
+	require.False(t, client.User.GetX(ctx, int(a8m.Id)).QueryBestFriend().ExistX(ctx))
+	require.Equal(t, 1, client.User.GetX(ctx, int(a8m.Id)).QueryPets().CountX(ctx), "pets are kept")
+	_, err = svc.Update(ctx, &entpb.UpdateUserRequest{User: &entpb.User{Id: nati.Id}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err), "name must not be empty")
+	_, err = svc.Update(ctx, &entpb.UpdateUserRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Delete.
+	_, err = svc.Delete(ctx, &entpb.DeleteUserRequest{Id: nati.Id})
+	require.NoError(t, err)
+	_, err = svc.Delete(ctx, &entpb.DeleteUserRequest{Id: nati.Id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,517 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package pethistory
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/entc/integration/history/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// HistoryTime applies equality check predicate on the "history_time" field. It's identical to HistoryTimeEQ.
+func HistoryTime(v time.Time) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHistoryTime), v))
+	})
+}
+
+// Ref applies equality check predicate on the "ref" field. It's identical to RefEQ.
+func Ref(v int) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRef), v))
+	})
+}
+
+// Actor applies equality check predicate on the "actor" field. It's identical to ActorEQ.
+func Actor(v string) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActor), v))
+	})
+}
+
+// HistoryTimeEQ applies the EQ predicate on the "history_time" field.
+func HistoryTimeEQ(v time.Time) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHistoryTime), v))
+	})
+}
+
+// HistoryTimeNEQ applies the NEQ predicate on the "history_time" field.
+func HistoryTimeNEQ(v time.Time) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldHistoryTime), v))
+	})
+}
+
+// HistoryTimeIn applies the In predicate on the "history_time" field.
+func HistoryTimeIn(vs ...time.Time) predicate.PetHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PetHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldHistoryTime), v...))
+	})
+}
+
+// HistoryTimeNotIn applies the NotIn predicate on the "history_time" field.
+func HistoryTimeNotIn(vs ...time.Time) predicate.PetHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PetHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldHistoryTime), v...))
+	})
+}
+
+// HistoryTimeGT applies the GT predicate on the "history_time" field.
+func HistoryTimeGT(v time.Time) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldHistoryTime), v))
+	})
+}
+
+// HistoryTimeGTE applies the GTE predicate on the "history_time" field.
+func HistoryTimeGTE(v time.Time) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldHistoryTime), v))
+	})
+}
+
+// HistoryTimeLT applies the LT predicate on the "history_time" field.
+func HistoryTimeLT(v time.Time) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldHistoryTime), v))
+	})
+}
+
+// HistoryTimeLTE applies the LTE predicate on the "history_time" field.
+func HistoryTimeLTE(v time.Time) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldHistoryTime), v))
+	})
+}
+
+// OperationEQ applies the EQ predicate on the "operation" field.
+func OperationEQ(v Operation) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOperation), v))
+	})
+}
+
+// OperationNEQ applies the NEQ predicate on the "operation" field.
+func OperationNEQ(v Operation) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOperation), v))
+	})
+}
+
+// OperationIn applies the In predicate on the "operation" field.
+func OperationIn(vs ...Operation) predicate.PetHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PetHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldOperation), v...))
+	})
+}
+
+// OperationNotIn applies the NotIn predicate on the "operation" field.
+func OperationNotIn(vs ...Operation) predicate.PetHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PetHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldOperation), v...))
+	})
+}
+
+// RefEQ applies the EQ predicate on the "ref" field.
+func RefEQ(v int) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRef), v))
+	})
+}
+
+// RefNEQ applies the NEQ predicate on the "ref" field.
+func RefNEQ(v int) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRef), v))
+	})
+}
+
+// RefIn applies the In predicate on the "ref" field.
+func RefIn(vs ...int) predicate.PetHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PetHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRef), v...))
+	})
+}
+
+// RefNotIn applies the NotIn predicate on the "ref" field.
+func RefNotIn(vs ...int) predicate.PetHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PetHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRef), v...))
+	})
+}
+
+// RefGT applies the GT predicate on the "ref" field.
+func RefGT(v int) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRef), v))
+	})
+}
+
+// RefGTE applies the GTE predicate on the "ref" field.
+func RefGTE(v int) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRef), v))
+	})
+}
+
+// RefLT applies the LT predicate on the "ref" field.
+func RefLT(v int) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRef), v))
+	})
+}
+
+// RefLTE applies the LTE predicate on the "ref" field.
+func RefLTE(v int) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRef), v))
+	})
+}
+
+// ActorEQ applies the EQ predicate on the "actor" field.
+func ActorEQ(v string) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActor), v))
+	})
+}
+
+// ActorNEQ applies the NEQ predicate on the "actor" field.
+func ActorNEQ(v string) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldActor), v))
+	})
+}
+
+// ActorIn applies the In predicate on the "actor" field.
+func ActorIn(vs ...string) predicate.PetHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PetHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldActor), v...))
+	})
+}
+
+// ActorNotIn applies the NotIn predicate on the "actor" field.
+func ActorNotIn(vs ...string) predicate.PetHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PetHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldActor), v...))
+	})
+}
+
+// ActorGT applies the GT predicate on the "actor" field.
+func ActorGT(v string) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldActor), v))
+	})
+}
+
+// ActorGTE applies the GTE predicate on the "actor" field.
+func ActorGTE(v string) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldActor), v))
+	})
+}
+
+// ActorLT applies the LT predicate on the "actor" field.
+func ActorLT(v string) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldActor), v))
+	})
+}
+
+// ActorLTE applies the LTE predicate on the "actor" field.
+func ActorLTE(v string) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldActor), v))
+	})
+}
+
+// ActorContains applies the Contains predicate on the "actor" field.
+func ActorContains(v string) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldActor), v))
+	})
+}
+
+// ActorHasPrefix applies the HasPrefix predicate on the "actor" field.
+func ActorHasPrefix(v string) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldActor), v))
+	})
+}
+
+// ActorHasSuffix applies the HasSuffix predicate on the "actor" field.
+func ActorHasSuffix(v string) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldActor), v))
+	})
+}
+
+// ActorIsNil applies the IsNil predicate on the "actor" field.
+func ActorIsNil() predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldActor)))
+	})
+}
+
+// ActorNotNil applies the NotNil predicate on the "actor" field.
+func ActorNotNil() predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldActor)))
+	})
+}
+
+// ActorEqualFold applies the EqualFold predicate on the "actor" field.
+func ActorEqualFold(v string) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldActor), v))
+	})
+}
+
+// ActorContainsFold applies the ContainsFold predicate on the "actor" field.
+func ActorContainsFold(v string) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldActor), v))
+	})
+}
+
+// OldValuesIsNil applies the IsNil predicate on the "old_values" field.
+func OldValuesIsNil() predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldOldValues)))
+	})
+}
+
+// OldValuesNotNil applies the NotNil predicate on the "old_values" field.
+func OldValuesNotNil() predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldOldValues)))
+	})
+}
+
+// NewValuesIsNil applies the IsNil predicate on the "new_values" field.
+func NewValuesIsNil() predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldNewValues)))
+	})
+}
+
+// NewValuesNotNil applies the NotNil predicate on the "new_values" field.
+func NewValuesNotNil() predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldNewValues)))
+	})
+}
+
+// EdgeChangesIsNil applies the IsNil predicate on the "edge_changes" field.
+func EdgeChangesIsNil() predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEdgeChanges)))
+	})
+}
+
+// EdgeChangesNotNil applies the NotNil predicate on the "edge_changes" field.
+func EdgeChangesNotNil() predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEdgeChanges)))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PetHistory) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PetHistory) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PetHistory) predicate.PetHistory {
+	return predicate.PetHistory(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,166 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"entgo.io/ent/entc/integration/history/ent"
+	"entgo.io/ent/entc/integration/history/ent/pet"
+	"entgo.io/ent/entc/integration/history/ent/pethistory"
+	"entgo.io/ent/entc/integration/history/ent/user"
+	"entgo.io/ent/entc/integration/history/ent/userhistory"
+	"entgo.io/ent/enthistory"
+	"entgo.io/ent/schema/mixin"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistory(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := enthistory.NewContext(context.Background(), "admin")
+	require.NoError(t, client.Schema.Create(ctx))
+
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SetPassword("secret").SaveX(ctx)
+	a8m = a8m.Update().SetName("Ariel").ClearAge().SetRole(user.RoleAdmin).SaveX(context.Background())
+	records := a8m.QueryHistory().AllX(ctx)
+	require.Len(t, records, 2)
+	require.Equal(t, userhistory.OperationCreate, records[0].Operation)
+	require.Equal(t, a8m.ID, records[0].Ref)
+	require.Equal(t, "admin", records[0].Actor)
+	require.Nil(t, records[0].OldValues)
+	require.Equal(t, "a8m", values(t, records[0].NewValues)["name"])
+	require.NotContains(t, values(t, records[0].NewValues), "password", "sensitive fields are not recorded")
+	require.Equal(t, userhistory.OperationUpdate, records[1].Operation)
+	require.Empty(t, records[1].Actor, "context without an actor")
+	require.Equal(t, "a8m", values(t, records[1].OldValues)["name"])
+	require.Equal(t, "Ariel", values(t, records[1].NewValues)["name"])
+	require.False(t, records[1].HistoryTime.Before(records[0].HistoryTime))
+
+	// Restore the first version.
+	a8m, err = client.User.Restore(ctx, records[0])
+	require.NoError(t, err)
+	require.Equal(t, "a8m", a8m.Name)
+	require.Equal(t, 30, *a8m.Age)
+	require.Equal(t, user.RoleUser, a8m.Role)
+	require.Equal(t, "secret", a8m.Password, "sensitive fields are not restored")
+	require.Equal(t, 3, a8m.QueryHistory().CountX(ctx), "restoring is recorded")
+
+	// Edge changes.
+	pedro := client.Pet.Create().SetName("pedro").SaveX(ctx)
+	client.User.UpdateOne(a8m).AddPets(pedro).ExecX(ctx)
+	last := a8m.QueryHistory().AllX(ctx)[3]
+	require.Equal(t, []ent.Value{float64(pedro.ID)}, last.EdgeChanges[user.EdgePets].Added)
+	require.Equal(t, 1, pedro.QueryHistory().CountX(ctx), "changes of the inverse edge are recorded only on the mutated type")
+	client.Pet.UpdateOne(pedro).SetName("Pedro").ClearOwner().ExecX(ctx)
+	pets := pedro.QueryHistory().AllX(ctx)
+	require.Len(t, pets, 2)
+	require.True(t, pets[1].EdgeChanges[pet.EdgeOwner].Cleared)
+	require.EqualValues(t, a8m.ID, values(t, pets[1].OldValues)["owner_id"])
+	pedro, err = client.Pet.Restore(ctx, pets[0])
+	require.NoError(t, err)
+	require.Equal(t, "pedro", pedro.Name)
+	require.Zero(t, pedro.OwnerID)
+	require.False(t, pedro.QueryOwner().ExistX(ctx))
+
+	// Soft-deleted entities can be restored.
+	client.Pet.DeleteOne(pedro).ExecX(ctx)
+	pets = pedro.QueryHistory().AllX(ctx)
+	require.Len(t, pets, 4, "soft-deletion is recorded once, as a deletion")
+	deleted := pets[3]
+	require.Equal(t, pethistory.OperationDelete, deleted.Operation)
+	require.Nil(t, values(t, deleted.OldValues)["deleted_at"])
+	pedro, err = client.Pet.Restore(ctx, deleted)
+	require.NoError(t, err)
+	require.Nil(t, pedro.DeletedAt)
+	require.Equal(t, "pedro", client.Pet.GetX(ctx, pedro.ID).Name)
+	require.Equal(t, pethistory.OperationUpdate, pedro.QueryHistory().AllX(ctx)[len(pets)].Operation)
+
+	// Bulk updates and deletions.
+	nati := client.User.Create().SetName("nati").SaveX(ctx)
+	n := client.User.Update().Where(user.IDIn(a8m.ID, nati.ID)).AddAge(1).SaveX(ctx)
+	require.Equal(t, 2, n)
+	updated := client.UserHistory.Query().Order(ent.Desc(userhistory.FieldID)).Limit(2).AllX(ctx)
+	require.ElementsMatch(t, []int{a8m.ID, nati.ID}, []int{updated[0].Ref, updated[1].Ref})
+	require.Equal(t, updated[0].HistoryTime, updated[1].HistoryTime)
+	require.EqualValues(t, 31, values(t, updated[1].NewValues)["age"])
+	client.User.DeleteOne(nati).ExecX(ctx)
+	records = client.User.QueryHistory(nati.ID).AllX(ctx)
+	require.Len(t, records, 3, "history of deleted entities is kept")
+	require.Equal(t, userhistory.OperationDelete, records[2].Operation)
+	require.Nil(t, records[2].NewValues)
+	require.Equal(t, "nati", values(t, records[2].OldValues)["name"])
+	_, err = client.User.Restore(ctx, records[2])
+	require.True(t, ent.IsNotFound(err), "deleted entities cannot be restored")
+
+	// History is recorded in the same transaction.
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	tx.User.UpdateOneID(a8m.ID).SetName("rollback").ExecX(ctx)
+	require.Equal(t, 6, tx.User.QueryHistory(a8m.ID).CountX(ctx))
+	require.NoError(t, tx.Rollback())
+	require.Equal(t, 5, client.User.QueryHistory(a8m.ID).CountX(ctx))
+	err = client.WithTx(ctx, func(tx *ent.Tx) error {
+		tx.User.UpdateOneID(a8m.ID).SetName("rollback").ExecX(ctx)
+		return errors.New("failed")
+	})
+	require.EqualError(t, err, "failed")
+	require.Equal(t, 5, client.User.QueryHistory(a8m.ID).CountX(ctx))
+}
+
+func TestHistoryRollback(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:rollback?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	a8m := client.User.Create().SetName("a8m").SaveX(ctx)
+	pedro := client.Pet.Create().SetName("pedro").SetOwner(a8m).SaveX(ctx)
+	client.UserHistory.Use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(context.Context, ent.Mutation) (ent.Value, error) {
+			return nil, errors.New("unavailable")
+		})
+	})
+	client.PetHistory.Use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(context.Context, ent.Mutation) (ent.Value, error) {
+			return nil, errors.New("unavailable")
+		})
+	})
+
+	// Mutations are rolled back if their history cannot be recorded.
+	_, err = client.User.Create().SetName("nati").Save(ctx)
+	require.EqualError(t, err, "ent: recording User history: unavailable")
+	require.False(t, client.User.Query().Where(user.Name("nati")).ExistX(ctx))
+	_, err = client.User.CreateBulk(client.User.Create().SetName("nati"), client.User.Create().SetName("alex")).Save(ctx)
+	require.Error(t, err)
+	require.Equal(t, 1, client.User.Query().CountX(ctx))
+	err = client.User.UpdateOne(a8m).SetName("Ariel").Exec(ctx)
+	require.Error(t, err)
+	require.Equal(t, "a8m", client.User.GetX(ctx, a8m.ID).Name)
+	_, err = client.User.Update().SetAge(30).Save(ctx)
+	require.Error(t, err)
+	require.Nil(t, client.User.GetX(ctx, a8m.ID).Age)
+	err = client.Pet.DeleteOne(pedro).Exec(ctx)
+	require.Error(t, err)
+	require.Nil(t, client.Pet.GetX(ctx, pedro.ID).DeletedAt, "soft-deletion is rolled back")
+	_, err = client.Pet.Delete().Exec(mixin.HardDelete(ctx))
+	require.Error(t, err)
+	require.True(t, client.Pet.Query().Where(pet.ID(pedro.ID)).ExistX(ctx))
+	require.Equal(t, 1, client.UserHistory.Query().CountX(ctx))
+	require.Equal(t, 1, client.PetHistory.Query().CountX(ctx))
+}
+
+func values(t *testing.T, b json.RawMessage) map[string]interface{} {
+	var v map[string]interface{}
+	require.NoError(t, json.Unmarshal(b, &v))
+	return v
+}
@@ -15,7 +15,7 @@ import (
 )
 
 // The init function reads all schema descriptors with runtime code
-// (default values, validators, hooks and policies) and stitches it
+// (default values, validators, hooks, interceptors and policies) and stitches it
 // to their package variables.
 func init() {
 	cardMixin := schema.Card{}.Mixin()
@@ -24,6 +24,8 @@ func init() {
 	card.Hooks[0] = cardMixinHooks0[0]
 	card.Hooks[1] = cardHooks[0]
 	card.Hooks[2] = cardHooks[1]
+	cardInters := schema.Card{}.Interceptors()
+	card.Interceptors[0] = cardInters[0]
 	cardFields := schema.Card{}.Fields()
 	_ = cardFields
 	// cardDescNumber is the schema descriptor for number field.
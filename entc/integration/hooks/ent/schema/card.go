@@ -13,6 +13,7 @@ import (
 	gen "entgo.io/ent/entc/integration/hooks/ent"
 	"entgo.io/ent/entc/integration/hooks/ent/card"
 	"entgo.io/ent/entc/integration/hooks/ent/hook"
+	"entgo.io/ent/entc/integration/hooks/ent/user"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/mixin"
@@ -71,6 +72,30 @@ func (Card) Hooks() []ent.Hook {
 	}
 }
 
+// ownerCtxKey is the context key for the card owner.
+type ownerCtxKey struct{}
+
+// NewOwnerContext returns a new context that scopes the card queries to the given owner.
+func NewOwnerContext(ctx context.Context, id int) context.Context {
+	return context.WithValue(ctx, ownerCtxKey{}, id)
+}
+
+func (Card) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		func(next ent.Querier) ent.Querier {
+			return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+				// Interceptor that scopes the query to the owner stored in the context (if any).
+				if id, ok := ctx.Value(ownerCtxKey{}).(int); ok {
+					if err := q.AddWhere(card.HasOwnerWith(user.ID(id))); err != nil {
+						return nil, err
+					}
+				}
+				return next.Query(ctx, q)
+			})
+		},
+	}
+}
+
 func (Card) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("number").
@@ -15,6 +15,7 @@ import (
 	"entgo.io/ent/entc/integration/hooks/ent/enttest"
 	"entgo.io/ent/entc/integration/hooks/ent/hook"
 	"entgo.io/ent/entc/integration/hooks/ent/migrate"
+	"entgo.io/ent/entc/integration/hooks/ent/schema"
 	"entgo.io/ent/entc/integration/hooks/ent/user"
 
 	_ "github.com/mattn/go-sqlite3"
@@ -239,6 +240,70 @@ func TestOldValues(t *testing.T) {
 	require.Equal(t, "Ariel", a8m.Name)
 }
 
+func TestInterceptors(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)))
+	defer client.Close()
+	a8m := client.User.Create().SetName("a8m").SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SaveX(ctx)
+	client.Card.Create().SetNumber("1234").SetOwner(a8m).SaveX(ctx)
+	client.Card.Create().SetNumber("5678").SetOwner(nati).SaveX(ctx)
+
+	// Schema interceptors.
+	require.Equal(t, 2, client.Card.Query().CountX(ctx))
+	octx := schema.NewOwnerContext(ctx, a8m.ID)
+	require.Equal(t, 1, client.Card.Query().CountX(octx))
+	require.Equal(t, "1234", client.Card.Query().OnlyX(octx).Number)
+	require.Equal(t, "1234", client.User.QueryCards(a8m).OnlyX(octx).Number)
+	require.False(t, client.User.QueryCards(nati).ExistX(octx))
+	require.Empty(t, client.User.Query().Where(user.ID(nati.ID)).WithCards().OnlyX(octx).Edges.Cards)
+	require.Equal(t, []string{"1234"}, client.Card.Query().Select(card.FieldNumber).StringsX(octx))
+
+	// Runtime interceptors.
+	var types []string
+	client.Intercept(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+			types = append(types, q.Type())
+			return next.Query(ctx, q)
+		})
+	})
+	client.User.Intercept(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+			q.SetLimit(1)
+			if err := q.AddOrder(ent.Desc(user.FieldName)); err != nil {
+				return nil, err
+			}
+			return next.Query(ctx, q)
+		})
+	})
+	users := client.User.Query().AllX(ctx)
+	require.Len(t, users, 1)
+	require.Equal(t, nati.Name, users[0].Name)
+	require.Equal(t, 2, client.Card.Query().CountX(ctx))
+	require.Equal(t, []string{ent.TypeUser, ent.TypeCard}, types)
+
+	// Interceptors are propagated to transactional clients.
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	require.True(t, tx.Card.Query().Where(card.Number("1234")).ExistX(octx))
+	require.False(t, tx.Card.Query().Where(card.Number("5678")).ExistX(octx))
+	require.NoError(t, tx.Rollback())
+	require.Equal(t, []string{ent.TypeUser, ent.TypeCard, ent.TypeCard, ent.TypeCard}, types)
+
+	// Predicates of other types are rejected.
+	err = client.Card.Query().AddWhere(user.ID(a8m.ID))
+	require.Error(t, err)
+
+	// Errors returned from interceptors.
+	client.Card.Intercept(func(ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(context.Context, ent.Query) (ent.Value, error) {
+			return nil, fmt.Errorf("query rejected")
+		})
+	})
+	_, err = client.Card.Query().All(ctx)
+	require.EqualError(t, err, "query rejected")
+}
+
 func TestConditions(t *testing.T) {
 	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)))
 	defer client.Close()
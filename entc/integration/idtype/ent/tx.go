@@ -8,9 +8,11 @@ package ent
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 )
 
 // Tx is a transactional client that is created by calling Client.Tx().
@@ -23,11 +25,6 @@ type Tx struct {
 	client     *Client
 	clientOnce sync.Once
 
-	// completion callbacks.
-	mu         sync.Mutex
-	onCommit   []CommitHook
-	onRollback []RollbackHook
-
 	// ctx lives for the life of the transaction. It is
 	// the same context used by the underlying connection.
 	ctx context.Context
@@ -67,14 +64,24 @@ func (f CommitFunc) Commit(ctx context.Context, tx *Tx) error {
 }
 
 // Commit commits the transaction.
+// Committing a nested transaction releases its savepoint, and its hooks are
+// deferred to its parent, because its changes are persisted (or discarded)
+// only when the outermost transaction is committed (or rolled back).
 func (tx *Tx) Commit() error {
 	txDriver := tx.config.driver.(*txDriver)
+	if txDriver.parent != nil {
+		if err := txDriver.tx.Commit(); err != nil {
+			return err
+		}
+		txDriver.parent.adopt(txDriver)
+		return nil
+	}
 	var fn Committer = CommitFunc(func(context.Context, *Tx) error {
 		return txDriver.tx.Commit()
 	})
-	tx.mu.Lock()
-	hooks := append([]CommitHook(nil), tx.onCommit...)
-	tx.mu.Unlock()
+	txDriver.mu.Lock()
+	hooks := append([]CommitHook(nil), txDriver.onCommit...)
+	txDriver.mu.Unlock()
 	for i := len(hooks) - 1; i >= 0; i-- {
 		fn = hooks[i](fn)
 	}
@@ -83,9 +90,10 @@ func (tx *Tx) Commit() error {
 
 // OnCommit adds a hook to call on commit.
 func (tx *Tx) OnCommit(f CommitHook) {
-	tx.mu.Lock()
-	defer tx.mu.Unlock()
-	tx.onCommit = append(tx.onCommit, f)
+	txDriver := tx.config.driver.(*txDriver)
+	txDriver.mu.Lock()
+	defer txDriver.mu.Unlock()
+	txDriver.onCommit = append(txDriver.onCommit, f)
 }
 
 type (
@@ -122,14 +130,15 @@ func (f RollbackFunc) Rollback(ctx context.Context, tx *Tx) error {
 }
 
 // Rollback rollbacks the transaction.
+// Rolling back a nested transaction discards only the changes that were made in it.
 func (tx *Tx) Rollback() error {
 	txDriver := tx.config.driver.(*txDriver)
 	var fn Rollbacker = RollbackFunc(func(context.Context, *Tx) error {
 		return txDriver.tx.Rollback()
 	})
-	tx.mu.Lock()
-	hooks := append([]RollbackHook(nil), tx.onRollback...)
-	tx.mu.Unlock()
+	txDriver.mu.Lock()
+	hooks := append([]RollbackHook(nil), txDriver.onRollback...)
+	txDriver.mu.Unlock()
 	for i := len(hooks) - 1; i >= 0; i-- {
 		fn = hooks[i](fn)
 	}
@@ -138,9 +147,10 @@ func (tx *Tx) Rollback() error {
 
 // OnRollback adds a hook to call on rollback.
 func (tx *Tx) OnRollback(f RollbackHook) {
-	tx.mu.Lock()
-	defer tx.mu.Unlock()
-	tx.onRollback = append(tx.onRollback, f)
+	txDriver := tx.config.driver.(*txDriver)
+	txDriver.mu.Lock()
+	defer txDriver.mu.Unlock()
+	txDriver.onRollback = append(txDriver.onRollback, f)
 }
 
 // Client returns a Client that binds to current transaction.
@@ -172,6 +182,16 @@ type txDriver struct {
 	drv dialect.Driver
 	// tx is the underlying transaction.
 	tx dialect.Tx
+	// completion callbacks.
+	mu         sync.Mutex
+	onCommit   []CommitHook
+	onRollback []RollbackHook
+	// parent is the transaction that a nested transaction was started from,
+	// and name is the name of its savepoint. nested counts the transactions
+	// that were started from this transaction.
+	parent *txDriver
+	name   string
+	nested int
 }
 
 // newTx creates a new transactional driver.
@@ -211,4 +231,32 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v interface{}
 	return tx.tx.Query(ctx, query, args, v)
 }
 
+// savepoint starts a nested transaction by creating a savepoint in tx.
+func (tx *txDriver) savepoint(ctx context.Context) (*txDriver, error) {
+	tx.mu.Lock()
+	tx.nested++
+	name := fmt.Sprintf("%s_%d", tx.name, tx.nested)
+	tx.mu.Unlock()
+	if tx.parent == nil {
+		name = "ent" + name
+	}
+	sp, err := sql.Savepoint(ctx, tx.tx, name)
+	if err != nil {
+		return nil, err
+	}
+	return &txDriver{tx: sp, drv: tx.drv, parent: tx, name: name}, nil
+}
+
+// adopt adds the hooks of the given nested transaction, that was committed,
+// to tx, as its changes are committed (or rolled back) along with tx.
+func (tx *txDriver) adopt(nested *txDriver) {
+	nested.mu.Lock()
+	onCommit, onRollback := nested.onCommit, nested.onRollback
+	nested.mu.Unlock()
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	tx.onCommit = append(tx.onCommit, onCommit...)
+	tx.onRollback = append(tx.onRollback, onRollback...)
+}
+
 var _ dialect.Driver = (*txDriver)(nil)
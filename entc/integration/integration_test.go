@@ -6,7 +6,6 @@ package integration
 
 import (
 	"context"
-	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -21,6 +20,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/ent"
 	"entgo.io/ent/entc/integration/ent/enttest"
@@ -114,6 +114,7 @@ var (
 		EntQL,
 		Sanity,
 		Paging,
+		CursorPaging,
 		Select,
 		Delete,
 		Relation,
@@ -285,6 +286,67 @@ func Paging(t *testing.T, client *ent.Client) {
 	}
 }
 
+func CursorPaging(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	for i := 1; i <= 10; i++ {
+		client.User.Create().SetName(fmt.Sprintf("name-%d", i)).SetAge(i % 5).SaveX(ctx)
+	}
+	first, last := 4, 3
+
+	t.Log("paginate forward by id")
+	var ids []int
+	var after *ent.Cursor
+	for {
+		page, err := client.User.Query().Paginate(ctx, after, &first, nil, nil, nil)
+		require.NoError(err)
+		for _, u := range page.Nodes {
+			ids = append(ids, u.ID)
+		}
+		if !page.PageInfo.HasNextPage {
+			require.Len(page.Nodes, 2)
+			break
+		}
+		require.Len(page.Nodes, first)
+		after = page.PageInfo.EndCursor
+	}
+	require.Equal(client.User.Query().Order(ent.Asc(user.FieldID)).IDsX(ctx), ids)
+
+	t.Log("paginate backward by age")
+	order := &ent.UserOrder{Direction: ent.OrderDirectionDesc, Field: ent.UserOrderFieldAge}
+	page, err := client.User.Query().Paginate(ctx, nil, nil, nil, &last, order)
+	require.NoError(err)
+	require.True(page.PageInfo.HasPreviousPage)
+	require.False(page.PageInfo.HasNextPage)
+	require.Len(page.Nodes, last)
+	require.Equal([]int{1, 0, 0}, []int{page.Nodes[0].Age, page.Nodes[1].Age, page.Nodes[2].Age})
+	require.Greater(page.Nodes[1].ID, page.Nodes[2].ID, "ties are ordered by id in the same direction")
+
+	t.Log("cursors are opaque and versioned")
+	text, err := page.PageInfo.StartCursor.MarshalText()
+	require.NoError(err)
+	cursor := &ent.Cursor{}
+	require.NoError(cursor.UnmarshalText(text))
+	prev, err := client.User.Query().Paginate(ctx, nil, nil, cursor, &last, order)
+	require.NoError(err)
+	require.True(prev.PageInfo.HasPreviousPage)
+	require.Equal([]int{2, 2, 1}, []int{prev.Nodes[0].Age, prev.Nodes[1].Age, prev.Nodes[2].Age})
+	next, err := client.User.Query().Paginate(ctx, prev.PageInfo.EndCursor, &last, nil, nil, order)
+	require.NoError(err)
+	require.Equal(page.Nodes[0].ID, next.Nodes[0].ID)
+	require.Error(cursor.UnmarshalText([]byte("eyJ2IjoyLCJpZCI6MX0")), "unsupported cursor version")
+
+	t.Log("predicates are applied on the page")
+	page, err = client.User.Query().Where(user.AgeGT(1)).Paginate(ctx, nil, &first, nil, nil, order)
+	require.NoError(err)
+	require.True(page.PageInfo.HasNextPage)
+	for _, u := range page.Nodes {
+		require.Greater(u.Age, 1)
+	}
+	_, err = client.User.Query().Paginate(ctx, nil, &first, nil, &last, nil)
+	require.Error(err)
+}
+
 func Select(t *testing.T, client *ent.Client) {
 	ctx := context.Background()
 	require := require.New(t)
@@ -881,6 +943,10 @@ func UniqueConstraint(t *testing.T, client *ent.Client) {
 	foo := client.User.Create().SetAge(1).SetName("foo").SetNickname("baz").SaveX(ctx)
 	_, err := client.User.Create().SetAge(1).SetName("bar").SetNickname("baz").Save(ctx)
 	require.True(ent.IsConstraintError(err))
+	require.True(sqlgraph.IsUniqueConstraintError(err))
+	var cerr *ent.ConstraintError
+	require.True(errors.As(err, &cerr))
+	require.Equal(sqlgraph.UniqueConstraint, cerr.Details().Kind)
 	bar := client.User.Create().SetAge(1).SetName("bar").SetNickname("bar").SetPhone("1").SaveX(ctx)
 
 	t.Log("unique constraint violation on 2 fields")
@@ -907,6 +973,9 @@ func UniqueConstraint(t *testing.T, client *ent.Client) {
 	c2 := client.User.Create().SetAge(1).SetName("c2").SetNickname("c2").SetParent(foo).SaveX(ctx)
 	_, err = client.User.Create().SetAge(10).SetName("z").SetNickname("z").AddChildren(c1).Save(ctx)
 	require.True(ent.IsConstraintError(err), "c1 already has a parent")
+	require.True(errors.As(err, &cerr))
+	require.Equal(sqlgraph.UniqueConstraint, cerr.Details().Kind)
+	require.Equal(user.Table, cerr.Details().Table)
 	_, err = client.User.Create().SetAge(10).SetName("z").SetNickname("z").AddChildren(c2).Save(ctx)
 	require.True(ent.IsConstraintError(err), "c2 already has a parent")
 	_, err = client.User.Create().SetAge(10).SetName("z").SetNickname("z").AddChildren(c1, c2).Save(ctx)
@@ -917,6 +986,12 @@ func UniqueConstraint(t *testing.T, client *ent.Client) {
 	_, err = client.GroupInfo.Create().SetDesc("desc").AddGroups(grp).Save(ctx)
 	require.True(ent.IsConstraintError(err))
 
+	_, err = client.Pet.Create().SetName("p0").SetOwnerID(foo.ID + 1<<20).Save(ctx)
+	require.True(ent.IsConstraintError(err), "owner does not exist")
+	require.True(sqlgraph.IsForeignKeyConstraintError(err))
+	require.True(errors.As(err, &cerr))
+	require.Equal(sqlgraph.ForeignKeyConstraint, cerr.Details().Kind)
+
 	p1 := client.Pet.Create().SetName("p1").SetOwner(foo).SaveX(ctx)
 	p2 := client.Pet.Create().SetName("p2").SetOwner(foo).SaveX(ctx)
 	_, err = client.User.Create().SetAge(10).SetName("new-owner").AddPets(p1, p2).Save(ctx)
@@ -1012,15 +1087,116 @@ func Tx(t *testing.T, client *ent.Client) {
 		require.Zero(t, nde.Unwrap().QueryNext().CountX(ctx), "should be able to query the entity after wrap")
 	})
 	t.Run("Nested", func(t *testing.T) {
+		client.Node.Delete().ExecX(ctx)
 		tx, err := client.Tx(ctx)
 		require.NoError(t, err)
 		var m mocker
 		m.On("onRollback", nil).Once()
 		defer m.AssertExpectations(t)
+		tx.Node.Create().SetValue(1).SaveX(ctx)
+		// Rolling back a nested transaction discards only its changes.
+		nested, err := tx.Client().Tx(ctx)
+		require.NoError(t, err)
+		nested.OnRollback(m.rHook())
+		nested.Node.Create().SetValue(2).SaveX(ctx)
+		require.Equal(t, 2, tx.Node.Query().CountX(ctx))
+		require.NoError(t, nested.Rollback())
+		require.Equal(t, []int{1}, tx.Node.Query().Select(node.FieldValue).IntsX(ctx))
+		// Hooks of committed nested transactions are executed by the outermost transaction.
+		var committed []int
+		commitHook := func(v int) ent.CommitHook {
+			return func(next ent.Committer) ent.Committer {
+				return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+					err := next.Commit(ctx, tx)
+					committed = append(committed, v)
+					return err
+				})
+			}
+		}
+		tx.OnCommit(commitHook(1))
+		nested, err = tx.Client().Tx(ctx)
+		require.NoError(t, err)
+		nested.OnCommit(commitHook(3))
+		nested.Node.Create().SetValue(3).SaveX(ctx)
+		inner, err := nested.Client().Tx(ctx)
+		require.NoError(t, err)
+		inner.OnCommit(commitHook(4))
+		inner.Node.Create().SetValue(4).SaveX(ctx)
+		require.NoError(t, inner.Commit())
+		require.NoError(t, nested.Commit())
+		require.Empty(t, committed, "hooks of nested transactions should be deferred")
+		require.NoError(t, tx.Commit())
+		require.Equal(t, []int{4, 3, 1}, committed)
+		require.Equal(t, []int{1, 3, 4}, client.Node.Query().Order(ent.Asc(node.FieldValue)).Select(node.FieldValue).IntsX(ctx))
+	})
+	t.Run("NestedRollback", func(t *testing.T) {
+		client.Node.Delete().ExecX(ctx)
+		tx, err := client.Tx(ctx)
+		require.NoError(t, err)
+		var m mocker
+		m.On("onRollback", nil).Twice()
+		defer m.AssertExpectations(t)
 		tx.OnRollback(m.rHook())
-		_, err = tx.Client().Tx(ctx)
-		require.Error(t, err, "cannot start a transaction within a transaction")
+		nested, err := tx.Client().Tx(ctx)
+		require.NoError(t, err)
+		nested.OnRollback(m.rHook())
+		nested.Node.Create().SaveX(ctx)
+		require.NoError(t, nested.Commit())
 		require.NoError(t, tx.Rollback())
+		require.Zero(t, client.Node.Query().CountX(ctx), "rollback should discard the changes of nested transactions")
+	})
+	t.Run("WithTx", func(t *testing.T) {
+		client.Node.Delete().ExecX(ctx)
+		var attempts int
+		err := client.WithTx(ctx, func(tx *ent.Tx) error {
+			attempts++
+			tx.Node.Create().SetValue(attempts).SaveX(ctx)
+			if attempts < 3 {
+				return errors.New("Error 1213: Deadlock found when trying to get lock")
+			}
+			return nil
+		}, ent.WithTxBackoff(func(int) time.Duration { return 0 }), ent.WithTxOptions(&sql.TxOptions{Isolation: sql.LevelSerializable}))
+		require.NoError(t, err)
+		require.Equal(t, 3, attempts, "transactions that failed on deadlocks should be retried")
+		require.Equal(t, []int{3}, client.Node.Query().Select(node.FieldValue).IntsX(ctx), "failed attempts should be rolled back")
+
+		attempts = 0
+		err = client.WithTx(ctx, func(tx *ent.Tx) error {
+			attempts++
+			return errors.New("pq: could not serialize access due to concurrent update")
+		}, ent.WithTxAttempts(2), ent.WithTxBackoff(func(int) time.Duration { return 0 }))
+		require.True(t, sql.IsSerializationError(err))
+		require.Equal(t, 2, attempts)
+
+		attempts = 0
+		err = client.WithTx(ctx, func(tx *ent.Tx) error {
+			attempts++
+			return tx.Node.Create().SetValue(1).Exec(ctx)
+		}, ent.WithTxRetryable(func(error) bool { return true }))
+		require.NoError(t, err)
+		require.Equal(t, 1, attempts, "successful transactions should not be retried")
+		require.Equal(t, 2, client.Node.Query().CountX(ctx))
+
+		require.Panics(t, func() {
+			client.WithTx(ctx, func(tx *ent.Tx) error {
+				tx.Node.Create().SaveX(ctx)
+				panic("boom")
+			})
+		})
+		require.Equal(t, 2, client.Node.Query().CountX(ctx), "transaction should be rolled back on panic")
+
+		// Nested calls are executed in nested transactions.
+		err = client.WithTx(ctx, func(tx *ent.Tx) error {
+			tx.Node.Create().SetValue(4).SaveX(ctx)
+			err := tx.Client().WithTx(ctx, func(tx *ent.Tx) error {
+				tx.Node.Create().SetValue(5).SaveX(ctx)
+				return errors.New("Error 1213: Deadlock found when trying to get lock")
+			})
+			require.Error(t, err)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []int{1, 3, 4}, client.Node.Query().Order(ent.Asc(node.FieldValue)).Select(node.FieldValue).IntsX(ctx))
 	})
 	t.Run("TxOptions", func(t *testing.T) {
 		if strings.Contains(t.Name(), "SQLite") {
@@ -1036,6 +1212,17 @@ func Tx(t *testing.T, client *ent.Client) {
 		require.Error(t, err)
 		require.NoError(t, tx.Rollback())
 	})
+	t.Run("ForUpdate", func(t *testing.T) {
+		nde := client.Node.Create().SetValue(1).SaveX(ctx)
+		tx, err := client.Tx(ctx)
+		require.NoError(t, err)
+		query := tx.Node.Query().Where(node.ID(nde.ID)).ForUpdate()
+		require.Equal(t, 1, query.CountX(ctx))
+		locked := query.Clone().OnlyX(ctx)
+		tx.Node.UpdateOne(locked).AddValue(1).ExecX(ctx)
+		require.NoError(t, tx.Commit())
+		require.Equal(t, 2, client.Node.GetX(ctx, nde.ID).Value)
+	})
 }
 
 func DefaultValue(t *testing.T, client *ent.Client) {
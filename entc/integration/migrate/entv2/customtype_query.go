@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/migrate/entv2/customtype"
@@ -27,6 +28,8 @@ type CustomTypeQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.CustomType
+	inters     []Interceptor
+	modifiers  []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -56,6 +59,48 @@ func (ctq *CustomTypeQuery) Order(o ...OrderFunc) *CustomTypeQuery {
 	return ctq
 }
 
+// Type returns the node type of this query (CustomType). It implements the ent.Query interface.
+func (ctq *CustomTypeQuery) Type() string {
+	return TypeCustomType
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (ctq *CustomTypeQuery) SetLimit(limit int) {
+	ctq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (ctq *CustomTypeQuery) SetOffset(offset int) {
+	ctq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (ctq *CustomTypeQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("entv2: unexpected order type %T for CustomTypeQuery", fn)
+		}
+		ctq.order = append(ctq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (ctq *CustomTypeQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.CustomType:
+			ctq.predicates = append(ctq.predicates, p)
+		case func(*sql.Selector):
+			ctq.predicates = append(ctq.predicates, p)
+		default:
+			return fmt.Errorf("entv2: unexpected predicate type %T for CustomTypeQuery", p)
+		}
+	}
+	return nil
+}
+
 // First returns the first CustomType entity from the query.
 // Returns a *NotFoundError when no CustomType was found.
 func (ctq *CustomTypeQuery) First(ctx context.Context) (*CustomType, error) {
@@ -161,7 +206,22 @@ func (ctq *CustomTypeQuery) All(ctx context.Context) ([]*CustomType, error) {
 	if err := ctq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return ctq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CustomTypeQuery)
+		if !ok {
+			return nil, fmt.Errorf("entv2: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, ctq, qr, ctq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*CustomType)
+	if !ok {
+		return nil, fmt.Errorf("entv2: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -196,7 +256,22 @@ func (ctq *CustomTypeQuery) Count(ctx context.Context) (int, error) {
 	if err := ctq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return ctq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CustomTypeQuery)
+		if !ok {
+			return nil, fmt.Errorf("entv2: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, ctq, qr, ctq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("entv2: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -213,7 +288,22 @@ func (ctq *CustomTypeQuery) Exist(ctx context.Context) (bool, error) {
 	if err := ctq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return ctq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*CustomTypeQuery)
+		if !ok {
+			return nil, fmt.Errorf("entv2: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, ctq, qr, ctq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("entv2: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -237,9 +327,11 @@ func (ctq *CustomTypeQuery) Clone() *CustomTypeQuery {
 		offset:     ctq.offset,
 		order:      append([]OrderFunc{}, ctq.order...),
 		predicates: append([]predicate.CustomType{}, ctq.predicates...),
+		inters:     append([]Interceptor{}, ctq.inters...),
 		// clone intermediate query.
-		sql:  ctq.sql.Clone(),
-		path: ctq.path,
+		sql:       ctq.sql.Clone(),
+		path:      ctq.path,
+		modifiers: append([]func(*sql.Selector){}, ctq.modifiers...),
 	}
 }
 
@@ -307,7 +399,7 @@ func (ctq *CustomTypeQuery) prepareQuery(ctx context.Context) error {
 func (ctq *CustomTypeQuery) sqlAll(ctx context.Context) ([]*CustomType, error) {
 	var (
 		nodes = []*CustomType{}
-		_spec = ctq.querySpec()
+		_spec = ctq.querySpec(ctx)
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		node := &CustomType{config: ctq.config}
@@ -331,7 +423,7 @@ func (ctq *CustomTypeQuery) sqlAll(ctx context.Context) ([]*CustomType, error) {
 }
 
 func (ctq *CustomTypeQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := ctq.querySpec()
+	_spec := ctq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, ctq.driver, _spec)
 }
 
@@ -343,7 +435,7 @@ func (ctq *CustomTypeQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (ctq *CustomTypeQuery) querySpec() *sqlgraph.QuerySpec {
+func (ctq *CustomTypeQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   customtype.Table,
@@ -385,6 +477,9 @@ func (ctq *CustomTypeQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := ctq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -410,9 +505,40 @@ func (ctq *CustomTypeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := ctq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range ctq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ctq *CustomTypeQuery) ForUpdate(opts ...sql.LockOption) *CustomTypeQuery {
+	ctq.modifiers = append(ctq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return ctq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ctq *CustomTypeQuery) ForShare(opts ...sql.LockOption) *CustomTypeQuery {
+	ctq.modifiers = append(ctq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return ctq
+}
+
 // CustomTypeGroupBy is the group-by builder for CustomType entities.
 type CustomTypeGroupBy struct {
 	config
@@ -662,7 +788,8 @@ func (ctgb *CustomTypeGroupBy) sqlScan(ctx context.Context, v interface{}) error
 }
 
 func (ctgb *CustomTypeGroupBy) sqlQuery() *sql.Selector {
-	selector := ctgb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := ctgb.sql.ClearLock()
 	columns := make([]string, 0, len(ctgb.fields)+len(ctgb.fns))
 	columns = append(columns, ctgb.fields...)
 	for _, fn := range ctgb.fns {
@@ -683,8 +810,12 @@ func (cts *CustomTypeSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := cts.prepareQuery(ctx); err != nil {
 		return err
 	}
-	cts.sql = cts.CustomTypeQuery.sqlQuery(ctx)
-	return cts.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		cts.sql = cts.CustomTypeQuery.sqlQuery(ctx)
+		return v, cts.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, cts.CustomTypeQuery, qr, cts.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/migrate/entv2/media"
@@ -27,6 +28,8 @@ type MediaQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Media
+	inters     []Interceptor
+	modifiers  []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -56,6 +59,48 @@ func (mq *MediaQuery) Order(o ...OrderFunc) *MediaQuery {
 	return mq
 }
 
+// Type returns the node type of this query (Media). It implements the ent.Query interface.
+func (mq *MediaQuery) Type() string {
+	return TypeMedia
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (mq *MediaQuery) SetLimit(limit int) {
+	mq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (mq *MediaQuery) SetOffset(offset int) {
+	mq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (mq *MediaQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("entv2: unexpected order type %T for MediaQuery", fn)
+		}
+		mq.order = append(mq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (mq *MediaQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Media:
+			mq.predicates = append(mq.predicates, p)
+		case func(*sql.Selector):
+			mq.predicates = append(mq.predicates, p)
+		default:
+			return fmt.Errorf("entv2: unexpected predicate type %T for MediaQuery", p)
+		}
+	}
+	return nil
+}
+
 // First returns the first Media entity from the query.
 // Returns a *NotFoundError when no Media was found.
 func (mq *MediaQuery) First(ctx context.Context) (*Media, error) {
@@ -161,7 +206,22 @@ func (mq *MediaQuery) All(ctx context.Context) ([]*Media, error) {
 	if err := mq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return mq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*MediaQuery)
+		if !ok {
+			return nil, fmt.Errorf("entv2: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, mq, qr, mq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Media)
+	if !ok {
+		return nil, fmt.Errorf("entv2: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -196,7 +256,22 @@ func (mq *MediaQuery) Count(ctx context.Context) (int, error) {
 	if err := mq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return mq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*MediaQuery)
+		if !ok {
+			return nil, fmt.Errorf("entv2: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, mq, qr, mq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("entv2: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -213,7 +288,22 @@ func (mq *MediaQuery) Exist(ctx context.Context) (bool, error) {
 	if err := mq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return mq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*MediaQuery)
+		if !ok {
+			return nil, fmt.Errorf("entv2: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, mq, qr, mq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("entv2: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -237,9 +327,11 @@ func (mq *MediaQuery) Clone() *MediaQuery {
 		offset:     mq.offset,
 		order:      append([]OrderFunc{}, mq.order...),
 		predicates: append([]predicate.Media{}, mq.predicates...),
+		inters:     append([]Interceptor{}, mq.inters...),
 		// clone intermediate query.
-		sql:  mq.sql.Clone(),
-		path: mq.path,
+		sql:       mq.sql.Clone(),
+		path:      mq.path,
+		modifiers: append([]func(*sql.Selector){}, mq.modifiers...),
 	}
 }
 
@@ -307,7 +399,7 @@ func (mq *MediaQuery) prepareQuery(ctx context.Context) error {
 func (mq *MediaQuery) sqlAll(ctx context.Context) ([]*Media, error) {
 	var (
 		nodes = []*Media{}
-		_spec = mq.querySpec()
+		_spec = mq.querySpec(ctx)
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		node := &Media{config: mq.config}
@@ -331,7 +423,7 @@ func (mq *MediaQuery) sqlAll(ctx context.Context) ([]*Media, error) {
 }
 
 func (mq *MediaQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := mq.querySpec()
+	_spec := mq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, mq.driver, _spec)
 }
 
@@ -343,7 +435,7 @@ func (mq *MediaQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (mq *MediaQuery) querySpec() *sqlgraph.QuerySpec {
+func (mq *MediaQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   media.Table,
@@ -385,6 +477,9 @@ func (mq *MediaQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := mq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -410,9 +505,40 @@ func (mq *MediaQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := mq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range mq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (mq *MediaQuery) ForUpdate(opts ...sql.LockOption) *MediaQuery {
+	mq.modifiers = append(mq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return mq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (mq *MediaQuery) ForShare(opts ...sql.LockOption) *MediaQuery {
+	mq.modifiers = append(mq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return mq
+}
+
 // MediaGroupBy is the group-by builder for Media entities.
 type MediaGroupBy struct {
 	config
@@ -662,7 +788,8 @@ func (mgb *MediaGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (mgb *MediaGroupBy) sqlQuery() *sql.Selector {
-	selector := mgb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := mgb.sql.ClearLock()
 	columns := make([]string, 0, len(mgb.fields)+len(mgb.fns))
 	columns = append(columns, mgb.fields...)
 	for _, fn := range mgb.fns {
@@ -683,8 +810,12 @@ func (ms *MediaSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := ms.prepareQuery(ctx); err != nil {
 		return err
 	}
-	ms.sql = ms.MediaQuery.sqlQuery(ctx)
-	return ms.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		ms.sql = ms.MediaQuery.sqlQuery(ctx)
+		return v, ms.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, ms.MediaQuery, qr, ms.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -143,6 +143,12 @@ var (
 				Columns: []*schema.Column{UsersColumns[6], UsersColumns[3]},
 			},
 		},
+		Checks: []*schema.Check{
+			{
+				Name: "users_age_check",
+				Expr: "age >= 0",
+			},
+		},
 	}
 	// FriendsColumns holds the columns for the "friends" table.
 	FriendsColumns = []*schema.Column{
@@ -6,6 +6,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -42,8 +43,12 @@ func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("id").
 			StorageKey("oid"),
-		// changing the type of the field.
-		field.Int("age"),
+		// changing the type of the field, and
+		// adding a CHECK constraint to its column.
+		field.Int("age").
+			Annotations(entsql.Annotation{
+				Checks: []*entsql.Check{{Expr: "age >= 0"}},
+			}),
 		// extending name field to longtext.
 		field.Text("name"),
 		// changing nickname from unique no non-unique.
@@ -0,0 +1,65 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"regexp"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// User holds the schema definition for the User entity.
+type User struct {
+	ent.Schema
+}
+
+// Fields of the User.
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(30).
+			Comment("The display name of the user."),
+		field.String("email").
+			Match(regexp.MustCompile("^[^@]+@[^@]+$")).
+			Unique(),
+		field.Int("age").
+			Positive().
+			Optional(),
+		field.String("nickname").
+			Optional().
+			Nillable(),
+		field.Enum("status").
+			Values("active", "suspended").
+			Default("active"),
+		field.String("password").
+			Optional().
+			Sensitive(),
+		field.JSON("tags", []string{}).
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the User.
+func (User) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("pets", Pet.Type),
+		edge.To("friends", User.Type),
+	}
+}
+
+// Indexes of the User.
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+	}
+}
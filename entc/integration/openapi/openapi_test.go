@@ -0,0 +1,148 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package openapi
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/entc/integration/openapi/ent"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPHandler(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	require.NoError(t, client.Schema.Create(context.Background()))
+	srv := httptest.NewServer(ent.NewHTTPHandler(client))
+	defer srv.Close()
+
+	do := func(method, path, body string, v interface{}) int {
+		req, err := http.NewRequest(method, srv.URL+path, strings.NewReader(body))
+		require.NoError(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		if v != nil {
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(v))
+		}
+		return resp.StatusCode
+	}
+	var a8m, nati ent.User
+	require.Equal(t, http.StatusCreated, do(http.MethodPost, "/users", `{"name": "a8m", "email": "a8m@example.com", "age": 30, "password": "secret", "tags": ["admin"]}`, &a8m))
+	require.Equal(t, "a8m", a8m.Name)
+	require.Equal(t, []string{"admin"}, a8m.Tags)
+	require.Equal(t, "active", a8m.Status.String(), "default values are set by the builder")
+	require.Empty(t, a8m.Password, "sensitive fields are not returned")
+	require.Equal(t, http.StatusCreated, do(http.MethodPost, "/users", `{"name": "nati", "email": "nati@example.com", "status": "suspended", "friends": [`+strconv.Itoa(a8m.ID)+`]}`, &nati))
+	var pet ent.Pet
+	require.Equal(t, http.StatusCreated, do(http.MethodPost, "/pets", `{"name": "pedro", "owner": `+strconv.Itoa(a8m.ID)+`}`, &pet))
+	require.Equal(t, a8m.ID, pet.OwnerID)
+
+	t.Run("Validation", func(t *testing.T) {
+		var e struct {
+			Code    int
+			Message string
+		}
+		require.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/users", `{"name": "", "email": "x@example.com"}`, &e))
+		require.Equal(t, http.StatusBadRequest, e.Code)
+		require.Contains(t, e.Message, `validator failed for field "name"`)
+		require.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/users", `{"name": "x", "email": "x"}`, &e))
+		require.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/users", `{"email": "x@example.com"}`, &e))
+		require.Contains(t, e.Message, `missing required field "name"`)
+		require.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/users", `{"name": "x", "email": "x@example.com", "status": "unknown"}`, &e))
+		require.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/users", `{"name": 1}`, &e))
+		require.Equal(t, http.StatusConflict, do(http.MethodPost, "/users", `{"name": "x", "email": "a8m@example.com"}`, &e))
+	})
+
+	t.Run("List", func(t *testing.T) {
+		var users []*ent.User
+		require.Equal(t, http.StatusOK, do(http.MethodGet, "/users", "", &users))
+		require.Len(t, users, 2)
+		require.Equal(t, http.StatusOK, do(http.MethodGet, "/users?page=2&itemsPerPage=1", "", &users))
+		require.Len(t, users, 1)
+		require.Equal(t, nati.ID, users[0].ID)
+		require.Equal(t, http.StatusOK, do(http.MethodGet, "/users?page=3&itemsPerPage=1", "", &users))
+		require.Empty(t, users)
+		require.Equal(t, http.StatusOK, do(http.MethodGet, "/users?status=suspended", "", &users))
+		require.Len(t, users, 1)
+		require.Equal(t, nati.ID, users[0].ID)
+		require.Equal(t, http.StatusOK, do(http.MethodGet, "/users?email=a8m@example.com&status=active", "", &users))
+		require.Len(t, users, 1)
+		require.Equal(t, a8m.ID, users[0].ID)
+		require.Equal(t, http.StatusBadRequest, do(http.MethodGet, "/users?status=unknown", "", nil))
+		require.Equal(t, http.StatusBadRequest, do(http.MethodGet, "/users?itemsPerPage=1000", "", nil))
+	})
+
+	t.Run("Read", func(t *testing.T) {
+		var u ent.User
+		require.Equal(t, http.StatusOK, do(http.MethodGet, "/users/"+strconv.Itoa(a8m.ID), "", &u))
+		require.Len(t, u.Edges.Pets, 1, "edges are eager-loaded")
+		require.Equal(t, pet.ID, u.Edges.Pets[0].ID)
+		require.Len(t, u.Edges.Friends, 1)
+		require.Equal(t, nati.ID, u.Edges.Friends[0].ID)
+		require.Equal(t, http.StatusNotFound, do(http.MethodGet, "/users/1000", "", nil))
+		require.Equal(t, http.StatusBadRequest, do(http.MethodGet, "/users/a8m", "", nil))
+		require.Equal(t, http.StatusNotFound, do(http.MethodGet, "/groups", "", nil))
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		var u ent.User
+		require.Equal(t, http.StatusOK, do(http.MethodPatch, "/users/"+strconv.Itoa(nati.ID), `{"nickname": "nat", "friends": []}`, &u))
+		require.Equal(t, "nat", *u.Nickname)
+		require.Equal(t, "nati", u.Name, "fields that are not set are not changed")
+		require.Zero(t, client.User.GetX(context.Background(), nati.ID).QueryFriends().CountX(context.Background()))
+		require.Equal(t, http.StatusBadRequest, do(http.MethodPatch, "/users/"+strconv.Itoa(nati.ID), `{"age": -1}`, nil))
+		require.Equal(t, http.StatusNotFound, do(http.MethodPatch, "/users/1000", `{"name": "x"}`, nil))
+		var p ent.Pet
+		require.Equal(t, http.StatusOK, do(http.MethodPatch, "/pets/"+strconv.Itoa(pet.ID), `{"owner": `+strconv.Itoa(nati.ID)+`}`, &p))
+		require.Equal(t, nati.ID, p.OwnerID)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		require.Equal(t, http.StatusNoContent, do(http.MethodDelete, "/pets/"+strconv.Itoa(pet.ID), "", nil))
+		require.Equal(t, http.StatusNotFound, do(http.MethodDelete, "/pets/"+strconv.Itoa(pet.ID), "", nil))
+		require.Equal(t, http.StatusMethodNotAllowed, do(http.MethodPut, "/pets/"+strconv.Itoa(pet.ID), "", nil))
+	})
+}
+
+func TestSpec(t *testing.T) {
+	b, err := ioutil.ReadFile("ent/openapi.json")
+	require.NoError(t, err)
+	var doc struct {
+		Paths      map[string]map[string]json.RawMessage
+		Components struct {
+			Schemas map[string]struct {
+				Required   []string
+				Properties map[string]map[string]interface{}
+			}
+		}
+	}
+	require.NoError(t, json.Unmarshal(b, &doc))
+	require.Len(t, doc.Paths, 4)
+	require.Contains(t, doc.Paths["/users"], "post")
+	require.Contains(t, doc.Paths["/users/{id}"], "patch")
+	user := doc.Components.Schemas["User"].Properties
+	require.Equal(t, map[string]interface{}{"type": "string", "minLength": 1.0, "maxLength": 30.0, "description": "The display name of the user."}, user["name"])
+	require.Equal(t, "^[^@]+@[^@]+$", user["email"]["pattern"])
+	require.Equal(t, 1.0, user["age"]["minimum"])
+	require.Equal(t, []interface{}{"active", "suspended"}, user["status"]["enum"])
+	require.Equal(t, true, user["nickname"]["nullable"])
+	require.NotContains(t, user, "password", "sensitive fields are omitted from responses")
+	create := doc.Components.Schemas["UserCreate"]
+	require.Equal(t, []string{"name", "email"}, create.Required)
+	require.Contains(t, create.Properties, "password")
+	require.Contains(t, create.Properties, "created_at")
+	require.NotContains(t, doc.Components.Schemas["UserUpdate"].Properties, "created_at", "immutable fields cannot be updated")
+}
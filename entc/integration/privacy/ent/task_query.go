@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/privacy/ent/predicate"
@@ -30,10 +31,12 @@ type TaskQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Task
+	inters     []Interceptor
 	// eager-loading edges.
 	withTeams *TeamQuery
 	withOwner *UserQuery
 	withFKs   bool
+	modifiers []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -63,9 +66,51 @@ func (tq *TaskQuery) Order(o ...OrderFunc) *TaskQuery {
 	return tq
 }
 
+// Type returns the node type of this query (Task). It implements the ent.Query interface.
+func (tq *TaskQuery) Type() string {
+	return TypeTask
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (tq *TaskQuery) SetLimit(limit int) {
+	tq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (tq *TaskQuery) SetOffset(offset int) {
+	tq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (tq *TaskQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for TaskQuery", fn)
+		}
+		tq.order = append(tq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (tq *TaskQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Task:
+			tq.predicates = append(tq.predicates, p)
+		case func(*sql.Selector):
+			tq.predicates = append(tq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for TaskQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryTeams chains the current query on the "teams" edge.
 func (tq *TaskQuery) QueryTeams() *TeamQuery {
-	query := &TeamQuery{config: tq.config}
+	query := (&TeamClient{config: tq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := tq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -87,7 +132,7 @@ func (tq *TaskQuery) QueryTeams() *TeamQuery {
 
 // QueryOwner chains the current query on the "owner" edge.
 func (tq *TaskQuery) QueryOwner() *UserQuery {
-	query := &UserQuery{config: tq.config}
+	query := (&UserClient{config: tq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := tq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -212,7 +257,22 @@ func (tq *TaskQuery) All(ctx context.Context) ([]*Task, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return tq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*TaskQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, tq, qr, tq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Task)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -247,7 +307,22 @@ func (tq *TaskQuery) Count(ctx context.Context) (int, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return tq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*TaskQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, tq, qr, tq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -264,7 +339,22 @@ func (tq *TaskQuery) Exist(ctx context.Context) (bool, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return tq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*TaskQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, tq, qr, tq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -288,18 +378,20 @@ func (tq *TaskQuery) Clone() *TaskQuery {
 		offset:     tq.offset,
 		order:      append([]OrderFunc{}, tq.order...),
 		predicates: append([]predicate.Task{}, tq.predicates...),
+		inters:     append([]Interceptor{}, tq.inters...),
 		withTeams:  tq.withTeams.Clone(),
 		withOwner:  tq.withOwner.Clone(),
 		// clone intermediate query.
-		sql:  tq.sql.Clone(),
-		path: tq.path,
+		sql:       tq.sql.Clone(),
+		path:      tq.path,
+		modifiers: append([]func(*sql.Selector){}, tq.modifiers...),
 	}
 }
 
 // WithTeams tells the query-builder to eager-load the nodes that are connected to
 // the "teams" edge. The optional arguments are used to configure the query builder of the edge.
 func (tq *TaskQuery) WithTeams(opts ...func(*TeamQuery)) *TaskQuery {
-	query := &TeamQuery{config: tq.config}
+	query := (&TeamClient{config: tq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -310,7 +402,7 @@ func (tq *TaskQuery) WithTeams(opts ...func(*TeamQuery)) *TaskQuery {
 // WithOwner tells the query-builder to eager-load the nodes that are connected to
 // the "owner" edge. The optional arguments are used to configure the query builder of the edge.
 func (tq *TaskQuery) WithOwner(opts ...func(*UserQuery)) *TaskQuery {
-	query := &UserQuery{config: tq.config}
+	query := (&UserClient{config: tq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -389,7 +481,7 @@ func (tq *TaskQuery) sqlAll(ctx context.Context) ([]*Task, error) {
 	var (
 		nodes       = []*Task{}
 		withFKs     = tq.withFKs
-		_spec       = tq.querySpec()
+		_spec       = tq.querySpec(ctx)
 		loadedTypes = [2]bool{
 			tq.withTeams != nil,
 			tq.withOwner != nil,
@@ -515,7 +607,7 @@ func (tq *TaskQuery) sqlAll(ctx context.Context) ([]*Task, error) {
 }
 
 func (tq *TaskQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := tq.querySpec()
+	_spec := tq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, tq.driver, _spec)
 }
 
@@ -527,7 +619,7 @@ func (tq *TaskQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (tq *TaskQuery) querySpec() *sqlgraph.QuerySpec {
+func (tq *TaskQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   task.Table,
@@ -569,6 +661,9 @@ func (tq *TaskQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := tq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -594,9 +689,40 @@ func (tq *TaskQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := tq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range tq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tq *TaskQuery) ForUpdate(opts ...sql.LockOption) *TaskQuery {
+	tq.modifiers = append(tq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return tq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tq *TaskQuery) ForShare(opts ...sql.LockOption) *TaskQuery {
+	tq.modifiers = append(tq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return tq
+}
+
 // TaskGroupBy is the group-by builder for Task entities.
 type TaskGroupBy struct {
 	config
@@ -846,7 +972,8 @@ func (tgb *TaskGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (tgb *TaskGroupBy) sqlQuery() *sql.Selector {
-	selector := tgb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := tgb.sql.ClearLock()
 	columns := make([]string, 0, len(tgb.fields)+len(tgb.fns))
 	columns = append(columns, tgb.fields...)
 	for _, fn := range tgb.fns {
@@ -867,8 +994,12 @@ func (ts *TaskSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := ts.prepareQuery(ctx); err != nil {
 		return err
 	}
-	ts.sql = ts.TaskQuery.sqlQuery(ctx)
-	return ts.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		ts.sql = ts.TaskQuery.sqlQuery(ctx)
+		return v, ts.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, ts.TaskQuery, qr, ts.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
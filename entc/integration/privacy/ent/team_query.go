@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/privacy/ent/predicate"
@@ -30,9 +31,11 @@ type TeamQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Team
+	inters     []Interceptor
 	// eager-loading edges.
 	withTasks *TaskQuery
 	withUsers *UserQuery
+	modifiers []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -62,9 +65,51 @@ func (tq *TeamQuery) Order(o ...OrderFunc) *TeamQuery {
 	return tq
 }
 
+// Type returns the node type of this query (Team). It implements the ent.Query interface.
+func (tq *TeamQuery) Type() string {
+	return TypeTeam
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (tq *TeamQuery) SetLimit(limit int) {
+	tq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (tq *TeamQuery) SetOffset(offset int) {
+	tq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (tq *TeamQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for TeamQuery", fn)
+		}
+		tq.order = append(tq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (tq *TeamQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Team:
+			tq.predicates = append(tq.predicates, p)
+		case func(*sql.Selector):
+			tq.predicates = append(tq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for TeamQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryTasks chains the current query on the "tasks" edge.
 func (tq *TeamQuery) QueryTasks() *TaskQuery {
-	query := &TaskQuery{config: tq.config}
+	query := (&TaskClient{config: tq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := tq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -86,7 +131,7 @@ func (tq *TeamQuery) QueryTasks() *TaskQuery {
 
 // QueryUsers chains the current query on the "users" edge.
 func (tq *TeamQuery) QueryUsers() *UserQuery {
-	query := &UserQuery{config: tq.config}
+	query := (&UserClient{config: tq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := tq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -211,7 +256,22 @@ func (tq *TeamQuery) All(ctx context.Context) ([]*Team, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return tq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*TeamQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, tq, qr, tq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Team)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -246,7 +306,22 @@ func (tq *TeamQuery) Count(ctx context.Context) (int, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return tq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*TeamQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, tq, qr, tq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -263,7 +338,22 @@ func (tq *TeamQuery) Exist(ctx context.Context) (bool, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return tq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*TeamQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, tq, qr, tq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -287,18 +377,20 @@ func (tq *TeamQuery) Clone() *TeamQuery {
 		offset:     tq.offset,
 		order:      append([]OrderFunc{}, tq.order...),
 		predicates: append([]predicate.Team{}, tq.predicates...),
+		inters:     append([]Interceptor{}, tq.inters...),
 		withTasks:  tq.withTasks.Clone(),
 		withUsers:  tq.withUsers.Clone(),
 		// clone intermediate query.
-		sql:  tq.sql.Clone(),
-		path: tq.path,
+		sql:       tq.sql.Clone(),
+		path:      tq.path,
+		modifiers: append([]func(*sql.Selector){}, tq.modifiers...),
 	}
 }
 
 // WithTasks tells the query-builder to eager-load the nodes that are connected to
 // the "tasks" edge. The optional arguments are used to configure the query builder of the edge.
 func (tq *TeamQuery) WithTasks(opts ...func(*TaskQuery)) *TeamQuery {
-	query := &TaskQuery{config: tq.config}
+	query := (&TaskClient{config: tq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -309,7 +401,7 @@ func (tq *TeamQuery) WithTasks(opts ...func(*TaskQuery)) *TeamQuery {
 // WithUsers tells the query-builder to eager-load the nodes that are connected to
 // the "users" edge. The optional arguments are used to configure the query builder of the edge.
 func (tq *TeamQuery) WithUsers(opts ...func(*UserQuery)) *TeamQuery {
-	query := &UserQuery{config: tq.config}
+	query := (&UserClient{config: tq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -387,7 +479,7 @@ func (tq *TeamQuery) prepareQuery(ctx context.Context) error {
 func (tq *TeamQuery) sqlAll(ctx context.Context) ([]*Team, error) {
 	var (
 		nodes       = []*Team{}
-		_spec       = tq.querySpec()
+		_spec       = tq.querySpec(ctx)
 		loadedTypes = [2]bool{
 			tq.withTasks != nil,
 			tq.withUsers != nil,
@@ -545,7 +637,7 @@ func (tq *TeamQuery) sqlAll(ctx context.Context) ([]*Team, error) {
 }
 
 func (tq *TeamQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := tq.querySpec()
+	_spec := tq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, tq.driver, _spec)
 }
 
@@ -557,7 +649,7 @@ func (tq *TeamQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (tq *TeamQuery) querySpec() *sqlgraph.QuerySpec {
+func (tq *TeamQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   team.Table,
@@ -599,6 +691,9 @@ func (tq *TeamQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := tq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -624,9 +719,40 @@ func (tq *TeamQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := tq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range tq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tq *TeamQuery) ForUpdate(opts ...sql.LockOption) *TeamQuery {
+	tq.modifiers = append(tq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return tq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tq *TeamQuery) ForShare(opts ...sql.LockOption) *TeamQuery {
+	tq.modifiers = append(tq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return tq
+}
+
 // TeamGroupBy is the group-by builder for Team entities.
 type TeamGroupBy struct {
 	config
@@ -876,7 +1002,8 @@ func (tgb *TeamGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (tgb *TeamGroupBy) sqlQuery() *sql.Selector {
-	selector := tgb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := tgb.sql.ClearLock()
 	columns := make([]string, 0, len(tgb.fields)+len(tgb.fns))
 	columns = append(columns, tgb.fields...)
 	for _, fn := range tgb.fns {
@@ -897,8 +1024,12 @@ func (ts *TeamSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := ts.prepareQuery(ctx); err != nil {
 		return err
 	}
-	ts.sql = ts.TeamQuery.sqlQuery(ctx)
-	return ts.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		ts.sql = ts.TeamQuery.sqlQuery(ctx)
+		return v, ts.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, ts.TeamQuery, qr, ts.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
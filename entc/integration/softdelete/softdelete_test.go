@@ -0,0 +1,71 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package softdelete
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/entc/integration/softdelete/ent"
+	"entgo.io/ent/entc/integration/softdelete/ent/pet"
+	"entgo.io/ent/entc/integration/softdelete/ent/user"
+	"entgo.io/ent/schema/mixin"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSoftDelete(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	a8m := client.User.Create().SetName("a8m").SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SaveX(ctx)
+	xabi := client.Pet.Create().SetName("xabi").SetOwner(a8m).SaveX(ctx)
+	luna := client.Pet.Create().SetName("luna").SetOwner(a8m).SaveX(ctx)
+	client.Pet.Create().SetName("pedro").SetOwner(nati).SaveX(ctx)
+
+	// Deleted entities are kept in the database, but filtered out from queries.
+	client.Pet.DeleteOne(xabi).ExecX(ctx)
+	require.Equal(t, 2, client.Pet.Query().CountX(ctx))
+	require.Equal(t, 3, client.Pet.Query().CountX(mixin.IncludeDeleted(ctx)))
+	deleted := client.Pet.Query().Where(pet.DeletedAtNotNil()).OnlyX(mixin.IncludeDeleted(ctx))
+	require.Equal(t, xabi.ID, deleted.ID)
+	require.NotNil(t, deleted.DeletedAt)
+	_, err = client.Pet.Get(ctx, xabi.ID)
+	require.True(t, ent.IsNotFound(err))
+	require.True(t, ent.IsNotFound(client.Pet.DeleteOne(xabi).Exec(ctx)), "pet was already deleted")
+
+	// The filtering applies on each execution, and does not affect later executions of the same query.
+	query := client.Pet.Query()
+	require.Equal(t, 2, query.CountX(ctx))
+	require.Equal(t, 3, query.CountX(mixin.IncludeDeleted(ctx)))
+	require.Len(t, query.AllX(mixin.IncludeDeleted(ctx)), 3)
+	require.Len(t, query.IDsX(mixin.IncludeDeleted(ctx)), 3)
+	require.Len(t, query.IDsX(ctx), 2)
+
+	// Edge traversals and eager-loading skip deleted entities.
+	require.Equal(t, []int{luna.ID}, a8m.QueryPets().IDsX(ctx))
+	require.Equal(t, []int{luna.ID}, client.User.Query().Where(user.ID(a8m.ID)).QueryPets().IDsX(ctx))
+	u := client.User.Query().Where(user.ID(a8m.ID)).WithPets().OnlyX(ctx)
+	require.Len(t, u.Edges.Pets, 1)
+	require.Equal(t, luna.ID, u.Edges.Pets[0].ID)
+
+	// Deleting by predicates returns the number of entities that were soft-deleted.
+	n := client.User.Delete().Where(user.Name("nati")).ExecX(ctx)
+	require.Equal(t, 1, n)
+	require.Equal(t, []int{a8m.ID}, client.User.Query().IDsX(ctx))
+	require.Equal(t, 1, client.User.Query().QueryPets().CountX(ctx), "pets of deleted users are filtered out")
+	require.Equal(t, nati.ID, client.Pet.Query().Where(pet.Name("pedro")).QueryOwner().OnlyX(mixin.IncludeDeleted(ctx)).ID)
+	require.False(t, client.Pet.Query().Where(pet.Name("pedro")).QueryOwner().ExistX(ctx))
+
+	// Hard-delete removes the entities from the database.
+	n = client.Pet.Delete().ExecX(mixin.HardDelete(ctx))
+	require.Equal(t, 3, n)
+	require.Zero(t, client.Pet.Query().CountX(mixin.IncludeDeleted(ctx)))
+}
@@ -26,6 +26,8 @@ type config struct {
 	log func(...interface{})
 	// hooks to execute on mutations.
 	hooks *hooks
+	// interceptors to execute on queries.
+	inters *inters
 	// HTTPClient field added by a test template.
 	HTTPClient *http.Client
 }
@@ -37,6 +39,13 @@ type hooks struct {
 	User  []ent.Hook
 }
 
+// interceptors per client, for fast access.
+type inters struct {
+	Group []ent.Interceptor
+	Pet   []ent.Interceptor
+	User  []ent.Interceptor
+}
+
 // Options applies the options on the config object.
 func (c *config) options(opts ...Option) {
 	for _, opt := range opts {
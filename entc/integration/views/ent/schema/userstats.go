@@ -0,0 +1,61 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// UserStats holds the schema definition for the UserStats view.
+type UserStats struct {
+	ent.Schema
+}
+
+// Annotations of the UserStats.
+func (UserStats) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table: "user_stats",
+			View: entsql.ViewFor(dialect.SQLite, func(s *sql.Selector) {
+				u, p := sql.Table("users"), sql.Table("pets")
+				s.From(u).
+					LeftJoin(p).
+					On(u.C("id"), p.C("owner_id")).
+					Select(
+						sql.As(u.C("id"), "id"),
+						sql.As(u.C("id"), "user_id"),
+						sql.As(u.C("name"), "name"),
+						sql.As(sql.Count(p.C("id")), "pets"),
+					).
+					GroupBy(u.C("id"), u.C("name"))
+			}),
+		},
+	}
+}
+
+// Fields of the UserStats.
+func (UserStats) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.Int("pets"),
+		field.Int("user_id"),
+	}
+}
+
+// Edges of the UserStats.
+func (UserStats) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
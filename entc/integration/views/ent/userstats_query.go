@@ -0,0 +1,1096 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/entc/integration/views/ent/predicate"
+	"entgo.io/ent/entc/integration/views/ent/user"
+	"entgo.io/ent/entc/integration/views/ent/userstats"
+	"entgo.io/ent/schema/field"
+)
+
+// UserStatsQuery is the builder for querying UserStats entities.
+type UserStatsQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.UserStats
+	inters     []Interceptor
+	// eager-loading edges.
+	withUser  *UserQuery
+	modifiers []func(s *sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UserStatsQuery builder.
+func (usq *UserStatsQuery) Where(ps ...predicate.UserStats) *UserStatsQuery {
+	usq.predicates = append(usq.predicates, ps...)
+	return usq
+}
+
+// Limit adds a limit step to the query.
+func (usq *UserStatsQuery) Limit(limit int) *UserStatsQuery {
+	usq.limit = &limit
+	return usq
+}
+
+// Offset adds an offset step to the query.
+func (usq *UserStatsQuery) Offset(offset int) *UserStatsQuery {
+	usq.offset = &offset
+	return usq
+}
+
+// Order adds an order step to the query.
+func (usq *UserStatsQuery) Order(o ...OrderFunc) *UserStatsQuery {
+	usq.order = append(usq.order, o...)
+	return usq
+}
+
+// Type returns the node type of this query (UserStats). It implements the ent.Query interface.
+func (usq *UserStatsQuery) Type() string {
+	return TypeUserStats
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (usq *UserStatsQuery) SetLimit(limit int) {
+	usq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (usq *UserStatsQuery) SetOffset(offset int) {
+	usq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (usq *UserStatsQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for UserStatsQuery", fn)
+		}
+		usq.order = append(usq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (usq *UserStatsQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.UserStats:
+			usq.predicates = append(usq.predicates, p)
+		case func(*sql.Selector):
+			usq.predicates = append(usq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for UserStatsQuery", p)
+		}
+	}
+	return nil
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (usq *UserStatsQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: usq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := usq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := usq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(userstats.Table, userstats.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, userstats.UserTable, userstats.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(usq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first UserStats entity from the query.
+// Returns a *NotFoundError when no UserStats was found.
+func (usq *UserStatsQuery) First(ctx context.Context) (*UserStats, error) {
+	nodes, err := usq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{userstats.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (usq *UserStatsQuery) FirstX(ctx context.Context) *UserStats {
+	node, err := usq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UserStats ID from the query.
+// Returns a *NotFoundError when no UserStats ID was found.
+func (usq *UserStatsQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = usq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{userstats.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (usq *UserStatsQuery) FirstIDX(ctx context.Context) int {
+	id, err := usq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UserStats entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one UserStats entity is not found.
+// Returns a *NotFoundError when no UserStats entities are found.
+func (usq *UserStatsQuery) Only(ctx context.Context) (*UserStats, error) {
+	nodes, err := usq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{userstats.Label}
+	default:
+		return nil, &NotSingularError{userstats.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (usq *UserStatsQuery) OnlyX(ctx context.Context) *UserStats {
+	node, err := usq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UserStats ID in the query.
+// Returns a *NotSingularError when exactly one UserStats ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (usq *UserStatsQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = usq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{userstats.Label}
+	default:
+		err = &NotSingularError{userstats.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (usq *UserStatsQuery) OnlyIDX(ctx context.Context) int {
+	id, err := usq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UserStatsSlice.
+func (usq *UserStatsQuery) All(ctx context.Context) ([]*UserStats, error) {
+	if err := usq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*UserStatsQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, usq, qr, usq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*UserStats)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
+}
+
+// AllX is like All, but panics if an error occurs.
+func (usq *UserStatsQuery) AllX(ctx context.Context) []*UserStats {
+	nodes, err := usq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UserStats IDs.
+func (usq *UserStatsQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := usq.Select(userstats.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (usq *UserStatsQuery) IDsX(ctx context.Context) []int {
+	ids, err := usq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (usq *UserStatsQuery) Count(ctx context.Context) (int, error) {
+	if err := usq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*UserStatsQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, usq, qr, usq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (usq *UserStatsQuery) CountX(ctx context.Context) int {
+	count, err := usq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (usq *UserStatsQuery) Exist(ctx context.Context) (bool, error) {
+	if err := usq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*UserStatsQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, usq, qr, usq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (usq *UserStatsQuery) ExistX(ctx context.Context) bool {
+	exist, err := usq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UserStatsQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (usq *UserStatsQuery) Clone() *UserStatsQuery {
+	if usq == nil {
+		return nil
+	}
+	return &UserStatsQuery{
+		config:     usq.config,
+		limit:      usq.limit,
+		offset:     usq.offset,
+		order:      append([]OrderFunc{}, usq.order...),
+		predicates: append([]predicate.UserStats{}, usq.predicates...),
+		inters:     append([]Interceptor{}, usq.inters...),
+		withUser:   usq.withUser.Clone(),
+		// clone intermediate query.
+		sql:       usq.sql.Clone(),
+		path:      usq.path,
+		modifiers: append([]func(*sql.Selector){}, usq.modifiers...),
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (usq *UserStatsQuery) WithUser(opts ...func(*UserQuery)) *UserStatsQuery {
+	query := (&UserClient{config: usq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	usq.withUser = query
+	return usq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UserStats.Query().
+//		GroupBy(userstats.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (usq *UserStatsQuery) GroupBy(field string, fields ...string) *UserStatsGroupBy {
+	group := &UserStatsGroupBy{config: usq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := usq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return usq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.UserStats.Query().
+//		Select(userstats.FieldName).
+//		Scan(ctx, &v)
+//
+func (usq *UserStatsQuery) Select(field string, fields ...string) *UserStatsSelect {
+	usq.fields = append([]string{field}, fields...)
+	return &UserStatsSelect{UserStatsQuery: usq}
+}
+
+func (usq *UserStatsQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range usq.fields {
+		if !userstats.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if usq.path != nil {
+		prev, err := usq.path(ctx)
+		if err != nil {
+			return err
+		}
+		usq.sql = prev
+	}
+	return nil
+}
+
+func (usq *UserStatsQuery) sqlAll(ctx context.Context) ([]*UserStats, error) {
+	var (
+		nodes       = []*UserStats{}
+		_spec       = usq.querySpec(ctx)
+		loadedTypes = [1]bool{
+			usq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &UserStats{config: usq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, usq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := usq.withUser; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*UserStats)
+		for i := range nodes {
+			fk := nodes[i].UserID
+			ids = append(ids, fk)
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(user.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.User = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (usq *UserStatsQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := usq.querySpec(ctx)
+	return sqlgraph.CountNodes(ctx, usq.driver, _spec)
+}
+
+func (usq *UserStatsQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := usq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (usq *UserStatsQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   userstats.Table,
+			Columns: userstats.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: userstats.FieldID,
+			},
+		},
+		From:   usq.sql,
+		Unique: true,
+	}
+	if fields := usq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userstats.FieldID)
+		for i := range fields {
+			if fields[i] != userstats.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := usq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := usq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := usq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := usq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, userstats.ValidColumn)
+			}
+		}
+	}
+	if ms := usq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
+	return _spec
+}
+
+func (usq *UserStatsQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(usq.driver.Dialect())
+	t1 := builder.Table(userstats.Table)
+	selector := builder.Select(t1.Columns(userstats.Columns...)...).From(t1)
+	if usq.sql != nil {
+		selector = usq.sql
+		selector.Select(selector.Columns(userstats.Columns...)...)
+	}
+	for _, p := range usq.predicates {
+		p(selector)
+	}
+	for _, p := range usq.order {
+		p(selector, userstats.ValidColumn)
+	}
+	if offset := usq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := usq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	for _, m := range usq.modifiers {
+		m(selector)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (usq *UserStatsQuery) ForUpdate(opts ...sql.LockOption) *UserStatsQuery {
+	usq.modifiers = append(usq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return usq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (usq *UserStatsQuery) ForShare(opts ...sql.LockOption) *UserStatsQuery {
+	usq.modifiers = append(usq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return usq
+}
+
+// UserStatsGroupBy is the group-by builder for UserStats entities.
+type UserStatsGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (usgb *UserStatsGroupBy) Aggregate(fns ...AggregateFunc) *UserStatsGroupBy {
+	usgb.fns = append(usgb.fns, fns...)
+	return usgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (usgb *UserStatsGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := usgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	usgb.sql = query
+	return usgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (usgb *UserStatsGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := usgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (usgb *UserStatsGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(usgb.fields) > 1 {
+		return nil, errors.New("ent: UserStatsGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := usgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (usgb *UserStatsGroupBy) StringsX(ctx context.Context) []string {
+	v, err := usgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (usgb *UserStatsGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = usgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{userstats.Label}
+	default:
+		err = fmt.Errorf("ent: UserStatsGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (usgb *UserStatsGroupBy) StringX(ctx context.Context) string {
+	v, err := usgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (usgb *UserStatsGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(usgb.fields) > 1 {
+		return nil, errors.New("ent: UserStatsGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := usgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (usgb *UserStatsGroupBy) IntsX(ctx context.Context) []int {
+	v, err := usgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (usgb *UserStatsGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = usgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{userstats.Label}
+	default:
+		err = fmt.Errorf("ent: UserStatsGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (usgb *UserStatsGroupBy) IntX(ctx context.Context) int {
+	v, err := usgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (usgb *UserStatsGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(usgb.fields) > 1 {
+		return nil, errors.New("ent: UserStatsGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := usgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (usgb *UserStatsGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := usgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (usgb *UserStatsGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = usgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{userstats.Label}
+	default:
+		err = fmt.Errorf("ent: UserStatsGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (usgb *UserStatsGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := usgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (usgb *UserStatsGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(usgb.fields) > 1 {
+		return nil, errors.New("ent: UserStatsGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := usgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (usgb *UserStatsGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := usgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (usgb *UserStatsGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = usgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{userstats.Label}
+	default:
+		err = fmt.Errorf("ent: UserStatsGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (usgb *UserStatsGroupBy) BoolX(ctx context.Context) bool {
+	v, err := usgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (usgb *UserStatsGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range usgb.fields {
+		if !userstats.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := usgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := usgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (usgb *UserStatsGroupBy) sqlQuery() *sql.Selector {
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := usgb.sql.ClearLock()
+	columns := make([]string, 0, len(usgb.fields)+len(usgb.fns))
+	columns = append(columns, usgb.fields...)
+	for _, fn := range usgb.fns {
+		columns = append(columns, fn(selector, userstats.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(usgb.fields...)
+}
+
+// UserStatsSelect is the builder for selecting fields of UserStats entities.
+type UserStatsSelect struct {
+	*UserStatsQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (uss *UserStatsSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := uss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		uss.sql = uss.UserStatsQuery.sqlQuery(ctx)
+		return v, uss.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, uss.UserStatsQuery, qr, uss.inters)
+	return err
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (uss *UserStatsSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := uss.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (uss *UserStatsSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(uss.fields) > 1 {
+		return nil, errors.New("ent: UserStatsSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := uss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (uss *UserStatsSelect) StringsX(ctx context.Context) []string {
+	v, err := uss.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (uss *UserStatsSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = uss.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{userstats.Label}
+	default:
+		err = fmt.Errorf("ent: UserStatsSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (uss *UserStatsSelect) StringX(ctx context.Context) string {
+	v, err := uss.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (uss *UserStatsSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(uss.fields) > 1 {
+		return nil, errors.New("ent: UserStatsSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := uss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (uss *UserStatsSelect) IntsX(ctx context.Context) []int {
+	v, err := uss.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (uss *UserStatsSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = uss.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{userstats.Label}
+	default:
+		err = fmt.Errorf("ent: UserStatsSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (uss *UserStatsSelect) IntX(ctx context.Context) int {
+	v, err := uss.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (uss *UserStatsSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(uss.fields) > 1 {
+		return nil, errors.New("ent: UserStatsSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := uss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (uss *UserStatsSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := uss.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (uss *UserStatsSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = uss.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{userstats.Label}
+	default:
+		err = fmt.Errorf("ent: UserStatsSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (uss *UserStatsSelect) Float64X(ctx context.Context) float64 {
+	v, err := uss.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (uss *UserStatsSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(uss.fields) > 1 {
+		return nil, errors.New("ent: UserStatsSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := uss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (uss *UserStatsSelect) BoolsX(ctx context.Context) []bool {
+	v, err := uss.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (uss *UserStatsSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = uss.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{userstats.Label}
+	default:
+		err = fmt.Errorf("ent: UserStatsSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (uss *UserStatsSelect) BoolX(ctx context.Context) bool {
+	v, err := uss.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (uss *UserStatsSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := uss.sqlQuery().Query()
+	if err := uss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (uss *UserStatsSelect) sqlQuery() sql.Querier {
+	selector := uss.sql
+	selector.Select(selector.Columns(uss.fields...)...)
+	return selector
+}
@@ -0,0 +1,61 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package views
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"entgo.io/ent/entc/integration/views/ent"
+	"entgo.io/ent/entc/integration/views/ent/user"
+	"entgo.io/ent/entc/integration/views/ent/userstats"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestViews(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	a8m := client.User.Create().SetName("a8m").SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SaveX(ctx)
+	client.Pet.Create().SetName("xabi").SetOwner(a8m).SaveX(ctx)
+	client.Pet.Create().SetName("luna").SetOwner(a8m).SaveX(ctx)
+
+	// Views are queried like any other type.
+	stats := client.UserStats.Query().Order(ent.Asc(userstats.FieldName)).AllX(ctx)
+	require.Len(t, stats, 2)
+	require.Equal(t, "a8m", stats[0].Name)
+	require.Equal(t, 2, stats[0].Pets)
+	require.Equal(t, "nati", stats[1].Name)
+	require.Zero(t, stats[1].Pets)
+	require.Equal(t, nati.ID, client.UserStats.Query().Where(userstats.PetsEQ(0)).OnlyX(ctx).UserID)
+	require.Equal(t, a8m.ID, client.UserStats.GetX(ctx, a8m.ID).UserID)
+
+	// Edges of views are loaded from their defining query.
+	require.Equal(t, a8m.ID, stats[0].QueryUser().OnlyIDX(ctx))
+	s := client.UserStats.Query().Where(userstats.HasUserWith(user.Name("nati"))).WithUser().OnlyX(ctx)
+	require.Equal(t, nati.ID, s.Edges.User.ID)
+
+	// The view reflects the current state of its underlying tables.
+	client.Pet.Create().SetName("pedro").SetOwner(nati).SaveX(ctx)
+	require.Equal(t, 1, client.UserStats.Query().Where(userstats.UserID(nati.ID)).OnlyX(ctx).Pets)
+
+	// Views are recreated on migration.
+	require.NoError(t, client.Schema.Create(ctx))
+	require.Equal(t, 2, client.UserStats.Query().CountX(ctx))
+
+	// Views are read-only, and do not have mutation builders.
+	typ := reflect.TypeOf(client.UserStats)
+	for _, name := range []string{"Create", "CreateBulk", "Update", "UpdateOne", "Delete", "DeleteOne"} {
+		_, ok := typ.MethodByName(name)
+		require.False(t, ok, "unexpected method %q for view client", name)
+	}
+}
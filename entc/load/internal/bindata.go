@@ -78,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templateMainTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x91\x51\x4f\xdb\x30\x14\x85\x9f\xe3\x5f\x71\x16\x31\x91\xb0\xe2\x02\x6f\x9b\xd4\x07\x04\x9d\xd4\x69\x83\x49\x45\xda\x03\x43\xc8\x75\x6e\x5a\x8b\xd4\xce\xae\x5d\xb4\xca\xca\x7f\x9f\xec\xb4\x6c\x7b\x4b\x7c\xbe\x7b\xce\xb9\x76\x8c\xd3\x33\x71\xe3\xfa\x3d\x9b\xf5\x26\xe0\xea\xe2\xf2\xe3\x79\xcf\xe4\xc9\x06\x7c\x56\x9a\x56\xce\xbd\x60\x61\xb5\xc4\x75\xd7\x21\x43\x1e\x49\xe7\x57\x6a\xa4\x78\xd8\x18\x0f\xef\x76\xac\x09\xda\x35\x04\xe3\xd1\x19\x4d\xd6\x53\x83\x9d\x6d\x88\x11\x36\x84\xeb\x5e\xe9\x0d\xe1\x4a\x5e\x1c\x55\xb4\x6e\x67\x1b\x61\x6c\xd6\xbf\x2e\x6e\xe6\x77\xcb\x39\x5a\xd3\x11\x0e\x67\xec\x5c\x40\x63\x98\x74\x70\xbc\x87\x6b\x11\xfe\x09\x0b\x4c\x24\xc5\xd9\x74\x18\x84\x88\x11\x0d\xb5\xc6\x12\xca\xad\x32\xb6\xc4\x30\x88\xe9\x14\x37\xa9\xcf\x9a\x2c\xb1\x0a\xd4\x60\xb5\xc7\x29\xd9\xa0\xdf\x8e\x4e\x25\x6e\xef\x71\x77\xff\x80\xf9\xed\xe2\x41\x8a\x5e\xe9\x17\xb5\x26\x24\x0f\x21\xcc\xb6\x77\x1c\x50\x89\xa2\x74\xbe\x14\x45\xb9\xda\x07\x4a\x1f\x31\x22\xd0\xb6\xef\x54\x20\x94\x23\xe5\x73\xa4\x28\xc8\x06\xaf\x37\xb4\x55\x88\x11\x3d\x1b\x1b\x5a\x94\xef\x7f\x95\x90\xdf\x0f\xde\xc3\x20\x6a\x21\x5e\x15\x63\x04\x3d\x66\x78\x7c\x22\x1b\xe4\xc2\x06\xe2\x56\x69\x8a\x29\xe2\x1c\xac\xec\x9a\x70\xf2\x3c\xc1\x89\x55\x5b\xc2\xa7\x19\xe4\x9d\xda\x92\x4f\x1e\xc5\xdf\x28\x99\xe0\xb7\x2c\x1f\x87\xf2\x30\x30\x0c\x93\xd1\x89\x6c\x93\x66\x06\x21\xda\x9d\xd5\x79\xbd\xaa\x46\x14\x45\xaa\xd1\x19\x4b\x1e\x8f\x4f\x8f\x4f\x69\x3f\x51\xb4\x8e\xf1\x3c\x39\xb4\x4b\xa1\x63\x8f\x63\xdb\x28\x8a\x62\x35\x01\x31\x27\xed\x9b\x62\xbf\x51\xdd\x32\x8b\xd5\xc8\xd4\xa2\x28\x4c\x9b\x89\x77\x33\x58\xd3\xe5\x99\xa2\x55\xa6\xab\x88\x39\xc9\xa9\xff\x98\x3b\x83\xea\x7b\xb2\x4d\x95\x7f\x27\x58\xd5\x22\xa9\xce\xcb\x65\x68\xdc\x2e\xc8\x1f\x6c\x02\x55\xf9\xea\xe5\x17\x67\xec\x11\x1c\xeb\x56\xe5\x4f\x5b\xd6\x75\xfd\xb6\xdb\x31\x25\xc5\x3b\xce\x4b\x8e\x5e\xc4\x3c\x7a\x2d\x03\x1b\xbb\x4e\x8c\x9c\x27\xa6\xaa\x3f\x64\x93\x0c\xce\x7f\x9b\x50\x5d\x66\xbb\xff\x5e\x79\xdc\x6c\x7c\xe4\x18\x8f\x17\xfa\x27\x00\x00\xff\xff\x54\xe7\x81\x8f\x3b\x03\x00\x00")
+var _templateMainTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x91\x51\x6b\xdb\x30\x1c\xc4\x9f\xa5\x4f\x71\x33\x1d\xb5\xbb\x54\x69\xfb\xb6\x41\x1e\x4a\x9b\x41\xc6\xd6\x0e\x52\xd8\x43\x57\x8a\x62\xff\x9d\x88\x3a\x92\xf7\x97\x52\x16\x84\xbe\xfb\x90\x9d\x74\xdb\x9b\xad\x3b\xdd\xef\x0e\xc5\x38\x3d\x93\x37\xae\xdf\xb3\x59\x6f\x02\xae\x2e\x2e\x3f\x9e\xf7\x4c\x9e\x6c\xc0\x67\x5d\xd3\xca\xb9\x17\x2c\x6c\xad\x70\xdd\x75\x18\x4c\x1e\x59\xe7\x57\x6a\x94\x7c\xd8\x18\x0f\xef\x76\x5c\x13\x6a\xd7\x10\x8c\x47\x67\x6a\xb2\x9e\x1a\xec\x6c\x43\x8c\xb0\x21\x5c\xf7\xba\xde\x10\xae\xd4\xc5\x51\x45\xeb\x76\xb6\x91\xc6\x0e\xfa\xd7\xc5\xcd\xfc\x6e\x39\x47\x6b\x3a\xc2\xe1\x8c\x9d\x0b\x68\x0c\x53\x1d\x1c\xef\xe1\x5a\x84\x7f\x60\x81\x89\x94\x3c\x9b\xa6\x24\x65\x8c\x68\xa8\x35\x96\x50\x6c\xb5\xb1\x05\x52\x92\xd3\x29\x6e\x72\x9f\x35\x59\x62\x1d\xa8\xc1\x6a\x8f\x53\xb2\xa1\x7e\x3b\x3a\x55\xb8\xbd\xc7\xdd\xfd\x03\xe6\xb7\x8b\x07\x25\x7b\x5d\xbf\xe8\x35\x21\x67\x48\x69\xb6\xbd\xe3\x80\x52\x8a\xc2\xf9\x42\x8a\x62\xb5\x0f\x94\x3f\x62\x44\xa0\x6d\xdf\xe9\x40\x28\x46\x97\x1f\x90\x52\x90\x0d\xbe\xde\xd0\x56\x23\x46\xf4\x6c\x6c\x68\x51\xbc\xff\x55\x40\x7d\x3f\x64\xa7\x24\x2b\x29\x5f\x35\x63\x34\x7a\xcc\xf0\xf8\x44\x36\xa8\x85\x0d\xc4\xad\xae\x29\x66\xc4\x39\x58\xdb\x35\xe1\xe4\x79\x82\x13\xab\xb7\x84\x4f\x33\xa8\x3b\xbd\x25\x9f\x51\xe2\x2f\x4a\x65\xf3\x1b\xcb\xc7\x54\x1c\x2e\xa4\x34\x19\x93\xc8\x36\xf9\x4e\x92\xb2\xdd\xd9\x7a\x98\x57\x56\x88\x52\xe4\x1a\x9d\xb1\xe4\xf1\xf8\xf4\xf8\x94\xf7\x49\xd1\x3a\xc6\xf3\xe4\xd0\x2e\x43\xc7\x1e\xc7\xb6\x51\x0a\xb1\x9a\x80\x98\xb3\xf6\x4d\xb3\xdf\xe8\x6e\x39\x88\xe5\xe8\xa9\xa4\x10\xa6\x1d\x1c\xef\x66\xb0\xa6\xcb\x24\x21\x5a\x6d\xba\x92\x98\xb3\x9c\xfb\x8f\xdc\x19\x74\xdf\x93\x6d\xca\xe1\x77\x82\x55\x25\x45\x92\xc2\x79\xb5\x0c\x8d\xdb\x05\xf5\x83\x4d\xa0\x32\x57\xf3\xea\x8b\x33\xf6\x68\x1c\xeb\x96\xc5\x4f\x5b\x54\x55\xf5\xb6\xed\x48\xc9\x78\xc7\xc3\xc8\x31\x8b\x98\xc7\xac\x65\x60\x63\xd7\xd9\xa3\xe6\xd9\x53\x56\x1f\x86\x90\x01\x3a\xff\x6d\x42\x79\x39\xc4\xfd\xf7\xca\xe3\xb2\xf1\x91\x63\x04\xd9\x06\x29\xc9\x3f\x03\x00\x54\xe7\x81\x8f\x3b\x03\x00\x00")
 
 func templateMainTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -98,7 +98,7 @@ func templateMainTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _schemaGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x5a\xdd\x6f\xdb\x46\x12\x7f\x96\xfe\x8a\xa9\x81\x06\x64\xa0\xd2\xbd\xa2\x28\xee\x94\xd3\x01\x45\x9a\xa0\xbe\x5e\xdc\xa0\x49\xfa\x62\x18\x2e\x4d\x0e\xe5\x8d\xc9\x5d\x75\xb9\xf2\x47\x5d\xff\xef\x87\x9d\xd9\x25\x97\x14\x29\x29\xfe\xc8\x4b\xc4\xd9\x99\xd9\x99\x1f\xe7\x6b\x97\x3e\x3c\x84\xd7\x6a\x75\xab\xc5\xf2\xc2\xc0\x77\xdf\xfe\xe3\x5f\xdf\xac\x34\xd6\x28\x0d\xbc\x4d\x33\x3c\x57\xea\x12\x8e\x64\x96\xc0\x8f\x65\x09\xc4\x54\x83\x5d\xd7\x57\x98\x27\xd3\xc3\x43\xf8\x78\x21\x6a\xa8\xd5\x5a\x67\x08\x99\xca\x11\x44\x0d\xa5\xc8\x50\xd6\x98\xc3\x5a\xe6\xa8\xc1\x5c\x20\xfc\xb8\x4a\xb3\x0b\x84\xef\x92\x6f\xfd\x2a\x14\x6a\x2d\x73\xab\x42\x48\x62\xf9\xdf\xd1\xeb\x37\xc7\x1f\xde\x40\x21\x4a\xf4\x34\xad\x94\x81\x5c\x68\xcc\x8c\xd2\xb7\xa0\x0a\x30\xc1\x7e\x46\x23\x26\xd3\xe9\x2a\xcd\x2e\xd3\x25\x42\xa9\xd2\x7c\x3a\x15\xd5\x4a\x69\x03\xd1\x74\x72\x80\x32\x53\xb9\x90\xcb\xc3\xcf\xb5\x92\x07\xd3\xc9\x41\x51\x19\xfb\x9f\xc6\xa2\xc4\xcc\x1c\x4c\x89\xc7\x2c\x55\x22\xd4\x21\x4a\x5a\x0b\x9f\x0f\xeb\xec\x02\xab\x74\x84\x7c\x88\xf9\x12\xc7\xd6\x0a\x81\x65\x3e\xb6\x28\x64\x8e\x37\x07\xd3\x78\x6a\xbd\xff\x40\x34\xd0\xe8\x70\xaf\x21\x95\x80\xd2\x24\x6e\xc1\x5c\xa4\x06\xae\xd3\x9a\xdc\xc3\x1c\x0a\xad\x2a\x48\x21\x53\xd5\xaa\x14\x16\xe3\x1a\x35\x38\x08\x92\xa9\xb9\x5d\xa1\x57\x59\x1b\xbd\xce\x0c\xdc\x4d\x27\xc7\x69\x85\xe0\xfe\xd5\x46\x0b\xb9\x84\xfe\xbf\x3f\x2c\x46\xf3\x03\x99\x56\x38\x53\x95\x30\x58\xad\xcc\xed\xc1\x1f\xd3\xc9\x6b\x25\x0b\xe1\xf8\xad\x59\xe1\x73\x57\x36\xa3\x95\xae\xf4\x9b\x7c\x89\xb5\x63\x3b\x39\x7d\x69\x1f\x47\x76\xb6\x68\xd6\x5d\xe1\xb7\x16\xc4\xba\x11\xa6\xc7\x61\x61\x82\xbb\x27\x7d\x64\x51\x76\x9b\x9f\x9c\xbe\xa4\xc7\x61\x69\xc1\x9c\x5d\xf1\x9f\x95\xba\x0c\x2c\x7f\xaf\x6a\x61\x84\x92\x03\xe2\x17\x96\xb3\x2b\xfc\x5e\x95\x22\xbb\xdd\x47\x78\x45\x9c\x5d\xe9\x1f\xa5\x54\x26\xb5\x02\x35\x54\xe9\xea\x84\x5f\xd9\xa9\x90\x06\x75\x91\x66\x78\x77\xef\xa5\xd3\x96\xb3\xa3\xe2\x9e\x42\xab\xd9\x36\xc7\x3a\xd3\xe2\x1c\x6b\x48\x61\xe5\x89\x2e\xc5\x38\x26\x5d\xe4\x34\x12\x6d\xec\x04\xb8\x09\x69\x00\x0e\x0f\x81\x49\x4e\x9e\xa0\x3f\xb4\x18\x40\x29\x6a\x93\x4c\x27\xef\xc4\x0d\xe6\x47\xe4\xec\xb9\x52\xa5\x93\x10\x59\x6a\xb0\x06\x51\x04\xbb\x82\x3a\xff\x8c\x19\x87\x77\x65\xa5\xbe\x11\x92\x15\x08\xe9\x37\xe1\x2d\x89\x04\x22\xdc\xb8\x22\x12\xef\xc9\xfe\x72\x80\x6c\x66\x12\xd3\x1f\x90\x48\x2c\x38\x9c\x47\xa3\x99\x34\x9e\x4a\x47\xb2\x50\x2d\xdb\x4b\x42\x2e\xf9\x78\xbb\xc2\xce\x82\x13\xb7\x06\x74\xc5\x3f\xa6\xe1\x66\x3b\x76\x37\x69\x2f\x13\x3f\x88\xbf\x02\xdb\x5f\x0a\x69\x7e\xf8\x7e\x54\xba\x16\x7f\xf5\x36\x7f\x23\xd7\x55\xdd\xb0\x9d\x9c\x32\x28\x77\x70\x3c\x83\xdf\xbd\x2d\x4d\x58\xa2\x65\xee\xca\x7f\x92\xe2\xcf\x75\x63\x00\xc5\xc5\xc0\x3f\x27\xbf\x26\xe6\xae\x82\x63\x51\x96\xe9\x79\x89\x7b\x29\x90\x8e\xb9\xab\xe2\xd7\x95\x8d\xed\xb4\xdc\x4b\x85\x72\xcc\x5d\x15\x3f\x61\x91\xae\x4b\xb3\x9f\x1b\x39\x33\x0f\x6a\xf8\x3d\x2d\x2d\x1c\x61\x4e\x8f\x6b\x38\xbb\xb2\xdc\x83\x7a\x7e\x11\xd2\xd6\x44\xd7\xd2\x12\xf7\x38\xa6\xe7\x52\xc8\xbc\xf7\x5e\x56\x79\x6a\xd0\xbb\xb5\xeb\xbd\x10\xf3\xd9\xa0\x5f\x47\x55\xb5\x36\xcd\x0b\xda\xa1\x48\x78\xe6\xae\x8e\xdf\xd3\x52\xe4\xa9\x51\x9a\x22\x8d\x72\x7f\x5c\xc7\x55\xc3\xdc\x0b\x74\xa3\x74\xba\xc4\x5f\x90\xea\xef\x8e\x34\xa9\x99\xf9\xec\x12\x6f\xfb\x15\x3c\x2c\xd9\x83\x15\x3c\x2c\xe2\xbc\xda\x33\x04\xa5\x25\x5f\xed\x85\x48\xed\x99\x7b\x3a\xa8\x4e\xda\x1a\x61\x79\x83\x66\xd0\xf1\xcb\xeb\x20\xe6\xb3\xcd\xca\x11\x36\x14\x18\x6b\x29\xbb\x7a\xca\xe4\xb5\xaa\x2a\x6c\xde\xc9\x0e\x60\x33\x66\x1e\xe8\x4a\x34\x03\x6c\x16\x69\x22\x3f\xa0\x46\x93\xdc\xd3\x8c\x3a\x1e\xe6\xdd\xb2\xdb\x8b\xf3\x0e\xd9\x7e\x65\x0e\xe7\x9a\xed\xa2\xd4\x31\xba\xc2\xbf\x61\xd1\xb8\xbc\x5d\x58\x63\x71\xb6\xe9\xf3\x6f\x58\x34\x8c\x83\xe3\x59\x28\x3f\x5e\xd2\x47\xa2\x7b\x4b\x3d\x3f\x92\x57\xa8\xeb\xad\xb9\xd1\x8c\x67\xc4\xd9\xb7\xfb\xcf\xb5\xd0\x98\xef\x16\xd7\x8e\x73\xbc\x4a\xbc\xb4\xb3\x67\xd2\xad\x1b\x7b\x94\x88\xa7\x1a\xd3\x78\xd2\xd9\xcc\x08\xa6\x3f\x20\x25\x58\xb0\xcd\x89\xe0\x45\x35\x50\x6d\x79\x33\xc1\xcc\x7e\xe2\xeb\xcc\x5e\x43\x7a\x9f\x7b\x68\x2a\x0f\x50\x6e\xc2\x75\x07\xd0\x8c\xd2\x31\x5e\x53\x78\x66\x1a\x69\x90\x4c\xa5\x47\xc4\x1a\xc5\xb0\xd0\x2f\x1e\x76\x57\x46\xe9\x64\x5a\xac\x65\xe6\x25\x23\xcc\xdd\x9b\xfe\xa9\xe1\x88\x5d\xcc\xdf\x4d\x27\x12\x61\xbe\x80\x17\xf6\xf1\x6e\x3a\xb1\xf9\x3c\x6f\x22\x09\xf3\xe4\x63\xba\x9c\x59\xf2\xed\x0a\xe7\x21\xd9\x16\x82\xe9\x84\xca\x4e\x48\xb7\xcf\x96\x4e\xe8\xcc\x5b\x3a\x3d\xdb\x05\x7e\x27\xf3\x66\x81\x9f\xed\x8a\x4b\x8c\xb9\x5f\x71\xcf\x76\xc9\x07\xfd\xdc\x2d\xf9\x67\x5e\x2b\x5a\x23\x68\xad\xf0\x46\xb4\x98\xcf\x69\xa9\x7d\xb6\xab\x41\x18\xcf\xa1\x4a\x2f\x31\x1a\x0e\xe6\x78\x36\x9d\xdc\x4f\x27\x85\xd2\x70\x36\x83\xd4\x58\xb8\x74\x2a\x97\x68\x55\x86\xb9\x60\xe1\x93\x98\xa4\x79\xde\x52\xa3\xd4\xc4\x24\x2e\x0a\x3b\xab\x58\x59\xb6\xf1\x15\x3d\x7e\xb5\x00\x29\x4a\x2f\x69\x6b\xd2\xa2\x79\x6d\x1a\x8b\x98\xe9\x41\xec\x2c\x80\xf9\x02\x1a\xa9\xd7\x68\xd6\x5a\x82\xc4\x36\x6a\xb8\xbc\x36\x61\xd3\xe4\x11\x91\x29\x6c\xf8\xe7\x50\xdc\x90\x6c\x54\xe4\x7e\x58\x0f\x23\x27\xe2\x03\xe9\x0c\x50\x6b\xfb\x7c\x47\xce\x15\x79\xf2\x46\xeb\xd0\x21\x6f\x92\x28\x67\x50\x54\xc6\x2e\x2b\x5d\x44\x9c\x1d\xf0\xf5\x9f\x73\xf8\xfa\xea\x60\x66\x05\xe9\x7d\x39\x0d\x8c\x56\x4d\x48\xbd\xa0\x8d\xee\xfa\x61\x06\x8d\x0c\x45\x4d\xa1\xba\x2b\x96\x32\xeb\x47\x32\xad\xb8\x58\xa6\x91\x7e\x1e\x2e\x10\x65\x23\x3a\x69\xa9\x8d\x4f\x3f\x88\xcf\x5b\x1b\xfc\xb4\x3d\x9d\x34\x33\x76\xbb\xea\x29\x76\xd5\xcd\x99\xf3\x56\xaf\x9f\x3c\x19\x30\xda\x3b\x9c\x48\xe7\xb4\x77\x67\x46\x6d\x39\x9b\x91\x73\xde\xf8\xdc\xcc\x95\xfd\xb0\xa7\xe5\x6e\xe0\xb7\xd3\x26\xad\x97\x28\xa3\x22\x4f\x5a\x6a\x4c\x4a\xfc\x5c\xd6\xec\xd1\x50\x68\xb9\x99\xcf\x9a\x3d\x1a\xca\x46\x72\xc1\xae\xf4\xf2\x23\x56\x80\x8f\xa3\x8c\xe6\x5e\xb1\x99\x7b\x75\x31\x9e\x7b\x75\x41\x71\x01\x8b\xdd\xf1\x59\x89\xba\xb6\xf5\x99\x5a\x8a\xb0\x42\x76\x7b\x1f\xb5\x07\x33\xab\xcb\x46\x5f\xab\xdb\x9e\x30\xe7\x0b\xa0\xa3\xa5\x85\xd2\x1e\x39\xe3\x57\x4c\xff\x6a\x01\xdf\x7a\xeb\xe8\x28\xba\x80\x17\x76\x21\x30\xcc\xbf\x60\xc7\x15\x1e\x70\x16\xcd\x01\xc7\x02\xfb\x6b\x11\xb5\x91\x13\xd3\x99\x27\x62\x2b\x6c\x37\xe5\x0b\x06\x77\x46\x01\x3a\x39\x41\x96\x4a\x38\x47\xa0\x0b\x41\xcc\xc1\x28\xe2\x59\xa2\x44\x9d\x52\xc2\x5b\xc9\xb7\x4a\x03\xde\xa4\xd5\xaa\xc4\x19\x48\x65\x20\x05\x5b\x07\x68\xec\x2f\xc5\x25\x82\x11\x15\x26\xc7\xea\x3a\x21\x8b\xcf\x28\xf3\xad\xc3\xb6\x7d\x25\xef\x52\x5d\x5f\xa4\x65\x68\xd9\x2b\x62\x08\xa0\x6e\xbd\xe2\xe3\xdf\x22\xc8\x80\xb0\x7c\xd5\xc5\xcc\xca\xb4\x35\x8c\x3b\xfa\x66\xeb\xe3\x0b\x11\x2a\x62\xfc\x73\xa8\x88\x91\x70\x24\xf2\x1b\x7b\xea\xcf\xf1\xa6\xdb\xff\x58\xf5\x5d\xb3\xf7\x0b\x22\x58\x6b\x69\x0e\x70\xa1\x28\xf2\x1b\x9a\xd0\xeb\xa6\xa9\xb9\x15\xbb\xc0\xcf\xfd\xba\x61\x57\xda\xaa\x11\x26\xa3\x5d\xe9\xa4\xe2\xbd\xf3\xd4\x61\xe8\xee\x2f\xf9\x6d\xd1\x9b\x0a\xee\x43\x9b\x94\xb1\xbf\x14\xa4\xf0\xdf\x0f\xbf\x1e\x5b\x61\x1a\x94\xdc\x8b\xce\x91\x5f\x34\xb1\x58\x05\x1f\x3a\xf7\x4d\xfc\x9f\x43\xa8\xb3\x69\x54\xfb\xbd\xed\xfc\xe5\x76\x8a\x21\x3a\x87\x93\xd3\xf3\x5b\x83\xfc\xce\xdb\x8a\x5f\x53\x7d\x66\xd9\x3b\x4a\x60\x59\x08\x5f\x6f\xdd\xd5\x1a\xd3\xa2\x78\x63\x4e\x10\x92\xaf\xb6\xa3\x5e\x70\xb3\x5c\x1c\x53\x7a\xb1\xdc\x17\x36\x69\x51\xf8\xd8\xac\x13\x1b\x2a\x74\x7d\xe6\xf5\x72\x58\xee\xd1\xa1\x1c\x16\xd4\xa2\xae\x6d\xc2\xbb\x0e\x85\xbe\x3d\xb5\xd7\xd7\xc1\x84\x0b\xea\x0a\xb5\x16\x39\x36\x57\x7a\xe1\x6a\x32\x58\xc5\x1c\x52\x81\x97\x51\xcc\x19\x33\x5e\xca\x3a\x0e\x72\x08\x3e\xbd\x87\x3c\xf0\x36\x7b\xa5\x05\x52\x16\xf8\x8d\x1a\x43\x9e\x62\x2f\x87\x0b\x86\x83\x95\x9d\xc4\x19\x07\x9e\xca\x17\x90\xae\x56\x28\xf3\xc8\x11\x66\xed\x74\x1b\xa4\x75\x14\xc7\x0e\x26\x77\xb3\x1d\x3a\xe0\xee\xc5\x9f\xd3\x05\x5b\x6b\x1a\x27\x9c\x0d\xce\x0d\x7f\x2b\x1f\x38\x72\xe4\x8d\x0c\x6b\xd5\xa0\x37\xbd\x97\x4e\x57\xf4\x4f\xff\xce\xfb\xdb\xf0\x65\xfe\xd3\xef\xe3\x04\x3b\xdd\xa3\x8e\x5d\x29\xfc\x24\xab\x4e\x31\xe4\x8a\x56\x73\xdf\x12\x57\x28\xe1\x7c\x5d\x14\xa8\x81\x6a\xa0\x6b\x07\xfe\x2e\x9f\xea\x5a\x4f\x43\x74\xbe\x2e\x5c\x11\xb3\xb3\x2b\x13\x67\x63\xa5\xac\x03\x03\x59\xd8\xa8\xb3\x8a\x66\x50\x6f\x07\x02\xb5\x0e\x03\xa2\x08\x52\xdd\xb5\x0b\x12\x69\xf7\x28\x12\xd7\xb1\xeb\x68\x53\xf3\xa6\x6a\xab\x3b\xe8\x97\x61\xbb\x6c\xea\x1d\xfd\xaa\xdd\x77\x02\xa3\xfc\x37\x07\x3e\x29\x86\xf5\xdd\x01\x16\xd5\xe0\x60\x89\xa1\x5f\x34\xfb\x0d\x81\x60\xb3\xb6\x91\xf6\x4e\x7e\x75\x6a\xed\x96\xec\x0a\x21\x12\x33\xa8\x82\x94\x61\x93\xe9\x34\x94\x56\x6e\xa6\x1a\x6e\x15\xd5\x4d\xd3\x26\xa6\x93\x89\x3b\x70\x87\xd6\xb8\xc2\x58\xdd\xc4\x2d\xdc\x03\xc8\x76\x07\x3f\xbb\x7b\x13\xb7\x32\x88\x5a\x6b\x2f\x19\xfc\xb9\xf3\x4e\x8b\xf6\x8d\x4e\xec\xec\xe2\xf6\x6f\x0f\x50\xdd\x6c\xb6\x6c\x03\xa6\x7c\xa9\x2d\x64\x8c\x9d\xa9\x9a\xcb\xd9\x05\xbc\xf0\xbf\x59\x23\x95\x13\xd7\x6f\x3f\xcf\x88\xe4\xbe\x4e\x11\xd1\x68\x1e\x4e\x26\xc1\x27\xa7\x39\x88\x59\xab\xdc\x07\x6b\x50\xae\xdc\xb4\x03\x75\xe1\x01\x19\x6b\x12\x4f\x0d\xfa\x58\x73\x78\x50\x77\x20\xad\xdb\xfa\xc3\x33\x58\x3f\xda\x17\x1e\xd3\x18\x68\x03\xfe\x06\x1b\xba\xc1\xcd\xe1\xc9\xe3\xbe\xb5\x9f\xb6\xf4\xd6\xf3\xd7\xe2\xc0\xf6\x9f\xd9\xa0\x27\x8c\x47\x6f\x86\xfb\x62\x1c\xfa\xea\x3a\xd4\x53\x3a\x2b\x0a\xe0\x8d\x3a\x8a\xea\xc4\x7d\xd9\x0e\x3c\x7d\xef\xec\xe9\xb9\xfa\xc5\x7e\x0d\x8c\x85\xd5\xcd\xc0\x48\x38\x3c\x13\x76\x1b\x42\xb7\x1b\xb8\x1c\xe6\x76\xc0\x07\xd8\x07\xb4\x83\xce\x88\x39\xda\x0f\xc6\x4b\xf0\x17\x77\x84\xe1\x02\xbb\x5f\x7d\x1d\x0f\x82\xa6\x7d\x8e\x56\x4e\xff\x7a\x88\x67\x57\x01\xdc\xc0\x7c\x10\xbb\x70\x52\x1b\x85\x6e\x2c\x87\xbf\x10\xb8\xa1\x0c\xdd\x37\x41\x9b\xfc\xe4\xd8\x6c\x62\xb8\x48\x4b\xbe\x81\xbd\xdf\xdb\xe5\xce\xd4\x38\xea\xf3\x78\x32\xef\xef\xf5\x60\xaa\xee\x97\xa9\xfb\xb9\xd3\x4b\x37\xb9\x79\x5c\xa3\xcc\xcc\xd6\x5a\xcf\x40\x5d\xf2\xe4\x1c\x24\xee\x49\x2a\xdd\x8c\x72\x4a\xd6\x7e\xa5\x2e\x9d\x8d\xc3\x4c\xd6\x66\xd9\xf8\xe9\x7d\xac\xbc\x6e\xbb\x4f\xe2\xf0\x49\xde\xa1\x5e\xa2\x8e\x5f\xc1\x6e\x9d\x15\x33\x47\xa9\x8c\xdd\x95\x03\x7b\x8a\xfc\x11\xe0\x41\x7e\xe2\x3e\x7e\x8e\x31\x3d\xc6\xcf\x2d\x3a\xc7\xfc\x2c\x80\x6f\xac\x1f\xe4\x68\xb1\x8f\xa3\x63\x4c\x8f\x71\x74\x8b\xce\xdd\x8e\xb6\x67\x8a\x36\xe5\xac\xbd\xed\x65\xe3\xdf\x7f\xdb\xa7\x23\x59\xa8\xe4\x78\x5d\xa1\x16\x59\x14\x5b\x62\xef\xfe\xb1\xbd\x80\x7c\x6b\xb7\xe8\x1e\x77\xc8\x21\xd9\x22\x15\xde\xf1\x25\x51\x51\xaa\xd4\xfc\xf0\x7d\xdc\x41\x6a\xa0\x23\xaf\x25\xde\xac\x30\x33\x98\xf7\x2e\x2f\xe9\x02\xb6\xb9\x7b\x9d\xf3\xe5\x6b\x78\xf7\x5a\x5f\x0b\x93\x5d\x80\xe1\xdd\xc9\x17\x7b\x3c\x78\x45\xef\x30\xad\x11\x0c\xfc\x67\x01\xe1\x9f\x1b\x99\x7f\xc2\x8b\x17\x60\xe0\xdf\x3d\xf2\x0f\xdf\xcf\x09\xf2\xde\x2d\x25\xdf\xe8\x5a\x94\x87\xd4\x7d\x12\xc3\xfa\x3e\x89\x51\x85\xeb\x56\xe3\x50\xd3\x6e\xbb\x26\x5c\xeb\x74\x55\x87\x7f\xa8\xe6\xe8\xa9\xcc\xf9\x98\xe4\x09\x15\x9a\x0b\x95\xc3\xb5\x30\x17\xa0\x31\x53\x57\x7c\x36\x46\x59\xaf\x35\x82\x54\xb0\x4a\xa5\xc8\x6a\x10\x12\xdc\x41\x56\xc8\xa5\x6b\xf5\x41\x97\x2e\xf2\xe0\x6f\x72\xc0\x11\x63\x38\x39\x6d\xff\x90\xec\x3e\x86\xc8\x35\xe4\x80\xdc\xbf\x19\xcc\xd1\x9e\xce\xad\x7a\x37\xb7\x88\x02\xae\xa8\x37\xb1\x71\xf6\x98\x7b\xd5\x69\xd0\x74\x59\xdc\x09\x89\xaf\x3f\x7a\xef\xd8\xf8\xe6\x53\xd1\x0c\xae\xe8\x04\x54\xf8\xe6\x4c\x51\x48\x33\x90\x3d\x08\xfa\xe8\x72\xdf\x1b\xeb\x28\x9e\xf5\xd0\xe5\xf3\xc2\x06\xb8\x4c\x7e\x2c\x94\xe1\x15\x59\x88\x26\xd3\x3d\x98\xf4\xe1\xd5\x62\xc9\x07\x99\x96\xf8\x1c\x48\x76\xfc\xeb\x80\xc9\x40\xa2\x3b\x3f\x0d\xe2\x18\x0a\x6f\x42\xe9\x0f\x2e\x1b\x60\xfa\x85\xc7\xc2\xd9\xbd\xb0\x0b\x01\xf5\x2b\x1e\x52\xbe\xcb\xb7\x98\xfa\xc3\x55\x40\x7f\x46\x58\xbd\xa7\x03\xc0\x8a\xe6\x58\xb7\x0d\xda\xc6\x91\x3e\xb8\x7c\x91\xb3\x01\x2d\x93\x1f\x0b\xec\xb6\x0b\x9e\x88\x0f\x48\x8c\xdf\xbb\xf6\x92\xe7\x59\xf0\x63\x77\x06\xd0\x63\x23\xb6\x63\xc7\x5e\x6c\x20\xc7\x03\xef\x06\x72\x4c\x7e\x2c\x72\x9d\x79\x3e\x08\x48\xa6\xfb\x70\xb4\x4f\x14\x8d\x3c\x88\xb7\xc4\x67\x84\x92\xfd\x1b\x80\xf2\xc2\x1d\x00\xb6\x41\xe9\xcc\xef\x43\xe9\x26\xe9\x0d\x2c\x1d\xfd\xb1\x60\x76\x4f\x0a\x01\x9a\x6e\x21\xa6\xd8\x74\x9b\x59\x38\xdd\xb4\xdf\x52\x9f\x11\x4f\xb7\xed\x00\xa0\x2b\x7f\xbe\xd8\x86\xa8\x77\x61\xd6\x39\x5c\x34\xb7\x99\xa6\xf3\x5d\x37\xee\x3c\xd1\x69\x5a\x69\x30\xee\x03\x6f\x38\x84\xbd\x37\x34\xca\x4d\x0c\x2c\xc0\x24\x6f\x4a\xac\xa2\xce\x28\x61\xa6\xf7\xd3\xff\x07\x00\x00\xff\xff\x50\x9b\x50\x07\xda\x32\x00\x00")
+var _schemaGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x5a\x6d\x6f\xdc\x36\xf2\x7f\xbd\xfa\x14\x13\x03\x35\xb4\xc6\x56\xdb\x7f\x11\x04\xff\x73\x6e\x0f\x28\xd2\x04\xe7\xeb\xc5\x0d\x1a\xa7\x6f\x0c\xc3\x95\x25\x6a\x97\xf1\x8a\xda\x8a\x5c\x3f\xd4\xf5\x77\x3f\xcc\x70\x28\x91\x2b\xc9\xbb\xf1\x43\xde\xc4\x1c\x72\x86\x33\x3f\xce\x13\xa9\x9d\x4e\xe1\x5d\xb5\xba\xad\xe5\x7c\x61\xe0\xc7\x1f\xfe\xef\x1f\xdf\xaf\x6a\xa1\x85\x32\xf0\x21\xcd\xc4\x45\x55\x5d\xc2\x91\xca\x12\xf8\x69\xb9\x04\x5a\xa4\x01\xe7\xeb\x2b\x91\x27\xd1\x74\x0a\x27\x0b\xa9\x41\x57\xeb\x3a\x13\x90\x55\xb9\x00\xa9\x61\x29\x33\xa1\xb4\xc8\x61\xad\x72\x51\x83\x59\x08\xf8\x69\x95\x66\x0b\x01\x3f\x26\x3f\xb8\x59\x28\xaa\xb5\xca\x51\x84\x54\xb4\xe4\xbf\x47\xef\xde\x1f\x7f\x7e\x0f\x85\x5c\x0a\x47\xab\xab\xca\x40\x2e\x6b\x91\x99\xaa\xbe\x85\xaa\x00\xe3\xed\x67\x6a\x21\x92\x28\x5a\xa5\xd9\x65\x3a\x17\xb0\xac\xd2\x3c\x8a\x64\xb9\xaa\x6a\x03\x71\x34\xda\x13\x2a\xab\x72\xa9\xe6\xd3\xaf\xba\x52\x7b\xd1\x68\xaf\x28\x0d\xfe\x57\x8b\x62\x29\x32\xb3\x17\xd1\x1a\x33\xaf\x12\x59\x4d\x85\x32\x7b\x1b\xe3\xa9\xce\x16\xa2\x4c\x07\xc8\x53\x91\xcf\xc5\xd0\x5c\x21\xc5\x32\x1f\x9a\x94\x2a\x17\x37\x7b\xd1\x38\x42\xeb\x3f\x13\x0d\x6a\xc1\xb8\x6b\x48\x15\x08\x65\x12\x9e\x30\x8b\xd4\xc0\x75\xaa\xc9\x3c\x91\x43\x51\x57\x25\xa4\x90\x55\xe5\x6a\x29\x11\x63\x2d\x6a\x60\x08\x92\xc8\xdc\xae\x84\x13\xa9\x4d\xbd\xce\x0c\xdc\x45\xa3\xe3\xb4\x14\xe0\xfe\x69\x53\x4b\x35\x77\xa3\xe6\xdf\x1f\x08\xd2\xe1\x9e\x4a\x4b\x31\xa9\x4a\x69\x44\xb9\x32\xb7\x7b\x7f\x44\xa3\x77\x95\x2a\xa4\x5b\x8f\x8a\x05\x84\x80\x39\xa3\x99\x90\xfd\x7d\x3e\x17\xda\xad\x3b\x3d\x3b\xc0\xb1\x1b\x6e\xb0\x23\xa0\x3a\xe4\xfe\x80\x38\x3a\xf6\xd3\xb3\x03\x1a\xf3\x70\x83\x9b\x20\xdf\x60\x3f\x42\xa4\xdd\xf6\xa7\x67\x07\x34\x76\x7c\x21\x3b\x1d\xca\xe6\xf6\xff\xae\xaa\x4b\xb7\x3b\x9c\x9e\x1d\x7c\xaa\xb4\x34\xb2\x52\x3d\xfc\x0b\x5c\xba\xb9\xbb\x11\x75\x26\x56\xa6\xaa\xf5\x16\x6e\xe9\x2d\x0d\x85\x7c\xaa\x96\x32\xbb\xdd\x49\x85\x15\x2d\x0d\xd9\x7f\x52\xaa\x32\x29\x32\x68\x80\x32\x5d\x9d\xda\xe3\x3f\xa3\x0d\x8b\x34\x13\x77\xf7\x8e\x3d\x6d\x97\x06\x32\xee\xc9\x4f\x9b\x7d\x73\xa1\xb3\x5a\x5e\x08\x0d\x29\xac\x1c\x91\xe3\xd5\x06\x0c\xbb\x61\xc3\xd1\x3a\xa2\x87\xbf\x54\x06\x60\x3a\x05\x4b\x62\x7e\x3a\xc3\x29\x42\x39\xf5\x10\x81\xa5\xd4\x26\x89\x46\x1f\xe5\x8d\xc8\x8f\x08\xfc\x8b\xaa\x5a\x32\xb7\xcc\x52\x23\x34\xc8\xc2\xd3\x00\xaa\x8b\xaf\x22\xb3\x71\x53\x22\xd7\xf7\x52\x59\x01\x52\xb9\x0d\xed\xf6\x44\x02\xe9\x2b\x51\x12\xc9\xee\x69\x6d\xb7\x5e\xd7\x0d\x51\x4b\x7f\x44\x84\x5a\xc6\x81\x00\x1d\x8a\xd0\xe1\x10\x3d\x52\x45\xe5\x16\x01\x1c\x10\x8a\xc9\xc9\xed\x4a\x04\x13\xcc\x8e\x0a\x84\xec\x27\xe9\x1c\x76\xde\xdd\xa4\xf3\x90\xfb\xb3\xfc\xcb\xd3\xfd\x40\x2a\xf3\xe6\xb5\x1b\x75\xb8\xb5\xfc\x6b\x63\xf3\xf7\x6a\x5d\x36\x21\x06\xa7\x67\x16\x94\x3b\x38\x9e\xc0\xef\x4e\x97\xc6\x45\x05\x2e\x0e\xf9\xbf\x28\xf9\xe7\xba\x51\x80\xfc\xc2\x0d\xba\xdb\xaf\x69\x71\x28\xe0\x58\x2e\x97\xe9\xc5\x52\xec\x24\x40\xf1\xe2\x50\xc4\xaf\x2b\xf4\xf3\x74\xb9\x93\x88\x8a\x17\x87\x22\x7e\x16\x45\xba\x5e\x1a\xd8\x49\x44\x6e\x17\xf7\x4a\xf8\x3d\x5d\x22\x1c\x7e\x7c\x0f\x4b\x38\xbf\xc2\xd5\xbd\x72\x7e\x91\x2a\x07\x00\xae\x95\x09\x0f\x87\xe4\x5c\x4a\x95\x87\x62\xbe\xac\xf2\xd4\x08\x16\xb6\xcd\xa0\x35\x2d\x3e\x67\x69\xa1\xa0\xa3\xb2\x5c\x9b\xe6\x80\xb6\x08\x92\x6e\x71\x28\xe3\xf7\x74\x29\xf3\x94\xf2\xb0\x4b\x3d\xc3\x32\xae\x9a\xc5\xa1\x90\x77\x95\xd2\xa6\x4e\xa5\x32\xba\x8d\xb2\x90\xd8\x0a\xc9\x5a\x7a\x28\xe5\xb3\xa9\xea\x74\x2e\x7e\x11\xb7\x3b\x04\x9b\xb6\x8b\xcf\x2f\xc5\x6d\x28\xa5\xc9\xad\xb4\xba\xb7\x28\xb4\x52\x5c\x96\xde\x50\x44\x28\x24\x5f\xed\x84\xab\x76\x8b\x37\x64\x50\xbe\xc7\x4c\x03\x10\x94\x97\xc0\x2e\x27\x83\x16\x9f\x77\xf3\x4f\x50\xa3\x86\x8a\xd4\xb6\x2a\x35\x7a\x57\x95\xa5\x68\x4e\x76\x0b\xb0\x99\x5d\x1c\x48\xb0\xb9\x9e\xfa\x93\x6e\xaa\x27\xf2\x23\x32\x3d\xf1\xf5\x27\xfa\x01\x15\x07\xb3\xbc\x83\x79\x3b\xef\xc3\x29\x7e\x0b\xef\x66\x7e\xf7\x5b\xae\x87\x59\xa9\xee\x84\xcc\xbf\x89\xa2\x31\xf9\x61\xe6\x5a\x14\xe7\x5d\x9b\x7f\x13\x85\x5b\x07\xbd\xad\xa3\xcf\x3f\x5c\x18\x06\xbc\xfb\x81\xaa\x70\xa4\xae\x44\xad\xc5\x0e\xdc\xd2\xae\x0c\xd9\x7f\x13\x7f\xae\x65\x2d\xf2\xed\xec\x35\xaf\x1c\xce\x12\x07\xd8\x17\x27\x61\xde\xd8\x21\x45\xf8\x51\x35\x10\x53\xdb\x42\xea\x64\x51\x57\xeb\xf9\x82\xb6\x3b\xf0\x0a\xf3\x49\xa7\x30\x1b\xbb\x32\x60\xb7\xf1\x64\xdb\xad\x6e\x40\x59\xfa\x23\x22\xca\x32\xb6\x21\xe5\x9d\x73\x83\xf4\x03\x07\xeb\xdd\x47\xa8\xd9\x40\x33\x76\xba\x7f\x6c\xae\xee\xbb\x6f\x78\x87\xc4\x8b\xb7\x9e\x93\x45\xe9\x58\x5c\xa3\x62\x90\xd5\x82\xba\xd9\x54\x39\x44\xf0\xf0\xed\xa5\x8f\xfe\xb2\xdd\x37\xde\x13\x92\xa8\x58\xab\xcc\x71\xc6\x22\x67\x47\xf9\xb9\x59\x31\xe6\x90\xb9\x8b\x46\x4a\xc0\xe1\x0c\xf6\x71\x78\x17\x8d\x46\x27\xe9\xfc\x90\xdd\x08\x40\xe4\xc9\x49\x3a\x9f\x20\xf9\x76\x25\x1a\x3a\x92\x31\x8f\x44\x23\xba\x3f\xfa\x74\x1c\xe3\x7a\x42\xc7\x4d\x88\x3c\xa1\x31\x4e\xd8\x33\xe1\x19\x91\x27\x76\x8c\x33\x1c\x57\x87\x6e\x86\xc7\x38\xe5\x62\xe6\x90\xa7\xdc\xd8\xce\x15\xad\x12\x34\x57\x38\x25\x5a\xcc\x0f\x49\x62\x3b\x46\x46\x2f\x0a\x0e\xa1\x4c\x2f\x45\xdc\x1f\x0b\x63\x5c\xcc\xfe\xde\xec\xc2\xe3\x49\x34\xba\x8f\x46\x45\x55\xc3\xf9\x04\x52\x83\x48\xd6\xa9\x9a\x0b\xdc\xcd\x8f\x32\x44\x56\x89\x24\xcd\xf3\x96\x1a\xa7\x66\x4c\xec\xb2\xc0\x5e\x0a\x79\xad\xfa\x6f\x69\xf8\x6a\x06\x4a\x2e\x1d\x27\x66\xbb\x59\x73\xa2\xb5\x28\xc6\x96\xde\x9a\x04\x33\xb0\xeb\x3c\x1a\x89\xaf\x85\x59\xd7\x0a\x94\xe0\x2b\xcb\xb1\xb8\xa6\xd3\x68\x3d\xaa\x09\x31\x22\x93\x47\x91\x0b\xf7\xba\x14\xf1\xc6\x45\xee\xda\x1c\xdf\xa9\x62\x7b\x0b\x9f\x80\xa8\x6b\x1c\xdf\x45\x23\x59\x40\x91\x27\xef\xeb\xda\x37\xc8\xa9\x24\x97\x13\x28\x4a\x83\xd3\x55\x5d\xc4\x7b\x24\x11\xbe\xfb\xf3\x10\xbe\xbb\xda\x9b\x20\x23\x1d\x25\x4b\xb0\x68\x69\x42\x6a\x9f\x36\xba\xdb\xf4\x40\x68\x78\xc8\xa1\x8a\x2a\x9c\xc1\x4b\xcf\x64\xd3\xc9\x89\x87\xdd\x9c\xae\x1c\xed\x14\xee\x8b\x94\x8e\xe3\xd2\x54\xeb\xba\xee\xa2\xc0\xb3\xa8\x83\xbb\x0d\x44\xa3\xe6\x0e\xd0\xce\x3a\x0a\xf2\x72\x1f\xcc\x93\x38\xcb\x14\x06\x0c\xd7\x04\x1d\xf3\x21\xae\x09\x7b\xe8\x76\x65\xd3\x12\x1f\x3a\x69\x0d\xa5\x13\x11\x34\xdd\x52\x70\xbe\xed\x86\x69\x7e\x29\x54\x5c\xe4\x49\x4b\xa5\x58\xf0\x1a\x5b\x5c\x55\x04\xad\x2e\x2e\xf8\xec\x5a\xc2\x46\x89\x86\x42\xd3\x4d\x6b\xd8\x28\xd1\x50\x3a\x81\x09\x5b\x43\x93\xbb\x3b\x0f\x40\xa6\x0c\x06\x67\xd1\x0d\x4e\x5d\x0c\x07\xa7\x2e\xc8\x71\x60\xb6\xdd\x81\x4b\xa9\x35\x96\x0d\x2a\x47\x12\x99\x70\x7b\xe7\xd6\x7b\x13\xd0\x05\xb9\x67\x2b\x1b\xaf\xc8\x87\x33\xbc\x78\xbc\x79\x1d\x23\x52\xf2\x2f\x31\x7e\x6b\xe9\xaf\x66\xf0\x83\xd3\x8e\xee\xd2\x33\xd8\xc7\x09\x4f\x31\xe7\x01\xbc\x8a\x87\x74\x25\x9b\x35\x37\x34\x6c\x0f\x7f\x2d\xe2\xd6\xb5\xc6\x74\x69\x8b\xad\x16\xf8\x56\x62\x5f\x48\xf8\x92\x05\x74\xf5\x83\x2c\x55\x70\x21\x80\x9e\x4a\x45\x0e\xa6\xa2\x35\x73\xa1\x44\x8d\x57\xa0\x84\x38\x3f\x54\x35\x88\x9b\xb4\x5c\x2d\xc5\x04\x54\x65\x20\x05\x4c\x14\x08\x21\x2c\xe5\xa5\x00\x23\x4b\x91\x1c\x57\xd7\x09\x99\x7b\x4e\xa9\x01\x03\x18\x2b\x65\xf2\x31\xad\xf5\x22\x5d\xfa\x9a\xbd\xa5\x05\x1e\xd4\xad\x55\xf6\xfe\x3a\xf3\x42\xc4\xcf\x6f\xba\x98\x20\x4f\x9b\xe4\x6c\x37\xd0\x2d\x9b\xf6\x45\x87\xb2\x9c\xfd\xb3\x2f\xcb\x11\x73\x2c\xf3\x1b\x38\xa0\x45\x41\x9a\xe3\xc7\xc2\xbb\x66\xef\x7d\x22\xe0\x19\x60\x7a\xd6\xec\x8a\x32\xbf\x49\x68\xdc\x14\x44\x9e\xc1\x09\x3b\xde\x4c\x2c\x38\xd3\xa6\x15\x3f\x5a\x71\x26\x88\xd5\x7b\xb6\x94\x31\xe4\x97\x5d\x7b\x5a\x74\x52\xde\x4b\x71\x13\x32\xe8\x67\x15\xa4\xf0\x9f\xcf\xbf\x1e\x47\xd3\xa9\x6d\xb2\xf8\xa0\x73\x61\x0f\x9a\x96\xa0\x80\xcf\xc1\x83\x99\x7d\x37\x63\x84\x82\x4d\x63\x7e\x59\xc3\x0d\x8f\xdc\x4e\x63\x88\x2f\xe0\xf4\xec\xe2\xd6\x08\x7b\xe6\x6d\x49\xd0\x78\xfe\xfb\x56\x3a\x62\x66\x5f\x8d\x19\x34\x7e\x27\xb4\xb4\x78\xdc\xe9\x31\xa4\xb2\x8f\xfe\xf1\x86\x73\x5b\xbe\xf1\x98\xc2\xcb\xf2\x7d\x4b\x81\xb7\x01\xc5\xbe\xa9\x13\x2c\x88\xf4\xfe\xc7\xb6\xb1\x5b\xee\x50\xc2\x18\x0b\xaa\x61\xd7\x18\xf0\x5c\xc2\x84\xab\x5f\xed\xc3\xbe\xd7\x5c\x43\x75\x25\xea\x5a\xe6\xa2\x79\x93\xf4\x67\x93\xde\x2c\xc6\x48\x79\x56\xc6\x54\x71\x47\x7a\x38\x95\x05\x06\x5a\x17\x7c\x7e\x0b\xb1\xd7\xd4\x4d\xa0\xeb\xb4\x10\x14\x05\x6e\xa3\x46\x91\xe7\xd8\x8b\x71\x11\x2d\x2c\xb4\x3b\xe3\x40\xfb\xc2\x0c\xd2\xd5\x4a\xa8\x3c\x66\xc2\xa4\xed\x8c\xbd\xb0\x8e\xc7\x63\x86\x89\x9f\xfb\x7d\x03\xf8\x6b\xc1\x4b\x9a\x80\xb9\xa6\x31\x82\x3f\x39\xb0\x19\xee\x5b\x85\x67\x08\x93\x26\x41\xae\xea\xb5\x66\xe3\xd0\xe9\xbb\xc5\xf3\x9f\xf9\xe6\x36\xfe\x07\x8e\x97\xdf\xcd\x7e\x09\x79\xfe\x7d\x98\x31\xa8\x55\x7a\xcc\x89\xf7\x8b\x2a\x83\xd4\x6b\xf3\xa7\xa6\xdc\x3b\x97\x57\x42\xc1\xc5\xba\x28\xf0\x2b\x67\xd5\xb6\xd8\x1c\xb4\x36\x8b\x6e\x48\x88\x2f\xd6\x05\xa7\x4c\x6c\xa5\xad\xd8\xc9\x50\xe2\x0c\x60\x20\x0d\x1b\x71\x28\x68\x02\xfa\x61\x20\x44\x5d\xfb\xee\x57\xb4\xce\xa7\xb9\x38\xe1\x96\xde\x1e\x45\xc2\xfd\x81\x8e\xbb\x92\xbb\xa2\x47\xf7\x3e\x84\xda\x2f\xce\x4d\x76\xa5\x92\x4c\xdf\x61\xf0\x03\x4b\xe5\x3e\xd1\x50\x6d\x0e\xaa\x09\x97\x9d\x58\x03\xc3\x32\x6e\x85\xf0\xb1\x87\x0c\x63\x54\xa2\xaa\xd1\x04\x92\x1e\xa4\xa3\x20\xb3\x37\x30\x76\x71\xf2\x21\x92\x13\x28\xbd\x00\x25\xa1\xb4\x16\x5f\xa6\x90\x3e\x54\x98\xca\x9b\xa6\x28\x45\xa3\x11\x3f\x0d\xf8\xda\x70\x1a\x2e\x6f\xc6\xd1\xa8\x47\x17\xa7\x8c\xef\xb8\x76\xf7\xc6\x6f\x95\xe7\xb5\xa8\x2f\x9d\xe9\xd7\xe0\x4c\x8b\xf6\x44\x47\xba\x68\xf6\x6f\xef\x73\x61\xee\x88\x46\xbd\xaa\x7c\xab\x2e\xa4\x0c\x76\xaf\xcd\x2b\xf4\x0c\xf6\xdd\xdf\x56\x22\x25\x32\xae\xee\x5f\xb1\x6a\x8f\xdc\xc7\x3c\x22\x9a\xda\xb6\x42\x23\xef\x0b\xdd\x21\xc8\x49\x2b\xdc\x39\xab\x97\x1c\xb9\xb7\x02\x5d\x38\x40\x86\x4a\xd2\x73\x83\x3e\x54\x8a\x1e\x55\x8b\x48\x73\xf7\xf1\xd9\xd7\x9d\x93\xff\x4b\x68\x3f\x58\x85\x9e\x52\x86\x68\x03\xfc\x74\x1b\x9a\x61\x4b\xd1\x73\x1b\xf1\xb5\xd5\x9f\xb6\x74\xda\xd3\x6e\xbe\xee\x44\x98\x3c\xa7\x3f\x3a\x35\xa8\xdd\xde\x3c\x32\xaf\x1e\xbe\xa0\xc9\x76\xeb\xf6\xc4\xda\x5d\xc3\x63\x6b\xe9\x2f\x82\x00\xff\xde\xc0\x47\x80\x6b\xf4\x73\xda\x2e\x0b\xb0\x1b\x05\x82\x74\xc2\x3f\x8c\xf0\x0c\xfe\xc4\xfa\x6c\x98\xfa\xcd\x76\xf5\xb4\xe1\xe5\x4d\x4f\x0b\xde\xdf\x83\x87\x25\x31\xac\x87\x9c\xc5\xf0\x4f\xcd\x0f\x06\x8f\x28\x88\x41\x4b\x3f\x58\x11\x87\x8b\xd0\x37\xd7\xc4\xfe\x12\xb3\x5b\x85\x19\x76\x82\xa6\x81\x18\xac\x1d\xee\x78\xee\xa3\x1d\x4a\x40\x07\xf3\x5e\xec\xfc\xce\x78\x10\xba\xa1\x2c\xf6\x8d\xc0\xf5\xe5\xa8\x5d\x53\x14\x9b\x0e\xec\x9b\x8d\x0f\x17\xe9\x52\x53\x6e\xba\xdf\xd9\x64\x3f\x0f\x6c\xb3\x7c\x5b\x52\x7b\x34\x02\x5e\xca\x7a\x5c\xc6\x7a\x46\x40\x82\x8b\xc4\x20\x14\xc3\xd9\x6d\x77\x10\x7a\x73\xd7\x6e\xa9\x6b\x37\x73\x36\xf2\x8f\xe2\x5c\xe2\x25\x2b\x7a\x2d\xc8\xd6\x75\x3d\x81\xea\x12\x3d\x52\xfb\x99\xec\x34\x55\xdc\xb6\x9e\x91\xb6\xaf\xaa\x4b\xd6\xb1\x7f\x11\xea\xac\x1a\x3b\x9d\x8d\xa5\x93\x8d\xfb\x24\x8c\x4f\xf2\x51\xd4\x73\x51\x8f\xdf\xc2\x76\x99\xa5\x5d\x1c\xa7\x6a\xcc\x6f\x5e\xf6\xe0\x84\xfd\x82\xf5\x28\x3b\xc5\x2e\x76\x8a\x17\xb0\x53\x7c\xbb\x9d\x05\xd8\x6f\x2a\x8f\x32\xb4\xd8\xc5\xd0\xe2\x05\x0c\x2d\x9e\x62\x68\x7b\xcd\xf4\xb2\x4f\x01\xaf\x9a\x87\x60\xf8\xfb\x6f\x1c\xe1\xa3\x7c\x72\xbc\x2e\x45\x2d\xb3\x78\x8c\xc4\x66\x05\x3e\x6f\xe3\x7b\xbd\xbb\x8b\x7d\xc0\x2d\xbc\x28\xc4\xa0\x41\x0f\x55\x2d\x52\xcc\x49\x8f\xcc\x49\x5c\x2c\xab\xd4\xbc\x79\x3d\x0e\x90\xea\x69\x51\xd6\x4a\xdc\xac\x44\x66\x44\xbe\xf1\x7a\x4e\x5f\x00\x9a\xc7\xff\x43\xfb\xfa\xef\x3f\xfe\xeb\x6b\x69\xb2\x05\x50\x27\xc1\xb6\xe0\x53\xe6\x5b\xb4\x36\x4b\xb5\x00\x03\xff\x9a\x81\xff\x83\x3d\xf3\xff\xb0\xbf\x0f\x06\xfe\xb9\x41\x7e\xf3\xfa\x10\x5b\x93\xc0\x02\x70\x9f\x14\xd4\xb8\x5f\xdc\x17\xd9\x2f\xef\x8b\x1c\x14\xb8\x6e\x25\x76\xd2\xcf\x74\xea\xb5\x11\x70\x5d\xa7\x2b\xed\xff\xec\x93\xe9\xa9\xca\xed\x65\xdf\x55\xec\x52\x98\x45\x95\xc3\xb5\x34\x0b\xa8\x45\x86\xaf\xa0\xf8\x0e\x20\x94\x5e\xd7\x02\x54\x05\xab\x54\xc9\x4c\xe3\x8f\xbe\xf9\x6d\x43\xaa\x39\xf7\x3e\x5e\xdb\x52\xe4\xde\xaf\xda\x80\x89\x63\x38\x3d\x6b\x7f\x8a\x79\x3f\x86\x98\x3b\x14\x8f\xbc\xf9\x34\x9d\x0b\x7c\xb0\x41\xf1\xdc\xc8\xc9\x02\xae\xf0\x84\x58\x39\x7c\xf9\xb8\xf2\x33\xf6\x08\xf9\x67\x81\x4b\x7c\x77\xe2\xac\xb3\xca\x37\x1f\x33\x27\x70\x85\x6d\x0f\xbf\x01\xe0\xf7\x5a\xf4\x42\xf4\x85\xfb\x78\xdc\x00\x5a\xf0\xc7\x72\x1d\x8f\xfd\x37\x93\xe6\xce\xda\x05\xd7\x92\x9f\x0a\xa5\xff\x46\xeb\xa3\x69\xe9\x0e\x4c\x1c\x11\x96\xf6\x6e\xdb\x12\x5f\x02\xc9\xc0\xbe\x00\x4c\x0b\xa4\xe0\x2b\x75\x2f\x8e\x3e\x73\x17\x4a\x77\x97\xed\x80\xe9\x26\x9e\x0a\x27\xcb\xe9\x01\xd4\xcd\x38\x48\x69\x4c\x98\xba\xfb\xb6\x47\x7f\x41\x58\x59\x8f\x3e\x60\x9d\x22\x0f\x43\xdb\x18\xb2\x09\x2e\x5d\xa0\xba\xd0\x5a\xf2\x53\x81\x7d\xe8\xcd\x2f\xa6\xe4\xc2\xf8\x7d\x6c\xdf\xfd\x5e\x04\x3f\x92\xdf\x87\x9e\x55\xe2\x61\xec\x88\xb9\x8b\x9c\xbd\x01\x74\x90\xb3\xe4\xa7\x22\x17\x5c\x70\x3c\x87\xb4\x74\xe7\x8e\x38\x22\x6f\xb4\x37\x93\x96\xf8\x82\x50\xa2\xf8\xde\x08\x5f\xf0\x8d\xe8\x21\x28\x59\xfd\x4d\x28\x83\x9b\x44\x07\xd1\x60\xf6\xa9\xc0\xfa\xc2\x7a\xf0\x0d\xa6\xdb\xa8\x6f\x88\x1c\xfb\x74\x0b\xea\x4c\xbe\x20\xea\xde\x2e\xbd\xe0\xb3\x4a\x0f\xa3\xef\x0b\xe9\x1e\x02\x5f\x67\x3a\xf0\x33\xfd\xa9\xc0\x87\xd7\x35\x0f\x72\x9e\x18\x53\x82\xe0\xcd\x10\x65\xbe\x72\xb5\xd4\x17\x84\x97\xb7\xed\x01\x96\xb5\x78\x18\x58\x67\x42\x03\x29\x2a\xd5\x7e\x65\x30\xc1\xaf\x3b\xc6\xc1\x08\x15\xc3\xd6\xd3\xf0\xcf\x3c\xfc\x4e\xf8\x93\xa1\x2b\xec\xc8\xc0\x0c\x4c\xf2\x7e\x29\x4a\xfe\x19\x48\x2d\xcc\xba\x56\x60\xa2\xfb\xe8\x7f\x03\x00\x61\x9d\xcf\x82\xfa\x37\x00\x00")
 
 func schemaGoBytes() ([]byte, error) {
 	return bindataRead(
@@ -113,7 +113,7 @@ func schemaGo() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "schema.go", size: 13018, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "schema.go", size: 14330, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
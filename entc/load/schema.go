@@ -18,19 +18,20 @@ import (
 
 // Schema represents an ent.Schema that was loaded from a complied user package.
 type Schema struct {
-	Name        string                 `json:"name,omitempty"`
-	Config      ent.Config             `json:"config,omitempty"`
-	Edges       []*Edge                `json:"edges,omitempty"`
-	Fields      []*Field               `json:"fields,omitempty"`
-	Indexes     []*Index               `json:"indexes,omitempty"`
-	Hooks       []*Position            `json:"hooks,omitempty"`
-	Policy      []*Position            `json:"policy,omitempty"`
-	Annotations map[string]interface{} `json:"annotations,omitempty"`
+	Name         string                 `json:"name,omitempty"`
+	Config       ent.Config             `json:"config,omitempty"`
+	Edges        []*Edge                `json:"edges,omitempty"`
+	Fields       []*Field               `json:"fields,omitempty"`
+	Indexes      []*Index               `json:"indexes,omitempty"`
+	Hooks        []*Position            `json:"hooks,omitempty"`
+	Interceptors []*Position            `json:"interceptors,omitempty"`
+	Policy       []*Position            `json:"policy,omitempty"`
+	Annotations  map[string]interface{} `json:"annotations,omitempty"`
 }
 
 // Position describes a position in the schema.
 type Position struct {
-	Index      int  // Index in the field/hook list.
+	Index      int  // Index in the field/hook/interceptor list.
 	MixedIn    bool // Indicates if the schema object was mixed-in.
 	MixinIndex int  // Mixin index in the mixin list.
 }
@@ -51,6 +52,7 @@ type Field struct {
 	UpdateDefault bool                    `json:"update_default,omitempty"`
 	Immutable     bool                    `json:"immutable,omitempty"`
 	Validators    int                     `json:"validators,omitempty"`
+	Constraints   *field.Constraints      `json:"constraints,omitempty"`
 	StorageKey    string                  `json:"storage_key,omitempty"`
 	Position      *Position               `json:"position,omitempty"`
 	Sensitive     bool                    `json:"sensitive,omitempty"`
@@ -72,6 +74,7 @@ type Edge struct {
 	Required    bool                   `json:"required,omitempty"`
 	StorageKey  *edge.StorageKey       `json:"storage_key,omitempty"`
 	Annotations map[string]interface{} `json:"annotations,omitempty"`
+	Through     *struct{ N, T string } `json:"through,omitempty"`
 }
 
 // Index represents an ent.Index that was loaded from a complied user package.
@@ -95,6 +98,7 @@ func NewEdge(ed *edge.Descriptor) *Edge {
 		RefName:     ed.RefName,
 		StorageKey:  ed.StorageKey,
 		Annotations: make(map[string]interface{}),
+		Through:     ed.Through,
 	}
 	for _, at := range ed.Annotations {
 		ne.addAnnotation(at)
@@ -124,6 +128,7 @@ func NewField(fd *field.Descriptor) (*Field, error) {
 		Immutable:     fd.Immutable,
 		StorageKey:    fd.StorageKey,
 		Validators:    len(fd.Validators),
+		Constraints:   fd.Constraints,
 		Sensitive:     fd.Sensitive,
 		SchemaType:    fd.SchemaType,
 		Annotations:   make(map[string]interface{}),
@@ -194,6 +199,9 @@ func MarshalSchema(schema ent.Interface) (b []byte, err error) {
 	if err := s.loadHooks(schema); err != nil {
 		return nil, fmt.Errorf("schema %q: %w", s.Name, err)
 	}
+	if err := s.loadInterceptors(schema); err != nil {
+		return nil, fmt.Errorf("schema %q: %w", s.Name, err)
+	}
 	if err := s.loadPolicy(schema); err != nil {
 		return nil, fmt.Errorf("schema %q: %w", s.Name, err)
 	}
@@ -263,6 +271,17 @@ func (s *Schema) loadMixin(schema ent.Interface) error {
 				MixinIndex: i,
 			})
 		}
+		inters, err := safeInterceptors(mx)
+		if err != nil {
+			return fmt.Errorf("mixin %q: %w", name, err)
+		}
+		for j := range inters {
+			s.Interceptors = append(s.Interceptors, &Position{
+				Index:      j,
+				MixedIn:    true,
+				MixinIndex: i,
+			})
+		}
 		policy, err := safePolicy(mx)
 		if err != nil {
 			return fmt.Errorf("mixin %q: %w", name, err)
@@ -311,6 +330,20 @@ func (s *Schema) loadHooks(schema ent.Interface) error {
 	return nil
 }
 
+func (s *Schema) loadInterceptors(schema ent.Interface) error {
+	inters, err := safeInterceptors(schema)
+	if err != nil {
+		return err
+	}
+	for i := range inters {
+		s.Interceptors = append(s.Interceptors, &Position{
+			Index:   i,
+			MixedIn: false,
+		})
+	}
+	return nil
+}
+
 func (s *Schema) loadPolicy(schema ent.Interface) error {
 	policy, err := safePolicy(schema)
 	if err != nil {
@@ -427,6 +460,17 @@ func safeHooks(schema interface{ Hooks() []ent.Hook }) (hooks []ent.Hook, err er
 	return schema.Hooks(), nil
 }
 
+// safeInterceptors wraps the schema.Interceptors method with recover to ensure no panics in marshaling.
+func safeInterceptors(schema interface{ Interceptors() []ent.Interceptor }) (inters []ent.Interceptor, err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = fmt.Errorf("schema.Interceptors panics: %v", v)
+			inters = nil
+		}
+	}()
+	return schema.Interceptors(), nil
+}
+
 // safePolicy wraps the schema.Policy method with recover to ensure no panics in marshaling.
 func safePolicy(schema interface{ Policy() ent.Policy }) (policy ent.Policy, err error) {
 	defer func() {
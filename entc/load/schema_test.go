@@ -359,6 +359,12 @@ func (HooksMixin) Hooks() []ent.Hook {
 	}
 }
 
+func (HooksMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		func(ent.Querier) ent.Querier { return nil },
+	}
+}
+
 type BoringPolicy struct{}
 
 func (BoringPolicy) EvalMutation(context.Context, ent.Mutation) error { return nil }
@@ -410,6 +416,13 @@ func (WithMixin) Hooks() []ent.Hook {
 	}
 }
 
+func (WithMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		func(ent.Querier) ent.Querier { return nil },
+		func(ent.Querier) ent.Querier { return nil },
+	}
+}
+
 func (WithMixin) Policy() ent.Policy {
 	return BoringPolicy{}
 }
@@ -465,6 +478,18 @@ func TestMarshalMixin(t *testing.T) {
 		require.Equal(t, 0, schema.Hooks[2].MixinIndex)
 	})
 
+	t.Run("Interceptors", func(t *testing.T) {
+		require.Len(t, schema.Interceptors, 3)
+		require.True(t, schema.Interceptors[0].MixedIn)
+		require.Equal(t, 1, schema.Interceptors[0].MixinIndex)
+		require.Equal(t, 0, schema.Interceptors[0].Index)
+
+		require.False(t, schema.Interceptors[1].MixedIn)
+		require.Equal(t, 0, schema.Interceptors[1].Index)
+		require.False(t, schema.Interceptors[2].MixedIn)
+		require.Equal(t, 1, schema.Interceptors[2].Index)
+	})
+
 	t.Run("Edges", func(t *testing.T) {
 		require.Len(t, schema.Edges, 2)
 		require.Equal(t, "user", schema.Edges[0].Name)
@@ -0,0 +1,97 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package entgql provides the schema annotations of the GraphQL codegen extension
+// (the "graphql" feature-flag).
+package entgql
+
+import "entgo.io/ent/schema"
+
+// Annotation is a builtin schema annotation for configuring the GraphQL
+// schema and the resolvers glue that are generated for types, fields and edges.
+type Annotation struct {
+	// Skip excludes the type, field or edge from the GraphQL schema.
+	Skip bool `json:"skip,omitempty"`
+
+	// Type overrides the GraphQL type name of a schema type, or the
+	// GraphQL (scalar) type of a field. For example:
+	//
+	//	field.String("hash").
+	//		Annotations(entgql.Type("Hash"))
+	//
+	Type string `json:"type,omitempty"`
+
+	// OrderField exposes the field as a GraphQL order field (an enum value
+	// of the <T>OrderField enum) under the given name. For example:
+	//
+	//	field.Time("created_at").
+	//		Annotations(entgql.OrderField("CREATED_AT"))
+	//
+	OrderField string `json:"order_field,omitempty"`
+
+	// RelayConnection exposes a Relay connection for the type under the root
+	// Query type, or for a non-unique edge under the type of the edge owner.
+	RelayConnection bool `json:"relay_connection,omitempty"`
+}
+
+// Name implements the schema.Annotation interface.
+func (Annotation) Name() string {
+	return "EntGQL"
+}
+
+// Merge implements the schema.Merger interface.
+func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
+	var ant Annotation
+	switch other := other.(type) {
+	case Annotation:
+		ant = other
+	case *Annotation:
+		if other != nil {
+			ant = *other
+		}
+	default:
+		return a
+	}
+	if ant.Skip {
+		a.Skip = true
+	}
+	if ant.Type != "" {
+		a.Type = ant.Type
+	}
+	if ant.OrderField != "" {
+		a.OrderField = ant.OrderField
+	}
+	if ant.RelayConnection {
+		a.RelayConnection = true
+	}
+	return a
+}
+
+var (
+	_ schema.Annotation = (*Annotation)(nil)
+	_ schema.Merger     = (*Annotation)(nil)
+)
+
+// Skip returns an annotation for excluding the type,
+// field or edge from the GraphQL schema.
+func Skip() Annotation {
+	return Annotation{Skip: true}
+}
+
+// Type returns an annotation for overriding the GraphQL type name.
+func Type(name string) Annotation {
+	return Annotation{Type: name}
+}
+
+// OrderField returns an annotation for exposing
+// the field as an order field with the given name.
+func OrderField(name string) Annotation {
+	return Annotation{OrderField: name}
+}
+
+// RelayConnection returns an annotation for exposing
+// a Relay connection for the type or the edge.
+func RelayConnection() Annotation {
+	return Annotation{RelayConnection: true}
+}
@@ -0,0 +1,82 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package enthistory provides the schema annotation and the runtime helpers
+// of the history codegen extension (the "history" feature-flag).
+package enthistory
+
+import (
+	"context"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+)
+
+// Annotation is a builtin schema annotation for recording the history of types.
+// For each annotated type, the codegen generates a companion <T>History type,
+// and a hook that records a history row for every mutation of the type.
+type Annotation struct {
+	// Enabled opts-in the type for recording its history.
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// Name implements the schema.Annotation interface.
+func (Annotation) Name() string {
+	return "EntHistory"
+}
+
+// Merge implements the schema.Merger interface.
+func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
+	switch other := other.(type) {
+	case Annotation:
+		a.Enabled = a.Enabled || other.Enabled
+	case *Annotation:
+		if other != nil {
+			a.Enabled = a.Enabled || other.Enabled
+		}
+	}
+	return a
+}
+
+var (
+	_ schema.Annotation = (*Annotation)(nil)
+	_ schema.Merger     = (*Annotation)(nil)
+)
+
+// Enable returns an annotation for recording the history of the type. For example:
+//
+//	func (User) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			enthistory.Enable(),
+//		}
+//	}
+//
+func Enable() Annotation {
+	return Annotation{Enabled: true}
+}
+
+// EdgeChange describes the changes that were made to an edge in a mutation.
+type EdgeChange struct {
+	// Added holds the identifiers of the nodes that were added to the edge.
+	Added []ent.Value `json:"added,omitempty"`
+	// Removed holds the identifiers of the nodes that were removed from the edge.
+	Removed []ent.Value `json:"removed,omitempty"`
+	// Cleared reports if the edge was cleared.
+	Cleared bool `json:"cleared,omitempty"`
+}
+
+type actorCtxKey struct{}
+
+// NewContext returns a new context with the given actor attached. The
+// actor is recorded in the history rows of the mutations that are
+// executed with this context (or its descendants).
+func NewContext(parent context.Context, actor string) context.Context {
+	return context.WithValue(parent, actorCtxKey{}, actor)
+}
+
+// ActorFromContext returns the actor stored in the context, if any.
+func ActorFromContext(ctx context.Context) (string, bool) {
+	actor, ok := ctx.Value(actorCtxKey{}).(string)
+	return actor, ok
+}
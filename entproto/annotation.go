@@ -0,0 +1,129 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package entproto provides the schema annotations of the protobuf and
+// gRPC codegen extension (the "grpc" feature-flag).
+package entproto
+
+import "entgo.io/ent/schema"
+
+// Annotation is a builtin schema annotation for configuring the protobuf
+// messages and the gRPC services that are generated for types, fields and edges.
+type Annotation struct {
+	// Message opts-in the type for generating a protobuf message.
+	Message bool `json:"message,omitempty"`
+
+	// MessageName overrides the name of the generated protobuf message.
+	// It defaults to the type name.
+	MessageName string `json:"message_name,omitempty"`
+
+	// Service opts-in the type for generating a gRPC service with
+	// the CRUD methods of the type. It implies Message.
+	Service bool `json:"service,omitempty"`
+
+	// Field defines the number of the protobuf field that is generated for
+	// a field or an edge. Numbers are required for all fields and edges of a
+	// message (except the ID field that is always numbered 1), and they must
+	// not be changed once they are in use. For example:
+	//
+	//	field.String("name").
+	//		Annotations(entproto.Field(2))
+	//
+	Field int `json:"field,omitempty"`
+
+	// Enum defines the numbers of the protobuf enum values that are generated
+	// for an enum field. The zero number is reserved for the unspecified value.
+	// For example:
+	//
+	//	field.Enum("status").
+	//		Values("active", "suspended").
+	//		Annotations(
+	//			entproto.Field(3),
+	//			entproto.Enum(map[string]int32{
+	//				"active":    1,
+	//				"suspended": 2,
+	//			}),
+	//		)
+	//
+	Enum map[string]int32 `json:"enum,omitempty"`
+
+	// Skip excludes the field or the edge from the generated message.
+	Skip bool `json:"skip,omitempty"`
+}
+
+// Name implements the schema.Annotation interface.
+func (Annotation) Name() string {
+	return "EntProto"
+}
+
+// Merge implements the schema.Merger interface.
+func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
+	var ant Annotation
+	switch other := other.(type) {
+	case Annotation:
+		ant = other
+	case *Annotation:
+		if other != nil {
+			ant = *other
+		}
+	default:
+		return a
+	}
+	if ant.Message {
+		a.Message = true
+	}
+	if ant.MessageName != "" {
+		a.MessageName = ant.MessageName
+	}
+	if ant.Service {
+		a.Service = true
+	}
+	if ant.Field != 0 {
+		a.Field = ant.Field
+	}
+	if ant.Enum != nil {
+		a.Enum = ant.Enum
+	}
+	if ant.Skip {
+		a.Skip = true
+	}
+	return a
+}
+
+var (
+	_ schema.Annotation = (*Annotation)(nil)
+	_ schema.Merger     = (*Annotation)(nil)
+)
+
+// Message returns an annotation for generating a protobuf message for the type.
+func Message() Annotation {
+	return Annotation{Message: true}
+}
+
+// MessageName returns an annotation for generating a protobuf
+// message with the given name for the type.
+func MessageName(name string) Annotation {
+	return Annotation{Message: true, MessageName: name}
+}
+
+// Service returns an annotation for generating a protobuf
+// message and a gRPC CRUD service for the type.
+func Service() Annotation {
+	return Annotation{Message: true, Service: true}
+}
+
+// Field returns an annotation for setting the protobuf field number of a field or an edge.
+func Field(num int) Annotation {
+	return Annotation{Field: num}
+}
+
+// Enum returns an annotation for setting the numbers of the protobuf enum values.
+func Enum(values map[string]int32) Annotation {
+	return Annotation{Enum: values}
+}
+
+// Skip returns an annotation for excluding the field or the edge from the generated message.
+func Skip() Annotation {
+	return Annotation{Skip: true}
+}
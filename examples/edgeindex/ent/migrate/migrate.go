@@ -32,11 +32,18 @@ var (
 	// and therefore, it's recommended to enable this option to get more
 	// flexibility in the schema changes.
 	WithDropIndex = schema.WithDropIndex
+	// WithDropCheck sets the drop check option to the migration.
+	// If this option is enabled, ent migration will drop old CHECK
+	// constraints that were defined in the schema. This defaults to false.
+	WithDropCheck = schema.WithDropCheck
 	// WithFixture sets the foreign-key renaming option to the migration when upgrading
 	// ent from v0.1.0 (issue-#285). Defaults to false.
 	WithFixture = schema.WithFixture
 	// WithForeignKeys enables creating foreign-key in schema DDL. This defaults to true.
 	WithForeignKeys = schema.WithForeignKeys
+	// WithDir sets the migration directory that is used by the Diff
+	// and NamedDiff methods for writing versioned migration files.
+	WithDir = schema.WithDir
 )
 
 // Schema is the API for creating, migrating and dropping a schema.
@@ -74,3 +81,29 @@ func (s *Schema) WriteTo(ctx context.Context, w io.Writer, opts ...schema.Migrat
 	}
 	return migrate.Create(ctx, Tables...)
 }
+
+// Diff writes the schema changes as a new versioned migration to the migration directory
+// configured by the WithDir option. The schema driver is used as a "dev database" for
+// replaying the existing migration files and computing the changes, and it must be
+// connected to an empty database.
+//
+// 	dir, err := schema.NewLocalDir("migrations")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+// 	if err := client.Schema.Diff(ctx, migrate.WithDir(dir)); err != nil {
+//		log.Fatal(err)
+// 	}
+//
+func (s *Schema) Diff(ctx context.Context, opts ...schema.MigrateOption) error {
+	return s.NamedDiff(ctx, "changes", opts...)
+}
+
+// NamedDiff is like Diff, but uses the given name for the migration files.
+func (s *Schema) NamedDiff(ctx context.Context, name string, opts ...schema.MigrateOption) error {
+	migrate, err := schema.NewMigrate(s.drv, opts...)
+	if err != nil {
+		return fmt.Errorf("ent/migrate: %w", err)
+	}
+	return migrate.NamedDiff(ctx, name, Tables...)
+}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/examples/edgeindex/ent/city"
@@ -28,9 +29,11 @@ type StreetQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Street
+	inters     []Interceptor
 	// eager-loading edges.
-	withCity *CityQuery
-	withFKs  bool
+	withCity  *CityQuery
+	withFKs   bool
+	modifiers []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -60,9 +63,51 @@ func (sq *StreetQuery) Order(o ...OrderFunc) *StreetQuery {
 	return sq
 }
 
+// Type returns the node type of this query (Street). It implements the ent.Query interface.
+func (sq *StreetQuery) Type() string {
+	return TypeStreet
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (sq *StreetQuery) SetLimit(limit int) {
+	sq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (sq *StreetQuery) SetOffset(offset int) {
+	sq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (sq *StreetQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for StreetQuery", fn)
+		}
+		sq.order = append(sq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (sq *StreetQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Street:
+			sq.predicates = append(sq.predicates, p)
+		case func(*sql.Selector):
+			sq.predicates = append(sq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for StreetQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryCity chains the current query on the "city" edge.
 func (sq *StreetQuery) QueryCity() *CityQuery {
-	query := &CityQuery{config: sq.config}
+	query := (&CityClient{config: sq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := sq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -187,7 +232,22 @@ func (sq *StreetQuery) All(ctx context.Context) ([]*Street, error) {
 	if err := sq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return sq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*StreetQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, sq, qr, sq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Street)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -222,7 +282,22 @@ func (sq *StreetQuery) Count(ctx context.Context) (int, error) {
 	if err := sq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return sq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*StreetQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, sq, qr, sq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -239,7 +314,22 @@ func (sq *StreetQuery) Exist(ctx context.Context) (bool, error) {
 	if err := sq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return sq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*StreetQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, sq, qr, sq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -263,17 +353,19 @@ func (sq *StreetQuery) Clone() *StreetQuery {
 		offset:     sq.offset,
 		order:      append([]OrderFunc{}, sq.order...),
 		predicates: append([]predicate.Street{}, sq.predicates...),
+		inters:     append([]Interceptor{}, sq.inters...),
 		withCity:   sq.withCity.Clone(),
 		// clone intermediate query.
-		sql:  sq.sql.Clone(),
-		path: sq.path,
+		sql:       sq.sql.Clone(),
+		path:      sq.path,
+		modifiers: append([]func(*sql.Selector){}, sq.modifiers...),
 	}
 }
 
 // WithCity tells the query-builder to eager-load the nodes that are connected to
 // the "city" edge. The optional arguments are used to configure the query builder of the edge.
 func (sq *StreetQuery) WithCity(opts ...func(*CityQuery)) *StreetQuery {
-	query := &CityQuery{config: sq.config}
+	query := (&CityClient{config: sq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -346,7 +438,7 @@ func (sq *StreetQuery) sqlAll(ctx context.Context) ([]*Street, error) {
 	var (
 		nodes       = []*Street{}
 		withFKs     = sq.withFKs
-		_spec       = sq.querySpec()
+		_spec       = sq.querySpec(ctx)
 		loadedTypes = [1]bool{
 			sq.withCity != nil,
 		}
@@ -407,7 +499,7 @@ func (sq *StreetQuery) sqlAll(ctx context.Context) ([]*Street, error) {
 }
 
 func (sq *StreetQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := sq.querySpec()
+	_spec := sq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, sq.driver, _spec)
 }
 
@@ -419,7 +511,7 @@ func (sq *StreetQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (sq *StreetQuery) querySpec() *sqlgraph.QuerySpec {
+func (sq *StreetQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   street.Table,
@@ -461,6 +553,9 @@ func (sq *StreetQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := sq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -486,9 +581,40 @@ func (sq *StreetQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := sq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range sq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (sq *StreetQuery) ForUpdate(opts ...sql.LockOption) *StreetQuery {
+	sq.modifiers = append(sq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return sq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (sq *StreetQuery) ForShare(opts ...sql.LockOption) *StreetQuery {
+	sq.modifiers = append(sq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return sq
+}
+
 // StreetGroupBy is the group-by builder for Street entities.
 type StreetGroupBy struct {
 	config
@@ -738,7 +864,8 @@ func (sgb *StreetGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (sgb *StreetGroupBy) sqlQuery() *sql.Selector {
-	selector := sgb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := sgb.sql.ClearLock()
 	columns := make([]string, 0, len(sgb.fields)+len(sgb.fns))
 	columns = append(columns, sgb.fields...)
 	for _, fn := range sgb.fns {
@@ -759,8 +886,12 @@ func (ss *StreetSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := ss.prepareQuery(ctx); err != nil {
 		return err
 	}
-	ss.sql = ss.StreetQuery.sqlQuery(ctx)
-	return ss.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		ss.sql = ss.StreetQuery.sqlQuery(ctx)
+		return v, ss.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, ss.StreetQuery, qr, ss.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/examples/o2mrecur/ent/node"
@@ -28,10 +29,12 @@ type NodeQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Node
+	inters     []Interceptor
 	// eager-loading edges.
 	withParent   *NodeQuery
 	withChildren *NodeQuery
 	withFKs      bool
+	modifiers    []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -61,9 +64,51 @@ func (nq *NodeQuery) Order(o ...OrderFunc) *NodeQuery {
 	return nq
 }
 
+// Type returns the node type of this query (Node). It implements the ent.Query interface.
+func (nq *NodeQuery) Type() string {
+	return TypeNode
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (nq *NodeQuery) SetLimit(limit int) {
+	nq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (nq *NodeQuery) SetOffset(offset int) {
+	nq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (nq *NodeQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for NodeQuery", fn)
+		}
+		nq.order = append(nq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (nq *NodeQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Node:
+			nq.predicates = append(nq.predicates, p)
+		case func(*sql.Selector):
+			nq.predicates = append(nq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for NodeQuery", p)
+		}
+	}
+	return nil
+}
+
 // QueryParent chains the current query on the "parent" edge.
 func (nq *NodeQuery) QueryParent() *NodeQuery {
-	query := &NodeQuery{config: nq.config}
+	query := (&NodeClient{config: nq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := nq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -85,7 +130,7 @@ func (nq *NodeQuery) QueryParent() *NodeQuery {
 
 // QueryChildren chains the current query on the "children" edge.
 func (nq *NodeQuery) QueryChildren() *NodeQuery {
-	query := &NodeQuery{config: nq.config}
+	query := (&NodeClient{config: nq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := nq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -210,7 +255,22 @@ func (nq *NodeQuery) All(ctx context.Context) ([]*Node, error) {
 	if err := nq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return nq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*NodeQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, nq, qr, nq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Node)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -245,7 +305,22 @@ func (nq *NodeQuery) Count(ctx context.Context) (int, error) {
 	if err := nq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return nq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*NodeQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, nq, qr, nq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -262,7 +337,22 @@ func (nq *NodeQuery) Exist(ctx context.Context) (bool, error) {
 	if err := nq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return nq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*NodeQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, nq, qr, nq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -286,18 +376,20 @@ func (nq *NodeQuery) Clone() *NodeQuery {
 		offset:       nq.offset,
 		order:        append([]OrderFunc{}, nq.order...),
 		predicates:   append([]predicate.Node{}, nq.predicates...),
+		inters:       append([]Interceptor{}, nq.inters...),
 		withParent:   nq.withParent.Clone(),
 		withChildren: nq.withChildren.Clone(),
 		// clone intermediate query.
-		sql:  nq.sql.Clone(),
-		path: nq.path,
+		sql:       nq.sql.Clone(),
+		path:      nq.path,
+		modifiers: append([]func(*sql.Selector){}, nq.modifiers...),
 	}
 }
 
 // WithParent tells the query-builder to eager-load the nodes that are connected to
 // the "parent" edge. The optional arguments are used to configure the query builder of the edge.
 func (nq *NodeQuery) WithParent(opts ...func(*NodeQuery)) *NodeQuery {
-	query := &NodeQuery{config: nq.config}
+	query := (&NodeClient{config: nq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -308,7 +400,7 @@ func (nq *NodeQuery) WithParent(opts ...func(*NodeQuery)) *NodeQuery {
 // WithChildren tells the query-builder to eager-load the nodes that are connected to
 // the "children" edge. The optional arguments are used to configure the query builder of the edge.
 func (nq *NodeQuery) WithChildren(opts ...func(*NodeQuery)) *NodeQuery {
-	query := &NodeQuery{config: nq.config}
+	query := (&NodeClient{config: nq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -381,7 +473,7 @@ func (nq *NodeQuery) sqlAll(ctx context.Context) ([]*Node, error) {
 	var (
 		nodes       = []*Node{}
 		withFKs     = nq.withFKs
-		_spec       = nq.querySpec()
+		_spec       = nq.querySpec(ctx)
 		loadedTypes = [2]bool{
 			nq.withParent != nil,
 			nq.withChildren != nil,
@@ -472,7 +564,7 @@ func (nq *NodeQuery) sqlAll(ctx context.Context) ([]*Node, error) {
 }
 
 func (nq *NodeQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := nq.querySpec()
+	_spec := nq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, nq.driver, _spec)
 }
 
@@ -484,7 +576,7 @@ func (nq *NodeQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (nq *NodeQuery) querySpec() *sqlgraph.QuerySpec {
+func (nq *NodeQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   node.Table,
@@ -526,6 +618,9 @@ func (nq *NodeQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := nq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -551,9 +646,40 @@ func (nq *NodeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := nq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range nq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (nq *NodeQuery) ForUpdate(opts ...sql.LockOption) *NodeQuery {
+	nq.modifiers = append(nq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return nq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (nq *NodeQuery) ForShare(opts ...sql.LockOption) *NodeQuery {
+	nq.modifiers = append(nq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return nq
+}
+
 // NodeGroupBy is the group-by builder for Node entities.
 type NodeGroupBy struct {
 	config
@@ -803,7 +929,8 @@ func (ngb *NodeGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (ngb *NodeGroupBy) sqlQuery() *sql.Selector {
-	selector := ngb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := ngb.sql.ClearLock()
 	columns := make([]string, 0, len(ngb.fields)+len(ngb.fns))
 	columns = append(columns, ngb.fields...)
 	for _, fn := range ngb.fns {
@@ -824,8 +951,12 @@ func (ns *NodeSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := ns.prepareQuery(ctx); err != nil {
 		return err
 	}
-	ns.sql = ns.NodeQuery.sqlQuery(ctx)
-	return ns.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		ns.sql = ns.NodeQuery.sqlQuery(ctx)
+		return v, ns.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, ns.NodeQuery, qr, ns.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
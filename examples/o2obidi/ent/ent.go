@@ -7,6 +7,7 @@
 package ent
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -18,14 +19,17 @@ import (
 
 // ent aliases to avoid import conflicts in user's code.
 type (
-	Op         = ent.Op
-	Hook       = ent.Hook
-	Value      = ent.Value
-	Query      = ent.Query
-	Policy     = ent.Policy
-	Mutator    = ent.Mutator
-	Mutation   = ent.Mutation
-	MutateFunc = ent.MutateFunc
+	Op          = ent.Op
+	Hook        = ent.Hook
+	Value       = ent.Value
+	Query       = ent.Query
+	Policy      = ent.Policy
+	Querier     = ent.Querier
+	QuerierFunc = ent.QuerierFunc
+	Interceptor = ent.Interceptor
+	Mutator     = ent.Mutator
+	Mutation    = ent.Mutation
+	MutateFunc  = ent.MutateFunc
 )
 
 // OrderFunc applies an ordering on the sql selector.
@@ -240,13 +244,30 @@ func IsConstraintError(err error) bool {
 	return errors.As(err, &e)
 }
 
+// withInterceptors wraps the given querier with the interceptors
+// chain and executes it on the given query.
+func withInterceptors(ctx context.Context, q Query, qr Querier, inters []Interceptor) (Value, error) {
+	for i := len(inters) - 1; i >= 0; i-- {
+		qr = inters[i](qr)
+	}
+	return qr.Query(ctx, q)
+}
+
 func isSQLConstraintError(err error) (*ConstraintError, bool) {
-	if sqlgraph.IsConstraintError(err) {
-		return &ConstraintError{err.Error(), err}, true
+	if cerr, ok := sqlgraph.ParseConstraintError(err); ok {
+		return &ConstraintError{msg: err.Error(), wrap: cerr}, true
 	}
 	return nil, false
 }
 
+// Details returns the details of the violated constraint, such as its kind (e.g. unique or foreign key),
+// name, table and columns, or nil if they are not available. Details that are not reported by the
+// database (or its driver) are empty.
+func (e *ConstraintError) Details() *sqlgraph.ConstraintError {
+	cerr, _ := sqlgraph.ParseConstraintError(e.wrap)
+	return cerr
+}
+
 // rollback calls tx.Rollback and wraps the given error with the rollback error if present.
 func rollback(tx dialect.Tx, err error) error {
 	if rerr := tx.Rollback(); rerr != nil {
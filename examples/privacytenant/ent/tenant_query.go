@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/examples/privacytenant/ent/predicate"
@@ -27,6 +28,8 @@ type TenantQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Tenant
+	inters     []Interceptor
+	modifiers  []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -56,6 +59,48 @@ func (tq *TenantQuery) Order(o ...OrderFunc) *TenantQuery {
 	return tq
 }
 
+// Type returns the node type of this query (Tenant). It implements the ent.Query interface.
+func (tq *TenantQuery) Type() string {
+	return TypeTenant
+}
+
+// SetLimit sets the limit of the query. It implements the ent.Query interface.
+func (tq *TenantQuery) SetLimit(limit int) {
+	tq.limit = &limit
+}
+
+// SetOffset sets the offset of the query. It implements the ent.Query interface.
+func (tq *TenantQuery) SetOffset(offset int) {
+	tq.offset = &offset
+}
+
+// AddOrder appends the given ordering functions to the query. It implements the ent.Query interface.
+func (tq *TenantQuery) AddOrder(o ...interface{}) error {
+	for _, fn := range o {
+		f, ok := fn.(OrderFunc)
+		if !ok {
+			return fmt.Errorf("ent: unexpected order type %T for TenantQuery", fn)
+		}
+		tq.order = append(tq.order, f)
+	}
+	return nil
+}
+
+// AddWhere appends the given predicates to the query. It implements the ent.Query interface.
+func (tq *TenantQuery) AddWhere(ps ...interface{}) error {
+	for _, p := range ps {
+		switch p := p.(type) {
+		case predicate.Tenant:
+			tq.predicates = append(tq.predicates, p)
+		case func(*sql.Selector):
+			tq.predicates = append(tq.predicates, p)
+		default:
+			return fmt.Errorf("ent: unexpected predicate type %T for TenantQuery", p)
+		}
+	}
+	return nil
+}
+
 // First returns the first Tenant entity from the query.
 // Returns a *NotFoundError when no Tenant was found.
 func (tq *TenantQuery) First(ctx context.Context) (*Tenant, error) {
@@ -161,7 +206,22 @@ func (tq *TenantQuery) All(ctx context.Context) ([]*Tenant, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return tq.sqlAll(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*TenantQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlAll(ctx)
+	})
+	v, err := withInterceptors(ctx, tq, qr, tq.inters)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Tenant)
+	if !ok {
+		return nil, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return nodes, nil
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -196,7 +256,22 @@ func (tq *TenantQuery) Count(ctx context.Context) (int, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return tq.sqlCount(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*TenantQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlCount(ctx)
+	})
+	v, err := withInterceptors(ctx, tq, qr, tq.inters)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return count, nil
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -213,7 +288,22 @@ func (tq *TenantQuery) Exist(ctx context.Context) (bool, error) {
 	if err := tq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
-	return tq.sqlExist(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(*TenantQuery)
+		if !ok {
+			return nil, fmt.Errorf("ent: unexpected query type %T", q)
+		}
+		return query.sqlExist(ctx)
+	})
+	v, err := withInterceptors(ctx, tq, qr, tq.inters)
+	if err != nil {
+		return false, err
+	}
+	exist, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("ent: unexpected type %T returned from interceptor", v)
+	}
+	return exist, nil
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -237,9 +327,11 @@ func (tq *TenantQuery) Clone() *TenantQuery {
 		offset:     tq.offset,
 		order:      append([]OrderFunc{}, tq.order...),
 		predicates: append([]predicate.Tenant{}, tq.predicates...),
+		inters:     append([]Interceptor{}, tq.inters...),
 		// clone intermediate query.
-		sql:  tq.sql.Clone(),
-		path: tq.path,
+		sql:       tq.sql.Clone(),
+		path:      tq.path,
+		modifiers: append([]func(*sql.Selector){}, tq.modifiers...),
 	}
 }
 
@@ -313,7 +405,7 @@ func (tq *TenantQuery) prepareQuery(ctx context.Context) error {
 func (tq *TenantQuery) sqlAll(ctx context.Context) ([]*Tenant, error) {
 	var (
 		nodes = []*Tenant{}
-		_spec = tq.querySpec()
+		_spec = tq.querySpec(ctx)
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		node := &Tenant{config: tq.config}
@@ -337,7 +429,7 @@ func (tq *TenantQuery) sqlAll(ctx context.Context) ([]*Tenant, error) {
 }
 
 func (tq *TenantQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := tq.querySpec()
+	_spec := tq.querySpec(ctx)
 	return sqlgraph.CountNodes(ctx, tq.driver, _spec)
 }
 
@@ -349,7 +441,7 @@ func (tq *TenantQuery) sqlExist(ctx context.Context) (bool, error) {
 	return n > 0, nil
 }
 
-func (tq *TenantQuery) querySpec() *sqlgraph.QuerySpec {
+func (tq *TenantQuery) querySpec(ctx context.Context) *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   tenant.Table,
@@ -391,6 +483,9 @@ func (tq *TenantQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
+	if ms := tq.modifiers; len(ms) > 0 {
+		_spec.Modifiers = ms
+	}
 	return _spec
 }
 
@@ -416,9 +511,40 @@ func (tq *TenantQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if limit := tq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	for _, m := range tq.modifiers {
+		m(selector)
+	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tq *TenantQuery) ForUpdate(opts ...sql.LockOption) *TenantQuery {
+	tq.modifiers = append(tq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForUpdate(opts...)
+	})
+	return tq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tq *TenantQuery) ForShare(opts ...sql.LockOption) *TenantQuery {
+	tq.modifiers = append(tq.modifiers, func(s *sql.Selector) {
+		// PostgreSQL does not support the DISTINCT clause with row-level locks.
+		if s.Dialect() == dialect.Postgres {
+			s.SetDistinct(false)
+		}
+		s.ForShare(opts...)
+	})
+	return tq
+}
+
 // TenantGroupBy is the group-by builder for Tenant entities.
 type TenantGroupBy struct {
 	config
@@ -668,7 +794,8 @@ func (tgb *TenantGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 }
 
 func (tgb *TenantGroupBy) sqlQuery() *sql.Selector {
-	selector := tgb.sql
+	// Row-level locks are not allowed with aggregate functions in PostgreSQL.
+	selector := tgb.sql.ClearLock()
 	columns := make([]string, 0, len(tgb.fields)+len(tgb.fns))
 	columns = append(columns, tgb.fields...)
 	for _, fn := range tgb.fns {
@@ -689,8 +816,12 @@ func (ts *TenantSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := ts.prepareQuery(ctx); err != nil {
 		return err
 	}
-	ts.sql = ts.TenantQuery.sqlQuery(ctx)
-	return ts.sqlScan(ctx, v)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		ts.sql = ts.TenantQuery.sqlQuery(ctx)
+		return v, ts.sqlScan(ctx, v)
+	})
+	_, err := withInterceptors(ctx, ts.TenantQuery, qr, ts.inters)
+	return err
 }
 
 // ScanX is like Scan, but panics if an error occurs.
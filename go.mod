@@ -23,4 +23,6 @@ require (
 	go.opencensus.io v0.23.0
 	golang.org/x/sync v0.0.0-20201020160332-67f06af15bc9
 	golang.org/x/tools v0.1.0
+	google.golang.org/grpc v1.38.0
+	google.golang.org/protobuf v1.26.0
 )
@@ -12,17 +12,18 @@ import (
 
 // A Descriptor for edge configuration.
 type Descriptor struct {
-	Tag         string              // struct tag.
-	Type        string              // edge type.
-	Name        string              // edge name.
-	Field       string              // edge field name (e.g. foreign-key).
-	RefName     string              // ref name; inverse only.
-	Ref         *Descriptor         // edge reference; to/from of the same type.
-	Unique      bool                // unique edge.
-	Inverse     bool                // inverse edge.
-	Required    bool                // required on creation.
-	StorageKey  *StorageKey         // optional storage-key configuration.
-	Annotations []schema.Annotation // edge annotations.
+	Tag         string                 // struct tag.
+	Type        string                 // edge type.
+	Name        string                 // edge name.
+	Field       string                 // edge field name (e.g. foreign-key).
+	RefName     string                 // ref name; inverse only.
+	Ref         *Descriptor            // edge reference; to/from of the same type.
+	Unique      bool                   // unique edge.
+	Inverse     bool                   // inverse edge.
+	Required    bool                   // required on creation.
+	StorageKey  *StorageKey            // optional storage-key configuration.
+	Annotations []schema.Annotation    // edge annotations.
+	Through     *struct{ N, T string } // edge schema (join type) of M2M edges.
 }
 
 // To defines an association edge between two vertices.
@@ -91,6 +92,18 @@ func (b *assocBuilder) Comment(string) *assocBuilder {
 	return b
 }
 
+// Through allows setting an "edge schema" to interact explicitly with M2M edges.
+// The given type is used as the join table of the edge, and the name is used
+// for generating its traversal and eager-loading methods.
+//
+//	edge.To("groups", Group.Type).
+//		Through("memberships", Membership.Type)
+//
+func (b *assocBuilder) Through(name string, t interface{}) *assocBuilder {
+	b.desc.Through = &struct{ N, T string }{N: name, T: typ(t)}
+	return b
+}
+
 // StorageKey sets the storage key of the edge.
 //
 //	edge.To("groups", Group.Type).
@@ -158,6 +171,17 @@ func (b *inverseBuilder) Comment(string) *inverseBuilder {
 	return b
 }
 
+// Through allows setting an "edge schema" to interact explicitly with M2M edges.
+//
+//	edge.From("users", User.Type).
+//		Ref("groups").
+//		Through("memberships", Membership.Type)
+//
+func (b *inverseBuilder) Through(name string, t interface{}) *inverseBuilder {
+	b.desc.Through = &struct{ N, T string }{N: name, T: typ(t)}
+	return b
+}
+
 // Field is used to bind an edge (with a foreign-key) to a field in the schema.
 //
 //	field.Int("owner_id").
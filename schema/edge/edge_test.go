@@ -93,6 +93,20 @@ func TestEdge(t *testing.T) {
 	assert.Equal("followers", from.Tag)
 	assert.Equal("following", from.Ref.Tag)
 	assert.Equal(edge.StorageKey{Table: "user_followers", Columns: []string{"following_id", "followers_id"}}, *from.Ref.StorageKey)
+
+	type Group struct{ ent.Schema }
+	type Membership struct{ ent.Schema }
+	e = edge.To("groups", Group.Type).
+		Through("memberships", Membership.Type).
+		Descriptor()
+	assert.Equal("memberships", e.Through.N)
+	assert.Equal("Membership", e.Through.T)
+	e = edge.From("users", User.Type).
+		Ref("groups").
+		Through("memberships", Membership.Type).
+		Descriptor()
+	assert.Equal("memberships", e.Through.N)
+	assert.Equal("Membership", e.Through.T)
 }
 
 type GQL struct {
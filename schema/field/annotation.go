@@ -19,6 +19,30 @@ type Annotation struct {
 	//	}
 	//
 	StructTag map[string]string
+
+	// ID defines a multi-field schema identifier (composite primary key).
+	// Note, the annotation is valid only for the SQL storage, and it is
+	// used for mapping tables that their primary key is composed from
+	// multiple columns. For example:
+	//
+	//	field.Annotation{
+	//		ID: []string{"user_id", "key"},
+	//	}
+	//
+	ID []string
+}
+
+// ID defines a multi-field schema identifier. Note, the annotation
+// is valid only for schemas (types), and not for fields. For example:
+//
+//	func (UserSetting) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			field.ID("user_id", "key"),
+//		}
+//	}
+//
+func ID(first, second string, fields ...string) *Annotation {
+	return &Annotation{ID: append([]string{first, second}, fields...)}
 }
 
 // Name describes the annotation name.
@@ -45,6 +69,9 @@ func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 		}
 		a.StructTag[k] = v
 	}
+	if len(ant.ID) > 0 {
+		a.ID = ant.ID
+	}
 	return a
 }
 
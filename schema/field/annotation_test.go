@@ -24,3 +24,15 @@ func TestAnnotation_Merge(t *testing.T) {
 	assert.Equal(t, a.(field.Annotation).StructTag["foo"], "baz")
 	assert.Equal(t, a.(field.Annotation).StructTag["baz"], "qux")
 }
+
+func TestAnnotation_ID(t *testing.T) {
+	ant := field.ID("user_id", "group_id")
+	assert.Equal(t, []string{"user_id", "group_id"}, ant.ID)
+	a := field.Annotation{
+		StructTag: map[string]string{"foo": "bar"},
+	}.Merge(ant)
+	assert.Equal(t, []string{"user_id", "group_id"}, a.(field.Annotation).ID)
+	assert.Equal(t, "bar", a.(field.Annotation).StructTag["foo"])
+	a = a.(field.Annotation).Merge(field.Annotation{})
+	assert.Equal(t, []string{"user_id", "group_id"}, a.(field.Annotation).ID)
+}
@@ -176,6 +176,8 @@ func (b *stringBuilder) Sensitive() *stringBuilder {
 
 // Match adds a regex matcher for this field. Operation fails if the regex fails.
 func (b *stringBuilder) Match(re *regexp.Regexp) *stringBuilder {
+	c := b.desc.constraints()
+	c.Patterns = append(c.Patterns, re.String())
 	b.desc.Validators = append(b.desc.Validators, func(v string) error {
 		if !re.MatchString(v) {
 			return errors.New("value does not match validation")
@@ -188,6 +190,7 @@ func (b *stringBuilder) Match(re *regexp.Regexp) *stringBuilder {
 // MinLen adds a length validator for this field.
 // Operation fails if the length of the string is less than the given value.
 func (b *stringBuilder) MinLen(i int) *stringBuilder {
+	b.desc.minLen(i)
 	b.desc.Validators = append(b.desc.Validators, func(v string) error {
 		if len(v) < i {
 			return errors.New("value is less than the required length")
@@ -207,6 +210,7 @@ func (b *stringBuilder) NotEmpty() *stringBuilder {
 // Operation fails if the length of the string is greater than the given value.
 func (b *stringBuilder) MaxLen(i int) *stringBuilder {
 	b.desc.Size = i
+	b.desc.maxLen(i)
 	b.desc.Validators = append(b.desc.Validators, func(v string) error {
 		if len(v) > i {
 			return errors.New("value is greater than the required length")
@@ -1042,6 +1046,7 @@ type Descriptor struct {
 	Default       interface{}             // default value on create.
 	UpdateDefault interface{}             // default value on update.
 	Validators    []interface{}           // validator functions.
+	Constraints   *Constraints            // builtin validators.
 	StorageKey    string                  // sql column or gremlin property.
 	Enums         []struct{ N, V string } // enum values.
 	Sensitive     bool                    // sensitive info string field.
@@ -1051,6 +1056,53 @@ type Descriptor struct {
 	Err           error
 }
 
+// Constraints describes the builtin validators of a field (e.g. MaxLen or Match) in
+// a serializable format, for describing the valid values of the field outside of Go.
+// For example, in the OpenAPI schemas that are generated by the codegen.
+type Constraints struct {
+	MinLen   *int     `json:"min_len,omitempty"`
+	MaxLen   *int     `json:"max_len,omitempty"`
+	Patterns []string `json:"patterns,omitempty"`
+	Min      *float64 `json:"min,omitempty"`
+	Max      *float64 `json:"max,omitempty"`
+}
+
+// constraints returns the constraints of the descriptor, and creates them if needed.
+func (d *Descriptor) constraints() *Constraints {
+	if d.Constraints == nil {
+		d.Constraints = &Constraints{}
+	}
+	return d.Constraints
+}
+
+// minLen records a minimum length constraint. The strictest constraint is kept.
+func (d *Descriptor) minLen(i int) {
+	if c := d.constraints(); c.MinLen == nil || *c.MinLen < i {
+		c.MinLen = &i
+	}
+}
+
+// maxLen records a maximum length constraint. The strictest constraint is kept.
+func (d *Descriptor) maxLen(i int) {
+	if c := d.constraints(); c.MaxLen == nil || *c.MaxLen > i {
+		c.MaxLen = &i
+	}
+}
+
+// min records a minimum value constraint. The strictest constraint is kept.
+func (d *Descriptor) min(f float64) {
+	if c := d.constraints(); c.Min == nil || *c.Min < f {
+		c.Min = &f
+	}
+}
+
+// max records a maximum value constraint. The strictest constraint is kept.
+func (d *Descriptor) max(f float64) {
+	if c := d.constraints(); c.Max == nil || *c.Max > f {
+		c.Max = &f
+	}
+}
+
 func (d *Descriptor) goType(typ interface{}, expectType reflect.Type) {
 	t := reflect.TypeOf(typ)
 	tv := indirect(t)
@@ -40,6 +40,8 @@ func TestInt(t *testing.T) {
 	assert.NotNil(t, fd.Default)
 	assert.Equal(t, 10, fd.Default)
 	assert.Len(t, fd.Validators, 2)
+	assert.Equal(t, 10.0, *fd.Constraints.Min)
+	assert.Equal(t, 20.0, *fd.Constraints.Max)
 
 	fd = field.Int("age").
 		Range(20, 40).
@@ -53,6 +55,8 @@ func TestInt(t *testing.T) {
 	assert.True(t, fd.Nillable)
 	assert.False(t, fd.Immutable)
 	assert.Len(t, fd.Validators, 1)
+	assert.Equal(t, 20.0, *fd.Constraints.Min)
+	assert.Equal(t, 40.0, *fd.Constraints.Max)
 	assert.Equal(t, "numeric", fd.SchemaType[dialect.SQLite])
 	assert.Equal(t, "int_type", fd.SchemaType[dialect.Postgres])
 
@@ -293,6 +297,12 @@ func TestString(t *testing.T) {
 	assert.True(t, fd.Unique)
 	assert.Len(t, fd.Validators, 2)
 	assert.True(t, fd.Sensitive)
+	assert.Equal(t, []string{"[a-zA-Z0-9]"}, fd.Constraints.Patterns)
+
+	fd = field.String("name").NotEmpty().MinLen(2).MaxLen(10).MaxLen(20).Descriptor()
+	assert.Equal(t, 2, *fd.Constraints.MinLen)
+	assert.Equal(t, 10, *fd.Constraints.MaxLen, "the strictest constraint is kept")
+	assert.Empty(t, fd.Constraints.Patterns)
 
 	fd = field.String("name").GoType(http.Dir("dir")).Descriptor()
 	assert.NoError(t, fd.Err)
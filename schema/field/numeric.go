@@ -122,6 +122,8 @@ func (b *intBuilder) Unique() *intBuilder {
 
 // Range adds a range validator for this field where the given value needs to be in the range of [i, j].
 func (b *intBuilder) Range(i, j int) *intBuilder {
+	b.desc.min(float64(i))
+	b.desc.max(float64(j))
 	b.desc.Validators = append(b.desc.Validators, func(v int) error {
 		if v < i || v > j {
 			return errors.New("value out of range")
@@ -133,6 +135,7 @@ func (b *intBuilder) Range(i, j int) *intBuilder {
 
 // Min adds a minimum value validator for this field. Operation fails if the validator fails.
 func (b *intBuilder) Min(i int) *intBuilder {
+	b.desc.min(float64(i))
 	b.desc.Validators = append(b.desc.Validators, func(v int) error {
 		if v < i {
 			return errors.New("value out of range")
@@ -144,6 +147,7 @@ func (b *intBuilder) Min(i int) *intBuilder {
 
 // Max adds a maximum value validator for this field. Operation fails if the validator fails.
 func (b *intBuilder) Max(i int) *intBuilder {
+	b.desc.max(float64(i))
 	b.desc.Validators = append(b.desc.Validators, func(v int) error {
 		if v > i {
 			return errors.New("value out of range")
@@ -283,6 +287,8 @@ func (b *uintBuilder) Unique() *uintBuilder {
 
 // Range adds a range validator for this field where the given value needs to be in the range of [i, j].
 func (b *uintBuilder) Range(i, j uint) *uintBuilder {
+	b.desc.min(float64(i))
+	b.desc.max(float64(j))
 	b.desc.Validators = append(b.desc.Validators, func(v uint) error {
 		if v < i || v > j {
 			return errors.New("value out of range")
@@ -294,6 +300,7 @@ func (b *uintBuilder) Range(i, j uint) *uintBuilder {
 
 // Min adds a minimum value validator for this field. Operation fails if the validator fails.
 func (b *uintBuilder) Min(i uint) *uintBuilder {
+	b.desc.min(float64(i))
 	b.desc.Validators = append(b.desc.Validators, func(v uint) error {
 		if v < i {
 			return errors.New("value out of range")
@@ -305,6 +312,7 @@ func (b *uintBuilder) Min(i uint) *uintBuilder {
 
 // Max adds a maximum value validator for this field. Operation fails if the validator fails.
 func (b *uintBuilder) Max(i uint) *uintBuilder {
+	b.desc.max(float64(i))
 	b.desc.Validators = append(b.desc.Validators, func(v uint) error {
 		if v > i {
 			return errors.New("value out of range")
@@ -434,6 +442,8 @@ func (b *int8Builder) Unique() *int8Builder {
 
 // Range adds a range validator for this field where the given value needs to be in the range of [i, j].
 func (b *int8Builder) Range(i, j int8) *int8Builder {
+	b.desc.min(float64(i))
+	b.desc.max(float64(j))
 	b.desc.Validators = append(b.desc.Validators, func(v int8) error {
 		if v < i || v > j {
 			return errors.New("value out of range")
@@ -445,6 +455,7 @@ func (b *int8Builder) Range(i, j int8) *int8Builder {
 
 // Min adds a minimum value validator for this field. Operation fails if the validator fails.
 func (b *int8Builder) Min(i int8) *int8Builder {
+	b.desc.min(float64(i))
 	b.desc.Validators = append(b.desc.Validators, func(v int8) error {
 		if v < i {
 			return errors.New("value out of range")
@@ -456,6 +467,7 @@ func (b *int8Builder) Min(i int8) *int8Builder {
 
 // Max adds a maximum value validator for this field. Operation fails if the validator fails.
 func (b *int8Builder) Max(i int8) *int8Builder {
+	b.desc.max(float64(i))
 	b.desc.Validators = append(b.desc.Validators, func(v int8) error {
 		if v > i {
 			return errors.New("value out of range")
@@ -595,6 +607,8 @@ func (b *int16Builder) Unique() *int16Builder {
 
 // Range adds a range validator for this field where the given value needs to be in the range of [i, j].
 func (b *int16Builder) Range(i, j int16) *int16Builder {
+	b.desc.min(float64(i))
+	b.desc.max(float64(j))
 	b.desc.Validators = append(b.desc.Validators, func(v int16) error {
 		if v < i || v > j {
 			return errors.New("value out of range")
@@ -606,6 +620,7 @@ func (b *int16Builder) Range(i, j int16) *int16Builder {
 
 // Min adds a minimum value validator for this field. Operation fails if the validator fails.
 func (b *int16Builder) Min(i int16) *int16Builder {
+	b.desc.min(float64(i))
 	b.desc.Validators = append(b.desc.Validators, func(v int16) error {
 		if v < i {
 			return errors.New("value out of range")
@@ -617,6 +632,7 @@ func (b *int16Builder) Min(i int16) *int16Builder {
 
 // Max adds a maximum value validator for this field. Operation fails if the validator fails.
 func (b *int16Builder) Max(i int16) *int16Builder {
+	b.desc.max(float64(i))
 	b.desc.Validators = append(b.desc.Validators, func(v int16) error {
 		if v > i {
 			return errors.New("value out of range")
@@ -756,6 +772,8 @@ func (b *int32Builder) Unique() *int32Builder {
 
 // Range adds a range validator for this field where the given value needs to be in the range of [i, j].
 func (b *int32Builder) Range(i, j int32) *int32Builder {
+	b.desc.min(float64(i))
+	b.desc.max(float64(j))
 	b.desc.Validators = append(b.desc.Validators, func(v int32) error {
 		if v < i || v > j {
 			return errors.New("value out of range")
@@ -767,6 +785,7 @@ func (b *int32Builder) Range(i, j int32) *int32Builder {
 
 // Min adds a minimum value validator for this field. Operation fails if the validator fails.
 func (b *int32Builder) Min(i int32) *int32Builder {
+	b.desc.min(float64(i))
 	b.desc.Validators = append(b.desc.Validators, func(v int32) error {
 		if v < i {
 			return errors.New("value out of range")
@@ -778,6 +797,7 @@ func (b *int32Builder) Min(i int32) *int32Builder {
 
 // Max adds a maximum value validator for this field. Operation fails if the validator fails.
 func (b *int32Builder) Max(i int32) *int32Builder {
+	b.desc.max(float64(i))
 	b.desc.Validators = append(b.desc.Validators, func(v int32) error {
 		if v > i {
 			return errors.New("value out of range")
@@ -917,6 +937,8 @@ func (b *int64Builder) Unique() *int64Builder {
 
 // Range adds a range validator for this field where the given value needs to be in the range of [i, j].
 func (b *int64Builder) Range(i, j int64) *int64Builder {
+	b.desc.min(float64(i))
+	b.desc.max(float64(j))
 	b.desc.Validators = append(b.desc.Validators, func(v int64) error {
 		if v < i || v > j {
 			return errors.New("value out of range")
@@ -928,6 +950,7 @@ func (b *int64Builder) Range(i, j int64) *int64Builder {
 
 // Min adds a minimum value validator for this field. Operation fails if the validator fails.
 func (b *int64Builder) Min(i int64) *int64Builder {
+	b.desc.min(float64(i))
 	b.desc.Validators = append(b.desc.Validators, func(v int64) error {
 		if v < i {
 			return errors.New("value out of range")
@@ -939,6 +962,7 @@ func (b *int64Builder) Min(i int64) *int64Builder {
 
 // Max adds a maximum value validator for this field. Operation fails if the validator fails.
 func (b *int64Builder) Max(i int64) *int64Builder {
+	b.desc.max(float64(i))
 	b.desc.Validators = append(b.desc.Validators, func(v int64) error {
 		if v > i {
 			return errors.New("value out of range")
@@ -1078,6 +1102,8 @@ func (b *uint8Builder) Unique() *uint8Builder {
 
 // Range adds a range validator for this field where the given value needs to be in the range of [i, j].
 func (b *uint8Builder) Range(i, j uint8) *uint8Builder {
+	b.desc.min(float64(i))
+	b.desc.max(float64(j))
 	b.desc.Validators = append(b.desc.Validators, func(v uint8) error {
 		if v < i || v > j {
 			return errors.New("value out of range")
@@ -1089,6 +1115,7 @@ func (b *uint8Builder) Range(i, j uint8) *uint8Builder {
 
 // Min adds a minimum value validator for this field. Operation fails if the validator fails.
 func (b *uint8Builder) Min(i uint8) *uint8Builder {
+	b.desc.min(float64(i))
 	b.desc.Validators = append(b.desc.Validators, func(v uint8) error {
 		if v < i {
 			return errors.New("value out of range")
@@ -1100,6 +1127,7 @@ func (b *uint8Builder) Min(i uint8) *uint8Builder {
 
 // Max adds a maximum value validator for this field. Operation fails if the validator fails.
 func (b *uint8Builder) Max(i uint8) *uint8Builder {
+	b.desc.max(float64(i))
 	b.desc.Validators = append(b.desc.Validators, func(v uint8) error {
 		if v > i {
 			return errors.New("value out of range")
@@ -1229,6 +1257,8 @@ func (b *uint16Builder) Unique() *uint16Builder {
 
 // Range adds a range validator for this field where the given value needs to be in the range of [i, j].
 func (b *uint16Builder) Range(i, j uint16) *uint16Builder {
+	b.desc.min(float64(i))
+	b.desc.max(float64(j))
 	b.desc.Validators = append(b.desc.Validators, func(v uint16) error {
 		if v < i || v > j {
 			return errors.New("value out of range")
@@ -1240,6 +1270,7 @@ func (b *uint16Builder) Range(i, j uint16) *uint16Builder {
 
 // Min adds a minimum value validator for this field. Operation fails if the validator fails.
 func (b *uint16Builder) Min(i uint16) *uint16Builder {
+	b.desc.min(float64(i))
 	b.desc.Validators = append(b.desc.Validators, func(v uint16) error {
 		if v < i {
 			return errors.New("value out of range")
@@ -1251,6 +1282,7 @@ func (b *uint16Builder) Min(i uint16) *uint16Builder {
 
 // Max adds a maximum value validator for this field. Operation fails if the validator fails.
 func (b *uint16Builder) Max(i uint16) *uint16Builder {
+	b.desc.max(float64(i))
 	b.desc.Validators = append(b.desc.Validators, func(v uint16) error {
 		if v > i {
 			return errors.New("value out of range")
@@ -1380,6 +1412,8 @@ func (b *uint32Builder) Unique() *uint32Builder {
 
 // Range adds a range validator for this field where the given value needs to be in the range of [i, j].
 func (b *uint32Builder) Range(i, j uint32) *uint32Builder {
+	b.desc.min(float64(i))
+	b.desc.max(float64(j))
 	b.desc.Validators = append(b.desc.Validators, func(v uint32) error {
 		if v < i || v > j {
 			return errors.New("value out of range")
@@ -1391,6 +1425,7 @@ func (b *uint32Builder) Range(i, j uint32) *uint32Builder {
 
 // Min adds a minimum value validator for this field. Operation fails if the validator fails.
 func (b *uint32Builder) Min(i uint32) *uint32Builder {
+	b.desc.min(float64(i))
 	b.desc.Validators = append(b.desc.Validators, func(v uint32) error {
 		if v < i {
 			return errors.New("value out of range")
@@ -1402,6 +1437,7 @@ func (b *uint32Builder) Min(i uint32) *uint32Builder {
 
 // Max adds a maximum value validator for this field. Operation fails if the validator fails.
 func (b *uint32Builder) Max(i uint32) *uint32Builder {
+	b.desc.max(float64(i))
 	b.desc.Validators = append(b.desc.Validators, func(v uint32) error {
 		if v > i {
 			return errors.New("value out of range")
@@ -1531,6 +1567,8 @@ func (b *uint64Builder) Unique() *uint64Builder {
 
 // Range adds a range validator for this field where the given value needs to be in the range of [i, j].
 func (b *uint64Builder) Range(i, j uint64) *uint64Builder {
+	b.desc.min(float64(i))
+	b.desc.max(float64(j))
 	b.desc.Validators = append(b.desc.Validators, func(v uint64) error {
 		if v < i || v > j {
 			return errors.New("value out of range")
@@ -1542,6 +1580,7 @@ func (b *uint64Builder) Range(i, j uint64) *uint64Builder {
 
 // Min adds a minimum value validator for this field. Operation fails if the validator fails.
 func (b *uint64Builder) Min(i uint64) *uint64Builder {
+	b.desc.min(float64(i))
 	b.desc.Validators = append(b.desc.Validators, func(v uint64) error {
 		if v < i {
 			return errors.New("value out of range")
@@ -1553,6 +1592,7 @@ func (b *uint64Builder) Min(i uint64) *uint64Builder {
 
 // Max adds a maximum value validator for this field. Operation fails if the validator fails.
 func (b *uint64Builder) Max(i uint64) *uint64Builder {
+	b.desc.max(float64(i))
 	b.desc.Validators = append(b.desc.Validators, func(v uint64) error {
 		if v > i {
 			return errors.New("value out of range")
@@ -1695,6 +1735,8 @@ func (b *float64Builder) Unique() *float64Builder {
 
 // Range adds a range validator for this field where the given value needs to be in the range of [i, j].
 func (b *float64Builder) Range(i, j float64) *float64Builder {
+	b.desc.min(float64(i))
+	b.desc.max(float64(j))
 	b.desc.Validators = append(b.desc.Validators, func(v float64) error {
 		if v < i || v > j {
 			return errors.New("value out of range")
@@ -1706,6 +1748,7 @@ func (b *float64Builder) Range(i, j float64) *float64Builder {
 
 // Min adds a minimum value validator for this field. Operation fails if the validator fails.
 func (b *float64Builder) Min(i float64) *float64Builder {
+	b.desc.min(float64(i))
 	b.desc.Validators = append(b.desc.Validators, func(v float64) error {
 		if v < i {
 			return errors.New("value out of range")
@@ -1717,6 +1760,7 @@ func (b *float64Builder) Min(i float64) *float64Builder {
 
 // Max adds a maximum value validator for this field. Operation fails if the validator fails.
 func (b *float64Builder) Max(i float64) *float64Builder {
+	b.desc.max(float64(i))
 	b.desc.Validators = append(b.desc.Validators, func(v float64) error {
 		if v > i {
 			return errors.New("value out of range")
@@ -1842,6 +1886,8 @@ func (b *float32Builder) Unique() *float32Builder {
 
 // Range adds a range validator for this field where the given value needs to be in the range of [i, j].
 func (b *float32Builder) Range(i, j float32) *float32Builder {
+	b.desc.min(float64(i))
+	b.desc.max(float64(j))
 	b.desc.Validators = append(b.desc.Validators, func(v float32) error {
 		if v < i || v > j {
 			return errors.New("value out of range")
@@ -1853,6 +1899,7 @@ func (b *float32Builder) Range(i, j float32) *float32Builder {
 
 // Min adds a minimum value validator for this field. Operation fails if the validator fails.
 func (b *float32Builder) Min(i float32) *float32Builder {
+	b.desc.min(float64(i))
 	b.desc.Validators = append(b.desc.Validators, func(v float32) error {
 		if v < i {
 			return errors.New("value out of range")
@@ -1864,6 +1911,7 @@ func (b *float32Builder) Min(i float32) *float32Builder {
 
 // Max adds a maximum value validator for this field. Operation fails if the validator fails.
 func (b *float32Builder) Max(i float32) *float32Builder {
+	b.desc.max(float64(i))
 	b.desc.Validators = append(b.desc.Validators, func(v float32) error {
 		if v > i {
 			return errors.New("value out of range")
@@ -5,6 +5,7 @@
 package mixin
 
 import (
+	"context"
 	"time"
 
 	"entgo.io/ent"
@@ -33,6 +34,9 @@ func (Schema) Indexes() []ent.Index { return nil }
 // Hooks of the mixin.
 func (Schema) Hooks() []ent.Hook { return nil }
 
+// Interceptors of the mixin.
+func (Schema) Interceptors() []ent.Interceptor { return nil }
+
 // Policy of the mixin.
 func (Schema) Policy() ent.Policy { return nil }
 
@@ -87,6 +91,80 @@ func (Time) Fields() []ent.Field {
 // time mixin must implement `Mixin` interface.
 var _ ent.Mixin = (*Time)(nil)
 
+// SoftDelete adds a deleted at time field, and marks the schema as a soft-deleted
+// schema. The generated delete builders of soft-deleted schemas set this field
+// instead of removing the entities from the database, and the generated query
+// builders filter out entities where this field is set. Use the IncludeDeleted
+// and HardDelete functions to change this behavior for a specific context.
+type SoftDelete struct{ Schema }
+
+// Fields of the soft delete mixin.
+func (SoftDelete) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Annotations of the soft delete mixin.
+func (SoftDelete) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		SoftDeleteAnnotation{Field: "deleted_at"},
+	}
+}
+
+// soft delete mixin must implement `Mixin` interface.
+var _ ent.Mixin = (*SoftDelete)(nil)
+
+// SoftDeleteAnnotation marks a schema as soft-deleted. It is added to the schema
+// by the SoftDelete mixin, and can be used directly by schemas that want to use a
+// different field. The field must be an optional and nillable time field.
+type SoftDeleteAnnotation struct {
+	// Field is the name of the field that holds the deletion time.
+	Field string `json:"field,omitempty"`
+}
+
+// Name describes the annotation name.
+func (SoftDeleteAnnotation) Name() string {
+	return "SoftDelete"
+}
+
+type (
+	includeDeletedKey struct{}
+	hardDeleteKey     struct{}
+)
+
+// IncludeDeleted returns a new context that tells the query builders
+// of soft-deleted schemas to include deleted entities in their results.
+//
+//	users := client.User.Query().AllX(mixin.IncludeDeleted(ctx))
+//
+func IncludeDeleted(parent context.Context) context.Context {
+	return context.WithValue(parent, includeDeletedKey{}, true)
+}
+
+// DeletedIncluded reports if the context was created with IncludeDeleted.
+func DeletedIncluded(ctx context.Context) bool {
+	include, _ := ctx.Value(includeDeletedKey{}).(bool)
+	return include
+}
+
+// HardDelete returns a new context that tells the delete builders of
+// soft-deleted schemas to remove the entities from the database.
+//
+//	client.User.DeleteOneID(id).ExecX(mixin.HardDelete(ctx))
+//
+func HardDelete(parent context.Context) context.Context {
+	return context.WithValue(parent, hardDeleteKey{}, true)
+}
+
+// IsHardDelete reports if the context was created with HardDelete.
+func IsHardDelete(ctx context.Context) bool {
+	hard, _ := ctx.Value(hardDeleteKey{}).(bool)
+	return hard
+}
+
 // AnnotateFields adds field annotations to underlying mixin fields.
 func AnnotateFields(m ent.Mixin, annotations ...schema.Annotation) ent.Mixin {
 	return fieldAnnotator{Mixin: m, annotations: annotations}
@@ -5,6 +5,7 @@
 package mixin_test
 
 import (
+	"context"
 	"testing"
 
 	"entgo.io/ent"
@@ -46,6 +47,25 @@ func TestTimeMixin(t *testing.T) {
 	})
 }
 
+func TestSoftDeleteMixin(t *testing.T) {
+	fields := mixin.SoftDelete{}.Fields()
+	require.Len(t, fields, 1)
+	desc := fields[0].Descriptor()
+	assert.Equal(t, "deleted_at", desc.Name)
+	assert.True(t, desc.Optional)
+	assert.True(t, desc.Nillable)
+	annotations := mixin.SoftDelete{}.Annotations()
+	require.Len(t, annotations, 1)
+	assert.Equal(t, mixin.SoftDeleteAnnotation{Field: "deleted_at"}, annotations[0])
+
+	ctx := context.Background()
+	assert.False(t, mixin.DeletedIncluded(ctx))
+	assert.False(t, mixin.IsHardDelete(ctx))
+	assert.True(t, mixin.DeletedIncluded(mixin.IncludeDeleted(ctx)))
+	assert.False(t, mixin.IsHardDelete(mixin.IncludeDeleted(ctx)))
+	assert.True(t, mixin.IsHardDelete(mixin.HardDelete(ctx)))
+}
+
 type annotation string
 
 func (annotation) Name() string { return "" }